@@ -657,6 +657,1134 @@ func (x *fastReflection_EventRelayMiningDifficultyUpdated) ProtoMethods() *proto
 	}
 }
 
+var (
+	md_EventServiceComputeUnitsToTokensMultiplierUpdated                                         protoreflect.MessageDescriptor
+	fd_EventServiceComputeUnitsToTokensMultiplierUpdated_service_id                              protoreflect.FieldDescriptor
+	fd_EventServiceComputeUnitsToTokensMultiplierUpdated_prev_compute_units_to_tokens_multiplier protoreflect.FieldDescriptor
+	fd_EventServiceComputeUnitsToTokensMultiplierUpdated_new_compute_units_to_tokens_multiplier  protoreflect.FieldDescriptor
+	fd_EventServiceComputeUnitsToTokensMultiplierUpdated_effective_block_height                  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_pocket_service_event_proto_init()
+	md_EventServiceComputeUnitsToTokensMultiplierUpdated = File_pocket_service_event_proto.Messages().ByName("EventServiceComputeUnitsToTokensMultiplierUpdated")
+	fd_EventServiceComputeUnitsToTokensMultiplierUpdated_service_id = md_EventServiceComputeUnitsToTokensMultiplierUpdated.Fields().ByName("service_id")
+	fd_EventServiceComputeUnitsToTokensMultiplierUpdated_prev_compute_units_to_tokens_multiplier = md_EventServiceComputeUnitsToTokensMultiplierUpdated.Fields().ByName("prev_compute_units_to_tokens_multiplier")
+	fd_EventServiceComputeUnitsToTokensMultiplierUpdated_new_compute_units_to_tokens_multiplier = md_EventServiceComputeUnitsToTokensMultiplierUpdated.Fields().ByName("new_compute_units_to_tokens_multiplier")
+	fd_EventServiceComputeUnitsToTokensMultiplierUpdated_effective_block_height = md_EventServiceComputeUnitsToTokensMultiplierUpdated.Fields().ByName("effective_block_height")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventServiceComputeUnitsToTokensMultiplierUpdated)(nil)
+
+type fastReflection_EventServiceComputeUnitsToTokensMultiplierUpdated EventServiceComputeUnitsToTokensMultiplierUpdated
+
+func (x *EventServiceComputeUnitsToTokensMultiplierUpdated) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventServiceComputeUnitsToTokensMultiplierUpdated)(x)
+}
+
+func (x *EventServiceComputeUnitsToTokensMultiplierUpdated) slowProtoReflect() protoreflect.Message {
+	mi := &file_pocket_service_event_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventServiceComputeUnitsToTokensMultiplierUpdated_messageType fastReflection_EventServiceComputeUnitsToTokensMultiplierUpdated_messageType
+var _ protoreflect.MessageType = fastReflection_EventServiceComputeUnitsToTokensMultiplierUpdated_messageType{}
+
+type fastReflection_EventServiceComputeUnitsToTokensMultiplierUpdated_messageType struct{}
+
+func (x fastReflection_EventServiceComputeUnitsToTokensMultiplierUpdated_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventServiceComputeUnitsToTokensMultiplierUpdated)(nil)
+}
+func (x fastReflection_EventServiceComputeUnitsToTokensMultiplierUpdated_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventServiceComputeUnitsToTokensMultiplierUpdated)
+}
+func (x fastReflection_EventServiceComputeUnitsToTokensMultiplierUpdated_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventServiceComputeUnitsToTokensMultiplierUpdated
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventServiceComputeUnitsToTokensMultiplierUpdated) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventServiceComputeUnitsToTokensMultiplierUpdated
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventServiceComputeUnitsToTokensMultiplierUpdated) Type() protoreflect.MessageType {
+	return _fastReflection_EventServiceComputeUnitsToTokensMultiplierUpdated_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventServiceComputeUnitsToTokensMultiplierUpdated) New() protoreflect.Message {
+	return new(fastReflection_EventServiceComputeUnitsToTokensMultiplierUpdated)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventServiceComputeUnitsToTokensMultiplierUpdated) Interface() protoreflect.ProtoMessage {
+	return (*EventServiceComputeUnitsToTokensMultiplierUpdated)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventServiceComputeUnitsToTokensMultiplierUpdated) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ServiceId != "" {
+		value := protoreflect.ValueOfString(x.ServiceId)
+		if !f(fd_EventServiceComputeUnitsToTokensMultiplierUpdated_service_id, value) {
+			return
+		}
+	}
+	if x.PrevComputeUnitsToTokensMultiplier != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PrevComputeUnitsToTokensMultiplier)
+		if !f(fd_EventServiceComputeUnitsToTokensMultiplierUpdated_prev_compute_units_to_tokens_multiplier, value) {
+			return
+		}
+	}
+	if x.NewComputeUnitsToTokensMultiplier != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.NewComputeUnitsToTokensMultiplier)
+		if !f(fd_EventServiceComputeUnitsToTokensMultiplierUpdated_new_compute_units_to_tokens_multiplier, value) {
+			return
+		}
+	}
+	if x.EffectiveBlockHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.EffectiveBlockHeight)
+		if !f(fd_EventServiceComputeUnitsToTokensMultiplierUpdated_effective_block_height, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventServiceComputeUnitsToTokensMultiplierUpdated) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "pocket.service.EventServiceComputeUnitsToTokensMultiplierUpdated.service_id":
+		return x.ServiceId != ""
+	case "pocket.service.EventServiceComputeUnitsToTokensMultiplierUpdated.prev_compute_units_to_tokens_multiplier":
+		return x.PrevComputeUnitsToTokensMultiplier != uint64(0)
+	case "pocket.service.EventServiceComputeUnitsToTokensMultiplierUpdated.new_compute_units_to_tokens_multiplier":
+		return x.NewComputeUnitsToTokensMultiplier != uint64(0)
+	case "pocket.service.EventServiceComputeUnitsToTokensMultiplierUpdated.effective_block_height":
+		return x.EffectiveBlockHeight != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.service.EventServiceComputeUnitsToTokensMultiplierUpdated"))
+		}
+		panic(fmt.Errorf("message pocket.service.EventServiceComputeUnitsToTokensMultiplierUpdated does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventServiceComputeUnitsToTokensMultiplierUpdated) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "pocket.service.EventServiceComputeUnitsToTokensMultiplierUpdated.service_id":
+		x.ServiceId = ""
+	case "pocket.service.EventServiceComputeUnitsToTokensMultiplierUpdated.prev_compute_units_to_tokens_multiplier":
+		x.PrevComputeUnitsToTokensMultiplier = uint64(0)
+	case "pocket.service.EventServiceComputeUnitsToTokensMultiplierUpdated.new_compute_units_to_tokens_multiplier":
+		x.NewComputeUnitsToTokensMultiplier = uint64(0)
+	case "pocket.service.EventServiceComputeUnitsToTokensMultiplierUpdated.effective_block_height":
+		x.EffectiveBlockHeight = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.service.EventServiceComputeUnitsToTokensMultiplierUpdated"))
+		}
+		panic(fmt.Errorf("message pocket.service.EventServiceComputeUnitsToTokensMultiplierUpdated does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventServiceComputeUnitsToTokensMultiplierUpdated) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "pocket.service.EventServiceComputeUnitsToTokensMultiplierUpdated.service_id":
+		value := x.ServiceId
+		return protoreflect.ValueOfString(value)
+	case "pocket.service.EventServiceComputeUnitsToTokensMultiplierUpdated.prev_compute_units_to_tokens_multiplier":
+		value := x.PrevComputeUnitsToTokensMultiplier
+		return protoreflect.ValueOfUint64(value)
+	case "pocket.service.EventServiceComputeUnitsToTokensMultiplierUpdated.new_compute_units_to_tokens_multiplier":
+		value := x.NewComputeUnitsToTokensMultiplier
+		return protoreflect.ValueOfUint64(value)
+	case "pocket.service.EventServiceComputeUnitsToTokensMultiplierUpdated.effective_block_height":
+		value := x.EffectiveBlockHeight
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.service.EventServiceComputeUnitsToTokensMultiplierUpdated"))
+		}
+		panic(fmt.Errorf("message pocket.service.EventServiceComputeUnitsToTokensMultiplierUpdated does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventServiceComputeUnitsToTokensMultiplierUpdated) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "pocket.service.EventServiceComputeUnitsToTokensMultiplierUpdated.service_id":
+		x.ServiceId = value.Interface().(string)
+	case "pocket.service.EventServiceComputeUnitsToTokensMultiplierUpdated.prev_compute_units_to_tokens_multiplier":
+		x.PrevComputeUnitsToTokensMultiplier = value.Uint()
+	case "pocket.service.EventServiceComputeUnitsToTokensMultiplierUpdated.new_compute_units_to_tokens_multiplier":
+		x.NewComputeUnitsToTokensMultiplier = value.Uint()
+	case "pocket.service.EventServiceComputeUnitsToTokensMultiplierUpdated.effective_block_height":
+		x.EffectiveBlockHeight = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.service.EventServiceComputeUnitsToTokensMultiplierUpdated"))
+		}
+		panic(fmt.Errorf("message pocket.service.EventServiceComputeUnitsToTokensMultiplierUpdated does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventServiceComputeUnitsToTokensMultiplierUpdated) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "pocket.service.EventServiceComputeUnitsToTokensMultiplierUpdated.service_id":
+		panic(fmt.Errorf("field service_id of message pocket.service.EventServiceComputeUnitsToTokensMultiplierUpdated is not mutable"))
+	case "pocket.service.EventServiceComputeUnitsToTokensMultiplierUpdated.prev_compute_units_to_tokens_multiplier":
+		panic(fmt.Errorf("field prev_compute_units_to_tokens_multiplier of message pocket.service.EventServiceComputeUnitsToTokensMultiplierUpdated is not mutable"))
+	case "pocket.service.EventServiceComputeUnitsToTokensMultiplierUpdated.new_compute_units_to_tokens_multiplier":
+		panic(fmt.Errorf("field new_compute_units_to_tokens_multiplier of message pocket.service.EventServiceComputeUnitsToTokensMultiplierUpdated is not mutable"))
+	case "pocket.service.EventServiceComputeUnitsToTokensMultiplierUpdated.effective_block_height":
+		panic(fmt.Errorf("field effective_block_height of message pocket.service.EventServiceComputeUnitsToTokensMultiplierUpdated is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.service.EventServiceComputeUnitsToTokensMultiplierUpdated"))
+		}
+		panic(fmt.Errorf("message pocket.service.EventServiceComputeUnitsToTokensMultiplierUpdated does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventServiceComputeUnitsToTokensMultiplierUpdated) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "pocket.service.EventServiceComputeUnitsToTokensMultiplierUpdated.service_id":
+		return protoreflect.ValueOfString("")
+	case "pocket.service.EventServiceComputeUnitsToTokensMultiplierUpdated.prev_compute_units_to_tokens_multiplier":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "pocket.service.EventServiceComputeUnitsToTokensMultiplierUpdated.new_compute_units_to_tokens_multiplier":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "pocket.service.EventServiceComputeUnitsToTokensMultiplierUpdated.effective_block_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.service.EventServiceComputeUnitsToTokensMultiplierUpdated"))
+		}
+		panic(fmt.Errorf("message pocket.service.EventServiceComputeUnitsToTokensMultiplierUpdated does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventServiceComputeUnitsToTokensMultiplierUpdated) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in pocket.service.EventServiceComputeUnitsToTokensMultiplierUpdated", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventServiceComputeUnitsToTokensMultiplierUpdated) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventServiceComputeUnitsToTokensMultiplierUpdated) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventServiceComputeUnitsToTokensMultiplierUpdated) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventServiceComputeUnitsToTokensMultiplierUpdated) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventServiceComputeUnitsToTokensMultiplierUpdated)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.ServiceId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.PrevComputeUnitsToTokensMultiplier != 0 {
+			n += 1 + runtime.Sov(uint64(x.PrevComputeUnitsToTokensMultiplier))
+		}
+		if x.NewComputeUnitsToTokensMultiplier != 0 {
+			n += 1 + runtime.Sov(uint64(x.NewComputeUnitsToTokensMultiplier))
+		}
+		if x.EffectiveBlockHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.EffectiveBlockHeight))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventServiceComputeUnitsToTokensMultiplierUpdated)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.EffectiveBlockHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.EffectiveBlockHeight))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.NewComputeUnitsToTokensMultiplier != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NewComputeUnitsToTokensMultiplier))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.PrevComputeUnitsToTokensMultiplier != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PrevComputeUnitsToTokensMultiplier))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.ServiceId) > 0 {
+			i -= len(x.ServiceId)
+			copy(dAtA[i:], x.ServiceId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ServiceId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventServiceComputeUnitsToTokensMultiplierUpdated)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventServiceComputeUnitsToTokensMultiplierUpdated: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventServiceComputeUnitsToTokensMultiplierUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ServiceId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ServiceId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PrevComputeUnitsToTokensMultiplier", wireType)
+				}
+				x.PrevComputeUnitsToTokensMultiplier = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PrevComputeUnitsToTokensMultiplier |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NewComputeUnitsToTokensMultiplier", wireType)
+				}
+				x.NewComputeUnitsToTokensMultiplier = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NewComputeUnitsToTokensMultiplier |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EffectiveBlockHeight", wireType)
+				}
+				x.EffectiveBlockHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.EffectiveBlockHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventServiceOwnerRevSharePercentageUpdated                                 protoreflect.MessageDescriptor
+	fd_EventServiceOwnerRevSharePercentageUpdated_service_id                      protoreflect.FieldDescriptor
+	fd_EventServiceOwnerRevSharePercentageUpdated_prev_owner_rev_share_percentage protoreflect.FieldDescriptor
+	fd_EventServiceOwnerRevSharePercentageUpdated_new_owner_rev_share_percentage  protoreflect.FieldDescriptor
+	fd_EventServiceOwnerRevSharePercentageUpdated_effective_block_height          protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_pocket_service_event_proto_init()
+	md_EventServiceOwnerRevSharePercentageUpdated = File_pocket_service_event_proto.Messages().ByName("EventServiceOwnerRevSharePercentageUpdated")
+	fd_EventServiceOwnerRevSharePercentageUpdated_service_id = md_EventServiceOwnerRevSharePercentageUpdated.Fields().ByName("service_id")
+	fd_EventServiceOwnerRevSharePercentageUpdated_prev_owner_rev_share_percentage = md_EventServiceOwnerRevSharePercentageUpdated.Fields().ByName("prev_owner_rev_share_percentage")
+	fd_EventServiceOwnerRevSharePercentageUpdated_new_owner_rev_share_percentage = md_EventServiceOwnerRevSharePercentageUpdated.Fields().ByName("new_owner_rev_share_percentage")
+	fd_EventServiceOwnerRevSharePercentageUpdated_effective_block_height = md_EventServiceOwnerRevSharePercentageUpdated.Fields().ByName("effective_block_height")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventServiceOwnerRevSharePercentageUpdated)(nil)
+
+type fastReflection_EventServiceOwnerRevSharePercentageUpdated EventServiceOwnerRevSharePercentageUpdated
+
+func (x *EventServiceOwnerRevSharePercentageUpdated) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventServiceOwnerRevSharePercentageUpdated)(x)
+}
+
+func (x *EventServiceOwnerRevSharePercentageUpdated) slowProtoReflect() protoreflect.Message {
+	mi := &file_pocket_service_event_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventServiceOwnerRevSharePercentageUpdated_messageType fastReflection_EventServiceOwnerRevSharePercentageUpdated_messageType
+var _ protoreflect.MessageType = fastReflection_EventServiceOwnerRevSharePercentageUpdated_messageType{}
+
+type fastReflection_EventServiceOwnerRevSharePercentageUpdated_messageType struct{}
+
+func (x fastReflection_EventServiceOwnerRevSharePercentageUpdated_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventServiceOwnerRevSharePercentageUpdated)(nil)
+}
+func (x fastReflection_EventServiceOwnerRevSharePercentageUpdated_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventServiceOwnerRevSharePercentageUpdated)
+}
+func (x fastReflection_EventServiceOwnerRevSharePercentageUpdated_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventServiceOwnerRevSharePercentageUpdated
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventServiceOwnerRevSharePercentageUpdated) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventServiceOwnerRevSharePercentageUpdated
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventServiceOwnerRevSharePercentageUpdated) Type() protoreflect.MessageType {
+	return _fastReflection_EventServiceOwnerRevSharePercentageUpdated_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventServiceOwnerRevSharePercentageUpdated) New() protoreflect.Message {
+	return new(fastReflection_EventServiceOwnerRevSharePercentageUpdated)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventServiceOwnerRevSharePercentageUpdated) Interface() protoreflect.ProtoMessage {
+	return (*EventServiceOwnerRevSharePercentageUpdated)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventServiceOwnerRevSharePercentageUpdated) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ServiceId != "" {
+		value := protoreflect.ValueOfString(x.ServiceId)
+		if !f(fd_EventServiceOwnerRevSharePercentageUpdated_service_id, value) {
+			return
+		}
+	}
+	if x.PrevOwnerRevSharePercentage != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PrevOwnerRevSharePercentage)
+		if !f(fd_EventServiceOwnerRevSharePercentageUpdated_prev_owner_rev_share_percentage, value) {
+			return
+		}
+	}
+	if x.NewOwnerRevSharePercentage != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.NewOwnerRevSharePercentage)
+		if !f(fd_EventServiceOwnerRevSharePercentageUpdated_new_owner_rev_share_percentage, value) {
+			return
+		}
+	}
+	if x.EffectiveBlockHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.EffectiveBlockHeight)
+		if !f(fd_EventServiceOwnerRevSharePercentageUpdated_effective_block_height, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventServiceOwnerRevSharePercentageUpdated) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "pocket.service.EventServiceOwnerRevSharePercentageUpdated.service_id":
+		return x.ServiceId != ""
+	case "pocket.service.EventServiceOwnerRevSharePercentageUpdated.prev_owner_rev_share_percentage":
+		return x.PrevOwnerRevSharePercentage != uint64(0)
+	case "pocket.service.EventServiceOwnerRevSharePercentageUpdated.new_owner_rev_share_percentage":
+		return x.NewOwnerRevSharePercentage != uint64(0)
+	case "pocket.service.EventServiceOwnerRevSharePercentageUpdated.effective_block_height":
+		return x.EffectiveBlockHeight != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.service.EventServiceOwnerRevSharePercentageUpdated"))
+		}
+		panic(fmt.Errorf("message pocket.service.EventServiceOwnerRevSharePercentageUpdated does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventServiceOwnerRevSharePercentageUpdated) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "pocket.service.EventServiceOwnerRevSharePercentageUpdated.service_id":
+		x.ServiceId = ""
+	case "pocket.service.EventServiceOwnerRevSharePercentageUpdated.prev_owner_rev_share_percentage":
+		x.PrevOwnerRevSharePercentage = uint64(0)
+	case "pocket.service.EventServiceOwnerRevSharePercentageUpdated.new_owner_rev_share_percentage":
+		x.NewOwnerRevSharePercentage = uint64(0)
+	case "pocket.service.EventServiceOwnerRevSharePercentageUpdated.effective_block_height":
+		x.EffectiveBlockHeight = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.service.EventServiceOwnerRevSharePercentageUpdated"))
+		}
+		panic(fmt.Errorf("message pocket.service.EventServiceOwnerRevSharePercentageUpdated does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventServiceOwnerRevSharePercentageUpdated) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "pocket.service.EventServiceOwnerRevSharePercentageUpdated.service_id":
+		value := x.ServiceId
+		return protoreflect.ValueOfString(value)
+	case "pocket.service.EventServiceOwnerRevSharePercentageUpdated.prev_owner_rev_share_percentage":
+		value := x.PrevOwnerRevSharePercentage
+		return protoreflect.ValueOfUint64(value)
+	case "pocket.service.EventServiceOwnerRevSharePercentageUpdated.new_owner_rev_share_percentage":
+		value := x.NewOwnerRevSharePercentage
+		return protoreflect.ValueOfUint64(value)
+	case "pocket.service.EventServiceOwnerRevSharePercentageUpdated.effective_block_height":
+		value := x.EffectiveBlockHeight
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.service.EventServiceOwnerRevSharePercentageUpdated"))
+		}
+		panic(fmt.Errorf("message pocket.service.EventServiceOwnerRevSharePercentageUpdated does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventServiceOwnerRevSharePercentageUpdated) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "pocket.service.EventServiceOwnerRevSharePercentageUpdated.service_id":
+		x.ServiceId = value.Interface().(string)
+	case "pocket.service.EventServiceOwnerRevSharePercentageUpdated.prev_owner_rev_share_percentage":
+		x.PrevOwnerRevSharePercentage = value.Uint()
+	case "pocket.service.EventServiceOwnerRevSharePercentageUpdated.new_owner_rev_share_percentage":
+		x.NewOwnerRevSharePercentage = value.Uint()
+	case "pocket.service.EventServiceOwnerRevSharePercentageUpdated.effective_block_height":
+		x.EffectiveBlockHeight = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.service.EventServiceOwnerRevSharePercentageUpdated"))
+		}
+		panic(fmt.Errorf("message pocket.service.EventServiceOwnerRevSharePercentageUpdated does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventServiceOwnerRevSharePercentageUpdated) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "pocket.service.EventServiceOwnerRevSharePercentageUpdated.service_id":
+		panic(fmt.Errorf("field service_id of message pocket.service.EventServiceOwnerRevSharePercentageUpdated is not mutable"))
+	case "pocket.service.EventServiceOwnerRevSharePercentageUpdated.prev_owner_rev_share_percentage":
+		panic(fmt.Errorf("field prev_owner_rev_share_percentage of message pocket.service.EventServiceOwnerRevSharePercentageUpdated is not mutable"))
+	case "pocket.service.EventServiceOwnerRevSharePercentageUpdated.new_owner_rev_share_percentage":
+		panic(fmt.Errorf("field new_owner_rev_share_percentage of message pocket.service.EventServiceOwnerRevSharePercentageUpdated is not mutable"))
+	case "pocket.service.EventServiceOwnerRevSharePercentageUpdated.effective_block_height":
+		panic(fmt.Errorf("field effective_block_height of message pocket.service.EventServiceOwnerRevSharePercentageUpdated is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.service.EventServiceOwnerRevSharePercentageUpdated"))
+		}
+		panic(fmt.Errorf("message pocket.service.EventServiceOwnerRevSharePercentageUpdated does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventServiceOwnerRevSharePercentageUpdated) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "pocket.service.EventServiceOwnerRevSharePercentageUpdated.service_id":
+		return protoreflect.ValueOfString("")
+	case "pocket.service.EventServiceOwnerRevSharePercentageUpdated.prev_owner_rev_share_percentage":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "pocket.service.EventServiceOwnerRevSharePercentageUpdated.new_owner_rev_share_percentage":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "pocket.service.EventServiceOwnerRevSharePercentageUpdated.effective_block_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.service.EventServiceOwnerRevSharePercentageUpdated"))
+		}
+		panic(fmt.Errorf("message pocket.service.EventServiceOwnerRevSharePercentageUpdated does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventServiceOwnerRevSharePercentageUpdated) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in pocket.service.EventServiceOwnerRevSharePercentageUpdated", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventServiceOwnerRevSharePercentageUpdated) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventServiceOwnerRevSharePercentageUpdated) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventServiceOwnerRevSharePercentageUpdated) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventServiceOwnerRevSharePercentageUpdated) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventServiceOwnerRevSharePercentageUpdated)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.ServiceId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.PrevOwnerRevSharePercentage != 0 {
+			n += 1 + runtime.Sov(uint64(x.PrevOwnerRevSharePercentage))
+		}
+		if x.NewOwnerRevSharePercentage != 0 {
+			n += 1 + runtime.Sov(uint64(x.NewOwnerRevSharePercentage))
+		}
+		if x.EffectiveBlockHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.EffectiveBlockHeight))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventServiceOwnerRevSharePercentageUpdated)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.EffectiveBlockHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.EffectiveBlockHeight))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.NewOwnerRevSharePercentage != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NewOwnerRevSharePercentage))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.PrevOwnerRevSharePercentage != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PrevOwnerRevSharePercentage))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.ServiceId) > 0 {
+			i -= len(x.ServiceId)
+			copy(dAtA[i:], x.ServiceId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ServiceId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventServiceOwnerRevSharePercentageUpdated)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventServiceOwnerRevSharePercentageUpdated: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventServiceOwnerRevSharePercentageUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ServiceId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ServiceId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PrevOwnerRevSharePercentage", wireType)
+				}
+				x.PrevOwnerRevSharePercentage = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PrevOwnerRevSharePercentage |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NewOwnerRevSharePercentage", wireType)
+				}
+				x.NewOwnerRevSharePercentage = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NewOwnerRevSharePercentage |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EffectiveBlockHeight", wireType)
+				}
+				x.EffectiveBlockHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.EffectiveBlockHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
 // 	protoc-gen-go v1.27.0
@@ -739,6 +1867,130 @@ func (x *EventRelayMiningDifficultyUpdated) GetNewNumRelaysEma() uint64 {
 	return 0
 }
 
+// EventServiceComputeUnitsToTokensMultiplierUpdated is an event emitted whenever
+// the compute units to tokens multiplier of a service is updated.
+type EventServiceComputeUnitsToTokensMultiplierUpdated struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServiceId                          string `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	PrevComputeUnitsToTokensMultiplier uint64 `protobuf:"varint,2,opt,name=prev_compute_units_to_tokens_multiplier,json=prevComputeUnitsToTokensMultiplier,proto3" json:"prev_compute_units_to_tokens_multiplier,omitempty"`
+	NewComputeUnitsToTokensMultiplier  uint64 `protobuf:"varint,3,opt,name=new_compute_units_to_tokens_multiplier,json=newComputeUnitsToTokensMultiplier,proto3" json:"new_compute_units_to_tokens_multiplier,omitempty"`
+	// The start height of the first session settled with the new multiplier.
+	EffectiveBlockHeight int64 `protobuf:"varint,4,opt,name=effective_block_height,json=effectiveBlockHeight,proto3" json:"effective_block_height,omitempty"`
+}
+
+func (x *EventServiceComputeUnitsToTokensMultiplierUpdated) Reset() {
+	*x = EventServiceComputeUnitsToTokensMultiplierUpdated{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pocket_service_event_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventServiceComputeUnitsToTokensMultiplierUpdated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventServiceComputeUnitsToTokensMultiplierUpdated) ProtoMessage() {}
+
+// Deprecated: Use EventServiceComputeUnitsToTokensMultiplierUpdated.ProtoReflect.Descriptor instead.
+func (*EventServiceComputeUnitsToTokensMultiplierUpdated) Descriptor() ([]byte, []int) {
+	return file_pocket_service_event_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *EventServiceComputeUnitsToTokensMultiplierUpdated) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+func (x *EventServiceComputeUnitsToTokensMultiplierUpdated) GetPrevComputeUnitsToTokensMultiplier() uint64 {
+	if x != nil {
+		return x.PrevComputeUnitsToTokensMultiplier
+	}
+	return 0
+}
+
+func (x *EventServiceComputeUnitsToTokensMultiplierUpdated) GetNewComputeUnitsToTokensMultiplier() uint64 {
+	if x != nil {
+		return x.NewComputeUnitsToTokensMultiplier
+	}
+	return 0
+}
+
+func (x *EventServiceComputeUnitsToTokensMultiplierUpdated) GetEffectiveBlockHeight() int64 {
+	if x != nil {
+		return x.EffectiveBlockHeight
+	}
+	return 0
+}
+
+// EventServiceOwnerRevSharePercentageUpdated is an event emitted whenever
+// the owner rev share percentage of a service is updated.
+type EventServiceOwnerRevSharePercentageUpdated struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServiceId                   string `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	PrevOwnerRevSharePercentage uint64 `protobuf:"varint,2,opt,name=prev_owner_rev_share_percentage,json=prevOwnerRevSharePercentage,proto3" json:"prev_owner_rev_share_percentage,omitempty"`
+	NewOwnerRevSharePercentage  uint64 `protobuf:"varint,3,opt,name=new_owner_rev_share_percentage,json=newOwnerRevSharePercentage,proto3" json:"new_owner_rev_share_percentage,omitempty"`
+	// The start height of the first session settled with the new percentage.
+	EffectiveBlockHeight int64 `protobuf:"varint,4,opt,name=effective_block_height,json=effectiveBlockHeight,proto3" json:"effective_block_height,omitempty"`
+}
+
+func (x *EventServiceOwnerRevSharePercentageUpdated) Reset() {
+	*x = EventServiceOwnerRevSharePercentageUpdated{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pocket_service_event_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventServiceOwnerRevSharePercentageUpdated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventServiceOwnerRevSharePercentageUpdated) ProtoMessage() {}
+
+// Deprecated: Use EventServiceOwnerRevSharePercentageUpdated.ProtoReflect.Descriptor instead.
+func (*EventServiceOwnerRevSharePercentageUpdated) Descriptor() ([]byte, []int) {
+	return file_pocket_service_event_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *EventServiceOwnerRevSharePercentageUpdated) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+func (x *EventServiceOwnerRevSharePercentageUpdated) GetPrevOwnerRevSharePercentage() uint64 {
+	if x != nil {
+		return x.PrevOwnerRevSharePercentage
+	}
+	return 0
+}
+
+func (x *EventServiceOwnerRevSharePercentageUpdated) GetNewOwnerRevSharePercentage() uint64 {
+	if x != nil {
+		return x.NewOwnerRevSharePercentage
+	}
+	return 0
+}
+
+func (x *EventServiceOwnerRevSharePercentageUpdated) GetEffectiveBlockHeight() int64 {
+	if x != nil {
+		return x.EffectiveBlockHeight
+	}
+	return 0
+}
+
 var File_pocket_service_event_proto protoreflect.FileDescriptor
 
 var file_pocket_service_event_proto_rawDesc = []byte{
@@ -764,17 +2016,46 @@ var file_pocket_service_event_proto_rawDesc = []byte{
 	0x73, 0x45, 0x6d, 0x61, 0x12, 0x2b, 0x0a, 0x12, 0x6e, 0x65, 0x77, 0x5f, 0x6e, 0x75, 0x6d, 0x5f,
 	0x72, 0x65, 0x6c, 0x61, 0x79, 0x73, 0x5f, 0x65, 0x6d, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04,
 	0x52, 0x0f, 0x6e, 0x65, 0x77, 0x4e, 0x75, 0x6d, 0x52, 0x65, 0x6c, 0x61, 0x79, 0x73, 0x45, 0x6d,
-	0x61, 0x42, 0x9e, 0x01, 0xd8, 0xe2, 0x1e, 0x01, 0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x2e, 0x70, 0x6f,
-	0x63, 0x6b, 0x65, 0x74, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x42, 0x0a, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x1f, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x6f, 0x63,
-	0x6b, 0x65, 0x74, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0xa2, 0x02, 0x03, 0x50, 0x53,
-	0x58, 0xaa, 0x02, 0x0e, 0x50, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0xca, 0x02, 0x0e, 0x50, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x5c, 0x53, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0xe2, 0x02, 0x1a, 0x50, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x5c, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0xea, 0x02, 0x0f, 0x50, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x3a, 0x3a, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x61, 0x22, 0xb0, 0x02, 0x0a, 0x31, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x54, 0x6f,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x53, 0x0a, 0x27, 0x70, 0x72, 0x65, 0x76, 0x5f, 0x63,
+	0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x5f, 0x74, 0x6f, 0x5f,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x22, 0x70, 0x72, 0x65, 0x76, 0x43, 0x6f, 0x6d,
+	0x70, 0x75, 0x74, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x54, 0x6f, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x73, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x12, 0x51, 0x0a, 0x26, 0x6e,
+	0x65, 0x77, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x73,
+	0x5f, 0x74, 0x6f, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69,
+	0x70, 0x6c, 0x69, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x21, 0x6e, 0x65, 0x77,
+	0x43, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x54, 0x6f, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x73, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x12, 0x34,
+	0x0a, 0x16, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14,
+	0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x22, 0x8b, 0x02, 0x0a, 0x2a, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x76, 0x53, 0x68, 0x61,
+	0x72, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x49, 0x64, 0x12, 0x44, 0x0a, 0x1f, 0x70, 0x72, 0x65, 0x76, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72,
+	0x5f, 0x72, 0x65, 0x76, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65,
+	0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x1b, 0x70, 0x72, 0x65,
+	0x76, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x76, 0x53, 0x68, 0x61, 0x72, 0x65, 0x50, 0x65,
+	0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x42, 0x0a, 0x1e, 0x6e, 0x65, 0x77, 0x5f,
+	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x76, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x5f,
+	0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x1a, 0x6e, 0x65, 0x77, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x76, 0x53, 0x68, 0x61,
+	0x72, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x34, 0x0a, 0x16,
+	0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14, 0x65, 0x66,
+	0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x42, 0x25, 0xd8, 0xe2, 0x1e, 0x01, 0x5a, 0x1f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x6f, 0x63, 0x6b, 0x65,
+	0x74, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -789,9 +2070,11 @@ func file_pocket_service_event_proto_rawDescGZIP() []byte {
 	return file_pocket_service_event_proto_rawDescData
 }
 
-var file_pocket_service_event_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_pocket_service_event_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_pocket_service_event_proto_goTypes = []interface{}{
-	(*EventRelayMiningDifficultyUpdated)(nil), // 0: pocket.service.EventRelayMiningDifficultyUpdated
+	(*EventRelayMiningDifficultyUpdated)(nil),                 // 0: pocket.service.EventRelayMiningDifficultyUpdated
+	(*EventServiceComputeUnitsToTokensMultiplierUpdated)(nil), // 1: pocket.service.EventServiceComputeUnitsToTokensMultiplierUpdated
+	(*EventServiceOwnerRevSharePercentageUpdated)(nil),        // 2: pocket.service.EventServiceOwnerRevSharePercentageUpdated
 }
 var file_pocket_service_event_proto_depIdxs = []int32{
 	0, // [0:0] is the sub-list for method output_type
@@ -819,6 +2102,30 @@ func file_pocket_service_event_proto_init() {
 				return nil
 			}
 		}
+		file_pocket_service_event_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventServiceComputeUnitsToTokensMultiplierUpdated); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pocket_service_event_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventServiceOwnerRevSharePercentageUpdated); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -826,7 +2133,7 @@ func file_pocket_service_event_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_pocket_service_event_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   1,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -16,9 +16,10 @@ import (
 )
 
 var (
-	md_Params                   protoreflect.MessageDescriptor
-	fd_Params_add_service_fee   protoreflect.FieldDescriptor
-	fd_Params_target_num_relays protoreflect.FieldDescriptor
+	md_Params                                        protoreflect.MessageDescriptor
+	fd_Params_add_service_fee                        protoreflect.FieldDescriptor
+	fd_Params_target_num_relays                      protoreflect.FieldDescriptor
+	fd_Params_max_compute_units_to_tokens_multiplier protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -26,6 +27,7 @@ func init() {
 	md_Params = File_pocket_service_params_proto.Messages().ByName("Params")
 	fd_Params_add_service_fee = md_Params.Fields().ByName("add_service_fee")
 	fd_Params_target_num_relays = md_Params.Fields().ByName("target_num_relays")
+	fd_Params_max_compute_units_to_tokens_multiplier = md_Params.Fields().ByName("max_compute_units_to_tokens_multiplier")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -105,6 +107,12 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.MaxComputeUnitsToTokensMultiplier != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxComputeUnitsToTokensMultiplier)
+		if !f(fd_Params_max_compute_units_to_tokens_multiplier, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -124,6 +132,8 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.AddServiceFee != nil
 	case "pocket.service.Params.target_num_relays":
 		return x.TargetNumRelays != uint64(0)
+	case "pocket.service.Params.max_compute_units_to_tokens_multiplier":
+		return x.MaxComputeUnitsToTokensMultiplier != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.service.Params"))
@@ -144,6 +154,8 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.AddServiceFee = nil
 	case "pocket.service.Params.target_num_relays":
 		x.TargetNumRelays = uint64(0)
+	case "pocket.service.Params.max_compute_units_to_tokens_multiplier":
+		x.MaxComputeUnitsToTokensMultiplier = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.service.Params"))
@@ -166,6 +178,9 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "pocket.service.Params.target_num_relays":
 		value := x.TargetNumRelays
 		return protoreflect.ValueOfUint64(value)
+	case "pocket.service.Params.max_compute_units_to_tokens_multiplier":
+		value := x.MaxComputeUnitsToTokensMultiplier
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.service.Params"))
@@ -190,6 +205,8 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		x.AddServiceFee = value.Message().Interface().(*v1beta1.Coin)
 	case "pocket.service.Params.target_num_relays":
 		x.TargetNumRelays = value.Uint()
+	case "pocket.service.Params.max_compute_units_to_tokens_multiplier":
+		x.MaxComputeUnitsToTokensMultiplier = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.service.Params"))
@@ -217,6 +234,8 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 		return protoreflect.ValueOfMessage(x.AddServiceFee.ProtoReflect())
 	case "pocket.service.Params.target_num_relays":
 		panic(fmt.Errorf("field target_num_relays of message pocket.service.Params is not mutable"))
+	case "pocket.service.Params.max_compute_units_to_tokens_multiplier":
+		panic(fmt.Errorf("field max_compute_units_to_tokens_multiplier of message pocket.service.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.service.Params"))
@@ -235,6 +254,8 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	case "pocket.service.Params.target_num_relays":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "pocket.service.Params.max_compute_units_to_tokens_multiplier":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.service.Params"))
@@ -311,6 +332,9 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		if x.TargetNumRelays != 0 {
 			n += 1 + runtime.Sov(uint64(x.TargetNumRelays))
 		}
+		if x.MaxComputeUnitsToTokensMultiplier != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxComputeUnitsToTokensMultiplier))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -340,6 +364,11 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.MaxComputeUnitsToTokensMultiplier != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxComputeUnitsToTokensMultiplier))
+			i--
+			dAtA[i] = 0x18
+		}
 		if x.TargetNumRelays != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.TargetNumRelays))
 			i--
@@ -463,6 +492,25 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxComputeUnitsToTokensMultiplier", wireType)
+				}
+				x.MaxComputeUnitsToTokensMultiplier = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxComputeUnitsToTokensMultiplier |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -524,6 +572,10 @@ type Params struct {
 	// target_num_relays is the target for the EMA of the number of relays per session.
 	// Per service, onchain relay mining difficulty will be adjusted to maintain this target.
 	TargetNumRelays uint64 `protobuf:"varint,2,opt,name=target_num_relays,json=targetNumRelays,proto3" json:"target_num_relays,omitempty"`
+	// max_compute_units_to_tokens_multiplier is the maximum compute units to tokens
+	// multiplier a service owner can set for their service. It does not bound the
+	// multipliers set through governance.
+	MaxComputeUnitsToTokensMultiplier uint64 `protobuf:"varint,3,opt,name=max_compute_units_to_tokens_multiplier,json=maxComputeUnitsToTokensMultiplier,proto3" json:"max_compute_units_to_tokens_multiplier,omitempty"`
 }
 
 func (x *Params) Reset() {
@@ -560,6 +612,13 @@ func (x *Params) GetTargetNumRelays() uint64 {
 	return 0
 }
 
+func (x *Params) GetMaxComputeUnitsToTokensMultiplier() uint64 {
+	if x != nil {
+		return x.MaxComputeUnitsToTokensMultiplier
+	}
+	return 0
+}
+
 var File_pocket_service_params_proto protoreflect.FileDescriptor
 
 var file_pocket_service_params_proto_rawDesc = []byte{
@@ -570,7 +629,7 @@ var file_pocket_service_params_proto_rawDesc = []byte{
 	0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62,
 	0x61, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xfb, 0x01, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xac, 0x03, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d,
 	0x73, 0x12, 0x70, 0x0a, 0x0f, 0x61, 0x64, 0x64, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
 	0x5f, 0x66, 0x65, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73,
 	0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
@@ -584,19 +643,23 @@ var file_pocket_service_params_proto_rawDesc = []byte{
 	0x65, 0x6c, 0x61, 0x79, 0x73, 0xf2, 0xde, 0x1f, 0x18, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x74,
 	0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x5f, 0x72, 0x65, 0x6c, 0x61, 0x79, 0x73,
 	0x22, 0x52, 0x0f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x4e, 0x75, 0x6d, 0x52, 0x65, 0x6c, 0x61,
-	0x79, 0x73, 0x3a, 0x20, 0xe8, 0xa0, 0x1f, 0x01, 0x8a, 0xe7, 0xb0, 0x2a, 0x17, 0x70, 0x6f, 0x63,
-	0x6b, 0x65, 0x74, 0x2f, 0x78, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x50, 0x61,
-	0x72, 0x61, 0x6d, 0x73, 0x42, 0x9f, 0x01, 0xd8, 0xe2, 0x1e, 0x01, 0x0a, 0x12, 0x63, 0x6f, 0x6d,
-	0x2e, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x42,
-	0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x1f,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0xa2,
-	0x02, 0x03, 0x50, 0x53, 0x58, 0xaa, 0x02, 0x0e, 0x50, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0xca, 0x02, 0x0e, 0x50, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x5c,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0xe2, 0x02, 0x1a, 0x50, 0x6f, 0x63, 0x6b, 0x65, 0x74,
-	0x5c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0f, 0x50, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x3a, 0x3a, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x79, 0x73, 0x12, 0xae, 0x01, 0x0a, 0x26, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x75,
+	0x74, 0x65, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x73, 0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x42, 0x5b, 0xea, 0xde, 0x1f, 0x26, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6f, 0x6d,
+	0x70, 0x75, 0x74, 0x65, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x73, 0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0xf2,
+	0xde, 0x1f, 0x2d, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6f, 0x6d,
+	0x70, 0x75, 0x74, 0x65, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x73, 0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x22,
+	0x52, 0x21, 0x6d, 0x61, 0x78, 0x43, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x55, 0x6e, 0x69, 0x74,
+	0x73, 0x54, 0x6f, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c,
+	0x69, 0x65, 0x72, 0x3a, 0x20, 0xe8, 0xa0, 0x1f, 0x01, 0x8a, 0xe7, 0xb0, 0x2a, 0x17, 0x70, 0x6f,
+	0x63, 0x6b, 0x65, 0x74, 0x2f, 0x78, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x25, 0xd8, 0xe2, 0x1e, 0x01, 0x5a, 0x1f, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x6f,
+	0x63, 0x6b, 0x65, 0x74, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
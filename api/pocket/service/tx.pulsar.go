@@ -2908,6 +2908,2907 @@ func (x *fastReflection_MsgAddServiceResponse) ProtoMethods() *protoiface.Method
 	}
 }
 
+var (
+	md_MsgSetServiceComputeUnitsToTokensMultiplier                                    protoreflect.MessageDescriptor
+	fd_MsgSetServiceComputeUnitsToTokensMultiplier_owner_address                      protoreflect.FieldDescriptor
+	fd_MsgSetServiceComputeUnitsToTokensMultiplier_service_id                         protoreflect.FieldDescriptor
+	fd_MsgSetServiceComputeUnitsToTokensMultiplier_compute_units_to_tokens_multiplier protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_pocket_service_tx_proto_init()
+	md_MsgSetServiceComputeUnitsToTokensMultiplier = File_pocket_service_tx_proto.Messages().ByName("MsgSetServiceComputeUnitsToTokensMultiplier")
+	fd_MsgSetServiceComputeUnitsToTokensMultiplier_owner_address = md_MsgSetServiceComputeUnitsToTokensMultiplier.Fields().ByName("owner_address")
+	fd_MsgSetServiceComputeUnitsToTokensMultiplier_service_id = md_MsgSetServiceComputeUnitsToTokensMultiplier.Fields().ByName("service_id")
+	fd_MsgSetServiceComputeUnitsToTokensMultiplier_compute_units_to_tokens_multiplier = md_MsgSetServiceComputeUnitsToTokensMultiplier.Fields().ByName("compute_units_to_tokens_multiplier")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgSetServiceComputeUnitsToTokensMultiplier)(nil)
+
+type fastReflection_MsgSetServiceComputeUnitsToTokensMultiplier MsgSetServiceComputeUnitsToTokensMultiplier
+
+func (x *MsgSetServiceComputeUnitsToTokensMultiplier) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgSetServiceComputeUnitsToTokensMultiplier)(x)
+}
+
+func (x *MsgSetServiceComputeUnitsToTokensMultiplier) slowProtoReflect() protoreflect.Message {
+	mi := &file_pocket_service_tx_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgSetServiceComputeUnitsToTokensMultiplier_messageType fastReflection_MsgSetServiceComputeUnitsToTokensMultiplier_messageType
+var _ protoreflect.MessageType = fastReflection_MsgSetServiceComputeUnitsToTokensMultiplier_messageType{}
+
+type fastReflection_MsgSetServiceComputeUnitsToTokensMultiplier_messageType struct{}
+
+func (x fastReflection_MsgSetServiceComputeUnitsToTokensMultiplier_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgSetServiceComputeUnitsToTokensMultiplier)(nil)
+}
+func (x fastReflection_MsgSetServiceComputeUnitsToTokensMultiplier_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgSetServiceComputeUnitsToTokensMultiplier)
+}
+func (x fastReflection_MsgSetServiceComputeUnitsToTokensMultiplier_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetServiceComputeUnitsToTokensMultiplier
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgSetServiceComputeUnitsToTokensMultiplier) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetServiceComputeUnitsToTokensMultiplier
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgSetServiceComputeUnitsToTokensMultiplier) Type() protoreflect.MessageType {
+	return _fastReflection_MsgSetServiceComputeUnitsToTokensMultiplier_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgSetServiceComputeUnitsToTokensMultiplier) New() protoreflect.Message {
+	return new(fastReflection_MsgSetServiceComputeUnitsToTokensMultiplier)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgSetServiceComputeUnitsToTokensMultiplier) Interface() protoreflect.ProtoMessage {
+	return (*MsgSetServiceComputeUnitsToTokensMultiplier)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgSetServiceComputeUnitsToTokensMultiplier) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.OwnerAddress != "" {
+		value := protoreflect.ValueOfString(x.OwnerAddress)
+		if !f(fd_MsgSetServiceComputeUnitsToTokensMultiplier_owner_address, value) {
+			return
+		}
+	}
+	if x.ServiceId != "" {
+		value := protoreflect.ValueOfString(x.ServiceId)
+		if !f(fd_MsgSetServiceComputeUnitsToTokensMultiplier_service_id, value) {
+			return
+		}
+	}
+	if x.ComputeUnitsToTokensMultiplier != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ComputeUnitsToTokensMultiplier)
+		if !f(fd_MsgSetServiceComputeUnitsToTokensMultiplier_compute_units_to_tokens_multiplier, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgSetServiceComputeUnitsToTokensMultiplier) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "pocket.service.MsgSetServiceComputeUnitsToTokensMultiplier.owner_address":
+		return x.OwnerAddress != ""
+	case "pocket.service.MsgSetServiceComputeUnitsToTokensMultiplier.service_id":
+		return x.ServiceId != ""
+	case "pocket.service.MsgSetServiceComputeUnitsToTokensMultiplier.compute_units_to_tokens_multiplier":
+		return x.ComputeUnitsToTokensMultiplier != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.service.MsgSetServiceComputeUnitsToTokensMultiplier"))
+		}
+		panic(fmt.Errorf("message pocket.service.MsgSetServiceComputeUnitsToTokensMultiplier does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetServiceComputeUnitsToTokensMultiplier) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "pocket.service.MsgSetServiceComputeUnitsToTokensMultiplier.owner_address":
+		x.OwnerAddress = ""
+	case "pocket.service.MsgSetServiceComputeUnitsToTokensMultiplier.service_id":
+		x.ServiceId = ""
+	case "pocket.service.MsgSetServiceComputeUnitsToTokensMultiplier.compute_units_to_tokens_multiplier":
+		x.ComputeUnitsToTokensMultiplier = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.service.MsgSetServiceComputeUnitsToTokensMultiplier"))
+		}
+		panic(fmt.Errorf("message pocket.service.MsgSetServiceComputeUnitsToTokensMultiplier does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgSetServiceComputeUnitsToTokensMultiplier) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "pocket.service.MsgSetServiceComputeUnitsToTokensMultiplier.owner_address":
+		value := x.OwnerAddress
+		return protoreflect.ValueOfString(value)
+	case "pocket.service.MsgSetServiceComputeUnitsToTokensMultiplier.service_id":
+		value := x.ServiceId
+		return protoreflect.ValueOfString(value)
+	case "pocket.service.MsgSetServiceComputeUnitsToTokensMultiplier.compute_units_to_tokens_multiplier":
+		value := x.ComputeUnitsToTokensMultiplier
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.service.MsgSetServiceComputeUnitsToTokensMultiplier"))
+		}
+		panic(fmt.Errorf("message pocket.service.MsgSetServiceComputeUnitsToTokensMultiplier does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetServiceComputeUnitsToTokensMultiplier) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "pocket.service.MsgSetServiceComputeUnitsToTokensMultiplier.owner_address":
+		x.OwnerAddress = value.Interface().(string)
+	case "pocket.service.MsgSetServiceComputeUnitsToTokensMultiplier.service_id":
+		x.ServiceId = value.Interface().(string)
+	case "pocket.service.MsgSetServiceComputeUnitsToTokensMultiplier.compute_units_to_tokens_multiplier":
+		x.ComputeUnitsToTokensMultiplier = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.service.MsgSetServiceComputeUnitsToTokensMultiplier"))
+		}
+		panic(fmt.Errorf("message pocket.service.MsgSetServiceComputeUnitsToTokensMultiplier does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetServiceComputeUnitsToTokensMultiplier) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "pocket.service.MsgSetServiceComputeUnitsToTokensMultiplier.owner_address":
+		panic(fmt.Errorf("field owner_address of message pocket.service.MsgSetServiceComputeUnitsToTokensMultiplier is not mutable"))
+	case "pocket.service.MsgSetServiceComputeUnitsToTokensMultiplier.service_id":
+		panic(fmt.Errorf("field service_id of message pocket.service.MsgSetServiceComputeUnitsToTokensMultiplier is not mutable"))
+	case "pocket.service.MsgSetServiceComputeUnitsToTokensMultiplier.compute_units_to_tokens_multiplier":
+		panic(fmt.Errorf("field compute_units_to_tokens_multiplier of message pocket.service.MsgSetServiceComputeUnitsToTokensMultiplier is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.service.MsgSetServiceComputeUnitsToTokensMultiplier"))
+		}
+		panic(fmt.Errorf("message pocket.service.MsgSetServiceComputeUnitsToTokensMultiplier does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgSetServiceComputeUnitsToTokensMultiplier) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "pocket.service.MsgSetServiceComputeUnitsToTokensMultiplier.owner_address":
+		return protoreflect.ValueOfString("")
+	case "pocket.service.MsgSetServiceComputeUnitsToTokensMultiplier.service_id":
+		return protoreflect.ValueOfString("")
+	case "pocket.service.MsgSetServiceComputeUnitsToTokensMultiplier.compute_units_to_tokens_multiplier":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.service.MsgSetServiceComputeUnitsToTokensMultiplier"))
+		}
+		panic(fmt.Errorf("message pocket.service.MsgSetServiceComputeUnitsToTokensMultiplier does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgSetServiceComputeUnitsToTokensMultiplier) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in pocket.service.MsgSetServiceComputeUnitsToTokensMultiplier", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgSetServiceComputeUnitsToTokensMultiplier) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetServiceComputeUnitsToTokensMultiplier) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgSetServiceComputeUnitsToTokensMultiplier) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgSetServiceComputeUnitsToTokensMultiplier) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgSetServiceComputeUnitsToTokensMultiplier)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.OwnerAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ServiceId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ComputeUnitsToTokensMultiplier != 0 {
+			n += 1 + runtime.Sov(uint64(x.ComputeUnitsToTokensMultiplier))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSetServiceComputeUnitsToTokensMultiplier)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.ComputeUnitsToTokensMultiplier != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ComputeUnitsToTokensMultiplier))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.ServiceId) > 0 {
+			i -= len(x.ServiceId)
+			copy(dAtA[i:], x.ServiceId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ServiceId)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.OwnerAddress) > 0 {
+			i -= len(x.OwnerAddress)
+			copy(dAtA[i:], x.OwnerAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OwnerAddress)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSetServiceComputeUnitsToTokensMultiplier)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetServiceComputeUnitsToTokensMultiplier: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetServiceComputeUnitsToTokensMultiplier: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.OwnerAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ServiceId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ServiceId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ComputeUnitsToTokensMultiplier", wireType)
+				}
+				x.ComputeUnitsToTokensMultiplier = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ComputeUnitsToTokensMultiplier |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgSetServiceComputeUnitsToTokensMultiplierResponse         protoreflect.MessageDescriptor
+	fd_MsgSetServiceComputeUnitsToTokensMultiplierResponse_service protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_pocket_service_tx_proto_init()
+	md_MsgSetServiceComputeUnitsToTokensMultiplierResponse = File_pocket_service_tx_proto.Messages().ByName("MsgSetServiceComputeUnitsToTokensMultiplierResponse")
+	fd_MsgSetServiceComputeUnitsToTokensMultiplierResponse_service = md_MsgSetServiceComputeUnitsToTokensMultiplierResponse.Fields().ByName("service")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgSetServiceComputeUnitsToTokensMultiplierResponse)(nil)
+
+type fastReflection_MsgSetServiceComputeUnitsToTokensMultiplierResponse MsgSetServiceComputeUnitsToTokensMultiplierResponse
+
+func (x *MsgSetServiceComputeUnitsToTokensMultiplierResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgSetServiceComputeUnitsToTokensMultiplierResponse)(x)
+}
+
+func (x *MsgSetServiceComputeUnitsToTokensMultiplierResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_pocket_service_tx_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgSetServiceComputeUnitsToTokensMultiplierResponse_messageType fastReflection_MsgSetServiceComputeUnitsToTokensMultiplierResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgSetServiceComputeUnitsToTokensMultiplierResponse_messageType{}
+
+type fastReflection_MsgSetServiceComputeUnitsToTokensMultiplierResponse_messageType struct{}
+
+func (x fastReflection_MsgSetServiceComputeUnitsToTokensMultiplierResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgSetServiceComputeUnitsToTokensMultiplierResponse)(nil)
+}
+func (x fastReflection_MsgSetServiceComputeUnitsToTokensMultiplierResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgSetServiceComputeUnitsToTokensMultiplierResponse)
+}
+func (x fastReflection_MsgSetServiceComputeUnitsToTokensMultiplierResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetServiceComputeUnitsToTokensMultiplierResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgSetServiceComputeUnitsToTokensMultiplierResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetServiceComputeUnitsToTokensMultiplierResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgSetServiceComputeUnitsToTokensMultiplierResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgSetServiceComputeUnitsToTokensMultiplierResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgSetServiceComputeUnitsToTokensMultiplierResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgSetServiceComputeUnitsToTokensMultiplierResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgSetServiceComputeUnitsToTokensMultiplierResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgSetServiceComputeUnitsToTokensMultiplierResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgSetServiceComputeUnitsToTokensMultiplierResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Service != nil {
+		value := protoreflect.ValueOfMessage(x.Service.ProtoReflect())
+		if !f(fd_MsgSetServiceComputeUnitsToTokensMultiplierResponse_service, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgSetServiceComputeUnitsToTokensMultiplierResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "pocket.service.MsgSetServiceComputeUnitsToTokensMultiplierResponse.service":
+		return x.Service != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.service.MsgSetServiceComputeUnitsToTokensMultiplierResponse"))
+		}
+		panic(fmt.Errorf("message pocket.service.MsgSetServiceComputeUnitsToTokensMultiplierResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetServiceComputeUnitsToTokensMultiplierResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "pocket.service.MsgSetServiceComputeUnitsToTokensMultiplierResponse.service":
+		x.Service = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.service.MsgSetServiceComputeUnitsToTokensMultiplierResponse"))
+		}
+		panic(fmt.Errorf("message pocket.service.MsgSetServiceComputeUnitsToTokensMultiplierResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgSetServiceComputeUnitsToTokensMultiplierResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "pocket.service.MsgSetServiceComputeUnitsToTokensMultiplierResponse.service":
+		value := x.Service
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.service.MsgSetServiceComputeUnitsToTokensMultiplierResponse"))
+		}
+		panic(fmt.Errorf("message pocket.service.MsgSetServiceComputeUnitsToTokensMultiplierResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetServiceComputeUnitsToTokensMultiplierResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "pocket.service.MsgSetServiceComputeUnitsToTokensMultiplierResponse.service":
+		x.Service = value.Message().Interface().(*shared.Service)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.service.MsgSetServiceComputeUnitsToTokensMultiplierResponse"))
+		}
+		panic(fmt.Errorf("message pocket.service.MsgSetServiceComputeUnitsToTokensMultiplierResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetServiceComputeUnitsToTokensMultiplierResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "pocket.service.MsgSetServiceComputeUnitsToTokensMultiplierResponse.service":
+		if x.Service == nil {
+			x.Service = new(shared.Service)
+		}
+		return protoreflect.ValueOfMessage(x.Service.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.service.MsgSetServiceComputeUnitsToTokensMultiplierResponse"))
+		}
+		panic(fmt.Errorf("message pocket.service.MsgSetServiceComputeUnitsToTokensMultiplierResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgSetServiceComputeUnitsToTokensMultiplierResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "pocket.service.MsgSetServiceComputeUnitsToTokensMultiplierResponse.service":
+		m := new(shared.Service)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.service.MsgSetServiceComputeUnitsToTokensMultiplierResponse"))
+		}
+		panic(fmt.Errorf("message pocket.service.MsgSetServiceComputeUnitsToTokensMultiplierResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgSetServiceComputeUnitsToTokensMultiplierResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in pocket.service.MsgSetServiceComputeUnitsToTokensMultiplierResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgSetServiceComputeUnitsToTokensMultiplierResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetServiceComputeUnitsToTokensMultiplierResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgSetServiceComputeUnitsToTokensMultiplierResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgSetServiceComputeUnitsToTokensMultiplierResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgSetServiceComputeUnitsToTokensMultiplierResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Service != nil {
+			l = options.Size(x.Service)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSetServiceComputeUnitsToTokensMultiplierResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Service != nil {
+			encoded, err := options.Marshal(x.Service)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSetServiceComputeUnitsToTokensMultiplierResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetServiceComputeUnitsToTokensMultiplierResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetServiceComputeUnitsToTokensMultiplierResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Service == nil {
+					x.Service = &shared.Service{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Service); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgUpdateServiceComputeUnitsToTokensMultiplier                                    protoreflect.MessageDescriptor
+	fd_MsgUpdateServiceComputeUnitsToTokensMultiplier_authority                          protoreflect.FieldDescriptor
+	fd_MsgUpdateServiceComputeUnitsToTokensMultiplier_service_id                         protoreflect.FieldDescriptor
+	fd_MsgUpdateServiceComputeUnitsToTokensMultiplier_compute_units_to_tokens_multiplier protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_pocket_service_tx_proto_init()
+	md_MsgUpdateServiceComputeUnitsToTokensMultiplier = File_pocket_service_tx_proto.Messages().ByName("MsgUpdateServiceComputeUnitsToTokensMultiplier")
+	fd_MsgUpdateServiceComputeUnitsToTokensMultiplier_authority = md_MsgUpdateServiceComputeUnitsToTokensMultiplier.Fields().ByName("authority")
+	fd_MsgUpdateServiceComputeUnitsToTokensMultiplier_service_id = md_MsgUpdateServiceComputeUnitsToTokensMultiplier.Fields().ByName("service_id")
+	fd_MsgUpdateServiceComputeUnitsToTokensMultiplier_compute_units_to_tokens_multiplier = md_MsgUpdateServiceComputeUnitsToTokensMultiplier.Fields().ByName("compute_units_to_tokens_multiplier")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgUpdateServiceComputeUnitsToTokensMultiplier)(nil)
+
+type fastReflection_MsgUpdateServiceComputeUnitsToTokensMultiplier MsgUpdateServiceComputeUnitsToTokensMultiplier
+
+func (x *MsgUpdateServiceComputeUnitsToTokensMultiplier) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateServiceComputeUnitsToTokensMultiplier)(x)
+}
+
+func (x *MsgUpdateServiceComputeUnitsToTokensMultiplier) slowProtoReflect() protoreflect.Message {
+	mi := &file_pocket_service_tx_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgUpdateServiceComputeUnitsToTokensMultiplier_messageType fastReflection_MsgUpdateServiceComputeUnitsToTokensMultiplier_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateServiceComputeUnitsToTokensMultiplier_messageType{}
+
+type fastReflection_MsgUpdateServiceComputeUnitsToTokensMultiplier_messageType struct{}
+
+func (x fastReflection_MsgUpdateServiceComputeUnitsToTokensMultiplier_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateServiceComputeUnitsToTokensMultiplier)(nil)
+}
+func (x fastReflection_MsgUpdateServiceComputeUnitsToTokensMultiplier_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateServiceComputeUnitsToTokensMultiplier)
+}
+func (x fastReflection_MsgUpdateServiceComputeUnitsToTokensMultiplier_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateServiceComputeUnitsToTokensMultiplier
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgUpdateServiceComputeUnitsToTokensMultiplier) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateServiceComputeUnitsToTokensMultiplier
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgUpdateServiceComputeUnitsToTokensMultiplier) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateServiceComputeUnitsToTokensMultiplier_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgUpdateServiceComputeUnitsToTokensMultiplier) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateServiceComputeUnitsToTokensMultiplier)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgUpdateServiceComputeUnitsToTokensMultiplier) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateServiceComputeUnitsToTokensMultiplier)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgUpdateServiceComputeUnitsToTokensMultiplier) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Authority != "" {
+		value := protoreflect.ValueOfString(x.Authority)
+		if !f(fd_MsgUpdateServiceComputeUnitsToTokensMultiplier_authority, value) {
+			return
+		}
+	}
+	if x.ServiceId != "" {
+		value := protoreflect.ValueOfString(x.ServiceId)
+		if !f(fd_MsgUpdateServiceComputeUnitsToTokensMultiplier_service_id, value) {
+			return
+		}
+	}
+	if x.ComputeUnitsToTokensMultiplier != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ComputeUnitsToTokensMultiplier)
+		if !f(fd_MsgUpdateServiceComputeUnitsToTokensMultiplier_compute_units_to_tokens_multiplier, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgUpdateServiceComputeUnitsToTokensMultiplier) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "pocket.service.MsgUpdateServiceComputeUnitsToTokensMultiplier.authority":
+		return x.Authority != ""
+	case "pocket.service.MsgUpdateServiceComputeUnitsToTokensMultiplier.service_id":
+		return x.ServiceId != ""
+	case "pocket.service.MsgUpdateServiceComputeUnitsToTokensMultiplier.compute_units_to_tokens_multiplier":
+		return x.ComputeUnitsToTokensMultiplier != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.service.MsgUpdateServiceComputeUnitsToTokensMultiplier"))
+		}
+		panic(fmt.Errorf("message pocket.service.MsgUpdateServiceComputeUnitsToTokensMultiplier does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateServiceComputeUnitsToTokensMultiplier) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "pocket.service.MsgUpdateServiceComputeUnitsToTokensMultiplier.authority":
+		x.Authority = ""
+	case "pocket.service.MsgUpdateServiceComputeUnitsToTokensMultiplier.service_id":
+		x.ServiceId = ""
+	case "pocket.service.MsgUpdateServiceComputeUnitsToTokensMultiplier.compute_units_to_tokens_multiplier":
+		x.ComputeUnitsToTokensMultiplier = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.service.MsgUpdateServiceComputeUnitsToTokensMultiplier"))
+		}
+		panic(fmt.Errorf("message pocket.service.MsgUpdateServiceComputeUnitsToTokensMultiplier does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgUpdateServiceComputeUnitsToTokensMultiplier) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "pocket.service.MsgUpdateServiceComputeUnitsToTokensMultiplier.authority":
+		value := x.Authority
+		return protoreflect.ValueOfString(value)
+	case "pocket.service.MsgUpdateServiceComputeUnitsToTokensMultiplier.service_id":
+		value := x.ServiceId
+		return protoreflect.ValueOfString(value)
+	case "pocket.service.MsgUpdateServiceComputeUnitsToTokensMultiplier.compute_units_to_tokens_multiplier":
+		value := x.ComputeUnitsToTokensMultiplier
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.service.MsgUpdateServiceComputeUnitsToTokensMultiplier"))
+		}
+		panic(fmt.Errorf("message pocket.service.MsgUpdateServiceComputeUnitsToTokensMultiplier does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateServiceComputeUnitsToTokensMultiplier) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "pocket.service.MsgUpdateServiceComputeUnitsToTokensMultiplier.authority":
+		x.Authority = value.Interface().(string)
+	case "pocket.service.MsgUpdateServiceComputeUnitsToTokensMultiplier.service_id":
+		x.ServiceId = value.Interface().(string)
+	case "pocket.service.MsgUpdateServiceComputeUnitsToTokensMultiplier.compute_units_to_tokens_multiplier":
+		x.ComputeUnitsToTokensMultiplier = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.service.MsgUpdateServiceComputeUnitsToTokensMultiplier"))
+		}
+		panic(fmt.Errorf("message pocket.service.MsgUpdateServiceComputeUnitsToTokensMultiplier does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateServiceComputeUnitsToTokensMultiplier) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "pocket.service.MsgUpdateServiceComputeUnitsToTokensMultiplier.authority":
+		panic(fmt.Errorf("field authority of message pocket.service.MsgUpdateServiceComputeUnitsToTokensMultiplier is not mutable"))
+	case "pocket.service.MsgUpdateServiceComputeUnitsToTokensMultiplier.service_id":
+		panic(fmt.Errorf("field service_id of message pocket.service.MsgUpdateServiceComputeUnitsToTokensMultiplier is not mutable"))
+	case "pocket.service.MsgUpdateServiceComputeUnitsToTokensMultiplier.compute_units_to_tokens_multiplier":
+		panic(fmt.Errorf("field compute_units_to_tokens_multiplier of message pocket.service.MsgUpdateServiceComputeUnitsToTokensMultiplier is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.service.MsgUpdateServiceComputeUnitsToTokensMultiplier"))
+		}
+		panic(fmt.Errorf("message pocket.service.MsgUpdateServiceComputeUnitsToTokensMultiplier does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgUpdateServiceComputeUnitsToTokensMultiplier) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "pocket.service.MsgUpdateServiceComputeUnitsToTokensMultiplier.authority":
+		return protoreflect.ValueOfString("")
+	case "pocket.service.MsgUpdateServiceComputeUnitsToTokensMultiplier.service_id":
+		return protoreflect.ValueOfString("")
+	case "pocket.service.MsgUpdateServiceComputeUnitsToTokensMultiplier.compute_units_to_tokens_multiplier":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.service.MsgUpdateServiceComputeUnitsToTokensMultiplier"))
+		}
+		panic(fmt.Errorf("message pocket.service.MsgUpdateServiceComputeUnitsToTokensMultiplier does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgUpdateServiceComputeUnitsToTokensMultiplier) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in pocket.service.MsgUpdateServiceComputeUnitsToTokensMultiplier", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgUpdateServiceComputeUnitsToTokensMultiplier) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateServiceComputeUnitsToTokensMultiplier) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgUpdateServiceComputeUnitsToTokensMultiplier) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgUpdateServiceComputeUnitsToTokensMultiplier) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgUpdateServiceComputeUnitsToTokensMultiplier)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Authority)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ServiceId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ComputeUnitsToTokensMultiplier != 0 {
+			n += 1 + runtime.Sov(uint64(x.ComputeUnitsToTokensMultiplier))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateServiceComputeUnitsToTokensMultiplier)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.ComputeUnitsToTokensMultiplier != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ComputeUnitsToTokensMultiplier))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.ServiceId) > 0 {
+			i -= len(x.ServiceId)
+			copy(dAtA[i:], x.ServiceId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ServiceId)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Authority) > 0 {
+			i -= len(x.Authority)
+			copy(dAtA[i:], x.Authority)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Authority)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateServiceComputeUnitsToTokensMultiplier)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateServiceComputeUnitsToTokensMultiplier: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateServiceComputeUnitsToTokensMultiplier: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Authority = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ServiceId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ServiceId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ComputeUnitsToTokensMultiplier", wireType)
+				}
+				x.ComputeUnitsToTokensMultiplier = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ComputeUnitsToTokensMultiplier |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgUpdateServiceComputeUnitsToTokensMultiplierResponse         protoreflect.MessageDescriptor
+	fd_MsgUpdateServiceComputeUnitsToTokensMultiplierResponse_service protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_pocket_service_tx_proto_init()
+	md_MsgUpdateServiceComputeUnitsToTokensMultiplierResponse = File_pocket_service_tx_proto.Messages().ByName("MsgUpdateServiceComputeUnitsToTokensMultiplierResponse")
+	fd_MsgUpdateServiceComputeUnitsToTokensMultiplierResponse_service = md_MsgUpdateServiceComputeUnitsToTokensMultiplierResponse.Fields().ByName("service")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgUpdateServiceComputeUnitsToTokensMultiplierResponse)(nil)
+
+type fastReflection_MsgUpdateServiceComputeUnitsToTokensMultiplierResponse MsgUpdateServiceComputeUnitsToTokensMultiplierResponse
+
+func (x *MsgUpdateServiceComputeUnitsToTokensMultiplierResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateServiceComputeUnitsToTokensMultiplierResponse)(x)
+}
+
+func (x *MsgUpdateServiceComputeUnitsToTokensMultiplierResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_pocket_service_tx_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgUpdateServiceComputeUnitsToTokensMultiplierResponse_messageType fastReflection_MsgUpdateServiceComputeUnitsToTokensMultiplierResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateServiceComputeUnitsToTokensMultiplierResponse_messageType{}
+
+type fastReflection_MsgUpdateServiceComputeUnitsToTokensMultiplierResponse_messageType struct{}
+
+func (x fastReflection_MsgUpdateServiceComputeUnitsToTokensMultiplierResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateServiceComputeUnitsToTokensMultiplierResponse)(nil)
+}
+func (x fastReflection_MsgUpdateServiceComputeUnitsToTokensMultiplierResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateServiceComputeUnitsToTokensMultiplierResponse)
+}
+func (x fastReflection_MsgUpdateServiceComputeUnitsToTokensMultiplierResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateServiceComputeUnitsToTokensMultiplierResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgUpdateServiceComputeUnitsToTokensMultiplierResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateServiceComputeUnitsToTokensMultiplierResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgUpdateServiceComputeUnitsToTokensMultiplierResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateServiceComputeUnitsToTokensMultiplierResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgUpdateServiceComputeUnitsToTokensMultiplierResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateServiceComputeUnitsToTokensMultiplierResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgUpdateServiceComputeUnitsToTokensMultiplierResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateServiceComputeUnitsToTokensMultiplierResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgUpdateServiceComputeUnitsToTokensMultiplierResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Service != nil {
+		value := protoreflect.ValueOfMessage(x.Service.ProtoReflect())
+		if !f(fd_MsgUpdateServiceComputeUnitsToTokensMultiplierResponse_service, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgUpdateServiceComputeUnitsToTokensMultiplierResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "pocket.service.MsgUpdateServiceComputeUnitsToTokensMultiplierResponse.service":
+		return x.Service != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.service.MsgUpdateServiceComputeUnitsToTokensMultiplierResponse"))
+		}
+		panic(fmt.Errorf("message pocket.service.MsgUpdateServiceComputeUnitsToTokensMultiplierResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateServiceComputeUnitsToTokensMultiplierResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "pocket.service.MsgUpdateServiceComputeUnitsToTokensMultiplierResponse.service":
+		x.Service = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.service.MsgUpdateServiceComputeUnitsToTokensMultiplierResponse"))
+		}
+		panic(fmt.Errorf("message pocket.service.MsgUpdateServiceComputeUnitsToTokensMultiplierResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgUpdateServiceComputeUnitsToTokensMultiplierResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "pocket.service.MsgUpdateServiceComputeUnitsToTokensMultiplierResponse.service":
+		value := x.Service
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.service.MsgUpdateServiceComputeUnitsToTokensMultiplierResponse"))
+		}
+		panic(fmt.Errorf("message pocket.service.MsgUpdateServiceComputeUnitsToTokensMultiplierResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateServiceComputeUnitsToTokensMultiplierResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "pocket.service.MsgUpdateServiceComputeUnitsToTokensMultiplierResponse.service":
+		x.Service = value.Message().Interface().(*shared.Service)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.service.MsgUpdateServiceComputeUnitsToTokensMultiplierResponse"))
+		}
+		panic(fmt.Errorf("message pocket.service.MsgUpdateServiceComputeUnitsToTokensMultiplierResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateServiceComputeUnitsToTokensMultiplierResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "pocket.service.MsgUpdateServiceComputeUnitsToTokensMultiplierResponse.service":
+		if x.Service == nil {
+			x.Service = new(shared.Service)
+		}
+		return protoreflect.ValueOfMessage(x.Service.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.service.MsgUpdateServiceComputeUnitsToTokensMultiplierResponse"))
+		}
+		panic(fmt.Errorf("message pocket.service.MsgUpdateServiceComputeUnitsToTokensMultiplierResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgUpdateServiceComputeUnitsToTokensMultiplierResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "pocket.service.MsgUpdateServiceComputeUnitsToTokensMultiplierResponse.service":
+		m := new(shared.Service)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.service.MsgUpdateServiceComputeUnitsToTokensMultiplierResponse"))
+		}
+		panic(fmt.Errorf("message pocket.service.MsgUpdateServiceComputeUnitsToTokensMultiplierResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgUpdateServiceComputeUnitsToTokensMultiplierResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in pocket.service.MsgUpdateServiceComputeUnitsToTokensMultiplierResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgUpdateServiceComputeUnitsToTokensMultiplierResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateServiceComputeUnitsToTokensMultiplierResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgUpdateServiceComputeUnitsToTokensMultiplierResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgUpdateServiceComputeUnitsToTokensMultiplierResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgUpdateServiceComputeUnitsToTokensMultiplierResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Service != nil {
+			l = options.Size(x.Service)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateServiceComputeUnitsToTokensMultiplierResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Service != nil {
+			encoded, err := options.Marshal(x.Service)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateServiceComputeUnitsToTokensMultiplierResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateServiceComputeUnitsToTokensMultiplierResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateServiceComputeUnitsToTokensMultiplierResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Service == nil {
+					x.Service = &shared.Service{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Service); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgSetServiceOwnerRevSharePercentage                            protoreflect.MessageDescriptor
+	fd_MsgSetServiceOwnerRevSharePercentage_owner_address              protoreflect.FieldDescriptor
+	fd_MsgSetServiceOwnerRevSharePercentage_service_id                 protoreflect.FieldDescriptor
+	fd_MsgSetServiceOwnerRevSharePercentage_owner_rev_share_percentage protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_pocket_service_tx_proto_init()
+	md_MsgSetServiceOwnerRevSharePercentage = File_pocket_service_tx_proto.Messages().ByName("MsgSetServiceOwnerRevSharePercentage")
+	fd_MsgSetServiceOwnerRevSharePercentage_owner_address = md_MsgSetServiceOwnerRevSharePercentage.Fields().ByName("owner_address")
+	fd_MsgSetServiceOwnerRevSharePercentage_service_id = md_MsgSetServiceOwnerRevSharePercentage.Fields().ByName("service_id")
+	fd_MsgSetServiceOwnerRevSharePercentage_owner_rev_share_percentage = md_MsgSetServiceOwnerRevSharePercentage.Fields().ByName("owner_rev_share_percentage")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgSetServiceOwnerRevSharePercentage)(nil)
+
+type fastReflection_MsgSetServiceOwnerRevSharePercentage MsgSetServiceOwnerRevSharePercentage
+
+func (x *MsgSetServiceOwnerRevSharePercentage) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgSetServiceOwnerRevSharePercentage)(x)
+}
+
+func (x *MsgSetServiceOwnerRevSharePercentage) slowProtoReflect() protoreflect.Message {
+	mi := &file_pocket_service_tx_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgSetServiceOwnerRevSharePercentage_messageType fastReflection_MsgSetServiceOwnerRevSharePercentage_messageType
+var _ protoreflect.MessageType = fastReflection_MsgSetServiceOwnerRevSharePercentage_messageType{}
+
+type fastReflection_MsgSetServiceOwnerRevSharePercentage_messageType struct{}
+
+func (x fastReflection_MsgSetServiceOwnerRevSharePercentage_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgSetServiceOwnerRevSharePercentage)(nil)
+}
+func (x fastReflection_MsgSetServiceOwnerRevSharePercentage_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgSetServiceOwnerRevSharePercentage)
+}
+func (x fastReflection_MsgSetServiceOwnerRevSharePercentage_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetServiceOwnerRevSharePercentage
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgSetServiceOwnerRevSharePercentage) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetServiceOwnerRevSharePercentage
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgSetServiceOwnerRevSharePercentage) Type() protoreflect.MessageType {
+	return _fastReflection_MsgSetServiceOwnerRevSharePercentage_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgSetServiceOwnerRevSharePercentage) New() protoreflect.Message {
+	return new(fastReflection_MsgSetServiceOwnerRevSharePercentage)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgSetServiceOwnerRevSharePercentage) Interface() protoreflect.ProtoMessage {
+	return (*MsgSetServiceOwnerRevSharePercentage)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgSetServiceOwnerRevSharePercentage) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.OwnerAddress != "" {
+		value := protoreflect.ValueOfString(x.OwnerAddress)
+		if !f(fd_MsgSetServiceOwnerRevSharePercentage_owner_address, value) {
+			return
+		}
+	}
+	if x.ServiceId != "" {
+		value := protoreflect.ValueOfString(x.ServiceId)
+		if !f(fd_MsgSetServiceOwnerRevSharePercentage_service_id, value) {
+			return
+		}
+	}
+	if x.OwnerRevSharePercentage != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.OwnerRevSharePercentage)
+		if !f(fd_MsgSetServiceOwnerRevSharePercentage_owner_rev_share_percentage, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgSetServiceOwnerRevSharePercentage) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "pocket.service.MsgSetServiceOwnerRevSharePercentage.owner_address":
+		return x.OwnerAddress != ""
+	case "pocket.service.MsgSetServiceOwnerRevSharePercentage.service_id":
+		return x.ServiceId != ""
+	case "pocket.service.MsgSetServiceOwnerRevSharePercentage.owner_rev_share_percentage":
+		return x.OwnerRevSharePercentage != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.service.MsgSetServiceOwnerRevSharePercentage"))
+		}
+		panic(fmt.Errorf("message pocket.service.MsgSetServiceOwnerRevSharePercentage does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetServiceOwnerRevSharePercentage) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "pocket.service.MsgSetServiceOwnerRevSharePercentage.owner_address":
+		x.OwnerAddress = ""
+	case "pocket.service.MsgSetServiceOwnerRevSharePercentage.service_id":
+		x.ServiceId = ""
+	case "pocket.service.MsgSetServiceOwnerRevSharePercentage.owner_rev_share_percentage":
+		x.OwnerRevSharePercentage = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.service.MsgSetServiceOwnerRevSharePercentage"))
+		}
+		panic(fmt.Errorf("message pocket.service.MsgSetServiceOwnerRevSharePercentage does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgSetServiceOwnerRevSharePercentage) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "pocket.service.MsgSetServiceOwnerRevSharePercentage.owner_address":
+		value := x.OwnerAddress
+		return protoreflect.ValueOfString(value)
+	case "pocket.service.MsgSetServiceOwnerRevSharePercentage.service_id":
+		value := x.ServiceId
+		return protoreflect.ValueOfString(value)
+	case "pocket.service.MsgSetServiceOwnerRevSharePercentage.owner_rev_share_percentage":
+		value := x.OwnerRevSharePercentage
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.service.MsgSetServiceOwnerRevSharePercentage"))
+		}
+		panic(fmt.Errorf("message pocket.service.MsgSetServiceOwnerRevSharePercentage does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetServiceOwnerRevSharePercentage) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "pocket.service.MsgSetServiceOwnerRevSharePercentage.owner_address":
+		x.OwnerAddress = value.Interface().(string)
+	case "pocket.service.MsgSetServiceOwnerRevSharePercentage.service_id":
+		x.ServiceId = value.Interface().(string)
+	case "pocket.service.MsgSetServiceOwnerRevSharePercentage.owner_rev_share_percentage":
+		x.OwnerRevSharePercentage = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.service.MsgSetServiceOwnerRevSharePercentage"))
+		}
+		panic(fmt.Errorf("message pocket.service.MsgSetServiceOwnerRevSharePercentage does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetServiceOwnerRevSharePercentage) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "pocket.service.MsgSetServiceOwnerRevSharePercentage.owner_address":
+		panic(fmt.Errorf("field owner_address of message pocket.service.MsgSetServiceOwnerRevSharePercentage is not mutable"))
+	case "pocket.service.MsgSetServiceOwnerRevSharePercentage.service_id":
+		panic(fmt.Errorf("field service_id of message pocket.service.MsgSetServiceOwnerRevSharePercentage is not mutable"))
+	case "pocket.service.MsgSetServiceOwnerRevSharePercentage.owner_rev_share_percentage":
+		panic(fmt.Errorf("field owner_rev_share_percentage of message pocket.service.MsgSetServiceOwnerRevSharePercentage is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.service.MsgSetServiceOwnerRevSharePercentage"))
+		}
+		panic(fmt.Errorf("message pocket.service.MsgSetServiceOwnerRevSharePercentage does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgSetServiceOwnerRevSharePercentage) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "pocket.service.MsgSetServiceOwnerRevSharePercentage.owner_address":
+		return protoreflect.ValueOfString("")
+	case "pocket.service.MsgSetServiceOwnerRevSharePercentage.service_id":
+		return protoreflect.ValueOfString("")
+	case "pocket.service.MsgSetServiceOwnerRevSharePercentage.owner_rev_share_percentage":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.service.MsgSetServiceOwnerRevSharePercentage"))
+		}
+		panic(fmt.Errorf("message pocket.service.MsgSetServiceOwnerRevSharePercentage does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgSetServiceOwnerRevSharePercentage) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in pocket.service.MsgSetServiceOwnerRevSharePercentage", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgSetServiceOwnerRevSharePercentage) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetServiceOwnerRevSharePercentage) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgSetServiceOwnerRevSharePercentage) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgSetServiceOwnerRevSharePercentage) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgSetServiceOwnerRevSharePercentage)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.OwnerAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ServiceId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.OwnerRevSharePercentage != 0 {
+			n += 1 + runtime.Sov(uint64(x.OwnerRevSharePercentage))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSetServiceOwnerRevSharePercentage)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.OwnerRevSharePercentage != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OwnerRevSharePercentage))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.ServiceId) > 0 {
+			i -= len(x.ServiceId)
+			copy(dAtA[i:], x.ServiceId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ServiceId)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.OwnerAddress) > 0 {
+			i -= len(x.OwnerAddress)
+			copy(dAtA[i:], x.OwnerAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OwnerAddress)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSetServiceOwnerRevSharePercentage)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetServiceOwnerRevSharePercentage: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetServiceOwnerRevSharePercentage: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.OwnerAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ServiceId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ServiceId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OwnerRevSharePercentage", wireType)
+				}
+				x.OwnerRevSharePercentage = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OwnerRevSharePercentage |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgSetServiceOwnerRevSharePercentageResponse         protoreflect.MessageDescriptor
+	fd_MsgSetServiceOwnerRevSharePercentageResponse_service protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_pocket_service_tx_proto_init()
+	md_MsgSetServiceOwnerRevSharePercentageResponse = File_pocket_service_tx_proto.Messages().ByName("MsgSetServiceOwnerRevSharePercentageResponse")
+	fd_MsgSetServiceOwnerRevSharePercentageResponse_service = md_MsgSetServiceOwnerRevSharePercentageResponse.Fields().ByName("service")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgSetServiceOwnerRevSharePercentageResponse)(nil)
+
+type fastReflection_MsgSetServiceOwnerRevSharePercentageResponse MsgSetServiceOwnerRevSharePercentageResponse
+
+func (x *MsgSetServiceOwnerRevSharePercentageResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgSetServiceOwnerRevSharePercentageResponse)(x)
+}
+
+func (x *MsgSetServiceOwnerRevSharePercentageResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_pocket_service_tx_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgSetServiceOwnerRevSharePercentageResponse_messageType fastReflection_MsgSetServiceOwnerRevSharePercentageResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgSetServiceOwnerRevSharePercentageResponse_messageType{}
+
+type fastReflection_MsgSetServiceOwnerRevSharePercentageResponse_messageType struct{}
+
+func (x fastReflection_MsgSetServiceOwnerRevSharePercentageResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgSetServiceOwnerRevSharePercentageResponse)(nil)
+}
+func (x fastReflection_MsgSetServiceOwnerRevSharePercentageResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgSetServiceOwnerRevSharePercentageResponse)
+}
+func (x fastReflection_MsgSetServiceOwnerRevSharePercentageResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetServiceOwnerRevSharePercentageResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgSetServiceOwnerRevSharePercentageResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetServiceOwnerRevSharePercentageResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgSetServiceOwnerRevSharePercentageResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgSetServiceOwnerRevSharePercentageResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgSetServiceOwnerRevSharePercentageResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgSetServiceOwnerRevSharePercentageResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgSetServiceOwnerRevSharePercentageResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgSetServiceOwnerRevSharePercentageResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgSetServiceOwnerRevSharePercentageResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Service != nil {
+		value := protoreflect.ValueOfMessage(x.Service.ProtoReflect())
+		if !f(fd_MsgSetServiceOwnerRevSharePercentageResponse_service, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgSetServiceOwnerRevSharePercentageResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "pocket.service.MsgSetServiceOwnerRevSharePercentageResponse.service":
+		return x.Service != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.service.MsgSetServiceOwnerRevSharePercentageResponse"))
+		}
+		panic(fmt.Errorf("message pocket.service.MsgSetServiceOwnerRevSharePercentageResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetServiceOwnerRevSharePercentageResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "pocket.service.MsgSetServiceOwnerRevSharePercentageResponse.service":
+		x.Service = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.service.MsgSetServiceOwnerRevSharePercentageResponse"))
+		}
+		panic(fmt.Errorf("message pocket.service.MsgSetServiceOwnerRevSharePercentageResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgSetServiceOwnerRevSharePercentageResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "pocket.service.MsgSetServiceOwnerRevSharePercentageResponse.service":
+		value := x.Service
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.service.MsgSetServiceOwnerRevSharePercentageResponse"))
+		}
+		panic(fmt.Errorf("message pocket.service.MsgSetServiceOwnerRevSharePercentageResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetServiceOwnerRevSharePercentageResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "pocket.service.MsgSetServiceOwnerRevSharePercentageResponse.service":
+		x.Service = value.Message().Interface().(*shared.Service)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.service.MsgSetServiceOwnerRevSharePercentageResponse"))
+		}
+		panic(fmt.Errorf("message pocket.service.MsgSetServiceOwnerRevSharePercentageResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetServiceOwnerRevSharePercentageResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "pocket.service.MsgSetServiceOwnerRevSharePercentageResponse.service":
+		if x.Service == nil {
+			x.Service = new(shared.Service)
+		}
+		return protoreflect.ValueOfMessage(x.Service.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.service.MsgSetServiceOwnerRevSharePercentageResponse"))
+		}
+		panic(fmt.Errorf("message pocket.service.MsgSetServiceOwnerRevSharePercentageResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgSetServiceOwnerRevSharePercentageResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "pocket.service.MsgSetServiceOwnerRevSharePercentageResponse.service":
+		m := new(shared.Service)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.service.MsgSetServiceOwnerRevSharePercentageResponse"))
+		}
+		panic(fmt.Errorf("message pocket.service.MsgSetServiceOwnerRevSharePercentageResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgSetServiceOwnerRevSharePercentageResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in pocket.service.MsgSetServiceOwnerRevSharePercentageResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgSetServiceOwnerRevSharePercentageResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetServiceOwnerRevSharePercentageResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgSetServiceOwnerRevSharePercentageResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgSetServiceOwnerRevSharePercentageResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgSetServiceOwnerRevSharePercentageResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Service != nil {
+			l = options.Size(x.Service)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSetServiceOwnerRevSharePercentageResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Service != nil {
+			encoded, err := options.Marshal(x.Service)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSetServiceOwnerRevSharePercentageResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetServiceOwnerRevSharePercentageResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetServiceOwnerRevSharePercentageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Service == nil {
+					x.Service = &shared.Service{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Service); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
 // 	protoc-gen-go v1.27.0
@@ -3008,7 +5909,6 @@ type MsgUpdateParam struct {
 	// specified in the `Params` message in `proof/params.proto.`
 	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	// Types that are assignable to AsType:
-	//
 	//	*MsgUpdateParam_AsCoin
 	//	*MsgUpdateParam_AsUint64
 	AsType isMsgUpdateParam_AsType `protobuf_oneof:"as_type"`
@@ -3203,6 +6103,277 @@ func (x *MsgAddServiceResponse) GetService() *shared.Service {
 	return nil
 }
 
+// MsgSetServiceComputeUnitsToTokensMultiplier defines a message for the service
+// owner to update the compute units to tokens multiplier of its service.
+type MsgSetServiceComputeUnitsToTokensMultiplier struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OwnerAddress string `protobuf:"bytes,1,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"` // The Bech32 address of the service owner.
+	ServiceId    string `protobuf:"bytes,2,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`          // The ID of the service to update.
+	// The amount of uPOKT a compute unit of the service translates to.
+	// Zero resets the service to the global 'compute_units_to_tokens_multiplier' shared param.
+	ComputeUnitsToTokensMultiplier uint64 `protobuf:"varint,3,opt,name=compute_units_to_tokens_multiplier,json=computeUnitsToTokensMultiplier,proto3" json:"compute_units_to_tokens_multiplier,omitempty"`
+}
+
+func (x *MsgSetServiceComputeUnitsToTokensMultiplier) Reset() {
+	*x = MsgSetServiceComputeUnitsToTokensMultiplier{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pocket_service_tx_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgSetServiceComputeUnitsToTokensMultiplier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgSetServiceComputeUnitsToTokensMultiplier) ProtoMessage() {}
+
+// Deprecated: Use MsgSetServiceComputeUnitsToTokensMultiplier.ProtoReflect.Descriptor instead.
+func (*MsgSetServiceComputeUnitsToTokensMultiplier) Descriptor() ([]byte, []int) {
+	return file_pocket_service_tx_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *MsgSetServiceComputeUnitsToTokensMultiplier) GetOwnerAddress() string {
+	if x != nil {
+		return x.OwnerAddress
+	}
+	return ""
+}
+
+func (x *MsgSetServiceComputeUnitsToTokensMultiplier) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+func (x *MsgSetServiceComputeUnitsToTokensMultiplier) GetComputeUnitsToTokensMultiplier() uint64 {
+	if x != nil {
+		return x.ComputeUnitsToTokensMultiplier
+	}
+	return 0
+}
+
+type MsgSetServiceComputeUnitsToTokensMultiplierResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Service *shared.Service `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
+}
+
+func (x *MsgSetServiceComputeUnitsToTokensMultiplierResponse) Reset() {
+	*x = MsgSetServiceComputeUnitsToTokensMultiplierResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pocket_service_tx_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgSetServiceComputeUnitsToTokensMultiplierResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgSetServiceComputeUnitsToTokensMultiplierResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgSetServiceComputeUnitsToTokensMultiplierResponse.ProtoReflect.Descriptor instead.
+func (*MsgSetServiceComputeUnitsToTokensMultiplierResponse) Descriptor() ([]byte, []int) {
+	return file_pocket_service_tx_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *MsgSetServiceComputeUnitsToTokensMultiplierResponse) GetService() *shared.Service {
+	if x != nil {
+		return x.Service
+	}
+	return nil
+}
+
+// MsgUpdateServiceComputeUnitsToTokensMultiplier defines a message for governance
+// to update the compute units to tokens multiplier of any service.
+type MsgUpdateServiceComputeUnitsToTokensMultiplier struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	ServiceId string `protobuf:"bytes,2,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"` // The ID of the service to update.
+	// The amount of uPOKT a compute unit of the service translates to.
+	// Zero resets the service to the global 'compute_units_to_tokens_multiplier' shared param.
+	ComputeUnitsToTokensMultiplier uint64 `protobuf:"varint,3,opt,name=compute_units_to_tokens_multiplier,json=computeUnitsToTokensMultiplier,proto3" json:"compute_units_to_tokens_multiplier,omitempty"`
+}
+
+func (x *MsgUpdateServiceComputeUnitsToTokensMultiplier) Reset() {
+	*x = MsgUpdateServiceComputeUnitsToTokensMultiplier{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pocket_service_tx_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgUpdateServiceComputeUnitsToTokensMultiplier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgUpdateServiceComputeUnitsToTokensMultiplier) ProtoMessage() {}
+
+// Deprecated: Use MsgUpdateServiceComputeUnitsToTokensMultiplier.ProtoReflect.Descriptor instead.
+func (*MsgUpdateServiceComputeUnitsToTokensMultiplier) Descriptor() ([]byte, []int) {
+	return file_pocket_service_tx_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *MsgUpdateServiceComputeUnitsToTokensMultiplier) GetAuthority() string {
+	if x != nil {
+		return x.Authority
+	}
+	return ""
+}
+
+func (x *MsgUpdateServiceComputeUnitsToTokensMultiplier) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+func (x *MsgUpdateServiceComputeUnitsToTokensMultiplier) GetComputeUnitsToTokensMultiplier() uint64 {
+	if x != nil {
+		return x.ComputeUnitsToTokensMultiplier
+	}
+	return 0
+}
+
+type MsgUpdateServiceComputeUnitsToTokensMultiplierResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Service *shared.Service `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
+}
+
+func (x *MsgUpdateServiceComputeUnitsToTokensMultiplierResponse) Reset() {
+	*x = MsgUpdateServiceComputeUnitsToTokensMultiplierResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pocket_service_tx_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgUpdateServiceComputeUnitsToTokensMultiplierResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgUpdateServiceComputeUnitsToTokensMultiplierResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgUpdateServiceComputeUnitsToTokensMultiplierResponse.ProtoReflect.Descriptor instead.
+func (*MsgUpdateServiceComputeUnitsToTokensMultiplierResponse) Descriptor() ([]byte, []int) {
+	return file_pocket_service_tx_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *MsgUpdateServiceComputeUnitsToTokensMultiplierResponse) GetService() *shared.Service {
+	if x != nil {
+		return x.Service
+	}
+	return nil
+}
+
+// MsgSetServiceOwnerRevSharePercentage defines a message for the service owner
+// to update the percentage of its service's settled claims which it receives.
+type MsgSetServiceOwnerRevSharePercentage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OwnerAddress string `protobuf:"bytes,1,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"` // The Bech32 address of the service owner.
+	ServiceId    string `protobuf:"bytes,2,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`          // The ID of the service to update.
+	// The percentage of each settled claim's amount for the service which is routed to the service owner.
+	// It is bounded by the max_rev_share_percentage of the TLM_SERVICE_OWNER_REV_SHARE token logic module.
+	OwnerRevSharePercentage uint64 `protobuf:"varint,3,opt,name=owner_rev_share_percentage,json=ownerRevSharePercentage,proto3" json:"owner_rev_share_percentage,omitempty"`
+}
+
+func (x *MsgSetServiceOwnerRevSharePercentage) Reset() {
+	*x = MsgSetServiceOwnerRevSharePercentage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pocket_service_tx_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgSetServiceOwnerRevSharePercentage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgSetServiceOwnerRevSharePercentage) ProtoMessage() {}
+
+// Deprecated: Use MsgSetServiceOwnerRevSharePercentage.ProtoReflect.Descriptor instead.
+func (*MsgSetServiceOwnerRevSharePercentage) Descriptor() ([]byte, []int) {
+	return file_pocket_service_tx_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *MsgSetServiceOwnerRevSharePercentage) GetOwnerAddress() string {
+	if x != nil {
+		return x.OwnerAddress
+	}
+	return ""
+}
+
+func (x *MsgSetServiceOwnerRevSharePercentage) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+func (x *MsgSetServiceOwnerRevSharePercentage) GetOwnerRevSharePercentage() uint64 {
+	if x != nil {
+		return x.OwnerRevSharePercentage
+	}
+	return 0
+}
+
+type MsgSetServiceOwnerRevSharePercentageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Service *shared.Service `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
+}
+
+func (x *MsgSetServiceOwnerRevSharePercentageResponse) Reset() {
+	*x = MsgSetServiceOwnerRevSharePercentageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pocket_service_tx_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgSetServiceOwnerRevSharePercentageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgSetServiceOwnerRevSharePercentageResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgSetServiceOwnerRevSharePercentageResponse.ProtoReflect.Descriptor instead.
+func (*MsgSetServiceOwnerRevSharePercentageResponse) Descriptor() ([]byte, []int) {
+	return file_pocket_service_tx_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *MsgSetServiceOwnerRevSharePercentageResponse) GetService() *shared.Service {
+	if x != nil {
+		return x.Service
+	}
+	return nil
+}
+
 var File_pocket_service_tx_proto protoreflect.FileDescriptor
 
 var file_pocket_service_tx_proto_rawDesc = []byte{
@@ -3267,34 +6438,125 @@ var file_pocket_service_tx_proto_rawDesc = []byte{
 	0x73, 0x65, 0x12, 0x30, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x73, 0x68, 0x61,
 	0x72, 0x65, 0x64, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x07, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x32, 0x91, 0x02, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x58, 0x0a, 0x0c,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x1f, 0x2e, 0x70,
+	0x76, 0x69, 0x63, 0x65, 0x22, 0xeb, 0x01, 0x0a, 0x2b, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x55, 0x6e, 0x69,
+	0x74, 0x73, 0x54, 0x6f, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70,
+	0x6c, 0x69, 0x65, 0x72, 0x12, 0x3d, 0x0a, 0x0d, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d,
+	0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0c, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x49, 0x64, 0x12, 0x4a, 0x0a, 0x22, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x5f, 0x75, 0x6e,
+	0x69, 0x74, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x5f, 0x6d, 0x75,
+	0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x1e,
+	0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x54, 0x6f, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x73, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x3a, 0x12,
+	0x82, 0xe7, 0xb0, 0x2a, 0x0d, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x22, 0x67, 0x0a, 0x33, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x54,
+	0x6f, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x07, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x6f, 0x63,
+	0x6b, 0x65, 0x74, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x22, 0xa7, 0x02, 0x0a, 0x2e,
+	0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x43, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x54, 0x6f, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x73, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x12, 0x36,
+	0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x61, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x4a, 0x0a, 0x22, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65,
+	0x5f, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73,
+	0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x1e, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x54,
+	0x6f, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65,
+	0x72, 0x3a, 0x52, 0x82, 0xe7, 0xb0, 0x2a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74,
+	0x79, 0x8a, 0xe7, 0xb0, 0x2a, 0x3f, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2f, 0x78, 0x2f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x55, 0x6e,
+	0x69, 0x74, 0x73, 0x54, 0x6f, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x4d, 0x75, 0x6c, 0x74, 0x69,
+	0x70, 0x6c, 0x69, 0x65, 0x72, 0x22, 0x6a, 0x0a, 0x36, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65,
+	0x55, 0x6e, 0x69, 0x74, 0x73, 0x54, 0x6f, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x4d, 0x75, 0x6c,
+	0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x30, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64,
+	0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x22, 0xd5, 0x01, 0x0a, 0x24, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x76, 0x53, 0x68, 0x61, 0x72, 0x65,
+	0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x3d, 0x0a, 0x0d, 0x6f, 0x77,
+	0x6e, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0c, 0x6f, 0x77, 0x6e,
+	0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x3b, 0x0a, 0x1a, 0x6f, 0x77, 0x6e, 0x65,
+	0x72, 0x5f, 0x72, 0x65, 0x76, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x63,
+	0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x17, 0x6f, 0x77,
+	0x6e, 0x65, 0x72, 0x52, 0x65, 0x76, 0x53, 0x68, 0x61, 0x72, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65,
+	0x6e, 0x74, 0x61, 0x67, 0x65, 0x3a, 0x12, 0x82, 0xe7, 0xb0, 0x2a, 0x0d, 0x6f, 0x77, 0x6e, 0x65,
+	0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x60, 0x0a, 0x2c, 0x4d, 0x73, 0x67,
+	0x53, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x52,
+	0x65, 0x76, 0x53, 0x68, 0x61, 0x72, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x07, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x6f, 0x63,
+	0x6b, 0x65, 0x74, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x92, 0x06, 0x0a, 0x03,
+	0x4d, 0x73, 0x67, 0x12, 0x58, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x12, 0x1f, 0x2e, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x1a, 0x27, 0x2e, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a,
+	0x0b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x1e, 0x2e, 0x70,
+	0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4d, 0x73,
+	0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x1a, 0x26, 0x2e, 0x70,
 	0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4d, 0x73,
-	0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x27, 0x2e,
-	0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4d,
-	0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x50, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x1e, 0x2e, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x50, 0x61, 0x72, 0x61, 0x6d, 0x1a, 0x26, 0x2e, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x50, 0x61, 0x72, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a,
-	0x0a, 0x41, 0x64, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x1d, 0x2e, 0x70, 0x6f,
+	0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x0a, 0x41, 0x64, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x1d, 0x2e, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x4d, 0x73, 0x67, 0x41, 0x64, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x1a, 0x25, 0x2e, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x4d, 0x73, 0x67, 0x41, 0x64, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0xac, 0x01, 0x0a, 0x28, 0x53, 0x65, 0x74,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x55, 0x6e,
+	0x69, 0x74, 0x73, 0x54, 0x6f, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x4d, 0x75, 0x6c, 0x74, 0x69,
+	0x70, 0x6c, 0x69, 0x65, 0x72, 0x12, 0x3b, 0x2e, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x73,
+	0x54, 0x6f, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69,
+	0x65, 0x72, 0x1a, 0x43, 0x2e, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x43, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x54, 0x6f, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0xb5, 0x01, 0x0a, 0x2b, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65,
+	0x55, 0x6e, 0x69, 0x74, 0x73, 0x54, 0x6f, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x4d, 0x75, 0x6c,
+	0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x12, 0x3e, 0x2e, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65,
+	0x55, 0x6e, 0x69, 0x74, 0x73, 0x54, 0x6f, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x4d, 0x75, 0x6c,
+	0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x1a, 0x46, 0x2e, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65,
+	0x55, 0x6e, 0x69, 0x74, 0x73, 0x54, 0x6f, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x4d, 0x75, 0x6c,
+	0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x97, 0x01, 0x0a, 0x21, 0x53, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4f, 0x77,
+	0x6e, 0x65, 0x72, 0x52, 0x65, 0x76, 0x53, 0x68, 0x61, 0x72, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65,
+	0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x34, 0x2e, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x76, 0x53, 0x68, 0x61, 0x72,
+	0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x1a, 0x3c, 0x2e, 0x70, 0x6f,
 	0x63, 0x6b, 0x65, 0x74, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4d, 0x73, 0x67,
-	0x41, 0x64, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x1a, 0x25, 0x2e, 0x70, 0x6f, 0x63,
-	0x6b, 0x65, 0x74, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4d, 0x73, 0x67, 0x41,
-	0x64, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x1a, 0x05, 0x80, 0xe7, 0xb0, 0x2a, 0x01, 0x42, 0x9b, 0x01, 0xd8, 0xe2, 0x1e, 0x01, 0x0a,
-	0x12, 0x63, 0x6f, 0x6d, 0x2e, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x42, 0x07, 0x54, 0x78, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x1f,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0xa2,
-	0x02, 0x03, 0x50, 0x53, 0x58, 0xaa, 0x02, 0x0e, 0x50, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0xca, 0x02, 0x0e, 0x50, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x5c,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0xe2, 0x02, 0x1a, 0x50, 0x6f, 0x63, 0x6b, 0x65, 0x74,
-	0x5c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0f, 0x50, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x3a, 0x3a, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x53, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x52,
+	0x65, 0x76, 0x53, 0x68, 0x61, 0x72, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x1a, 0x05, 0x80, 0xe7, 0xb0, 0x2a, 0x01,
+	0x42, 0x25, 0xd8, 0xe2, 0x1e, 0x01, 0x5a, 0x1f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
+	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2f,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -3309,35 +6571,50 @@ func file_pocket_service_tx_proto_rawDescGZIP() []byte {
 	return file_pocket_service_tx_proto_rawDescData
 }
 
-var file_pocket_service_tx_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_pocket_service_tx_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_pocket_service_tx_proto_goTypes = []interface{}{
-	(*MsgUpdateParams)(nil),         // 0: pocket.service.MsgUpdateParams
-	(*MsgUpdateParamsResponse)(nil), // 1: pocket.service.MsgUpdateParamsResponse
-	(*MsgUpdateParam)(nil),          // 2: pocket.service.MsgUpdateParam
-	(*MsgUpdateParamResponse)(nil),  // 3: pocket.service.MsgUpdateParamResponse
-	(*MsgAddService)(nil),           // 4: pocket.service.MsgAddService
-	(*MsgAddServiceResponse)(nil),   // 5: pocket.service.MsgAddServiceResponse
-	(*Params)(nil),                  // 6: pocket.service.Params
-	(*v1beta1.Coin)(nil),            // 7: cosmos.base.v1beta1.Coin
-	(*shared.Service)(nil),          // 8: pocket.shared.Service
+	(*MsgUpdateParams)(nil),                                        // 0: pocket.service.MsgUpdateParams
+	(*MsgUpdateParamsResponse)(nil),                                // 1: pocket.service.MsgUpdateParamsResponse
+	(*MsgUpdateParam)(nil),                                         // 2: pocket.service.MsgUpdateParam
+	(*MsgUpdateParamResponse)(nil),                                 // 3: pocket.service.MsgUpdateParamResponse
+	(*MsgAddService)(nil),                                          // 4: pocket.service.MsgAddService
+	(*MsgAddServiceResponse)(nil),                                  // 5: pocket.service.MsgAddServiceResponse
+	(*MsgSetServiceComputeUnitsToTokensMultiplier)(nil),            // 6: pocket.service.MsgSetServiceComputeUnitsToTokensMultiplier
+	(*MsgSetServiceComputeUnitsToTokensMultiplierResponse)(nil),    // 7: pocket.service.MsgSetServiceComputeUnitsToTokensMultiplierResponse
+	(*MsgUpdateServiceComputeUnitsToTokensMultiplier)(nil),         // 8: pocket.service.MsgUpdateServiceComputeUnitsToTokensMultiplier
+	(*MsgUpdateServiceComputeUnitsToTokensMultiplierResponse)(nil), // 9: pocket.service.MsgUpdateServiceComputeUnitsToTokensMultiplierResponse
+	(*MsgSetServiceOwnerRevSharePercentage)(nil),                   // 10: pocket.service.MsgSetServiceOwnerRevSharePercentage
+	(*MsgSetServiceOwnerRevSharePercentageResponse)(nil),           // 11: pocket.service.MsgSetServiceOwnerRevSharePercentageResponse
+	(*Params)(nil),         // 12: pocket.service.Params
+	(*v1beta1.Coin)(nil),   // 13: cosmos.base.v1beta1.Coin
+	(*shared.Service)(nil), // 14: pocket.shared.Service
 }
 var file_pocket_service_tx_proto_depIdxs = []int32{
-	6, // 0: pocket.service.MsgUpdateParams.params:type_name -> pocket.service.Params
-	7, // 1: pocket.service.MsgUpdateParam.as_coin:type_name -> cosmos.base.v1beta1.Coin
-	6, // 2: pocket.service.MsgUpdateParamResponse.params:type_name -> pocket.service.Params
-	8, // 3: pocket.service.MsgAddService.service:type_name -> pocket.shared.Service
-	8, // 4: pocket.service.MsgAddServiceResponse.service:type_name -> pocket.shared.Service
-	0, // 5: pocket.service.Msg.UpdateParams:input_type -> pocket.service.MsgUpdateParams
-	2, // 6: pocket.service.Msg.UpdateParam:input_type -> pocket.service.MsgUpdateParam
-	4, // 7: pocket.service.Msg.AddService:input_type -> pocket.service.MsgAddService
-	1, // 8: pocket.service.Msg.UpdateParams:output_type -> pocket.service.MsgUpdateParamsResponse
-	3, // 9: pocket.service.Msg.UpdateParam:output_type -> pocket.service.MsgUpdateParamResponse
-	5, // 10: pocket.service.Msg.AddService:output_type -> pocket.service.MsgAddServiceResponse
-	8, // [8:11] is the sub-list for method output_type
-	5, // [5:8] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	12, // 0: pocket.service.MsgUpdateParams.params:type_name -> pocket.service.Params
+	13, // 1: pocket.service.MsgUpdateParam.as_coin:type_name -> cosmos.base.v1beta1.Coin
+	12, // 2: pocket.service.MsgUpdateParamResponse.params:type_name -> pocket.service.Params
+	14, // 3: pocket.service.MsgAddService.service:type_name -> pocket.shared.Service
+	14, // 4: pocket.service.MsgAddServiceResponse.service:type_name -> pocket.shared.Service
+	14, // 5: pocket.service.MsgSetServiceComputeUnitsToTokensMultiplierResponse.service:type_name -> pocket.shared.Service
+	14, // 6: pocket.service.MsgUpdateServiceComputeUnitsToTokensMultiplierResponse.service:type_name -> pocket.shared.Service
+	14, // 7: pocket.service.MsgSetServiceOwnerRevSharePercentageResponse.service:type_name -> pocket.shared.Service
+	0,  // 8: pocket.service.Msg.UpdateParams:input_type -> pocket.service.MsgUpdateParams
+	2,  // 9: pocket.service.Msg.UpdateParam:input_type -> pocket.service.MsgUpdateParam
+	4,  // 10: pocket.service.Msg.AddService:input_type -> pocket.service.MsgAddService
+	6,  // 11: pocket.service.Msg.SetServiceComputeUnitsToTokensMultiplier:input_type -> pocket.service.MsgSetServiceComputeUnitsToTokensMultiplier
+	8,  // 12: pocket.service.Msg.UpdateServiceComputeUnitsToTokensMultiplier:input_type -> pocket.service.MsgUpdateServiceComputeUnitsToTokensMultiplier
+	10, // 13: pocket.service.Msg.SetServiceOwnerRevSharePercentage:input_type -> pocket.service.MsgSetServiceOwnerRevSharePercentage
+	1,  // 14: pocket.service.Msg.UpdateParams:output_type -> pocket.service.MsgUpdateParamsResponse
+	3,  // 15: pocket.service.Msg.UpdateParam:output_type -> pocket.service.MsgUpdateParamResponse
+	5,  // 16: pocket.service.Msg.AddService:output_type -> pocket.service.MsgAddServiceResponse
+	7,  // 17: pocket.service.Msg.SetServiceComputeUnitsToTokensMultiplier:output_type -> pocket.service.MsgSetServiceComputeUnitsToTokensMultiplierResponse
+	9,  // 18: pocket.service.Msg.UpdateServiceComputeUnitsToTokensMultiplier:output_type -> pocket.service.MsgUpdateServiceComputeUnitsToTokensMultiplierResponse
+	11, // 19: pocket.service.Msg.SetServiceOwnerRevSharePercentage:output_type -> pocket.service.MsgSetServiceOwnerRevSharePercentageResponse
+	14, // [14:20] is the sub-list for method output_type
+	8,  // [8:14] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_pocket_service_tx_proto_init() }
@@ -3419,6 +6696,78 @@ func file_pocket_service_tx_proto_init() {
 				return nil
 			}
 		}
+		file_pocket_service_tx_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgSetServiceComputeUnitsToTokensMultiplier); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pocket_service_tx_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgSetServiceComputeUnitsToTokensMultiplierResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pocket_service_tx_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgUpdateServiceComputeUnitsToTokensMultiplier); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pocket_service_tx_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgUpdateServiceComputeUnitsToTokensMultiplierResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pocket_service_tx_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgSetServiceOwnerRevSharePercentage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pocket_service_tx_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgSetServiceOwnerRevSharePercentageResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_pocket_service_tx_proto_msgTypes[2].OneofWrappers = []interface{}{
 		(*MsgUpdateParam_AsCoin)(nil),
@@ -3430,7 +6779,7 @@ func file_pocket_service_tx_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_pocket_service_tx_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   12,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
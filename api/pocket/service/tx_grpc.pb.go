@@ -19,9 +19,12 @@ import (
 const _ = grpc.SupportPackageIsVersion8
 
 const (
-	Msg_UpdateParams_FullMethodName = "/pocket.service.Msg/UpdateParams"
-	Msg_UpdateParam_FullMethodName  = "/pocket.service.Msg/UpdateParam"
-	Msg_AddService_FullMethodName   = "/pocket.service.Msg/AddService"
+	Msg_UpdateParams_FullMethodName                                = "/pocket.service.Msg/UpdateParams"
+	Msg_UpdateParam_FullMethodName                                 = "/pocket.service.Msg/UpdateParam"
+	Msg_AddService_FullMethodName                                  = "/pocket.service.Msg/AddService"
+	Msg_SetServiceComputeUnitsToTokensMultiplier_FullMethodName    = "/pocket.service.Msg/SetServiceComputeUnitsToTokensMultiplier"
+	Msg_UpdateServiceComputeUnitsToTokensMultiplier_FullMethodName = "/pocket.service.Msg/UpdateServiceComputeUnitsToTokensMultiplier"
+	Msg_SetServiceOwnerRevSharePercentage_FullMethodName           = "/pocket.service.Msg/SetServiceOwnerRevSharePercentage"
 )
 
 // MsgClient is the client API for Msg service.
@@ -35,6 +38,15 @@ type MsgClient interface {
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 	UpdateParam(ctx context.Context, in *MsgUpdateParam, opts ...grpc.CallOption) (*MsgUpdateParamResponse, error)
 	AddService(ctx context.Context, in *MsgAddService, opts ...grpc.CallOption) (*MsgAddServiceResponse, error)
+	// SetServiceComputeUnitsToTokensMultiplier defines a (service owner) operation
+	// for updating the compute units to tokens multiplier of a service.
+	SetServiceComputeUnitsToTokensMultiplier(ctx context.Context, in *MsgSetServiceComputeUnitsToTokensMultiplier, opts ...grpc.CallOption) (*MsgSetServiceComputeUnitsToTokensMultiplierResponse, error)
+	// UpdateServiceComputeUnitsToTokensMultiplier defines a (governance) operation
+	// for updating the compute units to tokens multiplier of any service.
+	UpdateServiceComputeUnitsToTokensMultiplier(ctx context.Context, in *MsgUpdateServiceComputeUnitsToTokensMultiplier, opts ...grpc.CallOption) (*MsgUpdateServiceComputeUnitsToTokensMultiplierResponse, error)
+	// SetServiceOwnerRevSharePercentage defines a (service owner) operation
+	// for updating the percentage of the settled claims which is routed to the service owner.
+	SetServiceOwnerRevSharePercentage(ctx context.Context, in *MsgSetServiceOwnerRevSharePercentage, opts ...grpc.CallOption) (*MsgSetServiceOwnerRevSharePercentageResponse, error)
 }
 
 type msgClient struct {
@@ -75,6 +87,36 @@ func (c *msgClient) AddService(ctx context.Context, in *MsgAddService, opts ...g
 	return out, nil
 }
 
+func (c *msgClient) SetServiceComputeUnitsToTokensMultiplier(ctx context.Context, in *MsgSetServiceComputeUnitsToTokensMultiplier, opts ...grpc.CallOption) (*MsgSetServiceComputeUnitsToTokensMultiplierResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetServiceComputeUnitsToTokensMultiplierResponse)
+	err := c.cc.Invoke(ctx, Msg_SetServiceComputeUnitsToTokensMultiplier_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateServiceComputeUnitsToTokensMultiplier(ctx context.Context, in *MsgUpdateServiceComputeUnitsToTokensMultiplier, opts ...grpc.CallOption) (*MsgUpdateServiceComputeUnitsToTokensMultiplierResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgUpdateServiceComputeUnitsToTokensMultiplierResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateServiceComputeUnitsToTokensMultiplier_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetServiceOwnerRevSharePercentage(ctx context.Context, in *MsgSetServiceOwnerRevSharePercentage, opts ...grpc.CallOption) (*MsgSetServiceOwnerRevSharePercentageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetServiceOwnerRevSharePercentageResponse)
+	err := c.cc.Invoke(ctx, Msg_SetServiceOwnerRevSharePercentage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -86,6 +128,15 @@ type MsgServer interface {
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 	UpdateParam(context.Context, *MsgUpdateParam) (*MsgUpdateParamResponse, error)
 	AddService(context.Context, *MsgAddService) (*MsgAddServiceResponse, error)
+	// SetServiceComputeUnitsToTokensMultiplier defines a (service owner) operation
+	// for updating the compute units to tokens multiplier of a service.
+	SetServiceComputeUnitsToTokensMultiplier(context.Context, *MsgSetServiceComputeUnitsToTokensMultiplier) (*MsgSetServiceComputeUnitsToTokensMultiplierResponse, error)
+	// UpdateServiceComputeUnitsToTokensMultiplier defines a (governance) operation
+	// for updating the compute units to tokens multiplier of any service.
+	UpdateServiceComputeUnitsToTokensMultiplier(context.Context, *MsgUpdateServiceComputeUnitsToTokensMultiplier) (*MsgUpdateServiceComputeUnitsToTokensMultiplierResponse, error)
+	// SetServiceOwnerRevSharePercentage defines a (service owner) operation
+	// for updating the percentage of the settled claims which is routed to the service owner.
+	SetServiceOwnerRevSharePercentage(context.Context, *MsgSetServiceOwnerRevSharePercentage) (*MsgSetServiceOwnerRevSharePercentageResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -102,6 +153,15 @@ func (UnimplementedMsgServer) UpdateParam(context.Context, *MsgUpdateParam) (*Ms
 func (UnimplementedMsgServer) AddService(context.Context, *MsgAddService) (*MsgAddServiceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddService not implemented")
 }
+func (UnimplementedMsgServer) SetServiceComputeUnitsToTokensMultiplier(context.Context, *MsgSetServiceComputeUnitsToTokensMultiplier) (*MsgSetServiceComputeUnitsToTokensMultiplierResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetServiceComputeUnitsToTokensMultiplier not implemented")
+}
+func (UnimplementedMsgServer) UpdateServiceComputeUnitsToTokensMultiplier(context.Context, *MsgUpdateServiceComputeUnitsToTokensMultiplier) (*MsgUpdateServiceComputeUnitsToTokensMultiplierResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateServiceComputeUnitsToTokensMultiplier not implemented")
+}
+func (UnimplementedMsgServer) SetServiceOwnerRevSharePercentage(context.Context, *MsgSetServiceOwnerRevSharePercentage) (*MsgSetServiceOwnerRevSharePercentageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetServiceOwnerRevSharePercentage not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -169,6 +229,60 @@ func _Msg_AddService_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetServiceComputeUnitsToTokensMultiplier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetServiceComputeUnitsToTokensMultiplier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetServiceComputeUnitsToTokensMultiplier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetServiceComputeUnitsToTokensMultiplier_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetServiceComputeUnitsToTokensMultiplier(ctx, req.(*MsgSetServiceComputeUnitsToTokensMultiplier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateServiceComputeUnitsToTokensMultiplier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateServiceComputeUnitsToTokensMultiplier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateServiceComputeUnitsToTokensMultiplier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateServiceComputeUnitsToTokensMultiplier_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateServiceComputeUnitsToTokensMultiplier(ctx, req.(*MsgUpdateServiceComputeUnitsToTokensMultiplier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetServiceOwnerRevSharePercentage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetServiceOwnerRevSharePercentage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetServiceOwnerRevSharePercentage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetServiceOwnerRevSharePercentage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetServiceOwnerRevSharePercentage(ctx, req.(*MsgSetServiceOwnerRevSharePercentage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -188,6 +302,18 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AddService",
 			Handler:    _Msg_AddService_Handler,
 		},
+		{
+			MethodName: "SetServiceComputeUnitsToTokensMultiplier",
+			Handler:    _Msg_SetServiceComputeUnitsToTokensMultiplier_Handler,
+		},
+		{
+			MethodName: "UpdateServiceComputeUnitsToTokensMultiplier",
+			Handler:    _Msg_UpdateServiceComputeUnitsToTokensMultiplier_Handler,
+		},
+		{
+			MethodName: "SetServiceOwnerRevSharePercentage",
+			Handler:    _Msg_SetServiceOwnerRevSharePercentage_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pocket/service/tx.proto",
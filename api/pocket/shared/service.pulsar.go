@@ -14,12 +14,118 @@ import (
 	sync "sync"
 )
 
+var _ protoreflect.List = (*_Service_7_list)(nil)
+
+type _Service_7_list struct {
+	list *[]*ServiceComputeUnitsToTokensMultiplierUpdate
+}
+
+func (x *_Service_7_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Service_7_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Service_7_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ServiceComputeUnitsToTokensMultiplierUpdate)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Service_7_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ServiceComputeUnitsToTokensMultiplierUpdate)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Service_7_list) AppendMutable() protoreflect.Value {
+	v := new(ServiceComputeUnitsToTokensMultiplierUpdate)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Service_7_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Service_7_list) NewElement() protoreflect.Value {
+	v := new(ServiceComputeUnitsToTokensMultiplierUpdate)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Service_7_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Service_8_list)(nil)
+
+type _Service_8_list struct {
+	list *[]*ServiceOwnerRevSharePercentageUpdate
+}
+
+func (x *_Service_8_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Service_8_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Service_8_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ServiceOwnerRevSharePercentageUpdate)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Service_8_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ServiceOwnerRevSharePercentageUpdate)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Service_8_list) AppendMutable() protoreflect.Value {
+	v := new(ServiceOwnerRevSharePercentageUpdate)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Service_8_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Service_8_list) NewElement() protoreflect.Value {
+	v := new(ServiceOwnerRevSharePercentageUpdate)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Service_8_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_Service                         protoreflect.MessageDescriptor
-	fd_Service_id                      protoreflect.FieldDescriptor
-	fd_Service_name                    protoreflect.FieldDescriptor
-	fd_Service_compute_units_per_relay protoreflect.FieldDescriptor
-	fd_Service_owner_address           protoreflect.FieldDescriptor
+	md_Service                                            protoreflect.MessageDescriptor
+	fd_Service_id                                         protoreflect.FieldDescriptor
+	fd_Service_name                                       protoreflect.FieldDescriptor
+	fd_Service_compute_units_per_relay                    protoreflect.FieldDescriptor
+	fd_Service_owner_address                              protoreflect.FieldDescriptor
+	fd_Service_compute_units_to_tokens_multiplier         protoreflect.FieldDescriptor
+	fd_Service_owner_rev_share_percentage                 protoreflect.FieldDescriptor
+	fd_Service_compute_units_to_tokens_multiplier_history protoreflect.FieldDescriptor
+	fd_Service_owner_rev_share_percentage_history         protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -29,6 +135,10 @@ func init() {
 	fd_Service_name = md_Service.Fields().ByName("name")
 	fd_Service_compute_units_per_relay = md_Service.Fields().ByName("compute_units_per_relay")
 	fd_Service_owner_address = md_Service.Fields().ByName("owner_address")
+	fd_Service_compute_units_to_tokens_multiplier = md_Service.Fields().ByName("compute_units_to_tokens_multiplier")
+	fd_Service_owner_rev_share_percentage = md_Service.Fields().ByName("owner_rev_share_percentage")
+	fd_Service_compute_units_to_tokens_multiplier_history = md_Service.Fields().ByName("compute_units_to_tokens_multiplier_history")
+	fd_Service_owner_rev_share_percentage_history = md_Service.Fields().ByName("owner_rev_share_percentage_history")
 }
 
 var _ protoreflect.Message = (*fastReflection_Service)(nil)
@@ -120,6 +230,30 @@ func (x *fastReflection_Service) Range(f func(protoreflect.FieldDescriptor, prot
 			return
 		}
 	}
+	if x.ComputeUnitsToTokensMultiplier != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ComputeUnitsToTokensMultiplier)
+		if !f(fd_Service_compute_units_to_tokens_multiplier, value) {
+			return
+		}
+	}
+	if x.OwnerRevSharePercentage != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.OwnerRevSharePercentage)
+		if !f(fd_Service_owner_rev_share_percentage, value) {
+			return
+		}
+	}
+	if len(x.ComputeUnitsToTokensMultiplierHistory) != 0 {
+		value := protoreflect.ValueOfList(&_Service_7_list{list: &x.ComputeUnitsToTokensMultiplierHistory})
+		if !f(fd_Service_compute_units_to_tokens_multiplier_history, value) {
+			return
+		}
+	}
+	if len(x.OwnerRevSharePercentageHistory) != 0 {
+		value := protoreflect.ValueOfList(&_Service_8_list{list: &x.OwnerRevSharePercentageHistory})
+		if !f(fd_Service_owner_rev_share_percentage_history, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -143,6 +277,14 @@ func (x *fastReflection_Service) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.ComputeUnitsPerRelay != uint64(0)
 	case "pocket.shared.Service.owner_address":
 		return x.OwnerAddress != ""
+	case "pocket.shared.Service.compute_units_to_tokens_multiplier":
+		return x.ComputeUnitsToTokensMultiplier != uint64(0)
+	case "pocket.shared.Service.owner_rev_share_percentage":
+		return x.OwnerRevSharePercentage != uint64(0)
+	case "pocket.shared.Service.compute_units_to_tokens_multiplier_history":
+		return len(x.ComputeUnitsToTokensMultiplierHistory) != 0
+	case "pocket.shared.Service.owner_rev_share_percentage_history":
+		return len(x.OwnerRevSharePercentageHistory) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.shared.Service"))
@@ -167,6 +309,14 @@ func (x *fastReflection_Service) Clear(fd protoreflect.FieldDescriptor) {
 		x.ComputeUnitsPerRelay = uint64(0)
 	case "pocket.shared.Service.owner_address":
 		x.OwnerAddress = ""
+	case "pocket.shared.Service.compute_units_to_tokens_multiplier":
+		x.ComputeUnitsToTokensMultiplier = uint64(0)
+	case "pocket.shared.Service.owner_rev_share_percentage":
+		x.OwnerRevSharePercentage = uint64(0)
+	case "pocket.shared.Service.compute_units_to_tokens_multiplier_history":
+		x.ComputeUnitsToTokensMultiplierHistory = nil
+	case "pocket.shared.Service.owner_rev_share_percentage_history":
+		x.OwnerRevSharePercentageHistory = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.shared.Service"))
@@ -195,6 +345,24 @@ func (x *fastReflection_Service) Get(descriptor protoreflect.FieldDescriptor) pr
 	case "pocket.shared.Service.owner_address":
 		value := x.OwnerAddress
 		return protoreflect.ValueOfString(value)
+	case "pocket.shared.Service.compute_units_to_tokens_multiplier":
+		value := x.ComputeUnitsToTokensMultiplier
+		return protoreflect.ValueOfUint64(value)
+	case "pocket.shared.Service.owner_rev_share_percentage":
+		value := x.OwnerRevSharePercentage
+		return protoreflect.ValueOfUint64(value)
+	case "pocket.shared.Service.compute_units_to_tokens_multiplier_history":
+		if len(x.ComputeUnitsToTokensMultiplierHistory) == 0 {
+			return protoreflect.ValueOfList(&_Service_7_list{})
+		}
+		listValue := &_Service_7_list{list: &x.ComputeUnitsToTokensMultiplierHistory}
+		return protoreflect.ValueOfList(listValue)
+	case "pocket.shared.Service.owner_rev_share_percentage_history":
+		if len(x.OwnerRevSharePercentageHistory) == 0 {
+			return protoreflect.ValueOfList(&_Service_8_list{})
+		}
+		listValue := &_Service_8_list{list: &x.OwnerRevSharePercentageHistory}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.shared.Service"))
@@ -223,6 +391,18 @@ func (x *fastReflection_Service) Set(fd protoreflect.FieldDescriptor, value prot
 		x.ComputeUnitsPerRelay = value.Uint()
 	case "pocket.shared.Service.owner_address":
 		x.OwnerAddress = value.Interface().(string)
+	case "pocket.shared.Service.compute_units_to_tokens_multiplier":
+		x.ComputeUnitsToTokensMultiplier = value.Uint()
+	case "pocket.shared.Service.owner_rev_share_percentage":
+		x.OwnerRevSharePercentage = value.Uint()
+	case "pocket.shared.Service.compute_units_to_tokens_multiplier_history":
+		lv := value.List()
+		clv := lv.(*_Service_7_list)
+		x.ComputeUnitsToTokensMultiplierHistory = *clv.list
+	case "pocket.shared.Service.owner_rev_share_percentage_history":
+		lv := value.List()
+		clv := lv.(*_Service_8_list)
+		x.OwnerRevSharePercentageHistory = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.shared.Service"))
@@ -243,6 +423,18 @@ func (x *fastReflection_Service) Set(fd protoreflect.FieldDescriptor, value prot
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_Service) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "pocket.shared.Service.compute_units_to_tokens_multiplier_history":
+		if x.ComputeUnitsToTokensMultiplierHistory == nil {
+			x.ComputeUnitsToTokensMultiplierHistory = []*ServiceComputeUnitsToTokensMultiplierUpdate{}
+		}
+		value := &_Service_7_list{list: &x.ComputeUnitsToTokensMultiplierHistory}
+		return protoreflect.ValueOfList(value)
+	case "pocket.shared.Service.owner_rev_share_percentage_history":
+		if x.OwnerRevSharePercentageHistory == nil {
+			x.OwnerRevSharePercentageHistory = []*ServiceOwnerRevSharePercentageUpdate{}
+		}
+		value := &_Service_8_list{list: &x.OwnerRevSharePercentageHistory}
+		return protoreflect.ValueOfList(value)
 	case "pocket.shared.Service.id":
 		panic(fmt.Errorf("field id of message pocket.shared.Service is not mutable"))
 	case "pocket.shared.Service.name":
@@ -251,6 +443,10 @@ func (x *fastReflection_Service) Mutable(fd protoreflect.FieldDescriptor) protor
 		panic(fmt.Errorf("field compute_units_per_relay of message pocket.shared.Service is not mutable"))
 	case "pocket.shared.Service.owner_address":
 		panic(fmt.Errorf("field owner_address of message pocket.shared.Service is not mutable"))
+	case "pocket.shared.Service.compute_units_to_tokens_multiplier":
+		panic(fmt.Errorf("field compute_units_to_tokens_multiplier of message pocket.shared.Service is not mutable"))
+	case "pocket.shared.Service.owner_rev_share_percentage":
+		panic(fmt.Errorf("field owner_rev_share_percentage of message pocket.shared.Service is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.shared.Service"))
@@ -272,6 +468,16 @@ func (x *fastReflection_Service) NewField(fd protoreflect.FieldDescriptor) proto
 		return protoreflect.ValueOfUint64(uint64(0))
 	case "pocket.shared.Service.owner_address":
 		return protoreflect.ValueOfString("")
+	case "pocket.shared.Service.compute_units_to_tokens_multiplier":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "pocket.shared.Service.owner_rev_share_percentage":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "pocket.shared.Service.compute_units_to_tokens_multiplier_history":
+		list := []*ServiceComputeUnitsToTokensMultiplierUpdate{}
+		return protoreflect.ValueOfList(&_Service_7_list{list: &list})
+	case "pocket.shared.Service.owner_rev_share_percentage_history":
+		list := []*ServiceOwnerRevSharePercentageUpdate{}
+		return protoreflect.ValueOfList(&_Service_8_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.shared.Service"))
@@ -349,12 +555,1175 @@ func (x *fastReflection_Service) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.ComputeUnitsPerRelay != 0 {
-			n += 1 + runtime.Sov(uint64(x.ComputeUnitsPerRelay))
+		if x.ComputeUnitsPerRelay != 0 {
+			n += 1 + runtime.Sov(uint64(x.ComputeUnitsPerRelay))
+		}
+		l = len(x.OwnerAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ComputeUnitsToTokensMultiplier != 0 {
+			n += 1 + runtime.Sov(uint64(x.ComputeUnitsToTokensMultiplier))
+		}
+		if x.OwnerRevSharePercentage != 0 {
+			n += 1 + runtime.Sov(uint64(x.OwnerRevSharePercentage))
+		}
+		if len(x.ComputeUnitsToTokensMultiplierHistory) > 0 {
+			for _, e := range x.ComputeUnitsToTokensMultiplierHistory {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.OwnerRevSharePercentageHistory) > 0 {
+			for _, e := range x.OwnerRevSharePercentageHistory {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Service)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.OwnerRevSharePercentageHistory) > 0 {
+			for iNdEx := len(x.OwnerRevSharePercentageHistory) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.OwnerRevSharePercentageHistory[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x42
+			}
+		}
+		if len(x.ComputeUnitsToTokensMultiplierHistory) > 0 {
+			for iNdEx := len(x.ComputeUnitsToTokensMultiplierHistory) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ComputeUnitsToTokensMultiplierHistory[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x3a
+			}
+		}
+		if x.OwnerRevSharePercentage != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OwnerRevSharePercentage))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.ComputeUnitsToTokensMultiplier != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ComputeUnitsToTokensMultiplier))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.OwnerAddress) > 0 {
+			i -= len(x.OwnerAddress)
+			copy(dAtA[i:], x.OwnerAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OwnerAddress)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.ComputeUnitsPerRelay != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ComputeUnitsPerRelay))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Name) > 0 {
+			i -= len(x.Name)
+			copy(dAtA[i:], x.Name)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Name)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Id) > 0 {
+			i -= len(x.Id)
+			copy(dAtA[i:], x.Id)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Id)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Service)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Service: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Service: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Id = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Name = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ComputeUnitsPerRelay", wireType)
+				}
+				x.ComputeUnitsPerRelay = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ComputeUnitsPerRelay |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.OwnerAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ComputeUnitsToTokensMultiplier", wireType)
+				}
+				x.ComputeUnitsToTokensMultiplier = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ComputeUnitsToTokensMultiplier |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OwnerRevSharePercentage", wireType)
+				}
+				x.OwnerRevSharePercentage = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OwnerRevSharePercentage |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ComputeUnitsToTokensMultiplierHistory", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ComputeUnitsToTokensMultiplierHistory = append(x.ComputeUnitsToTokensMultiplierHistory, &ServiceComputeUnitsToTokensMultiplierUpdate{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ComputeUnitsToTokensMultiplierHistory[len(x.ComputeUnitsToTokensMultiplierHistory)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OwnerRevSharePercentageHistory", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.OwnerRevSharePercentageHistory = append(x.OwnerRevSharePercentageHistory, &ServiceOwnerRevSharePercentageUpdate{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.OwnerRevSharePercentageHistory[len(x.OwnerRevSharePercentageHistory)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ServiceComputeUnitsToTokensMultiplierUpdate                                    protoreflect.MessageDescriptor
+	fd_ServiceComputeUnitsToTokensMultiplierUpdate_compute_units_to_tokens_multiplier protoreflect.FieldDescriptor
+	fd_ServiceComputeUnitsToTokensMultiplierUpdate_effective_block_height             protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_pocket_shared_service_proto_init()
+	md_ServiceComputeUnitsToTokensMultiplierUpdate = File_pocket_shared_service_proto.Messages().ByName("ServiceComputeUnitsToTokensMultiplierUpdate")
+	fd_ServiceComputeUnitsToTokensMultiplierUpdate_compute_units_to_tokens_multiplier = md_ServiceComputeUnitsToTokensMultiplierUpdate.Fields().ByName("compute_units_to_tokens_multiplier")
+	fd_ServiceComputeUnitsToTokensMultiplierUpdate_effective_block_height = md_ServiceComputeUnitsToTokensMultiplierUpdate.Fields().ByName("effective_block_height")
+}
+
+var _ protoreflect.Message = (*fastReflection_ServiceComputeUnitsToTokensMultiplierUpdate)(nil)
+
+type fastReflection_ServiceComputeUnitsToTokensMultiplierUpdate ServiceComputeUnitsToTokensMultiplierUpdate
+
+func (x *ServiceComputeUnitsToTokensMultiplierUpdate) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ServiceComputeUnitsToTokensMultiplierUpdate)(x)
+}
+
+func (x *ServiceComputeUnitsToTokensMultiplierUpdate) slowProtoReflect() protoreflect.Message {
+	mi := &file_pocket_shared_service_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ServiceComputeUnitsToTokensMultiplierUpdate_messageType fastReflection_ServiceComputeUnitsToTokensMultiplierUpdate_messageType
+var _ protoreflect.MessageType = fastReflection_ServiceComputeUnitsToTokensMultiplierUpdate_messageType{}
+
+type fastReflection_ServiceComputeUnitsToTokensMultiplierUpdate_messageType struct{}
+
+func (x fastReflection_ServiceComputeUnitsToTokensMultiplierUpdate_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ServiceComputeUnitsToTokensMultiplierUpdate)(nil)
+}
+func (x fastReflection_ServiceComputeUnitsToTokensMultiplierUpdate_messageType) New() protoreflect.Message {
+	return new(fastReflection_ServiceComputeUnitsToTokensMultiplierUpdate)
+}
+func (x fastReflection_ServiceComputeUnitsToTokensMultiplierUpdate_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ServiceComputeUnitsToTokensMultiplierUpdate
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ServiceComputeUnitsToTokensMultiplierUpdate) Descriptor() protoreflect.MessageDescriptor {
+	return md_ServiceComputeUnitsToTokensMultiplierUpdate
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ServiceComputeUnitsToTokensMultiplierUpdate) Type() protoreflect.MessageType {
+	return _fastReflection_ServiceComputeUnitsToTokensMultiplierUpdate_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ServiceComputeUnitsToTokensMultiplierUpdate) New() protoreflect.Message {
+	return new(fastReflection_ServiceComputeUnitsToTokensMultiplierUpdate)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ServiceComputeUnitsToTokensMultiplierUpdate) Interface() protoreflect.ProtoMessage {
+	return (*ServiceComputeUnitsToTokensMultiplierUpdate)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ServiceComputeUnitsToTokensMultiplierUpdate) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ComputeUnitsToTokensMultiplier != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ComputeUnitsToTokensMultiplier)
+		if !f(fd_ServiceComputeUnitsToTokensMultiplierUpdate_compute_units_to_tokens_multiplier, value) {
+			return
+		}
+	}
+	if x.EffectiveBlockHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.EffectiveBlockHeight)
+		if !f(fd_ServiceComputeUnitsToTokensMultiplierUpdate_effective_block_height, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ServiceComputeUnitsToTokensMultiplierUpdate) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "pocket.shared.ServiceComputeUnitsToTokensMultiplierUpdate.compute_units_to_tokens_multiplier":
+		return x.ComputeUnitsToTokensMultiplier != uint64(0)
+	case "pocket.shared.ServiceComputeUnitsToTokensMultiplierUpdate.effective_block_height":
+		return x.EffectiveBlockHeight != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.shared.ServiceComputeUnitsToTokensMultiplierUpdate"))
+		}
+		panic(fmt.Errorf("message pocket.shared.ServiceComputeUnitsToTokensMultiplierUpdate does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ServiceComputeUnitsToTokensMultiplierUpdate) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "pocket.shared.ServiceComputeUnitsToTokensMultiplierUpdate.compute_units_to_tokens_multiplier":
+		x.ComputeUnitsToTokensMultiplier = uint64(0)
+	case "pocket.shared.ServiceComputeUnitsToTokensMultiplierUpdate.effective_block_height":
+		x.EffectiveBlockHeight = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.shared.ServiceComputeUnitsToTokensMultiplierUpdate"))
+		}
+		panic(fmt.Errorf("message pocket.shared.ServiceComputeUnitsToTokensMultiplierUpdate does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ServiceComputeUnitsToTokensMultiplierUpdate) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "pocket.shared.ServiceComputeUnitsToTokensMultiplierUpdate.compute_units_to_tokens_multiplier":
+		value := x.ComputeUnitsToTokensMultiplier
+		return protoreflect.ValueOfUint64(value)
+	case "pocket.shared.ServiceComputeUnitsToTokensMultiplierUpdate.effective_block_height":
+		value := x.EffectiveBlockHeight
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.shared.ServiceComputeUnitsToTokensMultiplierUpdate"))
+		}
+		panic(fmt.Errorf("message pocket.shared.ServiceComputeUnitsToTokensMultiplierUpdate does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ServiceComputeUnitsToTokensMultiplierUpdate) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "pocket.shared.ServiceComputeUnitsToTokensMultiplierUpdate.compute_units_to_tokens_multiplier":
+		x.ComputeUnitsToTokensMultiplier = value.Uint()
+	case "pocket.shared.ServiceComputeUnitsToTokensMultiplierUpdate.effective_block_height":
+		x.EffectiveBlockHeight = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.shared.ServiceComputeUnitsToTokensMultiplierUpdate"))
+		}
+		panic(fmt.Errorf("message pocket.shared.ServiceComputeUnitsToTokensMultiplierUpdate does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ServiceComputeUnitsToTokensMultiplierUpdate) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "pocket.shared.ServiceComputeUnitsToTokensMultiplierUpdate.compute_units_to_tokens_multiplier":
+		panic(fmt.Errorf("field compute_units_to_tokens_multiplier of message pocket.shared.ServiceComputeUnitsToTokensMultiplierUpdate is not mutable"))
+	case "pocket.shared.ServiceComputeUnitsToTokensMultiplierUpdate.effective_block_height":
+		panic(fmt.Errorf("field effective_block_height of message pocket.shared.ServiceComputeUnitsToTokensMultiplierUpdate is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.shared.ServiceComputeUnitsToTokensMultiplierUpdate"))
+		}
+		panic(fmt.Errorf("message pocket.shared.ServiceComputeUnitsToTokensMultiplierUpdate does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ServiceComputeUnitsToTokensMultiplierUpdate) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "pocket.shared.ServiceComputeUnitsToTokensMultiplierUpdate.compute_units_to_tokens_multiplier":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "pocket.shared.ServiceComputeUnitsToTokensMultiplierUpdate.effective_block_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.shared.ServiceComputeUnitsToTokensMultiplierUpdate"))
+		}
+		panic(fmt.Errorf("message pocket.shared.ServiceComputeUnitsToTokensMultiplierUpdate does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ServiceComputeUnitsToTokensMultiplierUpdate) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in pocket.shared.ServiceComputeUnitsToTokensMultiplierUpdate", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ServiceComputeUnitsToTokensMultiplierUpdate) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ServiceComputeUnitsToTokensMultiplierUpdate) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ServiceComputeUnitsToTokensMultiplierUpdate) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ServiceComputeUnitsToTokensMultiplierUpdate) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ServiceComputeUnitsToTokensMultiplierUpdate)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.ComputeUnitsToTokensMultiplier != 0 {
+			n += 1 + runtime.Sov(uint64(x.ComputeUnitsToTokensMultiplier))
+		}
+		if x.EffectiveBlockHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.EffectiveBlockHeight))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ServiceComputeUnitsToTokensMultiplierUpdate)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.EffectiveBlockHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.EffectiveBlockHeight))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.ComputeUnitsToTokensMultiplier != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ComputeUnitsToTokensMultiplier))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ServiceComputeUnitsToTokensMultiplierUpdate)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ServiceComputeUnitsToTokensMultiplierUpdate: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ServiceComputeUnitsToTokensMultiplierUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ComputeUnitsToTokensMultiplier", wireType)
+				}
+				x.ComputeUnitsToTokensMultiplier = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ComputeUnitsToTokensMultiplier |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EffectiveBlockHeight", wireType)
+				}
+				x.EffectiveBlockHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.EffectiveBlockHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ServiceOwnerRevSharePercentageUpdate                            protoreflect.MessageDescriptor
+	fd_ServiceOwnerRevSharePercentageUpdate_owner_rev_share_percentage protoreflect.FieldDescriptor
+	fd_ServiceOwnerRevSharePercentageUpdate_effective_block_height     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_pocket_shared_service_proto_init()
+	md_ServiceOwnerRevSharePercentageUpdate = File_pocket_shared_service_proto.Messages().ByName("ServiceOwnerRevSharePercentageUpdate")
+	fd_ServiceOwnerRevSharePercentageUpdate_owner_rev_share_percentage = md_ServiceOwnerRevSharePercentageUpdate.Fields().ByName("owner_rev_share_percentage")
+	fd_ServiceOwnerRevSharePercentageUpdate_effective_block_height = md_ServiceOwnerRevSharePercentageUpdate.Fields().ByName("effective_block_height")
+}
+
+var _ protoreflect.Message = (*fastReflection_ServiceOwnerRevSharePercentageUpdate)(nil)
+
+type fastReflection_ServiceOwnerRevSharePercentageUpdate ServiceOwnerRevSharePercentageUpdate
+
+func (x *ServiceOwnerRevSharePercentageUpdate) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ServiceOwnerRevSharePercentageUpdate)(x)
+}
+
+func (x *ServiceOwnerRevSharePercentageUpdate) slowProtoReflect() protoreflect.Message {
+	mi := &file_pocket_shared_service_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ServiceOwnerRevSharePercentageUpdate_messageType fastReflection_ServiceOwnerRevSharePercentageUpdate_messageType
+var _ protoreflect.MessageType = fastReflection_ServiceOwnerRevSharePercentageUpdate_messageType{}
+
+type fastReflection_ServiceOwnerRevSharePercentageUpdate_messageType struct{}
+
+func (x fastReflection_ServiceOwnerRevSharePercentageUpdate_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ServiceOwnerRevSharePercentageUpdate)(nil)
+}
+func (x fastReflection_ServiceOwnerRevSharePercentageUpdate_messageType) New() protoreflect.Message {
+	return new(fastReflection_ServiceOwnerRevSharePercentageUpdate)
+}
+func (x fastReflection_ServiceOwnerRevSharePercentageUpdate_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ServiceOwnerRevSharePercentageUpdate
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ServiceOwnerRevSharePercentageUpdate) Descriptor() protoreflect.MessageDescriptor {
+	return md_ServiceOwnerRevSharePercentageUpdate
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ServiceOwnerRevSharePercentageUpdate) Type() protoreflect.MessageType {
+	return _fastReflection_ServiceOwnerRevSharePercentageUpdate_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ServiceOwnerRevSharePercentageUpdate) New() protoreflect.Message {
+	return new(fastReflection_ServiceOwnerRevSharePercentageUpdate)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ServiceOwnerRevSharePercentageUpdate) Interface() protoreflect.ProtoMessage {
+	return (*ServiceOwnerRevSharePercentageUpdate)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ServiceOwnerRevSharePercentageUpdate) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.OwnerRevSharePercentage != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.OwnerRevSharePercentage)
+		if !f(fd_ServiceOwnerRevSharePercentageUpdate_owner_rev_share_percentage, value) {
+			return
+		}
+	}
+	if x.EffectiveBlockHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.EffectiveBlockHeight)
+		if !f(fd_ServiceOwnerRevSharePercentageUpdate_effective_block_height, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ServiceOwnerRevSharePercentageUpdate) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "pocket.shared.ServiceOwnerRevSharePercentageUpdate.owner_rev_share_percentage":
+		return x.OwnerRevSharePercentage != uint64(0)
+	case "pocket.shared.ServiceOwnerRevSharePercentageUpdate.effective_block_height":
+		return x.EffectiveBlockHeight != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.shared.ServiceOwnerRevSharePercentageUpdate"))
+		}
+		panic(fmt.Errorf("message pocket.shared.ServiceOwnerRevSharePercentageUpdate does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ServiceOwnerRevSharePercentageUpdate) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "pocket.shared.ServiceOwnerRevSharePercentageUpdate.owner_rev_share_percentage":
+		x.OwnerRevSharePercentage = uint64(0)
+	case "pocket.shared.ServiceOwnerRevSharePercentageUpdate.effective_block_height":
+		x.EffectiveBlockHeight = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.shared.ServiceOwnerRevSharePercentageUpdate"))
+		}
+		panic(fmt.Errorf("message pocket.shared.ServiceOwnerRevSharePercentageUpdate does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ServiceOwnerRevSharePercentageUpdate) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "pocket.shared.ServiceOwnerRevSharePercentageUpdate.owner_rev_share_percentage":
+		value := x.OwnerRevSharePercentage
+		return protoreflect.ValueOfUint64(value)
+	case "pocket.shared.ServiceOwnerRevSharePercentageUpdate.effective_block_height":
+		value := x.EffectiveBlockHeight
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.shared.ServiceOwnerRevSharePercentageUpdate"))
+		}
+		panic(fmt.Errorf("message pocket.shared.ServiceOwnerRevSharePercentageUpdate does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ServiceOwnerRevSharePercentageUpdate) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "pocket.shared.ServiceOwnerRevSharePercentageUpdate.owner_rev_share_percentage":
+		x.OwnerRevSharePercentage = value.Uint()
+	case "pocket.shared.ServiceOwnerRevSharePercentageUpdate.effective_block_height":
+		x.EffectiveBlockHeight = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.shared.ServiceOwnerRevSharePercentageUpdate"))
+		}
+		panic(fmt.Errorf("message pocket.shared.ServiceOwnerRevSharePercentageUpdate does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ServiceOwnerRevSharePercentageUpdate) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "pocket.shared.ServiceOwnerRevSharePercentageUpdate.owner_rev_share_percentage":
+		panic(fmt.Errorf("field owner_rev_share_percentage of message pocket.shared.ServiceOwnerRevSharePercentageUpdate is not mutable"))
+	case "pocket.shared.ServiceOwnerRevSharePercentageUpdate.effective_block_height":
+		panic(fmt.Errorf("field effective_block_height of message pocket.shared.ServiceOwnerRevSharePercentageUpdate is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.shared.ServiceOwnerRevSharePercentageUpdate"))
+		}
+		panic(fmt.Errorf("message pocket.shared.ServiceOwnerRevSharePercentageUpdate does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ServiceOwnerRevSharePercentageUpdate) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "pocket.shared.ServiceOwnerRevSharePercentageUpdate.owner_rev_share_percentage":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "pocket.shared.ServiceOwnerRevSharePercentageUpdate.effective_block_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.shared.ServiceOwnerRevSharePercentageUpdate"))
+		}
+		panic(fmt.Errorf("message pocket.shared.ServiceOwnerRevSharePercentageUpdate does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ServiceOwnerRevSharePercentageUpdate) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in pocket.shared.ServiceOwnerRevSharePercentageUpdate", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ServiceOwnerRevSharePercentageUpdate) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ServiceOwnerRevSharePercentageUpdate) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ServiceOwnerRevSharePercentageUpdate) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ServiceOwnerRevSharePercentageUpdate) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ServiceOwnerRevSharePercentageUpdate)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.OwnerRevSharePercentage != 0 {
+			n += 1 + runtime.Sov(uint64(x.OwnerRevSharePercentage))
 		}
-		l = len(x.OwnerAddress)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.EffectiveBlockHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.EffectiveBlockHeight))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -366,7 +1735,7 @@ func (x *fastReflection_Service) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Service)
+		x := input.Message.Interface().(*ServiceOwnerRevSharePercentageUpdate)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -385,31 +1754,15 @@ func (x *fastReflection_Service) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.OwnerAddress) > 0 {
-			i -= len(x.OwnerAddress)
-			copy(dAtA[i:], x.OwnerAddress)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OwnerAddress)))
-			i--
-			dAtA[i] = 0x22
-		}
-		if x.ComputeUnitsPerRelay != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.ComputeUnitsPerRelay))
-			i--
-			dAtA[i] = 0x18
-		}
-		if len(x.Name) > 0 {
-			i -= len(x.Name)
-			copy(dAtA[i:], x.Name)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Name)))
+		if x.EffectiveBlockHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.EffectiveBlockHeight))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x10
 		}
-		if len(x.Id) > 0 {
-			i -= len(x.Id)
-			copy(dAtA[i:], x.Id)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Id)))
+		if x.OwnerRevSharePercentage != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OwnerRevSharePercentage))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -422,7 +1775,7 @@ func (x *fastReflection_Service) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Service)
+		x := input.Message.Interface().(*ServiceOwnerRevSharePercentageUpdate)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -454,17 +1807,17 @@ func (x *fastReflection_Service) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Service: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ServiceOwnerRevSharePercentageUpdate: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Service: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ServiceOwnerRevSharePercentageUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OwnerRevSharePercentage", wireType)
 				}
-				var stringLen uint64
+				x.OwnerRevSharePercentage = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -474,80 +1827,16 @@ func (x *fastReflection_Service) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					x.OwnerRevSharePercentage |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Id = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
 			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Name = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 3:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ComputeUnitsPerRelay", wireType)
-				}
-				x.ComputeUnitsPerRelay = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.ComputeUnitsPerRelay |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 4:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EffectiveBlockHeight", wireType)
 				}
-				var stringLen uint64
+				x.EffectiveBlockHeight = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -557,24 +1846,11 @@ func (x *fastReflection_Service) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					x.EffectiveBlockHeight |= int64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.OwnerAddress = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -630,7 +1906,7 @@ func (x *ApplicationServiceConfig) ProtoReflect() protoreflect.Message {
 }
 
 func (x *ApplicationServiceConfig) slowProtoReflect() protoreflect.Message {
-	mi := &file_pocket_shared_service_proto_msgTypes[1]
+	mi := &file_pocket_shared_service_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1156,7 +2432,7 @@ func (x *SupplierServiceConfig) ProtoReflect() protoreflect.Message {
 }
 
 func (x *SupplierServiceConfig) slowProtoReflect() protoreflect.Message {
-	mi := &file_pocket_shared_service_proto_msgTypes[2]
+	mi := &file_pocket_shared_service_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1801,7 +3077,7 @@ func (x *SupplierEndpoint) ProtoReflect() protoreflect.Message {
 }
 
 func (x *SupplierEndpoint) slowProtoReflect() protoreflect.Message {
-	mi := &file_pocket_shared_service_proto_msgTypes[3]
+	mi := &file_pocket_shared_service_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2354,7 +3630,7 @@ func (x *ServiceRevenueShare) ProtoReflect() protoreflect.Message {
 }
 
 func (x *ServiceRevenueShare) slowProtoReflect() protoreflect.Message {
-	mi := &file_pocket_shared_service_proto_msgTypes[4]
+	mi := &file_pocket_shared_service_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2822,7 +4098,7 @@ func (x *ConfigOption) ProtoReflect() protoreflect.Message {
 }
 
 func (x *ConfigOption) slowProtoReflect() protoreflect.Message {
-	mi := &file_pocket_shared_service_proto_msgTypes[5]
+	mi := &file_pocket_shared_service_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3399,14 +4675,33 @@ type Service struct {
 	// TODO_POST_MAINNET: Rename service.name to service.description
 	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"` // (Optional) Human readable description of the service
 	// The cost of a single relay for this service in terms of compute units.
-	// Must be used alongside the global 'compute_units_to_tokens_multiplier' to calculate the cost of a relay for this service.
-	// cost_per_relay_for_specific_service = compute_units_per_relay_for_specific_service * compute_units_to_tokens_multiplier_global_value
+	// Must be used alongside the service 'compute_units_to_tokens_multiplier' (or the global one if unset) to calculate the cost of a relay for this service.
+	// cost_per_relay_for_specific_service = compute_units_per_relay_for_specific_service * compute_units_to_tokens_multiplier_for_specific_service
 	ComputeUnitsPerRelay uint64 `protobuf:"varint,3,opt,name=compute_units_per_relay,json=computeUnitsPerRelay,proto3" json:"compute_units_per_relay,omitempty"` // Compute units required per relay for this service
 	// The owner address that created the service.
 	// It is the address that receives rewards based on the Service's onchain usage
 	// It is the only address that can update the service configuration (e.g. compute_units_per_relay),
 	// or make other updates to it.
 	OwnerAddress string `protobuf:"bytes,4,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"` // The Bech32 address of the service owner / creator
+	// (Optional) The amount of uPOKT a compute unit of this service translates to when settling a session.
+	// If zero, the global 'compute_units_to_tokens_multiplier' shared param is used instead.
+	// It can be updated by the service owner or by governance, and is the latest value set,
+	// which applies from the session following its update (see compute_units_to_tokens_multiplier_history).
+	ComputeUnitsToTokensMultiplier uint64 `protobuf:"varint,5,opt,name=compute_units_to_tokens_multiplier,json=computeUnitsToTokensMultiplier,proto3" json:"compute_units_to_tokens_multiplier,omitempty"`
+	// (Optional) The percentage of each settled claim's amount for this service which is
+	// routed to the service owner by the TLM_SERVICE_OWNER_REV_SHARE token logic module.
+	// It is bounded by the governance controlled max_rev_share_percentage of the TLM.
+	// It can be updated by the service owner, and is the latest value set,
+	// which applies from the session following its update (see owner_rev_share_percentage_history).
+	OwnerRevSharePercentage uint64 `protobuf:"varint,6,opt,name=owner_rev_share_percentage,json=ownerRevSharePercentage,proto3" json:"owner_rev_share_percentage,omitempty"`
+	// The updates of the compute units to tokens multiplier, ordered by effective height,
+	// which the sessions that are not settled yet may still be settled with.
+	// Empty if the compute_units_to_tokens_multiplier was never updated.
+	ComputeUnitsToTokensMultiplierHistory []*ServiceComputeUnitsToTokensMultiplierUpdate `protobuf:"bytes,7,rep,name=compute_units_to_tokens_multiplier_history,json=computeUnitsToTokensMultiplierHistory,proto3" json:"compute_units_to_tokens_multiplier_history,omitempty"`
+	// The updates of the owner rev share percentage, ordered by effective height,
+	// which the sessions that are not settled yet may still be settled with.
+	// Empty if the owner_rev_share_percentage was never updated.
+	OwnerRevSharePercentageHistory []*ServiceOwnerRevSharePercentageUpdate `protobuf:"bytes,8,rep,name=owner_rev_share_percentage_history,json=ownerRevSharePercentageHistory,proto3" json:"owner_rev_share_percentage_history,omitempty"`
 }
 
 func (x *Service) Reset() {
@@ -3457,6 +4752,129 @@ func (x *Service) GetOwnerAddress() string {
 	return ""
 }
 
+func (x *Service) GetComputeUnitsToTokensMultiplier() uint64 {
+	if x != nil {
+		return x.ComputeUnitsToTokensMultiplier
+	}
+	return 0
+}
+
+func (x *Service) GetOwnerRevSharePercentage() uint64 {
+	if x != nil {
+		return x.OwnerRevSharePercentage
+	}
+	return 0
+}
+
+func (x *Service) GetComputeUnitsToTokensMultiplierHistory() []*ServiceComputeUnitsToTokensMultiplierUpdate {
+	if x != nil {
+		return x.ComputeUnitsToTokensMultiplierHistory
+	}
+	return nil
+}
+
+func (x *Service) GetOwnerRevSharePercentageHistory() []*ServiceOwnerRevSharePercentageUpdate {
+	if x != nil {
+		return x.OwnerRevSharePercentageHistory
+	}
+	return nil
+}
+
+// ServiceComputeUnitsToTokensMultiplierUpdate is a compute units to tokens multiplier
+// of a service along with the height from which it applies.
+type ServiceComputeUnitsToTokensMultiplierUpdate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The amount of uPOKT a compute unit of the service translates to, or zero
+	// to use the global 'compute_units_to_tokens_multiplier' shared param.
+	ComputeUnitsToTokensMultiplier uint64 `protobuf:"varint,1,opt,name=compute_units_to_tokens_multiplier,json=computeUnitsToTokensMultiplier,proto3" json:"compute_units_to_tokens_multiplier,omitempty"`
+	// The start height of the first session settled with this multiplier.
+	EffectiveBlockHeight int64 `protobuf:"varint,2,opt,name=effective_block_height,json=effectiveBlockHeight,proto3" json:"effective_block_height,omitempty"`
+}
+
+func (x *ServiceComputeUnitsToTokensMultiplierUpdate) Reset() {
+	*x = ServiceComputeUnitsToTokensMultiplierUpdate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pocket_shared_service_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServiceComputeUnitsToTokensMultiplierUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceComputeUnitsToTokensMultiplierUpdate) ProtoMessage() {}
+
+// Deprecated: Use ServiceComputeUnitsToTokensMultiplierUpdate.ProtoReflect.Descriptor instead.
+func (*ServiceComputeUnitsToTokensMultiplierUpdate) Descriptor() ([]byte, []int) {
+	return file_pocket_shared_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ServiceComputeUnitsToTokensMultiplierUpdate) GetComputeUnitsToTokensMultiplier() uint64 {
+	if x != nil {
+		return x.ComputeUnitsToTokensMultiplier
+	}
+	return 0
+}
+
+func (x *ServiceComputeUnitsToTokensMultiplierUpdate) GetEffectiveBlockHeight() int64 {
+	if x != nil {
+		return x.EffectiveBlockHeight
+	}
+	return 0
+}
+
+// ServiceOwnerRevSharePercentageUpdate is an owner rev share percentage of a
+// service along with the height from which it applies.
+type ServiceOwnerRevSharePercentageUpdate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The percentage of each settled claim's amount which is routed to the service owner.
+	OwnerRevSharePercentage uint64 `protobuf:"varint,1,opt,name=owner_rev_share_percentage,json=ownerRevSharePercentage,proto3" json:"owner_rev_share_percentage,omitempty"`
+	// The start height of the first session settled with this percentage.
+	EffectiveBlockHeight int64 `protobuf:"varint,2,opt,name=effective_block_height,json=effectiveBlockHeight,proto3" json:"effective_block_height,omitempty"`
+}
+
+func (x *ServiceOwnerRevSharePercentageUpdate) Reset() {
+	*x = ServiceOwnerRevSharePercentageUpdate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pocket_shared_service_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServiceOwnerRevSharePercentageUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceOwnerRevSharePercentageUpdate) ProtoMessage() {}
+
+// Deprecated: Use ServiceOwnerRevSharePercentageUpdate.ProtoReflect.Descriptor instead.
+func (*ServiceOwnerRevSharePercentageUpdate) Descriptor() ([]byte, []int) {
+	return file_pocket_shared_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ServiceOwnerRevSharePercentageUpdate) GetOwnerRevSharePercentage() uint64 {
+	if x != nil {
+		return x.OwnerRevSharePercentage
+	}
+	return 0
+}
+
+func (x *ServiceOwnerRevSharePercentageUpdate) GetEffectiveBlockHeight() int64 {
+	if x != nil {
+		return x.EffectiveBlockHeight
+	}
+	return 0
+}
+
 // ApplicationServiceConfig holds the service configuration the application stakes for
 type ApplicationServiceConfig struct {
 	state         protoimpl.MessageState
@@ -3469,7 +4887,7 @@ type ApplicationServiceConfig struct {
 func (x *ApplicationServiceConfig) Reset() {
 	*x = ApplicationServiceConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pocket_shared_service_proto_msgTypes[1]
+		mi := &file_pocket_shared_service_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3483,7 +4901,7 @@ func (*ApplicationServiceConfig) ProtoMessage() {}
 
 // Deprecated: Use ApplicationServiceConfig.ProtoReflect.Descriptor instead.
 func (*ApplicationServiceConfig) Descriptor() ([]byte, []int) {
-	return file_pocket_shared_service_proto_rawDescGZIP(), []int{1}
+	return file_pocket_shared_service_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *ApplicationServiceConfig) GetServiceId() string {
@@ -3507,7 +4925,7 @@ type SupplierServiceConfig struct {
 func (x *SupplierServiceConfig) Reset() {
 	*x = SupplierServiceConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pocket_shared_service_proto_msgTypes[2]
+		mi := &file_pocket_shared_service_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3521,7 +4939,7 @@ func (*SupplierServiceConfig) ProtoMessage() {}
 
 // Deprecated: Use SupplierServiceConfig.ProtoReflect.Descriptor instead.
 func (*SupplierServiceConfig) Descriptor() ([]byte, []int) {
-	return file_pocket_shared_service_proto_rawDescGZIP(), []int{2}
+	return file_pocket_shared_service_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *SupplierServiceConfig) GetServiceId() string {
@@ -3559,7 +4977,7 @@ type SupplierEndpoint struct {
 func (x *SupplierEndpoint) Reset() {
 	*x = SupplierEndpoint{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pocket_shared_service_proto_msgTypes[3]
+		mi := &file_pocket_shared_service_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3573,7 +4991,7 @@ func (*SupplierEndpoint) ProtoMessage() {}
 
 // Deprecated: Use SupplierEndpoint.ProtoReflect.Descriptor instead.
 func (*SupplierEndpoint) Descriptor() ([]byte, []int) {
-	return file_pocket_shared_service_proto_rawDescGZIP(), []int{3}
+	return file_pocket_shared_service_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *SupplierEndpoint) GetUrl() string {
@@ -3610,7 +5028,7 @@ type ServiceRevenueShare struct {
 func (x *ServiceRevenueShare) Reset() {
 	*x = ServiceRevenueShare{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pocket_shared_service_proto_msgTypes[4]
+		mi := &file_pocket_shared_service_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3624,7 +5042,7 @@ func (*ServiceRevenueShare) ProtoMessage() {}
 
 // Deprecated: Use ServiceRevenueShare.ProtoReflect.Descriptor instead.
 func (*ServiceRevenueShare) Descriptor() ([]byte, []int) {
-	return file_pocket_shared_service_proto_rawDescGZIP(), []int{4}
+	return file_pocket_shared_service_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *ServiceRevenueShare) GetAddress() string {
@@ -3654,7 +5072,7 @@ type ConfigOption struct {
 func (x *ConfigOption) Reset() {
 	*x = ConfigOption{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pocket_shared_service_proto_msgTypes[5]
+		mi := &file_pocket_shared_service_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3668,7 +5086,7 @@ func (*ConfigOption) ProtoMessage() {}
 
 // Deprecated: Use ConfigOption.ProtoReflect.Descriptor instead.
 func (*ConfigOption) Descriptor() ([]byte, []int) {
-	return file_pocket_shared_service_proto_rawDescGZIP(), []int{5}
+	return file_pocket_shared_service_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *ConfigOption) GetKey() ConfigOptions {
@@ -3693,7 +5111,7 @@ var file_pocket_shared_service_proto_rawDesc = []byte{
 	0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x1a, 0x19, 0x63, 0x6f,
 	0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
 	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa3, 0x01,
+	0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc5, 0x04,
 	0x0a, 0x07, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
 	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x35, 0x0a,
@@ -3704,7 +5122,54 @@ var file_pocket_shared_service_proto_rawDesc = []byte{
 	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d,
 	0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53,
 	0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0c, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x22, 0x39, 0x0a, 0x18, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x65, 0x73, 0x73, 0x12, 0x4a, 0x0a, 0x22, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x5f, 0x75,
+	0x6e, 0x69, 0x74, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x5f, 0x6d,
+	0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x1e, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x54, 0x6f, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x12,
+	0x3b, 0x0a, 0x1a, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x76, 0x5f, 0x73, 0x68, 0x61,
+	0x72, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x17, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x76, 0x53, 0x68, 0x61,
+	0x72, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x95, 0x01, 0x0a,
+	0x2a, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x5f, 0x74,
+	0x6f, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c,
+	0x69, 0x65, 0x72, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x07, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x3a, 0x2e, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65,
+	0x64, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65,
+	0x55, 0x6e, 0x69, 0x74, 0x73, 0x54, 0x6f, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x4d, 0x75, 0x6c,
+	0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x25, 0x63,
+	0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x54, 0x6f, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x73, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x48, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x12, 0x7f, 0x0a, 0x22, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x72, 0x65,
+	0x76, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61,
+	0x67, 0x65, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x33, 0x2e, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64,
+	0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x76,
+	0x53, 0x68, 0x61, 0x72, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x1e, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x76, 0x53,
+	0x68, 0x61, 0x72, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x22, 0xaf, 0x01, 0x0a, 0x2b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x43, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x54, 0x6f, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x4a, 0x0a, 0x22, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65,
+	0x5f, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73,
+	0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x1e, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x54,
+	0x6f, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65,
+	0x72, 0x12, 0x34, 0x0a, 0x16, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x14, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x99, 0x01, 0x0a, 0x24, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x76, 0x53, 0x68, 0x61, 0x72, 0x65,
+	0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x12, 0x3b, 0x0a, 0x1a, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x76, 0x5f, 0x73, 0x68,
+	0x61, 0x72, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x17, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x76, 0x53, 0x68,
+	0x61, 0x72, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x34, 0x0a,
+	0x16, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14, 0x65,
+	0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x22, 0x39, 0x0a, 0x18, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
 	0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
 	0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x22, 0xb6,
@@ -3750,17 +5215,9 @@ var file_pocket_shared_service_proto_rawDesc = []byte{
 	0x30, 0x0a, 0x0d, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
 	0x12, 0x12, 0x0a, 0x0e, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x43, 0x4f, 0x4e, 0x46,
 	0x49, 0x47, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x54, 0x49, 0x4d, 0x45, 0x4f, 0x55, 0x54, 0x10,
-	0x01, 0x42, 0x9a, 0x01, 0xd8, 0xe2, 0x1e, 0x01, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x2e, 0x70, 0x6f,
-	0x63, 0x6b, 0x65, 0x74, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x42, 0x0c, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x1e, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x6f,
-	0x63, 0x6b, 0x65, 0x74, 0x2f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0xa2, 0x02, 0x03, 0x50, 0x53,
-	0x58, 0xaa, 0x02, 0x0d, 0x50, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65,
-	0x64, 0xca, 0x02, 0x0d, 0x50, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x5c, 0x53, 0x68, 0x61, 0x72, 0x65,
-	0x64, 0xe2, 0x02, 0x19, 0x50, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x5c, 0x53, 0x68, 0x61, 0x72, 0x65,
-	0x64, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0e,
-	0x50, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x3a, 0x3a, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x01, 0x42, 0x24, 0xd8, 0xe2, 0x1e, 0x01, 0x5a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
+	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74,
+	0x2f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -3776,28 +5233,32 @@ func file_pocket_shared_service_proto_rawDescGZIP() []byte {
 }
 
 var file_pocket_shared_service_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_pocket_shared_service_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_pocket_shared_service_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_pocket_shared_service_proto_goTypes = []interface{}{
-	(RPCType)(0),                     // 0: pocket.shared.RPCType
-	(ConfigOptions)(0),               // 1: pocket.shared.ConfigOptions
-	(*Service)(nil),                  // 2: pocket.shared.Service
-	(*ApplicationServiceConfig)(nil), // 3: pocket.shared.ApplicationServiceConfig
-	(*SupplierServiceConfig)(nil),    // 4: pocket.shared.SupplierServiceConfig
-	(*SupplierEndpoint)(nil),         // 5: pocket.shared.SupplierEndpoint
-	(*ServiceRevenueShare)(nil),      // 6: pocket.shared.ServiceRevenueShare
-	(*ConfigOption)(nil),             // 7: pocket.shared.ConfigOption
+	(RPCType)(0),       // 0: pocket.shared.RPCType
+	(ConfigOptions)(0), // 1: pocket.shared.ConfigOptions
+	(*Service)(nil),    // 2: pocket.shared.Service
+	(*ServiceComputeUnitsToTokensMultiplierUpdate)(nil), // 3: pocket.shared.ServiceComputeUnitsToTokensMultiplierUpdate
+	(*ServiceOwnerRevSharePercentageUpdate)(nil),        // 4: pocket.shared.ServiceOwnerRevSharePercentageUpdate
+	(*ApplicationServiceConfig)(nil),                    // 5: pocket.shared.ApplicationServiceConfig
+	(*SupplierServiceConfig)(nil),                       // 6: pocket.shared.SupplierServiceConfig
+	(*SupplierEndpoint)(nil),                            // 7: pocket.shared.SupplierEndpoint
+	(*ServiceRevenueShare)(nil),                         // 8: pocket.shared.ServiceRevenueShare
+	(*ConfigOption)(nil),                                // 9: pocket.shared.ConfigOption
 }
 var file_pocket_shared_service_proto_depIdxs = []int32{
-	5, // 0: pocket.shared.SupplierServiceConfig.endpoints:type_name -> pocket.shared.SupplierEndpoint
-	6, // 1: pocket.shared.SupplierServiceConfig.rev_share:type_name -> pocket.shared.ServiceRevenueShare
-	0, // 2: pocket.shared.SupplierEndpoint.rpc_type:type_name -> pocket.shared.RPCType
-	7, // 3: pocket.shared.SupplierEndpoint.configs:type_name -> pocket.shared.ConfigOption
-	1, // 4: pocket.shared.ConfigOption.key:type_name -> pocket.shared.ConfigOptions
-	5, // [5:5] is the sub-list for method output_type
-	5, // [5:5] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	3, // 0: pocket.shared.Service.compute_units_to_tokens_multiplier_history:type_name -> pocket.shared.ServiceComputeUnitsToTokensMultiplierUpdate
+	4, // 1: pocket.shared.Service.owner_rev_share_percentage_history:type_name -> pocket.shared.ServiceOwnerRevSharePercentageUpdate
+	7, // 2: pocket.shared.SupplierServiceConfig.endpoints:type_name -> pocket.shared.SupplierEndpoint
+	8, // 3: pocket.shared.SupplierServiceConfig.rev_share:type_name -> pocket.shared.ServiceRevenueShare
+	0, // 4: pocket.shared.SupplierEndpoint.rpc_type:type_name -> pocket.shared.RPCType
+	9, // 5: pocket.shared.SupplierEndpoint.configs:type_name -> pocket.shared.ConfigOption
+	1, // 6: pocket.shared.ConfigOption.key:type_name -> pocket.shared.ConfigOptions
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_pocket_shared_service_proto_init() }
@@ -3819,7 +5280,7 @@ func file_pocket_shared_service_proto_init() {
 			}
 		}
 		file_pocket_shared_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ApplicationServiceConfig); i {
+			switch v := v.(*ServiceComputeUnitsToTokensMultiplierUpdate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3831,7 +5292,7 @@ func file_pocket_shared_service_proto_init() {
 			}
 		}
 		file_pocket_shared_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SupplierServiceConfig); i {
+			switch v := v.(*ServiceOwnerRevSharePercentageUpdate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3843,7 +5304,7 @@ func file_pocket_shared_service_proto_init() {
 			}
 		}
 		file_pocket_shared_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SupplierEndpoint); i {
+			switch v := v.(*ApplicationServiceConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3855,7 +5316,7 @@ func file_pocket_shared_service_proto_init() {
 			}
 		}
 		file_pocket_shared_service_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ServiceRevenueShare); i {
+			switch v := v.(*SupplierServiceConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3867,6 +5328,30 @@ func file_pocket_shared_service_proto_init() {
 			}
 		}
 		file_pocket_shared_service_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SupplierEndpoint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pocket_shared_service_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServiceRevenueShare); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pocket_shared_service_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ConfigOption); i {
 			case 0:
 				return &v.state
@@ -3885,7 +5370,7 @@ func file_pocket_shared_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_pocket_shared_service_proto_rawDesc,
 			NumEnums:      2,
-			NumMessages:   6,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
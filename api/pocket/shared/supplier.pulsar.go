@@ -117,14 +117,119 @@ func (x *_Supplier_6_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_Supplier_8_list)(nil)
+
+type _Supplier_8_list struct {
+	list *[]*SupplierJailPeriod
+}
+
+func (x *_Supplier_8_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Supplier_8_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Supplier_8_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SupplierJailPeriod)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Supplier_8_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SupplierJailPeriod)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Supplier_8_list) AppendMutable() protoreflect.Value {
+	v := new(SupplierJailPeriod)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Supplier_8_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Supplier_8_list) NewElement() protoreflect.Value {
+	v := new(SupplierJailPeriod)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Supplier_8_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Supplier_11_list)(nil)
+
+type _Supplier_11_list struct {
+	list *[]*SupplierDelegationCommissionUpdate
+}
+
+func (x *_Supplier_11_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Supplier_11_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Supplier_11_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SupplierDelegationCommissionUpdate)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Supplier_11_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SupplierDelegationCommissionUpdate)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Supplier_11_list) AppendMutable() protoreflect.Value {
+	v := new(SupplierDelegationCommissionUpdate)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Supplier_11_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Supplier_11_list) NewElement() protoreflect.Value {
+	v := new(SupplierDelegationCommissionUpdate)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Supplier_11_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_Supplier                            protoreflect.MessageDescriptor
-	fd_Supplier_owner_address              protoreflect.FieldDescriptor
-	fd_Supplier_operator_address           protoreflect.FieldDescriptor
-	fd_Supplier_stake                      protoreflect.FieldDescriptor
-	fd_Supplier_services                   protoreflect.FieldDescriptor
-	fd_Supplier_unstake_session_end_height protoreflect.FieldDescriptor
-	fd_Supplier_service_config_history     protoreflect.FieldDescriptor
+	md_Supplier                                  protoreflect.MessageDescriptor
+	fd_Supplier_owner_address                    protoreflect.FieldDescriptor
+	fd_Supplier_operator_address                 protoreflect.FieldDescriptor
+	fd_Supplier_stake                            protoreflect.FieldDescriptor
+	fd_Supplier_services                         protoreflect.FieldDescriptor
+	fd_Supplier_unstake_session_end_height       protoreflect.FieldDescriptor
+	fd_Supplier_service_config_history           protoreflect.FieldDescriptor
+	fd_Supplier_delegation_commission_percentage protoreflect.FieldDescriptor
+	fd_Supplier_jail_history                     protoreflect.FieldDescriptor
+	fd_Supplier_delegation_commission_history    protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -136,6 +241,9 @@ func init() {
 	fd_Supplier_services = md_Supplier.Fields().ByName("services")
 	fd_Supplier_unstake_session_end_height = md_Supplier.Fields().ByName("unstake_session_end_height")
 	fd_Supplier_service_config_history = md_Supplier.Fields().ByName("service_config_history")
+	fd_Supplier_delegation_commission_percentage = md_Supplier.Fields().ByName("delegation_commission_percentage")
+	fd_Supplier_jail_history = md_Supplier.Fields().ByName("jail_history")
+	fd_Supplier_delegation_commission_history = md_Supplier.Fields().ByName("delegation_commission_history")
 }
 
 var _ protoreflect.Message = (*fastReflection_Supplier)(nil)
@@ -239,6 +347,24 @@ func (x *fastReflection_Supplier) Range(f func(protoreflect.FieldDescriptor, pro
 			return
 		}
 	}
+	if x.DelegationCommissionPercentage != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.DelegationCommissionPercentage)
+		if !f(fd_Supplier_delegation_commission_percentage, value) {
+			return
+		}
+	}
+	if len(x.JailHistory) != 0 {
+		value := protoreflect.ValueOfList(&_Supplier_8_list{list: &x.JailHistory})
+		if !f(fd_Supplier_jail_history, value) {
+			return
+		}
+	}
+	if len(x.DelegationCommissionHistory) != 0 {
+		value := protoreflect.ValueOfList(&_Supplier_11_list{list: &x.DelegationCommissionHistory})
+		if !f(fd_Supplier_delegation_commission_history, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -266,6 +392,12 @@ func (x *fastReflection_Supplier) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.UnstakeSessionEndHeight != uint64(0)
 	case "pocket.shared.Supplier.service_config_history":
 		return len(x.ServiceConfigHistory) != 0
+	case "pocket.shared.Supplier.delegation_commission_percentage":
+		return x.DelegationCommissionPercentage != uint64(0)
+	case "pocket.shared.Supplier.jail_history":
+		return len(x.JailHistory) != 0
+	case "pocket.shared.Supplier.delegation_commission_history":
+		return len(x.DelegationCommissionHistory) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.shared.Supplier"))
@@ -294,6 +426,12 @@ func (x *fastReflection_Supplier) Clear(fd protoreflect.FieldDescriptor) {
 		x.UnstakeSessionEndHeight = uint64(0)
 	case "pocket.shared.Supplier.service_config_history":
 		x.ServiceConfigHistory = nil
+	case "pocket.shared.Supplier.delegation_commission_percentage":
+		x.DelegationCommissionPercentage = uint64(0)
+	case "pocket.shared.Supplier.jail_history":
+		x.JailHistory = nil
+	case "pocket.shared.Supplier.delegation_commission_history":
+		x.DelegationCommissionHistory = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.shared.Supplier"))
@@ -334,6 +472,21 @@ func (x *fastReflection_Supplier) Get(descriptor protoreflect.FieldDescriptor) p
 		}
 		listValue := &_Supplier_6_list{list: &x.ServiceConfigHistory}
 		return protoreflect.ValueOfList(listValue)
+	case "pocket.shared.Supplier.delegation_commission_percentage":
+		value := x.DelegationCommissionPercentage
+		return protoreflect.ValueOfUint64(value)
+	case "pocket.shared.Supplier.jail_history":
+		if len(x.JailHistory) == 0 {
+			return protoreflect.ValueOfList(&_Supplier_8_list{})
+		}
+		listValue := &_Supplier_8_list{list: &x.JailHistory}
+		return protoreflect.ValueOfList(listValue)
+	case "pocket.shared.Supplier.delegation_commission_history":
+		if len(x.DelegationCommissionHistory) == 0 {
+			return protoreflect.ValueOfList(&_Supplier_11_list{})
+		}
+		listValue := &_Supplier_11_list{list: &x.DelegationCommissionHistory}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.shared.Supplier"))
@@ -370,6 +523,16 @@ func (x *fastReflection_Supplier) Set(fd protoreflect.FieldDescriptor, value pro
 		lv := value.List()
 		clv := lv.(*_Supplier_6_list)
 		x.ServiceConfigHistory = *clv.list
+	case "pocket.shared.Supplier.delegation_commission_percentage":
+		x.DelegationCommissionPercentage = value.Uint()
+	case "pocket.shared.Supplier.jail_history":
+		lv := value.List()
+		clv := lv.(*_Supplier_8_list)
+		x.JailHistory = *clv.list
+	case "pocket.shared.Supplier.delegation_commission_history":
+		lv := value.List()
+		clv := lv.(*_Supplier_11_list)
+		x.DelegationCommissionHistory = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.shared.Supplier"))
@@ -407,12 +570,26 @@ func (x *fastReflection_Supplier) Mutable(fd protoreflect.FieldDescriptor) proto
 		}
 		value := &_Supplier_6_list{list: &x.ServiceConfigHistory}
 		return protoreflect.ValueOfList(value)
+	case "pocket.shared.Supplier.jail_history":
+		if x.JailHistory == nil {
+			x.JailHistory = []*SupplierJailPeriod{}
+		}
+		value := &_Supplier_8_list{list: &x.JailHistory}
+		return protoreflect.ValueOfList(value)
+	case "pocket.shared.Supplier.delegation_commission_history":
+		if x.DelegationCommissionHistory == nil {
+			x.DelegationCommissionHistory = []*SupplierDelegationCommissionUpdate{}
+		}
+		value := &_Supplier_11_list{list: &x.DelegationCommissionHistory}
+		return protoreflect.ValueOfList(value)
 	case "pocket.shared.Supplier.owner_address":
 		panic(fmt.Errorf("field owner_address of message pocket.shared.Supplier is not mutable"))
 	case "pocket.shared.Supplier.operator_address":
 		panic(fmt.Errorf("field operator_address of message pocket.shared.Supplier is not mutable"))
 	case "pocket.shared.Supplier.unstake_session_end_height":
 		panic(fmt.Errorf("field unstake_session_end_height of message pocket.shared.Supplier is not mutable"))
+	case "pocket.shared.Supplier.delegation_commission_percentage":
+		panic(fmt.Errorf("field delegation_commission_percentage of message pocket.shared.Supplier is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.shared.Supplier"))
@@ -441,6 +618,14 @@ func (x *fastReflection_Supplier) NewField(fd protoreflect.FieldDescriptor) prot
 	case "pocket.shared.Supplier.service_config_history":
 		list := []*ServiceConfigUpdate{}
 		return protoreflect.ValueOfList(&_Supplier_6_list{list: &list})
+	case "pocket.shared.Supplier.delegation_commission_percentage":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "pocket.shared.Supplier.jail_history":
+		list := []*SupplierJailPeriod{}
+		return protoreflect.ValueOfList(&_Supplier_8_list{list: &list})
+	case "pocket.shared.Supplier.delegation_commission_history":
+		list := []*SupplierDelegationCommissionUpdate{}
+		return protoreflect.ValueOfList(&_Supplier_11_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.shared.Supplier"))
@@ -537,6 +722,21 @@ func (x *fastReflection_Supplier) ProtoMethods() *protoiface.Methods {
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		if x.DelegationCommissionPercentage != 0 {
+			n += 1 + runtime.Sov(uint64(x.DelegationCommissionPercentage))
+		}
+		if len(x.JailHistory) > 0 {
+			for _, e := range x.JailHistory {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.DelegationCommissionHistory) > 0 {
+			for _, e := range x.DelegationCommissionHistory {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -566,6 +766,43 @@ func (x *fastReflection_Supplier) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.DelegationCommissionHistory) > 0 {
+			for iNdEx := len(x.DelegationCommissionHistory) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.DelegationCommissionHistory[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x5a
+			}
+		}
+		if len(x.JailHistory) > 0 {
+			for iNdEx := len(x.JailHistory) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.JailHistory[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x42
+			}
+		}
+		if x.DelegationCommissionPercentage != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DelegationCommissionPercentage))
+			i--
+			dAtA[i] = 0x38
+		}
 		if len(x.ServiceConfigHistory) > 0 {
 			for iNdEx := len(x.ServiceConfigHistory) - 1; iNdEx >= 0; iNdEx-- {
 				encoded, err := options.Marshal(x.ServiceConfigHistory[iNdEx])
@@ -806,19 +1043,1073 @@ func (x *fastReflection_Supplier) ProtoMethods() *protoiface.Methods {
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if postIndex > l {
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Services = append(x.Services, &SupplierServiceConfig{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Services[len(x.Services)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UnstakeSessionEndHeight", wireType)
+				}
+				x.UnstakeSessionEndHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.UnstakeSessionEndHeight |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ServiceConfigHistory", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ServiceConfigHistory = append(x.ServiceConfigHistory, &ServiceConfigUpdate{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ServiceConfigHistory[len(x.ServiceConfigHistory)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DelegationCommissionPercentage", wireType)
+				}
+				x.DelegationCommissionPercentage = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DelegationCommissionPercentage |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field JailHistory", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.JailHistory = append(x.JailHistory, &SupplierJailPeriod{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.JailHistory[len(x.JailHistory)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DelegationCommissionHistory", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DelegationCommissionHistory = append(x.DelegationCommissionHistory, &SupplierDelegationCommissionUpdate{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DelegationCommissionHistory[len(x.DelegationCommissionHistory)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_SupplierJailPeriod                   protoreflect.MessageDescriptor
+	fd_SupplierJailPeriod_jail_start_height protoreflect.FieldDescriptor
+	fd_SupplierJailPeriod_jail_end_height   protoreflect.FieldDescriptor
+	fd_SupplierJailPeriod_unjail_height     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_pocket_shared_supplier_proto_init()
+	md_SupplierJailPeriod = File_pocket_shared_supplier_proto.Messages().ByName("SupplierJailPeriod")
+	fd_SupplierJailPeriod_jail_start_height = md_SupplierJailPeriod.Fields().ByName("jail_start_height")
+	fd_SupplierJailPeriod_jail_end_height = md_SupplierJailPeriod.Fields().ByName("jail_end_height")
+	fd_SupplierJailPeriod_unjail_height = md_SupplierJailPeriod.Fields().ByName("unjail_height")
+}
+
+var _ protoreflect.Message = (*fastReflection_SupplierJailPeriod)(nil)
+
+type fastReflection_SupplierJailPeriod SupplierJailPeriod
+
+func (x *SupplierJailPeriod) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SupplierJailPeriod)(x)
+}
+
+func (x *SupplierJailPeriod) slowProtoReflect() protoreflect.Message {
+	mi := &file_pocket_shared_supplier_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_SupplierJailPeriod_messageType fastReflection_SupplierJailPeriod_messageType
+var _ protoreflect.MessageType = fastReflection_SupplierJailPeriod_messageType{}
+
+type fastReflection_SupplierJailPeriod_messageType struct{}
+
+func (x fastReflection_SupplierJailPeriod_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SupplierJailPeriod)(nil)
+}
+func (x fastReflection_SupplierJailPeriod_messageType) New() protoreflect.Message {
+	return new(fastReflection_SupplierJailPeriod)
+}
+func (x fastReflection_SupplierJailPeriod_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SupplierJailPeriod
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_SupplierJailPeriod) Descriptor() protoreflect.MessageDescriptor {
+	return md_SupplierJailPeriod
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_SupplierJailPeriod) Type() protoreflect.MessageType {
+	return _fastReflection_SupplierJailPeriod_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_SupplierJailPeriod) New() protoreflect.Message {
+	return new(fastReflection_SupplierJailPeriod)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_SupplierJailPeriod) Interface() protoreflect.ProtoMessage {
+	return (*SupplierJailPeriod)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_SupplierJailPeriod) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.JailStartHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.JailStartHeight)
+		if !f(fd_SupplierJailPeriod_jail_start_height, value) {
+			return
+		}
+	}
+	if x.JailEndHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.JailEndHeight)
+		if !f(fd_SupplierJailPeriod_jail_end_height, value) {
+			return
+		}
+	}
+	if x.UnjailHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.UnjailHeight)
+		if !f(fd_SupplierJailPeriod_unjail_height, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_SupplierJailPeriod) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "pocket.shared.SupplierJailPeriod.jail_start_height":
+		return x.JailStartHeight != int64(0)
+	case "pocket.shared.SupplierJailPeriod.jail_end_height":
+		return x.JailEndHeight != int64(0)
+	case "pocket.shared.SupplierJailPeriod.unjail_height":
+		return x.UnjailHeight != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.shared.SupplierJailPeriod"))
+		}
+		panic(fmt.Errorf("message pocket.shared.SupplierJailPeriod does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SupplierJailPeriod) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "pocket.shared.SupplierJailPeriod.jail_start_height":
+		x.JailStartHeight = int64(0)
+	case "pocket.shared.SupplierJailPeriod.jail_end_height":
+		x.JailEndHeight = int64(0)
+	case "pocket.shared.SupplierJailPeriod.unjail_height":
+		x.UnjailHeight = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.shared.SupplierJailPeriod"))
+		}
+		panic(fmt.Errorf("message pocket.shared.SupplierJailPeriod does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_SupplierJailPeriod) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "pocket.shared.SupplierJailPeriod.jail_start_height":
+		value := x.JailStartHeight
+		return protoreflect.ValueOfInt64(value)
+	case "pocket.shared.SupplierJailPeriod.jail_end_height":
+		value := x.JailEndHeight
+		return protoreflect.ValueOfInt64(value)
+	case "pocket.shared.SupplierJailPeriod.unjail_height":
+		value := x.UnjailHeight
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.shared.SupplierJailPeriod"))
+		}
+		panic(fmt.Errorf("message pocket.shared.SupplierJailPeriod does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SupplierJailPeriod) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "pocket.shared.SupplierJailPeriod.jail_start_height":
+		x.JailStartHeight = value.Int()
+	case "pocket.shared.SupplierJailPeriod.jail_end_height":
+		x.JailEndHeight = value.Int()
+	case "pocket.shared.SupplierJailPeriod.unjail_height":
+		x.UnjailHeight = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.shared.SupplierJailPeriod"))
+		}
+		panic(fmt.Errorf("message pocket.shared.SupplierJailPeriod does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SupplierJailPeriod) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "pocket.shared.SupplierJailPeriod.jail_start_height":
+		panic(fmt.Errorf("field jail_start_height of message pocket.shared.SupplierJailPeriod is not mutable"))
+	case "pocket.shared.SupplierJailPeriod.jail_end_height":
+		panic(fmt.Errorf("field jail_end_height of message pocket.shared.SupplierJailPeriod is not mutable"))
+	case "pocket.shared.SupplierJailPeriod.unjail_height":
+		panic(fmt.Errorf("field unjail_height of message pocket.shared.SupplierJailPeriod is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.shared.SupplierJailPeriod"))
+		}
+		panic(fmt.Errorf("message pocket.shared.SupplierJailPeriod does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_SupplierJailPeriod) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "pocket.shared.SupplierJailPeriod.jail_start_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "pocket.shared.SupplierJailPeriod.jail_end_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "pocket.shared.SupplierJailPeriod.unjail_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.shared.SupplierJailPeriod"))
+		}
+		panic(fmt.Errorf("message pocket.shared.SupplierJailPeriod does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_SupplierJailPeriod) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in pocket.shared.SupplierJailPeriod", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_SupplierJailPeriod) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SupplierJailPeriod) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_SupplierJailPeriod) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_SupplierJailPeriod) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*SupplierJailPeriod)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.JailStartHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.JailStartHeight))
+		}
+		if x.JailEndHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.JailEndHeight))
+		}
+		if x.UnjailHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.UnjailHeight))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*SupplierJailPeriod)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.UnjailHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.UnjailHeight))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.JailEndHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.JailEndHeight))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.JailStartHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.JailStartHeight))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*SupplierJailPeriod)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SupplierJailPeriod: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SupplierJailPeriod: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field JailStartHeight", wireType)
+				}
+				x.JailStartHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.JailStartHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field JailEndHeight", wireType)
+				}
+				x.JailEndHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.JailEndHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UnjailHeight", wireType)
+				}
+				x.UnjailHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.UnjailHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_SupplierDelegationCommissionUpdate                                  protoreflect.MessageDescriptor
+	fd_SupplierDelegationCommissionUpdate_delegation_commission_percentage protoreflect.FieldDescriptor
+	fd_SupplierDelegationCommissionUpdate_effective_block_height           protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_pocket_shared_supplier_proto_init()
+	md_SupplierDelegationCommissionUpdate = File_pocket_shared_supplier_proto.Messages().ByName("SupplierDelegationCommissionUpdate")
+	fd_SupplierDelegationCommissionUpdate_delegation_commission_percentage = md_SupplierDelegationCommissionUpdate.Fields().ByName("delegation_commission_percentage")
+	fd_SupplierDelegationCommissionUpdate_effective_block_height = md_SupplierDelegationCommissionUpdate.Fields().ByName("effective_block_height")
+}
+
+var _ protoreflect.Message = (*fastReflection_SupplierDelegationCommissionUpdate)(nil)
+
+type fastReflection_SupplierDelegationCommissionUpdate SupplierDelegationCommissionUpdate
+
+func (x *SupplierDelegationCommissionUpdate) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SupplierDelegationCommissionUpdate)(x)
+}
+
+func (x *SupplierDelegationCommissionUpdate) slowProtoReflect() protoreflect.Message {
+	mi := &file_pocket_shared_supplier_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_SupplierDelegationCommissionUpdate_messageType fastReflection_SupplierDelegationCommissionUpdate_messageType
+var _ protoreflect.MessageType = fastReflection_SupplierDelegationCommissionUpdate_messageType{}
+
+type fastReflection_SupplierDelegationCommissionUpdate_messageType struct{}
+
+func (x fastReflection_SupplierDelegationCommissionUpdate_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SupplierDelegationCommissionUpdate)(nil)
+}
+func (x fastReflection_SupplierDelegationCommissionUpdate_messageType) New() protoreflect.Message {
+	return new(fastReflection_SupplierDelegationCommissionUpdate)
+}
+func (x fastReflection_SupplierDelegationCommissionUpdate_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SupplierDelegationCommissionUpdate
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_SupplierDelegationCommissionUpdate) Descriptor() protoreflect.MessageDescriptor {
+	return md_SupplierDelegationCommissionUpdate
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_SupplierDelegationCommissionUpdate) Type() protoreflect.MessageType {
+	return _fastReflection_SupplierDelegationCommissionUpdate_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_SupplierDelegationCommissionUpdate) New() protoreflect.Message {
+	return new(fastReflection_SupplierDelegationCommissionUpdate)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_SupplierDelegationCommissionUpdate) Interface() protoreflect.ProtoMessage {
+	return (*SupplierDelegationCommissionUpdate)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_SupplierDelegationCommissionUpdate) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.DelegationCommissionPercentage != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.DelegationCommissionPercentage)
+		if !f(fd_SupplierDelegationCommissionUpdate_delegation_commission_percentage, value) {
+			return
+		}
+	}
+	if x.EffectiveBlockHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.EffectiveBlockHeight)
+		if !f(fd_SupplierDelegationCommissionUpdate_effective_block_height, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_SupplierDelegationCommissionUpdate) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "pocket.shared.SupplierDelegationCommissionUpdate.delegation_commission_percentage":
+		return x.DelegationCommissionPercentage != uint64(0)
+	case "pocket.shared.SupplierDelegationCommissionUpdate.effective_block_height":
+		return x.EffectiveBlockHeight != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.shared.SupplierDelegationCommissionUpdate"))
+		}
+		panic(fmt.Errorf("message pocket.shared.SupplierDelegationCommissionUpdate does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SupplierDelegationCommissionUpdate) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "pocket.shared.SupplierDelegationCommissionUpdate.delegation_commission_percentage":
+		x.DelegationCommissionPercentage = uint64(0)
+	case "pocket.shared.SupplierDelegationCommissionUpdate.effective_block_height":
+		x.EffectiveBlockHeight = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.shared.SupplierDelegationCommissionUpdate"))
+		}
+		panic(fmt.Errorf("message pocket.shared.SupplierDelegationCommissionUpdate does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_SupplierDelegationCommissionUpdate) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "pocket.shared.SupplierDelegationCommissionUpdate.delegation_commission_percentage":
+		value := x.DelegationCommissionPercentage
+		return protoreflect.ValueOfUint64(value)
+	case "pocket.shared.SupplierDelegationCommissionUpdate.effective_block_height":
+		value := x.EffectiveBlockHeight
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.shared.SupplierDelegationCommissionUpdate"))
+		}
+		panic(fmt.Errorf("message pocket.shared.SupplierDelegationCommissionUpdate does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SupplierDelegationCommissionUpdate) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "pocket.shared.SupplierDelegationCommissionUpdate.delegation_commission_percentage":
+		x.DelegationCommissionPercentage = value.Uint()
+	case "pocket.shared.SupplierDelegationCommissionUpdate.effective_block_height":
+		x.EffectiveBlockHeight = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.shared.SupplierDelegationCommissionUpdate"))
+		}
+		panic(fmt.Errorf("message pocket.shared.SupplierDelegationCommissionUpdate does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SupplierDelegationCommissionUpdate) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "pocket.shared.SupplierDelegationCommissionUpdate.delegation_commission_percentage":
+		panic(fmt.Errorf("field delegation_commission_percentage of message pocket.shared.SupplierDelegationCommissionUpdate is not mutable"))
+	case "pocket.shared.SupplierDelegationCommissionUpdate.effective_block_height":
+		panic(fmt.Errorf("field effective_block_height of message pocket.shared.SupplierDelegationCommissionUpdate is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.shared.SupplierDelegationCommissionUpdate"))
+		}
+		panic(fmt.Errorf("message pocket.shared.SupplierDelegationCommissionUpdate does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_SupplierDelegationCommissionUpdate) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "pocket.shared.SupplierDelegationCommissionUpdate.delegation_commission_percentage":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "pocket.shared.SupplierDelegationCommissionUpdate.effective_block_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.shared.SupplierDelegationCommissionUpdate"))
+		}
+		panic(fmt.Errorf("message pocket.shared.SupplierDelegationCommissionUpdate does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_SupplierDelegationCommissionUpdate) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in pocket.shared.SupplierDelegationCommissionUpdate", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_SupplierDelegationCommissionUpdate) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SupplierDelegationCommissionUpdate) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_SupplierDelegationCommissionUpdate) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_SupplierDelegationCommissionUpdate) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*SupplierDelegationCommissionUpdate)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.DelegationCommissionPercentage != 0 {
+			n += 1 + runtime.Sov(uint64(x.DelegationCommissionPercentage))
+		}
+		if x.EffectiveBlockHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.EffectiveBlockHeight))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*SupplierDelegationCommissionUpdate)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.EffectiveBlockHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.EffectiveBlockHeight))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.DelegationCommissionPercentage != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DelegationCommissionPercentage))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*SupplierDelegationCommissionUpdate)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Services = append(x.Services, &SupplierServiceConfig{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Services[len(x.Services)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				iNdEx = postIndex
-			case 5:
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SupplierDelegationCommissionUpdate: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SupplierDelegationCommissionUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UnstakeSessionEndHeight", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DelegationCommissionPercentage", wireType)
 				}
-				x.UnstakeSessionEndHeight = 0
+				x.DelegationCommissionPercentage = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -828,16 +2119,16 @@ func (x *fastReflection_Supplier) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.UnstakeSessionEndHeight |= uint64(b&0x7F) << shift
+					x.DelegationCommissionPercentage |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-			case 6:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ServiceConfigHistory", wireType)
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EffectiveBlockHeight", wireType)
 				}
-				var msglen int
+				x.EffectiveBlockHeight = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -847,26 +2138,11 @@ func (x *fastReflection_Supplier) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					x.EffectiveBlockHeight |= int64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.ServiceConfigHistory = append(x.ServiceConfigHistory, &ServiceConfigUpdate{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ServiceConfigHistory[len(x.ServiceConfigHistory)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -928,7 +2204,7 @@ func (x *ServiceConfigUpdate) ProtoReflect() protoreflect.Message {
 }
 
 func (x *ServiceConfigUpdate) slowProtoReflect() protoreflect.Message {
-	mi := &file_pocket_shared_supplier_proto_msgTypes[1]
+	mi := &file_pocket_shared_supplier_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1532,6 +2808,20 @@ type Supplier struct {
 	// List of historical service configuration updates, tracking the suppliers
 	// services update and corresponding activation heights.
 	ServiceConfigHistory []*ServiceConfigUpdate `protobuf:"bytes,6,rep,name=service_config_history,json=serviceConfigHistory,proto3" json:"service_config_history,omitempty"`
+	// Percentage (0-100) of the delegators' share of the settlement rewards which
+	// is kept by the supplier, and distributed to its shareholders, as a commission.
+	// It is the latest commission set by the supplier owner, which applies from the
+	// session following its update (see delegation_commission_history).
+	DelegationCommissionPercentage uint64 `protobuf:"varint,7,opt,name=delegation_commission_percentage,json=delegationCommissionPercentage,proto3" json:"delegation_commission_percentage,omitempty"`
+	// History of the periods during which the supplier is jailed, and excluded from
+	// sessions, for repeatedly missing or submitting invalid proofs, ordered by jail
+	// start height. Only the latest period may not be unjailed yet, and the periods
+	// which no longer apply to the sessions that may still be claimed or settled are pruned.
+	JailHistory []*SupplierJailPeriod `protobuf:"bytes,8,rep,name=jail_history,json=jailHistory,proto3" json:"jail_history,omitempty"`
+	// History of the delegation commission updates, ordered by effective height.
+	// It is empty until the commission is first updated, and only retains the updates
+	// which apply to the sessions that may still be claimed or settled.
+	DelegationCommissionHistory []*SupplierDelegationCommissionUpdate `protobuf:"bytes,11,rep,name=delegation_commission_history,json=delegationCommissionHistory,proto3" json:"delegation_commission_history,omitempty"`
 }
 
 func (x *Supplier) Reset() {
@@ -1596,6 +2886,131 @@ func (x *Supplier) GetServiceConfigHistory() []*ServiceConfigUpdate {
 	return nil
 }
 
+func (x *Supplier) GetDelegationCommissionPercentage() uint64 {
+	if x != nil {
+		return x.DelegationCommissionPercentage
+	}
+	return 0
+}
+
+func (x *Supplier) GetJailHistory() []*SupplierJailPeriod {
+	if x != nil {
+		return x.JailHistory
+	}
+	return nil
+}
+
+func (x *Supplier) GetDelegationCommissionHistory() []*SupplierDelegationCommissionUpdate {
+	if x != nil {
+		return x.DelegationCommissionHistory
+	}
+	return nil
+}
+
+// SupplierJailPeriod is a period during which a supplier is excluded from sessions.
+type SupplierJailPeriod struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Session start height from which the supplier is jailed.
+	JailStartHeight int64 `protobuf:"varint,1,opt,name=jail_start_height,json=jailStartHeight,proto3" json:"jail_start_height,omitempty"`
+	// Session end height of the last session the supplier is jailed for.
+	// The supplier can only be unjailed once this session is reached.
+	JailEndHeight int64 `protobuf:"varint,2,opt,name=jail_end_height,json=jailEndHeight,proto3" json:"jail_end_height,omitempty"`
+	// Session start height from which the unjailed supplier is included in sessions
+	// again (0 if not unjailed yet)
+	UnjailHeight int64 `protobuf:"varint,3,opt,name=unjail_height,json=unjailHeight,proto3" json:"unjail_height,omitempty"`
+}
+
+func (x *SupplierJailPeriod) Reset() {
+	*x = SupplierJailPeriod{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pocket_shared_supplier_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SupplierJailPeriod) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SupplierJailPeriod) ProtoMessage() {}
+
+// Deprecated: Use SupplierJailPeriod.ProtoReflect.Descriptor instead.
+func (*SupplierJailPeriod) Descriptor() ([]byte, []int) {
+	return file_pocket_shared_supplier_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SupplierJailPeriod) GetJailStartHeight() int64 {
+	if x != nil {
+		return x.JailStartHeight
+	}
+	return 0
+}
+
+func (x *SupplierJailPeriod) GetJailEndHeight() int64 {
+	if x != nil {
+		return x.JailEndHeight
+	}
+	return 0
+}
+
+func (x *SupplierJailPeriod) GetUnjailHeight() int64 {
+	if x != nil {
+		return x.UnjailHeight
+	}
+	return 0
+}
+
+// SupplierDelegationCommissionUpdate is a delegation commission of a supplier
+// along with the height from which it applies.
+type SupplierDelegationCommissionUpdate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Percentage (0-100) of the delegators' share of the settlement rewards kept by the supplier.
+	DelegationCommissionPercentage uint64 `protobuf:"varint,1,opt,name=delegation_commission_percentage,json=delegationCommissionPercentage,proto3" json:"delegation_commission_percentage,omitempty"`
+	// The start height of the first session settled with this commission.
+	EffectiveBlockHeight int64 `protobuf:"varint,2,opt,name=effective_block_height,json=effectiveBlockHeight,proto3" json:"effective_block_height,omitempty"`
+}
+
+func (x *SupplierDelegationCommissionUpdate) Reset() {
+	*x = SupplierDelegationCommissionUpdate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pocket_shared_supplier_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SupplierDelegationCommissionUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SupplierDelegationCommissionUpdate) ProtoMessage() {}
+
+// Deprecated: Use SupplierDelegationCommissionUpdate.ProtoReflect.Descriptor instead.
+func (*SupplierDelegationCommissionUpdate) Descriptor() ([]byte, []int) {
+	return file_pocket_shared_supplier_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SupplierDelegationCommissionUpdate) GetDelegationCommissionPercentage() uint64 {
+	if x != nil {
+		return x.DelegationCommissionPercentage
+	}
+	return 0
+}
+
+func (x *SupplierDelegationCommissionUpdate) GetEffectiveBlockHeight() int64 {
+	if x != nil {
+		return x.EffectiveBlockHeight
+	}
+	return 0
+}
+
 // ServiceConfigUpdate tracks a change in a supplier's service configurations
 // at a specific block height, enabling tracking of configuration changes over time.
 // This record helps maintain a complete history of service configs and their availability periods.
@@ -1612,17 +3027,17 @@ type ServiceConfigUpdate struct {
 	ActivationHeight int64 `protobuf:"varint,3,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
 	// Block height at which this service configuration was deactivated (0 if still active)
 	// For service configs scheduled for deactivation:
-	//   - This field stores the block height when deactivation will occur
-	//   - After deactivation, the config remains in history only as needed for claim settlement
-	//   - Once no longer required for settlement, the config is automatically removed by
-	//     the EndBlockerPruneSupplierServiceConfigHistory process
+	// - This field stores the block height when deactivation will occur
+	// - After deactivation, the config remains in history only as needed for claim settlement
+	// - Once no longer required for settlement, the config is automatically removed by
+	//   the EndBlockerPruneSupplierServiceConfigHistory process
 	DeactivationHeight int64 `protobuf:"varint,4,opt,name=deactivation_height,json=deactivationHeight,proto3" json:"deactivation_height,omitempty"`
 }
 
 func (x *ServiceConfigUpdate) Reset() {
 	*x = ServiceConfigUpdate{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pocket_shared_supplier_proto_msgTypes[1]
+		mi := &file_pocket_shared_supplier_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1636,7 +3051,7 @@ func (*ServiceConfigUpdate) ProtoMessage() {}
 
 // Deprecated: Use ServiceConfigUpdate.ProtoReflect.Descriptor instead.
 func (*ServiceConfigUpdate) Descriptor() ([]byte, []int) {
-	return file_pocket_shared_supplier_proto_rawDescGZIP(), []int{1}
+	return file_pocket_shared_supplier_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *ServiceConfigUpdate) GetOperatorAddress() string {
@@ -1679,7 +3094,7 @@ var file_pocket_shared_supplier_proto_rawDesc = []byte{
 	0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74,
 	0x2f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x98, 0x03, 0x0a, 0x08,
+	0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x9f, 0x05, 0x0a, 0x08,
 	0x53, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x12, 0x3d, 0x0a, 0x0d, 0x6f, 0x77, 0x6e, 0x65,
 	0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
 	0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72,
@@ -1705,31 +3120,60 @@ var file_pocket_shared_supplier_proto_rawDesc = []byte{
 	0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x53, 0x65, 0x72,
 	0x76, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
 	0x52, 0x14, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48,
-	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x22, 0xde, 0x01, 0x0a, 0x13, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x29,
-	0x0a, 0x10, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
-	0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x3e, 0x0a, 0x07, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x6f, 0x63,
-	0x6b, 0x65, 0x74, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x53, 0x75, 0x70, 0x70, 0x6c,
-	0x69, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x63, 0x74,
-	0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x2f, 0x0a, 0x13, 0x64, 0x65, 0x61, 0x63, 0x74, 0x69,
-	0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x12, 0x64, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x42, 0x9b, 0x01, 0xd8, 0xe2, 0x1e, 0x01, 0x0a, 0x11,
-	0x63, 0x6f, 0x6d, 0x2e, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65,
-	0x64, 0x42, 0x0d, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f,
-	0x50, 0x01, 0x5a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
-	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2f, 0x73, 0x68, 0x61, 0x72,
-	0x65, 0x64, 0xa2, 0x02, 0x03, 0x50, 0x53, 0x58, 0xaa, 0x02, 0x0d, 0x50, 0x6f, 0x63, 0x6b, 0x65,
-	0x74, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0xca, 0x02, 0x0d, 0x50, 0x6f, 0x63, 0x6b, 0x65,
-	0x74, 0x5c, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0xe2, 0x02, 0x19, 0x50, 0x6f, 0x63, 0x6b, 0x65,
-	0x74, 0x5c, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0e, 0x50, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x3a, 0x3a, 0x53,
-	0x68, 0x61, 0x72, 0x65, 0x64, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x48, 0x0a, 0x20, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f,
+	0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x1e, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6d, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65,
+	0x12, 0x44, 0x0a, 0x0c, 0x6a, 0x61, 0x69, 0x6c, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e,
+	0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x4a,
+	0x61, 0x69, 0x6c, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x52, 0x0b, 0x6a, 0x61, 0x69, 0x6c, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x75, 0x0a, 0x1d, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f,
+	0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e,
+	0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x53, 0x75,
+	0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x52, 0x1b, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6d, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x22, 0x8d, 0x01,
+	0x0a, 0x12, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x4a, 0x61, 0x69, 0x6c, 0x50, 0x65,
+	0x72, 0x69, 0x6f, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x6a, 0x61, 0x69, 0x6c, 0x5f, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0f, 0x6a, 0x61, 0x69, 0x6c, 0x53, 0x74, 0x61, 0x72, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x12, 0x26, 0x0a, 0x0f, 0x6a, 0x61, 0x69, 0x6c, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6a, 0x61, 0x69, 0x6c, 0x45,
+	0x6e, 0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x75, 0x6e, 0x6a, 0x61,
+	0x69, 0x6c, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0c, 0x75, 0x6e, 0x6a, 0x61, 0x69, 0x6c, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0xa4, 0x01,
+	0x0a, 0x22, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x12, 0x48, 0x0a, 0x20, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x65,
+	0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x1e,
+	0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x34,
+	0x0a, 0x16, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14,
+	0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x22, 0xde, 0x01, 0x0a, 0x13, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x29, 0x0a, 0x10,
+	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x3e, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x6f, 0x63, 0x6b, 0x65,
+	0x74, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65,
+	0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x07,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x10, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x12, 0x2f, 0x0a, 0x13, 0x64, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x12, 0x64, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x42, 0x24, 0xd8, 0xe2, 0x1e, 0x01, 0x5a, 0x1e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x6f,
+	0x63, 0x6b, 0x65, 0x74, 0x2f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1744,23 +3188,27 @@ func file_pocket_shared_supplier_proto_rawDescGZIP() []byte {
 	return file_pocket_shared_supplier_proto_rawDescData
 }
 
-var file_pocket_shared_supplier_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_pocket_shared_supplier_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_pocket_shared_supplier_proto_goTypes = []interface{}{
-	(*Supplier)(nil),              // 0: pocket.shared.Supplier
-	(*ServiceConfigUpdate)(nil),   // 1: pocket.shared.ServiceConfigUpdate
-	(*v1beta1.Coin)(nil),          // 2: cosmos.base.v1beta1.Coin
-	(*SupplierServiceConfig)(nil), // 3: pocket.shared.SupplierServiceConfig
+	(*Supplier)(nil),                           // 0: pocket.shared.Supplier
+	(*SupplierJailPeriod)(nil),                 // 1: pocket.shared.SupplierJailPeriod
+	(*SupplierDelegationCommissionUpdate)(nil), // 2: pocket.shared.SupplierDelegationCommissionUpdate
+	(*ServiceConfigUpdate)(nil),                // 3: pocket.shared.ServiceConfigUpdate
+	(*v1beta1.Coin)(nil),                       // 4: cosmos.base.v1beta1.Coin
+	(*SupplierServiceConfig)(nil),              // 5: pocket.shared.SupplierServiceConfig
 }
 var file_pocket_shared_supplier_proto_depIdxs = []int32{
-	2, // 0: pocket.shared.Supplier.stake:type_name -> cosmos.base.v1beta1.Coin
-	3, // 1: pocket.shared.Supplier.services:type_name -> pocket.shared.SupplierServiceConfig
-	1, // 2: pocket.shared.Supplier.service_config_history:type_name -> pocket.shared.ServiceConfigUpdate
-	3, // 3: pocket.shared.ServiceConfigUpdate.service:type_name -> pocket.shared.SupplierServiceConfig
-	4, // [4:4] is the sub-list for method output_type
-	4, // [4:4] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	4, // 0: pocket.shared.Supplier.stake:type_name -> cosmos.base.v1beta1.Coin
+	5, // 1: pocket.shared.Supplier.services:type_name -> pocket.shared.SupplierServiceConfig
+	3, // 2: pocket.shared.Supplier.service_config_history:type_name -> pocket.shared.ServiceConfigUpdate
+	1, // 3: pocket.shared.Supplier.jail_history:type_name -> pocket.shared.SupplierJailPeriod
+	2, // 4: pocket.shared.Supplier.delegation_commission_history:type_name -> pocket.shared.SupplierDelegationCommissionUpdate
+	5, // 5: pocket.shared.ServiceConfigUpdate.service:type_name -> pocket.shared.SupplierServiceConfig
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_pocket_shared_supplier_proto_init() }
@@ -1783,6 +3231,30 @@ func file_pocket_shared_supplier_proto_init() {
 			}
 		}
 		file_pocket_shared_supplier_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SupplierJailPeriod); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pocket_shared_supplier_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SupplierDelegationCommissionUpdate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pocket_shared_supplier_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ServiceConfigUpdate); i {
 			case 0:
 				return &v.state
@@ -1801,7 +3273,7 @@ func file_pocket_shared_supplier_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_pocket_shared_supplier_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -2671,341 +2671,5136 @@ func (x *fastReflection_EventSupplierServiceConfigActivated) ProtoMethods() *pro
 	}
 }
 
+var (
+	md_EventSupplierDelegated                    protoreflect.MessageDescriptor
+	fd_EventSupplierDelegated_delegation         protoreflect.FieldDescriptor
+	fd_EventSupplierDelegated_session_end_height protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_pocket_supplier_event_proto_init()
+	md_EventSupplierDelegated = File_pocket_supplier_event_proto.Messages().ByName("EventSupplierDelegated")
+	fd_EventSupplierDelegated_delegation = md_EventSupplierDelegated.Fields().ByName("delegation")
+	fd_EventSupplierDelegated_session_end_height = md_EventSupplierDelegated.Fields().ByName("session_end_height")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventSupplierDelegated)(nil)
+
+type fastReflection_EventSupplierDelegated EventSupplierDelegated
+
+func (x *EventSupplierDelegated) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventSupplierDelegated)(x)
+}
+
+func (x *EventSupplierDelegated) slowProtoReflect() protoreflect.Message {
+	mi := &file_pocket_supplier_event_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventSupplierDelegated_messageType fastReflection_EventSupplierDelegated_messageType
+var _ protoreflect.MessageType = fastReflection_EventSupplierDelegated_messageType{}
+
+type fastReflection_EventSupplierDelegated_messageType struct{}
+
+func (x fastReflection_EventSupplierDelegated_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventSupplierDelegated)(nil)
+}
+func (x fastReflection_EventSupplierDelegated_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventSupplierDelegated)
+}
+func (x fastReflection_EventSupplierDelegated_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventSupplierDelegated
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventSupplierDelegated) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventSupplierDelegated
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventSupplierDelegated) Type() protoreflect.MessageType {
+	return _fastReflection_EventSupplierDelegated_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventSupplierDelegated) New() protoreflect.Message {
+	return new(fastReflection_EventSupplierDelegated)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventSupplierDelegated) Interface() protoreflect.ProtoMessage {
+	return (*EventSupplierDelegated)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventSupplierDelegated) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Delegation != nil {
+		value := protoreflect.ValueOfMessage(x.Delegation.ProtoReflect())
+		if !f(fd_EventSupplierDelegated_delegation, value) {
+			return
+		}
+	}
+	if x.SessionEndHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.SessionEndHeight)
+		if !f(fd_EventSupplierDelegated_session_end_height, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventSupplierDelegated) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "pocket.supplier.EventSupplierDelegated.delegation":
+		return x.Delegation != nil
+	case "pocket.supplier.EventSupplierDelegated.session_end_height":
+		return x.SessionEndHeight != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.supplier.EventSupplierDelegated"))
+		}
+		panic(fmt.Errorf("message pocket.supplier.EventSupplierDelegated does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventSupplierDelegated) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "pocket.supplier.EventSupplierDelegated.delegation":
+		x.Delegation = nil
+	case "pocket.supplier.EventSupplierDelegated.session_end_height":
+		x.SessionEndHeight = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.supplier.EventSupplierDelegated"))
+		}
+		panic(fmt.Errorf("message pocket.supplier.EventSupplierDelegated does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventSupplierDelegated) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "pocket.supplier.EventSupplierDelegated.delegation":
+		value := x.Delegation
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "pocket.supplier.EventSupplierDelegated.session_end_height":
+		value := x.SessionEndHeight
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.supplier.EventSupplierDelegated"))
+		}
+		panic(fmt.Errorf("message pocket.supplier.EventSupplierDelegated does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventSupplierDelegated) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "pocket.supplier.EventSupplierDelegated.delegation":
+		x.Delegation = value.Message().Interface().(*Delegation)
+	case "pocket.supplier.EventSupplierDelegated.session_end_height":
+		x.SessionEndHeight = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.supplier.EventSupplierDelegated"))
+		}
+		panic(fmt.Errorf("message pocket.supplier.EventSupplierDelegated does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventSupplierDelegated) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "pocket.supplier.EventSupplierDelegated.delegation":
+		if x.Delegation == nil {
+			x.Delegation = new(Delegation)
+		}
+		return protoreflect.ValueOfMessage(x.Delegation.ProtoReflect())
+	case "pocket.supplier.EventSupplierDelegated.session_end_height":
+		panic(fmt.Errorf("field session_end_height of message pocket.supplier.EventSupplierDelegated is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.supplier.EventSupplierDelegated"))
+		}
+		panic(fmt.Errorf("message pocket.supplier.EventSupplierDelegated does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventSupplierDelegated) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "pocket.supplier.EventSupplierDelegated.delegation":
+		m := new(Delegation)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "pocket.supplier.EventSupplierDelegated.session_end_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.supplier.EventSupplierDelegated"))
+		}
+		panic(fmt.Errorf("message pocket.supplier.EventSupplierDelegated does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventSupplierDelegated) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in pocket.supplier.EventSupplierDelegated", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventSupplierDelegated) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventSupplierDelegated) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventSupplierDelegated) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventSupplierDelegated) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventSupplierDelegated)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Delegation != nil {
+			l = options.Size(x.Delegation)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.SessionEndHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.SessionEndHeight))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventSupplierDelegated)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.SessionEndHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SessionEndHeight))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Delegation != nil {
+			encoded, err := options.Marshal(x.Delegation)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventSupplierDelegated)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventSupplierDelegated: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventSupplierDelegated: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Delegation", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Delegation == nil {
+					x.Delegation = &Delegation{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Delegation); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SessionEndHeight", wireType)
+				}
+				x.SessionEndHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SessionEndHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventSupplierDelegationUnbondingBegin                      protoreflect.MessageDescriptor
+	fd_EventSupplierDelegationUnbondingBegin_delegation           protoreflect.FieldDescriptor
+	fd_EventSupplierDelegationUnbondingBegin_session_end_height   protoreflect.FieldDescriptor
+	fd_EventSupplierDelegationUnbondingBegin_unbonding_end_height protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_pocket_supplier_event_proto_init()
+	md_EventSupplierDelegationUnbondingBegin = File_pocket_supplier_event_proto.Messages().ByName("EventSupplierDelegationUnbondingBegin")
+	fd_EventSupplierDelegationUnbondingBegin_delegation = md_EventSupplierDelegationUnbondingBegin.Fields().ByName("delegation")
+	fd_EventSupplierDelegationUnbondingBegin_session_end_height = md_EventSupplierDelegationUnbondingBegin.Fields().ByName("session_end_height")
+	fd_EventSupplierDelegationUnbondingBegin_unbonding_end_height = md_EventSupplierDelegationUnbondingBegin.Fields().ByName("unbonding_end_height")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventSupplierDelegationUnbondingBegin)(nil)
+
+type fastReflection_EventSupplierDelegationUnbondingBegin EventSupplierDelegationUnbondingBegin
+
+func (x *EventSupplierDelegationUnbondingBegin) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventSupplierDelegationUnbondingBegin)(x)
+}
+
+func (x *EventSupplierDelegationUnbondingBegin) slowProtoReflect() protoreflect.Message {
+	mi := &file_pocket_supplier_event_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventSupplierDelegationUnbondingBegin_messageType fastReflection_EventSupplierDelegationUnbondingBegin_messageType
+var _ protoreflect.MessageType = fastReflection_EventSupplierDelegationUnbondingBegin_messageType{}
+
+type fastReflection_EventSupplierDelegationUnbondingBegin_messageType struct{}
+
+func (x fastReflection_EventSupplierDelegationUnbondingBegin_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventSupplierDelegationUnbondingBegin)(nil)
+}
+func (x fastReflection_EventSupplierDelegationUnbondingBegin_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventSupplierDelegationUnbondingBegin)
+}
+func (x fastReflection_EventSupplierDelegationUnbondingBegin_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventSupplierDelegationUnbondingBegin
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventSupplierDelegationUnbondingBegin) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventSupplierDelegationUnbondingBegin
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventSupplierDelegationUnbondingBegin) Type() protoreflect.MessageType {
+	return _fastReflection_EventSupplierDelegationUnbondingBegin_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventSupplierDelegationUnbondingBegin) New() protoreflect.Message {
+	return new(fastReflection_EventSupplierDelegationUnbondingBegin)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventSupplierDelegationUnbondingBegin) Interface() protoreflect.ProtoMessage {
+	return (*EventSupplierDelegationUnbondingBegin)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventSupplierDelegationUnbondingBegin) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Delegation != nil {
+		value := protoreflect.ValueOfMessage(x.Delegation.ProtoReflect())
+		if !f(fd_EventSupplierDelegationUnbondingBegin_delegation, value) {
+			return
+		}
+	}
+	if x.SessionEndHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.SessionEndHeight)
+		if !f(fd_EventSupplierDelegationUnbondingBegin_session_end_height, value) {
+			return
+		}
+	}
+	if x.UnbondingEndHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.UnbondingEndHeight)
+		if !f(fd_EventSupplierDelegationUnbondingBegin_unbonding_end_height, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventSupplierDelegationUnbondingBegin) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "pocket.supplier.EventSupplierDelegationUnbondingBegin.delegation":
+		return x.Delegation != nil
+	case "pocket.supplier.EventSupplierDelegationUnbondingBegin.session_end_height":
+		return x.SessionEndHeight != int64(0)
+	case "pocket.supplier.EventSupplierDelegationUnbondingBegin.unbonding_end_height":
+		return x.UnbondingEndHeight != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.supplier.EventSupplierDelegationUnbondingBegin"))
+		}
+		panic(fmt.Errorf("message pocket.supplier.EventSupplierDelegationUnbondingBegin does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventSupplierDelegationUnbondingBegin) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "pocket.supplier.EventSupplierDelegationUnbondingBegin.delegation":
+		x.Delegation = nil
+	case "pocket.supplier.EventSupplierDelegationUnbondingBegin.session_end_height":
+		x.SessionEndHeight = int64(0)
+	case "pocket.supplier.EventSupplierDelegationUnbondingBegin.unbonding_end_height":
+		x.UnbondingEndHeight = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.supplier.EventSupplierDelegationUnbondingBegin"))
+		}
+		panic(fmt.Errorf("message pocket.supplier.EventSupplierDelegationUnbondingBegin does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventSupplierDelegationUnbondingBegin) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "pocket.supplier.EventSupplierDelegationUnbondingBegin.delegation":
+		value := x.Delegation
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "pocket.supplier.EventSupplierDelegationUnbondingBegin.session_end_height":
+		value := x.SessionEndHeight
+		return protoreflect.ValueOfInt64(value)
+	case "pocket.supplier.EventSupplierDelegationUnbondingBegin.unbonding_end_height":
+		value := x.UnbondingEndHeight
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.supplier.EventSupplierDelegationUnbondingBegin"))
+		}
+		panic(fmt.Errorf("message pocket.supplier.EventSupplierDelegationUnbondingBegin does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventSupplierDelegationUnbondingBegin) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "pocket.supplier.EventSupplierDelegationUnbondingBegin.delegation":
+		x.Delegation = value.Message().Interface().(*Delegation)
+	case "pocket.supplier.EventSupplierDelegationUnbondingBegin.session_end_height":
+		x.SessionEndHeight = value.Int()
+	case "pocket.supplier.EventSupplierDelegationUnbondingBegin.unbonding_end_height":
+		x.UnbondingEndHeight = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.supplier.EventSupplierDelegationUnbondingBegin"))
+		}
+		panic(fmt.Errorf("message pocket.supplier.EventSupplierDelegationUnbondingBegin does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventSupplierDelegationUnbondingBegin) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "pocket.supplier.EventSupplierDelegationUnbondingBegin.delegation":
+		if x.Delegation == nil {
+			x.Delegation = new(Delegation)
+		}
+		return protoreflect.ValueOfMessage(x.Delegation.ProtoReflect())
+	case "pocket.supplier.EventSupplierDelegationUnbondingBegin.session_end_height":
+		panic(fmt.Errorf("field session_end_height of message pocket.supplier.EventSupplierDelegationUnbondingBegin is not mutable"))
+	case "pocket.supplier.EventSupplierDelegationUnbondingBegin.unbonding_end_height":
+		panic(fmt.Errorf("field unbonding_end_height of message pocket.supplier.EventSupplierDelegationUnbondingBegin is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.supplier.EventSupplierDelegationUnbondingBegin"))
+		}
+		panic(fmt.Errorf("message pocket.supplier.EventSupplierDelegationUnbondingBegin does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventSupplierDelegationUnbondingBegin) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "pocket.supplier.EventSupplierDelegationUnbondingBegin.delegation":
+		m := new(Delegation)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "pocket.supplier.EventSupplierDelegationUnbondingBegin.session_end_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "pocket.supplier.EventSupplierDelegationUnbondingBegin.unbonding_end_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.supplier.EventSupplierDelegationUnbondingBegin"))
+		}
+		panic(fmt.Errorf("message pocket.supplier.EventSupplierDelegationUnbondingBegin does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventSupplierDelegationUnbondingBegin) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in pocket.supplier.EventSupplierDelegationUnbondingBegin", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventSupplierDelegationUnbondingBegin) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventSupplierDelegationUnbondingBegin) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventSupplierDelegationUnbondingBegin) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventSupplierDelegationUnbondingBegin) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventSupplierDelegationUnbondingBegin)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Delegation != nil {
+			l = options.Size(x.Delegation)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.SessionEndHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.SessionEndHeight))
+		}
+		if x.UnbondingEndHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.UnbondingEndHeight))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventSupplierDelegationUnbondingBegin)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.UnbondingEndHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.UnbondingEndHeight))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.SessionEndHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SessionEndHeight))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Delegation != nil {
+			encoded, err := options.Marshal(x.Delegation)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventSupplierDelegationUnbondingBegin)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventSupplierDelegationUnbondingBegin: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventSupplierDelegationUnbondingBegin: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Delegation", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Delegation == nil {
+					x.Delegation = &Delegation{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Delegation); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SessionEndHeight", wireType)
+				}
+				x.SessionEndHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SessionEndHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UnbondingEndHeight", wireType)
+				}
+				x.UnbondingEndHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.UnbondingEndHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventSupplierDelegationUnbondingEnd                      protoreflect.MessageDescriptor
+	fd_EventSupplierDelegationUnbondingEnd_delegation           protoreflect.FieldDescriptor
+	fd_EventSupplierDelegationUnbondingEnd_reason               protoreflect.FieldDescriptor
+	fd_EventSupplierDelegationUnbondingEnd_session_end_height   protoreflect.FieldDescriptor
+	fd_EventSupplierDelegationUnbondingEnd_unbonding_end_height protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_pocket_supplier_event_proto_init()
+	md_EventSupplierDelegationUnbondingEnd = File_pocket_supplier_event_proto.Messages().ByName("EventSupplierDelegationUnbondingEnd")
+	fd_EventSupplierDelegationUnbondingEnd_delegation = md_EventSupplierDelegationUnbondingEnd.Fields().ByName("delegation")
+	fd_EventSupplierDelegationUnbondingEnd_reason = md_EventSupplierDelegationUnbondingEnd.Fields().ByName("reason")
+	fd_EventSupplierDelegationUnbondingEnd_session_end_height = md_EventSupplierDelegationUnbondingEnd.Fields().ByName("session_end_height")
+	fd_EventSupplierDelegationUnbondingEnd_unbonding_end_height = md_EventSupplierDelegationUnbondingEnd.Fields().ByName("unbonding_end_height")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventSupplierDelegationUnbondingEnd)(nil)
+
+type fastReflection_EventSupplierDelegationUnbondingEnd EventSupplierDelegationUnbondingEnd
+
+func (x *EventSupplierDelegationUnbondingEnd) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventSupplierDelegationUnbondingEnd)(x)
+}
+
+func (x *EventSupplierDelegationUnbondingEnd) slowProtoReflect() protoreflect.Message {
+	mi := &file_pocket_supplier_event_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventSupplierDelegationUnbondingEnd_messageType fastReflection_EventSupplierDelegationUnbondingEnd_messageType
+var _ protoreflect.MessageType = fastReflection_EventSupplierDelegationUnbondingEnd_messageType{}
+
+type fastReflection_EventSupplierDelegationUnbondingEnd_messageType struct{}
+
+func (x fastReflection_EventSupplierDelegationUnbondingEnd_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventSupplierDelegationUnbondingEnd)(nil)
+}
+func (x fastReflection_EventSupplierDelegationUnbondingEnd_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventSupplierDelegationUnbondingEnd)
+}
+func (x fastReflection_EventSupplierDelegationUnbondingEnd_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventSupplierDelegationUnbondingEnd
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventSupplierDelegationUnbondingEnd) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventSupplierDelegationUnbondingEnd
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventSupplierDelegationUnbondingEnd) Type() protoreflect.MessageType {
+	return _fastReflection_EventSupplierDelegationUnbondingEnd_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventSupplierDelegationUnbondingEnd) New() protoreflect.Message {
+	return new(fastReflection_EventSupplierDelegationUnbondingEnd)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventSupplierDelegationUnbondingEnd) Interface() protoreflect.ProtoMessage {
+	return (*EventSupplierDelegationUnbondingEnd)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventSupplierDelegationUnbondingEnd) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Delegation != nil {
+		value := protoreflect.ValueOfMessage(x.Delegation.ProtoReflect())
+		if !f(fd_EventSupplierDelegationUnbondingEnd_delegation, value) {
+			return
+		}
+	}
+	if x.Reason != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Reason))
+		if !f(fd_EventSupplierDelegationUnbondingEnd_reason, value) {
+			return
+		}
+	}
+	if x.SessionEndHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.SessionEndHeight)
+		if !f(fd_EventSupplierDelegationUnbondingEnd_session_end_height, value) {
+			return
+		}
+	}
+	if x.UnbondingEndHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.UnbondingEndHeight)
+		if !f(fd_EventSupplierDelegationUnbondingEnd_unbonding_end_height, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventSupplierDelegationUnbondingEnd) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "pocket.supplier.EventSupplierDelegationUnbondingEnd.delegation":
+		return x.Delegation != nil
+	case "pocket.supplier.EventSupplierDelegationUnbondingEnd.reason":
+		return x.Reason != 0
+	case "pocket.supplier.EventSupplierDelegationUnbondingEnd.session_end_height":
+		return x.SessionEndHeight != int64(0)
+	case "pocket.supplier.EventSupplierDelegationUnbondingEnd.unbonding_end_height":
+		return x.UnbondingEndHeight != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.supplier.EventSupplierDelegationUnbondingEnd"))
+		}
+		panic(fmt.Errorf("message pocket.supplier.EventSupplierDelegationUnbondingEnd does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventSupplierDelegationUnbondingEnd) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "pocket.supplier.EventSupplierDelegationUnbondingEnd.delegation":
+		x.Delegation = nil
+	case "pocket.supplier.EventSupplierDelegationUnbondingEnd.reason":
+		x.Reason = 0
+	case "pocket.supplier.EventSupplierDelegationUnbondingEnd.session_end_height":
+		x.SessionEndHeight = int64(0)
+	case "pocket.supplier.EventSupplierDelegationUnbondingEnd.unbonding_end_height":
+		x.UnbondingEndHeight = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.supplier.EventSupplierDelegationUnbondingEnd"))
+		}
+		panic(fmt.Errorf("message pocket.supplier.EventSupplierDelegationUnbondingEnd does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventSupplierDelegationUnbondingEnd) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "pocket.supplier.EventSupplierDelegationUnbondingEnd.delegation":
+		value := x.Delegation
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "pocket.supplier.EventSupplierDelegationUnbondingEnd.reason":
+		value := x.Reason
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "pocket.supplier.EventSupplierDelegationUnbondingEnd.session_end_height":
+		value := x.SessionEndHeight
+		return protoreflect.ValueOfInt64(value)
+	case "pocket.supplier.EventSupplierDelegationUnbondingEnd.unbonding_end_height":
+		value := x.UnbondingEndHeight
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.supplier.EventSupplierDelegationUnbondingEnd"))
+		}
+		panic(fmt.Errorf("message pocket.supplier.EventSupplierDelegationUnbondingEnd does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventSupplierDelegationUnbondingEnd) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "pocket.supplier.EventSupplierDelegationUnbondingEnd.delegation":
+		x.Delegation = value.Message().Interface().(*Delegation)
+	case "pocket.supplier.EventSupplierDelegationUnbondingEnd.reason":
+		x.Reason = (DelegationUnbondingReason)(value.Enum())
+	case "pocket.supplier.EventSupplierDelegationUnbondingEnd.session_end_height":
+		x.SessionEndHeight = value.Int()
+	case "pocket.supplier.EventSupplierDelegationUnbondingEnd.unbonding_end_height":
+		x.UnbondingEndHeight = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.supplier.EventSupplierDelegationUnbondingEnd"))
+		}
+		panic(fmt.Errorf("message pocket.supplier.EventSupplierDelegationUnbondingEnd does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventSupplierDelegationUnbondingEnd) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "pocket.supplier.EventSupplierDelegationUnbondingEnd.delegation":
+		if x.Delegation == nil {
+			x.Delegation = new(Delegation)
+		}
+		return protoreflect.ValueOfMessage(x.Delegation.ProtoReflect())
+	case "pocket.supplier.EventSupplierDelegationUnbondingEnd.reason":
+		panic(fmt.Errorf("field reason of message pocket.supplier.EventSupplierDelegationUnbondingEnd is not mutable"))
+	case "pocket.supplier.EventSupplierDelegationUnbondingEnd.session_end_height":
+		panic(fmt.Errorf("field session_end_height of message pocket.supplier.EventSupplierDelegationUnbondingEnd is not mutable"))
+	case "pocket.supplier.EventSupplierDelegationUnbondingEnd.unbonding_end_height":
+		panic(fmt.Errorf("field unbonding_end_height of message pocket.supplier.EventSupplierDelegationUnbondingEnd is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.supplier.EventSupplierDelegationUnbondingEnd"))
+		}
+		panic(fmt.Errorf("message pocket.supplier.EventSupplierDelegationUnbondingEnd does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventSupplierDelegationUnbondingEnd) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "pocket.supplier.EventSupplierDelegationUnbondingEnd.delegation":
+		m := new(Delegation)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "pocket.supplier.EventSupplierDelegationUnbondingEnd.reason":
+		return protoreflect.ValueOfEnum(0)
+	case "pocket.supplier.EventSupplierDelegationUnbondingEnd.session_end_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "pocket.supplier.EventSupplierDelegationUnbondingEnd.unbonding_end_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.supplier.EventSupplierDelegationUnbondingEnd"))
+		}
+		panic(fmt.Errorf("message pocket.supplier.EventSupplierDelegationUnbondingEnd does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventSupplierDelegationUnbondingEnd) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in pocket.supplier.EventSupplierDelegationUnbondingEnd", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventSupplierDelegationUnbondingEnd) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventSupplierDelegationUnbondingEnd) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventSupplierDelegationUnbondingEnd) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventSupplierDelegationUnbondingEnd) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventSupplierDelegationUnbondingEnd)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Delegation != nil {
+			l = options.Size(x.Delegation)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Reason != 0 {
+			n += 1 + runtime.Sov(uint64(x.Reason))
+		}
+		if x.SessionEndHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.SessionEndHeight))
+		}
+		if x.UnbondingEndHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.UnbondingEndHeight))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventSupplierDelegationUnbondingEnd)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.UnbondingEndHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.UnbondingEndHeight))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.SessionEndHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SessionEndHeight))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.Reason != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Reason))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Delegation != nil {
+			encoded, err := options.Marshal(x.Delegation)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventSupplierDelegationUnbondingEnd)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventSupplierDelegationUnbondingEnd: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventSupplierDelegationUnbondingEnd: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Delegation", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Delegation == nil {
+					x.Delegation = &Delegation{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Delegation); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				}
+				x.Reason = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Reason |= DelegationUnbondingReason(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SessionEndHeight", wireType)
+				}
+				x.SessionEndHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SessionEndHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UnbondingEndHeight", wireType)
+				}
+				x.UnbondingEndHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.UnbondingEndHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventSupplierDelegationUnbondingCanceled                    protoreflect.MessageDescriptor
+	fd_EventSupplierDelegationUnbondingCanceled_delegation         protoreflect.FieldDescriptor
+	fd_EventSupplierDelegationUnbondingCanceled_height             protoreflect.FieldDescriptor
+	fd_EventSupplierDelegationUnbondingCanceled_session_end_height protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_pocket_supplier_event_proto_init()
+	md_EventSupplierDelegationUnbondingCanceled = File_pocket_supplier_event_proto.Messages().ByName("EventSupplierDelegationUnbondingCanceled")
+	fd_EventSupplierDelegationUnbondingCanceled_delegation = md_EventSupplierDelegationUnbondingCanceled.Fields().ByName("delegation")
+	fd_EventSupplierDelegationUnbondingCanceled_height = md_EventSupplierDelegationUnbondingCanceled.Fields().ByName("height")
+	fd_EventSupplierDelegationUnbondingCanceled_session_end_height = md_EventSupplierDelegationUnbondingCanceled.Fields().ByName("session_end_height")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventSupplierDelegationUnbondingCanceled)(nil)
+
+type fastReflection_EventSupplierDelegationUnbondingCanceled EventSupplierDelegationUnbondingCanceled
+
+func (x *EventSupplierDelegationUnbondingCanceled) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventSupplierDelegationUnbondingCanceled)(x)
+}
+
+func (x *EventSupplierDelegationUnbondingCanceled) slowProtoReflect() protoreflect.Message {
+	mi := &file_pocket_supplier_event_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventSupplierDelegationUnbondingCanceled_messageType fastReflection_EventSupplierDelegationUnbondingCanceled_messageType
+var _ protoreflect.MessageType = fastReflection_EventSupplierDelegationUnbondingCanceled_messageType{}
+
+type fastReflection_EventSupplierDelegationUnbondingCanceled_messageType struct{}
+
+func (x fastReflection_EventSupplierDelegationUnbondingCanceled_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventSupplierDelegationUnbondingCanceled)(nil)
+}
+func (x fastReflection_EventSupplierDelegationUnbondingCanceled_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventSupplierDelegationUnbondingCanceled)
+}
+func (x fastReflection_EventSupplierDelegationUnbondingCanceled_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventSupplierDelegationUnbondingCanceled
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventSupplierDelegationUnbondingCanceled) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventSupplierDelegationUnbondingCanceled
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventSupplierDelegationUnbondingCanceled) Type() protoreflect.MessageType {
+	return _fastReflection_EventSupplierDelegationUnbondingCanceled_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventSupplierDelegationUnbondingCanceled) New() protoreflect.Message {
+	return new(fastReflection_EventSupplierDelegationUnbondingCanceled)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventSupplierDelegationUnbondingCanceled) Interface() protoreflect.ProtoMessage {
+	return (*EventSupplierDelegationUnbondingCanceled)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventSupplierDelegationUnbondingCanceled) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Delegation != nil {
+		value := protoreflect.ValueOfMessage(x.Delegation.ProtoReflect())
+		if !f(fd_EventSupplierDelegationUnbondingCanceled_delegation, value) {
+			return
+		}
+	}
+	if x.Height != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Height)
+		if !f(fd_EventSupplierDelegationUnbondingCanceled_height, value) {
+			return
+		}
+	}
+	if x.SessionEndHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.SessionEndHeight)
+		if !f(fd_EventSupplierDelegationUnbondingCanceled_session_end_height, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventSupplierDelegationUnbondingCanceled) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "pocket.supplier.EventSupplierDelegationUnbondingCanceled.delegation":
+		return x.Delegation != nil
+	case "pocket.supplier.EventSupplierDelegationUnbondingCanceled.height":
+		return x.Height != int64(0)
+	case "pocket.supplier.EventSupplierDelegationUnbondingCanceled.session_end_height":
+		return x.SessionEndHeight != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.supplier.EventSupplierDelegationUnbondingCanceled"))
+		}
+		panic(fmt.Errorf("message pocket.supplier.EventSupplierDelegationUnbondingCanceled does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventSupplierDelegationUnbondingCanceled) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "pocket.supplier.EventSupplierDelegationUnbondingCanceled.delegation":
+		x.Delegation = nil
+	case "pocket.supplier.EventSupplierDelegationUnbondingCanceled.height":
+		x.Height = int64(0)
+	case "pocket.supplier.EventSupplierDelegationUnbondingCanceled.session_end_height":
+		x.SessionEndHeight = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.supplier.EventSupplierDelegationUnbondingCanceled"))
+		}
+		panic(fmt.Errorf("message pocket.supplier.EventSupplierDelegationUnbondingCanceled does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventSupplierDelegationUnbondingCanceled) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "pocket.supplier.EventSupplierDelegationUnbondingCanceled.delegation":
+		value := x.Delegation
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "pocket.supplier.EventSupplierDelegationUnbondingCanceled.height":
+		value := x.Height
+		return protoreflect.ValueOfInt64(value)
+	case "pocket.supplier.EventSupplierDelegationUnbondingCanceled.session_end_height":
+		value := x.SessionEndHeight
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.supplier.EventSupplierDelegationUnbondingCanceled"))
+		}
+		panic(fmt.Errorf("message pocket.supplier.EventSupplierDelegationUnbondingCanceled does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventSupplierDelegationUnbondingCanceled) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "pocket.supplier.EventSupplierDelegationUnbondingCanceled.delegation":
+		x.Delegation = value.Message().Interface().(*Delegation)
+	case "pocket.supplier.EventSupplierDelegationUnbondingCanceled.height":
+		x.Height = value.Int()
+	case "pocket.supplier.EventSupplierDelegationUnbondingCanceled.session_end_height":
+		x.SessionEndHeight = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.supplier.EventSupplierDelegationUnbondingCanceled"))
+		}
+		panic(fmt.Errorf("message pocket.supplier.EventSupplierDelegationUnbondingCanceled does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventSupplierDelegationUnbondingCanceled) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "pocket.supplier.EventSupplierDelegationUnbondingCanceled.delegation":
+		if x.Delegation == nil {
+			x.Delegation = new(Delegation)
+		}
+		return protoreflect.ValueOfMessage(x.Delegation.ProtoReflect())
+	case "pocket.supplier.EventSupplierDelegationUnbondingCanceled.height":
+		panic(fmt.Errorf("field height of message pocket.supplier.EventSupplierDelegationUnbondingCanceled is not mutable"))
+	case "pocket.supplier.EventSupplierDelegationUnbondingCanceled.session_end_height":
+		panic(fmt.Errorf("field session_end_height of message pocket.supplier.EventSupplierDelegationUnbondingCanceled is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.supplier.EventSupplierDelegationUnbondingCanceled"))
+		}
+		panic(fmt.Errorf("message pocket.supplier.EventSupplierDelegationUnbondingCanceled does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventSupplierDelegationUnbondingCanceled) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "pocket.supplier.EventSupplierDelegationUnbondingCanceled.delegation":
+		m := new(Delegation)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "pocket.supplier.EventSupplierDelegationUnbondingCanceled.height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "pocket.supplier.EventSupplierDelegationUnbondingCanceled.session_end_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.supplier.EventSupplierDelegationUnbondingCanceled"))
+		}
+		panic(fmt.Errorf("message pocket.supplier.EventSupplierDelegationUnbondingCanceled does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventSupplierDelegationUnbondingCanceled) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in pocket.supplier.EventSupplierDelegationUnbondingCanceled", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventSupplierDelegationUnbondingCanceled) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventSupplierDelegationUnbondingCanceled) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventSupplierDelegationUnbondingCanceled) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventSupplierDelegationUnbondingCanceled) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventSupplierDelegationUnbondingCanceled)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Delegation != nil {
+			l = options.Size(x.Delegation)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
+		if x.SessionEndHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.SessionEndHeight))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventSupplierDelegationUnbondingCanceled)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.SessionEndHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SessionEndHeight))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Delegation != nil {
+			encoded, err := options.Marshal(x.Delegation)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventSupplierDelegationUnbondingCanceled)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventSupplierDelegationUnbondingCanceled: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventSupplierDelegationUnbondingCanceled: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Delegation", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Delegation == nil {
+					x.Delegation = &Delegation{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Delegation); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				}
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SessionEndHeight", wireType)
+				}
+				x.SessionEndHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SessionEndHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventSupplierDelegationCommissionUpdated                                       protoreflect.MessageDescriptor
+	fd_EventSupplierDelegationCommissionUpdated_operator_address                      protoreflect.FieldDescriptor
+	fd_EventSupplierDelegationCommissionUpdated_prev_delegation_commission_percentage protoreflect.FieldDescriptor
+	fd_EventSupplierDelegationCommissionUpdated_new_delegation_commission_percentage  protoreflect.FieldDescriptor
+	fd_EventSupplierDelegationCommissionUpdated_effective_block_height                protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_pocket_supplier_event_proto_init()
+	md_EventSupplierDelegationCommissionUpdated = File_pocket_supplier_event_proto.Messages().ByName("EventSupplierDelegationCommissionUpdated")
+	fd_EventSupplierDelegationCommissionUpdated_operator_address = md_EventSupplierDelegationCommissionUpdated.Fields().ByName("operator_address")
+	fd_EventSupplierDelegationCommissionUpdated_prev_delegation_commission_percentage = md_EventSupplierDelegationCommissionUpdated.Fields().ByName("prev_delegation_commission_percentage")
+	fd_EventSupplierDelegationCommissionUpdated_new_delegation_commission_percentage = md_EventSupplierDelegationCommissionUpdated.Fields().ByName("new_delegation_commission_percentage")
+	fd_EventSupplierDelegationCommissionUpdated_effective_block_height = md_EventSupplierDelegationCommissionUpdated.Fields().ByName("effective_block_height")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventSupplierDelegationCommissionUpdated)(nil)
+
+type fastReflection_EventSupplierDelegationCommissionUpdated EventSupplierDelegationCommissionUpdated
+
+func (x *EventSupplierDelegationCommissionUpdated) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventSupplierDelegationCommissionUpdated)(x)
+}
+
+func (x *EventSupplierDelegationCommissionUpdated) slowProtoReflect() protoreflect.Message {
+	mi := &file_pocket_supplier_event_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventSupplierDelegationCommissionUpdated_messageType fastReflection_EventSupplierDelegationCommissionUpdated_messageType
+var _ protoreflect.MessageType = fastReflection_EventSupplierDelegationCommissionUpdated_messageType{}
+
+type fastReflection_EventSupplierDelegationCommissionUpdated_messageType struct{}
+
+func (x fastReflection_EventSupplierDelegationCommissionUpdated_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventSupplierDelegationCommissionUpdated)(nil)
+}
+func (x fastReflection_EventSupplierDelegationCommissionUpdated_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventSupplierDelegationCommissionUpdated)
+}
+func (x fastReflection_EventSupplierDelegationCommissionUpdated_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventSupplierDelegationCommissionUpdated
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventSupplierDelegationCommissionUpdated) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventSupplierDelegationCommissionUpdated
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventSupplierDelegationCommissionUpdated) Type() protoreflect.MessageType {
+	return _fastReflection_EventSupplierDelegationCommissionUpdated_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventSupplierDelegationCommissionUpdated) New() protoreflect.Message {
+	return new(fastReflection_EventSupplierDelegationCommissionUpdated)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventSupplierDelegationCommissionUpdated) Interface() protoreflect.ProtoMessage {
+	return (*EventSupplierDelegationCommissionUpdated)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventSupplierDelegationCommissionUpdated) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.OperatorAddress != "" {
+		value := protoreflect.ValueOfString(x.OperatorAddress)
+		if !f(fd_EventSupplierDelegationCommissionUpdated_operator_address, value) {
+			return
+		}
+	}
+	if x.PrevDelegationCommissionPercentage != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PrevDelegationCommissionPercentage)
+		if !f(fd_EventSupplierDelegationCommissionUpdated_prev_delegation_commission_percentage, value) {
+			return
+		}
+	}
+	if x.NewDelegationCommissionPercentage != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.NewDelegationCommissionPercentage)
+		if !f(fd_EventSupplierDelegationCommissionUpdated_new_delegation_commission_percentage, value) {
+			return
+		}
+	}
+	if x.EffectiveBlockHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.EffectiveBlockHeight)
+		if !f(fd_EventSupplierDelegationCommissionUpdated_effective_block_height, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventSupplierDelegationCommissionUpdated) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "pocket.supplier.EventSupplierDelegationCommissionUpdated.operator_address":
+		return x.OperatorAddress != ""
+	case "pocket.supplier.EventSupplierDelegationCommissionUpdated.prev_delegation_commission_percentage":
+		return x.PrevDelegationCommissionPercentage != uint64(0)
+	case "pocket.supplier.EventSupplierDelegationCommissionUpdated.new_delegation_commission_percentage":
+		return x.NewDelegationCommissionPercentage != uint64(0)
+	case "pocket.supplier.EventSupplierDelegationCommissionUpdated.effective_block_height":
+		return x.EffectiveBlockHeight != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.supplier.EventSupplierDelegationCommissionUpdated"))
+		}
+		panic(fmt.Errorf("message pocket.supplier.EventSupplierDelegationCommissionUpdated does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventSupplierDelegationCommissionUpdated) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "pocket.supplier.EventSupplierDelegationCommissionUpdated.operator_address":
+		x.OperatorAddress = ""
+	case "pocket.supplier.EventSupplierDelegationCommissionUpdated.prev_delegation_commission_percentage":
+		x.PrevDelegationCommissionPercentage = uint64(0)
+	case "pocket.supplier.EventSupplierDelegationCommissionUpdated.new_delegation_commission_percentage":
+		x.NewDelegationCommissionPercentage = uint64(0)
+	case "pocket.supplier.EventSupplierDelegationCommissionUpdated.effective_block_height":
+		x.EffectiveBlockHeight = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.supplier.EventSupplierDelegationCommissionUpdated"))
+		}
+		panic(fmt.Errorf("message pocket.supplier.EventSupplierDelegationCommissionUpdated does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventSupplierDelegationCommissionUpdated) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "pocket.supplier.EventSupplierDelegationCommissionUpdated.operator_address":
+		value := x.OperatorAddress
+		return protoreflect.ValueOfString(value)
+	case "pocket.supplier.EventSupplierDelegationCommissionUpdated.prev_delegation_commission_percentage":
+		value := x.PrevDelegationCommissionPercentage
+		return protoreflect.ValueOfUint64(value)
+	case "pocket.supplier.EventSupplierDelegationCommissionUpdated.new_delegation_commission_percentage":
+		value := x.NewDelegationCommissionPercentage
+		return protoreflect.ValueOfUint64(value)
+	case "pocket.supplier.EventSupplierDelegationCommissionUpdated.effective_block_height":
+		value := x.EffectiveBlockHeight
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.supplier.EventSupplierDelegationCommissionUpdated"))
+		}
+		panic(fmt.Errorf("message pocket.supplier.EventSupplierDelegationCommissionUpdated does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventSupplierDelegationCommissionUpdated) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "pocket.supplier.EventSupplierDelegationCommissionUpdated.operator_address":
+		x.OperatorAddress = value.Interface().(string)
+	case "pocket.supplier.EventSupplierDelegationCommissionUpdated.prev_delegation_commission_percentage":
+		x.PrevDelegationCommissionPercentage = value.Uint()
+	case "pocket.supplier.EventSupplierDelegationCommissionUpdated.new_delegation_commission_percentage":
+		x.NewDelegationCommissionPercentage = value.Uint()
+	case "pocket.supplier.EventSupplierDelegationCommissionUpdated.effective_block_height":
+		x.EffectiveBlockHeight = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.supplier.EventSupplierDelegationCommissionUpdated"))
+		}
+		panic(fmt.Errorf("message pocket.supplier.EventSupplierDelegationCommissionUpdated does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventSupplierDelegationCommissionUpdated) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "pocket.supplier.EventSupplierDelegationCommissionUpdated.operator_address":
+		panic(fmt.Errorf("field operator_address of message pocket.supplier.EventSupplierDelegationCommissionUpdated is not mutable"))
+	case "pocket.supplier.EventSupplierDelegationCommissionUpdated.prev_delegation_commission_percentage":
+		panic(fmt.Errorf("field prev_delegation_commission_percentage of message pocket.supplier.EventSupplierDelegationCommissionUpdated is not mutable"))
+	case "pocket.supplier.EventSupplierDelegationCommissionUpdated.new_delegation_commission_percentage":
+		panic(fmt.Errorf("field new_delegation_commission_percentage of message pocket.supplier.EventSupplierDelegationCommissionUpdated is not mutable"))
+	case "pocket.supplier.EventSupplierDelegationCommissionUpdated.effective_block_height":
+		panic(fmt.Errorf("field effective_block_height of message pocket.supplier.EventSupplierDelegationCommissionUpdated is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.supplier.EventSupplierDelegationCommissionUpdated"))
+		}
+		panic(fmt.Errorf("message pocket.supplier.EventSupplierDelegationCommissionUpdated does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventSupplierDelegationCommissionUpdated) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "pocket.supplier.EventSupplierDelegationCommissionUpdated.operator_address":
+		return protoreflect.ValueOfString("")
+	case "pocket.supplier.EventSupplierDelegationCommissionUpdated.prev_delegation_commission_percentage":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "pocket.supplier.EventSupplierDelegationCommissionUpdated.new_delegation_commission_percentage":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "pocket.supplier.EventSupplierDelegationCommissionUpdated.effective_block_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.supplier.EventSupplierDelegationCommissionUpdated"))
+		}
+		panic(fmt.Errorf("message pocket.supplier.EventSupplierDelegationCommissionUpdated does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventSupplierDelegationCommissionUpdated) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in pocket.supplier.EventSupplierDelegationCommissionUpdated", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventSupplierDelegationCommissionUpdated) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventSupplierDelegationCommissionUpdated) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventSupplierDelegationCommissionUpdated) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventSupplierDelegationCommissionUpdated) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventSupplierDelegationCommissionUpdated)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.OperatorAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.PrevDelegationCommissionPercentage != 0 {
+			n += 1 + runtime.Sov(uint64(x.PrevDelegationCommissionPercentage))
+		}
+		if x.NewDelegationCommissionPercentage != 0 {
+			n += 1 + runtime.Sov(uint64(x.NewDelegationCommissionPercentage))
+		}
+		if x.EffectiveBlockHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.EffectiveBlockHeight))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventSupplierDelegationCommissionUpdated)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.EffectiveBlockHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.EffectiveBlockHeight))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.NewDelegationCommissionPercentage != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NewDelegationCommissionPercentage))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.PrevDelegationCommissionPercentage != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PrevDelegationCommissionPercentage))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.OperatorAddress) > 0 {
+			i -= len(x.OperatorAddress)
+			copy(dAtA[i:], x.OperatorAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OperatorAddress)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventSupplierDelegationCommissionUpdated)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventSupplierDelegationCommissionUpdated: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventSupplierDelegationCommissionUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.OperatorAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PrevDelegationCommissionPercentage", wireType)
+				}
+				x.PrevDelegationCommissionPercentage = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PrevDelegationCommissionPercentage |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NewDelegationCommissionPercentage", wireType)
+				}
+				x.NewDelegationCommissionPercentage = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NewDelegationCommissionPercentage |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EffectiveBlockHeight", wireType)
+				}
+				x.EffectiveBlockHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.EffectiveBlockHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventSupplierProofFaultRecorded                  protoreflect.MessageDescriptor
+	fd_EventSupplierProofFaultRecorded_operator_address protoreflect.FieldDescriptor
+	fd_EventSupplierProofFaultRecorded_proof_fault      protoreflect.FieldDescriptor
+	fd_EventSupplierProofFaultRecorded_num_proof_faults protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_pocket_supplier_event_proto_init()
+	md_EventSupplierProofFaultRecorded = File_pocket_supplier_event_proto.Messages().ByName("EventSupplierProofFaultRecorded")
+	fd_EventSupplierProofFaultRecorded_operator_address = md_EventSupplierProofFaultRecorded.Fields().ByName("operator_address")
+	fd_EventSupplierProofFaultRecorded_proof_fault = md_EventSupplierProofFaultRecorded.Fields().ByName("proof_fault")
+	fd_EventSupplierProofFaultRecorded_num_proof_faults = md_EventSupplierProofFaultRecorded.Fields().ByName("num_proof_faults")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventSupplierProofFaultRecorded)(nil)
+
+type fastReflection_EventSupplierProofFaultRecorded EventSupplierProofFaultRecorded
+
+func (x *EventSupplierProofFaultRecorded) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventSupplierProofFaultRecorded)(x)
+}
+
+func (x *EventSupplierProofFaultRecorded) slowProtoReflect() protoreflect.Message {
+	mi := &file_pocket_supplier_event_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventSupplierProofFaultRecorded_messageType fastReflection_EventSupplierProofFaultRecorded_messageType
+var _ protoreflect.MessageType = fastReflection_EventSupplierProofFaultRecorded_messageType{}
+
+type fastReflection_EventSupplierProofFaultRecorded_messageType struct{}
+
+func (x fastReflection_EventSupplierProofFaultRecorded_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventSupplierProofFaultRecorded)(nil)
+}
+func (x fastReflection_EventSupplierProofFaultRecorded_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventSupplierProofFaultRecorded)
+}
+func (x fastReflection_EventSupplierProofFaultRecorded_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventSupplierProofFaultRecorded
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventSupplierProofFaultRecorded) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventSupplierProofFaultRecorded
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventSupplierProofFaultRecorded) Type() protoreflect.MessageType {
+	return _fastReflection_EventSupplierProofFaultRecorded_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventSupplierProofFaultRecorded) New() protoreflect.Message {
+	return new(fastReflection_EventSupplierProofFaultRecorded)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventSupplierProofFaultRecorded) Interface() protoreflect.ProtoMessage {
+	return (*EventSupplierProofFaultRecorded)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventSupplierProofFaultRecorded) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.OperatorAddress != "" {
+		value := protoreflect.ValueOfString(x.OperatorAddress)
+		if !f(fd_EventSupplierProofFaultRecorded_operator_address, value) {
+			return
+		}
+	}
+	if x.ProofFault != nil {
+		value := protoreflect.ValueOfMessage(x.ProofFault.ProtoReflect())
+		if !f(fd_EventSupplierProofFaultRecorded_proof_fault, value) {
+			return
+		}
+	}
+	if x.NumProofFaults != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.NumProofFaults)
+		if !f(fd_EventSupplierProofFaultRecorded_num_proof_faults, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventSupplierProofFaultRecorded) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "pocket.supplier.EventSupplierProofFaultRecorded.operator_address":
+		return x.OperatorAddress != ""
+	case "pocket.supplier.EventSupplierProofFaultRecorded.proof_fault":
+		return x.ProofFault != nil
+	case "pocket.supplier.EventSupplierProofFaultRecorded.num_proof_faults":
+		return x.NumProofFaults != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.supplier.EventSupplierProofFaultRecorded"))
+		}
+		panic(fmt.Errorf("message pocket.supplier.EventSupplierProofFaultRecorded does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventSupplierProofFaultRecorded) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "pocket.supplier.EventSupplierProofFaultRecorded.operator_address":
+		x.OperatorAddress = ""
+	case "pocket.supplier.EventSupplierProofFaultRecorded.proof_fault":
+		x.ProofFault = nil
+	case "pocket.supplier.EventSupplierProofFaultRecorded.num_proof_faults":
+		x.NumProofFaults = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.supplier.EventSupplierProofFaultRecorded"))
+		}
+		panic(fmt.Errorf("message pocket.supplier.EventSupplierProofFaultRecorded does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventSupplierProofFaultRecorded) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "pocket.supplier.EventSupplierProofFaultRecorded.operator_address":
+		value := x.OperatorAddress
+		return protoreflect.ValueOfString(value)
+	case "pocket.supplier.EventSupplierProofFaultRecorded.proof_fault":
+		value := x.ProofFault
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "pocket.supplier.EventSupplierProofFaultRecorded.num_proof_faults":
+		value := x.NumProofFaults
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.supplier.EventSupplierProofFaultRecorded"))
+		}
+		panic(fmt.Errorf("message pocket.supplier.EventSupplierProofFaultRecorded does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventSupplierProofFaultRecorded) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "pocket.supplier.EventSupplierProofFaultRecorded.operator_address":
+		x.OperatorAddress = value.Interface().(string)
+	case "pocket.supplier.EventSupplierProofFaultRecorded.proof_fault":
+		x.ProofFault = value.Message().Interface().(*ProofFault)
+	case "pocket.supplier.EventSupplierProofFaultRecorded.num_proof_faults":
+		x.NumProofFaults = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.supplier.EventSupplierProofFaultRecorded"))
+		}
+		panic(fmt.Errorf("message pocket.supplier.EventSupplierProofFaultRecorded does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventSupplierProofFaultRecorded) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "pocket.supplier.EventSupplierProofFaultRecorded.proof_fault":
+		if x.ProofFault == nil {
+			x.ProofFault = new(ProofFault)
+		}
+		return protoreflect.ValueOfMessage(x.ProofFault.ProtoReflect())
+	case "pocket.supplier.EventSupplierProofFaultRecorded.operator_address":
+		panic(fmt.Errorf("field operator_address of message pocket.supplier.EventSupplierProofFaultRecorded is not mutable"))
+	case "pocket.supplier.EventSupplierProofFaultRecorded.num_proof_faults":
+		panic(fmt.Errorf("field num_proof_faults of message pocket.supplier.EventSupplierProofFaultRecorded is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.supplier.EventSupplierProofFaultRecorded"))
+		}
+		panic(fmt.Errorf("message pocket.supplier.EventSupplierProofFaultRecorded does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventSupplierProofFaultRecorded) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "pocket.supplier.EventSupplierProofFaultRecorded.operator_address":
+		return protoreflect.ValueOfString("")
+	case "pocket.supplier.EventSupplierProofFaultRecorded.proof_fault":
+		m := new(ProofFault)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "pocket.supplier.EventSupplierProofFaultRecorded.num_proof_faults":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.supplier.EventSupplierProofFaultRecorded"))
+		}
+		panic(fmt.Errorf("message pocket.supplier.EventSupplierProofFaultRecorded does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventSupplierProofFaultRecorded) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in pocket.supplier.EventSupplierProofFaultRecorded", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventSupplierProofFaultRecorded) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventSupplierProofFaultRecorded) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventSupplierProofFaultRecorded) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventSupplierProofFaultRecorded) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventSupplierProofFaultRecorded)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.OperatorAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ProofFault != nil {
+			l = options.Size(x.ProofFault)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.NumProofFaults != 0 {
+			n += 1 + runtime.Sov(uint64(x.NumProofFaults))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventSupplierProofFaultRecorded)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.NumProofFaults != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NumProofFaults))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.ProofFault != nil {
+			encoded, err := options.Marshal(x.ProofFault)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.OperatorAddress) > 0 {
+			i -= len(x.OperatorAddress)
+			copy(dAtA[i:], x.OperatorAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OperatorAddress)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventSupplierProofFaultRecorded)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventSupplierProofFaultRecorded: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventSupplierProofFaultRecorded: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.OperatorAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProofFault", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.ProofFault == nil {
+					x.ProofFault = &ProofFault{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ProofFault); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NumProofFaults", wireType)
+				}
+				x.NumProofFaults = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NumProofFaults |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventSupplierJailed                    protoreflect.MessageDescriptor
+	fd_EventSupplierJailed_supplier           protoreflect.FieldDescriptor
+	fd_EventSupplierJailed_num_proof_faults   protoreflect.FieldDescriptor
+	fd_EventSupplierJailed_session_end_height protoreflect.FieldDescriptor
+	fd_EventSupplierJailed_jail_end_height    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_pocket_supplier_event_proto_init()
+	md_EventSupplierJailed = File_pocket_supplier_event_proto.Messages().ByName("EventSupplierJailed")
+	fd_EventSupplierJailed_supplier = md_EventSupplierJailed.Fields().ByName("supplier")
+	fd_EventSupplierJailed_num_proof_faults = md_EventSupplierJailed.Fields().ByName("num_proof_faults")
+	fd_EventSupplierJailed_session_end_height = md_EventSupplierJailed.Fields().ByName("session_end_height")
+	fd_EventSupplierJailed_jail_end_height = md_EventSupplierJailed.Fields().ByName("jail_end_height")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventSupplierJailed)(nil)
+
+type fastReflection_EventSupplierJailed EventSupplierJailed
+
+func (x *EventSupplierJailed) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventSupplierJailed)(x)
+}
+
+func (x *EventSupplierJailed) slowProtoReflect() protoreflect.Message {
+	mi := &file_pocket_supplier_event_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventSupplierJailed_messageType fastReflection_EventSupplierJailed_messageType
+var _ protoreflect.MessageType = fastReflection_EventSupplierJailed_messageType{}
+
+type fastReflection_EventSupplierJailed_messageType struct{}
+
+func (x fastReflection_EventSupplierJailed_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventSupplierJailed)(nil)
+}
+func (x fastReflection_EventSupplierJailed_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventSupplierJailed)
+}
+func (x fastReflection_EventSupplierJailed_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventSupplierJailed
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventSupplierJailed) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventSupplierJailed
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventSupplierJailed) Type() protoreflect.MessageType {
+	return _fastReflection_EventSupplierJailed_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventSupplierJailed) New() protoreflect.Message {
+	return new(fastReflection_EventSupplierJailed)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventSupplierJailed) Interface() protoreflect.ProtoMessage {
+	return (*EventSupplierJailed)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventSupplierJailed) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Supplier != nil {
+		value := protoreflect.ValueOfMessage(x.Supplier.ProtoReflect())
+		if !f(fd_EventSupplierJailed_supplier, value) {
+			return
+		}
+	}
+	if x.NumProofFaults != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.NumProofFaults)
+		if !f(fd_EventSupplierJailed_num_proof_faults, value) {
+			return
+		}
+	}
+	if x.SessionEndHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.SessionEndHeight)
+		if !f(fd_EventSupplierJailed_session_end_height, value) {
+			return
+		}
+	}
+	if x.JailEndHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.JailEndHeight)
+		if !f(fd_EventSupplierJailed_jail_end_height, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventSupplierJailed) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "pocket.supplier.EventSupplierJailed.supplier":
+		return x.Supplier != nil
+	case "pocket.supplier.EventSupplierJailed.num_proof_faults":
+		return x.NumProofFaults != uint64(0)
+	case "pocket.supplier.EventSupplierJailed.session_end_height":
+		return x.SessionEndHeight != int64(0)
+	case "pocket.supplier.EventSupplierJailed.jail_end_height":
+		return x.JailEndHeight != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.supplier.EventSupplierJailed"))
+		}
+		panic(fmt.Errorf("message pocket.supplier.EventSupplierJailed does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventSupplierJailed) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "pocket.supplier.EventSupplierJailed.supplier":
+		x.Supplier = nil
+	case "pocket.supplier.EventSupplierJailed.num_proof_faults":
+		x.NumProofFaults = uint64(0)
+	case "pocket.supplier.EventSupplierJailed.session_end_height":
+		x.SessionEndHeight = int64(0)
+	case "pocket.supplier.EventSupplierJailed.jail_end_height":
+		x.JailEndHeight = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.supplier.EventSupplierJailed"))
+		}
+		panic(fmt.Errorf("message pocket.supplier.EventSupplierJailed does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventSupplierJailed) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "pocket.supplier.EventSupplierJailed.supplier":
+		value := x.Supplier
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "pocket.supplier.EventSupplierJailed.num_proof_faults":
+		value := x.NumProofFaults
+		return protoreflect.ValueOfUint64(value)
+	case "pocket.supplier.EventSupplierJailed.session_end_height":
+		value := x.SessionEndHeight
+		return protoreflect.ValueOfInt64(value)
+	case "pocket.supplier.EventSupplierJailed.jail_end_height":
+		value := x.JailEndHeight
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.supplier.EventSupplierJailed"))
+		}
+		panic(fmt.Errorf("message pocket.supplier.EventSupplierJailed does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventSupplierJailed) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "pocket.supplier.EventSupplierJailed.supplier":
+		x.Supplier = value.Message().Interface().(*shared.Supplier)
+	case "pocket.supplier.EventSupplierJailed.num_proof_faults":
+		x.NumProofFaults = value.Uint()
+	case "pocket.supplier.EventSupplierJailed.session_end_height":
+		x.SessionEndHeight = value.Int()
+	case "pocket.supplier.EventSupplierJailed.jail_end_height":
+		x.JailEndHeight = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.supplier.EventSupplierJailed"))
+		}
+		panic(fmt.Errorf("message pocket.supplier.EventSupplierJailed does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventSupplierJailed) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "pocket.supplier.EventSupplierJailed.supplier":
+		if x.Supplier == nil {
+			x.Supplier = new(shared.Supplier)
+		}
+		return protoreflect.ValueOfMessage(x.Supplier.ProtoReflect())
+	case "pocket.supplier.EventSupplierJailed.num_proof_faults":
+		panic(fmt.Errorf("field num_proof_faults of message pocket.supplier.EventSupplierJailed is not mutable"))
+	case "pocket.supplier.EventSupplierJailed.session_end_height":
+		panic(fmt.Errorf("field session_end_height of message pocket.supplier.EventSupplierJailed is not mutable"))
+	case "pocket.supplier.EventSupplierJailed.jail_end_height":
+		panic(fmt.Errorf("field jail_end_height of message pocket.supplier.EventSupplierJailed is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.supplier.EventSupplierJailed"))
+		}
+		panic(fmt.Errorf("message pocket.supplier.EventSupplierJailed does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventSupplierJailed) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "pocket.supplier.EventSupplierJailed.supplier":
+		m := new(shared.Supplier)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "pocket.supplier.EventSupplierJailed.num_proof_faults":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "pocket.supplier.EventSupplierJailed.session_end_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "pocket.supplier.EventSupplierJailed.jail_end_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.supplier.EventSupplierJailed"))
+		}
+		panic(fmt.Errorf("message pocket.supplier.EventSupplierJailed does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventSupplierJailed) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in pocket.supplier.EventSupplierJailed", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventSupplierJailed) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventSupplierJailed) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventSupplierJailed) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventSupplierJailed) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventSupplierJailed)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Supplier != nil {
+			l = options.Size(x.Supplier)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.NumProofFaults != 0 {
+			n += 1 + runtime.Sov(uint64(x.NumProofFaults))
+		}
+		if x.SessionEndHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.SessionEndHeight))
+		}
+		if x.JailEndHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.JailEndHeight))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventSupplierJailed)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.JailEndHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.JailEndHeight))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.SessionEndHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SessionEndHeight))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.NumProofFaults != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NumProofFaults))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Supplier != nil {
+			encoded, err := options.Marshal(x.Supplier)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventSupplierJailed)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventSupplierJailed: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventSupplierJailed: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Supplier", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Supplier == nil {
+					x.Supplier = &shared.Supplier{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Supplier); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NumProofFaults", wireType)
+				}
+				x.NumProofFaults = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NumProofFaults |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SessionEndHeight", wireType)
+				}
+				x.SessionEndHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SessionEndHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field JailEndHeight", wireType)
+				}
+				x.JailEndHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.JailEndHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventSupplierUnjailed                    protoreflect.MessageDescriptor
+	fd_EventSupplierUnjailed_supplier           protoreflect.FieldDescriptor
+	fd_EventSupplierUnjailed_session_end_height protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_pocket_supplier_event_proto_init()
+	md_EventSupplierUnjailed = File_pocket_supplier_event_proto.Messages().ByName("EventSupplierUnjailed")
+	fd_EventSupplierUnjailed_supplier = md_EventSupplierUnjailed.Fields().ByName("supplier")
+	fd_EventSupplierUnjailed_session_end_height = md_EventSupplierUnjailed.Fields().ByName("session_end_height")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventSupplierUnjailed)(nil)
+
+type fastReflection_EventSupplierUnjailed EventSupplierUnjailed
+
+func (x *EventSupplierUnjailed) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventSupplierUnjailed)(x)
+}
+
+func (x *EventSupplierUnjailed) slowProtoReflect() protoreflect.Message {
+	mi := &file_pocket_supplier_event_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventSupplierUnjailed_messageType fastReflection_EventSupplierUnjailed_messageType
+var _ protoreflect.MessageType = fastReflection_EventSupplierUnjailed_messageType{}
+
+type fastReflection_EventSupplierUnjailed_messageType struct{}
+
+func (x fastReflection_EventSupplierUnjailed_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventSupplierUnjailed)(nil)
+}
+func (x fastReflection_EventSupplierUnjailed_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventSupplierUnjailed)
+}
+func (x fastReflection_EventSupplierUnjailed_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventSupplierUnjailed
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventSupplierUnjailed) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventSupplierUnjailed
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventSupplierUnjailed) Type() protoreflect.MessageType {
+	return _fastReflection_EventSupplierUnjailed_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventSupplierUnjailed) New() protoreflect.Message {
+	return new(fastReflection_EventSupplierUnjailed)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventSupplierUnjailed) Interface() protoreflect.ProtoMessage {
+	return (*EventSupplierUnjailed)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventSupplierUnjailed) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Supplier != nil {
+		value := protoreflect.ValueOfMessage(x.Supplier.ProtoReflect())
+		if !f(fd_EventSupplierUnjailed_supplier, value) {
+			return
+		}
+	}
+	if x.SessionEndHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.SessionEndHeight)
+		if !f(fd_EventSupplierUnjailed_session_end_height, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventSupplierUnjailed) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "pocket.supplier.EventSupplierUnjailed.supplier":
+		return x.Supplier != nil
+	case "pocket.supplier.EventSupplierUnjailed.session_end_height":
+		return x.SessionEndHeight != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.supplier.EventSupplierUnjailed"))
+		}
+		panic(fmt.Errorf("message pocket.supplier.EventSupplierUnjailed does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventSupplierUnjailed) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "pocket.supplier.EventSupplierUnjailed.supplier":
+		x.Supplier = nil
+	case "pocket.supplier.EventSupplierUnjailed.session_end_height":
+		x.SessionEndHeight = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.supplier.EventSupplierUnjailed"))
+		}
+		panic(fmt.Errorf("message pocket.supplier.EventSupplierUnjailed does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventSupplierUnjailed) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "pocket.supplier.EventSupplierUnjailed.supplier":
+		value := x.Supplier
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "pocket.supplier.EventSupplierUnjailed.session_end_height":
+		value := x.SessionEndHeight
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.supplier.EventSupplierUnjailed"))
+		}
+		panic(fmt.Errorf("message pocket.supplier.EventSupplierUnjailed does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventSupplierUnjailed) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "pocket.supplier.EventSupplierUnjailed.supplier":
+		x.Supplier = value.Message().Interface().(*shared.Supplier)
+	case "pocket.supplier.EventSupplierUnjailed.session_end_height":
+		x.SessionEndHeight = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.supplier.EventSupplierUnjailed"))
+		}
+		panic(fmt.Errorf("message pocket.supplier.EventSupplierUnjailed does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventSupplierUnjailed) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "pocket.supplier.EventSupplierUnjailed.supplier":
+		if x.Supplier == nil {
+			x.Supplier = new(shared.Supplier)
+		}
+		return protoreflect.ValueOfMessage(x.Supplier.ProtoReflect())
+	case "pocket.supplier.EventSupplierUnjailed.session_end_height":
+		panic(fmt.Errorf("field session_end_height of message pocket.supplier.EventSupplierUnjailed is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.supplier.EventSupplierUnjailed"))
+		}
+		panic(fmt.Errorf("message pocket.supplier.EventSupplierUnjailed does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventSupplierUnjailed) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "pocket.supplier.EventSupplierUnjailed.supplier":
+		m := new(shared.Supplier)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "pocket.supplier.EventSupplierUnjailed.session_end_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.supplier.EventSupplierUnjailed"))
+		}
+		panic(fmt.Errorf("message pocket.supplier.EventSupplierUnjailed does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventSupplierUnjailed) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in pocket.supplier.EventSupplierUnjailed", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventSupplierUnjailed) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventSupplierUnjailed) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventSupplierUnjailed) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventSupplierUnjailed) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventSupplierUnjailed)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Supplier != nil {
+			l = options.Size(x.Supplier)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.SessionEndHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.SessionEndHeight))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventSupplierUnjailed)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.SessionEndHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SessionEndHeight))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Supplier != nil {
+			encoded, err := options.Marshal(x.Supplier)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventSupplierUnjailed)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventSupplierUnjailed: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventSupplierUnjailed: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Supplier", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Supplier == nil {
+					x.Supplier = &shared.Supplier{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Supplier); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SessionEndHeight", wireType)
+				}
+				x.SessionEndHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SessionEndHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
 // 	protoc-gen-go v1.27.0
 // 	protoc        (unknown)
 // source: pocket/supplier/event.proto
 
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SupplierUnbondingReason int32
+
+const (
+	SupplierUnbondingReason_SUPPLIER_UNBONDING_REASON_UNSPECIFIED     SupplierUnbondingReason = 0
+	SupplierUnbondingReason_SUPPLIER_UNBONDING_REASON_VOLUNTARY       SupplierUnbondingReason = 1
+	SupplierUnbondingReason_SUPPLIER_UNBONDING_REASON_BELOW_MIN_STAKE SupplierUnbondingReason = 2
+)
+
+// Enum value maps for SupplierUnbondingReason.
+var (
+	SupplierUnbondingReason_name = map[int32]string{
+		0: "SUPPLIER_UNBONDING_REASON_UNSPECIFIED",
+		1: "SUPPLIER_UNBONDING_REASON_VOLUNTARY",
+		2: "SUPPLIER_UNBONDING_REASON_BELOW_MIN_STAKE",
+	}
+	SupplierUnbondingReason_value = map[string]int32{
+		"SUPPLIER_UNBONDING_REASON_UNSPECIFIED":     0,
+		"SUPPLIER_UNBONDING_REASON_VOLUNTARY":       1,
+		"SUPPLIER_UNBONDING_REASON_BELOW_MIN_STAKE": 2,
+	}
+)
+
+func (x SupplierUnbondingReason) Enum() *SupplierUnbondingReason {
+	p := new(SupplierUnbondingReason)
+	*p = x
+	return p
+}
+
+func (x SupplierUnbondingReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SupplierUnbondingReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_pocket_supplier_event_proto_enumTypes[0].Descriptor()
+}
+
+func (SupplierUnbondingReason) Type() protoreflect.EnumType {
+	return &file_pocket_supplier_event_proto_enumTypes[0]
+}
+
+func (x SupplierUnbondingReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SupplierUnbondingReason.Descriptor instead.
+func (SupplierUnbondingReason) EnumDescriptor() ([]byte, []int) {
+	return file_pocket_supplier_event_proto_rawDescGZIP(), []int{0}
+}
+
+type DelegationUnbondingReason int32
+
+const (
+	DelegationUnbondingReason_DELEGATION_UNBONDING_REASON_UNSPECIFIED       DelegationUnbondingReason = 0
+	DelegationUnbondingReason_DELEGATION_UNBONDING_REASON_VOLUNTARY         DelegationUnbondingReason = 1
+	DelegationUnbondingReason_DELEGATION_UNBONDING_REASON_SUPPLIER_UNBONDED DelegationUnbondingReason = 2
+)
+
+// Enum value maps for DelegationUnbondingReason.
+var (
+	DelegationUnbondingReason_name = map[int32]string{
+		0: "DELEGATION_UNBONDING_REASON_UNSPECIFIED",
+		1: "DELEGATION_UNBONDING_REASON_VOLUNTARY",
+		2: "DELEGATION_UNBONDING_REASON_SUPPLIER_UNBONDED",
+	}
+	DelegationUnbondingReason_value = map[string]int32{
+		"DELEGATION_UNBONDING_REASON_UNSPECIFIED":       0,
+		"DELEGATION_UNBONDING_REASON_VOLUNTARY":         1,
+		"DELEGATION_UNBONDING_REASON_SUPPLIER_UNBONDED": 2,
+	}
+)
+
+func (x DelegationUnbondingReason) Enum() *DelegationUnbondingReason {
+	p := new(DelegationUnbondingReason)
+	*p = x
+	return p
+}
+
+func (x DelegationUnbondingReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DelegationUnbondingReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_pocket_supplier_event_proto_enumTypes[1].Descriptor()
+}
+
+func (DelegationUnbondingReason) Type() protoreflect.EnumType {
+	return &file_pocket_supplier_event_proto_enumTypes[1]
+}
+
+func (x DelegationUnbondingReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DelegationUnbondingReason.Descriptor instead.
+func (DelegationUnbondingReason) EnumDescriptor() ([]byte, []int) {
+	return file_pocket_supplier_event_proto_rawDescGZIP(), []int{1}
+}
+
+// EventSupplierStaked is emitted when a supplier stake message is committed onchain.
+type EventSupplierStaked struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Supplier *shared.Supplier `protobuf:"bytes,1,opt,name=supplier,proto3" json:"supplier,omitempty"`
+	// The session end height of the last session in which the supplier was staked.
+	SessionEndHeight int64 `protobuf:"varint,2,opt,name=session_end_height,json=sessionEndHeight,proto3" json:"session_end_height,omitempty"`
+}
+
+func (x *EventSupplierStaked) Reset() {
+	*x = EventSupplierStaked{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pocket_supplier_event_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventSupplierStaked) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventSupplierStaked) ProtoMessage() {}
+
+// Deprecated: Use EventSupplierStaked.ProtoReflect.Descriptor instead.
+func (*EventSupplierStaked) Descriptor() ([]byte, []int) {
+	return file_pocket_supplier_event_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *EventSupplierStaked) GetSupplier() *shared.Supplier {
+	if x != nil {
+		return x.Supplier
+	}
+	return nil
+}
+
+func (x *EventSupplierStaked) GetSessionEndHeight() int64 {
+	if x != nil {
+		return x.SessionEndHeight
+	}
+	return 0
+}
+
+// EventSupplierUnbondingBegin is emitted when an application unstake message
+// is committed onchain, indicating that the supplier will now begin unbonding.
+type EventSupplierUnbondingBegin struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Supplier *shared.Supplier        `protobuf:"bytes,1,opt,name=supplier,proto3" json:"supplier,omitempty"`
+	Reason   SupplierUnbondingReason `protobuf:"varint,2,opt,name=reason,proto3,enum=pocket.supplier.SupplierUnbondingReason" json:"reason,omitempty"`
+	// The session end height of the last session in which the supplier unbonding began.
+	SessionEndHeight int64 `protobuf:"varint,3,opt,name=session_end_height,json=sessionEndHeight,proto3" json:"session_end_height,omitempty"`
+	// The height at which supplier unbonding will end.
+	UnbondingEndHeight int64 `protobuf:"varint,4,opt,name=unbonding_end_height,json=unbondingEndHeight,proto3" json:"unbonding_end_height,omitempty"`
+}
+
+func (x *EventSupplierUnbondingBegin) Reset() {
+	*x = EventSupplierUnbondingBegin{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pocket_supplier_event_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventSupplierUnbondingBegin) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventSupplierUnbondingBegin) ProtoMessage() {}
+
+// Deprecated: Use EventSupplierUnbondingBegin.ProtoReflect.Descriptor instead.
+func (*EventSupplierUnbondingBegin) Descriptor() ([]byte, []int) {
+	return file_pocket_supplier_event_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *EventSupplierUnbondingBegin) GetSupplier() *shared.Supplier {
+	if x != nil {
+		return x.Supplier
+	}
+	return nil
+}
+
+func (x *EventSupplierUnbondingBegin) GetReason() SupplierUnbondingReason {
+	if x != nil {
+		return x.Reason
+	}
+	return SupplierUnbondingReason_SUPPLIER_UNBONDING_REASON_UNSPECIFIED
+}
+
+func (x *EventSupplierUnbondingBegin) GetSessionEndHeight() int64 {
+	if x != nil {
+		return x.SessionEndHeight
+	}
+	return 0
+}
+
+func (x *EventSupplierUnbondingBegin) GetUnbondingEndHeight() int64 {
+	if x != nil {
+		return x.UnbondingEndHeight
+	}
+	return 0
+}
+
+// EventSupplierUnbondingEnd is emitted when an supplier has completed
+// unbonding. The unbonding period is determined by the shared param,
+// supplier_unbonding_period_sessions.
+type EventSupplierUnbondingEnd struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Supplier *shared.Supplier        `protobuf:"bytes,1,opt,name=supplier,proto3" json:"supplier,omitempty"`
+	Reason   SupplierUnbondingReason `protobuf:"varint,2,opt,name=reason,proto3,enum=pocket.supplier.SupplierUnbondingReason" json:"reason,omitempty"`
+	// The session end height of the session in which the supplier unbonding endeded.
+	SessionEndHeight int64 `protobuf:"varint,3,opt,name=session_end_height,json=sessionEndHeight,proto3" json:"session_end_height,omitempty"`
+	// The height at which supplier unbonding will end.
+	UnbondingEndHeight int64 `protobuf:"varint,4,opt,name=unbonding_end_height,json=unbondingEndHeight,proto3" json:"unbonding_end_height,omitempty"`
+}
+
+func (x *EventSupplierUnbondingEnd) Reset() {
+	*x = EventSupplierUnbondingEnd{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pocket_supplier_event_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-type SupplierUnbondingReason int32
+func (x *EventSupplierUnbondingEnd) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
-const (
-	SupplierUnbondingReason_SUPPLIER_UNBONDING_REASON_UNSPECIFIED     SupplierUnbondingReason = 0
-	SupplierUnbondingReason_SUPPLIER_UNBONDING_REASON_VOLUNTARY       SupplierUnbondingReason = 1
-	SupplierUnbondingReason_SUPPLIER_UNBONDING_REASON_BELOW_MIN_STAKE SupplierUnbondingReason = 2
-)
+func (*EventSupplierUnbondingEnd) ProtoMessage() {}
 
-// Enum value maps for SupplierUnbondingReason.
-var (
-	SupplierUnbondingReason_name = map[int32]string{
-		0: "SUPPLIER_UNBONDING_REASON_UNSPECIFIED",
-		1: "SUPPLIER_UNBONDING_REASON_VOLUNTARY",
-		2: "SUPPLIER_UNBONDING_REASON_BELOW_MIN_STAKE",
+// Deprecated: Use EventSupplierUnbondingEnd.ProtoReflect.Descriptor instead.
+func (*EventSupplierUnbondingEnd) Descriptor() ([]byte, []int) {
+	return file_pocket_supplier_event_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *EventSupplierUnbondingEnd) GetSupplier() *shared.Supplier {
+	if x != nil {
+		return x.Supplier
 	}
-	SupplierUnbondingReason_value = map[string]int32{
-		"SUPPLIER_UNBONDING_REASON_UNSPECIFIED":     0,
-		"SUPPLIER_UNBONDING_REASON_VOLUNTARY":       1,
-		"SUPPLIER_UNBONDING_REASON_BELOW_MIN_STAKE": 2,
+	return nil
+}
+
+func (x *EventSupplierUnbondingEnd) GetReason() SupplierUnbondingReason {
+	if x != nil {
+		return x.Reason
 	}
-)
+	return SupplierUnbondingReason_SUPPLIER_UNBONDING_REASON_UNSPECIFIED
+}
 
-func (x SupplierUnbondingReason) Enum() *SupplierUnbondingReason {
-	p := new(SupplierUnbondingReason)
-	*p = x
-	return p
+func (x *EventSupplierUnbondingEnd) GetSessionEndHeight() int64 {
+	if x != nil {
+		return x.SessionEndHeight
+	}
+	return 0
+}
+
+func (x *EventSupplierUnbondingEnd) GetUnbondingEndHeight() int64 {
+	if x != nil {
+		return x.UnbondingEndHeight
+	}
+	return 0
+}
+
+// EventSupplierUnbondingCanceled is emitted when an supplier which was unbonding
+// successfully (re-)stakes before the unbonding period has elapsed. An EventSupplierStaked
+// event will also be emitted immediately after this event.
+type EventSupplierUnbondingCanceled struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Supplier *shared.Supplier `protobuf:"bytes,1,opt,name=supplier,proto3" json:"supplier,omitempty"`
+	// The exact height at which the supplier unbonding was canceled.
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// The session end height of the session in which the supplier unbonding was canceled.
+	SessionEndHeight int64 `protobuf:"varint,2,opt,name=session_end_height,json=sessionEndHeight,proto3" json:"session_end_height,omitempty"`
+}
+
+func (x *EventSupplierUnbondingCanceled) Reset() {
+	*x = EventSupplierUnbondingCanceled{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pocket_supplier_event_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventSupplierUnbondingCanceled) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventSupplierUnbondingCanceled) ProtoMessage() {}
+
+// Deprecated: Use EventSupplierUnbondingCanceled.ProtoReflect.Descriptor instead.
+func (*EventSupplierUnbondingCanceled) Descriptor() ([]byte, []int) {
+	return file_pocket_supplier_event_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *EventSupplierUnbondingCanceled) GetSupplier() *shared.Supplier {
+	if x != nil {
+		return x.Supplier
+	}
+	return nil
+}
+
+func (x *EventSupplierUnbondingCanceled) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *EventSupplierUnbondingCanceled) GetSessionEndHeight() int64 {
+	if x != nil {
+		return x.SessionEndHeight
+	}
+	return 0
+}
+
+// EventSupplierServiceConfigActivated is emitted when a supplier service configuration
+// becomes effective at a specific block height.
+type EventSupplierServiceConfigActivated struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// supplier contains the complete updated supplier information including the
+	// active service configurations in supplier.Services.
+	Supplier *shared.Supplier `protobuf:"bytes,1,opt,name=supplier,proto3" json:"supplier,omitempty"`
+	// activation_height indicates the block height at which the new service
+	// configurations became active.
+	ActivationHeight int64 `protobuf:"varint,2,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+}
+
+func (x *EventSupplierServiceConfigActivated) Reset() {
+	*x = EventSupplierServiceConfigActivated{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pocket_supplier_event_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventSupplierServiceConfigActivated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventSupplierServiceConfigActivated) ProtoMessage() {}
+
+// Deprecated: Use EventSupplierServiceConfigActivated.ProtoReflect.Descriptor instead.
+func (*EventSupplierServiceConfigActivated) Descriptor() ([]byte, []int) {
+	return file_pocket_supplier_event_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *EventSupplierServiceConfigActivated) GetSupplier() *shared.Supplier {
+	if x != nil {
+		return x.Supplier
+	}
+	return nil
+}
+
+func (x *EventSupplierServiceConfigActivated) GetActivationHeight() int64 {
+	if x != nil {
+		return x.ActivationHeight
+	}
+	return 0
+}
+
+// EventSupplierDelegated is emitted when a delegate to supplier message is committed onchain.
+type EventSupplierDelegated struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Delegation *Delegation `protobuf:"bytes,1,opt,name=delegation,proto3" json:"delegation,omitempty"`
+	// The session end height of the session in which the delegation was made.
+	SessionEndHeight int64 `protobuf:"varint,2,opt,name=session_end_height,json=sessionEndHeight,proto3" json:"session_end_height,omitempty"`
+}
+
+func (x *EventSupplierDelegated) Reset() {
+	*x = EventSupplierDelegated{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pocket_supplier_event_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventSupplierDelegated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventSupplierDelegated) ProtoMessage() {}
+
+// Deprecated: Use EventSupplierDelegated.ProtoReflect.Descriptor instead.
+func (*EventSupplierDelegated) Descriptor() ([]byte, []int) {
+	return file_pocket_supplier_event_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *EventSupplierDelegated) GetDelegation() *Delegation {
+	if x != nil {
+		return x.Delegation
+	}
+	return nil
+}
+
+func (x *EventSupplierDelegated) GetSessionEndHeight() int64 {
+	if x != nil {
+		return x.SessionEndHeight
+	}
+	return 0
+}
+
+// EventSupplierDelegationUnbondingBegin is emitted when an undelegate from supplier
+// message is committed onchain, indicating that the delegation will now begin unbonding.
+type EventSupplierDelegationUnbondingBegin struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Delegation *Delegation `protobuf:"bytes,1,opt,name=delegation,proto3" json:"delegation,omitempty"`
+	// The session end height of the session in which the delegation unbonding began.
+	SessionEndHeight int64 `protobuf:"varint,2,opt,name=session_end_height,json=sessionEndHeight,proto3" json:"session_end_height,omitempty"`
+	// The height at which the delegation unbonding will end.
+	UnbondingEndHeight int64 `protobuf:"varint,3,opt,name=unbonding_end_height,json=unbondingEndHeight,proto3" json:"unbonding_end_height,omitempty"`
+}
+
+func (x *EventSupplierDelegationUnbondingBegin) Reset() {
+	*x = EventSupplierDelegationUnbondingBegin{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pocket_supplier_event_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventSupplierDelegationUnbondingBegin) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventSupplierDelegationUnbondingBegin) ProtoMessage() {}
+
+// Deprecated: Use EventSupplierDelegationUnbondingBegin.ProtoReflect.Descriptor instead.
+func (*EventSupplierDelegationUnbondingBegin) Descriptor() ([]byte, []int) {
+	return file_pocket_supplier_event_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *EventSupplierDelegationUnbondingBegin) GetDelegation() *Delegation {
+	if x != nil {
+		return x.Delegation
+	}
+	return nil
+}
+
+func (x *EventSupplierDelegationUnbondingBegin) GetSessionEndHeight() int64 {
+	if x != nil {
+		return x.SessionEndHeight
+	}
+	return 0
+}
+
+func (x *EventSupplierDelegationUnbondingBegin) GetUnbondingEndHeight() int64 {
+	if x != nil {
+		return x.UnbondingEndHeight
+	}
+	return 0
+}
+
+// EventSupplierDelegationUnbondingEnd is emitted when a delegation has completed
+// unbonding, either because the unbonding period, determined by the shared param
+// supplier_unbonding_period_sessions, has elapsed or because the supplier unbonded.
+type EventSupplierDelegationUnbondingEnd struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Delegation *Delegation               `protobuf:"bytes,1,opt,name=delegation,proto3" json:"delegation,omitempty"`
+	Reason     DelegationUnbondingReason `protobuf:"varint,2,opt,name=reason,proto3,enum=pocket.supplier.DelegationUnbondingReason" json:"reason,omitempty"`
+	// The session end height of the session in which the delegation unbonding ended.
+	SessionEndHeight int64 `protobuf:"varint,3,opt,name=session_end_height,json=sessionEndHeight,proto3" json:"session_end_height,omitempty"`
+	// The height at which the delegation unbonding ended.
+	UnbondingEndHeight int64 `protobuf:"varint,4,opt,name=unbonding_end_height,json=unbondingEndHeight,proto3" json:"unbonding_end_height,omitempty"`
+}
+
+func (x *EventSupplierDelegationUnbondingEnd) Reset() {
+	*x = EventSupplierDelegationUnbondingEnd{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pocket_supplier_event_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-func (x SupplierUnbondingReason) String() string {
-	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+func (x *EventSupplierDelegationUnbondingEnd) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (SupplierUnbondingReason) Descriptor() protoreflect.EnumDescriptor {
-	return file_pocket_supplier_event_proto_enumTypes[0].Descriptor()
+func (*EventSupplierDelegationUnbondingEnd) ProtoMessage() {}
+
+// Deprecated: Use EventSupplierDelegationUnbondingEnd.ProtoReflect.Descriptor instead.
+func (*EventSupplierDelegationUnbondingEnd) Descriptor() ([]byte, []int) {
+	return file_pocket_supplier_event_proto_rawDescGZIP(), []int{7}
 }
 
-func (SupplierUnbondingReason) Type() protoreflect.EnumType {
-	return &file_pocket_supplier_event_proto_enumTypes[0]
+func (x *EventSupplierDelegationUnbondingEnd) GetDelegation() *Delegation {
+	if x != nil {
+		return x.Delegation
+	}
+	return nil
 }
 
-func (x SupplierUnbondingReason) Number() protoreflect.EnumNumber {
-	return protoreflect.EnumNumber(x)
+func (x *EventSupplierDelegationUnbondingEnd) GetReason() DelegationUnbondingReason {
+	if x != nil {
+		return x.Reason
+	}
+	return DelegationUnbondingReason_DELEGATION_UNBONDING_REASON_UNSPECIFIED
 }
 
-// Deprecated: Use SupplierUnbondingReason.Descriptor instead.
-func (SupplierUnbondingReason) EnumDescriptor() ([]byte, []int) {
-	return file_pocket_supplier_event_proto_rawDescGZIP(), []int{0}
+func (x *EventSupplierDelegationUnbondingEnd) GetSessionEndHeight() int64 {
+	if x != nil {
+		return x.SessionEndHeight
+	}
+	return 0
 }
 
-// EventSupplierStaked is emitted when a supplier stake message is committed onchain.
-type EventSupplierStaked struct {
+func (x *EventSupplierDelegationUnbondingEnd) GetUnbondingEndHeight() int64 {
+	if x != nil {
+		return x.UnbondingEndHeight
+	}
+	return 0
+}
+
+// EventSupplierDelegationUnbondingCanceled is emitted when a delegator which was
+// undelegating delegates to the same supplier again before the unbonding period
+// has elapsed. An EventSupplierDelegated event will also be emitted immediately after this event.
+type EventSupplierDelegationUnbondingCanceled struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Supplier *shared.Supplier `protobuf:"bytes,1,opt,name=supplier,proto3" json:"supplier,omitempty"`
-	// The session end height of the last session in which the supplier was staked.
-	SessionEndHeight int64 `protobuf:"varint,2,opt,name=session_end_height,json=sessionEndHeight,proto3" json:"session_end_height,omitempty"`
+	Delegation *Delegation `protobuf:"bytes,1,opt,name=delegation,proto3" json:"delegation,omitempty"`
+	// The exact height at which the delegation unbonding was canceled.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// The session end height of the session in which the delegation unbonding was canceled.
+	SessionEndHeight int64 `protobuf:"varint,3,opt,name=session_end_height,json=sessionEndHeight,proto3" json:"session_end_height,omitempty"`
 }
 
-func (x *EventSupplierStaked) Reset() {
-	*x = EventSupplierStaked{}
+func (x *EventSupplierDelegationUnbondingCanceled) Reset() {
+	*x = EventSupplierDelegationUnbondingCanceled{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pocket_supplier_event_proto_msgTypes[0]
+		mi := &file_pocket_supplier_event_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EventSupplierStaked) String() string {
+func (x *EventSupplierDelegationUnbondingCanceled) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventSupplierStaked) ProtoMessage() {}
+func (*EventSupplierDelegationUnbondingCanceled) ProtoMessage() {}
 
-// Deprecated: Use EventSupplierStaked.ProtoReflect.Descriptor instead.
-func (*EventSupplierStaked) Descriptor() ([]byte, []int) {
-	return file_pocket_supplier_event_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use EventSupplierDelegationUnbondingCanceled.ProtoReflect.Descriptor instead.
+func (*EventSupplierDelegationUnbondingCanceled) Descriptor() ([]byte, []int) {
+	return file_pocket_supplier_event_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *EventSupplierStaked) GetSupplier() *shared.Supplier {
+func (x *EventSupplierDelegationUnbondingCanceled) GetDelegation() *Delegation {
 	if x != nil {
-		return x.Supplier
+		return x.Delegation
 	}
 	return nil
 }
 
-func (x *EventSupplierStaked) GetSessionEndHeight() int64 {
+func (x *EventSupplierDelegationUnbondingCanceled) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *EventSupplierDelegationUnbondingCanceled) GetSessionEndHeight() int64 {
 	if x != nil {
 		return x.SessionEndHeight
 	}
 	return 0
 }
 
-// EventSupplierUnbondingBegin is emitted when an application unstake message
-// is committed onchain, indicating that the supplier will now begin unbonding.
-type EventSupplierUnbondingBegin struct {
+// EventSupplierDelegationCommissionUpdated is emitted when a supplier owner updates
+// the commission it keeps from the delegators' share of the settlement rewards.
+type EventSupplierDelegationCommissionUpdated struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Supplier *shared.Supplier        `protobuf:"bytes,1,opt,name=supplier,proto3" json:"supplier,omitempty"`
-	Reason   SupplierUnbondingReason `protobuf:"varint,2,opt,name=reason,proto3,enum=pocket.supplier.SupplierUnbondingReason" json:"reason,omitempty"`
-	// The session end height of the last session in which the supplier unbonding began.
-	SessionEndHeight int64 `protobuf:"varint,3,opt,name=session_end_height,json=sessionEndHeight,proto3" json:"session_end_height,omitempty"`
-	// The height at which supplier unbonding will end.
-	UnbondingEndHeight int64 `protobuf:"varint,4,opt,name=unbonding_end_height,json=unbondingEndHeight,proto3" json:"unbonding_end_height,omitempty"`
+	OperatorAddress                    string `protobuf:"bytes,1,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty"`
+	PrevDelegationCommissionPercentage uint64 `protobuf:"varint,2,opt,name=prev_delegation_commission_percentage,json=prevDelegationCommissionPercentage,proto3" json:"prev_delegation_commission_percentage,omitempty"`
+	NewDelegationCommissionPercentage  uint64 `protobuf:"varint,3,opt,name=new_delegation_commission_percentage,json=newDelegationCommissionPercentage,proto3" json:"new_delegation_commission_percentage,omitempty"`
+	// The start height of the first session settled with the new delegation commission.
+	EffectiveBlockHeight int64 `protobuf:"varint,4,opt,name=effective_block_height,json=effectiveBlockHeight,proto3" json:"effective_block_height,omitempty"`
 }
 
-func (x *EventSupplierUnbondingBegin) Reset() {
-	*x = EventSupplierUnbondingBegin{}
+func (x *EventSupplierDelegationCommissionUpdated) Reset() {
+	*x = EventSupplierDelegationCommissionUpdated{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pocket_supplier_event_proto_msgTypes[1]
+		mi := &file_pocket_supplier_event_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EventSupplierUnbondingBegin) String() string {
+func (x *EventSupplierDelegationCommissionUpdated) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventSupplierUnbondingBegin) ProtoMessage() {}
+func (*EventSupplierDelegationCommissionUpdated) ProtoMessage() {}
 
-// Deprecated: Use EventSupplierUnbondingBegin.ProtoReflect.Descriptor instead.
-func (*EventSupplierUnbondingBegin) Descriptor() ([]byte, []int) {
-	return file_pocket_supplier_event_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use EventSupplierDelegationCommissionUpdated.ProtoReflect.Descriptor instead.
+func (*EventSupplierDelegationCommissionUpdated) Descriptor() ([]byte, []int) {
+	return file_pocket_supplier_event_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *EventSupplierUnbondingBegin) GetSupplier() *shared.Supplier {
+func (x *EventSupplierDelegationCommissionUpdated) GetOperatorAddress() string {
 	if x != nil {
-		return x.Supplier
+		return x.OperatorAddress
 	}
-	return nil
+	return ""
 }
 
-func (x *EventSupplierUnbondingBegin) GetReason() SupplierUnbondingReason {
+func (x *EventSupplierDelegationCommissionUpdated) GetPrevDelegationCommissionPercentage() uint64 {
 	if x != nil {
-		return x.Reason
+		return x.PrevDelegationCommissionPercentage
 	}
-	return SupplierUnbondingReason_SUPPLIER_UNBONDING_REASON_UNSPECIFIED
+	return 0
 }
 
-func (x *EventSupplierUnbondingBegin) GetSessionEndHeight() int64 {
+func (x *EventSupplierDelegationCommissionUpdated) GetNewDelegationCommissionPercentage() uint64 {
 	if x != nil {
-		return x.SessionEndHeight
+		return x.NewDelegationCommissionPercentage
 	}
 	return 0
 }
 
-func (x *EventSupplierUnbondingBegin) GetUnbondingEndHeight() int64 {
+func (x *EventSupplierDelegationCommissionUpdated) GetEffectiveBlockHeight() int64 {
 	if x != nil {
-		return x.UnbondingEndHeight
+		return x.EffectiveBlockHeight
 	}
 	return 0
 }
 
-// EventSupplierUnbondingEnd is emitted when an supplier has completed
-// unbonding. The unbonding period is determined by the shared param,
-// supplier_unbonding_period_sessions.
-type EventSupplierUnbondingEnd struct {
+// EventSupplierProofFaultRecorded is emitted when a claim of a supplier expires
+// because its required proof was missing or invalid.
+type EventSupplierProofFaultRecorded struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Supplier *shared.Supplier        `protobuf:"bytes,1,opt,name=supplier,proto3" json:"supplier,omitempty"`
-	Reason   SupplierUnbondingReason `protobuf:"varint,2,opt,name=reason,proto3,enum=pocket.supplier.SupplierUnbondingReason" json:"reason,omitempty"`
-	// The session end height of the session in which the supplier unbonding endeded.
-	SessionEndHeight int64 `protobuf:"varint,3,opt,name=session_end_height,json=sessionEndHeight,proto3" json:"session_end_height,omitempty"`
-	// The height at which supplier unbonding will end.
-	UnbondingEndHeight int64 `protobuf:"varint,4,opt,name=unbonding_end_height,json=unbondingEndHeight,proto3" json:"unbonding_end_height,omitempty"`
+	OperatorAddress string      `protobuf:"bytes,1,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty"`
+	ProofFault      *ProofFault `protobuf:"bytes,2,opt,name=proof_fault,json=proofFault,proto3" json:"proof_fault,omitempty"`
+	// The number of proof faults of the supplier within the proof fault window,
+	// including the recorded one.
+	NumProofFaults uint64 `protobuf:"varint,3,opt,name=num_proof_faults,json=numProofFaults,proto3" json:"num_proof_faults,omitempty"`
 }
 
-func (x *EventSupplierUnbondingEnd) Reset() {
-	*x = EventSupplierUnbondingEnd{}
+func (x *EventSupplierProofFaultRecorded) Reset() {
+	*x = EventSupplierProofFaultRecorded{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pocket_supplier_event_proto_msgTypes[2]
+		mi := &file_pocket_supplier_event_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EventSupplierUnbondingEnd) String() string {
+func (x *EventSupplierProofFaultRecorded) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventSupplierUnbondingEnd) ProtoMessage() {}
-
-// Deprecated: Use EventSupplierUnbondingEnd.ProtoReflect.Descriptor instead.
-func (*EventSupplierUnbondingEnd) Descriptor() ([]byte, []int) {
-	return file_pocket_supplier_event_proto_rawDescGZIP(), []int{2}
-}
+func (*EventSupplierProofFaultRecorded) ProtoMessage() {}
 
-func (x *EventSupplierUnbondingEnd) GetSupplier() *shared.Supplier {
-	if x != nil {
-		return x.Supplier
-	}
-	return nil
+// Deprecated: Use EventSupplierProofFaultRecorded.ProtoReflect.Descriptor instead.
+func (*EventSupplierProofFaultRecorded) Descriptor() ([]byte, []int) {
+	return file_pocket_supplier_event_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *EventSupplierUnbondingEnd) GetReason() SupplierUnbondingReason {
+func (x *EventSupplierProofFaultRecorded) GetOperatorAddress() string {
 	if x != nil {
-		return x.Reason
+		return x.OperatorAddress
 	}
-	return SupplierUnbondingReason_SUPPLIER_UNBONDING_REASON_UNSPECIFIED
+	return ""
 }
 
-func (x *EventSupplierUnbondingEnd) GetSessionEndHeight() int64 {
+func (x *EventSupplierProofFaultRecorded) GetProofFault() *ProofFault {
 	if x != nil {
-		return x.SessionEndHeight
+		return x.ProofFault
 	}
-	return 0
+	return nil
 }
 
-func (x *EventSupplierUnbondingEnd) GetUnbondingEndHeight() int64 {
+func (x *EventSupplierProofFaultRecorded) GetNumProofFaults() uint64 {
 	if x != nil {
-		return x.UnbondingEndHeight
+		return x.NumProofFaults
 	}
 	return 0
 }
 
-// EventSupplierUnbondingCanceled is emitted when an supplier which was unbonding
-// successfully (re-)stakes before the unbonding period has elapsed. An EventSupplierStaked
-// event will also be emitted immediately after this event.
-type EventSupplierUnbondingCanceled struct {
+// EventSupplierJailed is emitted when a supplier reaches the proof_fault_jailing_threshold
+// number of proof faults within the proof fault window. The supplier is excluded
+// from sessions starting from the next session.
+type EventSupplierJailed struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Supplier *shared.Supplier `protobuf:"bytes,1,opt,name=supplier,proto3" json:"supplier,omitempty"`
-	// The exact height at which the supplier unbonding was canceled.
-	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
-	// The session end height of the session in which the supplier unbonding was canceled.
-	SessionEndHeight int64 `protobuf:"varint,2,opt,name=session_end_height,json=sessionEndHeight,proto3" json:"session_end_height,omitempty"`
+	// The number of proof faults of the supplier within the proof fault window,
+	// since it was last jailed, which made it reach the jailing threshold.
+	NumProofFaults uint64 `protobuf:"varint,2,opt,name=num_proof_faults,json=numProofFaults,proto3" json:"num_proof_faults,omitempty"`
+	// The session end height of the session in which the supplier was jailed.
+	SessionEndHeight int64 `protobuf:"varint,3,opt,name=session_end_height,json=sessionEndHeight,proto3" json:"session_end_height,omitempty"`
+	// The session end height of the last session the supplier is jailed for.
+	JailEndHeight int64 `protobuf:"varint,4,opt,name=jail_end_height,json=jailEndHeight,proto3" json:"jail_end_height,omitempty"`
 }
 
-func (x *EventSupplierUnbondingCanceled) Reset() {
-	*x = EventSupplierUnbondingCanceled{}
+func (x *EventSupplierJailed) Reset() {
+	*x = EventSupplierJailed{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pocket_supplier_event_proto_msgTypes[3]
+		mi := &file_pocket_supplier_event_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EventSupplierUnbondingCanceled) String() string {
+func (x *EventSupplierJailed) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventSupplierUnbondingCanceled) ProtoMessage() {}
+func (*EventSupplierJailed) ProtoMessage() {}
 
-// Deprecated: Use EventSupplierUnbondingCanceled.ProtoReflect.Descriptor instead.
-func (*EventSupplierUnbondingCanceled) Descriptor() ([]byte, []int) {
-	return file_pocket_supplier_event_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use EventSupplierJailed.ProtoReflect.Descriptor instead.
+func (*EventSupplierJailed) Descriptor() ([]byte, []int) {
+	return file_pocket_supplier_event_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *EventSupplierUnbondingCanceled) GetSupplier() *shared.Supplier {
+func (x *EventSupplierJailed) GetSupplier() *shared.Supplier {
 	if x != nil {
 		return x.Supplier
 	}
 	return nil
 }
 
-func (x *EventSupplierUnbondingCanceled) GetHeight() int64 {
+func (x *EventSupplierJailed) GetNumProofFaults() uint64 {
 	if x != nil {
-		return x.Height
+		return x.NumProofFaults
 	}
 	return 0
 }
 
-func (x *EventSupplierUnbondingCanceled) GetSessionEndHeight() int64 {
+func (x *EventSupplierJailed) GetSessionEndHeight() int64 {
 	if x != nil {
 		return x.SessionEndHeight
 	}
 	return 0
 }
 
-// EventSupplierServiceConfigActivated is emitted when a supplier service configuration
-// becomes effective at a specific block height.
-type EventSupplierServiceConfigActivated struct {
+func (x *EventSupplierJailed) GetJailEndHeight() int64 {
+	if x != nil {
+		return x.JailEndHeight
+	}
+	return 0
+}
+
+// EventSupplierUnjailed is emitted when an unjail supplier message is committed onchain.
+// The supplier is included in sessions again starting from the next session.
+type EventSupplierUnjailed struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// supplier contains the complete updated supplier information including the
-	// active service configurations in supplier.Services.
 	Supplier *shared.Supplier `protobuf:"bytes,1,opt,name=supplier,proto3" json:"supplier,omitempty"`
-	// activation_height indicates the block height at which the new service
-	// configurations became active.
-	ActivationHeight int64 `protobuf:"varint,2,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+	// The session end height of the session in which the supplier was unjailed.
+	SessionEndHeight int64 `protobuf:"varint,2,opt,name=session_end_height,json=sessionEndHeight,proto3" json:"session_end_height,omitempty"`
 }
 
-func (x *EventSupplierServiceConfigActivated) Reset() {
-	*x = EventSupplierServiceConfigActivated{}
+func (x *EventSupplierUnjailed) Reset() {
+	*x = EventSupplierUnjailed{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pocket_supplier_event_proto_msgTypes[4]
+		mi := &file_pocket_supplier_event_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EventSupplierServiceConfigActivated) String() string {
+func (x *EventSupplierUnjailed) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventSupplierServiceConfigActivated) ProtoMessage() {}
+func (*EventSupplierUnjailed) ProtoMessage() {}
 
-// Deprecated: Use EventSupplierServiceConfigActivated.ProtoReflect.Descriptor instead.
-func (*EventSupplierServiceConfigActivated) Descriptor() ([]byte, []int) {
-	return file_pocket_supplier_event_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use EventSupplierUnjailed.ProtoReflect.Descriptor instead.
+func (*EventSupplierUnjailed) Descriptor() ([]byte, []int) {
+	return file_pocket_supplier_event_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *EventSupplierServiceConfigActivated) GetSupplier() *shared.Supplier {
+func (x *EventSupplierUnjailed) GetSupplier() *shared.Supplier {
 	if x != nil {
 		return x.Supplier
 	}
 	return nil
 }
 
-func (x *EventSupplierServiceConfigActivated) GetActivationHeight() int64 {
+func (x *EventSupplierUnjailed) GetSessionEndHeight() int64 {
 	if x != nil {
-		return x.ActivationHeight
+		return x.SessionEndHeight
 	}
 	return 0
 }
@@ -3022,102 +7817,243 @@ var file_pocket_supplier_event_proto_rawDesc = []byte{
 	0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x62,
 	0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
 	0x1c, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2f, 0x73,
-	0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x9e, 0x01,
-	0x0a, 0x13, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x53,
-	0x74, 0x61, 0x6b, 0x65, 0x64, 0x12, 0x41, 0x0a, 0x08, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65,
-	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74,
-	0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72,
-	0x42, 0x0c, 0xea, 0xde, 0x1f, 0x08, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x52, 0x08,
-	0x73, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x12, 0x44, 0x0a, 0x12, 0x73, 0x65, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x03, 0x42, 0x16, 0xea, 0xde, 0x1f, 0x12, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x10, 0x73, 0x65,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0xc0,
-	0x02, 0x0a, 0x1b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72,
-	0x55, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x12, 0x41,
-	0x0a, 0x08, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x17, 0x2e, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64,
-	0x2e, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x42, 0x0c, 0xea, 0xde, 0x1f, 0x08, 0x73,
-	0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x52, 0x08, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65,
-	0x72, 0x12, 0x4c, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x28, 0x2e, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x73, 0x75, 0x70, 0x70, 0x6c,
-	0x69, 0x65, 0x72, 0x2e, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x55, 0x6e, 0x62, 0x6f,
-	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x42, 0x0a, 0xea, 0xde, 0x1f,
-	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12,
-	0x44, 0x0a, 0x12, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x68,
-	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x42, 0x16, 0xea, 0xde, 0x1f,
+	0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x70,
+	0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2f, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x2f, 0x64,
+	0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x21, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2f, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72,
+	0x2f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x5f, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0x9e, 0x01, 0x0a, 0x13, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x75, 0x70, 0x70,
+	0x6c, 0x69, 0x65, 0x72, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x64, 0x12, 0x41, 0x0a, 0x08, 0x73, 0x75,
+	0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70,
+	0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x53, 0x75, 0x70,
+	0x70, 0x6c, 0x69, 0x65, 0x72, 0x42, 0x0c, 0xea, 0xde, 0x1f, 0x08, 0x73, 0x75, 0x70, 0x70, 0x6c,
+	0x69, 0x65, 0x72, 0x52, 0x08, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x12, 0x44, 0x0a,
 	0x12, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x68, 0x65, 0x69,
-	0x67, 0x68, 0x74, 0x52, 0x10, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x64, 0x48,
-	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x4a, 0x0a, 0x14, 0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69,
-	0x6e, 0x67, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x03, 0x42, 0x18, 0xea, 0xde, 0x1f, 0x14, 0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69,
-	0x6e, 0x67, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x12, 0x75,
-	0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x64, 0x48, 0x65, 0x69, 0x67, 0x68,
-	0x74, 0x22, 0xbe, 0x02, 0x0a, 0x19, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x75, 0x70, 0x70, 0x6c,
-	0x69, 0x65, 0x72, 0x55, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x64, 0x12,
+	0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x16, 0xea, 0xde, 0x1f, 0x12, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x52, 0x10, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x64, 0x48, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x22, 0xc0, 0x02, 0x0a, 0x1b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x75, 0x70,
+	0x70, 0x6c, 0x69, 0x65, 0x72, 0x55, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x65,
+	0x67, 0x69, 0x6e, 0x12, 0x41, 0x0a, 0x08, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x73,
+	0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x42, 0x0c,
+	0xea, 0xde, 0x1f, 0x08, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x52, 0x08, 0x73, 0x75,
+	0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x12, 0x4c, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x28, 0x2e, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e,
+	0x73, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x2e, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65,
+	0x72, 0x55, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x42, 0x0a, 0xea, 0xde, 0x1f, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x12, 0x44, 0x0a, 0x12, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f,
+	0x65, 0x6e, 0x64, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x42, 0x16, 0xea, 0xde, 0x1f, 0x12, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x6e,
+	0x64, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x10, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x45, 0x6e, 0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x4a, 0x0a, 0x14, 0x75, 0x6e,
+	0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x42, 0x18, 0xea, 0xde, 0x1f, 0x14, 0x75, 0x6e,
+	0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x52, 0x12, 0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x64,
+	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0xbe, 0x02, 0x0a, 0x19, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x53, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x55, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x45, 0x6e, 0x64, 0x12, 0x41, 0x0a, 0x08, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e,
+	0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x42,
+	0x0c, 0xea, 0xde, 0x1f, 0x08, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x52, 0x08, 0x73,
+	0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x12, 0x4c, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x28, 0x2e, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74,
+	0x2e, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x2e, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x69,
+	0x65, 0x72, 0x55, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x42, 0x0a, 0xea, 0xde, 0x1f, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x44, 0x0a, 0x12, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x42, 0x16, 0xea, 0xde, 0x1f, 0x12, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x65,
+	0x6e, 0x64, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x10, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x45, 0x6e, 0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x4a, 0x0a, 0x14, 0x75,
+	0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x42, 0x18, 0xea, 0xde, 0x1f, 0x14, 0x75,
+	0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x52, 0x12, 0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x45, 0x6e,
+	0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0xcd, 0x01, 0x0a, 0x1e, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x55, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x65, 0x64, 0x12, 0x41, 0x0a, 0x08, 0x73, 0x75,
+	0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70,
+	0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x53, 0x75, 0x70,
+	0x70, 0x6c, 0x69, 0x65, 0x72, 0x42, 0x0c, 0xea, 0xde, 0x1f, 0x08, 0x73, 0x75, 0x70, 0x70, 0x6c,
+	0x69, 0x65, 0x72, 0x52, 0x08, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x12, 0x22, 0x0a,
+	0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0a, 0xea,
+	0xde, 0x1f, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x12, 0x44, 0x0a, 0x12, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x6e, 0x64,
+	0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x16, 0xea,
+	0xde, 0x1f, 0x12, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x10, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x45, 0x6e,
+	0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0xac, 0x01, 0x0a, 0x23, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x64, 0x12,
 	0x41, 0x0a, 0x08, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x17, 0x2e, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65,
 	0x64, 0x2e, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x42, 0x0c, 0xea, 0xde, 0x1f, 0x08,
 	0x73, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x52, 0x08, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x69,
-	0x65, 0x72, 0x12, 0x4c, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x28, 0x2e, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x73, 0x75, 0x70, 0x70,
-	0x6c, 0x69, 0x65, 0x72, 0x2e, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x55, 0x6e, 0x62,
-	0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x42, 0x0a, 0xea, 0xde,
-	0x1f, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x65, 0x72, 0x12, 0x42, 0x0a, 0x11, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x15, 0xea,
+	0xde, 0x1f, 0x11, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x52, 0x10, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0xab, 0x01, 0x0a, 0x16, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x53, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65,
+	0x64, 0x12, 0x4b, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x73,
+	0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x42, 0x0e, 0xea, 0xde, 0x1f, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x44,
+	0x0a, 0x12, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x16, 0xea, 0xde, 0x1f, 0x12,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x52, 0x10, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x64, 0x48, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x22, 0x86, 0x02, 0x0a, 0x25, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x75,
+	0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x55, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x12, 0x4b,
+	0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x73, 0x75, 0x70, 0x70,
+	0x6c, 0x69, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42,
+	0x0e, 0xea, 0xde, 0x1f, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x0a, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x44, 0x0a, 0x12, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x16, 0xea, 0xde, 0x1f, 0x12, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52,
+	0x10, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x64, 0x48, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x12, 0x4a, 0x0a, 0x14, 0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x65,
+	0x6e, 0x64, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x42,
+	0x18, 0xea, 0xde, 0x1f, 0x14, 0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x65,
+	0x6e, 0x64, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x12, 0x75, 0x6e, 0x62, 0x6f, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0xd4, 0x02,
+	0x0a, 0x23, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x44,
+	0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x45, 0x6e, 0x64, 0x12, 0x4b, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x6f, 0x63, 0x6b,
+	0x65, 0x74, 0x2e, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0e, 0xea, 0xde, 0x1f, 0x0a, 0x64, 0x65, 0x6c, 0x65,
+	0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x4e, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x2a, 0x2e, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x73, 0x75, 0x70, 0x70,
+	0x6c, 0x69, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55,
+	0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x42, 0x0a,
+	0xea, 0xde, 0x1f, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x12, 0x44, 0x0a, 0x12, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x6e,
+	0x64, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x42, 0x16,
+	0xea, 0xde, 0x1f, 0x12, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x6e, 0x64, 0x5f,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x10, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x45,
+	0x6e, 0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x4a, 0x0a, 0x14, 0x75, 0x6e, 0x62, 0x6f,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x42, 0x18, 0xea, 0xde, 0x1f, 0x14, 0x75, 0x6e, 0x62, 0x6f,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x52, 0x12, 0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x64, 0x48, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x22, 0xe1, 0x01, 0x0a, 0x28, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x75,
+	0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x55, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x65,
+	0x64, 0x12, 0x4b, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x73,
+	0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x42, 0x0e, 0xea, 0xde, 0x1f, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22,
+	0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0a,
+	0xea, 0xde, 0x1f, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x12, 0x44, 0x0a, 0x12, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x6e,
+	0x64, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x42, 0x16,
+	0xea, 0xde, 0x1f, 0x12, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x6e, 0x64, 0x5f,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x10, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x45,
+	0x6e, 0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0xb6, 0x03, 0x0a, 0x28, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x3f, 0x0a, 0x10, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f,
+	0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x14, 0xea, 0xde, 0x1f, 0x10, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x0f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x7c, 0x0a, 0x25, 0x70, 0x72, 0x65, 0x76, 0x5f, 0x64,
+	0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x29, 0xea, 0xde, 0x1f, 0x25, 0x70, 0x72, 0x65, 0x76, 0x5f,
+	0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65,
+	0x52, 0x22, 0x70, 0x72, 0x65, 0x76, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e,
+	0x74, 0x61, 0x67, 0x65, 0x12, 0x79, 0x0a, 0x24, 0x6e, 0x65, 0x77, 0x5f, 0x64, 0x65, 0x6c, 0x65,
+	0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x42, 0x28, 0xea, 0xde, 0x1f, 0x24, 0x6e, 0x65, 0x77, 0x5f, 0x64, 0x65, 0x6c, 0x65,
+	0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x52, 0x21, 0x6e, 0x65,
+	0x77, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12,
+	0x50, 0x0a, 0x16, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x42,
+	0x1a, 0xea, 0xde, 0x1f, 0x16, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x14, 0x65, 0x66, 0x66,
+	0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x22, 0xf1, 0x01, 0x0a, 0x1f, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x75, 0x70, 0x70, 0x6c,
+	0x69, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x65, 0x64, 0x12, 0x3f, 0x0a, 0x10, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f,
+	0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x14, 0xea, 0xde, 0x1f, 0x10, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x0f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x4d, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x5f,
+	0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x6f,
+	0x63, 0x6b, 0x65, 0x74, 0x2e, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x2e, 0x50, 0x72,
+	0x6f, 0x6f, 0x66, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x42, 0x0f, 0xea, 0xde, 0x1f, 0x0b, 0x70, 0x72,
+	0x6f, 0x6f, 0x66, 0x5f, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x6f, 0x66,
+	0x46, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x3e, 0x0a, 0x10, 0x6e, 0x75, 0x6d, 0x5f, 0x70, 0x72, 0x6f,
+	0x6f, 0x66, 0x5f, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42,
+	0x14, 0xea, 0xde, 0x1f, 0x10, 0x6e, 0x75, 0x6d, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x5f, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x73, 0x52, 0x0e, 0x6e, 0x75, 0x6d, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x46,
+	0x61, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x9b, 0x02, 0x0a, 0x13, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53,
+	0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x4a, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x41, 0x0a,
+	0x08, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e,
+	0x53, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x42, 0x0c, 0xea, 0xde, 0x1f, 0x08, 0x73, 0x75,
+	0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x52, 0x08, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72,
+	0x12, 0x3e, 0x0a, 0x10, 0x6e, 0x75, 0x6d, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x5f, 0x66, 0x61,
+	0x75, 0x6c, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x14, 0xea, 0xde, 0x1f, 0x10,
+	0x6e, 0x75, 0x6d, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x5f, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73,
+	0x52, 0x0e, 0x6e, 0x75, 0x6d, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x73,
 	0x12, 0x44, 0x0a, 0x12, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x6e, 0x64, 0x5f,
 	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x42, 0x16, 0xea, 0xde,
 	0x1f, 0x12, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x68, 0x65,
 	0x69, 0x67, 0x68, 0x74, 0x52, 0x10, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x64,
-	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x4a, 0x0a, 0x14, 0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64,
-	0x69, 0x6e, 0x67, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x03, 0x42, 0x18, 0xea, 0xde, 0x1f, 0x14, 0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64,
-	0x69, 0x6e, 0x67, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x12,
-	0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x64, 0x48, 0x65, 0x69, 0x67,
-	0x68, 0x74, 0x22, 0xcd, 0x01, 0x0a, 0x1e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x75, 0x70, 0x70,
-	0x6c, 0x69, 0x65, 0x72, 0x55, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x61, 0x6e,
-	0x63, 0x65, 0x6c, 0x65, 0x64, 0x12, 0x41, 0x0a, 0x08, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65,
-	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74,
-	0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72,
-	0x42, 0x0c, 0xea, 0xde, 0x1f, 0x08, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x52, 0x08,
-	0x73, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67,
-	0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0a, 0xea, 0xde, 0x1f, 0x06, 0x68, 0x65,
-	0x69, 0x67, 0x68, 0x74, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x44, 0x0a, 0x12,
-	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x68, 0x65, 0x69, 0x67,
-	0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x16, 0xea, 0xde, 0x1f, 0x12, 0x73, 0x65,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
-	0x52, 0x10, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x64, 0x48, 0x65, 0x69, 0x67,
-	0x68, 0x74, 0x22, 0xac, 0x01, 0x0a, 0x23, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x75, 0x70, 0x70,
-	0x6c, 0x69, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x64, 0x12, 0x41, 0x0a, 0x08, 0x73, 0x75,
-	0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70,
-	0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x53, 0x75, 0x70,
-	0x70, 0x6c, 0x69, 0x65, 0x72, 0x42, 0x0c, 0xea, 0xde, 0x1f, 0x08, 0x73, 0x75, 0x70, 0x70, 0x6c,
-	0x69, 0x65, 0x72, 0x52, 0x08, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x12, 0x42, 0x0a,
-	0x11, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x65, 0x69, 0x67,
-	0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x15, 0xea, 0xde, 0x1f, 0x11, 0x61, 0x63,
-	0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52,
-	0x10, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x65, 0x69, 0x67, 0x68,
-	0x74, 0x2a, 0x9c, 0x01, 0x0a, 0x17, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x55, 0x6e,
-	0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x29, 0x0a,
-	0x25, 0x53, 0x55, 0x50, 0x50, 0x4c, 0x49, 0x45, 0x52, 0x5f, 0x55, 0x4e, 0x42, 0x4f, 0x4e, 0x44,
-	0x49, 0x4e, 0x47, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
-	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x27, 0x0a, 0x23, 0x53, 0x55, 0x50, 0x50,
-	0x4c, 0x49, 0x45, 0x52, 0x5f, 0x55, 0x4e, 0x42, 0x4f, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x52,
-	0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x56, 0x4f, 0x4c, 0x55, 0x4e, 0x54, 0x41, 0x52, 0x59, 0x10,
-	0x01, 0x12, 0x2d, 0x0a, 0x29, 0x53, 0x55, 0x50, 0x50, 0x4c, 0x49, 0x45, 0x52, 0x5f, 0x55, 0x4e,
-	0x42, 0x4f, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x42,
-	0x45, 0x4c, 0x4f, 0x57, 0x5f, 0x4d, 0x49, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x4b, 0x45, 0x10, 0x02,
-	0x42, 0xa4, 0x01, 0xd8, 0xe2, 0x1e, 0x01, 0x0a, 0x13, 0x63, 0x6f, 0x6d, 0x2e, 0x70, 0x6f, 0x63,
-	0x6b, 0x65, 0x74, 0x2e, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x42, 0x0a, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x20, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x6f, 0x63,
-	0x6b, 0x65, 0x74, 0x2f, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0xa2, 0x02, 0x03, 0x50,
-	0x53, 0x58, 0xaa, 0x02, 0x0f, 0x50, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x53, 0x75, 0x70, 0x70,
-	0x6c, 0x69, 0x65, 0x72, 0xca, 0x02, 0x0f, 0x50, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x5c, 0x53, 0x75,
-	0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0xe2, 0x02, 0x1b, 0x50, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x5c,
-	0x53, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x10, 0x50, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x3a, 0x3a, 0x53,
-	0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x3b, 0x0a, 0x0f, 0x6a, 0x61, 0x69, 0x6c, 0x5f, 0x65,
+	0x6e, 0x64, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x42,
+	0x13, 0xea, 0xde, 0x1f, 0x0f, 0x6a, 0x61, 0x69, 0x6c, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x52, 0x0d, 0x6a, 0x61, 0x69, 0x6c, 0x45, 0x6e, 0x64, 0x48, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x22, 0xa0, 0x01, 0x0a, 0x15, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x75, 0x70,
+	0x70, 0x6c, 0x69, 0x65, 0x72, 0x55, 0x6e, 0x6a, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x41, 0x0a,
+	0x08, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e,
+	0x53, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x42, 0x0c, 0xea, 0xde, 0x1f, 0x08, 0x73, 0x75,
+	0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x52, 0x08, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72,
+	0x12, 0x44, 0x0a, 0x12, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x6e, 0x64, 0x5f,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x16, 0xea, 0xde,
+	0x1f, 0x12, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x52, 0x10, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x64,
+	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x2a, 0x9c, 0x01, 0x0a, 0x17, 0x53, 0x75, 0x70, 0x70, 0x6c,
+	0x69, 0x65, 0x72, 0x55, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x25, 0x53, 0x55, 0x50, 0x50, 0x4c, 0x49, 0x45, 0x52, 0x5f, 0x55,
+	0x4e, 0x42, 0x4f, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x27, 0x0a,
+	0x23, 0x53, 0x55, 0x50, 0x50, 0x4c, 0x49, 0x45, 0x52, 0x5f, 0x55, 0x4e, 0x42, 0x4f, 0x4e, 0x44,
+	0x49, 0x4e, 0x47, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x56, 0x4f, 0x4c, 0x55, 0x4e,
+	0x54, 0x41, 0x52, 0x59, 0x10, 0x01, 0x12, 0x2d, 0x0a, 0x29, 0x53, 0x55, 0x50, 0x50, 0x4c, 0x49,
+	0x45, 0x52, 0x5f, 0x55, 0x4e, 0x42, 0x4f, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x52, 0x45, 0x41,
+	0x53, 0x4f, 0x4e, 0x5f, 0x42, 0x45, 0x4c, 0x4f, 0x57, 0x5f, 0x4d, 0x49, 0x4e, 0x5f, 0x53, 0x54,
+	0x41, 0x4b, 0x45, 0x10, 0x02, 0x2a, 0xa6, 0x01, 0x0a, 0x19, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x55, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x12, 0x2b, 0x0a, 0x27, 0x44, 0x45, 0x4c, 0x45, 0x47, 0x41, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x55, 0x4e, 0x42, 0x4f, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x52, 0x45, 0x41, 0x53,
+	0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x29, 0x0a, 0x25, 0x44, 0x45, 0x4c, 0x45, 0x47, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55,
+	0x4e, 0x42, 0x4f, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f,
+	0x56, 0x4f, 0x4c, 0x55, 0x4e, 0x54, 0x41, 0x52, 0x59, 0x10, 0x01, 0x12, 0x31, 0x0a, 0x2d, 0x44,
+	0x45, 0x4c, 0x45, 0x47, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x42, 0x4f, 0x4e, 0x44,
+	0x49, 0x4e, 0x47, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x53, 0x55, 0x50, 0x50, 0x4c,
+	0x49, 0x45, 0x52, 0x5f, 0x55, 0x4e, 0x42, 0x4f, 0x4e, 0x44, 0x45, 0x44, 0x10, 0x02, 0x42, 0x26,
+	0xd8, 0xe2, 0x1e, 0x01, 0x5a, 0x20, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
+	0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2f, 0x73, 0x75,
+	0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -3132,30 +8068,49 @@ func file_pocket_supplier_event_proto_rawDescGZIP() []byte {
 	return file_pocket_supplier_event_proto_rawDescData
 }
 
-var file_pocket_supplier_event_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_pocket_supplier_event_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_pocket_supplier_event_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_pocket_supplier_event_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
 var file_pocket_supplier_event_proto_goTypes = []interface{}{
-	(SupplierUnbondingReason)(0),                // 0: pocket.supplier.SupplierUnbondingReason
-	(*EventSupplierStaked)(nil),                 // 1: pocket.supplier.EventSupplierStaked
-	(*EventSupplierUnbondingBegin)(nil),         // 2: pocket.supplier.EventSupplierUnbondingBegin
-	(*EventSupplierUnbondingEnd)(nil),           // 3: pocket.supplier.EventSupplierUnbondingEnd
-	(*EventSupplierUnbondingCanceled)(nil),      // 4: pocket.supplier.EventSupplierUnbondingCanceled
-	(*EventSupplierServiceConfigActivated)(nil), // 5: pocket.supplier.EventSupplierServiceConfigActivated
-	(*shared.Supplier)(nil),                     // 6: pocket.shared.Supplier
+	(SupplierUnbondingReason)(0),                     // 0: pocket.supplier.SupplierUnbondingReason
+	(DelegationUnbondingReason)(0),                   // 1: pocket.supplier.DelegationUnbondingReason
+	(*EventSupplierStaked)(nil),                      // 2: pocket.supplier.EventSupplierStaked
+	(*EventSupplierUnbondingBegin)(nil),              // 3: pocket.supplier.EventSupplierUnbondingBegin
+	(*EventSupplierUnbondingEnd)(nil),                // 4: pocket.supplier.EventSupplierUnbondingEnd
+	(*EventSupplierUnbondingCanceled)(nil),           // 5: pocket.supplier.EventSupplierUnbondingCanceled
+	(*EventSupplierServiceConfigActivated)(nil),      // 6: pocket.supplier.EventSupplierServiceConfigActivated
+	(*EventSupplierDelegated)(nil),                   // 7: pocket.supplier.EventSupplierDelegated
+	(*EventSupplierDelegationUnbondingBegin)(nil),    // 8: pocket.supplier.EventSupplierDelegationUnbondingBegin
+	(*EventSupplierDelegationUnbondingEnd)(nil),      // 9: pocket.supplier.EventSupplierDelegationUnbondingEnd
+	(*EventSupplierDelegationUnbondingCanceled)(nil), // 10: pocket.supplier.EventSupplierDelegationUnbondingCanceled
+	(*EventSupplierDelegationCommissionUpdated)(nil), // 11: pocket.supplier.EventSupplierDelegationCommissionUpdated
+	(*EventSupplierProofFaultRecorded)(nil),          // 12: pocket.supplier.EventSupplierProofFaultRecorded
+	(*EventSupplierJailed)(nil),                      // 13: pocket.supplier.EventSupplierJailed
+	(*EventSupplierUnjailed)(nil),                    // 14: pocket.supplier.EventSupplierUnjailed
+	(*shared.Supplier)(nil),                          // 15: pocket.shared.Supplier
+	(*Delegation)(nil),                               // 16: pocket.supplier.Delegation
+	(*ProofFault)(nil),                               // 17: pocket.supplier.ProofFault
 }
 var file_pocket_supplier_event_proto_depIdxs = []int32{
-	6, // 0: pocket.supplier.EventSupplierStaked.supplier:type_name -> pocket.shared.Supplier
-	6, // 1: pocket.supplier.EventSupplierUnbondingBegin.supplier:type_name -> pocket.shared.Supplier
-	0, // 2: pocket.supplier.EventSupplierUnbondingBegin.reason:type_name -> pocket.supplier.SupplierUnbondingReason
-	6, // 3: pocket.supplier.EventSupplierUnbondingEnd.supplier:type_name -> pocket.shared.Supplier
-	0, // 4: pocket.supplier.EventSupplierUnbondingEnd.reason:type_name -> pocket.supplier.SupplierUnbondingReason
-	6, // 5: pocket.supplier.EventSupplierUnbondingCanceled.supplier:type_name -> pocket.shared.Supplier
-	6, // 6: pocket.supplier.EventSupplierServiceConfigActivated.supplier:type_name -> pocket.shared.Supplier
-	7, // [7:7] is the sub-list for method output_type
-	7, // [7:7] is the sub-list for method input_type
-	7, // [7:7] is the sub-list for extension type_name
-	7, // [7:7] is the sub-list for extension extendee
-	0, // [0:7] is the sub-list for field type_name
+	15, // 0: pocket.supplier.EventSupplierStaked.supplier:type_name -> pocket.shared.Supplier
+	15, // 1: pocket.supplier.EventSupplierUnbondingBegin.supplier:type_name -> pocket.shared.Supplier
+	0,  // 2: pocket.supplier.EventSupplierUnbondingBegin.reason:type_name -> pocket.supplier.SupplierUnbondingReason
+	15, // 3: pocket.supplier.EventSupplierUnbondingEnd.supplier:type_name -> pocket.shared.Supplier
+	0,  // 4: pocket.supplier.EventSupplierUnbondingEnd.reason:type_name -> pocket.supplier.SupplierUnbondingReason
+	15, // 5: pocket.supplier.EventSupplierUnbondingCanceled.supplier:type_name -> pocket.shared.Supplier
+	15, // 6: pocket.supplier.EventSupplierServiceConfigActivated.supplier:type_name -> pocket.shared.Supplier
+	16, // 7: pocket.supplier.EventSupplierDelegated.delegation:type_name -> pocket.supplier.Delegation
+	16, // 8: pocket.supplier.EventSupplierDelegationUnbondingBegin.delegation:type_name -> pocket.supplier.Delegation
+	16, // 9: pocket.supplier.EventSupplierDelegationUnbondingEnd.delegation:type_name -> pocket.supplier.Delegation
+	1,  // 10: pocket.supplier.EventSupplierDelegationUnbondingEnd.reason:type_name -> pocket.supplier.DelegationUnbondingReason
+	16, // 11: pocket.supplier.EventSupplierDelegationUnbondingCanceled.delegation:type_name -> pocket.supplier.Delegation
+	17, // 12: pocket.supplier.EventSupplierProofFaultRecorded.proof_fault:type_name -> pocket.supplier.ProofFault
+	15, // 13: pocket.supplier.EventSupplierJailed.supplier:type_name -> pocket.shared.Supplier
+	15, // 14: pocket.supplier.EventSupplierUnjailed.supplier:type_name -> pocket.shared.Supplier
+	15, // [15:15] is the sub-list for method output_type
+	15, // [15:15] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
 }
 
 func init() { file_pocket_supplier_event_proto_init() }
@@ -3163,6 +8118,8 @@ func file_pocket_supplier_event_proto_init() {
 	if File_pocket_supplier_event_proto != nil {
 		return
 	}
+	file_pocket_supplier_delegation_proto_init()
+	file_pocket_supplier_proof_fault_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_pocket_supplier_event_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*EventSupplierStaked); i {
@@ -3224,14 +8181,110 @@ func file_pocket_supplier_event_proto_init() {
 				return nil
 			}
 		}
+		file_pocket_supplier_event_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventSupplierDelegated); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pocket_supplier_event_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventSupplierDelegationUnbondingBegin); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pocket_supplier_event_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventSupplierDelegationUnbondingEnd); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pocket_supplier_event_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventSupplierDelegationUnbondingCanceled); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pocket_supplier_event_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventSupplierDelegationCommissionUpdated); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pocket_supplier_event_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventSupplierProofFaultRecorded); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pocket_supplier_event_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventSupplierJailed); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pocket_supplier_event_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventSupplierUnjailed); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_pocket_supplier_event_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   5,
+			NumEnums:      2,
+			NumMessages:   13,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
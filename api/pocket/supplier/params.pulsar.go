@@ -16,9 +16,13 @@ import (
 )
 
 var (
-	md_Params             protoreflect.MessageDescriptor
-	fd_Params_min_stake   protoreflect.FieldDescriptor
-	fd_Params_staking_fee protoreflect.FieldDescriptor
+	md_Params                                           protoreflect.MessageDescriptor
+	fd_Params_min_stake                                 protoreflect.FieldDescriptor
+	fd_Params_staking_fee                               protoreflect.FieldDescriptor
+	fd_Params_proof_fault_window_sessions               protoreflect.FieldDescriptor
+	fd_Params_proof_fault_penalty_escalation_percentage protoreflect.FieldDescriptor
+	fd_Params_proof_fault_jailing_threshold             protoreflect.FieldDescriptor
+	fd_Params_jail_duration_sessions                    protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -26,6 +30,10 @@ func init() {
 	md_Params = File_pocket_supplier_params_proto.Messages().ByName("Params")
 	fd_Params_min_stake = md_Params.Fields().ByName("min_stake")
 	fd_Params_staking_fee = md_Params.Fields().ByName("staking_fee")
+	fd_Params_proof_fault_window_sessions = md_Params.Fields().ByName("proof_fault_window_sessions")
+	fd_Params_proof_fault_penalty_escalation_percentage = md_Params.Fields().ByName("proof_fault_penalty_escalation_percentage")
+	fd_Params_proof_fault_jailing_threshold = md_Params.Fields().ByName("proof_fault_jailing_threshold")
+	fd_Params_jail_duration_sessions = md_Params.Fields().ByName("jail_duration_sessions")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -105,6 +113,30 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.ProofFaultWindowSessions != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ProofFaultWindowSessions)
+		if !f(fd_Params_proof_fault_window_sessions, value) {
+			return
+		}
+	}
+	if x.ProofFaultPenaltyEscalationPercentage != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ProofFaultPenaltyEscalationPercentage)
+		if !f(fd_Params_proof_fault_penalty_escalation_percentage, value) {
+			return
+		}
+	}
+	if x.ProofFaultJailingThreshold != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ProofFaultJailingThreshold)
+		if !f(fd_Params_proof_fault_jailing_threshold, value) {
+			return
+		}
+	}
+	if x.JailDurationSessions != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.JailDurationSessions)
+		if !f(fd_Params_jail_duration_sessions, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -124,6 +156,14 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.MinStake != nil
 	case "pocket.supplier.Params.staking_fee":
 		return x.StakingFee != nil
+	case "pocket.supplier.Params.proof_fault_window_sessions":
+		return x.ProofFaultWindowSessions != uint64(0)
+	case "pocket.supplier.Params.proof_fault_penalty_escalation_percentage":
+		return x.ProofFaultPenaltyEscalationPercentage != uint64(0)
+	case "pocket.supplier.Params.proof_fault_jailing_threshold":
+		return x.ProofFaultJailingThreshold != uint64(0)
+	case "pocket.supplier.Params.jail_duration_sessions":
+		return x.JailDurationSessions != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.supplier.Params"))
@@ -144,6 +184,14 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.MinStake = nil
 	case "pocket.supplier.Params.staking_fee":
 		x.StakingFee = nil
+	case "pocket.supplier.Params.proof_fault_window_sessions":
+		x.ProofFaultWindowSessions = uint64(0)
+	case "pocket.supplier.Params.proof_fault_penalty_escalation_percentage":
+		x.ProofFaultPenaltyEscalationPercentage = uint64(0)
+	case "pocket.supplier.Params.proof_fault_jailing_threshold":
+		x.ProofFaultJailingThreshold = uint64(0)
+	case "pocket.supplier.Params.jail_duration_sessions":
+		x.JailDurationSessions = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.supplier.Params"))
@@ -166,6 +214,18 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "pocket.supplier.Params.staking_fee":
 		value := x.StakingFee
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "pocket.supplier.Params.proof_fault_window_sessions":
+		value := x.ProofFaultWindowSessions
+		return protoreflect.ValueOfUint64(value)
+	case "pocket.supplier.Params.proof_fault_penalty_escalation_percentage":
+		value := x.ProofFaultPenaltyEscalationPercentage
+		return protoreflect.ValueOfUint64(value)
+	case "pocket.supplier.Params.proof_fault_jailing_threshold":
+		value := x.ProofFaultJailingThreshold
+		return protoreflect.ValueOfUint64(value)
+	case "pocket.supplier.Params.jail_duration_sessions":
+		value := x.JailDurationSessions
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.supplier.Params"))
@@ -190,6 +250,14 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		x.MinStake = value.Message().Interface().(*v1beta1.Coin)
 	case "pocket.supplier.Params.staking_fee":
 		x.StakingFee = value.Message().Interface().(*v1beta1.Coin)
+	case "pocket.supplier.Params.proof_fault_window_sessions":
+		x.ProofFaultWindowSessions = value.Uint()
+	case "pocket.supplier.Params.proof_fault_penalty_escalation_percentage":
+		x.ProofFaultPenaltyEscalationPercentage = value.Uint()
+	case "pocket.supplier.Params.proof_fault_jailing_threshold":
+		x.ProofFaultJailingThreshold = value.Uint()
+	case "pocket.supplier.Params.jail_duration_sessions":
+		x.JailDurationSessions = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.supplier.Params"))
@@ -220,6 +288,14 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 			x.StakingFee = new(v1beta1.Coin)
 		}
 		return protoreflect.ValueOfMessage(x.StakingFee.ProtoReflect())
+	case "pocket.supplier.Params.proof_fault_window_sessions":
+		panic(fmt.Errorf("field proof_fault_window_sessions of message pocket.supplier.Params is not mutable"))
+	case "pocket.supplier.Params.proof_fault_penalty_escalation_percentage":
+		panic(fmt.Errorf("field proof_fault_penalty_escalation_percentage of message pocket.supplier.Params is not mutable"))
+	case "pocket.supplier.Params.proof_fault_jailing_threshold":
+		panic(fmt.Errorf("field proof_fault_jailing_threshold of message pocket.supplier.Params is not mutable"))
+	case "pocket.supplier.Params.jail_duration_sessions":
+		panic(fmt.Errorf("field jail_duration_sessions of message pocket.supplier.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.supplier.Params"))
@@ -239,6 +315,14 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 	case "pocket.supplier.Params.staking_fee":
 		m := new(v1beta1.Coin)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "pocket.supplier.Params.proof_fault_window_sessions":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "pocket.supplier.Params.proof_fault_penalty_escalation_percentage":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "pocket.supplier.Params.proof_fault_jailing_threshold":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "pocket.supplier.Params.jail_duration_sessions":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.supplier.Params"))
@@ -316,6 +400,18 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			l = options.Size(x.StakingFee)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.ProofFaultWindowSessions != 0 {
+			n += 1 + runtime.Sov(uint64(x.ProofFaultWindowSessions))
+		}
+		if x.ProofFaultPenaltyEscalationPercentage != 0 {
+			n += 1 + runtime.Sov(uint64(x.ProofFaultPenaltyEscalationPercentage))
+		}
+		if x.ProofFaultJailingThreshold != 0 {
+			n += 1 + runtime.Sov(uint64(x.ProofFaultJailingThreshold))
+		}
+		if x.JailDurationSessions != 0 {
+			n += 1 + runtime.Sov(uint64(x.JailDurationSessions))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -345,6 +441,26 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.JailDurationSessions != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.JailDurationSessions))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.ProofFaultJailingThreshold != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProofFaultJailingThreshold))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.ProofFaultPenaltyEscalationPercentage != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProofFaultPenaltyEscalationPercentage))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.ProofFaultWindowSessions != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProofFaultWindowSessions))
+			i--
+			dAtA[i] = 0x18
+		}
 		if x.StakingFee != nil {
 			encoded, err := options.Marshal(x.StakingFee)
 			if err != nil {
@@ -494,6 +610,82 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProofFaultWindowSessions", wireType)
+				}
+				x.ProofFaultWindowSessions = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ProofFaultWindowSessions |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProofFaultPenaltyEscalationPercentage", wireType)
+				}
+				x.ProofFaultPenaltyEscalationPercentage = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ProofFaultPenaltyEscalationPercentage |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProofFaultJailingThreshold", wireType)
+				}
+				x.ProofFaultJailingThreshold = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ProofFaultJailingThreshold |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field JailDurationSessions", wireType)
+				}
+				x.JailDurationSessions = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.JailDurationSessions |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -553,6 +745,19 @@ type Params struct {
 	MinStake *v1beta1.Coin `protobuf:"bytes,1,opt,name=min_stake,json=minStake,proto3" json:"min_stake,omitempty"`
 	// staking_fee is the fee charged by the protocol for staking a supplier.
 	StakingFee *v1beta1.Coin `protobuf:"bytes,2,opt,name=staking_fee,json=stakingFee,proto3" json:"staking_fee,omitempty"`
+	// proof_fault_window_sessions is the number of sessions over which the missing
+	// or invalid proofs (i.e. proof faults) of a supplier are tracked.
+	ProofFaultWindowSessions uint64 `protobuf:"varint,3,opt,name=proof_fault_window_sessions,json=proofFaultWindowSessions,proto3" json:"proof_fault_window_sessions,omitempty"`
+	// proof_fault_penalty_escalation_percentage is the percentage of the proof missing
+	// penalty which is added to the penalty for each prior proof fault of the supplier
+	// within the proof fault window (e.g. 100 doubles the penalty of the second fault).
+	ProofFaultPenaltyEscalationPercentage uint64 `protobuf:"varint,4,opt,name=proof_fault_penalty_escalation_percentage,json=proofFaultPenaltyEscalationPercentage,proto3" json:"proof_fault_penalty_escalation_percentage,omitempty"`
+	// proof_fault_jailing_threshold is the number of proof faults within the proof
+	// fault window at which a supplier is jailed. Jailing is disabled if it is 0.
+	ProofFaultJailingThreshold uint64 `protobuf:"varint,5,opt,name=proof_fault_jailing_threshold,json=proofFaultJailingThreshold,proto3" json:"proof_fault_jailing_threshold,omitempty"`
+	// jail_duration_sessions is the number of sessions a jailed supplier is excluded
+	// from before it can be unjailed.
+	JailDurationSessions uint64 `protobuf:"varint,6,opt,name=jail_duration_sessions,json=jailDurationSessions,proto3" json:"jail_duration_sessions,omitempty"`
 }
 
 func (x *Params) Reset() {
@@ -589,6 +794,34 @@ func (x *Params) GetStakingFee() *v1beta1.Coin {
 	return nil
 }
 
+func (x *Params) GetProofFaultWindowSessions() uint64 {
+	if x != nil {
+		return x.ProofFaultWindowSessions
+	}
+	return 0
+}
+
+func (x *Params) GetProofFaultPenaltyEscalationPercentage() uint64 {
+	if x != nil {
+		return x.ProofFaultPenaltyEscalationPercentage
+	}
+	return 0
+}
+
+func (x *Params) GetProofFaultJailingThreshold() uint64 {
+	if x != nil {
+		return x.ProofFaultJailingThreshold
+	}
+	return 0
+}
+
+func (x *Params) GetJailDurationSessions() uint64 {
+	if x != nil {
+		return x.JailDurationSessions
+	}
+	return 0
+}
+
 var File_pocket_supplier_params_proto protoreflect.FileDescriptor
 
 var file_pocket_supplier_params_proto_rawDesc = []byte{
@@ -599,7 +832,7 @@ var file_pocket_supplier_params_proto_rawDesc = []byte{
 	0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f,
 	0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
 	0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f,
-	0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe9, 0x01, 0x0a, 0x06, 0x50, 0x61, 0x72,
+	0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb0, 0x06, 0x0a, 0x06, 0x50, 0x61, 0x72,
 	0x61, 0x6d, 0x73, 0x12, 0x59, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x6b, 0x65,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
 	0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69,
@@ -612,20 +845,48 @@ var file_pocket_supplier_params_proto_rawDesc = []byte{
 	0xea, 0xde, 0x1f, 0x0b, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x65, 0x65, 0xf2,
 	0xde, 0x1f, 0x12, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67,
 	0x5f, 0x66, 0x65, 0x65, 0x22, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x46, 0x65,
-	0x65, 0x3a, 0x21, 0xe8, 0xa0, 0x1f, 0x01, 0x8a, 0xe7, 0xb0, 0x2a, 0x18, 0x70, 0x6f, 0x63, 0x6b,
-	0x65, 0x74, 0x2f, 0x78, 0x2f, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x2f, 0x50, 0x61,
-	0x72, 0x61, 0x6d, 0x73, 0x42, 0xa5, 0x01, 0xd8, 0xe2, 0x1e, 0x01, 0x0a, 0x13, 0x63, 0x6f, 0x6d,
-	0x2e, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72,
-	0x42, 0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a,
-	0x20, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70,
-	0x69, 0x2f, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2f, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65,
-	0x72, 0xa2, 0x02, 0x03, 0x50, 0x53, 0x58, 0xaa, 0x02, 0x0f, 0x50, 0x6f, 0x63, 0x6b, 0x65, 0x74,
-	0x2e, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0xca, 0x02, 0x0f, 0x50, 0x6f, 0x63, 0x6b,
-	0x65, 0x74, 0x5c, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0xe2, 0x02, 0x1b, 0x50, 0x6f,
-	0x63, 0x6b, 0x65, 0x74, 0x5c, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x5c, 0x47, 0x50,
-	0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x10, 0x50, 0x6f, 0x63, 0x6b,
-	0x65, 0x74, 0x3a, 0x3a, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x12, 0x84, 0x01, 0x0a, 0x1b, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x5f, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x45, 0xea, 0xde, 0x1f, 0x1b, 0x70, 0x72, 0x6f,
+	0x6f, 0x66, 0x5f, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0xf2, 0xde, 0x1f, 0x22, 0x79, 0x61, 0x6d, 0x6c,
+	0x3a, 0x22, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x5f, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x77, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x52, 0x18,
+	0x70, 0x72, 0x6f, 0x6f, 0x66, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0xbb, 0x01, 0x0a, 0x29, 0x70, 0x72, 0x6f,
+	0x6f, 0x66, 0x5f, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79,
+	0x5f, 0x65, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x65, 0x72, 0x63,
+	0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x42, 0x61, 0xea, 0xde,
+	0x1f, 0x29, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x5f, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x70, 0x65,
+	0x6e, 0x61, 0x6c, 0x74, 0x79, 0x5f, 0x65, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0xf2, 0xde, 0x1f, 0x30, 0x79,
+	0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x5f, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x5f, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x5f, 0x65, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x22, 0x52,
+	0x25, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x50, 0x65, 0x6e, 0x61, 0x6c,
+	0x74, 0x79, 0x45, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x63,
+	0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x8c, 0x01, 0x0a, 0x1d, 0x70, 0x72, 0x6f, 0x6f, 0x66,
+	0x5f, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x6a, 0x61, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x74,
+	0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x42, 0x49,
+	0xea, 0xde, 0x1f, 0x1d, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x5f, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f,
+	0x6a, 0x61, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c,
+	0x64, 0xf2, 0xde, 0x1f, 0x24, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x70, 0x72, 0x6f, 0x6f, 0x66,
+	0x5f, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x6a, 0x61, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x74,
+	0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x22, 0x52, 0x1a, 0x70, 0x72, 0x6f, 0x6f, 0x66,
+	0x46, 0x61, 0x75, 0x6c, 0x74, 0x4a, 0x61, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x54, 0x68, 0x72, 0x65,
+	0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x71, 0x0a, 0x16, 0x6a, 0x61, 0x69, 0x6c, 0x5f, 0x64, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x04, 0x42, 0x3b, 0xea, 0xde, 0x1f, 0x16, 0x6a, 0x61, 0x69, 0x6c, 0x5f,
+	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0xf2, 0xde, 0x1f, 0x1d, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6a, 0x61, 0x69, 0x6c, 0x5f,
+	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x22, 0x52, 0x14, 0x6a, 0x61, 0x69, 0x6c, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x21, 0xe8, 0xa0, 0x1f, 0x01, 0x8a, 0xe7,
+	0xb0, 0x2a, 0x18, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2f, 0x78, 0x2f, 0x73, 0x75, 0x70, 0x70,
+	0x6c, 0x69, 0x65, 0x72, 0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x26, 0xd8, 0xe2, 0x1e,
+	0x01, 0x5a, 0x20, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2f, 0x73, 0x75, 0x70, 0x70, 0x6c,
+	0x69, 0x65, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
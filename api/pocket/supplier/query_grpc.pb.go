@@ -19,9 +19,12 @@ import (
 const _ = grpc.SupportPackageIsVersion8
 
 const (
-	Query_Params_FullMethodName       = "/pocket.supplier.Query/Params"
-	Query_Supplier_FullMethodName     = "/pocket.supplier.Query/Supplier"
-	Query_AllSuppliers_FullMethodName = "/pocket.supplier.Query/AllSuppliers"
+	Query_Params_FullMethodName                 = "/pocket.supplier.Query/Params"
+	Query_Supplier_FullMethodName               = "/pocket.supplier.Query/Supplier"
+	Query_AllSuppliers_FullMethodName           = "/pocket.supplier.Query/AllSuppliers"
+	Query_SupplierDelegations_FullMethodName    = "/pocket.supplier.Query/SupplierDelegations"
+	Query_SupplierProofFaults_FullMethodName    = "/pocket.supplier.Query/SupplierProofFaults"
+	Query_AllSupplierProofFaults_FullMethodName = "/pocket.supplier.Query/AllSupplierProofFaults"
 )
 
 // QueryClient is the client API for Query service.
@@ -35,6 +38,11 @@ type QueryClient interface {
 	// Queries a list of Supplier items.
 	Supplier(ctx context.Context, in *QueryGetSupplierRequest, opts ...grpc.CallOption) (*QueryGetSupplierResponse, error)
 	AllSuppliers(ctx context.Context, in *QueryAllSuppliersRequest, opts ...grpc.CallOption) (*QueryAllSuppliersResponse, error)
+	// Queries a list of the delegations to a supplier.
+	SupplierDelegations(ctx context.Context, in *QuerySupplierDelegationsRequest, opts ...grpc.CallOption) (*QuerySupplierDelegationsResponse, error)
+	// Queries the proof faults of a supplier within the proof fault window.
+	SupplierProofFaults(ctx context.Context, in *QueryGetSupplierProofFaultsRequest, opts ...grpc.CallOption) (*QueryGetSupplierProofFaultsResponse, error)
+	AllSupplierProofFaults(ctx context.Context, in *QueryAllSupplierProofFaultsRequest, opts ...grpc.CallOption) (*QueryAllSupplierProofFaultsResponse, error)
 }
 
 type queryClient struct {
@@ -75,6 +83,36 @@ func (c *queryClient) AllSuppliers(ctx context.Context, in *QueryAllSuppliersReq
 	return out, nil
 }
 
+func (c *queryClient) SupplierDelegations(ctx context.Context, in *QuerySupplierDelegationsRequest, opts ...grpc.CallOption) (*QuerySupplierDelegationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuerySupplierDelegationsResponse)
+	err := c.cc.Invoke(ctx, Query_SupplierDelegations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SupplierProofFaults(ctx context.Context, in *QueryGetSupplierProofFaultsRequest, opts ...grpc.CallOption) (*QueryGetSupplierProofFaultsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryGetSupplierProofFaultsResponse)
+	err := c.cc.Invoke(ctx, Query_SupplierProofFaults_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllSupplierProofFaults(ctx context.Context, in *QueryAllSupplierProofFaultsRequest, opts ...grpc.CallOption) (*QueryAllSupplierProofFaultsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryAllSupplierProofFaultsResponse)
+	err := c.cc.Invoke(ctx, Query_AllSupplierProofFaults_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -86,6 +124,11 @@ type QueryServer interface {
 	// Queries a list of Supplier items.
 	Supplier(context.Context, *QueryGetSupplierRequest) (*QueryGetSupplierResponse, error)
 	AllSuppliers(context.Context, *QueryAllSuppliersRequest) (*QueryAllSuppliersResponse, error)
+	// Queries a list of the delegations to a supplier.
+	SupplierDelegations(context.Context, *QuerySupplierDelegationsRequest) (*QuerySupplierDelegationsResponse, error)
+	// Queries the proof faults of a supplier within the proof fault window.
+	SupplierProofFaults(context.Context, *QueryGetSupplierProofFaultsRequest) (*QueryGetSupplierProofFaultsResponse, error)
+	AllSupplierProofFaults(context.Context, *QueryAllSupplierProofFaultsRequest) (*QueryAllSupplierProofFaultsResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -102,6 +145,15 @@ func (UnimplementedQueryServer) Supplier(context.Context, *QueryGetSupplierReque
 func (UnimplementedQueryServer) AllSuppliers(context.Context, *QueryAllSuppliersRequest) (*QueryAllSuppliersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AllSuppliers not implemented")
 }
+func (UnimplementedQueryServer) SupplierDelegations(context.Context, *QuerySupplierDelegationsRequest) (*QuerySupplierDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SupplierDelegations not implemented")
+}
+func (UnimplementedQueryServer) SupplierProofFaults(context.Context, *QueryGetSupplierProofFaultsRequest) (*QueryGetSupplierProofFaultsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SupplierProofFaults not implemented")
+}
+func (UnimplementedQueryServer) AllSupplierProofFaults(context.Context, *QueryAllSupplierProofFaultsRequest) (*QueryAllSupplierProofFaultsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllSupplierProofFaults not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -169,6 +221,60 @@ func _Query_AllSuppliers_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SupplierDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySupplierDelegationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SupplierDelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SupplierDelegations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SupplierDelegations(ctx, req.(*QuerySupplierDelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SupplierProofFaults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetSupplierProofFaultsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SupplierProofFaults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SupplierProofFaults_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SupplierProofFaults(ctx, req.(*QueryGetSupplierProofFaultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllSupplierProofFaults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllSupplierProofFaultsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllSupplierProofFaults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AllSupplierProofFaults_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllSupplierProofFaults(ctx, req.(*QueryAllSupplierProofFaultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -188,6 +294,18 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AllSuppliers",
 			Handler:    _Query_AllSuppliers_Handler,
 		},
+		{
+			MethodName: "SupplierDelegations",
+			Handler:    _Query_SupplierDelegations_Handler,
+		},
+		{
+			MethodName: "SupplierProofFaults",
+			Handler:    _Query_SupplierProofFaults_Handler,
+		},
+		{
+			MethodName: "AllSupplierProofFaults",
+			Handler:    _Query_AllSupplierProofFaults_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pocket/supplier/query.proto",
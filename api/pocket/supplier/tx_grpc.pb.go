@@ -19,10 +19,14 @@ import (
 const _ = grpc.SupportPackageIsVersion8
 
 const (
-	Msg_UpdateParams_FullMethodName    = "/pocket.supplier.Msg/UpdateParams"
-	Msg_StakeSupplier_FullMethodName   = "/pocket.supplier.Msg/StakeSupplier"
-	Msg_UnstakeSupplier_FullMethodName = "/pocket.supplier.Msg/UnstakeSupplier"
-	Msg_UpdateParam_FullMethodName     = "/pocket.supplier.Msg/UpdateParam"
+	Msg_UpdateParams_FullMethodName                    = "/pocket.supplier.Msg/UpdateParams"
+	Msg_StakeSupplier_FullMethodName                   = "/pocket.supplier.Msg/StakeSupplier"
+	Msg_UnstakeSupplier_FullMethodName                 = "/pocket.supplier.Msg/UnstakeSupplier"
+	Msg_UpdateParam_FullMethodName                     = "/pocket.supplier.Msg/UpdateParam"
+	Msg_DelegateToSupplier_FullMethodName              = "/pocket.supplier.Msg/DelegateToSupplier"
+	Msg_UndelegateFromSupplier_FullMethodName          = "/pocket.supplier.Msg/UndelegateFromSupplier"
+	Msg_SetSupplierDelegationCommission_FullMethodName = "/pocket.supplier.Msg/SetSupplierDelegationCommission"
+	Msg_UnjailSupplier_FullMethodName                  = "/pocket.supplier.Msg/UnjailSupplier"
 )
 
 // MsgClient is the client API for Msg service.
@@ -37,6 +41,10 @@ type MsgClient interface {
 	StakeSupplier(ctx context.Context, in *MsgStakeSupplier, opts ...grpc.CallOption) (*MsgStakeSupplierResponse, error)
 	UnstakeSupplier(ctx context.Context, in *MsgUnstakeSupplier, opts ...grpc.CallOption) (*MsgUnstakeSupplierResponse, error)
 	UpdateParam(ctx context.Context, in *MsgUpdateParam, opts ...grpc.CallOption) (*MsgUpdateParamResponse, error)
+	DelegateToSupplier(ctx context.Context, in *MsgDelegateToSupplier, opts ...grpc.CallOption) (*MsgDelegateToSupplierResponse, error)
+	UndelegateFromSupplier(ctx context.Context, in *MsgUndelegateFromSupplier, opts ...grpc.CallOption) (*MsgUndelegateFromSupplierResponse, error)
+	SetSupplierDelegationCommission(ctx context.Context, in *MsgSetSupplierDelegationCommission, opts ...grpc.CallOption) (*MsgSetSupplierDelegationCommissionResponse, error)
+	UnjailSupplier(ctx context.Context, in *MsgUnjailSupplier, opts ...grpc.CallOption) (*MsgUnjailSupplierResponse, error)
 }
 
 type msgClient struct {
@@ -87,6 +95,46 @@ func (c *msgClient) UpdateParam(ctx context.Context, in *MsgUpdateParam, opts ..
 	return out, nil
 }
 
+func (c *msgClient) DelegateToSupplier(ctx context.Context, in *MsgDelegateToSupplier, opts ...grpc.CallOption) (*MsgDelegateToSupplierResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgDelegateToSupplierResponse)
+	err := c.cc.Invoke(ctx, Msg_DelegateToSupplier_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UndelegateFromSupplier(ctx context.Context, in *MsgUndelegateFromSupplier, opts ...grpc.CallOption) (*MsgUndelegateFromSupplierResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgUndelegateFromSupplierResponse)
+	err := c.cc.Invoke(ctx, Msg_UndelegateFromSupplier_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetSupplierDelegationCommission(ctx context.Context, in *MsgSetSupplierDelegationCommission, opts ...grpc.CallOption) (*MsgSetSupplierDelegationCommissionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetSupplierDelegationCommissionResponse)
+	err := c.cc.Invoke(ctx, Msg_SetSupplierDelegationCommission_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnjailSupplier(ctx context.Context, in *MsgUnjailSupplier, opts ...grpc.CallOption) (*MsgUnjailSupplierResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgUnjailSupplierResponse)
+	err := c.cc.Invoke(ctx, Msg_UnjailSupplier_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -99,6 +147,10 @@ type MsgServer interface {
 	StakeSupplier(context.Context, *MsgStakeSupplier) (*MsgStakeSupplierResponse, error)
 	UnstakeSupplier(context.Context, *MsgUnstakeSupplier) (*MsgUnstakeSupplierResponse, error)
 	UpdateParam(context.Context, *MsgUpdateParam) (*MsgUpdateParamResponse, error)
+	DelegateToSupplier(context.Context, *MsgDelegateToSupplier) (*MsgDelegateToSupplierResponse, error)
+	UndelegateFromSupplier(context.Context, *MsgUndelegateFromSupplier) (*MsgUndelegateFromSupplierResponse, error)
+	SetSupplierDelegationCommission(context.Context, *MsgSetSupplierDelegationCommission) (*MsgSetSupplierDelegationCommissionResponse, error)
+	UnjailSupplier(context.Context, *MsgUnjailSupplier) (*MsgUnjailSupplierResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -118,6 +170,18 @@ func (UnimplementedMsgServer) UnstakeSupplier(context.Context, *MsgUnstakeSuppli
 func (UnimplementedMsgServer) UpdateParam(context.Context, *MsgUpdateParam) (*MsgUpdateParamResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParam not implemented")
 }
+func (UnimplementedMsgServer) DelegateToSupplier(context.Context, *MsgDelegateToSupplier) (*MsgDelegateToSupplierResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegateToSupplier not implemented")
+}
+func (UnimplementedMsgServer) UndelegateFromSupplier(context.Context, *MsgUndelegateFromSupplier) (*MsgUndelegateFromSupplierResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UndelegateFromSupplier not implemented")
+}
+func (UnimplementedMsgServer) SetSupplierDelegationCommission(context.Context, *MsgSetSupplierDelegationCommission) (*MsgSetSupplierDelegationCommissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSupplierDelegationCommission not implemented")
+}
+func (UnimplementedMsgServer) UnjailSupplier(context.Context, *MsgUnjailSupplier) (*MsgUnjailSupplierResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnjailSupplier not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -203,6 +267,78 @@ func _Msg_UpdateParam_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_DelegateToSupplier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDelegateToSupplier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DelegateToSupplier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_DelegateToSupplier_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DelegateToSupplier(ctx, req.(*MsgDelegateToSupplier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UndelegateFromSupplier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUndelegateFromSupplier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UndelegateFromSupplier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UndelegateFromSupplier_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UndelegateFromSupplier(ctx, req.(*MsgUndelegateFromSupplier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetSupplierDelegationCommission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetSupplierDelegationCommission)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetSupplierDelegationCommission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetSupplierDelegationCommission_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetSupplierDelegationCommission(ctx, req.(*MsgSetSupplierDelegationCommission))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnjailSupplier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnjailSupplier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnjailSupplier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UnjailSupplier_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnjailSupplier(ctx, req.(*MsgUnjailSupplier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -226,6 +362,22 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParam",
 			Handler:    _Msg_UpdateParam_Handler,
 		},
+		{
+			MethodName: "DelegateToSupplier",
+			Handler:    _Msg_DelegateToSupplier_Handler,
+		},
+		{
+			MethodName: "UndelegateFromSupplier",
+			Handler:    _Msg_UndelegateFromSupplier_Handler,
+		},
+		{
+			MethodName: "SetSupplierDelegationCommission",
+			Handler:    _Msg_SetSupplierDelegationCommission_Handler,
+		},
+		{
+			MethodName: "UnjailSupplier",
+			Handler:    _Msg_UnjailSupplier_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pocket/supplier/tx.proto",
@@ -2152,6 +2152,7 @@ var (
 	md_EventSupplierSlashed                       protoreflect.MessageDescriptor
 	fd_EventSupplierSlashed_claim                 protoreflect.FieldDescriptor
 	fd_EventSupplierSlashed_proof_missing_penalty protoreflect.FieldDescriptor
+	fd_EventSupplierSlashed_num_proof_faults      protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -2159,6 +2160,7 @@ func init() {
 	md_EventSupplierSlashed = File_pocket_tokenomics_event_proto.Messages().ByName("EventSupplierSlashed")
 	fd_EventSupplierSlashed_claim = md_EventSupplierSlashed.Fields().ByName("claim")
 	fd_EventSupplierSlashed_proof_missing_penalty = md_EventSupplierSlashed.Fields().ByName("proof_missing_penalty")
+	fd_EventSupplierSlashed_num_proof_faults = md_EventSupplierSlashed.Fields().ByName("num_proof_faults")
 }
 
 var _ protoreflect.Message = (*fastReflection_EventSupplierSlashed)(nil)
@@ -2238,6 +2240,582 @@ func (x *fastReflection_EventSupplierSlashed) Range(f func(protoreflect.FieldDes
 			return
 		}
 	}
+	if x.NumProofFaults != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.NumProofFaults)
+		if !f(fd_EventSupplierSlashed_num_proof_faults, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventSupplierSlashed) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "pocket.tokenomics.EventSupplierSlashed.claim":
+		return x.Claim != nil
+	case "pocket.tokenomics.EventSupplierSlashed.proof_missing_penalty":
+		return x.ProofMissingPenalty != nil
+	case "pocket.tokenomics.EventSupplierSlashed.num_proof_faults":
+		return x.NumProofFaults != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.tokenomics.EventSupplierSlashed"))
+		}
+		panic(fmt.Errorf("message pocket.tokenomics.EventSupplierSlashed does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventSupplierSlashed) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "pocket.tokenomics.EventSupplierSlashed.claim":
+		x.Claim = nil
+	case "pocket.tokenomics.EventSupplierSlashed.proof_missing_penalty":
+		x.ProofMissingPenalty = nil
+	case "pocket.tokenomics.EventSupplierSlashed.num_proof_faults":
+		x.NumProofFaults = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.tokenomics.EventSupplierSlashed"))
+		}
+		panic(fmt.Errorf("message pocket.tokenomics.EventSupplierSlashed does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventSupplierSlashed) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "pocket.tokenomics.EventSupplierSlashed.claim":
+		value := x.Claim
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "pocket.tokenomics.EventSupplierSlashed.proof_missing_penalty":
+		value := x.ProofMissingPenalty
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "pocket.tokenomics.EventSupplierSlashed.num_proof_faults":
+		value := x.NumProofFaults
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.tokenomics.EventSupplierSlashed"))
+		}
+		panic(fmt.Errorf("message pocket.tokenomics.EventSupplierSlashed does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventSupplierSlashed) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "pocket.tokenomics.EventSupplierSlashed.claim":
+		x.Claim = value.Message().Interface().(*proof.Claim)
+	case "pocket.tokenomics.EventSupplierSlashed.proof_missing_penalty":
+		x.ProofMissingPenalty = value.Message().Interface().(*v1beta1.Coin)
+	case "pocket.tokenomics.EventSupplierSlashed.num_proof_faults":
+		x.NumProofFaults = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.tokenomics.EventSupplierSlashed"))
+		}
+		panic(fmt.Errorf("message pocket.tokenomics.EventSupplierSlashed does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventSupplierSlashed) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "pocket.tokenomics.EventSupplierSlashed.claim":
+		if x.Claim == nil {
+			x.Claim = new(proof.Claim)
+		}
+		return protoreflect.ValueOfMessage(x.Claim.ProtoReflect())
+	case "pocket.tokenomics.EventSupplierSlashed.proof_missing_penalty":
+		if x.ProofMissingPenalty == nil {
+			x.ProofMissingPenalty = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.ProofMissingPenalty.ProtoReflect())
+	case "pocket.tokenomics.EventSupplierSlashed.num_proof_faults":
+		panic(fmt.Errorf("field num_proof_faults of message pocket.tokenomics.EventSupplierSlashed is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.tokenomics.EventSupplierSlashed"))
+		}
+		panic(fmt.Errorf("message pocket.tokenomics.EventSupplierSlashed does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventSupplierSlashed) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "pocket.tokenomics.EventSupplierSlashed.claim":
+		m := new(proof.Claim)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "pocket.tokenomics.EventSupplierSlashed.proof_missing_penalty":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "pocket.tokenomics.EventSupplierSlashed.num_proof_faults":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.tokenomics.EventSupplierSlashed"))
+		}
+		panic(fmt.Errorf("message pocket.tokenomics.EventSupplierSlashed does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventSupplierSlashed) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in pocket.tokenomics.EventSupplierSlashed", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventSupplierSlashed) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventSupplierSlashed) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventSupplierSlashed) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventSupplierSlashed) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventSupplierSlashed)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Claim != nil {
+			l = options.Size(x.Claim)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ProofMissingPenalty != nil {
+			l = options.Size(x.ProofMissingPenalty)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.NumProofFaults != 0 {
+			n += 1 + runtime.Sov(uint64(x.NumProofFaults))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventSupplierSlashed)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.NumProofFaults != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NumProofFaults))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.ProofMissingPenalty != nil {
+			encoded, err := options.Marshal(x.ProofMissingPenalty)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Claim != nil {
+			encoded, err := options.Marshal(x.Claim)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventSupplierSlashed)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventSupplierSlashed: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventSupplierSlashed: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Claim", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Claim == nil {
+					x.Claim = &proof.Claim{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Claim); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProofMissingPenalty", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.ProofMissingPenalty == nil {
+					x.ProofMissingPenalty = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ProofMissingPenalty); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NumProofFaults", wireType)
+				}
+				x.NumProofFaults = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NumProofFaults |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventSupplierDelegationSlashed                           protoreflect.MessageDescriptor
+	fd_EventSupplierDelegationSlashed_claim                     protoreflect.FieldDescriptor
+	fd_EventSupplierDelegationSlashed_delegator_address         protoreflect.FieldDescriptor
+	fd_EventSupplierDelegationSlashed_supplier_operator_address protoreflect.FieldDescriptor
+	fd_EventSupplierDelegationSlashed_slashed_amount            protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_pocket_tokenomics_event_proto_init()
+	md_EventSupplierDelegationSlashed = File_pocket_tokenomics_event_proto.Messages().ByName("EventSupplierDelegationSlashed")
+	fd_EventSupplierDelegationSlashed_claim = md_EventSupplierDelegationSlashed.Fields().ByName("claim")
+	fd_EventSupplierDelegationSlashed_delegator_address = md_EventSupplierDelegationSlashed.Fields().ByName("delegator_address")
+	fd_EventSupplierDelegationSlashed_supplier_operator_address = md_EventSupplierDelegationSlashed.Fields().ByName("supplier_operator_address")
+	fd_EventSupplierDelegationSlashed_slashed_amount = md_EventSupplierDelegationSlashed.Fields().ByName("slashed_amount")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventSupplierDelegationSlashed)(nil)
+
+type fastReflection_EventSupplierDelegationSlashed EventSupplierDelegationSlashed
+
+func (x *EventSupplierDelegationSlashed) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventSupplierDelegationSlashed)(x)
+}
+
+func (x *EventSupplierDelegationSlashed) slowProtoReflect() protoreflect.Message {
+	mi := &file_pocket_tokenomics_event_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventSupplierDelegationSlashed_messageType fastReflection_EventSupplierDelegationSlashed_messageType
+var _ protoreflect.MessageType = fastReflection_EventSupplierDelegationSlashed_messageType{}
+
+type fastReflection_EventSupplierDelegationSlashed_messageType struct{}
+
+func (x fastReflection_EventSupplierDelegationSlashed_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventSupplierDelegationSlashed)(nil)
+}
+func (x fastReflection_EventSupplierDelegationSlashed_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventSupplierDelegationSlashed)
+}
+func (x fastReflection_EventSupplierDelegationSlashed_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventSupplierDelegationSlashed
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventSupplierDelegationSlashed) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventSupplierDelegationSlashed
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventSupplierDelegationSlashed) Type() protoreflect.MessageType {
+	return _fastReflection_EventSupplierDelegationSlashed_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventSupplierDelegationSlashed) New() protoreflect.Message {
+	return new(fastReflection_EventSupplierDelegationSlashed)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventSupplierDelegationSlashed) Interface() protoreflect.ProtoMessage {
+	return (*EventSupplierDelegationSlashed)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventSupplierDelegationSlashed) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Claim != nil {
+		value := protoreflect.ValueOfMessage(x.Claim.ProtoReflect())
+		if !f(fd_EventSupplierDelegationSlashed_claim, value) {
+			return
+		}
+	}
+	if x.DelegatorAddress != "" {
+		value := protoreflect.ValueOfString(x.DelegatorAddress)
+		if !f(fd_EventSupplierDelegationSlashed_delegator_address, value) {
+			return
+		}
+	}
+	if x.SupplierOperatorAddress != "" {
+		value := protoreflect.ValueOfString(x.SupplierOperatorAddress)
+		if !f(fd_EventSupplierDelegationSlashed_supplier_operator_address, value) {
+			return
+		}
+	}
+	if x.SlashedAmount != nil {
+		value := protoreflect.ValueOfMessage(x.SlashedAmount.ProtoReflect())
+		if !f(fd_EventSupplierDelegationSlashed_slashed_amount, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -2251,17 +2829,21 @@ func (x *fastReflection_EventSupplierSlashed) Range(f func(protoreflect.FieldDes
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_EventSupplierSlashed) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_EventSupplierDelegationSlashed) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "pocket.tokenomics.EventSupplierSlashed.claim":
+	case "pocket.tokenomics.EventSupplierDelegationSlashed.claim":
 		return x.Claim != nil
-	case "pocket.tokenomics.EventSupplierSlashed.proof_missing_penalty":
-		return x.ProofMissingPenalty != nil
+	case "pocket.tokenomics.EventSupplierDelegationSlashed.delegator_address":
+		return x.DelegatorAddress != ""
+	case "pocket.tokenomics.EventSupplierDelegationSlashed.supplier_operator_address":
+		return x.SupplierOperatorAddress != ""
+	case "pocket.tokenomics.EventSupplierDelegationSlashed.slashed_amount":
+		return x.SlashedAmount != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.tokenomics.EventSupplierSlashed"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.tokenomics.EventSupplierDelegationSlashed"))
 		}
-		panic(fmt.Errorf("message pocket.tokenomics.EventSupplierSlashed does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message pocket.tokenomics.EventSupplierDelegationSlashed does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2271,17 +2853,21 @@ func (x *fastReflection_EventSupplierSlashed) Has(fd protoreflect.FieldDescripto
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventSupplierSlashed) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_EventSupplierDelegationSlashed) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "pocket.tokenomics.EventSupplierSlashed.claim":
+	case "pocket.tokenomics.EventSupplierDelegationSlashed.claim":
 		x.Claim = nil
-	case "pocket.tokenomics.EventSupplierSlashed.proof_missing_penalty":
-		x.ProofMissingPenalty = nil
+	case "pocket.tokenomics.EventSupplierDelegationSlashed.delegator_address":
+		x.DelegatorAddress = ""
+	case "pocket.tokenomics.EventSupplierDelegationSlashed.supplier_operator_address":
+		x.SupplierOperatorAddress = ""
+	case "pocket.tokenomics.EventSupplierDelegationSlashed.slashed_amount":
+		x.SlashedAmount = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.tokenomics.EventSupplierSlashed"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.tokenomics.EventSupplierDelegationSlashed"))
 		}
-		panic(fmt.Errorf("message pocket.tokenomics.EventSupplierSlashed does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message pocket.tokenomics.EventSupplierDelegationSlashed does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2291,19 +2877,25 @@ func (x *fastReflection_EventSupplierSlashed) Clear(fd protoreflect.FieldDescrip
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_EventSupplierSlashed) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventSupplierDelegationSlashed) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "pocket.tokenomics.EventSupplierSlashed.claim":
+	case "pocket.tokenomics.EventSupplierDelegationSlashed.claim":
 		value := x.Claim
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "pocket.tokenomics.EventSupplierSlashed.proof_missing_penalty":
-		value := x.ProofMissingPenalty
+	case "pocket.tokenomics.EventSupplierDelegationSlashed.delegator_address":
+		value := x.DelegatorAddress
+		return protoreflect.ValueOfString(value)
+	case "pocket.tokenomics.EventSupplierDelegationSlashed.supplier_operator_address":
+		value := x.SupplierOperatorAddress
+		return protoreflect.ValueOfString(value)
+	case "pocket.tokenomics.EventSupplierDelegationSlashed.slashed_amount":
+		value := x.SlashedAmount
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.tokenomics.EventSupplierSlashed"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.tokenomics.EventSupplierDelegationSlashed"))
 		}
-		panic(fmt.Errorf("message pocket.tokenomics.EventSupplierSlashed does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message pocket.tokenomics.EventSupplierDelegationSlashed does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2317,17 +2909,21 @@ func (x *fastReflection_EventSupplierSlashed) Get(descriptor protoreflect.FieldD
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventSupplierSlashed) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_EventSupplierDelegationSlashed) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "pocket.tokenomics.EventSupplierSlashed.claim":
+	case "pocket.tokenomics.EventSupplierDelegationSlashed.claim":
 		x.Claim = value.Message().Interface().(*proof.Claim)
-	case "pocket.tokenomics.EventSupplierSlashed.proof_missing_penalty":
-		x.ProofMissingPenalty = value.Message().Interface().(*v1beta1.Coin)
+	case "pocket.tokenomics.EventSupplierDelegationSlashed.delegator_address":
+		x.DelegatorAddress = value.Interface().(string)
+	case "pocket.tokenomics.EventSupplierDelegationSlashed.supplier_operator_address":
+		x.SupplierOperatorAddress = value.Interface().(string)
+	case "pocket.tokenomics.EventSupplierDelegationSlashed.slashed_amount":
+		x.SlashedAmount = value.Message().Interface().(*v1beta1.Coin)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.tokenomics.EventSupplierSlashed"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.tokenomics.EventSupplierDelegationSlashed"))
 		}
-		panic(fmt.Errorf("message pocket.tokenomics.EventSupplierSlashed does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message pocket.tokenomics.EventSupplierDelegationSlashed does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2341,52 +2937,60 @@ func (x *fastReflection_EventSupplierSlashed) Set(fd protoreflect.FieldDescripto
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventSupplierSlashed) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventSupplierDelegationSlashed) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "pocket.tokenomics.EventSupplierSlashed.claim":
+	case "pocket.tokenomics.EventSupplierDelegationSlashed.claim":
 		if x.Claim == nil {
 			x.Claim = new(proof.Claim)
 		}
 		return protoreflect.ValueOfMessage(x.Claim.ProtoReflect())
-	case "pocket.tokenomics.EventSupplierSlashed.proof_missing_penalty":
-		if x.ProofMissingPenalty == nil {
-			x.ProofMissingPenalty = new(v1beta1.Coin)
-		}
-		return protoreflect.ValueOfMessage(x.ProofMissingPenalty.ProtoReflect())
+	case "pocket.tokenomics.EventSupplierDelegationSlashed.slashed_amount":
+		if x.SlashedAmount == nil {
+			x.SlashedAmount = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.SlashedAmount.ProtoReflect())
+	case "pocket.tokenomics.EventSupplierDelegationSlashed.delegator_address":
+		panic(fmt.Errorf("field delegator_address of message pocket.tokenomics.EventSupplierDelegationSlashed is not mutable"))
+	case "pocket.tokenomics.EventSupplierDelegationSlashed.supplier_operator_address":
+		panic(fmt.Errorf("field supplier_operator_address of message pocket.tokenomics.EventSupplierDelegationSlashed is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.tokenomics.EventSupplierSlashed"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.tokenomics.EventSupplierDelegationSlashed"))
 		}
-		panic(fmt.Errorf("message pocket.tokenomics.EventSupplierSlashed does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message pocket.tokenomics.EventSupplierDelegationSlashed does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_EventSupplierSlashed) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventSupplierDelegationSlashed) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "pocket.tokenomics.EventSupplierSlashed.claim":
+	case "pocket.tokenomics.EventSupplierDelegationSlashed.claim":
 		m := new(proof.Claim)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "pocket.tokenomics.EventSupplierSlashed.proof_missing_penalty":
+	case "pocket.tokenomics.EventSupplierDelegationSlashed.delegator_address":
+		return protoreflect.ValueOfString("")
+	case "pocket.tokenomics.EventSupplierDelegationSlashed.supplier_operator_address":
+		return protoreflect.ValueOfString("")
+	case "pocket.tokenomics.EventSupplierDelegationSlashed.slashed_amount":
 		m := new(v1beta1.Coin)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.tokenomics.EventSupplierSlashed"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.tokenomics.EventSupplierDelegationSlashed"))
 		}
-		panic(fmt.Errorf("message pocket.tokenomics.EventSupplierSlashed does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message pocket.tokenomics.EventSupplierDelegationSlashed does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_EventSupplierSlashed) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_EventSupplierDelegationSlashed) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in pocket.tokenomics.EventSupplierSlashed", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in pocket.tokenomics.EventSupplierDelegationSlashed", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2394,7 +2998,7 @@ func (x *fastReflection_EventSupplierSlashed) WhichOneof(d protoreflect.OneofDes
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_EventSupplierSlashed) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_EventSupplierDelegationSlashed) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2405,7 +3009,7 @@ func (x *fastReflection_EventSupplierSlashed) GetUnknown() protoreflect.RawField
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventSupplierSlashed) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_EventSupplierDelegationSlashed) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2417,7 +3021,7 @@ func (x *fastReflection_EventSupplierSlashed) SetUnknown(fields protoreflect.Raw
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_EventSupplierSlashed) IsValid() bool {
+func (x *fastReflection_EventSupplierDelegationSlashed) IsValid() bool {
 	return x != nil
 }
 
@@ -2427,9 +3031,9 @@ func (x *fastReflection_EventSupplierSlashed) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_EventSupplierSlashed) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_EventSupplierDelegationSlashed) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*EventSupplierSlashed)
+		x := input.Message.Interface().(*EventSupplierDelegationSlashed)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2445,8 +3049,16 @@ func (x *fastReflection_EventSupplierSlashed) ProtoMethods() *protoiface.Methods
 			l = options.Size(x.Claim)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.ProofMissingPenalty != nil {
-			l = options.Size(x.ProofMissingPenalty)
+		l = len(x.DelegatorAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.SupplierOperatorAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.SlashedAmount != nil {
+			l = options.Size(x.SlashedAmount)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -2459,7 +3071,7 @@ func (x *fastReflection_EventSupplierSlashed) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*EventSupplierSlashed)
+		x := input.Message.Interface().(*EventSupplierDelegationSlashed)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2478,8 +3090,8 @@ func (x *fastReflection_EventSupplierSlashed) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.ProofMissingPenalty != nil {
-			encoded, err := options.Marshal(x.ProofMissingPenalty)
+		if x.SlashedAmount != nil {
+			encoded, err := options.Marshal(x.SlashedAmount)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2490,6 +3102,20 @@ func (x *fastReflection_EventSupplierSlashed) ProtoMethods() *protoiface.Methods
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.SupplierOperatorAddress) > 0 {
+			i -= len(x.SupplierOperatorAddress)
+			copy(dAtA[i:], x.SupplierOperatorAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SupplierOperatorAddress)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.DelegatorAddress) > 0 {
+			i -= len(x.DelegatorAddress)
+			copy(dAtA[i:], x.DelegatorAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DelegatorAddress)))
+			i--
 			dAtA[i] = 0x12
 		}
 		if x.Claim != nil {
@@ -2517,7 +3143,7 @@ func (x *fastReflection_EventSupplierSlashed) ProtoMethods() *protoiface.Methods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*EventSupplierSlashed)
+		x := input.Message.Interface().(*EventSupplierDelegationSlashed)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2549,10 +3175,10 @@ func (x *fastReflection_EventSupplierSlashed) ProtoMethods() *protoiface.Methods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventSupplierSlashed: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventSupplierDelegationSlashed: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventSupplierSlashed: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventSupplierDelegationSlashed: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -2593,7 +3219,71 @@ func (x *fastReflection_EventSupplierSlashed) ProtoMethods() *protoiface.Methods
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProofMissingPenalty", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SupplierOperatorAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SupplierOperatorAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SlashedAmount", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -2620,10 +3310,10 @@ func (x *fastReflection_EventSupplierSlashed) ProtoMethods() *protoiface.Methods
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.ProofMissingPenalty == nil {
-					x.ProofMissingPenalty = &v1beta1.Coin{}
+				if x.SlashedAmount == nil {
+					x.SlashedAmount = &v1beta1.Coin{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ProofMissingPenalty); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SlashedAmount); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -2692,7 +3382,7 @@ func (x *EventApplicationReimbursementRequest) ProtoReflect() protoreflect.Messa
 }
 
 func (x *EventApplicationReimbursementRequest) slowProtoReflect() protoreflect.Message {
-	mi := &file_pocket_tokenomics_event_proto_msgTypes[4]
+	mi := &file_pocket_tokenomics_event_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3490,7 +4180,7 @@ type EventClaimExpired struct {
 	Claim *proof.Claim `protobuf:"bytes,1,opt,name=claim,proto3" json:"claim,omitempty"`
 	// The reason why the claim expired, leading to a Supplier being penalized (i.e. burn).
 	ExpirationReason ClaimExpirationReason `protobuf:"varint,2,opt,name=expiration_reason,json=expirationReason,proto3,enum=pocket.tokenomics.ClaimExpirationReason" json:"expiration_reason,omitempty"`
-	// Number of relays claimed to be in the session tree.
+	//Number of relays claimed to be in the session tree.
 	NumRelays uint64 `protobuf:"varint,3,opt,name=num_relays,json=numRelays,proto3" json:"num_relays,omitempty"`
 	// Number of compute units claimed as a function of the number of relays
 	// and the compute units per relay for the particular service.
@@ -3739,6 +4429,9 @@ type EventSupplierSlashed struct {
 	// Amount slashed from the supplier's stake due to the expired claims.
 	// This is a function of the number of expired claims and proof missing penalty.
 	ProofMissingPenalty *v1beta1.Coin `protobuf:"bytes,2,opt,name=proof_missing_penalty,json=proofMissingPenalty,proto3" json:"proof_missing_penalty,omitempty"`
+	// The number of proof faults of the supplier within the proof fault window,
+	// including this one, which the proof missing penalty was escalated for.
+	NumProofFaults uint64 `protobuf:"varint,3,opt,name=num_proof_faults,json=numProofFaults,proto3" json:"num_proof_faults,omitempty"`
 }
 
 func (x *EventSupplierSlashed) Reset() {
@@ -3775,6 +4468,76 @@ func (x *EventSupplierSlashed) GetProofMissingPenalty() *v1beta1.Coin {
 	return nil
 }
 
+func (x *EventSupplierSlashed) GetNumProofFaults() uint64 {
+	if x != nil {
+		return x.NumProofFaults
+	}
+	return 0
+}
+
+// EventSupplierDelegationSlashed is emitted when a delegation to a supplier is
+// slashed, proportionally to its stake, along with the supplier.
+type EventSupplierDelegationSlashed struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Claim                   *proof.Claim `protobuf:"bytes,1,opt,name=claim,proto3" json:"claim,omitempty"`
+	DelegatorAddress        string       `protobuf:"bytes,2,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	SupplierOperatorAddress string       `protobuf:"bytes,3,opt,name=supplier_operator_address,json=supplierOperatorAddress,proto3" json:"supplier_operator_address,omitempty"`
+	// Amount slashed from the delegation's stake; its share of the supplier's
+	// proof missing penalty.
+	SlashedAmount *v1beta1.Coin `protobuf:"bytes,4,opt,name=slashed_amount,json=slashedAmount,proto3" json:"slashed_amount,omitempty"`
+}
+
+func (x *EventSupplierDelegationSlashed) Reset() {
+	*x = EventSupplierDelegationSlashed{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pocket_tokenomics_event_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventSupplierDelegationSlashed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventSupplierDelegationSlashed) ProtoMessage() {}
+
+// Deprecated: Use EventSupplierDelegationSlashed.ProtoReflect.Descriptor instead.
+func (*EventSupplierDelegationSlashed) Descriptor() ([]byte, []int) {
+	return file_pocket_tokenomics_event_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *EventSupplierDelegationSlashed) GetClaim() *proof.Claim {
+	if x != nil {
+		return x.Claim
+	}
+	return nil
+}
+
+func (x *EventSupplierDelegationSlashed) GetDelegatorAddress() string {
+	if x != nil {
+		return x.DelegatorAddress
+	}
+	return ""
+}
+
+func (x *EventSupplierDelegationSlashed) GetSupplierOperatorAddress() string {
+	if x != nil {
+		return x.SupplierOperatorAddress
+	}
+	return ""
+}
+
+func (x *EventSupplierDelegationSlashed) GetSlashedAmount() *v1beta1.Coin {
+	if x != nil {
+		return x.SlashedAmount
+	}
+	return nil
+}
+
 // EventApplicationReimbursementRequest is emitted when an application requests
 // a reimbursement.
 type EventApplicationReimbursementRequest struct {
@@ -3793,7 +4556,7 @@ type EventApplicationReimbursementRequest struct {
 func (x *EventApplicationReimbursementRequest) Reset() {
 	*x = EventApplicationReimbursementRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pocket_tokenomics_event_proto_msgTypes[4]
+		mi := &file_pocket_tokenomics_event_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3807,7 +4570,7 @@ func (*EventApplicationReimbursementRequest) ProtoMessage() {}
 
 // Deprecated: Use EventApplicationReimbursementRequest.ProtoReflect.Descriptor instead.
 func (*EventApplicationReimbursementRequest) Descriptor() ([]byte, []int) {
-	return file_pocket_tokenomics_event_proto_rawDescGZIP(), []int{4}
+	return file_pocket_tokenomics_event_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *EventApplicationReimbursementRequest) GetApplicationAddr() string {
@@ -3951,7 +4714,7 @@ var file_pocket_tokenomics_event_proto_rawDesc = []byte{
 	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62,
 	0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e,
 	0x52, 0x0d, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x42, 0x75, 0x72, 0x6e, 0x22,
-	0x90, 0x01, 0x0a, 0x14, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65,
+	0xba, 0x01, 0x0a, 0x14, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65,
 	0x72, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x05, 0x63, 0x6c, 0x61, 0x69,
 	0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74,
 	0x2e, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x2e, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x52, 0x05, 0x63, 0x6c,
@@ -3960,43 +4723,53 @@ var file_pocket_tokenomics_event_proto_rawDesc = []byte{
 	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65,
 	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x52, 0x13, 0x70,
 	0x72, 0x6f, 0x6f, 0x66, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x50, 0x65, 0x6e, 0x61, 0x6c,
-	0x74, 0x79, 0x22, 0xa8, 0x02, 0x0a, 0x24, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x41, 0x70, 0x70, 0x6c,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x69, 0x6d, 0x62, 0x75, 0x72, 0x73, 0x65,
-	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x61,
-	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x12, 0x34, 0x0a, 0x16, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x69,
-	0x65, 0x72, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72,
-	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x12, 0x2e, 0x0a, 0x13,
-	0x73, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x61,
-	0x64, 0x64, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x73, 0x75, 0x70, 0x70, 0x6c,
-	0x69, 0x65, 0x72, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x12, 0x1d, 0x0a, 0x0a,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73,
-	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x06, 0x61, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
-	0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x2a, 0x60, 0x0a,
-	0x15, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x45, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x1d, 0x45, 0x58, 0x50, 0x49, 0x52, 0x41,
-	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50,
-	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x50, 0x52, 0x4f,
-	0x4f, 0x46, 0x5f, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d,
-	0x50, 0x52, 0x4f, 0x4f, 0x46, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x10, 0x02, 0x42,
-	0xb0, 0x01, 0xd8, 0xe2, 0x1e, 0x01, 0x0a, 0x15, 0x63, 0x6f, 0x6d, 0x2e, 0x70, 0x6f, 0x63, 0x6b,
-	0x65, 0x74, 0x2e, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73, 0x42, 0x0a, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x22, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x6f,
-	0x63, 0x6b, 0x65, 0x74, 0x2f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73, 0xa2,
-	0x02, 0x03, 0x50, 0x54, 0x58, 0xaa, 0x02, 0x11, 0x50, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x54,
-	0x6f, 0x6b, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73, 0xca, 0x02, 0x11, 0x50, 0x6f, 0x63, 0x6b,
-	0x65, 0x74, 0x5c, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73, 0xe2, 0x02, 0x1d,
-	0x50, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x5c, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x63,
-	0x73, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x12,
-	0x50, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x3a, 0x3a, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x6f, 0x6d, 0x69,
-	0x63, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x74, 0x79, 0x12, 0x28, 0x0a, 0x10, 0x6e, 0x75, 0x6d, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x5f,
+	0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x6e, 0x75,
+	0x6d, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x22, 0xf6, 0x01, 0x0a,
+	0x1e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x44, 0x65,
+	0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x64, 0x12,
+	0x29, 0x0a, 0x05, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x2e, 0x43, 0x6c,
+	0x61, 0x69, 0x6d, 0x52, 0x05, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x12, 0x2b, 0x0a, 0x11, 0x64, 0x65,
+	0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x3a, 0x0a, 0x19, 0x73, 0x75, 0x70, 0x70, 0x6c,
+	0x69, 0x65, 0x72, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x17, 0x73, 0x75, 0x70, 0x70,
+	0x6c, 0x69, 0x65, 0x72, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x40, 0x0a, 0x0e, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x64, 0x5f, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x52, 0x0d, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x64, 0x41,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xa8, 0x02, 0x0a, 0x24, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x41,
+	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x69, 0x6d, 0x62, 0x75,
+	0x72, 0x73, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29,
+	0x0a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x64,
+	0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x12, 0x34, 0x0a, 0x16, 0x73, 0x75, 0x70,
+	0x70, 0x6c, 0x69, 0x65, 0x72, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x73, 0x75, 0x70, 0x70, 0x6c,
+	0x69, 0x65, 0x72, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x12,
+	0x2e, 0x0a, 0x13, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x5f, 0x6f, 0x77, 0x6e, 0x65,
+	0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x73, 0x75,
+	0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x12,
+	0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1d,
+	0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x31, 0x0a,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x2a, 0x60, 0x0a, 0x15, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x45, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x1d, 0x45, 0x58, 0x50,
+	0x49, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d,
+	0x50, 0x52, 0x4f, 0x4f, 0x46, 0x5f, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12,
+	0x11, 0x0a, 0x0d, 0x50, 0x52, 0x4f, 0x4f, 0x46, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44,
+	0x10, 0x02, 0x42, 0x28, 0xd8, 0xe2, 0x1e, 0x01, 0x5a, 0x22, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x6f, 0x63, 0x6b, 0x65,
+	0x74, 0x2f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -4012,37 +4785,40 @@ func file_pocket_tokenomics_event_proto_rawDescGZIP() []byte {
 }
 
 var file_pocket_tokenomics_event_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_pocket_tokenomics_event_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_pocket_tokenomics_event_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_pocket_tokenomics_event_proto_goTypes = []interface{}{
 	(ClaimExpirationReason)(0),                   // 0: pocket.tokenomics.ClaimExpirationReason
 	(*EventClaimExpired)(nil),                    // 1: pocket.tokenomics.EventClaimExpired
 	(*EventClaimSettled)(nil),                    // 2: pocket.tokenomics.EventClaimSettled
 	(*EventApplicationOverserviced)(nil),         // 3: pocket.tokenomics.EventApplicationOverserviced
 	(*EventSupplierSlashed)(nil),                 // 4: pocket.tokenomics.EventSupplierSlashed
-	(*EventApplicationReimbursementRequest)(nil), // 5: pocket.tokenomics.EventApplicationReimbursementRequest
-	(*proof.Claim)(nil),                          // 6: pocket.proof.Claim
-	(*v1beta1.Coin)(nil),                         // 7: cosmos.base.v1beta1.Coin
-	(proof.ProofRequirementReason)(0),            // 8: pocket.proof.ProofRequirementReason
-	(*ClaimSettlementResult)(nil),                // 9: pocket.tokenomics.ClaimSettlementResult
+	(*EventSupplierDelegationSlashed)(nil),       // 5: pocket.tokenomics.EventSupplierDelegationSlashed
+	(*EventApplicationReimbursementRequest)(nil), // 6: pocket.tokenomics.EventApplicationReimbursementRequest
+	(*proof.Claim)(nil),                          // 7: pocket.proof.Claim
+	(*v1beta1.Coin)(nil),                         // 8: cosmos.base.v1beta1.Coin
+	(proof.ProofRequirementReason)(0),            // 9: pocket.proof.ProofRequirementReason
+	(*ClaimSettlementResult)(nil),                // 10: pocket.tokenomics.ClaimSettlementResult
 }
 var file_pocket_tokenomics_event_proto_depIdxs = []int32{
-	6,  // 0: pocket.tokenomics.EventClaimExpired.claim:type_name -> pocket.proof.Claim
+	7,  // 0: pocket.tokenomics.EventClaimExpired.claim:type_name -> pocket.proof.Claim
 	0,  // 1: pocket.tokenomics.EventClaimExpired.expiration_reason:type_name -> pocket.tokenomics.ClaimExpirationReason
-	7,  // 2: pocket.tokenomics.EventClaimExpired.claimed_upokt:type_name -> cosmos.base.v1beta1.Coin
-	6,  // 3: pocket.tokenomics.EventClaimSettled.claim:type_name -> pocket.proof.Claim
-	8,  // 4: pocket.tokenomics.EventClaimSettled.proof_requirement:type_name -> pocket.proof.ProofRequirementReason
-	7,  // 5: pocket.tokenomics.EventClaimSettled.claimed_upokt:type_name -> cosmos.base.v1beta1.Coin
-	9,  // 6: pocket.tokenomics.EventClaimSettled.settlement_result:type_name -> pocket.tokenomics.ClaimSettlementResult
-	7,  // 7: pocket.tokenomics.EventApplicationOverserviced.expected_burn:type_name -> cosmos.base.v1beta1.Coin
-	7,  // 8: pocket.tokenomics.EventApplicationOverserviced.effective_burn:type_name -> cosmos.base.v1beta1.Coin
-	6,  // 9: pocket.tokenomics.EventSupplierSlashed.claim:type_name -> pocket.proof.Claim
-	7,  // 10: pocket.tokenomics.EventSupplierSlashed.proof_missing_penalty:type_name -> cosmos.base.v1beta1.Coin
-	7,  // 11: pocket.tokenomics.EventApplicationReimbursementRequest.amount:type_name -> cosmos.base.v1beta1.Coin
-	12, // [12:12] is the sub-list for method output_type
-	12, // [12:12] is the sub-list for method input_type
-	12, // [12:12] is the sub-list for extension type_name
-	12, // [12:12] is the sub-list for extension extendee
-	0,  // [0:12] is the sub-list for field type_name
+	8,  // 2: pocket.tokenomics.EventClaimExpired.claimed_upokt:type_name -> cosmos.base.v1beta1.Coin
+	7,  // 3: pocket.tokenomics.EventClaimSettled.claim:type_name -> pocket.proof.Claim
+	9,  // 4: pocket.tokenomics.EventClaimSettled.proof_requirement:type_name -> pocket.proof.ProofRequirementReason
+	8,  // 5: pocket.tokenomics.EventClaimSettled.claimed_upokt:type_name -> cosmos.base.v1beta1.Coin
+	10, // 6: pocket.tokenomics.EventClaimSettled.settlement_result:type_name -> pocket.tokenomics.ClaimSettlementResult
+	8,  // 7: pocket.tokenomics.EventApplicationOverserviced.expected_burn:type_name -> cosmos.base.v1beta1.Coin
+	8,  // 8: pocket.tokenomics.EventApplicationOverserviced.effective_burn:type_name -> cosmos.base.v1beta1.Coin
+	7,  // 9: pocket.tokenomics.EventSupplierSlashed.claim:type_name -> pocket.proof.Claim
+	8,  // 10: pocket.tokenomics.EventSupplierSlashed.proof_missing_penalty:type_name -> cosmos.base.v1beta1.Coin
+	7,  // 11: pocket.tokenomics.EventSupplierDelegationSlashed.claim:type_name -> pocket.proof.Claim
+	8,  // 12: pocket.tokenomics.EventSupplierDelegationSlashed.slashed_amount:type_name -> cosmos.base.v1beta1.Coin
+	8,  // 13: pocket.tokenomics.EventApplicationReimbursementRequest.amount:type_name -> cosmos.base.v1beta1.Coin
+	14, // [14:14] is the sub-list for method output_type
+	14, // [14:14] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
 }
 
 func init() { file_pocket_tokenomics_event_proto_init() }
@@ -4101,6 +4877,18 @@ func file_pocket_tokenomics_event_proto_init() {
 			}
 		}
 		file_pocket_tokenomics_event_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventSupplierDelegationSlashed); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pocket_tokenomics_event_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*EventApplicationReimbursementRequest); i {
 			case 0:
 				return &v.state
@@ -4119,7 +4907,7 @@ func file_pocket_tokenomics_event_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_pocket_tokenomics_event_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   5,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -17,11 +17,63 @@ import (
 	sync "sync"
 )
 
+var _ protoreflect.List = (*_Params_8_list)(nil)
+
+type _Params_8_list struct {
+	list *[]*TokenLogicModuleConfig
+}
+
+func (x *_Params_8_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_8_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Params_8_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TokenLogicModuleConfig)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_8_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TokenLogicModuleConfig)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_8_list) AppendMutable() protoreflect.Value {
+	v := new(TokenLogicModuleConfig)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_8_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_8_list) NewElement() protoreflect.Value {
+	v := new(TokenLogicModuleConfig)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_8_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_Params                             protoreflect.MessageDescriptor
 	fd_Params_mint_allocation_percentages protoreflect.FieldDescriptor
 	fd_Params_dao_reward_address          protoreflect.FieldDescriptor
 	fd_Params_global_inflation_per_claim  protoreflect.FieldDescriptor
+	fd_Params_token_logic_modules         protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -30,6 +82,7 @@ func init() {
 	fd_Params_mint_allocation_percentages = md_Params.Fields().ByName("mint_allocation_percentages")
 	fd_Params_dao_reward_address = md_Params.Fields().ByName("dao_reward_address")
 	fd_Params_global_inflation_per_claim = md_Params.Fields().ByName("global_inflation_per_claim")
+	fd_Params_token_logic_modules = md_Params.Fields().ByName("token_logic_modules")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -115,6 +168,12 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if len(x.TokenLogicModules) != 0 {
+		value := protoreflect.ValueOfList(&_Params_8_list{list: &x.TokenLogicModules})
+		if !f(fd_Params_token_logic_modules, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -136,6 +195,8 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.DaoRewardAddress != ""
 	case "pocket.tokenomics.Params.global_inflation_per_claim":
 		return x.GlobalInflationPerClaim != float64(0) || math.Signbit(x.GlobalInflationPerClaim)
+	case "pocket.tokenomics.Params.token_logic_modules":
+		return len(x.TokenLogicModules) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.tokenomics.Params"))
@@ -158,6 +219,8 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.DaoRewardAddress = ""
 	case "pocket.tokenomics.Params.global_inflation_per_claim":
 		x.GlobalInflationPerClaim = float64(0)
+	case "pocket.tokenomics.Params.token_logic_modules":
+		x.TokenLogicModules = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.tokenomics.Params"))
@@ -183,6 +246,12 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "pocket.tokenomics.Params.global_inflation_per_claim":
 		value := x.GlobalInflationPerClaim
 		return protoreflect.ValueOfFloat64(value)
+	case "pocket.tokenomics.Params.token_logic_modules":
+		if len(x.TokenLogicModules) == 0 {
+			return protoreflect.ValueOfList(&_Params_8_list{})
+		}
+		listValue := &_Params_8_list{list: &x.TokenLogicModules}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.tokenomics.Params"))
@@ -209,6 +278,10 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		x.DaoRewardAddress = value.Interface().(string)
 	case "pocket.tokenomics.Params.global_inflation_per_claim":
 		x.GlobalInflationPerClaim = value.Float()
+	case "pocket.tokenomics.Params.token_logic_modules":
+		lv := value.List()
+		clv := lv.(*_Params_8_list)
+		x.TokenLogicModules = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.tokenomics.Params"))
@@ -234,6 +307,12 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 			x.MintAllocationPercentages = new(MintAllocationPercentages)
 		}
 		return protoreflect.ValueOfMessage(x.MintAllocationPercentages.ProtoReflect())
+	case "pocket.tokenomics.Params.token_logic_modules":
+		if x.TokenLogicModules == nil {
+			x.TokenLogicModules = []*TokenLogicModuleConfig{}
+		}
+		value := &_Params_8_list{list: &x.TokenLogicModules}
+		return protoreflect.ValueOfList(value)
 	case "pocket.tokenomics.Params.dao_reward_address":
 		panic(fmt.Errorf("field dao_reward_address of message pocket.tokenomics.Params is not mutable"))
 	case "pocket.tokenomics.Params.global_inflation_per_claim":
@@ -258,6 +337,9 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfString("")
 	case "pocket.tokenomics.Params.global_inflation_per_claim":
 		return protoreflect.ValueOfFloat64(float64(0))
+	case "pocket.tokenomics.Params.token_logic_modules":
+		list := []*TokenLogicModuleConfig{}
+		return protoreflect.ValueOfList(&_Params_8_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.tokenomics.Params"))
@@ -338,6 +420,12 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		if x.GlobalInflationPerClaim != 0 || math.Signbit(x.GlobalInflationPerClaim) {
 			n += 9
 		}
+		if len(x.TokenLogicModules) > 0 {
+			for _, e := range x.TokenLogicModules {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -367,6 +455,22 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.TokenLogicModules) > 0 {
+			for iNdEx := len(x.TokenLogicModules) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.TokenLogicModules[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x42
+			}
+		}
 		if x.GlobalInflationPerClaim != 0 || math.Signbit(x.GlobalInflationPerClaim) {
 			i -= 8
 			binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(x.GlobalInflationPerClaim))))
@@ -522,6 +626,40 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
 				iNdEx += 8
 				x.GlobalInflationPerClaim = float64(math.Float64frombits(v))
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TokenLogicModules", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TokenLogicModules = append(x.TokenLogicModules, &TokenLogicModuleConfig{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TokenLogicModules[len(x.TokenLogicModules)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1118,155 +1256,3304 @@ func (x *fastReflection_MintAllocationPercentages) ProtoMethods() *protoiface.Me
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: pocket/tokenomics/params.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+var (
+	md_TokenLogicModuleConfig                                   protoreflect.MessageDescriptor
+	fd_TokenLogicModuleConfig_id                                protoreflect.FieldDescriptor
+	fd_TokenLogicModuleConfig_relay_burn_equals_mint            protoreflect.FieldDescriptor
+	fd_TokenLogicModuleConfig_global_mint                       protoreflect.FieldDescriptor
+	fd_TokenLogicModuleConfig_global_mint_reimbursement_request protoreflect.FieldDescriptor
+	fd_TokenLogicModuleConfig_service_owner_rev_share           protoreflect.FieldDescriptor
 )
 
-// Params defines the parameters for the tokenomics module.
-type Params struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func init() {
+	file_pocket_tokenomics_params_proto_init()
+	md_TokenLogicModuleConfig = File_pocket_tokenomics_params_proto.Messages().ByName("TokenLogicModuleConfig")
+	fd_TokenLogicModuleConfig_id = md_TokenLogicModuleConfig.Fields().ByName("id")
+	fd_TokenLogicModuleConfig_relay_burn_equals_mint = md_TokenLogicModuleConfig.Fields().ByName("relay_burn_equals_mint")
+	fd_TokenLogicModuleConfig_global_mint = md_TokenLogicModuleConfig.Fields().ByName("global_mint")
+	fd_TokenLogicModuleConfig_global_mint_reimbursement_request = md_TokenLogicModuleConfig.Fields().ByName("global_mint_reimbursement_request")
+	fd_TokenLogicModuleConfig_service_owner_rev_share = md_TokenLogicModuleConfig.Fields().ByName("service_owner_rev_share")
+}
 
-	// mint_allocation_percentages represents the distribution of newly minted tokens,
-	// at the end of claim settlement, as a result of the Global Mint TLM.
-	MintAllocationPercentages *MintAllocationPercentages `protobuf:"bytes,1,opt,name=mint_allocation_percentages,json=mintAllocationPercentages,proto3" json:"mint_allocation_percentages,omitempty"`
-	// dao_reward_address is the address to which mint_allocation_dao percentage of the
-	// minted tokens are at the end of claim settlement.
-	DaoRewardAddress string `protobuf:"bytes,6,opt,name=dao_reward_address,json=daoRewardAddress,proto3" json:"dao_reward_address,omitempty"` // Bech32 cosmos address
-	// global_inflation_per_claim is the percentage of a claim's claimable uPOKT amount which will be minted on settlement.
-	GlobalInflationPerClaim float64 `protobuf:"fixed64,7,opt,name=global_inflation_per_claim,json=globalInflationPerClaim,proto3" json:"global_inflation_per_claim,omitempty"`
+var _ protoreflect.Message = (*fastReflection_TokenLogicModuleConfig)(nil)
+
+type fastReflection_TokenLogicModuleConfig TokenLogicModuleConfig
+
+func (x *TokenLogicModuleConfig) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TokenLogicModuleConfig)(x)
 }
 
-func (x *Params) Reset() {
-	*x = Params{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pocket_tokenomics_params_proto_msgTypes[0]
+func (x *TokenLogicModuleConfig) slowProtoReflect() protoreflect.Message {
+	mi := &file_pocket_tokenomics_params_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
+	return mi.MessageOf(x)
 }
 
-func (x *Params) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*Params) ProtoMessage() {}
+var _fastReflection_TokenLogicModuleConfig_messageType fastReflection_TokenLogicModuleConfig_messageType
+var _ protoreflect.MessageType = fastReflection_TokenLogicModuleConfig_messageType{}
 
-// Deprecated: Use Params.ProtoReflect.Descriptor instead.
-func (*Params) Descriptor() ([]byte, []int) {
-	return file_pocket_tokenomics_params_proto_rawDescGZIP(), []int{0}
-}
+type fastReflection_TokenLogicModuleConfig_messageType struct{}
 
-func (x *Params) GetMintAllocationPercentages() *MintAllocationPercentages {
-	if x != nil {
-		return x.MintAllocationPercentages
-	}
-	return nil
+func (x fastReflection_TokenLogicModuleConfig_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TokenLogicModuleConfig)(nil)
 }
-
-func (x *Params) GetDaoRewardAddress() string {
-	if x != nil {
-		return x.DaoRewardAddress
-	}
-	return ""
+func (x fastReflection_TokenLogicModuleConfig_messageType) New() protoreflect.Message {
+	return new(fastReflection_TokenLogicModuleConfig)
 }
-
-func (x *Params) GetGlobalInflationPerClaim() float64 {
-	if x != nil {
-		return x.GlobalInflationPerClaim
-	}
-	return 0
+func (x fastReflection_TokenLogicModuleConfig_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TokenLogicModuleConfig
 }
 
-// MintAllocationPercentages represents the distribution of newly minted tokens,
-// at the end of claim settlement, as a result of the Global Mint TLM.
-type MintAllocationPercentages struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// dao is the percentage of the minted tokens which are sent
-	// to the DAO reward address during claim settlement.
-	Dao float64 `protobuf:"fixed64,1,opt,name=dao,proto3" json:"dao,omitempty"`
-	// proposer is the percentage of the minted tokens which are sent
-	// to the block proposer account address during claim settlement.
-	Proposer float64 `protobuf:"fixed64,2,opt,name=proposer,proto3" json:"proposer,omitempty"`
-	// supplier is the percentage of the minted tokens which are sent
-	// to the block supplier account address during claim settlement.
-	Supplier float64 `protobuf:"fixed64,3,opt,name=supplier,proto3" json:"supplier,omitempty"`
-	// source_owner is the percentage of the minted tokens which are sent
-	// to the service source owner account address during claim settlement.
-	SourceOwner float64 `protobuf:"fixed64,4,opt,name=source_owner,json=sourceOwner,proto3" json:"source_owner,omitempty"`
-	// allocation_application is the percentage of the minted tokens which are sent
-	// to the application account address during claim settlement.
-	Application float64 `protobuf:"fixed64,5,opt,name=application,proto3" json:"application,omitempty"`
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_TokenLogicModuleConfig) Descriptor() protoreflect.MessageDescriptor {
+	return md_TokenLogicModuleConfig
 }
 
-func (x *MintAllocationPercentages) Reset() {
-	*x = MintAllocationPercentages{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pocket_tokenomics_params_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_TokenLogicModuleConfig) Type() protoreflect.MessageType {
+	return _fastReflection_TokenLogicModuleConfig_messageType
 }
 
-func (x *MintAllocationPercentages) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_TokenLogicModuleConfig) New() protoreflect.Message {
+	return new(fastReflection_TokenLogicModuleConfig)
 }
 
-func (*MintAllocationPercentages) ProtoMessage() {}
-
-// Deprecated: Use MintAllocationPercentages.ProtoReflect.Descriptor instead.
-func (*MintAllocationPercentages) Descriptor() ([]byte, []int) {
-	return file_pocket_tokenomics_params_proto_rawDescGZIP(), []int{1}
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_TokenLogicModuleConfig) Interface() protoreflect.ProtoMessage {
+	return (*TokenLogicModuleConfig)(x)
 }
 
-func (x *MintAllocationPercentages) GetDao() float64 {
-	if x != nil {
-		return x.Dao
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_TokenLogicModuleConfig) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Id))
+		if !f(fd_TokenLogicModuleConfig_id, value) {
+			return
+		}
+	}
+	if x.Params != nil {
+		switch o := x.Params.(type) {
+		case *TokenLogicModuleConfig_RelayBurnEqualsMint:
+			v := o.RelayBurnEqualsMint
+			value := protoreflect.ValueOfMessage(v.ProtoReflect())
+			if !f(fd_TokenLogicModuleConfig_relay_burn_equals_mint, value) {
+				return
+			}
+		case *TokenLogicModuleConfig_GlobalMint:
+			v := o.GlobalMint
+			value := protoreflect.ValueOfMessage(v.ProtoReflect())
+			if !f(fd_TokenLogicModuleConfig_global_mint, value) {
+				return
+			}
+		case *TokenLogicModuleConfig_GlobalMintReimbursementRequest:
+			v := o.GlobalMintReimbursementRequest
+			value := protoreflect.ValueOfMessage(v.ProtoReflect())
+			if !f(fd_TokenLogicModuleConfig_global_mint_reimbursement_request, value) {
+				return
+			}
+		case *TokenLogicModuleConfig_ServiceOwnerRevShare:
+			v := o.ServiceOwnerRevShare
+			value := protoreflect.ValueOfMessage(v.ProtoReflect())
+			if !f(fd_TokenLogicModuleConfig_service_owner_rev_share, value) {
+				return
+			}
+		}
 	}
-	return 0
 }
 
-func (x *MintAllocationPercentages) GetProposer() float64 {
-	if x != nil {
-		return x.Proposer
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_TokenLogicModuleConfig) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "pocket.tokenomics.TokenLogicModuleConfig.id":
+		return x.Id != 0
+	case "pocket.tokenomics.TokenLogicModuleConfig.relay_burn_equals_mint":
+		if x.Params == nil {
+			return false
+		} else if _, ok := x.Params.(*TokenLogicModuleConfig_RelayBurnEqualsMint); ok {
+			return true
+		} else {
+			return false
+		}
+	case "pocket.tokenomics.TokenLogicModuleConfig.global_mint":
+		if x.Params == nil {
+			return false
+		} else if _, ok := x.Params.(*TokenLogicModuleConfig_GlobalMint); ok {
+			return true
+		} else {
+			return false
+		}
+	case "pocket.tokenomics.TokenLogicModuleConfig.global_mint_reimbursement_request":
+		if x.Params == nil {
+			return false
+		} else if _, ok := x.Params.(*TokenLogicModuleConfig_GlobalMintReimbursementRequest); ok {
+			return true
+		} else {
+			return false
+		}
+	case "pocket.tokenomics.TokenLogicModuleConfig.service_owner_rev_share":
+		if x.Params == nil {
+			return false
+		} else if _, ok := x.Params.(*TokenLogicModuleConfig_ServiceOwnerRevShare); ok {
+			return true
+		} else {
+			return false
+		}
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.tokenomics.TokenLogicModuleConfig"))
+		}
+		panic(fmt.Errorf("message pocket.tokenomics.TokenLogicModuleConfig does not contain field %s", fd.FullName()))
 	}
-	return 0
 }
 
-func (x *MintAllocationPercentages) GetSupplier() float64 {
-	if x != nil {
-		return x.Supplier
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TokenLogicModuleConfig) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "pocket.tokenomics.TokenLogicModuleConfig.id":
+		x.Id = 0
+	case "pocket.tokenomics.TokenLogicModuleConfig.relay_burn_equals_mint":
+		x.Params = nil
+	case "pocket.tokenomics.TokenLogicModuleConfig.global_mint":
+		x.Params = nil
+	case "pocket.tokenomics.TokenLogicModuleConfig.global_mint_reimbursement_request":
+		x.Params = nil
+	case "pocket.tokenomics.TokenLogicModuleConfig.service_owner_rev_share":
+		x.Params = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.tokenomics.TokenLogicModuleConfig"))
+		}
+		panic(fmt.Errorf("message pocket.tokenomics.TokenLogicModuleConfig does not contain field %s", fd.FullName()))
 	}
-	return 0
 }
 
-func (x *MintAllocationPercentages) GetSourceOwner() float64 {
-	if x != nil {
-		return x.SourceOwner
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_TokenLogicModuleConfig) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "pocket.tokenomics.TokenLogicModuleConfig.id":
+		value := x.Id
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "pocket.tokenomics.TokenLogicModuleConfig.relay_burn_equals_mint":
+		if x.Params == nil {
+			return protoreflect.ValueOfMessage((*TLMRelayBurnEqualsMintParams)(nil).ProtoReflect())
+		} else if v, ok := x.Params.(*TokenLogicModuleConfig_RelayBurnEqualsMint); ok {
+			return protoreflect.ValueOfMessage(v.RelayBurnEqualsMint.ProtoReflect())
+		} else {
+			return protoreflect.ValueOfMessage((*TLMRelayBurnEqualsMintParams)(nil).ProtoReflect())
+		}
+	case "pocket.tokenomics.TokenLogicModuleConfig.global_mint":
+		if x.Params == nil {
+			return protoreflect.ValueOfMessage((*TLMGlobalMintParams)(nil).ProtoReflect())
+		} else if v, ok := x.Params.(*TokenLogicModuleConfig_GlobalMint); ok {
+			return protoreflect.ValueOfMessage(v.GlobalMint.ProtoReflect())
+		} else {
+			return protoreflect.ValueOfMessage((*TLMGlobalMintParams)(nil).ProtoReflect())
+		}
+	case "pocket.tokenomics.TokenLogicModuleConfig.global_mint_reimbursement_request":
+		if x.Params == nil {
+			return protoreflect.ValueOfMessage((*TLMGlobalMintReimbursementRequestParams)(nil).ProtoReflect())
+		} else if v, ok := x.Params.(*TokenLogicModuleConfig_GlobalMintReimbursementRequest); ok {
+			return protoreflect.ValueOfMessage(v.GlobalMintReimbursementRequest.ProtoReflect())
+		} else {
+			return protoreflect.ValueOfMessage((*TLMGlobalMintReimbursementRequestParams)(nil).ProtoReflect())
+		}
+	case "pocket.tokenomics.TokenLogicModuleConfig.service_owner_rev_share":
+		if x.Params == nil {
+			return protoreflect.ValueOfMessage((*TLMServiceOwnerRevShareParams)(nil).ProtoReflect())
+		} else if v, ok := x.Params.(*TokenLogicModuleConfig_ServiceOwnerRevShare); ok {
+			return protoreflect.ValueOfMessage(v.ServiceOwnerRevShare.ProtoReflect())
+		} else {
+			return protoreflect.ValueOfMessage((*TLMServiceOwnerRevShareParams)(nil).ProtoReflect())
+		}
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.tokenomics.TokenLogicModuleConfig"))
+		}
+		panic(fmt.Errorf("message pocket.tokenomics.TokenLogicModuleConfig does not contain field %s", descriptor.FullName()))
 	}
-	return 0
 }
 
-func (x *MintAllocationPercentages) GetApplication() float64 {
-	if x != nil {
-		return x.Application
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TokenLogicModuleConfig) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "pocket.tokenomics.TokenLogicModuleConfig.id":
+		x.Id = (TokenLogicModuleId)(value.Enum())
+	case "pocket.tokenomics.TokenLogicModuleConfig.relay_burn_equals_mint":
+		cv := value.Message().Interface().(*TLMRelayBurnEqualsMintParams)
+		x.Params = &TokenLogicModuleConfig_RelayBurnEqualsMint{RelayBurnEqualsMint: cv}
+	case "pocket.tokenomics.TokenLogicModuleConfig.global_mint":
+		cv := value.Message().Interface().(*TLMGlobalMintParams)
+		x.Params = &TokenLogicModuleConfig_GlobalMint{GlobalMint: cv}
+	case "pocket.tokenomics.TokenLogicModuleConfig.global_mint_reimbursement_request":
+		cv := value.Message().Interface().(*TLMGlobalMintReimbursementRequestParams)
+		x.Params = &TokenLogicModuleConfig_GlobalMintReimbursementRequest{GlobalMintReimbursementRequest: cv}
+	case "pocket.tokenomics.TokenLogicModuleConfig.service_owner_rev_share":
+		cv := value.Message().Interface().(*TLMServiceOwnerRevShareParams)
+		x.Params = &TokenLogicModuleConfig_ServiceOwnerRevShare{ServiceOwnerRevShare: cv}
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.tokenomics.TokenLogicModuleConfig"))
+		}
+		panic(fmt.Errorf("message pocket.tokenomics.TokenLogicModuleConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TokenLogicModuleConfig) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "pocket.tokenomics.TokenLogicModuleConfig.relay_burn_equals_mint":
+		if x.Params == nil {
+			value := &TLMRelayBurnEqualsMintParams{}
+			oneofValue := &TokenLogicModuleConfig_RelayBurnEqualsMint{RelayBurnEqualsMint: value}
+			x.Params = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+		switch m := x.Params.(type) {
+		case *TokenLogicModuleConfig_RelayBurnEqualsMint:
+			return protoreflect.ValueOfMessage(m.RelayBurnEqualsMint.ProtoReflect())
+		default:
+			value := &TLMRelayBurnEqualsMintParams{}
+			oneofValue := &TokenLogicModuleConfig_RelayBurnEqualsMint{RelayBurnEqualsMint: value}
+			x.Params = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+	case "pocket.tokenomics.TokenLogicModuleConfig.global_mint":
+		if x.Params == nil {
+			value := &TLMGlobalMintParams{}
+			oneofValue := &TokenLogicModuleConfig_GlobalMint{GlobalMint: value}
+			x.Params = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+		switch m := x.Params.(type) {
+		case *TokenLogicModuleConfig_GlobalMint:
+			return protoreflect.ValueOfMessage(m.GlobalMint.ProtoReflect())
+		default:
+			value := &TLMGlobalMintParams{}
+			oneofValue := &TokenLogicModuleConfig_GlobalMint{GlobalMint: value}
+			x.Params = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+	case "pocket.tokenomics.TokenLogicModuleConfig.global_mint_reimbursement_request":
+		if x.Params == nil {
+			value := &TLMGlobalMintReimbursementRequestParams{}
+			oneofValue := &TokenLogicModuleConfig_GlobalMintReimbursementRequest{GlobalMintReimbursementRequest: value}
+			x.Params = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+		switch m := x.Params.(type) {
+		case *TokenLogicModuleConfig_GlobalMintReimbursementRequest:
+			return protoreflect.ValueOfMessage(m.GlobalMintReimbursementRequest.ProtoReflect())
+		default:
+			value := &TLMGlobalMintReimbursementRequestParams{}
+			oneofValue := &TokenLogicModuleConfig_GlobalMintReimbursementRequest{GlobalMintReimbursementRequest: value}
+			x.Params = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+	case "pocket.tokenomics.TokenLogicModuleConfig.service_owner_rev_share":
+		if x.Params == nil {
+			value := &TLMServiceOwnerRevShareParams{}
+			oneofValue := &TokenLogicModuleConfig_ServiceOwnerRevShare{ServiceOwnerRevShare: value}
+			x.Params = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+		switch m := x.Params.(type) {
+		case *TokenLogicModuleConfig_ServiceOwnerRevShare:
+			return protoreflect.ValueOfMessage(m.ServiceOwnerRevShare.ProtoReflect())
+		default:
+			value := &TLMServiceOwnerRevShareParams{}
+			oneofValue := &TokenLogicModuleConfig_ServiceOwnerRevShare{ServiceOwnerRevShare: value}
+			x.Params = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+	case "pocket.tokenomics.TokenLogicModuleConfig.id":
+		panic(fmt.Errorf("field id of message pocket.tokenomics.TokenLogicModuleConfig is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.tokenomics.TokenLogicModuleConfig"))
+		}
+		panic(fmt.Errorf("message pocket.tokenomics.TokenLogicModuleConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_TokenLogicModuleConfig) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "pocket.tokenomics.TokenLogicModuleConfig.id":
+		return protoreflect.ValueOfEnum(0)
+	case "pocket.tokenomics.TokenLogicModuleConfig.relay_burn_equals_mint":
+		value := &TLMRelayBurnEqualsMintParams{}
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "pocket.tokenomics.TokenLogicModuleConfig.global_mint":
+		value := &TLMGlobalMintParams{}
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "pocket.tokenomics.TokenLogicModuleConfig.global_mint_reimbursement_request":
+		value := &TLMGlobalMintReimbursementRequestParams{}
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "pocket.tokenomics.TokenLogicModuleConfig.service_owner_rev_share":
+		value := &TLMServiceOwnerRevShareParams{}
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.tokenomics.TokenLogicModuleConfig"))
+		}
+		panic(fmt.Errorf("message pocket.tokenomics.TokenLogicModuleConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_TokenLogicModuleConfig) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	case "pocket.tokenomics.TokenLogicModuleConfig.params":
+		if x.Params == nil {
+			return nil
+		}
+		switch x.Params.(type) {
+		case *TokenLogicModuleConfig_RelayBurnEqualsMint:
+			return x.Descriptor().Fields().ByName("relay_burn_equals_mint")
+		case *TokenLogicModuleConfig_GlobalMint:
+			return x.Descriptor().Fields().ByName("global_mint")
+		case *TokenLogicModuleConfig_GlobalMintReimbursementRequest:
+			return x.Descriptor().Fields().ByName("global_mint_reimbursement_request")
+		case *TokenLogicModuleConfig_ServiceOwnerRevShare:
+			return x.Descriptor().Fields().ByName("service_owner_rev_share")
+		}
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in pocket.tokenomics.TokenLogicModuleConfig", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_TokenLogicModuleConfig) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TokenLogicModuleConfig) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_TokenLogicModuleConfig) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_TokenLogicModuleConfig) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*TokenLogicModuleConfig)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		switch x := x.Params.(type) {
+		case *TokenLogicModuleConfig_RelayBurnEqualsMint:
+			if x == nil {
+				break
+			}
+			l = options.Size(x.RelayBurnEqualsMint)
+			n += 1 + l + runtime.Sov(uint64(l))
+		case *TokenLogicModuleConfig_GlobalMint:
+			if x == nil {
+				break
+			}
+			l = options.Size(x.GlobalMint)
+			n += 1 + l + runtime.Sov(uint64(l))
+		case *TokenLogicModuleConfig_GlobalMintReimbursementRequest:
+			if x == nil {
+				break
+			}
+			l = options.Size(x.GlobalMintReimbursementRequest)
+			n += 1 + l + runtime.Sov(uint64(l))
+		case *TokenLogicModuleConfig_ServiceOwnerRevShare:
+			if x == nil {
+				break
+			}
+			l = options.Size(x.ServiceOwnerRevShare)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*TokenLogicModuleConfig)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		switch x := x.Params.(type) {
+		case *TokenLogicModuleConfig_RelayBurnEqualsMint:
+			encoded, err := options.Marshal(x.RelayBurnEqualsMint)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		case *TokenLogicModuleConfig_GlobalMint:
+			encoded, err := options.Marshal(x.GlobalMint)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		case *TokenLogicModuleConfig_GlobalMintReimbursementRequest:
+			encoded, err := options.Marshal(x.GlobalMintReimbursementRequest)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		case *TokenLogicModuleConfig_ServiceOwnerRevShare:
+			encoded, err := options.Marshal(x.ServiceOwnerRevShare)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*TokenLogicModuleConfig)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TokenLogicModuleConfig: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TokenLogicModuleConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= TokenLogicModuleId(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RelayBurnEqualsMint", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				v := &TLMRelayBurnEqualsMintParams{}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.Params = &TokenLogicModuleConfig_RelayBurnEqualsMint{v}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GlobalMint", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				v := &TLMGlobalMintParams{}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.Params = &TokenLogicModuleConfig_GlobalMint{v}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GlobalMintReimbursementRequest", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				v := &TLMGlobalMintReimbursementRequestParams{}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.Params = &TokenLogicModuleConfig_GlobalMintReimbursementRequest{v}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ServiceOwnerRevShare", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				v := &TLMServiceOwnerRevShareParams{}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.Params = &TokenLogicModuleConfig_ServiceOwnerRevShare{v}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_TLMRelayBurnEqualsMintParams protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_pocket_tokenomics_params_proto_init()
+	md_TLMRelayBurnEqualsMintParams = File_pocket_tokenomics_params_proto.Messages().ByName("TLMRelayBurnEqualsMintParams")
+}
+
+var _ protoreflect.Message = (*fastReflection_TLMRelayBurnEqualsMintParams)(nil)
+
+type fastReflection_TLMRelayBurnEqualsMintParams TLMRelayBurnEqualsMintParams
+
+func (x *TLMRelayBurnEqualsMintParams) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TLMRelayBurnEqualsMintParams)(x)
+}
+
+func (x *TLMRelayBurnEqualsMintParams) slowProtoReflect() protoreflect.Message {
+	mi := &file_pocket_tokenomics_params_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_TLMRelayBurnEqualsMintParams_messageType fastReflection_TLMRelayBurnEqualsMintParams_messageType
+var _ protoreflect.MessageType = fastReflection_TLMRelayBurnEqualsMintParams_messageType{}
+
+type fastReflection_TLMRelayBurnEqualsMintParams_messageType struct{}
+
+func (x fastReflection_TLMRelayBurnEqualsMintParams_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TLMRelayBurnEqualsMintParams)(nil)
+}
+func (x fastReflection_TLMRelayBurnEqualsMintParams_messageType) New() protoreflect.Message {
+	return new(fastReflection_TLMRelayBurnEqualsMintParams)
+}
+func (x fastReflection_TLMRelayBurnEqualsMintParams_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TLMRelayBurnEqualsMintParams
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_TLMRelayBurnEqualsMintParams) Descriptor() protoreflect.MessageDescriptor {
+	return md_TLMRelayBurnEqualsMintParams
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_TLMRelayBurnEqualsMintParams) Type() protoreflect.MessageType {
+	return _fastReflection_TLMRelayBurnEqualsMintParams_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_TLMRelayBurnEqualsMintParams) New() protoreflect.Message {
+	return new(fastReflection_TLMRelayBurnEqualsMintParams)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_TLMRelayBurnEqualsMintParams) Interface() protoreflect.ProtoMessage {
+	return (*TLMRelayBurnEqualsMintParams)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_TLMRelayBurnEqualsMintParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_TLMRelayBurnEqualsMintParams) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.tokenomics.TLMRelayBurnEqualsMintParams"))
+		}
+		panic(fmt.Errorf("message pocket.tokenomics.TLMRelayBurnEqualsMintParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TLMRelayBurnEqualsMintParams) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.tokenomics.TLMRelayBurnEqualsMintParams"))
+		}
+		panic(fmt.Errorf("message pocket.tokenomics.TLMRelayBurnEqualsMintParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_TLMRelayBurnEqualsMintParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.tokenomics.TLMRelayBurnEqualsMintParams"))
+		}
+		panic(fmt.Errorf("message pocket.tokenomics.TLMRelayBurnEqualsMintParams does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TLMRelayBurnEqualsMintParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.tokenomics.TLMRelayBurnEqualsMintParams"))
+		}
+		panic(fmt.Errorf("message pocket.tokenomics.TLMRelayBurnEqualsMintParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TLMRelayBurnEqualsMintParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.tokenomics.TLMRelayBurnEqualsMintParams"))
+		}
+		panic(fmt.Errorf("message pocket.tokenomics.TLMRelayBurnEqualsMintParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_TLMRelayBurnEqualsMintParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.tokenomics.TLMRelayBurnEqualsMintParams"))
+		}
+		panic(fmt.Errorf("message pocket.tokenomics.TLMRelayBurnEqualsMintParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_TLMRelayBurnEqualsMintParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in pocket.tokenomics.TLMRelayBurnEqualsMintParams", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_TLMRelayBurnEqualsMintParams) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TLMRelayBurnEqualsMintParams) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_TLMRelayBurnEqualsMintParams) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_TLMRelayBurnEqualsMintParams) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*TLMRelayBurnEqualsMintParams)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*TLMRelayBurnEqualsMintParams)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*TLMRelayBurnEqualsMintParams)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TLMRelayBurnEqualsMintParams: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TLMRelayBurnEqualsMintParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_TLMGlobalMintParams protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_pocket_tokenomics_params_proto_init()
+	md_TLMGlobalMintParams = File_pocket_tokenomics_params_proto.Messages().ByName("TLMGlobalMintParams")
+}
+
+var _ protoreflect.Message = (*fastReflection_TLMGlobalMintParams)(nil)
+
+type fastReflection_TLMGlobalMintParams TLMGlobalMintParams
+
+func (x *TLMGlobalMintParams) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TLMGlobalMintParams)(x)
+}
+
+func (x *TLMGlobalMintParams) slowProtoReflect() protoreflect.Message {
+	mi := &file_pocket_tokenomics_params_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_TLMGlobalMintParams_messageType fastReflection_TLMGlobalMintParams_messageType
+var _ protoreflect.MessageType = fastReflection_TLMGlobalMintParams_messageType{}
+
+type fastReflection_TLMGlobalMintParams_messageType struct{}
+
+func (x fastReflection_TLMGlobalMintParams_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TLMGlobalMintParams)(nil)
+}
+func (x fastReflection_TLMGlobalMintParams_messageType) New() protoreflect.Message {
+	return new(fastReflection_TLMGlobalMintParams)
+}
+func (x fastReflection_TLMGlobalMintParams_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TLMGlobalMintParams
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_TLMGlobalMintParams) Descriptor() protoreflect.MessageDescriptor {
+	return md_TLMGlobalMintParams
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_TLMGlobalMintParams) Type() protoreflect.MessageType {
+	return _fastReflection_TLMGlobalMintParams_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_TLMGlobalMintParams) New() protoreflect.Message {
+	return new(fastReflection_TLMGlobalMintParams)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_TLMGlobalMintParams) Interface() protoreflect.ProtoMessage {
+	return (*TLMGlobalMintParams)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_TLMGlobalMintParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_TLMGlobalMintParams) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.tokenomics.TLMGlobalMintParams"))
+		}
+		panic(fmt.Errorf("message pocket.tokenomics.TLMGlobalMintParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TLMGlobalMintParams) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.tokenomics.TLMGlobalMintParams"))
+		}
+		panic(fmt.Errorf("message pocket.tokenomics.TLMGlobalMintParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_TLMGlobalMintParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.tokenomics.TLMGlobalMintParams"))
+		}
+		panic(fmt.Errorf("message pocket.tokenomics.TLMGlobalMintParams does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TLMGlobalMintParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.tokenomics.TLMGlobalMintParams"))
+		}
+		panic(fmt.Errorf("message pocket.tokenomics.TLMGlobalMintParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TLMGlobalMintParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.tokenomics.TLMGlobalMintParams"))
+		}
+		panic(fmt.Errorf("message pocket.tokenomics.TLMGlobalMintParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_TLMGlobalMintParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.tokenomics.TLMGlobalMintParams"))
+		}
+		panic(fmt.Errorf("message pocket.tokenomics.TLMGlobalMintParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_TLMGlobalMintParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in pocket.tokenomics.TLMGlobalMintParams", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_TLMGlobalMintParams) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TLMGlobalMintParams) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_TLMGlobalMintParams) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_TLMGlobalMintParams) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*TLMGlobalMintParams)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*TLMGlobalMintParams)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*TLMGlobalMintParams)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TLMGlobalMintParams: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TLMGlobalMintParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_TLMGlobalMintReimbursementRequestParams protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_pocket_tokenomics_params_proto_init()
+	md_TLMGlobalMintReimbursementRequestParams = File_pocket_tokenomics_params_proto.Messages().ByName("TLMGlobalMintReimbursementRequestParams")
+}
+
+var _ protoreflect.Message = (*fastReflection_TLMGlobalMintReimbursementRequestParams)(nil)
+
+type fastReflection_TLMGlobalMintReimbursementRequestParams TLMGlobalMintReimbursementRequestParams
+
+func (x *TLMGlobalMintReimbursementRequestParams) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TLMGlobalMintReimbursementRequestParams)(x)
+}
+
+func (x *TLMGlobalMintReimbursementRequestParams) slowProtoReflect() protoreflect.Message {
+	mi := &file_pocket_tokenomics_params_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_TLMGlobalMintReimbursementRequestParams_messageType fastReflection_TLMGlobalMintReimbursementRequestParams_messageType
+var _ protoreflect.MessageType = fastReflection_TLMGlobalMintReimbursementRequestParams_messageType{}
+
+type fastReflection_TLMGlobalMintReimbursementRequestParams_messageType struct{}
+
+func (x fastReflection_TLMGlobalMintReimbursementRequestParams_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TLMGlobalMintReimbursementRequestParams)(nil)
+}
+func (x fastReflection_TLMGlobalMintReimbursementRequestParams_messageType) New() protoreflect.Message {
+	return new(fastReflection_TLMGlobalMintReimbursementRequestParams)
+}
+func (x fastReflection_TLMGlobalMintReimbursementRequestParams_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TLMGlobalMintReimbursementRequestParams
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_TLMGlobalMintReimbursementRequestParams) Descriptor() protoreflect.MessageDescriptor {
+	return md_TLMGlobalMintReimbursementRequestParams
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_TLMGlobalMintReimbursementRequestParams) Type() protoreflect.MessageType {
+	return _fastReflection_TLMGlobalMintReimbursementRequestParams_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_TLMGlobalMintReimbursementRequestParams) New() protoreflect.Message {
+	return new(fastReflection_TLMGlobalMintReimbursementRequestParams)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_TLMGlobalMintReimbursementRequestParams) Interface() protoreflect.ProtoMessage {
+	return (*TLMGlobalMintReimbursementRequestParams)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_TLMGlobalMintReimbursementRequestParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_TLMGlobalMintReimbursementRequestParams) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.tokenomics.TLMGlobalMintReimbursementRequestParams"))
+		}
+		panic(fmt.Errorf("message pocket.tokenomics.TLMGlobalMintReimbursementRequestParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TLMGlobalMintReimbursementRequestParams) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.tokenomics.TLMGlobalMintReimbursementRequestParams"))
+		}
+		panic(fmt.Errorf("message pocket.tokenomics.TLMGlobalMintReimbursementRequestParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_TLMGlobalMintReimbursementRequestParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.tokenomics.TLMGlobalMintReimbursementRequestParams"))
+		}
+		panic(fmt.Errorf("message pocket.tokenomics.TLMGlobalMintReimbursementRequestParams does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TLMGlobalMintReimbursementRequestParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.tokenomics.TLMGlobalMintReimbursementRequestParams"))
+		}
+		panic(fmt.Errorf("message pocket.tokenomics.TLMGlobalMintReimbursementRequestParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TLMGlobalMintReimbursementRequestParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.tokenomics.TLMGlobalMintReimbursementRequestParams"))
+		}
+		panic(fmt.Errorf("message pocket.tokenomics.TLMGlobalMintReimbursementRequestParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_TLMGlobalMintReimbursementRequestParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.tokenomics.TLMGlobalMintReimbursementRequestParams"))
+		}
+		panic(fmt.Errorf("message pocket.tokenomics.TLMGlobalMintReimbursementRequestParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_TLMGlobalMintReimbursementRequestParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in pocket.tokenomics.TLMGlobalMintReimbursementRequestParams", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_TLMGlobalMintReimbursementRequestParams) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TLMGlobalMintReimbursementRequestParams) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_TLMGlobalMintReimbursementRequestParams) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_TLMGlobalMintReimbursementRequestParams) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*TLMGlobalMintReimbursementRequestParams)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*TLMGlobalMintReimbursementRequestParams)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*TLMGlobalMintReimbursementRequestParams)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TLMGlobalMintReimbursementRequestParams: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TLMGlobalMintReimbursementRequestParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_TLMServiceOwnerRevShareParams                          protoreflect.MessageDescriptor
+	fd_TLMServiceOwnerRevShareParams_max_rev_share_percentage protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_pocket_tokenomics_params_proto_init()
+	md_TLMServiceOwnerRevShareParams = File_pocket_tokenomics_params_proto.Messages().ByName("TLMServiceOwnerRevShareParams")
+	fd_TLMServiceOwnerRevShareParams_max_rev_share_percentage = md_TLMServiceOwnerRevShareParams.Fields().ByName("max_rev_share_percentage")
+}
+
+var _ protoreflect.Message = (*fastReflection_TLMServiceOwnerRevShareParams)(nil)
+
+type fastReflection_TLMServiceOwnerRevShareParams TLMServiceOwnerRevShareParams
+
+func (x *TLMServiceOwnerRevShareParams) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TLMServiceOwnerRevShareParams)(x)
+}
+
+func (x *TLMServiceOwnerRevShareParams) slowProtoReflect() protoreflect.Message {
+	mi := &file_pocket_tokenomics_params_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_TLMServiceOwnerRevShareParams_messageType fastReflection_TLMServiceOwnerRevShareParams_messageType
+var _ protoreflect.MessageType = fastReflection_TLMServiceOwnerRevShareParams_messageType{}
+
+type fastReflection_TLMServiceOwnerRevShareParams_messageType struct{}
+
+func (x fastReflection_TLMServiceOwnerRevShareParams_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TLMServiceOwnerRevShareParams)(nil)
+}
+func (x fastReflection_TLMServiceOwnerRevShareParams_messageType) New() protoreflect.Message {
+	return new(fastReflection_TLMServiceOwnerRevShareParams)
+}
+func (x fastReflection_TLMServiceOwnerRevShareParams_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TLMServiceOwnerRevShareParams
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_TLMServiceOwnerRevShareParams) Descriptor() protoreflect.MessageDescriptor {
+	return md_TLMServiceOwnerRevShareParams
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_TLMServiceOwnerRevShareParams) Type() protoreflect.MessageType {
+	return _fastReflection_TLMServiceOwnerRevShareParams_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_TLMServiceOwnerRevShareParams) New() protoreflect.Message {
+	return new(fastReflection_TLMServiceOwnerRevShareParams)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_TLMServiceOwnerRevShareParams) Interface() protoreflect.ProtoMessage {
+	return (*TLMServiceOwnerRevShareParams)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_TLMServiceOwnerRevShareParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.MaxRevSharePercentage != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxRevSharePercentage)
+		if !f(fd_TLMServiceOwnerRevShareParams_max_rev_share_percentage, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_TLMServiceOwnerRevShareParams) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "pocket.tokenomics.TLMServiceOwnerRevShareParams.max_rev_share_percentage":
+		return x.MaxRevSharePercentage != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.tokenomics.TLMServiceOwnerRevShareParams"))
+		}
+		panic(fmt.Errorf("message pocket.tokenomics.TLMServiceOwnerRevShareParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TLMServiceOwnerRevShareParams) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "pocket.tokenomics.TLMServiceOwnerRevShareParams.max_rev_share_percentage":
+		x.MaxRevSharePercentage = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.tokenomics.TLMServiceOwnerRevShareParams"))
+		}
+		panic(fmt.Errorf("message pocket.tokenomics.TLMServiceOwnerRevShareParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_TLMServiceOwnerRevShareParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "pocket.tokenomics.TLMServiceOwnerRevShareParams.max_rev_share_percentage":
+		value := x.MaxRevSharePercentage
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.tokenomics.TLMServiceOwnerRevShareParams"))
+		}
+		panic(fmt.Errorf("message pocket.tokenomics.TLMServiceOwnerRevShareParams does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TLMServiceOwnerRevShareParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "pocket.tokenomics.TLMServiceOwnerRevShareParams.max_rev_share_percentage":
+		x.MaxRevSharePercentage = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.tokenomics.TLMServiceOwnerRevShareParams"))
+		}
+		panic(fmt.Errorf("message pocket.tokenomics.TLMServiceOwnerRevShareParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TLMServiceOwnerRevShareParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "pocket.tokenomics.TLMServiceOwnerRevShareParams.max_rev_share_percentage":
+		panic(fmt.Errorf("field max_rev_share_percentage of message pocket.tokenomics.TLMServiceOwnerRevShareParams is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.tokenomics.TLMServiceOwnerRevShareParams"))
+		}
+		panic(fmt.Errorf("message pocket.tokenomics.TLMServiceOwnerRevShareParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_TLMServiceOwnerRevShareParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "pocket.tokenomics.TLMServiceOwnerRevShareParams.max_rev_share_percentage":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.tokenomics.TLMServiceOwnerRevShareParams"))
+		}
+		panic(fmt.Errorf("message pocket.tokenomics.TLMServiceOwnerRevShareParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_TLMServiceOwnerRevShareParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in pocket.tokenomics.TLMServiceOwnerRevShareParams", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_TLMServiceOwnerRevShareParams) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TLMServiceOwnerRevShareParams) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_TLMServiceOwnerRevShareParams) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_TLMServiceOwnerRevShareParams) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*TLMServiceOwnerRevShareParams)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.MaxRevSharePercentage != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxRevSharePercentage))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*TLMServiceOwnerRevShareParams)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.MaxRevSharePercentage != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxRevSharePercentage))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*TLMServiceOwnerRevShareParams)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TLMServiceOwnerRevShareParams: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TLMServiceOwnerRevShareParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxRevSharePercentage", wireType)
+				}
+				x.MaxRevSharePercentage = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxRevSharePercentage |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_TokenLogicModuleConfigs_1_list)(nil)
+
+type _TokenLogicModuleConfigs_1_list struct {
+	list *[]*TokenLogicModuleConfig
+}
+
+func (x *_TokenLogicModuleConfigs_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TokenLogicModuleConfigs_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_TokenLogicModuleConfigs_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TokenLogicModuleConfig)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TokenLogicModuleConfigs_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TokenLogicModuleConfig)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TokenLogicModuleConfigs_1_list) AppendMutable() protoreflect.Value {
+	v := new(TokenLogicModuleConfig)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_TokenLogicModuleConfigs_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TokenLogicModuleConfigs_1_list) NewElement() protoreflect.Value {
+	v := new(TokenLogicModuleConfig)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_TokenLogicModuleConfigs_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_TokenLogicModuleConfigs                     protoreflect.MessageDescriptor
+	fd_TokenLogicModuleConfigs_token_logic_modules protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_pocket_tokenomics_params_proto_init()
+	md_TokenLogicModuleConfigs = File_pocket_tokenomics_params_proto.Messages().ByName("TokenLogicModuleConfigs")
+	fd_TokenLogicModuleConfigs_token_logic_modules = md_TokenLogicModuleConfigs.Fields().ByName("token_logic_modules")
+}
+
+var _ protoreflect.Message = (*fastReflection_TokenLogicModuleConfigs)(nil)
+
+type fastReflection_TokenLogicModuleConfigs TokenLogicModuleConfigs
+
+func (x *TokenLogicModuleConfigs) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TokenLogicModuleConfigs)(x)
+}
+
+func (x *TokenLogicModuleConfigs) slowProtoReflect() protoreflect.Message {
+	mi := &file_pocket_tokenomics_params_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_TokenLogicModuleConfigs_messageType fastReflection_TokenLogicModuleConfigs_messageType
+var _ protoreflect.MessageType = fastReflection_TokenLogicModuleConfigs_messageType{}
+
+type fastReflection_TokenLogicModuleConfigs_messageType struct{}
+
+func (x fastReflection_TokenLogicModuleConfigs_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TokenLogicModuleConfigs)(nil)
+}
+func (x fastReflection_TokenLogicModuleConfigs_messageType) New() protoreflect.Message {
+	return new(fastReflection_TokenLogicModuleConfigs)
+}
+func (x fastReflection_TokenLogicModuleConfigs_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TokenLogicModuleConfigs
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_TokenLogicModuleConfigs) Descriptor() protoreflect.MessageDescriptor {
+	return md_TokenLogicModuleConfigs
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_TokenLogicModuleConfigs) Type() protoreflect.MessageType {
+	return _fastReflection_TokenLogicModuleConfigs_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_TokenLogicModuleConfigs) New() protoreflect.Message {
+	return new(fastReflection_TokenLogicModuleConfigs)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_TokenLogicModuleConfigs) Interface() protoreflect.ProtoMessage {
+	return (*TokenLogicModuleConfigs)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_TokenLogicModuleConfigs) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.TokenLogicModules) != 0 {
+		value := protoreflect.ValueOfList(&_TokenLogicModuleConfigs_1_list{list: &x.TokenLogicModules})
+		if !f(fd_TokenLogicModuleConfigs_token_logic_modules, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_TokenLogicModuleConfigs) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "pocket.tokenomics.TokenLogicModuleConfigs.token_logic_modules":
+		return len(x.TokenLogicModules) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.tokenomics.TokenLogicModuleConfigs"))
+		}
+		panic(fmt.Errorf("message pocket.tokenomics.TokenLogicModuleConfigs does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TokenLogicModuleConfigs) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "pocket.tokenomics.TokenLogicModuleConfigs.token_logic_modules":
+		x.TokenLogicModules = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.tokenomics.TokenLogicModuleConfigs"))
+		}
+		panic(fmt.Errorf("message pocket.tokenomics.TokenLogicModuleConfigs does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_TokenLogicModuleConfigs) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "pocket.tokenomics.TokenLogicModuleConfigs.token_logic_modules":
+		if len(x.TokenLogicModules) == 0 {
+			return protoreflect.ValueOfList(&_TokenLogicModuleConfigs_1_list{})
+		}
+		listValue := &_TokenLogicModuleConfigs_1_list{list: &x.TokenLogicModules}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.tokenomics.TokenLogicModuleConfigs"))
+		}
+		panic(fmt.Errorf("message pocket.tokenomics.TokenLogicModuleConfigs does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TokenLogicModuleConfigs) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "pocket.tokenomics.TokenLogicModuleConfigs.token_logic_modules":
+		lv := value.List()
+		clv := lv.(*_TokenLogicModuleConfigs_1_list)
+		x.TokenLogicModules = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.tokenomics.TokenLogicModuleConfigs"))
+		}
+		panic(fmt.Errorf("message pocket.tokenomics.TokenLogicModuleConfigs does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TokenLogicModuleConfigs) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "pocket.tokenomics.TokenLogicModuleConfigs.token_logic_modules":
+		if x.TokenLogicModules == nil {
+			x.TokenLogicModules = []*TokenLogicModuleConfig{}
+		}
+		value := &_TokenLogicModuleConfigs_1_list{list: &x.TokenLogicModules}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.tokenomics.TokenLogicModuleConfigs"))
+		}
+		panic(fmt.Errorf("message pocket.tokenomics.TokenLogicModuleConfigs does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_TokenLogicModuleConfigs) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "pocket.tokenomics.TokenLogicModuleConfigs.token_logic_modules":
+		list := []*TokenLogicModuleConfig{}
+		return protoreflect.ValueOfList(&_TokenLogicModuleConfigs_1_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.tokenomics.TokenLogicModuleConfigs"))
+		}
+		panic(fmt.Errorf("message pocket.tokenomics.TokenLogicModuleConfigs does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_TokenLogicModuleConfigs) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in pocket.tokenomics.TokenLogicModuleConfigs", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_TokenLogicModuleConfigs) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TokenLogicModuleConfigs) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_TokenLogicModuleConfigs) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_TokenLogicModuleConfigs) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*TokenLogicModuleConfigs)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.TokenLogicModules) > 0 {
+			for _, e := range x.TokenLogicModules {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*TokenLogicModuleConfigs)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.TokenLogicModules) > 0 {
+			for iNdEx := len(x.TokenLogicModules) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.TokenLogicModules[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*TokenLogicModuleConfigs)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TokenLogicModuleConfigs: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TokenLogicModuleConfigs: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TokenLogicModules", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TokenLogicModules = append(x.TokenLogicModules, &TokenLogicModuleConfig{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TokenLogicModules[len(x.TokenLogicModules)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: pocket/tokenomics/params.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// TokenLogicModuleId identifies a token logic module (TLM) implementation.
+type TokenLogicModuleId int32
+
+const (
+	// TLM_UNSPECIFIED is the default value for TokenLogicModuleId, it is not a valid TLM.
+	TokenLogicModuleId_TLM_UNSPECIFIED TokenLogicModuleId = 0
+	// TLM_RELAY_BURN_EQUALS_MINT burns the application's stake based on the amount of
+	// work done by the supplier, and mints the same amount to the supplier's shareholders.
+	TokenLogicModuleId_TLM_RELAY_BURN_EQUALS_MINT TokenLogicModuleId = 1
+	// TLM_GLOBAL_MINT mints new tokens, in proportion to the work done, which are
+	// distributed according to the mint_allocation_percentages.
+	TokenLogicModuleId_TLM_GLOBAL_MINT TokenLogicModuleId = 2
+	// TLM_GLOBAL_MINT_REIMBURSEMENT_REQUEST complements TLM_GLOBAL_MINT by overcharging
+	// the application by the global inflation amount, which is sent to the DAO to be
+	// reimbursed offchain, in order to prevent self-dealing attacks.
+	TokenLogicModuleId_TLM_GLOBAL_MINT_REIMBURSEMENT_REQUEST TokenLogicModuleId = 3
+	// TLM_SERVICE_OWNER_REV_SHARE routes a percentage of each settled claim's amount,
+	// configured per service and bounded by governance, from the supplier's rewards
+	// to the service owner.
+	TokenLogicModuleId_TLM_SERVICE_OWNER_REV_SHARE TokenLogicModuleId = 4
+)
+
+// Enum value maps for TokenLogicModuleId.
+var (
+	TokenLogicModuleId_name = map[int32]string{
+		0: "TLM_UNSPECIFIED",
+		1: "TLM_RELAY_BURN_EQUALS_MINT",
+		2: "TLM_GLOBAL_MINT",
+		3: "TLM_GLOBAL_MINT_REIMBURSEMENT_REQUEST",
+		4: "TLM_SERVICE_OWNER_REV_SHARE",
+	}
+	TokenLogicModuleId_value = map[string]int32{
+		"TLM_UNSPECIFIED":                       0,
+		"TLM_RELAY_BURN_EQUALS_MINT":            1,
+		"TLM_GLOBAL_MINT":                       2,
+		"TLM_GLOBAL_MINT_REIMBURSEMENT_REQUEST": 3,
+		"TLM_SERVICE_OWNER_REV_SHARE":           4,
+	}
+)
+
+func (x TokenLogicModuleId) Enum() *TokenLogicModuleId {
+	p := new(TokenLogicModuleId)
+	*p = x
+	return p
+}
+
+func (x TokenLogicModuleId) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TokenLogicModuleId) Descriptor() protoreflect.EnumDescriptor {
+	return file_pocket_tokenomics_params_proto_enumTypes[0].Descriptor()
+}
+
+func (TokenLogicModuleId) Type() protoreflect.EnumType {
+	return &file_pocket_tokenomics_params_proto_enumTypes[0]
+}
+
+func (x TokenLogicModuleId) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TokenLogicModuleId.Descriptor instead.
+func (TokenLogicModuleId) EnumDescriptor() ([]byte, []int) {
+	return file_pocket_tokenomics_params_proto_rawDescGZIP(), []int{0}
+}
+
+// Params defines the parameters for the tokenomics module.
+type Params struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// mint_allocation_percentages represents the distribution of newly minted tokens,
+	// at the end of claim settlement, as a result of the Global Mint TLM.
+	MintAllocationPercentages *MintAllocationPercentages `protobuf:"bytes,1,opt,name=mint_allocation_percentages,json=mintAllocationPercentages,proto3" json:"mint_allocation_percentages,omitempty"`
+	// dao_reward_address is the address to which mint_allocation_dao percentage of the
+	// minted tokens are at the end of claim settlement.
+	DaoRewardAddress string `protobuf:"bytes,6,opt,name=dao_reward_address,json=daoRewardAddress,proto3" json:"dao_reward_address,omitempty"` // Bech32 cosmos address
+	// global_inflation_per_claim is the percentage of a claim's claimable uPOKT amount which will be minted on settlement.
+	GlobalInflationPerClaim float64 `protobuf:"fixed64,7,opt,name=global_inflation_per_claim,json=globalInflationPerClaim,proto3" json:"global_inflation_per_claim,omitempty"`
+	// token_logic_modules is the ordered list of the token logic modules (TLMs), along
+	// with their parameters, which are run to settle each claim. The TLMs are run in
+	// the given order, and a TLM which is not listed is not run.
+	TokenLogicModules []*TokenLogicModuleConfig `protobuf:"bytes,8,rep,name=token_logic_modules,json=tokenLogicModules,proto3" json:"token_logic_modules,omitempty"`
+}
+
+func (x *Params) Reset() {
+	*x = Params{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pocket_tokenomics_params_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Params) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Params) ProtoMessage() {}
+
+// Deprecated: Use Params.ProtoReflect.Descriptor instead.
+func (*Params) Descriptor() ([]byte, []int) {
+	return file_pocket_tokenomics_params_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Params) GetMintAllocationPercentages() *MintAllocationPercentages {
+	if x != nil {
+		return x.MintAllocationPercentages
+	}
+	return nil
+}
+
+func (x *Params) GetDaoRewardAddress() string {
+	if x != nil {
+		return x.DaoRewardAddress
+	}
+	return ""
+}
+
+func (x *Params) GetGlobalInflationPerClaim() float64 {
+	if x != nil {
+		return x.GlobalInflationPerClaim
+	}
+	return 0
+}
+
+func (x *Params) GetTokenLogicModules() []*TokenLogicModuleConfig {
+	if x != nil {
+		return x.TokenLogicModules
+	}
+	return nil
+}
+
+// MintAllocationPercentages represents the distribution of newly minted tokens,
+// at the end of claim settlement, as a result of the Global Mint TLM.
+type MintAllocationPercentages struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// dao is the percentage of the minted tokens which are sent
+	// to the DAO reward address during claim settlement.
+	Dao float64 `protobuf:"fixed64,1,opt,name=dao,proto3" json:"dao,omitempty"`
+	// proposer is the percentage of the minted tokens which are sent
+	// to the block proposer account address during claim settlement.
+	Proposer float64 `protobuf:"fixed64,2,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	// supplier is the percentage of the minted tokens which are sent
+	// to the block supplier account address during claim settlement.
+	Supplier float64 `protobuf:"fixed64,3,opt,name=supplier,proto3" json:"supplier,omitempty"`
+	// source_owner is the percentage of the minted tokens which are sent
+	// to the service source owner account address during claim settlement.
+	SourceOwner float64 `protobuf:"fixed64,4,opt,name=source_owner,json=sourceOwner,proto3" json:"source_owner,omitempty"`
+	// allocation_application is the percentage of the minted tokens which are sent
+	// to the application account address during claim settlement.
+	Application float64 `protobuf:"fixed64,5,opt,name=application,proto3" json:"application,omitempty"`
+}
+
+func (x *MintAllocationPercentages) Reset() {
+	*x = MintAllocationPercentages{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pocket_tokenomics_params_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MintAllocationPercentages) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MintAllocationPercentages) ProtoMessage() {}
+
+// Deprecated: Use MintAllocationPercentages.ProtoReflect.Descriptor instead.
+func (*MintAllocationPercentages) Descriptor() ([]byte, []int) {
+	return file_pocket_tokenomics_params_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *MintAllocationPercentages) GetDao() float64 {
+	if x != nil {
+		return x.Dao
+	}
+	return 0
+}
+
+func (x *MintAllocationPercentages) GetProposer() float64 {
+	if x != nil {
+		return x.Proposer
+	}
+	return 0
+}
+
+func (x *MintAllocationPercentages) GetSupplier() float64 {
+	if x != nil {
+		return x.Supplier
+	}
+	return 0
+}
+
+func (x *MintAllocationPercentages) GetSourceOwner() float64 {
+	if x != nil {
+		return x.SourceOwner
+	}
+	return 0
+}
+
+func (x *MintAllocationPercentages) GetApplication() float64 {
+	if x != nil {
+		return x.Application
+	}
+	return 0
+}
+
+// TokenLogicModuleConfig enables a token logic module (TLM) in the settlement
+// pipeline, along with its parameters.
+type TokenLogicModuleConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id is the identifier of the enabled TLM.
+	Id TokenLogicModuleId `protobuf:"varint,1,opt,name=id,proto3,enum=pocket.tokenomics.TokenLogicModuleId" json:"id,omitempty"`
+	// params are the parameters of the TLM. They MUST be of the type matching the TLM id.
+	// They may be omitted for the TLMs which have no parameters.
+	//
+	// Types that are assignable to Params:
+	//	*TokenLogicModuleConfig_RelayBurnEqualsMint
+	//	*TokenLogicModuleConfig_GlobalMint
+	//	*TokenLogicModuleConfig_GlobalMintReimbursementRequest
+	//	*TokenLogicModuleConfig_ServiceOwnerRevShare
+	Params isTokenLogicModuleConfig_Params `protobuf_oneof:"params"`
+}
+
+func (x *TokenLogicModuleConfig) Reset() {
+	*x = TokenLogicModuleConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pocket_tokenomics_params_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TokenLogicModuleConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenLogicModuleConfig) ProtoMessage() {}
+
+// Deprecated: Use TokenLogicModuleConfig.ProtoReflect.Descriptor instead.
+func (*TokenLogicModuleConfig) Descriptor() ([]byte, []int) {
+	return file_pocket_tokenomics_params_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TokenLogicModuleConfig) GetId() TokenLogicModuleId {
+	if x != nil {
+		return x.Id
+	}
+	return TokenLogicModuleId_TLM_UNSPECIFIED
+}
+
+func (x *TokenLogicModuleConfig) GetParams() isTokenLogicModuleConfig_Params {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+func (x *TokenLogicModuleConfig) GetRelayBurnEqualsMint() *TLMRelayBurnEqualsMintParams {
+	if x, ok := x.GetParams().(*TokenLogicModuleConfig_RelayBurnEqualsMint); ok {
+		return x.RelayBurnEqualsMint
+	}
+	return nil
+}
+
+func (x *TokenLogicModuleConfig) GetGlobalMint() *TLMGlobalMintParams {
+	if x, ok := x.GetParams().(*TokenLogicModuleConfig_GlobalMint); ok {
+		return x.GlobalMint
+	}
+	return nil
+}
+
+func (x *TokenLogicModuleConfig) GetGlobalMintReimbursementRequest() *TLMGlobalMintReimbursementRequestParams {
+	if x, ok := x.GetParams().(*TokenLogicModuleConfig_GlobalMintReimbursementRequest); ok {
+		return x.GlobalMintReimbursementRequest
+	}
+	return nil
+}
+
+func (x *TokenLogicModuleConfig) GetServiceOwnerRevShare() *TLMServiceOwnerRevShareParams {
+	if x, ok := x.GetParams().(*TokenLogicModuleConfig_ServiceOwnerRevShare); ok {
+		return x.ServiceOwnerRevShare
+	}
+	return nil
+}
+
+type isTokenLogicModuleConfig_Params interface {
+	isTokenLogicModuleConfig_Params()
+}
+
+type TokenLogicModuleConfig_RelayBurnEqualsMint struct {
+	RelayBurnEqualsMint *TLMRelayBurnEqualsMintParams `protobuf:"bytes,2,opt,name=relay_burn_equals_mint,json=relayBurnEqualsMint,proto3,oneof"`
+}
+
+type TokenLogicModuleConfig_GlobalMint struct {
+	GlobalMint *TLMGlobalMintParams `protobuf:"bytes,3,opt,name=global_mint,json=globalMint,proto3,oneof"`
+}
+
+type TokenLogicModuleConfig_GlobalMintReimbursementRequest struct {
+	GlobalMintReimbursementRequest *TLMGlobalMintReimbursementRequestParams `protobuf:"bytes,4,opt,name=global_mint_reimbursement_request,json=globalMintReimbursementRequest,proto3,oneof"`
+}
+
+type TokenLogicModuleConfig_ServiceOwnerRevShare struct {
+	ServiceOwnerRevShare *TLMServiceOwnerRevShareParams `protobuf:"bytes,5,opt,name=service_owner_rev_share,json=serviceOwnerRevShare,proto3,oneof"`
+}
+
+func (*TokenLogicModuleConfig_RelayBurnEqualsMint) isTokenLogicModuleConfig_Params() {}
+
+func (*TokenLogicModuleConfig_GlobalMint) isTokenLogicModuleConfig_Params() {}
+
+func (*TokenLogicModuleConfig_GlobalMintReimbursementRequest) isTokenLogicModuleConfig_Params() {}
+
+func (*TokenLogicModuleConfig_ServiceOwnerRevShare) isTokenLogicModuleConfig_Params() {}
+
+// TLMRelayBurnEqualsMintParams are the parameters of the TLM_RELAY_BURN_EQUALS_MINT TLM.
+// It has no parameters yet.
+type TLMRelayBurnEqualsMintParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *TLMRelayBurnEqualsMintParams) Reset() {
+	*x = TLMRelayBurnEqualsMintParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pocket_tokenomics_params_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TLMRelayBurnEqualsMintParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TLMRelayBurnEqualsMintParams) ProtoMessage() {}
+
+// Deprecated: Use TLMRelayBurnEqualsMintParams.ProtoReflect.Descriptor instead.
+func (*TLMRelayBurnEqualsMintParams) Descriptor() ([]byte, []int) {
+	return file_pocket_tokenomics_params_proto_rawDescGZIP(), []int{3}
+}
+
+// TLMGlobalMintParams are the parameters of the TLM_GLOBAL_MINT TLM.
+// It has no parameters yet, the global inflation and its allocation are
+// configured by the global_inflation_per_claim and mint_allocation_percentages params.
+type TLMGlobalMintParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *TLMGlobalMintParams) Reset() {
+	*x = TLMGlobalMintParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pocket_tokenomics_params_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TLMGlobalMintParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TLMGlobalMintParams) ProtoMessage() {}
+
+// Deprecated: Use TLMGlobalMintParams.ProtoReflect.Descriptor instead.
+func (*TLMGlobalMintParams) Descriptor() ([]byte, []int) {
+	return file_pocket_tokenomics_params_proto_rawDescGZIP(), []int{4}
+}
+
+// TLMGlobalMintReimbursementRequestParams are the parameters of the
+// TLM_GLOBAL_MINT_REIMBURSEMENT_REQUEST TLM. It has no parameters yet.
+type TLMGlobalMintReimbursementRequestParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *TLMGlobalMintReimbursementRequestParams) Reset() {
+	*x = TLMGlobalMintReimbursementRequestParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pocket_tokenomics_params_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TLMGlobalMintReimbursementRequestParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TLMGlobalMintReimbursementRequestParams) ProtoMessage() {}
+
+// Deprecated: Use TLMGlobalMintReimbursementRequestParams.ProtoReflect.Descriptor instead.
+func (*TLMGlobalMintReimbursementRequestParams) Descriptor() ([]byte, []int) {
+	return file_pocket_tokenomics_params_proto_rawDescGZIP(), []int{5}
+}
+
+// TLMServiceOwnerRevShareParams are the parameters of the TLM_SERVICE_OWNER_REV_SHARE TLM.
+type TLMServiceOwnerRevShareParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// max_rev_share_percentage is the upper bound of the percentage of each settled
+	// claim's amount which is routed to the service owner. The service owner configured
+	// percentage (i.e. Service.owner_rev_share_percentage) is capped to this value.
+	MaxRevSharePercentage uint64 `protobuf:"varint,1,opt,name=max_rev_share_percentage,json=maxRevSharePercentage,proto3" json:"max_rev_share_percentage,omitempty"`
+}
+
+func (x *TLMServiceOwnerRevShareParams) Reset() {
+	*x = TLMServiceOwnerRevShareParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pocket_tokenomics_params_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TLMServiceOwnerRevShareParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TLMServiceOwnerRevShareParams) ProtoMessage() {}
+
+// Deprecated: Use TLMServiceOwnerRevShareParams.ProtoReflect.Descriptor instead.
+func (*TLMServiceOwnerRevShareParams) Descriptor() ([]byte, []int) {
+	return file_pocket_tokenomics_params_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *TLMServiceOwnerRevShareParams) GetMaxRevSharePercentage() uint64 {
+	if x != nil {
+		return x.MaxRevSharePercentage
 	}
 	return 0
 }
 
+// TokenLogicModuleConfigs is the ordered list of the enabled token logic modules.
+// It is used to update the token_logic_modules param via MsgUpdateParam.
+type TokenLogicModuleConfigs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TokenLogicModules []*TokenLogicModuleConfig `protobuf:"bytes,1,rep,name=token_logic_modules,json=tokenLogicModules,proto3" json:"token_logic_modules,omitempty"`
+}
+
+func (x *TokenLogicModuleConfigs) Reset() {
+	*x = TokenLogicModuleConfigs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pocket_tokenomics_params_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TokenLogicModuleConfigs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenLogicModuleConfigs) ProtoMessage() {}
+
+// Deprecated: Use TokenLogicModuleConfigs.ProtoReflect.Descriptor instead.
+func (*TokenLogicModuleConfigs) Descriptor() ([]byte, []int) {
+	return file_pocket_tokenomics_params_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *TokenLogicModuleConfigs) GetTokenLogicModules() []*TokenLogicModuleConfig {
+	if x != nil {
+		return x.TokenLogicModules
+	}
+	return nil
+}
+
 var File_pocket_tokenomics_params_proto protoreflect.FileDescriptor
 
 var file_pocket_tokenomics_params_proto_rawDesc = []byte{
@@ -1277,7 +4564,7 @@ var file_pocket_tokenomics_params_proto_rawDesc = []byte{
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f,
 	0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe2, 0x03, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf9, 0x04, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61,
 	0x6d, 0x73, 0x12, 0xb4, 0x01, 0x0a, 0x1b, 0x6d, 0x69, 0x6e, 0x74, 0x5f, 0x61, 0x6c, 0x6c, 0x6f,
 	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67,
 	0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x70, 0x6f, 0x63, 0x6b, 0x65,
@@ -1305,43 +4592,130 @@ var file_pocket_tokenomics_params_proto_rawDesc = []byte{
 	0x6c, 0x3a, 0x22, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x5f, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74,
 	0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x22, 0x52, 0x17,
 	0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50,
-	0x65, 0x72, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x3a, 0x23, 0xe8, 0xa0, 0x1f, 0x01, 0x8a, 0xe7, 0xb0,
-	0x2a, 0x1a, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2f, 0x78, 0x2f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
-	0x6f, 0x6d, 0x69, 0x63, 0x73, 0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0xd3, 0x02, 0x0a,
-	0x19, 0x4d, 0x69, 0x6e, 0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50,
-	0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x03, 0x64, 0x61,
-	0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x42, 0x15, 0xea, 0xde, 0x1f, 0x03, 0x64, 0x61, 0x6f,
-	0xf2, 0xde, 0x1f, 0x0a, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x64, 0x61, 0x6f, 0x22, 0x52, 0x03,
-	0x64, 0x61, 0x6f, 0x12, 0x3b, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x01, 0x42, 0x1f, 0xea, 0xde, 0x1f, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x6f,
-	0x73, 0x65, 0x72, 0xf2, 0xde, 0x1f, 0x0f, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x70, 0x72, 0x6f,
-	0x70, 0x6f, 0x73, 0x65, 0x72, 0x22, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72,
-	0x12, 0x3b, 0x0a, 0x08, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x01, 0x42, 0x1f, 0xea, 0xde, 0x1f, 0x08, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72,
-	0xf2, 0xde, 0x1f, 0x0f, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x69,
-	0x65, 0x72, 0x22, 0x52, 0x08, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x12, 0x4a, 0x0a,
-	0x0c, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x01, 0x42, 0x27, 0xea, 0xde, 0x1f, 0x0c, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f,
-	0x6f, 0x77, 0x6e, 0x65, 0x72, 0xf2, 0xde, 0x1f, 0x13, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x22, 0x52, 0x0b, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x47, 0x0a, 0x0b, 0x61, 0x70, 0x70,
-	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x42, 0x25,
-	0xea, 0xde, 0x1f, 0x0b, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0xf2,
-	0xde, 0x1f, 0x12, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x52, 0x0b, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x42, 0xb5, 0x01, 0xa8, 0xe2, 0x1e, 0x01, 0xd8, 0xe2, 0x1e, 0x01, 0x0a, 0x15, 0x63,
-	0x6f, 0x6d, 0x2e, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x6f,
-	0x6d, 0x69, 0x63, 0x73, 0x42, 0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x50, 0x72, 0x6f, 0x74,
-	0x6f, 0x50, 0x01, 0x5a, 0x22, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
-	0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2f, 0x74, 0x6f, 0x6b,
-	0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73, 0xa2, 0x02, 0x03, 0x50, 0x54, 0x58, 0xaa, 0x02, 0x11,
-	0x50, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x63,
-	0x73, 0xca, 0x02, 0x11, 0x50, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x5c, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x6f, 0x6d, 0x69, 0x63, 0x73, 0xe2, 0x02, 0x1d, 0x50, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x5c, 0x54,
-	0x6f, 0x6b, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x12, 0x50, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x3a, 0x3a,
-	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x65, 0x72, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x12, 0x94, 0x01, 0x0a, 0x13, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x5f, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x5f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x18,
+	0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4c,
+	0x6f, 0x67, 0x69, 0x63, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x42, 0x39, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x13, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f,
+	0x6c, 0x6f, 0x67, 0x69, 0x63, 0x5f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0xf2, 0xde, 0x1f,
+	0x1a, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x6c, 0x6f, 0x67,
+	0x69, 0x63, 0x5f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x22, 0x52, 0x11, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x4c, 0x6f, 0x67, 0x69, 0x63, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x3a, 0x23,
+	0xe8, 0xa0, 0x1f, 0x01, 0x8a, 0xe7, 0xb0, 0x2a, 0x1a, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2f,
+	0x78, 0x2f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73, 0x2f, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x22, 0xd3, 0x02, 0x0a, 0x19, 0x4d, 0x69, 0x6e, 0x74, 0x41, 0x6c, 0x6c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65,
+	0x73, 0x12, 0x27, 0x0a, 0x03, 0x64, 0x61, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x42, 0x15,
+	0xea, 0xde, 0x1f, 0x03, 0x64, 0x61, 0x6f, 0xf2, 0xde, 0x1f, 0x0a, 0x79, 0x61, 0x6d, 0x6c, 0x3a,
+	0x22, 0x64, 0x61, 0x6f, 0x22, 0x52, 0x03, 0x64, 0x61, 0x6f, 0x12, 0x3b, 0x0a, 0x08, 0x70, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x42, 0x1f, 0xea, 0xde,
+	0x1f, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0xf2, 0xde, 0x1f, 0x0f, 0x79, 0x61,
+	0x6d, 0x6c, 0x3a, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x22, 0x52, 0x08, 0x70,
+	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x12, 0x3b, 0x0a, 0x08, 0x73, 0x75, 0x70, 0x70, 0x6c,
+	0x69, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x42, 0x1f, 0xea, 0xde, 0x1f, 0x08, 0x73,
+	0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0xf2, 0xde, 0x1f, 0x0f, 0x79, 0x61, 0x6d, 0x6c, 0x3a,
+	0x22, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x22, 0x52, 0x08, 0x73, 0x75, 0x70, 0x70,
+	0x6c, 0x69, 0x65, 0x72, 0x12, 0x4a, 0x0a, 0x0c, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x6f,
+	0x77, 0x6e, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x42, 0x27, 0xea, 0xde, 0x1f, 0x0c,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0xf2, 0xde, 0x1f, 0x13,
+	0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x6f, 0x77, 0x6e,
+	0x65, 0x72, 0x22, 0x52, 0x0b, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4f, 0x77, 0x6e, 0x65, 0x72,
+	0x12, 0x47, 0x0a, 0x0b, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x01, 0x42, 0x25, 0xea, 0xde, 0x1f, 0x0b, 0x61, 0x70, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0xf2, 0xde, 0x1f, 0x12, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22,
+	0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x52, 0x0b, 0x61, 0x70,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x8e, 0x06, 0x0a, 0x16, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x4c, 0x6f, 0x67, 0x69, 0x63, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x12, 0x4a, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x25, 0x2e, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x6f,
+	0x6d, 0x69, 0x63, 0x73, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4c, 0x6f, 0x67, 0x69, 0x63, 0x4d,
+	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x42, 0x13, 0xea, 0xde, 0x1f, 0x02, 0x69, 0x64, 0xf2,
+	0xde, 0x1f, 0x09, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x69, 0x64, 0x22, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0xa3, 0x01, 0x0a, 0x16, 0x72, 0x65, 0x6c, 0x61, 0x79, 0x5f, 0x62, 0x75, 0x72, 0x6e, 0x5f,
+	0x65, 0x71, 0x75, 0x61, 0x6c, 0x73, 0x5f, 0x6d, 0x69, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2f, 0x2e, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x6f, 0x6d, 0x69, 0x63, 0x73, 0x2e, 0x54, 0x4c, 0x4d, 0x52, 0x65, 0x6c, 0x61, 0x79, 0x42, 0x75,
+	0x72, 0x6e, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x73, 0x4d, 0x69, 0x6e, 0x74, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x42, 0x3b, 0xea, 0xde, 0x1f, 0x16, 0x72, 0x65, 0x6c, 0x61, 0x79, 0x5f, 0x62, 0x75,
+	0x72, 0x6e, 0x5f, 0x65, 0x71, 0x75, 0x61, 0x6c, 0x73, 0x5f, 0x6d, 0x69, 0x6e, 0x74, 0xf2, 0xde,
+	0x1f, 0x1d, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x72, 0x65, 0x6c, 0x61, 0x79, 0x5f, 0x62, 0x75,
+	0x72, 0x6e, 0x5f, 0x65, 0x71, 0x75, 0x61, 0x6c, 0x73, 0x5f, 0x6d, 0x69, 0x6e, 0x74, 0x22, 0x48,
+	0x00, 0x52, 0x13, 0x72, 0x65, 0x6c, 0x61, 0x79, 0x42, 0x75, 0x72, 0x6e, 0x45, 0x71, 0x75, 0x61,
+	0x6c, 0x73, 0x4d, 0x69, 0x6e, 0x74, 0x12, 0x70, 0x0a, 0x0b, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c,
+	0x5f, 0x6d, 0x69, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x70, 0x6f,
+	0x63, 0x6b, 0x65, 0x74, 0x2e, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73, 0x2e,
+	0x54, 0x4c, 0x4d, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x4d, 0x69, 0x6e, 0x74, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x42, 0x25, 0xea, 0xde, 0x1f, 0x0b, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x5f,
+	0x6d, 0x69, 0x6e, 0x74, 0xf2, 0xde, 0x1f, 0x12, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x67, 0x6c,
+	0x6f, 0x62, 0x61, 0x6c, 0x5f, 0x6d, 0x69, 0x6e, 0x74, 0x22, 0x48, 0x00, 0x52, 0x0a, 0x67, 0x6c,
+	0x6f, 0x62, 0x61, 0x6c, 0x4d, 0x69, 0x6e, 0x74, 0x12, 0xda, 0x01, 0x0a, 0x21, 0x67, 0x6c, 0x6f,
+	0x62, 0x61, 0x6c, 0x5f, 0x6d, 0x69, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x69, 0x6d, 0x62, 0x75, 0x72,
+	0x73, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73, 0x2e, 0x54, 0x4c, 0x4d, 0x47, 0x6c, 0x6f, 0x62,
+	0x61, 0x6c, 0x4d, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x69, 0x6d, 0x62, 0x75, 0x72, 0x73, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x42, 0x51, 0xea, 0xde, 0x1f, 0x21, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x5f, 0x6d, 0x69, 0x6e,
+	0x74, 0x5f, 0x72, 0x65, 0x69, 0x6d, 0x62, 0x75, 0x72, 0x73, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0xf2, 0xde, 0x1f, 0x28, 0x79, 0x61, 0x6d, 0x6c, 0x3a,
+	0x22, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x5f, 0x6d, 0x69, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x69,
+	0x6d, 0x62, 0x75, 0x72, 0x73, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0x48, 0x00, 0x52, 0x1e, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x4d, 0x69, 0x6e,
+	0x74, 0x52, 0x65, 0x69, 0x6d, 0x62, 0x75, 0x72, 0x73, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0xa8, 0x01, 0x0a, 0x17, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x76, 0x5f, 0x73, 0x68, 0x61, 0x72,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74,
+	0x2e, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73, 0x2e, 0x54, 0x4c, 0x4d, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x76, 0x53, 0x68,
+	0x61, 0x72, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x3d, 0xea, 0xde, 0x1f, 0x17, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x76,
+	0x5f, 0x73, 0x68, 0x61, 0x72, 0x65, 0xf2, 0xde, 0x1f, 0x1e, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x72, 0x65,
+	0x76, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x22, 0x48, 0x00, 0x52, 0x14, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x76, 0x53, 0x68, 0x61, 0x72, 0x65,
+	0x42, 0x08, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x1e, 0x0a, 0x1c, 0x54, 0x4c,
+	0x4d, 0x52, 0x65, 0x6c, 0x61, 0x79, 0x42, 0x75, 0x72, 0x6e, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x73,
+	0x4d, 0x69, 0x6e, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x15, 0x0a, 0x13, 0x54, 0x4c,
+	0x4d, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x4d, 0x69, 0x6e, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x22, 0x29, 0x0a, 0x27, 0x54, 0x4c, 0x4d, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x4d, 0x69,
+	0x6e, 0x74, 0x52, 0x65, 0x69, 0x6d, 0x62, 0x75, 0x72, 0x73, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x99, 0x01, 0x0a,
+	0x1d, 0x54, 0x4c, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4f, 0x77, 0x6e, 0x65, 0x72,
+	0x52, 0x65, 0x76, 0x53, 0x68, 0x61, 0x72, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x78,
+	0x0a, 0x18, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x76, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x5f,
+	0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x42, 0x3f, 0xea, 0xde, 0x1f, 0x18, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x76, 0x5f, 0x73, 0x68,
+	0x61, 0x72, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0xf2, 0xde,
+	0x1f, 0x1f, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x76, 0x5f,
+	0x73, 0x68, 0x61, 0x72, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65,
+	0x22, 0x52, 0x15, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x76, 0x53, 0x68, 0x61, 0x72, 0x65, 0x50, 0x65,
+	0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x22, 0xb0, 0x01, 0x0a, 0x17, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x4c, 0x6f, 0x67, 0x69, 0x63, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x73, 0x12, 0x94, 0x01, 0x0a, 0x13, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x6c,
+	0x6f, 0x67, 0x69, 0x63, 0x5f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x29, 0x2e, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4c, 0x6f, 0x67, 0x69,
+	0x63, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x39, 0xc8,
+	0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x13, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x6c, 0x6f, 0x67,
+	0x69, 0x63, 0x5f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0xf2, 0xde, 0x1f, 0x1a, 0x79, 0x61,
+	0x6d, 0x6c, 0x3a, 0x22, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x5f,
+	0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x22, 0x52, 0x11, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x4c,
+	0x6f, 0x67, 0x69, 0x63, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x2a, 0xaa, 0x01, 0x0a, 0x12,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4c, 0x6f, 0x67, 0x69, 0x63, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
+	0x49, 0x64, 0x12, 0x13, 0x0a, 0x0f, 0x54, 0x4c, 0x4d, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x54, 0x4c, 0x4d, 0x5f, 0x52,
+	0x45, 0x4c, 0x41, 0x59, 0x5f, 0x42, 0x55, 0x52, 0x4e, 0x5f, 0x45, 0x51, 0x55, 0x41, 0x4c, 0x53,
+	0x5f, 0x4d, 0x49, 0x4e, 0x54, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x54, 0x4c, 0x4d, 0x5f, 0x47,
+	0x4c, 0x4f, 0x42, 0x41, 0x4c, 0x5f, 0x4d, 0x49, 0x4e, 0x54, 0x10, 0x02, 0x12, 0x29, 0x0a, 0x25,
+	0x54, 0x4c, 0x4d, 0x5f, 0x47, 0x4c, 0x4f, 0x42, 0x41, 0x4c, 0x5f, 0x4d, 0x49, 0x4e, 0x54, 0x5f,
+	0x52, 0x45, 0x49, 0x4d, 0x42, 0x55, 0x52, 0x53, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45,
+	0x51, 0x55, 0x45, 0x53, 0x54, 0x10, 0x03, 0x12, 0x1f, 0x0a, 0x1b, 0x54, 0x4c, 0x4d, 0x5f, 0x53,
+	0x45, 0x52, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x4f, 0x57, 0x4e, 0x45, 0x52, 0x5f, 0x52, 0x45, 0x56,
+	0x5f, 0x53, 0x48, 0x41, 0x52, 0x45, 0x10, 0x04, 0x42, 0x2c, 0xa8, 0xe2, 0x1e, 0x01, 0xd8, 0xe2,
+	0x1e, 0x01, 0x5a, 0x22, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1356,18 +4730,33 @@ func file_pocket_tokenomics_params_proto_rawDescGZIP() []byte {
 	return file_pocket_tokenomics_params_proto_rawDescData
 }
 
-var file_pocket_tokenomics_params_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_pocket_tokenomics_params_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_pocket_tokenomics_params_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_pocket_tokenomics_params_proto_goTypes = []interface{}{
-	(*Params)(nil),                    // 0: pocket.tokenomics.Params
-	(*MintAllocationPercentages)(nil), // 1: pocket.tokenomics.MintAllocationPercentages
+	(TokenLogicModuleId)(0),                         // 0: pocket.tokenomics.TokenLogicModuleId
+	(*Params)(nil),                                  // 1: pocket.tokenomics.Params
+	(*MintAllocationPercentages)(nil),               // 2: pocket.tokenomics.MintAllocationPercentages
+	(*TokenLogicModuleConfig)(nil),                  // 3: pocket.tokenomics.TokenLogicModuleConfig
+	(*TLMRelayBurnEqualsMintParams)(nil),            // 4: pocket.tokenomics.TLMRelayBurnEqualsMintParams
+	(*TLMGlobalMintParams)(nil),                     // 5: pocket.tokenomics.TLMGlobalMintParams
+	(*TLMGlobalMintReimbursementRequestParams)(nil), // 6: pocket.tokenomics.TLMGlobalMintReimbursementRequestParams
+	(*TLMServiceOwnerRevShareParams)(nil),           // 7: pocket.tokenomics.TLMServiceOwnerRevShareParams
+	(*TokenLogicModuleConfigs)(nil),                 // 8: pocket.tokenomics.TokenLogicModuleConfigs
 }
 var file_pocket_tokenomics_params_proto_depIdxs = []int32{
-	1, // 0: pocket.tokenomics.Params.mint_allocation_percentages:type_name -> pocket.tokenomics.MintAllocationPercentages
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	2, // 0: pocket.tokenomics.Params.mint_allocation_percentages:type_name -> pocket.tokenomics.MintAllocationPercentages
+	3, // 1: pocket.tokenomics.Params.token_logic_modules:type_name -> pocket.tokenomics.TokenLogicModuleConfig
+	0, // 2: pocket.tokenomics.TokenLogicModuleConfig.id:type_name -> pocket.tokenomics.TokenLogicModuleId
+	4, // 3: pocket.tokenomics.TokenLogicModuleConfig.relay_burn_equals_mint:type_name -> pocket.tokenomics.TLMRelayBurnEqualsMintParams
+	5, // 4: pocket.tokenomics.TokenLogicModuleConfig.global_mint:type_name -> pocket.tokenomics.TLMGlobalMintParams
+	6, // 5: pocket.tokenomics.TokenLogicModuleConfig.global_mint_reimbursement_request:type_name -> pocket.tokenomics.TLMGlobalMintReimbursementRequestParams
+	7, // 6: pocket.tokenomics.TokenLogicModuleConfig.service_owner_rev_share:type_name -> pocket.tokenomics.TLMServiceOwnerRevShareParams
+	3, // 7: pocket.tokenomics.TokenLogicModuleConfigs.token_logic_modules:type_name -> pocket.tokenomics.TokenLogicModuleConfig
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_pocket_tokenomics_params_proto_init() }
@@ -1400,19 +4789,98 @@ func file_pocket_tokenomics_params_proto_init() {
 				return nil
 			}
 		}
+		file_pocket_tokenomics_params_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TokenLogicModuleConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pocket_tokenomics_params_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TLMRelayBurnEqualsMintParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pocket_tokenomics_params_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TLMGlobalMintParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pocket_tokenomics_params_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TLMGlobalMintReimbursementRequestParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pocket_tokenomics_params_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TLMServiceOwnerRevShareParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pocket_tokenomics_params_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TokenLogicModuleConfigs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_pocket_tokenomics_params_proto_msgTypes[2].OneofWrappers = []interface{}{
+		(*TokenLogicModuleConfig_RelayBurnEqualsMint)(nil),
+		(*TokenLogicModuleConfig_GlobalMint)(nil),
+		(*TokenLogicModuleConfig_GlobalMintReimbursementRequest)(nil),
+		(*TokenLogicModuleConfig_ServiceOwnerRevShare)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_pocket_tokenomics_params_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   2,
+			NumEnums:      1,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_pocket_tokenomics_params_proto_goTypes,
 		DependencyIndexes: file_pocket_tokenomics_params_proto_depIdxs,
+		EnumInfos:         file_pocket_tokenomics_params_proto_enumTypes,
 		MessageInfos:      file_pocket_tokenomics_params_proto_msgTypes,
 	}.Build()
 	File_pocket_tokenomics_params_proto = out.File
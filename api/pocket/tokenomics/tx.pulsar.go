@@ -959,6 +959,7 @@ var (
 	fd_MsgUpdateParam_as_mint_allocation_percentages protoreflect.FieldDescriptor
 	fd_MsgUpdateParam_as_string                      protoreflect.FieldDescriptor
 	fd_MsgUpdateParam_as_float                       protoreflect.FieldDescriptor
+	fd_MsgUpdateParam_as_token_logic_modules         protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -969,6 +970,7 @@ func init() {
 	fd_MsgUpdateParam_as_mint_allocation_percentages = md_MsgUpdateParam.Fields().ByName("as_mint_allocation_percentages")
 	fd_MsgUpdateParam_as_string = md_MsgUpdateParam.Fields().ByName("as_string")
 	fd_MsgUpdateParam_as_float = md_MsgUpdateParam.Fields().ByName("as_float")
+	fd_MsgUpdateParam_as_token_logic_modules = md_MsgUpdateParam.Fields().ByName("as_token_logic_modules")
 }
 
 var _ protoreflect.Message = (*fastReflection_MsgUpdateParam)(nil)
@@ -1068,6 +1070,12 @@ func (x *fastReflection_MsgUpdateParam) Range(f func(protoreflect.FieldDescripto
 			if !f(fd_MsgUpdateParam_as_float, value) {
 				return
 			}
+		case *MsgUpdateParam_AsTokenLogicModules:
+			v := o.AsTokenLogicModules
+			value := protoreflect.ValueOfMessage(v.ProtoReflect())
+			if !f(fd_MsgUpdateParam_as_token_logic_modules, value) {
+				return
+			}
 		}
 	}
 }
@@ -1113,6 +1121,14 @@ func (x *fastReflection_MsgUpdateParam) Has(fd protoreflect.FieldDescriptor) boo
 		} else {
 			return false
 		}
+	case "pocket.tokenomics.MsgUpdateParam.as_token_logic_modules":
+		if x.AsType == nil {
+			return false
+		} else if _, ok := x.AsType.(*MsgUpdateParam_AsTokenLogicModules); ok {
+			return true
+		} else {
+			return false
+		}
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.tokenomics.MsgUpdateParam"))
@@ -1139,6 +1155,8 @@ func (x *fastReflection_MsgUpdateParam) Clear(fd protoreflect.FieldDescriptor) {
 		x.AsType = nil
 	case "pocket.tokenomics.MsgUpdateParam.as_float":
 		x.AsType = nil
+	case "pocket.tokenomics.MsgUpdateParam.as_token_logic_modules":
+		x.AsType = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.tokenomics.MsgUpdateParam"))
@@ -1185,6 +1203,14 @@ func (x *fastReflection_MsgUpdateParam) Get(descriptor protoreflect.FieldDescrip
 		} else {
 			return protoreflect.ValueOfFloat64(float64(0))
 		}
+	case "pocket.tokenomics.MsgUpdateParam.as_token_logic_modules":
+		if x.AsType == nil {
+			return protoreflect.ValueOfMessage((*TokenLogicModuleConfigs)(nil).ProtoReflect())
+		} else if v, ok := x.AsType.(*MsgUpdateParam_AsTokenLogicModules); ok {
+			return protoreflect.ValueOfMessage(v.AsTokenLogicModules.ProtoReflect())
+		} else {
+			return protoreflect.ValueOfMessage((*TokenLogicModuleConfigs)(nil).ProtoReflect())
+		}
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.tokenomics.MsgUpdateParam"))
@@ -1218,6 +1244,9 @@ func (x *fastReflection_MsgUpdateParam) Set(fd protoreflect.FieldDescriptor, val
 	case "pocket.tokenomics.MsgUpdateParam.as_float":
 		cv := value.Float()
 		x.AsType = &MsgUpdateParam_AsFloat{AsFloat: cv}
+	case "pocket.tokenomics.MsgUpdateParam.as_token_logic_modules":
+		cv := value.Message().Interface().(*TokenLogicModuleConfigs)
+		x.AsType = &MsgUpdateParam_AsTokenLogicModules{AsTokenLogicModules: cv}
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.tokenomics.MsgUpdateParam"))
@@ -1254,6 +1283,22 @@ func (x *fastReflection_MsgUpdateParam) Mutable(fd protoreflect.FieldDescriptor)
 			x.AsType = oneofValue
 			return protoreflect.ValueOfMessage(value.ProtoReflect())
 		}
+	case "pocket.tokenomics.MsgUpdateParam.as_token_logic_modules":
+		if x.AsType == nil {
+			value := &TokenLogicModuleConfigs{}
+			oneofValue := &MsgUpdateParam_AsTokenLogicModules{AsTokenLogicModules: value}
+			x.AsType = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+		switch m := x.AsType.(type) {
+		case *MsgUpdateParam_AsTokenLogicModules:
+			return protoreflect.ValueOfMessage(m.AsTokenLogicModules.ProtoReflect())
+		default:
+			value := &TokenLogicModuleConfigs{}
+			oneofValue := &MsgUpdateParam_AsTokenLogicModules{AsTokenLogicModules: value}
+			x.AsType = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
 	case "pocket.tokenomics.MsgUpdateParam.authority":
 		panic(fmt.Errorf("field authority of message pocket.tokenomics.MsgUpdateParam is not mutable"))
 	case "pocket.tokenomics.MsgUpdateParam.name":
@@ -1286,6 +1331,9 @@ func (x *fastReflection_MsgUpdateParam) NewField(fd protoreflect.FieldDescriptor
 		return protoreflect.ValueOfString("")
 	case "pocket.tokenomics.MsgUpdateParam.as_float":
 		return protoreflect.ValueOfFloat64(float64(0))
+	case "pocket.tokenomics.MsgUpdateParam.as_token_logic_modules":
+		value := &TokenLogicModuleConfigs{}
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: pocket.tokenomics.MsgUpdateParam"))
@@ -1310,6 +1358,8 @@ func (x *fastReflection_MsgUpdateParam) WhichOneof(d protoreflect.OneofDescripto
 			return x.Descriptor().Fields().ByName("as_string")
 		case *MsgUpdateParam_AsFloat:
 			return x.Descriptor().Fields().ByName("as_float")
+		case *MsgUpdateParam_AsTokenLogicModules:
+			return x.Descriptor().Fields().ByName("as_token_logic_modules")
 		}
 	default:
 		panic(fmt.Errorf("%s is not a oneof field in pocket.tokenomics.MsgUpdateParam", d.FullName()))
@@ -1393,6 +1443,12 @@ func (x *fastReflection_MsgUpdateParam) ProtoMethods() *protoiface.Methods {
 				break
 			}
 			n += 9
+		case *MsgUpdateParam_AsTokenLogicModules:
+			if x == nil {
+				break
+			}
+			l = options.Size(x.AsTokenLogicModules)
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -1448,6 +1504,19 @@ func (x *fastReflection_MsgUpdateParam) ProtoMethods() *protoiface.Methods {
 			binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(x.AsFloat))))
 			i--
 			dAtA[i] = 0x29
+		case *MsgUpdateParam_AsTokenLogicModules:
+			encoded, err := options.Marshal(x.AsTokenLogicModules)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x32
 		}
 		if len(x.Name) > 0 {
 			i -= len(x.Name)
@@ -1654,6 +1723,41 @@ func (x *fastReflection_MsgUpdateParam) ProtoMethods() *protoiface.Methods {
 				v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
 				iNdEx += 8
 				x.AsType = &MsgUpdateParam_AsFloat{float64(math.Float64frombits(v))}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AsTokenLogicModules", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				v := &TokenLogicModuleConfigs{}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.AsType = &MsgUpdateParam_AsTokenLogicModules{v}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2233,10 +2337,10 @@ type MsgUpdateParam struct {
 	// specified in the `Params` message in `proof/params.proto.`
 	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	// Types that are assignable to AsType:
-	//
 	//	*MsgUpdateParam_AsMintAllocationPercentages
 	//	*MsgUpdateParam_AsString
 	//	*MsgUpdateParam_AsFloat
+	//	*MsgUpdateParam_AsTokenLogicModules
 	AsType isMsgUpdateParam_AsType `protobuf_oneof:"as_type"`
 }
 
@@ -2302,6 +2406,13 @@ func (x *MsgUpdateParam) GetAsFloat() float64 {
 	return 0
 }
 
+func (x *MsgUpdateParam) GetAsTokenLogicModules() *TokenLogicModuleConfigs {
+	if x, ok := x.GetAsType().(*MsgUpdateParam_AsTokenLogicModules); ok {
+		return x.AsTokenLogicModules
+	}
+	return nil
+}
+
 type isMsgUpdateParam_AsType interface {
 	isMsgUpdateParam_AsType()
 }
@@ -2318,12 +2429,18 @@ type MsgUpdateParam_AsFloat struct {
 	AsFloat float64 `protobuf:"fixed64,5,opt,name=as_float,json=asFloat,proto3,oneof"`
 }
 
+type MsgUpdateParam_AsTokenLogicModules struct {
+	AsTokenLogicModules *TokenLogicModuleConfigs `protobuf:"bytes,6,opt,name=as_token_logic_modules,json=asTokenLogicModules,proto3,oneof"`
+}
+
 func (*MsgUpdateParam_AsMintAllocationPercentages) isMsgUpdateParam_AsType() {}
 
 func (*MsgUpdateParam_AsString) isMsgUpdateParam_AsType() {}
 
 func (*MsgUpdateParam_AsFloat) isMsgUpdateParam_AsType() {}
 
+func (*MsgUpdateParam_AsTokenLogicModules) isMsgUpdateParam_AsType() {}
+
 // MsgUpdateParamResponse defines the response structure for executing a
 // MsgUpdateParam message after a single param update.
 type MsgUpdateParamResponse struct {
@@ -2391,7 +2508,7 @@ var file_pocket_tokenomics_tx_proto_rawDesc = []byte{
 	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x70, 0x61, 0x72,
 	0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x6f, 0x63, 0x6b,
 	0x65, 0x74, 0x2e, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73, 0x2e, 0x50, 0x61,
-	0x72, 0x61, 0x6d, 0x73, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x93, 0x03, 0x0a,
+	0x72, 0x61, 0x6d, 0x73, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0xb4, 0x04, 0x0a,
 	0x0e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x12,
 	0x36, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41,
@@ -2415,38 +2532,39 @@ var file_pocket_tokenomics_tx_proto_rawDesc = []byte{
 	0x48, 0x00, 0x52, 0x08, 0x61, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x29, 0x0a, 0x08,
 	0x61, 0x73, 0x5f, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x42, 0x0c,
 	0xea, 0xde, 0x1f, 0x08, 0x61, 0x73, 0x5f, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x48, 0x00, 0x52, 0x07,
-	0x61, 0x73, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x3a, 0x0e, 0x82, 0xe7, 0xb0, 0x2a, 0x09, 0x61, 0x75,
-	0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x42, 0x09, 0x0a, 0x07, 0x61, 0x73, 0x5f, 0x74, 0x79,
-	0x70, 0x65, 0x22, 0x4b, 0x0a, 0x16, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50,
-	0x61, 0x72, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x06,
-	0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70,
+	0x61, 0x73, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x12, 0x9e, 0x01, 0x0a, 0x16, 0x61, 0x73, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x5f, 0x6d, 0x6f, 0x64, 0x75, 0x6c,
+	0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x70, 0x6f, 0x63, 0x6b, 0x65,
+	0x74, 0x2e, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73, 0x2e, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x4c, 0x6f, 0x67, 0x69, 0x63, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x73, 0x42, 0x3b, 0xea, 0xde, 0x1f, 0x16, 0x61, 0x73, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x5f, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x5f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73,
+	0xf2, 0xde, 0x1f, 0x1d, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x61, 0x73, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x5f, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x5f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73,
+	0x22, 0x48, 0x00, 0x52, 0x13, 0x61, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4c, 0x6f, 0x67, 0x69,
+	0x63, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x3a, 0x0e, 0x82, 0xe7, 0xb0, 0x2a, 0x09, 0x61,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x42, 0x09, 0x0a, 0x07, 0x61, 0x73, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x22, 0x4b, 0x0a, 0x16, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a,
+	0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x63,
+	0x73, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x32, 0xc9, 0x01, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x5e, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x22, 0x2e, 0x70, 0x6f, 0x63, 0x6b, 0x65,
+	0x74, 0x2e, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73, 0x2e, 0x4d, 0x73, 0x67,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x2a, 0x2e, 0x70,
 	0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73,
-	0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x32,
-	0xc9, 0x01, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x5e, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x22, 0x2e, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74,
+	0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5b, 0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x21, 0x2e, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74,
 	0x2e, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x2a, 0x2e, 0x70, 0x6f,
-	0x63, 0x6b, 0x65, 0x74, 0x2e, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73, 0x2e,
-	0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5b, 0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x21, 0x2e, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e,
-	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x1a, 0x29, 0x2e, 0x70, 0x6f, 0x63, 0x6b,
-	0x65, 0x74, 0x2e, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73, 0x2e, 0x4d, 0x73,
-	0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x1a, 0x05, 0x80, 0xe7, 0xb0, 0x2a, 0x01, 0x42, 0xad, 0x01, 0xd8, 0xe2,
-	0x1e, 0x01, 0x0a, 0x15, 0x63, 0x6f, 0x6d, 0x2e, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x74,
-	0x6f, 0x6b, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73, 0x42, 0x07, 0x54, 0x78, 0x50, 0x72, 0x6f,
-	0x74, 0x6f, 0x50, 0x01, 0x5a, 0x22, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
-	0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2f, 0x74, 0x6f,
-	0x6b, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73, 0xa2, 0x02, 0x03, 0x50, 0x54, 0x58, 0xaa, 0x02,
-	0x11, 0x50, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x6f, 0x6d, 0x69,
-	0x63, 0x73, 0xca, 0x02, 0x11, 0x50, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x5c, 0x54, 0x6f, 0x6b, 0x65,
-	0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73, 0xe2, 0x02, 0x1d, 0x50, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x5c,
-	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x12, 0x50, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x3a,
-	0x3a, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x1a, 0x29, 0x2e, 0x70, 0x6f, 0x63,
+	0x6b, 0x65, 0x74, 0x2e, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73, 0x2e, 0x4d,
+	0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x1a, 0x05, 0x80, 0xe7, 0xb0, 0x2a, 0x01, 0x42, 0x28, 0xd8, 0xe2,
+	0x1e, 0x01, 0x5a, 0x22, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2469,21 +2587,23 @@ var file_pocket_tokenomics_tx_proto_goTypes = []interface{}{
 	(*MsgUpdateParamResponse)(nil),    // 3: pocket.tokenomics.MsgUpdateParamResponse
 	(*Params)(nil),                    // 4: pocket.tokenomics.Params
 	(*MintAllocationPercentages)(nil), // 5: pocket.tokenomics.MintAllocationPercentages
+	(*TokenLogicModuleConfigs)(nil),   // 6: pocket.tokenomics.TokenLogicModuleConfigs
 }
 var file_pocket_tokenomics_tx_proto_depIdxs = []int32{
 	4, // 0: pocket.tokenomics.MsgUpdateParams.params:type_name -> pocket.tokenomics.Params
 	4, // 1: pocket.tokenomics.MsgUpdateParamsResponse.params:type_name -> pocket.tokenomics.Params
 	5, // 2: pocket.tokenomics.MsgUpdateParam.as_mint_allocation_percentages:type_name -> pocket.tokenomics.MintAllocationPercentages
-	4, // 3: pocket.tokenomics.MsgUpdateParamResponse.params:type_name -> pocket.tokenomics.Params
-	0, // 4: pocket.tokenomics.Msg.UpdateParams:input_type -> pocket.tokenomics.MsgUpdateParams
-	2, // 5: pocket.tokenomics.Msg.UpdateParam:input_type -> pocket.tokenomics.MsgUpdateParam
-	1, // 6: pocket.tokenomics.Msg.UpdateParams:output_type -> pocket.tokenomics.MsgUpdateParamsResponse
-	3, // 7: pocket.tokenomics.Msg.UpdateParam:output_type -> pocket.tokenomics.MsgUpdateParamResponse
-	6, // [6:8] is the sub-list for method output_type
-	4, // [4:6] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	6, // 3: pocket.tokenomics.MsgUpdateParam.as_token_logic_modules:type_name -> pocket.tokenomics.TokenLogicModuleConfigs
+	4, // 4: pocket.tokenomics.MsgUpdateParamResponse.params:type_name -> pocket.tokenomics.Params
+	0, // 5: pocket.tokenomics.Msg.UpdateParams:input_type -> pocket.tokenomics.MsgUpdateParams
+	2, // 6: pocket.tokenomics.Msg.UpdateParam:input_type -> pocket.tokenomics.MsgUpdateParam
+	1, // 7: pocket.tokenomics.Msg.UpdateParams:output_type -> pocket.tokenomics.MsgUpdateParamsResponse
+	3, // 8: pocket.tokenomics.Msg.UpdateParam:output_type -> pocket.tokenomics.MsgUpdateParamResponse
+	7, // [7:9] is the sub-list for method output_type
+	5, // [5:7] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_pocket_tokenomics_tx_proto_init() }
@@ -2546,6 +2666,7 @@ func file_pocket_tokenomics_tx_proto_init() {
 		(*MsgUpdateParam_AsMintAllocationPercentages)(nil),
 		(*MsgUpdateParam_AsString)(nil),
 		(*MsgUpdateParam_AsFloat)(nil),
+		(*MsgUpdateParam_AsTokenLogicModules)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -2812,6 +2812,11 @@ const (
 	// Module accounting
 	SettlementOpReason_TLM_GLOBAL_MINT_SUPPLIER_SHAREHOLDER_REWARD_MODULE_TRANSFER  SettlementOpReason = 13
 	SettlementOpReason_TLM_GLOBAL_MINT_REIMBURSEMENT_REQUEST_ESCROW_MODULE_TRANSFER SettlementOpReason = 14
+	// Service owner revenue share
+	SettlementOpReason_TLM_SERVICE_OWNER_REV_SHARE_REWARD_DISTRIBUTION SettlementOpReason = 15
+	// Supplier delegators reward distribution
+	SettlementOpReason_TLM_RELAY_BURN_EQUALS_MINT_DELEGATOR_REWARD_DISTRIBUTION SettlementOpReason = 16
+	SettlementOpReason_TLM_GLOBAL_MINT_DELEGATOR_REWARD_DISTRIBUTION            SettlementOpReason = 17
 )
 
 // Enum value maps for SettlementOpReason.
@@ -2832,6 +2837,9 @@ var (
 		12: "UNSPECIFIED_TLM_SUPPLIER_SLASH_STAKE_BURN",
 		13: "TLM_GLOBAL_MINT_SUPPLIER_SHAREHOLDER_REWARD_MODULE_TRANSFER",
 		14: "TLM_GLOBAL_MINT_REIMBURSEMENT_REQUEST_ESCROW_MODULE_TRANSFER",
+		15: "TLM_SERVICE_OWNER_REV_SHARE_REWARD_DISTRIBUTION",
+		16: "TLM_RELAY_BURN_EQUALS_MINT_DELEGATOR_REWARD_DISTRIBUTION",
+		17: "TLM_GLOBAL_MINT_DELEGATOR_REWARD_DISTRIBUTION",
 	}
 	SettlementOpReason_value = map[string]int32{
 		"UNSPECIFIED": 0,
@@ -2849,6 +2857,9 @@ var (
 		"UNSPECIFIED_TLM_SUPPLIER_SLASH_STAKE_BURN":                           12,
 		"TLM_GLOBAL_MINT_SUPPLIER_SHAREHOLDER_REWARD_MODULE_TRANSFER":         13,
 		"TLM_GLOBAL_MINT_REIMBURSEMENT_REQUEST_ESCROW_MODULE_TRANSFER":        14,
+		"TLM_SERVICE_OWNER_REV_SHARE_REWARD_DISTRIBUTION":                     15,
+		"TLM_RELAY_BURN_EQUALS_MINT_DELEGATOR_REWARD_DISTRIBUTION":            16,
+		"TLM_GLOBAL_MINT_DELEGATOR_REWARD_DISTRIBUTION":                       17,
 	}
 )
 
@@ -3211,8 +3222,8 @@ var file_pocket_tokenomics_types_proto_rawDesc = []byte{
 	0x65, 0x12, 0x3b, 0x0a, 0x04, 0x63, 0x6f, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
 	0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31,
 	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x0c, 0xc8, 0xde, 0x1f, 0x00,
-	0xea, 0xde, 0x1f, 0x04, 0x63, 0x6f, 0x69, 0x6e, 0x52, 0x04, 0x63, 0x6f, 0x69, 0x6e, 0x2a, 0xa5,
-	0x06, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x70, 0x52,
+	0xea, 0xde, 0x1f, 0x04, 0x63, 0x6f, 0x69, 0x6e, 0x52, 0x04, 0x63, 0x6f, 0x69, 0x6e, 0x2a, 0xcb,
+	0x07, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x70, 0x52,
 	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
 	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x32, 0x0a, 0x2e, 0x54, 0x4c, 0x4d, 0x5f, 0x52, 0x45,
 	0x4c, 0x41, 0x59, 0x5f, 0x42, 0x55, 0x52, 0x4e, 0x5f, 0x45, 0x51, 0x55, 0x41, 0x4c, 0x53, 0x5f,
@@ -3262,19 +3273,20 @@ var file_pocket_tokenomics_types_proto_rawDesc = []byte{
 	0x41, 0x4c, 0x5f, 0x4d, 0x49, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x49, 0x4d, 0x42, 0x55, 0x52, 0x53,
 	0x45, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x45, 0x53,
 	0x43, 0x52, 0x4f, 0x57, 0x5f, 0x4d, 0x4f, 0x44, 0x55, 0x4c, 0x45, 0x5f, 0x54, 0x52, 0x41, 0x4e,
-	0x53, 0x46, 0x45, 0x52, 0x10, 0x0e, 0x42, 0xb0, 0x01, 0xd8, 0xe2, 0x1e, 0x01, 0x0a, 0x15, 0x63,
-	0x6f, 0x6d, 0x2e, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x6f,
-	0x6d, 0x69, 0x63, 0x73, 0x42, 0x0a, 0x54, 0x79, 0x70, 0x65, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f,
-	0x50, 0x01, 0x5a, 0x22, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
+	0x53, 0x46, 0x45, 0x52, 0x10, 0x0e, 0x12, 0x33, 0x0a, 0x2f, 0x54, 0x4c, 0x4d, 0x5f, 0x53, 0x45,
+	0x52, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x4f, 0x57, 0x4e, 0x45, 0x52, 0x5f, 0x52, 0x45, 0x56, 0x5f,
+	0x53, 0x48, 0x41, 0x52, 0x45, 0x5f, 0x52, 0x45, 0x57, 0x41, 0x52, 0x44, 0x5f, 0x44, 0x49, 0x53,
+	0x54, 0x52, 0x49, 0x42, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x0f, 0x12, 0x3c, 0x0a, 0x38, 0x54,
+	0x4c, 0x4d, 0x5f, 0x52, 0x45, 0x4c, 0x41, 0x59, 0x5f, 0x42, 0x55, 0x52, 0x4e, 0x5f, 0x45, 0x51,
+	0x55, 0x41, 0x4c, 0x53, 0x5f, 0x4d, 0x49, 0x4e, 0x54, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x47, 0x41,
+	0x54, 0x4f, 0x52, 0x5f, 0x52, 0x45, 0x57, 0x41, 0x52, 0x44, 0x5f, 0x44, 0x49, 0x53, 0x54, 0x52,
+	0x49, 0x42, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x10, 0x12, 0x31, 0x0a, 0x2d, 0x54, 0x4c, 0x4d,
+	0x5f, 0x47, 0x4c, 0x4f, 0x42, 0x41, 0x4c, 0x5f, 0x4d, 0x49, 0x4e, 0x54, 0x5f, 0x44, 0x45, 0x4c,
+	0x45, 0x47, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x52, 0x45, 0x57, 0x41, 0x52, 0x44, 0x5f, 0x44, 0x49,
+	0x53, 0x54, 0x52, 0x49, 0x42, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x11, 0x42, 0x28, 0xd8, 0xe2,
+	0x1e, 0x01, 0x5a, 0x22, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
 	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2f, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73, 0xa2, 0x02, 0x03, 0x50, 0x54, 0x58, 0xaa, 0x02, 0x11, 0x50,
-	0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73,
-	0xca, 0x02, 0x11, 0x50, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x5c, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x6f,
-	0x6d, 0x69, 0x63, 0x73, 0xe2, 0x02, 0x1d, 0x50, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x5c, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x12, 0x50, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x3a, 0x3a, 0x54,
-	0x6f, 0x6b, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x6e, 0x6f, 0x6d, 0x69, 0x63, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
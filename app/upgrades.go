@@ -1,9 +1,21 @@
 package app
 
 import (
+	"context"
+
 	upgradetypes "cosmossdk.io/x/upgrade/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
 
 	"github.com/pokt-network/poktroll/app/upgrades"
+	apptypes "github.com/pokt-network/poktroll/x/application/types"
+	gatewaytypes "github.com/pokt-network/poktroll/x/gateway/types"
+	migrationtypes "github.com/pokt-network/poktroll/x/migration/types"
+	prooftypes "github.com/pokt-network/poktroll/x/proof/types"
+	servicetypes "github.com/pokt-network/poktroll/x/service/types"
+	sessiontypes "github.com/pokt-network/poktroll/x/session/types"
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
+	suppliertypes "github.com/pokt-network/poktroll/x/supplier/types"
+	tokenomicstypes "github.com/pokt-network/poktroll/x/tokenomics/types"
 )
 
 // allUpgrades includes all upgrades that have upgrade strategy implemented.
@@ -59,7 +71,24 @@ var allUpgrades = []upgrades.Upgrade{
 	// upgrades.Upgrade_0_1_10,
 
 	// v0.1.11 - upgrade to add allow_morse_account_import_overwrite param.
-	upgrades.Upgrade_0_1_11,
+	// upgrades.Upgrade_0_1_11,
+
+	// v0.1.12 - upgrade to add the token_logic_modules tokenomics param.
+	upgrades.Upgrade_0_1_12,
+}
+
+// pocketModuleNames are the names of the pocket modules, whose params may be
+// updated by the upgrade handlers.
+var pocketModuleNames = []string{
+	apptypes.ModuleName,
+	gatewaytypes.ModuleName,
+	migrationtypes.ModuleName,
+	prooftypes.ModuleName,
+	servicetypes.ModuleName,
+	sessiontypes.ModuleName,
+	sharedtypes.ModuleName,
+	suppliertypes.ModuleName,
+	tokenomicstypes.ModuleName,
 }
 
 // setUpgrades sets upgrade handlers for all upgrades and executes KVStore migration if an upgrade plan file exists.
@@ -69,7 +98,7 @@ func (app *App) setUpgrades() error {
 	for _, upgrade := range allUpgrades {
 		app.Keepers.UpgradeKeeper.SetUpgradeHandler(
 			upgrade.PlanName,
-			upgrade.CreateUpgradeHandler(app.ModuleManager, &app.Keepers, app.Configurator()),
+			withParamsUpdatedEvents(upgrade.CreateUpgradeHandler(app.ModuleManager, &app.Keepers, app.Configurator())),
 		)
 	}
 
@@ -109,3 +138,25 @@ func findPlannedUpgrade(upgradePlanName string, upgrades []upgrades.Upgrade) (*u
 	}
 	return nil, false
 }
+
+// withParamsUpdatedEvents wraps the given upgrade handler such that an EventParamsUpdated
+// event is emitted for each of the pocket modules once it succeeds.
+// Upgrade handlers may update the params of any module without going through its
+// update params messages, this notifies the offchain consumers (e.g. the RelayMiner
+// params caches) that the params may have changed.
+func withParamsUpdatedEvents(upgradeHandler upgradetypes.UpgradeHandler) upgradetypes.UpgradeHandler {
+	return func(ctx context.Context, plan upgradetypes.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		vm, err := upgradeHandler(ctx, plan, vm)
+		if err != nil {
+			return vm, err
+		}
+
+		for _, moduleName := range pocketModuleNames {
+			if err = sharedtypes.EmitParamsUpdatedEvent(ctx, moduleName); err != nil {
+				return vm, err
+			}
+		}
+
+		return vm, nil
+	}
+}
@@ -0,0 +1,155 @@
+package upgrades
+
+import (
+	"context"
+	"slices"
+
+	cosmoslog "cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+	cosmostypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	"github.com/pokt-network/poktroll/app/keepers"
+	servicetypes "github.com/pokt-network/poktroll/x/service/types"
+	suppliertypes "github.com/pokt-network/poktroll/x/supplier/types"
+	tokenomicstypes "github.com/pokt-network/poktroll/x/tokenomics/types"
+)
+
+const (
+	Upgrade_0_1_12_PlanName = "v0.1.12"
+)
+
+// Upgrade_0_1_12 handles the upgrade to release `v0.1.12`.
+// This upgrade adds:
+// - the `token_logic_modules` tokenomics module param
+//   - Set to the default TLMs (i.e. the TLMs which were unconditionally run prior to this upgrade)
+//
+// - per-service compute units to tokens multipliers
+//   - new `MsgSetServiceComputeUnitsToTokensMultiplier` (service owner) message
+//   - new `MsgUpdateServiceComputeUnitsToTokensMultiplier` (governance) message and a corresponding authz grant
+//   - new `max_compute_units_to_tokens_multiplier` service module param set to its default value
+//   - Multiplier updates take effect from the next session
+//
+// - the `TLM_SERVICE_OWNER_REV_SHARE` token logic module
+//   - Disabled by default; governance can enable it via the `token_logic_modules` tokenomics param
+//   - new `MsgSetServiceOwnerRevSharePercentage` (service owner) message
+//
+// - supplier delegations
+//   - new `MsgDelegateToSupplier` and `MsgUndelegateFromSupplier` (delegator) messages
+//   - new `MsgSetSupplierDelegationCommission` (supplier owner) message
+//   - Delegators earn a share of the supplier rewards and are slashed along with the supplier
+//
+// - graduated slashing and jailing of suppliers with repeated proof faults
+//   - new `proof_fault_window_sessions`, `proof_fault_penalty_escalation_percentage`,
+//     `proof_fault_jailing_threshold` and `jail_duration_sessions` supplier module params
+//     set to their default values
+//   - new `MsgUnjailSupplier` (supplier owner or operator) message
+//
+// https://github.com/pokt-network/poktroll/compare/v0.1.11..v0.1.12
+var Upgrade_0_1_12 = Upgrade{
+	PlanName: Upgrade_0_1_12_PlanName,
+	// No migrations in this upgrade.
+	StoreUpgrades: storetypes.StoreUpgrades{},
+
+	// Upgrade Handler
+	CreateUpgradeHandler: func(
+		mm *module.Manager,
+		keepers *keepers.Keepers,
+		configurator module.Configurator,
+	) upgradetypes.UpgradeHandler {
+		// Add new parameters by:
+		// 1. Inspecting the diff between v0.1.11...v0.1.12
+		// 2. Manually inspect changes in ignite's config.yml
+		// 3. Update the upgrade handler here accordingly
+		// Ref: https://github.com/pokt-network/poktroll/compare/v0.1.11...v0.1.12
+		applyNewParameters := func(ctx context.Context, logger cosmoslog.Logger) (err error) {
+			logger.Info("Starting parameter updates", "upgrade_plan_name", Upgrade_0_1_12_PlanName)
+
+			// Get the current tokenomics module params
+			tokenomicsParams := keepers.TokenomicsKeeper.GetParams(ctx)
+
+			// Preserve the settlement behavior prior to this upgrade by enabling
+			// all the default TLMs, in their default order.
+			tokenomicsParams.TokenLogicModules = slices.Clone(tokenomicstypes.DefaultTokenLogicModules)
+
+			// Ensure that the new parameters are valid
+			if err = tokenomicsParams.ValidateBasic(); err != nil {
+				logger.Error("Failed to validate tokenomics params", "error", err)
+				return err
+			}
+
+			// ALL parameters in the tokenomics module must be specified when
+			// setting parameters, even if just one is being CRUDed.
+			err = keepers.TokenomicsKeeper.SetParams(ctx, tokenomicsParams)
+			if err != nil {
+				logger.Error("Failed to set tokenomics params", "error", err)
+				return err
+			}
+			logger.Info("Successfully updated tokenomics params", "new_params", tokenomicsParams)
+
+			// Get the current service module params
+			serviceParams := keepers.ServiceKeeper.GetParams(ctx)
+
+			// Bound the per-service compute units to tokens multipliers service owners can set.
+			serviceParams.MaxComputeUnitsToTokensMultiplier = servicetypes.DefaultMaxComputeUnitsToTokensMultiplier
+
+			// Ensure that the new parameters are valid
+			if err = serviceParams.ValidateBasic(); err != nil {
+				logger.Error("Failed to validate service params", "error", err)
+				return err
+			}
+
+			err = keepers.ServiceKeeper.SetParams(ctx, serviceParams)
+			if err != nil {
+				logger.Error("Failed to set service params", "error", err)
+				return err
+			}
+			logger.Info("Successfully updated service params", "new_params", serviceParams)
+
+			// Get the current supplier module params
+			supplierParams := keepers.SupplierKeeper.GetParams(ctx)
+
+			// Track the suppliers' proof faults, escalate their penalties and jail
+			// repeat offenders using the default thresholds.
+			supplierParams.ProofFaultWindowSessions = suppliertypes.DefaultProofFaultWindowSessions
+			supplierParams.ProofFaultPenaltyEscalationPercentage = suppliertypes.DefaultProofFaultPenaltyEscalationPercentage
+			supplierParams.ProofFaultJailingThreshold = suppliertypes.DefaultProofFaultJailingThreshold
+			supplierParams.JailDurationSessions = suppliertypes.DefaultJailDurationSessions
+
+			// Ensure that the new parameters are valid
+			if err = supplierParams.Validate(); err != nil {
+				logger.Error("Failed to validate supplier params", "error", err)
+				return err
+			}
+
+			// ALL parameters in the supplier module must be specified when
+			// setting parameters, even if just one is being CRUDed.
+			err = keepers.SupplierKeeper.SetParams(ctx, supplierParams)
+			if err != nil {
+				logger.Error("Failed to set supplier params", "error", err)
+				return err
+			}
+			logger.Info("Successfully updated supplier params", "new_params", supplierParams)
+
+			return nil
+		}
+
+		return func(ctx context.Context, plan upgradetypes.Plan, vm module.VersionMap) (module.VersionMap, error) {
+			logger := cosmostypes.UnwrapSDKContext(ctx).Logger()
+
+			// Adds new authz authorizations from the diff:
+			// https://github.com/pokt-network/poktroll/compare/v0.1.11...v0.1.12
+			grantAuthorizationMessages := []string{"/pocket.service.MsgUpdateServiceComputeUnitsToTokensMultiplier"}
+			if err := applyNewAuthorizations(ctx, keepers, logger, grantAuthorizationMessages); err != nil {
+				return vm, err
+			}
+
+			if err := applyNewParameters(ctx, logger); err != nil {
+				return vm, err
+			}
+
+			return vm, nil
+		}
+	},
+}
@@ -35,7 +35,6 @@ import (
 	"github.com/pokt-network/poktroll/pkg/client"
 	"github.com/pokt-network/poktroll/pkg/client/block"
 	"github.com/pokt-network/poktroll/pkg/client/events"
-	"github.com/pokt-network/poktroll/pkg/client/tx"
 	"github.com/pokt-network/poktroll/testutil/testclient"
 	"github.com/pokt-network/poktroll/testutil/yaml"
 	apptypes "github.com/pokt-network/poktroll/x/application/types"
@@ -205,7 +204,7 @@ func (s *suite) Before() {
 		s.ctx,
 		s.deps,
 		"tm.event='Tx'",
-		tx.UnmarshalTxResult,
+		events.UnmarshalTxResult,
 		eventsReplayClientBufferSize,
 	)
 	require.NoError(s, err)
@@ -222,6 +222,8 @@ func (s *suite) newServiceMsgUpdateParams(params paramsAnyMap) cosmostypes.Msg {
 		switch paramName {
 		case servicetypes.ParamAddServiceFee:
 			msgUpdateParams.Params.AddServiceFee = paramValue.value.(*cosmostypes.Coin)
+		case servicetypes.ParamMaxComputeUnitsToTokensMultiplier:
+			msgUpdateParams.Params.MaxComputeUnitsToTokensMultiplier = paramValue.value.(uint64)
 		default:
 			s.Fatalf("ERROR: unexpected %q type param name %q", paramValue.typeStr, paramName)
 		}
@@ -244,6 +246,14 @@ func (s *suite) newSupplierMsgUpdateParams(params map[string]paramAny) cosmostyp
 			msgUpdateParams.Params.MinStake = paramValue.value.(*cosmostypes.Coin)
 		case suppliertypes.ParamStakingFee:
 			msgUpdateParams.Params.StakingFee = paramValue.value.(*cosmostypes.Coin)
+		case suppliertypes.ParamProofFaultWindowSessions:
+			msgUpdateParams.Params.ProofFaultWindowSessions = paramValue.value.(uint64)
+		case suppliertypes.ParamProofFaultPenaltyEscalationPercentage:
+			msgUpdateParams.Params.ProofFaultPenaltyEscalationPercentage = paramValue.value.(uint64)
+		case suppliertypes.ParamProofFaultJailingThreshold:
+			msgUpdateParams.Params.ProofFaultJailingThreshold = paramValue.value.(uint64)
+		case suppliertypes.ParamJailDurationSessions:
+			msgUpdateParams.Params.JailDurationSessions = paramValue.value.(uint64)
 		default:
 			s.Fatalf("ERROR: unexpected %q type param name %q", paramValue.typeStr, paramName)
 		}
@@ -380,6 +390,14 @@ func (s *suite) newServiceMsgUpdateParam(authority string, param paramAny) (msg
 				AsCoin: param.value.(*cosmostypes.Coin),
 			},
 		})
+	case "uint64":
+		msg = proto.Message(&servicetypes.MsgUpdateParam{
+			Authority: authority,
+			Name:      param.name,
+			AsType: &servicetypes.MsgUpdateParam_AsUint64{
+				AsUint64: param.value.(uint64),
+			},
+		})
 	default:
 		s.Fatalf("unexpected param type %q for %s module", param.typeStr, tokenomicstypes.ModuleName)
 	}
@@ -414,6 +432,14 @@ func (s *suite) newSupplierMsgUpdateParam(authority string, param paramAny) (msg
 				AsCoin: param.value.(*cosmostypes.Coin),
 			},
 		})
+	case "uint64":
+		msg = proto.Message(&suppliertypes.MsgUpdateParam{
+			Authority: authority,
+			Name:      param.name,
+			AsType: &suppliertypes.MsgUpdateParam_AsUint64{
+				AsUint64: param.value.(uint64),
+			},
+		})
 	default:
 		s.Fatalf("unexpected param type %q for %s module", param.typeStr, suppliertypes.ModuleName)
 	}
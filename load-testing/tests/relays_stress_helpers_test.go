@@ -1418,7 +1418,7 @@ func (s *relaysSuite) querySharedParams(queryNodeRPCURL string) {
 	s.Helper()
 
 	sharedParamsCache := querycache.NewNoOpParamsCache[sharedtypes.Params]()
-	blockhashCache := querycache.NewNoOpKeyValueCache[query.BlockHash]()
+	blockhashCache := querycache.NewNoOpHistoricalKeyValueCache[query.BlockHash]()
 	deps := depinject.Supply(
 		s.txContext.GetClientCtx(),
 		logger,
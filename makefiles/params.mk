@@ -26,6 +26,10 @@ params_update_tokenomics_dao_reward_address: ## Update the tokenomics module dao
 params_update_tokenomics_global_inflation_per_claim: ## Update the tokenomics module global_inflation_per_claim param
 	pocketd tx authz exec ./tools/scripts/params/tokenomics_global_inflation_per_claim.json $(PARAM_FLAGS)
 
+.PHONY: params_update_tokenomics_token_logic_modules
+params_update_tokenomics_token_logic_modules: ## Update the tokenomics module token_logic_modules param
+	pocketd tx authz exec ./tools/scripts/params/tokenomics_token_logic_modules.json $(PARAM_FLAGS)
+
 ### Service Module Params ###
 .PHONY: params_get_service
 params_get_service: ## Get the service module params
@@ -43,6 +47,10 @@ params_update_service_add_service_fee: ## Update the service module add_service_
 params_update_service_target_num_relays: ## Update the service module target_num_relays param
 	pocketd tx authz exec ./tools/scripts/params/service_target_num_relays.json $(PARAM_FLAGS)
 
+.PHONY: params_update_service_max_compute_units_to_tokens_multiplier
+params_update_service_max_compute_units_to_tokens_multiplier: ## Update the service module max_compute_units_to_tokens_multiplier param
+	pocketd tx authz exec ./tools/scripts/params/service_max_compute_units_to_tokens_multiplier.json $(PARAM_FLAGS)
+
 ### Proof Module Params ###
 .PHONY: params_get_proof
 params_get_proof: ## Get the proof module params
@@ -164,6 +172,22 @@ params_update_supplier_min_stake: ## Update the supplier module min_stake param
 params_update_supplier_staking_fee: ## Update the supplier module staking_fee param
 	pocketd tx authz exec ./tools/scripts/params/supplier_staking_fee.json $(PARAM_FLAGS)
 
+.PHONY: params_update_supplier_proof_fault_window_sessions
+params_update_supplier_proof_fault_window_sessions: ## Update the supplier module proof_fault_window_sessions param
+	pocketd tx authz exec ./tools/scripts/params/supplier_proof_fault_window_sessions.json $(PARAM_FLAGS)
+
+.PHONY: params_update_supplier_proof_fault_penalty_escalation_percentage
+params_update_supplier_proof_fault_penalty_escalation_percentage: ## Update the supplier module proof_fault_penalty_escalation_percentage param
+	pocketd tx authz exec ./tools/scripts/params/supplier_proof_fault_penalty_escalation_percentage.json $(PARAM_FLAGS)
+
+.PHONY: params_update_supplier_proof_fault_jailing_threshold
+params_update_supplier_proof_fault_jailing_threshold: ## Update the supplier module proof_fault_jailing_threshold param
+	pocketd tx authz exec ./tools/scripts/params/supplier_proof_fault_jailing_threshold.json $(PARAM_FLAGS)
+
+.PHONY: params_update_supplier_jail_duration_sessions
+params_update_supplier_jail_duration_sessions: ## Update the supplier module jail_duration_sessions param
+	pocketd tx authz exec ./tools/scripts/params/supplier_jail_duration_sessions.json $(PARAM_FLAGS)
+
 ### Session Module Params ###
 .PHONY: params_get_session
 params_get_session: ## Get the session module params
@@ -46,6 +46,12 @@ func (blockEvent *CometNewBlockEvent) Txs() []types.Tx {
 	return blockEvent.Data.Value.Block.Txs
 }
 
+// FinalizeBlockEvents returns the events emitted while finalizing the block,
+// outside of its transactions (e.g. by the pre, begin and end blockers).
+func (blockEvent *CometNewBlockEvent) FinalizeBlockEvents() []abci.Event {
+	return blockEvent.Data.Value.ResultFinalizeBlock.Events
+}
+
 // UnmarshalNewBlockEvent is a function that attempts to deserialize the given bytes
 // into a comet new block event . If the resulting block has a height of zero,
 // assume the event was not a block event and return an ErrUnmarshalBlockEvent error.
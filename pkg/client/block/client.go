@@ -2,6 +2,7 @@ package block
 
 import (
 	"context"
+	"sync"
 
 	"cosmossdk.io/depinject"
 
@@ -12,11 +13,11 @@ import (
 )
 
 const (
-	// committedBlocksQuery is the query used to subscribe to new committed block
+	// CommittedBlocksQuery is the query used to subscribe to new committed block
 	// events used by the EventsQueryClient to subscribe to new block events from
 	// the chain.
 	// See: https://docs.cosmos.network/v0.47/learn/advanced/events#default-events
-	committedBlocksQuery = "tm.event='NewBlock'"
+	CommittedBlocksQuery = "tm.event='NewBlock'"
 
 	// defaultBlocksReplayLimit is the number of blocks that the replay
 	// observable returned by LastNBlocks() will be able to replay.
@@ -26,7 +27,7 @@ const (
 )
 
 // NewBlockClient creates a new block client from the given dependencies and
-// cometWebsocketURL. It uses a pre-defined committedBlocksQuery to subscribe to
+// cometWebsocketURL. It uses a pre-defined CommittedBlocksQuery to subscribe to
 // newly committed block events which are mapped to Block objects.
 //
 // This lightly wraps the EventsReplayClient[Block] generic to correctly mock
@@ -48,29 +49,34 @@ func NewBlockClient(
 	bClient := &blockReplayClient{
 		latestBlockReplayObs: latestBlockReplayObs,
 		close:                cancel,
+		maxBackfillBlocks:    events.DefaultMaxBackfillBlocks,
 	}
 
 	for _, opt := range opts {
 		opt(bClient)
 	}
 
+	if err := depinject.Inject(deps, &bClient.onStartQueryClient); err != nil {
+		cancel()
+		return nil, err
+	}
+
 	bClient.eventsReplayClient, err = events.NewEventsReplayClient[client.Block](
 		ctx,
 		deps,
-		committedBlocksQuery,
+		CommittedBlocksQuery,
 		UnmarshalNewBlock,
 		defaultBlocksReplayLimit,
 		events.WithConnRetryLimit[client.Block](bClient.connRetryLimit),
+		// Backfill the blocks committed while reconnecting, such that the
+		// committed blocks sequence has no height gap.
+		events.WithBackfill[client.Block](blockEventKey, bClient.backfillBlocks),
 	)
 	if err != nil {
 		cancel()
 		return nil, err
 	}
 
-	if err := depinject.Inject(deps, &bClient.onStartQueryClient); err != nil {
-		return nil, err
-	}
-
 	bClient.asyncForwardBlockEvent(ctx, latestBlockPublishCh)
 
 	if err := bClient.getInitialBlock(ctx, latestBlockPublishCh); err != nil {
@@ -88,6 +94,7 @@ type blockReplayClient struct {
 	// onStartQueryClient is the RPC client that is used to query for the initial block
 	// upon blockReplayClient construction. The result of this query is only used if it
 	// returns before the eventsReplayClient receives its first event.
+	// It is also used to backfill the blocks committed while reconnecting.
 	onStartQueryClient client.BlockQueryClient
 
 	// eventsReplayClient is the underlying EventsReplayClient that is used to
@@ -109,6 +116,21 @@ type blockReplayClient struct {
 	// should retry in the event that it encounters an error or its connection is interrupted.
 	// If connRetryLimit is < 0, it will retry indefinitely.
 	connRetryLimit int
+
+	// maxBackfillBlocks is the maximum number of blocks committed while reconnecting
+	// which are backfilled. If more blocks were committed, only the most recent
+	// ones are backfilled.
+	maxBackfillBlocks int64
+
+	// latestBlockHeightMu protects latestBlockHeight and serializes the publications
+	// to the latestBlockReplayObs.
+	latestBlockHeightMu sync.Mutex
+	// latestBlockHeight is the height of the latest block published to the
+	// latestBlockReplayObs. The blocks which are not more recent are not published,
+	// so that the block sequence never goes backwards. For instance, after the
+	// events query client failed over to a lagging node, or if the initial block
+	// query completes after the first block event.
+	latestBlockHeight int64
 }
 
 // CommittedBlocksSequence returns a replay observable of new block events.
@@ -139,7 +161,7 @@ func (b *blockReplayClient) asyncForwardBlockEvent(
 ) {
 	channel.ForEach(ctx, b.eventsReplayClient.EventsSequence(ctx),
 		func(ctx context.Context, block client.Block) {
-			latestBlockPublishCh <- block
+			b.publishBlock(block, latestBlockPublishCh)
 		},
 	)
 }
@@ -173,10 +195,27 @@ func (b *blockReplayClient) getInitialBlock(
 
 	// At this point blockQueryResultCh was the first to receive the first block.
 	// Publish the initialBlock to the latestBlockPublishCh.
-	latestBlockPublishCh <- initialBlock
+	b.publishBlock(initialBlock, latestBlockPublishCh)
 	return nil
 }
 
+// publishBlock publishes the given block to latestBlockPublishCh if it is more
+// recent than the latest published one.
+func (b *blockReplayClient) publishBlock(
+	block client.Block,
+	latestBlockPublishCh chan<- client.Block,
+) {
+	b.latestBlockHeightMu.Lock()
+	defer b.latestBlockHeightMu.Unlock()
+
+	if block.Height() <= b.latestBlockHeight {
+		return
+	}
+
+	b.latestBlockHeight = block.Height()
+	latestBlockPublishCh <- block
+}
+
 // queryLatestBlock uses comet RPC block client to asynchronously query for
 // the latest block. It returns an error channel which may be sent a block query error.
 // It is *NOT* intended to be called in a goroutine.
@@ -199,3 +238,39 @@ func (b *blockReplayClient) queryLatestBlock(
 
 	return errCh
 }
+
+// blockEventKey returns the key of the given block, which is the only event
+// of its height. It implements events.EventKeyFn.
+func blockEventKey(block client.Block) events.EventKey {
+	return events.EventKey{Height: block.Height()}
+}
+
+// backfillBlocks queries the blocks committed from fromHeight up to the latest
+// committed block, in order. It implements events.BackfillEventsFn and is used
+// by the eventsReplayClient to backfill the blocks committed while reconnecting.
+func (b *blockReplayClient) backfillBlocks(
+	ctx context.Context,
+	fromHeight int64,
+) (blocks []client.Block, latestHeight int64, err error) {
+	startHeight, latestHeight, err := events.GetBackfillHeightsRange(
+		ctx,
+		b.onStartQueryClient,
+		fromHeight,
+		b.maxBackfillBlocks,
+	)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	for height := startHeight; height <= latestHeight; height++ {
+		queryBlockResult, err := b.onStartQueryClient.Block(ctx, &height)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		blockResult := CometBlockResult(*queryBlockResult)
+		blocks = append(blocks, &blockResult)
+	}
+
+	return blocks, latestHeight, nil
+}
@@ -2,6 +2,7 @@ package block_test
 
 import (
 	"context"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -15,6 +16,8 @@ import (
 
 	"github.com/pokt-network/poktroll/pkg/client"
 	"github.com/pokt-network/poktroll/pkg/client/block"
+	"github.com/pokt-network/poktroll/pkg/either"
+	"github.com/pokt-network/poktroll/pkg/observable/channel"
 	"github.com/pokt-network/poktroll/testutil/mockclient"
 	"github.com/pokt-network/poktroll/testutil/testclient/testeventsquery"
 )
@@ -167,3 +170,106 @@ type testBlockEventValueStruct struct {
 	Block   *types.Block  `json:"block"`
 	BlockID types.BlockID `json:"block_id"`
 }
+
+func TestBlockClient_BackfillOnReconnect(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	// latestHeight is the height of the latest committed block, as returned by
+	// the block query client.
+	var latestHeight atomic.Int64
+	latestHeight.Store(1)
+
+	ctrl := gomock.NewController(t)
+	eventsQueryClient := mockclient.NewMockEventsQueryClient(ctrl)
+	gomock.InOrder(
+		// The first subscription notifies about block 1 and is interrupted
+		// while blocks 2 to 4 are committed.
+		eventsQueryClient.EXPECT().
+			EventsBytes(gomock.Any(), committedBlocksQuery).
+			DoAndReturn(func(context.Context, string) (client.EventsBytesObservable, error) {
+				return newTestBlockEventsObservable(t, []int64{1}, func() { latestHeight.Store(4) }), nil
+			}),
+		// The re-established subscription notifies about block 5 onward.
+		eventsQueryClient.EXPECT().
+			EventsBytes(gomock.Any(), committedBlocksQuery).
+			DoAndReturn(func(context.Context, string) (client.EventsBytesObservable, error) {
+				return newTestBlockEventsObservable(t, []int64{5}, nil), nil
+			}),
+	)
+
+	cometClientMock := mockclient.NewMockCometRPC(ctrl)
+	cometClientMock.EXPECT().
+		Block(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, height *int64) (*coretypes.ResultBlock, error) {
+			blockHeight := latestHeight.Load()
+			if height != nil {
+				blockHeight = *height
+			}
+
+			return &coretypes.ResultBlock{
+				Block: &types.Block{Header: types.Header{Height: blockHeight}},
+			}, nil
+		}).
+		AnyTimes()
+
+	deps := depinject.Supply(client.EventsQueryClient(eventsQueryClient), cometClientMock)
+	blockClient, err := block.NewBlockClient(ctx, deps)
+	require.NoError(t, err)
+
+	// The blocks committed while reconnecting are backfilled such that the
+	// committed blocks sequence has no height gap.
+	blocksCh := blockClient.CommittedBlocksSequence(ctx).Subscribe(ctx).Ch()
+	for expectedHeight := int64(1); expectedHeight <= 5; expectedHeight++ {
+		select {
+		case actualBlock := <-blocksCh:
+			require.Equal(t, expectedHeight, actualBlock.Height())
+		case <-time.After(3 * time.Second):
+			t.Fatalf("timed out waiting for block %d", expectedHeight)
+		}
+	}
+}
+
+// newTestBlockEventsObservable returns an events bytes observable which notifies
+// about the committed blocks at the given heights. If onClose is not nil, the
+// observable is closed, after calling onClose, once all the blocks are notified.
+func newTestBlockEventsObservable(
+	t *testing.T,
+	heights []int64,
+	onClose func(),
+) client.EventsBytesObservable {
+	t.Helper()
+
+	blockEventsBzObs, blockEventsBzPublishCh := channel.NewObservable[either.Bytes]()
+	go func() {
+		// Wait a tick for the replay client to subscribe to the returned
+		// observable since the block events are not replayed.
+		time.Sleep(50 * time.Millisecond)
+
+		for _, height := range heights {
+			blockEvent := &testBlockEvent{
+				Data: testBlockEventDataStruct{
+					Value: testBlockEventValueStruct{
+						Block: &types.Block{Header: types.Header{Height: height}},
+					},
+				},
+			}
+			blockEventBz, err := json.Marshal(blockEvent)
+			require.NoError(t, err)
+
+			rpcResponseBz, err := json.Marshal(&rpctypes.RPCResponse{Result: blockEventBz})
+			require.NoError(t, err)
+
+			blockEventsBzPublishCh <- either.Success(rpcResponseBz)
+		}
+
+		if onClose != nil {
+			// Wait a tick for the notified blocks to be forwarded before closing.
+			time.Sleep(50 * time.Millisecond)
+			onClose()
+			close(blockEventsBzPublishCh)
+		}
+	}()
+
+	return blockEventsBzObs
+}
@@ -11,3 +11,13 @@ func WithConnRetryLimit(limit int) client.BlockClientOption {
 		client.(*blockReplayClient).connRetryLimit = limit
 	}
 }
+
+// WithMaxBackfillBlocks returns an option function which sets the maximum number
+// of blocks committed while the underlying replay client was reconnecting which
+// are backfilled. If more blocks were committed, only the most recent ones are
+// backfilled.
+func WithMaxBackfillBlocks(maxBlocks int64) client.BlockClientOption {
+	return func(client client.BlockClient) {
+		client.(*blockReplayClient).maxBackfillBlocks = maxBlocks
+	}
+}
@@ -0,0 +1,123 @@
+package events
+
+import (
+	"context"
+	"time"
+
+	"github.com/pokt-network/poktroll/pkg/client"
+	"github.com/pokt-network/poktroll/pkg/polylog"
+)
+
+const (
+	// DefaultMaxBackfillBlocks is the maximum number of blocks whose events are
+	// backfilled after a reconnection. If more blocks were committed while
+	// disconnected, only the events of the most recent ones are backfilled.
+	DefaultMaxBackfillBlocks = 1000
+
+	// backfillRetryInitialDelay is the delay before the first retry of a failed
+	// backfill of the missed events, doubled on each subsequent retry.
+	backfillRetryInitialDelay = time.Second
+	// backfillRetryMaxDelay is the maximum delay between the backfill retries.
+	backfillRetryMaxDelay = 30 * time.Second
+)
+
+// EventKey identifies an event by the height of the block it belongs to and
+// its index in that block (e.g. the index of a transaction). The events which
+// are emitted once per block have a zero index.
+type EventKey struct {
+	Height int64
+	Index  uint32
+}
+
+// IsAfter returns true if the event identified by key comes after the one
+// identified by other in the events sequence.
+func (key EventKey) IsAfter(other EventKey) bool {
+	if key.Height != other.Height {
+		return key.Height > other.Height
+	}
+	return key.Index > other.Index
+}
+
+// EventKeyFn returns the key identifying the given event in the events sequence.
+type EventKeyFn[T any] func(event T) EventKey
+
+// BackfillEventsFn returns, in order, the events of the committed blocks from
+// fromHeight up to the latest committed block, along with the latest block height.
+// It is used to backfill the events which were missed while the events query
+// client was disconnected.
+type BackfillEventsFn[T any] func(ctx context.Context, fromHeight int64) (events []T, latestHeight int64, err error)
+
+// eventsBackfill holds the functions used by the replay client to backfill the
+// events missed while reconnecting.
+type eventsBackfill[T any] struct {
+	eventKeyFn EventKeyFn[T]
+	backfillFn BackfillEventsFn[T]
+}
+
+// backfillEvents returns, in order, the events of the blocks committed from
+// fromHeight, along with the height of the latest backfilled block.
+// A failed backfill is retried with an exponential backoff until it succeeds,
+// since the missed events would otherwise never be published. It returns false
+// if ctx is done before the events could be backfilled.
+func (b *eventsBackfill[T]) backfillEvents(
+	ctx context.Context,
+	fromHeight int64,
+) (events []T, latestHeight int64, ok bool) {
+	logger := polylog.Ctx(ctx).With("backfill_from_height", fromHeight)
+
+	retryDelay := backfillRetryInitialDelay
+	for attempt := 1; ; attempt++ {
+		var err error
+		events, latestHeight, err = b.backfillFn(ctx, fromHeight)
+		if err == nil {
+			logger.Info().
+				Int("num_backfilled_events", len(events)).
+				Int64("latest_backfilled_height", latestHeight).
+				Msg("backfilled the events missed while reconnecting")
+
+			return events, latestHeight, true
+		}
+
+		logger.Warn().
+			Err(err).
+			Int("attempt", attempt).
+			Dur("retry_delay", retryDelay).
+			Msg("failed to backfill the events missed while reconnecting, retrying")
+
+		select {
+		case <-ctx.Done():
+			return nil, 0, false
+		case <-time.After(retryDelay):
+		}
+		retryDelay = min(2*retryDelay, backfillRetryMaxDelay)
+	}
+}
+
+// GetBackfillHeightsRange returns the range of block heights (inclusive) to
+// backfill from fromHeight up to the latest committed block height, limited to
+// the maxBlocks most recent blocks.
+// It is intended to be used by the BackfillEventsFn implementations.
+func GetBackfillHeightsRange(
+	ctx context.Context,
+	blockQueryClient client.BlockQueryClient,
+	fromHeight int64,
+	maxBlocks int64,
+) (startHeight, latestHeight int64, err error) {
+	latestBlock, err := blockQueryClient.Block(ctx, nil)
+	if err != nil {
+		return 0, 0, err
+	}
+	latestHeight = latestBlock.Block.Height
+
+	startHeight = fromHeight
+	if latestHeight-startHeight+1 > maxBlocks {
+		startHeight = latestHeight - maxBlocks + 1
+
+		polylog.Ctx(ctx).Warn().
+			Int64("from_height", fromHeight).
+			Int64("backfill_start_height", startHeight).
+			Msg("too many blocks to backfill, skipping the oldest ones")
+	}
+
+	return startHeight, latestHeight, nil
+}
@@ -0,0 +1,171 @@
+package events_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"cosmossdk.io/depinject"
+	abci "github.com/cometbft/cometbft/abci/types"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	comettypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/pokt-network/poktroll/pkg/client"
+	"github.com/pokt-network/poktroll/pkg/client/events"
+	"github.com/pokt-network/poktroll/pkg/either"
+	"github.com/pokt-network/poktroll/pkg/observable/channel"
+	"github.com/pokt-network/poktroll/testutil/mockclient"
+)
+
+func TestReplayClient_TxResultsBackfill(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	// committedTxs are the transactions committed at each height.
+	committedTxs := map[int64][][]byte{
+		1: {[]byte("tx_1_0"), []byte("tx_1_1")},
+		3: {[]byte("tx_3_0")},
+		4: {[]byte("tx_4_0")},
+		5: {[]byte("tx_5_0")},
+	}
+
+	// latestHeight is the height of the latest committed block, as returned by
+	// the block query client.
+	var latestHeight atomic.Int64
+	latestHeight.Store(1)
+
+	ctrl := gomock.NewController(t)
+	eventsQueryClient := mockclient.NewMockEventsQueryClient(ctrl)
+	gomock.InOrder(
+		// The first subscription notifies about the first tx at height 1 and is
+		// interrupted before notifying about the second one, while the blocks
+		// 2 to 4 are committed.
+		eventsQueryClient.EXPECT().
+			EventsBytes(gomock.Any(), events.CommittedTxsQuery).
+			DoAndReturn(func(context.Context, string) (client.EventsBytesObservable, error) {
+				return newTestTxResultsObservable(
+					t, committedTxs,
+					[]events.EventKey{{Height: 1, Index: 0}},
+					func() { latestHeight.Store(4) },
+				), nil
+			}),
+		// The re-established subscription notifies about the tx at height 4,
+		// which is already backfilled, and the one at height 5.
+		eventsQueryClient.EXPECT().
+			EventsBytes(gomock.Any(), events.CommittedTxsQuery).
+			DoAndReturn(func(context.Context, string) (client.EventsBytesObservable, error) {
+				return newTestTxResultsObservable(
+					t, committedTxs,
+					[]events.EventKey{{Height: 4, Index: 0}, {Height: 5, Index: 0}},
+					nil,
+				), nil
+			}),
+	)
+
+	blockQueryClient := mockclient.NewMockCometRPC(ctrl)
+	blockQueryClient.EXPECT().
+		Block(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, height *int64) (*coretypes.ResultBlock, error) {
+			blockHeight := latestHeight.Load()
+			if height != nil {
+				blockHeight = *height
+			}
+
+			block := &comettypes.Block{Header: comettypes.Header{Height: blockHeight}}
+			for _, txBz := range committedTxs[blockHeight] {
+				block.Txs = append(block.Txs, txBz)
+			}
+
+			return &coretypes.ResultBlock{Block: block}, nil
+		}).
+		AnyTimes()
+	// The first backfill attempt fails, the backfill being retried until it succeeds.
+	blockQueryClient.EXPECT().
+		BlockResults(gomock.Any(), gomock.Any()).
+		Return(nil, errors.New("connection refused"))
+	blockQueryClient.EXPECT().
+		BlockResults(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, height *int64) (*coretypes.ResultBlockResults, error) {
+			blockResults := &coretypes.ResultBlockResults{Height: *height}
+			for range committedTxs[*height] {
+				blockResults.TxsResults = append(blockResults.TxsResults, &abci.ExecTxResult{})
+			}
+
+			return blockResults, nil
+		}).
+		AnyTimes()
+
+	deps := depinject.Supply(client.EventsQueryClient(eventsQueryClient))
+	txResultsReplayClient, err := events.NewCommittedTxsReplayClient(
+		ctx, deps, 1,
+		events.WithTxResultsBackfill(blockQueryClient),
+	)
+	require.NoError(t, err)
+
+	// The txs committed while reconnecting, including the one of the block
+	// which was being notified about, are backfilled in order, and each tx is
+	// notified exactly once.
+	txResultsCh := txResultsReplayClient.EventsSequence(ctx).Subscribe(ctx).Ch()
+	for _, expectedKey := range []events.EventKey{
+		{Height: 1, Index: 0},
+		{Height: 1, Index: 1},
+		{Height: 3, Index: 0},
+		{Height: 4, Index: 0},
+		{Height: 5, Index: 0},
+	} {
+		select {
+		case txResult := <-txResultsCh:
+			require.Equal(t, expectedKey, events.TxResultKey(txResult))
+			require.Equal(t, committedTxs[expectedKey.Height][expectedKey.Index], txResult.Tx)
+		case <-time.After(3 * time.Second):
+			t.Fatalf("timed out waiting for the tx result %+v", expectedKey)
+		}
+	}
+
+	select {
+	case txResult := <-txResultsCh:
+		t.Fatalf("unexpected tx result %+v", events.TxResultKey(txResult))
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+// newTestTxResultsObservable returns an events bytes observable which notifies
+// about the committed txs with the given keys. If onClose is not nil, the
+// observable is closed, after calling onClose, once all the txs are notified.
+func newTestTxResultsObservable(
+	t *testing.T,
+	committedTxs map[int64][][]byte,
+	txKeys []events.EventKey,
+	onClose func(),
+) client.EventsBytesObservable {
+	t.Helper()
+
+	txResultsBzObs, txResultsBzPublishCh := channel.NewObservable[either.Bytes]()
+	go func() {
+		// Wait a tick for the replay client to subscribe to the returned
+		// observable since the transactions results are not replayed.
+		time.Sleep(50 * time.Millisecond)
+
+		for _, txKey := range txKeys {
+			txResult := testTxResult{
+				height: txKey.Height,
+				index:  txKey.Index,
+				txBz:   committedTxs[txKey.Height][txKey.Index],
+			}
+			txResultsBzPublishCh <- either.Success(encodeTestTxResult(t, txResult))
+		}
+
+		if onClose != nil {
+			// Wait a tick for the notified txs to be forwarded before closing.
+			time.Sleep(50 * time.Millisecond)
+			onClose()
+			close(txResultsBzPublishCh)
+		}
+	}()
+
+	return txResultsBzObs
+}
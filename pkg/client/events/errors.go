@@ -10,4 +10,6 @@ var (
 	ErrEventsSubscribe      = sdkerrors.Register(codespace, 3, "failed to subscribe to events")
 	ErrEventsUnmarshalEvent = sdkerrors.Register(codespace, 4, "failed to unmarshal event bytes")
 	ErrEventsConsClosed     = sdkerrors.Register(codespace, 5, "eventsqueryclient connection closed")
+	ErrEventsTypedEventType = sdkerrors.Register(codespace, 6, "invalid typed event type")
+	ErrEventsBackfill       = sdkerrors.Register(codespace, 7, "failed to backfill events")
 )
@@ -9,4 +9,15 @@
 // provide the latest event data to the caller, even if the connection to the
 // EventsQueryClient is lost and re-established, without the caller having to
 // re-subscribe to the EventsQueryClient.
+//
+// The events emitted while the connection is being re-established can be
+// backfilled, in order, by querying the missed blocks (see WithBackfill and
+// WithTxResultsBackfill), such that the consumers observe a contiguous height
+// sequence across reconnections.
+//
+// SubscribeTypedEvents builds on the EventsReplayClient to provide observables
+// of decoded proto typed events, along with the height and hash of the
+// transaction which emitted them. All the typed events subscriptions share the
+// same committed transactions subscription (i.e. CommittedTxsQuery), such that a
+// single websocket subscription per node is open for all the consumers.
 package events
@@ -1,6 +1,9 @@
 package events
 
-import "github.com/pokt-network/poktroll/pkg/client"
+import (
+	"github.com/pokt-network/poktroll/pkg/client"
+	"github.com/pokt-network/poktroll/pkg/client/failover"
+)
 
 // WithDialer returns a client.EventsQueryClientOption which sets the given dialer on the
 // resulting eventsQueryClient when passed to NewEventsQueryClient().
@@ -10,6 +13,17 @@ func WithDialer(dialer client.Dialer) client.EventsQueryClientOption {
 	}
 }
 
+// WithFailoverEndpoints returns a client.EventsQueryClientOption which sets the
+// CometBFT RPC endpoints whose websockets the resulting eventsQueryClient connects
+// to, in order of preference, failing over to the next candidate endpoint when
+// one is unreachable or its connection is interrupted.
+// The cometWebsocketURL passed to NewEventsQueryClient() is ignored in this case.
+func WithFailoverEndpoints(endpoints *failover.Endpoints) client.EventsQueryClientOption {
+	return func(evtClient client.EventsQueryClient) {
+		evtClient.(*eventsQueryClient).endpoints = endpoints
+	}
+}
+
 // WithConnRetryLimit returns an option function which sets the number
 // of times the replay client should retry in the event that it encounters
 // an error or its connection is interrupted.
@@ -24,3 +38,20 @@ func WithConnRetryLimit[T any](limit int) client.EventsReplayClientOption[T] {
 		}
 	}
 }
+
+// WithBackfill returns an option function which makes the replay client backfill,
+// in order, the events of the blocks committed while its events query subscription
+// was being re-established, using the given backfillFn.
+// The events are deduplicated by the key returned by eventKeyFn, such that each
+// event is published exactly once.
+func WithBackfill[T any](
+	eventKeyFn EventKeyFn[T],
+	backfillFn BackfillEventsFn[T],
+) client.EventsReplayClientOption[T] {
+	return func(client client.EventsReplayClient[T]) {
+		client.(*replayClient[T]).backfill = &eventsBackfill[T]{
+			eventKeyFn: eventKeyFn,
+			backfillFn: backfillFn,
+		}
+	}
+}
@@ -14,6 +14,7 @@ import (
 
 	"github.com/pokt-network/poktroll/pkg/client"
 	"github.com/pokt-network/poktroll/pkg/client/events/websocket"
+	"github.com/pokt-network/poktroll/pkg/client/failover"
 	"github.com/pokt-network/poktroll/pkg/either"
 	"github.com/pokt-network/poktroll/pkg/observable"
 	"github.com/pokt-network/poktroll/pkg/observable/channel"
@@ -38,6 +39,11 @@ type eventsQueryClient struct {
 	// dialer is responsible for creating the connection instance which
 	// facilitates communication with the cometbft node via message passing.
 	dialer client.Dialer
+	// endpoints, if set, are the CometBFT RPC endpoints whose websockets are
+	// connected to, failing over to the next candidate endpoint when one is
+	// unreachable or its connection is interrupted. cometWebsocketURL is not
+	// used in this case.
+	endpoints *failover.Endpoints
 	// eventsBytesAndConnsMu protects the eventsBytesAndConns map.
 	eventsBytesAndConnsMu sync.RWMutex
 	// eventsBytesAndConns maps event subscription queries to their respective
@@ -67,6 +73,7 @@ func (ebc *eventsBytesAndConn) Close() {
 //
 // Available options:
 //   - WithDialer
+//   - WithFailoverEndpoints
 func NewEventsQueryClient(cometWebsocketURL string, opts ...client.EventsQueryClientOption) client.EventsQueryClient {
 	evtClient := &eventsQueryClient{
 		cometWebsocketURL:   cometWebsocketURL,
@@ -150,7 +157,7 @@ func (eqc *eventsQueryClient) newEventsBytesAndConn(
 	query string,
 ) (*eventsBytesAndConn, error) {
 	// Get a connection for the query.
-	conn, err := eqc.openEventsBytesAndConn(ctx, query)
+	conn, endpointURL, err := eqc.openEventsBytesAndConn(ctx, query)
 	if err != nil {
 		return nil, err
 	}
@@ -162,7 +169,7 @@ func (eqc *eventsQueryClient) newEventsBytesAndConn(
 	// the eventsBz observable.
 	// NB: intentionally not retrying on error, leaving that to the caller.
 	// (see: https://github.com/pokt-network/poktroll/pull/64#discussion_r1373826542)
-	go eqc.goPublishEventsBz(ctx, conn, eventsBzPublishCh)
+	go eqc.goPublishEventsBz(ctx, conn, endpointURL, eventsBzPublishCh)
 
 	return &eventsBytesAndConn{
 		eventsBytes: eventsBzObservable,
@@ -172,18 +179,53 @@ func (eqc *eventsQueryClient) newEventsBytesAndConn(
 
 // openEventsBytesAndConn gets a connection using the configured dialer and sends
 // an event subscription request on it, returning the connection.
+// If failover endpoints are configured, the candidate endpoints are connected
+// to in order until one succeeds, and its URL is also returned.
 func (eqc *eventsQueryClient) openEventsBytesAndConn(
 	ctx context.Context,
 	query string,
-) (client.Connection, error) {
+) (_ client.Connection, endpointURL *url.URL, _ error) {
 	// Get a request for subscribing to events matching the given query.
 	req, err := eqc.eventSubscriptionRequest(query)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+
+	if eqc.endpoints == nil {
+		conn, err := eqc.dialAndSubscribe(ctx, eqc.cometWebsocketURL, req)
+		return conn, nil, err
+	}
+
+	var errs error
+	for _, endpointURL := range eqc.endpoints.Candidates() {
+		conn, err := eqc.dialAndSubscribe(ctx, RPCToWebsocketURL(endpointURL), req)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, nil, err
+			}
+
+			eqc.endpoints.ReportFailure(endpointURL, err)
+			errs = multierr.Append(errs, err)
+			continue
+		}
+
+		eqc.endpoints.ReportSuccess(endpointURL, 0)
+		return conn, endpointURL, nil
 	}
 
+	return nil, nil, errs
+}
+
+// dialAndSubscribe gets a connection to the given websocket URL using the
+// configured dialer and sends the given event subscription request on it,
+// returning the connection.
+func (eqc *eventsQueryClient) dialAndSubscribe(
+	ctx context.Context,
+	websocketURL string,
+	req []byte,
+) (client.Connection, error) {
 	// Get a connection from the dialer.
-	conn, err := eqc.dialer.DialContext(ctx, eqc.cometWebsocketURL)
+	conn, err := eqc.dialer.DialContext(ctx, websocketURL)
 	if err != nil {
 		return nil, ErrEventsDial.Wrapf("%s", err)
 	}
@@ -199,10 +241,13 @@ func (eqc *eventsQueryClient) openEventsBytesAndConn(
 }
 
 // goPublishEventsBz blocks on reading messages from a websocket connection.
+// If the connection is to a failover endpoint, given by its URL, the endpoint is
+// reported as unhealthy when the connection is interrupted.
 // It is intended to be called from within a go routine.
 func (eqc *eventsQueryClient) goPublishEventsBz(
 	ctx context.Context,
 	conn client.Connection,
+	endpointURL *url.URL,
 	eventsBzPublishCh chan<- either.Bytes,
 ) {
 	// Read and handle messages from the websocket. This loop will exit when the
@@ -222,6 +267,11 @@ func (eqc *eventsQueryClient) goPublishEventsBz(
 
 			// Only propagate error if it's not a context cancellation error.
 			if !errors.Is(ctx.Err(), context.Canceled) {
+				// Fail over to the next candidate endpoint when resubscribing.
+				if endpointURL != nil {
+					eqc.endpoints.ReportFailure(endpointURL, err)
+				}
+
 				// Populate the error side (left) of the either and publish it.
 				eventsBzPublishCh <- either.Error[[]byte](err)
 			}
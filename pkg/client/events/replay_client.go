@@ -100,6 +100,10 @@ type replayClient[T any] struct {
 	// in the event that it encounters an error or its connection is interrupted.
 	// If connRetryLimit is < 0, it will retry indefinitely.
 	connRetryLimit int
+	// backfill, if set (see WithBackfill), is used to backfill the events of
+	// the blocks committed while the events query subscription was being
+	// re-established, such that no event is missed across reconnections.
+	backfill *eventsBackfill[T]
 }
 
 // NewEventsReplayClient creates a new EventsReplayClient from the given
@@ -179,15 +183,69 @@ func (rClient *replayClient[T]) EventsSequence(ctx context.Context) observable.R
 }
 
 // goRemapEventsSequence publishes events observed by the most recent cached
-// events type replay observable to the given publishCh
+// events type replay observable to the given publishCh.
+// If the replay client has a backfill, the events of the blocks committed from
+// the last event published before a reconnection up to the first one received
+// after it are backfilled, in order, before the latter. The backfilled events
+// which were already published are skipped by their key.
 func (rClient *replayClient[T]) goRemapEventsSequence(ctx context.Context, publishCh chan<- T) {
+	var (
+		// isReconnection is true for all but the first events type observable.
+		isReconnection bool
+		// hasPublishedEvent is true once an event was published to publishCh.
+		hasPublishedEvent bool
+		// lastEventKey is the key of the last event published to publishCh,
+		// either as received or backfilled.
+		lastEventKey EventKey
+	)
+
 	channel.ForEach[observable.ReplayObservable[T]](
 		ctx,
 		rClient.replayObsCache,
 		func(ctx context.Context, eventTypeObs observable.ReplayObservable[T]) {
+			// Subscribe before backfilling so that the events received while
+			// backfilling are not missed.
 			eventObserver := eventTypeObs.Subscribe(ctx)
+
+			// The events received after the reconnection which belong to the
+			// backfilled blocks are skipped as they were already published.
+			var backfilledHeight int64
+			if isReconnection && rClient.backfill != nil && hasPublishedEvent {
+				// Backfill from the height of the last published event, since
+				// the other events of its block may not have been received
+				// before the disconnection.
+				backfilledEvents, latestHeight, ok := rClient.backfill.backfillEvents(ctx, lastEventKey.Height)
+				if !ok {
+					return
+				}
+
+				for _, event := range backfilledEvents {
+					eventKey := rClient.backfill.eventKeyFn(event)
+					if !eventKey.IsAfter(lastEventKey) {
+						continue
+					}
+
+					publishCh <- event
+					lastEventKey = eventKey
+				}
+				backfilledHeight = latestHeight
+			}
+			isReconnection = true
+
 			for event := range eventObserver.Ch() {
+				if rClient.backfill == nil {
+					publishCh <- event
+					continue
+				}
+
+				eventKey := rClient.backfill.eventKeyFn(event)
+				if eventKey.Height <= backfilledHeight {
+					continue
+				}
+
 				publishCh <- event
+				hasPublishedEvent = true
+				lastEventKey = eventKey
 			}
 		},
 	)
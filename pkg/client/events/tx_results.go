@@ -0,0 +1,158 @@
+package events
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+
+	"cosmossdk.io/depinject"
+	abci "github.com/cometbft/cometbft/abci/types"
+	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+
+	"github.com/pokt-network/poktroll/pkg/client"
+)
+
+// CommittedTxsQuery is the query used to subscribe to all the committed transactions.
+// All the consumers of transactions events use this exact query so that they
+// share the same events query subscription (i.e. websocket connection) to the node,
+// and filter the transactions or events they are interested in on the client side.
+const CommittedTxsQuery = "tm.event='Tx'"
+
+// CometTxEvent is used to deserialize incoming transaction event messages
+// from the respective events query subscription. This structure is adapted
+// to handle CometBFT's unique serialization format, which diverges from
+// conventional approaches seen in implementations like rollkit's. The design
+// ensures accurate parsing and compatibility with CometBFT's serialization
+// of transaction results.
+type CometTxEvent struct {
+	Data struct {
+		// TxResult is nested to accommodate CometBFT's serialization format,
+		// ensuring correct deserialization of transaction results.
+		Value struct {
+			TxResult abci.TxResult
+		} `json:"value"`
+	} `json:"data"`
+}
+
+// NewCommittedTxsReplayClient returns an EventsReplayClient of the results of all
+// the committed transactions, through the shared CommittedTxsQuery subscription.
+// The replayObsBufferSize is the replay buffer size of the replay observable
+// which is notified of new transaction results.
+//
+// Required dependencies:
+//   - client.EventsQueryClient
+func NewCommittedTxsReplayClient(
+	ctx context.Context,
+	deps depinject.Config,
+	replayObsBufferSize int,
+	opts ...client.EventsReplayClientOption[*abci.TxResult],
+) (client.EventsReplayClient[*abci.TxResult], error) {
+	return NewEventsReplayClient(
+		ctx,
+		deps,
+		CommittedTxsQuery,
+		UnmarshalTxResult,
+		replayObsBufferSize,
+		opts...,
+	)
+}
+
+// WithTxResultsBackfill returns an option function which makes a committed
+// transactions replay client backfill, in order, the results of the transactions
+// committed while reconnecting, by querying the blocks results using the given
+// blockQueryClient.
+// See WithBackfill and DefaultMaxBackfillBlocks.
+func WithTxResultsBackfill(
+	blockQueryClient client.BlockQueryClient,
+) client.EventsReplayClientOption[*abci.TxResult] {
+	return WithBackfill(
+		TxResultKey,
+		NewTxResultsBackfillFn(blockQueryClient, DefaultMaxBackfillBlocks),
+	)
+}
+
+// TxResultKey returns the height of the block which includes the given
+// transaction result, along with the transaction index in it. It implements
+// EventKeyFn.
+func TxResultKey(txResult *abci.TxResult) EventKey {
+	return EventKey{Height: txResult.Height, Index: txResult.Index}
+}
+
+// NewTxResultsBackfillFn returns a BackfillEventsFn which queries, using the given
+// blockQueryClient, the results of the transactions committed from fromHeight
+// up to the latest committed block, limited to the maxBlocks most recent blocks.
+func NewTxResultsBackfillFn(
+	blockQueryClient client.BlockQueryClient,
+	maxBlocks int64,
+) BackfillEventsFn[*abci.TxResult] {
+	return func(ctx context.Context, fromHeight int64) ([]*abci.TxResult, int64, error) {
+		startHeight, latestHeight, err := GetBackfillHeightsRange(ctx, blockQueryClient, fromHeight, maxBlocks)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		var txResults []*abci.TxResult
+		for height := startHeight; height <= latestHeight; height++ {
+			blockResults, err := blockQueryClient.BlockResults(ctx, &height)
+			if err != nil {
+				return nil, 0, err
+			}
+
+			// Only query the block, for its transactions bytes, if it has any.
+			if len(blockResults.TxsResults) == 0 {
+				continue
+			}
+
+			block, err := blockQueryClient.Block(ctx, &height)
+			if err != nil {
+				return nil, 0, err
+			}
+
+			txs := block.Block.Txs
+			if len(txs) != len(blockResults.TxsResults) {
+				return nil, 0, ErrEventsBackfill.Wrapf(
+					"block at height %d has %d txs but %d txs results",
+					height, len(txs), len(blockResults.TxsResults),
+				)
+			}
+
+			for txIdx, execTxResult := range blockResults.TxsResults {
+				txResults = append(txResults, &abci.TxResult{
+					Height: height,
+					Index:  uint32(txIdx),
+					Tx:     txs[txIdx],
+					Result: *execTxResult,
+				})
+			}
+		}
+
+		return txResults, latestHeight, nil
+	}
+}
+
+// UnmarshalTxResult attempts to deserialize a slice of bytes into a TxResult
+// It checks if the given bytes correspond to a valid transaction event.
+// If the resulting TxResult has empty transaction bytes, it assumes that
+// the message was not a transaction results and returns an error.
+func UnmarshalTxResult(txResultBz []byte) (*abci.TxResult, error) {
+	var rpcResponse rpctypes.RPCResponse
+
+	// Try to deserialize the provided bytes into an RPCResponse.
+	if err := json.Unmarshal(txResultBz, &rpcResponse); err != nil {
+		return nil, ErrEventsUnmarshalEvent.Wrap(err.Error())
+	}
+
+	var cometTxEvent CometTxEvent
+	// Try to deserialize the provided bytes into a CometTxEvent.
+	if err := json.Unmarshal(rpcResponse.Result, &cometTxEvent); err != nil {
+		return nil, ErrEventsUnmarshalEvent.Wrap(err.Error())
+	}
+
+	// Check if the TxResult has empty transaction bytes, which indicates
+	// the message might not be a valid transaction event.
+	if bytes.Equal(cometTxEvent.Data.Value.TxResult.Tx, []byte{}) {
+		return nil, ErrEventsUnmarshalEvent.Wrap("event bytes do not correspond to an abci.TxResult")
+	}
+
+	return &cometTxEvent.Data.Value.TxResult, nil
+}
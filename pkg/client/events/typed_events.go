@@ -0,0 +1,259 @@
+package events
+
+import (
+	"context"
+	"strings"
+
+	"cosmossdk.io/depinject"
+	abci "github.com/cometbft/cometbft/abci/types"
+	comettypes "github.com/cometbft/cometbft/types"
+	cosmostypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/pokt-network/poktroll/pkg/client"
+	"github.com/pokt-network/poktroll/pkg/encoding"
+	"github.com/pokt-network/poktroll/pkg/observable"
+	"github.com/pokt-network/poktroll/pkg/observable/channel"
+)
+
+// DefaultTypedEventsReplayBufferSize is the default replay buffer size of the
+// typed events replay observables, i.e. the number of past typed events that are
+// replayed to their new observers.
+const DefaultTypedEventsReplayBufferSize = 1
+
+// TypedEvent is a typed event emitted by a committed transaction, along with the
+// height of the block and the hash of the transaction which emitted it.
+type TypedEvent[T proto.Message] struct {
+	// Event is the decoded typed event.
+	Event T
+	// Height is the height of the block which includes the transaction.
+	Height int64
+	// TxHash is the normalized hexadecimal hash of the transaction.
+	TxHash string
+}
+
+// eventAttribute is an attribute key/value pair which the typed events must have.
+type eventAttribute struct {
+	key   string
+	value string
+}
+
+// typedEventsConfig is the configuration of a typed events subscription.
+// It is intended to be configured via TypedEventsOption functions.
+type typedEventsConfig struct {
+	// eventTypes are the proto message names of the subscribed typed events.
+	eventTypes map[string]struct{}
+	// attributes are the attributes that the subscribed typed events must all have.
+	attributes []eventAttribute
+	// replayBufferSize is the replay buffer size of the typed events observable.
+	replayBufferSize int
+	// connRetryLimit is the number of times the subscription is re-established
+	// after an error, see WithConnRetryLimit.
+	connRetryLimit int
+	// backfillBlockQueryClient, if set, is used to backfill the typed events
+	// emitted while reconnecting, see WithTxResultsBackfill.
+	backfillBlockQueryClient client.BlockQueryClient
+}
+
+// TypedEventsOption is a function which configures a typed events subscription.
+type TypedEventsOption func(*typedEventsConfig)
+
+// WithEventTypes subscribes to the typed events of the same types as the given
+// events, instead of the type parameter of SubscribeTypedEvents.
+// It is required when the type parameter is an interface (e.g. proto.Message),
+// in order to subscribe to several event types at once.
+func WithEventTypes(events ...proto.Message) TypedEventsOption {
+	return func(cfg *typedEventsConfig) {
+		for _, event := range events {
+			cfg.eventTypes[proto.MessageName(event)] = struct{}{}
+		}
+	}
+}
+
+// WithEventAttribute only keeps the typed events having the given attribute
+// key and value. The typed events attribute values are JSON encoded, the value
+// is compared to the attribute value without its enclosing quotes, if any
+// (e.g. "pokt1..." for a string field, or "42" for a number field).
+// If provided multiple times, the typed events must have all the attributes.
+func WithEventAttribute(key, value string) TypedEventsOption {
+	return func(cfg *typedEventsConfig) {
+		cfg.attributes = append(cfg.attributes, eventAttribute{key: key, value: value})
+	}
+}
+
+// WithTypedEventsReplayBufferSize sets the replay buffer size of the typed
+// events observable.
+func WithTypedEventsReplayBufferSize(replayBufferSize int) TypedEventsOption {
+	return func(cfg *typedEventsConfig) {
+		cfg.replayBufferSize = replayBufferSize
+	}
+}
+
+// WithTypedEventsConnRetryLimit sets the number of times the subscription is
+// re-established after an error or an interruption of its connection.
+// See WithConnRetryLimit.
+func WithTypedEventsConnRetryLimit(connRetryLimit int) TypedEventsOption {
+	return func(cfg *typedEventsConfig) {
+		cfg.connRetryLimit = connRetryLimit
+	}
+}
+
+// WithTypedEventsBackfill backfills, in order, the typed events emitted by the
+// transactions committed while the subscription was being re-established, by
+// querying the blocks results using the given blockQueryClient.
+// See WithTxResultsBackfill.
+func WithTypedEventsBackfill(blockQueryClient client.BlockQueryClient) TypedEventsOption {
+	return func(cfg *typedEventsConfig) {
+		cfg.backfillBlockQueryClient = blockQueryClient
+	}
+}
+
+// SubscribeTypedEvents returns a replay observable of the typed events of type T
+// emitted by the successfully committed transactions.
+//
+// The transactions are received through the CommittedTxsQuery subscription, which
+// is shared with all the other transactions events consumers using the same
+// EventsQueryClient, so that a single websocket subscription per node is open
+// regardless of the number of subscribed event types.
+// The subscription is re-established if it errors or its connection is interrupted,
+// and closed when the given context is done.
+//
+// Required dependencies:
+//   - client.EventsQueryClient
+//
+// Available options:
+//   - WithEventTypes
+//   - WithEventAttribute
+//   - WithTypedEventsReplayBufferSize
+//   - WithTypedEventsConnRetryLimit
+//   - WithTypedEventsBackfill
+func SubscribeTypedEvents[T proto.Message](
+	ctx context.Context,
+	deps depinject.Config,
+	opts ...TypedEventsOption,
+) (observable.ReplayObservable[*TypedEvent[T]], error) {
+	cfg := &typedEventsConfig{
+		eventTypes:       make(map[string]struct{}),
+		replayBufferSize: DefaultTypedEventsReplayBufferSize,
+	}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	// Default to the event type given by the type parameter.
+	if len(cfg.eventTypes) == 0 {
+		eventType := proto.MessageName(*new(T))
+		if eventType == "" {
+			return nil, ErrEventsTypedEventType.Wrapf(
+				"unable to determine the event type of %T, use WithEventTypes", *new(T),
+			)
+		}
+		cfg.eventTypes[eventType] = struct{}{}
+	}
+
+	txResultsReplayClientOpts := []client.EventsReplayClientOption[*abci.TxResult]{
+		WithConnRetryLimit[*abci.TxResult](cfg.connRetryLimit),
+	}
+	if cfg.backfillBlockQueryClient != nil {
+		txResultsReplayClientOpts = append(
+			txResultsReplayClientOpts,
+			WithTxResultsBackfill(cfg.backfillBlockQueryClient),
+		)
+	}
+
+	txResultsReplayClient, err := NewCommittedTxsReplayClient(
+		ctx,
+		deps,
+		cfg.replayBufferSize,
+		txResultsReplayClientOpts...,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	typedEventsObs, typedEventsPublishCh := channel.NewReplayObservable[*TypedEvent[T]](
+		ctx,
+		cfg.replayBufferSize,
+	)
+
+	channel.ForEach(
+		ctx,
+		txResultsReplayClient.EventsSequence(ctx),
+		func(ctx context.Context, txResult *abci.TxResult) {
+			for _, typedEvent := range getTxTypedEvents[T](cfg, txResult) {
+				typedEventsPublishCh <- typedEvent
+			}
+		},
+	)
+
+	return typedEventsObs, nil
+}
+
+// getTxTypedEvents returns the typed events of type T emitted by the given
+// transaction which match the given configuration.
+// Failed transactions are skipped since they do not update the onchain state.
+func getTxTypedEvents[T proto.Message](
+	cfg *typedEventsConfig,
+	txResult *abci.TxResult,
+) []*TypedEvent[T] {
+	if txResult.Result.IsErr() {
+		return nil
+	}
+
+	var (
+		typedEvents []*TypedEvent[T]
+		txHash      string
+	)
+	for _, event := range txResult.Result.Events {
+		if _, ok := cfg.eventTypes[event.GetType()]; !ok {
+			continue
+		}
+
+		if !eventHasAttributes(event, cfg.attributes) {
+			continue
+		}
+
+		parsedEvent, err := cosmostypes.ParseTypedEvent(event)
+		if err != nil {
+			continue
+		}
+
+		typedEvent, ok := parsedEvent.(T)
+		if !ok {
+			continue
+		}
+
+		// Only hash the transactions emitting subscribed events.
+		if txHash == "" {
+			txHash = encoding.TxHashBytesToNormalizedHex(comettypes.Tx(txResult.Tx).Hash())
+		}
+
+		typedEvents = append(typedEvents, &TypedEvent[T]{
+			Event:  typedEvent,
+			Height: txResult.Height,
+			TxHash: txHash,
+		})
+	}
+
+	return typedEvents
+}
+
+// eventHasAttributes returns true if the given event has all the given attributes.
+func eventHasAttributes(event abci.Event, attributes []eventAttribute) bool {
+	for _, attribute := range attributes {
+		hasAttribute := false
+		for _, eventAttribute := range event.GetAttributes() {
+			if eventAttribute.GetKey() == attribute.key &&
+				strings.Trim(eventAttribute.GetValue(), `"`) == attribute.value {
+				hasAttribute = true
+				break
+			}
+		}
+
+		if !hasAttribute {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,236 @@
+package events_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"cosmossdk.io/depinject"
+	abci "github.com/cometbft/cometbft/abci/types"
+	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+	comettypes "github.com/cometbft/cometbft/types"
+	cosmostypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/pokt-network/poktroll/pkg/client"
+	"github.com/pokt-network/poktroll/pkg/client/events"
+	"github.com/pokt-network/poktroll/pkg/either"
+	"github.com/pokt-network/poktroll/pkg/encoding"
+	"github.com/pokt-network/poktroll/pkg/observable/channel"
+	"github.com/pokt-network/poktroll/testutil/mockclient"
+	"github.com/pokt-network/poktroll/testutil/sample"
+	apptypes "github.com/pokt-network/poktroll/x/application/types"
+)
+
+// testTxResult is a committed transaction which emits the given typed events.
+type testTxResult struct {
+	height      int64
+	index       uint32
+	code        uint32
+	txBz        []byte
+	typedEvents []proto.Message
+}
+
+func TestSubscribeTypedEvents(t *testing.T) {
+	var (
+		failedApp  = apptypes.Application{Address: sample.AccAddress()}
+		stakedApp  = apptypes.Application{Address: sample.AccAddress()}
+		upstakeApp = apptypes.Application{Address: sample.AccAddress()}
+
+		failedStakeEvent = &apptypes.EventApplicationStaked{Application: &failedApp, SessionEndHeight: 10}
+		stakeEvent       = &apptypes.EventApplicationStaked{Application: &stakedApp, SessionEndHeight: 10}
+		upstakeEvent     = &apptypes.EventApplicationStaked{Application: &upstakeApp, SessionEndHeight: 20}
+		transferEvent    = &apptypes.EventTransferBegin{
+			SourceAddress:      stakedApp.Address,
+			DestinationAddress: upstakeApp.Address,
+			SessionEndHeight:   10,
+		}
+	)
+
+	txResults := []testTxResult{
+		// Failed transactions are skipped.
+		{height: 1, code: 1, txBz: []byte("failed_tx"), typedEvents: []proto.Message{failedStakeEvent}},
+		{height: 2, txBz: []byte("stake_and_transfer_tx"), typedEvents: []proto.Message{stakeEvent, transferEvent}},
+		{height: 3, txBz: []byte("upstake_tx"), typedEvents: []proto.Message{upstakeEvent}},
+	}
+	stakeAndTransferTxHash := encoding.TxHashBytesToNormalizedHex(comettypes.Tx(txResults[1].txBz).Hash())
+	upstakeTxHash := encoding.TxHashBytesToNormalizedHex(comettypes.Tx(txResults[2].txBz).Hash())
+
+	tests := []struct {
+		desc                string
+		opts                []events.TypedEventsOption
+		expectedTypedEvents []*events.TypedEvent[proto.Message]
+	}{
+		{
+			desc: "single event type",
+			opts: []events.TypedEventsOption{
+				events.WithEventTypes(&apptypes.EventApplicationStaked{}),
+			},
+			expectedTypedEvents: []*events.TypedEvent[proto.Message]{
+				{Event: stakeEvent, Height: 2, TxHash: stakeAndTransferTxHash},
+				{Event: upstakeEvent, Height: 3, TxHash: upstakeTxHash},
+			},
+		},
+		{
+			desc: "multiple event types",
+			opts: []events.TypedEventsOption{
+				events.WithEventTypes(&apptypes.EventApplicationStaked{}, &apptypes.EventTransferBegin{}),
+			},
+			expectedTypedEvents: []*events.TypedEvent[proto.Message]{
+				{Event: stakeEvent, Height: 2, TxHash: stakeAndTransferTxHash},
+				{Event: transferEvent, Height: 2, TxHash: stakeAndTransferTxHash},
+				{Event: upstakeEvent, Height: 3, TxHash: upstakeTxHash},
+			},
+		},
+		{
+			desc: "string attribute filter",
+			opts: []events.TypedEventsOption{
+				events.WithEventTypes(&apptypes.EventApplicationStaked{}, &apptypes.EventTransferBegin{}),
+				events.WithEventAttribute("source_address", stakedApp.Address),
+			},
+			expectedTypedEvents: []*events.TypedEvent[proto.Message]{
+				{Event: transferEvent, Height: 2, TxHash: stakeAndTransferTxHash},
+			},
+		},
+		{
+			desc: "multiple attributes filter",
+			opts: []events.TypedEventsOption{
+				events.WithEventTypes(&apptypes.EventApplicationStaked{}, &apptypes.EventTransferBegin{}),
+				events.WithEventAttribute("session_end_height", "10"),
+				events.WithEventAttribute("destination_address", upstakeApp.Address),
+			},
+			expectedTypedEvents: []*events.TypedEvent[proto.Message]{
+				{Event: transferEvent, Height: 2, TxHash: stakeAndTransferTxHash},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			t.Cleanup(cancel)
+
+			deps := newTxResultsEventsQueryClientDeps(t, txResults)
+			opts := append(test.opts, events.WithTypedEventsReplayBufferSize(len(test.expectedTypedEvents)))
+			typedEventsObs, err := events.SubscribeTypedEvents[proto.Message](ctx, deps, opts...)
+			require.NoError(t, err)
+
+			typedEventsCh := typedEventsObs.Subscribe(ctx).Ch()
+			for _, expectedTypedEvent := range test.expectedTypedEvents {
+				select {
+				case typedEvent := <-typedEventsCh:
+					require.Equal(t, expectedTypedEvent.Height, typedEvent.Height)
+					require.Equal(t, expectedTypedEvent.TxHash, typedEvent.TxHash)
+					require.True(t, proto.Equal(expectedTypedEvent.Event, typedEvent.Event))
+				case <-time.After(time.Second):
+					t.Fatal("timed out waiting for typed event")
+				}
+			}
+
+			// No other typed event is expected.
+			select {
+			case typedEvent := <-typedEventsCh:
+				t.Fatalf("unexpected typed event: %+v", typedEvent)
+			case <-time.After(50 * time.Millisecond):
+			}
+		})
+	}
+}
+
+func TestSubscribeTypedEvents_DefaultEventType(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	stakeEvent := &apptypes.EventApplicationStaked{
+		Application: &apptypes.Application{Address: sample.AccAddress()},
+	}
+	txResults := []testTxResult{{
+		height:      1,
+		txBz:        []byte("stake_tx"),
+		typedEvents: []proto.Message{&apptypes.EventTransferBegin{}, stakeEvent},
+	}}
+
+	deps := newTxResultsEventsQueryClientDeps(t, txResults)
+	typedEventsObs, err := events.SubscribeTypedEvents[*apptypes.EventApplicationStaked](ctx, deps)
+	require.NoError(t, err)
+
+	select {
+	case typedEvent := <-typedEventsObs.Subscribe(ctx).Ch():
+		require.Equal(t, int64(1), typedEvent.Height)
+		require.True(t, proto.Equal(stakeEvent, typedEvent.Event))
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for typed event")
+	}
+}
+
+func TestSubscribeTypedEvents_UnknownEventType(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	eventsQueryClient := mockclient.NewMockEventsQueryClient(gomock.NewController(t))
+	deps := depinject.Supply(client.EventsQueryClient(eventsQueryClient))
+
+	// The event type cannot be inferred from an interface type parameter.
+	_, err := events.SubscribeTypedEvents[proto.Message](ctx, deps)
+	require.ErrorIs(t, err, events.ErrEventsTypedEventType)
+}
+
+// newTxResultsEventsQueryClientDeps returns the dependencies of a typed events
+// subscription whose events query client notifies about the given committed
+// transactions upon subscription to the committed transactions.
+func newTxResultsEventsQueryClientDeps(t *testing.T, txResults []testTxResult) depinject.Config {
+	t.Helper()
+
+	eventsQueryClient := mockclient.NewMockEventsQueryClient(gomock.NewController(t))
+	eventsQueryClient.EXPECT().
+		EventsBytes(gomock.Any(), events.CommittedTxsQuery).
+		DoAndReturn(func(ctx context.Context, query string) (client.EventsBytesObservable, error) {
+			txResultsBzObs, txResultsBzPublishCh := channel.NewObservable[either.Bytes]()
+
+			go func() {
+				// Wait a tick for the replay client to subscribe to the returned
+				// observable since the transactions results are not replayed.
+				time.Sleep(50 * time.Millisecond)
+
+				for _, txResult := range txResults {
+					txResultsBzPublishCh <- either.Success(encodeTestTxResult(t, txResult))
+				}
+			}()
+
+			return txResultsBzObs, nil
+		}).
+		Times(1)
+
+	return depinject.Supply(client.EventsQueryClient(eventsQueryClient))
+}
+
+// encodeTestTxResult returns the websocket message notifying about the given
+// committed transaction.
+func encodeTestTxResult(t *testing.T, txResult testTxResult) []byte {
+	t.Helper()
+
+	txResultEvent := &events.CometTxEvent{}
+	txResultEvent.Data.Value.TxResult.Height = txResult.height
+	txResultEvent.Data.Value.TxResult.Index = txResult.index
+	txResultEvent.Data.Value.TxResult.Tx = txResult.txBz
+	txResultEvent.Data.Value.TxResult.Result.Code = txResult.code
+	for _, typedEvent := range txResult.typedEvents {
+		event, err := cosmostypes.TypedEventToEvent(typedEvent)
+		require.NoError(t, err)
+		txResultEvent.Data.Value.TxResult.Result.Events = append(
+			txResultEvent.Data.Value.TxResult.Result.Events,
+			abci.Event(event),
+		)
+	}
+
+	txResultBz, err := json.Marshal(txResultEvent)
+	require.NoError(t, err)
+
+	rpcResponseBz, err := json.Marshal(&rpctypes.RPCResponse{Result: txResultBz})
+	require.NoError(t, err)
+
+	return rpcResponseBz
+}
@@ -0,0 +1,341 @@
+package failover
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/cometbft/cometbft/libs/bytes"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	sdkclient "github.com/cosmos/cosmos-sdk/client"
+
+	"github.com/pokt-network/poktroll/pkg/client"
+	"github.com/pokt-network/poktroll/pkg/polylog"
+)
+
+var (
+	_ sdkclient.CometRPC      = (*CometRPC)(nil)
+	_ client.BlockQueryClient = (*CometRPC)(nil)
+)
+
+// CometRPC is a CometBFT RPC client which fails over across multiple CometBFT
+// RPC endpoints.
+//
+// The requests are performed on the first healthy and up to date endpoint, and
+// retried on the next candidate ones if it is unreachable. The responses to the
+// requests targeting the latest height (e.g. Status or Block with a nil height)
+// are only accepted from endpoints which are not behind the highest known height,
+// unless none is available.
+type CometRPC struct {
+	endpoints *Endpoints
+	// clients are the CometBFT RPC clients of the endpoints, indexed by URL string.
+	clients map[string]sdkclient.CometRPC
+}
+
+// NewCometRPC returns a CometRPC client failing over across the given CometBFT
+// RPC endpoint URLs, in order of preference, and starts checking their health
+// until the context is done.
+func NewCometRPC(
+	ctx context.Context,
+	logger polylog.Logger,
+	rpcURLs []*url.URL,
+) (*CometRPC, error) {
+	endpoints, err := NewEndpoints(logger, rpcURLs)
+	if err != nil {
+		return nil, err
+	}
+
+	clients := make(map[string]sdkclient.CometRPC, len(rpcURLs))
+	for _, rpcURL := range rpcURLs {
+		rpcClient, err := sdkclient.NewClientFromNode(rpcURL.String())
+		if err != nil {
+			return nil, ErrFailoverInvalidEndpoint.Wrapf("%q: %v", rpcURL, err)
+		}
+		clients[rpcURL.String()] = rpcClient
+	}
+
+	cometRPC := newCometRPC(endpoints, clients)
+	endpoints.GoHealthCheck(ctx, DefaultHealthCheckInterval, cometRPC.checkHealth)
+
+	return cometRPC, nil
+}
+
+// newCometRPC returns a CometRPC client failing over across the given endpoints,
+// using the given clients indexed by endpoint URL string.
+func newCometRPC(endpoints *Endpoints, clients map[string]sdkclient.CometRPC) *CometRPC {
+	return &CometRPC{
+		endpoints: endpoints,
+		clients:   clients,
+	}
+}
+
+// Endpoints returns the tracked CometBFT RPC endpoints, which can be shared with
+// the clients connecting to the same nodes through other means (e.g. websockets).
+func (c *CometRPC) Endpoints() *Endpoints {
+	return c.endpoints
+}
+
+// checkHealth is a HealthCheckFn which considers the endpoints that are catching
+// up with the network as unhealthy.
+func (c *CometRPC) checkHealth(ctx context.Context, rpcURL *url.URL) (int64, error) {
+	status, err := c.clients[rpcURL.String()].Status(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	if status.SyncInfo.CatchingUp {
+		return 0, fmt.Errorf("node is catching up at height %d", status.SyncInfo.LatestBlockHeight)
+	}
+
+	return status.SyncInfo.LatestBlockHeight, nil
+}
+
+// ABCIInfo implements the rpcclient.ABCIClient interface.
+func (c *CometRPC) ABCIInfo(ctx context.Context) (*coretypes.ResultABCIInfo, error) {
+	return callWithFailover(ctx, c, classifyReadErr, nil,
+		func(rpcClient sdkclient.CometRPC) (*coretypes.ResultABCIInfo, error) {
+			return rpcClient.ABCIInfo(ctx)
+		},
+	)
+}
+
+// ABCIQuery implements the rpcclient.ABCIClient interface.
+func (c *CometRPC) ABCIQuery(
+	ctx context.Context,
+	path string,
+	data bytes.HexBytes,
+) (*coretypes.ResultABCIQuery, error) {
+	return c.ABCIQueryWithOptions(ctx, path, data, rpcclient.DefaultABCIQueryOptions)
+}
+
+// ABCIQueryWithOptions implements the rpcclient.ABCIClient interface.
+// The queries of the latest state are only accepted from up to date endpoints.
+func (c *CometRPC) ABCIQueryWithOptions(
+	ctx context.Context,
+	path string,
+	data bytes.HexBytes,
+	opts rpcclient.ABCIQueryOptions,
+) (*coretypes.ResultABCIQuery, error) {
+	var heightFn func(*coretypes.ResultABCIQuery) int64
+	if opts.Height == 0 {
+		heightFn = func(res *coretypes.ResultABCIQuery) int64 { return res.Response.Height }
+	}
+
+	return callWithFailover(ctx, c, classifyReadErr, heightFn,
+		func(rpcClient sdkclient.CometRPC) (*coretypes.ResultABCIQuery, error) {
+			return rpcClient.ABCIQueryWithOptions(ctx, path, data, opts)
+		},
+	)
+}
+
+// BroadcastTxCommit implements the rpcclient.ABCIClient interface.
+func (c *CometRPC) BroadcastTxCommit(ctx context.Context, tx cmttypes.Tx) (*coretypes.ResultBroadcastTxCommit, error) {
+	return callWithFailover(ctx, c, classifyBroadcastErr, nil,
+		func(rpcClient sdkclient.CometRPC) (*coretypes.ResultBroadcastTxCommit, error) {
+			return rpcClient.BroadcastTxCommit(ctx, tx)
+		},
+	)
+}
+
+// BroadcastTxAsync implements the rpcclient.ABCIClient interface.
+func (c *CometRPC) BroadcastTxAsync(ctx context.Context, tx cmttypes.Tx) (*coretypes.ResultBroadcastTx, error) {
+	return callWithFailover(ctx, c, classifyBroadcastErr, nil,
+		func(rpcClient sdkclient.CometRPC) (*coretypes.ResultBroadcastTx, error) {
+			return rpcClient.BroadcastTxAsync(ctx, tx)
+		},
+	)
+}
+
+// BroadcastTxSync implements the rpcclient.ABCIClient interface.
+func (c *CometRPC) BroadcastTxSync(ctx context.Context, tx cmttypes.Tx) (*coretypes.ResultBroadcastTx, error) {
+	return callWithFailover(ctx, c, classifyBroadcastErr, nil,
+		func(rpcClient sdkclient.CometRPC) (*coretypes.ResultBroadcastTx, error) {
+			return rpcClient.BroadcastTxSync(ctx, tx)
+		},
+	)
+}
+
+// Validators implements the sdkclient.CometRPC interface.
+func (c *CometRPC) Validators(
+	ctx context.Context,
+	height *int64,
+	page, perPage *int,
+) (*coretypes.ResultValidators, error) {
+	return callWithFailover(ctx, c, classifyReadErr, nil,
+		func(rpcClient sdkclient.CometRPC) (*coretypes.ResultValidators, error) {
+			return rpcClient.Validators(ctx, height, page, perPage)
+		},
+	)
+}
+
+// Status implements the sdkclient.CometRPC interface.
+// It is only accepted from up to date endpoints.
+func (c *CometRPC) Status(ctx context.Context) (*coretypes.ResultStatus, error) {
+	return callWithFailover(ctx, c, classifyReadErr,
+		func(res *coretypes.ResultStatus) int64 { return res.SyncInfo.LatestBlockHeight },
+		func(rpcClient sdkclient.CometRPC) (*coretypes.ResultStatus, error) {
+			return rpcClient.Status(ctx)
+		},
+	)
+}
+
+// Block implements the sdkclient.CometRPC and client.BlockQueryClient interfaces.
+// The latest block (i.e. nil height) is only accepted from up to date endpoints.
+func (c *CometRPC) Block(ctx context.Context, height *int64) (*coretypes.ResultBlock, error) {
+	var heightFn func(*coretypes.ResultBlock) int64
+	if height == nil {
+		heightFn = func(res *coretypes.ResultBlock) int64 { return res.Block.Height }
+	}
+
+	return callWithFailover(ctx, c, classifyReadErr, heightFn,
+		func(rpcClient sdkclient.CometRPC) (*coretypes.ResultBlock, error) {
+			return rpcClient.Block(ctx, height)
+		},
+	)
+}
+
+// BlockByHash implements the sdkclient.CometRPC interface.
+func (c *CometRPC) BlockByHash(ctx context.Context, hash []byte) (*coretypes.ResultBlock, error) {
+	return callWithFailover(ctx, c, classifyReadErr, nil,
+		func(rpcClient sdkclient.CometRPC) (*coretypes.ResultBlock, error) {
+			return rpcClient.BlockByHash(ctx, hash)
+		},
+	)
+}
+
+// BlockResults implements the sdkclient.CometRPC interface.
+// The latest block results (i.e. nil height) are only accepted from up to date
+// endpoints.
+func (c *CometRPC) BlockResults(ctx context.Context, height *int64) (*coretypes.ResultBlockResults, error) {
+	var heightFn func(*coretypes.ResultBlockResults) int64
+	if height == nil {
+		heightFn = func(res *coretypes.ResultBlockResults) int64 { return res.Height }
+	}
+
+	return callWithFailover(ctx, c, classifyReadErr, heightFn,
+		func(rpcClient sdkclient.CometRPC) (*coretypes.ResultBlockResults, error) {
+			return rpcClient.BlockResults(ctx, height)
+		},
+	)
+}
+
+// BlockchainInfo implements the sdkclient.CometRPC interface.
+func (c *CometRPC) BlockchainInfo(
+	ctx context.Context,
+	minHeight, maxHeight int64,
+) (*coretypes.ResultBlockchainInfo, error) {
+	return callWithFailover(ctx, c, classifyReadErr, nil,
+		func(rpcClient sdkclient.CometRPC) (*coretypes.ResultBlockchainInfo, error) {
+			return rpcClient.BlockchainInfo(ctx, minHeight, maxHeight)
+		},
+	)
+}
+
+// Commit implements the sdkclient.CometRPC interface.
+func (c *CometRPC) Commit(ctx context.Context, height *int64) (*coretypes.ResultCommit, error) {
+	return callWithFailover(ctx, c, classifyReadErr, nil,
+		func(rpcClient sdkclient.CometRPC) (*coretypes.ResultCommit, error) {
+			return rpcClient.Commit(ctx, height)
+		},
+	)
+}
+
+// Tx implements the sdkclient.CometRPC interface.
+func (c *CometRPC) Tx(ctx context.Context, hash []byte, prove bool) (*coretypes.ResultTx, error) {
+	return callWithFailover(ctx, c, classifyReadErr, nil,
+		func(rpcClient sdkclient.CometRPC) (*coretypes.ResultTx, error) {
+			return rpcClient.Tx(ctx, hash, prove)
+		},
+	)
+}
+
+// TxSearch implements the sdkclient.CometRPC interface.
+func (c *CometRPC) TxSearch(
+	ctx context.Context,
+	query string,
+	prove bool,
+	page, perPage *int,
+	orderBy string,
+) (*coretypes.ResultTxSearch, error) {
+	return callWithFailover(ctx, c, classifyReadErr, nil,
+		func(rpcClient sdkclient.CometRPC) (*coretypes.ResultTxSearch, error) {
+			return rpcClient.TxSearch(ctx, query, prove, page, perPage, orderBy)
+		},
+	)
+}
+
+// BlockSearch implements the sdkclient.CometRPC interface.
+func (c *CometRPC) BlockSearch(
+	ctx context.Context,
+	query string,
+	page, perPage *int,
+	orderBy string,
+) (*coretypes.ResultBlockSearch, error) {
+	return callWithFailover(ctx, c, classifyReadErr, nil,
+		func(rpcClient sdkclient.CometRPC) (*coretypes.ResultBlockSearch, error) {
+			return rpcClient.BlockSearch(ctx, query, page, perPage, orderBy)
+		},
+	)
+}
+
+// callWithFailover performs the given call on the candidate endpoints of the
+// given CometRPC client until one succeeds, classifying its errors with the given
+// errClassifierFn.
+// If heightFn is not nil, it returns the height the result was served at, which
+// is used to prefer the up to date endpoints.
+func callWithFailover[R any](
+	ctx context.Context,
+	c *CometRPC,
+	classifyErr errClassifierFn,
+	heightFn func(R) int64,
+	call func(rpcClient sdkclient.CometRPC) (R, error),
+) (R, error) {
+	var result R
+	err := c.endpoints.do(ctx,
+		func(rpcURL *url.URL) (int64, error) {
+			res, err := call(c.clients[rpcURL.String()])
+			if err != nil {
+				return 0, err
+			}
+
+			result = res
+			if heightFn == nil {
+				return 0, nil
+			}
+			return heightFn(res), nil
+		},
+		classifyErr,
+	)
+	if err != nil {
+		return *new(R), err
+	}
+
+	return result, nil
+}
+
+// classifyReadErr is the errClassifierFn of the read requests.
+// The JSON-RPC errors are returned by reachable nodes (e.g. block height not
+// available yet) and may not be returned by the others, so they are retried
+// without reporting the endpoint as unhealthy.
+func classifyReadErr(err error) (isEndpointErr, shouldRetry bool) {
+	var rpcErr *rpctypes.RPCError
+	if errors.As(err, &rpcErr) {
+		return false, true
+	}
+	return true, true
+}
+
+// classifyBroadcastErr is the errClassifierFn of the tx broadcast requests.
+// The JSON-RPC errors are returned by reachable nodes which rejected the tx,
+// which the other nodes would also reject, so they are not retried.
+func classifyBroadcastErr(err error) (isEndpointErr, shouldRetry bool) {
+	var rpcErr *rpctypes.RPCError
+	if errors.As(err, &rpcErr) {
+		return false, false
+	}
+	return true, true
+}
@@ -0,0 +1,343 @@
+package failover
+
+import (
+	"context"
+	"net/url"
+	"slices"
+	"sync"
+	"time"
+
+	"go.uber.org/multierr"
+
+	"github.com/pokt-network/poktroll/pkg/polylog"
+)
+
+// DefaultHealthCheckInterval is the interval at which the health and latest
+// block height of the endpoints are checked.
+const DefaultHealthCheckInterval = 5 * time.Second
+
+// HealthCheckFn checks the health of the given endpoint and returns the latest
+// block height it has committed, or an error if it is unhealthy.
+type HealthCheckFn func(ctx context.Context, endpointURL *url.URL) (height int64, err error)
+
+// attemptFn performs a request on the given endpoint and returns the block height
+// the request was served at, or 0 if unknown or irrelevant to the request.
+type attemptFn func(endpointURL *url.URL) (height int64, err error)
+
+// errClassifierFn classifies the error returned by a request attempt.
+//   - isEndpointErr is true if the error is caused by the endpoint itself
+//     (e.g. unreachable), in which case the endpoint is reported as unhealthy.
+//   - shouldRetry is true if the request may succeed on another endpoint.
+type errClassifierFn func(err error) (isEndpointErr, shouldRetry bool)
+
+// endpoint holds the tracked state of a single endpoint.
+type endpoint struct {
+	url *url.URL
+	// height is the latest block height the endpoint is known to have committed,
+	// or 0 if unknown.
+	height int64
+	// healthy is false from the moment a request or health check fails, until
+	// one succeeds.
+	healthy bool
+}
+
+// endpointRank is the rank of an endpoint in the candidates order, the lower
+// the preferred.
+type endpointRank int
+
+const (
+	// rankUpToDate is the rank of the healthy endpoints which are not behind
+	// the highest known height.
+	rankUpToDate endpointRank = iota
+	// rankLagging is the rank of the healthy endpoints which are behind the
+	// highest known height.
+	rankLagging
+	// rankUnhealthy is the rank of the unhealthy endpoints, which are only tried
+	// as a last resort.
+	rankUnhealthy
+)
+
+// Endpoints tracks the health and the latest block height of an ordered list of
+// endpoints of the same kind (e.g. CometBFT RPC or gRPC), which are expected to
+// serve the same chain.
+//
+// The endpoints are tried in the following order:
+//   - The healthy endpoints which are up to date, in the configured order.
+//   - The healthy endpoints which are behind the highest known height, the
+//     most recent first.
+//   - The unhealthy endpoints, in the configured order.
+type Endpoints struct {
+	logger polylog.Logger
+
+	// endpointsMu protects the state of the endpoints and the highest height.
+	endpointsMu sync.RWMutex
+	// endpoints are the tracked endpoints in the configured order.
+	endpoints []*endpoint
+	// endpointsByURL indexes the endpoints by their URL string.
+	endpointsByURL map[string]*endpoint
+	// highestHeight is the highest block height reported by any of the endpoints.
+	highestHeight int64
+}
+
+// NewEndpoints returns Endpoints tracking the given endpoint URLs, whose order
+// is the order of preference when they are equally healthy and up to date.
+// All the endpoints are considered healthy until a request or health check fails.
+func NewEndpoints(logger polylog.Logger, endpointURLs []*url.URL) (*Endpoints, error) {
+	if len(endpointURLs) == 0 {
+		return nil, ErrFailoverNoEndpoints
+	}
+
+	e := &Endpoints{
+		logger:         logger,
+		endpoints:      make([]*endpoint, 0, len(endpointURLs)),
+		endpointsByURL: make(map[string]*endpoint, len(endpointURLs)),
+	}
+
+	for _, endpointURL := range endpointURLs {
+		if endpointURL == nil {
+			return nil, ErrFailoverInvalidEndpoint.Wrap("nil endpoint URL")
+		}
+
+		if _, ok := e.endpointsByURL[endpointURL.String()]; ok {
+			return nil, ErrFailoverInvalidEndpoint.Wrapf("duplicate endpoint %q", endpointURL)
+		}
+
+		ep := &endpoint{url: endpointURL, healthy: true}
+		e.endpoints = append(e.endpoints, ep)
+		e.endpointsByURL[endpointURL.String()] = ep
+	}
+
+	return e, nil
+}
+
+// URLs returns the URLs of the endpoints in the configured order.
+func (e *Endpoints) URLs() []*url.URL {
+	endpointURLs := make([]*url.URL, 0, len(e.endpoints))
+	for _, ep := range e.endpoints {
+		endpointURLs = append(endpointURLs, ep.url)
+	}
+	return endpointURLs
+}
+
+// Candidates returns the URLs of the endpoints in the order they should be tried.
+func (e *Endpoints) Candidates() []*url.URL {
+	candidates, _ := e.rankedCandidates()
+	return candidates
+}
+
+// HighestHeight returns the highest block height reported by any of the endpoints.
+func (e *Endpoints) HighestHeight() int64 {
+	e.endpointsMu.RLock()
+	defer e.endpointsMu.RUnlock()
+
+	return e.highestHeight
+}
+
+// ReportSuccess marks the given endpoint as healthy and records the given block
+// height as its latest one if it is more recent than the known one.
+// A zero height is ignored.
+func (e *Endpoints) ReportSuccess(endpointURL *url.URL, height int64) {
+	e.endpointsMu.Lock()
+	defer e.endpointsMu.Unlock()
+
+	ep, ok := e.endpointsByURL[endpointURL.String()]
+	if !ok {
+		return
+	}
+
+	if !ep.healthy {
+		e.logger.Info().
+			Str("endpoint", ep.url.Host).
+			Msg("endpoint is healthy again")
+	}
+	ep.healthy = true
+
+	if height > ep.height {
+		ep.height = height
+	}
+	if height > e.highestHeight {
+		e.highestHeight = height
+	}
+}
+
+// ReportFailure marks the given endpoint as unhealthy until a request or health
+// check succeeds.
+func (e *Endpoints) ReportFailure(endpointURL *url.URL, err error) {
+	e.endpointsMu.Lock()
+	defer e.endpointsMu.Unlock()
+
+	ep, ok := e.endpointsByURL[endpointURL.String()]
+	if !ok {
+		return
+	}
+
+	if ep.healthy {
+		e.logger.Warn().
+			Err(err).
+			Str("endpoint", ep.url.Host).
+			Msg("endpoint is unhealthy, failing over to the next one")
+	}
+	ep.healthy = false
+}
+
+// GoHealthCheck starts checking the health of all the endpoints at the given
+// interval, using the given HealthCheckFn, until the context is done.
+// It is intended to be called once, with a HealthCheckFn that matches the kind
+// of the tracked endpoints.
+func (e *Endpoints) GoHealthCheck(ctx context.Context, interval time.Duration, checkHealth HealthCheckFn) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			e.checkHealth(ctx, interval, checkHealth)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// checkHealth concurrently checks the health of all the endpoints, giving each
+// check at most the given timeout to complete.
+func (e *Endpoints) checkHealth(ctx context.Context, timeout time.Duration, checkHealth HealthCheckFn) {
+	var wg sync.WaitGroup
+	for _, endpointURL := range e.URLs() {
+		wg.Add(1)
+		go func(endpointURL *url.URL) {
+			defer wg.Done()
+
+			checkCtx, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+
+			height, err := checkHealth(checkCtx, endpointURL)
+			if ctx.Err() != nil {
+				return
+			}
+			if err != nil {
+				e.ReportFailure(endpointURL, err)
+				return
+			}
+			e.ReportSuccess(endpointURL, height)
+		}(endpointURL)
+	}
+	wg.Wait()
+}
+
+// do performs a request by calling the given attemptFn on the candidate endpoints,
+// in order, until one succeeds or returns an error which should not be retried.
+//
+// A response served at a height lower than the highest known one is only accepted
+// if none of the remaining candidates is known to be up to date, or if they all
+// fail, which guarantees that failing over never goes backwards in block height
+// unless no better endpoint is available.
+//
+// If all the attempts fail, the error of the single attempt is returned as is,
+// otherwise an ErrFailoverAllEndpointsFailed combining all of them is returned.
+func (e *Endpoints) do(
+	ctx context.Context,
+	attempt attemptFn,
+	classifyErr errClassifierFn,
+) error {
+	candidates, ranks := e.rankedCandidates()
+
+	var (
+		errs             []error
+		laggingSucceeded bool
+	)
+	for i, endpointURL := range candidates {
+		height, err := attempt(endpointURL)
+		if err == nil {
+			e.ReportSuccess(endpointURL, height)
+
+			// Try the next up to date endpoint, if any, when the response is
+			// behind the height already observed on another endpoint.
+			if height > 0 && height < e.HighestHeight() &&
+				slices.Contains(ranks[i+1:], rankUpToDate) {
+				laggingSucceeded = true
+				continue
+			}
+			return nil
+		}
+
+		// Don't report the endpoint as unhealthy if the request was canceled.
+		if ctx.Err() != nil {
+			return err
+		}
+
+		isEndpointErr, shouldRetry := classifyErr(err)
+		if isEndpointErr {
+			e.ReportFailure(endpointURL, err)
+		}
+		errs = append(errs, err)
+
+		if !shouldRetry {
+			break
+		}
+	}
+
+	switch {
+	case laggingSucceeded:
+		return nil
+	case len(errs) == 1:
+		return errs[0]
+	default:
+		return ErrFailoverAllEndpointsFailed.Wrapf("%s", multierr.Combine(errs...))
+	}
+}
+
+// rankedCandidates returns the URLs of the endpoints in the order they should be
+// tried, along with their respective ranks.
+func (e *Endpoints) rankedCandidates() ([]*url.URL, []endpointRank) {
+	e.endpointsMu.RLock()
+	defer e.endpointsMu.RUnlock()
+
+	type rankedEndpoint struct {
+		url    *url.URL
+		height int64
+		rank   endpointRank
+	}
+
+	rankedEndpoints := make([]rankedEndpoint, 0, len(e.endpoints))
+	for _, ep := range e.endpoints {
+		rank := rankUpToDate
+		switch {
+		case !ep.healthy:
+			rank = rankUnhealthy
+		case ep.height < e.highestHeight:
+			rank = rankLagging
+		}
+		rankedEndpoints = append(rankedEndpoints, rankedEndpoint{
+			url:    ep.url,
+			height: ep.height,
+			rank:   rank,
+		})
+	}
+
+	// The stable sort preserves the configured order of equally ranked endpoints.
+	slices.SortStableFunc(rankedEndpoints, func(a, b rankedEndpoint) int {
+		if a.rank != b.rank {
+			return int(a.rank) - int(b.rank)
+		}
+		// Prefer the most recent lagging endpoints.
+		if a.rank == rankLagging && a.height != b.height {
+			if a.height > b.height {
+				return -1
+			}
+			return 1
+		}
+		return 0
+	})
+
+	candidates := make([]*url.URL, 0, len(rankedEndpoints))
+	ranks := make([]endpointRank, 0, len(rankedEndpoints))
+	for _, rankedEp := range rankedEndpoints {
+		candidates = append(candidates, rankedEp.url)
+		ranks = append(ranks, rankedEp.rank)
+	}
+
+	return candidates, ranks
+}
@@ -0,0 +1,223 @@
+package failover
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/poktroll/pkg/polylog/polyzero"
+)
+
+var (
+	errEndpoint = errors.New("endpoint unreachable")
+	errRequest  = errors.New("invalid request")
+	errRetry    = errors.New("not available on this endpoint")
+)
+
+// classifyTestErr is the errClassifierFn of the test errors.
+func classifyTestErr(err error) (isEndpointErr, shouldRetry bool) {
+	switch {
+	case errors.Is(err, errEndpoint):
+		return true, true
+	case errors.Is(err, errRetry):
+		return false, true
+	default:
+		return false, false
+	}
+}
+
+func TestEndpoints_Candidates(t *testing.T) {
+	tests := []struct {
+		desc string
+		// heights are the heights reported for the endpoints, 0 meaning unreported.
+		heights []int64
+		// unhealthy are the indices of the endpoints reported as unhealthy.
+		unhealthy          []int
+		expectedCandidates []int
+	}{
+		{
+			desc:               "configured order when all are healthy and up to date",
+			heights:            []int64{10, 10, 10},
+			expectedCandidates: []int{0, 1, 2},
+		},
+		{
+			desc:               "unhealthy endpoints are last",
+			heights:            []int64{10, 10, 10},
+			unhealthy:          []int{0},
+			expectedCandidates: []int{1, 2, 0},
+		},
+		{
+			desc:               "lagging endpoints are after the up to date ones, most recent first",
+			heights:            []int64{8, 9, 10},
+			expectedCandidates: []int{2, 1, 0},
+		},
+		{
+			desc:               "unreported heights are lagging",
+			heights:            []int64{0, 10, 0},
+			expectedCandidates: []int{1, 0, 2},
+		},
+		{
+			desc:               "unhealthy endpoints are after the lagging ones",
+			heights:            []int64{10, 9, 10},
+			unhealthy:          []int{0},
+			expectedCandidates: []int{2, 1, 0},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			endpointURLs := newTestEndpointURLs(len(test.heights))
+			endpoints, err := NewEndpoints(polyzero.NewLogger(), endpointURLs)
+			require.NoError(t, err)
+
+			for i, height := range test.heights {
+				endpoints.ReportSuccess(endpointURLs[i], height)
+			}
+			for _, i := range test.unhealthy {
+				endpoints.ReportFailure(endpointURLs[i], errEndpoint)
+			}
+
+			expectedCandidates := make([]*url.URL, 0, len(test.expectedCandidates))
+			for _, i := range test.expectedCandidates {
+				expectedCandidates = append(expectedCandidates, endpointURLs[i])
+			}
+			require.Equal(t, expectedCandidates, endpoints.Candidates())
+		})
+	}
+}
+
+func TestEndpoints_Do(t *testing.T) {
+	type attemptResult struct {
+		height int64
+		err    error
+	}
+
+	tests := []struct {
+		desc string
+		// initialHeights are the heights reported for the endpoints before the request.
+		initialHeights []int64
+		// results are the results of the request attempts on each endpoint.
+		results           []attemptResult
+		expectedAttempts  []int
+		expectedErr       error
+		expectedUnhealthy []int
+	}{
+		{
+			desc:             "first endpoint succeeds",
+			initialHeights:   []int64{10, 10, 10},
+			results:          []attemptResult{{height: 10}, {height: 10}, {height: 10}},
+			expectedAttempts: []int{0},
+		},
+		{
+			desc:              "fails over to the next endpoint when unreachable",
+			initialHeights:    []int64{10, 10, 10},
+			results:           []attemptResult{{err: errEndpoint}, {height: 10}, {height: 10}},
+			expectedAttempts:  []int{0, 1},
+			expectedUnhealthy: []int{0},
+		},
+		{
+			desc:             "retries the request on the next endpoint without reporting it as unhealthy",
+			initialHeights:   []int64{10, 10, 10},
+			results:          []attemptResult{{err: errRetry}, {height: 10}, {height: 10}},
+			expectedAttempts: []int{0, 1},
+		},
+		{
+			desc:             "does not retry the invalid requests",
+			initialHeights:   []int64{10, 10, 10},
+			results:          []attemptResult{{err: errRequest}, {height: 10}, {height: 10}},
+			expectedAttempts: []int{0},
+			expectedErr:      errRequest,
+		},
+		{
+			desc:             "does not go backwards in height when an up to date endpoint is available",
+			initialHeights:   []int64{10, 10, 10},
+			results:          []attemptResult{{height: 9}, {height: 10}, {height: 10}},
+			expectedAttempts: []int{0, 1},
+		},
+		{
+			desc:             "accepts a lagging response when no up to date endpoint is available",
+			initialHeights:   []int64{10, 9, 9},
+			results:          []attemptResult{{height: 8}, {height: 9}, {height: 9}},
+			expectedAttempts: []int{0},
+		},
+		{
+			desc:              "accepts a lagging response when the up to date endpoints fail",
+			initialHeights:    []int64{10, 10, 10},
+			results:           []attemptResult{{height: 9}, {err: errEndpoint}, {err: errEndpoint}},
+			expectedAttempts:  []int{0, 1, 2},
+			expectedUnhealthy: []int{1, 2},
+		},
+		{
+			desc:              "fails when all the endpoints fail",
+			initialHeights:    []int64{10, 10, 10},
+			results:           []attemptResult{{err: errEndpoint}, {err: errEndpoint}, {err: errEndpoint}},
+			expectedAttempts:  []int{0, 1, 2},
+			expectedErr:       ErrFailoverAllEndpointsFailed,
+			expectedUnhealthy: []int{0, 1, 2},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			endpointURLs := newTestEndpointURLs(len(test.initialHeights))
+			endpoints, err := NewEndpoints(polyzero.NewLogger(), endpointURLs)
+			require.NoError(t, err)
+
+			endpointIndices := make(map[string]int, len(endpointURLs))
+			for i, endpointURL := range endpointURLs {
+				endpointIndices[endpointURL.String()] = i
+				endpoints.ReportSuccess(endpointURL, test.initialHeights[i])
+			}
+
+			var attempts []int
+			err = endpoints.do(context.Background(),
+				func(endpointURL *url.URL) (int64, error) {
+					i := endpointIndices[endpointURL.String()]
+					attempts = append(attempts, i)
+					return test.results[i].height, test.results[i].err
+				},
+				classifyTestErr,
+			)
+
+			if test.expectedErr != nil {
+				require.ErrorIs(t, err, test.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, test.expectedAttempts, attempts)
+
+			candidates, ranks := endpoints.rankedCandidates()
+			var unhealthy []int
+			for i, rank := range ranks {
+				if rank == rankUnhealthy {
+					unhealthy = append(unhealthy, endpointIndices[candidates[i].String()])
+				}
+			}
+			require.Equal(t, test.expectedUnhealthy, unhealthy)
+		})
+	}
+}
+
+func TestNewEndpoints_Invalid(t *testing.T) {
+	_, err := NewEndpoints(polyzero.NewLogger(), nil)
+	require.ErrorIs(t, err, ErrFailoverNoEndpoints)
+
+	endpointURL := &url.URL{Scheme: "tcp", Host: "node1:26657"}
+	_, err = NewEndpoints(polyzero.NewLogger(), []*url.URL{endpointURL, endpointURL})
+	require.ErrorIs(t, err, ErrFailoverInvalidEndpoint)
+}
+
+// newTestEndpointURLs returns n distinct endpoint URLs.
+func newTestEndpointURLs(n int) []*url.URL {
+	endpointURLs := make([]*url.URL, 0, n)
+	for i := range n {
+		endpointURLs = append(endpointURLs, &url.URL{
+			Scheme: "tcp",
+			Host:   "node" + string(rune('a'+i)) + ":26657",
+		})
+	}
+	return endpointURLs
+}
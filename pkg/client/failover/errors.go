@@ -0,0 +1,11 @@
+package failover
+
+import sdkerrors "cosmossdk.io/errors"
+
+var (
+	codespace = "failover"
+
+	ErrFailoverNoEndpoints        = sdkerrors.Register(codespace, 1, "no endpoints configured")
+	ErrFailoverInvalidEndpoint    = sdkerrors.Register(codespace, 2, "invalid endpoint")
+	ErrFailoverAllEndpointsFailed = sdkerrors.Register(codespace, 3, "all endpoints failed")
+)
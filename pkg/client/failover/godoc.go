@@ -0,0 +1,8 @@
+// Package failover provides clients which spread the requests to the pocket
+// nodes over an ordered list of endpoints, failing over to the next one when
+// an endpoint is unreachable or lagging behind.
+//
+// The health and latest block height of each endpoint are tracked by Endpoints,
+// which prefers the endpoints that are both healthy and up to date, so that
+// failing over never makes the clients go backwards in block height.
+package failover
@@ -0,0 +1,183 @@
+package failover
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"reflect"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client/grpc/cmtservice"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/pokt-network/poktroll/pkg/polylog"
+)
+
+var _ gogogrpc.ClientConn = (*grpcClientConn)(nil)
+
+// grpcClientConn is a gRPC client connection which fails over across multiple
+// gRPC endpoints.
+//
+// The queries are performed on the first healthy and up to date endpoint, and
+// retried on the next candidate ones if it is unavailable. The responses to the
+// queries of the latest state are only accepted from endpoints which are not
+// behind the highest known height, unless none is available.
+type grpcClientConn struct {
+	endpoints *Endpoints
+	// conns are the gRPC connections of the endpoints, indexed by URL string.
+	conns map[string]gogogrpc.ClientConn
+}
+
+// NewGRPCClientConn returns a gRPC client connection failing over across the
+// given gRPC endpoint URLs, in order of preference, using their respective given
+// connections. It starts checking the health of the endpoints until the context
+// is done.
+func NewGRPCClientConn(
+	ctx context.Context,
+	logger polylog.Logger,
+	grpcURLs []*url.URL,
+	conns []gogogrpc.ClientConn,
+) (gogogrpc.ClientConn, error) {
+	if len(grpcURLs) != len(conns) {
+		return nil, ErrFailoverInvalidEndpoint.Wrapf(
+			"got %d gRPC connections for %d endpoints",
+			len(conns), len(grpcURLs),
+		)
+	}
+
+	endpoints, err := NewEndpoints(logger, grpcURLs)
+	if err != nil {
+		return nil, err
+	}
+
+	conn := newGRPCClientConn(endpoints, grpcURLs, conns)
+	endpoints.GoHealthCheck(ctx, DefaultHealthCheckInterval, conn.checkHealth)
+
+	return conn, nil
+}
+
+// newGRPCClientConn returns a gRPC client connection failing over across the
+// given endpoints, using the connections aligned with the given URLs.
+func newGRPCClientConn(
+	endpoints *Endpoints,
+	grpcURLs []*url.URL,
+	conns []gogogrpc.ClientConn,
+) *grpcClientConn {
+	connsByURL := make(map[string]gogogrpc.ClientConn, len(conns))
+	for i, grpcURL := range grpcURLs {
+		connsByURL[grpcURL.String()] = conns[i]
+	}
+
+	return &grpcClientConn{
+		endpoints: endpoints,
+		conns:     connsByURL,
+	}
+}
+
+// checkHealth is a HealthCheckFn which queries the latest block of the endpoint.
+func (conn *grpcClientConn) checkHealth(ctx context.Context, grpcURL *url.URL) (int64, error) {
+	cmtServiceClient := cmtservice.NewServiceClient(conn.conns[grpcURL.String()])
+	res, err := cmtServiceClient.GetLatestBlock(ctx, &cmtservice.GetLatestBlockRequest{})
+	if err != nil {
+		return 0, err
+	}
+
+	if res.SdkBlock == nil {
+		return 0, fmt.Errorf("latest block response has no block")
+	}
+
+	return res.SdkBlock.Header.Height, nil
+}
+
+// Invoke performs the query of the given method on the candidate endpoints until
+// one succeeds.
+// Unless the query targets a specific height, the height its response was served
+// at is used to prefer the up to date endpoints.
+func (conn *grpcClientConn) Invoke(
+	ctx context.Context,
+	method string,
+	args, reply any,
+	opts ...grpc.CallOption,
+) error {
+	md, _ := metadata.FromOutgoingContext(ctx)
+	isLatestHeightQuery := len(md.Get(grpctypes.GRPCBlockHeightHeader)) == 0
+
+	return conn.endpoints.do(ctx,
+		func(grpcURL *url.URL) (int64, error) {
+			var header metadata.MD
+			callOpts := append(append([]grpc.CallOption{}, opts...), grpc.Header(&header))
+
+			// Unmarshal the response into a new reply, since the generated
+			// Unmarshal methods merge into the existing fields, and only keep it
+			// if the query succeeds, since a previous lagging response may be
+			// accepted if this attempt fails.
+			attemptReply := reflect.New(reflect.TypeOf(reply).Elem())
+			if err := conn.conns[grpcURL.String()].Invoke(ctx, method, args, attemptReply.Interface(), callOpts...); err != nil {
+				return 0, err
+			}
+			reflect.ValueOf(reply).Elem().Set(attemptReply.Elem())
+
+			if !isLatestHeightQuery {
+				return 0, nil
+			}
+			return blockHeightFromHeader(header), nil
+		},
+		classifyGRPCErr,
+	)
+}
+
+// NewStream opens a stream on the first candidate endpoint which succeeds to.
+// The stream itself does not fail over.
+func (conn *grpcClientConn) NewStream(
+	ctx context.Context,
+	desc *grpc.StreamDesc,
+	method string,
+	opts ...grpc.CallOption,
+) (grpc.ClientStream, error) {
+	var stream grpc.ClientStream
+	err := conn.endpoints.do(ctx,
+		func(grpcURL *url.URL) (height int64, err error) {
+			stream, err = conn.conns[grpcURL.String()].NewStream(ctx, desc, method, opts...)
+			return 0, err
+		},
+		classifyGRPCErr,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return stream, nil
+}
+
+// blockHeightFromHeader returns the block height a query was served at, as set
+// in its response header by the cosmos-sdk gRPC server, or 0 if not set.
+func blockHeightFromHeader(header metadata.MD) int64 {
+	heights := header.Get(grpctypes.GRPCBlockHeightHeader)
+	if len(heights) == 0 {
+		return 0
+	}
+
+	height, err := strconv.ParseInt(heights[0], 10, 64)
+	if err != nil {
+		return 0
+	}
+
+	return height
+}
+
+// classifyGRPCErr is the errClassifierFn of the gRPC queries.
+// Only the errors indicating that the endpoint is unavailable are retried,
+// the others being returned by reachable nodes which processed the query.
+func classifyGRPCErr(err error) (isEndpointErr, shouldRetry bool) {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded:
+		return true, true
+	default:
+		return false, false
+	}
+}
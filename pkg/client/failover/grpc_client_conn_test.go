@@ -0,0 +1,169 @@
+package failover
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"testing"
+
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/pokt-network/poktroll/pkg/polylog/polyzero"
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
+	suppliertypes "github.com/pokt-network/poktroll/x/supplier/types"
+)
+
+const testSuppliersMethod = "/pocket.supplier.Query/AllSuppliers"
+
+// testGRPCConn is a gogogrpc.ClientConn replying with the given suppliers at
+// the given height, or failing with the given error.
+type testGRPCConn struct {
+	gogogrpc.ClientConn
+
+	height    int64
+	suppliers []sharedtypes.Supplier
+	err       error
+}
+
+func (conn *testGRPCConn) Invoke(
+	_ context.Context,
+	_ string,
+	_, reply any,
+	opts ...grpc.CallOption,
+) error {
+	if conn.err != nil {
+		return conn.err
+	}
+
+	for _, opt := range opts {
+		if headerOpt, ok := opt.(grpc.HeaderCallOption); ok {
+			*headerOpt.HeaderAddr = metadata.Pairs(
+				grpctypes.GRPCBlockHeightHeader,
+				strconv.FormatInt(conn.height, 10),
+			)
+		}
+	}
+
+	// Append to the reply, like the generated Unmarshal methods do.
+	res := reply.(*suppliertypes.QueryAllSuppliersResponse)
+	res.Supplier = append(res.Supplier, conn.suppliers...)
+	return nil
+}
+
+func TestGRPCClientConn_Invoke(t *testing.T) {
+	unavailableErr := status.Error(codes.Unavailable, "connection refused")
+	notFoundErr := status.Error(codes.NotFound, "not found")
+
+	tests := []struct {
+		desc              string
+		conns             []*testGRPCConn
+		queryHeight       int64
+		expectedSuppliers []string
+		expectedErr       error
+	}{
+		{
+			desc: "replies from the first endpoint",
+			conns: []*testGRPCConn{
+				{height: 10, suppliers: []sharedtypes.Supplier{{OperatorAddress: "a"}}},
+				{height: 10, suppliers: []sharedtypes.Supplier{{OperatorAddress: "b"}}},
+			},
+			expectedSuppliers: []string{"a"},
+		},
+		{
+			desc: "fails over when the first endpoint is unavailable",
+			conns: []*testGRPCConn{
+				{err: unavailableErr},
+				{height: 10, suppliers: []sharedtypes.Supplier{{OperatorAddress: "b"}}},
+			},
+			expectedSuppliers: []string{"b"},
+		},
+		{
+			desc: "does not fail over on query errors",
+			conns: []*testGRPCConn{
+				{err: notFoundErr},
+				{height: 10, suppliers: []sharedtypes.Supplier{{OperatorAddress: "b"}}},
+			},
+			expectedErr: notFoundErr,
+		},
+		{
+			desc: "replaces a lagging reply by an up to date one",
+			conns: []*testGRPCConn{
+				{height: 9, suppliers: []sharedtypes.Supplier{{OperatorAddress: "a"}}},
+				{height: 10, suppliers: []sharedtypes.Supplier{{OperatorAddress: "b"}}},
+			},
+			expectedSuppliers: []string{"b"},
+		},
+		{
+			desc: "keeps a lagging reply when the up to date endpoint is unavailable",
+			conns: []*testGRPCConn{
+				{height: 9, suppliers: []sharedtypes.Supplier{{OperatorAddress: "a"}}},
+				{err: unavailableErr},
+			},
+			expectedSuppliers: []string{"a"},
+		},
+		{
+			desc: "ignores the heights of the queries at a specific height",
+			conns: []*testGRPCConn{
+				{height: 5, suppliers: []sharedtypes.Supplier{{OperatorAddress: "a"}}},
+				{height: 10, suppliers: []sharedtypes.Supplier{{OperatorAddress: "b"}}},
+			},
+			queryHeight:       5,
+			expectedSuppliers: []string{"a"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			grpcURLs := newTestEndpointURLs(len(test.conns))
+			endpoints, err := NewEndpoints(polyzero.NewLogger(), grpcURLs)
+			require.NoError(t, err)
+
+			// All the endpoints are known to be up to date at height 10.
+			conns := make([]gogogrpc.ClientConn, 0, len(test.conns))
+			for i, conn := range test.conns {
+				endpoints.ReportSuccess(grpcURLs[i], 10)
+				conns = append(conns, conn)
+			}
+
+			ctx := context.Background()
+			if test.queryHeight > 0 {
+				ctx = metadata.AppendToOutgoingContext(
+					ctx,
+					grpctypes.GRPCBlockHeightHeader,
+					strconv.FormatInt(test.queryHeight, 10),
+				)
+			}
+
+			conn := newGRPCClientConn(endpoints, grpcURLs, conns)
+			res := &suppliertypes.QueryAllSuppliersResponse{}
+			err = conn.Invoke(ctx, testSuppliersMethod, &suppliertypes.QueryAllSuppliersRequest{}, res)
+			if test.expectedErr != nil {
+				require.ErrorIs(t, err, test.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+
+			suppliers := make([]string, 0, len(res.Supplier))
+			for _, supplier := range res.Supplier {
+				suppliers = append(suppliers, supplier.OperatorAddress)
+			}
+			require.Equal(t, test.expectedSuppliers, suppliers)
+		})
+	}
+}
+
+func TestGRPCClientConn_MismatchedConns(t *testing.T) {
+	_, err := NewGRPCClientConn(
+		context.Background(),
+		polyzero.NewLogger(),
+		[]*url.URL{{Scheme: "tcp", Host: "node1:9090"}},
+		nil,
+	)
+	require.ErrorIs(t, err, ErrFailoverInvalidEndpoint)
+}
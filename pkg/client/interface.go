@@ -99,6 +99,35 @@ type TxClient interface {
 		ctx context.Context,
 		msgs ...cosmostypes.Msg,
 	) (txResponse *cosmostypes.TxResponse, eitherErr either.AsyncError)
+
+	// SignAndBroadcastWithFeeMultiplier is the same as SignAndBroadcastWithTimeoutHeight
+	// except that the transaction fee is multiplied by feeMultiplier, which MUST
+	// be greater than or equal to 1. The resulting gas prices never exceed the
+	// max gas prices of the client, if any.
+	SignAndBroadcastWithFeeMultiplier(
+		ctx context.Context,
+		timeoutHeight int64,
+		feeMultiplier float64,
+		msgs ...cosmostypes.Msg,
+	) (txResponse *cosmostypes.TxResponse, eitherErr either.AsyncError)
+
+	// PendingTxs returns the transactions which were broadcast but are neither
+	// committed nor timed out yet, ordered by account sequence.
+	PendingTxs() []PendingTx
+}
+
+// PendingTx is a transaction which was broadcast by a TxClient and is waiting
+// to be committed.
+type PendingTx struct {
+	// TxHash is the normalized hex hash of the transaction.
+	TxHash string
+	// Sequence is the account sequence the transaction was signed with.
+	Sequence uint64
+	// TimeoutHeight is the height after which the transaction can no longer be committed.
+	TimeoutHeight int64
+	// LastBroadcastHeight is the height at which the transaction was last (re)broadcast.
+	// It is 0 until a block is observed after the transaction was first broadcast.
+	LastBroadcastHeight int64
 }
 
 // TxContext provides an interface which consolidates the operational dependencies
@@ -126,6 +155,19 @@ type TxContext interface {
 		offline, overwriteSig bool,
 	) error
 
+	// SignTxWithSequence signs a transaction using the specified key name with the
+	// given account number and sequence instead of querying them from the network.
+	// Any existing signature is overwritten.
+	SignTxWithSequence(
+		keyName string,
+		txBuilder cosmosclient.TxBuilder,
+		accountNumber, sequence uint64,
+	) error
+
+	// GetAccountNumberSequence returns the account number and sequence of the
+	// given address as of the latest committed block.
+	GetAccountNumberSequence(address cosmostypes.AccAddress) (accountNumber, sequence uint64, err error)
+
 	// EncodeTx takes a transaction builder and encodes it, returning its byte representation.
 	EncodeTx(txBuilder cosmosclient.TxBuilder) ([]byte, error)
 
@@ -133,7 +175,8 @@ type TxContext interface {
 	BroadcastTx(txBytes []byte) (*cosmostypes.TxResponse, error)
 
 	// QueryTx retrieves a transaction status based on its hash and optionally provides
-	// proof of the transaction.
+	// proof of the transaction. It returns a tx.ErrTxNotFound error if the transaction
+	// is not indexed, i.e. was never committed.
 	QueryTx(
 		ctx context.Context,
 		txHash []byte,
@@ -143,10 +186,12 @@ type TxContext interface {
 	// GetClientCtx returns the cosmos-sdk client context associated with the transaction context.
 	GetClientCtx() cosmosclient.Context
 
-	// GetSimulatedTxGas returns the estimated gas for the given messages.
+	// GetSimulatedTxGas returns the estimated gas for the given messages when
+	// signed with the given account sequence.
 	GetSimulatedTxGas(
 		ctx context.Context,
 		signingKeyName string,
+		sequence uint64,
 		msgs ...cosmostypes.Msg,
 	) (uint64, error)
 }
@@ -246,6 +291,9 @@ type EventsQueryClientOption func(EventsQueryClient)
 // TxClientOption defines a function type that modifies the TxClient.
 type TxClientOption func(TxClient)
 
+// TxContextOption defines a function type that modifies the TxContext.
+type TxContextOption func(TxContext)
+
 // SupplierClientOption defines a function type that modifies the SupplierClient.
 type SupplierClientOption func(SupplierClient)
 
@@ -334,6 +382,9 @@ type SharedQueryClient interface {
 // latest block is returned.
 type BlockQueryClient interface {
 	Block(ctx context.Context, height *int64) (*cometrpctypes.ResultBlock, error)
+	// BlockResults returns the results (e.g. transactions results, finalize
+	// block events) of the block at the given height.
+	BlockResults(ctx context.Context, height *int64) (*cometrpctypes.ResultBlockResults, error)
 }
 
 // ProofParams is a go interface type reflecting the pocket.proof.Params protobuf.
@@ -0,0 +1,125 @@
+package cache
+
+import (
+	"context"
+
+	"cosmossdk.io/depinject"
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"github.com/pokt-network/poktroll/pkg/client"
+	"github.com/pokt-network/poktroll/pkg/client/block"
+	"github.com/pokt-network/poktroll/pkg/client/events"
+	"github.com/pokt-network/poktroll/pkg/observable/channel"
+)
+
+// eventModeAttrKey is the key of the attribute which indicates the stage
+// (e.g. BeginBlock, EndBlock) at which a finalize block event was emitted.
+const eventModeAttrKey = "mode"
+
+// committedBlockEvents are the events emitted while finalizing a committed block,
+// outside of its transactions (e.g. governance proposals executed by the end
+// blocker or upgrade handlers run by the pre blocker).
+type committedBlockEvents struct {
+	height int64
+	events []abci.Event
+}
+
+// forEachCommittedBlockEvents calls fn with the events emitted while finalizing
+// every committed block, outside of its transactions.
+// The subscription is re-established whenever it errors or its connection is
+// interrupted, and the events of the blocks committed in the meantime are
+// backfilled such that the caches do not go stale after a disconnection.
+//
+// Required dependencies:
+//   - client.EventsQueryClient
+//   - client.BlockQueryClient
+func forEachCommittedBlockEvents(
+	ctx context.Context,
+	deps depinject.Config,
+	fn func(ctx context.Context, blockEvents []abci.Event),
+) error {
+	var blockQueryClient client.BlockQueryClient
+	if err := depinject.Inject(deps, &blockQueryClient); err != nil {
+		return err
+	}
+
+	blockEventsReplayClient, err := events.NewEventsReplayClient(
+		ctx,
+		deps,
+		block.CommittedBlocksQuery,
+		unmarshalCommittedBlockEvents,
+		committedEventsReplayBufferSize,
+		events.WithBackfill(
+			committedBlockEventsKey,
+			newCommittedBlockEventsBackfillFn(blockQueryClient),
+		),
+	)
+	if err != nil {
+		return err
+	}
+
+	channel.ForEach(
+		ctx,
+		blockEventsReplayClient.EventsSequence(ctx),
+		func(ctx context.Context, blockEvents *committedBlockEvents) {
+			fn(ctx, blockEvents.events)
+		},
+	)
+
+	return nil
+}
+
+// unmarshalCommittedBlockEvents deserializes the given committed block event
+// message bytes into the events emitted while finalizing the block.
+// It implements events.NewEventsFn.
+func unmarshalCommittedBlockEvents(blockMsgBz []byte) (*committedBlockEvents, error) {
+	newBlockEvent, err := block.UnmarshalNewBlockEvent(blockMsgBz)
+	if err != nil {
+		return nil, err
+	}
+
+	return &committedBlockEvents{
+		height: newBlockEvent.Height(),
+		events: newBlockEvent.FinalizeBlockEvents(),
+	}, nil
+}
+
+// committedBlockEventsKey returns the key of the given events, which are the
+// only ones of the block which emitted them. It implements events.EventKeyFn.
+func committedBlockEventsKey(blockEvents *committedBlockEvents) events.EventKey {
+	return events.EventKey{Height: blockEvents.height}
+}
+
+// newCommittedBlockEventsBackfillFn returns an events.BackfillEventsFn which
+// queries, using the given blockQueryClient, the events emitted while finalizing
+// the blocks committed from fromHeight up to the latest committed block.
+func newCommittedBlockEventsBackfillFn(
+	blockQueryClient client.BlockQueryClient,
+) events.BackfillEventsFn[*committedBlockEvents] {
+	return func(ctx context.Context, fromHeight int64) ([]*committedBlockEvents, int64, error) {
+		startHeight, latestHeight, err := events.GetBackfillHeightsRange(
+			ctx,
+			blockQueryClient,
+			fromHeight,
+			events.DefaultMaxBackfillBlocks,
+		)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		blocksEvents := make([]*committedBlockEvents, 0, latestHeight-startHeight+1)
+		for height := startHeight; height <= latestHeight; height++ {
+			blockResults, err := blockQueryClient.BlockResults(ctx, &height)
+			if err != nil {
+				return nil, 0, err
+			}
+
+			blocksEvents = append(blocksEvents, &committedBlockEvents{
+				height: height,
+				events: blockResults.FinalizeBlockEvents,
+			})
+		}
+
+		return blocksEvents, latestHeight, nil
+	}
+}
@@ -7,6 +7,7 @@ import (
 
 var _ client.ParamsCache[any] = (*noOpParamsCache[any])(nil)
 var _ cache.KeyValueCache[any] = (*noOpKeyValueCache[any])(nil)
+var _ cache.HistoricalKeyValueCache[any] = (*noOpHistoricalKeyValueCache[any])(nil)
 
 // noOpParamsCache is a no-op implementation of a ParamsCache.
 // It does not store any values.
@@ -63,3 +64,35 @@ func (c *noOpKeyValueCache[T]) Delete(_ string) {
 // Clear empties the cache.
 func (c *noOpKeyValueCache[T]) Clear() {
 }
+
+// noOpHistoricalKeyValueCache is a no-op implementation of a HistoricalKeyValueCache.
+// It does not store any values.
+type noOpHistoricalKeyValueCache[T any] struct{}
+
+// NewNoOpHistoricalKeyValueCache returns a new instance of a HistoricalKeyValueCache.
+func NewNoOpHistoricalKeyValueCache[T any]() *noOpHistoricalKeyValueCache[T] {
+	return &noOpHistoricalKeyValueCache[T]{}
+}
+
+// GetLatestVersion returns the value of the latest version for the given key.
+func (c *noOpHistoricalKeyValueCache[T]) GetLatestVersion(_ string) (value T, found bool) {
+	var zeroValue T
+	return zeroValue, false
+}
+
+// GetVersion retrieves the value at the specified version number.
+func (c *noOpHistoricalKeyValueCache[T]) GetVersion(_ string, _ int64) (value T, found bool) {
+	var zeroValue T
+	return zeroValue, false
+}
+
+// GetVersionLTE retrieves the value at the nearest version <= maxVersion.
+func (c *noOpHistoricalKeyValueCache[T]) GetVersionLTE(_ string, _ int64) (value T, found bool) {
+	var zeroValue T
+	return zeroValue, false
+}
+
+// SetVersion adds or updates a value at a specific version number.
+func (c *noOpHistoricalKeyValueCache[T]) SetVersion(_ string, _ T, _ int64) error {
+	return nil
+}
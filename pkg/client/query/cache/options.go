@@ -2,11 +2,21 @@ package cache
 
 import (
 	"context"
+	"slices"
 
 	"cosmossdk.io/depinject"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cosmostypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
 
+	"github.com/pokt-network/poktroll/pkg/cache"
 	"github.com/pokt-network/poktroll/pkg/client"
+	"github.com/pokt-network/poktroll/pkg/crypto"
 	"github.com/pokt-network/poktroll/pkg/observable/channel"
+	apptypes "github.com/pokt-network/poktroll/x/application/types"
+	servicetypes "github.com/pokt-network/poktroll/x/service/types"
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
+	suppliertypes "github.com/pokt-network/poktroll/x/supplier/types"
 )
 
 // Cache is an interface that defines the common methods for a cache object.
@@ -36,3 +46,202 @@ func WithNewBlockCacheClearing[C Cache](ctx context.Context, deps depinject.Conf
 
 	return nil
 }
+
+// WithSessionBoundaryCacheClearing is a cache option that clears the cache on
+// the blocks at which the onchain state may change outside of any transaction:
+// - Session end heights (e.g. unbonding, transfers)
+// - Session start heights (e.g. supplier services activation)
+// - Claims settlement heights (e.g. stake burning and slashing, relay mining difficulty updates)
+//
+// It is intended to be combined with options which invalidate the cache upon
+// the transactions updating the cached values.
+func WithSessionBoundaryCacheClearing[C Cache](ctx context.Context, deps depinject.Config, cache C) error {
+	var (
+		blockClient       client.BlockClient
+		sharedQueryClient client.SharedQueryClient
+	)
+	if err := depinject.Inject(deps, &blockClient, &sharedQueryClient); err != nil {
+		return err
+	}
+
+	channel.ForEach(
+		ctx,
+		blockClient.CommittedBlocksSequence(ctx),
+		func(ctx context.Context, block client.Block) {
+			sharedParams, err := sharedQueryClient.GetParams(ctx)
+			// Clear the cache if the session boundaries can't be determined.
+			if err != nil || isSessionBoundaryHeight(sharedParams, block.Height()) {
+				cache.Clear()
+			}
+		},
+	)
+
+	return nil
+}
+
+// WithTxMsgsCacheClearing returns a cache option that clears the cache every time
+// a transaction containing a message of one of the given types is committed,
+// including the messages executed through an authz MsgExec.
+func WithTxMsgsCacheClearing[C Cache](msgTypeURLs ...string) CacheOption[C] {
+	return func(ctx context.Context, deps depinject.Config, cache C) error {
+		return forEachCommittedTx(ctx, deps, func(ctx context.Context, txResult *abci.TxResult) {
+			txMsgTypeURLs, err := getTxMsgTypeURLs(txResult.Tx)
+			if err != nil {
+				// Clear the cache if the transaction messages can't be inspected.
+				cache.Clear()
+				return
+			}
+
+			for _, txMsgTypeURL := range txMsgTypeURLs {
+				if slices.Contains(msgTypeURLs, txMsgTypeURL) {
+					cache.Clear()
+					return
+				}
+			}
+		})
+	}
+}
+
+// WithParamsUpdateCacheClearing returns a params cache option that clears the
+// cache every time the params of the given module are updated, which is notified
+// by the module through an EventParamsUpdated event emitted either:
+//   - By a committed transaction (i.e. MsgUpdateParam(s), possibly through an authz MsgExec)
+//   - While finalizing a block (i.e. governance proposals executed by the end blocker
+//     or upgrade handlers run by the pre blocker)
+func WithParamsUpdateCacheClearing[T any](moduleName string) CacheOption[client.ParamsCache[T]] {
+	return func(ctx context.Context, deps depinject.Config, paramsCache client.ParamsCache[T]) error {
+		clearOnParamsUpdated := func(ctx context.Context, abciEvents []abci.Event) {
+			for _, event := range getTypedEvents(abciEvents) {
+				paramsUpdatedEvent, ok := event.(*sharedtypes.EventParamsUpdated)
+				if ok && paramsUpdatedEvent.GetModuleName() == moduleName {
+					paramsCache.Clear()
+					return
+				}
+			}
+		}
+
+		if err := forEachCommittedTx(ctx, deps, func(ctx context.Context, txResult *abci.TxResult) {
+			clearOnParamsUpdated(ctx, txResult.Result.Events)
+		}); err != nil {
+			return err
+		}
+
+		return forEachCommittedBlockEvents(ctx, deps, clearOnParamsUpdated)
+	}
+}
+
+// WithServiceUpdateCacheClearing is a cache option that clears the services
+// cache every time a transaction adding or updating a service is committed.
+func WithServiceUpdateCacheClearing(
+	ctx context.Context,
+	deps depinject.Config,
+	serviceCache cache.KeyValueCache[sharedtypes.Service],
+) error {
+	return WithTxMsgsCacheClearing[cache.KeyValueCache[sharedtypes.Service]](
+		cosmostypes.MsgTypeURL(&servicetypes.MsgAddService{}),
+		cosmostypes.MsgTypeURL(&servicetypes.MsgSetServiceComputeUnitsToTokensMultiplier{}),
+		cosmostypes.MsgTypeURL(&servicetypes.MsgUpdateServiceComputeUnitsToTokensMultiplier{}),
+		cosmostypes.MsgTypeURL(&servicetypes.MsgSetServiceOwnerRevSharePercentage{}),
+	)(ctx, deps, serviceCache)
+}
+
+// WithApplicationEventsCacheInvalidation is a cache option that deletes the
+// cached applications which are updated by the committed transactions.
+// E.g. staking, (re-)delegation or unbonding.
+func WithApplicationEventsCacheInvalidation(
+	ctx context.Context,
+	deps depinject.Config,
+	appCache cache.KeyValueCache[apptypes.Application],
+) error {
+	return withTxEventsCacheInvalidation(ctx, deps, appCache.Delete, func(event proto.Message) string {
+		switch appEvent := event.(type) {
+		case *apptypes.EventApplicationStaked:
+			return appEvent.GetApplication().GetAddress()
+		case *apptypes.EventRedelegation:
+			return appEvent.GetApplication().GetAddress()
+		case *apptypes.EventApplicationUnbondingBegin:
+			return appEvent.GetApplication().GetAddress()
+		case *apptypes.EventApplicationUnbondingCanceled:
+			return appEvent.GetApplication().GetAddress()
+		case *apptypes.EventTransferBegin:
+			return appEvent.GetSourceAddress()
+		default:
+			return ""
+		}
+	})
+}
+
+// WithSupplierEventsCacheInvalidation is a cache option that deletes the
+// cached suppliers which are updated by the committed transactions.
+// E.g. staking, unbonding, updating the delegation commission or unjailing.
+func WithSupplierEventsCacheInvalidation(
+	ctx context.Context,
+	deps depinject.Config,
+	supplierCache cache.KeyValueCache[sharedtypes.Supplier],
+) error {
+	return withTxEventsCacheInvalidation(ctx, deps, supplierCache.Delete, func(event proto.Message) string {
+		switch supplierEvent := event.(type) {
+		case *suppliertypes.EventSupplierStaked:
+			return supplierEvent.GetSupplier().GetOperatorAddress()
+		case *suppliertypes.EventSupplierUnbondingBegin:
+			return supplierEvent.GetSupplier().GetOperatorAddress()
+		case *suppliertypes.EventSupplierUnbondingCanceled:
+			return supplierEvent.GetSupplier().GetOperatorAddress()
+		case *suppliertypes.EventSupplierDelegationCommissionUpdated:
+			return supplierEvent.GetOperatorAddress()
+		case *suppliertypes.EventSupplierUnjailed:
+			return supplierEvent.GetSupplier().GetOperatorAddress()
+		default:
+			return ""
+		}
+	})
+}
+
+// WithApplicationEventsRingCacheInvalidation is a ring cache option that removes
+// the cached rings of the applications whose delegations are changed by the
+// committed transactions (i.e. delegation to or undelegation from a gateway).
+func WithApplicationEventsRingCacheInvalidation(
+	ctx context.Context,
+	deps depinject.Config,
+	ringCache crypto.RingCache,
+) error {
+	return withTxEventsCacheInvalidation(ctx, deps, ringCache.InvalidateRings, func(event proto.Message) string {
+		switch appEvent := event.(type) {
+		// EventRedelegation is emitted by both the delegation and undelegation messages.
+		case *apptypes.EventRedelegation:
+			return appEvent.GetApplication().GetAddress()
+		default:
+			return ""
+		}
+	})
+}
+
+// withTxEventsCacheInvalidation calls invalidateKey with the key returned by
+// getEventKey for each typed event of the committed transactions.
+// getEventKey returns an empty key for the events which do not affect the cache.
+func withTxEventsCacheInvalidation(
+	ctx context.Context,
+	deps depinject.Config,
+	invalidateKey func(key string),
+	getEventKey func(event proto.Message) string,
+) error {
+	return forEachCommittedTx(ctx, deps, func(ctx context.Context, txResult *abci.TxResult) {
+		for _, event := range getTypedEvents(txResult.Result.Events) {
+			if key := getEventKey(event); key != "" {
+				invalidateKey(key)
+			}
+		}
+	})
+}
+
+// isSessionBoundaryHeight returns true if the given height is a session start
+// or end height, or the height at which the claims of a session are settled.
+func isSessionBoundaryHeight(sharedParams *sharedtypes.Params, height int64) bool {
+	// The claims of a session are settled in the block following its proof window close.
+	settledSessionEndHeight := height - sharedtypes.GetSessionEndToProofWindowCloseBlocks(sharedParams) - 1
+
+	return sharedtypes.IsSessionStartHeight(sharedParams, height) ||
+		sharedtypes.GetSessionEndHeight(sharedParams, height) == height ||
+		(settledSessionEndHeight > 0 &&
+			sharedtypes.GetSessionEndHeight(sharedParams, settledSessionEndHeight) == settledSessionEndHeight)
+}
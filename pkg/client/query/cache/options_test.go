@@ -0,0 +1,371 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"cosmossdk.io/depinject"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cometjson "github.com/cometbft/cometbft/libs/json"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+	comettypes "github.com/cometbft/cometbft/types"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cosmostypes "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/pokt-network/poktroll/pkg/cache/memory"
+	"github.com/pokt-network/poktroll/pkg/client"
+	"github.com/pokt-network/poktroll/pkg/client/block"
+	"github.com/pokt-network/poktroll/pkg/client/events"
+	"github.com/pokt-network/poktroll/pkg/either"
+	"github.com/pokt-network/poktroll/pkg/observable/channel"
+	"github.com/pokt-network/poktroll/testutil/mockclient"
+	"github.com/pokt-network/poktroll/testutil/sample"
+	apptypes "github.com/pokt-network/poktroll/x/application/types"
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
+)
+
+func TestGetTxMsgTypeURLs(t *testing.T) {
+	authority := sample.AccAddress()
+
+	sharedParamMsg := &sharedtypes.MsgUpdateParam{Authority: authority, Name: "num_blocks_per_session"}
+	appParamsMsg := &apptypes.MsgUpdateParams{Authority: authority}
+	authzExecMsg := authz.NewMsgExec(cosmostypes.MustAccAddressFromBech32(sample.AccAddress()), []cosmostypes.Msg{appParamsMsg})
+
+	txBz := encodeTestTx(t, sharedParamMsg, &authzExecMsg)
+
+	msgTypeURLs, err := getTxMsgTypeURLs(txBz)
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"/pocket.shared.MsgUpdateParam",
+		"/cosmos.authz.v1beta1.MsgExec",
+		"/pocket.application.MsgUpdateParams",
+	}, msgTypeURLs)
+}
+
+func TestIsSessionBoundaryHeight(t *testing.T) {
+	sharedParams := sharedtypes.DefaultParams()
+	sharedParams.NumBlocksPerSession = 10
+	sharedParams.ClaimWindowOpenOffsetBlocks = 1
+	sharedParams.ClaimWindowCloseOffsetBlocks = 4
+	sharedParams.ProofWindowOpenOffsetBlocks = 0
+	sharedParams.ProofWindowCloseOffsetBlocks = 2
+
+	tests := []struct {
+		desc               string
+		height             int64
+		isBoundaryExpected bool
+	}{
+		{desc: "session start height", height: 11, isBoundaryExpected: true},
+		{desc: "session end height", height: 20, isBoundaryExpected: true},
+		{desc: "claims settlement height", height: 18, isBoundaryExpected: true},
+		{desc: "height after session start", height: 12, isBoundaryExpected: false},
+		{desc: "height before claims settlement", height: 17, isBoundaryExpected: false},
+		{desc: "height after claims settlement", height: 19, isBoundaryExpected: false},
+		{desc: "first settlement height before any session ended", height: 8, isBoundaryExpected: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			require.Equal(t, test.isBoundaryExpected, isSessionBoundaryHeight(&sharedParams, test.height))
+		})
+	}
+}
+
+func TestWithApplicationEventsCacheInvalidation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	txResultsBzObs, txResultsBzPublishCh := channel.NewObservable[either.Bytes]()
+	eventsQueryClient := mockclient.NewMockEventsQueryClient(gomock.NewController(t))
+	eventsQueryClient.EXPECT().
+		EventsBytes(gomock.Any(), committedTxsQuery).
+		Return(client.EventsBytesObservable(txResultsBzObs), nil).
+		Times(1)
+
+	appCache, err := memory.NewKeyValueCache[apptypes.Application]()
+	require.NoError(t, err)
+
+	stakedApp := apptypes.Application{Address: sample.AccAddress()}
+	otherApp := apptypes.Application{Address: sample.AccAddress()}
+	appCache.Set(stakedApp.Address, stakedApp)
+	appCache.Set(otherApp.Address, otherApp)
+
+	blockQueryClient := mockclient.NewMockCometRPC(gomock.NewController(t))
+
+	deps := depinject.Supply(client.EventsQueryClient(eventsQueryClient), client.BlockQueryClient(blockQueryClient))
+	err = WithApplicationEventsCacheInvalidation(ctx, deps, appCache)
+	require.NoError(t, err)
+
+	// Wait a tick for the replay client to subscribe since the transactions
+	// results are not replayed.
+	time.Sleep(50 * time.Millisecond)
+
+	// A failed transaction does not invalidate the cache.
+	txResultsBzPublishCh <- either.Success(encodeTestTxResultEvent(
+		t, 1,
+		&apptypes.EventApplicationStaked{Application: &otherApp},
+	))
+	// A successful transaction invalidates the staked application only.
+	txResultsBzPublishCh <- either.Success(encodeTestTxResultEvent(
+		t, 0,
+		&apptypes.EventApplicationStaked{Application: &stakedApp},
+	))
+
+	require.Eventually(t, func() bool {
+		_, found := appCache.Get(stakedApp.Address)
+		return !found
+	}, time.Second, 10*time.Millisecond)
+
+	// The transactions are processed in order, the failed one was already ignored.
+	_, found := appCache.Get(otherApp.Address)
+	require.True(t, found)
+}
+
+// encodeTestTx returns an encoded transaction containing the given messages.
+func encodeTestTx(t *testing.T, msgs ...cosmostypes.Msg) []byte {
+	t.Helper()
+
+	msgAnys := make([]*codectypes.Any, 0, len(msgs))
+	for _, msg := range msgs {
+		msgAny, err := codectypes.NewAnyWithValue(msg)
+		require.NoError(t, err)
+		msgAnys = append(msgAnys, msgAny)
+	}
+
+	txBodyBz, err := (&txtypes.TxBody{Messages: msgAnys}).Marshal()
+	require.NoError(t, err)
+
+	txBz, err := (&txtypes.TxRaw{BodyBytes: txBodyBz}).Marshal()
+	require.NoError(t, err)
+
+	return txBz
+}
+
+// encodeTestTxResultEvent returns the websocket message notifying about a
+// committed transaction with the given result code and typed events.
+func encodeTestTxResultEvent(t *testing.T, code uint32, typedEvents ...proto.Message) []byte {
+	t.Helper()
+
+	txResultEvent := &events.CometTxEvent{}
+	// The transaction itself is irrelevant but must not be empty to be considered a tx result.
+	txResultEvent.Data.Value.TxResult.Tx = encodeTestTx(t, &apptypes.MsgStakeApplication{})
+	txResultEvent.Data.Value.TxResult.Height = 1
+	txResultEvent.Data.Value.TxResult.Result.Code = code
+	for _, typedEvent := range typedEvents {
+		event, err := cosmostypes.TypedEventToEvent(typedEvent)
+		require.NoError(t, err)
+		txResultEvent.Data.Value.TxResult.Result.Events = append(
+			txResultEvent.Data.Value.TxResult.Result.Events,
+			abci.Event(event),
+		)
+	}
+
+	txResultBz, err := json.Marshal(txResultEvent)
+	require.NoError(t, err)
+
+	rpcResponseBz, err := json.Marshal(&rpctypes.RPCResponse{Result: txResultBz})
+	require.NoError(t, err)
+
+	return rpcResponseBz
+}
+
+func TestWithApplicationEventsRingCacheInvalidation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	txResultsBzObs, txResultsBzPublishCh := channel.NewObservable[either.Bytes]()
+	eventsQueryClient := mockclient.NewMockEventsQueryClient(gomock.NewController(t))
+	eventsQueryClient.EXPECT().
+		EventsBytes(gomock.Any(), committedTxsQuery).
+		Return(client.EventsBytesObservable(txResultsBzObs), nil).
+		Times(1)
+
+	ringCache := &testRingCache{invalidatedAppAddressesCh: make(chan string, 1)}
+
+	blockQueryClient := mockclient.NewMockCometRPC(gomock.NewController(t))
+
+	deps := depinject.Supply(client.EventsQueryClient(eventsQueryClient), client.BlockQueryClient(blockQueryClient))
+	err := WithApplicationEventsRingCacheInvalidation(ctx, deps, ringCache)
+	require.NoError(t, err)
+
+	// Wait a tick for the replay client to subscribe since the transactions
+	// results are not replayed.
+	time.Sleep(50 * time.Millisecond)
+
+	stakedApp := apptypes.Application{Address: sample.AccAddress()}
+	redelegatedApp := apptypes.Application{Address: sample.AccAddress()}
+
+	// Staking does not change the application's ring.
+	txResultsBzPublishCh <- either.Success(encodeTestTxResultEvent(
+		t, 0,
+		&apptypes.EventApplicationStaked{Application: &stakedApp},
+	))
+	// (Un)delegating invalidates the application's rings.
+	txResultsBzPublishCh <- either.Success(encodeTestTxResultEvent(
+		t, 0,
+		&apptypes.EventRedelegation{Application: &redelegatedApp},
+	))
+
+	select {
+	case invalidatedAppAddress := <-ringCache.invalidatedAppAddressesCh:
+		require.Equal(t, redelegatedApp.Address, invalidatedAppAddress)
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for the ring cache invalidation")
+	}
+}
+
+// testRingCache is a crypto.RingCache which publishes the invalidated application
+// addresses on invalidatedAppAddressesCh.
+type testRingCache struct {
+	invalidatedAppAddressesCh chan string
+}
+
+func (c *testRingCache) InvalidateRings(appAddress string) {
+	c.invalidatedAppAddressesCh <- appAddress
+}
+
+func (c *testRingCache) Clear() {}
+
+func TestWithParamsUpdateCacheClearing(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	// The events emitted while finalizing the blocks committed while the blocks
+	// subscription is interrupted, which are backfilled after reconnecting.
+	missedBlocksEvents := map[int64][]abci.Event{
+		2: nil,
+		3: {encodeTestBlockEvent(t, &sharedtypes.EventParamsUpdated{ModuleName: sharedtypes.ModuleName})},
+	}
+
+	txResultsBzObs, txResultsBzPublishCh := channel.NewObservable[either.Bytes]()
+	firstBlocksBzObs, firstBlocksBzPublishCh := channel.NewObservable[either.Bytes]()
+	secondBlocksBzObs, secondBlocksBzPublishCh := channel.NewObservable[either.Bytes]()
+
+	ctrl := gomock.NewController(t)
+	eventsQueryClient := mockclient.NewMockEventsQueryClient(ctrl)
+	eventsQueryClient.EXPECT().
+		EventsBytes(gomock.Any(), committedTxsQuery).
+		Return(client.EventsBytesObservable(txResultsBzObs), nil).
+		Times(1)
+	gomock.InOrder(
+		eventsQueryClient.EXPECT().
+			EventsBytes(gomock.Any(), block.CommittedBlocksQuery).
+			Return(client.EventsBytesObservable(firstBlocksBzObs), nil),
+		eventsQueryClient.EXPECT().
+			EventsBytes(gomock.Any(), block.CommittedBlocksQuery).
+			Return(client.EventsBytesObservable(secondBlocksBzObs), nil),
+	)
+
+	blockQueryClient := mockclient.NewMockCometRPC(ctrl)
+	blockQueryClient.EXPECT().
+		Block(gomock.Any(), nil).
+		Return(&coretypes.ResultBlock{Block: &comettypes.Block{Header: comettypes.Header{Height: 3}}}, nil).
+		AnyTimes()
+	blockQueryClient.EXPECT().
+		BlockResults(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, height *int64) (*coretypes.ResultBlockResults, error) {
+			return &coretypes.ResultBlockResults{
+				Height:              *height,
+				FinalizeBlockEvents: missedBlocksEvents[*height],
+			}, nil
+		}).
+		AnyTimes()
+
+	paramsCache, err := NewParamsCache[sharedtypes.Params]()
+	require.NoError(t, err)
+
+	deps := depinject.Supply(client.EventsQueryClient(eventsQueryClient), client.BlockQueryClient(blockQueryClient))
+	err = WithParamsUpdateCacheClearing[sharedtypes.Params](sharedtypes.ModuleName)(ctx, deps, paramsCache)
+	require.NoError(t, err)
+
+	// Wait a tick for the replay clients to subscribe since the transactions
+	// results and blocks are not replayed.
+	time.Sleep(50 * time.Millisecond)
+
+	requireParamsCacheCleared := func(t *testing.T) {
+		t.Helper()
+
+		require.Eventually(t, func() bool {
+			_, found := paramsCache.Get()
+			return !found
+		}, 3*time.Second, 10*time.Millisecond)
+	}
+	requireParamsCacheNotCleared := func(t *testing.T) {
+		t.Helper()
+
+		time.Sleep(50 * time.Millisecond)
+		_, found := paramsCache.Get()
+		require.True(t, found)
+	}
+
+	// The params of another module being updated do not clear the cache.
+	paramsCache.Set(sharedtypes.DefaultParams())
+	txResultsBzPublishCh <- either.Success(encodeTestTxResultEvent(
+		t, 0,
+		&sharedtypes.EventParamsUpdated{ModuleName: apptypes.ModuleName},
+	))
+	firstBlocksBzPublishCh <- either.Success(encodeTestBlockEvents(
+		t, 1,
+		encodeTestBlockEvent(t, &sharedtypes.EventParamsUpdated{ModuleName: apptypes.ModuleName}),
+	))
+	requireParamsCacheNotCleared(t)
+
+	// A transaction updating the params clears the cache (e.g. MsgUpdateParam).
+	txResultsBzPublishCh <- either.Success(encodeTestTxResultEvent(
+		t, 0,
+		&sharedtypes.EventParamsUpdated{ModuleName: sharedtypes.ModuleName},
+	))
+	requireParamsCacheCleared(t)
+
+	// The params updated while the blocks subscription is interrupted clear the
+	// cache once it is re-established (e.g. by an upgrade handler).
+	paramsCache.Set(sharedtypes.DefaultParams())
+	firstBlocksBzObs.UnsubscribeAll()
+	requireParamsCacheCleared(t)
+
+	// The params updated while finalizing a block clear the cache (e.g. by a
+	// governance proposal executed by the end blocker).
+	paramsCache.Set(sharedtypes.DefaultParams())
+	secondBlocksBzPublishCh <- either.Success(encodeTestBlockEvents(
+		t, 4,
+		encodeTestBlockEvent(t, &sharedtypes.EventParamsUpdated{ModuleName: sharedtypes.ModuleName}),
+	))
+	requireParamsCacheCleared(t)
+}
+
+// encodeTestBlockEvent returns the given typed event as emitted by the end blocker.
+func encodeTestBlockEvent(t *testing.T, typedEvent proto.Message) abci.Event {
+	t.Helper()
+
+	event, err := cosmostypes.TypedEventToEvent(typedEvent)
+	require.NoError(t, err)
+	event.Attributes = append(event.Attributes, abci.EventAttribute{Key: eventModeAttrKey, Value: "EndBlock"})
+
+	return abci.Event(event)
+}
+
+// encodeTestBlockEvents returns the websocket message notifying about a
+// committed block at the given height, which emitted the given finalize block events.
+func encodeTestBlockEvents(t *testing.T, height int64, finalizeBlockEvents ...abci.Event) []byte {
+	t.Helper()
+
+	newBlockEvent := &block.CometNewBlockEvent{}
+	newBlockEvent.Data.Value.Block = &comettypes.Block{Header: comettypes.Header{Height: height}}
+	newBlockEvent.Data.Value.ResultFinalizeBlock.Events = finalizeBlockEvents
+
+	newBlockEventBz, err := cometjson.Marshal(newBlockEvent)
+	require.NoError(t, err)
+
+	rpcResponseBz, err := cometjson.Marshal(&rpctypes.RPCResponse{Result: newBlockEventBz})
+	require.NoError(t, err)
+
+	return rpcResponseBz
+}
@@ -0,0 +1,153 @@
+package cache
+
+import (
+	"context"
+
+	"cosmossdk.io/depinject"
+	abci "github.com/cometbft/cometbft/abci/types"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cosmostypes "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/pokt-network/poktroll/pkg/client"
+	"github.com/pokt-network/poktroll/pkg/client/events"
+	"github.com/pokt-network/poktroll/pkg/observable/channel"
+)
+
+// committedTxsQuery is the query used to subscribe to the committed transactions.
+// It is the same as the one used by the other transactions events consumers
+// (e.g. the relay meter and the tx client) so that they share the same subscription.
+const committedTxsQuery = events.CommittedTxsQuery
+
+// committedEventsReplayBufferSize is the replay buffer size of the committed
+// transactions and blocks events replay clients. The caches only observe the
+// events as they are committed, there is no need to replay past ones.
+const committedEventsReplayBufferSize = 1
+
+// authzMsgExecTypeURL is the type URL of the authz message which executes
+// messages on behalf of their signer (e.g. params updates by the authority).
+var authzMsgExecTypeURL = cosmostypes.MsgTypeURL(&authz.MsgExec{})
+
+// forEachCommittedTx calls fn with the result of every transaction which is
+// successfully committed onchain.
+// The subscription is re-established whenever it errors or its connection is
+// interrupted, and the transactions committed in the meantime are backfilled
+// such that the caches do not go stale after a disconnection.
+//
+// Required dependencies:
+//   - client.EventsQueryClient
+//   - client.BlockQueryClient
+func forEachCommittedTx(
+	ctx context.Context,
+	deps depinject.Config,
+	fn func(ctx context.Context, txResult *abci.TxResult),
+) error {
+	var blockQueryClient client.BlockQueryClient
+	if err := depinject.Inject(deps, &blockQueryClient); err != nil {
+		return err
+	}
+
+	txResultsReplayClient, err := events.NewCommittedTxsReplayClient(
+		ctx,
+		deps,
+		committedEventsReplayBufferSize,
+		events.WithTxResultsBackfill(blockQueryClient),
+	)
+	if err != nil {
+		return err
+	}
+
+	channel.ForEach(
+		ctx,
+		txResultsReplayClient.EventsSequence(ctx),
+		func(ctx context.Context, txResult *abci.TxResult) {
+			// Failed transactions do not update the onchain state.
+			if txResult.Result.IsErr() {
+				return
+			}
+
+			fn(ctx, txResult)
+		},
+	)
+
+	return nil
+}
+
+// getTypedEvents returns the typed events among the given ones.
+// The events which are not typed (e.g. message, transfer...) are skipped.
+func getTypedEvents(abciEvents []abci.Event) []proto.Message {
+	typedEvents := make([]proto.Message, 0, len(abciEvents))
+	for _, event := range abciEvents {
+		typedEvent, err := cosmostypes.ParseTypedEvent(withoutEventMode(event))
+		if err != nil {
+			continue
+		}
+
+		typedEvents = append(typedEvents, typedEvent)
+	}
+
+	return typedEvents
+}
+
+// withoutEventMode returns a copy of the given event without the "mode" attribute
+// (e.g. BeginBlock, EndBlock) which is added to the events emitted while finalizing
+// a block. Its value is not JSON encoded, which makes the typed events fail to parse.
+func withoutEventMode(event abci.Event) abci.Event {
+	eventAttrs := make([]abci.EventAttribute, 0, len(event.Attributes))
+	for _, attr := range event.Attributes {
+		if attr.Key == eventModeAttrKey {
+			continue
+		}
+		eventAttrs = append(eventAttrs, attr)
+	}
+	event.Attributes = eventAttrs
+
+	return event
+}
+
+// getTxMsgTypeURLs returns the type URLs of the messages of the given encoded
+// transaction, including the ones executed through an authz MsgExec.
+//
+// DEV_NOTE: The messages are not unpacked, which makes it possible to inspect
+// transactions containing messages whose types are not registered.
+func getTxMsgTypeURLs(txBz []byte) ([]string, error) {
+	var txRaw txtypes.TxRaw
+	if err := txRaw.Unmarshal(txBz); err != nil {
+		return nil, err
+	}
+
+	var txBody txtypes.TxBody
+	if err := txBody.Unmarshal(txRaw.BodyBytes); err != nil {
+		return nil, err
+	}
+
+	return getMsgTypeURLs(txBody.Messages)
+}
+
+// getMsgTypeURLs returns the type URLs of the given messages, recursing into
+// the ones executed through an authz MsgExec.
+func getMsgTypeURLs(msgAnys []*codectypes.Any) ([]string, error) {
+	msgTypeURLs := make([]string, 0, len(msgAnys))
+	for _, msgAny := range msgAnys {
+		msgTypeURLs = append(msgTypeURLs, msgAny.GetTypeUrl())
+
+		if msgAny.GetTypeUrl() != authzMsgExecTypeURL {
+			continue
+		}
+
+		var msgExec authz.MsgExec
+		if err := msgExec.Unmarshal(msgAny.GetValue()); err != nil {
+			return nil, err
+		}
+
+		execMsgTypeURLs, err := getMsgTypeURLs(msgExec.Msgs)
+		if err != nil {
+			return nil, err
+		}
+		msgTypeURLs = append(msgTypeURLs, execMsgTypeURLs...)
+	}
+
+	return msgTypeURLs, nil
+}
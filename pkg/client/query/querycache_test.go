@@ -500,7 +500,7 @@ func supplyCacheDeps(t *testing.T) depinject.Config {
 	balanceCache, err := memory.NewKeyValueCache[query.Balance](opts)
 	require.NoError(t, err)
 
-	blockHashCache, err := memory.NewKeyValueCache[query.BlockHash](opts)
+	blockHashCache, err := memory.NewHistoricalKeyValueCache[query.BlockHash]()
 	require.NoError(t, err)
 
 	claimsCache, err := memory.NewKeyValueCache[prooftypes.Claim](opts)
@@ -2,7 +2,6 @@ package query
 
 import (
 	"context"
-	"strconv"
 	"sync"
 
 	"cosmossdk.io/depinject"
@@ -18,6 +17,10 @@ import (
 
 var _ client.SharedQueryClient = (*sharedQuerier)(nil)
 
+// blockHashCacheKey is the key under which the block hashes are cached, with
+// their height as the version.
+const blockHashCacheKey = "block_hash"
+
 // sharedQuerier is a wrapper around the sharedtypes.QueryClient that enables the
 // querying of onchain shared information through a single exposed method
 // which returns an sharedtypes.Session struct
@@ -27,8 +30,9 @@ type sharedQuerier struct {
 	blockQuerier  client.BlockQueryClient
 	logger        polylog.Logger
 
-	// blockHashCache caches blockQuerier.Block requests
-	blockHashCache cache.KeyValueCache[BlockHash]
+	// blockHashCache caches blockQuerier.Block requests, using the block height
+	// as the version of the single blockHashCacheKey key.
+	blockHashCache cache.HistoricalKeyValueCache[BlockHash]
 	// blockHashMutex to protect cache access patterns for block hashes
 	blockHashMutex sync.Mutex
 
@@ -175,36 +179,9 @@ func (sq *sharedQuerier) GetEarliestSupplierClaimCommitHeight(ctx context.Contex
 	// of the seed to the pseudo-random number generator.
 	claimWindowOpenHeight := sharedtypes.GetClaimWindowOpenHeight(sharedParams, queryHeight)
 
-	// Check if the block hash is already in the cache.
-	blockHashCacheKey := getBlockHashCacheKey(claimWindowOpenHeight)
-	claimWindowOpenBlockHash, found := sq.blockHashCache.Get(blockHashCacheKey)
-
-	if !found {
-		// Use mutex for cache miss pattern
-		sq.blockHashMutex.Lock()
-		defer sq.blockHashMutex.Unlock()
-
-		// Double-check cache after acquiring lock (follows standard double-checked locking pattern)
-		claimWindowOpenBlockHash, found = sq.blockHashCache.Get(blockHashCacheKey)
-		if found {
-			logger.Debug().Msgf("cache HIT for blockHeight after lock: %s", blockHashCacheKey)
-		} else {
-			logger.Debug().Msgf("cache MISS for blockHeight: %s", blockHashCacheKey)
-
-			claimWindowOpenBlock, err := retry.Call(ctx, func() (*cometrpctypes.ResultBlock, error) {
-				return sq.blockQuerier.Block(ctx, &claimWindowOpenHeight)
-			}, retry.GetStrategy(ctx))
-			if err != nil {
-				return 0, err
-			}
-
-			// Cache the block hash for future use.
-			// NB: Byte slice representation of block hashes don't need to be normalized.
-			claimWindowOpenBlockHash = claimWindowOpenBlock.BlockID.Hash.Bytes()
-			sq.blockHashCache.Set(blockHashCacheKey, claimWindowOpenBlockHash)
-		}
-	} else {
-		logger.Debug().Msgf("cache HIT for blockHeight: %s", blockHashCacheKey)
+	claimWindowOpenBlockHash, err := sq.getBlockHash(ctx, logger, claimWindowOpenHeight)
+	if err != nil {
+		return 0, err
 	}
 
 	return sharedtypes.GetEarliestSupplierClaimCommitHeight(
@@ -235,34 +212,9 @@ func (sq *sharedQuerier) GetEarliestSupplierProofCommitHeight(ctx context.Contex
 	// of the seed to the pseudo-random number generator.
 	proofWindowOpenHeight := sharedtypes.GetProofWindowOpenHeight(sharedParams, queryHeight)
 
-	blockHashCacheKey := getBlockHashCacheKey(proofWindowOpenHeight)
-	proofWindowOpenBlockHash, found := sq.blockHashCache.Get(blockHashCacheKey)
-
-	if !found {
-		// Use mutex for cache miss pattern
-		sq.blockHashMutex.Lock()
-		defer sq.blockHashMutex.Unlock()
-
-		// Double-check cache after acquiring lock (follows standard double-checked locking pattern)
-		proofWindowOpenBlockHash, found = sq.blockHashCache.Get(blockHashCacheKey)
-		if found {
-			logger.Debug().Msgf("cache HIT for blockHeight after lock: %s", blockHashCacheKey)
-		} else {
-			logger.Debug().Msgf("cache MISS for blockHeight: %s", blockHashCacheKey)
-
-			proofWindowOpenBlock, err := retry.Call(ctx, func() (*cometrpctypes.ResultBlock, error) {
-				return sq.blockQuerier.Block(ctx, &proofWindowOpenHeight)
-			}, retry.GetStrategy(ctx))
-			if err != nil {
-				return 0, err
-			}
-
-			// Cache the block hash for future use.
-			proofWindowOpenBlockHash = proofWindowOpenBlock.BlockID.Hash.Bytes()
-			sq.blockHashCache.Set(blockHashCacheKey, proofWindowOpenBlockHash)
-		}
-	} else {
-		logger.Debug().Msgf("cache HIT for blockHeight: %s", blockHashCacheKey)
+	proofWindowOpenBlockHash, err := sq.getBlockHash(ctx, logger, proofWindowOpenHeight)
+	if err != nil {
+		return 0, err
 	}
 
 	return sharedtypes.GetEarliestSupplierProofCommitHeight(
@@ -288,7 +240,41 @@ func (sq *sharedQuerier) GetComputeUnitsToTokensMultiplier(ctx context.Context)
 	return sharedParams.GetComputeUnitsToTokensMultiplier(), nil
 }
 
-// getBlockHashCacheKey constructs the cache key for a block hash by string formatting the block height.
-func getBlockHashCacheKey(height int64) string {
-	return strconv.FormatInt(height, 10)
+// getBlockHash returns the hash of the block at the given height.
+// Since the hash of a committed block never changes, it is cached as the
+// version of the blockHashCacheKey corresponding to the block height.
+func (sq *sharedQuerier) getBlockHash(ctx context.Context, logger polylog.Logger, height int64) (BlockHash, error) {
+	// Check if the block hash is already in the cache.
+	if blockHash, found := sq.blockHashCache.GetVersion(blockHashCacheKey, height); found {
+		logger.Debug().Msgf("cache HIT for blockHeight: %d", height)
+		return blockHash, nil
+	}
+
+	// Use mutex for cache miss pattern
+	sq.blockHashMutex.Lock()
+	defer sq.blockHashMutex.Unlock()
+
+	// Double-check cache after acquiring lock (follows standard double-checked locking pattern)
+	if blockHash, found := sq.blockHashCache.GetVersion(blockHashCacheKey, height); found {
+		logger.Debug().Msgf("cache HIT for blockHeight after lock: %d", height)
+		return blockHash, nil
+	}
+
+	logger.Debug().Msgf("cache MISS for blockHeight: %d", height)
+
+	block, err := retry.Call(ctx, func() (*cometrpctypes.ResultBlock, error) {
+		return sq.blockQuerier.Block(ctx, &height)
+	}, retry.GetStrategy(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	// Cache the block hash for future use.
+	// NB: Byte slice representation of block hashes don't need to be normalized.
+	blockHash := BlockHash(block.BlockID.Hash.Bytes())
+	if err := sq.blockHashCache.SetVersion(blockHashCacheKey, blockHash, height); err != nil {
+		logger.Warn().Err(err).Msgf("failed to cache the hash of block %d", height)
+	}
+
+	return blockHash, nil
 }
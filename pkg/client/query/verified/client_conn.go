@@ -0,0 +1,92 @@
+package verified
+
+import (
+	"context"
+	"strconv"
+
+	"cosmossdk.io/store/rootmulti"
+	"github.com/cometbft/cometbft/crypto/merkle"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+var _ gogogrpc.ClientConn = (*clientConn)(nil)
+
+// clientConn is a gRPC client connection which verifies the responses of the
+// sessions, applications, suppliers, services and params queries against the
+// onchain state, as proven by the ABCI store queries merkle proofs and the state
+// roots of the headers verified by a light client.
+//
+// The other queries and the streams are forwarded to the underlying connection
+// without verification.
+type clientConn struct {
+	gogogrpc.ClientConn
+
+	abciClient        rpcclient.ABCIClient
+	stateRootProvider StateRootProvider
+	proofRuntime      *merkle.ProofRuntime
+}
+
+// queryFn performs the query of the given method on the state committed at the
+// height being verified.
+type queryFn func(method string, args, reply any) error
+
+// NewClientConn returns a gRPC client connection which forwards the queries to
+// the given connection and verifies the responses of the supported ones using
+// ABCI store queries with proofs, performed by the given ABCI client, against
+// the state roots provided by the given StateRootProvider.
+func NewClientConn(
+	conn gogogrpc.ClientConn,
+	abciClient rpcclient.ABCIClient,
+	stateRootProvider StateRootProvider,
+) gogogrpc.ClientConn {
+	return &clientConn{
+		ClientConn:        conn,
+		abciClient:        abciClient,
+		stateRootProvider: stateRootProvider,
+		proofRuntime:      rootmulti.DefaultProofRuntime(),
+	}
+}
+
+// Invoke performs the query of the given method and verifies its response if the
+// method is supported.
+// The query is performed on the most recent state whose state root is trusted,
+// which is usually the one committed at the height preceding the latest block.
+func (conn *clientConn) Invoke(
+	ctx context.Context,
+	method string,
+	args, reply any,
+	opts ...grpc.CallOption,
+) error {
+	verifyQuery, ok := queryVerifiers[method]
+	if !ok {
+		return conn.ClientConn.Invoke(ctx, method, args, reply, opts...)
+	}
+
+	height, appHash, err := conn.stateRootProvider.LatestStateRoot(ctx)
+	if err != nil {
+		return err
+	}
+
+	store := &provenStore{
+		abciClient:   conn.abciClient,
+		proofRuntime: conn.proofRuntime,
+		height:       height,
+		appHash:      appHash,
+	}
+
+	// Query the state of the trusted height, which is the only one that can be proven.
+	heightCtx := metadata.AppendToOutgoingContext(
+		ctx,
+		grpctypes.GRPCBlockHeightHeader,
+		strconv.FormatInt(height, 10),
+	)
+	query := func(method string, args, reply any) error {
+		return conn.ClientConn.Invoke(heightCtx, method, args, reply, opts...)
+	}
+
+	return verifyQuery(ctx, store, query, method, args, reply)
+}
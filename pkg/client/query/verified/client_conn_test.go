@@ -0,0 +1,514 @@
+package verified
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/bytes"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	dbm "github.com/cosmos/cosmos-db"
+	cosmostypes "github.com/cosmos/cosmos-sdk/types"
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/pokt-network/poktroll/testutil/sample"
+	apptypes "github.com/pokt-network/poktroll/x/application/types"
+	sessionkeeper "github.com/pokt-network/poktroll/x/session/keeper"
+	sessiontypes "github.com/pokt-network/poktroll/x/session/types"
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
+	suppliertypes "github.com/pokt-network/poktroll/x/supplier/types"
+)
+
+const testServiceId = "svc1"
+
+func TestClientConn_VerifiesParams(t *testing.T) {
+	sharedParams := sharedtypes.DefaultParams()
+	chain := newTestChain(t, map[string]map[string]protoMarshaler{
+		sharedtypes.StoreKey: {string(sharedtypes.ParamsKey): &sharedParams},
+	})
+
+	tamperedSharedParams := sharedParams
+	tamperedSharedParams.NumBlocksPerSession++
+
+	tests := []struct {
+		desc        string
+		response    proto.Message
+		expectedErr error
+	}{
+		{
+			desc:     "onchain params",
+			response: &sharedtypes.QueryParamsResponse{Params: sharedParams},
+		},
+		{
+			desc:        "tampered params",
+			response:    &sharedtypes.QueryParamsResponse{Params: tamperedSharedParams},
+			expectedErr: ErrVerifiedQueryResponseMismatch,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			conn := chain.newClientConn(func(string, any) (proto.Message, error) {
+				return test.response, nil
+			})
+
+			params, err := sharedtypes.NewQueryClient(conn).Params(context.Background(), &sharedtypes.QueryParamsRequest{})
+			if test.expectedErr != nil {
+				require.ErrorIs(t, err, test.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, sharedParams, params.Params)
+		})
+	}
+}
+
+func TestClientConn_VerifiesApplication(t *testing.T) {
+	app := newTestApplication()
+	chain := newTestChain(t, map[string]map[string]protoMarshaler{
+		apptypes.StoreKey: {testApplicationKey(app.Address): app},
+	})
+
+	tamperedApp := *app
+	tamperedApp.Stake = &cosmostypes.Coin{Denom: "upokt", Amount: math.NewInt(1)}
+
+	tests := []struct {
+		desc          string
+		appAddress    string
+		response      proto.Message
+		responseErr   error
+		expectedErr   error
+		isNotFoundErr bool
+	}{
+		{
+			desc:       "onchain application",
+			appAddress: app.Address,
+			response:   &apptypes.QueryGetApplicationResponse{Application: *app},
+		},
+		{
+			desc:        "tampered application",
+			appAddress:  app.Address,
+			response:    &apptypes.QueryGetApplicationResponse{Application: tamperedApp},
+			expectedErr: ErrVerifiedQueryResponseMismatch,
+		},
+		{
+			desc:        "onchain application reported as not found",
+			appAddress:  app.Address,
+			responseErr: status.Error(codes.NotFound, "application not found"),
+			expectedErr: ErrVerifiedQueryResponseMismatch,
+		},
+		{
+			desc:          "application not found onchain",
+			appAddress:    sample.AccAddress(),
+			responseErr:   status.Error(codes.NotFound, "application not found"),
+			isNotFoundErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			conn := chain.newClientConn(func(string, any) (proto.Message, error) {
+				return test.response, test.responseErr
+			})
+
+			res, err := apptypes.NewQueryClient(conn).Application(
+				context.Background(),
+				&apptypes.QueryGetApplicationRequest{Address: test.appAddress},
+			)
+			switch {
+			case test.expectedErr != nil:
+				require.ErrorIs(t, err, test.expectedErr)
+			case test.isNotFoundErr:
+				require.Equal(t, codes.NotFound, status.Code(err))
+			default:
+				require.NoError(t, err)
+				require.Equal(t, app.Address, res.Application.Address)
+			}
+		})
+	}
+}
+
+func TestClientConn_VerifiesSession(t *testing.T) {
+	sharedParams := sharedtypes.DefaultParams()
+	// Sessions having 2 suppliers are made of the candidates with the highest weights.
+	sessionParams := sessiontypes.DefaultParams()
+	sessionParams.NumSuppliersPerSession = 2
+	app := newTestApplication()
+	supplier, serviceConfigUpdate := newTestSupplier()
+	otherSupplier, otherServiceConfigUpdate := newTestSupplier()
+	jailedSupplier, jailedServiceConfigUpdate := newTestSupplier()
+	sessionStartBlockHash := []byte("session_start_block_hash")
+
+	// The test chain state is committed at height 1, which is the session start height.
+	sessionStartHeight := sharedtypes.GetSessionStartHeight(&sharedParams, 1)
+	jailedSupplier.JailHistory = []*sharedtypes.SupplierJailPeriod{{
+		JailStartHeight: sessionStartHeight,
+		JailEndHeight:   sharedtypes.GetSessionEndHeight(&sharedParams, 1),
+		UnjailHeight:    sharedtypes.SupplierNotUnjailed,
+	}}
+
+	supplierRecords := make(map[string]protoMarshaler)
+	hydratedSuppliers := make(map[string]sharedtypes.Supplier)
+	for _, testSupplier := range []struct {
+		supplier            *sharedtypes.Supplier
+		serviceConfigUpdate *sharedtypes.ServiceConfigUpdate
+	}{
+		{supplier, serviceConfigUpdate},
+		{otherSupplier, otherServiceConfigUpdate},
+		{jailedSupplier, jailedServiceConfigUpdate},
+	} {
+		dehydratedSupplier := testSupplier.supplier
+		supplierServiceConfigUpdate := testSupplier.serviceConfigUpdate
+		supplierRecords[string(prefixedKey(suppliertypes.SupplierOperatorKeyPrefix, suppliertypes.SupplierOperatorKey(dehydratedSupplier.OperatorAddress)))] = dehydratedSupplier
+		supplierRecords[string(prefixedKey(suppliertypes.ServiceConfigUpdateKeyPrefix, suppliertypes.ServiceConfigUpdateKey(*supplierServiceConfigUpdate)))] = supplierServiceConfigUpdate
+
+		hydratedSupplier := *dehydratedSupplier
+		hydratedSupplier.ServiceConfigHistory = []*sharedtypes.ServiceConfigUpdate{supplierServiceConfigUpdate}
+		hydratedSupplier.Services = []*sharedtypes.SupplierServiceConfig{supplierServiceConfigUpdate.Service}
+		hydratedSuppliers[dehydratedSupplier.OperatorAddress] = hydratedSupplier
+	}
+
+	chain := newTestChain(t, map[string]map[string]protoMarshaler{
+		sharedtypes.StoreKey: {string(sharedtypes.ParamsKey): &sharedParams},
+		sessiontypes.StoreKey: {
+			string(sessiontypes.ParamsKey): &sessionParams,
+			string(prefixedKey(sessiontypes.BlockHashKeyPrefix, sessiontypes.BlockHashKey(sessionStartHeight))): rawValue(sessionStartBlockHash),
+		},
+		apptypes.StoreKey:      {testApplicationKey(app.Address): app},
+		suppliertypes.StoreKey: supplierRecords,
+	})
+
+	sessionId, sessionIdBz := sessionkeeper.GetSessionId(&sharedParams, app.Address, testServiceId, sessionStartBlockHash, 1)
+
+	// The suppliers of a session having as many suppliers as the number of
+	// suppliers per session, in their selection order.
+	selectedSupplierConfigs := sessionkeeper.SortCandidateSupplierConfigs(
+		sessionIdBz,
+		[]*sharedtypes.ServiceConfigUpdate{serviceConfigUpdate, otherServiceConfigUpdate},
+	)
+	selectedSuppliers := make([]*sharedtypes.Supplier, 0, len(selectedSupplierConfigs))
+	for _, selectedSupplierConfig := range selectedSupplierConfigs {
+		selectedSupplier := hydratedSuppliers[selectedSupplierConfig.OperatorAddress]
+		selectedSuppliers = append(selectedSuppliers, newTestSessionSupplier(&selectedSupplier))
+	}
+
+	newSession := func() *sessiontypes.Session {
+		return &sessiontypes.Session{
+			Header: &sessiontypes.SessionHeader{
+				ApplicationAddress:      app.Address,
+				ServiceId:               testServiceId,
+				SessionId:               sessionId,
+				SessionStartBlockHeight: sessionStartHeight,
+				SessionEndBlockHeight:   sharedtypes.GetSessionEndHeight(&sharedParams, 1),
+			},
+			SessionId:           sessionId,
+			SessionNumber:       sharedtypes.GetSessionNumber(&sharedParams, 1),
+			NumBlocksPerSession: int64(sharedParams.NumBlocksPerSession),
+			Application:         app,
+			Suppliers: []*sharedtypes.Supplier{{
+				OwnerAddress:    supplier.OwnerAddress,
+				OperatorAddress: supplier.OperatorAddress,
+				Stake:           supplier.Stake,
+				Services:        []*sharedtypes.SupplierServiceConfig{serviceConfigUpdate.Service},
+			}},
+		}
+	}
+
+	tests := []struct {
+		desc               string
+		requestBlockHeight int64
+		tamperSession      func(session *sessiontypes.Session)
+		expectedErr        error
+	}{
+		{
+			desc:               "onchain session",
+			requestBlockHeight: 1,
+		},
+		{
+			desc:               "session of a more recent height of the trusted session",
+			requestBlockHeight: sharedtypes.GetSessionEndHeight(&sharedParams, 1),
+		},
+		{
+			desc:               "session of an untrusted height",
+			requestBlockHeight: sharedtypes.GetNextSessionStartHeight(&sharedParams, 1),
+			expectedErr:        ErrVerifiedQueryUntrustedHeight,
+		},
+		{
+			desc:               "tampered session id",
+			requestBlockHeight: 1,
+			tamperSession: func(session *sessiontypes.Session) {
+				session.SessionId = "tampered_session_id"
+				session.Header.SessionId = session.SessionId
+			},
+			expectedErr: ErrVerifiedQueryResponseMismatch,
+		},
+		{
+			desc:               "tampered application",
+			requestBlockHeight: 1,
+			tamperSession: func(session *sessiontypes.Session) {
+				tamperedApp := *app
+				tamperedApp.Stake = &cosmostypes.Coin{Denom: "upokt", Amount: math.NewInt(1)}
+				session.Application = &tamperedApp
+			},
+			expectedErr: ErrVerifiedQueryResponseMismatch,
+		},
+		{
+			desc:               "tampered supplier service config",
+			requestBlockHeight: 1,
+			tamperSession: func(session *sessiontypes.Session) {
+				session.Suppliers[0].Services = []*sharedtypes.SupplierServiceConfig{{ServiceId: testServiceId}}
+			},
+			expectedErr: ErrVerifiedQueryResponseMismatch,
+		},
+		{
+			desc:               "jailed supplier",
+			requestBlockHeight: 1,
+			tamperSession: func(session *sessiontypes.Session) {
+				jailedHydratedSupplier := hydratedSuppliers[jailedSupplier.OperatorAddress]
+				session.Suppliers = []*sharedtypes.Supplier{newTestSessionSupplier(&jailedHydratedSupplier)}
+			},
+			expectedErr: ErrVerifiedQueryResponseMismatch,
+		},
+		{
+			desc:               "duplicated supplier",
+			requestBlockHeight: 1,
+			tamperSession: func(session *sessiontypes.Session) {
+				session.Suppliers = append(session.Suppliers, session.Suppliers[0])
+			},
+			expectedErr: ErrVerifiedQueryResponseMismatch,
+		},
+		{
+			desc:               "suppliers in their selection order",
+			requestBlockHeight: 1,
+			tamperSession: func(session *sessiontypes.Session) {
+				session.Suppliers = slices.Clone(selectedSuppliers)
+			},
+		},
+		{
+			desc:               "suppliers out of their selection order",
+			requestBlockHeight: 1,
+			tamperSession: func(session *sessiontypes.Session) {
+				session.Suppliers = []*sharedtypes.Supplier{selectedSuppliers[1], selectedSuppliers[0]}
+			},
+			expectedErr: ErrVerifiedQueryResponseMismatch,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			conn := chain.newClientConn(func(method string, args any) (proto.Message, error) {
+				if method == getSupplierMethod {
+					operatorAddress := args.(*suppliertypes.QueryGetSupplierRequest).OperatorAddress
+					return &suppliertypes.QueryGetSupplierResponse{Supplier: hydratedSuppliers[operatorAddress]}, nil
+				}
+
+				// The session is always queried at the trusted height.
+				require.Equal(t, int64(1), args.(*sessiontypes.QueryGetSessionRequest).BlockHeight)
+
+				session := newSession()
+				if test.tamperSession != nil {
+					test.tamperSession(session)
+				}
+				return &sessiontypes.QueryGetSessionResponse{Session: session}, nil
+			})
+
+			res, err := sessiontypes.NewQueryClient(conn).GetSession(
+				context.Background(),
+				&sessiontypes.QueryGetSessionRequest{
+					ApplicationAddress: app.Address,
+					ServiceId:          testServiceId,
+					BlockHeight:        test.requestBlockHeight,
+				},
+			)
+			if test.expectedErr != nil {
+				require.ErrorIs(t, err, test.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, sessionId, res.Session.SessionId)
+		})
+	}
+}
+
+func TestClientConn_ForwardsUnverifiedQueries(t *testing.T) {
+	chain := newTestChain(t, nil)
+
+	var invokedMethod string
+	conn := chain.newClientConn(func(method string, _ any) (proto.Message, error) {
+		invokedMethod = method
+		return &sessiontypes.QueryParamsResponse{}, nil
+	})
+
+	err := conn.Invoke(context.Background(), "/pocket.unverified.Query/Params", nil, &sessiontypes.QueryParamsResponse{})
+	require.NoError(t, err)
+	require.Equal(t, "/pocket.unverified.Query/Params", invokedMethod)
+}
+
+// testChain is an in-memory multistore whose state is committed at height 1 and
+// which serves ABCI store queries with proofs.
+type testChain struct {
+	rpcclient.ABCIClient
+
+	store *rootmulti.Store
+	// appHash is the root of the committed state.
+	appHash []byte
+}
+
+// newTestChain returns a testChain whose state contains the given records,
+// as a map of store name -> key -> record.
+func newTestChain(t *testing.T, records map[string]map[string]protoMarshaler) *testChain {
+	t.Helper()
+
+	store := rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger(), metrics.NewNoOpMetrics())
+
+	// Mount every store used by the verifiers, even if it has no records.
+	storeKeys := make(map[string]*storetypes.KVStoreKey)
+	for _, storeName := range []string{
+		apptypes.StoreKey,
+		sessiontypes.StoreKey,
+		sharedtypes.StoreKey,
+		suppliertypes.StoreKey,
+	} {
+		storeKeys[storeName] = storetypes.NewKVStoreKey(storeName)
+		store.MountStoreWithDB(storeKeys[storeName], storetypes.StoreTypeIAVL, nil)
+	}
+	require.NoError(t, store.LoadLatestVersion())
+
+	for storeName, storeRecords := range records {
+		kvStore := store.GetKVStore(storeKeys[storeName])
+		for key, record := range storeRecords {
+			recordBz, err := record.Marshal()
+			require.NoError(t, err)
+			kvStore.Set([]byte(key), recordBz)
+		}
+	}
+
+	commitId := store.Commit()
+	require.Equal(t, int64(1), commitId.Version)
+
+	return &testChain{store: store, appHash: commitId.Hash}
+}
+
+// newClientConn returns a verifying client connection to the test chain whose
+// gRPC queries are replied by the given function.
+func (chain *testChain) newClientConn(
+	replyFn func(method string, args any) (proto.Message, error),
+) gogogrpc.ClientConn {
+	return NewClientConn(&testGRPCConn{replyFn: replyFn}, chain, chain)
+}
+
+// LatestStateRoot implements the StateRootProvider interface.
+func (chain *testChain) LatestStateRoot(context.Context) (int64, []byte, error) {
+	return 1, chain.appHash, nil
+}
+
+// ABCIQueryWithOptions serves the ABCI store queries using the test chain's multistore.
+func (chain *testChain) ABCIQueryWithOptions(
+	_ context.Context,
+	path string,
+	data bytes.HexBytes,
+	opts rpcclient.ABCIQueryOptions,
+) (*coretypes.ResultABCIQuery, error) {
+	res, err := chain.store.Query(&storetypes.RequestQuery{
+		Path:   strings.TrimPrefix(path, "/store"),
+		Data:   data,
+		Height: opts.Height,
+		Prove:  opts.Prove,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &coretypes.ResultABCIQuery{Response: abci.ResponseQuery{
+		Code:     res.Code,
+		Key:      res.Key,
+		Value:    res.Value,
+		ProofOps: res.ProofOps,
+		Height:   res.Height,
+	}}, nil
+}
+
+// testGRPCConn is a gRPC client connection whose queries are replied by replyFn.
+type testGRPCConn struct {
+	gogogrpc.ClientConn
+
+	replyFn func(method string, args any) (proto.Message, error)
+}
+
+// Invoke replies to the query with the response returned by replyFn.
+func (conn *testGRPCConn) Invoke(_ context.Context, method string, args, reply any, _ ...grpc.CallOption) error {
+	response, err := conn.replyFn(method, args)
+	if err != nil {
+		return err
+	}
+
+	responseBz, err := proto.Marshal(response)
+	if err != nil {
+		return err
+	}
+
+	return proto.Unmarshal(responseBz, reply.(proto.Message))
+}
+
+// rawValue is a record whose encoding is the wrapped bytes.
+type rawValue []byte
+
+func (value rawValue) Marshal() ([]byte, error) { return value, nil }
+
+func newTestApplication() *apptypes.Application {
+	stake := cosmostypes.NewInt64Coin("upokt", 1000)
+	return &apptypes.Application{
+		Address:        sample.AccAddress(),
+		Stake:          &stake,
+		ServiceConfigs: []*sharedtypes.ApplicationServiceConfig{{ServiceId: testServiceId}},
+	}
+}
+
+func newTestSupplier() (*sharedtypes.Supplier, *sharedtypes.ServiceConfigUpdate) {
+	stake := cosmostypes.NewInt64Coin("upokt", 1000)
+	operatorAddress := sample.AccAddress()
+	supplier := &sharedtypes.Supplier{
+		OwnerAddress:    operatorAddress,
+		OperatorAddress: operatorAddress,
+		Stake:           &stake,
+	}
+
+	serviceConfigUpdate := &sharedtypes.ServiceConfigUpdate{
+		OperatorAddress: operatorAddress,
+		Service: &sharedtypes.SupplierServiceConfig{
+			ServiceId: testServiceId,
+			Endpoints: []*sharedtypes.SupplierEndpoint{{
+				Url:     "http://localhost:8545",
+				RpcType: sharedtypes.RPCType_JSON_RPC,
+			}},
+		},
+		ActivationHeight: 1,
+	}
+
+	return supplier, serviceConfigUpdate
+}
+
+// newTestSessionSupplier returns the session supplier of the given hydrated
+// supplier, which has only the service config of the test service.
+func newTestSessionSupplier(hydratedSupplier *sharedtypes.Supplier) *sharedtypes.Supplier {
+	sessionSupplier := *hydratedSupplier
+	sessionSupplier.ServiceConfigHistory = nil
+	return &sessionSupplier
+}
+
+func testApplicationKey(appAddress string) string {
+	return string(prefixedKey(apptypes.ApplicationKeyPrefix, apptypes.ApplicationKey(appAddress)))
+}
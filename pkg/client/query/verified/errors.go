@@ -0,0 +1,11 @@
+package verified
+
+import sdkerrors "cosmossdk.io/errors"
+
+var (
+	codespace                        = "verified_query"
+	ErrVerifiedQueryLightClient      = sdkerrors.Register(codespace, 1, "light client failed to verify the chain headers")
+	ErrVerifiedQueryInvalidProof     = sdkerrors.Register(codespace, 2, "invalid store merkle proof")
+	ErrVerifiedQueryResponseMismatch = sdkerrors.Register(codespace, 3, "query response does not match the proven onchain state")
+	ErrVerifiedQueryUntrustedHeight  = sdkerrors.Register(codespace, 4, "query height is not trusted yet")
+)
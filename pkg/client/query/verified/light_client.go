@@ -0,0 +1,182 @@
+package verified
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"cosmossdk.io/depinject"
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/cometbft/cometbft/light"
+	"github.com/cometbft/cometbft/light/provider"
+	lighthttp "github.com/cometbft/cometbft/light/provider/http"
+	lightdb "github.com/cometbft/cometbft/light/store/db"
+
+	"github.com/pokt-network/poktroll/pkg/client"
+)
+
+// lightStoreName is the name of the database persisting the verified headers
+// in the light client's store directory.
+const lightStoreName = "light_client"
+
+// StateRootProvider provides the trusted state roots (i.e. app hashes) which the
+// query responses are verified against.
+type StateRootProvider interface {
+	// LatestStateRoot returns the most recent height whose committed state root
+	// is trusted, along with that state root.
+	LatestStateRoot(ctx context.Context) (height int64, appHash []byte, err error)
+}
+
+// LightClientConfig is the configuration of the light client which verifies the
+// headers of the chain, starting from a trusted one.
+type LightClientConfig struct {
+	// ChainId is the ID of the chain whose headers are verified.
+	ChainId string
+	// TrustedHeight is the height of the header which is trusted without verification.
+	TrustedHeight int64
+	// TrustedHash is the hash of the header at TrustedHeight.
+	TrustedHash []byte
+	// TrustingPeriod is the duration for which a verified header is trusted.
+	// It MUST be significantly lower than the chain's unbonding period.
+	TrustingPeriod time.Duration
+	// PrimaryRPCUrl is the CometBFT RPC URL of the node providing the headers.
+	PrimaryRPCUrl string
+	// WitnessRPCUrls are the CometBFT RPC URLs of the nodes the headers provided
+	// by the primary are cross-checked with to detect forks.
+	WitnessRPCUrls []string
+	// StoreDirectory is the path on disk where the verified headers are persisted,
+	// so that the light client resumes from the latest one after a restart instead
+	// of verifying the headers since TrustedHeight again.
+	// If empty, the verified headers are only kept in memory.
+	StoreDirectory string
+}
+
+var _ StateRootProvider = (*lightClientStateRootProvider)(nil)
+
+// lightClientStateRootProvider is a StateRootProvider whose state roots are the
+// app hashes of the headers verified by a CometBFT light client.
+// The light client is only updated once per committed block, the state root of
+// the latest verified header being cached until the next block is observed.
+type lightClientStateRootProvider struct {
+	blockClient client.BlockClient
+
+	// lightClientMu guards the light client and the cached state root.
+	lightClientMu sync.Mutex
+	lightClient   *light.Client
+
+	// stateRootHeight and stateRoot are the height and state root of the latest
+	// verified header, which was verified when the last committed block observed
+	// was at stateRootBlockHeight.
+	stateRootHeight      int64
+	stateRoot            []byte
+	stateRootBlockHeight int64
+}
+
+// NewLightClientStateRootProvider returns a StateRootProvider backed by a CometBFT
+// light client configured with the given config.
+//
+// If no witness is configured, the primary is used as its own witness: the headers
+// are still verified against the validator set signatures but forks can't be detected.
+//
+// Required dependencies:
+//   - client.BlockClient
+func NewLightClientStateRootProvider(
+	ctx context.Context,
+	deps depinject.Config,
+	config *LightClientConfig,
+) (StateRootProvider, error) {
+	p := &lightClientStateRootProvider{}
+	if err := depinject.Inject(deps, &p.blockClient); err != nil {
+		return nil, err
+	}
+
+	primary, err := lighthttp.New(config.ChainId, config.PrimaryRPCUrl)
+	if err != nil {
+		return nil, ErrVerifiedQueryLightClient.Wrapf("creating primary provider: %v", err)
+	}
+
+	witnesses := make([]provider.Provider, 0, len(config.WitnessRPCUrls))
+	for _, witnessRPCUrl := range config.WitnessRPCUrls {
+		witness, err := lighthttp.New(config.ChainId, witnessRPCUrl)
+		if err != nil {
+			return nil, ErrVerifiedQueryLightClient.Wrapf("creating witness provider %q: %v", witnessRPCUrl, err)
+		}
+		witnesses = append(witnesses, witness)
+	}
+
+	if len(witnesses) == 0 {
+		witnesses = append(witnesses, primary)
+	}
+
+	var lightStoreDB dbm.DB = dbm.NewMemDB()
+	if config.StoreDirectory != "" {
+		var err error
+		if lightStoreDB, err = dbm.NewGoLevelDB(lightStoreName, config.StoreDirectory); err != nil {
+			return nil, ErrVerifiedQueryLightClient.Wrapf("opening the light client store: %v", err)
+		}
+	}
+
+	lightClient, err := light.NewClient(
+		ctx,
+		config.ChainId,
+		light.TrustOptions{
+			Period: config.TrustingPeriod,
+			Height: config.TrustedHeight,
+			Hash:   config.TrustedHash,
+		},
+		primary,
+		witnesses,
+		lightdb.New(lightStoreDB, config.ChainId),
+	)
+	if err != nil {
+		_ = lightStoreDB.Close()
+		return nil, ErrVerifiedQueryLightClient.Wrapf("creating light client: %v", err)
+	}
+	p.lightClient = lightClient
+
+	// Close the light client store once the provider is no longer used.
+	go func() {
+		<-ctx.Done()
+		p.lightClientMu.Lock()
+		defer p.lightClientMu.Unlock()
+		_ = lightStoreDB.Close()
+	}()
+
+	return p, nil
+}
+
+// LatestStateRoot returns the state root committed to by the latest verified
+// header, which is the one of the state committed at the previous height.
+// The light client verifies the latest header provided by the primary at most
+// once per committed block.
+func (p *lightClientStateRootProvider) LatestStateRoot(ctx context.Context) (int64, []byte, error) {
+	p.lightClientMu.Lock()
+	defer p.lightClientMu.Unlock()
+
+	blockHeight := p.blockClient.LastBlock(ctx).Height()
+	if p.stateRoot != nil && blockHeight <= p.stateRootBlockHeight {
+		return p.stateRootHeight, p.stateRoot, nil
+	}
+
+	if _, err := p.lightClient.Update(ctx, time.Now()); err != nil {
+		return 0, nil, ErrVerifiedQueryLightClient.Wrapf("updating to the latest header: %v", err)
+	}
+
+	lastTrustedHeight, err := p.lightClient.LastTrustedHeight()
+	if err != nil {
+		return 0, nil, ErrVerifiedQueryLightClient.Wrapf("getting the last trusted height: %v", err)
+	}
+
+	lightBlock, err := p.lightClient.TrustedLightBlock(lastTrustedHeight)
+	if err != nil {
+		return 0, nil, ErrVerifiedQueryLightClient.Wrapf("getting the last trusted header: %v", err)
+	}
+
+	// The app hash of a header is the state root resulting from the execution
+	// of the previous block.
+	p.stateRootHeight = lightBlock.Height - 1
+	p.stateRoot = lightBlock.AppHash
+	p.stateRootBlockHeight = blockHeight
+
+	return p.stateRootHeight, p.stateRoot, nil
+}
@@ -0,0 +1,77 @@
+package verified
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/cometbft/cometbft/crypto/merkle"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+)
+
+// provenStore reads the onchain state committed at a given height, proving each
+// value (or its absence) with a merkle proof against the trusted state root
+// (i.e. app hash) of that height.
+type provenStore struct {
+	abciClient   rpcclient.ABCIClient
+	proofRuntime *merkle.ProofRuntime
+
+	// height is the height whose committed state is read.
+	height int64
+	// appHash is the trusted state root of the state committed at height.
+	appHash []byte
+}
+
+// get returns the value stored at key in the store of the given module, or nil
+// if there is none. An error is returned if the value or its absence could not
+// be proven.
+func (store *provenStore) get(ctx context.Context, storeName string, key []byte) ([]byte, error) {
+	res, err := store.abciClient.ABCIQueryWithOptions(
+		ctx,
+		fmt.Sprintf("/store/%s/key", storeName),
+		key,
+		rpcclient.ABCIQueryOptions{Height: store.height, Prove: true},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	response := res.Response
+	if !response.IsOK() {
+		return nil, ErrVerifiedQueryInvalidProof.Wrapf(
+			"querying key %q of store %q at height %d: %s",
+			key, storeName, store.height, response.Log,
+		)
+	}
+
+	if response.Height != store.height || !bytes.Equal(response.Key, key) {
+		return nil, ErrVerifiedQueryInvalidProof.Wrapf(
+			"expected key %q at height %d, got key %q at height %d",
+			key, store.height, response.Key, response.Height,
+		)
+	}
+
+	if response.ProofOps == nil {
+		return nil, ErrVerifiedQueryInvalidProof.Wrapf("missing proof for key %q of store %q", key, storeName)
+	}
+
+	// The proof ops first prove the key against the module store root, then the
+	// module store root against the app hash.
+	keyPath := merkle.KeyPath{}.
+		AppendKey([]byte(storeName), merkle.KeyEncodingURL).
+		AppendKey(key, merkle.KeyEncodingURL).
+		String()
+
+	if len(response.Value) == 0 {
+		if err := store.proofRuntime.VerifyAbsence(response.ProofOps, store.appHash, keyPath); err != nil {
+			return nil, ErrVerifiedQueryInvalidProof.Wrapf("absence of key %q of store %q: %v", key, storeName, err)
+		}
+		return nil, nil
+	}
+
+	if err := store.proofRuntime.VerifyValue(response.ProofOps, store.appHash, keyPath, response.Value); err != nil {
+		return nil, ErrVerifiedQueryInvalidProof.Wrapf("value of key %q of store %q: %v", key, storeName, err)
+	}
+
+	return response.Value, nil
+}
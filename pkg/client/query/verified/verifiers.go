@@ -0,0 +1,570 @@
+package verified
+
+import (
+	"bytes"
+	"context"
+	"slices"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	apptypes "github.com/pokt-network/poktroll/x/application/types"
+	gatewaytypes "github.com/pokt-network/poktroll/x/gateway/types"
+	prooftypes "github.com/pokt-network/poktroll/x/proof/types"
+	servicetypes "github.com/pokt-network/poktroll/x/service/types"
+	sessionkeeper "github.com/pokt-network/poktroll/x/session/keeper"
+	sessiontypes "github.com/pokt-network/poktroll/x/session/types"
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
+	suppliertypes "github.com/pokt-network/poktroll/x/supplier/types"
+	tokenomicstypes "github.com/pokt-network/poktroll/x/tokenomics/types"
+)
+
+const getSupplierMethod = "/pocket.supplier.Query/Supplier"
+
+// queryVerifierFn performs the query of the given method using the given queryFn
+// and verifies its response against the onchain state proven by the given store.
+type queryVerifierFn func(
+	ctx context.Context,
+	store *provenStore,
+	query queryFn,
+	method string,
+	args, reply any,
+) error
+
+// queryVerifiers is a map of gRPC query method -> verifier of its response.
+var queryVerifiers = map[string]queryVerifierFn{
+	"/pocket.application.Query/Params": newParamsVerifierFn[apptypes.Params](apptypes.StoreKey, apptypes.ParamsKey),
+	"/pocket.gateway.Query/Params":     newParamsVerifierFn[gatewaytypes.Params](gatewaytypes.StoreKey, gatewaytypes.ParamsKey),
+	"/pocket.proof.Query/Params":       newParamsVerifierFn[prooftypes.Params](prooftypes.StoreKey, prooftypes.ParamsKey),
+	"/pocket.service.Query/Params":     newParamsVerifierFn[servicetypes.Params](servicetypes.StoreKey, servicetypes.ParamsKey),
+	"/pocket.session.Query/Params":     newParamsVerifierFn[sessiontypes.Params](sessiontypes.StoreKey, sessiontypes.ParamsKey),
+	"/pocket.shared.Query/Params":      newParamsVerifierFn[sharedtypes.Params](sharedtypes.StoreKey, sharedtypes.ParamsKey),
+	"/pocket.supplier.Query/Params":    newParamsVerifierFn[suppliertypes.Params](suppliertypes.StoreKey, suppliertypes.ParamsKey),
+	"/pocket.tokenomics.Query/Params":  newParamsVerifierFn[tokenomicstypes.Params](tokenomicstypes.StoreKey, tokenomicstypes.ParamsKey),
+
+	"/pocket.application.Query/Application": verifyApplicationQuery,
+	"/pocket.service.Query/Service":         verifyServiceQuery,
+	getSupplierMethod:                       verifySupplierQuery,
+	"/pocket.session.Query/GetSession":      verifySessionQuery,
+}
+
+// protoMarshaler is implemented by the generated protobuf messages.
+type protoMarshaler interface {
+	Marshal() ([]byte, error)
+}
+
+// newParamsVerifierFn returns a queryVerifierFn which verifies that the params of
+// the response are the ones stored at paramsKey in the given module store.
+func newParamsVerifierFn[P any, PP interface {
+	*P
+	protoMarshaler
+}](storeName string, paramsKey []byte) queryVerifierFn {
+	return func(
+		ctx context.Context,
+		store *provenStore,
+		query queryFn,
+		method string,
+		args, reply any,
+	) error {
+		if err := query(method, args, reply); err != nil {
+			return err
+		}
+
+		paramsRes, ok := reply.(interface{ GetParams() P })
+		if !ok {
+			return ErrVerifiedQueryResponseMismatch.Wrapf("unexpected %s response type %T", method, reply)
+		}
+		params := paramsRes.GetParams()
+
+		// Params which were never set are queried as zero values, which are
+		// encoded as empty bytes, matching their absence from the store.
+		return verifyRecord(ctx, store, storeName, paramsKey, PP(&params))
+	}
+}
+
+// verifyApplicationQuery verifies the response of an application query.
+func verifyApplicationQuery(
+	ctx context.Context,
+	store *provenStore,
+	query queryFn,
+	method string,
+	args, reply any,
+) error {
+	req, res, err := castQuery[apptypes.QueryGetApplicationRequest, apptypes.QueryGetApplicationResponse](method, args, reply)
+	if err != nil {
+		return err
+	}
+
+	appKey := prefixedKey(apptypes.ApplicationKeyPrefix, apptypes.ApplicationKey(req.Address))
+	if err := query(method, args, reply); err != nil {
+		return verifyNotFoundRecord(ctx, store, apptypes.StoreKey, appKey, err)
+	}
+
+	return verifyRecord(ctx, store, apptypes.StoreKey, appKey, &res.Application)
+}
+
+// verifyServiceQuery verifies the response of a service query.
+func verifyServiceQuery(
+	ctx context.Context,
+	store *provenStore,
+	query queryFn,
+	method string,
+	args, reply any,
+) error {
+	req, res, err := castQuery[servicetypes.QueryGetServiceRequest, servicetypes.QueryGetServiceResponse](method, args, reply)
+	if err != nil {
+		return err
+	}
+
+	serviceKey := prefixedKey(servicetypes.ServiceKeyPrefix, servicetypes.ServiceKey(req.Id))
+	if err := query(method, args, reply); err != nil {
+		return verifyNotFoundRecord(ctx, store, servicetypes.StoreKey, serviceKey, err)
+	}
+
+	return verifyRecord(ctx, store, servicetypes.StoreKey, serviceKey, &res.Service)
+}
+
+// verifySupplierQuery verifies the response of a supplier query.
+func verifySupplierQuery(
+	ctx context.Context,
+	store *provenStore,
+	query queryFn,
+	method string,
+	args, reply any,
+) error {
+	req, res, err := castQuery[suppliertypes.QueryGetSupplierRequest, suppliertypes.QueryGetSupplierResponse](method, args, reply)
+	if err != nil {
+		return err
+	}
+
+	if err := query(method, args, reply); err != nil {
+		supplierKey := prefixedKey(suppliertypes.SupplierOperatorKeyPrefix, suppliertypes.SupplierOperatorKey(req.OperatorAddress))
+		return verifyNotFoundRecord(ctx, store, suppliertypes.StoreKey, supplierKey, err)
+	}
+
+	return verifySupplier(ctx, store, req.OperatorAddress, &res.Supplier)
+}
+
+// verifySupplier verifies that the given hydrated supplier is the one stored
+// onchain for the given operator address.
+//
+// Suppliers are stored dehydrated (i.e. without their service configs), each of
+// their service config updates being stored as a separate record.
+//
+// DEV_NOTE: Each service config update of the supplier's history is proven to be
+// stored onchain but the completeness of the history can't be proven since ABCI
+// store queries don't provide range proofs.
+func verifySupplier(
+	ctx context.Context,
+	store *provenStore,
+	operatorAddress string,
+	supplier *sharedtypes.Supplier,
+) error {
+	supplierKey := prefixedKey(suppliertypes.SupplierOperatorKeyPrefix, suppliertypes.SupplierOperatorKey(operatorAddress))
+	dehydratedSupplier := *supplier
+	dehydratedSupplier.Services = nil
+	dehydratedSupplier.ServiceConfigHistory = nil
+	if err := verifyRecord(ctx, store, suppliertypes.StoreKey, supplierKey, &dehydratedSupplier); err != nil {
+		return err
+	}
+
+	for _, serviceConfigUpdate := range supplier.ServiceConfigHistory {
+		if serviceConfigUpdate.GetOperatorAddress() != operatorAddress || serviceConfigUpdate.GetService() == nil {
+			return ErrVerifiedQueryResponseMismatch.Wrapf(
+				"supplier %q has an invalid service config update %+v",
+				operatorAddress, serviceConfigUpdate,
+			)
+		}
+
+		serviceConfigUpdateKey := prefixedKey(
+			suppliertypes.ServiceConfigUpdateKeyPrefix,
+			suppliertypes.ServiceConfigUpdateKey(*serviceConfigUpdate),
+		)
+		if err := verifyRecord(ctx, store, suppliertypes.StoreKey, serviceConfigUpdateKey, serviceConfigUpdate); err != nil {
+			return err
+		}
+	}
+
+	// The supplier is hydrated with the service configs active at the query height.
+	activeServiceConfigs := supplier.GetActiveServiceConfigs(store.height)
+	if !equalMessages(supplier.Services, activeServiceConfigs) {
+		return ErrVerifiedQueryResponseMismatch.Wrapf(
+			"supplier %q services do not match its service configs active at height %d",
+			operatorAddress, store.height,
+		)
+	}
+
+	return nil
+}
+
+// verifySessionQuery verifies the response of a session query.
+//
+// The query is performed at the trusted height if the requested block height is
+// more recent but belongs to the same session, since both heights have the same
+// session.
+//
+// DEV_NOTE: Every session field is verified against the onchain state, including
+// each of the session suppliers, their jail state and their selection order.
+// However, the omission of candidate suppliers from the session can't be proven
+// since ABCI store queries don't provide range proofs over the service config
+// updates of the service.
+func verifySessionQuery(
+	ctx context.Context,
+	store *provenStore,
+	query queryFn,
+	method string,
+	args, reply any,
+) error {
+	req, res, err := castQuery[sessiontypes.QueryGetSessionRequest, sessiontypes.QueryGetSessionResponse](method, args, reply)
+	if err != nil {
+		return err
+	}
+
+	sharedParams, err := getProvenParams[sharedtypes.Params](ctx, store, sharedtypes.StoreKey, sharedtypes.ParamsKey)
+	if err != nil {
+		return err
+	}
+
+	// A zero block height requests the session of the query height.
+	blockHeight := req.BlockHeight
+	if blockHeight == 0 || blockHeight > store.height {
+		if sharedtypes.GetSessionStartHeight(sharedParams, blockHeight) > store.height {
+			return ErrVerifiedQueryUntrustedHeight.Wrapf(
+				"session of block height %d starts after the trusted height %d",
+				blockHeight, store.height,
+			)
+		}
+		blockHeight = store.height
+	}
+
+	sessionReq := &sessiontypes.QueryGetSessionRequest{
+		ApplicationAddress: req.ApplicationAddress,
+		ServiceId:          req.ServiceId,
+		BlockHeight:        blockHeight,
+	}
+	if err := query(method, sessionReq, res); err != nil {
+		return err
+	}
+
+	session := res.GetSession()
+	sessionHeader := session.GetHeader()
+	if sessionHeader == nil {
+		return ErrVerifiedQueryResponseMismatch.Wrap("session has no header")
+	}
+
+	sessionStartHeight := sharedtypes.GetSessionStartHeight(sharedParams, blockHeight)
+	var blockHash []byte
+	if sessionStartHeight > 0 {
+		blockHashKey := prefixedKey(sessiontypes.BlockHashKeyPrefix, sessiontypes.BlockHashKey(sessionStartHeight))
+		if blockHash, err = store.get(ctx, sessiontypes.StoreKey, blockHashKey); err != nil {
+			return err
+		}
+	}
+	sessionId, sessionIdBz := sessionkeeper.GetSessionId(sharedParams, req.ApplicationAddress, req.ServiceId, blockHash, blockHeight)
+
+	expectedSessionHeader := &sessiontypes.SessionHeader{
+		ApplicationAddress:      req.ApplicationAddress,
+		ServiceId:               req.ServiceId,
+		SessionId:               sessionId,
+		SessionStartBlockHeight: sessionStartHeight,
+		SessionEndBlockHeight:   sharedtypes.GetSessionEndHeight(sharedParams, blockHeight),
+	}
+	if !equalMessages([]*sessiontypes.SessionHeader{sessionHeader}, []*sessiontypes.SessionHeader{expectedSessionHeader}) ||
+		session.SessionId != sessionId ||
+		session.SessionNumber != sharedtypes.GetSessionNumber(sharedParams, blockHeight) ||
+		session.NumBlocksPerSession != int64(sharedParams.NumBlocksPerSession) {
+		return ErrVerifiedQueryResponseMismatch.Wrapf(
+			"session %+v does not match the expected header %+v",
+			session, expectedSessionHeader,
+		)
+	}
+
+	if err := verifySessionApplication(ctx, store, session); err != nil {
+		return err
+	}
+
+	return verifySessionSuppliers(ctx, store, query, session, sessionIdBz, blockHeight)
+}
+
+// verifySessionApplication verifies that the session application is the one
+// stored onchain and that it can be part of the session.
+func verifySessionApplication(
+	ctx context.Context,
+	store *provenStore,
+	session *sessiontypes.Session,
+) error {
+	sessionHeader := session.GetHeader()
+	app := session.GetApplication()
+	if app == nil {
+		return ErrVerifiedQueryResponseMismatch.Wrapf("session %q has no application", session.SessionId)
+	}
+
+	appKey := prefixedKey(apptypes.ApplicationKeyPrefix, apptypes.ApplicationKey(sessionHeader.ApplicationAddress))
+	if err := verifyRecord(ctx, store, apptypes.StoreKey, appKey, app); err != nil {
+		return err
+	}
+
+	if !app.IsActive(sessionHeader.SessionEndBlockHeight) {
+		return ErrVerifiedQueryResponseMismatch.Wrapf(
+			"session %q application %q is not active",
+			session.SessionId, app.Address,
+		)
+	}
+
+	for _, appServiceConfig := range app.ServiceConfigs {
+		if appServiceConfig.GetServiceId() == sessionHeader.ServiceId {
+			return nil
+		}
+	}
+
+	return ErrVerifiedQueryResponseMismatch.Wrapf(
+		"session %q application %q is not staked for service %q",
+		session.SessionId, app.Address, sessionHeader.ServiceId,
+	)
+}
+
+// verifySessionSuppliers verifies that each session supplier is stored onchain
+// along with the service config of the session service it is part of the session for,
+// that it is not jailed, and that the session suppliers are the ones selected among
+// them as candidates.
+func verifySessionSuppliers(
+	ctx context.Context,
+	store *provenStore,
+	query queryFn,
+	session *sessiontypes.Session,
+	sessionIdBz []byte,
+	blockHeight int64,
+) error {
+	sessionParams, err := getProvenParams[sessiontypes.Params](ctx, store, sessiontypes.StoreKey, sessiontypes.ParamsKey)
+	if err != nil {
+		return err
+	}
+
+	if uint64(len(session.Suppliers)) > sessionParams.NumSuppliersPerSession {
+		return ErrVerifiedQueryResponseMismatch.Wrapf(
+			"session %q has %d suppliers, more than the %d suppliers per session",
+			session.SessionId, len(session.Suppliers), sessionParams.NumSuppliersPerSession,
+		)
+	}
+
+	serviceId := session.GetHeader().GetServiceId()
+	candidateSupplierConfigs := make([]*sharedtypes.ServiceConfigUpdate, 0, len(session.Suppliers))
+	for _, sessionSupplier := range session.Suppliers {
+		// Session suppliers are dehydrated suppliers having only the service config
+		// of the session service, which requires the hydrated supplier to be verified.
+		supplierRes := &suppliertypes.QueryGetSupplierResponse{}
+		supplierReq := &suppliertypes.QueryGetSupplierRequest{OperatorAddress: sessionSupplier.OperatorAddress}
+		if err := query(getSupplierMethod, supplierReq, supplierRes); err != nil {
+			return err
+		}
+
+		supplier := &supplierRes.Supplier
+		if err := verifySupplier(ctx, store, sessionSupplier.OperatorAddress, supplier); err != nil {
+			return err
+		}
+
+		dehydratedSupplier := *supplier
+		dehydratedSupplier.Services = sessionSupplier.Services
+		dehydratedSupplier.ServiceConfigHistory = nil
+
+		var sessionServiceConfigs []*sharedtypes.SupplierServiceConfig
+		for _, serviceConfigUpdate := range supplier.ServiceConfigHistory {
+			if serviceConfigUpdate.Service.ServiceId == serviceId && serviceConfigUpdate.IsActive(blockHeight) {
+				sessionServiceConfigs = append(sessionServiceConfigs, serviceConfigUpdate.Service)
+			}
+		}
+
+		if len(sessionSupplier.Services) != 1 ||
+			!equalMessages([]*sharedtypes.Supplier{sessionSupplier}, []*sharedtypes.Supplier{&dehydratedSupplier}) ||
+			!containsMessage(sessionServiceConfigs, sessionSupplier.Services[0]) {
+			return ErrVerifiedQueryResponseMismatch.Wrapf(
+				"session %q supplier %q does not match its onchain service %q config",
+				session.SessionId, sessionSupplier.OperatorAddress, serviceId,
+			)
+		}
+
+		// Jailed suppliers are excluded from the sessions.
+		if supplier.IsJailed(blockHeight) {
+			return ErrVerifiedQueryResponseMismatch.Wrapf(
+				"session %q supplier %q is jailed at height %d",
+				session.SessionId, sessionSupplier.OperatorAddress, blockHeight,
+			)
+		}
+
+		candidateSupplierConfigs = append(candidateSupplierConfigs, &sharedtypes.ServiceConfigUpdate{
+			OperatorAddress: sessionSupplier.OperatorAddress,
+			Service:         sessionSupplier.Services[0],
+		})
+	}
+
+	return verifySessionSuppliersSelection(session, sessionIdBz, candidateSupplierConfigs, sessionParams.NumSuppliersPerSession)
+}
+
+// verifySessionSuppliersSelection verifies that the session suppliers are the
+// ones selected among the given proven candidates.
+//
+// All the candidates are part of the session if there are fewer of them than
+// the number of suppliers per session. Otherwise, the session suppliers are the
+// candidates sorted by their random weights for the session.
+func verifySessionSuppliersSelection(
+	session *sessiontypes.Session,
+	sessionIdBz []byte,
+	candidateSupplierConfigs []*sharedtypes.ServiceConfigUpdate,
+	numSuppliersPerSession uint64,
+) error {
+	operatorAddresses := make(map[string]struct{}, len(candidateSupplierConfigs))
+	for _, candidateSupplierConfig := range candidateSupplierConfigs {
+		if _, ok := operatorAddresses[candidateSupplierConfig.OperatorAddress]; ok {
+			return ErrVerifiedQueryResponseMismatch.Wrapf(
+				"session %q supplier %q is duplicated",
+				session.SessionId, candidateSupplierConfig.OperatorAddress,
+			)
+		}
+		operatorAddresses[candidateSupplierConfig.OperatorAddress] = struct{}{}
+	}
+
+	if uint64(len(candidateSupplierConfigs)) < numSuppliersPerSession {
+		return nil
+	}
+
+	sortedCandidates := sessionkeeper.SortCandidateSupplierConfigs(sessionIdBz, slices.Clone(candidateSupplierConfigs))
+	for i, sortedCandidate := range sortedCandidates {
+		if sortedCandidate.OperatorAddress != candidateSupplierConfigs[i].OperatorAddress {
+			return ErrVerifiedQueryResponseMismatch.Wrapf(
+				"session %q suppliers do not match their selection order",
+				session.SessionId,
+			)
+		}
+	}
+
+	return nil
+}
+
+// verifyRecord verifies that the given record is the one stored at key in the
+// given module store.
+func verifyRecord(
+	ctx context.Context,
+	store *provenStore,
+	storeName string,
+	key []byte,
+	record protoMarshaler,
+) error {
+	provenRecordBz, err := store.get(ctx, storeName, key)
+	if err != nil {
+		return err
+	}
+
+	recordBz, err := record.Marshal()
+	if err != nil {
+		return err
+	}
+
+	if !bytes.Equal(recordBz, provenRecordBz) {
+		return ErrVerifiedQueryResponseMismatch.Wrapf("record %q of store %q", key, storeName)
+	}
+
+	return nil
+}
+
+// verifyNotFoundRecord returns the given query error, after verifying that there
+// is no record stored at key in the given module store if the query error is a
+// NotFound one.
+func verifyNotFoundRecord(
+	ctx context.Context,
+	store *provenStore,
+	storeName string,
+	key []byte,
+	queryErr error,
+) error {
+	if status.Code(queryErr) != codes.NotFound {
+		return queryErr
+	}
+
+	provenRecordBz, err := store.get(ctx, storeName, key)
+	if err != nil {
+		return err
+	}
+
+	if provenRecordBz != nil {
+		return ErrVerifiedQueryResponseMismatch.Wrapf(
+			"record %q of store %q reported as not found: %v",
+			key, storeName, queryErr,
+		)
+	}
+
+	return queryErr
+}
+
+// getProvenParams returns the params stored at paramsKey in the given module store.
+func getProvenParams[P any, PP interface {
+	*P
+	Unmarshal([]byte) error
+}](ctx context.Context, store *provenStore, storeName string, paramsKey []byte) (*P, error) {
+	paramsBz, err := store.get(ctx, storeName, paramsKey)
+	if err != nil {
+		return nil, err
+	}
+
+	var params P
+	if err := PP(&params).Unmarshal(paramsBz); err != nil {
+		return nil, err
+	}
+
+	return &params, nil
+}
+
+// castQuery returns the given query args and reply as the request and response
+// types of the given method.
+func castQuery[Req, Res any](method string, args, reply any) (*Req, *Res, error) {
+	req, ok := args.(*Req)
+	if !ok {
+		return nil, nil, ErrVerifiedQueryResponseMismatch.Wrapf("unexpected %s request type %T", method, args)
+	}
+
+	res, ok := reply.(*Res)
+	if !ok {
+		return nil, nil, ErrVerifiedQueryResponseMismatch.Wrapf("unexpected %s response type %T", method, reply)
+	}
+
+	return req, res, nil
+}
+
+// prefixedKey returns the store key of the given module store prefix and key.
+func prefixedKey(prefix string, key []byte) []byte {
+	return append([]byte(prefix), key...)
+}
+
+// equalMessages returns true if the given messages lists have the same encoding.
+func equalMessages[M protoMarshaler](messagesA, messagesB []M) bool {
+	if len(messagesA) != len(messagesB) {
+		return false
+	}
+
+	for i := range messagesA {
+		if !equalMessage(messagesA[i], messagesB[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// containsMessage returns true if the given messages list contains a message
+// having the same encoding as the given one.
+func containsMessage[M protoMarshaler](messages []M, message M) bool {
+	for _, candidate := range messages {
+		if equalMessage(candidate, message) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// equalMessage returns true if the given messages have the same encoding.
+func equalMessage(messageA, messageB protoMarshaler) bool {
+	messageABz, errA := messageA.Marshal()
+	messageBBz, errB := messageB.Marshal()
+
+	return errA == nil && errB == nil && bytes.Equal(messageABz, messageBBz)
+}
@@ -2,7 +2,6 @@ package supplier
 
 import (
 	"context"
-	"sync"
 
 	"cosmossdk.io/depinject"
 	cosmostypes "github.com/cosmos/cosmos-sdk/types"
@@ -11,6 +10,7 @@ import (
 	"github.com/pokt-network/poktroll/pkg/client/keyring"
 	"github.com/pokt-network/poktroll/pkg/polylog"
 	prooftypes "github.com/pokt-network/poktroll/x/proof/types"
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
 )
 
 var _ client.SupplierClient = (*supplierClient)(nil)
@@ -23,11 +23,16 @@ type supplierClient struct {
 	// signingKeyAddr is the bech32 address representation of the operator key in the keyring.
 	signingKeyAddr string
 
-	// pendingTxMu is used to prevent concurrent txs with the same sequence number.
-	pendingTxMu sync.Mutex
-
 	txClient client.TxClient
 	txCtx    client.TxContext
+
+	// resubmissionPolicy, if set, defines how the claim and proof transactions
+	// which are not committed in time are resubmitted with a higher fee.
+	resubmissionPolicy *ResubmissionPolicy
+	// sharedQueryClient and blockClient are only required by the resubmission
+	// policy to determine the remaining blocks of the claim and proof windows.
+	sharedQueryClient client.SharedQueryClient
+	blockClient       client.BlockClient
 }
 
 // NewSupplierClient constructs a new SupplierClient with the given dependencies
@@ -36,9 +41,12 @@ type supplierClient struct {
 // Required dependencies:
 //   - client.TxClient
 //   - client.TxContext
+//   - client.SharedQueryClient (only if WithResubmissionPolicy is used)
+//   - client.BlockClient (only if WithResubmissionPolicy is used)
 //
 // Available options:
 //   - WithSigningKeyName
+//   - WithResubmissionPolicy
 func NewSupplierClient(
 	deps depinject.Config,
 	opts ...client.SupplierClientOption,
@@ -57,6 +65,16 @@ func NewSupplierClient(
 		opt(sClient)
 	}
 
+	if sClient.resubmissionPolicy != nil {
+		if err := depinject.Inject(
+			deps,
+			&sClient.sharedQueryClient,
+			&sClient.blockClient,
+		); err != nil {
+			return nil, err
+		}
+	}
+
 	if err := sClient.validateConfigAndSetDefaults(); err != nil {
 		return nil, err
 	}
@@ -67,28 +85,42 @@ func NewSupplierClient(
 // SubmitProofs constructs submit proof messages into a single transaction
 // then signs and broadcasts it to the network via #txClient. It blocks until
 // the transaction is included in a block or times out.
+//
+// Since #txClient manages the account sequence, it can be called concurrently
+// with CreateClaims and SubmitProofs to pipeline the supplier transactions.
 func (sClient *supplierClient) SubmitProofs(
 	ctx context.Context,
 	timeoutHeight int64,
 	proofMsgs ...client.MsgSubmitProof,
 ) error {
-	sClient.pendingTxMu.Lock()
-	defer sClient.pendingTxMu.Unlock()
 	logger := polylog.Ctx(ctx)
+	sClient.logPendingTxs(logger)
 
 	msgs := make([]cosmostypes.Msg, 0, len(proofMsgs))
 	for _, p := range proofMsgs {
 		msgs = append(msgs, p)
 	}
 
+	var sessionEndHeight int64
+	if len(proofMsgs) > 0 {
+		sessionEndHeight = proofMsgs[0].GetSessionHeader().GetSessionEndBlockHeight()
+	}
+
 	// TODO(@bryanchriswhite): reconcile splitting of supplier & proof modules
 	//  with offchain pkgs/nomenclature.
-	_, eitherErr := sClient.txClient.SignAndBroadcastWithTimeoutHeight(ctx, timeoutHeight, msgs...)
-	err, errCh := eitherErr.SyncOrAsyncError()
-	if err != nil {
-		return err
-	}
+	return sClient.broadcastTx(
+		ctx,
+		txTypeSubmitProofs,
+		timeoutHeight,
+		sessionEndHeight,
+		sharedtypes.GetProofWindowCloseHeight,
+		msgs,
+		func() { logSubmittedProofs(logger, proofMsgs) },
+	)
+}
 
+// logSubmittedProofs logs the details of each submitted proof.
+func logSubmittedProofs(logger polylog.Logger, proofMsgs []client.MsgSubmitProof) {
 	for _, p := range proofMsgs {
 		// Type casting does not need to be checked here since the concrete type is
 		// guaranteed to implement the interface which is just an identity for the
@@ -105,37 +137,47 @@ func (sClient *supplierClient) SubmitProofs(
 			}).
 			Msg("submitted a new proof")
 	}
-
-	return <-errCh
 }
 
 // CreateClaim constructs create claim messages into a single transaction
 // then signs and broadcasts it to the network via #txClient. It blocks until
 // the transaction is included in a block or times out.
+//
+// Since #txClient manages the account sequence, it can be called concurrently
+// with CreateClaims and SubmitProofs to pipeline the supplier transactions.
 func (sClient *supplierClient) CreateClaims(
 	ctx context.Context,
 	timeoutHeight int64,
 	claimMsgs ...client.MsgCreateClaim,
 ) error {
-	// Prevent concurrent txs with the same sequence number.
-	sClient.pendingTxMu.Lock()
-	defer sClient.pendingTxMu.Unlock()
-
 	logger := polylog.Ctx(ctx)
+	sClient.logPendingTxs(logger)
 
 	msgs := make([]cosmostypes.Msg, 0, len(claimMsgs))
 	for _, c := range claimMsgs {
 		msgs = append(msgs, c)
 	}
 
+	var sessionEndHeight int64
+	if len(claimMsgs) > 0 {
+		sessionEndHeight = claimMsgs[0].GetSessionHeader().GetSessionEndBlockHeight()
+	}
+
 	// TODO(@bryanchriswhite): reconcile splitting of supplier & proof modules
 	//  with offchain pkgs/nomenclature.
-	_, eitherErr := sClient.txClient.SignAndBroadcastWithTimeoutHeight(ctx, timeoutHeight, msgs...)
-	err, errCh := eitherErr.SyncOrAsyncError()
-	if err != nil {
-		return err
-	}
+	return sClient.broadcastTx(
+		ctx,
+		txTypeCreateClaims,
+		timeoutHeight,
+		sessionEndHeight,
+		sharedtypes.GetClaimWindowCloseHeight,
+		msgs,
+		func() { logCreatedClaims(logger, claimMsgs) },
+	)
+}
 
+// logCreatedClaims logs the details of each created claim.
+func logCreatedClaims(logger polylog.Logger, claimMsgs []client.MsgCreateClaim) {
 	for _, c := range claimMsgs {
 		// Type casting does not need to be checked here since the concrete type is
 		// guaranteed to implement the interface which is just an identity for the
@@ -152,8 +194,21 @@ func (sClient *supplierClient) CreateClaims(
 			}).
 			Msg("created a new claim")
 	}
+}
+
+// logPendingTxs logs the transactions of the supplier which are still pending,
+// and which the next transaction is pipelined behind.
+func (sClient *supplierClient) logPendingTxs(logger polylog.Logger) {
+	pendingTxs := sClient.txClient.PendingTxs()
+	if len(pendingTxs) == 0 {
+		return
+	}
 
-	return <-errCh
+	logger.Debug().
+		Str("supplier_operator_addr", sClient.signingKeyAddr).
+		Int("num_pending_txs", len(pendingTxs)).
+		Uint64("last_pending_tx_sequence", pendingTxs[len(pendingTxs)-1].Sequence).
+		Msg("pipelining transaction behind pending transactions")
 }
 
 // OperatorAddress returns the bech32 string representation of the supplier operator address.
@@ -2,23 +2,39 @@ package supplier_test
 
 import (
 	"context"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"cosmossdk.io/depinject"
+	"cosmossdk.io/math"
+	cometbytes "github.com/cometbft/cometbft/libs/bytes"
+	cometrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+	comettypes "github.com/cometbft/cometbft/types"
+	cosmostypes "github.com/cosmos/cosmos-sdk/types"
 	"github.com/pokt-network/smt"
 	"github.com/pokt-network/smt/kvstore/pebble"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 
+	"github.com/pokt-network/poktroll/app/volatile"
+	"github.com/pokt-network/poktroll/pkg/client"
 	"github.com/pokt-network/poktroll/pkg/client/keyring"
 	"github.com/pokt-network/poktroll/pkg/client/supplier"
+	"github.com/pokt-network/poktroll/pkg/client/tx"
 	"github.com/pokt-network/poktroll/pkg/crypto/protocol"
+	"github.com/pokt-network/poktroll/pkg/either"
+	"github.com/pokt-network/poktroll/pkg/observable/channel"
 	"github.com/pokt-network/poktroll/testutil/mockclient"
+	"github.com/pokt-network/poktroll/testutil/testclient/testblock"
+	"github.com/pokt-network/poktroll/testutil/testclient/testeventsquery"
 	"github.com/pokt-network/poktroll/testutil/testclient/testkeyring"
+	"github.com/pokt-network/poktroll/testutil/testclient/testqueryclients"
 	"github.com/pokt-network/poktroll/testutil/testclient/testtx"
 	prooftypes "github.com/pokt-network/poktroll/x/proof/types"
 	sessiontypes "github.com/pokt-network/poktroll/x/session/types"
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
 )
 
 const (
@@ -211,3 +227,298 @@ func TestSupplierClient_SubmitProof(t *testing.T) {
 		t.Log("OK: SubmitProof unblocked after signAndBroadcastDelay")
 	}
 }
+
+func TestSupplierClient_CreateClaims_Resubmission(t *testing.T) {
+	sharedParams := sharedtypes.DefaultParams()
+	sessionEndHeight := int64(sharedParams.GetNumBlocksPerSession())
+	claimWindowCloseHeight := sharedtypes.GetClaimWindowCloseHeight(&sharedParams, sessionEndHeight)
+
+	resubmissionPolicy := &supplier.ResubmissionPolicy{
+		AttemptTimeoutBlocks: 2,
+		FeeBumpFactor:        1.5,
+		MaxFeeMultiplier:     2,
+	}
+
+	tests := []struct {
+		desc string
+		// currentHeight is the height of the last block when the claim is created.
+		currentHeight int64
+		// attemptErrs are the errors of each successive broadcast attempt.
+		attemptErrs                []error
+		expectedErr                error
+		expectedFeeMultipliers     []float64
+		expectedTimeoutHeightDelta int64
+	}{
+		{
+			desc:                       "claim committed on first attempt",
+			currentHeight:              claimWindowCloseHeight - 3,
+			attemptErrs:                []error{nil},
+			expectedErr:                nil,
+			expectedFeeMultipliers:     []float64{1},
+			expectedTimeoutHeightDelta: 2,
+		},
+		{
+			desc:                       "claim resubmitted with capped fee bumps until committed",
+			currentHeight:              claimWindowCloseHeight - 3,
+			attemptErrs:                []error{tx.ErrTxTimeout, tx.ErrTxTimeout, nil},
+			expectedErr:                nil,
+			expectedFeeMultipliers:     []float64{1, 1.5, 2},
+			expectedTimeoutHeightDelta: 2,
+		},
+		{
+			desc:                       "evicted claim resubmitted without fee bump",
+			currentHeight:              claimWindowCloseHeight - 3,
+			attemptErrs:                []error{tx.ErrTxEvicted, nil},
+			expectedErr:                nil,
+			expectedFeeMultipliers:     []float64{1, 1},
+			expectedTimeoutHeightDelta: 2,
+		},
+		{
+			desc:                       "claim not resubmitted after the claim window closed",
+			currentHeight:              claimWindowCloseHeight - 1,
+			attemptErrs:                []error{tx.ErrTxTimeout},
+			expectedErr:                tx.ErrTxTimeout,
+			expectedFeeMultipliers:     []float64{1},
+			expectedTimeoutHeightDelta: 1,
+		},
+		{
+			desc:                       "claim not resubmitted on non timeout errors",
+			currentHeight:              claimWindowCloseHeight - 3,
+			attemptErrs:                []error{tx.ErrCheckTx},
+			expectedErr:                tx.ErrCheckTx,
+			expectedFeeMultipliers:     []float64{1},
+			expectedTimeoutHeightDelta: 2,
+		},
+		{
+			desc:                       "claim not resubmitted when its tx status is unknown",
+			currentHeight:              claimWindowCloseHeight - 3,
+			attemptErrs:                []error{tx.ErrQueryTx},
+			expectedErr:                tx.ErrQueryTx,
+			expectedFeeMultipliers:     []float64{1},
+			expectedTimeoutHeightDelta: 2,
+		},
+		{
+			desc:                       "claim not resubmitted when its tx failed",
+			currentHeight:              claimWindowCloseHeight - 3,
+			attemptErrs:                []error{tx.ErrTxExecution},
+			expectedErr:                tx.ErrTxExecution,
+			expectedFeeMultipliers:     []float64{1},
+			expectedTimeoutHeightDelta: 2,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			ctx := context.Background()
+			ctrl := gomock.NewController(t)
+
+			keyring, testAppKey := testkeyring.NewTestKeyringWithKey(t, testSigningKeyName)
+			testAppAddr, err := testAppKey.GetAddress()
+			require.NoError(t, err)
+
+			var feeMultipliers []float64
+			txClientMock := mockclient.NewMockTxClient(ctrl)
+			txClientMock.EXPECT().PendingTxs().Return(nil).AnyTimes()
+			txClientMock.EXPECT().
+				SignAndBroadcastWithFeeMultiplier(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(
+					_ context.Context,
+					timeoutHeight int64,
+					feeMultiplier float64,
+					_ ...cosmostypes.Msg,
+				) (*cosmostypes.TxResponse, either.AsyncError) {
+					require.Equal(t, test.currentHeight+test.expectedTimeoutHeightDelta, timeoutHeight)
+
+					errCh := make(chan error, 1)
+					errCh <- test.attemptErrs[len(feeMultipliers)]
+					close(errCh)
+
+					feeMultipliers = append(feeMultipliers, feeMultiplier)
+					return &cosmostypes.TxResponse{}, either.AsyncErr(errCh)
+				}).
+				Times(len(test.attemptErrs))
+
+			txCtxMock, _ := testtx.NewAnyTimesTxTxContext(t, keyring)
+			deps := depinject.Supply(
+				txCtxMock,
+				txClientMock,
+				testqueryclients.NewTestSharedQueryClient(t),
+				testblock.NewAnyTimeLastBlockBlockClient(t, nil, test.currentHeight),
+			)
+
+			supplierClient, err := supplier.NewSupplierClient(
+				deps,
+				supplier.WithSigningKeyName(testAppKey.Name),
+				supplier.WithResubmissionPolicy(resubmissionPolicy),
+			)
+			require.NoError(t, err)
+
+			msgClaim := &prooftypes.MsgCreateClaim{
+				SessionHeader: &sessiontypes.SessionHeader{
+					ApplicationAddress:      testAppAddr.String(),
+					SessionStartBlockHeight: 1,
+					SessionEndBlockHeight:   sessionEndHeight,
+					ServiceId:               testService,
+				},
+			}
+
+			err = supplierClient.CreateClaims(ctx, claimWindowCloseHeight, msgClaim)
+			if test.expectedErr != nil {
+				require.ErrorIs(t, err, test.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, test.expectedFeeMultipliers, feeMultipliers)
+		})
+	}
+}
+
+func TestSupplierClient_CreateClaims_ResubmissionOnTxClientTimeout(t *testing.T) {
+	sharedParams := sharedtypes.DefaultParams()
+	sessionEndHeight := int64(sharedParams.GetNumBlocksPerSession())
+	claimWindowCloseHeight := sharedtypes.GetClaimWindowCloseHeight(&sharedParams, sessionEndHeight)
+	// Leave enough blocks before the claim window closes for a single resubmission.
+	currentHeight := claimWindowCloseHeight - 3
+
+	var (
+		ctx                    = context.Background()
+		ctrl                   = gomock.NewController(t)
+		txResultsBzPublishChMu = new(sync.Mutex)
+		txResultsBzPublishCh   chan<- either.Bytes
+		lastBlockHeight        atomic.Int64
+		// broadcastFeesCh receives the fee of each distinct broadcast transaction.
+		broadcastFeesCh = make(chan cosmostypes.Coins, 10)
+		expectedTx      cometbytes.HexBytes
+	)
+	lastBlockHeight.Store(currentHeight)
+
+	keyring, testAppKey := testkeyring.NewTestKeyringWithKey(t, testSigningKeyName)
+	testAppAddr, err := testAppKey.GetAddress()
+	require.NoError(t, err)
+
+	// The transactions are never committed and are not found when queried once
+	// they time out, as is the case for the ones which never made it into a block.
+	txCtxMock := testtx.NewBaseTxContext(t, testSigningKeyName, keyring, &expectedTx)
+	_, txCtx := testtx.NewAnyTimesTxTxContext(t, keyring)
+	txDecoder := txCtx.GetClientCtx().TxConfig.TxDecoder()
+	broadcastTxHashes := make(map[string]struct{})
+	txCtxMock.EXPECT().BroadcastTx(gomock.Any()).
+		DoAndReturn(func(txBytes []byte) (*cosmostypes.TxResponse, error) {
+			var txHash cometbytes.HexBytes = comettypes.Tx(txBytes).Hash()
+
+			// Ignore the rebroadcasts of the same transaction.
+			if _, ok := broadcastTxHashes[txHash.String()]; !ok {
+				broadcastTxHashes[txHash.String()] = struct{}{}
+
+				decodedTx, decodeErr := txDecoder(txBytes)
+				require.NoError(t, decodeErr)
+				broadcastFeesCh <- decodedTx.(cosmostypes.FeeTx).GetFee()
+			}
+
+			return &cosmostypes.TxResponse{TxHash: txHash.String()}, nil
+		}).
+		AnyTimes()
+	txCtxMock.EXPECT().GetSimulatedTxGas(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(uint64(1000), nil).
+		AnyTimes()
+	txCtxMock.EXPECT().QueryTx(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, txHash []byte, _ bool) (*cometrpctypes.ResultTx, error) {
+			return nil, tx.ErrTxNotFound.Wrapf("with hash %X", txHash)
+		}).
+		AnyTimes()
+
+	blocksObs, blocksPublishCh := channel.NewReplayObservable[client.Block](ctx, 1)
+	blockClientMock := mockclient.NewMockBlockClient(ctrl)
+	blockClientMock.EXPECT().CommittedBlocksSequence(gomock.Any()).Return(blocksObs).AnyTimes()
+	blockClientMock.EXPECT().LastBlock(gomock.Any()).
+		DoAndReturn(func(context.Context) client.Block {
+			return testblock.NewAnyTimesBlock(t, nil, lastBlockHeight.Load())
+		}).
+		AnyTimes()
+	publishBlock := func(height int64) {
+		lastBlockHeight.Store(height)
+		blocksPublishCh <- testblock.NewAnyTimesBlock(t, nil, height)
+	}
+
+	eventsQueryClient := testeventsquery.NewOneTimeTxEventsQueryClient(
+		ctx, t, txResultsBzPublishChMu, &txResultsBzPublishCh,
+	)
+
+	// The gas price of 1upokt multiplied by the fee bump factor is capped at 1.2upokt.
+	maxGasPrices := cosmostypes.NewDecCoins(
+		cosmostypes.NewDecCoinFromDec(volatile.DenomuPOKT, math.LegacyNewDecWithPrec(12, 1)),
+	)
+	txClient, err := tx.NewTxClient(
+		ctx,
+		depinject.Supply(eventsQueryClient, txCtxMock, blockClientMock),
+		append(
+			testtx.WithDefaultTxClientOptions(t, testSigningKeyName),
+			tx.WithMaxGasPrices(&maxGasPrices),
+		)...,
+	)
+	require.NoError(t, err)
+
+	supplierClient, err := supplier.NewSupplierClient(
+		depinject.Supply(
+			txCtxMock,
+			txClient,
+			testqueryclients.NewTestSharedQueryClient(t),
+			blockClientMock,
+		),
+		supplier.WithSigningKeyName(testAppKey.Name),
+		supplier.WithResubmissionPolicy(&supplier.ResubmissionPolicy{
+			AttemptTimeoutBlocks: 2,
+			FeeBumpFactor:        1.5,
+			MaxFeeMultiplier:     2,
+		}),
+	)
+	require.NoError(t, err)
+
+	msgClaim := &prooftypes.MsgCreateClaim{
+		SupplierOperatorAddress: testAppAddr.String(),
+		SessionHeader: &sessiontypes.SessionHeader{
+			ApplicationAddress:      testAppAddr.String(),
+			SessionId:               "session_id",
+			SessionStartBlockHeight: 1,
+			SessionEndBlockHeight:   sessionEndHeight,
+			ServiceId:               testService,
+		},
+		RootHash: []byte("root_hash"),
+	}
+
+	createClaimsErrCh := make(chan error, 1)
+	go func() {
+		createClaimsErrCh <- supplierClient.CreateClaims(ctx, claimWindowCloseHeight, msgClaim)
+	}()
+
+	receiveFee := func() cosmostypes.Coins {
+		select {
+		case fee := <-broadcastFeesCh:
+			return fee
+		case <-time.After(time.Second):
+			t.Fatal("timed out waiting for the claim tx to be broadcast")
+			return nil
+		}
+	}
+
+	// The first attempt times out 2 blocks later, at which point the tx is not found.
+	require.Equal(t, "1000upokt", receiveFee().String())
+	publishBlock(currentHeight + 1)
+	publishBlock(currentHeight + 2)
+
+	// The claim is resubmitted with a bumped fee, capped by the max gas prices,
+	// and times out when the claim window closes.
+	require.Equal(t, "1200upokt", receiveFee().String())
+	publishBlock(currentHeight + 3)
+
+	select {
+	case err = <-createClaimsErrCh:
+		require.ErrorIs(t, err, tx.ErrTxTimeout)
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for CreateClaims to return")
+	}
+
+	// No further resubmission happens once the claim window is closed.
+	require.Empty(t, broadcastFeesCh)
+}
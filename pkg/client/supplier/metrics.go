@@ -0,0 +1,51 @@
+package supplier
+
+import (
+	"github.com/go-kit/kit/metrics/prometheus"
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	relayMinerProcess = "relayminer"
+
+	txResubmissionsTotal          = "tx_resubmissions_total"
+	txResubmissionFeeMultiplier   = "tx_resubmission_fee_multiplier"
+	txResubmissionsExhaustedTotal = "tx_resubmissions_exhausted_total"
+)
+
+var (
+	// TxResubmissionsTotal is a Counter metric for the claim and proof transactions
+	// resubmitted with a higher fee after they were not committed in time.
+	// It is labeled by 'supplier_operator_address' and 'tx_type'.
+	//
+	// Usage:
+	// - Monitor how often claims and proofs get stuck in the mempool.
+	// - Tune the resubmission policy and the gas prices.
+	TxResubmissionsTotal = prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+		Subsystem: relayMinerProcess,
+		Name:      txResubmissionsTotal,
+		Help:      "Total number of claim and proof transactions resubmitted with a higher fee.",
+	}, []string{"supplier_operator_address", "tx_type"})
+
+	// TxResubmissionFeeMultiplier is a Histogram metric for the fee multiplier
+	// applied to each resubmitted claim and proof transaction.
+	// It is labeled by 'supplier_operator_address' and 'tx_type'.
+	//
+	// Buckets:
+	// - 1 to 10, covering the range of reasonable fee multiplier ceilings.
+	TxResubmissionFeeMultiplier = prometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+		Subsystem: relayMinerProcess,
+		Name:      txResubmissionFeeMultiplier,
+		Help:      "Fee multiplier applied to the resubmitted claim and proof transactions.",
+		Buckets:   []float64{1, 1.25, 1.5, 2, 3, 5, 10},
+	}, []string{"supplier_operator_address", "tx_type"})
+
+	// TxResubmissionsExhaustedTotal is a Counter metric for the claim and proof
+	// transactions which were still not committed when their window closed.
+	// It is labeled by 'supplier_operator_address' and 'tx_type'.
+	TxResubmissionsExhaustedTotal = prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+		Subsystem: relayMinerProcess,
+		Name:      txResubmissionsExhaustedTotal,
+		Help:      "Total number of claim and proof transactions not committed before their window closed.",
+	}, []string{"supplier_operator_address", "tx_type"})
+)
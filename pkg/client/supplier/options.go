@@ -10,3 +10,14 @@ func WithSigningKeyName(keyName string) client.SupplierClientOption {
 		sClient.(*supplierClient).signingKeyName = keyName
 	}
 }
+
+// WithResubmissionPolicy sets the policy according to which the supplier client
+// resubmits, with a higher fee, the claim and proof transactions which are not
+// committed in time.
+// The supplier client requires a client.SharedQueryClient and a client.BlockClient
+// dependencies when this option is used.
+func WithResubmissionPolicy(policy *ResubmissionPolicy) client.SupplierClientOption {
+	return func(sClient client.SupplierClient) {
+		sClient.(*supplierClient).resubmissionPolicy = policy
+	}
+}
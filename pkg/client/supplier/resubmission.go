@@ -0,0 +1,168 @@
+package supplier
+
+import (
+	"context"
+	"errors"
+
+	cosmostypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/pokt-network/poktroll/pkg/client/tx"
+	"github.com/pokt-network/poktroll/pkg/polylog"
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
+)
+
+const (
+	txTypeCreateClaims = "create_claims"
+	txTypeSubmitProofs = "submit_proofs"
+)
+
+// ResubmissionPolicy defines how the supplier client resubmits the claim and
+// proof transactions which are not committed in time, usually because their
+// fee is too low compared to the other transactions competing for the block space.
+type ResubmissionPolicy struct {
+	// AttemptTimeoutBlocks is the number of blocks after which a transaction that
+	// is not committed is considered stuck and resubmitted.
+	AttemptTimeoutBlocks int64
+	// FeeBumpFactor is the factor by which the fee is multiplied on each resubmission.
+	FeeBumpFactor float64
+	// MaxFeeMultiplier is the ceiling of the cumulative fee multiplier, relative
+	// to the fee of the first submission. The absolute ceiling of the gas prices
+	// is enforced by the TxClient (see tx.WithMaxGasPrices).
+	MaxFeeMultiplier float64
+}
+
+// windowCloseHeightFn returns the height at which the claim or proof window of
+// the session ending at sessionEndHeight closes.
+type windowCloseHeightFn func(sharedParams *sharedtypes.Params, sessionEndHeight int64) int64
+
+// broadcastTx signs and broadcasts the given messages in a single transaction
+// and blocks until it is committed or times out. onBroadcast is called once the
+// transaction is first accepted by the mempool.
+//
+// A transaction pipelined behind one which timed out is evicted by the txClient
+// (see: tx.ErrTxEvicted) and resubmitted with a resynced account sequence.
+//
+// If a resubmission policy is configured, the transaction is resubmitted with a
+// higher fee each time it is not committed within the policy's attempt timeout,
+// for as long as the claim or proof window of its session is open.
+func (sClient *supplierClient) broadcastTx(
+	ctx context.Context,
+	txType string,
+	timeoutHeight int64,
+	sessionEndHeight int64,
+	getWindowCloseHeight windowCloseHeightFn,
+	msgs []cosmostypes.Msg,
+	onBroadcast func(),
+) error {
+	logger := polylog.Ctx(ctx).With(
+		"supplier_operator_addr", sClient.signingKeyAddr,
+		"tx_type", txType,
+	)
+
+	if sClient.resubmissionPolicy == nil {
+		for attempt := 0; ; attempt++ {
+			_, eitherErr := sClient.txClient.SignAndBroadcastWithTimeoutHeight(ctx, timeoutHeight, msgs...)
+			err, errCh := eitherErr.SyncOrAsyncError()
+			if err != nil {
+				return err
+			}
+
+			if attempt == 0 {
+				onBroadcast()
+			}
+
+			err = <-errCh
+			if !errors.Is(err, tx.ErrTxEvicted) {
+				return err
+			}
+
+			logger.Warn().
+				Int("attempt", attempt+1).
+				Msg("transaction evicted after a preceding one timed out, resubmitting it")
+		}
+	}
+
+	sharedParams, err := sClient.sharedQueryClient.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Never let a transaction outlive the window of its session, nor the timeout
+	// height requested by the caller, if any.
+	deadlineHeight := getWindowCloseHeight(sharedParams, sessionEndHeight)
+	if timeoutHeight > 0 {
+		deadlineHeight = min(deadlineHeight, timeoutHeight)
+	}
+
+	feeMultiplier := 1.0
+	for attempt := 0; ; attempt++ {
+		currentHeight := sClient.blockClient.LastBlock(ctx).Height()
+		attemptTimeoutHeight := min(currentHeight+sClient.resubmissionPolicy.AttemptTimeoutBlocks, deadlineHeight)
+
+		_, eitherErr := sClient.txClient.SignAndBroadcastWithFeeMultiplier(
+			ctx,
+			attemptTimeoutHeight,
+			feeMultiplier,
+			msgs...,
+		)
+		err, errCh := eitherErr.SyncOrAsyncError()
+		if err != nil {
+			return err
+		}
+
+		if attempt == 0 {
+			onBroadcast()
+		} else {
+			TxResubmissionsTotal.With(
+				"supplier_operator_address", sClient.signingKeyAddr,
+				"tx_type", txType,
+			).Add(1)
+			TxResubmissionFeeMultiplier.With(
+				"supplier_operator_address", sClient.signingKeyAddr,
+				"tx_type", txType,
+			).Observe(feeMultiplier)
+		}
+
+		err = <-errCh
+
+		// The evicted transaction was not committed because of the one it was
+		// pipelined behind, not because of its fee.
+		if errors.Is(err, tx.ErrTxEvicted) {
+			logger.Warn().
+				Int("attempt", attempt+1).
+				Float64("fee_multiplier", feeMultiplier).
+				Msg("transaction evicted after a preceding one timed out, resubmitting it")
+			continue
+		}
+
+		if !errors.Is(err, tx.ErrTxTimeout) {
+			return err
+		}
+
+		// The transaction timed out at attemptTimeoutHeight, which leaves no block
+		// to resubmit it if the deadline is reached.
+		if attemptTimeoutHeight >= deadlineHeight {
+			TxResubmissionsExhaustedTotal.With(
+				"supplier_operator_address", sClient.signingKeyAddr,
+				"tx_type", txType,
+			).Add(1)
+			logger.Error().
+				Int("attempts", attempt+1).
+				Int64("deadline_height", deadlineHeight).
+				Msg("transaction not committed before its window closed")
+			return err
+		}
+
+		feeMultiplier = min(
+			feeMultiplier*sClient.resubmissionPolicy.FeeBumpFactor,
+			sClient.resubmissionPolicy.MaxFeeMultiplier,
+		)
+
+		logger.Warn().
+			Int("attempt", attempt+1).
+			Int64("timeout_height", attemptTimeoutHeight).
+			Int64("deadline_height", deadlineHeight).
+			Float64("fee_multiplier", feeMultiplier).
+			Msg("transaction timed out, resubmitting it with a higher fee")
+	}
+}
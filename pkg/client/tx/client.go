@@ -1,23 +1,24 @@
 package tx
 
 import (
-	"bytes"
 	"context"
 	"encoding/hex"
+	"errors"
 	"fmt"
+	"strconv"
 	"sync"
 
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/math"
 	abci "github.com/cometbft/cometbft/abci/types"
-	"github.com/cometbft/cometbft/libs/json"
-	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
 	comettypes "github.com/cometbft/cometbft/types"
 	cosmosclient "github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	cosmostypes "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"go.uber.org/multierr"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/pokt-network/poktroll/app/volatile"
 	"github.com/pokt-network/poktroll/pkg/client"
@@ -25,6 +26,7 @@ import (
 	"github.com/pokt-network/poktroll/pkg/client/keyring"
 	"github.com/pokt-network/poktroll/pkg/either"
 	"github.com/pokt-network/poktroll/pkg/encoding"
+	"github.com/pokt-network/poktroll/pkg/polylog"
 	"github.com/pokt-network/poktroll/pkg/retry"
 )
 
@@ -35,42 +37,31 @@ const (
 	// TODO_TECHDEBT: populate this from the config file.
 	DefaultCommitTimeoutHeightOffset = 5
 
+	// DefaultRebroadcastHeightOffset is the default number of blocks after which
+	// a pending transaction is rebroadcast, in case it was evicted from the mempool.
+	DefaultRebroadcastHeightOffset = 2
+
+	// maxSequenceMismatchRetries is the number of times a transaction is signed
+	// again with the account sequence expected by the network, when broadcasting
+	// it fails because of an account sequence mismatch.
+	maxSequenceMismatchRetries = 3
+
 	// defaultTxReplayLimit is the number of comettypes.EventDataTx events that the replay
 	// observable returned by LastNBlocks() will be able to replay.
 	// TODO_TECHDEBT/TODO_FUTURE: add a `blocksReplayLimit` field to the blockClient
 	// struct that defaults to this but can be overridden via an option.
 	defaultTxReplayLimit = 100
-
-	// txWithSenderAddrQueryFmt is the query used to subscribe to cometbft transactions
-	// events where the sender address matches the interpolated address.
-	// (see: https://docs.cosmos.network/v0.47/core/events#subscribing-to-events)
-	txWithSenderAddrQueryFmt = "tm.event='Tx' AND message.sender='%s'"
 )
 
 // TODO_TECHDEBT(@bryanchriswhite): Refactor this to use the EventsReplayClient
 // In order to simplify the logic of the TxClient
 var _ client.TxClient = (*txClient)(nil)
 
-// CometTxEvent is used to deserialize incoming transaction event messages
-// from the respective events query subscription. This structure is adapted
-// to handle CometBFT's unique serialization format, which diverges from
-// conventional approaches seen in implementations like rollkit's. The design
-// ensures accurate parsing and compatibility with CometBFT's serialization
-// of transaction results.
-type CometTxEvent struct {
-	Data struct {
-		// TxResult is nested to accommodate CometBFT's serialization format,
-		// ensuring correct deserialization of transaction results.
-		Value struct {
-			TxResult abci.TxResult
-		} `json:"value"`
-	} `json:"data"`
-}
-
 // txClient orchestrates building, signing, broadcasting, and querying of transactions.
 //
-// It maintains a single events query subscription to its own transactions (via the
-// EventsQueryClient) to receive status notifications.
+// It observes the committed transactions through the events query subscription
+// shared by all the transactions events consumers (via the EventsQueryClient) to
+// receive status notifications about its own transactions.
 //
 // Dependencies:
 // - Uses BlockClient as a synchronized block-height timer for transaction timeout logic
@@ -79,11 +70,19 @@ type CometTxEvent struct {
 // Timeout handling:
 // - Upon timeout, the client queries the network for the transaction's last status
 // - This status is used to derive the asynchronous error populated in either.AsyncError
+//
+// Sequence handling:
+// - The account sequence is tracked locally so several transactions can be pending
+// - It is synced from the network initially, after a timeout, and on sequence mismatch
+// - Pending transactions are periodically rebroadcast in case they were evicted
 type txClient struct {
 	// TODO_TECHDEBT: this should be configurable & integrated w/ viper, flags, etc.
 	// commitTimeoutHeightOffset is the number of blocks after the latest block
 	// that a transactions should be considered errored if it has not been committed.
 	commitTimeoutHeightOffset int64
+	// rebroadcastHeightOffset is the number of blocks after which a pending
+	// transaction is broadcast again.
+	rebroadcastHeightOffset int64
 	// signingKeyName is the name of the key in the keyring to use for signing
 	// transactions.
 	signingKeyName string
@@ -93,15 +92,27 @@ type txClient struct {
 	// txCtx is the transactions context which encapsulates transactions building, signing,
 	// broadcasting, and querying, as well as keyring access.
 	txCtx client.TxContext
-	// eventsReplayClient is the client used to subscribe to transactions events from this
-	// sender. It is used to receive notifications about transactions events corresponding
+	// eventsReplayClient is the client used to subscribe to the committed transactions.
+	// It is used to receive notifications about transactions events corresponding
 	// to transactions which it has constructed, signed, and broadcast.
 	eventsReplayClient client.EventsReplayClient[*abci.TxResult]
 	// blockClient is the client used to query for the latest block height.
 	// It is used to implement timeout logic for transactions which weren't committed.
 	blockClient client.BlockClient
 
-	// txsMutex protects txErrorChans and txTimeoutPool maps.
+	// sequenceMu serializes the signing and broadcasting of transactions so they
+	// reach the mempool in the order of their account sequence. It protects
+	// accountNumber, nextSequence and isSequenceSynced.
+	sequenceMu sync.Mutex
+	// accountNumber is the account number of the signing address.
+	accountNumber uint64
+	// nextSequence is the account sequence the next transaction is signed with.
+	nextSequence uint64
+	// isSequenceSynced is false until accountNumber and nextSequence are queried
+	// from the network, and when they need to be queried again.
+	isSequenceSynced bool
+
+	// txsMutex protects txErrorChans, txTimeoutPool and pendingTxs maps.
 	txsMutex sync.Mutex
 	// txErrorChans maps tx_hash->channel which will receive an error or nil,
 	// and close, when the transactions with the given hash is committed.
@@ -110,10 +121,17 @@ type txClient struct {
 	// is used to ensure that transactions error channels receive and close in the event
 	// that they have not already by the given timeout height.
 	txTimeoutPool txTimeoutPool
+	// pendingTxs maps tx_hash->pendingTx for the transactions which are neither
+	// committed nor timed out yet.
+	pendingTxs map[txHash]*pendingTx
 
 	// gasPrices is the gas unit prices used for sending transactions.
 	gasPrices *cosmostypes.DecCoins
 
+	// maxGasPrices is the ceiling of the gas unit prices, which the gas prices
+	// multiplied by a fee multiplier never exceed. It is nil if uncapped.
+	maxGasPrices *cosmostypes.DecCoins
+
 	// gasAdjustment is the gas adjustment factor used for sending transactions.
 	gasAdjustment float64
 
@@ -166,8 +184,10 @@ func NewTxClient(
 ) (_ client.TxClient, err error) {
 	txnClient := &txClient{
 		commitTimeoutHeightOffset: DefaultCommitTimeoutHeightOffset,
+		rebroadcastHeightOffset:   DefaultRebroadcastHeightOffset,
 		txErrorChans:              make(txErrorChansByHash),
 		txTimeoutPool:             make(txTimeoutPool),
+		pendingTxs:                make(map[txHash]*pendingTx),
 	}
 
 	if err = depinject.Inject(
@@ -186,15 +206,13 @@ func NewTxClient(
 		return nil, err
 	}
 
-	// Form a query based on the client's signing address.
-	eventQuery := fmt.Sprintf(txWithSenderAddrQueryFmt, txnClient.signingAddr)
-
-	// Initialize and events replay client.
-	txnClient.eventsReplayClient, err = events.NewEventsReplayClient[*abci.TxResult](
+	// Initialize an events replay client of the committed transactions, sharing
+	// the committed transactions subscription with the other tx clients and
+	// events consumers. The transactions of other signers are ignored since
+	// they are not pending in this client.
+	txnClient.eventsReplayClient, err = events.NewCommittedTxsReplayClient(
 		ctx,
 		deps,
-		eventQuery,
-		UnmarshalTxResult,
 		defaultTxReplayLimit,
 		events.WithConnRetryLimit[*abci.TxResult](txnClient.connRetryLimit),
 	)
@@ -222,13 +240,17 @@ func NewTxClient(
 //  2. Constructs the transaction using the Cosmos SDK's transaction builder.
 //  3. Sets the transaction's timeout height.
 //  4. Sets a default gas limit (note: this will be made configurable in the future).
-//  5. Signs the transaction.
+//  5. Signs the transaction with the locally tracked account sequence.
 //  6. Validates the constructed transaction.
 //  7. Serializes and broadcasts the transaction.
-//  8. Checks the broadcast response for errors.
+//  8. Checks the broadcast response for errors, signing and broadcasting the
+//     transaction again with the expected sequence on account sequence mismatch.
 //  9. If all the above steps are successful, the function registers the
 //     transaction as pending.
 //
+// Transactions are broadcast one at a time, but several of them can be pending
+// at the same time since the function does not wait for them to be committed.
+//
 // If any step encounters an error, it returns an either.AsyncError populated with
 // the synchronous error. If the function completes successfully, it returns an
 // either.AsyncError populated with the error channel which will receive if the
@@ -237,6 +259,43 @@ func (txnClient *txClient) SignAndBroadcastWithTimeoutHeight(
 	ctx context.Context,
 	timeoutHeight int64,
 	msgs ...cosmostypes.Msg,
+) (txResponse *cosmostypes.TxResponse, eitherErr either.AsyncError) {
+	return txnClient.signAndBroadcast(ctx, timeoutHeight, math.LegacyOneDec(), msgs...)
+}
+
+// SignAndBroadcastWithFeeMultiplier is the same as SignAndBroadcastWithTimeoutHeight
+// except that the transaction fee, derived from either the gas prices or the fee
+// amount, is multiplied by feeMultiplier. The multiplied gas prices are capped
+// by the max gas prices, if any. It is used to resubmit transactions
+// which were not committed in time with a higher fee.
+func (txnClient *txClient) SignAndBroadcastWithFeeMultiplier(
+	ctx context.Context,
+	timeoutHeight int64,
+	feeMultiplier float64,
+	msgs ...cosmostypes.Msg,
+) (txResponse *cosmostypes.TxResponse, eitherErr either.AsyncError) {
+	if feeMultiplier < 1 {
+		return nil, either.SyncErr(ErrInvalidFeeMultiplier.Wrapf(
+			"expected a fee multiplier >= 1, got %f",
+			feeMultiplier,
+		))
+	}
+
+	feeMultiplierDec, err := math.LegacyNewDecFromStr(strconv.FormatFloat(feeMultiplier, 'f', 6, 64))
+	if err != nil {
+		return nil, either.SyncErr(ErrInvalidFeeMultiplier.Wrap(err.Error()))
+	}
+
+	return txnClient.signAndBroadcast(ctx, timeoutHeight, feeMultiplierDec, msgs...)
+}
+
+// signAndBroadcast implements SignAndBroadcastWithTimeoutHeight, multiplying the
+// transaction fee by feeMultiplier.
+func (txnClient *txClient) signAndBroadcast(
+	ctx context.Context,
+	timeoutHeight int64,
+	feeMultiplier math.LegacyDec,
+	msgs ...cosmostypes.Msg,
 ) (txResponse *cosmostypes.TxResponse, eitherErr either.AsyncError) {
 	var validationErrs error
 	for i, msg := range msgs {
@@ -259,54 +318,68 @@ func (txnClient *txClient) SignAndBroadcastWithTimeoutHeight(
 		return nil, either.SyncErr(err)
 	}
 
-	feeAmount, err := txnClient.getFeeAmount(ctx, txBuilder, msgs...)
-	if err != nil {
-		return nil, either.SyncErr(err)
-	}
-	txBuilder.SetFeeAmount(feeAmount)
-
 	txBuilder.SetTimeoutHeight(uint64(timeoutHeight))
 
-	// sign transactions
-	err = txnClient.txCtx.SignTx(
-		txnClient.signingKeyName,
-		txBuilder,
-		false, false,
-	)
-	if err != nil {
-		return nil, either.SyncErr(err)
-	}
+	// Hold the sequence lock until the transaction is broadcast so that the next
+	// one is signed with the following sequence.
+	txnClient.sequenceMu.Lock()
+	defer txnClient.sequenceMu.Unlock()
 
-	// ensure transactions is valid
-	// NOTE: this makes the transactions valid; i.e. it is *REQUIRED*
-	if err = txBuilder.GetTx().ValidateBasic(); err != nil {
-		return nil, either.SyncErr(err)
-	}
+	for numRetries := 0; ; numRetries++ {
+		if err := txnClient.syncSequence(); err != nil {
+			return nil, either.SyncErr(err)
+		}
+		sequence := txnClient.nextSequence
 
-	// serialize transactions
-	txBz, err := txnClient.txCtx.EncodeTx(txBuilder)
-	if err != nil {
-		return nil, either.SyncErr(err)
-	}
+		feeAmount, err := txnClient.getFeeAmount(ctx, txBuilder, sequence, feeMultiplier, msgs...)
+		if err != nil {
+			// The gas simulation fails if the sequence is not the expected one.
+			if numRetries < maxSequenceMismatchRetries && txnClient.resyncSequence(err.Error()) {
+				continue
+			}
+			return nil, either.SyncErr(err)
+		}
+		txBuilder.SetFeeAmount(feeAmount)
 
-	txResponse, err = retry.Call(ctx, func() (*cosmostypes.TxResponse, error) {
-		response, txErr := txnClient.txCtx.BroadcastTx(txBz)
-		// Wrap timeout height error to make it non-retryable.
-		if txErr != nil && sdkerrors.ErrTxTimeoutHeight.Is(txErr) {
-			txErr = retry.ErrNonRetryable.Wrap(txErr.Error())
+		txBz, err := txnClient.signAndEncode(txBuilder, sequence)
+		if err != nil {
+			return nil, either.SyncErr(err)
 		}
 
-		return response, txErr
-	}, retry.GetStrategy(ctx))
-	if err != nil {
-		return nil, either.SyncErr(err)
-	}
+		txResponse, err = retry.Call(ctx, func() (*cosmostypes.TxResponse, error) {
+			response, txErr := txnClient.txCtx.BroadcastTx(txBz)
+			// Wrap timeout height error to make it non-retryable.
+			if txErr != nil && sdkerrors.ErrTxTimeoutHeight.Is(txErr) {
+				txErr = retry.ErrNonRetryable.Wrap(txErr.Error())
+			}
 
-	if txResponse.Code != 0 {
-		return txResponse, either.SyncErr(ErrCheckTx.Wrapf("%s", txResponse.RawLog))
-	}
+			return response, txErr
+		}, retry.GetStrategy(ctx))
+		if err != nil {
+			// It is unknown whether the transaction made it to the mempool or not.
+			txnClient.isSequenceSynced = false
+			return nil, either.SyncErr(err)
+		}
+
+		if isSequenceMismatch(txResponse) &&
+			numRetries < maxSequenceMismatchRetries &&
+			txnClient.resyncSequence(txResponse.RawLog) {
+			continue
+		}
+
+		if txResponse.Code != 0 {
+			return txResponse, either.SyncErr(ErrCheckTx.Wrapf("%s", txResponse.RawLog))
+		}
 
-	return txResponse, txnClient.addPendingTransactions(encoding.NormalizeTxHashHex(txResponse.TxHash), timeoutHeight)
+		txnClient.nextSequence = sequence + 1
+
+		return txResponse, txnClient.addPendingTransactions(
+			encoding.NormalizeTxHashHex(txResponse.TxHash),
+			sequence,
+			txBz,
+			timeoutHeight,
+		)
+	}
 }
 
 // SignAndBroadcast signs a set of Cosmos SDK messages, constructs a transaction,
@@ -369,6 +442,12 @@ func (txnClient *txClient) validateConfigAndSetDefaults() error {
 		return fmt.Errorf("cannot set both fee amount and gas settings")
 	}
 
+	// The gas prices ceiling cannot be enforced on a fee amount which does not
+	// depend on the gas used by the transaction.
+	if txnClient.feeAmount != nil && txnClient.maxGasPrices != nil {
+		return fmt.Errorf("cannot set both fee amount and max gas prices")
+	}
+
 	// Validate gas-related parameters
 	if txnClient.feeAmount == nil {
 		// If no fee amount is explicitly configured, we need valid gas settings
@@ -405,6 +484,9 @@ func (txnClient *txClient) validateConfigAndSetDefaults() error {
 //     given timeout height in the txTimeoutPool. The same error notification channel
 //     is also associated with the transaction hash in this map.
 //
+//  3. Keeps the transaction bytes and sequence in the pendingTxs map so that it
+//     can be rebroadcast until it is committed or times out.
+//
 // Both txErrorChans and txTimeoutPool store references to the same error notification
 // channel for a given transaction hash. This ensures idempotency of error handling
 // for any given transaction between asynchronous, transaction-specific errors and
@@ -418,6 +500,8 @@ func (txnClient *txClient) validateConfigAndSetDefaults() error {
 //     provided transaction hash.
 func (txnClient *txClient) addPendingTransactions(
 	txHash string,
+	sequence uint64,
+	txBz []byte,
 	timeoutHeight int64,
 ) either.AsyncError {
 	txnClient.txsMutex.Lock()
@@ -446,17 +530,27 @@ func (txnClient *txClient) addPendingTransactions(
 		txnClient.txErrorChans[txHash] = errCh
 	}
 
+	txnClient.pendingTxs[txHash] = &pendingTx{
+		txBz: txBz,
+		PendingTx: client.PendingTx{
+			TxHash:        txHash,
+			Sequence:      sequence,
+			TimeoutHeight: timeoutHeight,
+		},
+	}
+
 	return either.AsyncErr(errCh)
 }
 
-// goSubscribeToOwnTxs establishes an event query subscription to monitor transactions
-// originating from this client's signing address, ranges over observed transaction events,
-// and performs the following steps on each:
+// goSubscribeToOwnTxs ranges over the committed transactions to monitor the ones
+// originating from this client, ignoring the others, and performs the following
+// steps on each:
 //
 //  1. Normalize hexadeimal transaction hash.
 //  2. Retrieves the transaction's error channel from txErrorChans.
 //  3. Closes and removes it from txErrorChans.
 //  4. Removes the transaction error channel from txTimeoutPool.
+//  5. Removes the transaction from pendingTxs.
 //
 // It is intended to be called in a goroutine.
 //
@@ -515,6 +609,7 @@ func (txnClient *txClient) goSubscribeToOwnTxs(ctx context.Context) {
 			// Close and remove from txErrChans
 			close(txErrCh)
 			delete(txnClient.txErrorChans, txHashHex)
+			delete(txnClient.pendingTxs, txHashHex)
 		}
 
 		txnClient.txsMutex.Unlock()
@@ -522,12 +617,9 @@ func (txnClient *txClient) goSubscribeToOwnTxs(ctx context.Context) {
 }
 
 // goTimeoutPendingTransactions monitors blocks and handles transaction timeouts.
-// For each block observed, it checks if there are transactions associated with that
-// block's height in the txTimeoutPool. If transactions are found, the function
-// evaluates whether they have already been processed by the transaction events
-// query subscription logic. If not, a timeout error is generated and sent on the
-// transaction's error channel. Finally, the error channel is closed and removed
-// from the txTimeoutPool.
+// For each block observed, it times out the pending transactions associated with
+// the block's height (see: timeoutPendingTransactions), then rebroadcasts the
+// pending transactions which were not committed for rebroadcastHeightOffset blocks.
 func (txnClient *txClient) goTimeoutPendingTransactions(ctx context.Context) {
 	// Subscribe to a sequence of committed blocks.
 	blockCh := txnClient.blockClient.CommittedBlocksSequence(ctx).Subscribe(ctx).Ch()
@@ -541,48 +633,142 @@ func (txnClient *txClient) goTimeoutPendingTransactions(ctx context.Context) {
 		default:
 		}
 
-		txnClient.txsMutex.Lock()
+		if hasTimedOutTxs := txnClient.timeoutPendingTransactions(ctx, block.Height()); hasTimedOutTxs {
+			// The sequences of the timed out transactions were never used, which
+			// makes the sequences of the transactions signed after them invalid.
+			txnClient.sequenceMu.Lock()
+			txnClient.isSequenceSynced = false
+			txnClient.sequenceMu.Unlock()
+		}
+
+		txnClient.rebroadcastPendingTransactions(ctx, block.Height())
+	}
+}
+
+// timeoutPendingTransactions checks if there are transactions associated with
+// the given height in the txTimeoutPool. If transactions are found, the function
+// evaluates whether they have already been processed by the transaction events
+// query subscription logic. If not, a timeout error is generated and sent on the
+// transaction's error channel. Finally, the error channel is closed and removed
+// from the txTimeoutPool.
+// The pending transactions with a sequence higher than the one of a transaction
+// which was never committed can no longer be committed either: they are evicted
+// with an ErrTxEvicted so that they can be resubmitted (see: evictPendingTransactions).
+// It returns true if any transaction timed out, or if its status is unknown.
+func (txnClient *txClient) timeoutPendingTransactions(ctx context.Context, height int64) (hasTimedOutTxs bool) {
+	txnClient.txsMutex.Lock()
+	defer txnClient.txsMutex.Unlock()
 
-		// Retrieve transactions associated with the current block's height.
-		txsByHash, ok := txnClient.txTimeoutPool[block.Height()]
-		if !ok {
-			// If no transactions are found for the current block height, continue.
-			txnClient.txsMutex.Unlock()
+	// Retrieve transactions associated with the current block's height.
+	txsByHash, ok := txnClient.txTimeoutPool[height]
+	if !ok {
+		// If no transactions are found for the current block height, continue.
+		return false
+	}
+
+	// evictSequence is the lowest sequence of the transactions which were never
+	// committed, the pending transactions with higher sequences are evicted.
+	var (
+		evictSequence uint64
+		shouldEvict   bool
+	)
+
+	// Process each transaction for the current block height.
+	for txHash, txErrCh := range txsByHash {
+		select {
+		// Check if the transaction was processed by its subscription.
+		case err, ok := <-txErrCh:
+			if ok {
+				// Unexpected state: error channel should be closed after processing.
+				panic(fmt.Errorf("Expected txErrCh to be closed; received err: %w", err))
+			}
+			// Remove the processed transaction.
+			delete(txsByHash, txHash)
 			continue
+		default:
 		}
 
-		// Process each transaction for the current block height.
-		for txHash, txErrCh := range txsByHash {
-			select {
-			// Check if the transaction was processed by its subscription.
-			case err, ok := <-txErrCh:
-				if ok {
-					// Unexpected state: error channel should be closed after processing.
-					panic(fmt.Errorf("Expected txErrCh to be closed; received err: %w", err))
-				}
-				// Remove the processed transaction.
-				delete(txsByHash, txHash)
-				txnClient.txsMutex.Unlock()
-				continue
-			default:
+		// Transaction was not processed by its subscription: handle timeout.
+		txErr := txnClient.getTxTimeoutError(ctx, txHash)
+		if txErr != nil {
+			txErrCh <- txErr // Send the timeout, execution or query error.
+		}
+		close(txErrCh)            // Close the error channel.
+		delete(txsByHash, txHash) // Remove the transaction.
+		delete(txnClient.txErrorChans, txHash)
+		// The sequence of a committed transaction is used, even if it failed.
+		if errors.Is(txErr, ErrTxTimeout) || errors.Is(txErr, ErrQueryTx) {
+			hasTimedOutTxs = true
+		}
+		// Only a transaction which is known to never have been committed leaves
+		// a gap in the account sequences.
+		if pendingTx, ok := txnClient.pendingTxs[txHash]; ok && errors.Is(txErr, ErrTxTimeout) {
+			if !shouldEvict || pendingTx.Sequence < evictSequence {
+				evictSequence = pendingTx.Sequence
 			}
+			shouldEvict = true
+		}
+		delete(txnClient.pendingTxs, txHash)
+	}
 
-			// Transaction was not processed by its subscription: handle timeout.
-			txErrCh <- txnClient.getTxTimeoutError(ctx, txHash) // Send a timeout error.
-			close(txErrCh)                                      // Close the error channel.
-			delete(txsByHash, txHash)                           // Remove the transaction.
+	// Clean up the txTimeoutPool for the current block height.
+	delete(txnClient.txTimeoutPool, height)
+
+	if shouldEvict {
+		txnClient.evictPendingTransactions(ctx, evictSequence)
+	}
+
+	return hasTimedOutTxs
+}
+
+// evictPendingTransactions sends an ErrTxEvicted on the error channels of the
+// pending transactions with a sequence higher than timedOutSequence, which can
+// no longer be committed since the transaction signed with timedOutSequence
+// never was, and stops tracking them.
+// It MUST be called while holding txsMutex.
+func (txnClient *txClient) evictPendingTransactions(ctx context.Context, timedOutSequence uint64) {
+	logger := polylog.Ctx(ctx)
+
+	for txHash, pendingTx := range txnClient.pendingTxs {
+		if pendingTx.Sequence <= timedOutSequence {
+			continue
 		}
 
-		// Clean up the txTimeoutPool for the current block height.
-		delete(txnClient.txTimeoutPool, block.Height())
-		txnClient.txsMutex.Unlock()
+		if txErrCh, ok := txnClient.txErrorChans[txHash]; ok {
+			txErrCh <- ErrTxEvicted.Wrapf(
+				"with hash %s: sequence %d follows the timed out sequence %d",
+				txHash, pendingTx.Sequence, timedOutSequence,
+			)
+			close(txErrCh)
+		}
+
+		if txsByHash, ok := txnClient.txTimeoutPool[pendingTx.TimeoutHeight]; ok {
+			delete(txsByHash, txHash)
+			if len(txsByHash) == 0 {
+				delete(txnClient.txTimeoutPool, pendingTx.TimeoutHeight)
+			}
+		}
+		delete(txnClient.txErrorChans, txHash)
+		delete(txnClient.pendingTxs, txHash)
+
+		logger.Warn().
+			Str("tx_hash", txHash).
+			Uint64("sequence", pendingTx.Sequence).
+			Uint64("timed_out_sequence", timedOutSequence).
+			Msg("evicted pending tx following a timed out tx")
 	}
 }
 
 // getTxTimeoutError checks if a transaction with the specified hash has timed out.
 // The function decodes the provided hexadecimal hash into bytes and queries the
-// transaction using the byte hash. If any error occurs during this process,
-// appropriate wrapped errors are returned for easier debugging.
+// transaction using the byte hash:
+//   - If it is not found, it was never included in a block and, since its timeout
+//     height is reached, can no longer be: an ErrTxTimeout is returned so that the
+//     caller can resubmit it.
+//   - If it is found, it was committed but missed by the transaction events
+//     subscription: nil is returned, or an ErrTxExecution if it failed.
+//   - Any other query error is returned as an ErrQueryTx since the transaction
+//     status is unknown and it MUST NOT be resubmitted.
 func (txnClient *txClient) getTxTimeoutError(ctx context.Context, txHashHex string) error {
 	// Decode the provided hex hash into bytes.
 	txHash, err := hex.DecodeString(txHashHex)
@@ -592,12 +778,26 @@ func (txnClient *txClient) getTxTimeoutError(ctx context.Context, txHashHex stri
 
 	// Query the transaction using the decoded byte hash.
 	txResponse, err := txnClient.txCtx.QueryTx(ctx, txHash, false)
-	if err != nil {
-		return ErrQueryTx.Wrapf("with hash: %s: %s", txHashHex, err)
+	switch {
+	case err != nil && isTxNotFoundError(err):
+		return ErrTxTimeout.Wrapf("with hash %s: %s", txHashHex, err)
+	case err != nil:
+		return ErrQueryTx.Wrapf("with hash %s: %s", txHashHex, err)
+	case txResponse.TxResult.Code != 0:
+		return ErrTxExecution.Wrapf(
+			"with hash %s (codespace: %s, code: %d): %s",
+			txHashHex, txResponse.TxResult.Codespace, txResponse.TxResult.Code, txResponse.TxResult.Log,
+		)
+	default:
+		return nil
 	}
+}
 
-	// Return a timeout error with details about the transaction.
-	return ErrTxTimeout.Wrapf("with hash %s: %s", txHashHex, txResponse.TxResult.Log)
+// isTxNotFoundError returns true if the given QueryTx error is returned for
+// transactions which are not indexed, i.e. never committed: either an
+// ErrTxNotFound or a gRPC NotFound status.
+func isTxNotFoundError(err error) bool {
+	return errors.Is(err, ErrTxNotFound) || status.Code(err) == codes.NotFound
 }
 
 // getFeeAmount calculates the transaction fee amount based on client settings.
@@ -605,11 +805,16 @@ func (txnClient *txClient) getTxTimeoutError(ctx context.Context, txHashHex stri
 // This method determines the transaction fee using one of two approaches:
 // 1. If a fee amount is explicitly set on the client (txnClient.feeAmount), it uses that amount.
 // 2. Otherwise, it calculates the fee based on gas limit and gas prices, where:
-//   - If simulation is enabled, it estimates gas by simulating the transaction and applies the gas adjustment.
+//   - If simulation is enabled, it estimates gas by simulating the transaction signed
+//     with the given sequence and applies the gas adjustment.
 //   - If simulation is disabled, it uses the predefined gas limit from the gas settings.
+//
+// In both cases, the fee amount or gas prices are multiplied by feeMultiplier.
 func (txnClient *txClient) getFeeAmount(
 	ctx context.Context,
 	txBuilder cosmosclient.TxBuilder,
+	sequence uint64,
+	feeMultiplier math.LegacyDec,
 	msgs ...cosmostypes.Msg,
 ) (cosmostypes.Coins, error) {
 	if ctx.Err() != nil {
@@ -618,7 +823,7 @@ func (txnClient *txClient) getFeeAmount(
 
 	if txnClient.feeAmount != nil {
 		// Set the fee amount if provided.
-		feeCoins, changeCoins := txnClient.feeAmount.TruncateDecimal()
+		feeCoins, changeCoins := txnClient.feeAmount.MulDec(feeMultiplier).TruncateDecimal()
 
 		// Ensure that any decimal remainder is added to the corresponding coin as an
 		// integer amount of the minimal denomination (1upokt).
@@ -635,7 +840,7 @@ func (txnClient *txClient) getFeeAmount(
 	if txnClient.gasSetting.Simulate {
 		// If the gas setting is set to simulate, we need to calculate the gas limit
 		// based on the messages.
-		simulatedGas, err := txnClient.txCtx.GetSimulatedTxGas(ctx, txnClient.signingKeyName, msgs...)
+		simulatedGas, err := txnClient.txCtx.GetSimulatedTxGas(ctx, txnClient.signingKeyName, sequence, msgs...)
 		if err != nil {
 			return nil, err
 		}
@@ -647,8 +852,13 @@ func (txnClient *txClient) getFeeAmount(
 
 	txBuilder.SetGasLimit(gasLimit)
 
+	gasPrices := txnClient.gasPrices.MulDec(feeMultiplier)
+	if txnClient.maxGasPrices != nil {
+		gasPrices = capGasPrices(gasPrices, *txnClient.maxGasPrices)
+	}
+
 	gasLimitDec := math.LegacyNewDec(int64(gasLimit))
-	feeAmountDec := txnClient.gasPrices.MulDec(gasLimitDec)
+	feeAmountDec := gasPrices.MulDec(gasLimitDec)
 
 	feeCoins, changeCoins := feeAmountDec.TruncateDecimal()
 	// Ensure that any decimal remainder is added to the corresponding coin as an
@@ -662,29 +872,17 @@ func (txnClient *txClient) getFeeAmount(
 	return feeCoins, nil
 }
 
-// UnmarshalTxResult attempts to deserialize a slice of bytes into a TxResult
-// It checks if the given bytes correspond to a valid transaction event.
-// If the resulting TxResult has empty transaction bytes, it assumes that
-// the message was not a transaction results and returns an error.
-func UnmarshalTxResult(txResultBz []byte) (*abci.TxResult, error) {
-	var rpcResponse rpctypes.RPCResponse
-
-	// Try to deserialize the provided bytes into an RPCResponse.
-	if err := json.Unmarshal(txResultBz, &rpcResponse); err != nil {
-		return nil, events.ErrEventsUnmarshalEvent.Wrap(err.Error())
-	}
-
-	var cometTxEvent CometTxEvent
-	// Try to deserialize the provided bytes into a CometTxEvent.
-	if err := json.Unmarshal(rpcResponse.Result, &cometTxEvent); err != nil {
-		return nil, events.ErrEventsUnmarshalEvent.Wrap(err.Error())
-	}
-
-	// Check if the TxResult has empty transaction bytes, which indicates
-	// the message might not be a valid transaction event.
-	if bytes.Equal(cometTxEvent.Data.Value.TxResult.Tx, []byte{}) {
-		return nil, events.ErrEventsUnmarshalEvent.Wrap("event bytes do not correspond to an abci.TxResult")
+// capGasPrices returns the given gas prices where the price of each denom which
+// has a ceiling in maxGasPrices is lowered to that ceiling if it exceeds it.
+func capGasPrices(gasPrices, maxGasPrices cosmostypes.DecCoins) cosmostypes.DecCoins {
+	cappedGasPrices := make(cosmostypes.DecCoins, 0, len(gasPrices))
+	for _, gasPrice := range gasPrices {
+		maxGasPriceAmount := maxGasPrices.AmountOf(gasPrice.Denom)
+		if maxGasPriceAmount.IsPositive() && gasPrice.Amount.GT(maxGasPriceAmount) {
+			gasPrice.Amount = maxGasPriceAmount
+		}
+		cappedGasPrices = append(cappedGasPrices, gasPrice)
 	}
 
-	return &cometTxEvent.Data.Value.TxResult, nil
+	return cappedGasPrices
 }
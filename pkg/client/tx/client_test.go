@@ -2,6 +2,7 @@ package tx_test
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"sync"
@@ -10,9 +11,12 @@ import (
 
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
 	cometbytes "github.com/cometbft/cometbft/libs/bytes"
 	"github.com/cometbft/cometbft/libs/json"
+	cometrpctypes "github.com/cometbft/cometbft/rpc/core/types"
 	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+	comettypes "github.com/cometbft/cometbft/types"
 	cosmosclient "github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	cosmoskeyring "github.com/cosmos/cosmos-sdk/crypto/keyring"
@@ -22,13 +26,17 @@ import (
 	"github.com/pokt-network/smt"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/pokt-network/poktroll/app/volatile"
 	"github.com/pokt-network/poktroll/pkg/client"
+	"github.com/pokt-network/poktroll/pkg/client/events"
 	"github.com/pokt-network/poktroll/pkg/client/keyring"
 	"github.com/pokt-network/poktroll/pkg/client/tx"
 	"github.com/pokt-network/poktroll/pkg/crypto/protocol"
 	"github.com/pokt-network/poktroll/pkg/either"
+	"github.com/pokt-network/poktroll/pkg/encoding"
 	"github.com/pokt-network/poktroll/pkg/observable/channel"
 	"github.com/pokt-network/poktroll/testutil/mockclient"
 	"github.com/pokt-network/poktroll/testutil/testclient"
@@ -73,7 +81,7 @@ func TestTxClient_SignAndBroadcast_Succeeds(t *testing.T) {
 
 	// Construct a new mock events query client
 	eventsQueryClient := testeventsquery.NewOneTimeTxEventsQueryClient(
-		ctx, t, signingKey, txResultsBzPublishChMu, &txResultsBzPublishCh,
+		ctx, t, txResultsBzPublishChMu, &txResultsBzPublishCh,
 	)
 
 	// Construct a new mock transactions context
@@ -126,7 +134,7 @@ func TestTxClient_SignAndBroadcast_Succeeds(t *testing.T) {
 	require.NoError(t, err)
 
 	// Construct the expected RPC response from the expected transaction bytes.
-	txResultEvent := &tx.CometTxEvent{}
+	txResultEvent := &events.CometTxEvent{}
 	txResultEvent.Data.Value.TxResult.Tx = expectedTx
 	txResultBz, err := json.Marshal(txResultEvent)
 	require.NoError(t, err)
@@ -237,7 +245,7 @@ func TestTxClient_SignAndBroadcast_SyncError(t *testing.T) {
 	// NewTxClient call to fail, we don't need to set any expectations
 	// on this mock.
 	eventsQueryClient := testeventsquery.NewOneTimeTxEventsQueryClient(
-		ctx, t, signingKey, txResultsBzPublishChMu, &txResultsBzPublishCh,
+		ctx, t, txResultsBzPublishChMu, &txResultsBzPublishCh,
 	)
 
 	// Construct a new mock transaction context.
@@ -314,7 +322,7 @@ $ go test -v -count=1 -run TestTxClient_SignAndBroadcast_CheckTxError ./pkg/clie
 	keyring, signingKey := testkeyring.NewTestKeyringWithKey(t, testSigningKeyName)
 
 	eventsQueryClient := testeventsquery.NewOneTimeTxEventsQueryClient(
-		ctx, t, signingKey, txResultsBzPublishChMu, &txResultsBzPublishCh,
+		ctx, t, txResultsBzPublishChMu, &txResultsBzPublishCh,
 	)
 
 	txCtxMock := testtx.NewOneTimeErrCheckTxTxContext(
@@ -361,102 +369,180 @@ $ go test -v -count=1 -run TestTxClient_SignAndBroadcast_CheckTxError ./pkg/clie
 }
 
 func TestTxClient_SignAndBroadcast_Timeout(t *testing.T) {
-	var (
-		timeoutHeight = int64(5)
-		// expectedErrMsg is the expected error message that will be returned
-		// by the transaction client. It is computed and assigned in the
-		// testtx.NewOneTimeErrCheckTxTxContext helper function.
-		expectedErrMsg string
-		// txResultsBzPublishChMu is a mutex that protects txResultsBzPublishCh from concurrent access
-		// as it is expected to be updated in a mock method but is also sent on in the test.
-		txResultsBzPublishChMu = new(sync.Mutex)
-		// txResultsBzPublishCh is the channel that the mock events query client
-		// will use to publish the transaction event bytes. It is used near the end of
-		// the test to mock the network signaling that the transaction was committed.
-		txResultsBzPublishCh chan<- either.Bytes
-		blocksPublishCh      = make(chan client.Block, timeoutHeight)
-		ctx                  = context.Background()
+	const executionErrLog = "fee payer address does not exist: unknown address"
 
-		// Trie related variables
-		spec           = smt.NewTrieSpec(protocol.NewTrieHasher(), true)
-		emptyBlockHash = make([]byte, spec.PathHasherSize())
-	)
+	tests := []struct {
+		desc      string
+		queryTxFn func(txHash, txBz []byte) (*cometrpctypes.ResultTx, error)
+		// expectedErr is nil if no error is expected on the error channel.
+		expectedErr       error
+		expectedErrString string
+	}{
+		{
+			desc: "tx not found - timed out",
+			queryTxFn: func(txHash, _ []byte) (*cometrpctypes.ResultTx, error) {
+				return nil, tx.ErrTxNotFound.Wrapf("with hash %X", txHash)
+			},
+			expectedErr:       tx.ErrTxTimeout,
+			expectedErrString: "not found",
+		},
+		{
+			desc: "tx not found gRPC status - timed out",
+			queryTxFn: func(txHash, _ []byte) (*cometrpctypes.ResultTx, error) {
+				return nil, status.Errorf(codes.NotFound, "tx not found: %X", txHash)
+			},
+			expectedErr:       tx.ErrTxTimeout,
+			expectedErrString: "not found",
+		},
+		{
+			desc: "untyped not found error - unknown status",
+			queryTxFn: func(txHash, _ []byte) (*cometrpctypes.ResultTx, error) {
+				return nil, fmt.Errorf("tx (%X) not found", txHash)
+			},
+			expectedErr:       tx.ErrQueryTx,
+			expectedErrString: "not found",
+		},
+		{
+			desc: "tx found with a zero code - committed",
+			queryTxFn: func(txHash, txBz []byte) (*cometrpctypes.ResultTx, error) {
+				return &cometrpctypes.ResultTx{
+					Hash:     txHash,
+					Height:   1,
+					TxResult: abci.ExecTxResult{Code: 0},
+					Tx:       txBz,
+				}, nil
+			},
+			expectedErr: nil,
+		},
+		{
+			desc: "tx found with a non-zero code - execution error",
+			queryTxFn: func(txHash, txBz []byte) (*cometrpctypes.ResultTx, error) {
+				return &cometrpctypes.ResultTx{
+					Hash:   txHash,
+					Height: 1,
+					TxResult: abci.ExecTxResult{
+						Code:      1,
+						Log:       executionErrLog,
+						Codespace: "test_codespace",
+					},
+					Tx: txBz,
+				}, nil
+			},
+			expectedErr:       tx.ErrTxExecution,
+			expectedErrString: executionErrLog,
+		},
+		{
+			desc: "tx query error - unknown status",
+			queryTxFn: func(_, _ []byte) (*cometrpctypes.ResultTx, error) {
+				return nil, fmt.Errorf("post failed: connection refused")
+			},
+			expectedErr:       tx.ErrQueryTx,
+			expectedErrString: "connection refused",
+		},
+	}
 
-	keyring, signingKey := testkeyring.NewTestKeyringWithKey(t, testSigningKeyName)
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			var (
+				timeoutHeight = int64(5)
+				// txResultsBzPublishChMu is a mutex that protects txResultsBzPublishCh from concurrent access
+				// as it is expected to be updated in a mock method but is also sent on in the test.
+				txResultsBzPublishChMu = new(sync.Mutex)
+				// txResultsBzPublishCh is the channel that the mock events query client
+				// will use to publish the transaction event bytes. No transaction event
+				// is published in this test, so that the transaction reaches its timeout.
+				txResultsBzPublishCh chan<- either.Bytes
+				blocksPublishCh      = make(chan client.Block, timeoutHeight)
+				ctx                  = context.Background()
+
+				// Trie related variables
+				spec           = smt.NewTrieSpec(protocol.NewTrieHasher(), true)
+				emptyBlockHash = make([]byte, spec.PathHasherSize())
+			)
 
-	eventsQueryClient := testeventsquery.NewOneTimeTxEventsQueryClient(
-		ctx, t, signingKey, txResultsBzPublishChMu, &txResultsBzPublishCh,
-	)
+			keyring, signingKey := testkeyring.NewTestKeyringWithKey(t, testSigningKeyName)
 
-	txCtxMock := testtx.NewOneTimeErrTxTimeoutTxContext(
-		t, keyring,
-		testSigningKeyName,
-		&expectedErrMsg,
-	)
+			eventsQueryClient := testeventsquery.NewOneTimeTxEventsQueryClient(
+				ctx, t, txResultsBzPublishChMu, &txResultsBzPublishCh,
+			)
 
-	// Construct a new mock block client because it is a required dependency.
-	// Since we're not exercising transaction timeouts in this test, we don't need to
-	// set any particular expectations on it, nor do we care about the contents
-	// of the latest block.
-	blockClientMock := testblock.NewOneTimeCommittedBlocksSequenceBlockClient(
-		t, blocksPublishCh,
-	)
+			txCtxMock := testtx.NewOneTimeErrTxTimeoutTxContext(
+				t, keyring,
+				testSigningKeyName,
+				test.queryTxFn,
+			)
 
-	// Construct a new depinject config with the mocks we created above.
-	txClientDeps := depinject.Supply(
-		eventsQueryClient,
-		txCtxMock,
-		blockClientMock,
-	)
+			// Construct a new mock block client which publishes the blocks up to
+			// the transaction's timeout height.
+			blockClientMock := testblock.NewOneTimeCommittedBlocksSequenceBlockClient(
+				t, blocksPublishCh,
+			)
 
-	// Construct the transaction client.
-	txClient, err := tx.NewTxClient(
-		ctx,
-		txClientDeps,
-		testtx.WithDefaultTxClientOptions(t, testSigningKeyName)...,
-	)
-	require.NoError(t, err)
+			// Construct a new depinject config with the mocks we created above.
+			txClientDeps := depinject.Supply(
+				eventsQueryClient,
+				txCtxMock,
+				blockClientMock,
+			)
 
-	signingKeyAddr, err := signingKey.GetAddress()
-	require.NoError(t, err)
+			// Construct the transaction client.
+			txClient, err := tx.NewTxClient(
+				ctx,
+				txClientDeps,
+				testtx.WithDefaultTxClientOptions(t, testSigningKeyName)...,
+			)
+			require.NoError(t, err)
 
-	// Construct a valid (arbitrary) message to sign, encode, and broadcast.
-	appStake := types.NewCoin("upokt", math.NewInt(1000000))
-	appStakeMsg := &apptypes.MsgStakeApplication{
-		Address:  signingKeyAddr.String(),
-		Stake:    &appStake,
-		Services: client.NewTestApplicationServiceConfig(testServiceIdPrefix, 1),
-	}
+			signingKeyAddr, err := signingKey.GetAddress()
+			require.NoError(t, err)
 
-	// Sign and broadcast the message in a transaction.
-	_, eitherErr := txClient.SignAndBroadcast(ctx, appStakeMsg)
-	err, errCh := eitherErr.SyncOrAsyncError()
-	require.NoError(t, err)
+			// Construct a valid (arbitrary) message to sign, encode, and broadcast.
+			appStake := types.NewCoin("upokt", math.NewInt(1000000))
+			appStakeMsg := &apptypes.MsgStakeApplication{
+				Address:  signingKeyAddr.String(),
+				Stake:    &appStake,
+				Services: client.NewTestApplicationServiceConfig(testServiceIdPrefix, 1),
+			}
 
-	for i := int64(0); i < timeoutHeight; i++ {
-		blocksPublishCh <- testblock.NewAnyTimesBlock(t, emptyBlockHash, int64(i+1))
-	}
+			// Sign and broadcast the message in a transaction.
+			_, eitherErr := txClient.SignAndBroadcast(ctx, appStakeMsg)
+			err, errCh := eitherErr.SyncOrAsyncError()
+			require.NoError(t, err)
 
-	// Assert that we receive the expected error type & message.
-	select {
-	case err := <-errCh:
-		require.ErrorIs(t, err, tx.ErrTxTimeout)
-		require.ErrorContains(t, err, expectedErrMsg)
-	// NB: wait 110% of txCommitTimeout; a bit longer than strictly necessary in
-	// order to mitigate flakiness.
-	case <-time.After(txCommitTimeout * 110 / 100):
-		t.Fatal("test timed out waiting for errCh to receive")
-	}
+			for i := int64(0); i < timeoutHeight; i++ {
+				blocksPublishCh <- testblock.NewAnyTimesBlock(t, emptyBlockHash, int64(i+1))
+			}
 
-	// Assert that the error channel was closed.
-	select {
-	case err, ok := <-errCh:
-		require.Falsef(t, ok, "expected errCh to be closed")
-		require.NoError(t, err)
-	// NB: Give the error channel some time to be ready to receive in order to
-	// mitigate flakiness.
-	case <-time.After(50 * time.Millisecond):
-		t.Fatal("expected errCh to be closed")
+			// Assert that we receive the expected error type & message, if any,
+			// and that the error channel is closed.
+			select {
+			case err, ok := <-errCh:
+				if test.expectedErr == nil {
+					require.Falsef(t, ok, "expected errCh to be closed without an error")
+					require.NoError(t, err)
+					return
+				}
+				require.ErrorIs(t, err, test.expectedErr)
+				require.ErrorContains(t, err, test.expectedErrString)
+				// Only a transaction which is not found may be resubmitted.
+				require.Equal(t, test.expectedErr == tx.ErrTxTimeout, errors.Is(err, tx.ErrTxTimeout))
+			// NB: wait 110% of txCommitTimeout; a bit longer than strictly necessary in
+			// order to mitigate flakiness.
+			case <-time.After(txCommitTimeout * 110 / 100):
+				t.Fatal("test timed out waiting for errCh to receive")
+			}
+
+			// Assert that the error channel was closed.
+			select {
+			case err, ok := <-errCh:
+				require.Falsef(t, ok, "expected errCh to be closed")
+				require.NoError(t, err)
+			// NB: Give the error channel some time to be ready to receive in order to
+			// mitigate flakiness.
+			case <-time.After(50 * time.Millisecond):
+				t.Fatal("expected errCh to be closed")
+			}
+		})
 	}
 }
 
@@ -491,7 +577,7 @@ func TestTxClient_SignAndBroadcast_Retry(t *testing.T) {
 	// NewTxClient call to fail, we don't need to set any expectations
 	// on this mock.
 	eventsQueryClient := testeventsquery.NewOneTimeTxEventsQueryClient(
-		ctx, t, signingKey, txResultsBzPublishChMu, &txResultsBzPublishCh,
+		ctx, t, txResultsBzPublishChMu, &txResultsBzPublishCh,
 	)
 
 	// Instruct the tx client to return an error when submitting a transaction.
@@ -574,6 +660,268 @@ func TestTxClient_SignAndBroadcast_Retry(t *testing.T) {
 	require.Equal(t, 1, callStatus.successCount)
 }
 
+func TestTxClient_SignAndBroadcast_SequenceManagement(t *testing.T) {
+	var (
+		// txResultsBzPublishCh is the channel for mock events query client to publish transaction event bytes
+		// - Not used in this test
+		// - Required to use the NewOneTimeTxEventsQueryClient helper
+		txResultsBzPublishCh chan<- either.Bytes
+
+		// txResultsBzPublishChMu protects txResultsBzPublishCh from concurrent access
+		txResultsBzPublishChMu = new(sync.Mutex)
+
+		// blocksPublishCh is the channel used to publish the blocks which trigger
+		// the rebroadcast of the pending transactions.
+		blocksPublishCh = make(chan client.Block)
+
+		// broadcastMu protects signedSequences and broadcastCounts which are
+		// updated by the tx client goroutines.
+		broadcastMu sync.Mutex
+		// signedSequences are the account sequences the transactions were signed with.
+		signedSequences []uint64
+		// broadcastCounts maps tx_hash->number of times the transaction was broadcast.
+		broadcastCounts = make(map[string]int)
+
+		ctx = context.Background()
+
+		// Trie related variables
+		spec           = smt.NewTrieSpec(protocol.NewTrieHasher(), true)
+		emptyBlockHash = make([]byte, spec.PathHasherSize())
+	)
+
+	keyring, signingKey := testkeyring.NewTestKeyringWithKey(t, testSigningKeyName)
+
+	eventsQueryClient := testeventsquery.NewOneTimeTxEventsQueryClient(
+		ctx, t, txResultsBzPublishChMu, &txResultsBzPublishCh,
+	)
+
+	// The account sequence queried from the network is 1 while the network
+	// expects the next transaction to be signed with sequence 5.
+	txCtxMock, txCtx := testtx.NewAnyTimesTxTxContext(t, keyring)
+	txCtxMock.EXPECT().NewTxBuilder().
+		DoAndReturn(txCtx.NewTxBuilder).
+		AnyTimes()
+	txCtxMock.EXPECT().GetSimulatedTxGas(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(uint64(1), nil).
+		AnyTimes()
+	txCtxMock.EXPECT().SignTxWithSequence(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(
+			keyName string,
+			txBuilder cosmosclient.TxBuilder,
+			accountNumber, sequence uint64,
+		) error {
+			broadcastMu.Lock()
+			signedSequences = append(signedSequences, sequence)
+			broadcastMu.Unlock()
+
+			return txCtx.SignTxWithSequence(keyName, txBuilder, accountNumber, sequence)
+		}).
+		AnyTimes()
+	txCtxMock.EXPECT().EncodeTx(gomock.Any()).
+		DoAndReturn(txCtx.EncodeTx).
+		AnyTimes()
+	txCtxMock.EXPECT().BroadcastTx(gomock.Any()).
+		DoAndReturn(func(txBytes []byte) (*cosmostypes.TxResponse, error) {
+			broadcastMu.Lock()
+			defer broadcastMu.Unlock()
+
+			txHash := encoding.TxHashBytesToNormalizedHex(comettypes.Tx(txBytes).Hash())
+			switch {
+			case len(broadcastCounts) == 0:
+				broadcastCounts[txHash]++
+				return &cosmostypes.TxResponse{
+					Codespace: sdkerrors.ErrWrongSequence.Codespace(),
+					Code:      sdkerrors.ErrWrongSequence.ABCICode(),
+					RawLog:    "account sequence mismatch, expected 5, got 1: incorrect account sequence",
+				}, nil
+			case broadcastCounts[txHash] > 0:
+				broadcastCounts[txHash]++
+				return &cosmostypes.TxResponse{
+					Codespace: sdkerrors.ErrTxInMempoolCache.Codespace(),
+					Code:      sdkerrors.ErrTxInMempoolCache.ABCICode(),
+				}, nil
+			default:
+				broadcastCounts[txHash]++
+				return &cosmostypes.TxResponse{TxHash: txHash}, nil
+			}
+		}).
+		AnyTimes()
+
+	blockClientMock := testblock.NewOneTimeCommittedBlocksSequenceBlockClient(
+		t, blocksPublishCh,
+	)
+
+	// Construct a new depinject config with the mocks we created above.
+	txClientDeps := depinject.Supply(
+		eventsQueryClient,
+		txCtxMock,
+		blockClientMock,
+	)
+
+	// Construct the transaction client.
+	txClient, err := tx.NewTxClient(
+		ctx,
+		txClientDeps,
+		testtx.WithDefaultTxClientOptions(t, testSigningKeyName)...,
+	)
+	require.NoError(t, err)
+
+	signingAddr, err := signingKey.GetAddress()
+	require.NoError(t, err)
+
+	// Sign and broadcast two transactions without waiting for them to be committed.
+	var txHashes []string
+	for i := range 2 {
+		appStake := types.NewCoin(volatile.DenomuPOKT, math.NewInt(int64(1000000+i)))
+		appStakeMsg := &apptypes.MsgStakeApplication{
+			Address:  signingAddr.String(),
+			Stake:    &appStake,
+			Services: client.NewTestApplicationServiceConfig(testServiceIdPrefix, 1),
+		}
+
+		txResponse, eitherErr := txClient.SignAndBroadcast(ctx, appStakeMsg)
+		err, _ := eitherErr.SyncOrAsyncError()
+		require.NoError(t, err)
+
+		txHashes = append(txHashes, encoding.NormalizeTxHashHex(txResponse.TxHash))
+	}
+
+	// The first transaction is signed again with the sequence expected by the
+	// network, and the second one with the following sequence.
+	broadcastMu.Lock()
+	require.Equal(t, []uint64{1, 5, 6}, signedSequences)
+	broadcastMu.Unlock()
+
+	pendingTxs := txClient.PendingTxs()
+	require.Len(t, pendingTxs, 2)
+	for i, pendingTx := range pendingTxs {
+		require.Equal(t, txHashes[i], pendingTx.TxHash)
+		require.Equal(t, uint64(5+i), pendingTx.Sequence)
+	}
+
+	// Publish enough blocks for the pending transactions to be rebroadcast once,
+	// before they time out.
+	for height := int64(1); height <= 1+tx.DefaultRebroadcastHeightOffset; height++ {
+		blocksPublishCh <- testblock.NewAnyTimesBlock(t, emptyBlockHash, height)
+	}
+
+	require.Eventually(t, func() bool {
+		broadcastMu.Lock()
+		defer broadcastMu.Unlock()
+
+		return broadcastCounts[txHashes[0]] == 2 && broadcastCounts[txHashes[1]] == 2
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestTxClient_SignAndBroadcast_EvictsTxsFollowingTimedOutTx(t *testing.T) {
+	var (
+		// txResultsBzPublishCh is the channel for mock events query client to publish transaction event bytes
+		// - Not used in this test
+		// - Required to use the NewOneTimeTxEventsQueryClient helper
+		txResultsBzPublishCh chan<- either.Bytes
+
+		// txResultsBzPublishChMu protects txResultsBzPublishCh from concurrent access
+		txResultsBzPublishChMu = new(sync.Mutex)
+
+		// blocksPublishCh is the channel used to publish the blocks which trigger
+		// the timeout of the first transaction.
+		blocksPublishCh = make(chan client.Block)
+
+		// firstTxTimeoutHeight is the timeout height of the first transaction,
+		// the second one only timing out much later.
+		firstTxTimeoutHeight  = int64(2)
+		secondTxTimeoutHeight = int64(100)
+
+		ctx = context.Background()
+
+		// Trie related variables
+		spec           = smt.NewTrieSpec(protocol.NewTrieHasher(), true)
+		emptyBlockHash = make([]byte, spec.PathHasherSize())
+	)
+
+	keyring, signingKey := testkeyring.NewTestKeyringWithKey(t, testSigningKeyName)
+
+	eventsQueryClient := testeventsquery.NewOneTimeTxEventsQueryClient(
+		ctx, t, txResultsBzPublishChMu, &txResultsBzPublishCh,
+	)
+
+	// The transactions are accepted by the mempool but never committed.
+	txCtxMock, txCtx := testtx.NewAnyTimesTxTxContext(t, keyring)
+	txCtxMock.EXPECT().NewTxBuilder().
+		DoAndReturn(txCtx.NewTxBuilder).
+		AnyTimes()
+	txCtxMock.EXPECT().GetSimulatedTxGas(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(uint64(1), nil).
+		AnyTimes()
+	txCtxMock.EXPECT().SignTxWithSequence(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(txCtx.SignTxWithSequence).
+		AnyTimes()
+	txCtxMock.EXPECT().EncodeTx(gomock.Any()).
+		DoAndReturn(txCtx.EncodeTx).
+		AnyTimes()
+	txCtxMock.EXPECT().BroadcastTx(gomock.Any()).
+		DoAndReturn(func(txBytes []byte) (*cosmostypes.TxResponse, error) {
+			txHash := encoding.TxHashBytesToNormalizedHex(comettypes.Tx(txBytes).Hash())
+			return &cosmostypes.TxResponse{TxHash: txHash}, nil
+		}).
+		AnyTimes()
+	txCtxMock.EXPECT().QueryTx(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, txHash []byte, _ bool) (*cometrpctypes.ResultTx, error) {
+			return nil, tx.ErrTxNotFound.Wrapf("with hash %X", txHash)
+		}).
+		Times(1)
+
+	blockClientMock := testblock.NewOneTimeCommittedBlocksSequenceBlockClient(
+		t, blocksPublishCh,
+	)
+
+	// Construct the transaction client.
+	txClient, err := tx.NewTxClient(
+		ctx,
+		depinject.Supply(eventsQueryClient, txCtxMock, blockClientMock),
+		testtx.WithDefaultTxClientOptions(t, testSigningKeyName)...,
+	)
+	require.NoError(t, err)
+
+	signingAddr, err := signingKey.GetAddress()
+	require.NoError(t, err)
+
+	// Sign and broadcast two pipelined transactions, the second one being signed
+	// with the sequence following the one of the first.
+	var errChs []<-chan error
+	for i, timeoutHeight := range []int64{firstTxTimeoutHeight, secondTxTimeoutHeight} {
+		appStake := types.NewCoin(volatile.DenomuPOKT, math.NewInt(int64(1000000+i)))
+		appStakeMsg := &apptypes.MsgStakeApplication{
+			Address:  signingAddr.String(),
+			Stake:    &appStake,
+			Services: client.NewTestApplicationServiceConfig(testServiceIdPrefix, 1),
+		}
+
+		_, eitherErr := txClient.SignAndBroadcastWithTimeoutHeight(ctx, timeoutHeight, appStakeMsg)
+		err, errCh := eitherErr.SyncOrAsyncError()
+		require.NoError(t, err)
+
+		errChs = append(errChs, errCh)
+	}
+	require.Len(t, txClient.PendingTxs(), 2)
+
+	for height := int64(1); height <= firstTxTimeoutHeight; height++ {
+		blocksPublishCh <- testblock.NewAnyTimesBlock(t, emptyBlockHash, height)
+	}
+
+	// The first transaction times out, which evicts the second one long before
+	// its own timeout height since its sequence can no longer be used.
+	for i, expectedErr := range []error{tx.ErrTxTimeout, tx.ErrTxEvicted} {
+		select {
+		case err := <-errChs[i]:
+			require.ErrorIs(t, err, expectedErr)
+		case <-time.After(time.Second):
+			t.Fatalf("timed out waiting for the error of tx %d", i)
+		}
+	}
+	require.Empty(t, txClient.PendingTxs())
+}
+
 // TODO_TECHDEBT: add coverage for sending multiple messages simultaneously
 func TestTxClient_SignAndBroadcast_MultipleMsgs(t *testing.T) {
 	t.SkipNow()
@@ -596,6 +944,7 @@ func TestTxClient_GasConfig(t *testing.T) {
 	tests := []struct {
 		name          string
 		options       []client.TxClientOption
+		feeMultiplier float64
 		expectError   bool
 		errorContains string
 		validateFee   func(t *testing.T, txBuilder cosmosclient.TxBuilder)
@@ -691,6 +1040,69 @@ func TestTxClient_GasConfig(t *testing.T) {
 				require.Equal(t, volatile.DenomuPOKT, feeCoins[0].Denom)
 			},
 		},
+		{
+			name: "fee multiplier with gas prices - should multiply calculated fee",
+			options: []client.TxClientOption{
+				tx.WithSigningKeyName(testSigningKeyName),
+				tx.WithGasPrices(&standardGasPrices),
+			},
+			feeMultiplier: 1.5,
+			expectError:   false,
+			validateFee: func(t *testing.T, txBuilder cosmosclient.TxBuilder) {
+				// Default gas * gas price * multiplier = 200000 * 1000 * 1.5 = 300000000
+				feeCoins := txBuilder.GetTx().GetFee()
+				require.Equal(t, 1, len(feeCoins))
+				require.Equal(t, "300000000", feeCoins[0].Amount.String())
+				require.Equal(t, volatile.DenomuPOKT, feeCoins[0].Denom)
+			},
+		},
+		{
+			name: "fee multiplier with fee amount - should multiply fee amount and round up",
+			options: []client.TxClientOption{
+				tx.WithSigningKeyName(testSigningKeyName),
+				tx.WithFeeAmount(&cosmostypes.DecCoins{
+					cosmostypes.NewDecCoinFromDec(volatile.DenomuPOKT, math.LegacyNewDecWithPrec(1005, 1)), // 100.5 uPOKT
+				}),
+			},
+			feeMultiplier: 2.25,
+			expectError:   false,
+			validateFee: func(t *testing.T, txBuilder cosmosclient.TxBuilder) {
+				// 100.5 * 2.25 = 226.125 with decimal rounding to 227
+				feeCoins := txBuilder.GetTx().GetFee()
+				require.Equal(t, 1, len(feeCoins))
+				require.Equal(t, "227", feeCoins[0].Amount.String())
+				require.Equal(t, volatile.DenomuPOKT, feeCoins[0].Denom)
+			},
+		},
+		{
+			name: "fee multiplier with max gas prices - should cap multiplied gas prices",
+			options: []client.TxClientOption{
+				tx.WithSigningKeyName(testSigningKeyName),
+				tx.WithGasPrices(&standardGasPrices),
+				tx.WithMaxGasPrices(&cosmostypes.DecCoins{
+					cosmostypes.NewDecCoin(volatile.DenomuPOKT, math.NewInt(1200)),
+				}),
+			},
+			feeMultiplier: 1.5,
+			expectError:   false,
+			validateFee: func(t *testing.T, txBuilder cosmosclient.TxBuilder) {
+				// Default gas * max gas price = 200000 * 1200 = 240000000
+				feeCoins := txBuilder.GetTx().GetFee()
+				require.Equal(t, 1, len(feeCoins))
+				require.Equal(t, "240000000", feeCoins[0].Amount.String())
+				require.Equal(t, volatile.DenomuPOKT, feeCoins[0].Denom)
+			},
+		},
+		{
+			name: "fee amount and max gas prices - should fail with error",
+			options: []client.TxClientOption{
+				tx.WithSigningKeyName(testSigningKeyName),
+				tx.WithFeeAmount(&standardFeeAmount),
+				tx.WithMaxGasPrices(&standardGasPrices),
+			},
+			expectError:   true,
+			errorContains: "cannot set both fee amount and max gas prices",
+		},
 	}
 
 	for _, tt := range tests {
@@ -711,11 +1123,11 @@ func TestTxClient_GasConfig(t *testing.T) {
 				}).AnyTimes()
 
 				// For simulation tests
-				txCtxMock.EXPECT().GetSimulatedTxGas(gomock.Any(), gomock.Any(), gomock.Any()).
+				txCtxMock.EXPECT().GetSimulatedTxGas(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 					Return(uint64(100000), nil).AnyTimes()
 
 				// Other required methods to pass validation
-				txCtxMock.EXPECT().SignTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				txCtxMock.EXPECT().SignTxWithSequence(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 					Return(nil).AnyTimes()
 				txCtxMock.EXPECT().EncodeTx(gomock.Any()).
 					Return([]byte("test-tx"), nil).AnyTimes()
@@ -788,7 +1200,13 @@ func TestTxClient_GasConfig(t *testing.T) {
 				}
 
 				// Call SignAndBroadcast to trigger fee calculation
-				txClient.SignAndBroadcast(ctx, msg)
+				if tt.feeMultiplier != 0 {
+					_, eitherErr := txClient.SignAndBroadcastWithFeeMultiplier(ctx, 101, tt.feeMultiplier, msg)
+					err, _ := eitherErr.SyncOrAsyncError()
+					require.NoError(t, err)
+				} else {
+					txClient.SignAndBroadcast(ctx, msg)
+				}
 
 				// Validate the fee that was set
 				tt.validateFee(t, txBuilder)
@@ -816,7 +1234,7 @@ func newTxContext(
 	// Construct a new mock transactions context.
 	txCtxMock := testtx.NewBaseTxContext(t, signingKeyName, keyring, expectedTx)
 
-	txCtxMock.EXPECT().GetSimulatedTxGas(gomock.Any(), gomock.Any(), gomock.Any()).
+	txCtxMock.EXPECT().GetSimulatedTxGas(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return(uint64(1), nil).
 		AnyTimes()
 
@@ -2,6 +2,7 @@ package tx
 
 import (
 	"context"
+	"fmt"
 
 	"cosmossdk.io/depinject"
 	cometrpctypes "github.com/cometbft/cometbft/rpc/core/types"
@@ -11,10 +12,13 @@ import (
 	cosmostypes "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/tx"
 	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
 	grpc "google.golang.org/grpc"
 
 	"github.com/pokt-network/poktroll/pkg/client"
 	txtypes "github.com/pokt-network/poktroll/pkg/client/tx/types"
+	"github.com/pokt-network/poktroll/pkg/signer"
 )
 
 // maxGRPCMsgSize is the maximum message size the gRPC client can send and receive.
@@ -37,6 +41,14 @@ type cosmosTxContext struct {
 	// Holds the cosmos-sdk transaction factory.
 	// (see: https://pkg.go.dev/github.com/cosmos/cosmos-sdk@v0.47.5/client/tx#Factory)
 	txFactory cosmostx.Factory
+
+	// remoteSignerClient, if set, is used to sign with the keys served by the
+	// remote signing services instead of the keyring.
+	remoteSignerClient *signer.RemoteSignerClient
+
+	// queryClientConn, if set, is the gRPC connection used to query the accounts
+	// and to simulate the transactions instead of the client context.
+	queryClientConn gogogrpc.ClientConn
 }
 
 // NewTxContext initializes a new cosmosTxContext with the given dependencies.
@@ -46,8 +58,12 @@ type cosmosTxContext struct {
 // Required dependencies:
 //   - cosmosclient.Context
 //   - cosmostx.Factory
-func NewTxContext(deps depinject.Config) (client.TxContext, error) {
-	txCtx := cosmosTxContext{}
+//
+// Available options:
+//   - WithRemoteSigner
+//   - WithQueryClientConn
+func NewTxContext(deps depinject.Config, opts ...client.TxContextOption) (client.TxContext, error) {
+	txCtx := &cosmosTxContext{}
 
 	if err := depinject.Inject(
 		deps,
@@ -57,6 +73,23 @@ func NewTxContext(deps depinject.Config) (client.TxContext, error) {
 		return nil, err
 	}
 
+	for _, opt := range opts {
+		opt(txCtx)
+	}
+
+	// Sign with the remotely served keys through the keyring, so that the
+	// cosmos-sdk signing functions transparently use the remote signing services.
+	if txCtx.remoteSignerClient != nil {
+		remoteSigningKeyring := signer.NewRemoteSigningKeyring(
+			txCtx.txFactory.Keybase(),
+			txCtx.remoteSignerClient,
+		)
+		txCtx.txFactory = txCtx.txFactory.WithKeybase(remoteSigningKeyring)
+		txCtx.clientCtx = txtypes.Context(
+			cosmosclient.Context(txCtx.clientCtx).WithKeyring(remoteSigningKeyring),
+		)
+	}
+
 	return txCtx, nil
 }
 
@@ -83,6 +116,54 @@ func (txCtx cosmosTxContext) SignTx(
 	)
 }
 
+// SignTxWithSequence signs the provided transaction using the given key name,
+// account number and sequence, overwriting any existing signature. Since the
+// account number and sequence are given, it does not query the network.
+func (txCtx cosmosTxContext) SignTxWithSequence(
+	signingKeyName string,
+	txBuilder cosmosclient.TxBuilder,
+	accountNumber, sequence uint64,
+) error {
+	txFactory := txCtx.txFactory.
+		WithAccountNumber(accountNumber).
+		WithSequence(sequence)
+
+	return authclient.SignTx(
+		txFactory,
+		cosmosclient.Context(txCtx.clientCtx),
+		signingKeyName,
+		txBuilder,
+		true, true,
+	)
+}
+
+// GetAccountNumberSequence queries the account number and sequence of the given
+// address from the network.
+func (txCtx cosmosTxContext) GetAccountNumberSequence(
+	address cosmostypes.AccAddress,
+) (accountNumber, sequence uint64, err error) {
+	if txCtx.queryClientConn == nil {
+		clientCtx := cosmosclient.Context(txCtx.clientCtx)
+		return txCtx.clientCtx.AccountRetriever.GetAccountNumberSequence(clientCtx, address)
+	}
+
+	authQueryClient := authtypes.NewQueryClient(txCtx.queryClientConn)
+	res, err := authQueryClient.Account(
+		context.Background(),
+		&authtypes.QueryAccountRequest{Address: address.String()},
+	)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	var account cosmostypes.AccountI
+	if err := txCtx.clientCtx.InterfaceRegistry.UnpackAny(res.Account, &account); err != nil {
+		return 0, 0, err
+	}
+
+	return account.GetAccountNumber(), account.GetSequence(), nil
+}
+
 // NewTxBuilder returns a new transaction builder instance using the cosmos-sdk client transaction config.
 func (txCtx cosmosTxContext) NewTxBuilder() cosmosclient.TxBuilder {
 	return txCtx.clientCtx.TxConfig.NewTxBuilder()
@@ -103,13 +184,34 @@ func (txCtx cosmosTxContext) BroadcastTx(txBytes []byte) (*cosmostypes.TxRespons
 }
 
 // QueryTx queries the transaction based on its hash and optionally provides proof
-// of the transaction. It returns the transaction query result.
+// of the transaction. It returns the transaction query result, or an ErrTxNotFound
+// if the transaction is not indexed, i.e. was never committed.
 func (txCtx cosmosTxContext) QueryTx(
 	ctx context.Context,
 	txHash []byte,
 	prove bool,
 ) (*cometrpctypes.ResultTx, error) {
-	return txCtx.clientCtx.Client.Tx(ctx, txHash, prove)
+	txResult, err := txCtx.clientCtx.Client.Tx(ctx, txHash, prove)
+	if err == nil {
+		return txResult, nil
+	}
+
+	// CometBFT replies with the same JSON-RPC error code to all the failed tx
+	// queries. Search the transaction by hash to tell the ones which are not
+	// indexed apart from the other errors (e.g. connection, indexing disabled).
+	searchResult, searchErr := txCtx.clientCtx.Client.TxSearch(
+		ctx,
+		fmt.Sprintf("tx.hash='%X'", txHash),
+		false,
+		nil,
+		nil,
+		"",
+	)
+	if searchErr == nil && searchResult.TotalCount == 0 {
+		return nil, ErrTxNotFound.Wrapf("with hash %X: %s", txHash, err)
+	}
+
+	return nil, err
 }
 
 // GetClientCtx returns the cosmos-sdk client context associated with the transaction context.
@@ -118,39 +220,29 @@ func (txCtx cosmosTxContext) GetClientCtx() cosmosclient.Context {
 }
 
 // GetSimulatedTxGas calculates the gas for the given messages using the simulation mode.
+// The sequence MUST be the one the transaction is going to be signed with, since
+// the simulation fails on account sequence mismatch.
 func (txCtx cosmosTxContext) GetSimulatedTxGas(
 	ctx context.Context,
 	signingKeyName string,
+	sequence uint64,
 	msgs ...cosmostypes.Msg,
 ) (uint64, error) {
-	clientCtx := cosmosclient.Context(txCtx.clientCtx)
-	keyRecord, err := txCtx.GetKeyring().Key(signingKeyName)
-	if err != nil {
-		return 0, err
-	}
-
-	accAddress, err := keyRecord.GetAddress()
-	if err != nil {
-		return 0, err
-	}
-
-	accountRetriever := txCtx.clientCtx.AccountRetriever
-	_, seq, err := accountRetriever.GetAccountNumberSequence(clientCtx, accAddress)
-	if err != nil {
-		return 0, err
-	}
-
 	txf := txCtx.txFactory.
 		WithSimulateAndExecute(true).
 		WithFromName(signingKeyName).
-		WithSequence(seq)
+		WithSequence(sequence)
 
 	txBytes, err := txf.BuildSimTx(msgs...)
 	if err != nil {
 		return 0, err
 	}
 
-	txSvcClient := tx.NewServiceClient(clientCtx)
+	var queryClientConn gogogrpc.ClientConn = cosmosclient.Context(txCtx.clientCtx)
+	if txCtx.queryClientConn != nil {
+		queryClientConn = txCtx.queryClientConn
+	}
+	txSvcClient := tx.NewServiceClient(queryClientConn)
 
 	simRequest := &tx.SimulateRequest{TxBytes: txBytes}
 	// Set the maximum message size for the gRPC client to allow large transactions
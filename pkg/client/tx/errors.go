@@ -37,5 +37,23 @@ var (
 	// bytes into the corresponding Tx structure or object.
 	ErrUnmarshalTx = sdkerrors.Register(codespace, 10, "failed to unmarshal tx")
 
+	// ErrInvalidFeeMultiplier is returned when a transaction fee is multiplied by
+	// a multiplier which is lower than 1 or cannot be represented as a decimal.
+	ErrInvalidFeeMultiplier = sdkerrors.Register(codespace, 11, "invalid fee multiplier")
+
+	// ErrTxExecution is returned when a transaction which was not observed by the
+	// transaction events subscription is found to have been committed with a
+	// non-zero result code.
+	ErrTxExecution = sdkerrors.Register(codespace, 12, "tx execution failed")
+
+	// ErrTxEvicted is returned when a pending transaction can no longer be
+	// committed because a transaction with a lower account sequence timed out.
+	// It was never committed and can be resubmitted with a resynced sequence.
+	ErrTxEvicted = sdkerrors.Register(codespace, 13, "tx evicted")
+
+	// ErrTxNotFound is returned by TxContext#QueryTx when the queried
+	// transaction is not indexed, i.e. was never committed.
+	ErrTxNotFound = sdkerrors.Register(codespace, 14, "tx not found")
+
 	codespace = "tx_client"
 )
@@ -3,8 +3,10 @@ package tx
 import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	cosmostypes "github.com/cosmos/cosmos-sdk/types"
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
 
 	"github.com/pokt-network/poktroll/pkg/client"
+	"github.com/pokt-network/poktroll/pkg/signer"
 )
 
 // WithSigningKeyName sets the name of the key which should be retrieved from the
@@ -32,6 +34,15 @@ func WithGasPrices(gasPrices *cosmostypes.DecCoins) client.TxClientOption {
 	}
 }
 
+// WithMaxGasPrices sets the ceiling of the gas prices, once multiplied by the
+// fee multiplier of the resubmitted transactions, that the transactions fees are
+// computed with. It cannot be used along with a fee amount.
+func WithMaxGasPrices(maxGasPrices *cosmostypes.DecCoins) client.TxClientOption {
+	return func(client client.TxClient) {
+		client.(*txClient).maxGasPrices = maxGasPrices
+	}
+}
+
 // WithGasSetting sets the gas setting to be used when constructing transactions.
 func WithGasSetting(gasSetting *flags.GasSetting) client.TxClientOption {
 	return func(client client.TxClient) {
@@ -52,3 +63,21 @@ func WithGasAdjustment(gasAdjustment float64) client.TxClientOption {
 		client.(*txClient).gasAdjustment = gasAdjustment
 	}
 }
+
+// WithRemoteSigner sets the client used to sign the transactions with the keys
+// served by the remote signing services, which only need to be in the keyring
+// as offline keys (i.e. public key only).
+func WithRemoteSigner(remoteSignerClient *signer.RemoteSignerClient) client.TxContextOption {
+	return func(txCtx client.TxContext) {
+		txCtx.(*cosmosTxContext).remoteSignerClient = remoteSignerClient
+	}
+}
+
+// WithQueryClientConn sets the gRPC connection used to query the accounts and
+// simulate the transactions, instead of the one of the client context.
+// For instance, a connection failing over across multiple query nodes.
+func WithQueryClientConn(queryClientConn gogogrpc.ClientConn) client.TxContextOption {
+	return func(txCtx client.TxContext) {
+		txCtx.(*cosmosTxContext).queryClientConn = queryClientConn
+	}
+}
@@ -0,0 +1,184 @@
+package tx
+
+import (
+	"cmp"
+	"context"
+	"regexp"
+	"slices"
+	"strconv"
+
+	cosmosclient "github.com/cosmos/cosmos-sdk/client"
+	cosmostypes "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/pokt-network/poktroll/pkg/client"
+	"github.com/pokt-network/poktroll/pkg/polylog"
+)
+
+// expectedSequenceRegex extracts the sequence expected by the network from an
+// account sequence mismatch error message.
+// (see: https://github.com/cosmos/cosmos-sdk/blob/v0.50.13/x/auth/ante/sigverify.go#L289)
+var expectedSequenceRegex = regexp.MustCompile(`account sequence mismatch, expected (\d+), got \d+`)
+
+// pendingTx is a transaction which was broadcast by the txClient and which is
+// neither committed nor timed out yet.
+type pendingTx struct {
+	client.PendingTx
+
+	// txBz is the signed and encoded transaction, kept to rebroadcast it.
+	txBz []byte
+}
+
+// PendingTxs returns the transactions which were broadcast but are neither
+// committed nor timed out yet, ordered by account sequence.
+func (txnClient *txClient) PendingTxs() []client.PendingTx {
+	txnClient.txsMutex.Lock()
+	defer txnClient.txsMutex.Unlock()
+
+	pendingTxs := make([]client.PendingTx, 0, len(txnClient.pendingTxs))
+	for _, pendingTx := range txnClient.pendingTxs {
+		pendingTxs = append(pendingTxs, pendingTx.PendingTx)
+	}
+
+	slices.SortFunc(pendingTxs, func(a, b client.PendingTx) int {
+		return cmp.Compare(a.Sequence, b.Sequence)
+	})
+
+	return pendingTxs
+}
+
+// syncSequence queries the account number and sequence of the signing address
+// from the network if they are not synced yet.
+// It MUST be called while holding sequenceMu.
+func (txnClient *txClient) syncSequence() error {
+	if txnClient.isSequenceSynced {
+		return nil
+	}
+
+	accountNumber, sequence, err := txnClient.txCtx.GetAccountNumberSequence(txnClient.signingAddr)
+	if err != nil {
+		return err
+	}
+
+	txnClient.accountNumber = accountNumber
+	txnClient.nextSequence = sequence
+	txnClient.isSequenceSynced = true
+
+	return nil
+}
+
+// resyncSequence sets the next sequence to the one expected by the network if
+// errMsg is an account sequence mismatch error message.
+// It returns false if errMsg is not an account sequence mismatch error message.
+// It MUST be called while holding sequenceMu.
+func (txnClient *txClient) resyncSequence(errMsg string) bool {
+	matches := expectedSequenceRegex.FindStringSubmatch(errMsg)
+	if matches == nil {
+		return false
+	}
+
+	expectedSequence, err := strconv.ParseUint(matches[1], 10, 64)
+	if err != nil {
+		// Query the sequence from the network instead.
+		txnClient.isSequenceSynced = false
+		return true
+	}
+
+	txnClient.nextSequence = expectedSequence
+	return true
+}
+
+// signAndEncode signs the transaction with the given sequence, validates and
+// serializes it.
+func (txnClient *txClient) signAndEncode(
+	txBuilder cosmosclient.TxBuilder,
+	sequence uint64,
+) ([]byte, error) {
+	// sign transactions
+	err := txnClient.txCtx.SignTxWithSequence(
+		txnClient.signingKeyName,
+		txBuilder,
+		txnClient.accountNumber,
+		sequence,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// ensure transactions is valid
+	// NOTE: this makes the transactions valid; i.e. it is *REQUIRED*
+	if err = txBuilder.GetTx().ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	// serialize transactions
+	return txnClient.txCtx.EncodeTx(txBuilder)
+}
+
+// rebroadcastPendingTransactions broadcasts again, in sequence order, the pending
+// transactions which were not committed rebroadcastHeightOffset blocks after they
+// were last broadcast. Since the mempool rejects the transactions it already
+// contains, only the evicted ones are effectively added back to it.
+func (txnClient *txClient) rebroadcastPendingTransactions(ctx context.Context, height int64) {
+	logger := polylog.Ctx(ctx)
+
+	// Prevent new transactions from being broadcast before the rebroadcast ones
+	// which have lower sequences.
+	txnClient.sequenceMu.Lock()
+	defer txnClient.sequenceMu.Unlock()
+
+	txnClient.txsMutex.Lock()
+	var txsToRebroadcast []*pendingTx
+	for _, pendingTx := range txnClient.pendingTxs {
+		if pendingTx.LastBroadcastHeight == 0 {
+			pendingTx.LastBroadcastHeight = height
+			continue
+		}
+
+		if height-pendingTx.LastBroadcastHeight < txnClient.rebroadcastHeightOffset ||
+			height >= pendingTx.TimeoutHeight {
+			continue
+		}
+
+		pendingTx.LastBroadcastHeight = height
+		txsToRebroadcast = append(txsToRebroadcast, pendingTx)
+	}
+	txnClient.txsMutex.Unlock()
+
+	slices.SortFunc(txsToRebroadcast, func(a, b *pendingTx) int {
+		return cmp.Compare(a.Sequence, b.Sequence)
+	})
+
+	for _, pendingTx := range txsToRebroadcast {
+		txLogger := logger.With(
+			"tx_hash", pendingTx.TxHash,
+			"sequence", pendingTx.Sequence,
+		)
+
+		txResponse, err := txnClient.txCtx.BroadcastTx(pendingTx.txBz)
+		switch {
+		case err != nil:
+			txLogger.Warn().Err(err).Msg("failed to rebroadcast pending tx")
+		case isTxInMempool(txResponse):
+			// The transaction was not evicted, nothing to do.
+		case txResponse.Code != 0:
+			txLogger.Warn().Str("raw_log", txResponse.RawLog).Msg("pending tx rebroadcast rejected")
+		default:
+			txLogger.Info().Msg("rebroadcast pending tx evicted from the mempool")
+		}
+	}
+}
+
+// isSequenceMismatch returns true if the transaction was rejected because it
+// was not signed with the account sequence expected by the network.
+func isSequenceMismatch(txResponse *cosmostypes.TxResponse) bool {
+	return txResponse.Codespace == sdkerrors.ErrWrongSequence.Codespace() &&
+		txResponse.Code == sdkerrors.ErrWrongSequence.ABCICode()
+}
+
+// isTxInMempool returns true if the transaction was rejected because the
+// mempool already contains it.
+func isTxInMempool(txResponse *cosmostypes.TxResponse) bool {
+	return txResponse.Codespace == sdkerrors.ErrTxInMempoolCache.Codespace() &&
+		txResponse.Code == sdkerrors.ErrTxInMempoolCache.ABCICode()
+}
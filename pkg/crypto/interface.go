@@ -13,7 +13,10 @@ import (
 // RingClient is used to construct rings by querying the application module for
 // the addresses of the gateways the application delegated to, and converting
 // them into their corresponding public key points on the secp256k1 curve.
+// The constructed rings are cached per application and session.
 type RingClient interface {
+	RingCache
+
 	// GetRingForAddressAtHeight returns the ring for the given application address
 	// and blockHeight if it exists.
 	GetRingForAddressAtHeight(
@@ -27,6 +30,17 @@ type RingClient interface {
 	VerifyRelayRequestSignature(ctx context.Context, relayRequest *types.RelayRequest) error
 }
 
+// RingCache is the cache of the rings constructed by a RingClient, keyed by
+// application address and session end height.
+type RingCache interface {
+	// InvalidateRings removes the cached rings of the given application address
+	// for all sessions (e.g. when its delegations change).
+	InvalidateRings(appAddress string)
+
+	// Clear removes all the cached rings.
+	Clear()
+}
+
 // PubKeyClient is used to get the public key given an address.
 // Onchain and offchain implementations should take care of retrieving the
 // address' account and returning its public key.
@@ -0,0 +1,171 @@
+package rings
+
+import (
+	"container/list"
+	"slices"
+	"sync"
+
+	ringtypes "github.com/athanorlabs/go-dleq/types"
+)
+
+// DefaultRingCacheMaxEntries is the default maximum number of rings (i.e. distinct
+// application and session end height pairs) held by the ring cache before it
+// starts evicting the oldest ones.
+const DefaultRingCacheMaxEntries = 10_000
+
+// ringCacheKey identifies the ring of an application for a given session.
+type ringCacheKey struct {
+	appAddress       string
+	sessionEndHeight int64
+}
+
+// ringCacheEntry holds the points of a ring along with the addresses they were
+// derived from.
+type ringCacheEntry struct {
+	key ringCacheKey
+
+	// ringAddresses are the sorted addresses of the ring members, which are
+	// compared against the ones derived from the current application state
+	// before the entry is used.
+	ringAddresses []string
+
+	// points are the secp256k1 points of the ring members, in ringAddresses order.
+	points []ringtypes.Point
+
+	// pointsByKey indexes the points by their encoded bytes.
+	pointsByKey map[string]ringtypes.Point
+}
+
+// ringCache is a concurrency-safe and size-bounded cache of the rings points,
+// keyed by application address and session end height.
+//
+// Since converting public keys into points is a deterministic function of the
+// ring addresses, an entry is only considered as a cache hit when its ring
+// addresses match the ones derived from the current application state. This
+// guarantees that a cached ring is always the one that would have been rebuilt,
+// regardless of how (or whether) the cache is invalidated, which is required
+// for the onchain usage of the ring client.
+//
+// When the cache is full, the least recently added entries are evicted first,
+// which naturally drops the rings of the past sessions.
+type ringCache struct {
+	maxEntries int
+
+	// entriesMu protects entries and entriesOrder.
+	entriesMu sync.Mutex
+	// entries indexes the entriesOrder elements by key.
+	entries map[ringCacheKey]*list.Element
+	// entriesOrder holds the *ringCacheEntry values, in insertion order.
+	entriesOrder *list.List
+}
+
+// newRingCache returns an empty ring cache holding at most maxEntries rings.
+// A non-positive maxEntries means the cache is unbounded.
+func newRingCache(maxEntries int) *ringCache {
+	return &ringCache{
+		maxEntries:   maxEntries,
+		entries:      make(map[ringCacheKey]*list.Element),
+		entriesOrder: list.New(),
+	}
+}
+
+// newRingCacheEntry returns a ring cache entry for the given sorted ring addresses
+// and their respective points.
+func newRingCacheEntry(
+	appAddress string,
+	sessionEndHeight int64,
+	ringAddresses []string,
+	points []ringtypes.Point,
+) *ringCacheEntry {
+	pointsByKey := make(map[string]ringtypes.Point, len(points))
+	for _, point := range points {
+		// Use the point's encoded bytes as the key in the map to identify it and
+		// avoid nested loops when checking for its existence.
+		// Since it's not possible to use bytes slices as keys in a map, we convert
+		// the point to a string before using it as a key.
+		pointsByKey[string(point.Encode())] = point
+	}
+
+	return &ringCacheEntry{
+		key: ringCacheKey{
+			appAddress:       appAddress,
+			sessionEndHeight: sessionEndHeight,
+		},
+		ringAddresses: ringAddresses,
+		points:        points,
+		pointsByKey:   pointsByKey,
+	}
+}
+
+// get returns the cached ring of the given application for the given session,
+// if it is made of the given sorted ring addresses.
+func (rc *ringCache) get(
+	appAddress string,
+	sessionEndHeight int64,
+	ringAddresses []string,
+) (*ringCacheEntry, bool) {
+	rc.entriesMu.Lock()
+	defer rc.entriesMu.Unlock()
+
+	element, ok := rc.entries[ringCacheKey{appAddress, sessionEndHeight}]
+	if !ok {
+		return nil, false
+	}
+
+	entry := element.Value.(*ringCacheEntry)
+	if !slices.Equal(entry.ringAddresses, ringAddresses) {
+		return nil, false
+	}
+
+	return entry, true
+}
+
+// set adds or replaces the given entry, evicting the oldest entries if the
+// cache is full.
+func (rc *ringCache) set(entry *ringCacheEntry) {
+	rc.entriesMu.Lock()
+	defer rc.entriesMu.Unlock()
+
+	if element, ok := rc.entries[entry.key]; ok {
+		rc.entriesOrder.Remove(element)
+	}
+	rc.entries[entry.key] = rc.entriesOrder.PushBack(entry)
+
+	for rc.maxEntries > 0 && rc.entriesOrder.Len() > rc.maxEntries {
+		oldest := rc.entriesOrder.Remove(rc.entriesOrder.Front()).(*ringCacheEntry)
+		delete(rc.entries, oldest.key)
+	}
+}
+
+// InvalidateRings removes the cached rings of the given application address
+// for all sessions.
+func (rc *ringCache) InvalidateRings(appAddress string) {
+	rc.entriesMu.Lock()
+	defer rc.entriesMu.Unlock()
+
+	for key, element := range rc.entries {
+		if key.appAddress != appAddress {
+			continue
+		}
+
+		rc.entriesOrder.Remove(element)
+		delete(rc.entries, key)
+	}
+}
+
+// Clear removes all the cached rings.
+func (rc *ringCache) Clear() {
+	rc.entriesMu.Lock()
+	defer rc.entriesMu.Unlock()
+
+	rc.entries = make(map[ringCacheKey]*list.Element)
+	rc.entriesOrder.Init()
+}
+
+// len returns the number of cached rings.
+func (rc *ringCache) len() int {
+	rc.entriesMu.Lock()
+	defer rc.entriesMu.Unlock()
+
+	return rc.entriesOrder.Len()
+}
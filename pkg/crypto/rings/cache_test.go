@@ -0,0 +1,120 @@
+package rings
+
+import (
+	"testing"
+
+	ring_secp256k1 "github.com/athanorlabs/go-dleq/secp256k1"
+	ringtypes "github.com/athanorlabs/go-dleq/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/poktroll/testutil/sample"
+)
+
+func TestRingCache_Get(t *testing.T) {
+	appAddress := sample.AccAddress()
+	gatewayAddress := sample.AccAddress()
+	otherGatewayAddress := sample.AccAddress()
+	ringAddresses := []string{appAddress, gatewayAddress}
+
+	tests := []struct {
+		desc             string
+		appAddress       string
+		sessionEndHeight int64
+		ringAddresses    []string
+		expectedHit      bool
+	}{
+		{
+			desc:             "hit for the same app, session and ring addresses",
+			appAddress:       appAddress,
+			sessionEndHeight: 10,
+			ringAddresses:    ringAddresses,
+			expectedHit:      true,
+		},
+		{
+			desc:             "miss for another session",
+			appAddress:       appAddress,
+			sessionEndHeight: 20,
+			ringAddresses:    ringAddresses,
+		},
+		{
+			desc:             "miss for another app",
+			appAddress:       gatewayAddress,
+			sessionEndHeight: 10,
+			ringAddresses:    ringAddresses,
+		},
+		{
+			desc:             "miss when the delegations changed",
+			appAddress:       appAddress,
+			sessionEndHeight: 10,
+			ringAddresses:    []string{appAddress, gatewayAddress, otherGatewayAddress},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			cache := newRingCache(DefaultRingCacheMaxEntries)
+			cache.set(newTestRingCacheEntry(t, appAddress, 10, ringAddresses))
+
+			_, isHit := cache.get(test.appAddress, test.sessionEndHeight, test.ringAddresses)
+			require.Equal(t, test.expectedHit, isHit)
+		})
+	}
+}
+
+func TestRingCache_Eviction(t *testing.T) {
+	appAddress := sample.AccAddress()
+	ringAddresses := []string{appAddress, appAddress}
+
+	cache := newRingCache(2)
+	for sessionEndHeight := int64(10); sessionEndHeight <= 30; sessionEndHeight += 10 {
+		cache.set(newTestRingCacheEntry(t, appAddress, sessionEndHeight, ringAddresses))
+	}
+	require.Equal(t, 2, cache.len())
+
+	// The oldest session ring is evicted first.
+	_, isHit := cache.get(appAddress, 10, ringAddresses)
+	require.False(t, isHit)
+	_, isHit = cache.get(appAddress, 20, ringAddresses)
+	require.True(t, isHit)
+	_, isHit = cache.get(appAddress, 30, ringAddresses)
+	require.True(t, isHit)
+}
+
+func TestRingCache_InvalidateRings(t *testing.T) {
+	appAddress := sample.AccAddress()
+	otherAppAddress := sample.AccAddress()
+
+	cache := newRingCache(DefaultRingCacheMaxEntries)
+	cache.set(newTestRingCacheEntry(t, appAddress, 10, []string{appAddress, appAddress}))
+	cache.set(newTestRingCacheEntry(t, appAddress, 20, []string{appAddress, appAddress}))
+	cache.set(newTestRingCacheEntry(t, otherAppAddress, 10, []string{otherAppAddress, otherAppAddress}))
+
+	// All the sessions rings of the invalidated app are removed.
+	cache.InvalidateRings(appAddress)
+	require.Equal(t, 1, cache.len())
+
+	_, isHit := cache.get(otherAppAddress, 10, []string{otherAppAddress, otherAppAddress})
+	require.True(t, isHit)
+
+	cache.Clear()
+	require.Equal(t, 0, cache.len())
+}
+
+// newTestRingCacheEntry returns a ring cache entry with a random point for each
+// of the given ring addresses.
+func newTestRingCacheEntry(
+	t *testing.T,
+	appAddress string,
+	sessionEndHeight int64,
+	ringAddresses []string,
+) *ringCacheEntry {
+	t.Helper()
+
+	curve := ring_secp256k1.NewCurve()
+	points := make([]ringtypes.Point, 0, len(ringAddresses))
+	for range ringAddresses {
+		points = append(points, curve.ScalarBaseMul(curve.NewRandomScalar()))
+	}
+
+	return newRingCacheEntry(appAddress, sessionEndHeight, ringAddresses, points)
+}
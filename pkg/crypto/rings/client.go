@@ -36,6 +36,13 @@ type ringClient struct {
 
 	// sharedQuerier is used to fetch the shared module's parameters.
 	sharedQuerier client.SharedQueryClient
+
+	// ringCacheMaxEntries is the maximum number of rings held by ringCache.
+	ringCacheMaxEntries int
+
+	// ringCache caches the rings points by application address and session
+	// end height. It is embedded to implement the crypto.RingCache interface.
+	*ringCache
 }
 
 // NewRingClient returns a new ring client constructed from the given dependencies.
@@ -46,8 +53,16 @@ type ringClient struct {
 // - client.ApplicationQueryClient
 // - client.AccountQueryClient
 // - client.SharedQueryClient
-func NewRingClient(deps depinject.Config) (_ crypto.RingClient, err error) {
-	rc := new(ringClient)
+//
+// Available options:
+// - WithRingCacheMaxEntries
+func NewRingClient(
+	deps depinject.Config,
+	opts ...RingClientOption,
+) (_ crypto.RingClient, err error) {
+	rc := &ringClient{
+		ringCacheMaxEntries: DefaultRingCacheMaxEntries,
+	}
 
 	if err := depinject.Inject(
 		deps,
@@ -59,6 +74,12 @@ func NewRingClient(deps depinject.Config) (_ crypto.RingClient, err error) {
 		return nil, err
 	}
 
+	for _, opt := range opts {
+		opt(rc)
+	}
+
+	rc.ringCache = newRingCache(rc.ringCacheMaxEntries)
+
 	return rc, nil
 }
 
@@ -78,12 +99,12 @@ func (rc *ringClient) GetRingForAddressAtHeight(
 	appAddress string,
 	blockHeight int64,
 ) (*ring.Ring, error) {
-	ringPubKeys, err := rc.getRingPubKeysForAddress(ctx, appAddress, blockHeight)
+	ringEntry, err := rc.getRingForAddressAtHeight(ctx, appAddress, blockHeight)
 	if err != nil {
 		return nil, err
 	}
 
-	return GetRingFromPubKeys(ringPubKeys)
+	return newRingFromPoints(ringEntry.points)
 }
 
 // VerifyRelayRequestSignature verifies the signature of the relay request
@@ -125,7 +146,7 @@ func (rc *ringClient) VerifyRelayRequestSignature(
 	// Get the ring for the application address of the relay request.
 	sessionEndHeight := sessionHeader.GetSessionEndBlockHeight()
 	appAddress := sessionHeader.GetApplicationAddress()
-	expectedRelayRingPointsForApp, err := rc.getRingPointsForAddressAtHeight(
+	expectedRelayRingForApp, err := rc.getRingForAddressAtHeight(
 		ctx,
 		appAddress,
 		sessionEndHeight,
@@ -138,7 +159,7 @@ func (rc *ringClient) VerifyRelayRequestSignature(
 
 	// Check that the expected ring signature points map contains the public keys
 	// in the relay request's ring signature.
-	if !ringPointsContain(expectedRelayRingPointsForApp, relayRequestRingSig) {
+	if !ringPointsContain(expectedRelayRingForApp.pointsByKey, relayRequestRingSig) {
 		return ErrRingClientInvalidRelayRequestSignature.Wrapf(
 			"ring signature in the relay request does not match the expected one for the app %s", appAddress,
 		)
@@ -158,31 +179,75 @@ func (rc *ringClient) VerifyRelayRequestSignature(
 	return nil
 }
 
-// getRingPubKeysForAddress returns the public keys corresponding to a ring.
-// It returns a slice consisting of the application's public key and the public
-// keys of the gateways to which the application delegated the authority to sign
-// relay requests on its behalf at the given block height.
-func (rc *ringClient) getRingPubKeysForAddress(
+// getRingForAddressAtHeight returns the ring points of the given application at
+// the given block height. They are served from the ring cache when the ring
+// addresses derived from the current application state did not change since
+// they were cached, otherwise the ring members public keys are queried and
+// converted into points before being cached.
+func (rc *ringClient) getRingForAddressAtHeight(
 	ctx context.Context,
 	appAddress string,
 	blockHeight int64,
-) ([]cryptotypes.PubKey, error) {
+) (*ringCacheEntry, error) {
 	// Get the application's on chain state.
 	app, err := rc.applicationQuerier.GetApplication(ctx, appAddress)
 	if err != nil {
 		return nil, err
 	}
 
-	// Reconstruct the delegatee gateway addresses at the given block height and
-	// add them to the ring addresses.
-	delegateeGatewayAddresses, err := rc.GetRingAddressesAtBlock(ctx, &app, blockHeight)
+	// TODO_MAINNET(#543): We don't really want to have to query the params for every method call.
+	// Once `ModuleParamsClient` is implemented, use its replay observable's `#Last` method
+	// to get the most recently (asynchronously) observed (and cached) value.
+	sharedParams, err := rc.sharedQuerier.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Round the height up to the session end height, which is both the height
+	// at which the delegations are considered and the ring cache key.
+	sessionEndHeight := sharedtypes.GetSessionEndHeight(sharedParams, blockHeight)
+	ringAddresses := getRingAddresses(&app, sessionEndHeight)
+
+	if ringEntry, ok := rc.ringCache.get(appAddress, sessionEndHeight, ringAddresses); ok {
+		RingCacheHitsTotal.Add(1)
+		return ringEntry, nil
+	}
+	RingCacheMissesTotal.Add(1)
+
+	rc.logger.Debug().
+		// TODO_TECHDEBT: implement and use `polylog.Event#Strs([]string)`
+		Str("addresses", fmt.Sprintf("%v", ringAddresses)).
+		Msg("converting addresses to points")
+
+	ringPubKeys, err := rc.addressesToPubKeys(ctx, ringAddresses)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the points on the secp256k1 curve for the public keys in the ring.
+	points, err := pointsFromPublicKeys(ringPubKeys...)
 	if err != nil {
 		return nil, err
 	}
 
+	ringEntry := newRingCacheEntry(appAddress, sessionEndHeight, ringAddresses, points)
+	rc.ringCache.set(ringEntry)
+
+	return ringEntry, nil
+}
+
+// getRingAddresses returns the sorted addresses of the ring of the given application
+// at the given session end height. It consists of the application's address and
+// the addresses of the gateways to which the application delegated the authority
+// to sign relay requests on its behalf at the given session end height.
+func getRingAddresses(app *apptypes.Application, sessionEndHeight int64) []string {
+	// Reconstruct the delegatee gateway addresses at the given session end height
+	// and add them to the ring addresses.
+	delegateeGatewayAddresses := GetRingAddressesAtSessionEndHeight(app, uint64(sessionEndHeight))
+
 	// Create a slice of addresses for the ring.
-	ringAddresses := make([]string, 0)
-	ringAddresses = append(ringAddresses, appAddress) // app address is index 0
+	ringAddresses := make([]string, 0, len(delegateeGatewayAddresses)+1)
+	ringAddresses = append(ringAddresses, app.Address) // app address is index 0
 
 	// TODO_IMPROVE: The appAddress is added twice because a ring signature
 	// requires AT LEAST two pubKeys. If the Application has not delegated
@@ -199,12 +264,7 @@ func (rc *ringClient) getRingPubKeysForAddress(
 	// verification by satisfying relayRequestRingSig.Ring().Equals(expectedAppRing)
 	slices.Sort(ringAddresses)
 
-	rc.logger.Debug().
-		// TODO_TECHDEBT: implement and use `polylog.Event#Strs([]string)`
-		Str("addresses", fmt.Sprintf("%v", ringAddresses)).
-		Msg("converting addresses to points")
-
-	return rc.addressesToPubKeys(ctx, ringAddresses)
+	return ringAddresses
 }
 
 // addressesToPubKeys queries for and returns the public keys for the addresses
@@ -224,40 +284,6 @@ func (rc *ringClient) addressesToPubKeys(
 	return pubKeys, nil
 }
 
-// getRingPointsForAddressAtHeight returns a map of the ring points for the given
-// application at a specific height. It takes into account the application itself
-// as well as all the addresses it delegated to. It returns a map of encoded
-// ring points to Point objects (i.e. public keys).
-func (rc *ringClient) getRingPointsForAddressAtHeight(
-	ctx context.Context,
-	appAddress string,
-	blockHeight int64,
-) (map[string]ringtypes.Point, error) {
-	ringPubKeys, err := rc.getRingPubKeysForAddress(ctx, appAddress, blockHeight)
-	if err != nil {
-		return nil, err
-	}
-
-	// Get the points on the secp256k1 curve for the public keys in the ring.
-	points, err := pointsFromPublicKeys(ringPubKeys...)
-	if err != nil {
-		return nil, err
-	}
-
-	ringPoints := make(map[string]ringtypes.Point, len(points))
-	for _, point := range points {
-		// Use the point's encoded bytes as the key in the map to identify it and
-		// avoid nested loops when checking for its existence.
-		// Since it's not possible to use bytes slices as keys in a map, we convert
-		// the point to a string before using it as a key.
-		keyFromPoint := string(point.Encode())
-		ringPoints[keyFromPoint] = point
-	}
-
-	// Return the ring the constructed from the points retrieved above.
-	return ringPoints, nil
-}
-
 // GetRingAddressesAtBlock returns the active gateway addresses that need to be
 // used to construct the ring in order to validate that the given app should pay for.
 // It takes into account both active delegations and pending undelegations that
@@ -0,0 +1,35 @@
+package rings
+
+import (
+	"github.com/go-kit/kit/metrics/prometheus"
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	ringClientSubsystem = "ring_client"
+
+	ringCacheHitsTotal   = "ring_cache_hits_total"
+	ringCacheMissesTotal = "ring_cache_misses_total"
+)
+
+var (
+	// RingCacheHitsTotal is a Counter metric for the rings served from the ring
+	// cache, without querying the application's and gateways' public keys.
+	//
+	// Usage:
+	// - Monitor the effectiveness of the ring cache on the relay verification path.
+	// - Together with RingCacheMissesTotal, compute the ring cache hit ratio.
+	RingCacheHitsTotal = prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+		Subsystem: ringClientSubsystem,
+		Name:      ringCacheHitsTotal,
+		Help:      "Total number of rings served from the ring cache.",
+	}, []string{})
+
+	// RingCacheMissesTotal is a Counter metric for the rings which were not cached,
+	// or whose application delegations changed, and had to be rebuilt.
+	RingCacheMissesTotal = prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+		Subsystem: ringClientSubsystem,
+		Name:      ringCacheMissesTotal,
+		Help:      "Total number of rings rebuilt because they were missing from the ring cache.",
+	}, []string{})
+)
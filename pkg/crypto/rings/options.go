@@ -0,0 +1,13 @@
+package rings
+
+// RingClientOption is a function which configures a ringClient.
+type RingClientOption func(*ringClient)
+
+// WithRingCacheMaxEntries sets the maximum number of rings (i.e. distinct
+// application and session end height pairs) held by the ring cache before the
+// oldest ones are evicted. A non-positive value makes the ring cache unbounded.
+func WithRingCacheMaxEntries(maxEntries int) RingClientOption {
+	return func(rc *ringClient) {
+		rc.ringCacheMaxEntries = maxEntries
+	}
+}
@@ -16,11 +16,13 @@ import (
 	"github.com/pokt-network/poktroll/pkg/client"
 	"github.com/pokt-network/poktroll/pkg/client/block"
 	"github.com/pokt-network/poktroll/pkg/client/events"
+	"github.com/pokt-network/poktroll/pkg/client/failover"
 	"github.com/pokt-network/poktroll/pkg/client/query"
 	querycache "github.com/pokt-network/poktroll/pkg/client/query/cache"
 	"github.com/pokt-network/poktroll/pkg/client/supplier"
 	"github.com/pokt-network/poktroll/pkg/client/tx"
 	txtypes "github.com/pokt-network/poktroll/pkg/client/tx/types"
+	"github.com/pokt-network/poktroll/pkg/crypto"
 	"github.com/pokt-network/poktroll/pkg/crypto/rings"
 	"github.com/pokt-network/poktroll/pkg/polylog"
 )
@@ -68,15 +70,41 @@ func NewSupplyLoggerFromCtx(ctx context.Context) SupplierFn {
 
 // NewSupplyEventsQueryClientFn supplies a depinject config with an
 // EventsQueryClient from the given queryNodeRPCURL.
-func NewSupplyEventsQueryClientFn(queryNodeRPCURL *url.URL) SupplierFn {
+// If fallbackQueryNodeRPCURLs are given, the EventsQueryClient fails over to
+// their websockets, in order, when the preferred one is unreachable, lagging
+// behind or its connection is interrupted.
+func NewSupplyEventsQueryClientFn(
+	queryNodeRPCURL *url.URL,
+	fallbackQueryNodeRPCURLs ...*url.URL,
+) SupplierFn {
 	return func(
-		_ context.Context,
+		ctx context.Context,
 		deps depinject.Config,
 		_ *cobra.Command,
 	) (depinject.Config, error) {
 		// Convert the host to a websocket URL
 		queryNodeWebsocketURL := events.RPCToWebsocketURL(queryNodeRPCURL)
-		eventsQueryClient := events.NewEventsQueryClient(queryNodeWebsocketURL)
+
+		var eventsQueryClientOpts []client.EventsQueryClientOption
+		if len(fallbackQueryNodeRPCURLs) > 0 {
+			// The CometRPC client tracks the health and height of the endpoints
+			// whose websockets are connected to.
+			cometRPC, err := failover.NewCometRPC(
+				ctx,
+				polylog.Ctx(ctx),
+				append([]*url.URL{queryNodeRPCURL}, fallbackQueryNodeRPCURLs...),
+			)
+			if err != nil {
+				return nil, err
+			}
+
+			eventsQueryClientOpts = append(
+				eventsQueryClientOpts,
+				events.WithFailoverEndpoints(cometRPC.Endpoints()),
+			)
+		}
+
+		eventsQueryClient := events.NewEventsQueryClient(queryNodeWebsocketURL, eventsQueryClientOpts...)
 
 		return depinject.Configs(deps, depinject.Supply(eventsQueryClient)), nil
 	}
@@ -100,66 +128,123 @@ func NewSupplyBlockClientFn(queryNodeRPCURL *url.URL) SupplierFn {
 	}
 }
 
+// QueryClientConnWrapperFn wraps the gRPC connection used by the query clients,
+// for instance to verify the responses of the queries, using the dependencies
+// supplied so far.
+type QueryClientConnWrapperFn func(deps depinject.Config, conn grpc.ClientConn) (grpc.ClientConn, error)
+
 // NewSupplyQueryClientContextFn supplies a depinject config with a query
 //
 //	ClientContext, a GRPC client connection, and a keyring from the given queryNodeGRPCURL.
-func NewSupplyQueryClientContextFn(queryNodeGRPCURL *url.URL) SupplierFn {
-	return func(_ context.Context,
+//
+// If fallbackQueryNodeGRPCURLs are given, the GRPC client connection fails over
+// to them, in order, when the preferred one is unreachable or lagging behind.
+// The connection is then wrapped by the given connWrapperFns, in order.
+func NewSupplyQueryClientContextFn(
+	queryNodeGRPCURL *url.URL,
+	fallbackQueryNodeGRPCURLs []*url.URL,
+	connWrapperFns ...QueryClientConnWrapperFn,
+) SupplierFn {
+	return func(ctx context.Context,
 		deps depinject.Config,
 		cmd *cobra.Command,
 	) (depinject.Config, error) {
-		// Temporarily store the flag's current value to be restored later, after
-		// the client context has been created with queryNodeGRPCURL.
-		// TODO_TECHDEBT(#223) Retrieve value from viper instead, once integrated.
-		tmpGRPC, err := cmd.Flags().GetString(cosmosflags.FlagGRPC)
+		queryClientCtx, err := getClientQueryContext(cmd, queryNodeGRPCURL)
 		if err != nil {
 			return nil, err
 		}
 
-		// Set --grpc-addr flag to the pocketQueryNodeURL for the client context
-		// This flag is read by sdkclient.GetClientQueryContext.
-		// Cosmos-SDK is expecting a GRPC address formatted as <hostname>[:<port>],
-		// so we only need to set the Host parameter of the URL to cosmosflags.FlagGRPC value.
-		if err = cmd.Flags().Set(cosmosflags.FlagGRPC, queryNodeGRPCURL.Host); err != nil {
-			return nil, err
+		var queryClientConn grpc.ClientConn = queryClientCtx
+		if len(fallbackQueryNodeGRPCURLs) > 0 {
+			queryNodeGRPCURLs := append([]*url.URL{queryNodeGRPCURL}, fallbackQueryNodeGRPCURLs...)
+			queryClientConns := []grpc.ClientConn{queryClientCtx}
+			for _, fallbackQueryNodeGRPCURL := range fallbackQueryNodeGRPCURLs {
+				fallbackQueryClientCtx, err := getClientQueryContext(cmd, fallbackQueryNodeGRPCURL)
+				if err != nil {
+					return nil, err
+				}
+				queryClientConns = append(queryClientConns, fallbackQueryClientCtx)
+			}
+
+			queryClientConn, err = failover.NewGRPCClientConn(
+				ctx,
+				polylog.Ctx(ctx),
+				queryNodeGRPCURLs,
+				queryClientConns,
+			)
+			if err != nil {
+				return nil, err
+			}
 		}
 
-		// NB: Currently, the implementations of GetClientTxContext() and
-		// GetClientQueryContext() are identical, allowing for their interchangeable
-		// use in both querying and transaction operations. However, in order to support
-		// independent configuration of client contexts for distinct querying and
-		// transacting purposes.
-		// For example, txs could be dispatched to a validator while queries
-		// could be handled by a full-node.
-		queryClientCtx, err := sdkclient.GetClientQueryContext(cmd)
-		if err != nil {
-			return nil, err
+		for _, wrapConn := range connWrapperFns {
+			if queryClientConn, err = wrapConn(deps, queryClientConn); err != nil {
+				return nil, err
+			}
 		}
+
 		deps = depinject.Configs(deps, depinject.Supply(
 			query.Context(queryClientCtx),
-			grpc.ClientConn(queryClientCtx),
+			queryClientConn,
 			queryClientCtx.Keyring,
 		))
 
-		// Restore the flag's original value in order for other components
-		// to use the flag as expected.
-		if err := cmd.Flags().Set(cosmosflags.FlagGRPC, tmpGRPC); err != nil {
-			return nil, err
-		}
-
 		return deps, nil
 	}
 }
 
+// getClientQueryContext returns a query client context whose GRPC client
+// connection is to the given queryNodeGRPCURL.
+func getClientQueryContext(cmd *cobra.Command, queryNodeGRPCURL *url.URL) (sdkclient.Context, error) {
+	// Temporarily store the flag's current value to be restored later, after
+	// the client context has been created with queryNodeGRPCURL.
+	// TODO_TECHDEBT(#223) Retrieve value from viper instead, once integrated.
+	tmpGRPC, err := cmd.Flags().GetString(cosmosflags.FlagGRPC)
+	if err != nil {
+		return sdkclient.Context{}, err
+	}
+
+	// Set --grpc-addr flag to the pocketQueryNodeURL for the client context
+	// This flag is read by sdkclient.GetClientQueryContext.
+	// Cosmos-SDK is expecting a GRPC address formatted as <hostname>[:<port>],
+	// so we only need to set the Host parameter of the URL to cosmosflags.FlagGRPC value.
+	if err = cmd.Flags().Set(cosmosflags.FlagGRPC, queryNodeGRPCURL.Host); err != nil {
+		return sdkclient.Context{}, err
+	}
+
+	// NB: Currently, the implementations of GetClientTxContext() and
+	// GetClientQueryContext() are identical, allowing for their interchangeable
+	// use in both querying and transaction operations. However, in order to support
+	// independent configuration of client contexts for distinct querying and
+	// transacting purposes.
+	// For example, txs could be dispatched to a validator while queries
+	// could be handled by a full-node.
+	queryClientCtx, err := sdkclient.GetClientQueryContext(cmd)
+	if err != nil {
+		return sdkclient.Context{}, err
+	}
+
+	// Restore the flag's original value in order for other components
+	// to use the flag as expected.
+	if err := cmd.Flags().Set(cosmosflags.FlagGRPC, tmpGRPC); err != nil {
+		return sdkclient.Context{}, err
+	}
+
+	return queryClientCtx, nil
+}
+
 // NewSupplyTxClientContextFn supplies a depinject config with a TxClientContext
 // from the given txNodeGRPCURL.
+// If fallbackTxNodeRPCURLs are given, the txs are broadcast to them, in order,
+// when the preferred one is unreachable.
 // TODO_TECHDEBT(#256): Remove this function once the as we may no longer
 // need to supply a TxClientContext to the RelayMiner.
 func NewSupplyTxClientContextFn(
 	queryNodeGRPCURL *url.URL,
 	txNodeRPCURL *url.URL,
+	fallbackTxNodeRPCURLs ...*url.URL,
 ) SupplierFn {
-	return func(_ context.Context,
+	return func(ctx context.Context,
 		deps depinject.Config,
 		cmd *cobra.Command,
 	) (depinject.Config, error) {
@@ -214,6 +299,19 @@ func NewSupplyTxClientContextFn(
 		if err != nil {
 			return nil, err
 		}
+
+		if len(fallbackTxNodeRPCURLs) > 0 {
+			cometRPC, err := failover.NewCometRPC(
+				ctx,
+				polylog.Ctx(ctx),
+				append([]*url.URL{txNodeRPCURL}, fallbackTxNodeRPCURLs...),
+			)
+			if err != nil {
+				return nil, err
+			}
+			txClientCtx = txClientCtx.WithClient(cometRPC)
+		}
+
 		deps = depinject.Configs(deps, depinject.Supply(
 			txtypes.Context(txClientCtx),
 		))
@@ -311,9 +409,11 @@ func NewSupplySupplierQuerierFn() SupplierFn {
 }
 
 // NewSupplyRingClientFn supplies a depinject config with a RingClient.
-func NewSupplyRingClientFn() SupplierFn {
+// The given cache options are applied to the ring cache of the RingClient
+// (e.g. to invalidate it upon delegation changes).
+func NewSupplyRingClientFn(opts ...querycache.CacheOption[crypto.RingCache]) SupplierFn {
 	return func(
-		_ context.Context,
+		ctx context.Context,
 		deps depinject.Config,
 		_ *cobra.Command,
 	) (depinject.Config, error) {
@@ -323,6 +423,12 @@ func NewSupplyRingClientFn() SupplierFn {
 			return nil, err
 		}
 
+		for _, opt := range opts {
+			if err := opt(ctx, deps, ringClient); err != nil {
+				return nil, err
+			}
+		}
+
 		// Supply the ring cache to the provided deps
 		return depinject.Configs(deps, depinject.Supply(ringClient)), nil
 	}
@@ -336,7 +442,14 @@ func NewSupplyRingClientFn() SupplierFn {
 //   - gasSettingStr is the gas setting to use for the tx client.
 //     Options are "auto", "<integer>", or "".
 //     See: config.GetTxClientGasAndFeesOptionsFromFlags.
-func NewSupplySupplierClientsFn(signingKeyNames []string, gasSettingStr string) SupplierFn {
+//   - txClientOpts are additional options applied to every tx client.
+//   - supplierClientOpts are additional options applied to every supplier client.
+func NewSupplySupplierClientsFn(
+	signingKeyNames []string,
+	gasSettingStr string,
+	txClientOpts []client.TxClientOption,
+	supplierClientOpts ...client.SupplierClientOption,
+) SupplierFn {
 	return func(
 		ctx context.Context,
 		deps depinject.Config,
@@ -347,6 +460,7 @@ func NewSupplySupplierClientsFn(signingKeyNames []string, gasSettingStr string)
 		if err != nil {
 			return nil, err
 		}
+		txClientOptions = append(txClientOptions, txClientOpts...)
 
 		suppliers := supplier.NewSupplierClientMap()
 		for _, signingKeyName := range signingKeyNames {
@@ -362,7 +476,10 @@ func NewSupplySupplierClientsFn(signingKeyNames []string, gasSettingStr string)
 
 			supplierClient, err := supplier.NewSupplierClient(
 				txClientDepinjectConfig,
-				supplier.WithSigningKeyName(signingKeyName),
+				append(
+					[]client.SupplierClientOption{supplier.WithSigningKeyName(signingKeyName)},
+					supplierClientOpts...,
+				)...,
 			)
 			if err != nil {
 				return nil, err
@@ -378,12 +495,30 @@ func NewSupplySupplierClientsFn(signingKeyNames []string, gasSettingStr string)
 // NewSupplyBlockQueryClientFn returns a function which constructs a
 // BlockQueryClient instance and returns a new depinject.Config which
 // is supplied with the given deps and the new BlockQueryClient.
-func NewSupplyBlockQueryClientFn(queryNodeRPCUrl *url.URL) SupplierFn {
+// If fallbackQueryNodeRPCUrls are given, the BlockQueryClient fails over to them,
+// in order, when the preferred one is unreachable or lagging behind.
+func NewSupplyBlockQueryClientFn(
+	queryNodeRPCUrl *url.URL,
+	fallbackQueryNodeRPCUrls ...*url.URL,
+) SupplierFn {
 	return func(
-		_ context.Context,
+		ctx context.Context,
 		deps depinject.Config,
 		_ *cobra.Command,
 	) (depinject.Config, error) {
+		if len(fallbackQueryNodeRPCUrls) > 0 {
+			cometRPC, err := failover.NewCometRPC(
+				ctx,
+				polylog.Ctx(ctx),
+				append([]*url.URL{queryNodeRPCUrl}, fallbackQueryNodeRPCUrls...),
+			)
+			if err != nil {
+				return nil, err
+			}
+
+			return depinject.Configs(deps, depinject.Supply(cometRPC)), nil
+		}
+
 		blockQueryClient, err := sdkclient.NewClientFromNode(queryNodeRPCUrl.String())
 		if err != nil {
 			return nil, err
@@ -521,6 +656,35 @@ func NewSupplyKeyValueCacheFn[T any](opts ...querycache.CacheOption[cache.KeyVal
 	}
 }
 
+// NewSupplyHistoricalKeyValueCacheFn returns a function which constructs a
+// HistoricalKeyValueCache of type T, configured with the given memory cache options.
+func NewSupplyHistoricalKeyValueCacheFn[T any](opts ...memory.KeyValueCacheOptionFn) SupplierFn {
+	return func(
+		_ context.Context,
+		deps depinject.Config,
+		cmd *cobra.Command,
+	) (depinject.Config, error) {
+		// Check if query caching is enabled
+		queryCachingEnabled, err := cmd.Flags().GetBool(FlagQueryCaching)
+		if err != nil {
+			return nil, err
+		}
+
+		// Use a NoOpHistoricalKeyValueCache if query caching is disabled
+		if !queryCachingEnabled {
+			noopHistoricalCache := querycache.NewNoOpHistoricalKeyValueCache[T]()
+			return depinject.Configs(deps, depinject.Supply(noopHistoricalCache)), nil
+		}
+
+		historicalCache, err := memory.NewHistoricalKeyValueCache[T](opts...)
+		if err != nil {
+			return nil, err
+		}
+
+		return depinject.Configs(deps, depinject.Supply(historicalCache)), nil
+	}
+}
+
 // NewSupplyParamsCacheFn returns a function which constructs a ParamsCache of type T.
 // It take a list of cache options that can be used to configure the cache.
 func NewSupplyParamsCacheFn[T any](opts ...querycache.CacheOption[client.ParamsCache[T]]) SupplierFn {
@@ -13,16 +13,20 @@ type ForEachFn[V any] func(ctx context.Context, src V)
 // notification received from the observable. If the transformFn returns a skip
 // bool of true, the notification is skipped and not emitted to the resulting
 // observable.
+// The given options configure the resulting observable; WithStageName also
+// instruments the transformation stage.
 func Map[S, D any](
 	ctx context.Context,
 	srcObservable observable.Observable[S],
 	transformFn MapFn[S, D],
+	opts ...option[D],
 ) observable.Observable[D] {
-	dstObservable, dstProducer := NewObservable[D]()
+	dstObservable, dstProducer := NewObservable[D](opts...)
 	srcObserver := srcObservable.Subscribe(ctx)
 
 	go goMapTransformNotification(
 		ctx,
+		getStageName(opts),
 		srcObserver,
 		transformFn,
 		func(dstNotification D) {
@@ -41,12 +45,14 @@ func MapExpand[S, D any](
 	ctx context.Context,
 	srcObservable observable.Observable[S],
 	transformFn MapFn[S, []D],
+	opts ...option[D],
 ) observable.Observable[D] {
-	dstObservable, dstPublishCh := NewObservable[D]()
+	dstObservable, dstPublishCh := NewObservable[D](opts...)
 	srcObserver := srcObservable.Subscribe(ctx)
 
 	go goMapTransformNotification(
 		ctx,
+		getStageName(opts),
 		srcObserver,
 		transformFn,
 		func(dstNotifications []D) {
@@ -71,12 +77,14 @@ func MapReplay[S, D any](
 	replayBufferCap int,
 	srcObservable observable.Observable[S],
 	transformFn MapFn[S, D],
+	opts ...option[D],
 ) observable.ReplayObservable[D] {
-	dstObservable, dstProducer := NewReplayObservable[D](ctx, replayBufferCap)
+	dstObservable, dstProducer := NewReplayObservable[D](ctx, replayBufferCap, opts...)
 	srcObserver := srcObservable.Subscribe(ctx)
 
 	go goMapTransformNotification(
 		ctx,
+		getStageName(opts),
 		srcObserver,
 		transformFn,
 		func(dstNotification D) {
@@ -92,10 +100,13 @@ func MapReplay[S, D any](
 // observable, similar to Map; however, ForEach does not publish to a destination
 // observable. ForEach is useful for side effects and is a terminal observable
 // operator.
+// WithStageName instruments the ForEach stage, the other options are irrelevant
+// since no value is published.
 func ForEach[V any](
 	ctx context.Context,
 	srcObservable observable.Observable[V],
 	forEachFn ForEachFn[V],
+	opts ...option[V],
 ) {
 	Map(
 		ctx, srcObservable,
@@ -105,13 +116,17 @@ func ForEach[V any](
 			// No downstream observers; SHOULD always skip.
 			return zeroValue[V](), true
 		},
+		opts...,
 	)
 }
 
 // goMapTransformNotification transforms, optionally skips, and publishes
 // notifications via the given publishFn.
+// If stageName is not empty, the stage's input queue length and throughput are
+// instrumented.
 func goMapTransformNotification[S, D, P any](
 	ctx context.Context,
+	stageName string,
 	srcObserver observable.Observer[S],
 	transformFn MapFn[S, D],
 	publishFn func(dstNotifications D),
@@ -122,7 +137,12 @@ func goMapTransformNotification[S, D, P any](
 	dstProducerCh chan<- P,
 ) {
 	for srcNotification := range srcObserver.Ch() {
+		if stageName != "" {
+			setStageQueueLength(stageName, inputQueue, len(srcObserver.Ch()))
+		}
+
 		dstNotifications, skip := transformFn(ctx, srcNotification)
+		addStageProcessed(stageName)
 		if skip {
 			continue
 		}
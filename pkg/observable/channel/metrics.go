@@ -0,0 +1,126 @@
+package channel
+
+import (
+	"github.com/go-kit/kit/metrics/prometheus"
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	observableSubsystem = "observable"
+
+	stageQueueLength    = "stage_queue_length"
+	stagePublishedTotal = "stage_published_total"
+	stageProcessedTotal = "stage_processed_total"
+	stageDroppedTotal   = "stage_dropped_total"
+	stageBlockedTotal   = "stage_blocked_total"
+
+	// publishQueue is the queue of values sent on an observable's publish
+	// channel which are not yet notified to its observers.
+	publishQueue = "publish"
+	// observersQueue is the queue of values notified to the slowest observer
+	// of an observable which it did not consume yet.
+	observersQueue = "observers"
+	// inputQueue is the queue of values notified to an operator (e.g. Map, ForEach)
+	// which it did not process yet.
+	inputQueue = "input"
+)
+
+// Only the named stages (see WithStageName) are instrumented, in order to keep
+// the metrics cardinality bounded and their values attributable.
+var (
+	// StageQueueLength is a Gauge metric for the number of values queued in a
+	// pipeline stage, labeled by 'stage' and 'queue' (i.e. publish, observers
+	// or input).
+	//
+	// Usage:
+	// - Detect the stages which fall behind (e.g. relay → miner → session tree).
+	// - Size the stages' buffers and choose their overflow policies.
+	StageQueueLength = prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+		Subsystem: observableSubsystem,
+		Name:      stageQueueLength,
+		Help:      "Number of values queued in a pipeline stage, labeled by stage and queue.",
+	}, []string{"stage", "queue"})
+
+	// StagePublishedTotal is a Counter metric for the values notified by the
+	// observable of a pipeline stage, labeled by 'stage'.
+	StagePublishedTotal = prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+		Subsystem: observableSubsystem,
+		Name:      stagePublishedTotal,
+		Help:      "Total number of values notified by a pipeline stage observable, labeled by stage.",
+	}, []string{"stage"})
+
+	// StageProcessedTotal is a Counter metric for the values processed by the
+	// operator (e.g. Map, ForEach) of a pipeline stage, labeled by 'stage'.
+	//
+	// Usage:
+	// - Monitor the throughput of each stage.
+	StageProcessedTotal = prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+		Subsystem: observableSubsystem,
+		Name:      stageProcessedTotal,
+		Help:      "Total number of values processed by a pipeline stage operator, labeled by stage.",
+	}, []string{"stage"})
+
+	// StageDroppedTotal is a Counter metric for the values dropped because an
+	// observer's buffer was full, labeled by 'stage' and 'overflow_policy'.
+	StageDroppedTotal = prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+		Subsystem: observableSubsystem,
+		Name:      stageDroppedTotal,
+		Help:      "Total number of values dropped by a pipeline stage, labeled by stage and overflow policy.",
+	}, []string{"stage", "overflow_policy"})
+
+	// StageBlockedTotal is a Counter metric for the notifications which blocked
+	// a pipeline stage because an observer's buffer was full, labeled by 'stage'.
+	//
+	// Usage:
+	// - Detect slow subscribers which block their publishers.
+	StageBlockedTotal = prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+		Subsystem: observableSubsystem,
+		Name:      stageBlockedTotal,
+		Help:      "Total number of notifications blocked on a full observer buffer, labeled by stage.",
+	}, []string{"stage"})
+)
+
+// setStageQueueLength sets the queue length metric of the given stage, if named.
+func setStageQueueLength(stageName, queue string, length int) {
+	if stageName == "" {
+		return
+	}
+
+	StageQueueLength.With("stage", stageName, "queue", queue).Set(float64(length))
+}
+
+// addStagePublished increments the published values metric of the given stage, if named.
+func addStagePublished(stageName string) {
+	if stageName == "" {
+		return
+	}
+
+	StagePublishedTotal.With("stage", stageName).Add(1)
+}
+
+// addStageProcessed increments the processed values metric of the given stage, if named.
+func addStageProcessed(stageName string) {
+	if stageName == "" {
+		return
+	}
+
+	StageProcessedTotal.With("stage", stageName).Add(1)
+}
+
+// addStageDropped increments the dropped values metric of the given stage, if named.
+func addStageDropped(stageName string, policy OverflowPolicy) {
+	if stageName == "" {
+		return
+	}
+
+	StageDroppedTotal.With("stage", stageName, "overflow_policy", policy.String()).Add(1)
+}
+
+// addStageBlocked increments the blocked notifications metric of the given stage, if named.
+func addStageBlocked(stageName string) {
+	if stageName == "" {
+		return
+	}
+
+	StageBlockedTotal.With("stage", stageName).Add(1)
+}
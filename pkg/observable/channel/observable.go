@@ -31,19 +31,25 @@ type channelObservable[V any] struct {
 	// publishCh is an observable-wide channel that is used to receive values
 	// which are subsequently fanned out to observers.
 	publishCh chan V
+	// stageName is the name of the pipeline stage which the observable is part
+	// of. It attributes the stage's metrics and logs, which are only emitted for
+	// named stages.
+	stageName string
+	// observersStageName is the stage name attributed to the observers' dropped
+	// values. It is the stageName unless the observable is internal to a stage
+	// (e.g. a replay observable subscription).
+	observersStageName string
+	// observerBufferSize is the buffer size of the observers' channels.
+	observerBufferSize int
+	// overflowPolicy determines how the observers whose buffer is full are notified.
+	overflowPolicy OverflowPolicy
 }
 
 // NewObservable creates a new observable which is notified when the publishCh
 // channel receives a value.
 func NewObservable[V any](opts ...option[V]) (observable.Observable[V], chan<- V) {
 	// initialize an observable that publishes messages from 1 publishCh to N observers
-	obs := &channelObservable[V]{
-		observerManager: newObserverManager[V](),
-	}
-
-	for _, opt := range opts {
-		opt(obs)
-	}
+	obs := newChannelObservable(opts...)
 
 	// If the caller does not provide a publishCh, create a new one using the
 	// defaultPublishBuffer size and return it.
@@ -57,6 +63,22 @@ func NewObservable[V any](opts ...option[V]) (observable.Observable[V], chan<- V
 	return obs, obs.publishCh
 }
 
+// newChannelObservable returns a channelObservable configured with the given
+// options, which is not yet publishing.
+func newChannelObservable[V any](opts ...option[V]) *channelObservable[V] {
+	obs := &channelObservable[V]{
+		observerManager:    newObserverManager[V](),
+		observerBufferSize: defaultSubscribeBufferSize,
+		overflowPolicy:     OverflowPolicyBlock,
+	}
+
+	for _, opt := range opts {
+		opt(obs)
+	}
+
+	return obs
+}
+
 // WithPublisher returns an option function which sets the given publishCh of the
 // resulting observable when passed to NewObservable().
 func WithPublisher[V any](publishCh chan V) option[V] {
@@ -65,6 +87,49 @@ func WithPublisher[V any](publishCh chan V) option[V] {
 	}
 }
 
+// WithStageName returns an option function which names the pipeline stage of
+// the resulting observable, or operator (e.g. Map, ForEach), when passed to
+// NewObservable() or the operator function.
+// Named stages expose their queue lengths, throughput and dropped values as
+// metrics (see metrics.go), and attribute their logged errors.
+func WithStageName[V any](stageName string) option[V] {
+	return func(obs *channelObservable[V]) {
+		obs.stageName = stageName
+		obs.observersStageName = stageName
+	}
+}
+
+// WithOverflowPolicy returns an option function which sets the policy used by
+// the resulting observable to notify the observers whose buffer is full, when
+// passed to NewObservable(). It defaults to OverflowPolicyBlock.
+func WithOverflowPolicy[V any](policy OverflowPolicy) option[V] {
+	return func(obs *channelObservable[V]) {
+		obs.overflowPolicy = policy
+	}
+}
+
+// WithObserverBufferSize returns an option function which sets the buffer size
+// of the resulting observable observers' channels, when passed to NewObservable().
+func WithObserverBufferSize[V any](bufferSize int) option[V] {
+	return func(obs *channelObservable[V]) {
+		obs.observerBufferSize = bufferSize
+	}
+}
+
+// withObserversStageName returns an option function which only attributes the
+// dropped values of the resulting observable's observers to the given stage,
+// without instrumenting the observable itself.
+func withObserversStageName[V any](stageName string) option[V] {
+	return func(obs *channelObservable[V]) {
+		obs.observersStageName = stageName
+	}
+}
+
+// getStageName returns the stage name set by the given options, if any.
+func getStageName[V any](opts []option[V]) string {
+	return newChannelObservable(opts...).stageName
+}
+
 // Subscribe returns an observer which is notified when the publishCh channel
 // receives a value.
 func (obs *channelObservable[V]) Subscribe(ctx context.Context) observable.Observer[V] {
@@ -81,7 +146,13 @@ func (obs *channelObservable[V]) Subscribe(ctx context.Context) observable.Obser
 
 	// Create a new observer and add it to the list of observers to be notified
 	// when publishCh receives a new value.
-	observer := NewObserver[V](ctx, removeAndCancel)
+	observer := newObserver[V](
+		ctx,
+		removeAndCancel,
+		obs.observerBufferSize,
+		obs.overflowPolicy,
+		obs.observersStageName,
+	)
 	obs.observerManager.add(observer)
 
 	// asynchronously wait for the context to be done and then unsubscribe
@@ -101,6 +172,12 @@ func (obs *channelObservable[V]) UnsubscribeAll() {
 func (obs *channelObservable[V]) goPublish() {
 	for notification := range obs.publishCh {
 		obs.observerManager.notifyAll(notification)
+
+		if obs.stageName != "" {
+			addStagePublished(obs.stageName)
+			setStageQueueLength(obs.stageName, publishQueue, len(obs.publishCh))
+			setStageQueueLength(obs.stageName, observersQueue, obs.observerManager.maxQueueLength())
+		}
 	}
 
 	// Here we know that the publisher channel has been closed.
@@ -323,6 +323,95 @@ func TestChannelObservable_SequentialPublishAndUnsubscription(t *testing.T) {
 	}
 }
 
+func TestChannelObservable_OverflowPolicies(t *testing.T) {
+	const observerBufferSize = 2
+	inputs := []int{1, 2, 3, 4, 5}
+
+	tests := []struct {
+		desc                    string
+		overflowPolicy          channel.OverflowPolicy
+		expectedSlowOutputs     []int
+		expectSlowObserverClose bool
+	}{
+		{
+			desc:                "block",
+			overflowPolicy:      channel.OverflowPolicyBlock,
+			expectedSlowOutputs: inputs,
+		},
+		{
+			desc:                "drop oldest",
+			overflowPolicy:      channel.OverflowPolicyDropOldest,
+			expectedSlowOutputs: []int{4, 5},
+		},
+		{
+			desc:                "drop newest",
+			overflowPolicy:      channel.OverflowPolicyDropNewest,
+			expectedSlowOutputs: []int{1, 2},
+		},
+		{
+			desc:                    "error",
+			overflowPolicy:          channel.OverflowPolicyError,
+			expectedSlowOutputs:     []int{1, 2},
+			expectSlowObserverClose: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			t.Cleanup(cancel)
+
+			obsvbl, publishCh := channel.NewObservable[int](
+				channel.WithStageName[int]("test_overflow_"+test.overflowPolicy.String()),
+				channel.WithOverflowPolicy[int](test.overflowPolicy),
+				channel.WithObserverBufferSize[int](observerBufferSize),
+			)
+
+			// The observers are notified in their subscription order, the slow
+			// observer does not receive any value until all of them are published.
+			slowObserver := obsvbl.Subscribe(ctx)
+			fastObserver := obsvbl.Subscribe(ctx)
+
+			for _, input := range inputs {
+				publishCh <- input
+
+				// Unless it blocks, the slow observer does not prevent the fast one
+				// from being notified of every value, after the slow one was.
+				if test.overflowPolicy != channel.OverflowPolicyBlock {
+					require.Equal(t, []int{input}, receiveN(t, fastObserver.Ch(), 1))
+				}
+			}
+
+			// The slow observer blocks the observable, the fast observer is only
+			// notified of the values which were published before the slow one's
+			// buffer was full, until the slow one consumes.
+			if test.overflowPolicy == channel.OverflowPolicyBlock {
+				require.Equal(t, inputs[:observerBufferSize], receiveN(t, fastObserver.Ch(), observerBufferSize))
+				select {
+				case output := <-fastObserver.Ch():
+					t.Fatalf("unexpected output: %d", output)
+				case <-time.After(notifyTimeout):
+				}
+			}
+
+			require.Equal(t, test.expectedSlowOutputs, receiveN(t, slowObserver.Ch(), len(test.expectedSlowOutputs)))
+
+			select {
+			case output, ok := <-slowObserver.Ch():
+				require.Falsef(t, ok, "unexpected output: %d", output)
+				require.True(t, test.expectSlowObserverClose)
+			case <-time.After(notifyTimeout):
+				require.False(t, test.expectSlowObserverClose)
+			}
+
+			if test.overflowPolicy == channel.OverflowPolicyBlock {
+				remainingInputs := inputs[observerBufferSize:]
+				require.Equal(t, remainingInputs, receiveN(t, fastObserver.Ch(), len(remainingInputs)))
+			}
+		})
+	}
+}
+
 // TODO_IMPROVE: add coverage for active observers closing when publishCh closes.
 func TestChannelObservable_ObserversCloseOnPublishChannelClose(t *testing.T) {
 	t.Skip("add coverage: all observers should unsubscribe when publishCh closes")
@@ -369,3 +458,22 @@ func goNotifiedOrTimedOutFactory[V any](
 		}
 	}
 }
+
+// receiveN returns the next n values received from the given channel, failing
+// the test if they are not received in time.
+func receiveN[V any](t *testing.T, ch <-chan V, n int) []V {
+	t.Helper()
+
+	outputs := make([]V, 0, n)
+	for len(outputs) < n {
+		select {
+		case output, ok := <-ch:
+			require.True(t, ok, "channel closed before receiving all the values")
+			outputs = append(outputs, output)
+		case <-time.After(time.Second):
+			t.Fatalf("timed out after receiving %d/%d values", len(outputs), n)
+		}
+	}
+
+	return outputs
+}
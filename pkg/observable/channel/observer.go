@@ -43,6 +43,11 @@ type channelObserver[V any] struct {
 	// isClosed indicates whether the observer has been isClosed. It's set in
 	// unsubscribe; isClosed observers can't be reused.
 	isClosed bool
+	// overflowPolicy determines how the observer is notified when observerCh is full.
+	overflowPolicy OverflowPolicy
+	// stageName is the name of the pipeline stage which the observer's dropped
+	// or blocked notifications are attributed to.
+	stageName string
 }
 
 type UnsubscribeFunc[V any] func(toRemove observable.Observer[V])
@@ -50,13 +55,27 @@ type UnsubscribeFunc[V any] func(toRemove observable.Observer[V])
 func NewObserver[V any](
 	ctx context.Context,
 	onUnsubscribe UnsubscribeFunc[V],
+) *channelObserver[V] {
+	return newObserver(ctx, onUnsubscribe, defaultSubscribeBufferSize, OverflowPolicyBlock, "")
+}
+
+// newObserver returns an observer whose channel has the given buffer size and
+// which is notified according to the given overflow policy when it is full.
+func newObserver[V any](
+	ctx context.Context,
+	onUnsubscribe UnsubscribeFunc[V],
+	bufferSize int,
+	overflowPolicy OverflowPolicy,
+	stageName string,
 ) *channelObserver[V] {
 	// Create a channel for the observer and append it to the observers list
 	return &channelObserver[V]{
-		ctx:           ctx,
-		observerMu:    new(sync.RWMutex),
-		observerCh:    make(chan V, defaultSubscribeBufferSize),
-		onUnsubscribe: onUnsubscribe,
+		ctx:            ctx,
+		observerMu:     new(sync.RWMutex),
+		observerCh:     make(chan V, bufferSize),
+		onUnsubscribe:  onUnsubscribe,
+		overflowPolicy: overflowPolicy,
+		stageName:      stageName,
 	}
 }
 
@@ -108,15 +127,83 @@ func (obsvr *channelObserver[V]) unsubscribe() {
 
 // notify is called by observable to send a msg on the observer's channel.
 // We can't use channelObserver#Ch because it's intended to be a
-// receive-only channel. The channel will block if it is full (determined by the buffer
-// size)
-// if the channel's buffer is full, we will retry after sendRetryInterval/s.
+// receive-only channel. If the channel is full (determined by the buffer size),
+// the observer's overflow policy applies.
+func (obsvr *channelObserver[V]) notify(value V) {
+	if obsvr.overflowPolicy == OverflowPolicyBlock {
+		obsvr.notifyBlocking(value)
+		return
+	}
+
+	if !obsvr.notifyNonBlocking(value) {
+		return
+	}
+
+	addStageDropped(obsvr.stageName, obsvr.overflowPolicy)
+
+	if obsvr.overflowPolicy == OverflowPolicyError {
+		logger := polylog.Ctx(obsvr.ctx)
+		logger.Error().
+			Err(observable.ErrObserverBufferFull).
+			Str("stage", obsvr.stageName).
+			Msg("unsubscribing observer which fell behind")
+
+		// The read-lock MUST be released before unsubscribing, which write-locks.
+		if !obsvr.IsClosed() {
+			obsvr.unsubscribe()
+		}
+	}
+}
+
+// notifyNonBlocking sends the given value on the observer's channel without
+// blocking, dropping either the oldest buffered value or the given value if
+// the channel is full, according to the observer's overflow policy.
+// It returns true if a value was dropped.
+func (obsvr *channelObserver[V]) notifyNonBlocking(value V) (dropped bool) {
+	obsvr.observerMu.RLock()
+	defer obsvr.observerMu.RUnlock()
+
+	if obsvr.isClosed || obsvr.ctx.Err() != nil {
+		return false
+	}
+
+	select {
+	case obsvr.observerCh <- value:
+		return false
+	default:
+	}
+
+	if obsvr.overflowPolicy == OverflowPolicyDropOldest {
+		// Make room for the value by dropping the oldest one, unless the consumer
+		// concurrently received it.
+		select {
+		case <-obsvr.observerCh:
+		default:
+		}
+
+		// The observable is the only sender, the channel can only be full again
+		// if the buffer size is 0; in which case the value is dropped instead.
+		select {
+		case obsvr.observerCh <- value:
+		default:
+		}
+	}
+
+	return true
+}
+
+// notifyBlocking sends the given value on the observer's channel, blocking
+// while the channel is full.
+// If the channel's buffer is full, we will retry after sendRetryInterval/s.
 // The other half is spent holding the read-lock and waiting for the (full) channel
 // to be ready to receive.
-func (obsvr *channelObserver[V]) notify(value V) {
+func (obsvr *channelObserver[V]) notifyBlocking(value V) {
 	defer obsvr.observerMu.RUnlock() // defer releasing a read lock
 
 	sendRetryTicker := time.NewTicker(sendRetryInterval)
+	defer sendRetryTicker.Stop()
+
+	isBlocked := false
 	for {
 		// observerMu must remain read-locked until the value is sent on observerCh
 		// in the event that it would be isClosed concurrently (i.e. this observer
@@ -139,10 +226,13 @@ func (obsvr *channelObserver[V]) notify(value V) {
 		// release the read-lock to give write-lockers a turn. This case
 		// continues the loop, re-read-locking and trying again.
 		case <-sendRetryTicker.C:
-			// TODO_IMPROVE: this is where we would implement
-			// some backpressure strategy. It would be good to have a simple fail-
-			// safe strategy that can be used by default; e.g. dropping the oldest
-			// value if its buffer is full.
+			// The non-blocking overflow policies (see OverflowPolicy) can be used
+			// by the observables whose publishers MUST NOT be blocked by a slow
+			// observer. Count the blocked notification so that it is not silent.
+			if !isBlocked {
+				isBlocked = true
+				addStageBlocked(obsvr.stageName)
+			}
 
 			// This case implies that the (read) lock was acquired, so it must
 			// be unlocked before continuing the send retry loop.
@@ -22,6 +22,7 @@ type observerManager[V any] interface {
 	remove(toRemove observable.Observer[V])
 	removeAll()
 	goUnsubscribeOnDone(ctx context.Context, observer observable.Observer[V])
+	maxQueueLength() int
 }
 
 // TODO_CONSIDERATION: if this were a generic implementation, we wouldn't need
@@ -135,6 +136,16 @@ func (com *channelObserverManager[V]) goUnsubscribeOnDone( //nolint:unused // Us
 	observer.Unsubscribe()
 }
 
+// maxQueueLength returns the number of values queued in the buffer of the
+// slowest observer (i.e. the observer with the most values queued).
+func (com *channelObserverManager[V]) maxQueueLength() (maxLength int) { //nolint:unused // Used in the observable implementation.
+	for _, observer := range com.copyObservers() {
+		maxLength = max(maxLength, len(observer.observerCh))
+	}
+
+	return maxLength
+}
+
 // copyObservers returns a copy of the current observers list. It is safe to
 // call concurrently. Notably, it is not part of the observerManager interface.
 func (com *channelObserverManager[V]) copyObservers() (observers []*channelObserver[V]) { //nolint:unused // Used in the observable implementation.
@@ -0,0 +1,39 @@
+package channel
+
+// OverflowPolicy determines how an observable notifies an observer whose
+// channel buffer is full, i.e. an observer which consumes slower than the
+// values are published.
+type OverflowPolicy int
+
+const (
+	// OverflowPolicyBlock blocks the observable until the observer's buffer has
+	// room for the value. Since the observers are notified sequentially, a slow
+	// observer delays all the other observers and eventually blocks the publishers.
+	// It is the default policy, as no value is ever lost.
+	OverflowPolicyBlock OverflowPolicy = iota
+	// OverflowPolicyDropOldest drops the oldest value of the observer's buffer
+	// to make room for the new value.
+	OverflowPolicyDropOldest
+	// OverflowPolicyDropNewest drops the new value, keeping the observer's buffer as is.
+	OverflowPolicyDropNewest
+	// OverflowPolicyError drops the new value and unsubscribes the observer,
+	// logging an ErrObserverBufferFull error. The observer's channel is closed
+	// so that the consumer is notified that it fell behind.
+	OverflowPolicyError
+)
+
+// String returns the name of the overflow policy, as used in metrics labels.
+func (policy OverflowPolicy) String() string {
+	switch policy {
+	case OverflowPolicyBlock:
+		return "block"
+	case OverflowPolicyDropOldest:
+		return "drop_oldest"
+	case OverflowPolicyDropNewest:
+		return "drop_newest"
+	case OverflowPolicyError:
+		return "error"
+	default:
+		return "unknown"
+	}
+}
@@ -26,6 +26,9 @@ type replayObservable[V any] struct {
 	// bufferingObsvbl is an observable that emits all buffered values in one
 	// notification.
 	bufferingObsvbl observable.Observable[[]V]
+	// subscriptionOpts are the options of the observables backing each
+	// subscription, which carry the overflow policy of the replay observable.
+	subscriptionOpts []option[V]
 }
 
 // NewReplayObservable returns a new ReplayObservable with the given replay buffer
@@ -37,7 +40,16 @@ func NewReplayObservable[V any](
 ) (observable.ReplayObservable[V], chan<- V) {
 	obsvbl, publishCh := NewObservable[V](opts...)
 
-	return ToReplayObservable(ctx, replayBufferCap, obsvbl), publishCh
+	// Apply the observers options to the subscriptions' observables, which
+	// notify the replay observable's observers.
+	obsCfg := newChannelObservable(opts...)
+	subscriptionOpts := []option[V]{
+		WithOverflowPolicy[V](obsCfg.overflowPolicy),
+		WithObserverBufferSize[V](obsCfg.observerBufferSize),
+		withObserversStageName[V](obsCfg.observersStageName),
+	}
+
+	return toReplayObservable(ctx, replayBufferCap, obsvbl, subscriptionOpts), publishCh
 }
 
 // ToReplayObservable returns an observable which replays the last replayBufferCap
@@ -49,10 +61,22 @@ func ToReplayObservable[V any](
 	ctx context.Context,
 	replayBufferCap int,
 	srcObsvbl observable.Observable[V],
+) observable.ReplayObservable[V] {
+	return toReplayObservable(ctx, replayBufferCap, srcObsvbl, nil)
+}
+
+// toReplayObservable returns a replay observable of the given source observable
+// whose subscriptions' observables are created with the given options.
+func toReplayObservable[V any](
+	ctx context.Context,
+	replayBufferCap int,
+	srcObsvbl observable.Observable[V],
+	subscriptionOpts []option[V],
 ) observable.ReplayObservable[V] {
 	replayObsvbl := &replayObservable[V]{
-		replayBufferCap: replayBufferCap,
-		replayBuffer:    []V{},
+		replayBufferCap:  replayBufferCap,
+		replayBuffer:     []V{},
+		subscriptionOpts: subscriptionOpts,
 	}
 
 	replayObsvbl.bufferingObsvbl = replayObsvbl.initBufferingObservable(ctx, srcObsvbl)
@@ -139,7 +163,7 @@ func (ro *replayObservable[V]) SubscribeFromLatestBufferedOffset(
 	ctx context.Context,
 	endOffset int,
 ) observable.Observer[V] {
-	obs, ch := NewObservable[V]()
+	obs, ch := NewObservable[V](ro.subscriptionOpts...)
 	ctx, cancel := context.WithCancel(ctx)
 
 	go func() {
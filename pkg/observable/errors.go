@@ -3,6 +3,7 @@ package observable
 import sdkerrors "cosmossdk.io/errors"
 
 var (
-	ErrObserverClosed = sdkerrors.Register(codespace, 1, "observer is closed")
-	codespace         = "observable"
+	ErrObserverClosed     = sdkerrors.Register(codespace, 1, "observer is closed")
+	ErrObserverBufferFull = sdkerrors.Register(codespace, 2, "observer buffer is full")
+	codespace             = "observable"
 )
@@ -9,14 +9,23 @@ import (
 )
 
 // LogErrors operates on an observable of errors. It logs all errors received
-// from the observable.
-func LogErrors(ctx context.Context, errs observable.Observable[error]) {
-	channel.ForEach(ctx, errs, forEachErrorLogError)
+// from the observable, attributed to the given pipeline stage name, which also
+// names the logging stage's metrics (see channel.WithStageName).
+func LogErrors(ctx context.Context, errs observable.Observable[error], stageName string) {
+	channel.ForEach(
+		ctx,
+		errs,
+		newForEachErrorLogErrorFn(stageName),
+		channel.WithStageName[error](stageName),
+	)
 }
 
-// forEachErrorLogError is a ForEachFn that logs the given error.
-func forEachErrorLogError(ctx context.Context, err error) {
-	logger := polylog.Ctx(ctx)
-	// Logging the error and flushing (i.e. sending) the log message to stdout
-	logger.Error().Err(err).Send()
+// newForEachErrorLogErrorFn returns a ForEachFn that logs the given error,
+// attributed to the given stage.
+func newForEachErrorLogErrorFn(stageName string) channel.ForEachFn[error] {
+	return func(ctx context.Context, err error) {
+		logger := polylog.Ctx(ctx)
+		// Logging the error and flushing (i.e. sending) the log message to stdout
+		logger.Error().Str("stage", stageName).Err(err).Send()
+	}
 }
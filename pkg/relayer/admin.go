@@ -0,0 +1,187 @@
+package relayer
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+)
+
+// adminSessionTree is the JSON representation of a session tree replied by the
+// admin API.
+type adminSessionTree struct {
+	SessionId               string           `json:"session_id"`
+	ApplicationAddress      string           `json:"application_address"`
+	ServiceId               string           `json:"service_id"`
+	SessionStartBlockHeight int64            `json:"session_start_block_height"`
+	SessionEndBlockHeight   int64            `json:"session_end_block_height"`
+	RelayCount              uint64           `json:"relay_count"`
+	SMSTSum                 uint64           `json:"smst_sum"`
+	ClaimRoot               string           `json:"claim_root,omitempty"`
+	State                   SessionTreeState `json:"state"`
+}
+
+// adminSessionTreesResponse is the reply to the admin API session trees listing.
+type adminSessionTreesResponse struct {
+	// Suppliers is a map of supplier operator address -> session trees.
+	Suppliers map[string][]adminSessionTree `json:"suppliers"`
+}
+
+// adminErrorResponse is the reply to a failed admin API request.
+type adminErrorResponse struct {
+	Error string `json:"error"`
+}
+
+// ServeAdmin starts an HTTP/JSON server which allows operators to:
+// - List the session trees of each supplier along with their lifecycle state
+// - Retry the failed claim or proof of a session tree
+//
+// Every request MUST be authenticated with the given token as a bearer token.
+func (rel *relayMiner) ServeAdmin(ctx context.Context, network, addr, authToken string) error {
+	if len(authToken) == 0 {
+		return errors.New("admin server auth token is required")
+	}
+
+	ln, err := net.Listen(network, addr)
+	if err != nil {
+		return err
+	}
+
+	server := &http.Server{Handler: rel.newAdminHandler(authToken)}
+
+	go func() {
+		rel.logger.Info().Str("endpoint", addr).Msg("starting admin server")
+		if err := server.Serve(ln); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			rel.logger.Error().Err(err).Msg("admin server unexpectedly closed")
+		}
+	}()
+
+	go func() {
+		<-ctx.Done()
+		rel.logger.Info().Str("endpoint", addr).Msg("stopping admin server")
+		_ = server.Close()
+	}()
+
+	return nil
+}
+
+// newAdminHandler returns the handler of the admin API routes, which rejects
+// the requests not authenticated with the given token.
+func (rel *relayMiner) newAdminHandler(authToken string) http.Handler {
+	adminMux := http.NewServeMux()
+	adminMux.HandleFunc("GET /session_trees", rel.handleListSessionTrees)
+	adminMux.HandleFunc(
+		"POST /session_trees/{supplier_operator_address}/{session_id}/retry",
+		rel.handleRetrySessionTree,
+	)
+
+	expectedAuthorization := []byte("Bearer " + authToken)
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		authorization := []byte(req.Header.Get("Authorization"))
+		if subtle.ConstantTimeCompare(authorization, expectedAuthorization) != 1 {
+			rel.writeAdminResponse(w, http.StatusUnauthorized, &adminErrorResponse{Error: "unauthorized"})
+			return
+		}
+
+		adminMux.ServeHTTP(w, req)
+	})
+}
+
+// handleListSessionTrees replies with the session trees grouped by supplier
+// operator address, optionally restricted to the one given by the
+// supplier_operator_address query parameter.
+func (rel *relayMiner) handleListSessionTrees(w http.ResponseWriter, req *http.Request) {
+	supplierOperatorAddress := req.URL.Query().Get("supplier_operator_address")
+
+	response := &adminSessionTreesResponse{Suppliers: make(map[string][]adminSessionTree)}
+	for _, sessionTreeInfo := range rel.relayerSessionsManager.GetSessionTrees() {
+		if supplierOperatorAddress != "" && sessionTreeInfo.SupplierOperatorAddress != supplierOperatorAddress {
+			continue
+		}
+
+		sessionHeader := sessionTreeInfo.SessionHeader
+		var claimRoot string
+		if sessionTreeInfo.ClaimRoot != nil {
+			claimRoot = hex.EncodeToString(sessionTreeInfo.ClaimRoot)
+		}
+
+		response.Suppliers[sessionTreeInfo.SupplierOperatorAddress] = append(
+			response.Suppliers[sessionTreeInfo.SupplierOperatorAddress],
+			adminSessionTree{
+				SessionId:               sessionHeader.GetSessionId(),
+				ApplicationAddress:      sessionHeader.GetApplicationAddress(),
+				ServiceId:               sessionHeader.GetServiceId(),
+				SessionStartBlockHeight: sessionHeader.GetSessionStartBlockHeight(),
+				SessionEndBlockHeight:   sessionHeader.GetSessionEndBlockHeight(),
+				RelayCount:              sessionTreeInfo.RelayCount,
+				SMSTSum:                 sessionTreeInfo.SMSTSum,
+				ClaimRoot:               claimRoot,
+				State:                   sessionTreeInfo.State,
+			},
+		)
+	}
+
+	rel.writeAdminResponse(w, http.StatusOK, response)
+}
+
+// handleRetrySessionTree sends the session tree back to the claim or proof
+// pipeline if its claim or proof failed.
+func (rel *relayMiner) handleRetrySessionTree(w http.ResponseWriter, req *http.Request) {
+	supplierOperatorAddress := req.PathValue("supplier_operator_address")
+	sessionId := req.PathValue("session_id")
+
+	// Look the session tree up beforehand to reply with a meaningful status code.
+	var sessionTreeInfo *SessionTreeInfo
+	for _, info := range rel.relayerSessionsManager.GetSessionTrees() {
+		if info.SupplierOperatorAddress == supplierOperatorAddress &&
+			info.SessionHeader.GetSessionId() == sessionId {
+			sessionTreeInfo = &info
+			break
+		}
+	}
+
+	if sessionTreeInfo == nil {
+		rel.writeAdminResponse(w, http.StatusNotFound, &adminErrorResponse{Error: "session tree not found"})
+		return
+	}
+
+	switch sessionTreeInfo.State {
+	case SessionTreeStateClaimFailed, SessionTreeStateProofFailed:
+	default:
+		rel.writeAdminResponse(w, http.StatusConflict, &adminErrorResponse{
+			Error: fmt.Sprintf("session tree in state %q cannot be retried", sessionTreeInfo.State),
+		})
+		return
+	}
+
+	err := rel.relayerSessionsManager.RetrySessionTree(req.Context(), supplierOperatorAddress, sessionId)
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		rel.writeAdminResponse(w, http.StatusServiceUnavailable, &adminErrorResponse{
+			Error: "session tree retry pipeline busy",
+		})
+		return
+	}
+	if err != nil {
+		rel.logger.Warn().Err(err).
+			Str("supplier_operator_address", supplierOperatorAddress).
+			Str("session_id", sessionId).
+			Msg("failed to retry session tree")
+		rel.writeAdminResponse(w, http.StatusInternalServerError, &adminErrorResponse{Error: err.Error()})
+		return
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// writeAdminResponse replies with the given status code and JSON encoded response.
+func (rel *relayMiner) writeAdminResponse(w http.ResponseWriter, statusCode int, response any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		rel.logger.Warn().Err(err).Msg("failed to write admin response")
+	}
+}
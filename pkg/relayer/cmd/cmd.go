@@ -7,18 +7,27 @@ import (
 	"net/http"
 	"net/url"
 	"os"
+	"path"
+	"time"
 
 	"cosmossdk.io/depinject"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
 	cosmosclient "github.com/cosmos/cosmos-sdk/client"
 	cosmosflags "github.com/cosmos/cosmos-sdk/client/flags"
 	cosmostx "github.com/cosmos/cosmos-sdk/client/tx"
 	cosmostypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/grpc"
 	"github.com/spf13/cobra"
 
 	"github.com/pokt-network/poktroll/cmd/flags"
 	"github.com/pokt-network/poktroll/cmd/signals"
+	"github.com/pokt-network/poktroll/pkg/cache/memory"
+	"github.com/pokt-network/poktroll/pkg/client"
+	"github.com/pokt-network/poktroll/pkg/client/failover"
 	"github.com/pokt-network/poktroll/pkg/client/query"
 	"github.com/pokt-network/poktroll/pkg/client/query/cache"
+	"github.com/pokt-network/poktroll/pkg/client/query/verified"
+	"github.com/pokt-network/poktroll/pkg/client/supplier"
 	"github.com/pokt-network/poktroll/pkg/client/tx"
 	txtypes "github.com/pokt-network/poktroll/pkg/client/tx/types"
 	"github.com/pokt-network/poktroll/pkg/deps/config"
@@ -30,6 +39,7 @@ import (
 	"github.com/pokt-network/poktroll/pkg/relayer/proxy"
 	"github.com/pokt-network/poktroll/pkg/relayer/relay_authenticator"
 	"github.com/pokt-network/poktroll/pkg/relayer/session"
+	"github.com/pokt-network/poktroll/pkg/signer"
 	apptypes "github.com/pokt-network/poktroll/x/application/types"
 	prooftypes "github.com/pokt-network/poktroll/x/proof/types"
 	servicetypes "github.com/pokt-network/poktroll/x/service/types"
@@ -38,6 +48,14 @@ import (
 	suppliertypes "github.com/pokt-network/poktroll/x/supplier/types"
 )
 
+// relayMinerShutdownTimeout is the maximum duration to wait for the relays
+// served before exiting to be added to their session trees and persisted.
+const relayMinerShutdownTimeout = 30 * time.Second
+
+// blockHashCacheMaxVersionAge is the number of blocks below the highest cached
+// block hash for which the block hashes are retained.
+const blockHashCacheMaxVersionAge = 1000
+
 // TODO_CONSIDERATION: Consider moving all flags defined in `/pkg` to the cmd/flags package.
 var (
 	// flagRelayMinerConfig is the variable containing the relay miner config filepath
@@ -90,6 +108,8 @@ for such operations.`,
 	cmd.Flags().String(cosmosflags.FlagGasPrices, "1upokt", "Set the gas unit price in upokt")
 	cmd.Flags().Bool(config.FlagQueryCaching, true, "Enable or disable onchain query caching")
 
+	cmd.AddCommand(RemoteSignerCmd())
+
 	return cmd
 }
 
@@ -168,6 +188,24 @@ func runRelayer(cmd *cobra.Command, _ []string) error {
 		}
 	}
 
+	if relayMinerConfig.Admin.Enabled {
+		err := relayMiner.ServeAdmin(ctx, "tcp", relayMinerConfig.Admin.Addr, relayMinerConfig.Admin.AuthToken)
+		if err != nil {
+			return fmt.Errorf("failed to start admin endpoint: %w", err)
+		}
+	}
+
+	// Drain the relays which were served before exiting into their session
+	// trees and persist them, so they can still be claimed after a restart.
+	// This is deferred so that it also happens when the relay miner fails.
+	defer func() {
+		shutdownCtx, cancelShutdown := context.WithTimeout(context.WithoutCancel(ctx), relayMinerShutdownTimeout)
+		defer cancelShutdown()
+		if err := relayMiner.Stop(shutdownCtx); err != nil {
+			logger.Error().Err(err).Msg("failed to gracefully stop relay miner")
+		}
+	}()
+
 	// Start the relay miner
 	logger.Info().Msg("Starting relay miner...")
 	if err := relayMiner.Start(ctx); err != nil && !errors.Is(err, http.ErrServerClosed) {
@@ -175,6 +213,7 @@ func runRelayer(cmd *cobra.Command, _ []string) error {
 	} else if errors.Is(err, http.ErrServerClosed) {
 		logger.Info().Msg("Relay miner stopped; exiting")
 	}
+
 	return nil
 }
 
@@ -215,36 +254,106 @@ func setupRelayerDependencies(
 		txNodeRPCUrl = parsedFlagNodeRPCUrl
 	}
 
+	// The fallback URLs which are identical to the (possibly overridden) preferred
+	// ones are ignored.
+	fallbackQueryNodeRPCUrls := excludeUrl(relayMinerConfig.PocketNode.FallbackQueryNodeRPCUrls, queryNodeRPCUrl)
+	fallbackQueryNodeGRPCUrls := excludeUrl(relayMinerConfig.PocketNode.FallbackQueryNodeGRPCUrls, queryNodeGRPCUrl)
+	fallbackTxNodeRPCUrls := excludeUrl(relayMinerConfig.PocketNode.FallbackTxNodeRPCUrls, txNodeRPCUrl)
+
 	signingKeyNames := uniqueSigningKeyNames(relayMinerConfig)
 	servicesConfigMap := relayMinerConfig.Servers
 	smtStorePath := relayMinerConfig.SmtStorePath
 
+	var (
+		supplierClientOpts []client.SupplierClientOption
+		txClientOpts       []client.TxClientOption
+	)
+	if relayMinerConfig.TxResubmission.Enabled {
+		supplierClientOpts = append(supplierClientOpts, supplier.WithResubmissionPolicy(&supplier.ResubmissionPolicy{
+			AttemptTimeoutBlocks: relayMinerConfig.TxResubmission.AttemptTimeoutBlocks,
+			FeeBumpFactor:        relayMinerConfig.TxResubmission.FeeBumpFactor,
+			MaxFeeMultiplier:     relayMinerConfig.TxResubmission.MaxFeeMultiplier,
+		}))
+
+		if maxGasPrices := relayMinerConfig.TxResubmission.MaxGasPrices; maxGasPrices != nil {
+			txClientOpts = append(txClientOpts, tx.WithMaxGasPrices(&maxGasPrices))
+		}
+	}
+
+	var (
+		txContextOpts          []client.TxContextOption
+		relayAuthenticatorOpts = []relayer.RelayAuthenticatorOption{
+			relay_authenticator.WithSigningKeyNames(signingKeyNames),
+		}
+	)
+	if relayMinerConfig.RemoteSigner.Enabled {
+		remoteSignerClient, err := newRemoteSignerClient(relayMinerConfig.RemoteSigner)
+		if err != nil {
+			return nil, err
+		}
+
+		txContextOpts = append(txContextOpts, tx.WithRemoteSigner(remoteSignerClient))
+		relayAuthenticatorOpts = append(relayAuthenticatorOpts, relay_authenticator.WithRemoteSigner(remoteSignerClient))
+	}
+
+	var queryClientConnWrapperFns []config.QueryClientConnWrapperFn
+	if relayMinerConfig.PocketNode.LightClient.Enabled {
+		verifiedQueryClientConnWrapperFn, err := newVerifiedQueryClientConnWrapperFn(
+			ctx,
+			queryNodeRPCUrl,
+			fallbackQueryNodeRPCUrls,
+			relayMinerConfig.PocketNode.LightClient,
+			smtStorePath,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		queryClientConnWrapperFns = append(queryClientConnWrapperFns, verifiedQueryClientConnWrapperFn)
+	}
+
 	supplierFuncs := []config.SupplierFn{
 		config.NewSupplyLoggerFromCtx(ctx),
-		config.NewSupplyEventsQueryClientFn(queryNodeRPCUrl),              // leaf
-		config.NewSupplyBlockQueryClientFn(queryNodeRPCUrl),               // leaf
-		config.NewSupplyBlockClientFn(queryNodeRPCUrl),                    // leaf
-		config.NewSupplyQueryClientContextFn(queryNodeGRPCUrl),            // leaf
-		config.NewSupplyTxClientContextFn(queryNodeGRPCUrl, txNodeRPCUrl), // leaf
-
-		// Setup the params caches and configure them to clear on new blocks.
+		config.NewSupplyEventsQueryClientFn(queryNodeRPCUrl, fallbackQueryNodeRPCUrls...),                               // leaf
+		config.NewSupplyBlockQueryClientFn(queryNodeRPCUrl, fallbackQueryNodeRPCUrls...),                                // leaf
+		config.NewSupplyBlockClientFn(queryNodeRPCUrl),                                                                  // leaf
+		config.NewSupplyQueryClientContextFn(queryNodeGRPCUrl, fallbackQueryNodeGRPCUrls, queryClientConnWrapperFns...), // leaf
+		config.NewSupplyTxClientContextFn(queryNodeGRPCUrl, txNodeRPCUrl, fallbackTxNodeRPCUrls...),                     // leaf
+
+		// Setup the params caches and configure them to clear whenever the params
+		// of their module are updated.
 		// Some of the params (tokenomics and gateway) are not used in the RelayMiner
 		// and don't need to have a corresponding cache.
-		config.NewSupplyParamsCacheFn[sharedtypes.Params](cache.WithNewBlockCacheClearing),   // leaf
-		config.NewSupplyParamsCacheFn[apptypes.Params](cache.WithNewBlockCacheClearing),      // leaf
-		config.NewSupplyParamsCacheFn[sessiontypes.Params](cache.WithNewBlockCacheClearing),  // leaf
-		config.NewSupplyParamsCacheFn[prooftypes.Params](cache.WithNewBlockCacheClearing),    // leaf
-		config.NewSupplyParamsCacheFn[servicetypes.Params](cache.WithNewBlockCacheClearing),  // leaf
-		config.NewSupplyParamsCacheFn[suppliertypes.Params](cache.WithNewBlockCacheClearing), // leaf
-
-		// Setup the key-value caches for pocket types and configure them to clear on new blocks.
-		config.NewSupplyKeyValueCacheFn[sharedtypes.Service](cache.WithNewBlockCacheClearing),                // leaf
-		config.NewSupplyKeyValueCacheFn[servicetypes.RelayMiningDifficulty](cache.WithNewBlockCacheClearing), // leaf
-		config.NewSupplyKeyValueCacheFn[apptypes.Application](cache.WithNewBlockCacheClearing),               // leaf
-		config.NewSupplyKeyValueCacheFn[sharedtypes.Supplier](cache.WithNewBlockCacheClearing),               // leaf
-		config.NewSupplyKeyValueCacheFn[query.BlockHash](cache.WithNewBlockCacheClearing),                    // leaf
-		config.NewSupplyKeyValueCacheFn[query.Balance](cache.WithNewBlockCacheClearing),                      // leaf
-		config.NewSupplyKeyValueCacheFn[prooftypes.Claim](cache.WithNewBlockCacheClearing),                   // leaf
+		config.NewSupplyParamsCacheFn[sharedtypes.Params](cache.WithParamsUpdateCacheClearing[sharedtypes.Params](sharedtypes.ModuleName)),       // leaf
+		config.NewSupplyParamsCacheFn[apptypes.Params](cache.WithParamsUpdateCacheClearing[apptypes.Params](apptypes.ModuleName)),                // leaf
+		config.NewSupplyParamsCacheFn[sessiontypes.Params](cache.WithParamsUpdateCacheClearing[sessiontypes.Params](sessiontypes.ModuleName)),    // leaf
+		config.NewSupplyParamsCacheFn[prooftypes.Params](cache.WithParamsUpdateCacheClearing[prooftypes.Params](prooftypes.ModuleName)),          // leaf
+		config.NewSupplyParamsCacheFn[servicetypes.Params](cache.WithParamsUpdateCacheClearing[servicetypes.Params](servicetypes.ModuleName)),    // leaf
+		config.NewSupplyParamsCacheFn[suppliertypes.Params](cache.WithParamsUpdateCacheClearing[suppliertypes.Params](suppliertypes.ModuleName)), // leaf
+
+		// The hash of a committed block never changes, cache them by height and only
+		// retain the ones of the blocks that are recent enough to be queried.
+		config.NewSupplyHistoricalKeyValueCacheFn[query.BlockHash](memory.WithMaxVersionAge(blockHashCacheMaxVersionAge)), // leaf
+		// The shared query client is needed by the caches which clear on session boundaries.
+		config.NewSupplySharedQueryClientFn(),
+
+		// Setup the key-value caches for pocket types whose values are only updated
+		// by specific transactions or on session boundaries.
+		config.NewSupplyKeyValueCacheFn[sharedtypes.Service](cache.WithServiceUpdateCacheClearing),
+		config.NewSupplyKeyValueCacheFn[servicetypes.RelayMiningDifficulty](cache.WithSessionBoundaryCacheClearing),
+		config.NewSupplyKeyValueCacheFn[apptypes.Application](
+			cache.WithApplicationEventsCacheInvalidation,
+			cache.WithSessionBoundaryCacheClearing,
+		),
+		config.NewSupplyKeyValueCacheFn[sharedtypes.Supplier](
+			cache.WithSupplierEventsCacheInvalidation,
+			cache.WithSessionBoundaryCacheClearing,
+		),
+
+		// Setup the key-value caches for the data which may change at every block
+		// and configure them to clear on new blocks.
+		config.NewSupplyKeyValueCacheFn[query.Balance](cache.WithNewBlockCacheClearing),    // leaf
+		config.NewSupplyKeyValueCacheFn[prooftypes.Claim](cache.WithNewBlockCacheClearing), // leaf
 		// The session querier returns *sessiontypes.Session, so its cache must also return pointers.
 		// This differs from other queriers which return value types.
 		config.NewSupplyKeyValueCacheFn[*sessiontypes.Session](cache.WithNewBlockCacheClearing), // leaf
@@ -252,24 +361,28 @@ func setupRelayerDependencies(
 		// Setup the key-value for cosmos types and configure them to clear on new blocks.
 		config.NewSupplyKeyValueCacheFn[cosmostypes.AccountI](cache.WithNewBlockCacheClearing), // leaf
 
-		config.NewSupplySharedQueryClientFn(),
 		config.NewSupplyServiceQueryClientFn(),
 		config.NewSupplyApplicationQuerierFn(),
 		config.NewSupplySessionQuerierFn(),
-		supplyRelayMeter,
+		newSupplyRelayMeterFn(smtStorePath, relayMinerConfig.RelayMeter),
 		supplyMiner,
 		config.NewSupplyAccountQuerierFn(),
 		config.NewSupplyBankQuerierFn(),
 		config.NewSupplySupplierQuerierFn(),
 		config.NewSupplyProofQueryClientFn(),
-		config.NewSupplyRingClientFn(),
+		config.NewSupplyRingClientFn(cache.WithApplicationEventsRingCacheInvalidation),
 		supplyTxFactory,
-		supplyTxContext,
+		newSupplyTxContextFn(txContextOpts...),
 		// The RelayMiner always uses tx simulation to estimate the gas since this
 		// will be variable depending on the tx being sent.
 		// Always use the "auto" gas setting for the RelayMiner.
-		config.NewSupplySupplierClientsFn(signingKeyNames, cosmosflags.GasFlagAuto),
-		newSupplyRelayAuthenticatorFn(signingKeyNames),
+		config.NewSupplySupplierClientsFn(
+			signingKeyNames,
+			cosmosflags.GasFlagAuto,
+			txClientOpts,
+			supplierClientOpts...,
+		),
+		newSupplyRelayAuthenticatorFn(relayAuthenticatorOpts...),
 		newSupplyRelayerProxyFn(servicesConfigMap),
 		newSupplyRelayerSessionsManagerFn(smtStorePath),
 	}
@@ -292,19 +405,29 @@ func supplyMiner(
 	return depinject.Configs(deps, depinject.Supply(mnr)), nil
 }
 
-// supplyRelayMeter constructs a RelayMeter instance and returns a new
-// depinject.Config which is supplied with the given deps and the new RelayMeter.
-func supplyRelayMeter(
-	_ context.Context,
-	deps depinject.Config,
-	_ *cobra.Command,
-) (depinject.Config, error) {
-	rm, err := proxy.NewRelayMeter(deps)
-	if err != nil {
-		return nil, err
-	}
+// newSupplyRelayMeterFn returns a function which constructs a RelayMeter
+// instance and returns a new depinject.Config which is supplied with the given
+// deps and the new RelayMeter.
+func newSupplyRelayMeterFn(
+	smtStorePath string,
+	relayMeterConfig *relayerconfig.RelayMinerRelayMeterConfig,
+) config.SupplierFn {
+	return func(
+		_ context.Context,
+		deps depinject.Config,
+		_ *cobra.Command,
+	) (depinject.Config, error) {
+		rm, err := proxy.NewRelayMeter(
+			deps,
+			proxy.WithRelayMeterStoresDirectory(smtStorePath),
+			proxy.WithRelayMeterConfig(relayMeterConfig),
+		)
+		if err != nil {
+			return nil, err
+		}
 
-	return depinject.Configs(deps, depinject.Supply(rm)), nil
+		return depinject.Configs(deps, depinject.Supply(rm)), nil
+	}
 }
 
 // supplyTxFactory constructs a cosmostx.Factory instance and returns a new
@@ -329,34 +452,137 @@ func supplyTxFactory(
 	return depinject.Configs(deps, depinject.Supply(clientFactory)), nil
 }
 
-func supplyTxContext(
-	_ context.Context,
-	deps depinject.Config,
-	_ *cobra.Command,
-) (depinject.Config, error) {
-	txContext, err := tx.NewTxContext(deps)
+// newSupplyTxContextFn returns a function which constructs a TxContext
+// instance with the given options and returns a new depinject.Config which
+// is supplied with the given deps and the new TxContext.
+func newSupplyTxContextFn(opts ...client.TxContextOption) config.SupplierFn {
+	return func(
+		_ context.Context,
+		deps depinject.Config,
+		_ *cobra.Command,
+	) (depinject.Config, error) {
+		// Query the accounts and simulate the txs through the same connection as
+		// the query clients, which fails over across the query nodes.
+		var queryClientConn grpc.ClientConn
+		if err := depinject.Inject(deps, &queryClientConn); err != nil {
+			return nil, err
+		}
+
+		txContext, err := tx.NewTxContext(deps, append(opts, tx.WithQueryClientConn(queryClientConn))...)
+		if err != nil {
+			return nil, err
+		}
+
+		return depinject.Configs(deps, depinject.Supply(txContext)), nil
+	}
+}
+
+// excludeUrl returns the given URLs, except the ones equal to the excluded URL.
+func excludeUrl(urls []*url.URL, excludedUrl *url.URL) []*url.URL {
+	includedUrls := make([]*url.URL, 0, len(urls))
+	for _, u := range urls {
+		if u.String() != excludedUrl.String() {
+			includedUrls = append(includedUrls, u)
+		}
+	}
+	return includedUrls
+}
+
+// newRemoteSignerClient constructs the client of the remote signing services
+// which sign the relay responses and transactions on behalf of the suppliers.
+func newRemoteSignerClient(
+	remoteSignerConfig *relayerconfig.RelayMinerRemoteSignerConfig,
+) (*signer.RemoteSignerClient, error) {
+	tlsConfig, err := signer.NewRemoteSignerClientTLSConfig(
+		remoteSignerConfig.CAFile,
+		remoteSignerConfig.CertFile,
+		remoteSignerConfig.KeyFile,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	endpoints := make([]signer.RemoteSignerEndpoint, 0, len(remoteSignerConfig.Endpoints))
+	for _, endpoint := range remoteSignerConfig.Endpoints {
+		endpoints = append(endpoints, signer.RemoteSignerEndpoint{
+			Url:      endpoint.Url,
+			KeyNames: endpoint.KeyNames,
+		})
+	}
+
+	return signer.NewRemoteSignerClient(endpoints, tlsConfig)
+}
+
+// newVerifiedQueryClientConnWrapperFn returns a function which wraps the query
+// clients gRPC connection so that the query responses are verified against the
+// onchain state, using merkle proofs of the query node state and a light client
+// verifying the headers provided by the query node.
+// The verified headers are persisted in a light_client subdirectory of storesDirectory.
+func newVerifiedQueryClientConnWrapperFn(
+	ctx context.Context,
+	queryNodeRPCUrl *url.URL,
+	fallbackQueryNodeRPCUrls []*url.URL,
+	lightClientConfig *relayerconfig.RelayMinerLightClientConfig,
+	storesDirectory string,
+) (config.QueryClientConnWrapperFn, error) {
+	witnessRPCUrls := make([]string, 0, len(lightClientConfig.WitnessRPCUrls))
+	for _, witnessRPCUrl := range lightClientConfig.WitnessRPCUrls {
+		witnessRPCUrls = append(witnessRPCUrls, witnessRPCUrl.String())
+	}
+
+	verifiedLightClientConfig := &verified.LightClientConfig{
+		ChainId:        lightClientConfig.ChainId,
+		TrustedHeight:  lightClientConfig.TrustedHeight,
+		TrustedHash:    lightClientConfig.TrustedHash,
+		TrustingPeriod: lightClientConfig.TrustingPeriod,
+		PrimaryRPCUrl:  queryNodeRPCUrl.String(),
+		WitnessRPCUrls: witnessRPCUrls,
+		StoreDirectory: path.Join(storesDirectory, "light_client"),
+	}
+
+	// The proofs are queried from the fallback query nodes, if any, when the
+	// preferred one is unreachable. They are verified all the same.
+	var (
+		abciClient rpcclient.ABCIClient
+		err        error
+	)
+	if len(fallbackQueryNodeRPCUrls) > 0 {
+		abciClient, err = failover.NewCometRPC(
+			ctx,
+			polylog.Ctx(ctx),
+			append([]*url.URL{queryNodeRPCUrl}, fallbackQueryNodeRPCUrls...),
+		)
+	} else {
+		abciClient, err = cosmosclient.NewClientFromNode(queryNodeRPCUrl.String())
+	}
 	if err != nil {
 		return nil, err
 	}
 
-	return depinject.Configs(deps, depinject.Supply(txContext)), nil
+	// The state root provider requires the block client, which is supplied
+	// before the query clients.
+	return func(deps depinject.Config, conn grpc.ClientConn) (grpc.ClientConn, error) {
+		stateRootProvider, err := verified.NewLightClientStateRootProvider(ctx, deps, verifiedLightClientConfig)
+		if err != nil {
+			return nil, err
+		}
+
+		return verified.NewClientConn(conn, abciClient, stateRootProvider), nil
+	}, nil
 }
 
 // newSupplyRelayAuthenticatorFn returns a function which constructs a
 // RelayAuthenticator instance and returns a new depinject.Config which
 // is supplied with the given deps and the new RelayAuthenticator.
 func newSupplyRelayAuthenticatorFn(
-	signingKeyNames []string,
+	opts ...relayer.RelayAuthenticatorOption,
 ) config.SupplierFn {
 	return func(
 		ctx context.Context,
 		deps depinject.Config,
 		_ *cobra.Command,
 	) (depinject.Config, error) {
-		relayAuthenticator, err := relay_authenticator.NewRelayAuthenticator(
-			deps,
-			relay_authenticator.WithSigningKeyNames(signingKeyNames),
-		)
+		relayAuthenticator, err := relay_authenticator.NewRelayAuthenticator(deps, opts...)
 		if err != nil {
 			return nil, err
 		}
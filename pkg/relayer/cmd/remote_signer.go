@@ -0,0 +1,149 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"os"
+	"strings"
+
+	cosmosclient "github.com/cosmos/cosmos-sdk/client"
+	cosmosflags "github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/pokt-network/poktroll/cmd/signals"
+	"github.com/pokt-network/poktroll/pkg/polylog"
+	"github.com/pokt-network/poktroll/pkg/polylog/polyzero"
+	"github.com/pokt-network/poktroll/pkg/signer"
+)
+
+var (
+	// flagRemoteSignerListenAddr is the address the remote signer server listens on.
+	flagRemoteSignerListenAddr string
+	// flagRemoteSignerKeyNames are the names of the keyring keys the remote signer server signs with.
+	flagRemoteSignerKeyNames []string
+	// flagRemoteSignerTLSClientCAFile is the CA bundle the client certificates are verified against.
+	flagRemoteSignerTLSClientCAFile string
+	// flagRemoteSignerTLSCertFile is the certificate presented by the remote signer server.
+	flagRemoteSignerTLSCertFile string
+	// flagRemoteSignerTLSKeyFile is the private key of the remote signer server certificate.
+	flagRemoteSignerTLSKeyFile string
+	// flagRemoteSignerLogLevel is the log level of the remote signer server.
+	flagRemoteSignerLogLevel string
+)
+
+// RemoteSignerCmd returns the Cobra command for running a reference remote
+// signing service.
+func RemoteSignerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remote-signer",
+		Short: "Start a reference remote signing service for RelayMiners",
+		Long: `Run a reference remote signing service, which signs the relay responses and
+transactions of the RelayMiners configured with a "remote_signer" section, using
+the given keys of the local keyring.
+
+It allows to keep the supplier operator keys off the RelayMiner hosts, which only
+need the public keys (e.g. pocketd keys add <name> --pubkey <pubkey>), and serves
+as a local stand-in of production signing services (e.g. backed by a KMS or an HSM)
+implementing the same API.
+
+The RelayMiners MUST present a client certificate signed by one of the CAs of the
+--tls-client-ca-file bundle (i.e. mTLS).`,
+		Example: `pocketd relayminer remote-signer \
+  --key-names=supplier1,supplier2 \
+  --listen-addr=0.0.0.0:8085 \
+  --tls-client-ca-file=ca.crt --tls-cert-file=tls.crt --tls-key-file=tls.key \
+  --keyring-backend=file`,
+		RunE: runRemoteSigner,
+	}
+
+	cmd.Flags().StringVar(&flagRemoteSignerListenAddr, "listen-addr", "localhost:8085", "The address the remote signer listens on")
+	cmd.Flags().StringSliceVar(&flagRemoteSignerKeyNames, "key-names", nil, "The names of the keyring keys to sign with")
+	cmd.Flags().StringVar(&flagRemoteSignerTLSClientCAFile, "tls-client-ca-file", "", "The path to the PEM encoded CA bundle the RelayMiners certificates are verified against")
+	cmd.Flags().StringVar(&flagRemoteSignerTLSCertFile, "tls-cert-file", "", "The path to the PEM encoded certificate presented to the RelayMiners")
+	cmd.Flags().StringVar(&flagRemoteSignerTLSKeyFile, "tls-key-file", "", "The path to the PEM encoded private key of the certificate")
+	cmd.Flags().String(cosmosflags.FlagKeyringBackend, "", "Select keyring's backend (os|file|kwallet|pass|test)")
+	cmd.Flags().StringVar(&flagRemoteSignerLogLevel, cosmosflags.FlagLogLevel, "info", "The logging level (debug|info|warn|error)")
+
+	for _, flagName := range []string{"key-names", "tls-client-ca-file", "tls-cert-file", "tls-key-file"} {
+		_ = cmd.MarkFlagRequired(flagName)
+	}
+
+	return cmd
+}
+
+func runRemoteSigner(cmd *cobra.Command, _ []string) error {
+	ctx, cancelCtx := context.WithCancel(cmd.Context())
+	// Ensure context cancellation.
+	defer cancelCtx()
+
+	// Handle interrupt and kill signals asynchronously.
+	signals.GoOnExitSignal(cancelCtx)
+
+	logger := polyzero.NewLogger(
+		polyzero.WithLevel(polyzero.ParseLevel(flagRemoteSignerLogLevel)),
+		polyzero.WithOutput(os.Stderr),
+	)
+
+	clientCtx, err := cosmosclient.GetClientQueryContext(cmd)
+	if err != nil {
+		return err
+	}
+
+	handler, err := signer.NewRemoteSignerHandler(clientCtx.Keyring, flagRemoteSignerKeyNames)
+	if err != nil {
+		return err
+	}
+
+	tlsConfig, err := signer.NewRemoteSignerServerTLSConfig(
+		flagRemoteSignerTLSClientCAFile,
+		flagRemoteSignerTLSCertFile,
+		flagRemoteSignerTLSKeyFile,
+	)
+	if err != nil {
+		return err
+	}
+
+	server := &http.Server{
+		Addr:      flagRemoteSignerListenAddr,
+		Handler:   newRemoteSignerLoggingHandler(logger, handler),
+		TLSConfig: tlsConfig,
+	}
+
+	go func() {
+		<-ctx.Done()
+		logger.Info().Str("endpoint", flagRemoteSignerListenAddr).Msg("stopping remote signer")
+		_ = server.Close()
+	}()
+
+	logger.Info().
+		Str("endpoint", flagRemoteSignerListenAddr).
+		Str("key_names", strings.Join(flagRemoteSignerKeyNames, ",")).
+		Msg("starting remote signer")
+
+	// The certificate and key are already loaded in the TLS config.
+	if err := server.ListenAndServeTLS("", ""); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+
+	return nil
+}
+
+// newRemoteSignerLoggingHandler logs the key name and client certificate subject
+// of every signing request, to audit the usage of the keys.
+func newRemoteSignerLoggingHandler(logger polylog.Logger, handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		var clientSubject string
+		if req.TLS != nil && len(req.TLS.PeerCertificates) > 0 {
+			clientSubject = req.TLS.PeerCertificates[0].Subject.String()
+		}
+
+		logger.Debug().
+			Str("path", req.URL.Path).
+			Str("client_subject", clientSubject).
+			Str("remote_addr", req.RemoteAddr).
+			Msg("remote signer request")
+
+		handler.ServeHTTP(w, req)
+	})
+}
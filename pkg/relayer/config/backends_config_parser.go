@@ -0,0 +1,154 @@
+package config
+
+import (
+	"net/url"
+	"time"
+)
+
+const (
+	// DefaultBackendWeight is the default weight of a supplier's backend.
+	DefaultBackendWeight = 1
+
+	// DefaultBackendHealthCheckInterval is the default interval between two
+	// consecutive health checks of a supplier's backends.
+	DefaultBackendHealthCheckInterval = 10 * time.Second
+
+	// DefaultBackendHealthCheckTimeout is the default maximum amount of time a
+	// backend is given to reply to a health check.
+	DefaultBackendHealthCheckTimeout = 2 * time.Second
+
+	// DefaultBackendUnhealthyThreshold is the default number of consecutive failed
+	// health checks after which a backend is ejected.
+	DefaultBackendUnhealthyThreshold = 3
+
+	// DefaultBackendHealthyThreshold is the default number of consecutive
+	// successful health checks after which an ejected backend is readmitted.
+	DefaultBackendHealthyThreshold = 2
+)
+
+// loadBalancingPolicies maps the load_balancing_policy config values to their
+// corresponding LoadBalancingPolicy.
+var loadBalancingPolicies = map[string]LoadBalancingPolicy{
+	"":                LoadBalancingPolicyRoundRobin,
+	"round_robin":     LoadBalancingPolicyRoundRobin,
+	"least_in_flight": LoadBalancingPolicyLeastInFlight,
+	"weighted":        LoadBalancingPolicyWeighted,
+}
+
+// primaryBackendUrl returns the url of the first backend of the service, which
+// is backend_url if specified or the first entry of the backends list otherwise.
+func (yamlSupplierServiceConfig YAMLRelayMinerSupplierServiceConfig) primaryBackendUrl() string {
+	if len(yamlSupplierServiceConfig.BackendUrl) == 0 && len(yamlSupplierServiceConfig.Backends) > 0 {
+		return yamlSupplierServiceConfig.Backends[0].Url
+	}
+
+	return yamlSupplierServiceConfig.BackendUrl
+}
+
+// parseSupplierBackends populates the supplier fields of the target structure
+// that are relevant to load balancing the relays across the service's backends.
+// The backend_url, if specified, is the first backend, followed by the ones of
+// the backends list.
+// This function alters the target RelayMinerSupplierServiceConfig structure
+// as a side effect.
+func (supplierServiceConfig *RelayMinerSupplierServiceConfig) parseSupplierBackends(
+	yamlSupplierServiceConfig YAMLRelayMinerSupplierServiceConfig,
+) error {
+	yamlBackends := yamlSupplierServiceConfig.Backends
+	if len(yamlSupplierServiceConfig.BackendUrl) > 0 {
+		yamlBackends = append(
+			[]YAMLRelayMinerSupplierServiceBackend{{Url: yamlSupplierServiceConfig.BackendUrl}},
+			yamlBackends...,
+		)
+	}
+
+	supplierServiceConfig.Backends = make([]*RelayMinerSupplierServiceBackend, 0, len(yamlBackends))
+	for _, yamlBackend := range yamlBackends {
+		backendUrl, err := url.Parse(yamlBackend.Url)
+		if err != nil {
+			return ErrRelayMinerConfigInvalidSupplier.Wrapf(
+				"invalid supplier backend url %s",
+				err.Error(),
+			)
+		}
+
+		if backendUrl.Scheme == "" || backendUrl.Host == "" {
+			return ErrRelayMinerConfigInvalidSupplier.Wrapf(
+				"missing scheme or host in supplier backend url %q",
+				yamlBackend.Url,
+			)
+		}
+
+		// All the backends of a service MUST be forwarded relays the same way.
+		if len(supplierServiceConfig.Backends) > 0 {
+			primaryBackendUrl := supplierServiceConfig.Backends[0].Url
+			if isGRPCScheme(primaryBackendUrl.Scheme) != isGRPCScheme(backendUrl.Scheme) {
+				return ErrRelayMinerConfigInvalidSupplier.Wrapf(
+					"supplier backend url %q is not of the same type as %q",
+					yamlBackend.Url,
+					primaryBackendUrl.String(),
+				)
+			}
+		}
+
+		weight := yamlBackend.Weight
+		if weight == 0 {
+			weight = DefaultBackendWeight
+		}
+
+		supplierServiceConfig.Backends = append(supplierServiceConfig.Backends, &RelayMinerSupplierServiceBackend{
+			Url:    backendUrl,
+			Weight: weight,
+		})
+	}
+
+	policy, ok := loadBalancingPolicies[yamlSupplierServiceConfig.LoadBalancingPolicy]
+	if !ok {
+		return ErrRelayMinerConfigInvalidSupplier.Wrapf(
+			"invalid supplier load balancing policy %q",
+			yamlSupplierServiceConfig.LoadBalancingPolicy,
+		)
+	}
+	supplierServiceConfig.LoadBalancingPolicy = policy
+
+	supplierServiceConfig.HealthCheck = parseSupplierBackendsHealthCheck(yamlSupplierServiceConfig.HealthCheck)
+
+	return nil
+}
+
+// parseSupplierBackendsHealthCheck returns the health check configuration of
+// the service's backends, falling back to the defaults for the fields which are
+// not specified.
+func parseSupplierBackendsHealthCheck(
+	yamlHealthCheck YAMLRelayMinerSupplierServiceHealthCheck,
+) *RelayMinerSupplierServiceHealthCheck {
+	healthCheck := &RelayMinerSupplierServiceHealthCheck{
+		Interval:           DefaultBackendHealthCheckInterval,
+		Timeout:            DefaultBackendHealthCheckTimeout,
+		UnhealthyThreshold: DefaultBackendUnhealthyThreshold,
+		HealthyThreshold:   DefaultBackendHealthyThreshold,
+	}
+
+	if yamlHealthCheck.IntervalSeconds > 0 {
+		healthCheck.Interval = time.Duration(yamlHealthCheck.IntervalSeconds) * time.Second
+	}
+
+	if yamlHealthCheck.TimeoutMs > 0 {
+		healthCheck.Timeout = msToDuration(yamlHealthCheck.TimeoutMs)
+	}
+
+	if yamlHealthCheck.UnhealthyThreshold > 0 {
+		healthCheck.UnhealthyThreshold = yamlHealthCheck.UnhealthyThreshold
+	}
+
+	if yamlHealthCheck.HealthyThreshold > 0 {
+		healthCheck.HealthyThreshold = yamlHealthCheck.HealthyThreshold
+	}
+
+	return healthCheck
+}
+
+// isGRPCScheme returns true if the backend url scheme designates a gRPC backend.
+func isGRPCScheme(scheme string) bool {
+	return scheme == "grpc" || scheme == "grpcs"
+}
@@ -11,4 +11,10 @@ var (
 	ErrRelayMinerConfigEmpty                 = sdkerrors.Register(codespace, 2104, "empty RelayMiner config")
 	ErrRelayMinerConfigInvalidSupplier       = sdkerrors.Register(codespace, 2105, "invalid supplier in RelayMiner config")
 	ErrRelayMinerConfigInvalidServer         = sdkerrors.Register(codespace, 2106, "invalid server in RelayMiner config")
+	ErrRelayMinerConfigInvalidServerTLS      = sdkerrors.Register(codespace, 2107, "invalid server tls in RelayMiner config")
+	ErrRelayMinerConfigInvalidRelayMeter     = sdkerrors.Register(codespace, 2108, "invalid relay meter in RelayMiner config")
+	ErrRelayMinerConfigInvalidAdmin          = sdkerrors.Register(codespace, 2109, "invalid admin in RelayMiner config")
+	ErrRelayMinerConfigInvalidTxResubmission = sdkerrors.Register(codespace, 2110, "invalid tx resubmission in RelayMiner config")
+	ErrRelayMinerConfigInvalidRemoteSigner   = sdkerrors.Register(codespace, 2111, "invalid remote signer in RelayMiner config")
+	ErrRelayMinerConfigInvalidLightClient    = sdkerrors.Register(codespace, 2112, "invalid light client in RelayMiner config")
 )
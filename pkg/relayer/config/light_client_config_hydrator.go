@@ -0,0 +1,73 @@
+package config
+
+import (
+	"encoding/hex"
+	"net/url"
+	"time"
+
+	"github.com/cometbft/cometbft/crypto/tmhash"
+)
+
+// DefaultLightClientTrustingPeriodSeconds is the default duration, in seconds,
+// for which a header verified by the light client is trusted.
+// It is two thirds of the default cosmos-sdk unbonding period of 21 days.
+const DefaultLightClientTrustingPeriodSeconds = 14 * 24 * 60 * 60
+
+// HydratePocketNodeLightClient populates the light client fields of the
+// RelayMinerConfig that are relevant to the "light_client" sub-section of the
+// "pocket_node" section in the config file.
+// The light client starts from a trusted header, which MUST be obtained from a
+// trusted source (e.g. a block explorer or a trusted node) and be more recent
+// than the trusting period.
+func (relayMinerConfig *RelayMinerConfig) HydratePocketNodeLightClient(
+	yamlLightClientConfig *YAMLRelayMinerLightClientConfig,
+) error {
+	lightClientConfig := &RelayMinerLightClientConfig{
+		Enabled:        yamlLightClientConfig.Enabled,
+		TrustingPeriod: DefaultLightClientTrustingPeriodSeconds * time.Second,
+	}
+	relayMinerConfig.PocketNode.LightClient = lightClientConfig
+
+	if !yamlLightClientConfig.Enabled {
+		return nil
+	}
+
+	if len(yamlLightClientConfig.ChainId) == 0 {
+		return ErrRelayMinerConfigInvalidLightClient.Wrap("chain_id is required")
+	}
+	lightClientConfig.ChainId = yamlLightClientConfig.ChainId
+
+	if yamlLightClientConfig.TrustedHeight <= 0 {
+		return ErrRelayMinerConfigInvalidLightClient.Wrapf(
+			"trusted_height must be positive, got %d",
+			yamlLightClientConfig.TrustedHeight,
+		)
+	}
+	lightClientConfig.TrustedHeight = yamlLightClientConfig.TrustedHeight
+
+	trustedHash, err := hex.DecodeString(yamlLightClientConfig.TrustedHash)
+	if err != nil || len(trustedHash) != tmhash.Size {
+		return ErrRelayMinerConfigInvalidLightClient.Wrapf(
+			"trusted_hash must be a %d bytes hex encoded header hash, got %q",
+			tmhash.Size, yamlLightClientConfig.TrustedHash,
+		)
+	}
+	lightClientConfig.TrustedHash = trustedHash
+
+	if yamlLightClientConfig.TrustingPeriodSeconds > 0 {
+		lightClientConfig.TrustingPeriod = time.Duration(yamlLightClientConfig.TrustingPeriodSeconds) * time.Second
+	}
+
+	for _, yamlWitnessRPCUrl := range yamlLightClientConfig.WitnessRPCUrls {
+		witnessRPCUrl, err := url.Parse(yamlWitnessRPCUrl)
+		if err != nil {
+			return ErrRelayMinerConfigInvalidLightClient.Wrapf(
+				"invalid witness rpc url %q: %v",
+				yamlWitnessRPCUrl, err,
+			)
+		}
+		lightClientConfig.WitnessRPCUrls = append(lightClientConfig.WitnessRPCUrls, witnessRPCUrl)
+	}
+
+	return nil
+}
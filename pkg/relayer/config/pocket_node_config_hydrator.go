@@ -52,5 +52,75 @@ func (relayMinerConfig *RelayMinerConfig) HydratePocketNodeUrls(
 	}
 	relayMinerConfig.PocketNode.QueryNodeGRPCUrl = queryNodeGRPCUrl
 
+	fallbackTxNodeRPCUrls, err := parseFallbackNodeUrls(
+		"tx node rpc",
+		yamlPocketNodeConfig.FallbackTxNodeRPCUrls,
+		relayMinerConfig.PocketNode.TxNodeRPCUrl,
+	)
+	if err != nil {
+		return err
+	}
+	relayMinerConfig.PocketNode.FallbackTxNodeRPCUrls = fallbackTxNodeRPCUrls
+
+	// If neither the query node rpc url nor its fallbacks are set, use the
+	// fallback tx node rpc urls, the same way the tx node rpc url is used.
+	if len(yamlPocketNodeConfig.QueryNodeRPCUrl) == 0 &&
+		len(yamlPocketNodeConfig.FallbackQueryNodeRPCUrls) == 0 {
+		relayMinerConfig.PocketNode.FallbackQueryNodeRPCUrls = fallbackTxNodeRPCUrls
+	} else {
+		fallbackQueryNodeRPCUrls, parseErr := parseFallbackNodeUrls(
+			"query node rpc",
+			yamlPocketNodeConfig.FallbackQueryNodeRPCUrls,
+			relayMinerConfig.PocketNode.QueryNodeRPCUrl,
+		)
+		if parseErr != nil {
+			return parseErr
+		}
+		relayMinerConfig.PocketNode.FallbackQueryNodeRPCUrls = fallbackQueryNodeRPCUrls
+	}
+
+	fallbackQueryNodeGRPCUrls, err := parseFallbackNodeUrls(
+		"query node grpc",
+		yamlPocketNodeConfig.FallbackQueryNodeGRPCUrls,
+		relayMinerConfig.PocketNode.QueryNodeGRPCUrl,
+	)
+	if err != nil {
+		return err
+	}
+	relayMinerConfig.PocketNode.FallbackQueryNodeGRPCUrls = fallbackQueryNodeGRPCUrls
+
 	return nil
 }
+
+// parseFallbackNodeUrls parses the given fallback URLs of the named node endpoint,
+// ensuring that they are neither duplicated nor equal to the given primary URL.
+func parseFallbackNodeUrls(
+	endpointName string,
+	yamlFallbackUrls []string,
+	primaryUrl *url.URL,
+) ([]*url.URL, error) {
+	seenUrls := map[string]struct{}{primaryUrl.String(): {}}
+
+	fallbackUrls := make([]*url.URL, 0, len(yamlFallbackUrls))
+	for _, yamlFallbackUrl := range yamlFallbackUrls {
+		fallbackUrl, err := url.Parse(yamlFallbackUrl)
+		if err != nil {
+			return nil, ErrRelayMinerConfigInvalidNodeUrl.Wrapf(
+				"invalid fallback %s url %s",
+				endpointName, err.Error(),
+			)
+		}
+
+		if _, ok := seenUrls[fallbackUrl.String()]; ok {
+			return nil, ErrRelayMinerConfigInvalidNodeUrl.Wrapf(
+				"duplicate fallback %s url %s",
+				endpointName, yamlFallbackUrl,
+			)
+		}
+		seenUrls[fallbackUrl.String()] = struct{}{}
+
+		fallbackUrls = append(fallbackUrls, fallbackUrl)
+	}
+
+	return fallbackUrls, nil
+}
@@ -1,6 +1,29 @@
 package config
 
-import "net/url"
+import (
+	"net/url"
+	"time"
+)
+
+const (
+	// DefaultBackendMaxIdleConns is the default maximum number of idle connections
+	// kept open to a supplier's backend.
+	DefaultBackendMaxIdleConns = 100
+
+	// DefaultBackendDialTimeout is the default maximum amount of time to wait for
+	// a connection to a supplier's backend to be established.
+	DefaultBackendDialTimeout = 5 * time.Second
+
+	// DefaultBackendRequestTimeout is the default overall amount of time a relay
+	// is given to be served by a supplier's backend.
+	// It matches the write timeout of the relay servers, past which the relay
+	// response could not be sent to the client anyway.
+	DefaultBackendRequestTimeout = 10 * time.Second
+
+	// DefaultBackendRetryBackoff is the default delay before retrying a request
+	// to a supplier's backend.
+	DefaultBackendRetryBackoff = 100 * time.Millisecond
+)
 
 // parseHTTPServerConfig populates the server fields of the target structure that
 // are relevant to the "http" type.
@@ -27,27 +50,23 @@ func (serverConfig *RelayMinerServerConfig) parseHTTPServerConfig(
 }
 
 // parseSupplierBackendUrl populates the supplier fields of the target structure
-// that are relevant to "http" and "https" backend url service configurations.
+// that are relevant to "http", "https", "grpc" and "grpcs" backend url service
+// configurations.
 // This function alters the target RelayMinerSupplierServiceConfig structure
 // as a side effect.
 func (supplierServiceConfig *RelayMinerSupplierServiceConfig) parseSupplierBackendUrl(
 	yamlSupplierServiceConfig YAMLRelayMinerSupplierServiceConfig,
 ) error {
 	// Check if the supplier backend url is empty
-	if len(yamlSupplierServiceConfig.BackendUrl) == 0 {
+	if len(yamlSupplierServiceConfig.primaryBackendUrl()) == 0 {
 		return ErrRelayMinerConfigInvalidSupplier.Wrap("empty supplier backend url")
 	}
 
-	// Check if the supplier backend url is a valid URL
-	supplierServiceBackendUrl, err := url.Parse(yamlSupplierServiceConfig.BackendUrl)
-	if err != nil {
-		return ErrRelayMinerConfigInvalidSupplier.Wrapf(
-			"invalid supplier backend url %s",
-			err.Error(),
-		)
+	if err := supplierServiceConfig.parseSupplierBackends(yamlSupplierServiceConfig); err != nil {
+		return err
 	}
 
-	supplierServiceConfig.BackendUrl = supplierServiceBackendUrl
+	supplierServiceConfig.BackendUrl = supplierServiceConfig.Backends[0].Url
 
 	// If the Authentication section is not empty, populate the supplier service
 	// authentication fields
@@ -63,5 +82,60 @@ func (supplierServiceConfig *RelayMinerSupplierServiceConfig) parseSupplierBacke
 		supplierServiceConfig.Headers = yamlSupplierServiceConfig.Headers
 	}
 
+	supplierServiceConfig.parseSupplierBackendClientConfig(yamlSupplierServiceConfig)
+
+	rateLimit, err := parseSupplierRateLimit(yamlSupplierServiceConfig.RateLimit)
+	if err != nil {
+		return err
+	}
+	supplierServiceConfig.RateLimit = rateLimit
+
+	responseCache, err := parseSupplierResponseCache(
+		yamlSupplierServiceConfig.ResponseCache,
+		supplierServiceConfig.BackendUrl,
+	)
+	if err != nil {
+		return err
+	}
+	supplierServiceConfig.ResponseCache = responseCache
+
 	return nil
 }
+
+// parseSupplierBackendClientConfig populates the supplier fields of the target
+// structure that configure the client used to forward relays to the backend,
+// falling back to the defaults for the fields which are not specified.
+// This function alters the target RelayMinerSupplierServiceConfig structure
+// as a side effect.
+func (supplierServiceConfig *RelayMinerSupplierServiceConfig) parseSupplierBackendClientConfig(
+	yamlSupplierServiceConfig YAMLRelayMinerSupplierServiceConfig,
+) {
+	supplierServiceConfig.MaxIdleConns = DefaultBackendMaxIdleConns
+	if yamlSupplierServiceConfig.MaxIdleConns > 0 {
+		supplierServiceConfig.MaxIdleConns = int(yamlSupplierServiceConfig.MaxIdleConns)
+	}
+
+	supplierServiceConfig.DialTimeout = DefaultBackendDialTimeout
+	if yamlSupplierServiceConfig.DialTimeoutMs > 0 {
+		supplierServiceConfig.DialTimeout = msToDuration(yamlSupplierServiceConfig.DialTimeoutMs)
+	}
+
+	supplierServiceConfig.RequestTimeout = DefaultBackendRequestTimeout
+	if yamlSupplierServiceConfig.RequestTimeoutMs > 0 {
+		supplierServiceConfig.RequestTimeout = msToDuration(yamlSupplierServiceConfig.RequestTimeoutMs)
+	}
+
+	supplierServiceConfig.RetryBackoff = DefaultBackendRetryBackoff
+	if yamlSupplierServiceConfig.RetryBackoffMs > 0 {
+		supplierServiceConfig.RetryBackoff = msToDuration(yamlSupplierServiceConfig.RetryBackoffMs)
+	}
+
+	supplierServiceConfig.ResponseHeaderTimeout = msToDuration(yamlSupplierServiceConfig.ResponseHeaderTimeoutMs)
+	supplierServiceConfig.MaxRetries = yamlSupplierServiceConfig.MaxRetries
+	supplierServiceConfig.IdempotentJSONRPCMethods = yamlSupplierServiceConfig.IdempotentJSONRPCMethods
+}
+
+// msToDuration converts a number of milliseconds into a time.Duration.
+func msToDuration(ms uint64) time.Duration {
+	return time.Duration(ms) * time.Millisecond
+}
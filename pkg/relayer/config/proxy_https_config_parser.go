@@ -0,0 +1,51 @@
+package config
+
+import "time"
+
+// DefaultServerTLSReloadInterval is the interval at which an "https" server
+// checks its certificate and key files for changes when the config does not
+// specify a `reload_interval_seconds`.
+const DefaultServerTLSReloadInterval = time.Minute
+
+// parseHTTPSServerConfig populates the server fields of the target structure that
+// are relevant to the "https" type.
+// It is complementary to parseHTTPServerConfig which populates the fields shared
+// by both the "http" and "https" types (e.g. listen address).
+// This function alters the target RelayMinerServerConfig structure as a side effect.
+func (serverConfig *RelayMinerServerConfig) parseHTTPSServerConfig(
+	yamlSupplierConfig YAMLRelayMinerSupplierConfig,
+) error {
+	yamlTLSConfig := yamlSupplierConfig.TLS
+
+	// Both the certificate and its private key are required to terminate TLS.
+	if len(yamlTLSConfig.CertFile) == 0 {
+		return ErrRelayMinerConfigInvalidServerTLS.Wrapf(
+			"empty tls cert_file for listen url %q",
+			yamlSupplierConfig.ListenUrl,
+		)
+	}
+
+	if len(yamlTLSConfig.KeyFile) == 0 {
+		return ErrRelayMinerConfigInvalidServerTLS.Wrapf(
+			"empty tls key_file for listen url %q",
+			yamlSupplierConfig.ListenUrl,
+		)
+	}
+
+	reloadInterval := DefaultServerTLSReloadInterval
+	if yamlTLSConfig.ReloadIntervalSeconds > 0 {
+		reloadInterval = time.Duration(yamlTLSConfig.ReloadIntervalSeconds) * time.Second
+	}
+
+	// NB: The existence and validity of the files are intentionally not checked
+	// here. The server would fail to start if they are invalid, which is the
+	// intended behaviour.
+	serverConfig.TLS = &RelayMinerServerTLSConfig{
+		CertFile:       yamlTLSConfig.CertFile,
+		KeyFile:        yamlTLSConfig.KeyFile,
+		ClientCAFile:   yamlTLSConfig.ClientCAFile,
+		ReloadInterval: reloadInterval,
+	}
+
+	return nil
+}
@@ -0,0 +1,30 @@
+package config
+
+// parseSupplierRateLimit returns the rate limit applied to each application of
+// the service, or nil if neither a request rate nor a concurrency limit is
+// specified.
+// The burst defaults to the requests per second if not specified.
+func parseSupplierRateLimit(
+	yamlRateLimit YAMLRelayMinerSupplierServiceRateLimit,
+) (*RelayMinerSupplierServiceRateLimit, error) {
+	if yamlRateLimit.RequestsPerSecond == 0 && yamlRateLimit.Burst > 0 {
+		return nil, ErrRelayMinerConfigInvalidSupplier.Wrap(
+			"supplier rate limit burst requires requests_per_second to be set",
+		)
+	}
+
+	if yamlRateLimit.RequestsPerSecond == 0 && yamlRateLimit.MaxInFlight == 0 {
+		return nil, nil
+	}
+
+	burst := yamlRateLimit.Burst
+	if burst == 0 {
+		burst = yamlRateLimit.RequestsPerSecond
+	}
+
+	return &RelayMinerSupplierServiceRateLimit{
+		RequestsPerSecond: yamlRateLimit.RequestsPerSecond,
+		Burst:             burst,
+		MaxInFlight:       yamlRateLimit.MaxInFlight,
+	}, nil
+}
@@ -0,0 +1,145 @@
+package config
+
+// DefaultOverServicingAllowanceUpokt is the default amount of uPOKT a supplier
+// over-services an application per session, beyond the portion of its stake
+// that the supplier can claim.
+const DefaultOverServicingAllowanceUpokt = 1000000
+
+// overServicingAllowancePolicies maps the over-servicing allowance policy config
+// values to their corresponding OverServicingAllowancePolicy.
+var overServicingAllowancePolicies = map[string]OverServicingAllowancePolicy{
+	"fixed":            OverServicingAllowancePolicyFixed,
+	"stake_percentage": OverServicingAllowancePolicyStakePercentage,
+	"unlimited":        OverServicingAllowancePolicyUnlimited,
+	"none":             OverServicingAllowancePolicyNone,
+}
+
+// rateLimitedReplyPolicies maps the rate_limited_reply config values to their
+// corresponding RateLimitedReplyPolicy.
+var rateLimitedReplyPolicies = map[string]RateLimitedReplyPolicy{
+	"":                  RateLimitedReplyPolicyTooManyRequests,
+	"too_many_requests": RateLimitedReplyPolicyTooManyRequests,
+	"relay_error":       RateLimitedReplyPolicyRelayError,
+}
+
+// HydrateRelayMeter populates the relay meter fields of the RelayMinerConfig
+// that are relevant to the "relay_meter" section in the config file.
+// The global over-servicing allowance defaults to DefaultOverServicingAllowanceUpokt
+// if not specified.
+func (relayMinerConfig *RelayMinerConfig) HydrateRelayMeter(
+	yamlRelayMeterConfig *YAMLRelayMinerRelayMeterConfig,
+) error {
+	relayMinerConfig.RelayMeter = &RelayMinerRelayMeterConfig{
+		OverServicingAllowance: &RelayMinerOverServicingAllowance{
+			Policy: OverServicingAllowancePolicyFixed,
+			Upokt:  DefaultOverServicingAllowanceUpokt,
+		},
+		ServicesOverServicingAllowance: make(map[string]*RelayMinerOverServicingAllowance),
+	}
+
+	if len(yamlRelayMeterConfig.OverServicingAllowance.Policy) > 0 {
+		allowance, err := parseOverServicingAllowance(yamlRelayMeterConfig.OverServicingAllowance)
+		if err != nil {
+			return err
+		}
+		relayMinerConfig.RelayMeter.OverServicingAllowance = allowance
+	}
+
+	for serviceId, yamlAllowance := range yamlRelayMeterConfig.ServicesOverServicingAllowance {
+		if len(serviceId) == 0 {
+			return ErrRelayMinerConfigInvalidRelayMeter.Wrap(
+				"empty service id in services over-servicing allowance",
+			)
+		}
+
+		allowance, err := parseOverServicingAllowance(yamlAllowance)
+		if err != nil {
+			return ErrRelayMinerConfigInvalidRelayMeter.Wrapf(
+				"service %q: %s",
+				serviceId,
+				err.Error(),
+			)
+		}
+		relayMinerConfig.RelayMeter.ServicesOverServicingAllowance[serviceId] = allowance
+	}
+
+	// An application cannot be both allowed and denied to be over-serviced.
+	allowedApplications := make(map[string]struct{}, len(yamlRelayMeterConfig.AllowedApplications))
+	for _, appAddress := range yamlRelayMeterConfig.AllowedApplications {
+		if len(appAddress) == 0 {
+			return ErrRelayMinerConfigInvalidRelayMeter.Wrap("empty allowed application address")
+		}
+		allowedApplications[appAddress] = struct{}{}
+	}
+
+	for _, appAddress := range yamlRelayMeterConfig.DeniedApplications {
+		if len(appAddress) == 0 {
+			return ErrRelayMinerConfigInvalidRelayMeter.Wrap("empty denied application address")
+		}
+		if _, ok := allowedApplications[appAddress]; ok {
+			return ErrRelayMinerConfigInvalidRelayMeter.Wrapf(
+				"application %q is both allowed and denied",
+				appAddress,
+			)
+		}
+	}
+
+	relayMinerConfig.RelayMeter.AllowedApplications = yamlRelayMeterConfig.AllowedApplications
+	relayMinerConfig.RelayMeter.DeniedApplications = yamlRelayMeterConfig.DeniedApplications
+
+	rateLimitedReplyPolicy, ok := rateLimitedReplyPolicies[yamlRelayMeterConfig.RateLimitedReply]
+	if !ok {
+		return ErrRelayMinerConfigInvalidRelayMeter.Wrapf(
+			"invalid rate limited reply %q",
+			yamlRelayMeterConfig.RateLimitedReply,
+		)
+	}
+	relayMinerConfig.RelayMeter.RateLimitedReplyPolicy = rateLimitedReplyPolicy
+
+	return nil
+}
+
+// parseOverServicingAllowance returns the over-servicing allowance described by
+// the given YAML allowance, ensuring that only the field relevant to its policy
+// is specified.
+func parseOverServicingAllowance(
+	yamlAllowance YAMLRelayMinerOverServicingAllowance,
+) (*RelayMinerOverServicingAllowance, error) {
+	policy, ok := overServicingAllowancePolicies[yamlAllowance.Policy]
+	if !ok {
+		return nil, ErrRelayMinerConfigInvalidRelayMeter.Wrapf(
+			"invalid over-servicing allowance policy %q",
+			yamlAllowance.Policy,
+		)
+	}
+
+	allowance := &RelayMinerOverServicingAllowance{Policy: policy}
+
+	switch policy {
+	case OverServicingAllowancePolicyFixed:
+		if yamlAllowance.StakePercentage != 0 {
+			return nil, ErrRelayMinerConfigInvalidRelayMeter.Wrap(
+				"stake_percentage cannot be set for the fixed over-servicing allowance policy",
+			)
+		}
+		allowance.Upokt = yamlAllowance.Upokt
+
+	case OverServicingAllowancePolicyStakePercentage:
+		if yamlAllowance.Upokt != 0 {
+			return nil, ErrRelayMinerConfigInvalidRelayMeter.Wrap(
+				"upokt cannot be set for the stake_percentage over-servicing allowance policy",
+			)
+		}
+		allowance.StakePercentage = yamlAllowance.StakePercentage
+
+	default:
+		if yamlAllowance.Upokt != 0 || yamlAllowance.StakePercentage != 0 {
+			return nil, ErrRelayMinerConfigInvalidRelayMeter.Wrapf(
+				"upokt and stake_percentage cannot be set for the %s over-servicing allowance policy",
+				yamlAllowance.Policy,
+			)
+		}
+	}
+
+	return allowance, nil
+}
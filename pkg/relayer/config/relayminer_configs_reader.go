@@ -47,11 +47,47 @@ func ParseRelayMinerConfigs(configContent []byte) (*RelayMinerConfig, error) {
 		Addr:    yamlRelayMinerConfig.Ping.Addr,
 	}
 
+	// The admin API exposes manual session actions, it MUST NOT be served
+	// without authentication.
+	if yamlRelayMinerConfig.Admin.Enabled {
+		if len(yamlRelayMinerConfig.Admin.Addr) == 0 {
+			return nil, ErrRelayMinerConfigInvalidAdmin.Wrap("addr is required")
+		}
+		if len(yamlRelayMinerConfig.Admin.AuthToken) == 0 {
+			return nil, ErrRelayMinerConfigInvalidAdmin.Wrap("auth_token is required")
+		}
+	}
+	relayMinerConfig.Admin = &RelayMinerAdminConfig{
+		Enabled:   yamlRelayMinerConfig.Admin.Enabled,
+		Addr:      yamlRelayMinerConfig.Admin.Addr,
+		AuthToken: yamlRelayMinerConfig.Admin.AuthToken,
+	}
+
 	// Hydrate the pocket node urls
 	if err := relayMinerConfig.HydratePocketNodeUrls(&yamlRelayMinerConfig.PocketNode); err != nil {
 		return nil, err
 	}
 
+	// Hydrate the light client verifying the query node responses
+	if err := relayMinerConfig.HydratePocketNodeLightClient(&yamlRelayMinerConfig.PocketNode.LightClient); err != nil {
+		return nil, err
+	}
+
+	// Hydrate the relay meter over-servicing policy
+	if err := relayMinerConfig.HydrateRelayMeter(&yamlRelayMinerConfig.RelayMeter); err != nil {
+		return nil, err
+	}
+
+	// Hydrate the claim and proof transactions resubmission policy
+	if err := relayMinerConfig.HydrateTxResubmission(&yamlRelayMinerConfig.TxResubmission); err != nil {
+		return nil, err
+	}
+
+	// Hydrate the remote signing services
+	if err := relayMinerConfig.HydrateRemoteSigner(&yamlRelayMinerConfig.RemoteSigner); err != nil {
+		return nil, err
+	}
+
 	// Hydrate the relay miner servers config
 	if err := relayMinerConfig.HydrateServers(yamlRelayMinerConfig.Suppliers); err != nil {
 		return nil, err
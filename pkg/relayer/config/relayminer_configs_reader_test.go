@@ -1,14 +1,19 @@
 package config_test
 
 import (
+	"encoding/hex"
 	"net/url"
 	"os"
 	"testing"
+	"time"
 
 	sdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	cosmostypes "github.com/cosmos/cosmos-sdk/types"
 	"github.com/gogo/status"
 	"github.com/stretchr/testify/require"
 
+	"github.com/pokt-network/poktroll/app/volatile"
 	"github.com/pokt-network/poktroll/pkg/relayer/config"
 	"github.com/pokt-network/poktroll/testutil/yaml"
 )
@@ -22,6 +27,9 @@ func Test_ParseRelayMinerConfig_ReferenceExample(t *testing.T) {
 }
 
 func Test_ParseRelayMinerConfigs(t *testing.T) {
+	lightClientTrustedHash, err := hex.DecodeString("0E6EA7B5D4A0D63BC1A6D3B3BC5A18F47E5F0C4AA8D4F4E1D6E2F0C44E1B9A77")
+	require.NoError(t, err)
+
 	tests := []struct {
 		desc            string
 		inputConfigYAML string
@@ -367,69 +375,200 @@ func Test_ParseRelayMinerConfigs(t *testing.T) {
 				},
 			},
 		},
-		// Invalid Configs
 		{
-			desc: "invalid: invalid tx node grpc url",
+			desc: "valid: relay miner config with https server and mTLS",
 
 			inputConfigYAML: `
 				pocket_node:
 				  query_node_rpc_url: tcp://127.0.0.1:26657
 				  query_node_grpc_url: tcp://127.0.0.1:9090
-				  tx_node_rpc_url: &tcp://127.0.0.1:36659
+				  tx_node_rpc_url: tcp://127.0.0.1:36659
 				default_signing_key_names: [ supplier1 ]
 				smt_store_path: smt_stores
 				suppliers:
 				  - service_id: ethereum
-				    listen_url: http://127.0.0.1:8080
+				    listen_url: https://127.0.0.1:8443
+				    tls:
+				      cert_file: /etc/relayminer/tls/tls.crt
+				      key_file: /etc/relayminer/tls/tls.key
+				      client_ca_file: /etc/relayminer/tls/ca.crt
+				      reload_interval_seconds: 30
 				    service_config:
 				      backend_url: http://anvil.servicer:8545
+				  - service_id: ollama
+				    listen_url: https://127.0.0.1:8444
+				    tls:
+				      cert_file: /etc/relayminer/tls/tls.crt
+				      key_file: /etc/relayminer/tls/tls.key
+				    service_config:
+				      backend_url: http://ollama.servicer:11434
 				`,
 
-			expectedErr: config.ErrRelayMinerConfigInvalidNodeUrl,
+			expectedErr: nil,
+			expectedConfig: &config.RelayMinerConfig{
+				PocketNode: &config.RelayMinerPocketNodeConfig{
+					QueryNodeRPCUrl:  &url.URL{Scheme: "tcp", Host: "127.0.0.1:26657"},
+					QueryNodeGRPCUrl: &url.URL{Scheme: "tcp", Host: "127.0.0.1:9090"},
+					TxNodeRPCUrl:     &url.URL{Scheme: "tcp", Host: "127.0.0.1:36659"},
+				},
+				DefaultSigningKeyNames: []string{"supplier1"},
+				SmtStorePath:           "smt_stores",
+				Servers: map[string]*config.RelayMinerServerConfig{
+					"https://127.0.0.1:8443": {
+						ListenAddress: "127.0.0.1:8443",
+						ServerType:    config.RelayMinerServerTypeHTTPS,
+						TLS: &config.RelayMinerServerTLSConfig{
+							CertFile:       "/etc/relayminer/tls/tls.crt",
+							KeyFile:        "/etc/relayminer/tls/tls.key",
+							ClientCAFile:   "/etc/relayminer/tls/ca.crt",
+							ReloadInterval: 30 * time.Second,
+						},
+						SupplierConfigsMap: map[string]*config.RelayMinerSupplierConfig{
+							"ethereum": {
+								ServiceId:  "ethereum",
+								ServerType: config.RelayMinerServerTypeHTTP,
+								ServiceConfig: &config.RelayMinerSupplierServiceConfig{
+									BackendUrl: &url.URL{Scheme: "http", Host: "anvil.servicer:8545"},
+								},
+							},
+						},
+					},
+					"https://127.0.0.1:8444": {
+						ListenAddress: "127.0.0.1:8444",
+						ServerType:    config.RelayMinerServerTypeHTTPS,
+						TLS: &config.RelayMinerServerTLSConfig{
+							CertFile:       "/etc/relayminer/tls/tls.crt",
+							KeyFile:        "/etc/relayminer/tls/tls.key",
+							ReloadInterval: config.DefaultServerTLSReloadInterval,
+						},
+						SupplierConfigsMap: map[string]*config.RelayMinerSupplierConfig{
+							"ollama": {
+								ServiceId:  "ollama",
+								ServerType: config.RelayMinerServerTypeHTTP,
+								ServiceConfig: &config.RelayMinerSupplierServiceConfig{
+									BackendUrl: &url.URL{Scheme: "http", Host: "ollama.servicer:11434"},
+								},
+							},
+						},
+					},
+				},
+			},
 		},
 		{
-			desc: "invalid: missing tx node grpc url",
+			desc: "valid: suppliers sharing an https listen url with the same tls config",
 
 			inputConfigYAML: `
 				pocket_node:
 				  query_node_rpc_url: tcp://127.0.0.1:26657
-				  # explicitly omitted tx node grpc url
 				  query_node_grpc_url: tcp://127.0.0.1:9090
+				  tx_node_rpc_url: tcp://127.0.0.1:36659
 				default_signing_key_names: [ supplier1 ]
 				smt_store_path: smt_stores
 				suppliers:
 				  - service_id: ethereum
+				    listen_url: https://127.0.0.1:8443
+				    tls:
+				      cert_file: /etc/relayminer/tls/tls.crt
+				      key_file: /etc/relayminer/tls/tls.key
 				    service_config:
 				      backend_url: http://anvil.servicer:8545
+				  - service_id: ollama
+				    listen_url: https://127.0.0.1:8443
+				    tls:
+				      cert_file: /etc/relayminer/tls/tls.crt
+				      key_file: /etc/relayminer/tls/tls.key
+				    service_config:
+				      backend_url: http://ollama.servicer:11434
 				`,
 
-			expectedErr: config.ErrRelayMinerConfigInvalidNodeUrl,
+			expectedErr: nil,
+			expectedConfig: &config.RelayMinerConfig{
+				PocketNode: &config.RelayMinerPocketNodeConfig{
+					QueryNodeRPCUrl:  &url.URL{Scheme: "tcp", Host: "127.0.0.1:26657"},
+					QueryNodeGRPCUrl: &url.URL{Scheme: "tcp", Host: "127.0.0.1:9090"},
+					TxNodeRPCUrl:     &url.URL{Scheme: "tcp", Host: "127.0.0.1:36659"},
+				},
+				DefaultSigningKeyNames: []string{"supplier1"},
+				SmtStorePath:           "smt_stores",
+				Servers: map[string]*config.RelayMinerServerConfig{
+					"https://127.0.0.1:8443": {
+						ListenAddress: "127.0.0.1:8443",
+						ServerType:    config.RelayMinerServerTypeHTTPS,
+						TLS: &config.RelayMinerServerTLSConfig{
+							CertFile:       "/etc/relayminer/tls/tls.crt",
+							KeyFile:        "/etc/relayminer/tls/tls.key",
+							ReloadInterval: config.DefaultServerTLSReloadInterval,
+						},
+						SupplierConfigsMap: map[string]*config.RelayMinerSupplierConfig{
+							"ethereum": {
+								ServiceId:  "ethereum",
+								ServerType: config.RelayMinerServerTypeHTTP,
+								ServiceConfig: &config.RelayMinerSupplierServiceConfig{
+									BackendUrl: &url.URL{Scheme: "http", Host: "anvil.servicer:8545"},
+								},
+							},
+							"ollama": {
+								ServiceId:  "ollama",
+								ServerType: config.RelayMinerServerTypeHTTP,
+								ServiceConfig: &config.RelayMinerSupplierServiceConfig{
+									BackendUrl: &url.URL{Scheme: "http", Host: "ollama.servicer:11434"},
+								},
+							},
+						},
+					},
+				},
+			},
 		},
 		{
-			desc: "invalid: invalid query node grpc url",
+			desc: "valid: relay miner config with grpc backend",
 
 			inputConfigYAML: `
 				pocket_node:
 				  query_node_rpc_url: tcp://127.0.0.1:26657
-				  query_node_grpc_url: &tcp://127.0.0.1:9090
+				  query_node_grpc_url: tcp://127.0.0.1:9090
 				  tx_node_rpc_url: tcp://127.0.0.1:36659
 				default_signing_key_names: [ supplier1 ]
 				smt_store_path: smt_stores
 				suppliers:
-				  - service_id: ethereum
+				  - service_id: cosmoshub
 				    listen_url: http://127.0.0.1:8080
 				    service_config:
-				      backend_url: http://anvil.servicer:8545
+				      backend_url: grpcs://cosmoshub.servicer:9090
 				`,
 
-			expectedErr: config.ErrRelayMinerConfigInvalidNodeUrl,
+			expectedErr: nil,
+			expectedConfig: &config.RelayMinerConfig{
+				PocketNode: &config.RelayMinerPocketNodeConfig{
+					QueryNodeRPCUrl:  &url.URL{Scheme: "tcp", Host: "127.0.0.1:26657"},
+					QueryNodeGRPCUrl: &url.URL{Scheme: "tcp", Host: "127.0.0.1:9090"},
+					TxNodeRPCUrl:     &url.URL{Scheme: "tcp", Host: "127.0.0.1:36659"},
+				},
+				DefaultSigningKeyNames: []string{"supplier1"},
+				SmtStorePath:           "smt_stores",
+				Servers: map[string]*config.RelayMinerServerConfig{
+					"http://127.0.0.1:8080": {
+						ListenAddress: "127.0.0.1:8080",
+						ServerType:    config.RelayMinerServerTypeHTTP,
+						SupplierConfigsMap: map[string]*config.RelayMinerSupplierConfig{
+							"cosmoshub": {
+								ServiceId:   "cosmoshub",
+								ServerType:  config.RelayMinerServerTypeHTTP,
+								BackendType: config.RelayMinerSupplierBackendTypeGRPC,
+								ServiceConfig: &config.RelayMinerSupplierServiceConfig{
+									BackendUrl: &url.URL{Scheme: "grpcs", Host: "cosmoshub.servicer:9090"},
+								},
+							},
+						},
+					},
+				},
+			},
 		},
 		{
-			desc: "invalid: invalid query node rpc url",
+			desc: "valid: relay miner config with backend client settings",
 
 			inputConfigYAML: `
 				pocket_node:
-				  query_node_rpc_url: &tcp://127.0.0.1:26657
+				  query_node_rpc_url: tcp://127.0.0.1:26657
 				  query_node_grpc_url: tcp://127.0.0.1:9090
 				  tx_node_rpc_url: tcp://127.0.0.1:36659
 				default_signing_key_names: [ supplier1 ]
@@ -439,17 +578,55 @@ func Test_ParseRelayMinerConfigs(t *testing.T) {
 				    listen_url: http://127.0.0.1:8080
 				    service_config:
 				      backend_url: http://anvil.servicer:8545
+				      max_idle_conns: 20
+				      dial_timeout_ms: 1000
+				      response_header_timeout_ms: 2000
+				      request_timeout_ms: 3000
+				      max_retries: 2
+				      retry_backoff_ms: 50
+				      idempotent_jsonrpc_methods: [ eth_call, eth_getBalance ]
 				`,
 
-			expectedErr: config.ErrRelayMinerConfigInvalidNodeUrl,
+			expectedErr: nil,
+			expectedConfig: &config.RelayMinerConfig{
+				PocketNode: &config.RelayMinerPocketNodeConfig{
+					QueryNodeRPCUrl:  &url.URL{Scheme: "tcp", Host: "127.0.0.1:26657"},
+					QueryNodeGRPCUrl: &url.URL{Scheme: "tcp", Host: "127.0.0.1:9090"},
+					TxNodeRPCUrl:     &url.URL{Scheme: "tcp", Host: "127.0.0.1:36659"},
+				},
+				DefaultSigningKeyNames: []string{"supplier1"},
+				SmtStorePath:           "smt_stores",
+				Servers: map[string]*config.RelayMinerServerConfig{
+					"http://127.0.0.1:8080": {
+						ListenAddress: "127.0.0.1:8080",
+						ServerType:    config.RelayMinerServerTypeHTTP,
+						SupplierConfigsMap: map[string]*config.RelayMinerSupplierConfig{
+							"ethereum": {
+								ServiceId:  "ethereum",
+								ServerType: config.RelayMinerServerTypeHTTP,
+								ServiceConfig: &config.RelayMinerSupplierServiceConfig{
+									BackendUrl:               &url.URL{Scheme: "http", Host: "anvil.servicer:8545"},
+									MaxIdleConns:             20,
+									DialTimeout:              time.Second,
+									ResponseHeaderTimeout:    2 * time.Second,
+									RequestTimeout:           3 * time.Second,
+									MaxRetries:               2,
+									RetryBackoff:             50 * time.Millisecond,
+									IdempotentJSONRPCMethods: []string{"eth_call", "eth_getBalance"},
+								},
+							},
+						},
+					},
+				},
+			},
 		},
 		{
-			desc: "invalid: missing query node grpc url",
+			desc: "valid: relay miner config with multiple load balanced backends",
 
 			inputConfigYAML: `
 				pocket_node:
-				  # explicitly omitted query node rpc url
 				  query_node_rpc_url: tcp://127.0.0.1:26657
+				  query_node_grpc_url: tcp://127.0.0.1:9090
 				  tx_node_rpc_url: tcp://127.0.0.1:36659
 				default_signing_key_names: [ supplier1 ]
 				smt_store_path: smt_stores
@@ -457,32 +634,106 @@ func Test_ParseRelayMinerConfigs(t *testing.T) {
 				  - service_id: ethereum
 				    listen_url: http://127.0.0.1:8080
 				    service_config:
-				      backend_url: http://anvil.servicer:8545
+				      backend_url: http://anvil1.servicer:8545
+				      backends:
+				        - url: http://anvil2.servicer:8545
+				          weight: 3
+				      load_balancing_policy: weighted
+				      health_check:
+				        interval_seconds: 5
+				        unhealthy_threshold: 1
 				`,
 
-			expectedErr: config.ErrRelayMinerConfigInvalidNodeUrl,
+			expectedErr: nil,
+			expectedConfig: &config.RelayMinerConfig{
+				PocketNode: &config.RelayMinerPocketNodeConfig{
+					QueryNodeRPCUrl:  &url.URL{Scheme: "tcp", Host: "127.0.0.1:26657"},
+					QueryNodeGRPCUrl: &url.URL{Scheme: "tcp", Host: "127.0.0.1:9090"},
+					TxNodeRPCUrl:     &url.URL{Scheme: "tcp", Host: "127.0.0.1:36659"},
+				},
+				DefaultSigningKeyNames: []string{"supplier1"},
+				SmtStorePath:           "smt_stores",
+				Servers: map[string]*config.RelayMinerServerConfig{
+					"http://127.0.0.1:8080": {
+						ListenAddress: "127.0.0.1:8080",
+						ServerType:    config.RelayMinerServerTypeHTTP,
+						SupplierConfigsMap: map[string]*config.RelayMinerSupplierConfig{
+							"ethereum": {
+								ServiceId:  "ethereum",
+								ServerType: config.RelayMinerServerTypeHTTP,
+								ServiceConfig: &config.RelayMinerSupplierServiceConfig{
+									BackendUrl: &url.URL{Scheme: "http", Host: "anvil1.servicer:8545"},
+									Backends: []*config.RelayMinerSupplierServiceBackend{
+										{Url: &url.URL{Scheme: "http", Host: "anvil1.servicer:8545"}, Weight: 1},
+										{Url: &url.URL{Scheme: "http", Host: "anvil2.servicer:8545"}, Weight: 3},
+									},
+									LoadBalancingPolicy: config.LoadBalancingPolicyWeighted,
+									HealthCheck: &config.RelayMinerSupplierServiceHealthCheck{
+										Interval:           5 * time.Second,
+										Timeout:            config.DefaultBackendHealthCheckTimeout,
+										UnhealthyThreshold: 1,
+										HealthyThreshold:   config.DefaultBackendHealthyThreshold,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 		},
 		{
-			desc: "invalid: missing both default and supplier signing key names",
+			desc: "valid: relay miner config with applications rate limit",
 
 			inputConfigYAML: `
 				pocket_node:
 				  query_node_rpc_url: tcp://127.0.0.1:26657
 				  query_node_grpc_url: tcp://127.0.0.1:9090
 				  tx_node_rpc_url: tcp://127.0.0.1:36659
-				# explicitly omitted signing key name
+				default_signing_key_names: [ supplier1 ]
 				smt_store_path: smt_stores
 				suppliers:
 				  - service_id: ethereum
 				    listen_url: http://127.0.0.1:8080
 				    service_config:
 				      backend_url: http://anvil.servicer:8545
+				      rate_limit:
+				        requests_per_second: 50
+				        max_in_flight: 10
 				`,
 
-			expectedErr: config.ErrRelayMinerConfigInvalidSigningKeyName,
+			expectedErr: nil,
+			expectedConfig: &config.RelayMinerConfig{
+				PocketNode: &config.RelayMinerPocketNodeConfig{
+					QueryNodeRPCUrl:  &url.URL{Scheme: "tcp", Host: "127.0.0.1:26657"},
+					QueryNodeGRPCUrl: &url.URL{Scheme: "tcp", Host: "127.0.0.1:9090"},
+					TxNodeRPCUrl:     &url.URL{Scheme: "tcp", Host: "127.0.0.1:36659"},
+				},
+				DefaultSigningKeyNames: []string{"supplier1"},
+				SmtStorePath:           "smt_stores",
+				Servers: map[string]*config.RelayMinerServerConfig{
+					"http://127.0.0.1:8080": {
+						ListenAddress: "127.0.0.1:8080",
+						ServerType:    config.RelayMinerServerTypeHTTP,
+						SupplierConfigsMap: map[string]*config.RelayMinerSupplierConfig{
+							"ethereum": {
+								ServiceId:  "ethereum",
+								ServerType: config.RelayMinerServerTypeHTTP,
+								ServiceConfig: &config.RelayMinerSupplierServiceConfig{
+									BackendUrl: &url.URL{Scheme: "http", Host: "anvil.servicer:8545"},
+									RateLimit: &config.RelayMinerSupplierServiceRateLimit{
+										RequestsPerSecond: 50,
+										Burst:             50,
+										MaxInFlight:       10,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 		},
 		{
-			desc: "invalid: missing smt store path",
+			desc: "valid: relay miner config with response cache",
 
 			inputConfigYAML: `
 				pocket_node:
@@ -490,18 +741,53 @@ func Test_ParseRelayMinerConfigs(t *testing.T) {
 				  query_node_grpc_url: tcp://127.0.0.1:9090
 				  tx_node_rpc_url: tcp://127.0.0.1:36659
 				default_signing_key_names: [ supplier1 ]
-				# explicitly omitted smt store path
+				smt_store_path: smt_stores
 				suppliers:
 				  - service_id: ethereum
 				    listen_url: http://127.0.0.1:8080
 				    service_config:
 				      backend_url: http://anvil.servicer:8545
+				      response_cache:
+				        method_ttls_seconds:
+				          eth_chainId: 3600
+				          eth_getBlockByNumber: 60
 				`,
 
-			expectedErr: config.ErrRelayMinerConfigInvalidSmtStorePath,
+			expectedErr: nil,
+			expectedConfig: &config.RelayMinerConfig{
+				PocketNode: &config.RelayMinerPocketNodeConfig{
+					QueryNodeRPCUrl:  &url.URL{Scheme: "tcp", Host: "127.0.0.1:26657"},
+					QueryNodeGRPCUrl: &url.URL{Scheme: "tcp", Host: "127.0.0.1:9090"},
+					TxNodeRPCUrl:     &url.URL{Scheme: "tcp", Host: "127.0.0.1:36659"},
+				},
+				DefaultSigningKeyNames: []string{"supplier1"},
+				SmtStorePath:           "smt_stores",
+				Servers: map[string]*config.RelayMinerServerConfig{
+					"http://127.0.0.1:8080": {
+						ListenAddress: "127.0.0.1:8080",
+						ServerType:    config.RelayMinerServerTypeHTTP,
+						SupplierConfigsMap: map[string]*config.RelayMinerSupplierConfig{
+							"ethereum": {
+								ServiceId:  "ethereum",
+								ServerType: config.RelayMinerServerTypeHTTP,
+								ServiceConfig: &config.RelayMinerSupplierServiceConfig{
+									BackendUrl: &url.URL{Scheme: "http", Host: "anvil.servicer:8545"},
+									ResponseCache: &config.RelayMinerSupplierServiceResponseCache{
+										MaxEntries: config.DefaultResponseCacheMaxEntries,
+										MethodTTLs: map[string]time.Duration{
+											"eth_chainId":          time.Hour,
+											"eth_getBlockByNumber": time.Minute,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 		},
 		{
-			desc: "invalid: empty listen address",
+			desc: "valid: relay miner config with relay meter over-servicing policy",
 
 			inputConfigYAML: `
 				pocket_node:
@@ -512,15 +798,65 @@ func Test_ParseRelayMinerConfigs(t *testing.T) {
 				smt_store_path: smt_stores
 				suppliers:
 				  - service_id: ethereum
-				    listen_url: http:// # explicitly empty listen url
+				    listen_url: http://127.0.0.1:8080
 				    service_config:
 				      backend_url: http://anvil.servicer:8545
+				relay_meter:
+				  over_servicing_allowance:
+				    policy: stake_percentage
+				    stake_percentage: 10
+				  services_over_servicing_allowance:
+				    ethereum:
+				      policy: fixed
+				      upokt: 500
+				    ollama:
+				      policy: none
+				  allowed_applications: [ pokt1app1 ]
+				  denied_applications: [ pokt1app2 ]
+				  rate_limited_reply: relay_error
 				`,
 
-			expectedErr: config.ErrRelayMinerConfigInvalidServer,
+			expectedErr: nil,
+			expectedConfig: &config.RelayMinerConfig{
+				PocketNode: &config.RelayMinerPocketNodeConfig{
+					QueryNodeRPCUrl:  &url.URL{Scheme: "tcp", Host: "127.0.0.1:26657"},
+					QueryNodeGRPCUrl: &url.URL{Scheme: "tcp", Host: "127.0.0.1:9090"},
+					TxNodeRPCUrl:     &url.URL{Scheme: "tcp", Host: "127.0.0.1:36659"},
+				},
+				DefaultSigningKeyNames: []string{"supplier1"},
+				SmtStorePath:           "smt_stores",
+				RelayMeter: &config.RelayMinerRelayMeterConfig{
+					OverServicingAllowance: &config.RelayMinerOverServicingAllowance{
+						Policy:          config.OverServicingAllowancePolicyStakePercentage,
+						StakePercentage: 10,
+					},
+					ServicesOverServicingAllowance: map[string]*config.RelayMinerOverServicingAllowance{
+						"ethereum": {Policy: config.OverServicingAllowancePolicyFixed, Upokt: 500},
+						"ollama":   {Policy: config.OverServicingAllowancePolicyNone},
+					},
+					AllowedApplications:    []string{"pokt1app1"},
+					DeniedApplications:     []string{"pokt1app2"},
+					RateLimitedReplyPolicy: config.RateLimitedReplyPolicyRelayError,
+				},
+				Servers: map[string]*config.RelayMinerServerConfig{
+					"http://127.0.0.1:8080": {
+						ListenAddress: "127.0.0.1:8080",
+						ServerType:    config.RelayMinerServerTypeHTTP,
+						SupplierConfigsMap: map[string]*config.RelayMinerSupplierConfig{
+							"ethereum": {
+								ServiceId:  "ethereum",
+								ServerType: config.RelayMinerServerTypeHTTP,
+								ServiceConfig: &config.RelayMinerSupplierServiceConfig{
+									BackendUrl: &url.URL{Scheme: "http", Host: "anvil.servicer:8545"},
+								},
+							},
+						},
+					},
+				},
+			},
 		},
 		{
-			desc: "invalid: unsupported server type",
+			desc: "valid: relay miner config with admin api",
 
 			inputConfigYAML: `
 				pocket_node:
@@ -531,34 +867,755 @@ func Test_ParseRelayMinerConfigs(t *testing.T) {
 				smt_store_path: smt_stores
 				suppliers:
 				  - service_id: ethereum
-				    listen_url: unsupported://127.0.0.1:8080
+				    listen_url: http://127.0.0.1:8080
 				    service_config:
 				      backend_url: http://anvil.servicer:8545
+				admin:
+				  enabled: true
+				  addr: localhost:8082
+				  auth_token: secret
 				`,
 
-			expectedErr: config.ErrRelayMinerConfigInvalidServer,
-		},
-		{
-			desc: "invalid: missing supplier name",
-
-			inputConfigYAML: `
-				pocket_node:
-				  query_node_rpc_url: tcp://127.0.0.1:26657
-				  query_node_grpc_url: tcp://127.0.0.1:9090
-				  tx_node_rpc_url: tcp://127.0.0.1:36659
-				default_signing_key_names: [ supplier1 ]
+			expectedErr: nil,
+			expectedConfig: &config.RelayMinerConfig{
+				PocketNode: &config.RelayMinerPocketNodeConfig{
+					QueryNodeRPCUrl:  &url.URL{Scheme: "tcp", Host: "127.0.0.1:26657"},
+					QueryNodeGRPCUrl: &url.URL{Scheme: "tcp", Host: "127.0.0.1:9090"},
+					TxNodeRPCUrl:     &url.URL{Scheme: "tcp", Host: "127.0.0.1:36659"},
+				},
+				DefaultSigningKeyNames: []string{"supplier1"},
+				SmtStorePath:           "smt_stores",
+				Admin: &config.RelayMinerAdminConfig{
+					Enabled:   true,
+					Addr:      "localhost:8082",
+					AuthToken: "secret",
+				},
+				Servers: map[string]*config.RelayMinerServerConfig{
+					"http://127.0.0.1:8080": {
+						ListenAddress: "127.0.0.1:8080",
+						ServerType:    config.RelayMinerServerTypeHTTP,
+						SupplierConfigsMap: map[string]*config.RelayMinerSupplierConfig{
+							"ethereum": {
+								ServiceId:  "ethereum",
+								ServerType: config.RelayMinerServerTypeHTTP,
+								ServiceConfig: &config.RelayMinerSupplierServiceConfig{
+									BackendUrl: &url.URL{Scheme: "http", Host: "anvil.servicer:8545"},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			desc: "valid: relay miner config with default tx resubmission policy",
+
+			inputConfigYAML: `
+				pocket_node:
+				  query_node_rpc_url: tcp://127.0.0.1:26657
+				  query_node_grpc_url: tcp://127.0.0.1:9090
+				  tx_node_rpc_url: tcp://127.0.0.1:36659
+				default_signing_key_names: [ supplier1 ]
+				smt_store_path: smt_stores
+				suppliers:
+				  - service_id: ethereum
+				    listen_url: http://127.0.0.1:8080
+				    service_config:
+				      backend_url: http://anvil.servicer:8545
+				tx_resubmission:
+				  enabled: true
+				`,
+
+			expectedErr: nil,
+			expectedConfig: &config.RelayMinerConfig{
+				PocketNode: &config.RelayMinerPocketNodeConfig{
+					QueryNodeRPCUrl:  &url.URL{Scheme: "tcp", Host: "127.0.0.1:26657"},
+					QueryNodeGRPCUrl: &url.URL{Scheme: "tcp", Host: "127.0.0.1:9090"},
+					TxNodeRPCUrl:     &url.URL{Scheme: "tcp", Host: "127.0.0.1:36659"},
+				},
+				DefaultSigningKeyNames: []string{"supplier1"},
+				SmtStorePath:           "smt_stores",
+				TxResubmission: &config.RelayMinerTxResubmissionConfig{
+					Enabled:              true,
+					AttemptTimeoutBlocks: config.DefaultTxResubmissionAttemptTimeoutBlocks,
+					FeeBumpFactor:        config.DefaultTxResubmissionFeeBumpFactor,
+					MaxFeeMultiplier:     config.DefaultTxResubmissionMaxFeeMultiplier,
+				},
+				Servers: map[string]*config.RelayMinerServerConfig{
+					"http://127.0.0.1:8080": {
+						ListenAddress: "127.0.0.1:8080",
+						ServerType:    config.RelayMinerServerTypeHTTP,
+						SupplierConfigsMap: map[string]*config.RelayMinerSupplierConfig{
+							"ethereum": {
+								ServiceId:  "ethereum",
+								ServerType: config.RelayMinerServerTypeHTTP,
+								ServiceConfig: &config.RelayMinerSupplierServiceConfig{
+									BackendUrl: &url.URL{Scheme: "http", Host: "anvil.servicer:8545"},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			desc: "valid: relay miner config with custom tx resubmission policy",
+
+			inputConfigYAML: `
+				pocket_node:
+				  query_node_rpc_url: tcp://127.0.0.1:26657
+				  query_node_grpc_url: tcp://127.0.0.1:9090
+				  tx_node_rpc_url: tcp://127.0.0.1:36659
+				default_signing_key_names: [ supplier1 ]
+				smt_store_path: smt_stores
+				suppliers:
+				  - service_id: ethereum
+				    listen_url: http://127.0.0.1:8080
+				    service_config:
+				      backend_url: http://anvil.servicer:8545
+				tx_resubmission:
+				  enabled: true
+				  attempt_timeout_blocks: 4
+				  fee_bump_factor: 1.2
+				  max_fee_multiplier: 2
+				  max_gas_prices: 0.5upokt
+				`,
+
+			expectedErr: nil,
+			expectedConfig: &config.RelayMinerConfig{
+				PocketNode: &config.RelayMinerPocketNodeConfig{
+					QueryNodeRPCUrl:  &url.URL{Scheme: "tcp", Host: "127.0.0.1:26657"},
+					QueryNodeGRPCUrl: &url.URL{Scheme: "tcp", Host: "127.0.0.1:9090"},
+					TxNodeRPCUrl:     &url.URL{Scheme: "tcp", Host: "127.0.0.1:36659"},
+				},
+				DefaultSigningKeyNames: []string{"supplier1"},
+				SmtStorePath:           "smt_stores",
+				TxResubmission: &config.RelayMinerTxResubmissionConfig{
+					Enabled:              true,
+					AttemptTimeoutBlocks: 4,
+					FeeBumpFactor:        1.2,
+					MaxFeeMultiplier:     2,
+					MaxGasPrices: cosmostypes.NewDecCoins(
+						cosmostypes.NewDecCoinFromDec(volatile.DenomuPOKT, math.LegacyNewDecWithPrec(5, 1)),
+					),
+				},
+				Servers: map[string]*config.RelayMinerServerConfig{
+					"http://127.0.0.1:8080": {
+						ListenAddress: "127.0.0.1:8080",
+						ServerType:    config.RelayMinerServerTypeHTTP,
+						SupplierConfigsMap: map[string]*config.RelayMinerSupplierConfig{
+							"ethereum": {
+								ServiceId:  "ethereum",
+								ServerType: config.RelayMinerServerTypeHTTP,
+								ServiceConfig: &config.RelayMinerSupplierServiceConfig{
+									BackendUrl: &url.URL{Scheme: "http", Host: "anvil.servicer:8545"},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			desc: "valid: relay miner config with remote signer",
+
+			inputConfigYAML: `
+				pocket_node:
+				  query_node_rpc_url: tcp://127.0.0.1:26657
+				  query_node_grpc_url: tcp://127.0.0.1:9090
+				  tx_node_rpc_url: tcp://127.0.0.1:36659
+				default_signing_key_names: [ supplier1 ]
+				smt_store_path: smt_stores
+				suppliers:
+				  - service_id: ethereum
+				    listen_url: http://127.0.0.1:8080
+				    service_config:
+				      backend_url: http://anvil.servicer:8545
+				remote_signer:
+				  enabled: true
+				  endpoints:
+				    - url: https://signer-1:8085
+				      key_names: [ supplier1 ]
+				    - url: https://signer-2:8085
+				      key_names: [ supplier2, supplier3 ]
+				  tls:
+				    ca_file: /etc/relayminer/signer/ca.crt
+				    cert_file: /etc/relayminer/signer/tls.crt
+				    key_file: /etc/relayminer/signer/tls.key
+				`,
+
+			expectedErr: nil,
+			expectedConfig: &config.RelayMinerConfig{
+				PocketNode: &config.RelayMinerPocketNodeConfig{
+					QueryNodeRPCUrl:  &url.URL{Scheme: "tcp", Host: "127.0.0.1:26657"},
+					QueryNodeGRPCUrl: &url.URL{Scheme: "tcp", Host: "127.0.0.1:9090"},
+					TxNodeRPCUrl:     &url.URL{Scheme: "tcp", Host: "127.0.0.1:36659"},
+				},
+				DefaultSigningKeyNames: []string{"supplier1"},
+				SmtStorePath:           "smt_stores",
+				RemoteSigner: &config.RelayMinerRemoteSignerConfig{
+					Enabled: true,
+					Endpoints: []*config.RelayMinerRemoteSignerEndpoint{
+						{
+							Url:      &url.URL{Scheme: "https", Host: "signer-1:8085"},
+							KeyNames: []string{"supplier1"},
+						},
+						{
+							Url:      &url.URL{Scheme: "https", Host: "signer-2:8085"},
+							KeyNames: []string{"supplier2", "supplier3"},
+						},
+					},
+					CAFile:   "/etc/relayminer/signer/ca.crt",
+					CertFile: "/etc/relayminer/signer/tls.crt",
+					KeyFile:  "/etc/relayminer/signer/tls.key",
+				},
+				Servers: map[string]*config.RelayMinerServerConfig{
+					"http://127.0.0.1:8080": {
+						ListenAddress: "127.0.0.1:8080",
+						ServerType:    config.RelayMinerServerTypeHTTP,
+						SupplierConfigsMap: map[string]*config.RelayMinerSupplierConfig{
+							"ethereum": {
+								ServiceId:  "ethereum",
+								ServerType: config.RelayMinerServerTypeHTTP,
+								ServiceConfig: &config.RelayMinerSupplierServiceConfig{
+									BackendUrl: &url.URL{Scheme: "http", Host: "anvil.servicer:8545"},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			desc: "valid: relay miner config with light client",
+
+			inputConfigYAML: `
+				pocket_node:
+				  query_node_rpc_url: tcp://127.0.0.1:26657
+				  query_node_grpc_url: tcp://127.0.0.1:9090
+				  tx_node_rpc_url: tcp://127.0.0.1:36659
+				  light_client:
+				    enabled: true
+				    chain_id: pocket
+				    trusted_height: 1000
+				    trusted_hash: 0E6EA7B5D4A0D63BC1A6D3B3BC5A18F47E5F0C4AA8D4F4E1D6E2F0C44E1B9A77
+				    trusting_period_seconds: 86400
+				    witness_rpc_urls: [ tcp://witness:26657 ]
+				default_signing_key_names: [ supplier1 ]
+				smt_store_path: smt_stores
+				suppliers:
+				  - service_id: ethereum
+				    listen_url: http://127.0.0.1:8080
+				    service_config:
+				      backend_url: http://anvil.servicer:8545
+				`,
+
+			expectedErr: nil,
+			expectedConfig: &config.RelayMinerConfig{
+				PocketNode: &config.RelayMinerPocketNodeConfig{
+					QueryNodeRPCUrl:  &url.URL{Scheme: "tcp", Host: "127.0.0.1:26657"},
+					QueryNodeGRPCUrl: &url.URL{Scheme: "tcp", Host: "127.0.0.1:9090"},
+					TxNodeRPCUrl:     &url.URL{Scheme: "tcp", Host: "127.0.0.1:36659"},
+					LightClient: &config.RelayMinerLightClientConfig{
+						Enabled:        true,
+						ChainId:        "pocket",
+						TrustedHeight:  1000,
+						TrustedHash:    lightClientTrustedHash,
+						TrustingPeriod: 24 * time.Hour,
+						WitnessRPCUrls: []*url.URL{{Scheme: "tcp", Host: "witness:26657"}},
+					},
+				},
+				DefaultSigningKeyNames: []string{"supplier1"},
+				SmtStorePath:           "smt_stores",
+				Servers: map[string]*config.RelayMinerServerConfig{
+					"http://127.0.0.1:8080": {
+						ListenAddress: "127.0.0.1:8080",
+						ServerType:    config.RelayMinerServerTypeHTTP,
+						SupplierConfigsMap: map[string]*config.RelayMinerSupplierConfig{
+							"ethereum": {
+								ServiceId:  "ethereum",
+								ServerType: config.RelayMinerServerTypeHTTP,
+								ServiceConfig: &config.RelayMinerSupplierServiceConfig{
+									BackendUrl: &url.URL{Scheme: "http", Host: "anvil.servicer:8545"},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			desc: "valid: relay miner config with fallback node urls",
+
+			inputConfigYAML: `
+				pocket_node:
+				  query_node_grpc_url: tcp://127.0.0.1:9090
+				  tx_node_rpc_url: tcp://127.0.0.1:36659
+				  fallback_query_node_grpc_urls: [ tcp://fallback1:9090, tcp://fallback2:9090 ]
+				  fallback_tx_node_rpc_urls: [ tcp://fallback1:26657 ]
+				default_signing_key_names: [ supplier1 ]
+				smt_store_path: smt_stores
+				suppliers:
+				  - service_id: ethereum
+				    listen_url: http://127.0.0.1:8080
+				    service_config:
+				      backend_url: http://anvil.servicer:8545
+				`,
+
+			expectedErr: nil,
+			expectedConfig: &config.RelayMinerConfig{
+				PocketNode: &config.RelayMinerPocketNodeConfig{
+					QueryNodeRPCUrl:  &url.URL{Scheme: "tcp", Host: "127.0.0.1:36659"},
+					QueryNodeGRPCUrl: &url.URL{Scheme: "tcp", Host: "127.0.0.1:9090"},
+					TxNodeRPCUrl:     &url.URL{Scheme: "tcp", Host: "127.0.0.1:36659"},
+					// The fallback query node rpc urls default to the fallback tx node rpc urls.
+					FallbackQueryNodeRPCUrls: []*url.URL{{Scheme: "tcp", Host: "fallback1:26657"}},
+					FallbackQueryNodeGRPCUrls: []*url.URL{
+						{Scheme: "tcp", Host: "fallback1:9090"},
+						{Scheme: "tcp", Host: "fallback2:9090"},
+					},
+					FallbackTxNodeRPCUrls: []*url.URL{{Scheme: "tcp", Host: "fallback1:26657"}},
+				},
+				DefaultSigningKeyNames: []string{"supplier1"},
+				SmtStorePath:           "smt_stores",
+				Servers: map[string]*config.RelayMinerServerConfig{
+					"http://127.0.0.1:8080": {
+						ListenAddress: "127.0.0.1:8080",
+						ServerType:    config.RelayMinerServerTypeHTTP,
+						SupplierConfigsMap: map[string]*config.RelayMinerSupplierConfig{
+							"ethereum": {
+								ServiceId:  "ethereum",
+								ServerType: config.RelayMinerServerTypeHTTP,
+								ServiceConfig: &config.RelayMinerSupplierServiceConfig{
+									BackendUrl: &url.URL{Scheme: "http", Host: "anvil.servicer:8545"},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		// Invalid Configs
+		{
+			desc: "invalid: invalid tx node grpc url",
+
+			inputConfigYAML: `
+				pocket_node:
+				  query_node_rpc_url: tcp://127.0.0.1:26657
+				  query_node_grpc_url: tcp://127.0.0.1:9090
+				  tx_node_rpc_url: &tcp://127.0.0.1:36659
+				default_signing_key_names: [ supplier1 ]
+				smt_store_path: smt_stores
+				suppliers:
+				  - service_id: ethereum
+				    listen_url: http://127.0.0.1:8080
+				    service_config:
+				      backend_url: http://anvil.servicer:8545
+				`,
+
+			expectedErr: config.ErrRelayMinerConfigInvalidNodeUrl,
+		},
+		{
+			desc: "invalid: missing tx node grpc url",
+
+			inputConfigYAML: `
+				pocket_node:
+				  query_node_rpc_url: tcp://127.0.0.1:26657
+				  # explicitly omitted tx node grpc url
+				  query_node_grpc_url: tcp://127.0.0.1:9090
+				default_signing_key_names: [ supplier1 ]
+				smt_store_path: smt_stores
+				suppliers:
+				  - service_id: ethereum
+				    service_config:
+				      backend_url: http://anvil.servicer:8545
+				`,
+
+			expectedErr: config.ErrRelayMinerConfigInvalidNodeUrl,
+		},
+		{
+			desc: "invalid: invalid query node grpc url",
+
+			inputConfigYAML: `
+				pocket_node:
+				  query_node_rpc_url: tcp://127.0.0.1:26657
+				  query_node_grpc_url: &tcp://127.0.0.1:9090
+				  tx_node_rpc_url: tcp://127.0.0.1:36659
+				default_signing_key_names: [ supplier1 ]
+				smt_store_path: smt_stores
+				suppliers:
+				  - service_id: ethereum
+				    listen_url: http://127.0.0.1:8080
+				    service_config:
+				      backend_url: http://anvil.servicer:8545
+				`,
+
+			expectedErr: config.ErrRelayMinerConfigInvalidNodeUrl,
+		},
+		{
+			desc: "invalid: invalid query node rpc url",
+
+			inputConfigYAML: `
+				pocket_node:
+				  query_node_rpc_url: &tcp://127.0.0.1:26657
+				  query_node_grpc_url: tcp://127.0.0.1:9090
+				  tx_node_rpc_url: tcp://127.0.0.1:36659
+				default_signing_key_names: [ supplier1 ]
+				smt_store_path: smt_stores
+				suppliers:
+				  - service_id: ethereum
+				    listen_url: http://127.0.0.1:8080
+				    service_config:
+				      backend_url: http://anvil.servicer:8545
+				`,
+
+			expectedErr: config.ErrRelayMinerConfigInvalidNodeUrl,
+		},
+		{
+			desc: "invalid: missing query node grpc url",
+
+			inputConfigYAML: `
+				pocket_node:
+				  # explicitly omitted query node rpc url
+				  query_node_rpc_url: tcp://127.0.0.1:26657
+				  tx_node_rpc_url: tcp://127.0.0.1:36659
+				default_signing_key_names: [ supplier1 ]
+				smt_store_path: smt_stores
+				suppliers:
+				  - service_id: ethereum
+				    listen_url: http://127.0.0.1:8080
+				    service_config:
+				      backend_url: http://anvil.servicer:8545
+				`,
+
+			expectedErr: config.ErrRelayMinerConfigInvalidNodeUrl,
+		},
+		{
+			desc: "invalid: missing both default and supplier signing key names",
+
+			inputConfigYAML: `
+				pocket_node:
+				  query_node_rpc_url: tcp://127.0.0.1:26657
+				  query_node_grpc_url: tcp://127.0.0.1:9090
+				  tx_node_rpc_url: tcp://127.0.0.1:36659
+				# explicitly omitted signing key name
+				smt_store_path: smt_stores
+				suppliers:
+				  - service_id: ethereum
+				    listen_url: http://127.0.0.1:8080
+				    service_config:
+				      backend_url: http://anvil.servicer:8545
+				`,
+
+			expectedErr: config.ErrRelayMinerConfigInvalidSigningKeyName,
+		},
+		{
+			desc: "invalid: missing smt store path",
+
+			inputConfigYAML: `
+				pocket_node:
+				  query_node_rpc_url: tcp://127.0.0.1:26657
+				  query_node_grpc_url: tcp://127.0.0.1:9090
+				  tx_node_rpc_url: tcp://127.0.0.1:36659
+				default_signing_key_names: [ supplier1 ]
+				# explicitly omitted smt store path
+				suppliers:
+				  - service_id: ethereum
+				    listen_url: http://127.0.0.1:8080
+				    service_config:
+				      backend_url: http://anvil.servicer:8545
+				`,
+
+			expectedErr: config.ErrRelayMinerConfigInvalidSmtStorePath,
+		},
+		{
+			desc: "invalid: empty listen address",
+
+			inputConfigYAML: `
+				pocket_node:
+				  query_node_rpc_url: tcp://127.0.0.1:26657
+				  query_node_grpc_url: tcp://127.0.0.1:9090
+				  tx_node_rpc_url: tcp://127.0.0.1:36659
+				default_signing_key_names: [ supplier1 ]
+				smt_store_path: smt_stores
+				suppliers:
+				  - service_id: ethereum
+				    listen_url: http:// # explicitly empty listen url
+				    service_config:
+				      backend_url: http://anvil.servicer:8545
+				`,
+
+			expectedErr: config.ErrRelayMinerConfigInvalidServer,
+		},
+		{
+			desc: "invalid: unsupported server type",
+
+			inputConfigYAML: `
+				pocket_node:
+				  query_node_rpc_url: tcp://127.0.0.1:26657
+				  query_node_grpc_url: tcp://127.0.0.1:9090
+				  tx_node_rpc_url: tcp://127.0.0.1:36659
+				default_signing_key_names: [ supplier1 ]
+				smt_store_path: smt_stores
+				suppliers:
+				  - service_id: ethereum
+				    listen_url: unsupported://127.0.0.1:8080
+				    service_config:
+				      backend_url: http://anvil.servicer:8545
+				`,
+
+			expectedErr: config.ErrRelayMinerConfigInvalidServer,
+		},
+		{
+			desc: "invalid: https server missing tls cert file",
+
+			inputConfigYAML: `
+				pocket_node:
+				  query_node_rpc_url: tcp://127.0.0.1:26657
+				  query_node_grpc_url: tcp://127.0.0.1:9090
+				  tx_node_rpc_url: tcp://127.0.0.1:36659
+				default_signing_key_names: [ supplier1 ]
+				smt_store_path: smt_stores
+				suppliers:
+				  - service_id: ethereum
+				    listen_url: https://127.0.0.1:8443
+				    tls:
+				      key_file: /etc/relayminer/tls/tls.key
+				    service_config:
+				      backend_url: http://anvil.servicer:8545
+				`,
+
+			expectedErr: config.ErrRelayMinerConfigInvalidServerTLS,
+		},
+		{
+			desc: "invalid: https server missing tls section",
+
+			inputConfigYAML: `
+				pocket_node:
+				  query_node_rpc_url: tcp://127.0.0.1:26657
+				  query_node_grpc_url: tcp://127.0.0.1:9090
+				  tx_node_rpc_url: tcp://127.0.0.1:36659
+				default_signing_key_names: [ supplier1 ]
+				smt_store_path: smt_stores
+				suppliers:
+				  - service_id: ethereum
+				    listen_url: https://127.0.0.1:8443
+				    service_config:
+				      backend_url: http://anvil.servicer:8545
+				`,
+
+			expectedErr: config.ErrRelayMinerConfigInvalidServerTLS,
+		},
+		{
+			desc: "invalid: suppliers sharing an https listen url with different tls configs",
+
+			inputConfigYAML: `
+				pocket_node:
+				  query_node_rpc_url: tcp://127.0.0.1:26657
+				  query_node_grpc_url: tcp://127.0.0.1:9090
+				  tx_node_rpc_url: tcp://127.0.0.1:36659
+				default_signing_key_names: [ supplier1 ]
+				smt_store_path: smt_stores
+				suppliers:
+				  - service_id: ethereum
+				    listen_url: https://127.0.0.1:8443
+				    tls:
+				      cert_file: /etc/relayminer/tls/tls.crt
+				      key_file: /etc/relayminer/tls/tls.key
+				      client_ca_file: /etc/relayminer/tls/ca.crt
+				    service_config:
+				      backend_url: http://anvil.servicer:8545
+				  - service_id: ollama
+				    listen_url: https://127.0.0.1:8443
+				    tls:
+				      cert_file: /etc/relayminer/tls/tls.crt
+				      key_file: /etc/relayminer/tls/tls.key
+				    service_config:
+				      backend_url: http://ollama.servicer:11434
+				`,
+
+			expectedErr: config.ErrRelayMinerConfigInvalidServerTLS,
+		},
+		{
+			desc: "invalid: missing supplier name",
+
+			inputConfigYAML: `
+				pocket_node:
+				  query_node_rpc_url: tcp://127.0.0.1:26657
+				  query_node_grpc_url: tcp://127.0.0.1:9090
+				  tx_node_rpc_url: tcp://127.0.0.1:36659
+				default_signing_key_names: [ supplier1 ]
+				smt_store_path: smt_stores
+				suppliers:
+				  - listen_url: http://127.0.0.1:8080
+				    # explicitly missing supplier name
+				    service_config:
+				      backend_url: http://anvil.servicer:8545
+				`,
+
+			expectedErr: config.ErrRelayMinerConfigInvalidSupplier,
+		},
+		{
+			desc: "invalid: empty supplier name",
+
+			inputConfigYAML: `
+				pocket_node:
+				  query_node_rpc_url: tcp://127.0.0.1:26657
+				  query_node_grpc_url: tcp://127.0.0.1:9090
+				  tx_node_rpc_url: tcp://127.0.0.1:36659
+				default_signing_key_names: [ supplier1 ]
+				smt_store_path: smt_stores
+				suppliers:
+				  - service_id: # explicitly empty supplier name
+				    listen_url: http://127.0.0.1:8080
+				    service_config:
+				      backend_url: http://anvil.servicer:8545
+				`,
+
+			expectedErr: config.ErrRelayMinerConfigInvalidSupplier,
+		},
+		{
+			desc: "invalid: bad supplier service config backend url",
+
+			inputConfigYAML: `
+				pocket_node:
+				  query_node_rpc_url: tcp://127.0.0.1:26657
+				  query_node_grpc_url: tcp://127.0.0.1:9090
+				  tx_node_rpc_url: tcp://127.0.0.1:36659
+				default_signing_key_names: [ supplier1 ]
+				smt_store_path: smt_stores
+				suppliers:
+				  - service_id: ethereum
+				    listen_url: http://127.0.0.1:8080
+				    service_config:
+				      backend_url: &http://anvil.servicer:8545
+				`,
+
+			expectedErr: config.ErrRelayMinerConfigInvalidSupplier,
+		},
+		{
+			desc: "invalid: empty supplier service config backend url",
+
+			inputConfigYAML: `
+				pocket_node:
+				  query_node_rpc_url: tcp://127.0.0.1:26657
+				  query_node_grpc_url: tcp://127.0.0.1:9090
+				  tx_node_rpc_url: tcp://127.0.0.1:36659
+				default_signing_key_names: [ supplier1 ]
+				smt_store_path: smt_stores
+				suppliers:
+				  - service_id: ethereum
+				    listen_url: http://127.0.0.1:8080
+				    service_config:
+				      backend_url: # explicitly empty supplier service config backend url
+				`,
+
+			expectedErr: config.ErrRelayMinerConfigInvalidSupplier,
+		},
+		{
+			desc: "invalid: missing supplier service config backend url",
+
+			inputConfigYAML: `
+				pocket_node:
+				  query_node_rpc_url: tcp://127.0.0.1:26657
+				  query_node_grpc_url: tcp://127.0.0.1:9090
+				  tx_node_rpc_url: tcp://127.0.0.1:36659
+				default_signing_key_names: [ supplier1 ]
+				smt_store_path: smt_stores
+				suppliers:
+				  - service_id: ethereum
+				    listen_url: http://127.0.0.1:8080
+				    service_config:
+				`,
+
+			expectedErr: config.ErrRelayMinerConfigInvalidSupplier,
+		},
+		{
+			desc: "invalid: supplier backends of different types",
+
+			inputConfigYAML: `
+				pocket_node:
+				  query_node_rpc_url: tcp://127.0.0.1:26657
+				  query_node_grpc_url: tcp://127.0.0.1:9090
+				  tx_node_rpc_url: tcp://127.0.0.1:36659
+				default_signing_key_names: [ supplier1 ]
+				smt_store_path: smt_stores
+				suppliers:
+				  - service_id: ethereum
+				    listen_url: http://127.0.0.1:8080
+				    service_config:
+				      backends:
+				        - url: http://anvil.servicer:8545
+				        - url: grpc://anvil.servicer:9090
+				`,
+
+			expectedErr: config.ErrRelayMinerConfigInvalidSupplier,
+		},
+		{
+			desc: "invalid: unsupported supplier load balancing policy",
+
+			inputConfigYAML: `
+				pocket_node:
+				  query_node_rpc_url: tcp://127.0.0.1:26657
+				  query_node_grpc_url: tcp://127.0.0.1:9090
+				  tx_node_rpc_url: tcp://127.0.0.1:36659
+				default_signing_key_names: [ supplier1 ]
+				smt_store_path: smt_stores
+				suppliers:
+				  - service_id: ethereum
+				    listen_url: http://127.0.0.1:8080
+				    service_config:
+				      backend_url: http://anvil.servicer:8545
+				      load_balancing_policy: random
+				`,
+
+			expectedErr: config.ErrRelayMinerConfigInvalidSupplier,
+		},
+		{
+			desc: "invalid: applications rate limit burst without requests per second",
+
+			inputConfigYAML: `
+				pocket_node:
+				  query_node_rpc_url: tcp://127.0.0.1:26657
+				  query_node_grpc_url: tcp://127.0.0.1:9090
+				  tx_node_rpc_url: tcp://127.0.0.1:36659
+				default_signing_key_names: [ supplier1 ]
+				smt_store_path: smt_stores
+				suppliers:
+				  - service_id: ethereum
+				    listen_url: http://127.0.0.1:8080
+				    service_config:
+				      backend_url: http://anvil.servicer:8545
+				      rate_limit:
+				        burst: 100
+				`,
+
+			expectedErr: config.ErrRelayMinerConfigInvalidSupplier,
+		},
+		{
+			desc: "invalid: response cache for grpc backend",
+
+			inputConfigYAML: `
+				pocket_node:
+				  query_node_rpc_url: tcp://127.0.0.1:26657
+				  query_node_grpc_url: tcp://127.0.0.1:9090
+				  tx_node_rpc_url: tcp://127.0.0.1:36659
+				default_signing_key_names: [ supplier1 ]
 				smt_store_path: smt_stores
 				suppliers:
-				  - listen_url: http://127.0.0.1:8080
-				    # explicitly missing supplier name
+				  - service_id: ethereum
+				    listen_url: http://127.0.0.1:8080
 				    service_config:
-				      backend_url: http://anvil.servicer:8545
+				      backend_url: grpc://anvil.servicer:9090
+				      response_cache:
+				        method_ttls_seconds:
+				          eth_chainId: 3600
 				`,
 
 			expectedErr: config.ErrRelayMinerConfigInvalidSupplier,
 		},
 		{
-			desc: "invalid: empty supplier name",
+			desc: "invalid: unsupported relay meter over-servicing allowance policy",
 
 			inputConfigYAML: `
 				pocket_node:
@@ -568,16 +1625,19 @@ func Test_ParseRelayMinerConfigs(t *testing.T) {
 				default_signing_key_names: [ supplier1 ]
 				smt_store_path: smt_stores
 				suppliers:
-				  - service_id: # explicitly empty supplier name
+				  - service_id: ethereum
 				    listen_url: http://127.0.0.1:8080
 				    service_config:
 				      backend_url: http://anvil.servicer:8545
+				relay_meter:
+				  over_servicing_allowance:
+				    policy: generous
 				`,
 
-			expectedErr: config.ErrRelayMinerConfigInvalidSupplier,
+			expectedErr: config.ErrRelayMinerConfigInvalidRelayMeter,
 		},
 		{
-			desc: "invalid: bad supplier service config backend url",
+			desc: "invalid: relay meter over-servicing allowance with mismatching policy field",
 
 			inputConfigYAML: `
 				pocket_node:
@@ -590,13 +1650,18 @@ func Test_ParseRelayMinerConfigs(t *testing.T) {
 				  - service_id: ethereum
 				    listen_url: http://127.0.0.1:8080
 				    service_config:
-				      backend_url: &http://anvil.servicer:8545
+				      backend_url: http://anvil.servicer:8545
+				relay_meter:
+				  services_over_servicing_allowance:
+				    ethereum:
+				      policy: fixed
+				      stake_percentage: 10
 				`,
 
-			expectedErr: config.ErrRelayMinerConfigInvalidSupplier,
+			expectedErr: config.ErrRelayMinerConfigInvalidRelayMeter,
 		},
 		{
-			desc: "invalid: empty supplier service config backend url",
+			desc: "invalid: relay meter application both allowed and denied",
 
 			inputConfigYAML: `
 				pocket_node:
@@ -609,13 +1674,16 @@ func Test_ParseRelayMinerConfigs(t *testing.T) {
 				  - service_id: ethereum
 				    listen_url: http://127.0.0.1:8080
 				    service_config:
-				      backend_url: # explicitly empty supplier service config backend url
+				      backend_url: http://anvil.servicer:8545
+				relay_meter:
+				  allowed_applications: [ pokt1app1 ]
+				  denied_applications: [ pokt1app1 ]
 				`,
 
-			expectedErr: config.ErrRelayMinerConfigInvalidSupplier,
+			expectedErr: config.ErrRelayMinerConfigInvalidRelayMeter,
 		},
 		{
-			desc: "invalid: missing supplier service config backend url",
+			desc: "invalid: unsupported relay meter rate limited reply",
 
 			inputConfigYAML: `
 				pocket_node:
@@ -628,9 +1696,251 @@ func Test_ParseRelayMinerConfigs(t *testing.T) {
 				  - service_id: ethereum
 				    listen_url: http://127.0.0.1:8080
 				    service_config:
+				      backend_url: http://anvil.servicer:8545
+				relay_meter:
+				  rate_limited_reply: silent
 				`,
 
-			expectedErr: config.ErrRelayMinerConfigInvalidSupplier,
+			expectedErr: config.ErrRelayMinerConfigInvalidRelayMeter,
+		},
+		{
+			desc: "invalid: admin api enabled without auth token",
+
+			inputConfigYAML: `
+				pocket_node:
+				  query_node_rpc_url: tcp://127.0.0.1:26657
+				  query_node_grpc_url: tcp://127.0.0.1:9090
+				  tx_node_rpc_url: tcp://127.0.0.1:36659
+				default_signing_key_names: [ supplier1 ]
+				smt_store_path: smt_stores
+				suppliers:
+				  - service_id: ethereum
+				    listen_url: http://127.0.0.1:8080
+				    service_config:
+				      backend_url: http://anvil.servicer:8545
+				admin:
+				  enabled: true
+				  addr: localhost:8082
+				`,
+
+			expectedErr: config.ErrRelayMinerConfigInvalidAdmin,
+		},
+		{
+			desc: "invalid: tx resubmission fee bump factor not greater than 1",
+
+			inputConfigYAML: `
+				pocket_node:
+				  query_node_rpc_url: tcp://127.0.0.1:26657
+				  query_node_grpc_url: tcp://127.0.0.1:9090
+				  tx_node_rpc_url: tcp://127.0.0.1:36659
+				default_signing_key_names: [ supplier1 ]
+				smt_store_path: smt_stores
+				suppliers:
+				  - service_id: ethereum
+				    listen_url: http://127.0.0.1:8080
+				    service_config:
+				      backend_url: http://anvil.servicer:8545
+				tx_resubmission:
+				  enabled: true
+				  fee_bump_factor: 1
+				`,
+
+			expectedErr: config.ErrRelayMinerConfigInvalidTxResubmission,
+		},
+		{
+			desc: "invalid: tx resubmission max fee multiplier lower than fee bump factor",
+
+			inputConfigYAML: `
+				pocket_node:
+				  query_node_rpc_url: tcp://127.0.0.1:26657
+				  query_node_grpc_url: tcp://127.0.0.1:9090
+				  tx_node_rpc_url: tcp://127.0.0.1:36659
+				default_signing_key_names: [ supplier1 ]
+				smt_store_path: smt_stores
+				suppliers:
+				  - service_id: ethereum
+				    listen_url: http://127.0.0.1:8080
+				    service_config:
+				      backend_url: http://anvil.servicer:8545
+				tx_resubmission:
+				  enabled: true
+				  fee_bump_factor: 2
+				  max_fee_multiplier: 1.5
+				`,
+
+			expectedErr: config.ErrRelayMinerConfigInvalidTxResubmission,
+		},
+		{
+			desc: "invalid: tx resubmission max gas prices not in upokt",
+
+			inputConfigYAML: `
+				pocket_node:
+				  query_node_rpc_url: tcp://127.0.0.1:26657
+				  query_node_grpc_url: tcp://127.0.0.1:9090
+				  tx_node_rpc_url: tcp://127.0.0.1:36659
+				default_signing_key_names: [ supplier1 ]
+				smt_store_path: smt_stores
+				suppliers:
+				  - service_id: ethereum
+				    listen_url: http://127.0.0.1:8080
+				    service_config:
+				      backend_url: http://anvil.servicer:8545
+				tx_resubmission:
+				  enabled: true
+				  max_gas_prices: 1stake
+				`,
+
+			expectedErr: config.ErrRelayMinerConfigInvalidTxResubmission,
+		},
+		{
+			desc: "invalid: remote signer endpoint without https scheme",
+
+			inputConfigYAML: `
+				pocket_node:
+				  query_node_rpc_url: tcp://127.0.0.1:26657
+				  query_node_grpc_url: tcp://127.0.0.1:9090
+				  tx_node_rpc_url: tcp://127.0.0.1:36659
+				default_signing_key_names: [ supplier1 ]
+				smt_store_path: smt_stores
+				suppliers:
+				  - service_id: ethereum
+				    listen_url: http://127.0.0.1:8080
+				    service_config:
+				      backend_url: http://anvil.servicer:8545
+				remote_signer:
+				  enabled: true
+				  endpoints:
+				    - url: http://signer-1:8085
+				      key_names: [ supplier1 ]
+				  tls:
+				    ca_file: /etc/relayminer/signer/ca.crt
+				    cert_file: /etc/relayminer/signer/tls.crt
+				    key_file: /etc/relayminer/signer/tls.key
+				`,
+
+			expectedErr: config.ErrRelayMinerConfigInvalidRemoteSigner,
+		},
+		{
+			desc: "invalid: remote signer key name served by multiple endpoints",
+
+			inputConfigYAML: `
+				pocket_node:
+				  query_node_rpc_url: tcp://127.0.0.1:26657
+				  query_node_grpc_url: tcp://127.0.0.1:9090
+				  tx_node_rpc_url: tcp://127.0.0.1:36659
+				default_signing_key_names: [ supplier1 ]
+				smt_store_path: smt_stores
+				suppliers:
+				  - service_id: ethereum
+				    listen_url: http://127.0.0.1:8080
+				    service_config:
+				      backend_url: http://anvil.servicer:8545
+				remote_signer:
+				  enabled: true
+				  endpoints:
+				    - url: https://signer-1:8085
+				      key_names: [ supplier1 ]
+				    - url: https://signer-2:8085
+				      key_names: [ supplier1 ]
+				  tls:
+				    ca_file: /etc/relayminer/signer/ca.crt
+				    cert_file: /etc/relayminer/signer/tls.crt
+				    key_file: /etc/relayminer/signer/tls.key
+				`,
+
+			expectedErr: config.ErrRelayMinerConfigInvalidRemoteSigner,
+		},
+		{
+			desc: "invalid: remote signer without client certificate",
+
+			inputConfigYAML: `
+				pocket_node:
+				  query_node_rpc_url: tcp://127.0.0.1:26657
+				  query_node_grpc_url: tcp://127.0.0.1:9090
+				  tx_node_rpc_url: tcp://127.0.0.1:36659
+				default_signing_key_names: [ supplier1 ]
+				smt_store_path: smt_stores
+				suppliers:
+				  - service_id: ethereum
+				    listen_url: http://127.0.0.1:8080
+				    service_config:
+				      backend_url: http://anvil.servicer:8545
+				remote_signer:
+				  enabled: true
+				  endpoints:
+				    - url: https://signer-1:8085
+				      key_names: [ supplier1 ]
+				  tls:
+				    ca_file: /etc/relayminer/signer/ca.crt
+				`,
+
+			expectedErr: config.ErrRelayMinerConfigInvalidRemoteSigner,
+		},
+		{
+			desc: "invalid: light client without trusted hash",
+
+			inputConfigYAML: `
+				pocket_node:
+				  query_node_rpc_url: tcp://127.0.0.1:26657
+				  query_node_grpc_url: tcp://127.0.0.1:9090
+				  tx_node_rpc_url: tcp://127.0.0.1:36659
+				  light_client:
+				    enabled: true
+				    chain_id: pocket
+				    trusted_height: 1000
+				default_signing_key_names: [ supplier1 ]
+				smt_store_path: smt_stores
+				suppliers:
+				  - service_id: ethereum
+				    listen_url: http://127.0.0.1:8080
+				    service_config:
+				      backend_url: http://anvil.servicer:8545
+				`,
+
+			expectedErr: config.ErrRelayMinerConfigInvalidLightClient,
+		},
+		{
+			desc: "invalid: light client without chain id",
+
+			inputConfigYAML: `
+				pocket_node:
+				  query_node_rpc_url: tcp://127.0.0.1:26657
+				  query_node_grpc_url: tcp://127.0.0.1:9090
+				  tx_node_rpc_url: tcp://127.0.0.1:36659
+				  light_client:
+				    enabled: true
+				    trusted_height: 1000
+				    trusted_hash: 0E6EA7B5D4A0D63BC1A6D3B3BC5A18F47E5F0C4AA8D4F4E1D6E2F0C44E1B9A77
+				default_signing_key_names: [ supplier1 ]
+				smt_store_path: smt_stores
+				suppliers:
+				  - service_id: ethereum
+				    listen_url: http://127.0.0.1:8080
+				    service_config:
+				      backend_url: http://anvil.servicer:8545
+				`,
+
+			expectedErr: config.ErrRelayMinerConfigInvalidLightClient,
+		},
+		{
+			desc: "invalid: fallback query node grpc url duplicating the primary one",
+
+			inputConfigYAML: `
+				pocket_node:
+				  query_node_rpc_url: tcp://127.0.0.1:26657
+				  query_node_grpc_url: tcp://127.0.0.1:9090
+				  tx_node_rpc_url: tcp://127.0.0.1:36659
+				  fallback_query_node_grpc_urls: [ tcp://fallback1:9090, tcp://127.0.0.1:9090 ]
+				default_signing_key_names: [ supplier1 ]
+				smt_store_path: smt_stores
+				suppliers:
+				  - service_id: ethereum
+				    listen_url: http://127.0.0.1:8080
+				    service_config:
+				      backend_url: http://anvil.servicer:8545
+				`,
+
+			expectedErr: config.ErrRelayMinerConfigInvalidNodeUrl,
 		},
 		{
 			desc: "invalid: empty RelayMiner config file",
@@ -674,6 +1984,70 @@ func Test_ParseRelayMinerConfigs(t *testing.T) {
 				config.SmtStorePath,
 			)
 
+			if test.expectedConfig.RelayMeter != nil {
+				require.Equal(
+					t,
+					test.expectedConfig.RelayMeter,
+					config.RelayMeter,
+				)
+			}
+
+			if test.expectedConfig.Admin != nil {
+				require.Equal(
+					t,
+					test.expectedConfig.Admin,
+					config.Admin,
+				)
+			}
+
+			if test.expectedConfig.TxResubmission != nil {
+				require.Equal(
+					t,
+					test.expectedConfig.TxResubmission,
+					config.TxResubmission,
+				)
+			}
+
+			if test.expectedConfig.RemoteSigner != nil {
+				require.Equal(
+					t,
+					test.expectedConfig.RemoteSigner,
+					config.RemoteSigner,
+				)
+			}
+
+			if test.expectedConfig.PocketNode.LightClient != nil {
+				require.Equal(
+					t,
+					test.expectedConfig.PocketNode.LightClient,
+					config.PocketNode.LightClient,
+				)
+			}
+
+			if test.expectedConfig.PocketNode.FallbackQueryNodeRPCUrls != nil {
+				require.Equal(
+					t,
+					test.expectedConfig.PocketNode.FallbackQueryNodeRPCUrls,
+					config.PocketNode.FallbackQueryNodeRPCUrls,
+				)
+			}
+
+			if test.expectedConfig.PocketNode.FallbackQueryNodeGRPCUrls != nil {
+				require.Equal(
+					t,
+					test.expectedConfig.PocketNode.FallbackQueryNodeGRPCUrls,
+					config.PocketNode.FallbackQueryNodeGRPCUrls,
+				)
+			}
+
+			if test.expectedConfig.PocketNode.FallbackTxNodeRPCUrls != nil {
+				require.Equal(
+					t,
+					test.expectedConfig.PocketNode.FallbackTxNodeRPCUrls,
+					config.PocketNode.FallbackTxNodeRPCUrls,
+				)
+			}
+
 			require.Equal(
 				t,
 				test.expectedConfig.PocketNode.QueryNodeGRPCUrl.String(),
@@ -705,6 +2079,12 @@ func Test_ParseRelayMinerConfigs(t *testing.T) {
 					config.Servers[listenAddress].ServerType,
 				)
 
+				require.Equal(
+					t,
+					server.TLS,
+					config.Servers[listenAddress].TLS,
+				)
+
 				for supplierOperatorName, supplier := range server.SupplierConfigsMap {
 					require.Equal(
 						t,
@@ -718,6 +2098,12 @@ func Test_ParseRelayMinerConfigs(t *testing.T) {
 						config.Servers[listenAddress].SupplierConfigsMap[supplierOperatorName].ServerType,
 					)
 
+					require.Equal(
+						t,
+						supplier.BackendType,
+						config.Servers[listenAddress].SupplierConfigsMap[supplierOperatorName].BackendType,
+					)
+
 					require.Equal(
 						t,
 						supplier.ServiceConfig.BackendUrl.String(),
@@ -743,6 +2129,44 @@ func Test_ParseRelayMinerConfigs(t *testing.T) {
 						)
 					}
 
+					// Only the test cases specifying the backend client settings are
+					// expected to differ from the defaults.
+					if supplier.ServiceConfig.RequestTimeout != 0 {
+						actualServiceConfig := config.Servers[listenAddress].SupplierConfigsMap[supplierOperatorName].ServiceConfig
+						require.Equal(t, supplier.ServiceConfig.MaxIdleConns, actualServiceConfig.MaxIdleConns)
+						require.Equal(t, supplier.ServiceConfig.DialTimeout, actualServiceConfig.DialTimeout)
+						require.Equal(t, supplier.ServiceConfig.ResponseHeaderTimeout, actualServiceConfig.ResponseHeaderTimeout)
+						require.Equal(t, supplier.ServiceConfig.RequestTimeout, actualServiceConfig.RequestTimeout)
+						require.Equal(t, supplier.ServiceConfig.MaxRetries, actualServiceConfig.MaxRetries)
+						require.Equal(t, supplier.ServiceConfig.RetryBackoff, actualServiceConfig.RetryBackoff)
+						require.Equal(t, supplier.ServiceConfig.IdempotentJSONRPCMethods, actualServiceConfig.IdempotentJSONRPCMethods)
+					}
+
+					// Only the test cases specifying several backends are expected
+					// to differ from the defaults.
+					if len(supplier.ServiceConfig.Backends) > 0 {
+						actualServiceConfig := config.Servers[listenAddress].SupplierConfigsMap[supplierOperatorName].ServiceConfig
+						require.Len(t, actualServiceConfig.Backends, len(supplier.ServiceConfig.Backends))
+						for i, backend := range supplier.ServiceConfig.Backends {
+							require.Equal(t, backend.Url.String(), actualServiceConfig.Backends[i].Url.String())
+							require.Equal(t, backend.Weight, actualServiceConfig.Backends[i].Weight)
+						}
+						require.Equal(t, supplier.ServiceConfig.LoadBalancingPolicy, actualServiceConfig.LoadBalancingPolicy)
+						require.Equal(t, supplier.ServiceConfig.HealthCheck, actualServiceConfig.HealthCheck)
+					}
+
+					require.Equal(
+						t,
+						supplier.ServiceConfig.RateLimit,
+						config.Servers[listenAddress].SupplierConfigsMap[supplierOperatorName].ServiceConfig.RateLimit,
+					)
+
+					require.Equal(
+						t,
+						supplier.ServiceConfig.ResponseCache,
+						config.Servers[listenAddress].SupplierConfigsMap[supplierOperatorName].ServiceConfig.ResponseCache,
+					)
+
 					for headerKey, headerValue := range supplier.ServiceConfig.Headers {
 						require.Equal(
 							t,
@@ -0,0 +1,79 @@
+package config
+
+import "net/url"
+
+// HydrateRemoteSigner populates the remote signer fields of the RelayMinerConfig
+// that are relevant to the "remote_signer" section in the config file.
+// Remote signing services MUST be reached over mTLS, and each key name MUST be
+// served by a single remote signing service.
+func (relayMinerConfig *RelayMinerConfig) HydrateRemoteSigner(
+	yamlRemoteSignerConfig *YAMLRelayMinerRemoteSignerConfig,
+) error {
+	relayMinerConfig.RemoteSigner = &RelayMinerRemoteSignerConfig{
+		Enabled: yamlRemoteSignerConfig.Enabled,
+	}
+
+	if !yamlRemoteSignerConfig.Enabled {
+		return nil
+	}
+
+	if len(yamlRemoteSignerConfig.Endpoints) == 0 {
+		return ErrRelayMinerConfigInvalidRemoteSigner.Wrap("at least one endpoint is required")
+	}
+
+	yamlTLSConfig := yamlRemoteSignerConfig.TLS
+	if len(yamlTLSConfig.CAFile) == 0 || len(yamlTLSConfig.CertFile) == 0 || len(yamlTLSConfig.KeyFile) == 0 {
+		return ErrRelayMinerConfigInvalidRemoteSigner.Wrap("tls ca_file, cert_file and key_file are required")
+	}
+
+	relayMinerConfig.RemoteSigner.CAFile = yamlTLSConfig.CAFile
+	relayMinerConfig.RemoteSigner.CertFile = yamlTLSConfig.CertFile
+	relayMinerConfig.RemoteSigner.KeyFile = yamlTLSConfig.KeyFile
+
+	routedKeyNames := make(map[string]struct{})
+	for _, yamlEndpoint := range yamlRemoteSignerConfig.Endpoints {
+		endpointUrl, err := url.Parse(yamlEndpoint.Url)
+		if err != nil {
+			return ErrRelayMinerConfigInvalidRemoteSigner.Wrapf("invalid endpoint url %q: %v", yamlEndpoint.Url, err)
+		}
+		if endpointUrl.Scheme != "https" {
+			return ErrRelayMinerConfigInvalidRemoteSigner.Wrapf(
+				"endpoint url %q must use the https scheme",
+				yamlEndpoint.Url,
+			)
+		}
+
+		if len(yamlEndpoint.KeyNames) == 0 {
+			return ErrRelayMinerConfigInvalidRemoteSigner.Wrapf(
+				"endpoint %q has no key names",
+				yamlEndpoint.Url,
+			)
+		}
+
+		for _, keyName := range yamlEndpoint.KeyNames {
+			if len(keyName) == 0 {
+				return ErrRelayMinerConfigInvalidRemoteSigner.Wrapf(
+					"empty key name for endpoint %q",
+					yamlEndpoint.Url,
+				)
+			}
+			if _, ok := routedKeyNames[keyName]; ok {
+				return ErrRelayMinerConfigInvalidRemoteSigner.Wrapf(
+					"key name %q is served by multiple endpoints",
+					keyName,
+				)
+			}
+			routedKeyNames[keyName] = struct{}{}
+		}
+
+		relayMinerConfig.RemoteSigner.Endpoints = append(
+			relayMinerConfig.RemoteSigner.Endpoints,
+			&RelayMinerRemoteSignerEndpoint{
+				Url:      endpointUrl,
+				KeyNames: yamlEndpoint.KeyNames,
+			},
+		)
+	}
+
+	return nil
+}
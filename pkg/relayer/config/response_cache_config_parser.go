@@ -0,0 +1,56 @@
+package config
+
+import (
+	"net/url"
+	"time"
+)
+
+// DefaultResponseCacheMaxEntries is the default maximum number of responses
+// cached per JSON-RPC method.
+const DefaultResponseCacheMaxEntries = 10000
+
+// parseSupplierResponseCache returns the configuration of the service's backend
+// response cache, or nil if no JSON-RPC method is configured to be cached.
+// Only the responses of HTTP backends can be cached.
+func parseSupplierResponseCache(
+	yamlResponseCache YAMLRelayMinerSupplierServiceResponseCache,
+	backendUrl *url.URL,
+) (*RelayMinerSupplierServiceResponseCache, error) {
+	if len(yamlResponseCache.MethodTTLsSeconds) == 0 {
+		if yamlResponseCache.MaxEntries > 0 {
+			return nil, ErrRelayMinerConfigInvalidSupplier.Wrap(
+				"supplier response cache max_entries requires method_ttls_seconds to be set",
+			)
+		}
+		return nil, nil
+	}
+
+	if isGRPCScheme(backendUrl.Scheme) {
+		return nil, ErrRelayMinerConfigInvalidSupplier.Wrapf(
+			"supplier response cache is not supported for gRPC backend %q",
+			backendUrl.String(),
+		)
+	}
+
+	responseCache := &RelayMinerSupplierServiceResponseCache{
+		MaxEntries: DefaultResponseCacheMaxEntries,
+		MethodTTLs: make(map[string]time.Duration, len(yamlResponseCache.MethodTTLsSeconds)),
+	}
+
+	if yamlResponseCache.MaxEntries > 0 {
+		responseCache.MaxEntries = yamlResponseCache.MaxEntries
+	}
+
+	for method, ttlSeconds := range yamlResponseCache.MethodTTLsSeconds {
+		if len(method) == 0 || ttlSeconds == 0 {
+			return nil, ErrRelayMinerConfigInvalidSupplier.Wrapf(
+				"invalid supplier response cache ttl %d for method %q",
+				ttlSeconds,
+				method,
+			)
+		}
+		responseCache.MethodTTLs[method] = time.Duration(ttlSeconds) * time.Second
+	}
+
+	return responseCache, nil
+}
@@ -29,7 +29,22 @@ func (relayMinerConfig *RelayMinerConfig) HydrateServers(
 			)
 		}
 
-		if _, ok := relayMinerConfig.Servers[yamlSupplierConfig.ListenUrl]; ok {
+		if serverConfig, ok := relayMinerConfig.Servers[yamlSupplierConfig.ListenUrl]; ok {
+			// Suppliers sharing a listen url are served by the same server, so they
+			// MUST agree on its TLS configuration.
+			if serverConfig.ServerType == RelayMinerServerTypeHTTPS {
+				supplierServerConfig := &RelayMinerServerConfig{}
+				if err := supplierServerConfig.parseHTTPSServerConfig(yamlSupplierConfig); err != nil {
+					return err
+				}
+
+				if *supplierServerConfig.TLS != *serverConfig.TLS {
+					return ErrRelayMinerConfigInvalidServerTLS.Wrapf(
+						"suppliers sharing listen url %q have different tls configs",
+						yamlSupplierConfig.ListenUrl,
+					)
+				}
+			}
 			continue
 		}
 
@@ -45,6 +60,14 @@ func (relayMinerConfig *RelayMinerConfig) HydrateServers(
 				return err
 			}
 			serverConfig.ServerType = RelayMinerServerTypeHTTP
+		case "https", "wss":
+			if err := serverConfig.parseHTTPServerConfig(yamlSupplierConfig); err != nil {
+				return err
+			}
+			if err := serverConfig.parseHTTPSServerConfig(yamlSupplierConfig); err != nil {
+				return err
+			}
+			serverConfig.ServerType = RelayMinerServerTypeHTTPS
 		default:
 			// Fail if the relay miner server type is not supported
 			return ErrRelayMinerConfigInvalidServer.Wrapf(
@@ -18,7 +18,10 @@ func (supplierConfig *RelayMinerSupplierConfig) HydrateSupplier(
 	// `HydrateSuppliers` is a part of `pkg/relayer/config/suppliers_config_hydrator.go`.
 	supplierConfig.SigningKeyNames = yamlSupplierConfig.SigningKeyNames
 
-	backendUrl, err := url.Parse(yamlSupplierConfig.ServiceConfig.BackendUrl)
+	// The supplier type is inferred from the first backend, all the other ones
+	// being required to be of the same type.
+	primaryBackendUrl := yamlSupplierConfig.ServiceConfig.primaryBackendUrl()
+	backendUrl, err := url.Parse(primaryBackendUrl)
 	if err != nil {
 		return ErrRelayMinerConfigInvalidSupplier.Wrapf(
 			"invalid supplier backend url %s",
@@ -29,7 +32,7 @@ func (supplierConfig *RelayMinerSupplierConfig) HydrateSupplier(
 	if backendUrl.Scheme == "" {
 		return ErrRelayMinerConfigInvalidSupplier.Wrapf(
 			"missing scheme in supplier backend url %s",
-			yamlSupplierConfig.ServiceConfig.BackendUrl,
+			primaryBackendUrl,
 		)
 	}
 
@@ -41,6 +44,15 @@ func (supplierConfig *RelayMinerSupplierConfig) HydrateSupplier(
 	switch backendUrl.Scheme {
 	case "http", "https", "ws", "wss":
 		supplierConfig.ServerType = RelayMinerServerTypeHTTP
+		supplierConfig.BackendType = RelayMinerSupplierBackendTypeHTTP
+		if err := supplierConfig.ServiceConfig.
+			parseSupplierBackendUrl(yamlSupplierConfig.ServiceConfig); err != nil {
+			return err
+		}
+	case "grpc", "grpcs":
+		// gRPC relays are received by "http" or "https" servers as well.
+		supplierConfig.ServerType = RelayMinerServerTypeHTTP
+		supplierConfig.BackendType = RelayMinerSupplierBackendTypeGRPC
 		if err := supplierConfig.ServiceConfig.
 			parseSupplierBackendUrl(yamlSupplierConfig.ServiceConfig); err != nil {
 			return err
@@ -0,0 +1,98 @@
+package config
+
+import (
+	cosmostypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/pokt-network/poktroll/app/volatile"
+)
+
+const (
+	// DefaultTxResubmissionAttemptTimeoutBlocks is the default number of blocks
+	// after which a claim or proof transaction that is not committed is resubmitted.
+	DefaultTxResubmissionAttemptTimeoutBlocks = 2
+	// DefaultTxResubmissionFeeBumpFactor is the default factor by which the fee
+	// is multiplied on each resubmission.
+	DefaultTxResubmissionFeeBumpFactor = 1.5
+	// DefaultTxResubmissionMaxFeeMultiplier is the default ceiling of the
+	// cumulative fee multiplier.
+	DefaultTxResubmissionMaxFeeMultiplier = 3
+)
+
+// HydrateTxResubmission populates the tx resubmission fields of the RelayMinerConfig
+// that are relevant to the "tx_resubmission" section in the config file.
+// Unspecified fields default to their Default* values when the resubmission is enabled.
+func (relayMinerConfig *RelayMinerConfig) HydrateTxResubmission(
+	yamlTxResubmissionConfig *YAMLRelayMinerTxResubmissionConfig,
+) error {
+	relayMinerConfig.TxResubmission = &RelayMinerTxResubmissionConfig{
+		Enabled:              yamlTxResubmissionConfig.Enabled,
+		AttemptTimeoutBlocks: DefaultTxResubmissionAttemptTimeoutBlocks,
+		FeeBumpFactor:        DefaultTxResubmissionFeeBumpFactor,
+		MaxFeeMultiplier:     DefaultTxResubmissionMaxFeeMultiplier,
+	}
+
+	if !yamlTxResubmissionConfig.Enabled {
+		return nil
+	}
+
+	if yamlTxResubmissionConfig.AttemptTimeoutBlocks < 0 {
+		return ErrRelayMinerConfigInvalidTxResubmission.Wrapf(
+			"attempt_timeout_blocks must be positive, got %d",
+			yamlTxResubmissionConfig.AttemptTimeoutBlocks,
+		)
+	}
+	if yamlTxResubmissionConfig.AttemptTimeoutBlocks > 0 {
+		relayMinerConfig.TxResubmission.AttemptTimeoutBlocks = yamlTxResubmissionConfig.AttemptTimeoutBlocks
+	}
+
+	if yamlTxResubmissionConfig.FeeBumpFactor < 0 ||
+		(yamlTxResubmissionConfig.FeeBumpFactor > 0 && yamlTxResubmissionConfig.FeeBumpFactor <= 1) {
+		return ErrRelayMinerConfigInvalidTxResubmission.Wrapf(
+			"fee_bump_factor must be greater than 1, got %f",
+			yamlTxResubmissionConfig.FeeBumpFactor,
+		)
+	}
+	if yamlTxResubmissionConfig.FeeBumpFactor > 0 {
+		relayMinerConfig.TxResubmission.FeeBumpFactor = yamlTxResubmissionConfig.FeeBumpFactor
+	}
+
+	if yamlTxResubmissionConfig.MaxFeeMultiplier < 0 {
+		return ErrRelayMinerConfigInvalidTxResubmission.Wrapf(
+			"max_fee_multiplier must be positive, got %f",
+			yamlTxResubmissionConfig.MaxFeeMultiplier,
+		)
+	}
+	if yamlTxResubmissionConfig.MaxFeeMultiplier > 0 {
+		relayMinerConfig.TxResubmission.MaxFeeMultiplier = yamlTxResubmissionConfig.MaxFeeMultiplier
+	}
+
+	// The fee bump would be capped before the first resubmission.
+	if relayMinerConfig.TxResubmission.MaxFeeMultiplier < relayMinerConfig.TxResubmission.FeeBumpFactor {
+		return ErrRelayMinerConfigInvalidTxResubmission.Wrapf(
+			"max_fee_multiplier (%f) must be greater than or equal to fee_bump_factor (%f)",
+			relayMinerConfig.TxResubmission.MaxFeeMultiplier,
+			relayMinerConfig.TxResubmission.FeeBumpFactor,
+		)
+	}
+
+	if yamlTxResubmissionConfig.MaxGasPrices != "" {
+		maxGasPrices, err := cosmostypes.ParseDecCoins(yamlTxResubmissionConfig.MaxGasPrices)
+		if err != nil {
+			return ErrRelayMinerConfigInvalidTxResubmission.Wrapf(
+				"invalid max_gas_prices %q: %s",
+				yamlTxResubmissionConfig.MaxGasPrices, err,
+			)
+		}
+
+		// Onchain fees can only be paid in upokt.
+		if len(maxGasPrices) != 1 || maxGasPrices[0].Denom != volatile.DenomuPOKT {
+			return ErrRelayMinerConfigInvalidTxResubmission.Wrapf(
+				"max_gas_prices must be a single %s amount, got %q",
+				volatile.DenomuPOKT, yamlTxResubmissionConfig.MaxGasPrices,
+			)
+		}
+		relayMinerConfig.TxResubmission.MaxGasPrices = maxGasPrices
+	}
+
+	return nil
+}
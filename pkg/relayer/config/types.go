@@ -1,13 +1,18 @@
 package config
 
-import "net/url"
+import (
+	"net/url"
+	"time"
+
+	cosmostypes "github.com/cosmos/cosmos-sdk/types"
+)
 
 type RelayMinerServerType int
 
 const (
 	RelayMinerServerTypeHTTP RelayMinerServerType = iota
+	RelayMinerServerTypeHTTPS
 	// TODO_FUTURE: Support other RelayMinerServerType:
-	// RelayMinerServerTypeHTTPS
 	// RelayMinerServerTypeTCP
 	// RelayMinerServerTypeUDP
 	// RelayMinerServerTypeQUIC
@@ -16,15 +21,99 @@ const (
 	// Etc...
 )
 
+// RelayMinerSupplierBackendType is the protocol used by a supplier to forward
+// the relays it receives to its backends, independently of the type of the
+// relay miner server receiving them.
+type RelayMinerSupplierBackendType int
+
+const (
+	// RelayMinerSupplierBackendTypeHTTP designates http(s) and ws(s) backends,
+	// which the relays are forwarded to as HTTP requests or websocket messages.
+	RelayMinerSupplierBackendTypeHTTP RelayMinerSupplierBackendType = iota
+	// RelayMinerSupplierBackendTypeGRPC designates grpc(s) backends, which the
+	// relays are forwarded to as gRPC calls.
+	RelayMinerSupplierBackendTypeGRPC
+)
+
+// LoadBalancingPolicy is the policy used to select which of a supplier service's
+// backends a relay is forwarded to.
+type LoadBalancingPolicy int
+
+const (
+	// LoadBalancingPolicyRoundRobin forwards the relays to each healthy backend in turn.
+	LoadBalancingPolicyRoundRobin LoadBalancingPolicy = iota
+	// LoadBalancingPolicyLeastInFlight forwards the relays to the healthy backend
+	// with the fewest relays currently being served.
+	LoadBalancingPolicyLeastInFlight
+	// LoadBalancingPolicyWeighted forwards the relays to the healthy backends
+	// proportionally to their configured weights.
+	LoadBalancingPolicyWeighted
+)
+
+// OverServicingAllowancePolicy is the policy used to determine how much a
+// supplier is willing to over-service an application beyond the portion of its
+// stake that the supplier can claim for a session.
+type OverServicingAllowancePolicy int
+
+const (
+	// OverServicingAllowancePolicyFixed allows over-servicing an application up
+	// to a fixed amount of uPOKT per session.
+	OverServicingAllowancePolicyFixed OverServicingAllowancePolicy = iota
+	// OverServicingAllowancePolicyStakePercentage allows over-servicing an
+	// application up to a percentage of the portion of its stake that the
+	// supplier can claim for a session.
+	OverServicingAllowancePolicyStakePercentage
+	// OverServicingAllowancePolicyUnlimited allows over-servicing an application
+	// without any limit.
+	OverServicingAllowancePolicyUnlimited
+	// OverServicingAllowancePolicyNone does not allow over-servicing an application.
+	OverServicingAllowancePolicyNone
+)
+
+// RateLimitedReplyPolicy is the policy used to reply to the relay requests of
+// an application which has been rate limited by the relay meter.
+type RateLimitedReplyPolicy int
+
+const (
+	// RateLimitedReplyPolicyTooManyRequests replies with an HTTP 429 status code
+	// and a Retry-After header pointing at the start of the next session.
+	RateLimitedReplyPolicyTooManyRequests RateLimitedReplyPolicy = iota
+	// RateLimitedReplyPolicyRelayError replies with a relay error, the same way
+	// as any other relay serving error.
+	RateLimitedReplyPolicyRelayError
+)
+
 // YAMLRelayMinerConfig is the structure used to unmarshal the RelayMiner config file
 type YAMLRelayMinerConfig struct {
-	DefaultSigningKeyNames []string                       `yaml:"default_signing_key_names"`
-	Metrics                YAMLRelayMinerMetricsConfig    `yaml:"metrics"`
-	PocketNode             YAMLRelayMinerPocketNodeConfig `yaml:"pocket_node"`
-	Pprof                  YAMLRelayMinerPprofConfig      `yaml:"pprof"`
-	SmtStorePath           string                         `yaml:"smt_store_path"`
-	Suppliers              []YAMLRelayMinerSupplierConfig `yaml:"suppliers"`
-	Ping                   YAMLRelayMinerPingConfig       `yaml:"ping"`
+	DefaultSigningKeyNames []string                           `yaml:"default_signing_key_names"`
+	Metrics                YAMLRelayMinerMetricsConfig        `yaml:"metrics"`
+	PocketNode             YAMLRelayMinerPocketNodeConfig     `yaml:"pocket_node"`
+	Pprof                  YAMLRelayMinerPprofConfig          `yaml:"pprof"`
+	SmtStorePath           string                             `yaml:"smt_store_path"`
+	Suppliers              []YAMLRelayMinerSupplierConfig     `yaml:"suppliers"`
+	Ping                   YAMLRelayMinerPingConfig           `yaml:"ping"`
+	RelayMeter             YAMLRelayMinerRelayMeterConfig     `yaml:"relay_meter,omitempty"`
+	Admin                  YAMLRelayMinerAdminConfig          `yaml:"admin,omitempty"`
+	TxResubmission         YAMLRelayMinerTxResubmissionConfig `yaml:"tx_resubmission,omitempty"`
+	RemoteSigner           YAMLRelayMinerRemoteSignerConfig   `yaml:"remote_signer,omitempty"`
+}
+
+// YAMLRelayMinerRelayMeterConfig is the structure used to unmarshal the relay
+// meter section of the RelayMiner config file.
+type YAMLRelayMinerRelayMeterConfig struct {
+	OverServicingAllowance         YAMLRelayMinerOverServicingAllowance            `yaml:"over_servicing_allowance,omitempty"`
+	ServicesOverServicingAllowance map[string]YAMLRelayMinerOverServicingAllowance `yaml:"services_over_servicing_allowance,omitempty"`
+	AllowedApplications            []string                                        `yaml:"allowed_applications,omitempty"`
+	DeniedApplications             []string                                        `yaml:"denied_applications,omitempty"`
+	RateLimitedReply               string                                          `yaml:"rate_limited_reply,omitempty"`
+}
+
+// YAMLRelayMinerOverServicingAllowance is the structure used to unmarshal an
+// over-servicing allowance of the relay meter section of the RelayMiner config file.
+type YAMLRelayMinerOverServicingAllowance struct {
+	Policy          string `yaml:"policy"`
+	Upokt           uint64 `yaml:"upokt,omitempty"`
+	StakePercentage uint64 `yaml:"stake_percentage,omitempty"`
 }
 
 // YAMLRelayMinerPingConfig represents the configuration to expose a ping server.
@@ -40,6 +129,24 @@ type YAMLRelayMinerPocketNodeConfig struct {
 	QueryNodeRPCUrl  string `yaml:"query_node_rpc_url"`
 	QueryNodeGRPCUrl string `yaml:"query_node_grpc_url"`
 	TxNodeRPCUrl     string `yaml:"tx_node_rpc_url"`
+	// The fallback URLs are failed over to, in order, when the URLs above are
+	// unreachable or lagging behind.
+	FallbackQueryNodeRPCUrls  []string                        `yaml:"fallback_query_node_rpc_urls,omitempty"`
+	FallbackQueryNodeGRPCUrls []string                        `yaml:"fallback_query_node_grpc_urls,omitempty"`
+	FallbackTxNodeRPCUrls     []string                        `yaml:"fallback_tx_node_rpc_urls,omitempty"`
+	LightClient               YAMLRelayMinerLightClientConfig `yaml:"light_client,omitempty"`
+}
+
+// YAMLRelayMinerLightClientConfig is the structure used to unmarshal the light
+// client sub-section of the pocket node section of the RelayMiner config file,
+// which enables the verification of the query node responses.
+type YAMLRelayMinerLightClientConfig struct {
+	Enabled               bool     `yaml:"enabled,omitempty"`
+	ChainId               string   `yaml:"chain_id,omitempty"`
+	TrustedHeight         int64    `yaml:"trusted_height,omitempty"`
+	TrustedHash           string   `yaml:"trusted_hash,omitempty"`
+	TrustingPeriodSeconds uint64   `yaml:"trusting_period_seconds,omitempty"`
+	WitnessRPCUrls        []string `yaml:"witness_rpc_urls,omitempty"`
 }
 
 // YAMLRelayMinerMetricsConfig is the structure used to unmarshal the metrics
@@ -57,14 +164,68 @@ type YAMLRelayMinerSupplierConfig struct {
 	ServiceId            string                              `yaml:"service_id"`
 	SigningKeyNames      []string                            `yaml:"signing_key_names"`
 	XForwardedHostLookup bool                                `yaml:"x_forwarded_host_lookup"`
+	TLS                  YAMLRelayMinerServerTLSConfig       `yaml:"tls,omitempty"`
+}
+
+// YAMLRelayMinerServerTLSConfig is the structure used to unmarshal the TLS
+// sub-section of a supplier whose listen url uses the "https" scheme.
+type YAMLRelayMinerServerTLSConfig struct {
+	CertFile              string `yaml:"cert_file"`
+	KeyFile               string `yaml:"key_file"`
+	ClientCAFile          string `yaml:"client_ca_file,omitempty"`
+	ReloadIntervalSeconds uint64 `yaml:"reload_interval_seconds,omitempty"`
 }
 
 // YAMLRelayMinerSupplierServiceConfig is the structure used to unmarshal the supplier
 // service sub-section of the RelayMiner config file.
 type YAMLRelayMinerSupplierServiceConfig struct {
-	Authentication YAMLRelayMinerSupplierServiceAuthentication `yaml:"authentication,omitempty"`
-	BackendUrl     string                                      `yaml:"backend_url"`
-	Headers        map[string]string                           `yaml:"headers,omitempty"`
+	Authentication           YAMLRelayMinerSupplierServiceAuthentication `yaml:"authentication,omitempty"`
+	BackendUrl               string                                      `yaml:"backend_url"`
+	Headers                  map[string]string                           `yaml:"headers,omitempty"`
+	MaxIdleConns             uint64                                      `yaml:"max_idle_conns,omitempty"`
+	DialTimeoutMs            uint64                                      `yaml:"dial_timeout_ms,omitempty"`
+	ResponseHeaderTimeoutMs  uint64                                      `yaml:"response_header_timeout_ms,omitempty"`
+	RequestTimeoutMs         uint64                                      `yaml:"request_timeout_ms,omitempty"`
+	MaxRetries               uint64                                      `yaml:"max_retries,omitempty"`
+	RetryBackoffMs           uint64                                      `yaml:"retry_backoff_ms,omitempty"`
+	IdempotentJSONRPCMethods []string                                    `yaml:"idempotent_jsonrpc_methods,omitempty"`
+	Backends                 []YAMLRelayMinerSupplierServiceBackend      `yaml:"backends,omitempty"`
+	LoadBalancingPolicy      string                                      `yaml:"load_balancing_policy,omitempty"`
+	HealthCheck              YAMLRelayMinerSupplierServiceHealthCheck    `yaml:"health_check,omitempty"`
+	RateLimit                YAMLRelayMinerSupplierServiceRateLimit      `yaml:"rate_limit,omitempty"`
+	ResponseCache            YAMLRelayMinerSupplierServiceResponseCache  `yaml:"response_cache,omitempty"`
+}
+
+// YAMLRelayMinerSupplierServiceResponseCache is the structure used to unmarshal
+// the supplier service response cache sub-section of the RelayMiner config file.
+type YAMLRelayMinerSupplierServiceResponseCache struct {
+	MaxEntries        uint64            `yaml:"max_entries,omitempty"`
+	MethodTTLsSeconds map[string]uint64 `yaml:"method_ttls_seconds,omitempty"`
+}
+
+// YAMLRelayMinerSupplierServiceRateLimit is the structure used to unmarshal the
+// supplier service applications rate limit sub-section of the RelayMiner config file.
+type YAMLRelayMinerSupplierServiceRateLimit struct {
+	RequestsPerSecond uint64 `yaml:"requests_per_second,omitempty"`
+	Burst             uint64 `yaml:"burst,omitempty"`
+	MaxInFlight       uint64 `yaml:"max_in_flight,omitempty"`
+}
+
+// YAMLRelayMinerSupplierServiceBackend is the structure used to unmarshal an
+// entry of the supplier service backends list of the RelayMiner config file.
+type YAMLRelayMinerSupplierServiceBackend struct {
+	Url    string `yaml:"url"`
+	Weight uint64 `yaml:"weight,omitempty"`
+}
+
+// YAMLRelayMinerSupplierServiceHealthCheck is the structure used to unmarshal
+// the supplier service backends health check sub-section of the RelayMiner
+// config file.
+type YAMLRelayMinerSupplierServiceHealthCheck struct {
+	IntervalSeconds    uint64 `yaml:"interval_seconds,omitempty"`
+	TimeoutMs          uint64 `yaml:"timeout_ms,omitempty"`
+	UnhealthyThreshold uint64 `yaml:"unhealthy_threshold,omitempty"`
+	HealthyThreshold   uint64 `yaml:"healthy_threshold,omitempty"`
 }
 
 // YAMLRelayMinerSupplierServiceAuthentication is the structure used to unmarshal
@@ -82,6 +243,47 @@ type YAMLRelayMinerPprofConfig struct {
 	Addr    string `yaml:"addr,omitempty"`
 }
 
+// YAMLRelayMinerAdminConfig is the structure used to unmarshal the config
+// for the `admin` API server.
+type YAMLRelayMinerAdminConfig struct {
+	Enabled   bool   `yaml:"enabled,omitempty"`
+	Addr      string `yaml:"addr,omitempty"`
+	AuthToken string `yaml:"auth_token,omitempty"`
+}
+
+// YAMLRelayMinerTxResubmissionConfig is the structure used to unmarshal the config
+// for the `tx_resubmission` of the claim and proof transactions.
+type YAMLRelayMinerTxResubmissionConfig struct {
+	Enabled              bool    `yaml:"enabled,omitempty"`
+	AttemptTimeoutBlocks int64   `yaml:"attempt_timeout_blocks,omitempty"`
+	FeeBumpFactor        float64 `yaml:"fee_bump_factor,omitempty"`
+	MaxFeeMultiplier     float64 `yaml:"max_fee_multiplier,omitempty"`
+	MaxGasPrices         string  `yaml:"max_gas_prices,omitempty"`
+}
+
+// YAMLRelayMinerRemoteSignerConfig is the structure used to unmarshal the config
+// for the `remote_signer` services signing on behalf of the suppliers.
+type YAMLRelayMinerRemoteSignerConfig struct {
+	Enabled   bool                                 `yaml:"enabled,omitempty"`
+	Endpoints []YAMLRelayMinerRemoteSignerEndpoint `yaml:"endpoints,omitempty"`
+	TLS       YAMLRelayMinerRemoteSignerTLSConfig  `yaml:"tls,omitempty"`
+}
+
+// YAMLRelayMinerRemoteSignerEndpoint is the structure used to unmarshal a remote
+// signing service of the remote_signer section of the RelayMiner config file.
+type YAMLRelayMinerRemoteSignerEndpoint struct {
+	Url      string   `yaml:"url"`
+	KeyNames []string `yaml:"key_names"`
+}
+
+// YAMLRelayMinerRemoteSignerTLSConfig is the structure used to unmarshal the
+// mTLS sub-section of the remote_signer section of the RelayMiner config file.
+type YAMLRelayMinerRemoteSignerTLSConfig struct {
+	CAFile   string `yaml:"ca_file"`
+	CertFile string `yaml:"cert_file"`
+	KeyFile  string `yaml:"key_file"`
+}
+
 // RelayMinerConfig is the structure describing the RelayMiner config
 type RelayMinerConfig struct {
 	DefaultSigningKeyNames []string
@@ -91,6 +293,44 @@ type RelayMinerConfig struct {
 	Servers                map[string]*RelayMinerServerConfig
 	SmtStorePath           string
 	Ping                   *RelayMinerPingConfig
+	RelayMeter             *RelayMinerRelayMeterConfig
+	Admin                  *RelayMinerAdminConfig
+	TxResubmission         *RelayMinerTxResubmissionConfig
+	RemoteSigner           *RelayMinerRemoteSignerConfig
+}
+
+// RelayMinerRelayMeterConfig is the structure resulting from parsing the relay
+// meter section of the RelayMiner config file.
+type RelayMinerRelayMeterConfig struct {
+	// OverServicingAllowance is the over-servicing allowance of the applications
+	// of the services which do not have a specific one.
+	OverServicingAllowance *RelayMinerOverServicingAllowance
+	// ServicesOverServicingAllowance is a map of serviceId -> over-servicing
+	// allowance of the applications of the service, overriding the global one.
+	ServicesOverServicingAllowance map[string]*RelayMinerOverServicingAllowance
+	// AllowedApplications are the addresses of the applications which are
+	// over-serviced without any limit, regardless of the configured allowances.
+	AllowedApplications []string
+	// DeniedApplications are the addresses of the applications which are never
+	// over-serviced, regardless of the configured allowances.
+	DeniedApplications []string
+	// RateLimitedReplyPolicy is the policy used to reply to the relay requests
+	// of the applications which have been rate limited.
+	RateLimitedReplyPolicy RateLimitedReplyPolicy
+}
+
+// RelayMinerOverServicingAllowance is the structure resulting from parsing an
+// over-servicing allowance of the relay meter section of the RelayMiner config file.
+type RelayMinerOverServicingAllowance struct {
+	// Policy determines which of the other fields, if any, is used to compute
+	// the allowance.
+	Policy OverServicingAllowancePolicy
+	// Upokt is the allowance of the OverServicingAllowancePolicyFixed policy.
+	Upokt uint64
+	// StakePercentage is the allowance of the OverServicingAllowancePolicyStakePercentage
+	// policy, as a percentage of the application stake portion claimable by the
+	// supplier for the session.
+	StakePercentage uint64
 }
 
 // TODO_TECHDEBT(@red-0ne): Remove this structure altogether. See the discussion here for ref:
@@ -109,12 +349,41 @@ type RelayMinerPocketNodeConfig struct {
 	QueryNodeRPCUrl  *url.URL
 	QueryNodeGRPCUrl *url.URL
 	TxNodeRPCUrl     *url.URL
+	// FallbackQueryNodeRPCUrls are the query node RPC URLs failed over to, in
+	// order, when QueryNodeRPCUrl is unreachable or lagging behind.
+	FallbackQueryNodeRPCUrls []*url.URL
+	// FallbackQueryNodeGRPCUrls are the query node gRPC URLs failed over to, in
+	// order, when QueryNodeGRPCUrl is unreachable or lagging behind.
+	FallbackQueryNodeGRPCUrls []*url.URL
+	// FallbackTxNodeRPCUrls are the tx node RPC URLs failed over to, in order,
+	// when TxNodeRPCUrl is unreachable.
+	FallbackTxNodeRPCUrls []*url.URL
+	// LightClient is the configuration of the light client used to verify the
+	// responses of the query node.
+	LightClient *RelayMinerLightClientConfig
+}
+
+// RelayMinerLightClientConfig is the structure resulting from parsing the light
+// client sub-section of the pocket node section of the RelayMiner config file.
+type RelayMinerLightClientConfig struct {
+	Enabled bool
+	// ChainId is the ID of the chain whose headers are verified.
+	ChainId string
+	// TrustedHeight is the height of the header trusted without verification,
+	// from which the light client starts verifying the subsequent headers.
+	TrustedHeight int64
+	// TrustedHash is the hash of the header at TrustedHeight.
+	TrustedHash []byte
+	// TrustingPeriod is the duration for which a verified header is trusted.
+	TrustingPeriod time.Duration
+	// WitnessRPCUrls are the RPC URLs of the nodes the headers provided by the
+	// query node are cross-checked with.
+	WitnessRPCUrls []*url.URL
 }
 
 // RelayMinerServerConfig is the structure resulting from parsing the supplier's
 // server section of the RelayMiner config file.
 // Each server section embeds a map of supplier configs that are associated with it.
-// TODO_IMPROVE: Other server types may embed other fields in the future; eg. "https" may embed a TLS config.
 type RelayMinerServerConfig struct {
 	// ServerType is the transport protocol used by the server like (http, https, etc.)
 	ServerType RelayMinerServerType
@@ -125,10 +394,28 @@ type RelayMinerServerConfig struct {
 	// should lookup the host from the X-Forwarded-Host header before falling
 	// back to the Host header.
 	XForwardedHostLookup bool
+	// TLS is the TLS configuration of the server.
+	// It is only populated for servers of type RelayMinerServerTypeHTTPS.
+	TLS *RelayMinerServerTLSConfig
 	// SupplierConfigsMap is a map of serviceIds -> RelayMinerSupplierConfig
 	SupplierConfigsMap map[string]*RelayMinerSupplierConfig
 }
 
+// RelayMinerServerTLSConfig is the structure resulting from parsing the TLS
+// sub-section of an "https" server.
+type RelayMinerServerTLSConfig struct {
+	// CertFile is the path to the PEM encoded certificate (chain) presented to clients.
+	CertFile string
+	// KeyFile is the path to the PEM encoded private key of the certificate.
+	KeyFile string
+	// ClientCAFile is the optional path to a PEM encoded CA bundle.
+	// When set, clients MUST present a certificate signed by one of these CAs (mTLS).
+	ClientCAFile string
+	// ReloadInterval is the interval at which the certificate and key files are
+	// checked for changes and hot reloaded if they were modified.
+	ReloadInterval time.Duration
+}
+
 // RelayMinerMetricsConfig is the structure resulting from parsing the metrics
 // section of the RelayMiner config file
 type RelayMinerMetricsConfig struct {
@@ -143,8 +430,10 @@ type RelayMinerSupplierConfig struct {
 	ServiceId string
 	// ServerType is the transport protocol used by the supplier, it must match the
 	// type of the relay miner server it is associated with.
-
 	ServerType RelayMinerServerType
+	// BackendType is the protocol used by the supplier to forward relays to its
+	// backends, which is inferred from the scheme of their URLs.
+	BackendType RelayMinerSupplierBackendType
 	// ServiceConfig is the config of the service that relays will be proxied to.
 	// Other supplier types may embed other fields in the future. eg. "https" may
 	// embed a TLS config.
@@ -159,7 +448,23 @@ type RelayMinerSupplierConfig struct {
 // service sub-section of the RelayMiner config file.
 type RelayMinerSupplierServiceConfig struct {
 	// BackendUrl is the URL of the service that relays will be proxied to.
+	// It is the URL of the first backend when several are configured, and is
+	// replaced by the URL of the backend selected for the relay being forwarded.
 	BackendUrl *url.URL
+	// Backends are all the backends of the service, the first one being the
+	// one of BackendUrl. Relays are load balanced across the healthy ones.
+	Backends []*RelayMinerSupplierServiceBackend
+	// LoadBalancingPolicy is the policy used to select the backend a relay is
+	// forwarded to.
+	LoadBalancingPolicy LoadBalancingPolicy
+	// HealthCheck is the configuration of the active health checks of the backends.
+	HealthCheck *RelayMinerSupplierServiceHealthCheck
+	// RateLimit limits the relay requests each application can send to the
+	// service. It is nil if the applications are not rate limited.
+	RateLimit *RelayMinerSupplierServiceRateLimit
+	// ResponseCache is the configuration of the cache of the backend responses
+	// to deterministic JSON-RPC requests. It is nil if no response is cached.
+	ResponseCache *RelayMinerSupplierServiceResponseCache
 	// Authentication is the basic auth structure used to authenticate to the
 	// request being proxied from the current relay miner server.
 	// If the service the relay requests are forwarded to requires basic auth
@@ -170,6 +475,84 @@ type RelayMinerSupplierServiceConfig struct {
 	// authentication then this field must be populated accordingly.
 	// For example: { "Authorization": "Bearer <token>" }
 	Headers map[string]string
+	// MaxIdleConns is the maximum number of idle (keep-alive) connections
+	// kept open to the backend for reuse.
+	MaxIdleConns int
+	// DialTimeout is the maximum amount of time to wait for a connection to
+	// the backend to be established.
+	DialTimeout time.Duration
+	// ResponseHeaderTimeout is the maximum amount of time to wait for the
+	// backend's response headers after the request has been written.
+	// Zero means no timeout other than RequestTimeout.
+	ResponseHeaderTimeout time.Duration
+	// RequestTimeout is the maximum overall amount of time a single relay is
+	// given to be served by the backend, including retries.
+	// It is further capped by the TIMEOUT config option of the supplier's onchain
+	// service endpoints, if any.
+	RequestTimeout time.Duration
+	// MaxRetries is the maximum number of times an idempotent request is retried
+	// if the backend is unreachable or replies with a 502, 503 or 504 status code.
+	MaxRetries uint64
+	// RetryBackoff is the delay before the first retry. It is doubled on every
+	// subsequent retry, up to a maximum delay.
+	RetryBackoff time.Duration
+	// IdempotentJSONRPCMethods are the JSON-RPC methods which are safe to retry
+	// (e.g. read-only ones such as eth_call), although JSON-RPC requests are sent
+	// with the non-idempotent POST HTTP method.
+	// A batched JSON-RPC request is only retried if all its methods are idempotent.
+	IdempotentJSONRPCMethods []string
+}
+
+// RelayMinerSupplierServiceBackend is the structure resulting from parsing an
+// entry of the supplier service backends list of the RelayMiner config file.
+type RelayMinerSupplierServiceBackend struct {
+	// Url is the URL of the backend.
+	Url *url.URL
+	// Weight is the relative share of the relays forwarded to the backend when
+	// using the LoadBalancingPolicyWeighted policy.
+	Weight uint64
+}
+
+// RelayMinerSupplierServiceHealthCheck is the structure resulting from parsing
+// the supplier service backends health check sub-section of the RelayMiner
+// config file.
+type RelayMinerSupplierServiceHealthCheck struct {
+	// Interval is the interval between two consecutive health checks of the backends.
+	Interval time.Duration
+	// Timeout is the maximum amount of time a backend is given to reply to a
+	// health check.
+	Timeout time.Duration
+	// UnhealthyThreshold is the number of consecutive failed health checks
+	// after which a backend is ejected.
+	UnhealthyThreshold uint64
+	// HealthyThreshold is the number of consecutive successful health checks
+	// after which an ejected backend is readmitted.
+	HealthyThreshold uint64
+}
+
+// RelayMinerSupplierServiceRateLimit is the structure resulting from parsing
+// the supplier service applications rate limit sub-section of the RelayMiner
+// config file.
+type RelayMinerSupplierServiceRateLimit struct {
+	// RequestsPerSecond is the sustained rate of relay requests an application
+	// can send to the service. Zero means no rate limit.
+	RequestsPerSecond uint64
+	// Burst is the maximum number of relay requests an application can send at
+	// once, on top of the sustained rate.
+	Burst uint64
+	// MaxInFlight is the maximum number of relay requests of an application
+	// being served concurrently. Zero means no concurrency limit.
+	MaxInFlight uint64
+}
+
+// RelayMinerSupplierServiceResponseCache is the structure resulting from parsing
+// the supplier service response cache sub-section of the RelayMiner config file.
+type RelayMinerSupplierServiceResponseCache struct {
+	// MaxEntries is the maximum number of responses cached per JSON-RPC method.
+	MaxEntries uint64
+	// MethodTTLs is a map of JSON-RPC method -> duration its responses are
+	// cached for. The responses of the methods not listed are never cached.
+	MethodTTLs map[string]time.Duration
 }
 
 // RelayMinerSupplierServiceAuthentication is the structure resulting from parsing
@@ -186,3 +569,51 @@ type RelayMinerPprofConfig struct {
 	Enabled bool
 	Addr    string
 }
+
+// RelayMinerAdminConfig is the structure resulting from parsing the admin API
+// server config section of a RelayMiner config.
+type RelayMinerAdminConfig struct {
+	Enabled bool
+	Addr    string
+	// AuthToken is the bearer token the admin API requests must be authenticated with.
+	AuthToken string
+}
+
+// RelayMinerTxResubmissionConfig is the structure resulting from parsing the
+// tx resubmission config section of a RelayMiner config.
+type RelayMinerTxResubmissionConfig struct {
+	Enabled bool
+	// AttemptTimeoutBlocks is the number of blocks after which a claim or proof
+	// transaction that is not committed is resubmitted.
+	AttemptTimeoutBlocks int64
+	// FeeBumpFactor is the factor by which the fee is multiplied on each resubmission.
+	FeeBumpFactor float64
+	// MaxFeeMultiplier is the ceiling of the cumulative fee multiplier.
+	MaxFeeMultiplier float64
+	// MaxGasPrices is the absolute ceiling of the gas prices the resubmitted
+	// transactions are signed with. It is nil if only the fee multiplier is capped.
+	MaxGasPrices cosmostypes.DecCoins
+}
+
+// RelayMinerRemoteSignerConfig is the structure resulting from parsing the
+// remote signer config section of a RelayMiner config.
+type RelayMinerRemoteSignerConfig struct {
+	Enabled bool
+	// Endpoints are the remote signing services along with the names of the
+	// keys each of them signs with.
+	Endpoints []*RelayMinerRemoteSignerEndpoint
+	// CAFile is the path to the PEM encoded CA bundle the remote signing
+	// services certificates are verified against.
+	CAFile string
+	// CertFile and KeyFile are the paths to the PEM encoded client certificate
+	// and private key presented to the remote signing services (i.e. mTLS).
+	CertFile string
+	KeyFile  string
+}
+
+// RelayMinerRemoteSignerEndpoint is a remote signing service along with the
+// names of the keys it signs with.
+type RelayMinerRemoteSignerEndpoint struct {
+	Url      *url.URL
+	KeyNames []string
+}
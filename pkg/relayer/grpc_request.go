@@ -0,0 +1,191 @@
+package relayer
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+
+	sdktypes "github.com/pokt-network/shannon-sdk/types"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/pokt-network/poktroll/pkg/relayer/config"
+	"github.com/pokt-network/poktroll/x/service/types"
+)
+
+// grpcFrameHeaderLength is the length of the gRPC length-prefixed message header:
+// 1 byte for the compressed flag followed by 4 bytes of big-endian message length.
+// See: https://github.com/grpc/grpc/blob/master/doc/PROTOCOL-HTTP2.md#requests
+const grpcFrameHeaderLength = 5
+
+// metadataBinarySuffix is the suffix of gRPC metadata keys carrying binary values.
+const metadataBinarySuffix = "-bin"
+
+// grpcReservedHeaders are the upstream request headers which are managed by the
+// gRPC transport itself and MUST NOT be forwarded as metadata to the backend.
+var grpcReservedHeaders = map[string]struct{}{
+	"content-type":      {},
+	"content-length":    {},
+	"te":                {},
+	"host":              {},
+	"connection":        {},
+	"user-agent":        {},
+	"transfer-encoding": {},
+}
+
+// GRPCBackendRequest is a gRPC call decoded from a RelayRequest's payload which
+// is ready to be forwarded to a gRPC service backend.
+type GRPCBackendRequest struct {
+	// FullMethod is the fully qualified gRPC method name (e.g. "/pkg.Service/Method").
+	FullMethod string
+	// Metadata is the outgoing metadata (i.e. headers) of the gRPC call.
+	Metadata metadata.MD
+	// Message is the serialized (protobuf) request message.
+	Message []byte
+}
+
+// BuildServiceBackendGRPCRequest decodes the relay request payload into a gRPC call.
+//
+// The payload is expected to be a serialized POKTHTTPRequest of the gRPC call as
+// it is sent over HTTP/2 by any gRPC client (i.e. a request to the method path
+// with an application/grpc body):
+//   - The URL path is the full method name (e.g. "/cosmos.bank.v1beta1.Query/Balance").
+//   - The body is a single, uncompressed, gRPC length-prefixed message.
+//   - Non-reserved headers are forwarded as gRPC metadata.
+//
+// Unary and server-streaming calls share this exact same request format.
+func BuildServiceBackendGRPCRequest(
+	relayRequest *types.RelayRequest,
+	serviceConfig *config.RelayMinerSupplierServiceConfig,
+) (*GRPCBackendRequest, error) {
+	// Deserialize the relay request payload to get the upstream gRPC request.
+	poktHTTPRequest, err := sdktypes.DeserializeHTTPRequest(relayRequest.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	requestUrl, err := url.Parse(poktHTTPRequest.Url)
+	if err != nil {
+		return nil, err
+	}
+
+	// A gRPC method path MUST have the "/<service>/<method>" form.
+	fullMethod := path.Clean("/" + requestUrl.Path)
+	if strings.Count(fullMethod, "/") != 2 {
+		return nil, fmt.Errorf("invalid gRPC method path %q", requestUrl.Path)
+	}
+
+	messages, err := ParseGRPCFrames(poktHTTPRequest.BodyBz)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only unary and server-streaming calls are supported, both of which send
+	// exactly one request message.
+	if len(messages) != 1 {
+		return nil, fmt.Errorf("expected exactly one gRPC request message, got %d", len(messages))
+	}
+
+	md := metadata.MD{}
+	for key, header := range poktHTTPRequest.Header {
+		lowerKey := strings.ToLower(key)
+		if _, isReserved := grpcReservedHeaders[lowerKey]; isReserved {
+			continue
+		}
+		// Pseudo-headers and grpc-* headers are owned by the gRPC transport.
+		if strings.HasPrefix(lowerKey, ":") || strings.HasPrefix(lowerKey, "grpc-") {
+			continue
+		}
+		// Binary metadata values are base64 encoded when carried as headers,
+		// while the gRPC transport expects them raw.
+		if strings.HasSuffix(lowerKey, metadataBinarySuffix) {
+			for _, value := range header.Values {
+				decodedValue, decodeErr := decodeBinaryHeaderValue(value)
+				if decodeErr != nil {
+					return nil, fmt.Errorf("invalid binary header %q: %w", key, decodeErr)
+				}
+				md.Append(lowerKey, string(decodedValue))
+			}
+			continue
+		}
+		md.Append(lowerKey, header.Values...)
+	}
+
+	// Basic HTTP Authentication, which gRPC servers commonly accept through
+	// the "authorization" metadata.
+	if serviceConfig.Authentication != nil {
+		auth := serviceConfig.Authentication.Username + ":" + serviceConfig.Authentication.Password
+		encodedAuth := base64.StdEncoding.EncodeToString([]byte(auth))
+		md.Set("authorization", "Basic "+encodedAuth)
+	}
+
+	// Add service-specific configuration headers (e.g. auth/authz),
+	// overriding any matching upstream metadata (i.e. same key).
+	for key, value := range serviceConfig.Headers {
+		md.Set(strings.ToLower(key), value)
+	}
+
+	return &GRPCBackendRequest{
+		FullMethod: fullMethod,
+		Metadata:   md,
+		Message:    messages[0],
+	}, nil
+}
+
+// ParseGRPCFrames splits a gRPC body into its length-prefixed messages.
+// Compressed messages are not supported.
+func ParseGRPCFrames(bodyBz []byte) ([][]byte, error) {
+	messages := make([][]byte, 0, 1)
+	for len(bodyBz) > 0 {
+		if len(bodyBz) < grpcFrameHeaderLength {
+			return nil, fmt.Errorf("truncated gRPC frame header")
+		}
+
+		if bodyBz[0] != 0 {
+			return nil, fmt.Errorf("compressed gRPC messages are not supported")
+		}
+
+		messageLength := binary.BigEndian.Uint32(bodyBz[1:grpcFrameHeaderLength])
+		bodyBz = bodyBz[grpcFrameHeaderLength:]
+		if uint64(len(bodyBz)) < uint64(messageLength) {
+			return nil, fmt.Errorf("truncated gRPC message")
+		}
+
+		messages = append(messages, bodyBz[:messageLength])
+		bodyBz = bodyBz[messageLength:]
+	}
+
+	return messages, nil
+}
+
+// EncodeGRPCMetadataHeader returns the http header representation of the given
+// gRPC metadata value, base64 encoding binary (i.e. "-bin" suffixed) values.
+func EncodeGRPCMetadataHeader(key, value string) string {
+	if strings.HasSuffix(key, metadataBinarySuffix) {
+		return base64.RawStdEncoding.EncodeToString([]byte(value))
+	}
+
+	return value
+}
+
+// decodeBinaryHeaderValue decodes a base64 binary header value, which may or
+// may not be padded as per the gRPC over HTTP/2 spec.
+func decodeBinaryHeaderValue(value string) ([]byte, error) {
+	if len(value)%4 == 0 {
+		return base64.StdEncoding.DecodeString(value)
+	}
+
+	return base64.RawStdEncoding.DecodeString(value)
+}
+
+// AppendGRPCFrame appends the given message to dst as an uncompressed gRPC
+// length-prefixed message and returns the extended slice.
+func AppendGRPCFrame(dst []byte, message []byte) []byte {
+	var header [grpcFrameHeaderLength]byte
+	binary.BigEndian.PutUint32(header[1:], uint32(len(message)))
+
+	dst = append(dst, header[:]...)
+	return append(dst, message...)
+}
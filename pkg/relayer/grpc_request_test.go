@@ -0,0 +1,101 @@
+package relayer_test
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/url"
+	"testing"
+
+	sdktypes "github.com/pokt-network/shannon-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/poktroll/pkg/relayer"
+	"github.com/pokt-network/poktroll/pkg/relayer/config"
+	servicetypes "github.com/pokt-network/poktroll/x/service/types"
+)
+
+func TestBuildServiceBackendGRPCRequest(t *testing.T) {
+	serviceConfig := &config.RelayMinerSupplierServiceConfig{
+		BackendUrl: &url.URL{Scheme: "grpc", Host: "cosmos.servicer:9090"},
+		Headers:    map[string]string{"X-Api-Key": "secret"},
+	}
+
+	message := []byte("serialized protobuf message")
+
+	tests := []struct {
+		desc        string
+		path        string
+		body        []byte
+		header      http.Header
+		expectedErr bool
+	}{
+		{
+			desc: "valid: unary or server-streaming call",
+			path: "/cosmos.bank.v1beta1.Query/Balance",
+			body: relayer.AppendGRPCFrame(nil, message),
+			header: http.Header{
+				"Content-Type":   []string{"application/grpc"},
+				"Grpc-Timeout":   []string{"1S"},
+				"X-Request-Id":   []string{"42"},
+				"X-Trace-Id-Bin": []string{"AQID"},
+			},
+		},
+		{
+			desc:        "invalid: malformed method path",
+			path:        "/cosmos.bank.v1beta1.Query",
+			body:        relayer.AppendGRPCFrame(nil, message),
+			expectedErr: true,
+		},
+		{
+			desc:        "invalid: client-streaming call",
+			path:        "/cosmos.bank.v1beta1.Query/Balance",
+			body:        relayer.AppendGRPCFrame(relayer.AppendGRPCFrame(nil, message), message),
+			expectedErr: true,
+		},
+		{
+			desc:        "invalid: truncated message",
+			path:        "/cosmos.bank.v1beta1.Query/Balance",
+			body:        relayer.AppendGRPCFrame(nil, message)[:10],
+			expectedErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			httpRequest := &http.Request{
+				Method: http.MethodPost,
+				URL:    &url.URL{Scheme: "https", Host: "relayminer", Path: test.path},
+				Header: test.header,
+				Body:   io.NopCloser(bytes.NewReader(test.body)),
+			}
+			if httpRequest.Header == nil {
+				httpRequest.Header = http.Header{}
+			}
+
+			_, payloadBz, err := sdktypes.SerializeHTTPRequest(httpRequest)
+			require.NoError(t, err)
+
+			relayRequest := &servicetypes.RelayRequest{Payload: payloadBz}
+			grpcRequest, err := relayer.BuildServiceBackendGRPCRequest(relayRequest, serviceConfig)
+			if test.expectedErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			require.Equal(t, test.path, grpcRequest.FullMethod)
+			require.Equal(t, message, grpcRequest.Message)
+
+			// Transport managed headers are not forwarded while the others are,
+			// along with the service configured headers.
+			require.Empty(t, grpcRequest.Metadata.Get("content-type"))
+			require.Empty(t, grpcRequest.Metadata.Get("grpc-timeout"))
+			require.Equal(t, []string{"42"}, grpcRequest.Metadata.Get("x-request-id"))
+			require.Equal(t, []string{"secret"}, grpcRequest.Metadata.Get("x-api-key"))
+
+			// Binary headers are base64 decoded.
+			require.Equal(t, []string{"\x01\x02\x03"}, grpcRequest.Metadata.Get("x-trace-id-bin"))
+		})
+	}
+}
@@ -118,13 +118,28 @@ type RelayerSessionsManager interface {
 	// network as necessary.
 	Start(ctx context.Context) error
 
-	// Stop unsubscribes all observables from the InsertRelays observable which
-	// will close downstream observables as they drain.
-	//
-	// TODO_TECHDEBT: Either add a mechanism to wait for draining to complete
-	// and/or ensure that the state at each pipeline stage is persisted to disk
-	// and exit as early as possible.
-	Stop()
+	// Stop waits, until the context is done, for the InsertRelays observable to
+	// complete and for the relays it notified to be added to their session's
+	// SMST (tree). The upstream stages (i.e. the relayer proxy and the miner)
+	// are therefore expected to be stopped first.
+	// It then stops accepting new relays, persists all the session trees and
+	// their metadata to disk and reports the claim/proof operations that were
+	// interrupted, which are resumed on the next start.
+	// It returns an error if the pipeline could not be drained before the context
+	// is done, in which case the relays that were not yet added are dropped.
+	Stop(ctx context.Context) error
+
+	// GetSessionTrees returns a snapshot of the session trees currently managed,
+	// across all suppliers.
+	GetSessionTrees() []SessionTreeInfo
+
+	// RetrySessionTree sends the session tree of the given supplier and session
+	// back to the claim or proof pipeline, depending on which of its claim or
+	// proof failed.
+	// It returns an error if no such session tree exists or if it is not in a
+	// failed state, or the context error if it is done before the session tree
+	// is picked up by the pipeline.
+	RetrySessionTree(ctx context.Context, supplierOperatorAddress, sessionId string) error
 }
 
 type RelayerSessionsManagerOption func(RelayerSessionsManager)
@@ -177,6 +192,9 @@ type SessionTree interface {
 	// It returns an error if it has already been marked as such.
 	StartClaiming() error
 
+	// IsClaiming returns true if the session tree has been picked up for claiming.
+	IsClaiming() bool
+
 	// GetSupplierOperatorAddress returns a stringified bech32 address of the supplier
 	// operator this sessionTree belongs to.
 	GetSupplierOperatorAddress() string
@@ -214,3 +232,7 @@ type RelayMeter interface {
 	// until the relay is served and the relay response signed.
 	SetNonApplicableRelayReward(ctx context.Context, relayRequestMeta servicetypes.RelayRequestMetadata) error
 }
+
+// RelayMeterOption defines a function which configures a RelayMeter when it is
+// created (e.g. its persistence directory or its over-servicing policy).
+type RelayMeterOption func(RelayMeter)
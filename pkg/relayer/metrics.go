@@ -15,6 +15,20 @@ const (
 	responseSizeBytes    = "response_size_bytes"
 	smtSizeBytes         = "smt_size_bytes"
 	relayDurationSeconds = "relay_duration_seconds"
+
+	backendTimeoutsTotal = "backend_timeouts_total"
+	backendRetriesTotal  = "backend_retries_total"
+
+	backendRequestsTotal      = "backend_requests_total"
+	backendInFlight           = "backend_in_flight_requests"
+	backendHealthy            = "backend_healthy"
+	backendEjectionsTotal     = "backend_ejections_total"
+	backendHealthChecksFailed = "backend_health_check_failures_total"
+
+	relaysRateLimitedTotal = "relays_rate_limited_total"
+
+	responseCacheHitsTotal   = "response_cache_hits_total"
+	responseCacheMissesTotal = "response_cache_misses_total"
 )
 
 var (
@@ -95,4 +109,112 @@ var (
 		Help:      "Histogram of request sizes in bytes for performance analysis.",
 		Buckets:   []float64{100, 500, 1000, 5000, 10000, 50000},
 	}, []string{"service_id"})
+
+	// RelaysBackendTimeoutsTotal is a Counter metric for the relays which could not
+	// be served because the service backend did not reply in time (i.e. dial,
+	// response header or overall request timeout).
+	// It is labeled by 'service_id'.
+	//
+	// Usage:
+	// - Detect slow or overloaded backends.
+	// - Tune the per-service backend timeouts.
+	RelaysBackendTimeoutsTotal = prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+		Subsystem: relayMinerProcess,
+		Name:      backendTimeoutsTotal,
+		Help:      "Total number of relays which timed out waiting for the service backend, labeled by service ID.",
+	}, []string{"service_id"})
+
+	// RelaysBackendRetriesTotal is a Counter metric for the retried requests to
+	// the service backends.
+	// It is labeled by 'service_id'.
+	RelaysBackendRetriesTotal = prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+		Subsystem: relayMinerProcess,
+		Name:      backendRetriesTotal,
+		Help:      "Total number of retried requests to the service backend, labeled by service ID.",
+	}, []string{"service_id"})
+
+	// BackendRequestsTotal is a Counter metric for the relays forwarded to each
+	// of a service's backends.
+	// It is labeled by 'service_id' and 'backend' (i.e. the backend URL without
+	// its credentials and query).
+	//
+	// Usage:
+	// - Check how the load is balanced across a service's backends.
+	BackendRequestsTotal = prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+		Subsystem: relayMinerProcess,
+		Name:      backendRequestsTotal,
+		Help:      "Total number of relays forwarded to a service backend, labeled by service ID and backend.",
+	}, []string{"service_id", "backend"})
+
+	// BackendInFlightRequests is a Gauge metric for the relays currently being
+	// served by each of a service's backends.
+	// It is labeled by 'service_id' and 'backend'.
+	BackendInFlightRequests = prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+		Subsystem: relayMinerProcess,
+		Name:      backendInFlight,
+		Help:      "Number of relays currently being served by a service backend, labeled by service ID and backend.",
+	}, []string{"service_id", "backend"})
+
+	// BackendHealthy is a Gauge metric set to 1 while a service's backend is
+	// healthy and to 0 while it is ejected from the load balancing.
+	// It is labeled by 'service_id' and 'backend'.
+	BackendHealthy = prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+		Subsystem: relayMinerProcess,
+		Name:      backendHealthy,
+		Help:      "Whether a service backend is healthy (1) or ejected (0), labeled by service ID and backend.",
+	}, []string{"service_id", "backend"})
+
+	// BackendEjectionsTotal is a Counter metric for the number of times a
+	// service's backend was ejected for failing its health checks.
+	// It is labeled by 'service_id' and 'backend'.
+	BackendEjectionsTotal = prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+		Subsystem: relayMinerProcess,
+		Name:      backendEjectionsTotal,
+		Help:      "Total number of ejections of a service backend, labeled by service ID and backend.",
+	}, []string{"service_id", "backend"})
+
+	// BackendHealthCheckFailuresTotal is a Counter metric for the failed health
+	// checks of each of a service's backends.
+	// It is labeled by 'service_id' and 'backend'.
+	BackendHealthCheckFailuresTotal = prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+		Subsystem: relayMinerProcess,
+		Name:      backendHealthChecksFailed,
+		Help:      "Total number of failed health checks of a service backend, labeled by service ID and backend.",
+	}, []string{"service_id", "backend"})
+
+	// RelaysRateLimitedTotal is a Counter metric for the relay requests rejected
+	// because their application exceeded the service's per-application rate limit.
+	// It is labeled by 'service_id' and 'reason' (i.e. "requests_per_second" or
+	// "max_in_flight").
+	//
+	// Usage:
+	// - Detect gateways flooding a service.
+	// - Tune the per-service application rate limits.
+	RelaysRateLimitedTotal = prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+		Subsystem: relayMinerProcess,
+		Name:      relaysRateLimitedTotal,
+		Help:      "Total number of relay requests rejected by the per-application rate limit, labeled by service ID and reason.",
+	}, []string{"service_id", "reason"})
+
+	// ResponseCacheHitsTotal is a Counter metric for the relays served from the
+	// service's response cache instead of being forwarded to its backend.
+	// It is labeled by 'service_id' and 'method' (i.e. the JSON-RPC method).
+	//
+	// Usage:
+	// - Measure the backend load saved by the response cache.
+	// - Tune the per-method response cache TTLs.
+	ResponseCacheHitsTotal = prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+		Subsystem: relayMinerProcess,
+		Name:      responseCacheHitsTotal,
+		Help:      "Total number of relays served from the response cache, labeled by service ID and method.",
+	}, []string{"service_id", "method"})
+
+	// ResponseCacheMissesTotal is a Counter metric for the cacheable relays which
+	// were forwarded to the service's backend because their response was not cached.
+	// It is labeled by 'service_id' and 'method'.
+	ResponseCacheMissesTotal = prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+		Subsystem: relayMinerProcess,
+		Name:      responseCacheMissesTotal,
+		Help:      "Total number of cacheable relays not found in the response cache, labeled by service ID and method.",
+	}, []string{"service_id", "method"})
 )
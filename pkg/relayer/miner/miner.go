@@ -69,13 +69,22 @@ func (mnr *miner) MinedRelays(
 	// support for generic types.
 	relaysObs := observable.Observable[*servicetypes.Relay](servedRelaysObs)
 
+	// The pipeline is not canceled along with the context but completes once
+	// servedRelaysObs is unsubscribed from, after mining the relays already
+	// received. Its completion then propagates to the mined relays observers.
+	relaysCtx := context.WithoutCancel(ctx)
+
 	// Map servedRelaysObs to a new observable of an either type, populated with
 	// the minedRelay or an error. It is notified after the relay has been mined
 	// or an error has been encountered, respectively.
-	eitherMinedRelaysObs := channel.Map(ctx, relaysObs, mnr.mapMineRelay)
-	logging.LogErrors(ctx, filter.EitherError(ctx, eitherMinedRelaysObs))
-
-	return filter.EitherSuccess(ctx, eitherMinedRelaysObs)
+	eitherMinedRelaysObs := channel.Map(
+		relaysCtx, relaysObs,
+		mnr.mapMineRelay,
+		channel.WithStageName[either.Either[*relayer.MinedRelay]]("miner_mine_relays"),
+	)
+	logging.LogErrors(relaysCtx, filter.EitherError(relaysCtx, eitherMinedRelaysObs), "miner_mine_relays_errors")
+
+	return filter.EitherSuccess(relaysCtx, eitherMinedRelaysObs)
 }
 
 // mapMineRelay is intended to be used as a MapFn.
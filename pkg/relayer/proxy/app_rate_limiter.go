@@ -0,0 +1,149 @@
+package proxy
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"github.com/pokt-network/poktroll/pkg/relayer"
+	"github.com/pokt-network/poktroll/pkg/relayer/config"
+)
+
+// appRateLimiterPruneInterval is the interval at which the rate limiters of the
+// applications which stopped sending relay requests are pruned.
+const appRateLimiterPruneInterval = time.Minute
+
+// appLimiter is the rate limiter of a single application of a service.
+type appLimiter struct {
+	// tokenBucket limits the rate of relay requests of the application.
+	// It is nil if the service has no requests per second limit.
+	tokenBucket *rate.Limiter
+	// inFlight is the number of relay requests of the application currently
+	// being served.
+	inFlight uint64
+	// lastSeenAt is the time of the application's last relay request.
+	lastSeenAt time.Time
+}
+
+// appRateLimiter limits the rate and concurrency of the relay requests each
+// application sends to a service, independently from its stake, so a single
+// gateway cannot flood the service's backends.
+type appRateLimiter struct {
+	serviceId string
+	rateLimit *config.RelayMinerSupplierServiceRateLimit
+
+	// mu guards appLimiters and their in-flight counts.
+	mu sync.Mutex
+	// appLimiters is a map of application address -> appLimiter.
+	appLimiters map[string]*appLimiter
+}
+
+// newAppRateLimiter creates an appRateLimiter for the service with the given
+// rate limit configuration.
+func newAppRateLimiter(
+	serviceId string,
+	rateLimit *config.RelayMinerSupplierServiceRateLimit,
+) *appRateLimiter {
+	return &appRateLimiter{
+		serviceId:   serviceId,
+		rateLimit:   rateLimit,
+		appLimiters: make(map[string]*appLimiter),
+	}
+}
+
+// acquire accounts for a new relay request of the given application, returning
+// an error wrapping ErrRelayerProxyAppRateLimited if it exceeds the application's
+// rate or concurrency limit.
+// The returned release function MUST be called once the relay request has been
+// served if no error is returned.
+func (limiter *appRateLimiter) acquire(appAddress string) (release func(), err error) {
+	limiter.mu.Lock()
+	defer limiter.mu.Unlock()
+
+	now := time.Now()
+	appLim, ok := limiter.appLimiters[appAddress]
+	if !ok {
+		appLim = &appLimiter{}
+		if limiter.rateLimit.RequestsPerSecond > 0 {
+			appLim.tokenBucket = rate.NewLimiter(
+				rate.Limit(limiter.rateLimit.RequestsPerSecond),
+				int(limiter.rateLimit.Burst),
+			)
+		}
+		limiter.appLimiters[appAddress] = appLim
+	}
+	appLim.lastSeenAt = now
+
+	maxInFlight := limiter.rateLimit.MaxInFlight
+	if maxInFlight > 0 && appLim.inFlight >= maxInFlight {
+		relayer.RelaysRateLimitedTotal.With("service_id", limiter.serviceId, "reason", "max_in_flight").Add(1)
+		return nil, &rateLimitedError{
+			err: ErrRelayerProxyAppRateLimited.Wrapf(
+				"application %q reached the maximum of %d in-flight relay requests",
+				appAddress,
+				maxInFlight,
+			),
+		}
+	}
+
+	if appLim.tokenBucket != nil {
+		reservation := appLim.tokenBucket.ReserveN(now, 1)
+		if delay := reservation.DelayFrom(now); delay > 0 {
+			// Give the token back since the relay request is rejected.
+			reservation.CancelAt(now)
+			relayer.RelaysRateLimitedTotal.With("service_id", limiter.serviceId, "reason", "requests_per_second").Add(1)
+			return nil, &rateLimitedError{
+				err: ErrRelayerProxyAppRateLimited.Wrapf(
+					"application %q exceeded %d relay requests per second",
+					appAddress,
+					limiter.rateLimit.RequestsPerSecond,
+				),
+				retryAfter: delay,
+			}
+		}
+	}
+
+	appLim.inFlight++
+
+	return func() {
+		limiter.mu.Lock()
+		defer limiter.mu.Unlock()
+
+		appLim.inFlight--
+	}, nil
+}
+
+// goPruneIdleAppLimiters periodically removes the rate limiters of the
+// applications which have no relay request being served and did not send any
+// for appRateLimiterPruneInterval, until the context is done.
+// A pruned application starts again with a full token bucket, which it would
+// have regained by then anyway.
+// It is intended to be called in a goroutine.
+func (limiter *appRateLimiter) goPruneIdleAppLimiters(ctx context.Context) {
+	ticker := time.NewTicker(appRateLimiterPruneInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			limiter.pruneIdleAppLimiters(now)
+		}
+	}
+}
+
+// pruneIdleAppLimiters removes the rate limiters of the applications which are
+// idle as of the given time.
+func (limiter *appRateLimiter) pruneIdleAppLimiters(now time.Time) {
+	limiter.mu.Lock()
+	defer limiter.mu.Unlock()
+
+	for appAddress, appLim := range limiter.appLimiters {
+		if appLim.inFlight == 0 && now.Sub(appLim.lastSeenAt) >= appRateLimiterPruneInterval {
+			delete(limiter.appLimiters, appAddress)
+		}
+	}
+}
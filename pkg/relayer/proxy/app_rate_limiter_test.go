@@ -0,0 +1,240 @@
+package proxy
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/poktroll/pkg/polylog/polyzero"
+	"github.com/pokt-network/poktroll/pkg/relayer/config"
+	"github.com/pokt-network/poktroll/testutil/sample"
+	servicetypes "github.com/pokt-network/poktroll/x/service/types"
+	sessiontypes "github.com/pokt-network/poktroll/x/session/types"
+)
+
+func TestAppRateLimiter_PerAppAndPerServiceLimits(t *testing.T) {
+	firstServiceLimiter := newAppRateLimiter("svc1", &config.RelayMinerSupplierServiceRateLimit{
+		RequestsPerSecond: 1,
+		Burst:             1,
+	})
+	secondServiceLimiter := newAppRateLimiter("svc2", &config.RelayMinerSupplierServiceRateLimit{
+		RequestsPerSecond: 1,
+		Burst:             2,
+	})
+	appAddress := sample.AccAddress()
+	otherAppAddress := sample.AccAddress()
+
+	// The application exhausts its first service's limit.
+	_, err := firstServiceLimiter.acquire(appAddress)
+	require.NoError(t, err)
+	_, err = firstServiceLimiter.acquire(appAddress)
+	require.ErrorIs(t, err, ErrRelayerProxyAppRateLimited)
+
+	// Other applications of the same service are not limited.
+	_, err = firstServiceLimiter.acquire(otherAppAddress)
+	require.NoError(t, err)
+
+	// The application is limited according to the second service's own limit.
+	for range 2 {
+		_, err = secondServiceLimiter.acquire(appAddress)
+		require.NoError(t, err)
+	}
+	_, err = secondServiceLimiter.acquire(appAddress)
+	require.ErrorIs(t, err, ErrRelayerProxyAppRateLimited)
+}
+
+func TestAppRateLimiter_BurstAndRefill(t *testing.T) {
+	const (
+		requestsPerSecond = 50
+		burst             = 3
+	)
+	limiter := newAppRateLimiter("svc1", &config.RelayMinerSupplierServiceRateLimit{
+		RequestsPerSecond: requestsPerSecond,
+		Burst:             burst,
+	})
+	appAddress := sample.AccAddress()
+
+	// A full bucket allows a burst of relay requests.
+	for range burst {
+		_, err := limiter.acquire(appAddress)
+		require.NoError(t, err)
+	}
+
+	// The next relay request is rejected, with a hint to retry once a token is refilled.
+	_, err := limiter.acquire(appAddress)
+	var rateLimitedErr *rateLimitedError
+	require.ErrorAs(t, err, &rateLimitedErr)
+	require.Greater(t, rateLimitedErr.retryAfter, time.Duration(0))
+	require.LessOrEqual(t, rateLimitedErr.retryAfter, time.Second/requestsPerSecond)
+
+	// Rejected relay requests do not consume tokens, so the application is served
+	// again once the first hinted delay elapsed.
+	for range burst {
+		_, err = limiter.acquire(appAddress)
+		require.ErrorIs(t, err, ErrRelayerProxyAppRateLimited)
+	}
+	time.Sleep(rateLimitedErr.retryAfter)
+	_, err = limiter.acquire(appAddress)
+	require.NoError(t, err)
+}
+
+func TestAppRateLimiter_MaxInFlight(t *testing.T) {
+	limiter := newAppRateLimiter("svc1", &config.RelayMinerSupplierServiceRateLimit{
+		MaxInFlight: 2,
+	})
+	appAddress := sample.AccAddress()
+
+	firstRelease, err := limiter.acquire(appAddress)
+	require.NoError(t, err)
+	_, err = limiter.acquire(appAddress)
+	require.NoError(t, err)
+
+	// The in-flight limit is reached, without any hint on when to retry.
+	_, err = limiter.acquire(appAddress)
+	var rateLimitedErr *rateLimitedError
+	require.ErrorAs(t, err, &rateLimitedErr)
+	require.ErrorIs(t, err, ErrRelayerProxyAppRateLimited)
+	require.Zero(t, rateLimitedErr.retryAfter)
+
+	// Serving a relay request frees an in-flight slot.
+	firstRelease()
+	_, err = limiter.acquire(appAddress)
+	require.NoError(t, err)
+}
+
+func TestAppRateLimiter_RejectionStatusCodes(t *testing.T) {
+	server := &relayMinerHTTPServer{
+		logger:       polyzero.NewLogger(),
+		serverConfig: &config.RelayMinerServerConfig{ListenAddress: "127.0.0.1:8545"},
+	}
+	limiter := newAppRateLimiter("svc1", &config.RelayMinerSupplierServiceRateLimit{
+		RequestsPerSecond: 1,
+		Burst:             1,
+		MaxInFlight:       1,
+	})
+	appAddress := sample.AccAddress()
+
+	replyWithErrorRecorder := func(replyError error) *httptest.ResponseRecorder {
+		recorder := httptest.NewRecorder()
+		server.replyWithError(replyError, &servicetypes.RelayRequest{}, recorder)
+		return recorder
+	}
+
+	release, err := limiter.acquire(appAddress)
+	require.NoError(t, err)
+
+	// Exceeding the in-flight limit is replied with a 429 without Retry-After.
+	_, inFlightErr := limiter.acquire(appAddress)
+	require.Error(t, inFlightErr)
+	recorder := replyWithErrorRecorder(inFlightErr)
+	require.Equal(t, http.StatusTooManyRequests, recorder.Code)
+	require.Empty(t, recorder.Header().Get("Retry-After"))
+
+	// Exceeding the rate limit is replied with a 429 and the seconds to wait,
+	// rounded up.
+	release()
+	_, rateErr := limiter.acquire(appAddress)
+	require.Error(t, rateErr)
+	recorder = replyWithErrorRecorder(rateErr)
+	require.Equal(t, http.StatusTooManyRequests, recorder.Code)
+	require.Equal(t, "1", recorder.Header().Get("Retry-After"))
+
+	// Other errors are not replied with a 429.
+	recorder = replyWithErrorRecorder(errors.New("other error"))
+	require.Equal(t, http.StatusOK, recorder.Code)
+}
+
+func TestAppRateLimiter_ServeHTTPLimitsSyncAndAsyncRelays(t *testing.T) {
+	const serviceId = "svc1"
+	limiter := newAppRateLimiter(serviceId, &config.RelayMinerSupplierServiceRateLimit{
+		MaxInFlight: 1,
+	})
+	server := &relayMinerHTTPServer{
+		logger:          polyzero.NewLogger(),
+		serverConfig:    &config.RelayMinerServerConfig{ListenAddress: "127.0.0.1:8545"},
+		appRateLimiters: map[string]*appRateLimiter{serviceId: limiter},
+	}
+	appAddress := sample.AccAddress()
+
+	// Exhaust the application's in-flight limit so that its relay requests are
+	// rejected before being served, which would otherwise require the server's
+	// clients to be set up.
+	_, err := limiter.acquire(appAddress)
+	require.NoError(t, err)
+
+	t.Run("synchronous relay requests are rate limited", func(t *testing.T) {
+		relayRequest := &servicetypes.RelayRequest{
+			Meta: servicetypes.RelayRequestMetadata{
+				SessionHeader: &sessiontypes.SessionHeader{
+					ApplicationAddress:      appAddress,
+					ServiceId:               serviceId,
+					SessionId:               "session",
+					SessionStartBlockHeight: 1,
+					SessionEndBlockHeight:   10,
+				},
+				Signature:               []byte("signature"),
+				SupplierOperatorAddress: sample.AccAddress(),
+			},
+		}
+		relayRequestBz, err := relayRequest.Marshal()
+		require.NoError(t, err)
+
+		recorder := httptest.NewRecorder()
+		request := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(relayRequestBz))
+		server.ServeHTTP(recorder, request)
+		require.Equal(t, http.StatusTooManyRequests, recorder.Code)
+	})
+
+	t.Run("websocket connections are rate limited", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		request := httptest.NewRequest(http.MethodGet, "/", nil)
+		request.Header.Set("Upgrade", "websocket")
+		request.Header.Set("Connection", "Upgrade")
+		request.Header.Set("Target-Service-Id", serviceId)
+		request.Header.Set("App-Address", appAddress)
+		server.ServeHTTP(recorder, request)
+		require.Equal(t, http.StatusTooManyRequests, recorder.Code)
+	})
+}
+
+func TestAppRateLimiter_PrunesIdleAppLimiters(t *testing.T) {
+	limiter := newAppRateLimiter("svc1", &config.RelayMinerSupplierServiceRateLimit{
+		RequestsPerSecond: 1,
+		Burst:             1,
+		MaxInFlight:       1,
+	})
+	idleAppAddress := sample.AccAddress()
+	inFlightAppAddress := sample.AccAddress()
+	recentAppAddress := sample.AccAddress()
+
+	releaseIdle, err := limiter.acquire(idleAppAddress)
+	require.NoError(t, err)
+	releaseIdle()
+	_, err = limiter.acquire(inFlightAppAddress)
+	require.NoError(t, err)
+
+	// Make the idle and in-flight applications' last relay requests old enough
+	// to be pruned.
+	for _, appAddress := range []string{idleAppAddress, inFlightAppAddress} {
+		limiter.appLimiters[appAddress].lastSeenAt = time.Now().Add(-appRateLimiterPruneInterval)
+	}
+	_, err = limiter.acquire(recentAppAddress)
+	require.NoError(t, err)
+
+	limiter.pruneIdleAppLimiters(time.Now())
+
+	// Only the idle application's limiter is pruned, the application with an
+	// in-flight relay request and the recent one are kept.
+	require.NotContains(t, limiter.appLimiters, idleAppAddress)
+	require.Contains(t, limiter.appLimiters, inFlightAppAddress)
+	require.Contains(t, limiter.appLimiters, recentAppAddress)
+
+	// The pruned application starts again with a full bucket.
+	_, err = limiter.acquire(idleAppAddress)
+	require.NoError(t, err)
+}
@@ -6,21 +6,21 @@ import (
 
 	"github.com/gorilla/websocket"
 
+	"github.com/pokt-network/poktroll/pkg/relayer/config"
 	proxyws "github.com/pokt-network/poktroll/pkg/relayer/proxy/websockets"
 	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
 )
 
-// handleAsyncConnection handles the asynchronous relay request by creating a
-// websocket bridge between the client and the service endpoint.
+// handleAsyncConnection handles the asynchronous relay request of the given
+// application for the given service, as determined from the request headers,
+// by creating a websocket bridge between the client and the service endpoint.
 func (server *relayMinerHTTPServer) handleAsyncConnection(
 	ctx context.Context,
 	writer http.ResponseWriter,
 	request *http.Request,
+	serviceId string,
+	appAddress string,
 ) error {
-	// Determine the service ID and application address from the request headers.
-	serviceId := request.Header.Get("Target-Service-Id")
-	appAddress := request.Header.Get("App-Address")
-
 	logger := server.logger.With(
 		"relay_request_type", "asynchronous",
 		"service_id", serviceId,
@@ -41,12 +41,29 @@ func (server *relayMinerHTTPServer) handleAsyncConnection(
 	if !ok {
 		return ErrRelayerProxyServiceEndpointNotHandled
 	}
-	supplierServiceConfig := supplierConfig.ServiceConfig
+	// gRPC backends are only supported for synchronous relays.
+	if supplierConfig.BackendType == config.RelayMinerSupplierBackendTypeGRPC {
+		return ErrRelayerProxyUnsupportedTransportType.Wrapf(
+			"websocket relays are not supported by the gRPC service %q",
+			serviceId,
+		)
+	}
+	backendPool, ok := server.backendPools[serviceId]
+	if !ok {
+		return ErrRelayerProxyServiceEndpointNotHandled
+	}
+
+	// Select the backend to bridge the websocket connection to.
+	// Websocket connections are long-lived, so they are balanced on selection
+	// only and are not accounted as in-flight relays.
+	backend := backendPool.next()
+	supplierServiceConfig := *supplierConfig.ServiceConfig
+	supplierServiceConfig.BackendUrl = backend.url
 
 	logger = logger.With(
 		"server_addr", server.server.Addr,
 		"session_start_height", sessionHeader.SessionStartBlockHeight,
-		"destination_url", supplierServiceConfig.BackendUrl.String(),
+		"backend", backend.label,
 	)
 
 	// Upgrade the HTTP connection to a websocket connection.
@@ -67,7 +84,7 @@ func (server *relayMinerHTTPServer) handleAsyncConnection(
 		server.relayMeter,
 		server.servedRelaysProducer,
 		server.blockClient,
-		supplierServiceConfig,
+		&supplierServiceConfig,
 		session,
 		clientConn,
 	)
@@ -0,0 +1,306 @@
+package proxy
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pokt-network/poktroll/pkg/relayer/config"
+)
+
+const (
+	// backendIdleConnTimeout is the maximum amount of time an idle connection to
+	// a service backend is kept open before being closed.
+	backendIdleConnTimeout = 90 * time.Second
+
+	// backendTLSHandshakeTimeout is the maximum amount of time to wait for a TLS
+	// handshake with an "https" service backend.
+	backendTLSHandshakeTimeout = 10 * time.Second
+
+	// backendKeepAlive is the interval between TCP keep-alive probes of the
+	// connections to a service backend.
+	backendKeepAlive = 30 * time.Second
+
+	// backendMaxRetryBackoff is the maximum delay between two attempts of a
+	// request to a service backend.
+	backendMaxRetryBackoff = 10 * time.Second
+)
+
+// serviceBackendClient is the client used by a relay server to forward relays
+// to a single service backend.
+// It owns a dedicated connection pool so that connections are reused across
+// relays, enforces the configured timeouts and retries idempotent requests.
+type serviceBackendClient struct {
+	httpClient *http.Client
+
+	// requestTimeout is the overall amount of time a relay is given to be served
+	// by the backend, including retries. Zero means no timeout.
+	requestTimeout time.Duration
+
+	// maxRetries is the maximum number of retries of an idempotent request.
+	maxRetries uint64
+
+	// retryBackoff is the delay before the first retry, doubled on every retry
+	// up to backendMaxRetryBackoff.
+	retryBackoff time.Duration
+
+	// idempotentJSONRPCMethods is the set of JSON-RPC methods whose requests are
+	// retried, although they are sent with the POST HTTP method.
+	idempotentJSONRPCMethods map[string]struct{}
+}
+
+// newServiceBackendClient creates a serviceBackendClient for the given service
+// configuration.
+// onchainTimeout is the TIMEOUT advertised by the supplier's onchain service
+// endpoints, which caps the configured request timeout if non-zero.
+func newServiceBackendClient(
+	serviceConfig *config.RelayMinerSupplierServiceConfig,
+	onchainTimeout time.Duration,
+) *serviceBackendClient {
+	dialer := &net.Dialer{
+		Timeout:   serviceConfig.DialTimeout,
+		KeepAlive: backendKeepAlive,
+	}
+
+	// The idle connections limit applies to each of the service's backends
+	// rather than being shared among them.
+	numBackends := max(len(serviceConfig.Backends), 1)
+	transport := &http.Transport{
+		Proxy:                 http.ProxyFromEnvironment,
+		DialContext:           dialer.DialContext,
+		ForceAttemptHTTP2:     true,
+		MaxIdleConns:          serviceConfig.MaxIdleConns * numBackends,
+		MaxIdleConnsPerHost:   serviceConfig.MaxIdleConns,
+		IdleConnTimeout:       backendIdleConnTimeout,
+		TLSHandshakeTimeout:   backendTLSHandshakeTimeout,
+		ResponseHeaderTimeout: serviceConfig.ResponseHeaderTimeout,
+		TLSClientConfig:       &tls.Config{},
+	}
+
+	requestTimeout := serviceConfig.RequestTimeout
+	if onchainTimeout > 0 && (requestTimeout == 0 || onchainTimeout < requestTimeout) {
+		requestTimeout = onchainTimeout
+	}
+
+	idempotentJSONRPCMethods := make(map[string]struct{}, len(serviceConfig.IdempotentJSONRPCMethods))
+	for _, method := range serviceConfig.IdempotentJSONRPCMethods {
+		idempotentJSONRPCMethods[method] = struct{}{}
+	}
+
+	return &serviceBackendClient{
+		httpClient:               &http.Client{Transport: transport},
+		requestTimeout:           requestTimeout,
+		maxRetries:               serviceConfig.MaxRetries,
+		retryBackoff:             serviceConfig.RetryBackoff,
+		idempotentJSONRPCMethods: idempotentJSONRPCMethods,
+	}
+}
+
+// withRequestTimeout returns a context which is canceled once the client's
+// overall request timeout elapses, if any.
+func (c *serviceBackendClient) withRequestTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if c.requestTimeout == 0 {
+		return context.WithCancel(ctx)
+	}
+
+	return context.WithTimeout(ctx, c.requestTimeout)
+}
+
+// do sends the request built by buildRequest to the backend, retrying it with
+// an exponential backoff if it is idempotent and the backend is either unreachable
+// or temporarily unavailable (i.e. 502, 503 or 504 status codes).
+// buildRequest is called for every attempt so each one gets a fresh request body.
+// onRetry is called before every retry.
+// The returned response body is bound to ctx, which MUST outlive reading it.
+func (c *serviceBackendClient) do(
+	ctx context.Context,
+	buildRequest func() (*http.Request, error),
+	onRetry func(attempt uint64, err error),
+) (*http.Response, error) {
+	isIdempotent := false
+	for attempt := uint64(0); ; attempt++ {
+		request, err := buildRequest()
+		if err != nil {
+			return nil, err
+		}
+
+		// All the attempts send the same request, so only inspect the first one.
+		if attempt == 0 {
+			if isIdempotent, err = c.isIdempotentRequest(request); err != nil {
+				return nil, err
+			}
+		}
+
+		response, err := c.httpClient.Do(request.WithContext(ctx))
+		if !isIdempotent || !c.shouldRetry(ctx, response, err, attempt) {
+			return response, err
+		}
+
+		// Release the connection of the discarded response so it can be reused.
+		if response != nil {
+			_, _ = io.Copy(io.Discard, response.Body)
+			_ = response.Body.Close()
+			err = errors.New(response.Status)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(c.getRetryBackoff(attempt)):
+		}
+
+		onRetry(attempt+1, err)
+	}
+}
+
+// shouldRetry returns true if the given attempt of an idempotent request failed
+// in a way that is worth retrying.
+func (c *serviceBackendClient) shouldRetry(
+	ctx context.Context,
+	response *http.Response,
+	err error,
+	attempt uint64,
+) bool {
+	if attempt >= c.maxRetries || ctx.Err() != nil {
+		return false
+	}
+
+	if err != nil {
+		return true
+	}
+
+	switch response.StatusCode {
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
+
+// getRetryBackoff returns the delay before retrying the given attempt, which
+// doubles on every attempt up to backendMaxRetryBackoff.
+func (c *serviceBackendClient) getRetryBackoff(attempt uint64) time.Duration {
+	// Stop doubling once the maximum delay is reached rather than shifting by
+	// attempt, which would overflow for large numbers of attempts.
+	backoff := c.retryBackoff
+	for i := uint64(0); i < attempt && backoff > 0 && backoff < backendMaxRetryBackoff; i++ {
+		backoff *= 2
+	}
+
+	return min(backoff, backendMaxRetryBackoff)
+}
+
+// isIdempotentRequest returns true if the request is safe to retry, i.e. its
+// HTTP method is idempotent or it is a JSON-RPC request whose methods are all
+// configured as idempotent.
+// Inspecting a JSON-RPC request reads its body, which is replaced by an
+// equivalent one so the request can still be sent.
+func (c *serviceBackendClient) isIdempotentRequest(request *http.Request) (bool, error) {
+	if isIdempotentMethod(request.Method) {
+		return true, nil
+	}
+
+	if len(c.idempotentJSONRPCMethods) == 0 ||
+		request.Method != http.MethodPost ||
+		request.Body == nil {
+		return false, nil
+	}
+
+	bodyBz, err := io.ReadAll(request.Body)
+	if err != nil {
+		return false, err
+	}
+	_ = request.Body.Close()
+	request.Body = io.NopCloser(bytes.NewReader(bodyBz))
+
+	methods, ok := getJSONRPCMethods(bodyBz)
+	if !ok {
+		return false, nil
+	}
+
+	for _, method := range methods {
+		if _, ok := c.idempotentJSONRPCMethods[method]; !ok {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// getJSONRPCMethods returns the methods of the given single or batched JSON-RPC
+// request, or false if it is not a JSON-RPC request.
+func getJSONRPCMethods(requestBz []byte) ([]string, bool) {
+	var requests []jsonRPCRequest
+	if trimmedBz := bytes.TrimSpace(requestBz); len(trimmedBz) > 0 && trimmedBz[0] == '[' {
+		if err := json.Unmarshal(trimmedBz, &requests); err != nil {
+			return nil, false
+		}
+	} else {
+		var request jsonRPCRequest
+		if err := json.Unmarshal(trimmedBz, &request); err != nil {
+			return nil, false
+		}
+		requests = append(requests, request)
+	}
+
+	if len(requests) == 0 {
+		return nil, false
+	}
+
+	methods := make([]string, 0, len(requests))
+	for _, request := range requests {
+		if request.JSONRPC == "" || request.Method == "" {
+			return nil, false
+		}
+		methods = append(methods, request.Method)
+	}
+
+	return methods, true
+}
+
+// isIdempotentMethod returns true if the HTTP method is idempotent as per RFC 9110.
+// See: https://www.rfc-editor.org/rfc/rfc9110#section-9.2.2
+func isIdempotentMethod(method string) bool {
+	switch strings.ToUpper(method) {
+	case http.MethodGet, http.MethodHead, http.MethodOptions,
+		http.MethodTrace, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// isTimeoutError returns true if the error was caused by a timeout, either
+// at the network level or by the request context's deadline.
+func isTimeoutError(err error) bool {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+// parseEndpointTimeout parses the value of a TIMEOUT config option of an onchain
+// supplier endpoint. It accepts either a Go duration string (e.g. "1500ms", "10s")
+// or an integer number of seconds.
+func parseEndpointTimeout(value string) (time.Duration, error) {
+	if timeout, err := time.ParseDuration(value); err == nil {
+		return timeout, nil
+	}
+
+	seconds, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		return 0, err
+	}
+
+	return time.Duration(seconds) * time.Second, nil
+}
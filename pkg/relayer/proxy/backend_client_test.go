@@ -0,0 +1,265 @@
+package proxy
+
+import (
+	"context"
+	"io"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/poktroll/pkg/relayer/config"
+)
+
+func TestServiceBackendClient_Retries(t *testing.T) {
+	const maxRetries = 3
+
+	tests := []struct {
+		desc                     string
+		method                   string
+		body                     string
+		idempotentJSONRPCMethods []string
+		numFailures              uint64
+		failureStatusCode        int
+		expectedNumAttempts      uint64
+		expectedStatusCode       int
+	}{
+		{
+			desc:                "idempotent request is retried until it succeeds",
+			method:              http.MethodGet,
+			numFailures:         2,
+			failureStatusCode:   http.StatusServiceUnavailable,
+			expectedNumAttempts: 3,
+			expectedStatusCode:  http.StatusOK,
+		},
+		{
+			desc:                "idempotent request is retried at most maxRetries times",
+			method:              http.MethodGet,
+			numFailures:         maxRetries + 1,
+			failureStatusCode:   http.StatusBadGateway,
+			expectedNumAttempts: maxRetries + 1,
+			expectedStatusCode:  http.StatusBadGateway,
+		},
+		{
+			desc:                "non-retryable status code is not retried",
+			method:              http.MethodGet,
+			numFailures:         1,
+			failureStatusCode:   http.StatusInternalServerError,
+			expectedNumAttempts: 1,
+			expectedStatusCode:  http.StatusInternalServerError,
+		},
+		{
+			desc:                "POST request is not retried",
+			method:              http.MethodPost,
+			body:                `{"jsonrpc":"2.0","id":1,"method":"eth_call"}`,
+			numFailures:         1,
+			failureStatusCode:   http.StatusServiceUnavailable,
+			expectedNumAttempts: 1,
+			expectedStatusCode:  http.StatusServiceUnavailable,
+		},
+		{
+			desc:                     "JSON-RPC request of an idempotent method is retried",
+			method:                   http.MethodPost,
+			body:                     `{"jsonrpc":"2.0","id":1,"method":"eth_call"}`,
+			idempotentJSONRPCMethods: []string{"eth_call", "eth_getBalance"},
+			numFailures:              1,
+			failureStatusCode:        http.StatusServiceUnavailable,
+			expectedNumAttempts:      2,
+			expectedStatusCode:       http.StatusOK,
+		},
+		{
+			desc:                     "batched JSON-RPC request of idempotent methods is retried",
+			method:                   http.MethodPost,
+			body:                     `[{"jsonrpc":"2.0","id":1,"method":"eth_call"},{"jsonrpc":"2.0","id":2,"method":"eth_getBalance"}]`,
+			idempotentJSONRPCMethods: []string{"eth_call", "eth_getBalance"},
+			numFailures:              1,
+			failureStatusCode:        http.StatusServiceUnavailable,
+			expectedNumAttempts:      2,
+			expectedStatusCode:       http.StatusOK,
+		},
+		{
+			desc:                     "batched JSON-RPC request with a non-idempotent method is not retried",
+			method:                   http.MethodPost,
+			body:                     `[{"jsonrpc":"2.0","id":1,"method":"eth_call"},{"jsonrpc":"2.0","id":2,"method":"eth_sendRawTransaction"}]`,
+			idempotentJSONRPCMethods: []string{"eth_call"},
+			numFailures:              1,
+			failureStatusCode:        http.StatusServiceUnavailable,
+			expectedNumAttempts:      1,
+			expectedStatusCode:       http.StatusServiceUnavailable,
+		},
+		{
+			desc:                     "non JSON-RPC POST request is not retried",
+			method:                   http.MethodPost,
+			body:                     `{"method":"eth_call"}`,
+			idempotentJSONRPCMethods: []string{"eth_call"},
+			numFailures:              1,
+			failureStatusCode:        http.StatusServiceUnavailable,
+			expectedNumAttempts:      1,
+			expectedStatusCode:       http.StatusServiceUnavailable,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			var numAttempts atomic.Uint64
+			backend := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				// Every attempt is sent the whole request body.
+				body, err := io.ReadAll(r.Body)
+				assert.NoError(t, err)
+				assert.Equal(t, test.body, string(body))
+
+				if numAttempts.Add(1) <= test.numFailures {
+					w.WriteHeader(test.failureStatusCode)
+					return
+				}
+				w.WriteHeader(http.StatusOK)
+			}))
+			t.Cleanup(backend.Close)
+
+			backendClient := newServiceBackendClient(&config.RelayMinerSupplierServiceConfig{
+				MaxRetries:               maxRetries,
+				RetryBackoff:             time.Millisecond,
+				IdempotentJSONRPCMethods: test.idempotentJSONRPCMethods,
+			}, 0)
+
+			var retryAttempts []uint64
+			response, err := backendClient.do(
+				context.Background(),
+				newTestBackendRequestBuilder(t, test.method, backend.URL, test.body),
+				func(attempt uint64, _ error) { retryAttempts = append(retryAttempts, attempt) },
+			)
+			require.NoError(t, err)
+			t.Cleanup(func() { _ = response.Body.Close() })
+
+			require.Equal(t, test.expectedStatusCode, response.StatusCode)
+			require.Equal(t, test.expectedNumAttempts, numAttempts.Load())
+			require.Len(t, retryAttempts, int(test.expectedNumAttempts-1))
+			for i, attempt := range retryAttempts {
+				require.Equal(t, uint64(i+1), attempt)
+			}
+		})
+	}
+}
+
+func TestServiceBackendClient_RetriesUnreachableBackend(t *testing.T) {
+	// Get the URL of a backend which is no longer listening.
+	backend := httptest.NewServer(http.NotFoundHandler())
+	backendUrl := backend.URL
+	backend.Close()
+
+	backendClient := newServiceBackendClient(&config.RelayMinerSupplierServiceConfig{
+		MaxRetries:   2,
+		RetryBackoff: time.Millisecond,
+	}, 0)
+
+	numRetries := 0
+	_, err := backendClient.do(
+		context.Background(),
+		newTestBackendRequestBuilder(t, http.MethodGet, backendUrl, ""),
+		func(uint64, error) { numRetries++ },
+	)
+	require.Error(t, err)
+	require.Equal(t, 2, numRetries)
+}
+
+func TestServiceBackendClient_Timeout(t *testing.T) {
+	backend := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(time.Second):
+		}
+	}))
+	t.Cleanup(backend.Close)
+
+	t.Run("onchain timeout caps the request timeout", func(t *testing.T) {
+		serviceConfig := &config.RelayMinerSupplierServiceConfig{RequestTimeout: time.Minute}
+		require.Equal(t, 50*time.Millisecond, newServiceBackendClient(serviceConfig, 50*time.Millisecond).requestTimeout)
+		require.Equal(t, time.Minute, newServiceBackendClient(serviceConfig, 2*time.Minute).requestTimeout)
+		require.Equal(t, time.Minute, newServiceBackendClient(serviceConfig, 0).requestTimeout)
+	})
+
+	t.Run("request timeout includes the retries", func(t *testing.T) {
+		backendClient := newServiceBackendClient(&config.RelayMinerSupplierServiceConfig{
+			RequestTimeout: 50 * time.Millisecond,
+			MaxRetries:     10,
+			RetryBackoff:   time.Millisecond,
+		}, 0)
+
+		ctx, cancel := backendClient.withRequestTimeout(context.Background())
+		defer cancel()
+
+		startTime := time.Now()
+		_, err := backendClient.do(
+			ctx,
+			newTestBackendRequestBuilder(t, http.MethodGet, backend.URL, ""),
+			func(uint64, error) {},
+		)
+		require.True(t, isTimeoutError(err))
+		require.Less(t, time.Since(startTime), time.Second)
+	})
+
+	t.Run("response header timeout", func(t *testing.T) {
+		backendClient := newServiceBackendClient(&config.RelayMinerSupplierServiceConfig{
+			ResponseHeaderTimeout: 50 * time.Millisecond,
+		}, 0)
+
+		_, err := backendClient.do(
+			context.Background(),
+			newTestBackendRequestBuilder(t, http.MethodGet, backend.URL, ""),
+			func(uint64, error) {},
+		)
+		require.True(t, isTimeoutError(err))
+	})
+}
+
+func TestServiceBackendClient_RetryBackoff(t *testing.T) {
+	backendClient := newServiceBackendClient(&config.RelayMinerSupplierServiceConfig{
+		RetryBackoff: 100 * time.Millisecond,
+	}, 0)
+
+	// The backoff doubles on every attempt.
+	require.Equal(t, 100*time.Millisecond, backendClient.getRetryBackoff(0))
+	require.Equal(t, 200*time.Millisecond, backendClient.getRetryBackoff(1))
+	require.Equal(t, 400*time.Millisecond, backendClient.getRetryBackoff(2))
+
+	// The backoff is capped, without overflowing for large numbers of attempts.
+	for _, attempt := range []uint64{7, 8, 63, 64, math.MaxUint64} {
+		require.Equal(t, backendMaxRetryBackoff, backendClient.getRetryBackoff(attempt))
+	}
+
+	// A configured backoff above the cap is capped as well.
+	backendClient.retryBackoff = time.Duration(math.MaxInt64)
+	require.Equal(t, backendMaxRetryBackoff, backendClient.getRetryBackoff(0))
+	require.Equal(t, backendMaxRetryBackoff, backendClient.getRetryBackoff(math.MaxUint64))
+
+	// No backoff is doubled to no backoff.
+	backendClient.retryBackoff = 0
+	require.Zero(t, backendClient.getRetryBackoff(math.MaxUint64))
+}
+
+// newTestBackendRequestBuilder returns a function building a new request with
+// the given method, URL and body on each call, as the relay servers do.
+func newTestBackendRequestBuilder(
+	t *testing.T,
+	method string,
+	backendUrl string,
+	body string,
+) func() (*http.Request, error) {
+	t.Helper()
+
+	return func() (*http.Request, error) {
+		request, err := http.NewRequest(method, backendUrl, strings.NewReader(body))
+		if err != nil {
+			return nil, err
+		}
+		// Mimic the relay backend requests which cannot be re-read.
+		request.GetBody = nil
+		return request, nil
+	}
+}
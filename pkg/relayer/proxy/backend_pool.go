@@ -0,0 +1,373 @@
+package proxy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/grpc"
+
+	"github.com/pokt-network/poktroll/pkg/polylog"
+	"github.com/pokt-network/poktroll/pkg/relayer"
+	"github.com/pokt-network/poktroll/pkg/relayer/config"
+)
+
+// serviceBackend is a single backend of a service, along with its load
+// balancing and health state.
+type serviceBackend struct {
+	serviceId string
+
+	// url is the URL relays are forwarded to.
+	url *url.URL
+
+	// label identifies the backend in logs and metrics without exposing any
+	// credentials that its URL may contain.
+	label string
+
+	// weight is the relative share of the relays forwarded to the backend when
+	// using the LoadBalancingPolicyWeighted policy.
+	weight int64
+
+	// grpcConn is the client connection to the backend if it is a gRPC service.
+	grpcConn *grpc.ClientConn
+
+	// inFlight is the number of relays currently being served by the backend.
+	inFlight atomic.Int64
+
+	// healthy is false while the backend is ejected for failing its health checks.
+	healthy atomic.Bool
+
+	// The following fields are guarded by the pool's mutex.
+	currentWeight        int64
+	consecutiveFailures  uint64
+	consecutiveSuccesses uint64
+}
+
+// release MUST be called once the relay acquired with serviceBackendPool.acquire
+// has been served.
+func (backend *serviceBackend) release() {
+	backend.inFlight.Add(-1)
+	relayer.BackendInFlightRequests.
+		With("service_id", backend.serviceId, "backend", backend.label).
+		Add(-1)
+}
+
+// serviceBackendPool load balances the relays of a service across its backends,
+// ejecting the backends which fail their health checks until they recover.
+type serviceBackendPool struct {
+	logger polylog.Logger
+
+	serviceId   string
+	backendType config.RelayMinerSupplierBackendType
+	policy      config.LoadBalancingPolicy
+	healthCheck *config.RelayMinerSupplierServiceHealthCheck
+	backends    []*serviceBackend
+
+	// mu guards the backends selection and health state.
+	mu sync.Mutex
+	// nextIndex is the index of the backend to start the next selection from.
+	nextIndex int
+}
+
+// newServiceBackendPool creates a serviceBackendPool for the backends of the
+// given supplier config. All the backends are initially considered healthy.
+func newServiceBackendPool(
+	logger polylog.Logger,
+	supplierConfig *config.RelayMinerSupplierConfig,
+) (*serviceBackendPool, error) {
+	serviceConfig := supplierConfig.ServiceConfig
+
+	backendConfigs := serviceConfig.Backends
+	if len(backendConfigs) == 0 {
+		backendConfigs = []*config.RelayMinerSupplierServiceBackend{
+			{Url: serviceConfig.BackendUrl, Weight: config.DefaultBackendWeight},
+		}
+	}
+
+	healthCheck := serviceConfig.HealthCheck
+	if healthCheck == nil {
+		healthCheck = &config.RelayMinerSupplierServiceHealthCheck{
+			Interval:           config.DefaultBackendHealthCheckInterval,
+			Timeout:            config.DefaultBackendHealthCheckTimeout,
+			UnhealthyThreshold: config.DefaultBackendUnhealthyThreshold,
+			HealthyThreshold:   config.DefaultBackendHealthyThreshold,
+		}
+	}
+
+	pool := &serviceBackendPool{
+		logger:      logger.With("service_id", supplierConfig.ServiceId),
+		serviceId:   supplierConfig.ServiceId,
+		backendType: supplierConfig.BackendType,
+		policy:      serviceConfig.LoadBalancingPolicy,
+		healthCheck: healthCheck,
+	}
+
+	for _, backendConfig := range backendConfigs {
+		backend := &serviceBackend{
+			serviceId: supplierConfig.ServiceId,
+			url:       backendConfig.Url,
+			label:     backendLabel(backendConfig.Url),
+			weight:    int64(backendConfig.Weight),
+		}
+		backend.healthy.Store(true)
+		relayer.BackendHealthy.
+			With("service_id", pool.serviceId, "backend", backend.label).
+			Set(1)
+
+		if supplierConfig.BackendType == config.RelayMinerSupplierBackendTypeGRPC {
+			conn, err := newGRPCBackendConn(backendConfig.Url)
+			if err != nil {
+				_ = pool.close()
+				return nil, ErrRelayerProxyInternalError.Wrapf(
+					"failed to create gRPC client for service %q: %v",
+					supplierConfig.ServiceId,
+					err,
+				)
+			}
+			backend.grpcConn = conn
+		}
+
+		pool.backends = append(pool.backends, backend)
+	}
+
+	return pool, nil
+}
+
+// acquire selects the backend to forward a relay to and accounts for it as
+// being in flight until its release method is called.
+func (pool *serviceBackendPool) acquire() *serviceBackend {
+	backend := pool.next()
+
+	backend.inFlight.Add(1)
+	labels := []string{"service_id", pool.serviceId, "backend", backend.label}
+	relayer.BackendInFlightRequests.With(labels...).Add(1)
+	relayer.BackendRequestsTotal.With(labels...).Add(1)
+
+	return backend
+}
+
+// next selects a healthy backend according to the pool's load balancing policy.
+// If all the backends are ejected, it selects among all of them rather than
+// failing every relay, since the health checks may be failing while the
+// backends are still able to serve relays.
+func (pool *serviceBackendPool) next() *serviceBackend {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+
+	// candidateIndexes are the indexes of the candidate backends, starting from
+	// nextIndex so the ties are broken in turn.
+	candidateIndexes := make([]int, 0, len(pool.backends))
+	for i := range pool.backends {
+		backendIndex := (pool.nextIndex + i) % len(pool.backends)
+		if pool.backends[backendIndex].healthy.Load() {
+			candidateIndexes = append(candidateIndexes, backendIndex)
+		}
+	}
+	if len(candidateIndexes) == 0 {
+		for i := range pool.backends {
+			candidateIndexes = append(candidateIndexes, (pool.nextIndex+i)%len(pool.backends))
+		}
+	}
+
+	var selectedIndex int
+	switch pool.policy {
+	case config.LoadBalancingPolicyLeastInFlight:
+		selectedIndex = candidateIndexes[0]
+		for _, backendIndex := range candidateIndexes[1:] {
+			if pool.backends[backendIndex].inFlight.Load() < pool.backends[selectedIndex].inFlight.Load() {
+				selectedIndex = backendIndex
+			}
+		}
+
+	case config.LoadBalancingPolicyWeighted:
+		// Smooth weighted round-robin, which spreads the relays of the heavier
+		// backends instead of sending them in bursts.
+		// See: https://github.com/phusion/nginx/commit/27e94984486058d73157038f7950a0a36ecc6e35
+		var totalWeight int64
+		selectedIndex = candidateIndexes[0]
+		for _, backendIndex := range candidateIndexes {
+			backend := pool.backends[backendIndex]
+			backend.currentWeight += backend.weight
+			totalWeight += backend.weight
+			if backend.currentWeight > pool.backends[selectedIndex].currentWeight {
+				selectedIndex = backendIndex
+			}
+		}
+		pool.backends[selectedIndex].currentWeight -= totalWeight
+
+	default:
+		selectedIndex = candidateIndexes[0]
+	}
+
+	// Start the next selection right after the selected backend, so the turns
+	// go over the candidate backends only, instead of favoring the backend
+	// which follows an ejected one.
+	pool.nextIndex = (selectedIndex + 1) % len(pool.backends)
+
+	return pool.backends[selectedIndex]
+}
+
+// ping checks the health of all the backends of the pool, updating their state
+// accordingly. It returns an error if none of them is reachable.
+func (pool *serviceBackendPool) ping(ctx context.Context) error {
+	var (
+		errs      error
+		reachable bool
+	)
+	for _, backend := range pool.backends {
+		err := pingBackend(ctx, pool.backendType, backend.url, pool.healthCheck.Timeout)
+		pool.recordHealthCheck(backend, err)
+		if err != nil {
+			errs = errors.Join(errs, fmt.Errorf("backend %s: %w", backend.label, err))
+			continue
+		}
+		reachable = true
+	}
+
+	if reachable {
+		return nil
+	}
+
+	return errs
+}
+
+// goHealthCheck actively checks the health of the backends on the configured
+// interval until the context is done.
+// It is a no-op for pools of a single backend, since ejecting it would not
+// change where the relays are forwarded to.
+// It is intended to be called in a goroutine.
+func (pool *serviceBackendPool) goHealthCheck(ctx context.Context) {
+	if len(pool.backends) < 2 {
+		return
+	}
+
+	ticker := time.NewTicker(pool.healthCheck.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			_ = pool.ping(ctx)
+		}
+	}
+}
+
+// recordHealthCheck updates the health state of the backend given the result
+// of its latest health check, ejecting or readmitting it once the respective
+// threshold of consecutive failures or successes is reached.
+func (pool *serviceBackendPool) recordHealthCheck(backend *serviceBackend, err error) {
+	// Do not account for checks which were interrupted by the RelayMiner stopping.
+	if err != nil && errors.Is(err, context.Canceled) {
+		return
+	}
+
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+
+	labels := []string{"service_id", pool.serviceId, "backend", backend.label}
+	logger := pool.logger.With("backend", backend.label)
+
+	if err != nil {
+		backend.consecutiveSuccesses = 0
+		backend.consecutiveFailures++
+		relayer.BackendHealthCheckFailuresTotal.With(labels...).Add(1)
+
+		if backend.healthy.Load() && backend.consecutiveFailures >= pool.healthCheck.UnhealthyThreshold {
+			backend.healthy.Store(false)
+			relayer.BackendHealthy.With(labels...).Set(0)
+			relayer.BackendEjectionsTotal.With(labels...).Add(1)
+			logger.Warn().Err(err).Msg("ejecting unhealthy service backend")
+		}
+		return
+	}
+
+	backend.consecutiveFailures = 0
+	backend.consecutiveSuccesses++
+
+	if !backend.healthy.Load() && backend.consecutiveSuccesses >= pool.healthCheck.HealthyThreshold {
+		backend.healthy.Store(true)
+		relayer.BackendHealthy.With(labels...).Set(1)
+		logger.Info().Msg("readmitting recovered service backend")
+	}
+}
+
+// close closes the gRPC client connections to the backends, if any.
+func (pool *serviceBackendPool) close() error {
+	var err error
+	for _, backend := range pool.backends {
+		if backend.grpcConn != nil {
+			err = errors.Join(err, backend.grpcConn.Close())
+		}
+	}
+
+	return err
+}
+
+// pingBackend tests the connectivity of the given backend.
+// gRPC backends are dialed while the others are sent an HTTP HEAD request,
+// which fails if the backend replies with a 5xx status code.
+func pingBackend(
+	ctx context.Context,
+	backendType config.RelayMinerSupplierBackendType,
+	backendUrl *url.URL,
+	timeout time.Duration,
+) error {
+	// gRPC backends do not serve plain HTTP requests, test their
+	// connectivity by dialing them instead.
+	if backendType == config.RelayMinerSupplierBackendTypeGRPC {
+		dialer := &net.Dialer{Timeout: timeout}
+		conn, err := dialer.DialContext(ctx, "tcp", backendUrl.Host)
+		if err != nil {
+			return err
+		}
+		return conn.Close()
+	}
+
+	pingUrl := *backendUrl
+	// TODO_IMPROVE: Consider testing websocket connectivity by establishing
+	// a websocket connection instead of using an HTTP connection.
+	switch pingUrl.Scheme {
+	case "ws":
+		pingUrl.Scheme = "http"
+	case "wss":
+		pingUrl.Scheme = "https"
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodHead, pingUrl.String(), nil)
+	if err != nil {
+		return err
+	}
+
+	c := &http.Client{Timeout: timeout}
+	resp, err := c.Do(request)
+	if err != nil {
+		return err
+	}
+	_ = resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusInternalServerError {
+		return errors.New("ping failed")
+	}
+
+	return nil
+}
+
+// backendLabel returns the backend URL stripped from its credentials and query,
+// which may contain secrets (e.g. API keys), to be used in logs and metrics.
+func backendLabel(backendUrl *url.URL) string {
+	labelUrl := url.URL{
+		Scheme: backendUrl.Scheme,
+		Host:   backendUrl.Host,
+		Path:   backendUrl.Path,
+	}
+
+	return labelUrl.String()
+}
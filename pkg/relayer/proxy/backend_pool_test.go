@@ -0,0 +1,165 @@
+package proxy
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/poktroll/pkg/polylog/polyzero"
+	"github.com/pokt-network/poktroll/pkg/relayer/config"
+)
+
+const testBackendPoolServiceId = "backend_pool_svc"
+
+func TestServiceBackendPool_EjectsAndReadmitsUnhealthyBackend(t *testing.T) {
+	const (
+		unhealthyThreshold = 2
+		healthyThreshold   = 3
+	)
+
+	healthyBackendUrl, _ := newTestPoolBackend(t)
+	flakyBackendUrl, isFlakyBackendFailing := newTestPoolBackend(t)
+
+	pool := newTestServiceBackendPool(t, unhealthyThreshold, healthyThreshold, healthyBackendUrl, flakyBackendUrl)
+
+	// Both backends are initially in rotation.
+	require.ElementsMatch(t, []*url.URL{healthyBackendUrl, flakyBackendUrl}, selectTestPoolBackends(pool, 2))
+
+	// The failing backend stays in rotation until it reaches the unhealthy threshold.
+	isFlakyBackendFailing.Store(true)
+	for range unhealthyThreshold - 1 {
+		require.NoError(t, pool.ping(context.Background()))
+	}
+	require.ElementsMatch(t, []*url.URL{healthyBackendUrl, flakyBackendUrl}, selectTestPoolBackends(pool, 2))
+
+	// The failing backend is ejected once it reaches the unhealthy threshold.
+	require.NoError(t, pool.ping(context.Background()))
+	for _, selectedBackendUrl := range selectTestPoolBackends(pool, 4) {
+		require.Equal(t, healthyBackendUrl, selectedBackendUrl)
+	}
+
+	// The recovered backend stays ejected until it reaches the healthy threshold.
+	isFlakyBackendFailing.Store(false)
+	for range healthyThreshold - 1 {
+		require.NoError(t, pool.ping(context.Background()))
+	}
+	for _, selectedBackendUrl := range selectTestPoolBackends(pool, 4) {
+		require.Equal(t, healthyBackendUrl, selectedBackendUrl)
+	}
+
+	// The recovered backend is readmitted once it reaches the healthy threshold.
+	require.NoError(t, pool.ping(context.Background()))
+	require.ElementsMatch(t, []*url.URL{healthyBackendUrl, flakyBackendUrl}, selectTestPoolBackends(pool, 2))
+}
+
+func TestServiceBackendPool_RoundRobinOverHealthyBackends(t *testing.T) {
+	firstBackendUrl, _ := newTestPoolBackend(t)
+	ejectedBackendUrl, isEjectedBackendFailing := newTestPoolBackend(t)
+	thirdBackendUrl, _ := newTestPoolBackend(t)
+
+	pool := newTestServiceBackendPool(t, 1, 1, firstBackendUrl, ejectedBackendUrl, thirdBackendUrl)
+
+	isEjectedBackendFailing.Store(true)
+	require.NoError(t, pool.ping(context.Background()))
+
+	// The healthy backends take turns, the one following the ejected backend
+	// not being selected twice as often as the other.
+	require.Equal(t,
+		[]*url.URL{firstBackendUrl, thirdBackendUrl, firstBackendUrl, thirdBackendUrl},
+		selectTestPoolBackends(pool, 4),
+	)
+}
+
+func TestServiceBackendPool_AllBackendsEjected(t *testing.T) {
+	firstBackendUrl, isFirstBackendFailing := newTestPoolBackend(t)
+	secondBackendUrl, isSecondBackendFailing := newTestPoolBackend(t)
+
+	pool := newTestServiceBackendPool(t, 1, 1, firstBackendUrl, secondBackendUrl)
+
+	isFirstBackendFailing.Store(true)
+	isSecondBackendFailing.Store(true)
+	require.Error(t, pool.ping(context.Background()))
+
+	// The relays are still forwarded to all the backends rather than failed.
+	require.ElementsMatch(t, []*url.URL{firstBackendUrl, secondBackendUrl}, selectTestPoolBackends(pool, 2))
+}
+
+// newTestPoolBackend starts an HTTP backend which fails its health checks while
+// the returned flag is set, and returns its URL.
+func newTestPoolBackend(t *testing.T) (*url.URL, *atomic.Bool) {
+	t.Helper()
+
+	isFailing := new(atomic.Bool)
+	backend := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if isFailing.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(backend.Close)
+
+	backendUrl, err := url.Parse(backend.URL)
+	require.NoError(t, err)
+
+	return backendUrl, isFailing
+}
+
+// newTestServiceBackendPool creates a round-robin serviceBackendPool of the
+// given backends, with the given health check thresholds.
+func newTestServiceBackendPool(
+	t *testing.T,
+	unhealthyThreshold uint64,
+	healthyThreshold uint64,
+	backendUrls ...*url.URL,
+) *serviceBackendPool {
+	t.Helper()
+
+	backends := make([]*config.RelayMinerSupplierServiceBackend, 0, len(backendUrls))
+	for _, backendUrl := range backendUrls {
+		backends = append(backends, &config.RelayMinerSupplierServiceBackend{
+			Url:    backendUrl,
+			Weight: config.DefaultBackendWeight,
+		})
+	}
+
+	pool, err := newServiceBackendPool(polyzero.NewLogger(), &config.RelayMinerSupplierConfig{
+		ServiceId:   testBackendPoolServiceId,
+		ServerType:  config.RelayMinerServerTypeHTTP,
+		BackendType: config.RelayMinerSupplierBackendTypeHTTP,
+		ServiceConfig: &config.RelayMinerSupplierServiceConfig{
+			BackendUrl:          backendUrls[0],
+			Backends:            backends,
+			LoadBalancingPolicy: config.LoadBalancingPolicyRoundRobin,
+			HealthCheck: &config.RelayMinerSupplierServiceHealthCheck{
+				Interval:           time.Second,
+				Timeout:            time.Second,
+				UnhealthyThreshold: unhealthyThreshold,
+				HealthyThreshold:   healthyThreshold,
+			},
+		},
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = pool.close() })
+
+	return pool
+}
+
+// selectTestPoolBackends returns the URLs of the backends which the next
+// numRelays relays are forwarded to.
+func selectTestPoolBackends(pool *serviceBackendPool, numRelays int) []*url.URL {
+	selectedBackendUrls := make([]*url.URL, 0, numRelays)
+	for range numRelays {
+		backend := pool.acquire()
+		selectedBackendUrls = append(selectedBackendUrls, backend.url)
+		backend.release()
+	}
+
+	return selectedBackendUrls
+}
@@ -2,7 +2,9 @@ package proxy
 
 import (
 	"errors"
+	"math"
 	"net/http"
+	"strconv"
 
 	"github.com/pokt-network/poktroll/pkg/relayer"
 	"github.com/pokt-network/poktroll/x/service/types"
@@ -50,6 +52,17 @@ func (sync *relayMinerHTTPServer) replyWithError(
 		return
 	}
 
+	// Rate limited applications are replied with a 429 status code and hinted
+	// when to retry, if known, so they do not keep sending relays until then.
+	var rateLimitedErr *rateLimitedError
+	if errors.As(replyError, &rateLimitedErr) {
+		if rateLimitedErr.retryAfter > 0 {
+			retryAfterSeconds := int64(math.Ceil(rateLimitedErr.retryAfter.Seconds()))
+			writer.Header().Set("Retry-After", strconv.FormatInt(retryAfterSeconds, 10))
+		}
+		writer.WriteHeader(http.StatusTooManyRequests)
+	}
+
 	if _, err = writer.Write(relayResponseBz); err != nil {
 		errorLogger.Err(err).Msg("failed writing error relay response")
 		return
@@ -15,4 +15,7 @@ var (
 	ErrRelayerProxyRateLimited               = sdkerrors.Register(codespace, 7, "offchain rate limit hit by relayer proxy")
 	ErrRelayerProxyCalculateRelayCost        = sdkerrors.Register(codespace, 8, "failed to calculate relay cost")
 	ErrRelayerProxySupplierNotReachable      = sdkerrors.Register(codespace, 9, "supplier(s) not reachable")
+	ErrRelayerProxyTLSConfig                 = sdkerrors.Register(codespace, 10, "invalid relayer proxy tls configuration")
+	ErrRelayerProxyBackendTimeout            = sdkerrors.Register(codespace, 11, "timed out waiting for the service backend")
+	ErrRelayerProxyAppRateLimited            = sdkerrors.Register(codespace, 12, "application request rate limit hit by relayer proxy")
 )
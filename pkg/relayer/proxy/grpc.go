@@ -0,0 +1,201 @@
+package proxy
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	sdktypes "github.com/pokt-network/shannon-sdk/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/pokt-network/poktroll/pkg/relayer"
+	"github.com/pokt-network/poktroll/pkg/relayer/config"
+	"github.com/pokt-network/poktroll/x/service/types"
+)
+
+// grpcPassthroughStreamDesc describes the stream used to forward any gRPC call
+// to the backend. Unary and server-streaming calls are indistinguishable on the
+// wire from the client's perspective: a single request message followed by
+// zero or more response messages. They are therefore both forwarded as
+// server-streaming calls, without requiring the backend's service descriptors.
+var grpcPassthroughStreamDesc = &grpc.StreamDesc{ServerStreams: true}
+
+// rawGRPCCodec is a gRPC codec that passes the already serialized messages
+// through as-is, which allows forwarding calls of arbitrary message types.
+type rawGRPCCodec struct{}
+
+// Marshal returns the raw message bytes pointed to by v.
+func (rawGRPCCodec) Marshal(v any) ([]byte, error) {
+	messageBz, ok := v.(*[]byte)
+	if !ok {
+		return nil, fmt.Errorf("unexpected gRPC message type %T", v)
+	}
+
+	return *messageBz, nil
+}
+
+// Unmarshal copies the raw message bytes into the byte slice pointed to by v.
+func (rawGRPCCodec) Unmarshal(data []byte, v any) error {
+	messageBz, ok := v.(*[]byte)
+	if !ok {
+		return fmt.Errorf("unexpected gRPC message type %T", v)
+	}
+
+	*messageBz = append((*messageBz)[:0], data...)
+	return nil
+}
+
+// Name returns "proto" so the backend sees a standard "application/grpc+proto"
+// content type, since the forwarded messages are protobuf encoded.
+func (rawGRPCCodec) Name() string {
+	return "proto"
+}
+
+// newGRPCBackendConn creates a gRPC client connection to the given backend.
+// The connection is established lazily, on the first forwarded call.
+func newGRPCBackendConn(backendUrl *url.URL) (*grpc.ClientConn, error) {
+	transportCredentials := insecure.NewCredentials()
+	if backendUrl.Scheme == "grpcs" {
+		transportCredentials = credentials.NewTLS(&tls.Config{})
+	}
+
+	return grpc.NewClient(
+		backendUrl.Host,
+		grpc.WithTransportCredentials(transportCredentials),
+		grpc.WithDefaultCallOptions(grpc.ForceCodec(rawGRPCCodec{})),
+	)
+}
+
+// forwardGRPCRequest decodes the relay request payload into a gRPC call, forwards
+// it to the given gRPC backend of the service and returns the serialized response
+// to be embedded into the RelayResponse.
+//
+// The response is serialized as a POKTHTTPResponse of the gRPC call, as a gRPC
+// client would receive it over HTTP/2: the response metadata and trailers
+// (including grpc-status and grpc-message) as headers, and the response messages
+// as a sequence of gRPC length-prefixed messages in the body.
+func (server *relayMinerHTTPServer) forwardGRPCRequest(
+	ctx context.Context,
+	relayRequest *types.RelayRequest,
+	serviceId string,
+	serviceConfig *config.RelayMinerSupplierServiceConfig,
+	backend *serviceBackend,
+) ([]byte, error) {
+	backendClient, ok := server.backendClients[serviceId]
+	if !ok || backend.grpcConn == nil {
+		return nil, ErrRelayerProxyServiceEndpointNotHandled.Wrapf(
+			"no gRPC backend for service %q",
+			serviceId,
+		)
+	}
+
+	grpcRequest, err := relayer.BuildServiceBackendGRPCRequest(relayRequest, serviceConfig)
+	if err != nil {
+		server.logger.Error().Err(err).Msg("failed to build the service backend gRPC request")
+		return nil, ErrRelayerProxyInternalError.Wrapf("failed to build the service backend gRPC request: %v", err)
+	}
+
+	// Honor the same overall request timeout as HTTP backends.
+	ctx, cancel := backendClient.withRequestTimeout(metadata.NewOutgoingContext(ctx, grpcRequest.Metadata))
+	defer cancel()
+
+	stream, err := backend.grpcConn.NewStream(ctx, grpcPassthroughStreamDesc, grpcRequest.FullMethod)
+	if err != nil {
+		return nil, server.grpcCallError(serviceId, err)
+	}
+
+	if err = stream.SendMsg(&grpcRequest.Message); err != nil && !errors.Is(err, io.EOF) {
+		return nil, server.grpcCallError(serviceId, err)
+	}
+
+	if err = stream.CloseSend(); err != nil {
+		return nil, server.grpcCallError(serviceId, err)
+	}
+
+	// Collect all the response messages (i.e. exactly one for unary calls).
+	var responseBodyBz []byte
+	for {
+		var responseMessage []byte
+		if err = stream.RecvMsg(&responseMessage); err != nil {
+			break
+		}
+		responseBodyBz = relayer.AppendGRPCFrame(responseBodyBz, responseMessage)
+	}
+
+	// io.EOF designates a successful end of the stream (i.e. status OK).
+	var callStatus *status.Status
+	if errors.Is(err, io.EOF) {
+		callStatus = status.New(codes.OK, "")
+	} else {
+		// The backend not being reachable is an issue of the RelayMiner, not a
+		// response of the backend to the relayed call.
+		if status.Code(err) == codes.Unavailable || ctx.Err() != nil {
+			return nil, server.grpcCallError(serviceId, err)
+		}
+		callStatus = status.Convert(err)
+	}
+
+	header := http.Header{}
+	header.Set("Content-Type", "application/grpc")
+
+	// Response header metadata is available once the first response is received.
+	// It is absent if the call failed before the backend sent any header.
+	if headerMD, headerErr := stream.Header(); headerErr == nil {
+		copyGRPCMetadataToHTTPHeader(headerMD, header)
+	}
+	copyGRPCMetadataToHTTPHeader(stream.Trailer(), header)
+
+	header.Set("Grpc-Status", strconv.Itoa(int(callStatus.Code())))
+	if callStatus.Message() != "" {
+		header.Set("Grpc-Message", callStatus.Message())
+	}
+
+	httpResponse := &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     header,
+		Body:       io.NopCloser(bytes.NewReader(responseBodyBz)),
+	}
+
+	_, responseBz, err := sdktypes.SerializeHTTPResponse(httpResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return responseBz, nil
+}
+
+// copyGRPCMetadataToHTTPHeader copies the given gRPC metadata to the http header,
+// skipping the reserved grpc-* keys which are set from the call status.
+func copyGRPCMetadataToHTTPHeader(md metadata.MD, header http.Header) {
+	for key, values := range md {
+		if key == "grpc-status" || key == "grpc-message" || key == "content-type" {
+			continue
+		}
+
+		for _, value := range values {
+			header.Add(key, relayer.EncodeGRPCMetadataHeader(key, value))
+		}
+	}
+}
+
+// grpcCallError converts errors which occurred while forwarding a gRPC call
+// into the relay error replied to the client, surfacing deadline exceeded
+// errors as backend timeouts.
+func (server *relayMinerHTTPServer) grpcCallError(serviceId string, err error) error {
+	if status.Code(err) == codes.DeadlineExceeded {
+		err = context.DeadlineExceeded
+	}
+
+	return server.backendError(serviceId, err)
+}
@@ -0,0 +1,274 @@
+package proxy
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	sdktypes "github.com/pokt-network/shannon-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/pokt-network/poktroll/pkg/polylog/polyzero"
+	"github.com/pokt-network/poktroll/pkg/relayer"
+	"github.com/pokt-network/poktroll/pkg/relayer/config"
+	"github.com/pokt-network/poktroll/x/service/types"
+)
+
+const (
+	testGRPCServiceId = "grpc_svc"
+
+	// The methods of the test gRPC backend, which replies according to the
+	// method being called.
+	testGRPCMethodEcho   = "/test.Backend/Echo"
+	testGRPCMethodStream = "/test.Backend/Stream"
+	testGRPCMethodFail   = "/test.Backend/Fail"
+	testGRPCMethodSlow   = "/test.Backend/Slow"
+)
+
+func TestRelayMinerHTTPServer_ForwardGRPCRequest(t *testing.T) {
+	backendUrl := newTestGRPCBackend(t)
+	server := newTestGRPCRelayMinerHTTPServer(t, backendUrl, 0)
+
+	t.Run("unary call", func(t *testing.T) {
+		response := forwardTestGRPCRequest(t, server, testGRPCMethodEcho, []byte("ping"))
+
+		require.Equal(t, uint32(http.StatusOK), response.StatusCode)
+		header := http.Header{}
+		response.CopyToHTTPHeader(header)
+		require.Equal(t, "application/grpc", header.Get("Content-Type"))
+		require.Equal(t, "0", header.Get("Grpc-Status"))
+		require.Empty(t, header.Get("Grpc-Message"))
+
+		// The backend received the request metadata along with the service
+		// configured headers, and its response metadata and trailers are relayed.
+		require.Equal(t, "secret", header.Get("X-Received-Api-Key"))
+		require.Equal(t, "42", header.Get("X-Received-Request-Id"))
+		require.Equal(t, "done", header.Get("X-Trailer"))
+
+		messages, err := relayer.ParseGRPCFrames(response.BodyBz)
+		require.NoError(t, err)
+		require.Equal(t, [][]byte{[]byte("ping")}, messages)
+	})
+
+	t.Run("server-streaming call", func(t *testing.T) {
+		response := forwardTestGRPCRequest(t, server, testGRPCMethodStream, []byte("ping"))
+
+		header := http.Header{}
+		response.CopyToHTTPHeader(header)
+		require.Equal(t, "0", header.Get("Grpc-Status"))
+
+		messages, err := relayer.ParseGRPCFrames(response.BodyBz)
+		require.NoError(t, err)
+		require.Equal(t, [][]byte{[]byte("ping-0"), []byte("ping-1"), []byte("ping-2")}, messages)
+	})
+
+	t.Run("call failed by the backend", func(t *testing.T) {
+		// Failed calls are a response of the backend which is relayed as is.
+		response := forwardTestGRPCRequest(t, server, testGRPCMethodFail, []byte("ping"))
+
+		require.Equal(t, uint32(http.StatusOK), response.StatusCode)
+		header := http.Header{}
+		response.CopyToHTTPHeader(header)
+		require.Equal(t, "5", header.Get("Grpc-Status"))
+		require.Equal(t, "ping not found", header.Get("Grpc-Message"))
+		require.Empty(t, response.BodyBz)
+	})
+}
+
+func TestRelayMinerHTTPServer_ForwardGRPCRequest_BackendErrors(t *testing.T) {
+	t.Run("request timeout", func(t *testing.T) {
+		server := newTestGRPCRelayMinerHTTPServer(t, newTestGRPCBackend(t), 50*time.Millisecond)
+
+		_, err := server.forwardToBackend(
+			context.Background(),
+			newTestGRPCRelayRequest(t, testGRPCMethodSlow, []byte("ping")),
+			server.serverConfig.SupplierConfigsMap[testGRPCServiceId],
+		)
+		require.ErrorIs(t, err, ErrRelayerProxyBackendTimeout)
+	})
+
+	t.Run("unreachable backend", func(t *testing.T) {
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		backendUrl := &url.URL{Scheme: "grpc", Host: listener.Addr().String()}
+		require.NoError(t, listener.Close())
+
+		server := newTestGRPCRelayMinerHTTPServer(t, backendUrl, 0)
+		_, err = server.forwardToBackend(
+			context.Background(),
+			newTestGRPCRelayRequest(t, testGRPCMethodEcho, []byte("ping")),
+			server.serverConfig.SupplierConfigsMap[testGRPCServiceId],
+		)
+		require.ErrorIs(t, err, ErrRelayerProxyInternalError)
+	})
+
+	t.Run("malformed gRPC request", func(t *testing.T) {
+		server := newTestGRPCRelayMinerHTTPServer(t, newTestGRPCBackend(t), 0)
+
+		_, err := server.forwardToBackend(
+			context.Background(),
+			newTestGRPCRelayRequest(t, "/test.Backend", []byte("ping")),
+			server.serverConfig.SupplierConfigsMap[testGRPCServiceId],
+		)
+		require.ErrorIs(t, err, ErrRelayerProxyInternalError)
+	})
+}
+
+// newTestGRPCBackend starts a gRPC backend serving any method with the raw
+// messages it is sent, and returns its URL.
+func newTestGRPCBackend(t *testing.T) *url.URL {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	grpcServer := grpc.NewServer(
+		grpc.ForceServerCodec(rawGRPCCodec{}),
+		grpc.UnknownServiceHandler(serveTestGRPCCall),
+	)
+	go func() { _ = grpcServer.Serve(listener) }()
+	t.Cleanup(grpcServer.Stop)
+
+	return &url.URL{Scheme: "grpc", Host: listener.Addr().String()}
+}
+
+// serveTestGRPCCall replies to a call of the test gRPC backend according to
+// its method, echoing the request metadata in the response metadata.
+func serveTestGRPCCall(_ any, stream grpc.ServerStream) error {
+	method, _ := grpc.MethodFromServerStream(stream)
+
+	var request []byte
+	if err := stream.RecvMsg(&request); err != nil {
+		return err
+	}
+
+	incomingMD, _ := metadata.FromIncomingContext(stream.Context())
+	responseMD := metadata.Pairs(
+		"x-received-api-key", firstMetadataValue(incomingMD, "x-api-key"),
+		"x-received-request-id", firstMetadataValue(incomingMD, "x-request-id"),
+	)
+	if err := stream.SendHeader(responseMD); err != nil {
+		return err
+	}
+	stream.SetTrailer(metadata.Pairs("x-trailer", "done"))
+
+	switch method {
+	case testGRPCMethodEcho:
+		return stream.SendMsg(&request)
+	case testGRPCMethodStream:
+		for i := range 3 {
+			response := append(bytes.Clone(request), []byte{'-', byte('0' + i)}...)
+			if err := stream.SendMsg(&response); err != nil {
+				return err
+			}
+		}
+		return nil
+	case testGRPCMethodFail:
+		return status.Errorf(codes.NotFound, "%s not found", request)
+	case testGRPCMethodSlow:
+		<-stream.Context().Done()
+		return stream.Context().Err()
+	default:
+		return status.Errorf(codes.Unimplemented, "unknown method %s", method)
+	}
+}
+
+// firstMetadataValue returns the first value of the given metadata key, if any.
+func firstMetadataValue(md metadata.MD, key string) string {
+	if values := md.Get(key); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+// newTestGRPCRelayMinerHTTPServer creates a relayMinerHTTPServer forwarding
+// the relays of a single gRPC service to the given backend.
+func newTestGRPCRelayMinerHTTPServer(
+	t *testing.T,
+	backendUrl *url.URL,
+	requestTimeout time.Duration,
+) *relayMinerHTTPServer {
+	t.Helper()
+
+	logger := polyzero.NewLogger()
+	supplierConfig := &config.RelayMinerSupplierConfig{
+		ServiceId:   testGRPCServiceId,
+		ServerType:  config.RelayMinerServerTypeHTTP,
+		BackendType: config.RelayMinerSupplierBackendTypeGRPC,
+		ServiceConfig: &config.RelayMinerSupplierServiceConfig{
+			BackendUrl:     backendUrl,
+			Headers:        map[string]string{"X-Api-Key": "secret"},
+			RequestTimeout: requestTimeout,
+		},
+	}
+
+	backendPool, err := newServiceBackendPool(logger, supplierConfig)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = backendPool.close() })
+
+	return &relayMinerHTTPServer{
+		logger: logger,
+		serverConfig: &config.RelayMinerServerConfig{
+			SupplierConfigsMap: map[string]*config.RelayMinerSupplierConfig{
+				testGRPCServiceId: supplierConfig,
+			},
+		},
+		backendPools: map[string]*serviceBackendPool{testGRPCServiceId: backendPool},
+		backendClients: map[string]*serviceBackendClient{
+			testGRPCServiceId: newServiceBackendClient(supplierConfig.ServiceConfig, 0),
+		},
+	}
+}
+
+// newTestGRPCRelayRequest returns a relay request whose payload is the given
+// gRPC call, as sent over HTTP/2 by a gRPC client.
+func newTestGRPCRelayRequest(t *testing.T, fullMethod string, message []byte) *types.RelayRequest {
+	t.Helper()
+
+	httpRequest := &http.Request{
+		Method: http.MethodPost,
+		URL:    &url.URL{Scheme: "https", Host: "relayminer", Path: fullMethod},
+		Header: http.Header{
+			"Content-Type": []string{"application/grpc"},
+			"X-Request-Id": []string{"42"},
+		},
+		Body: io.NopCloser(bytes.NewReader(relayer.AppendGRPCFrame(nil, message))),
+	}
+
+	_, payloadBz, err := sdktypes.SerializeHTTPRequest(httpRequest)
+	require.NoError(t, err)
+
+	return &types.RelayRequest{Payload: payloadBz}
+}
+
+// forwardTestGRPCRequest forwards the given gRPC call through the server and
+// returns the deserialized backend response.
+func forwardTestGRPCRequest(
+	t *testing.T,
+	server *relayMinerHTTPServer,
+	fullMethod string,
+	message []byte,
+) *sdktypes.POKTHTTPResponse {
+	t.Helper()
+
+	responseBz, err := server.forwardToBackend(
+		context.Background(),
+		newTestGRPCRelayRequest(t, fullMethod, message),
+		server.serverConfig.SupplierConfigsMap[testGRPCServiceId],
+	)
+	require.NoError(t, err)
+
+	response, err := sdktypes.DeserializeHTTPResponse(responseBz)
+	require.NoError(t, err)
+
+	return response
+}
@@ -3,6 +3,7 @@ package proxy
 import (
 	"context"
 	"errors"
+	"fmt"
 	"net"
 	"net/http"
 	"time"
@@ -43,6 +44,28 @@ type relayMinerHTTPServer struct {
 	// server is the HTTP server that listens for incoming relay requests.
 	server *http.Server
 
+	// tlsCertReloader holds and hot reloads the server's TLS certificate.
+	// It is nil unless the server is of type RelayMinerServerTypeHTTPS.
+	tlsCertReloader *tlsCertReloader
+
+	// backendPools is a map of serviceId -> pool of the service's backends
+	// the relays are load balanced across.
+	backendPools map[string]*serviceBackendPool
+
+	// backendClients is a map of serviceId -> client used to forward the relays
+	// to the service backend, owning its connection pool, timeouts and retries.
+	backendClients map[string]*serviceBackendClient
+
+	// appRateLimiters is a map of serviceId -> rate limiter of the relay requests
+	// of each application of the service. Services without a rate limit
+	// configuration have no entry.
+	appRateLimiters map[string]*appRateLimiter
+
+	// responseCaches is a map of serviceId -> cache of the service's backend
+	// responses to deterministic JSON-RPC requests. Services without a response
+	// cache configuration have no entry.
+	responseCaches map[string]*serviceResponseCache
+
 	// relayAuthenticator is the RelayMiner's relay authenticator that validates
 	// the relay requests and signs the relay responses.
 	relayAuthenticator relayer.RelayAuthenticator
@@ -63,6 +86,10 @@ type relayMinerHTTPServer struct {
 
 // NewHTTPServer creates a new RelayServer that listens for incoming relay requests
 // and forwards them to the corresponding proxied service endpoint.
+// If the server config is of type RelayMinerServerTypeHTTPS, the server terminates
+// TLS itself using the configured certificate, which is hot reloaded on change.
+// onchainServiceTimeouts is a map of serviceId -> TIMEOUT advertised by the
+// supplier's onchain endpoints, which caps the service's backend request timeout.
 // TODO_RESEARCH(#590): Currently, the communication between the Gateway and the
 // RelayMiner uses HTTP. This could be changed to a more generic and performant
 // one, such as QUIC or pure TCP.
@@ -75,7 +102,8 @@ func NewHTTPServer(
 	blockClient client.BlockClient,
 	sharedQueryClient client.SharedQueryClient,
 	sessionQueryClient client.SessionQueryClient,
-) relayer.RelayServer {
+	onchainServiceTimeouts map[string]time.Duration,
+) (relayer.RelayServer, error) {
 	// Create the HTTP server.
 	httpServer := &http.Server{
 		// TODO_IMPROVE: Make timeouts configurable.
@@ -84,9 +112,69 @@ func NewHTTPServer(
 		WriteTimeout: 10 * time.Second,
 	}
 
+	var certReloader *tlsCertReloader
+	if serverConfig.ServerType == config.RelayMinerServerTypeHTTPS {
+		if serverConfig.TLS == nil {
+			return nil, ErrRelayerProxyTLSConfig.Wrapf(
+				"missing tls config for https server %q",
+				serverConfig.ListenAddress,
+			)
+		}
+
+		var err error
+		certReloader, err = newTLSCertReloader(logger, serverConfig.TLS.CertFile, serverConfig.TLS.KeyFile)
+		if err != nil {
+			return nil, err
+		}
+
+		if httpServer.TLSConfig, err = newServerTLSConfig(serverConfig.TLS, certReloader); err != nil {
+			return nil, err
+		}
+	}
+
+	backendPools := make(map[string]*serviceBackendPool)
+	backendClients := make(map[string]*serviceBackendClient)
+	appRateLimiters := make(map[string]*appRateLimiter)
+	responseCaches := make(map[string]*serviceResponseCache)
+	for serviceId, supplierConfig := range serverConfig.SupplierConfigsMap {
+		backendPool, err := newServiceBackendPool(logger, supplierConfig)
+		if err != nil {
+			for _, pool := range backendPools {
+				_ = pool.close()
+			}
+			return nil, err
+		}
+		backendPools[serviceId] = backendPool
+
+		backendClients[serviceId] = newServiceBackendClient(
+			supplierConfig.ServiceConfig,
+			onchainServiceTimeouts[serviceId],
+		)
+
+		if rateLimit := supplierConfig.ServiceConfig.RateLimit; rateLimit != nil {
+			appRateLimiters[serviceId] = newAppRateLimiter(serviceId, rateLimit)
+		}
+
+		if responseCacheConfig := supplierConfig.ServiceConfig.ResponseCache; responseCacheConfig != nil {
+			responseCache, err := newServiceResponseCache(serviceId, responseCacheConfig)
+			if err != nil {
+				for _, pool := range backendPools {
+					_ = pool.close()
+				}
+				return nil, err
+			}
+			responseCaches[serviceId] = responseCache
+		}
+	}
+
 	return &relayMinerHTTPServer{
 		logger:               logger,
 		server:               httpServer,
+		tlsCertReloader:      certReloader,
+		backendPools:         backendPools,
+		backendClients:       backendClients,
+		appRateLimiters:      appRateLimiters,
+		responseCaches:       responseCaches,
 		relayAuthenticator:   relayAuthenticator,
 		servedRelaysProducer: servedRelaysProducer,
 		serverConfig:         serverConfig,
@@ -94,7 +182,7 @@ func NewHTTPServer(
 		blockClient:          blockClient,
 		sharedQueryClient:    sharedQueryClient,
 		sessionQueryClient:   sessionQueryClient,
-	}
+	}, nil
 }
 
 // Start starts the service server and returns an error if it fails.
@@ -109,54 +197,60 @@ func (server *relayMinerHTTPServer) Start(ctx context.Context) error {
 	// Set the HTTP handler.
 	server.server.Handler = server
 
+	// Actively check the health of the services' backends so the unhealthy
+	// ones are ejected from the load balancing until they recover.
+	for _, backendPool := range server.backendPools {
+		go backendPool.goHealthCheck(ctx)
+	}
+
+	for _, appRateLimiter := range server.appRateLimiters {
+		go appRateLimiter.goPruneIdleAppLimiters(ctx)
+	}
+
 	listener, err := net.Listen("tcp", server.serverConfig.ListenAddress)
 	if err != nil {
 		server.logger.Error().Err(err).Msg("failed to create listener")
 		return err
 	}
 
+	// Terminate TLS using the certificate provided by the tlsCertReloader,
+	// which keeps it up to date with the files on disk.
+	if server.tlsCertReloader != nil {
+		go server.tlsCertReloader.goReloadOnInterval(ctx, server.serverConfig.TLS.ReloadInterval)
+		return server.server.ServeTLS(listener, "", "")
+	}
+
 	return server.server.Serve(listener)
 }
 
 // Stop terminates the service server and returns an error if it fails.
+// It also closes the connections to the service backends.
 func (server *relayMinerHTTPServer) Stop(ctx context.Context) error {
-	return server.server.Shutdown(ctx)
+	err := server.server.Shutdown(ctx)
+
+	for _, backendPool := range server.backendPools {
+		err = errors.Join(err, backendPool.close())
+	}
+
+	for _, backendClient := range server.backendClients {
+		backendClient.httpClient.CloseIdleConnections()
+	}
+
+	return err
 }
 
 // Ping tries to dial the suppliers backend URLs to test the connection.
+// The backends which cannot be reached are accounted as having failed a health
+// check, and an error is returned if none of a service's backends is reachable.
 func (server *relayMinerHTTPServer) Ping(ctx context.Context) error {
-	for _, supplierCfg := range server.serverConfig.SupplierConfigsMap {
-		c := &http.Client{Timeout: 2 * time.Second}
-
-		backendUrl := *supplierCfg.ServiceConfig.BackendUrl
-		if backendUrl.Scheme == "ws" || backendUrl.Scheme == "wss" {
-			// TODO_IMPROVE: Consider testing websocket connectivity by establishing
-			// a websocket connection instead of using an HTTP connection.
-			server.logger.Warn().Msgf(
-				"backend URL %s scheme is a %s, switching to http to check connectivity",
-				backendUrl.String(),
-				backendUrl.Scheme,
-			)
-
-			if backendUrl.Scheme == "ws" {
-				backendUrl.Scheme = "http"
-			} else {
-				backendUrl.Scheme = "https"
-			}
+	var err error
+	for serviceId, backendPool := range server.backendPools {
+		if pingErr := backendPool.ping(ctx); pingErr != nil {
+			err = errors.Join(err, fmt.Errorf("service %q: %w", serviceId, pingErr))
 		}
-		resp, err := c.Head(backendUrl.String())
-		if err != nil {
-			return err
-		}
-		_ = resp.Body.Close()
-
-		if resp.StatusCode >= http.StatusInternalServerError {
-			return errors.New("ping failed")
-		}
-
 	}
 
-	return nil
+	return err
 }
 
 // ServeHTTP listens for incoming relay requests. It implements the respective
@@ -170,7 +264,19 @@ func (server *relayMinerHTTPServer) ServeHTTP(writer http.ResponseWriter, reques
 	if isWebSocketRequest(request) {
 		server.logger.Debug().Msg("detected asynchronous relay request")
 
-		if err := server.handleAsyncConnection(ctx, writer, request); err != nil {
+		// Limit the rate and concurrency of the application's websocket connections
+		// before establishing them.
+		serviceId := request.Header.Get("Target-Service-Id")
+		appAddress := request.Header.Get("App-Address")
+		release, err := server.acquireAppRateLimit(serviceId, appAddress)
+		if err != nil {
+			server.replyWithError(err, nil, writer)
+			server.logger.Warn().Err(err).Msg("rate limited asynchronous relay request")
+			return
+		}
+		defer release()
+
+		if err := server.handleAsyncConnection(ctx, writer, request, serviceId, appAddress); err != nil {
 			// Reply with an error if the relay could not be served.
 			server.replyWithError(err, nil, writer)
 			server.logger.Warn().Err(err).Msg("failed serving asynchronous relay request")
@@ -179,7 +285,25 @@ func (server *relayMinerHTTPServer) ServeHTTP(writer http.ResponseWriter, reques
 	} else {
 		server.logger.Debug().Msg("detected synchronous relay request")
 
-		if relayRequest, err := server.serveSyncRequest(ctx, writer, request); err != nil {
+		relayRequest, err := server.extractSyncRelayRequest(request)
+		if err != nil {
+			server.replyWithError(err, relayRequest, writer)
+			server.logger.Warn().Err(err).Msg("failed extracting synchronous relay request")
+			return
+		}
+
+		// Limit the rate and concurrency of the application's relay requests before
+		// spending any resources on verifying their signature.
+		sessionHeader := relayRequest.GetMeta().SessionHeader
+		release, err := server.acquireAppRateLimit(sessionHeader.GetServiceId(), sessionHeader.GetApplicationAddress())
+		if err != nil {
+			server.replyWithError(err, relayRequest, writer)
+			server.logger.Warn().Err(err).Msg("rate limited synchronous relay request")
+			return
+		}
+		defer release()
+
+		if err := server.serveSyncRequest(ctx, writer, relayRequest); err != nil {
 			// Reply with an error if the relay could not be served.
 			server.replyWithError(err, relayRequest, writer)
 			server.logger.Warn().Err(err).Msg("failed serving synchronous relay request")
@@ -188,6 +312,18 @@ func (server *relayMinerHTTPServer) ServeHTTP(writer http.ResponseWriter, reques
 	}
 }
 
+// acquireAppRateLimit acquires a relay request slot of the given application from
+// the rate limiter of the given service, if the service is rate limited.
+// The returned release function MUST be called once the relay request is served.
+func (server *relayMinerHTTPServer) acquireAppRateLimit(serviceId, appAddress string) (release func(), err error) {
+	appRateLimiter, ok := server.appRateLimiters[serviceId]
+	if !ok {
+		return func() {}, nil
+	}
+
+	return appRateLimiter.acquire(appAddress)
+}
+
 // isWebSocketRequest checks if the request is trying to upgrade to WebSocket.
 func isWebSocketRequest(r *http.Request) bool {
 	// Check if the request is trying to upgrade to WebSocket as per the RFC 6455.
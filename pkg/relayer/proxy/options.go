@@ -14,3 +14,21 @@ func WithServicesConfigMap(servicesConfigMap map[string]*config.RelayMinerServer
 		relProxy.(*relayerProxy).serverConfigs = servicesConfigMap
 	}
 }
+
+// WithRelayMeterStoresDirectory sets the path on disk where the relay meter
+// persists the stake consumed by each application per session, so that it is
+// not forgotten across RelayMiner restarts.
+func WithRelayMeterStoresDirectory(storesDirectory string) relayer.RelayMeterOption {
+	return func(relayMeter relayer.RelayMeter) {
+		relayMeter.(*ProxyRelayMeter).storesDirectory = storesDirectory
+	}
+}
+
+// WithRelayMeterConfig sets the over-servicing policy of the relay meter.
+// If not provided, the relay meter over-services applications up to
+// config.DefaultOverServicingAllowanceUpokt per session.
+func WithRelayMeterConfig(relayMeterConfig *config.RelayMinerRelayMeterConfig) relayer.RelayMeterOption {
+	return func(relayMeter relayer.RelayMeter) {
+		relayMeter.(*ProxyRelayMeter).relayMeterConfig = relayMeterConfig
+	}
+}
@@ -3,6 +3,7 @@ package proxy
 import (
 	"context"
 	"errors"
+	"time"
 
 	"cosmossdk.io/depinject"
 	"golang.org/x/sync/errgroup"
@@ -59,6 +60,11 @@ type relayerProxy struct {
 	// is its configuration.
 	serverConfigs map[string]*config.RelayMinerServerConfig
 
+	// onchainServiceTimeouts is a map of serviceId -> TIMEOUT config option
+	// advertised by the supplier(s) onchain endpoints of the service.
+	// It is populated when building the provided services.
+	onchainServiceTimeouts map[string]time.Duration
+
 	// servedRelays is an observable that notifies the miner about the relays that have been served.
 	servedRelays relayer.RelaysObservable
 
@@ -100,7 +106,11 @@ func NewRelayerProxy(
 		return nil, err
 	}
 
-	servedRelays, servedRelaysProducer := channel.NewObservable[*types.Relay]()
+	// The served relays are the first stage of the relay → miner → session tree
+	// pipeline, which is instrumented stage by stage (see channel.WithStageName).
+	servedRelays, servedRelaysProducer := channel.NewObservable[*types.Relay](
+		channel.WithStageName[*types.Relay]("proxy_served_relays"),
+	)
 
 	rp.servedRelays = servedRelays
 	rp.servedRelaysPublishCh = servedRelaysProducer
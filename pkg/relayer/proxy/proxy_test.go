@@ -613,6 +613,63 @@ func (t *RelayProxyPingAllSuite) TestOKPingAllWithSingleRelayServer() {
 	require.NoError(t.T(), err)
 }
 
+// TestOKPingAllWithPartiallyUnreachableBackends tests that the connectivity
+// check succeeds as long as one of a service's backends is reachable.
+func (t *RelayProxyPingAllSuite) TestOKPingAllWithPartiallyUnreachableBackends() {
+	ctx, cancel := context.WithCancel(context.TODO())
+	defer cancel()
+
+	// The reachable backend is the default service's one, which is served by
+	// the default relayminer behavior.
+	relayMinerServerAddress := "127.0.0.1:8250"
+	reachableBackendUrl := &url.URL{Scheme: "http", Host: "127.0.0.1:8645", Path: "/"}
+	unreachableBackendUrl := &url.URL{Scheme: "http", Host: "127.0.0.1:8651", Path: "/"}
+
+	cm := map[string]*config.RelayMinerServerConfig{
+		relayMinerServerAddress: {
+			ServerType:    config.RelayMinerServerTypeHTTP,
+			ListenAddress: relayMinerServerAddress,
+			SupplierConfigsMap: map[string]*config.RelayMinerSupplierConfig{
+				defaultService: {
+					ServiceId:  defaultService,
+					ServerType: config.RelayMinerServerTypeHTTP,
+					ServiceConfig: &config.RelayMinerSupplierServiceConfig{
+						BackendUrl: reachableBackendUrl,
+						Backends: []*config.RelayMinerSupplierServiceBackend{
+							{Url: reachableBackendUrl, Weight: 1},
+							{Url: unreachableBackendUrl, Weight: 1},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	test := testproxy.NewRelayerProxyTestBehavior(ctx, t.T(), []string{supplierOperatorKeyName}, t.relayerProxyBehavior...)
+
+	rp, err := proxy.NewRelayerProxy(
+		test.Deps,
+		proxy.WithServicesConfigMap(cm),
+	)
+	require.NoError(t.T(), err)
+
+	go func() {
+		if errStart := rp.Start(ctx); !errors.Is(errStart, http.ErrServerClosed) {
+			require.NoError(t.T(), errStart)
+		}
+	}()
+
+	// waiting for relayer proxy to start
+	// and perform ping request.
+	time.Sleep(100 * time.Millisecond)
+
+	err = rp.PingAll(ctx)
+	require.NoError(t.T(), err)
+
+	err = rp.Stop(ctx)
+	require.NoError(t.T(), err)
+}
+
 // TestOKPingAllWithMultipleRelayServers reuses default relayminer and
 // instantiates an additional one to test the connectivity.
 func (t *RelayProxyPingAllSuite) TestOKPingAllWithMultipleRelayServers() {
@@ -3,33 +3,52 @@ package proxy
 import (
 	"context"
 	"math/big"
-	"strings"
+	"path"
 	"sync"
+	"time"
 
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/math"
 	cosmostypes "github.com/cosmos/cosmos-sdk/types"
-	"github.com/cosmos/gogoproto/proto"
+	"github.com/pokt-network/smt/kvstore/pebble"
 
 	"github.com/pokt-network/poktroll/app/volatile"
 	"github.com/pokt-network/poktroll/pkg/client"
-	"github.com/pokt-network/poktroll/pkg/client/tx"
+	"github.com/pokt-network/poktroll/pkg/client/events"
 	"github.com/pokt-network/poktroll/pkg/crypto/protocol"
-	"github.com/pokt-network/poktroll/pkg/either"
-	"github.com/pokt-network/poktroll/pkg/observable"
 	"github.com/pokt-network/poktroll/pkg/observable/channel"
 	"github.com/pokt-network/poktroll/pkg/polylog"
 	"github.com/pokt-network/poktroll/pkg/relayer"
+	"github.com/pokt-network/poktroll/pkg/relayer/config"
 	apptypes "github.com/pokt-network/poktroll/x/application/types"
 	servicetypes "github.com/pokt-network/poktroll/x/service/types"
 	sessiontypes "github.com/pokt-network/poktroll/x/session/types"
 	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
 )
 
-const defaultOverServicingAllowanceCoins = 1000000
-
 var _ relayer.RelayMeter = (*ProxyRelayMeter)(nil)
 
+// rateLimitedError is the error returned when an application is rate limited,
+// either by the relay meter configured to reply with RateLimitedReplyPolicyTooManyRequests
+// or by the per-application rate limit of a service.
+// It wraps ErrRelayerProxyRateLimited or ErrRelayerProxyAppRateLimited respectively,
+// and is replied with an HTTP 429 status code.
+type rateLimitedError struct {
+	err error
+	// retryAfter is the estimated duration after which the application can be
+	// served again (e.g. the next session start for the relay meter).
+	// It is zero if the duration is unknown.
+	retryAfter time.Duration
+}
+
+func (e *rateLimitedError) Error() string {
+	return e.err.Error()
+}
+
+func (e *rateLimitedError) Unwrap() error {
+	return e.err
+}
+
 // sessionRelayMeter is the relay meter's internal representation of an onchain
 // Application's max and consumed stake.
 type sessionRelayMeter struct {
@@ -39,6 +58,7 @@ type sessionRelayMeter struct {
 	// This is a fraction of the Application's overall stake in proportion.
 	maxCoin cosmostypes.Coin
 	// The amount of uPOKT a specific application has consumed from this relayer in the given session.
+	// It exceeds maxCoin by the cost of the relays which have been over-serviced.
 	consumedCoin cosmostypes.Coin
 	// The header for the session the Application and Supplier (backed by the relayer)
 	// are exchanging services in.
@@ -71,16 +91,45 @@ type ProxyRelayMeter struct {
 	// Only known applications (i.e. have sent at least one relay) have their stakes metered.
 	// This map gets reset every new session in order to meter new applications.
 	sessionToRelayMeterMap map[string]*sessionRelayMeter
-	// overServicingAllowanceCoins allows Suppliers to overservice applications.
+	// relayMeterConfig is the over-servicing policy of the relay meter.
+	// Over-servicing allows Suppliers to overservice applications.
 	// This entails providing a free service (i.e. mine for relays), that they will not be paid for onchain.
 	// This is common by some suppliers to build goodwill and receive a higher offchain quality-of-service rating.
-	// If negative, allow infinite overservicing.
-	// TODO_MAINNET(@red-0ne): Expose overServicingAllowanceCoins as a configuration parameter.
-	overServicingAllowanceCoins cosmostypes.Coin
+	relayMeterConfig *config.RelayMinerRelayMeterConfig
+	// allowedApplications and deniedApplications are the sets of the application
+	// addresses of relayMeterConfig's AllowedApplications and DeniedApplications.
+	allowedApplications map[string]struct{}
+	deniedApplications  map[string]struct{}
+
+	// lastBlockObservedAt and estimatedBlockDuration are used to estimate when
+	// the next session starts, in order to hint rate limited applications when
+	// to retry. They are updated on every committed block.
+	lastBlockObservedAt    time.Time
+	estimatedBlockDuration time.Duration
 
 	// relayMeterMu ensures that relay meter operations are thread-safe.
 	relayMeterMu sync.Mutex
 
+	// storesDirectory is the path on disk where the relay meter's KVStore is created.
+	// If empty, the relay meters are only kept in memory.
+	storesDirectory string
+
+	// relayMeterStore is a key-value store used to persist the consumed stake of
+	// each session relay meter in order to recover it in case of a restart.
+	// It is nil if storesDirectory is empty.
+	relayMeterStore pebble.PebbleKVStore
+
+	// relayMeterStoreMu serializes the writes to relayMeterStore, which are done
+	// without holding relayMeterMu so that the relays being metered are not blocked
+	// by the disk I/O. It MUST be acquired before relayMeterMu when both are held.
+	relayMeterStoreMu sync.Mutex
+
+	// pendingRelayMeterWrites are the session relay meters updated since they were
+	// last written to relayMeterStore, keyed by session ID.
+	// A nil relay meter means that the persisted relay meter is to be deleted.
+	// They are written every relayMeterFlushInterval and when the relay meter stops.
+	pendingRelayMeterWrites map[string]*sessionRelayMeter
+
 	// Clients to query onchain data.
 	applicationQuerier client.ApplicationQueryClient
 	serviceQuerier     client.ServiceQueryClient
@@ -92,11 +141,27 @@ type ProxyRelayMeter struct {
 	logger polylog.Logger
 }
 
-func NewRelayMeter(deps depinject.Config) (relayer.RelayMeter, error) {
-	overservicingAllowanceCoins := cosmostypes.NewInt64Coin(volatile.DenomuPOKT, defaultOverServicingAllowanceCoins)
+// NewRelayMeter creates a new ProxyRelayMeter.
+//
+// Required dependencies:
+//   - client.SharedQueryClient
+//   - client.ApplicationQueryClient
+//   - client.ServiceQueryClient
+//   - client.BlockClient
+//   - client.EventsQueryClient
+//   - client.SessionQueryClient
+//   - polylog.Logger
+//
+// Available options:
+//   - WithRelayMeterStoresDirectory
+//   - WithRelayMeterConfig
+func NewRelayMeter(
+	deps depinject.Config,
+	opts ...relayer.RelayMeterOption,
+) (relayer.RelayMeter, error) {
 	rm := &ProxyRelayMeter{
-		sessionToRelayMeterMap:      make(map[string]*sessionRelayMeter),
-		overServicingAllowanceCoins: overservicingAllowanceCoins,
+		sessionToRelayMeterMap:  make(map[string]*sessionRelayMeter),
+		pendingRelayMeterWrites: make(map[string]*sessionRelayMeter),
 	}
 
 	if err := depinject.Inject(
@@ -112,20 +177,55 @@ func NewRelayMeter(deps depinject.Config) (relayer.RelayMeter, error) {
 		return nil, err
 	}
 
+	for _, opt := range opts {
+		opt(rm)
+	}
+
+	// Default to the over-servicing policy of a RelayMiner config without a
+	// relay_meter section.
+	if rm.relayMeterConfig == nil {
+		rm.relayMeterConfig = &config.RelayMinerRelayMeterConfig{
+			OverServicingAllowance: &config.RelayMinerOverServicingAllowance{
+				Policy: config.OverServicingAllowancePolicyFixed,
+				Upokt:  config.DefaultOverServicingAllowanceUpokt,
+			},
+		}
+	}
+
+	rm.allowedApplications = make(map[string]struct{}, len(rm.relayMeterConfig.AllowedApplications))
+	for _, appAddress := range rm.relayMeterConfig.AllowedApplications {
+		rm.allowedApplications[appAddress] = struct{}{}
+	}
+
+	rm.deniedApplications = make(map[string]struct{}, len(rm.relayMeterConfig.DeniedApplications))
+	for _, appAddress := range rm.relayMeterConfig.DeniedApplications {
+		rm.deniedApplications[appAddress] = struct{}{}
+	}
+
+	// Initialize the relay meter store if persistence is enabled.
+	if rm.storesDirectory != "" {
+		relayMeterStoreDir := path.Join(rm.storesDirectory, "relay_meter")
+		store, err := pebble.NewKVStore(relayMeterStoreDir)
+		if err != nil {
+			return nil, err
+		}
+		rm.relayMeterStore = store
+	}
+
 	return rm, nil
 }
 
 // Start starts the relay meter by observing application staked events and new sessions.
 func (rmtr *ProxyRelayMeter) Start(ctx context.Context) error {
-	// Listen to transaction events to filter application staked events.
-	// TODO_TECHDEBT(@red-0ne): refactor this listener to be shared across all query clients
-	// and remove the need to listen to events in the relay meter.
-	eventsObs, err := rmtr.eventsQueryClient.EventsBytes(ctx, "tm.event = 'Tx'")
-	if err != nil {
+	// Restore the relay meters of the sessions that are still active so the
+	// stake consumed before a restart keeps being accounted for.
+	if err := rmtr.loadSessionRelayMeters(ctx); err != nil {
 		return err
 	}
 
 	// Listen for application staked events and update known application stakes.
+	// The events are received through the committed transactions subscription
+	// shared with the other transactions events consumers (e.g. the query caches).
 	//
 	// Since an applications might upstake (never downstake) during a session, this
 	// stake increase is guaranteed to be available at settlement.
@@ -133,13 +233,23 @@ func (rmtr *ProxyRelayMeter) Start(ctx context.Context) error {
 	//
 	// This enables applications to adjust their stake mid-session and increase
 	// their rate limits without needing to wait for the next session to start.
-	appStakedEvents := filterTypedEvents[*apptypes.EventApplicationStaked](ctx, eventsObs, nil)
+	appStakedEvents, err := events.SubscribeTypedEvents[*apptypes.EventApplicationStaked](
+		ctx,
+		depinject.Supply(rmtr.eventsQueryClient),
+	)
+	if err != nil {
+		return err
+	}
 	channel.ForEach(ctx, appStakedEvents, rmtr.forEachEventApplicationStakedFn)
 
 	// Listen to new blocks and reset the relay meter application stakes every new session.
 	committedBlocksSequence := rmtr.blockQuerier.CommittedBlocksSequence(ctx)
 	channel.ForEach(ctx, committedBlocksSequence, rmtr.forEachNewBlockFn)
 
+	// Periodically write the updated relay meters to the relay meter store, and
+	// close it once the relay meter is stopped.
+	go rmtr.goFlushSessionRelayMeters(ctx)
+
 	return nil
 }
 
@@ -166,6 +276,7 @@ func (rmtr *ProxyRelayMeter) AccumulateRelayReward(ctx context.Context, reqMeta
 		appRelayMeter.sharedParams,
 		appRelayMeter.service,
 		appRelayMeter.serviceRelayDifficulty,
+		reqMeta.GetSessionHeader().GetSessionStartBlockHeight(),
 	)
 	if err != nil {
 		return err
@@ -177,31 +288,32 @@ func (rmtr *ProxyRelayMeter) AccumulateRelayReward(ctx context.Context, reqMeta
 	isAppOverServiced := appRelayMeter.maxCoin.IsLT(newConsumedCoin)
 	if !isAppOverServiced {
 		appRelayMeter.consumedCoin = newConsumedCoin
+		rmtr.persistSessionRelayMeter(appRelayMeter)
 		return nil
 	}
 
-	// Check if the supplier is allowing unlimited over-servicing (i.e. negative value)
-	allowUnlimitedOverServicing := rmtr.overServicingAllowanceCoins.IsNegative()
+	// Get the over-servicing allowance configured for the application and service.
+	overServicingAllowanceCoin, allowUnlimitedOverServicing := rmtr.getOverServicingAllowance(appRelayMeter)
 
 	// The application is over-servicing, if unlimited over-servicing is not allowed
-	// and the newConsumedCoin is greater than the maxCoin + overServicingAllowanceCoins,
+	// and the newConsumedCoin is greater than the maxCoin + overServicingAllowanceCoin,
 	// then return a rate limit error.
 	overServicingCoin := newConsumedCoin.Sub(appRelayMeter.maxCoin)
 
-	// In case Allowance is positive, add it to maxCoin to allow no or limited over-servicing.
+	// In case the allowance is limited, add it to maxCoin to allow no or limited over-servicing.
 	if !allowUnlimitedOverServicing {
-		maxAllowedOverServicing := appRelayMeter.maxCoin.Add(rmtr.overServicingAllowanceCoins)
+		maxAllowedOverServicing := appRelayMeter.maxCoin.Add(overServicingAllowanceCoin)
 		if maxAllowedOverServicing.IsLT(newConsumedCoin) {
-			return ErrRelayerProxyRateLimited.Wrapf(
-				"application has been rate limited, stake needed: %s, has: %s",
-				newConsumedCoin.String(),
-				appRelayMeter.maxCoin.String(),
-			)
+			return rmtr.newRateLimitedError(ctx, appRelayMeter, newConsumedCoin)
 		}
 	}
 
+	// Account for the over-serviced relay so that the limited allowances are
+	// consumed by the successive over-serviced relays.
+	appRelayMeter.consumedCoin = newConsumedCoin
 	appRelayMeter.numOverServicedRelays++
 	appRelayMeter.numOverServicedComputeUnits += appRelayMeter.service.ComputeUnitsPerRelay
+	rmtr.persistSessionRelayMeter(appRelayMeter)
 
 	// Exponential backoff, only log over-servicing when numOverServicedRelays is a power of 2
 	if shouldLogOverServicing(appRelayMeter.numOverServicedRelays) {
@@ -233,15 +345,28 @@ func (rmtr *ProxyRelayMeter) SetNonApplicableRelayReward(ctx context.Context, re
 		sessionRelayMeter.sharedParams,
 		sessionRelayMeter.service,
 		sessionRelayMeter.serviceRelayDifficulty,
+		reqMeta.GetSessionHeader().GetSessionStartBlockHeight(),
 	)
 	if err != nil {
 		return ErrRelayerProxyCalculateRelayCost.Wrapf("%s", err)
 	}
 
+	// Refunding a relay while the consumed stake exceeds the max amount removes
+	// one of the over-serviced relays, whose allowance can be consumed again.
+	isOverServicedRelay := sessionRelayMeter.maxCoin.IsLT(sessionRelayMeter.consumedCoin)
+	if isOverServicedRelay && sessionRelayMeter.numOverServicedRelays > 0 {
+		sessionRelayMeter.numOverServicedRelays--
+		sessionRelayMeter.numOverServicedComputeUnits -= min(
+			sessionRelayMeter.service.ComputeUnitsPerRelay,
+			sessionRelayMeter.numOverServicedComputeUnits,
+		)
+	}
+
 	// Decrease the consumed stake amount by relay cost.
 	newConsumedAmount := sessionRelayMeter.consumedCoin.Sub(relayCost)
 
 	sessionRelayMeter.consumedCoin = newConsumedAmount
+	rmtr.persistSessionRelayMeter(sessionRelayMeter)
 	return nil
 }
 
@@ -252,6 +377,8 @@ func (rmtr *ProxyRelayMeter) forEachNewBlockFn(ctx context.Context, block client
 	rmtr.relayMeterMu.Lock()
 	defer rmtr.relayMeterMu.Unlock()
 
+	rmtr.updateEstimatedBlockDuration(time.Now())
+
 	sharedParams, err := rmtr.sharedQuerier.GetParams(ctx)
 	if err != nil {
 		return
@@ -266,17 +393,21 @@ func (rmtr *ProxyRelayMeter) forEachNewBlockFn(ctx context.Context, block client
 			// The session started its claim phase and the corresponding session relay meter
 			// is no longer needed.
 			delete(rmtr.sessionToRelayMeterMap, sessionRelayMeter.sessionHeader.GetSessionId())
+			rmtr.deletePersistedSessionRelayMeter(sessionRelayMeter.sessionHeader.GetSessionId())
 		}
 	}
 }
 
 // forEachEventApplicationStakedFn is a callback function that is called every time
 // an application staked event is observed. It updates the relay meter known applications.
-func (rmtr *ProxyRelayMeter) forEachEventApplicationStakedFn(ctx context.Context, event *apptypes.EventApplicationStaked) {
+func (rmtr *ProxyRelayMeter) forEachEventApplicationStakedFn(
+	ctx context.Context,
+	event *events.TypedEvent[*apptypes.EventApplicationStaked],
+) {
 	rmtr.relayMeterMu.Lock()
 	defer rmtr.relayMeterMu.Unlock()
 
-	app := event.GetApplication()
+	app := event.Event.GetApplication()
 
 	// Since lean clients are supported, multiple suppliers might share the same RelayMiner.
 	// Loop over all the suppliers that have metered the application and update their
@@ -298,133 +429,200 @@ func (rmtr *ProxyRelayMeter) forEachEventApplicationStakedFn(ctx context.Context
 // ensureRequestSessionRelayMeter ensures that the relay miner has a relay meter
 // ready for monitoring the requests's application's consumption.
 func (rmtr *ProxyRelayMeter) ensureRequestSessionRelayMeter(ctx context.Context, reqMeta servicetypes.RelayRequestMetadata) (*sessionRelayMeter, error) {
-	appAddress := reqMeta.GetSessionHeader().GetApplicationAddress()
 	sessionId := reqMeta.GetSessionHeader().GetSessionId()
 
 	relayMeter, ok := rmtr.sessionToRelayMeterMap[sessionId]
 	// If the application is seen for the first time in this session, calculate the
 	// max amount of stake the application can consume.
 	if !ok {
-		var app apptypes.Application
-		app, err := rmtr.applicationQuerier.GetApplication(ctx, appAddress)
+		var err error
+		relayMeter, err = rmtr.newSessionRelayMeter(ctx, reqMeta.SessionHeader)
 		if err != nil {
 			return nil, err
 		}
 
-		// In order to prevent over-servicing, the protocol must split the application's stake
-		// among all the suppliers that are serving it.
-		if len(app.ServiceConfigs) != 1 {
-			return nil, ErrRelayerProxyInvalidSession.Wrapf(
-				"application %q has %d service configs, expected 1",
-				appAddress,
-				len(app.ServiceConfigs),
-			)
-		}
-
-		sharedParams, err := rmtr.sharedQuerier.GetParams(ctx)
-		if err != nil {
-			return nil, err
-		}
+		rmtr.sessionToRelayMeterMap[sessionId] = relayMeter
+	}
 
-		service, err := rmtr.serviceQuerier.GetService(ctx, reqMeta.SessionHeader.ServiceId)
-		if err != nil {
-			return nil, err
-		}
+	return relayMeter, nil
+}
 
-		serviceRelayDifficulty, err := rmtr.serviceQuerier.GetServiceRelayDifficulty(ctx, service.Id)
-		if err != nil {
-			return nil, err
-		}
+// newSessionRelayMeter creates a relay meter, with no consumed stake, for the
+// application of the given session.
+func (rmtr *ProxyRelayMeter) newSessionRelayMeter(
+	ctx context.Context,
+	sessionHeader *sessiontypes.SessionHeader,
+) (*sessionRelayMeter, error) {
+	appAddress := sessionHeader.GetApplicationAddress()
 
-		sessionParams, err := rmtr.sessionQuerier.GetParams(ctx)
-		if err != nil {
-			return nil, err
-		}
+	var app apptypes.Application
+	app, err := rmtr.applicationQuerier.GetApplication(ctx, appAddress)
+	if err != nil {
+		return nil, err
+	}
 
-		// calculate the max amount of stake the application can consume in the current session.
-		supplierAppStake := getAppStakePortionPayableToSessionSupplier(
-			app.GetStake(),
-			sharedParams,
-			sessionParams.GetNumSuppliersPerSession(),
+	// In order to prevent over-servicing, the protocol must split the application's stake
+	// among all the suppliers that are serving it.
+	if len(app.ServiceConfigs) != 1 {
+		return nil, ErrRelayerProxyInvalidSession.Wrapf(
+			"application %q has %d service configs, expected 1",
+			appAddress,
+			len(app.ServiceConfigs),
 		)
-		relayMeter = &sessionRelayMeter{
-			app:                    app,
-			consumedCoin:           cosmostypes.NewInt64Coin(volatile.DenomuPOKT, 0),
-			maxCoin:                supplierAppStake,
-			sessionHeader:          reqMeta.SessionHeader,
-			sharedParams:           sharedParams,
-			service:                &service,
-			serviceRelayDifficulty: serviceRelayDifficulty,
-			numSuppliersPerSession: sessionParams.GetNumSuppliersPerSession(),
-		}
+	}
 
-		rmtr.sessionToRelayMeterMap[sessionId] = relayMeter
+	sharedParams, err := rmtr.sharedQuerier.GetParams(ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	return relayMeter, nil
+	service, err := rmtr.serviceQuerier.GetService(ctx, sessionHeader.ServiceId)
+	if err != nil {
+		return nil, err
+	}
+
+	serviceRelayDifficulty, err := rmtr.serviceQuerier.GetServiceRelayDifficulty(ctx, service.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	sessionParams, err := rmtr.sessionQuerier.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// calculate the max amount of stake the application can consume in the current session.
+	supplierAppStake := getAppStakePortionPayableToSessionSupplier(
+		app.GetStake(),
+		sharedParams,
+		sessionParams.GetNumSuppliersPerSession(),
+	)
+
+	return &sessionRelayMeter{
+		app:                    app,
+		consumedCoin:           cosmostypes.NewInt64Coin(volatile.DenomuPOKT, 0),
+		maxCoin:                supplierAppStake,
+		sessionHeader:          sessionHeader,
+		sharedParams:           sharedParams,
+		service:                &service,
+		serviceRelayDifficulty: serviceRelayDifficulty,
+		numSuppliersPerSession: sessionParams.GetNumSuppliersPerSession(),
+	}, nil
+}
+
+// getOverServicingAllowance returns the amount of uPOKT the given session relay
+// meter's application can be over-serviced, or true if it can be over-serviced
+// without any limit.
+// The denied and allowed applications take precedence over the allowance of the
+// service, which takes precedence over the global one.
+func (rmtr *ProxyRelayMeter) getOverServicingAllowance(
+	relayMeter *sessionRelayMeter,
+) (allowanceCoin cosmostypes.Coin, isUnlimited bool) {
+	zeroCoin := cosmostypes.NewInt64Coin(volatile.DenomuPOKT, 0)
+	appAddress := relayMeter.app.GetAddress()
+
+	if _, ok := rmtr.deniedApplications[appAddress]; ok {
+		return zeroCoin, false
+	}
+
+	if _, ok := rmtr.allowedApplications[appAddress]; ok {
+		return zeroCoin, true
+	}
+
+	allowance := rmtr.relayMeterConfig.OverServicingAllowance
+	serviceId := relayMeter.sessionHeader.GetServiceId()
+	if serviceAllowance, ok := rmtr.relayMeterConfig.ServicesOverServicingAllowance[serviceId]; ok {
+		allowance = serviceAllowance
+	}
+
+	switch allowance.Policy {
+	case config.OverServicingAllowancePolicyFixed:
+		return cosmostypes.NewCoin(volatile.DenomuPOKT, math.NewIntFromUint64(allowance.Upokt)), false
+	case config.OverServicingAllowancePolicyStakePercentage:
+		allowanceAmount := relayMeter.maxCoin.Amount.
+			Mul(math.NewIntFromUint64(allowance.StakePercentage)).
+			Quo(math.NewInt(100))
+		return cosmostypes.NewCoin(volatile.DenomuPOKT, allowanceAmount), false
+	case config.OverServicingAllowancePolicyUnlimited:
+		return zeroCoin, true
+	default:
+		return zeroCoin, false
+	}
 }
 
-// filterTypedEvents filters the provided events bytes for the typed event T.
-// T is then filtered by the provided filter function.
-func filterTypedEvents[T proto.Message](
+// newRateLimitedError returns the error to reply with when the given session
+// relay meter's application is rate limited, according to the configured
+// rate limited reply policy.
+func (rmtr *ProxyRelayMeter) newRateLimitedError(
 	ctx context.Context,
-	eventBzObs client.EventsBytesObservable,
-	filterFn func(T) bool,
-) observable.Observable[T] {
-	eventObs, eventCh := channel.NewObservable[T]()
-	channel.ForEach(ctx, eventBzObs, func(ctx context.Context, maybeTxBz either.Bytes) {
-		if maybeTxBz.IsError() {
-			return
-		}
-		txBz, _ := maybeTxBz.ValueOrError()
+	relayMeter *sessionRelayMeter,
+	newConsumedCoin cosmostypes.Coin,
+) error {
+	err := ErrRelayerProxyRateLimited.Wrapf(
+		"application has been rate limited, stake needed: %s, has: %s",
+		newConsumedCoin.String(),
+		relayMeter.maxCoin.String(),
+	)
 
-		// Try to deserialize the provided bytes into an abci.TxResult.
-		txResult, err := tx.UnmarshalTxResult(txBz)
-		if err != nil {
-			return
-		}
+	if rmtr.relayMeterConfig.RateLimitedReplyPolicy != config.RateLimitedReplyPolicyTooManyRequests {
+		return err
+	}
 
-		for _, event := range txResult.Result.Events {
-			eventApplicationStakedType := cosmostypes.MsgTypeURL(*new(T))
-			if strings.Trim(event.GetType(), "/") != strings.Trim(eventApplicationStakedType, "/") {
-				continue
-			}
-
-			typedEvent, err := cosmostypes.ParseTypedEvent(event)
-			if err != nil {
-				return
-			}
-
-			castedEvent, ok := typedEvent.(T)
-			if !ok {
-				return
-			}
-
-			// Apply the filter function to the typed event.
-			if filterFn == nil || filterFn(castedEvent) {
-				eventCh <- castedEvent
-				return
-			}
-		}
-	})
+	// The application can be served again once the next session starts.
+	nextSessionStartHeight := relayMeter.sessionHeader.GetSessionEndBlockHeight() + 1
+	numBlocksUntilNextSession := nextSessionStartHeight - rmtr.blockQuerier.LastBlock(ctx).Height()
+	if numBlocksUntilNextSession < 1 {
+		numBlocksUntilNextSession = 1
+	}
 
-	return eventObs
+	return &rateLimitedError{
+		err:        err,
+		retryAfter: time.Duration(numBlocksUntilNextSession) * rmtr.estimatedBlockDuration,
+	}
+}
+
+// updateEstimatedBlockDuration updates the estimated block duration given the
+// time a new block has been observed at, using an exponential moving average
+// to smooth out the variations between consecutive blocks.
+// It MUST be called with relayMeterMu held.
+func (rmtr *ProxyRelayMeter) updateEstimatedBlockDuration(observedAt time.Time) {
+	defer func() { rmtr.lastBlockObservedAt = observedAt }()
+
+	if rmtr.lastBlockObservedAt.IsZero() {
+		return
+	}
+
+	blockDuration := observedAt.Sub(rmtr.lastBlockObservedAt)
+	if rmtr.estimatedBlockDuration == 0 {
+		rmtr.estimatedBlockDuration = blockDuration
+		return
+	}
+
+	rmtr.estimatedBlockDuration = (4*rmtr.estimatedBlockDuration + blockDuration) / 5
 }
 
 // getSingleMinedRelayCostCoin returns the cost of a relay based on the shared parameters and the service.
 // relayCost = Compute Units Per Relay (CUPR) * Compute Units To Token Multiplier (CUTTM) * relayDifficultyMultiplier
+// The CUTTM is the service specific one applying to the session starting at
+// sessionStartHeight if set, or the global one otherwise.
 func getSingleMinedRelayCostCoin(
 	sharedParams *sharedtypes.Params,
 	service *sharedtypes.Service,
 	relayMiningDifficulty servicetypes.RelayMiningDifficulty,
+	sessionStartHeight int64,
 ) (cosmostypes.Coin, error) {
 	// Get the difficulty multiplier based on the relay mining difficulty.
 	difficultyTargetHash := relayMiningDifficulty.GetTargetHash()
 	difficultyMultiplier := protocol.GetRelayDifficultyMultiplier(difficultyTargetHash)
 
 	// Get the estimated cost of the relay if it gets mined.
-	relayCostAmt := service.ComputeUnitsPerRelay * sharedParams.GetComputeUnitsToTokensMultiplier()
-	relayCostRat := big.NewRat(int64(relayCostAmt), 1)
+	// It is computed as a ratio since the product of the compute units per relay
+	// and the compute units to tokens multiplier may overflow uint64.
+	computeUnitsPerRelayRat := new(big.Rat).SetUint64(service.ComputeUnitsPerRelay)
+	computeUnitsToTokensMultiplierRat := new(big.Rat).SetUint64(
+		sharedParams.GetServiceComputeUnitsToTokensMultiplier(service, sessionStartHeight),
+	)
+	relayCostRat := new(big.Rat).Mul(computeUnitsPerRelayRat, computeUnitsToTokensMultiplierRat)
 	estimatedRelayCostRat := big.NewRat(0, 1).Mul(relayCostRat, difficultyMultiplier)
 	estimatedRelayCost := big.NewInt(0).Quo(estimatedRelayCostRat.Num(), estimatedRelayCostRat.Denom())
 
@@ -0,0 +1,230 @@
+package proxy
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	cosmostypes "github.com/cosmos/cosmos-sdk/types"
+
+	sessiontypes "github.com/pokt-network/poktroll/x/session/types"
+)
+
+// relayMeterFlushInterval is the interval at which the updated session relay
+// meters are written to the relay meter store.
+// The relay meters updated during the last interval before a crash are lost,
+// which may only let their applications be over-serviced by that interval's relays.
+const relayMeterFlushInterval = time.Second
+
+// persistedSessionRelayMeter is the representation of a session relay meter
+// persisted to the relay meter store.
+// Only the consumption accounting is persisted, the application stake and the
+// onchain parameters being queried again when the relay meter is restored.
+type persistedSessionRelayMeter struct {
+	SessionHeader               *sessiontypes.SessionHeader `json:"session_header"`
+	ConsumedCoin                cosmostypes.Coin            `json:"consumed_coin"`
+	NumOverServicedRelays       uint64                      `json:"num_over_serviced_relays"`
+	NumOverServicedComputeUnits uint64                      `json:"num_over_serviced_compute_units"`
+}
+
+// loadSessionRelayMeters restores the relay meters persisted before a restart
+// of the RelayMiner.
+// The relay meters of sessions whose claim window is already open are deleted
+// from the store since their application can no longer be over-serviced, while
+// the other ones are restored with their consumed stake.
+func (rmtr *ProxyRelayMeter) loadSessionRelayMeters(ctx context.Context) error {
+	rmtr.relayMeterStoreMu.Lock()
+	defer rmtr.relayMeterStoreMu.Unlock()
+
+	rmtr.relayMeterMu.Lock()
+	defer rmtr.relayMeterMu.Unlock()
+
+	if rmtr.relayMeterStore == nil {
+		return nil
+	}
+
+	height := rmtr.blockQuerier.LastBlock(ctx).Height()
+	logger := rmtr.logger.With("method", "loadSessionRelayMeters", "height", height)
+
+	sharedParams, err := rmtr.sharedQuerier.GetParams(ctx)
+	if err != nil {
+		logger.Error().Err(err).Msg("failed to get shared params")
+		return err
+	}
+
+	sessionIds, persistedRelayMeters, err := rmtr.relayMeterStore.GetAll([]byte{}, false)
+	if err != nil {
+		logger.Error().Err(err).Msg("failed to get persisted relay meters")
+		return err
+	}
+
+	numRestoredRelayMeters := 0
+	for i, persistedRelayMeterBz := range persistedRelayMeters {
+		sessionId := string(sessionIds[i])
+		sessionLogger := logger.With("session_id", sessionId)
+
+		persistedRelayMeter := &persistedSessionRelayMeter{}
+		if err := json.Unmarshal(persistedRelayMeterBz, persistedRelayMeter); err != nil {
+			sessionLogger.Error().Err(err).Msg("failed to unmarshal persisted relay meter, deleting")
+			rmtr.deletePersistedSessionRelayMeter(sessionId)
+			continue
+		}
+
+		// Prune the relay meters the same way forEachNewBlockFn does for the
+		// in-memory ones.
+		sessionEndHeight := persistedRelayMeter.SessionHeader.GetSessionEndBlockHeight()
+		sessionClaimOpenHeight := sessionEndHeight + int64(sharedParams.GetClaimWindowOpenOffsetBlocks())
+		if height >= sessionClaimOpenHeight {
+			sessionLogger.Debug().Msg("deleting the persisted relay meter of an ended session")
+			rmtr.deletePersistedSessionRelayMeter(sessionId)
+			continue
+		}
+
+		relayMeter, err := rmtr.newSessionRelayMeter(ctx, persistedRelayMeter.SessionHeader)
+		if err != nil {
+			// The application may have been unstaked in the meantime, in which case
+			// it can no longer be served anyway.
+			sessionLogger.Warn().Err(err).Msg("failed to restore the persisted relay meter, deleting")
+			rmtr.deletePersistedSessionRelayMeter(sessionId)
+			continue
+		}
+
+		relayMeter.consumedCoin = persistedRelayMeter.ConsumedCoin
+		relayMeter.numOverServicedRelays = persistedRelayMeter.NumOverServicedRelays
+		relayMeter.numOverServicedComputeUnits = persistedRelayMeter.NumOverServicedComputeUnits
+
+		rmtr.sessionToRelayMeterMap[sessionId] = relayMeter
+		numRestoredRelayMeters++
+	}
+
+	if numRestoredRelayMeters > 0 {
+		logger.Info().Msgf("restored %d persisted session relay meters", numRestoredRelayMeters)
+	}
+
+	return nil
+}
+
+// persistSessionRelayMeter schedules the consumption accounting of the given
+// session relay meter to be saved to the relay meter store, if any, by the next
+// flushSessionRelayMeters call.
+// It MUST be called with relayMeterMu held.
+func (rmtr *ProxyRelayMeter) persistSessionRelayMeter(relayMeter *sessionRelayMeter) {
+	if rmtr.relayMeterStore == nil {
+		return
+	}
+
+	rmtr.pendingRelayMeterWrites[relayMeter.sessionHeader.GetSessionId()] = relayMeter
+}
+
+// deletePersistedSessionRelayMeter schedules the relay meter of the given session
+// to be removed from the relay meter store, if any, by the next
+// flushSessionRelayMeters call.
+// It MUST be called with relayMeterMu held.
+func (rmtr *ProxyRelayMeter) deletePersistedSessionRelayMeter(sessionId string) {
+	if rmtr.relayMeterStore == nil {
+		return
+	}
+
+	rmtr.pendingRelayMeterWrites[sessionId] = nil
+}
+
+// goFlushSessionRelayMeters writes the updated session relay meters to the relay
+// meter store every relayMeterFlushInterval until ctx is done, at which point it
+// writes the remaining ones and closes the store.
+// It is intended to be run in a goroutine.
+func (rmtr *ProxyRelayMeter) goFlushSessionRelayMeters(ctx context.Context) {
+	ticker := time.NewTicker(relayMeterFlushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			rmtr.stopRelayMeterStore()
+			return
+		case <-ticker.C:
+			rmtr.flushSessionRelayMeters()
+		}
+	}
+}
+
+// flushSessionRelayMeters writes the session relay meters updated since the last
+// flush to the relay meter store, if any.
+func (rmtr *ProxyRelayMeter) flushSessionRelayMeters() {
+	rmtr.relayMeterStoreMu.Lock()
+	defer rmtr.relayMeterStoreMu.Unlock()
+
+	rmtr.flushSessionRelayMetersLocked()
+}
+
+// flushSessionRelayMetersLocked writes the session relay meters updated since the
+// last flush to the relay meter store, if any.
+// Failing to write a relay meter is logged rather than retried since the next
+// update of the relay meter schedules it to be written again.
+// It MUST be called with relayMeterStoreMu held, and without relayMeterMu held.
+func (rmtr *ProxyRelayMeter) flushSessionRelayMetersLocked() {
+	// Only hold relayMeterMu while taking a snapshot of the pending writes so the
+	// relays being metered are not blocked by the disk I/O.
+	rmtr.relayMeterMu.Lock()
+	if rmtr.relayMeterStore == nil || len(rmtr.pendingRelayMeterWrites) == 0 {
+		rmtr.relayMeterMu.Unlock()
+		return
+	}
+
+	persistedRelayMetersBz := make(map[string][]byte, len(rmtr.pendingRelayMeterWrites))
+	for sessionId, relayMeter := range rmtr.pendingRelayMeterWrites {
+		if relayMeter == nil {
+			persistedRelayMetersBz[sessionId] = nil
+			continue
+		}
+
+		persistedRelayMeterBz, err := json.Marshal(&persistedSessionRelayMeter{
+			SessionHeader:               relayMeter.sessionHeader,
+			ConsumedCoin:                relayMeter.consumedCoin,
+			NumOverServicedRelays:       relayMeter.numOverServicedRelays,
+			NumOverServicedComputeUnits: relayMeter.numOverServicedComputeUnits,
+		})
+		if err != nil {
+			rmtr.logger.Error().Err(err).Msgf("failed to marshal the relay meter of session %q", sessionId)
+			continue
+		}
+		persistedRelayMetersBz[sessionId] = persistedRelayMeterBz
+	}
+	clear(rmtr.pendingRelayMeterWrites)
+	rmtr.relayMeterMu.Unlock()
+
+	for sessionId, persistedRelayMeterBz := range persistedRelayMetersBz {
+		if persistedRelayMeterBz == nil {
+			if err := rmtr.relayMeterStore.Delete([]byte(sessionId)); err != nil {
+				rmtr.logger.Error().Err(err).Msgf("failed to delete the persisted relay meter of session %q", sessionId)
+			}
+			continue
+		}
+
+		if err := rmtr.relayMeterStore.Set([]byte(sessionId), persistedRelayMeterBz); err != nil {
+			rmtr.logger.Error().Err(err).Msgf("failed to persist the relay meter of session %q", sessionId)
+		}
+	}
+}
+
+// stopRelayMeterStore writes the pending session relay meters to the relay meter
+// store, if any, then closes it and releases its resources.
+// The relay meters are no longer persisted afterwards.
+func (rmtr *ProxyRelayMeter) stopRelayMeterStore() {
+	rmtr.relayMeterStoreMu.Lock()
+	defer rmtr.relayMeterStoreMu.Unlock()
+
+	rmtr.flushSessionRelayMetersLocked()
+
+	rmtr.relayMeterMu.Lock()
+	defer rmtr.relayMeterMu.Unlock()
+
+	if rmtr.relayMeterStore == nil {
+		return
+	}
+
+	if err := rmtr.relayMeterStore.Stop(); err != nil {
+		rmtr.logger.Error().Err(err).Msg("failed to stop the relay meter store")
+	}
+	rmtr.relayMeterStore = nil
+	clear(rmtr.pendingRelayMeterWrites)
+}
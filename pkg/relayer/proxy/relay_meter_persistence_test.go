@@ -0,0 +1,419 @@
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"testing"
+
+	"cosmossdk.io/depinject"
+	cosmostypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/pokt-network/poktroll/app/volatile"
+	"github.com/pokt-network/poktroll/pkg/crypto/protocol"
+	"github.com/pokt-network/poktroll/pkg/polylog/polyzero"
+	"github.com/pokt-network/poktroll/pkg/relayer"
+	"github.com/pokt-network/poktroll/pkg/relayer/config"
+	"github.com/pokt-network/poktroll/testutil/mockclient"
+	"github.com/pokt-network/poktroll/testutil/sample"
+	apptypes "github.com/pokt-network/poktroll/x/application/types"
+	servicetypes "github.com/pokt-network/poktroll/x/service/types"
+	sessiontypes "github.com/pokt-network/poktroll/x/session/types"
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
+)
+
+const (
+	testRelayMeterServiceId = "svc1"
+	// testRelayMeterAppStakeAmt is large enough for an application to be served
+	// several relays before being over-serviced.
+	testRelayMeterAppStakeAmt = 1_000_000
+)
+
+func TestRelayMeterPersistence_RestoresSessionRelayMetersAfterRestart(t *testing.T) {
+	ctx := context.Background()
+	storesDirectory := t.TempDir()
+	appStakes := map[string]int64{
+		sample.AccAddress(): testRelayMeterAppStakeAmt,
+		sample.AccAddress(): testRelayMeterAppStakeAmt,
+	}
+
+	relayMeter := newTestRelayMeter(t, 1, appStakes, WithRelayMeterStoresDirectory(storesDirectory))
+	require.NoError(t, relayMeter.loadSessionRelayMeters(ctx))
+	require.Empty(t, relayMeter.sessionToRelayMeterMap)
+
+	// Meter a different number of relays for each application's session, and mark
+	// one of them as non-applicable.
+	numRelays := int64(0)
+	for appAddress := range appStakes {
+		numRelays++
+		reqMeta := newTestRelayRequestMetadata(appAddress, 1)
+		for range numRelays + 1 {
+			require.NoError(t, relayMeter.AccumulateRelayReward(ctx, reqMeta))
+		}
+		require.NoError(t, relayMeter.SetNonApplicableRelayReward(ctx, reqMeta))
+	}
+	expectedRelayMeters := relayMeter.sessionToRelayMeterMap
+	require.Len(t, expectedRelayMeters, len(appStakes))
+
+	// Restart the relay meter, using the same stores directory.
+	relayMeter.stopRelayMeterStore()
+	restartedRelayMeter := newTestRelayMeter(t, 5, appStakes, WithRelayMeterStoresDirectory(storesDirectory))
+	require.NoError(t, restartedRelayMeter.loadSessionRelayMeters(ctx))
+
+	require.Len(t, restartedRelayMeter.sessionToRelayMeterMap, len(expectedRelayMeters))
+	for sessionId, expectedRelayMeter := range expectedRelayMeters {
+		restoredRelayMeter, ok := restartedRelayMeter.sessionToRelayMeterMap[sessionId]
+		require.True(t, ok)
+		require.Equal(t, expectedRelayMeter.consumedCoin, restoredRelayMeter.consumedCoin)
+		require.Equal(t, expectedRelayMeter.maxCoin, restoredRelayMeter.maxCoin)
+		require.Equal(t, expectedRelayMeter.sessionHeader, restoredRelayMeter.sessionHeader)
+	}
+
+	// The restored relay meters keep metering the relays of their sessions.
+	for _, restoredRelayMeter := range restartedRelayMeter.sessionToRelayMeterMap {
+		reqMeta := newTestRelayRequestMetadata(restoredRelayMeter.app.GetAddress(), 1)
+		consumedCoin := restoredRelayMeter.consumedCoin
+		require.NoError(t, restartedRelayMeter.AccumulateRelayReward(ctx, reqMeta))
+		require.Equal(t, consumedCoin.Add(getTestRelayCostCoin(t)), restoredRelayMeter.consumedCoin)
+	}
+}
+
+func TestRelayMeterPersistence_RestoresOverServicedRelays(t *testing.T) {
+	ctx := context.Background()
+	storesDirectory := t.TempDir()
+	appAddress := sample.AccAddress()
+	// Use a stake which only covers a single relay so the next ones are over-serviced.
+	appStakes := map[string]int64{appAddress: getTestMinAppStakeAmt(t, 1)}
+
+	relayMeter := newTestRelayMeter(t, 1, appStakes,
+		WithRelayMeterStoresDirectory(storesDirectory),
+		WithRelayMeterConfig(&config.RelayMinerRelayMeterConfig{
+			OverServicingAllowance: &config.RelayMinerOverServicingAllowance{
+				Policy: config.OverServicingAllowancePolicyUnlimited,
+			},
+		}),
+	)
+	reqMeta := newTestRelayRequestMetadata(appAddress, 1)
+	for range 3 {
+		require.NoError(t, relayMeter.AccumulateRelayReward(ctx, reqMeta))
+	}
+	sessionId := reqMeta.GetSessionHeader().GetSessionId()
+	require.Equal(t, uint64(2), relayMeter.sessionToRelayMeterMap[sessionId].numOverServicedRelays)
+
+	relayMeter.stopRelayMeterStore()
+	restartedRelayMeter := newTestRelayMeter(t, 1, appStakes, WithRelayMeterStoresDirectory(storesDirectory))
+	require.NoError(t, restartedRelayMeter.loadSessionRelayMeters(ctx))
+
+	restoredRelayMeter, ok := restartedRelayMeter.sessionToRelayMeterMap[sessionId]
+	require.True(t, ok)
+	require.Equal(t, uint64(2), restoredRelayMeter.numOverServicedRelays)
+	require.Equal(t, uint64(2), restoredRelayMeter.numOverServicedComputeUnits)
+	require.Equal(t, relayMeter.sessionToRelayMeterMap[sessionId].consumedCoin, restoredRelayMeter.consumedCoin)
+}
+
+func TestRelayMeterPersistence_FlushesPendingWrites(t *testing.T) {
+	ctx := context.Background()
+	appAddress := sample.AccAddress()
+	appStakes := map[string]int64{appAddress: testRelayMeterAppStakeAmt}
+	reqMeta := newTestRelayRequestMetadata(appAddress, 1)
+	sessionId := reqMeta.GetSessionHeader().GetSessionId()
+
+	t.Run("relay meters are written when flushed", func(t *testing.T) {
+		relayMeter := newTestRelayMeter(t, 1, appStakes, WithRelayMeterStoresDirectory(t.TempDir()))
+		for range 3 {
+			require.NoError(t, relayMeter.AccumulateRelayReward(ctx, reqMeta))
+		}
+
+		// Metering relays does not write to the store.
+		requireStoredSessionIds(t, relayMeter)
+		require.Len(t, relayMeter.pendingRelayMeterWrites, 1)
+
+		relayMeter.flushSessionRelayMeters()
+		requireStoredSessionIds(t, relayMeter, sessionId)
+		require.Empty(t, relayMeter.pendingRelayMeterWrites)
+	})
+
+	t.Run("relay meters are written when the relay meter stops", func(t *testing.T) {
+		storesDirectory := t.TempDir()
+		relayMeter := newTestRelayMeter(t, 1, appStakes, WithRelayMeterStoresDirectory(storesDirectory))
+		require.NoError(t, relayMeter.loadSessionRelayMeters(ctx))
+
+		relayMeterCtx, cancelRelayMeterCtx := context.WithCancel(ctx)
+		flushDone := make(chan struct{})
+		go func() {
+			relayMeter.goFlushSessionRelayMeters(relayMeterCtx)
+			close(flushDone)
+		}()
+
+		require.NoError(t, relayMeter.AccumulateRelayReward(ctx, reqMeta))
+		cancelRelayMeterCtx()
+		<-flushDone
+
+		restartedRelayMeter := newTestRelayMeter(t, 1, appStakes, WithRelayMeterStoresDirectory(storesDirectory))
+		require.NoError(t, restartedRelayMeter.loadSessionRelayMeters(ctx))
+		require.Contains(t, restartedRelayMeter.sessionToRelayMeterMap, sessionId)
+	})
+}
+
+func TestRelayMeterPersistence_PrunesEndedSessions(t *testing.T) {
+	ctx := context.Background()
+	sharedParams := sharedtypes.DefaultParams()
+	appAddress := sample.AccAddress()
+	appStakes := map[string]int64{appAddress: testRelayMeterAppStakeAmt}
+
+	// The relay meter of the first session is no longer needed once its claim
+	// window opens, while the one of the second session is still needed.
+	firstSessionReqMeta := newTestRelayRequestMetadata(appAddress, 1)
+	secondSessionStartHeight := sharedtypes.GetNextSessionStartHeight(&sharedParams, 1)
+	secondSessionReqMeta := newTestRelayRequestMetadata(appAddress, secondSessionStartHeight)
+	firstSessionClaimWindowOpenHeight := sharedtypes.GetClaimWindowOpenHeight(&sharedParams, 1)
+
+	t.Run("persisted relay meters of ended sessions are deleted when restored", func(t *testing.T) {
+		storesDirectory := t.TempDir()
+		relayMeter := newTestRelayMeter(t, secondSessionStartHeight, appStakes, WithRelayMeterStoresDirectory(storesDirectory))
+		require.NoError(t, relayMeter.AccumulateRelayReward(ctx, firstSessionReqMeta))
+		require.NoError(t, relayMeter.AccumulateRelayReward(ctx, secondSessionReqMeta))
+		relayMeter.stopRelayMeterStore()
+
+		restartedRelayMeter := newTestRelayMeter(t, firstSessionClaimWindowOpenHeight, appStakes, WithRelayMeterStoresDirectory(storesDirectory))
+		require.NoError(t, restartedRelayMeter.loadSessionRelayMeters(ctx))
+
+		require.Len(t, restartedRelayMeter.sessionToRelayMeterMap, 1)
+		require.Contains(t, restartedRelayMeter.sessionToRelayMeterMap, secondSessionReqMeta.GetSessionHeader().GetSessionId())
+		requirePersistedSessionIds(t, restartedRelayMeter, secondSessionReqMeta.GetSessionHeader().GetSessionId())
+	})
+
+	t.Run("persisted relay meters of ended sessions are deleted on new blocks", func(t *testing.T) {
+		storesDirectory := t.TempDir()
+		relayMeter := newTestRelayMeter(t, secondSessionStartHeight, appStakes, WithRelayMeterStoresDirectory(storesDirectory))
+		require.NoError(t, relayMeter.AccumulateRelayReward(ctx, firstSessionReqMeta))
+		require.NoError(t, relayMeter.AccumulateRelayReward(ctx, secondSessionReqMeta))
+		requirePersistedSessionIds(t, relayMeter,
+			firstSessionReqMeta.GetSessionHeader().GetSessionId(),
+			secondSessionReqMeta.GetSessionHeader().GetSessionId(),
+		)
+
+		relayMeter.forEachNewBlockFn(ctx, newTestBlock(t, firstSessionClaimWindowOpenHeight))
+
+		require.Len(t, relayMeter.sessionToRelayMeterMap, 1)
+		requirePersistedSessionIds(t, relayMeter, secondSessionReqMeta.GetSessionHeader().GetSessionId())
+	})
+}
+
+func TestRelayMeterPersistence_CorruptOrMissingStore(t *testing.T) {
+	ctx := context.Background()
+	appAddress := sample.AccAddress()
+	unstakedAppAddress := sample.AccAddress()
+	appStakes := map[string]int64{
+		appAddress:         testRelayMeterAppStakeAmt,
+		unstakedAppAddress: testRelayMeterAppStakeAmt,
+	}
+
+	t.Run("missing stores directory starts with no relay meters", func(t *testing.T) {
+		storesDirectory := path.Join(t.TempDir(), "missing")
+		relayMeter := newTestRelayMeter(t, 1, appStakes, WithRelayMeterStoresDirectory(storesDirectory))
+		require.NoError(t, relayMeter.loadSessionRelayMeters(ctx))
+		require.Empty(t, relayMeter.sessionToRelayMeterMap)
+
+		// The relay meters are persisted to the newly created store.
+		reqMeta := newTestRelayRequestMetadata(appAddress, 1)
+		require.NoError(t, relayMeter.AccumulateRelayReward(ctx, reqMeta))
+		requirePersistedSessionIds(t, relayMeter, reqMeta.GetSessionHeader().GetSessionId())
+	})
+
+	t.Run("corrupt and unrestorable relay meters are deleted", func(t *testing.T) {
+		storesDirectory := t.TempDir()
+		relayMeter := newTestRelayMeter(t, 1, appStakes, WithRelayMeterStoresDirectory(storesDirectory))
+		reqMeta := newTestRelayRequestMetadata(appAddress, 1)
+		require.NoError(t, relayMeter.AccumulateRelayReward(ctx, reqMeta))
+		unstakedAppReqMeta := newTestRelayRequestMetadata(unstakedAppAddress, 1)
+		require.NoError(t, relayMeter.AccumulateRelayReward(ctx, unstakedAppReqMeta))
+		require.NoError(t, relayMeter.relayMeterStore.Set([]byte("corrupt_session"), []byte("not a relay meter")))
+		relayMeter.stopRelayMeterStore()
+
+		// The application of the second session is unstaked across the restart.
+		restartedRelayMeter := newTestRelayMeter(t, 1,
+			map[string]int64{appAddress: testRelayMeterAppStakeAmt},
+			WithRelayMeterStoresDirectory(storesDirectory),
+		)
+		require.NoError(t, restartedRelayMeter.loadSessionRelayMeters(ctx))
+
+		require.Len(t, restartedRelayMeter.sessionToRelayMeterMap, 1)
+		require.Contains(t, restartedRelayMeter.sessionToRelayMeterMap, reqMeta.GetSessionHeader().GetSessionId())
+		requirePersistedSessionIds(t, restartedRelayMeter, reqMeta.GetSessionHeader().GetSessionId())
+	})
+
+	t.Run("relay meters are not persisted once the store is stopped", func(t *testing.T) {
+		relayMeter := newTestRelayMeter(t, 1, appStakes, WithRelayMeterStoresDirectory(t.TempDir()))
+		relayMeter.stopRelayMeterStore()
+		require.NoError(t, relayMeter.loadSessionRelayMeters(ctx))
+
+		reqMeta := newTestRelayRequestMetadata(appAddress, 1)
+		require.NoError(t, relayMeter.AccumulateRelayReward(ctx, reqMeta))
+		require.Contains(t, relayMeter.sessionToRelayMeterMap, reqMeta.GetSessionHeader().GetSessionId())
+	})
+}
+
+// newTestRelayMeter returns a ProxyRelayMeter whose last block is at the given
+// height and whose application querier returns the applications of appStakes,
+// staked for testRelayMeterServiceId with the given amounts.
+func newTestRelayMeter(
+	t *testing.T,
+	blockHeight int64,
+	appStakes map[string]int64,
+	opts ...relayer.RelayMeterOption,
+) *ProxyRelayMeter {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+
+	appQuerier := mockclient.NewMockApplicationQueryClient(ctrl)
+	appQuerier.EXPECT().GetApplication(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, appAddress string) (apptypes.Application, error) {
+			appStakeAmt, ok := appStakes[appAddress]
+			if !ok {
+				return apptypes.Application{}, apptypes.ErrAppNotFound
+			}
+			appStake := cosmostypes.NewInt64Coin(volatile.DenomuPOKT, appStakeAmt)
+			return apptypes.Application{
+				Address:        appAddress,
+				Stake:          &appStake,
+				ServiceConfigs: []*sharedtypes.ApplicationServiceConfig{{ServiceId: testRelayMeterServiceId}},
+			}, nil
+		}).
+		AnyTimes()
+
+	serviceQuerier := mockclient.NewMockServiceQueryClient(ctrl)
+	serviceQuerier.EXPECT().GetService(gomock.Any(), testRelayMeterServiceId).
+		Return(newTestRelayMeterService(), nil).
+		AnyTimes()
+	serviceQuerier.EXPECT().GetServiceRelayDifficulty(gomock.Any(), testRelayMeterServiceId).
+		Return(servicetypes.RelayMiningDifficulty{
+			ServiceId:  testRelayMeterServiceId,
+			TargetHash: protocol.BaseRelayDifficultyHashBz,
+		}, nil).
+		AnyTimes()
+
+	sessionParams := sessiontypes.DefaultParams()
+	sessionQuerier := mockclient.NewMockSessionQueryClient(ctrl)
+	sessionQuerier.EXPECT().GetParams(gomock.Any()).Return(&sessionParams, nil).AnyTimes()
+
+	sharedParams := sharedtypes.DefaultParams()
+	sharedQuerier := mockclient.NewMockSharedQueryClient(ctrl)
+	sharedQuerier.EXPECT().GetParams(gomock.Any()).Return(&sharedParams, nil).AnyTimes()
+
+	blockClient := mockclient.NewMockBlockClient(ctrl)
+	blockClient.EXPECT().LastBlock(gomock.Any()).Return(newTestBlock(t, blockHeight)).AnyTimes()
+
+	deps := depinject.Supply(
+		sharedQuerier,
+		appQuerier,
+		serviceQuerier,
+		sessionQuerier,
+		blockClient,
+		mockclient.NewMockEventsQueryClient(ctrl),
+		polyzero.NewLogger(),
+	)
+
+	relayMeter, err := NewRelayMeter(deps, opts...)
+	require.NoError(t, err)
+
+	proxyRelayMeter := relayMeter.(*ProxyRelayMeter)
+	t.Cleanup(proxyRelayMeter.stopRelayMeterStore)
+
+	return proxyRelayMeter
+}
+
+// newTestBlock returns a block at the given height.
+func newTestBlock(t *testing.T, blockHeight int64) *mockclient.MockBlock {
+	t.Helper()
+
+	block := mockclient.NewMockBlock(gomock.NewController(t))
+	block.EXPECT().Height().Return(blockHeight).AnyTimes()
+
+	return block
+}
+
+// newTestRelayMeterService returns the service of the test relay meters, whose
+// relays cost a single compute unit.
+func newTestRelayMeterService() sharedtypes.Service {
+	return sharedtypes.Service{
+		Id:                   testRelayMeterServiceId,
+		ComputeUnitsPerRelay: 1,
+	}
+}
+
+// newTestRelayRequestMetadata returns the metadata of a relay request sent by the
+// given application for the session starting at sessionStartHeight.
+func newTestRelayRequestMetadata(appAddress string, sessionStartHeight int64) servicetypes.RelayRequestMetadata {
+	sharedParams := sharedtypes.DefaultParams()
+	return servicetypes.RelayRequestMetadata{
+		SessionHeader: &sessiontypes.SessionHeader{
+			ApplicationAddress:      appAddress,
+			ServiceId:               testRelayMeterServiceId,
+			SessionId:               fmt.Sprintf("%s_%d", appAddress, sessionStartHeight),
+			SessionStartBlockHeight: sessionStartHeight,
+			SessionEndBlockHeight:   sharedtypes.GetSessionEndHeight(&sharedParams, sessionStartHeight),
+		},
+	}
+}
+
+// getTestRelayCostCoin returns the cost of a single relay of the test relay meters' service.
+func getTestRelayCostCoin(t *testing.T) cosmostypes.Coin {
+	t.Helper()
+
+	sharedParams := sharedtypes.DefaultParams()
+	service := newTestRelayMeterService()
+	relayCostCoin, err := getSingleMinedRelayCostCoin(
+		&sharedParams,
+		&service,
+		servicetypes.RelayMiningDifficulty{TargetHash: protocol.BaseRelayDifficultyHashBz},
+		1,
+	)
+	require.NoError(t, err)
+
+	return relayCostCoin
+}
+
+// getTestMinAppStakeAmt returns the minimum application stake for the relay meter
+// to allow numRelays relays to be served without over-servicing.
+func getTestMinAppStakeAmt(t *testing.T, numRelays int64) int64 {
+	t.Helper()
+
+	sharedParams := sharedtypes.DefaultParams()
+	sessionParams := sessiontypes.DefaultParams()
+	// The application stake is split across its session suppliers and the sessions
+	// which are pending settlement, as done by getAppStakePortionPayableToSessionSupplier.
+	numBlocksPerSession := int64(sharedParams.GetNumBlocksPerSession())
+	numBlocksUntilProofWindowCloses := sharedtypes.GetSessionEndToProofWindowCloseBlocks(&sharedParams)
+	numPendingSessions := (numBlocksUntilProofWindowCloses + numBlocksPerSession - 1) / numBlocksPerSession
+
+	return numRelays * getTestRelayCostCoin(t).Amount.Int64() *
+		int64(sessionParams.GetNumSuppliersPerSession()) * numPendingSessions
+}
+
+// requirePersistedSessionIds asserts that, once the pending relay meter writes
+// are flushed, the relay meters persisted to the relay meter store are exactly
+// the ones of the given sessions.
+func requirePersistedSessionIds(t *testing.T, relayMeter *ProxyRelayMeter, expectedSessionIds ...string) {
+	t.Helper()
+
+	relayMeter.flushSessionRelayMeters()
+	requireStoredSessionIds(t, relayMeter, expectedSessionIds...)
+}
+
+// requireStoredSessionIds asserts that the relay meters currently written to the
+// relay meter store are exactly the ones of the given sessions.
+func requireStoredSessionIds(t *testing.T, relayMeter *ProxyRelayMeter, expectedSessionIds ...string) {
+	t.Helper()
+
+	sessionIdsBz, _, err := relayMeter.relayMeterStore.GetAll([]byte{}, false)
+	require.NoError(t, err)
+
+	sessionIds := make([]string, 0, len(sessionIdsBz))
+	for _, sessionIdBz := range sessionIdsBz {
+		sessionIds = append(sessionIds, string(sessionIdBz))
+	}
+	require.ElementsMatch(t, expectedSessionIds, sessionIds)
+}
@@ -0,0 +1,278 @@
+package proxy
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/poktroll/pkg/crypto/protocol"
+	"github.com/pokt-network/poktroll/pkg/relayer"
+	"github.com/pokt-network/poktroll/pkg/relayer/config"
+	"github.com/pokt-network/poktroll/testutil/sample"
+	servicetypes "github.com/pokt-network/poktroll/x/service/types"
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
+)
+
+func TestGetSingleMinedRelayCostCoin(t *testing.T) {
+	tests := []struct {
+		desc                           string
+		computeUnitsPerRelay           uint64
+		computeUnitsToTokensMultiplier uint64
+		expectedRelayCostAmt           *big.Int
+	}{
+		{
+			desc:                           "relay cost is the product of the compute units and the multiplier",
+			computeUnitsPerRelay:           3,
+			computeUnitsToTokensMultiplier: 42,
+			expectedRelayCostAmt:           big.NewInt(126),
+		},
+		{
+			desc:                           "relay cost exceeding uint64 does not overflow",
+			computeUnitsPerRelay:           math.MaxUint64,
+			computeUnitsToTokensMultiplier: 4,
+			expectedRelayCostAmt: new(big.Int).Mul(
+				new(big.Int).SetUint64(math.MaxUint64),
+				big.NewInt(4),
+			),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			sharedParams := sharedtypes.DefaultParams()
+			sharedParams.ComputeUnitsToTokensMultiplier = test.computeUnitsToTokensMultiplier
+			service := sharedtypes.Service{
+				Id:                   testRelayMeterServiceId,
+				ComputeUnitsPerRelay: test.computeUnitsPerRelay,
+			}
+
+			// The base relay difficulty has a difficulty multiplier of 1.
+			relayCostCoin, err := getSingleMinedRelayCostCoin(
+				&sharedParams,
+				&service,
+				servicetypes.RelayMiningDifficulty{TargetHash: protocol.BaseRelayDifficultyHashBz},
+				1,
+			)
+			require.NoError(t, err)
+			require.Equal(t, test.expectedRelayCostAmt.String(), relayCostCoin.Amount.String())
+		})
+	}
+}
+
+func TestRelayMeter_OverServicingAllowance(t *testing.T) {
+	// The application stake portion payable to the supplier covers 2 relays, and
+	// the relay meter is sent 6 relays.
+	const (
+		numCoveredRelays = 2
+		numRelays        = 6
+	)
+	relayCostAmt := getTestRelayCostCoin(t).Amount.Uint64()
+	appAddress := sample.AccAddress()
+
+	tests := []struct {
+		desc             string
+		relayMeterConfig *config.RelayMinerRelayMeterConfig
+		// expectedNumServedRelays is the number of relays served before the
+		// application is rate limited.
+		expectedNumServedRelays uint64
+	}{
+		{
+			desc:                    "default allowance",
+			expectedNumServedRelays: numCoveredRelays + config.DefaultOverServicingAllowanceUpokt/relayCostAmt,
+		},
+		{
+			desc: "no over-servicing",
+			relayMeterConfig: &config.RelayMinerRelayMeterConfig{
+				OverServicingAllowance: &config.RelayMinerOverServicingAllowance{
+					Policy: config.OverServicingAllowancePolicyNone,
+				},
+			},
+			expectedNumServedRelays: numCoveredRelays,
+		},
+		{
+			desc: "fixed allowance",
+			relayMeterConfig: &config.RelayMinerRelayMeterConfig{
+				OverServicingAllowance: &config.RelayMinerOverServicingAllowance{
+					Policy: config.OverServicingAllowancePolicyFixed,
+					Upokt:  2 * relayCostAmt,
+				},
+			},
+			expectedNumServedRelays: numCoveredRelays + 2,
+		},
+		{
+			desc: "stake percentage allowance",
+			relayMeterConfig: &config.RelayMinerRelayMeterConfig{
+				OverServicingAllowance: &config.RelayMinerOverServicingAllowance{
+					Policy:          config.OverServicingAllowancePolicyStakePercentage,
+					StakePercentage: 50,
+				},
+			},
+			expectedNumServedRelays: numCoveredRelays + 1,
+		},
+		{
+			desc: "unlimited allowance",
+			relayMeterConfig: &config.RelayMinerRelayMeterConfig{
+				OverServicingAllowance: &config.RelayMinerOverServicingAllowance{
+					Policy: config.OverServicingAllowancePolicyUnlimited,
+				},
+			},
+			expectedNumServedRelays: numRelays,
+		},
+		{
+			desc: "service allowance overrides the global one",
+			relayMeterConfig: &config.RelayMinerRelayMeterConfig{
+				OverServicingAllowance: &config.RelayMinerOverServicingAllowance{
+					Policy: config.OverServicingAllowancePolicyNone,
+				},
+				ServicesOverServicingAllowance: map[string]*config.RelayMinerOverServicingAllowance{
+					testRelayMeterServiceId: {
+						Policy: config.OverServicingAllowancePolicyFixed,
+						Upokt:  relayCostAmt,
+					},
+				},
+			},
+			expectedNumServedRelays: numCoveredRelays + 1,
+		},
+		{
+			desc: "allowed application is over-serviced without limit",
+			relayMeterConfig: &config.RelayMinerRelayMeterConfig{
+				OverServicingAllowance: &config.RelayMinerOverServicingAllowance{
+					Policy: config.OverServicingAllowancePolicyNone,
+				},
+				AllowedApplications: []string{appAddress},
+			},
+			expectedNumServedRelays: numRelays,
+		},
+		{
+			desc: "denied application is not over-serviced",
+			relayMeterConfig: &config.RelayMinerRelayMeterConfig{
+				OverServicingAllowance: &config.RelayMinerOverServicingAllowance{
+					Policy: config.OverServicingAllowancePolicyUnlimited,
+				},
+				DeniedApplications: []string{appAddress},
+			},
+			expectedNumServedRelays: numCoveredRelays,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			ctx := context.Background()
+			appStakes := map[string]int64{appAddress: getTestMinAppStakeAmt(t, numCoveredRelays)}
+
+			var opts []relayer.RelayMeterOption
+			if test.relayMeterConfig != nil {
+				opts = append(opts, WithRelayMeterConfig(test.relayMeterConfig))
+			}
+			relayMeter := newTestRelayMeter(t, 1, appStakes, opts...)
+
+			reqMeta := newTestRelayRequestMetadata(appAddress, 1)
+			numServedRelays := uint64(0)
+			for range numRelays {
+				err := relayMeter.AccumulateRelayReward(ctx, reqMeta)
+				if err != nil {
+					require.ErrorIs(t, err, ErrRelayerProxyRateLimited)
+					continue
+				}
+				numServedRelays++
+			}
+			require.Equal(t, min(test.expectedNumServedRelays, numRelays), numServedRelays)
+
+			sessionRelayMeter := relayMeter.sessionToRelayMeterMap[reqMeta.GetSessionHeader().GetSessionId()]
+			require.Equal(t, numServedRelays-numCoveredRelays, sessionRelayMeter.numOverServicedRelays)
+		})
+	}
+}
+
+func TestRelayMeter_SetNonApplicableRelayRewardOverServicedRelays(t *testing.T) {
+	// The application stake portion payable to the supplier covers 2 relays,
+	// and the allowance 2 more over-serviced relays.
+	const numCoveredRelays = 2
+	relayCostAmt := getTestRelayCostCoin(t).Amount.Uint64()
+	appAddress := sample.AccAddress()
+
+	ctx := context.Background()
+	appStakes := map[string]int64{appAddress: getTestMinAppStakeAmt(t, numCoveredRelays)}
+	relayMeter := newTestRelayMeter(t, 1, appStakes, WithRelayMeterConfig(&config.RelayMinerRelayMeterConfig{
+		OverServicingAllowance: &config.RelayMinerOverServicingAllowance{
+			Policy: config.OverServicingAllowancePolicyFixed,
+			Upokt:  2 * relayCostAmt,
+		},
+	}))
+
+	reqMeta := newTestRelayRequestMetadata(appAddress, 1)
+	for range numCoveredRelays + 2 {
+		require.NoError(t, relayMeter.AccumulateRelayReward(ctx, reqMeta))
+	}
+	require.ErrorIs(t, relayMeter.AccumulateRelayReward(ctx, reqMeta), ErrRelayerProxyRateLimited)
+
+	sessionRelayMeter := relayMeter.sessionToRelayMeterMap[reqMeta.GetSessionHeader().GetSessionId()]
+	computeUnitsPerRelay := sessionRelayMeter.service.ComputeUnitsPerRelay
+	require.Equal(t, uint64(2), sessionRelayMeter.numOverServicedRelays)
+	require.Equal(t, 2*computeUnitsPerRelay, sessionRelayMeter.numOverServicedComputeUnits)
+
+	// Refunding the over-serviced relays decrements the over-serviced counters,
+	// while refunding a covered relay leaves them unchanged.
+	require.NoError(t, relayMeter.SetNonApplicableRelayReward(ctx, reqMeta))
+	require.Equal(t, uint64(1), sessionRelayMeter.numOverServicedRelays)
+	require.Equal(t, computeUnitsPerRelay, sessionRelayMeter.numOverServicedComputeUnits)
+
+	require.NoError(t, relayMeter.SetNonApplicableRelayReward(ctx, reqMeta))
+	require.NoError(t, relayMeter.SetNonApplicableRelayReward(ctx, reqMeta))
+	require.Zero(t, sessionRelayMeter.numOverServicedRelays)
+	require.Zero(t, sessionRelayMeter.numOverServicedComputeUnits)
+
+	// The refunded relays free the allowance for the subsequent ones.
+	for range 3 {
+		require.NoError(t, relayMeter.AccumulateRelayReward(ctx, reqMeta))
+	}
+	require.Equal(t, uint64(2), sessionRelayMeter.numOverServicedRelays)
+	require.Equal(t, 2*computeUnitsPerRelay, sessionRelayMeter.numOverServicedComputeUnits)
+}
+
+func TestRelayMeter_RateLimitedReplyPolicy(t *testing.T) {
+	tests := []struct {
+		desc                       string
+		rateLimitedReplyPolicy     config.RateLimitedReplyPolicy
+		expectedIsRateLimitedError bool
+	}{
+		{
+			desc:                       "too many requests",
+			rateLimitedReplyPolicy:     config.RateLimitedReplyPolicyTooManyRequests,
+			expectedIsRateLimitedError: true,
+		},
+		{
+			desc:                       "relay error",
+			rateLimitedReplyPolicy:     config.RateLimitedReplyPolicyRelayError,
+			expectedIsRateLimitedError: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			ctx := context.Background()
+			appAddress := sample.AccAddress()
+			appStakes := map[string]int64{appAddress: getTestMinAppStakeAmt(t, 1)}
+
+			relayMeter := newTestRelayMeter(t, 1, appStakes, WithRelayMeterConfig(&config.RelayMinerRelayMeterConfig{
+				OverServicingAllowance: &config.RelayMinerOverServicingAllowance{
+					Policy: config.OverServicingAllowancePolicyNone,
+				},
+				RateLimitedReplyPolicy: test.rateLimitedReplyPolicy,
+			}))
+
+			reqMeta := newTestRelayRequestMetadata(appAddress, 1)
+			require.NoError(t, relayMeter.AccumulateRelayReward(ctx, reqMeta))
+
+			err := relayMeter.AccumulateRelayReward(ctx, reqMeta)
+			require.ErrorIs(t, err, ErrRelayerProxyRateLimited)
+
+			var rateLimitedErr *rateLimitedError
+			require.Equal(t, test.expectedIsRateLimitedError, errors.As(err, &rateLimitedErr))
+		})
+	}
+}
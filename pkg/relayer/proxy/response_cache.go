@@ -0,0 +1,406 @@
+package proxy
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync/atomic"
+
+	sdktypes "github.com/pokt-network/shannon-sdk/types"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/pokt-network/poktroll/pkg/cache"
+	"github.com/pokt-network/poktroll/pkg/cache/memory"
+	"github.com/pokt-network/poktroll/pkg/relayer"
+	"github.com/pokt-network/poktroll/pkg/relayer/config"
+)
+
+// movingBlockTags are the EVM block tags which designate a block that changes
+// as the chain progresses. Requests referencing them are not deterministic and
+// are therefore never cached.
+var movingBlockTags = map[string]struct{}{
+	"latest":    {},
+	"pending":   {},
+	"safe":      {},
+	"finalized": {},
+}
+
+// finalizedBlockMethods are the EVM JSON-RPC methods whose responses to the
+// requests of the "finalized" block tag are observed to learn the highest block
+// number known to be final.
+var finalizedBlockMethods = map[string]struct{}{
+	"eth_getBlockByNumber":  {},
+	"eth_getHeaderByNumber": {},
+}
+
+// maxBlockNumberHexDigits is the maximum number of hex digits of a JSON-RPC
+// quantity param which is considered to be a block number. It distinguishes
+// block numbers from the longer hex encoded addresses and hashes.
+const maxBlockNumberHexDigits = 16
+
+// jsonRPCRequest is the subset of a JSON-RPC request that is relevant to caching
+// its response.
+type jsonRPCRequest struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params,omitempty"`
+}
+
+// jsonRPCResponse is the subset of a JSON-RPC response that is relevant to caching it.
+type jsonRPCResponse struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Result  json.RawMessage `json:"result,omitempty"`
+	Error   json.RawMessage `json:"error,omitempty"`
+}
+
+// cacheableRequest is a relay request whose backend response can be cached.
+type cacheableRequest struct {
+	// key identifies the backend request independently from its JSON-RPC id.
+	key string
+	// method is the JSON-RPC method of the request.
+	method string
+	// id is the JSON-RPC id of the request, which the cached response is
+	// replied with.
+	id json.RawMessage
+	// blockNumber is the highest block number referenced by the request params,
+	// which must be known to be final for its response to be cached.
+	blockNumber uint64
+}
+
+// cachedResponse is a successful backend response to a JSON-RPC request,
+// stripped from the JSON-RPC id of the request it was received for.
+type cachedResponse struct {
+	statusCode uint32
+	header     map[string]*sdktypes.Header
+	result     json.RawMessage
+}
+
+// serviceResponseCache caches the backend responses to the deterministic
+// JSON-RPC requests of a service, so identical read-only requests are not all
+// forwarded to the backend.
+// Only the serialized backend response is cached, each relay still getting its
+// own freshly signed RelayResponse.
+type serviceResponseCache struct {
+	serviceId string
+	// methodCaches is a map of JSON-RPC method -> cache of its responses, each
+	// method having its own TTL.
+	methodCaches map[string]cache.KeyValueCache[*cachedResponse]
+	// finalizedBlockNumber is the highest block number known to be final, as
+	// learned from the backend responses to the requests of the finalized block.
+	finalizedBlockNumber atomic.Uint64
+}
+
+// newServiceResponseCache creates a serviceResponseCache for the given service
+// response cache configuration.
+func newServiceResponseCache(
+	serviceId string,
+	responseCacheConfig *config.RelayMinerSupplierServiceResponseCache,
+) (*serviceResponseCache, error) {
+	responseCache := &serviceResponseCache{
+		serviceId:    serviceId,
+		methodCaches: make(map[string]cache.KeyValueCache[*cachedResponse]),
+	}
+
+	for method, ttl := range responseCacheConfig.MethodTTLs {
+		methodCache, err := memory.NewKeyValueCache[*cachedResponse](
+			memory.WithTTL(ttl),
+			memory.WithMaxKeys(int64(responseCacheConfig.MaxEntries)),
+		)
+		if err != nil {
+			return nil, err
+		}
+		responseCache.methodCaches[method] = methodCache
+	}
+
+	return responseCache, nil
+}
+
+// getCacheableRequest returns the cacheable request corresponding to the given
+// relay request payload, or false if its response cannot be cached.
+// Only single (i.e. not batched) JSON-RPC requests of the configured methods,
+// which do not reference a moving block tag, are cacheable. The block numbers
+// they reference are checked to be final when their response is cached.
+func (responseCache *serviceResponseCache) getCacheableRequest(
+	relayRequestPayload []byte,
+) (*cacheableRequest, bool) {
+	poktHTTPRequest, err := sdktypes.DeserializeHTTPRequest(relayRequestPayload)
+	if err != nil || poktHTTPRequest.GetMethod() != http.MethodPost {
+		return nil, false
+	}
+
+	var request jsonRPCRequest
+	if err := json.Unmarshal(poktHTTPRequest.GetBodyBz(), &request); err != nil {
+		return nil, false
+	}
+
+	if request.JSONRPC == "" || len(request.ID) == 0 {
+		return nil, false
+	}
+
+	if _, ok := responseCache.methodCaches[request.Method]; !ok {
+		return nil, false
+	}
+
+	// Normalize the params so requests which only differ by their formatting
+	// (e.g. whitespaces, object keys order) share the same cached response.
+	var params any
+	if len(request.Params) > 0 {
+		if err := json.Unmarshal(request.Params, &params); err != nil {
+			return nil, false
+		}
+	}
+
+	if referencesMovingBlockTag(params) {
+		return nil, false
+	}
+
+	normalizedParams, err := json.Marshal(params)
+	if err != nil {
+		return nil, false
+	}
+
+	// The requests sent to different paths are distinct, even if their
+	// JSON-RPC method and params are the same.
+	var requestPath string
+	if requestUrl, err := url.Parse(poktHTTPRequest.GetUrl()); err == nil {
+		requestPath = requestUrl.Path
+	}
+
+	return &cacheableRequest{
+		key:         requestPath + "\x00" + request.Method + "\x00" + string(normalizedParams),
+		method:      request.Method,
+		id:          request.ID,
+		blockNumber: getMaxBlockNumber(params),
+	}, true
+}
+
+// get returns the serialized cached backend response to the given request,
+// with its JSON-RPC id set to the one of the request, or false on a cache miss.
+func (responseCache *serviceResponseCache) get(request *cacheableRequest) ([]byte, bool) {
+	labels := []string{"service_id", responseCache.serviceId, "method", request.method}
+
+	cached, ok := responseCache.methodCaches[request.method].Get(request.key)
+	if !ok {
+		relayer.ResponseCacheMissesTotal.With(labels...).Add(1)
+		return nil, false
+	}
+
+	bodyBz, err := json.Marshal(&jsonRPCResponse{
+		JSONRPC: "2.0",
+		ID:      request.id,
+		Result:  cached.result,
+	})
+	if err != nil {
+		relayer.ResponseCacheMissesTotal.With(labels...).Add(1)
+		return nil, false
+	}
+
+	// Use deterministic marshalling, the same way as the backend responses
+	// are serialized.
+	responseBz, err := proto.MarshalOptions{Deterministic: true}.Marshal(&sdktypes.POKTHTTPResponse{
+		StatusCode: cached.statusCode,
+		Header:     cached.header,
+		BodyBz:     bodyBz,
+	})
+	if err != nil {
+		relayer.ResponseCacheMissesTotal.With(labels...).Add(1)
+		return nil, false
+	}
+
+	relayer.ResponseCacheHitsTotal.With(labels...).Add(1)
+
+	return responseBz, true
+}
+
+// set caches the given serialized backend response to the given request if it
+// is a successful non-null JSON-RPC response and the block numbers referenced by
+// the request are known to be final.
+func (responseCache *serviceResponseCache) set(request *cacheableRequest, responseBz []byte) {
+	// Blocks which are not final yet may be reorganized, or not exist at all,
+	// their responses may therefore change.
+	if request.blockNumber > responseCache.finalizedBlockNumber.Load() {
+		return
+	}
+
+	poktHTTPResponse, err := sdktypes.DeserializeHTTPResponse(responseBz)
+	if err != nil || poktHTTPResponse.GetStatusCode() != http.StatusOK {
+		return
+	}
+
+	var response jsonRPCResponse
+	if err := json.Unmarshal(poktHTTPResponse.GetBodyBz(), &response); err != nil {
+		return
+	}
+
+	// Do not cache errors (e.g. rate limits, unavailable data) which may not be
+	// returned by subsequent requests.
+	isNullError := len(response.Error) == 0 || bytes.Equal(response.Error, []byte("null"))
+	if !isNullError || len(response.Result) == 0 {
+		return
+	}
+
+	// Do not cache null results (e.g. unknown block or transaction) which may
+	// not be null anymore once the backend catches up with the chain.
+	if bytes.Equal(bytes.TrimSpace(response.Result), []byte("null")) {
+		return
+	}
+
+	// The body of the cached response is rebuilt for every request, its length
+	// is therefore not the one of the original response.
+	header := make(map[string]*sdktypes.Header, len(poktHTTPResponse.GetHeader()))
+	for key, value := range poktHTTPResponse.GetHeader() {
+		if http.CanonicalHeaderKey(key) == "Content-Length" {
+			continue
+		}
+		header[key] = value
+	}
+
+	responseCache.methodCaches[request.method].Set(request.key, &cachedResponse{
+		statusCode: poktHTTPResponse.GetStatusCode(),
+		header:     header,
+		result:     response.Result,
+	})
+}
+
+// observeFinalizedBlock updates the highest block number known to be final if
+// the given relay request payload is a request of the finalized block, using
+// the block number of its serialized backend response.
+func (responseCache *serviceResponseCache) observeFinalizedBlock(
+	relayRequestPayload []byte,
+	responseBz []byte,
+) {
+	poktHTTPRequest, err := sdktypes.DeserializeHTTPRequest(relayRequestPayload)
+	if err != nil || poktHTTPRequest.GetMethod() != http.MethodPost {
+		return
+	}
+
+	var request jsonRPCRequest
+	if err := json.Unmarshal(poktHTTPRequest.GetBodyBz(), &request); err != nil {
+		return
+	}
+
+	if _, ok := finalizedBlockMethods[request.Method]; !ok {
+		return
+	}
+
+	var params []any
+	if err := json.Unmarshal(request.Params, &params); err != nil || len(params) == 0 || params[0] != "finalized" {
+		return
+	}
+
+	poktHTTPResponse, err := sdktypes.DeserializeHTTPResponse(responseBz)
+	if err != nil || poktHTTPResponse.GetStatusCode() != http.StatusOK {
+		return
+	}
+
+	var response struct {
+		Result struct {
+			Number string `json:"number"`
+		} `json:"result"`
+	}
+	if err := json.Unmarshal(poktHTTPResponse.GetBodyBz(), &response); err != nil {
+		return
+	}
+
+	finalizedBlockNumber, ok := parseBlockNumber(response.Result.Number)
+	if !ok {
+		return
+	}
+
+	// The finalized block never moves backward, ignore the responses of the
+	// backends which are lagging behind.
+	for {
+		current := responseCache.finalizedBlockNumber.Load()
+		if finalizedBlockNumber <= current ||
+			responseCache.finalizedBlockNumber.CompareAndSwap(current, finalizedBlockNumber) {
+			return
+		}
+	}
+}
+
+// getMaxBlockNumber returns the highest block number referenced by the given
+// decoded JSON-RPC params, or 0 if none is referenced.
+// Any hex encoded quantity short enough to be a block number is considered to
+// be one, so no block number can be missed.
+func getMaxBlockNumber(params any) uint64 {
+	var maxBlockNumber uint64
+	switch value := params.(type) {
+	case string:
+		blockNumber, _ := parseBlockNumber(value)
+		return blockNumber
+	case []any:
+		for _, item := range value {
+			maxBlockNumber = max(maxBlockNumber, getMaxBlockNumber(item))
+		}
+	case map[string]any:
+		for _, item := range value {
+			maxBlockNumber = max(maxBlockNumber, getMaxBlockNumber(item))
+		}
+	}
+
+	return maxBlockNumber
+}
+
+// isHexQuantity returns true if the given string is a "0x" prefixed hex encoded
+// quantity which is short enough to be a block number.
+func isHexQuantity(value string) bool {
+	digits, ok := strings.CutPrefix(value, "0x")
+	if !ok || len(digits) == 0 || len(digits) > maxBlockNumberHexDigits {
+		return false
+	}
+
+	for _, digit := range digits {
+		isHexDigit := (digit >= '0' && digit <= '9') ||
+			(digit >= 'a' && digit <= 'f') ||
+			(digit >= 'A' && digit <= 'F')
+		if !isHexDigit {
+			return false
+		}
+	}
+
+	return true
+}
+
+// parseBlockNumber returns the block number encoded by the given "0x" prefixed
+// hex quantity, or false if it is not a valid block number.
+func parseBlockNumber(value string) (uint64, bool) {
+	if !isHexQuantity(value) {
+		return 0, false
+	}
+
+	blockNumber, err := strconv.ParseUint(value[len("0x"):], 16, 64)
+	if err != nil {
+		return 0, false
+	}
+
+	return blockNumber, true
+}
+
+// referencesMovingBlockTag returns true if any of the given decoded JSON-RPC
+// params is a moving block tag.
+func referencesMovingBlockTag(params any) bool {
+	switch value := params.(type) {
+	case string:
+		_, ok := movingBlockTags[value]
+		return ok
+	case []any:
+		for _, item := range value {
+			if referencesMovingBlockTag(item) {
+				return true
+			}
+		}
+	case map[string]any:
+		for _, item := range value {
+			if referencesMovingBlockTag(item) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
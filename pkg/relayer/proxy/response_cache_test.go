@@ -0,0 +1,174 @@
+package proxy
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	sdktypes "github.com/pokt-network/shannon-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/poktroll/pkg/relayer/config"
+)
+
+func TestServiceResponseCache(t *testing.T) {
+	responseCache, err := newServiceResponseCache("anvil", &config.RelayMinerSupplierServiceResponseCache{
+		MaxEntries: 10,
+		MethodTTLs: map[string]time.Duration{
+			"eth_chainId":          time.Minute,
+			"eth_getBlockByNumber": time.Minute,
+		},
+	})
+	require.NoError(t, err)
+
+	t.Run("cached response is replied with the request id", func(t *testing.T) {
+		request, isCacheable := responseCache.getCacheableRequest(
+			serializeJSONRPCRequest(t, `{"jsonrpc":"2.0","id":1,"method":"eth_chainId"}`),
+		)
+		require.True(t, isCacheable)
+
+		_, isCached := responseCache.get(request)
+		require.False(t, isCached)
+
+		responseCache.set(request, serializeJSONRPCResponse(t, http.StatusOK, `{"jsonrpc":"2.0","id":1,"result":"0x1"}`))
+
+		otherIdRequest, isCacheable := responseCache.getCacheableRequest(
+			serializeJSONRPCRequest(t, `{"jsonrpc":"2.0","id":"abc","method":"eth_chainId"}`),
+		)
+		require.True(t, isCacheable)
+
+		responseBz, isCached := responseCache.get(otherIdRequest)
+		require.True(t, isCached)
+
+		response, err := sdktypes.DeserializeHTTPResponse(responseBz)
+		require.NoError(t, err)
+		require.Equal(t, uint32(http.StatusOK), response.GetStatusCode())
+		require.JSONEq(t, `{"jsonrpc":"2.0","id":"abc","result":"0x1"}`, string(response.GetBodyBz()))
+	})
+
+	t.Run("blocks which are not known to be final are not cached", func(t *testing.T) {
+		request, isCacheable := responseCache.getCacheableRequest(
+			serializeJSONRPCRequest(t, `{"jsonrpc":"2.0","id":1,"method":"eth_getBlockByNumber","params":["0x10", false]}`),
+		)
+		require.True(t, isCacheable)
+
+		responseCache.set(request, serializeJSONRPCResponse(t, http.StatusOK, `{"jsonrpc":"2.0","id":1,"result":{"number":"0x10"}}`))
+		_, isCached := responseCache.get(request)
+		require.False(t, isCached)
+
+		responseCache.observeFinalizedBlock(
+			serializeJSONRPCRequest(t, `{"jsonrpc":"2.0","id":1,"method":"eth_getBlockByNumber","params":["finalized", false]}`),
+			serializeJSONRPCResponse(t, http.StatusOK, `{"jsonrpc":"2.0","id":1,"result":{"number":"0x100"}}`),
+		)
+		// The responses of lagging backends do not move the finalized block backward.
+		responseCache.observeFinalizedBlock(
+			serializeJSONRPCRequest(t, `{"jsonrpc":"2.0","id":1,"method":"eth_getBlockByNumber","params":["finalized", false]}`),
+			serializeJSONRPCResponse(t, http.StatusOK, `{"jsonrpc":"2.0","id":1,"result":{"number":"0x8"}}`),
+		)
+
+		responseCache.set(request, serializeJSONRPCResponse(t, http.StatusOK, `{"jsonrpc":"2.0","id":1,"result":{"number":"0x10"}}`))
+		_, isCached = responseCache.get(request)
+		require.True(t, isCached)
+
+		nonFinalRequest, isCacheable := responseCache.getCacheableRequest(
+			serializeJSONRPCRequest(t, `{"jsonrpc":"2.0","id":1,"method":"eth_getBlockByNumber","params":["0x101", false]}`),
+		)
+		require.True(t, isCacheable)
+
+		responseCache.set(nonFinalRequest, serializeJSONRPCResponse(t, http.StatusOK, `{"jsonrpc":"2.0","id":1,"result":{"number":"0x101"}}`))
+		_, isCached = responseCache.get(nonFinalRequest)
+		require.False(t, isCached)
+	})
+
+	t.Run("params are normalized", func(t *testing.T) {
+		request, isCacheable := responseCache.getCacheableRequest(
+			serializeJSONRPCRequest(t, `{"jsonrpc":"2.0","id":1,"method":"eth_getBlockByNumber","params":["0x10", false]}`),
+		)
+		require.True(t, isCacheable)
+		responseCache.set(request, serializeJSONRPCResponse(t, http.StatusOK, `{"jsonrpc":"2.0","id":1,"result":{"number":"0x10"}}`))
+
+		sameRequest, isCacheable := responseCache.getCacheableRequest(
+			serializeJSONRPCRequest(t, `{"jsonrpc":"2.0","id":2,"method":"eth_getBlockByNumber","params":[ "0x10",false ]}`),
+		)
+		require.True(t, isCacheable)
+		_, isCached := responseCache.get(sameRequest)
+		require.True(t, isCached)
+
+		otherParamsRequest, isCacheable := responseCache.getCacheableRequest(
+			serializeJSONRPCRequest(t, `{"jsonrpc":"2.0","id":3,"method":"eth_getBlockByNumber","params":["0x11", false]}`),
+		)
+		require.True(t, isCacheable)
+		_, isCached = responseCache.get(otherParamsRequest)
+		require.False(t, isCached)
+	})
+
+	t.Run("error responses are not cached", func(t *testing.T) {
+		request, isCacheable := responseCache.getCacheableRequest(
+			serializeJSONRPCRequest(t, `{"jsonrpc":"2.0","id":1,"method":"eth_getBlockByNumber","params":["0x20", false]}`),
+		)
+		require.True(t, isCacheable)
+
+		responseCache.set(request, serializeJSONRPCResponse(t, http.StatusOK, `{"jsonrpc":"2.0","id":1,"error":{"code":-32000,"message":"busy"}}`))
+		_, isCached := responseCache.get(request)
+		require.False(t, isCached)
+
+		responseCache.set(request, serializeJSONRPCResponse(t, http.StatusServiceUnavailable, `{"jsonrpc":"2.0","id":1,"result":"0x1"}`))
+		_, isCached = responseCache.get(request)
+		require.False(t, isCached)
+
+		responseCache.set(request, serializeJSONRPCResponse(t, http.StatusOK, `{"jsonrpc":"2.0","id":1,"result":null}`))
+		_, isCached = responseCache.get(request)
+		require.False(t, isCached)
+	})
+
+	t.Run("non deterministic requests are not cacheable", func(t *testing.T) {
+		for _, body := range []string{
+			`{"jsonrpc":"2.0","id":1,"method":"eth_blockNumber"}`,
+			`{"jsonrpc":"2.0","id":1,"method":"eth_getBlockByNumber","params":["latest", false]}`,
+			`{"jsonrpc":"2.0","id":1,"method":"eth_getBlockByNumber","params":[{"blockTag":"finalized"}]}`,
+			`[{"jsonrpc":"2.0","id":1,"method":"eth_chainId"}]`,
+			`{"jsonrpc":"2.0","method":"eth_chainId"}`,
+			`not json`,
+		} {
+			_, isCacheable := responseCache.getCacheableRequest(serializeJSONRPCRequest(t, body))
+			require.False(t, isCacheable, body)
+		}
+	})
+}
+
+// serializeJSONRPCRequest returns the relay request payload of a JSON-RPC
+// request with the given body.
+func serializeJSONRPCRequest(t *testing.T, body string) []byte {
+	t.Helper()
+
+	request, err := http.NewRequest(http.MethodPost, "http://anvil.servicer:8545/", strings.NewReader(body))
+	require.NoError(t, err)
+
+	_, requestBz, err := sdktypes.SerializeHTTPRequest(request)
+	require.NoError(t, err)
+
+	return requestBz
+}
+
+// serializeJSONRPCResponse returns the serialized backend response with the
+// given status code and body.
+func serializeJSONRPCResponse(t *testing.T, statusCode int, body string) []byte {
+	t.Helper()
+
+	response := &http.Response{
+		StatusCode: statusCode,
+		Header: http.Header{
+			"Content-Type":   []string{"application/json"},
+			"Content-Length": []string{"42"},
+		},
+		Body: io.NopCloser(bytes.NewBufferString(body)),
+	}
+
+	_, responseBz, err := sdktypes.SerializeHTTPResponse(response)
+	require.NoError(t, err)
+
+	return responseBz
+}
@@ -32,6 +32,8 @@ const (
 // It populates the relayerProxy's `advertisedRelayServers` map of servers for each service, where each server
 // is responsible for listening for incoming relay requests and relaying them to the supported proxied service.
 func (rp *relayerProxy) BuildProvidedServices(ctx context.Context) error {
+	rp.onchainServiceTimeouts = make(map[string]time.Duration)
+
 	for _, supplierOperatorAddress := range rp.relayAuthenticator.GetSupplierOperatorAddresses() {
 		// TODO_MAINNET: We currently block RelayMiner from starting if at least one address
 		// is not staked or staked incorrectly. As node runners will maintain many different
@@ -54,6 +56,8 @@ func (rp *relayerProxy) BuildProvidedServices(ctx context.Context) error {
 		// service's endpoint
 		for _, service := range supplier.Services {
 			for _, endpoint := range service.Endpoints {
+				rp.collectOnchainServiceTimeout(service.ServiceId, endpoint)
+
 				found := false
 				// Iterate over the server configs and check if `endpointUrl` is present
 				// in any of the server config's suppliers' service's PubliclyExposedEndpoints
@@ -96,31 +100,72 @@ func (rp *relayerProxy) initializeProxyServers() (proxyServerMap map[string]rela
 		rp.logger.Info().Str("server host", serverConfig.ListenAddress).Msg("starting relay proxy server")
 
 		// Initialize the server according to the server type defined in the config file
+		var serverType string
 		switch serverConfig.ServerType {
 		case config.RelayMinerServerTypeHTTP:
-			logger := rp.logger.With(
-				"server_type", "http",
-				"server_host", serverConfig.ListenAddress,
-			)
-
-			servers[serverConfig.ListenAddress] = NewHTTPServer(
-				logger,
-				serverConfig,
-				rp.servedRelaysPublishCh,
-				rp.relayAuthenticator,
-				rp.relayMeter,
-				rp.blockClient,
-				rp.sharedQuerier,
-				rp.sessionQuerier,
-			)
+			serverType = "http"
+		case config.RelayMinerServerTypeHTTPS:
+			serverType = "https"
 		default:
 			return nil, ErrRelayerProxyUnsupportedTransportType
 		}
+
+		logger := rp.logger.With(
+			"server_type", serverType,
+			"server_host", serverConfig.ListenAddress,
+		)
+
+		// Both "http" and "https" servers are backed by the same relayMinerHTTPServer,
+		// the latter being TLS enabled according to its server config.
+		server, err := NewHTTPServer(
+			logger,
+			serverConfig,
+			rp.servedRelaysPublishCh,
+			rp.relayAuthenticator,
+			rp.relayMeter,
+			rp.blockClient,
+			rp.sharedQuerier,
+			rp.sessionQuerier,
+			rp.onchainServiceTimeouts,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		servers[serverConfig.ListenAddress] = server
 	}
 
 	return servers, nil
 }
 
+// collectOnchainServiceTimeout records the TIMEOUT config option of the given
+// onchain supplier endpoint, if any, for the given service.
+// If several endpoints advertise a timeout for the same service, the smallest
+// one is kept so that none of them is exceeded.
+func (rp *relayerProxy) collectOnchainServiceTimeout(
+	serviceId string,
+	endpoint *sharedtypes.SupplierEndpoint,
+) {
+	for _, configOption := range endpoint.Configs {
+		if configOption.Key != sharedtypes.ConfigOptions_TIMEOUT {
+			continue
+		}
+
+		timeout, err := parseEndpointTimeout(configOption.Value)
+		if err != nil || timeout <= 0 {
+			rp.logger.Warn().
+				Str("service_id", serviceId).
+				Str("endpoint_url", endpoint.Url).
+				Msgf("ignoring invalid onchain endpoint timeout %q", configOption.Value)
+			continue
+		}
+
+		if currentTimeout, ok := rp.onchainServiceTimeouts[serviceId]; !ok || timeout < currentTimeout {
+			rp.onchainServiceTimeouts[serviceId] = timeout
+		}
+	}
+}
+
 // waitForSupplierToStake waits in a loop until it gets the onchain supplier's
 // information back.
 // This is useful for testing and development purposes, in production the supplier
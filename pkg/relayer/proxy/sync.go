@@ -2,7 +2,7 @@ package proxy
 
 import (
 	"context"
-	"crypto/tls"
+	"errors"
 	"fmt"
 	"net/http"
 	"time"
@@ -15,13 +15,9 @@ import (
 	"github.com/pokt-network/poktroll/x/service/types"
 )
 
-// serveSyncRequest serves a synchronous relay request by forwarding the request
-// to the service's backend URL and returning the response to the client.
-func (server *relayMinerHTTPServer) serveSyncRequest(
-	ctx context.Context,
-	writer http.ResponseWriter,
-	request *http.Request,
-) (*types.RelayRequest, error) {
+// extractSyncRelayRequest extracts the relay request of a synchronous relay from
+// the request body and validates it.
+func (server *relayMinerHTTPServer) extractSyncRelayRequest(request *http.Request) (*types.RelayRequest, error) {
 	logger := server.logger.With("relay_request_type", "synchronous")
 
 	logger.ProbabilisticDebugInfo(polylog.ProbabilisticDebugInfoProb).Msg("handling HTTP request")
@@ -40,10 +36,24 @@ func (server *relayMinerHTTPServer) serveSyncRequest(
 		return relayRequest, err
 	}
 
+	return relayRequest, nil
+}
+
+// serveSyncRequest serves a synchronous relay request by forwarding the request
+// to the service's backend URL and returning the response to the client.
+// The relay request is expected to be validated and rate limited by the caller.
+func (server *relayMinerHTTPServer) serveSyncRequest(
+	ctx context.Context,
+	writer http.ResponseWriter,
+	relayRequest *types.RelayRequest,
+) error {
+	logger := server.logger.With("relay_request_type", "synchronous")
+
+	var err error
 	meta := relayRequest.Meta
 	serviceId := meta.SessionHeader.ServiceId
 
-	var serviceConfig *config.RelayMinerSupplierServiceConfig
+	var supplierConfig *config.RelayMinerSupplierConfig
 
 	// Get the Service and serviceUrl corresponding to the originHost.
 	// TODO_IMPROVE(red-0ne): Checking that the originHost is currently done by
@@ -53,13 +63,13 @@ func (server *relayMinerHTTPServer) serveSyncRequest(
 	// key so that we can get the service and serviceUrl in O(1) time.
 	for _, supplierServiceConfig := range server.serverConfig.SupplierConfigsMap {
 		if serviceId == supplierServiceConfig.ServiceId {
-			serviceConfig = supplierServiceConfig.ServiceConfig
+			supplierConfig = supplierServiceConfig
 			break
 		}
 	}
 
-	if serviceConfig == nil {
-		return relayRequest, ErrRelayerProxyServiceEndpointNotHandled
+	if supplierConfig == nil {
+		return ErrRelayerProxyServiceEndpointNotHandled
 	}
 
 	logger = logger.With(
@@ -67,7 +77,6 @@ func (server *relayMinerHTTPServer) serveSyncRequest(
 		"server_addr", server.server.Addr,
 		"application_address", meta.SessionHeader.ApplicationAddress,
 		"session_start_height", meta.SessionHeader.SessionStartBlockHeight,
-		"destination_url", serviceConfig.BackendUrl.String(),
 	)
 
 	// Increment the relays counter.
@@ -88,7 +97,7 @@ func (server *relayMinerHTTPServer) serveSyncRequest(
 
 	// Verify the relay request signature and session.
 	if err = server.relayAuthenticator.VerifyRelayRequest(ctx, relayRequest, serviceId); err != nil {
-		return relayRequest, err
+		return err
 	}
 
 	// Optimistically accumulate the relay reward before actually serving the relay.
@@ -96,43 +105,38 @@ func (server *relayMinerHTTPServer) serveSyncRequest(
 	// If the relay comes out to be not reward / volume applicable, the miner will refund the
 	// claimed price back to the application.
 	if err = server.relayMeter.AccumulateRelayReward(ctx, meta); err != nil {
-		return relayRequest, err
+		return err
 	}
 
-	httpRequest, err := relayer.BuildServiceBackendRequest(relayRequest, serviceConfig)
-	if err != nil {
-		logger.Error().Err(err).Msg("failed to build the service backend request")
-		return relayRequest, ErrRelayerProxyInternalError.Wrapf("failed to build the service backend request: %v", err)
-	}
-	defer httpRequest.Body.Close()
-
-	// Configure the HTTP client to use the appropriate transport based on the
-	// backend URL scheme.
-	var client *http.Client
-	switch serviceConfig.BackendUrl.Scheme {
-	case "https":
-		transport := &http.Transport{
-			TLSClientConfig: &tls.Config{},
+	// Serve the relay from the service's response cache if the same deterministic
+	// request was recently forwarded to the backend.
+	var (
+		responseBz       []byte
+		isCachedResponse bool
+		cacheableReq     *cacheableRequest
+	)
+	responseCache, hasResponseCache := server.responseCaches[serviceId]
+	if hasResponseCache {
+		var isCacheable bool
+		if cacheableReq, isCacheable = responseCache.getCacheableRequest(relayRequest.Payload); isCacheable {
+			responseBz, isCachedResponse = responseCache.get(cacheableReq)
 		}
-		client = &http.Client{Transport: transport}
-	default:
-		client = http.DefaultClient
 	}
 
-	// Send the relay request to the native service.
-	httpResponse, err := client.Do(httpRequest)
-	if err != nil {
-		// Do not expose connection errors with the backend service to the client.
-		return relayRequest, ErrRelayerProxyInternalError.Wrap(err.Error())
-	}
-	defer httpResponse.Body.Close()
+	if !isCachedResponse {
+		responseBz, err = server.forwardToBackend(ctx, relayRequest, supplierConfig)
+		if err != nil {
+			return err
+		}
 
-	// Serialize the service response to be sent back to the client.
-	// This will include the status code, headers, and body.
-	_, responseBz, err := sdktypes.SerializeHTTPResponse(httpResponse)
-	if err != nil {
-		return relayRequest, err
+		if hasResponseCache {
+			responseCache.observeFinalizedBlock(relayRequest.Payload, responseBz)
+		}
+		if cacheableReq != nil {
+			responseCache.set(cacheableReq, responseBz)
+		}
 	}
+	logger = logger.With("cached_response", isCachedResponse)
 
 	logger.Debug().
 		Str("relay_request_session_header", meta.SessionHeader.String()).
@@ -146,7 +150,7 @@ func (server *relayMinerHTTPServer) serveSyncRequest(
 		// The client should not have knowledge about the RelayMiner's issues with
 		// building the relay response. Reply with an internal error so that the
 		// original error is not exposed to the client.
-		return relayRequest, ErrRelayerProxyInternalError.Wrap(err.Error())
+		return ErrRelayerProxyInternalError.Wrap(err.Error())
 	}
 
 	relay := &types.Relay{Req: relayRequest, Res: relayResponse}
@@ -157,7 +161,7 @@ func (server *relayMinerHTTPServer) serveSyncRequest(
 		// the original error is not exposed to the client.
 		clientError := ErrRelayerProxyInternalError.Wrap(err.Error())
 		logger.Warn().Err(err).Msg("failed sending relay response")
-		return relayRequest, clientError
+		return clientError
 	}
 
 	logger.ProbabilisticDebugInfo(polylog.ProbabilisticDebugInfoProb).Msg("OLSH2 relay request served successfully")
@@ -170,7 +174,120 @@ func (server *relayMinerHTTPServer) serveSyncRequest(
 	// Emit the relay to the servedRelays observable.
 	server.servedRelaysProducer <- relay
 
-	return relayRequest, nil
+	return nil
+}
+
+// forwardToBackend forwards the relay request to one of the service's backends,
+// according to the service's type and load balancing policy, and returns the
+// serialized backend response to be embedded into the RelayResponse.
+func (server *relayMinerHTTPServer) forwardToBackend(
+	ctx context.Context,
+	relayRequest *types.RelayRequest,
+	supplierConfig *config.RelayMinerSupplierConfig,
+) ([]byte, error) {
+	serviceId := supplierConfig.ServiceId
+
+	backendPool, ok := server.backendPools[serviceId]
+	if !ok {
+		return nil, ErrRelayerProxyServiceEndpointNotHandled
+	}
+
+	// Select the backend to forward the relay request to and target it instead
+	// of the service's first backend.
+	backend := backendPool.acquire()
+	defer backend.release()
+
+	backendServiceConfig := *supplierConfig.ServiceConfig
+	backendServiceConfig.BackendUrl = backend.url
+
+	server.logger.Debug().
+		Str("service_id", serviceId).
+		Str("backend", backend.label).
+		Msg("forwarding relay request to the service backend")
+
+	// Forward the relay request to the service backend according to its type.
+	switch supplierConfig.BackendType {
+	case config.RelayMinerSupplierBackendTypeGRPC:
+		return server.forwardGRPCRequest(ctx, relayRequest, serviceId, &backendServiceConfig, backend)
+	default:
+		return server.forwardHTTPRequest(ctx, relayRequest, serviceId, &backendServiceConfig)
+	}
+}
+
+// forwardHTTPRequest builds the HTTP request out of the relay request payload,
+// sends it to the service's backend URL using the service's backend client and
+// returns the serialized response (i.e. status code, headers and body) to be
+// embedded into the RelayResponse.
+func (server *relayMinerHTTPServer) forwardHTTPRequest(
+	ctx context.Context,
+	relayRequest *types.RelayRequest,
+	serviceId string,
+	serviceConfig *config.RelayMinerSupplierServiceConfig,
+) ([]byte, error) {
+	backendClient, ok := server.backendClients[serviceId]
+	if !ok {
+		return nil, ErrRelayerProxyServiceEndpointNotHandled.Wrapf(
+			"no backend client for service %q",
+			serviceId,
+		)
+	}
+
+	buildRequest := func() (*http.Request, error) {
+		httpRequest, err := relayer.BuildServiceBackendRequest(relayRequest, serviceConfig)
+		if err != nil {
+			server.logger.Error().Err(err).Msg("failed to build the service backend request")
+			return nil, ErrRelayerProxyInternalError.Wrapf("failed to build the service backend request: %v", err)
+		}
+		return httpRequest, nil
+	}
+
+	onRetry := func(attempt uint64, err error) {
+		server.logger.Debug().Err(err).
+			Str("service_id", serviceId).
+			Uint64("attempt", attempt).
+			Msg("retrying service backend request")
+		relayer.RelaysBackendRetriesTotal.With("service_id", serviceId).Add(1)
+	}
+
+	// The context MUST outlive reading the response body below.
+	ctx, cancel := backendClient.withRequestTimeout(ctx)
+	defer cancel()
+
+	// Send the relay request to the native service.
+	httpResponse, err := backendClient.do(ctx, buildRequest, onRetry)
+	if err != nil {
+		return nil, server.backendError(serviceId, err)
+	}
+	defer httpResponse.Body.Close()
+
+	// Serialize the service response to be sent back to the client.
+	// This will include the status code, headers, and body.
+	_, responseBz, err := sdktypes.SerializeHTTPResponse(httpResponse)
+	if err != nil {
+		return nil, server.backendError(serviceId, err)
+	}
+
+	return responseBz, nil
+}
+
+// backendError converts an error which occurred while communicating with the
+// service backend into the relay error replied to the client.
+// Timeouts are surfaced as such while any other error is replied as an internal
+// error so that connection errors with the backend service are not exposed to
+// the client.
+func (server *relayMinerHTTPServer) backendError(serviceId string, err error) error {
+	// Errors that are already relay errors (e.g. failing to build the request)
+	// are returned as-is.
+	if errors.Is(err, ErrRelayerProxyInternalError) {
+		return err
+	}
+
+	if isTimeoutError(err) {
+		relayer.RelaysBackendTimeoutsTotal.With("service_id", serviceId).Add(1)
+		return ErrRelayerProxyBackendTimeout.Wrapf("service %q", serviceId)
+	}
+
+	return ErrRelayerProxyInternalError.Wrap(err.Error())
 }
 
 // sendRelayResponse marshals the relay response and sends it to the client.
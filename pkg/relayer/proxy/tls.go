@@ -0,0 +1,162 @@
+package proxy
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/pokt-network/poktroll/pkg/polylog"
+	"github.com/pokt-network/poktroll/pkg/relayer/config"
+)
+
+// tlsCertReloader holds the TLS certificate presented by an "https" RelayMiner
+// server and hot reloads it from disk whenever its certificate or key file is
+// modified. This allows operators to rotate certificates (e.g. via cert-manager
+// or certbot) without restarting the RelayMiner.
+type tlsCertReloader struct {
+	logger polylog.Logger
+
+	certFile string
+	keyFile  string
+
+	// certMu protects the fields below, which are read on every TLS handshake
+	// and written when the certificate is reloaded.
+	certMu      sync.RWMutex
+	cert        *tls.Certificate
+	certModTime time.Time
+	keyModTime  time.Time
+}
+
+// newTLSCertReloader loads the certificate and key files and returns a
+// tlsCertReloader serving them. It returns an error if the initial load fails.
+func newTLSCertReloader(
+	logger polylog.Logger,
+	certFile string,
+	keyFile string,
+) (*tlsCertReloader, error) {
+	reloader := &tlsCertReloader{
+		logger:   logger,
+		certFile: certFile,
+		keyFile:  keyFile,
+	}
+
+	if _, err := reloader.reloadIfModified(); err != nil {
+		return nil, err
+	}
+
+	return reloader, nil
+}
+
+// GetCertificate returns the currently loaded certificate.
+// It implements the tls.Config#GetCertificate callback.
+func (r *tlsCertReloader) GetCertificate(_ *tls.ClientHelloInfo) (*tls.Certificate, error) {
+	r.certMu.RLock()
+	defer r.certMu.RUnlock()
+
+	return r.cert, nil
+}
+
+// reloadIfModified reloads the certificate if either the certificate or the key
+// file modification time changed since the last load.
+// It returns true if the certificate was reloaded.
+func (r *tlsCertReloader) reloadIfModified() (bool, error) {
+	certInfo, err := os.Stat(r.certFile)
+	if err != nil {
+		return false, ErrRelayerProxyTLSConfig.Wrapf("unable to stat tls cert file: %v", err)
+	}
+
+	keyInfo, err := os.Stat(r.keyFile)
+	if err != nil {
+		return false, ErrRelayerProxyTLSConfig.Wrapf("unable to stat tls key file: %v", err)
+	}
+
+	r.certMu.RLock()
+	isModified := r.cert == nil ||
+		!certInfo.ModTime().Equal(r.certModTime) ||
+		!keyInfo.ModTime().Equal(r.keyModTime)
+	r.certMu.RUnlock()
+
+	if !isModified {
+		return false, nil
+	}
+
+	cert, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+	if err != nil {
+		return false, ErrRelayerProxyTLSConfig.Wrapf("unable to load tls key pair: %v", err)
+	}
+
+	r.certMu.Lock()
+	r.cert = &cert
+	r.certModTime = certInfo.ModTime()
+	r.keyModTime = keyInfo.ModTime()
+	r.certMu.Unlock()
+
+	return true, nil
+}
+
+// goReloadOnInterval periodically checks the certificate and key files for
+// changes until the context is done.
+// A failed reload keeps serving the previously loaded certificate.
+// It is intended to be called in a goroutine.
+func (r *tlsCertReloader) goReloadOnInterval(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			reloaded, err := r.reloadIfModified()
+			if err != nil {
+				r.logger.Error().Err(err).Msg("failed to reload tls certificate, keeping the previous one")
+				continue
+			}
+
+			if reloaded {
+				r.logger.Info().
+					Str("cert_file", r.certFile).
+					Msg("tls certificate reloaded")
+			}
+		}
+	}
+}
+
+// newServerTLSConfig builds the tls.Config of an "https" RelayMiner server
+// which serves the certificate held by the given reloader.
+// If a client CA file is configured, clients are required to present a
+// certificate signed by one of its CAs (i.e. mTLS).
+func newServerTLSConfig(
+	tlsConfig *config.RelayMinerServerTLSConfig,
+	certReloader *tlsCertReloader,
+) (*tls.Config, error) {
+	serverTLSConfig := &tls.Config{
+		MinVersion:     tls.VersionTLS12,
+		GetCertificate: certReloader.GetCertificate,
+	}
+
+	if tlsConfig.ClientCAFile == "" {
+		return serverTLSConfig, nil
+	}
+
+	clientCAsPEM, err := os.ReadFile(tlsConfig.ClientCAFile)
+	if err != nil {
+		return nil, ErrRelayerProxyTLSConfig.Wrapf("unable to read tls client ca file: %v", err)
+	}
+
+	clientCAs := x509.NewCertPool()
+	if !clientCAs.AppendCertsFromPEM(clientCAsPEM) {
+		return nil, ErrRelayerProxyTLSConfig.Wrapf(
+			"no valid certificate found in tls client ca file %q",
+			tlsConfig.ClientCAFile,
+		)
+	}
+
+	serverTLSConfig.ClientCAs = clientCAs
+	serverTLSConfig.ClientAuth = tls.RequireAndVerifyClientCert
+
+	return serverTLSConfig, nil
+}
@@ -0,0 +1,285 @@
+package proxy
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/poktroll/pkg/polylog/polyzero"
+	"github.com/pokt-network/poktroll/pkg/relayer/config"
+)
+
+func TestTLSCertReloader_HotReload(t *testing.T) {
+	certDir := t.TempDir()
+	certFile := filepath.Join(certDir, "tls.crt")
+	keyFile := filepath.Join(certDir, "tls.key")
+
+	ca := newTestCertAuthority(t)
+	firstCert := ca.issueCert(t, "first.relayminer")
+	writeTestCertFiles(t, firstCert, certFile, keyFile, time.Now())
+
+	reloader, err := newTLSCertReloader(polyzero.NewLogger(), certFile, keyFile)
+	require.NoError(t, err)
+	requireServedCert(t, reloader, firstCert)
+
+	// Unmodified files are not reloaded.
+	isReloaded, err := reloader.reloadIfModified()
+	require.NoError(t, err)
+	require.False(t, isReloaded)
+
+	// Modified files are reloaded.
+	secondCert := ca.issueCert(t, "second.relayminer")
+	writeTestCertFiles(t, secondCert, certFile, keyFile, time.Now().Add(time.Minute))
+	isReloaded, err = reloader.reloadIfModified()
+	require.NoError(t, err)
+	require.True(t, isReloaded)
+	requireServedCert(t, reloader, secondCert)
+
+	// Invalid files fail to reload, and the previous certificate keeps being served.
+	require.NoError(t, os.WriteFile(certFile, []byte("not a certificate"), 0o600))
+	require.NoError(t, os.Chtimes(certFile, time.Now().Add(2*time.Minute), time.Now().Add(2*time.Minute)))
+	_, err = reloader.reloadIfModified()
+	require.ErrorIs(t, err, ErrRelayerProxyTLSConfig)
+	requireServedCert(t, reloader, secondCert)
+
+	// Missing files fail to load.
+	_, err = newTLSCertReloader(polyzero.NewLogger(), filepath.Join(certDir, "missing.crt"), keyFile)
+	require.ErrorIs(t, err, ErrRelayerProxyTLSConfig)
+}
+
+func TestTLSCertReloader_ReloadsOnInterval(t *testing.T) {
+	certDir := t.TempDir()
+	certFile := filepath.Join(certDir, "tls.crt")
+	keyFile := filepath.Join(certDir, "tls.key")
+
+	ca := newTestCertAuthority(t)
+	firstCert := ca.issueCert(t, "first.relayminer")
+	writeTestCertFiles(t, firstCert, certFile, keyFile, time.Now())
+
+	reloader, err := newTLSCertReloader(polyzero.NewLogger(), certFile, keyFile)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	go reloader.goReloadOnInterval(ctx, 10*time.Millisecond)
+
+	// A TLS server serving the reloader's certificate serves the rotated one
+	// without being restarted.
+	serverTLSConfig, err := newServerTLSConfig(&config.RelayMinerServerTLSConfig{}, reloader)
+	require.NoError(t, err)
+	server := newTestTLSServer(t, serverTLSConfig)
+
+	clientTLSConfig := &tls.Config{RootCAs: ca.certPool}
+	require.Equal(t, firstCert.leaf.Subject.CommonName, getServedCertCommonName(t, server, clientTLSConfig))
+
+	secondCert := ca.issueCert(t, "second.relayminer")
+	writeTestCertFiles(t, secondCert, certFile, keyFile, time.Now().Add(time.Minute))
+	require.Eventually(t, func() bool {
+		return getServedCertCommonName(t, server, clientTLSConfig) == secondCert.leaf.Subject.CommonName
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestNewServerTLSConfig_ClientVerification(t *testing.T) {
+	certDir := t.TempDir()
+	certFile := filepath.Join(certDir, "tls.crt")
+	keyFile := filepath.Join(certDir, "tls.key")
+	clientCAFile := filepath.Join(certDir, "client_ca.crt")
+
+	serverCA := newTestCertAuthority(t)
+	writeTestCertFiles(t, serverCA.issueCert(t, "relayminer"), certFile, keyFile, time.Now())
+	reloader, err := newTLSCertReloader(polyzero.NewLogger(), certFile, keyFile)
+	require.NoError(t, err)
+
+	clientCA := newTestCertAuthority(t)
+	require.NoError(t, os.WriteFile(clientCAFile, clientCA.certPEM, 0o600))
+
+	serverTLSConfig, err := newServerTLSConfig(&config.RelayMinerServerTLSConfig{ClientCAFile: clientCAFile}, reloader)
+	require.NoError(t, err)
+	require.Equal(t, tls.RequireAndVerifyClientCert, serverTLSConfig.ClientAuth)
+	server := newTestTLSServer(t, serverTLSConfig)
+
+	sendRequest := func(clientCerts ...tls.Certificate) error {
+		client := &http.Client{Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{RootCAs: serverCA.certPool, Certificates: clientCerts},
+		}}
+		t.Cleanup(client.CloseIdleConnections)
+
+		response, err := client.Get(server.URL)
+		if err != nil {
+			return err
+		}
+		_ = response.Body.Close()
+		require.Equal(t, http.StatusOK, response.StatusCode)
+		return nil
+	}
+
+	// Clients presenting a certificate signed by the client CA are served.
+	require.NoError(t, sendRequest(clientCA.issueCert(t, "gateway").tlsCert))
+
+	// Clients without a certificate or with one signed by another CA are rejected.
+	require.Error(t, sendRequest())
+	require.Error(t, sendRequest(newTestCertAuthority(t).issueCert(t, "gateway").tlsCert))
+
+	// Client CA files without any valid certificate are rejected.
+	require.NoError(t, os.WriteFile(clientCAFile, []byte("not a certificate"), 0o600))
+	_, err = newServerTLSConfig(&config.RelayMinerServerTLSConfig{ClientCAFile: clientCAFile}, reloader)
+	require.ErrorIs(t, err, ErrRelayerProxyTLSConfig)
+
+	// Missing client CA files are rejected.
+	_, err = newServerTLSConfig(&config.RelayMinerServerTLSConfig{ClientCAFile: filepath.Join(certDir, "missing.crt")}, reloader)
+	require.ErrorIs(t, err, ErrRelayerProxyTLSConfig)
+}
+
+// testCertAuthority is a self-signed certificate authority issuing the
+// certificates used by the TLS tests.
+type testCertAuthority struct {
+	cert     *x509.Certificate
+	key      *ecdsa.PrivateKey
+	certPEM  []byte
+	certPool *x509.CertPool
+}
+
+// testCert is a certificate issued by a testCertAuthority.
+type testCert struct {
+	leaf    *x509.Certificate
+	certPEM []byte
+	keyPEM  []byte
+	tlsCert tls.Certificate
+}
+
+// newTestCertAuthority creates a new self-signed certificate authority.
+func newTestCertAuthority(t *testing.T) *testCertAuthority {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	certDER, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(certDER)
+	require.NoError(t, err)
+
+	certPool := x509.NewCertPool()
+	certPool.AddCert(cert)
+
+	return &testCertAuthority{
+		cert:     cert,
+		key:      key,
+		certPEM:  pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDER}),
+		certPool: certPool,
+	}
+}
+
+// issueCert issues a certificate for the given common name, valid both for
+// serving 127.0.0.1 and for authenticating a client.
+func (ca *testCertAuthority) issueCert(t *testing.T, commonName string) *testCert {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	serialNumber, err := rand.Int(rand.Reader, big.NewInt(1<<62))
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: serialNumber,
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+	}
+	certDER, err := x509.CreateCertificate(rand.Reader, template, ca.cert, &key.PublicKey, ca.key)
+	require.NoError(t, err)
+	leaf, err := x509.ParseCertificate(certDER)
+	require.NoError(t, err)
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDER})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+	tlsCert, err := tls.X509KeyPair(certPEM, keyPEM)
+	require.NoError(t, err)
+
+	return &testCert{
+		leaf:    leaf,
+		certPEM: certPEM,
+		keyPEM:  keyPEM,
+		tlsCert: tlsCert,
+	}
+}
+
+// writeTestCertFiles writes the given certificate and its key to the given files,
+// setting their modification time to modTime.
+func writeTestCertFiles(t *testing.T, cert *testCert, certFile, keyFile string, modTime time.Time) {
+	t.Helper()
+
+	require.NoError(t, os.WriteFile(certFile, cert.certPEM, 0o600))
+	require.NoError(t, os.WriteFile(keyFile, cert.keyPEM, 0o600))
+	require.NoError(t, os.Chtimes(certFile, modTime, modTime))
+	require.NoError(t, os.Chtimes(keyFile, modTime, modTime))
+}
+
+// requireServedCert asserts that the reloader serves the given certificate.
+func requireServedCert(t *testing.T, reloader *tlsCertReloader, expectedCert *testCert) {
+	t.Helper()
+
+	servedCert, err := reloader.GetCertificate(nil)
+	require.NoError(t, err)
+	require.Equal(t, expectedCert.tlsCert.Certificate, servedCert.Certificate)
+}
+
+// newTestTLSServer starts a TLS server with the given config, replying 200 OK.
+// NB: httptest.Server#StartTLS is not used since it sets its own certificate,
+// which takes precedence over the config's GetCertificate callback.
+func newTestTLSServer(t *testing.T, serverTLSConfig *tls.Config) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	server.Listener = tls.NewListener(server.Listener, serverTLSConfig)
+	server.Start()
+	server.URL = "https://" + server.Listener.Addr().String()
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+// getServedCertCommonName returns the common name of the certificate served by
+// the given TLS server, using a new connection.
+func getServedCertCommonName(t *testing.T, server *httptest.Server, clientTLSConfig *tls.Config) string {
+	t.Helper()
+
+	conn, err := tls.Dial("tcp", server.Listener.Addr().String(), clientTLSConfig)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	return conn.ConnectionState().PeerCertificates[0].Subject.CommonName
+}
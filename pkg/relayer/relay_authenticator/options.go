@@ -2,6 +2,7 @@ package relay_authenticator
 
 import (
 	"github.com/pokt-network/poktroll/pkg/relayer"
+	"github.com/pokt-network/poktroll/pkg/signer"
 )
 
 // WithSigningKeyNames sets the signing key names used by the relay authenticator
@@ -11,3 +12,11 @@ func WithSigningKeyNames(keyNames []string) relayer.RelayAuthenticatorOption {
 		relAuth.(*relayAuthenticator).signingKeyNames = keyNames
 	}
 }
+
+// WithRemoteSigner sets the client used by the relay authenticator to sign the
+// relay responses with the keys served by the remote signing services.
+func WithRemoteSigner(remoteSignerClient *signer.RemoteSignerClient) relayer.RelayAuthenticatorOption {
+	return func(relAuth relayer.RelayAuthenticator) {
+		relAuth.(*relayAuthenticator).remoteSignerClient = remoteSignerClient
+	}
+}
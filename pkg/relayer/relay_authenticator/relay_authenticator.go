@@ -8,6 +8,7 @@ import (
 	"github.com/pokt-network/poktroll/pkg/crypto"
 	"github.com/pokt-network/poktroll/pkg/polylog"
 	"github.com/pokt-network/poktroll/pkg/relayer"
+	"github.com/pokt-network/poktroll/pkg/signer"
 )
 
 var _ relayer.RelayAuthenticator = (*relayAuthenticator)(nil)
@@ -24,6 +25,10 @@ type relayAuthenticator struct {
 	signingKeyNames []string
 	keyring         keyring.Keyring
 
+	// remoteSignerClient, if set, is used to sign the relay responses with the
+	// keys served by the remote signing services instead of the keyring.
+	remoteSignerClient *signer.RemoteSignerClient
+
 	// sessionQuerier is the query client used to get the current session & session
 	// params from the blockchain, which are needed to check if the relay proxy
 	// should be serving an incoming relay request.
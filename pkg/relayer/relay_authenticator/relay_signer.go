@@ -12,12 +12,15 @@ func (ra *relayAuthenticator) SignRelayResponse(relayResponse *types.RelayRespon
 		return ErrRelayAuthenticatorInvalidRelayResponse.Wrapf("invalid session header: %v", err)
 	}
 
-	// create a simple signer for the request
+	// create a simple or remote signer for the request
 	operatorKeyName, ok := ra.operatorAddressToSigningKeyNameMap[supplierOperatorAddr]
 	if !ok {
 		return ErrRelayAuthenticatorUndefinedSigningKeyNames.Wrapf("unable to resolve the signing key name for %s", supplierOperatorAddr)
 	}
-	signer := signer.NewSimpleSigner(ra.keyring, operatorKeyName)
+	var responseSigner signer.Signer = signer.NewSimpleSigner(ra.keyring, operatorKeyName)
+	if ra.remoteSignerClient != nil && ra.remoteSignerClient.HasKeyName(operatorKeyName) {
+		responseSigner = signer.NewRemoteSigner(ra.remoteSignerClient, operatorKeyName)
+	}
 
 	// extract and hash the relay response's signable bytes
 	signableBz, err := relayResponse.GetSignableBytesHash()
@@ -26,7 +29,7 @@ func (ra *relayAuthenticator) SignRelayResponse(relayResponse *types.RelayRespon
 	}
 
 	// sign the relay response
-	responseSig, err := signer.Sign(signableBz)
+	responseSig, err := responseSigner.Sign(signableBz)
 	if err != nil {
 		return ErrRelayAuthenticatorInvalidRelayResponse.Wrapf("error signing relay response: %v", err)
 	}
@@ -21,6 +21,10 @@ type relayMiner struct {
 	relayerProxy           RelayerProxy
 	miner                  Miner
 	relayerSessionsManager RelayerSessionsManager
+
+	// servedRelaysObs is the observable of the relays served by the relayer proxy,
+	// which the miner is unsubscribed from on Stop.
+	servedRelaysObs RelaysObservable
 }
 
 // NewRelayMiner creates a new Relayer instance with the given dependencies.
@@ -45,8 +49,8 @@ func NewRelayMiner(ctx context.Context, deps depinject.Config) (*relayMiner, err
 	}
 
 	// Set up relay pipeline
-	servedRelaysObs := rel.relayerProxy.ServedRelays()
-	minedRelaysObs := rel.miner.MinedRelays(ctx, servedRelaysObs)
+	rel.servedRelaysObs = rel.relayerProxy.ServedRelays()
+	minedRelaysObs := rel.miner.MinedRelays(ctx, rel.servedRelaysObs)
 	rel.relayerSessionsManager.InsertRelays(minedRelaysObs)
 
 	return rel, nil
@@ -84,9 +88,19 @@ func (rel *relayMiner) Start(ctx context.Context) error {
 
 // Stop stops the relayer proxy which in turn stops all advertised relay servers
 // and unsubscribes the miner from the served relays observable.
+// The relayer sessions manager is stopped last so the relays served until then
+// are drained through the miner into their session trees and persisted before
+// exiting.
 func (rel *relayMiner) Stop(ctx context.Context) error {
-	rel.relayerSessionsManager.Stop()
-	return rel.relayerProxy.Stop(ctx)
+	proxyErr := rel.relayerProxy.Stop(ctx)
+
+	// Complete the miner's input once no more relays are served. The relays
+	// already received are still mined, after which the completion propagates
+	// to the relayer sessions manager, which is then drained.
+	rel.servedRelaysObs.UnsubscribeAll()
+
+	sessionsErr := rel.relayerSessionsManager.Stop(ctx)
+	return errors.Join(proxyErr, sessionsErr)
 }
 
 // Starts a metrics server on the given address.
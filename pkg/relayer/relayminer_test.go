@@ -3,6 +3,7 @@ package relayer_test
 import (
 	"context"
 	"errors"
+	"io"
 	"net"
 	"net/http"
 	"net/url"
@@ -21,6 +22,7 @@ import (
 	"github.com/pokt-network/poktroll/pkg/relayer"
 	"github.com/pokt-network/poktroll/testutil/testrelayer"
 	servicetypes "github.com/pokt-network/poktroll/x/service/types"
+	sessiontypes "github.com/pokt-network/poktroll/x/session/types"
 )
 
 func TestRelayMiner_StartAndStop(t *testing.T) {
@@ -57,6 +59,9 @@ func TestRelayMiner_StartAndStop(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, relayminer)
 
+	// Stand in for the miner's subscription to the served relays.
+	servedRelaysObserver := servedRelaysObs.Subscribe(ctx)
+
 	err = relayminer.Start(ctx)
 	require.NoError(t, err)
 
@@ -64,6 +69,10 @@ func TestRelayMiner_StartAndStop(t *testing.T) {
 
 	err = relayminer.Stop(ctx)
 	require.NoError(t, err)
+
+	// Stopping completes the miner's input so that it can be drained.
+	_, isOpen := <-servedRelaysObserver.Ch()
+	require.False(t, isOpen)
 }
 
 type RelayMinerPingSuite struct {
@@ -275,3 +284,164 @@ func (t *RelayMinerPingSuite) NOKPingWithoutTemporaryError() {
 	err = relayminer.Stop(ctx)
 	require.NoError(t.T(), err)
 }
+
+func TestRelayMiner_ServeAdmin(t *testing.T) {
+	const authToken = "secret"
+
+	srObs, _ := channel.NewObservable[*servicetypes.Relay]()
+	servedRelaysObs := relayer.RelaysObservable(srObs)
+
+	mrObs, _ := channel.NewObservable[*relayer.MinedRelay]()
+	minedRelaysObs := relayer.MinedRelaysObservable(mrObs)
+
+	ctx := polyzero.NewLogger().WithContext(context.Background())
+	relayerProxyMock := testrelayer.NewMockOneTimeRelayerProxy(ctx, t, servedRelaysObs)
+	minerMock := testrelayer.NewMockOneTimeMiner(ctx, t, servedRelaysObs, minedRelaysObs)
+	relayerSessionsManagerMock := testrelayer.NewMockOneTimeRelayerSessionsManager(ctx, t, minedRelaysObs)
+
+	relayerSessionsManagerMock.EXPECT().
+		GetSessionTrees().
+		Return([]relayer.SessionTreeInfo{
+			{
+				SessionHeader:           &sessiontypes.SessionHeader{SessionId: "activeSession", SessionEndBlockHeight: 10},
+				SupplierOperatorAddress: "supplier1",
+				RelayCount:              3,
+				SMSTSum:                 30,
+				State:                   relayer.SessionTreeStateActive,
+			},
+			{
+				SessionHeader:           &sessiontypes.SessionHeader{SessionId: "failedClaimSession", SessionEndBlockHeight: 5},
+				SupplierOperatorAddress: "supplier2",
+				RelayCount:              1,
+				SMSTSum:                 10,
+				ClaimRoot:               []byte{0x01, 0x02},
+				State:                   relayer.SessionTreeStateClaimFailed,
+			},
+		}).
+		AnyTimes()
+	relayerSessionsManagerMock.EXPECT().
+		RetrySessionTree(gomock.Any(), gomock.Eq("supplier2"), gomock.Eq("failedClaimSession")).
+		Return(nil).
+		Times(1)
+	// The second retry times out waiting for the retry pipeline to pick it up.
+	relayerSessionsManagerMock.EXPECT().
+		RetrySessionTree(gomock.Any(), gomock.Eq("supplier2"), gomock.Eq("failedClaimSession")).
+		Return(context.DeadlineExceeded).
+		Times(1)
+
+	deps := depinject.Supply(
+		relayerProxyMock,
+		minerMock,
+		relayerSessionsManagerMock,
+	)
+
+	relayminer, err := relayer.NewRelayMiner(ctx, deps)
+	require.NoError(t, err)
+
+	err = relayminer.Start(ctx)
+	require.NoError(t, err)
+
+	relayminerSocketPath := filepath.Join(t.TempDir(), "3f1c0a7e")
+	err = relayminer.ServeAdmin(ctx, "unix", relayminerSocketPath, authToken)
+	require.NoError(t, err)
+
+	// Override transport configuration to adapt the http client to the unix socket listener.
+	httpClient := http.Client{Transport: &http.Transport{
+		DialContext: func(ctx context.Context, network, addr string) (net.Conn, error) {
+			return net.Dial("unix", relayminerSocketPath)
+		},
+	}}
+
+	tests := []struct {
+		desc               string
+		method             string
+		path               string
+		authToken          string
+		expectedStatusCode int
+		expectedBody       string
+	}{
+		{
+			desc:               "unauthenticated request is rejected",
+			method:             http.MethodGet,
+			path:               "/session_trees",
+			authToken:          "wrong",
+			expectedStatusCode: http.StatusUnauthorized,
+			expectedBody:       `{"error":"unauthorized"}`,
+		},
+		{
+			desc:               "session trees are listed per supplier",
+			method:             http.MethodGet,
+			path:               "/session_trees",
+			authToken:          authToken,
+			expectedStatusCode: http.StatusOK,
+			expectedBody: `{"suppliers":{
+				"supplier1":[{"session_id":"activeSession","application_address":"","service_id":"","session_start_block_height":0,"session_end_block_height":10,"relay_count":3,"smst_sum":30,"state":"active"}],
+				"supplier2":[{"session_id":"failedClaimSession","application_address":"","service_id":"","session_start_block_height":0,"session_end_block_height":5,"relay_count":1,"smst_sum":10,"claim_root":"0102","state":"claim_failed"}]
+			}}`,
+		},
+		{
+			desc:               "session trees are filtered by supplier",
+			method:             http.MethodGet,
+			path:               "/session_trees?supplier_operator_address=supplier2",
+			authToken:          authToken,
+			expectedStatusCode: http.StatusOK,
+			expectedBody: `{"suppliers":{
+				"supplier2":[{"session_id":"failedClaimSession","application_address":"","service_id":"","session_start_block_height":0,"session_end_block_height":5,"relay_count":1,"smst_sum":10,"claim_root":"0102","state":"claim_failed"}]
+			}}`,
+		},
+		{
+			desc:               "failed claim is retried",
+			method:             http.MethodPost,
+			path:               "/session_trees/supplier2/failedClaimSession/retry",
+			authToken:          authToken,
+			expectedStatusCode: http.StatusAccepted,
+		},
+		{
+			desc:               "failed claim retry is rejected when the retry pipeline is busy",
+			method:             http.MethodPost,
+			path:               "/session_trees/supplier2/failedClaimSession/retry",
+			authToken:          authToken,
+			expectedStatusCode: http.StatusServiceUnavailable,
+			expectedBody:       `{"error":"session tree retry pipeline busy"}`,
+		},
+		{
+			desc:               "active session tree cannot be retried",
+			method:             http.MethodPost,
+			path:               "/session_trees/supplier1/activeSession/retry",
+			authToken:          authToken,
+			expectedStatusCode: http.StatusConflict,
+			expectedBody:       `{"error":"session tree in state \"active\" cannot be retried"}`,
+		},
+		{
+			desc:               "unknown session tree cannot be retried",
+			method:             http.MethodPost,
+			path:               "/session_trees/supplier1/unknownSession/retry",
+			authToken:          authToken,
+			expectedStatusCode: http.StatusNotFound,
+			expectedBody:       `{"error":"session tree not found"}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			req, err := http.NewRequest(test.method, "http://unix"+test.path, nil)
+			require.NoError(t, err)
+			req.Header.Set("Authorization", "Bearer "+test.authToken)
+
+			resp, err := httpClient.Do(req)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+
+			require.Equal(t, test.expectedStatusCode, resp.StatusCode)
+
+			if test.expectedBody != "" {
+				body, err := io.ReadAll(resp.Body)
+				require.NoError(t, err)
+				require.JSONEq(t, test.expectedBody, string(body))
+			}
+		})
+	}
+
+	err = relayminer.Stop(ctx)
+	require.NoError(t, err)
+}
@@ -54,6 +54,7 @@ func (rs *relayerSessionsManager) createClaims(
 	eitherClaimedSessionsObs := channel.Map(
 		ctx, sessionsWithOpenClaimWindowObs,
 		rs.newMapClaimSessionsFn(supplierClient, failedCreateClaimSessionsPublishCh),
+		channel.WithStageName[either.SessionTrees]("session_create_claims"),
 	)
 
 	// TODO_TECHDEBT: pass failed create claim sessions to some retry mechanism.
@@ -61,12 +62,12 @@ func (rs *relayerSessionsManager) createClaims(
 	// observable which corresponds to failSubmitProofsSessionsCh to have a
 	// reference to the error which caused the proof submission to fail.
 	// In this case, the error may not be persistent.
-	logging.LogErrors(ctx, filter.EitherError(ctx, eitherClaimedSessionsObs))
+	logging.LogErrors(ctx, filter.EitherError(ctx, eitherClaimedSessionsObs), "session_create_claims_errors")
 
 	// Delete expired session trees so they don't get claimed again.
 	channel.ForEach(
 		ctx, failedCreateClaimSessionsObs,
-		rs.deleteExpiredSessionTreesFn(sharedtypes.GetClaimWindowCloseHeight),
+		rs.deleteExpiredSessionTreesFn(sharedtypes.GetClaimWindowCloseHeight, relayer.SessionTreeStateClaimFailed),
 	)
 
 	// Map eitherClaimedSessions to a new observable of []relayer.SessionTree
@@ -244,6 +245,8 @@ func (rs *relayerSessionsManager) newMapClaimSessionsFn(
 			return either.Error[[]relayer.SessionTree](err), false
 		}
 
+		rs.setSessionTreesState(claimableSessionTrees, relayer.SessionTreeStateClaimed)
+
 		return either.Success(claimableSessionTrees), false
 	}
 }
@@ -14,4 +14,8 @@ var (
 	ErrSessionUpdatingTree                 = sdkerrors.Register(codespace, 8, "error updating session SMST")
 	ErrSessionRelayMetaHasNoServiceID      = sdkerrors.Register(codespace, 9, "service ID not specified in relay metadata")
 	ErrSessionRelayMetaHasInvalidServiceID = sdkerrors.Register(codespace, 10, "service specified in relay metadata not found")
+	ErrSessionManagerStopped               = sdkerrors.Register(codespace, 11, "relayer sessions manager stopped")
+	ErrSessionRelaysNotDrained             = sdkerrors.Register(codespace, 12, "relays pipeline not drained before stopping")
+	ErrSessionTreeNotFound                 = sdkerrors.Register(codespace, 13, "session tree not found")
+	ErrSessionTreeNotRetryable             = sdkerrors.Register(codespace, 14, "session tree claim or proof not failed")
 )
@@ -0,0 +1,219 @@
+package session
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/pokt-network/smt"
+
+	"github.com/pokt-network/poktroll/pkg/client"
+	"github.com/pokt-network/poktroll/pkg/observable/channel"
+	"github.com/pokt-network/poktroll/pkg/relayer"
+)
+
+// sessionTreesRetryPublishChs are the channels of a supplier to send the session
+// trees whose claim or proof is retried on.
+type sessionTreesRetryPublishChs struct {
+	// claimsPublishCh emits the session trees whose claim is retried.
+	claimsPublishCh chan<- []relayer.SessionTree
+	// proofsPublishCh emits the session trees whose proof is retried.
+	proofsPublishCh chan<- []relayer.SessionTree
+}
+
+// startRetryPipelines starts the claim and proof pipelines of the given supplier
+// which the session trees are sent to when retried, and returns the channels to
+// send them on.
+// Retried claims go through the whole claim/proof lifecycle, while retried proofs
+// skip the claim creation step.
+// It DOES NOT BLOCK as map operations run in their own goroutines.
+func (rs *relayerSessionsManager) startRetryPipelines(
+	ctx context.Context,
+	supplierClient client.SupplierClient,
+) *sessionTreesRetryPublishChs {
+	retryClaimsObs, retryClaimsPublishCh := channel.NewObservable[[]relayer.SessionTree]()
+	claimedSessionsObs := rs.createClaims(ctx, supplierClient, retryClaimsObs)
+	rs.submitProofs(ctx, supplierClient, claimedSessionsObs)
+
+	retryProofsObs, retryProofsPublishCh := channel.NewObservable[[]relayer.SessionTree]()
+	rs.submitProofs(ctx, supplierClient, retryProofsObs)
+
+	return &sessionTreesRetryPublishChs{
+		claimsPublishCh: retryClaimsPublishCh,
+		proofsPublishCh: retryProofsPublishCh,
+	}
+}
+
+// GetSessionTrees returns a snapshot of the session trees currently managed,
+// across all suppliers, sorted by supplier operator address, session end height
+// and session id.
+func (rs *relayerSessionsManager) GetSessionTrees() []relayer.SessionTreeInfo {
+	rs.sessionsTreesMu.Lock()
+	defer rs.sessionsTreesMu.Unlock()
+
+	sessionTreesInfo := make([]relayer.SessionTreeInfo, 0)
+	for _, supplierSessionTrees := range rs.sessionsTrees {
+		for _, sessionTreesAtHeight := range supplierSessionTrees {
+			for _, sessionTree := range sessionTreesAtHeight {
+				sessionTreesInfo = append(sessionTreesInfo, rs.getSessionTreeInfo(sessionTree))
+			}
+		}
+	}
+
+	slices.SortFunc(sessionTreesInfo, func(a, b relayer.SessionTreeInfo) int {
+		return cmp.Or(
+			strings.Compare(a.SupplierOperatorAddress, b.SupplierOperatorAddress),
+			cmp.Compare(a.SessionHeader.GetSessionEndBlockHeight(), b.SessionHeader.GetSessionEndBlockHeight()),
+			strings.Compare(a.SessionHeader.GetSessionId(), b.SessionHeader.GetSessionId()),
+		)
+	})
+
+	return sessionTreesInfo
+}
+
+// RetrySessionTree sends the session tree of the given supplier and session back
+// to the supplier's retry claim or proof pipeline, depending on which of its
+// claim or proof failed.
+// If the context is done before the pipeline picks the session tree up, its
+// failed state is restored and the context error is returned.
+func (rs *relayerSessionsManager) RetrySessionTree(
+	ctx context.Context,
+	supplierOperatorAddress, sessionId string,
+) error {
+	rs.sessionsTreesMu.Lock()
+
+	if rs.isStopped {
+		rs.sessionsTreesMu.Unlock()
+		return ErrSessionManagerStopped
+	}
+
+	var sessionTree relayer.SessionTree
+	for _, sessionTreesAtHeight := range rs.sessionsTrees[supplierOperatorAddress] {
+		if tree, ok := sessionTreesAtHeight[sessionId]; ok {
+			sessionTree = tree
+			break
+		}
+	}
+	if sessionTree == nil {
+		rs.sessionsTreesMu.Unlock()
+		return ErrSessionTreeNotFound.Wrapf(
+			"supplier operator address %q, session id %q",
+			supplierOperatorAddress,
+			sessionId,
+		)
+	}
+
+	retryPublishChs, ok := rs.retryPublishChs[supplierOperatorAddress]
+	if !ok {
+		rs.sessionsTreesMu.Unlock()
+		return ErrSessionSupplierClientNotFound.Wrapf("supplier operator address %q", supplierOperatorAddress)
+	}
+
+	// Reset the state of the session tree to the one preceding the failed
+	// operation so it is reported as in progress while being retried.
+	sessionStoreKey := string(getSessionStoreKey(supplierOperatorAddress, sessionId))
+	var retryPublishCh chan<- []relayer.SessionTree
+	failedState := rs.getSessionTreeState(sessionTree)
+	switch failedState {
+	case relayer.SessionTreeStateClaimFailed:
+		delete(rs.sessionTreesStates, sessionStoreKey)
+		retryPublishCh = retryPublishChs.claimsPublishCh
+	case relayer.SessionTreeStateProofFailed:
+		rs.sessionTreesStates[sessionStoreKey] = relayer.SessionTreeStateClaimed
+		retryPublishCh = retryPublishChs.proofsPublishCh
+	default:
+		rs.sessionsTreesMu.Unlock()
+		return ErrSessionTreeNotRetryable.Wrapf("session id %q is %s", sessionId, failedState)
+	}
+
+	rs.sessionsTreesMu.Unlock()
+
+	rs.logger.Info().
+		Str("supplier_operator_address", supplierOperatorAddress).
+		Str("session_id", sessionId).
+		Msg("retrying failed session tree claim/proof")
+
+	select {
+	case retryPublishCh <- []relayer.SessionTree{sessionTree}:
+		return nil
+	case <-ctx.Done():
+		rs.setSessionTreesState([]relayer.SessionTree{sessionTree}, failedState)
+		return ctx.Err()
+	}
+}
+
+// setSessionTreesState sets the lifecycle state of the given session trees.
+func (rs *relayerSessionsManager) setSessionTreesState(
+	sessionTrees []relayer.SessionTree,
+	state relayer.SessionTreeState,
+) {
+	rs.sessionsTreesMu.Lock()
+	defer rs.sessionsTreesMu.Unlock()
+
+	for _, sessionTree := range sessionTrees {
+		sessionStoreKey := getSessionStoreKey(
+			sessionTree.GetSupplierOperatorAddress(),
+			sessionTree.GetSessionHeader().GetSessionId(),
+		)
+		rs.sessionTreesStates[string(sessionStoreKey)] = state
+	}
+}
+
+// getSessionTreeState returns the lifecycle state of the given session tree.
+// It MUST be called with sessionsTreesMu locked.
+func (rs *relayerSessionsManager) getSessionTreeState(sessionTree relayer.SessionTree) relayer.SessionTreeState {
+	sessionStoreKey := getSessionStoreKey(
+		sessionTree.GetSupplierOperatorAddress(),
+		sessionTree.GetSessionHeader().GetSessionId(),
+	)
+	if state, ok := rs.sessionTreesStates[string(sessionStoreKey)]; ok {
+		return state
+	}
+
+	if sessionTree.IsClaiming() {
+		return relayer.SessionTreeStateClaiming
+	}
+
+	return relayer.SessionTreeStateActive
+}
+
+// getSessionTreeInfo returns a snapshot of the given session tree.
+// It MUST be called with sessionsTreesMu locked.
+func (rs *relayerSessionsManager) getSessionTreeInfo(sessionTree relayer.SessionTree) relayer.SessionTreeInfo {
+	sessionTreeInfo := relayer.SessionTreeInfo{
+		SessionHeader:           sessionTree.GetSessionHeader(),
+		SupplierOperatorAddress: sessionTree.GetSupplierOperatorAddress(),
+		ClaimRoot:               sessionTree.GetClaimRoot(),
+		State:                   rs.getSessionTreeState(sessionTree),
+	}
+
+	// The SMST is no longer available once flushed to create the claim, whose
+	// root holds the same count and sum.
+	root := smt.MerkleSumRoot(sessionTreeInfo.ClaimRoot)
+	if root == nil {
+		root = sessionTree.GetSMSTRoot()
+	}
+	if root == nil {
+		return sessionTreeInfo
+	}
+
+	logger := rs.logger.With(
+		"session_id", sessionTree.GetSessionHeader().GetSessionId(),
+		"supplier_operator_address", sessionTree.GetSupplierOperatorAddress(),
+	)
+
+	count, err := root.Count()
+	if err != nil {
+		logger.Error().Err(err).Msg("failed to get the session tree relay count")
+	}
+	sessionTreeInfo.RelayCount = count
+
+	sum, err := root.Sum()
+	if err != nil {
+		logger.Error().Err(err).Msg("failed to get the session tree sum")
+	}
+	sessionTreeInfo.SMSTSum = sum
+
+	return sessionTreeInfo
+}
@@ -165,6 +165,12 @@ func (rs *relayerSessionsManager) loadSessionTreeMap(ctx context.Context, height
 		// This ensures that Claim Creation & Proof Submissions are processed in the correct order.
 		if ok := rs.insertSessionTree(sessionTree); !ok {
 			sessionLogger.Error().Msg("the session tree already exists, skipping")
+			continue
+		}
+
+		if claim != nil {
+			rs.sessionTreesStates[string(getSessionStoreKey(supplierOperatorAddress, sessionId))] =
+				relayer.SessionTreeStateClaimed
 		}
 	}
 
@@ -43,14 +43,15 @@ func (rs *relayerSessionsManager) submitProofs(
 	eitherProvenSessionsObs := channel.Map(
 		ctx, sessionsWithOpenProofWindowObs,
 		rs.newMapProveSessionsFn(supplierClient, failedSubmitProofsSessionsPublishCh),
+		channel.WithStageName[either.SessionTrees]("session_submit_proofs"),
 	)
 
-	logging.LogErrors(ctx, filter.EitherError(ctx, eitherProvenSessionsObs))
+	logging.LogErrors(ctx, filter.EitherError(ctx, eitherProvenSessionsObs), "session_submit_proofs_errors")
 
 	// Delete expired session trees so they don't get proven again.
 	channel.ForEach(
 		ctx, failedSubmitProofsSessionsObs,
-		rs.deleteExpiredSessionTreesFn(sharedtypes.GetProofWindowCloseHeight),
+		rs.deleteExpiredSessionTreesFn(sharedtypes.GetProofWindowCloseHeight, relayer.SessionTreeStateProofFailed),
 	)
 }
 
@@ -305,15 +306,20 @@ func (rs *relayerSessionsManager) isProofRequired(
 		return false, err
 	}
 
-	// Retrieving the relay mining difficulty for the service at hand
+	// Retrieving the service and its relay mining difficulty for the service at hand
 	serviceId := claim.GetSessionHeader().GetServiceId()
+	service, err := rs.serviceQueryClient.GetService(ctx, serviceId)
+	if err != nil {
+		return false, err
+	}
+
 	relayMiningDifficulty, err := rs.serviceQueryClient.GetServiceRelayDifficulty(ctx, serviceId)
 	if err != nil {
 		return false, err
 	}
 
 	// The amount of uPOKT being claimed.
-	claimedAmount, err := claim.GetClaimeduPOKT(*sharedParams, relayMiningDifficulty)
+	claimedAmount, err := claim.GetClaimeduPOKT(*sharedParams, service, relayMiningDifficulty)
 	if err != nil {
 		return false, err
 	}
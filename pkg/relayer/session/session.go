@@ -44,6 +44,30 @@ type relayerSessionsManager struct {
 	sessionsTrees   SessionsTreesMap
 	sessionsTreesMu *sync.Mutex
 
+	// isStopped is set once the session trees are persisted on Stop, after which
+	// no new session tree is created.
+	// It is guarded by sessionsTreesMu.
+	isStopped bool
+
+	// stopMu serializes the calls to Stop, such that the session trees are
+	// persisted and released only once.
+	stopMu sync.Mutex
+
+	// sessionTreesStates is a map of session store key -> lifecycle state of the
+	// session trees whose state cannot be derived from the session tree itself
+	// (i.e. claimed or failed).
+	// It is guarded by sessionsTreesMu.
+	sessionTreesStates map[string]relayer.SessionTreeState
+
+	// retryPublishChs is a map of supplier operator address -> channels to send
+	// the session trees whose claim or proof is retried on.
+	retryPublishChs map[string]*sessionTreesRetryPublishChs
+
+	// relaysDrainedCh is closed once every mined relay received from relayObs
+	// has been processed by the pipeline adding them to their session tree.
+	// It is nil until Start is called.
+	relaysDrainedCh chan struct{}
+
 	// blockClient is used to get the notifications of committed blocks.
 	blockClient client.BlockClient
 
@@ -98,8 +122,10 @@ func NewRelayerSessions(
 	opts ...relayer.RelayerSessionsManagerOption,
 ) (_ relayer.RelayerSessionsManager, err error) {
 	rs := &relayerSessionsManager{
-		sessionsTrees:   make(SessionsTreesMap),
-		sessionsTreesMu: &sync.Mutex{},
+		sessionsTrees:      make(SessionsTreesMap),
+		sessionsTreesMu:    &sync.Mutex{},
+		sessionTreesStates: make(map[string]relayer.SessionTreeState),
+		retryPublishChs:    make(map[string]*sessionTreesRetryPublishChs),
 	}
 
 	if err = depinject.Inject(
@@ -161,44 +187,96 @@ func (rs *relayerSessionsManager) Start(ctx context.Context) error {
 	// support for generic types.
 	relayObs := observable.Observable[*relayer.MinedRelay](rs.relayObs)
 
+	// The mined relays pipeline is not canceled along with the context but
+	// completes along with relayObs, once the upstream stages are stopped. This
+	// way, the relays that were already mined can still be added to their session
+	// tree while stopping.
+	relaysCtx := context.WithoutCancel(ctx)
+
 	// Map eitherMinedRelays to a new observable of an error type which is
 	// notified if an error occurs when attempting to add the relay to the session tree.
-	miningErrorsObs := channel.Map(ctx, relayObs, rs.mapAddMinedRelayToSessionTree)
-	logging.LogErrors(ctx, miningErrorsObs)
+	miningErrorsObs := channel.Map(
+		relaysCtx, relayObs,
+		rs.mapAddMinedRelayToSessionTree,
+		channel.WithStageName[error]("session_add_mined_relays"),
+	)
+	logging.LogErrors(relaysCtx, miningErrorsObs, "session_add_mined_relays_errors")
+
+	// miningErrorsObs is closed once the pipeline has processed every relay
+	// received before relayObs completed or was unsubscribed from.
+	rs.relaysDrainedCh = make(chan struct{})
+	go rs.goNotifyRelaysDrained(relaysCtx, miningErrorsObs)
 
 	// Start claim/proof pipeline for each supplier that is present in the RelayMiner.
 	for supplierOperatorAddress, supplierClient := range rs.supplierClients.SupplierClients {
 		supplierSessionsToClaimObs := rs.supplierSessionsToClaim(ctx, supplierOperatorAddress)
 		claimedSessionsObs := rs.createClaims(ctx, supplierClient, supplierSessionsToClaimObs)
 		rs.submitProofs(ctx, supplierClient, claimedSessionsObs)
-	}
 
-	// Stop the relayer sessions manager when the context is done.
-	// This is necessary to ensure that during shutdown:
-	//   - All session trees are persisted
-	//   - Their root hashes are preserved
-	go func() {
-		<-ctx.Done()
-		rs.Stop()
-	}()
+		// Start the pipelines which the session trees whose claim or proof failed
+		// are sent to when retried.
+		rs.retryPublishChs[supplierOperatorAddress] = rs.startRetryPipelines(ctx, supplierClient)
+	}
 
 	return nil
 }
 
 // Stop performs a complete shutdown of the relayerSessionsManager by:
+//   - Waiting, until the context is done, for the mined relays observable to
+//     complete and the relays it notified to be added to their session tree
+//   - Unsubscribing from the mined relays observable to stop accepting new relays
 //   - Closing connections and canceling subscriptions
 //   - Persisting all session data to storage
+//   - Reporting the claim/proof operations that were interrupted
 //   - Releasing resources and clearing memory
 //
 // This ensures no data is lost during shutdown and resources are properly cleaned up.
-func (rs *relayerSessionsManager) Stop() {
-	// Close the block client and unsubscribe from all observables to stop receiving events.
+// Calling Stop more than once is a no-op.
+func (rs *relayerSessionsManager) Stop(ctx context.Context) error {
+	rs.stopMu.Lock()
+	defer rs.stopMu.Unlock()
+
+	rs.sessionsTreesMu.Lock()
+	isStopped := rs.isStopped
+	rs.sessionsTreesMu.Unlock()
+	if isStopped {
+		return nil
+	}
+
+	// Wait for the upstream stages (i.e. the miner) to be drained, which
+	// completes relayObs, and for the relays they mined to be added to their
+	// session tree.
+	var drainErr error
+	if rs.relaysDrainedCh != nil {
+		select {
+		case <-rs.relaysDrainedCh:
+			rs.logger.Info().Msg("mined relays pipeline drained")
+		case <-ctx.Done():
+			drainErr = ErrSessionRelaysNotDrained.Wrapf(
+				"the remaining mined relays are dropped: %v",
+				ctx.Err(),
+			)
+			rs.logger.Error().Err(drainErr).Msg("failed to drain the mined relays pipeline")
+		}
+	}
+
+	// Stop accepting new relays, which is a no-op if relayObs completed.
+	rs.relayObs.UnsubscribeAll()
+
+	// Prevent new session trees from being created by relays which could not be
+	// drained in time, since they would not be persisted, and the claims/proofs
+	// interrupted below from being handled as failed.
+	rs.sessionsTreesMu.Lock()
+	defer rs.sessionsTreesMu.Unlock()
+	rs.isStopped = true
+
+	// Close the block client to stop receiving events, which stops progressing the
+	// session trees through the claim/proof lifecycle.
 	// Proper shutdown is important for:
 	//   - Graceful termination
 	//   - Testing scenarios
 	// While process termination would eventually clean these up, explicit cleanup is preferred.
 	rs.blockClient.Close()
-	rs.relayObs.UnsubscribeAll()
 
 	// Persist each active session's state to disk and properly close the associated
 	// key-value stores. This ensures that all accumulated relay data (including root
@@ -208,6 +286,12 @@ func (rs *relayerSessionsManager) Stop() {
 		for _, sessionTreesAtHeight := range supplierSessionTrees {
 			for _, sessionTree := range sessionTreesAtHeight {
 				sessionId := sessionTree.GetSessionHeader().GetSessionId()
+
+				// Report the sessions whose claim or proof was in progress. Their
+				// lifecycle is resumed on the next start if their claim or proof
+				// window is still open.
+				rs.reportInterruptedSessionTree(sessionTree)
+
 				// Store the session tee to disk
 				if err := rs.persistSessionMetadata(sessionTree); err != nil {
 					rs.logger.Error().Err(err).Msgf(
@@ -236,7 +320,49 @@ func (rs *relayerSessionsManager) Stop() {
 	}
 
 	clear(rs.sessionsTrees)
+	clear(rs.sessionTreesStates)
 	rs.logger.Info().Msgf("Successfully cleared %d session trees from memory", numSessionTrees)
+
+	return drainErr
+}
+
+// goNotifyRelaysDrained closes relaysDrainedCh once the given mining errors
+// observable is closed, which happens after the mined relays pipeline has
+// processed every relay it received.
+// It is intended to be called in a goroutine.
+func (rs *relayerSessionsManager) goNotifyRelaysDrained(
+	ctx context.Context,
+	miningErrorsObs observable.Observable[error],
+) {
+	// Errors are logged by logging.LogErrors, only the closing of the
+	// observable is relevant here.
+	for range miningErrorsObs.Subscribe(ctx).Ch() {
+	}
+
+	close(rs.relaysDrainedCh)
+}
+
+// reportInterruptedSessionTree logs the claim or proof operation of the given
+// session tree if one was in progress while stopping.
+func (rs *relayerSessionsManager) reportInterruptedSessionTree(sessionTree relayer.SessionTree) {
+	if !sessionTree.IsClaiming() {
+		return
+	}
+
+	// The claim root is only set once the session tree is flushed to create the
+	// claim, which is then kept until the proof is submitted.
+	interruptedOperation := "create_claim"
+	if sessionTree.GetClaimRoot() != nil {
+		interruptedOperation = "submit_proof"
+	}
+
+	sessionHeader := sessionTree.GetSessionHeader()
+	rs.logger.Warn().
+		Str("interrupted_operation", interruptedOperation).
+		Str("session_id", sessionHeader.GetSessionId()).
+		Str("supplier_operator_address", sessionTree.GetSupplierOperatorAddress()).
+		Int64("session_end_height", sessionHeader.GetSessionEndBlockHeight()).
+		Msg("session claim/proof lifecycle interrupted by stopping the relayer sessions manager")
 }
 
 // SessionsToClaim returns an observable that notifies when sessions are ready to be claimed.
@@ -253,6 +379,10 @@ func (rs *relayerSessionsManager) ensureSessionTree(
 	rs.sessionsTreesMu.Lock()
 	defer rs.sessionsTreesMu.Unlock()
 
+	if rs.isStopped {
+		return nil, ErrSessionManagerStopped
+	}
+
 	// Get the supplier session trees for the supplierOperatorAddress.
 	supplierOperatorAddress := relayRequestMetadata.SupplierOperatorAddress
 	supplierSessionTrees, ok := rs.sessionsTrees[supplierOperatorAddress]
@@ -433,6 +563,7 @@ func (rs *relayerSessionsManager) removeFromRelayerSessions(sessionTree relayer.
 	}
 
 	delete(sessionsTreesEndingAtBlockHeight, sessionHeader.SessionId)
+	delete(rs.sessionTreesStates, string(getSessionStoreKey(supplierOperatorAddress, sessionHeader.SessionId)))
 
 	// Check if the suppliersSessionTrees map is empty and delete it if so.
 	if len(sessionsTreesEndingAtBlockHeight) == 0 {
@@ -545,12 +676,25 @@ func (rs *relayerSessionsManager) mapAddMinedRelayToSessionTree(
 	return nil, true
 }
 
-// deleteExpiredSessionTreesFn returns a function that deletes non-claimed sessions
-// that have expired.
+// deleteExpiredSessionTreesFn returns a function that marks the given failed
+// sessions with failedState, so they can be retried, and deletes the ones that
+// have expired.
 func (rs *relayerSessionsManager) deleteExpiredSessionTreesFn(
 	expirationHeightFn func(*sharedtypes.Params, int64) int64,
+	failedState relayer.SessionTreeState,
 ) func(ctx context.Context, failedSessionTrees []relayer.SessionTree) {
 	return func(ctx context.Context, failedSessionTrees []relayer.SessionTree) {
+		// Claims and proofs interrupted by Stop fail as the block client is closed.
+		// Their session trees are persisted and resumed on the next start instead.
+		rs.sessionsTreesMu.Lock()
+		isStopped := rs.isStopped
+		rs.sessionsTreesMu.Unlock()
+		if isStopped {
+			return
+		}
+
+		rs.setSessionTreesState(failedSessionTrees, failedState)
+
 		currentHeight := rs.blockClient.LastBlock(ctx).Height()
 		sharedParams, err := rs.sharedQueryClient.GetParams(ctx)
 		if err != nil {
@@ -6,6 +6,7 @@ import (
 	"os"
 	"strings"
 	"testing"
+	"time"
 
 	"cosmossdk.io/depinject"
 	coretypes "github.com/cometbft/cometbft/rpc/core/types"
@@ -118,11 +119,6 @@ func (s *SessionPersistenceTestSuite) SetupTest() {
 	// Set up dependencies for the relayer sessions manager
 	s.deps = s.setupSessionManagerDependencies()
 
-	// Create mined relays observable and channel for publishing
-	mrObs, minedRelaysPublishCh := channel.NewObservable[*relayer.MinedRelay]()
-	s.minedRelaysObs = relayer.MinedRelaysObservable(mrObs)
-	s.minedRelaysPublishCh = minedRelaysPublishCh
-
 	// Initialize and start the relayer sessions manager
 	s.relayerSessionsManager = s.setupNewRelayerSessionsManager()
 	s.advanceToBlock(1)
@@ -147,7 +143,7 @@ func (s *SessionPersistenceTestSuite) SetupTest() {
 // TearDownTest cleans up resources after each test execution
 func (s *SessionPersistenceTestSuite) TearDownTest() {
 	// Stop the relayer sessions manager
-	s.relayerSessionsManager.Stop()
+	s.stopRelayerSessionsManager()
 	// Delete all temporary files and directories created by the test on completion.
 	_ = os.RemoveAll(s.tmpStoresDir)
 }
@@ -157,7 +153,7 @@ func (s *SessionPersistenceTestSuite) TearDownTest() {
 // the relayer sessions manager is stopped and restarted.
 func (s *SessionPersistenceTestSuite) TestSaveAndRetrieveSession() {
 	// Stop the current relayer sessions manager
-	s.relayerSessionsManager.Stop()
+	s.stopRelayerSessionsManager()
 	// Create a new relayer sessions manager.
 	// Note: This does not load state from the store.
 	s.relayerSessionsManager = s.setupNewRelayerSessionsManager()
@@ -192,6 +188,60 @@ func (s *SessionPersistenceTestSuite) TestSaveAndRetrieveSession() {
 	require.Equal(s.T(), uint64(2), count)
 }
 
+// TestStopDrainsMinedRelays tests that the mined relays received before stopping
+// the relayer sessions manager are added to their session tree and persisted.
+func (s *SessionPersistenceTestSuite) TestStopDrainsMinedRelays() {
+	// Publish mined relays and stop the relayer sessions manager right away,
+	// without waiting for them to be processed.
+	const numMinedRelays = 10
+	for range numMinedRelays {
+		s.minedRelaysPublishCh <- testrelayer.NewUnsignedMinedRelay(s.T(), s.activeSessionHeader, s.supplierOperatorAddress)
+	}
+	s.stopRelayerSessionsManager()
+
+	// Restart the relayer sessions manager and load state from the store.
+	s.relayerSessionsManager = s.setupNewRelayerSessionsManager()
+	err := s.relayerSessionsManager.Start(s.ctx)
+	require.NoError(s.T(), err)
+	waitSimulateIO()
+
+	// Verify the session tree contains the relay added during the setup and
+	// all the ones published before stopping.
+	sessionTree := s.getActiveSessionTree()
+	smstRoot := sessionTree.GetSMSTRoot()
+	count, err := smstRoot.Count()
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), uint64(numMinedRelays+1), count)
+}
+
+// TestStopDropsUndrainedMinedRelays tests that stopping the relayer sessions
+// manager before the mined relays observable completes gives up draining it
+// once the context is done, and still persists the session trees.
+func (s *SessionPersistenceTestSuite) TestStopDropsUndrainedMinedRelays() {
+	stopCtx, cancelStop := context.WithTimeout(s.ctx, 50*time.Millisecond)
+	defer cancelStop()
+
+	err := s.relayerSessionsManager.Stop(stopCtx)
+	require.ErrorIs(s.T(), err, session.ErrSessionRelaysNotDrained)
+
+	// Relays mined after stopping are not added to any session tree.
+	s.minedRelaysPublishCh <- testrelayer.NewUnsignedMinedRelay(s.T(), s.activeSessionHeader, s.supplierOperatorAddress)
+	waitSimulateIO()
+
+	// Restart the relayer sessions manager and load state from the store.
+	s.relayerSessionsManager = s.setupNewRelayerSessionsManager()
+	err = s.relayerSessionsManager.Start(s.ctx)
+	require.NoError(s.T(), err)
+	waitSimulateIO()
+
+	// Verify the session tree only contains the relay added during the setup.
+	sessionTree := s.getActiveSessionTree()
+	smstRoot := sessionTree.GetSMSTRoot()
+	count, err := smstRoot.Count()
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), uint64(1), count)
+}
+
 // TestRestartAfterClaimWindowOpen tests session persistence when the relayer is restarted
 // after the claim window opens but before a claim is created.
 // This verifies that the relayer automatically creates a claim when restarted
@@ -211,7 +261,7 @@ func (s *SessionPersistenceTestSuite) TestRestartAfterClaimWindowOpen() {
 	require.Equal(s.T(), 0, s.createClaimCallCount)
 
 	// Stop and recreate the relayer sessions manager
-	s.relayerSessionsManager.Stop()
+	s.stopRelayerSessionsManager()
 	s.relayerSessionsManager = s.setupNewRelayerSessionsManager()
 
 	// Advance to the block where the claim window opens while the relayer sessions manager is stopped
@@ -265,7 +315,7 @@ func (s *SessionPersistenceTestSuite) TestRestartAfterClaimSubmitted() {
 	require.Equal(s.T(), uint64(1), count)
 
 	// Stop and recreate the relayer sessions manager
-	s.relayerSessionsManager.Stop()
+	s.stopRelayerSessionsManager()
 	s.relayerSessionsManager = s.setupNewRelayerSessionsManager()
 
 	// Advance to the next block after claim window open while the relayer sessions manager is stopped
@@ -310,7 +360,7 @@ func (s *SessionPersistenceTestSuite) TestRestartAfterClaimWindowClose() {
 	require.Equal(s.T(), 0, s.createClaimCallCount)
 
 	// Stop and recreate the relayer sessions manager
-	s.relayerSessionsManager.Stop()
+	s.stopRelayerSessionsManager()
 	s.relayerSessionsManager = s.setupNewRelayerSessionsManager()
 
 	// Calculate when the claim window closes for this session
@@ -348,7 +398,7 @@ func (s *SessionPersistenceTestSuite) TestRestartAfterProofWindowClosed() {
 	require.Equal(s.T(), 1, s.createClaimCallCount)
 
 	// Stop and recreate the relayer sessions manager
-	s.relayerSessionsManager.Stop()
+	s.stopRelayerSessionsManager()
 	s.relayerSessionsManager = s.setupNewRelayerSessionsManager()
 
 	// Calculate when the proof window closes for this session
@@ -390,6 +440,16 @@ func (s *SessionPersistenceTestSuite) getActiveSessionTree() relayer.SessionTree
 	return sessionTree
 }
 
+// stopRelayerSessionsManager completes the mined relays observable, as the
+// relayer proxy and miner do when stopping, then stops the relayer sessions
+// manager, which drains and persists the mined relays.
+func (s *SessionPersistenceTestSuite) stopRelayerSessionsManager() {
+	close(s.minedRelaysPublishCh)
+
+	err := s.relayerSessionsManager.Stop(s.ctx)
+	require.NoError(s.T(), err)
+}
+
 // setupNewRelayerSessionsManager creates and configures a new relayer sessions manager for testing.
 // This is used both in the initial setup and when simulating restarts.
 func (s *SessionPersistenceTestSuite) setupNewRelayerSessionsManager() relayer.RelayerSessionsManager {
@@ -415,7 +475,11 @@ func (s *SessionPersistenceTestSuite) setupNewRelayerSessionsManager() relayer.R
 	require.NoError(s.T(), err)
 	require.NotNil(s.T(), relayerSessionsManager)
 
-	// Insert the mined relays observable into the sessions manager
+	// Create a new mined relays observable, which is completed when the relayer
+	// sessions manager is stopped, and insert it into the sessions manager.
+	mrObs, minedRelaysPublishCh := channel.NewObservable[*relayer.MinedRelay]()
+	s.minedRelaysObs = relayer.MinedRelaysObservable(mrObs)
+	s.minedRelaysPublishCh = minedRelaysPublishCh
 	relayerSessionsManager.InsertRelays(s.minedRelaysObs)
 
 	return relayerSessionsManager
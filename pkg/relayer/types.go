@@ -2,6 +2,28 @@ package relayer
 
 import (
 	"github.com/pokt-network/poktroll/x/service/types"
+	sessiontypes "github.com/pokt-network/poktroll/x/session/types"
+)
+
+// SessionTreeState is the claim/proof lifecycle state of a session tree.
+type SessionTreeState string
+
+const (
+	// SessionTreeStateActive is the state of a session tree which is accumulating
+	// relays and has not been picked up for claiming yet.
+	SessionTreeStateActive SessionTreeState = "active"
+	// SessionTreeStateClaiming is the state of a session tree which has been picked
+	// up for claiming and is waiting for its claim to be created.
+	SessionTreeStateClaiming SessionTreeState = "claiming"
+	// SessionTreeStateClaimed is the state of a session tree whose claim has been
+	// created and which is waiting for its proof to be submitted, if required.
+	SessionTreeStateClaimed SessionTreeState = "claimed"
+	// SessionTreeStateClaimFailed is the state of a session tree whose claim
+	// creation failed while its claim window is still open.
+	SessionTreeStateClaimFailed SessionTreeState = "claim_failed"
+	// SessionTreeStateProofFailed is the state of a session tree whose proof
+	// submission failed while its proof window is still open.
+	SessionTreeStateProofFailed SessionTreeState = "proof_failed"
 )
 
 // MinedRelay is a wrapper around a relay that has been serialized and hashed.
@@ -10,3 +32,18 @@ type MinedRelay struct {
 	Bytes []byte
 	Hash  []byte
 }
+
+// SessionTreeInfo is a snapshot of a session tree managed by the RelayerSessionsManager.
+type SessionTreeInfo struct {
+	SessionHeader           *sessiontypes.SessionHeader
+	SupplierOperatorAddress string
+	// RelayCount is the number of relays in the session tree.
+	RelayCount uint64
+	// SMSTSum is the sum of the weights (i.e. compute units) of the relays in
+	// the session tree.
+	SMSTSum uint64
+	// ClaimRoot is the root hash of the session tree used to create the claim.
+	// It is nil until the session tree is flushed to create the claim.
+	ClaimRoot []byte
+	State     SessionTreeState
+}
@@ -0,0 +1,10 @@
+package signer
+
+import sdkerrors "cosmossdk.io/errors"
+
+var (
+	codespace                  = "signer"
+	ErrSignerRemoteSign        = sdkerrors.Register(codespace, 1, "remote signer failed to sign")
+	ErrSignerUnknownKeyName    = sdkerrors.Register(codespace, 2, "no remote signer serves the key name")
+	ErrSignerInvalidRemoteConf = sdkerrors.Register(codespace, 3, "invalid remote signer configuration")
+)
@@ -0,0 +1,24 @@
+package signer
+
+var _ Signer = (*RemoteSigner)(nil)
+
+// RemoteSigner is a signer implementation that delegates the signing of messages
+// to the remote signing service holding the key, so that the key never has to
+// be present on the host using it. Its signatures are verified the same way as
+// the SimpleSigner ones, using the signer's corresponding public key.
+type RemoteSigner struct {
+	client  *RemoteSignerClient
+	keyName string
+}
+
+// NewRemoteSigner creates a new RemoteSigner instance with the remote signer
+// client and keyName provided
+func NewRemoteSigner(client *RemoteSignerClient, keyName string) *RemoteSigner {
+	return &RemoteSigner{client: client, keyName: keyName}
+}
+
+// Sign signs the given message using the remote signing service serving the
+// RemoteSigner's keyName
+func (s *RemoteSigner) Sign(msg [32]byte) (signature []byte, err error) {
+	return s.client.Sign(s.keyName, msg[:])
+}
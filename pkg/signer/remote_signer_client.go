@@ -0,0 +1,134 @@
+package signer
+
+import (
+	"bytes"
+	"crypto/tls"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// remoteSignerRequestTimeout is the maximum duration of a request to a remote
+// signing service. Relay responses are signed while the application waits for
+// them, so a remote signing service that does not reply fast enough fails the relay.
+const remoteSignerRequestTimeout = 5 * time.Second
+
+// RemoteSignerEndpoint is a remote signing service along with the names of the
+// keys it signs with.
+type RemoteSignerEndpoint struct {
+	// Url is the base URL of the remote signing service (e.g. https://signer:8085).
+	Url *url.URL
+	// KeyNames are the names of the keys the remote signing service signs with.
+	KeyNames []string
+}
+
+// remoteSignRequest is the JSON body of a remote signing request.
+type remoteSignRequest struct {
+	SignBytes []byte `json:"sign_bytes"`
+}
+
+// remoteSignResponse is the JSON reply to a successful remote signing request.
+type remoteSignResponse struct {
+	Signature []byte `json:"signature"`
+}
+
+// remoteSignErrorResponse is the JSON reply to a failed remote signing request.
+type remoteSignErrorResponse struct {
+	Error string `json:"error"`
+}
+
+// RemoteSignerClient requests signatures from the remote signing services over
+// mutually authenticated TLS, routing each request to the service which holds
+// the requested key.
+type RemoteSignerClient struct {
+	httpClient *http.Client
+	// keyNameToEndpointUrl routes each key name to the remote signing service
+	// which signs with it.
+	keyNameToEndpointUrl map[string]*url.URL
+}
+
+// NewRemoteSignerClient creates a new RemoteSignerClient which requests the
+// signatures from the given endpoints using the given TLS config, which SHOULD
+// present a client certificate to the remote signing services.
+// A key name MUST be served by a single endpoint.
+func NewRemoteSignerClient(
+	endpoints []RemoteSignerEndpoint,
+	tlsConfig *tls.Config,
+) (*RemoteSignerClient, error) {
+	if tlsConfig == nil {
+		return nil, ErrSignerInvalidRemoteConf.Wrap("tls config is required")
+	}
+
+	keyNameToEndpointUrl := make(map[string]*url.URL)
+	for _, endpoint := range endpoints {
+		if endpoint.Url == nil || endpoint.Url.Scheme != "https" {
+			return nil, ErrSignerInvalidRemoteConf.Wrapf("endpoint url %q must use the https scheme", endpoint.Url)
+		}
+
+		for _, keyName := range endpoint.KeyNames {
+			if _, ok := keyNameToEndpointUrl[keyName]; ok {
+				return nil, ErrSignerInvalidRemoteConf.Wrapf("key name %q is served by multiple endpoints", keyName)
+			}
+			keyNameToEndpointUrl[keyName] = endpoint.Url
+		}
+	}
+
+	return &RemoteSignerClient{
+		httpClient: &http.Client{
+			Timeout:   remoteSignerRequestTimeout,
+			Transport: &http.Transport{TLSClientConfig: tlsConfig},
+		},
+		keyNameToEndpointUrl: keyNameToEndpointUrl,
+	}, nil
+}
+
+// HasKeyName returns true if a remote signing service signs with the given key name.
+func (c *RemoteSignerClient) HasKeyName(keyName string) bool {
+	_, ok := c.keyNameToEndpointUrl[keyName]
+	return ok
+}
+
+// Sign requests the remote signing service holding the given key to sign the
+// given bytes, the same way the cosmos-sdk keyring would if it held the key.
+func (c *RemoteSignerClient) Sign(keyName string, signBytes []byte) ([]byte, error) {
+	endpointUrl, ok := c.keyNameToEndpointUrl[keyName]
+	if !ok {
+		return nil, ErrSignerUnknownKeyName.Wrapf("key name %q", keyName)
+	}
+
+	reqBody, err := json.Marshal(&remoteSignRequest{SignBytes: signBytes})
+	if err != nil {
+		return nil, err
+	}
+
+	signUrl := endpointUrl.JoinPath("v1", "keys", keyName, "sign")
+	httpResponse, err := c.httpClient.Post(signUrl.String(), "application/json", bytes.NewReader(reqBody))
+	if err != nil {
+		return nil, ErrSignerRemoteSign.Wrapf("key name %q: %v", keyName, err)
+	}
+	defer httpResponse.Body.Close()
+
+	if httpResponse.StatusCode != http.StatusOK {
+		errResponse := &remoteSignErrorResponse{}
+		// The error message is best effort, the status code is enough to fail.
+		_ = json.NewDecoder(httpResponse.Body).Decode(errResponse)
+		return nil, ErrSignerRemoteSign.Wrapf(
+			"key name %q: status %d: %s",
+			keyName,
+			httpResponse.StatusCode,
+			errResponse.Error,
+		)
+	}
+
+	signResponse := &remoteSignResponse{}
+	if err := json.NewDecoder(httpResponse.Body).Decode(signResponse); err != nil {
+		return nil, ErrSignerRemoteSign.Wrapf("key name %q: unable to decode response: %v", keyName, err)
+	}
+
+	if len(signResponse.Signature) == 0 {
+		return nil, ErrSignerRemoteSign.Wrapf("key name %q: empty signature", keyName)
+	}
+
+	return signResponse.Signature, nil
+}
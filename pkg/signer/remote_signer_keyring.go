@@ -0,0 +1,74 @@
+package signer
+
+import (
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	cosmostypes "github.com/cosmos/cosmos-sdk/types"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+var _ keyring.Keyring = (*remoteSigningKeyring)(nil)
+
+// remoteSigningKeyring is a keyring which delegates the signing with the keys
+// served by the remote signing services to them.
+// The keys MUST still be in the wrapped keyring, usually as offline keys only
+// holding the public key, so that their address and public key can be retrieved.
+type remoteSigningKeyring struct {
+	keyring.Keyring
+
+	client *RemoteSignerClient
+}
+
+// NewRemoteSigningKeyring returns a keyring which signs with the keys served by
+// the given remote signer client remotely, and delegates everything else,
+// including the signing with the other keys, to the given keyring.
+func NewRemoteSigningKeyring(kr keyring.Keyring, client *RemoteSignerClient) keyring.Keyring {
+	return &remoteSigningKeyring{
+		Keyring: kr,
+		client:  client,
+	}
+}
+
+// Sign signs msg with the key named uid, remotely if the key is served by a
+// remote signing service.
+func (kr *remoteSigningKeyring) Sign(
+	uid string,
+	msg []byte,
+	signMode signingtypes.SignMode,
+) ([]byte, cryptotypes.PubKey, error) {
+	if !kr.client.HasKeyName(uid) {
+		return kr.Keyring.Sign(uid, msg, signMode)
+	}
+
+	record, err := kr.Keyring.Key(uid)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pubKey, err := record.GetPubKey()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	signature, err := kr.client.Sign(uid, msg)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return signature, pubKey, nil
+}
+
+// SignByAddress signs msg with the key of the given address, remotely if the
+// key is served by a remote signing service.
+func (kr *remoteSigningKeyring) SignByAddress(
+	address cosmostypes.Address,
+	msg []byte,
+	signMode signingtypes.SignMode,
+) ([]byte, cryptotypes.PubKey, error) {
+	record, err := kr.Keyring.KeyByAddress(address)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return kr.Sign(record.Name, msg, signMode)
+}
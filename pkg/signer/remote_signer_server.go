@@ -0,0 +1,61 @@
+package signer
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// NewRemoteSignerHandler returns the handler of a reference remote signing
+// service, which signs with the given keys of the given keyring the bytes
+// requested by the RemoteSignerClient.
+// It is intended to be used as a local stand-in of a production signing service
+// (e.g. backed by an HSM or a KMS), which MUST implement the same API:
+//   - POST /v1/keys/{key_name}/sign {"sign_bytes": "<base64>"} -> {"signature": "<base64>"}
+//
+// Requests for the keys which are not in keyNames are rejected.
+func NewRemoteSignerHandler(kr keyring.Keyring, keyNames []string) (http.Handler, error) {
+	servedKeyNames := make(map[string]struct{}, len(keyNames))
+	for _, keyName := range keyNames {
+		if _, err := kr.Key(keyName); err != nil {
+			return nil, ErrSignerInvalidRemoteConf.Wrapf("key name %q: %v", keyName, err)
+		}
+		servedKeyNames[keyName] = struct{}{}
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /v1/keys/{key_name}/sign", func(w http.ResponseWriter, req *http.Request) {
+		keyName := req.PathValue("key_name")
+		if _, ok := servedKeyNames[keyName]; !ok {
+			writeRemoteSignerResponse(w, http.StatusNotFound, &remoteSignErrorResponse{Error: "unknown key name"})
+			return
+		}
+
+		signRequest := &remoteSignRequest{}
+		if err := json.NewDecoder(req.Body).Decode(signRequest); err != nil || len(signRequest.SignBytes) == 0 {
+			writeRemoteSignerResponse(w, http.StatusBadRequest, &remoteSignErrorResponse{Error: "invalid sign request"})
+			return
+		}
+
+		// The sign mode only matters to the ledger keys, which hash the bytes
+		// differently, the other keys always sign the sha256 hash of the bytes.
+		signature, _, err := kr.Sign(keyName, signRequest.SignBytes, signingtypes.SignMode_SIGN_MODE_DIRECT)
+		if err != nil {
+			writeRemoteSignerResponse(w, http.StatusInternalServerError, &remoteSignErrorResponse{Error: err.Error()})
+			return
+		}
+
+		writeRemoteSignerResponse(w, http.StatusOK, &remoteSignResponse{Signature: signature})
+	})
+
+	return mux, nil
+}
+
+// writeRemoteSignerResponse replies with the given status code and JSON encoded response.
+func writeRemoteSignerResponse(w http.ResponseWriter, statusCode int, response any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	_ = json.NewEncoder(w).Encode(response)
+}
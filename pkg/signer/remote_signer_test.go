@@ -0,0 +1,129 @@
+package signer_test
+
+import (
+	"crypto/sha256"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/poktroll/pkg/signer"
+	"github.com/pokt-network/poktroll/testutil/testclient/testkeyring"
+)
+
+const (
+	testRemoteKeyName   = "remote_key"
+	testUnservedKeyName = "unserved_key"
+)
+
+func TestRemoteSigner_Sign(t *testing.T) {
+	kr, record := testkeyring.NewTestKeyringWithKey(t, testRemoteKeyName)
+	pubKey, err := record.GetPubKey()
+	require.NoError(t, err)
+
+	handler, err := signer.NewRemoteSignerHandler(kr, []string{testRemoteKeyName})
+	require.NoError(t, err)
+
+	server := httptest.NewTLSServer(handler)
+	t.Cleanup(server.Close)
+
+	serverUrl, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	remoteSignerClient, err := signer.NewRemoteSignerClient(
+		[]signer.RemoteSignerEndpoint{{
+			Url: serverUrl,
+			// The unserved key is routed to the server which does not sign with it.
+			KeyNames: []string{testRemoteKeyName, testUnservedKeyName},
+		}},
+		server.Client().Transport.(*http.Transport).TLSClientConfig,
+	)
+	require.NoError(t, err)
+
+	msg := sha256.Sum256([]byte("relay response"))
+
+	tests := []struct {
+		desc        string
+		keyName     string
+		expectedErr error
+	}{
+		{
+			desc:        "key served by the remote signer",
+			keyName:     testRemoteKeyName,
+			expectedErr: nil,
+		},
+		{
+			desc:        "key not routed to any remote signer",
+			keyName:     "unknown_key",
+			expectedErr: signer.ErrSignerUnknownKeyName,
+		},
+		{
+			desc:        "key routed to a remote signer which does not serve it",
+			keyName:     testUnservedKeyName,
+			expectedErr: signer.ErrSignerRemoteSign,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			signature, err := signer.NewRemoteSigner(remoteSignerClient, test.keyName).Sign(msg)
+			if test.expectedErr != nil {
+				require.ErrorIs(t, err, test.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+
+			// The remote signature is the one the local key would have produced.
+			localSignature, err := signer.NewSimpleSigner(kr, test.keyName).Sign(msg)
+			require.NoError(t, err)
+			require.Equal(t, localSignature, signature)
+			require.True(t, pubKey.VerifySignature(msg[:], signature))
+		})
+	}
+}
+
+func TestRemoteSigningKeyring_Sign(t *testing.T) {
+	remoteKeyring, record := testkeyring.NewTestKeyringWithKey(t, testRemoteKeyName)
+	pubKey, err := record.GetPubKey()
+	require.NoError(t, err)
+
+	handler, err := signer.NewRemoteSignerHandler(remoteKeyring, []string{testRemoteKeyName})
+	require.NoError(t, err)
+
+	server := httptest.NewTLSServer(handler)
+	t.Cleanup(server.Close)
+
+	serverUrl, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	remoteSignerClient, err := signer.NewRemoteSignerClient(
+		[]signer.RemoteSignerEndpoint{{Url: serverUrl, KeyNames: []string{testRemoteKeyName}}},
+		server.Client().Transport.(*http.Transport).TLSClientConfig,
+	)
+	require.NoError(t, err)
+
+	// The local keyring only holds the public key of the remotely served key.
+	localKeyring, _ := testkeyring.NewTestKeyringWithKey(t, "local_key")
+	_, err = localKeyring.SaveOfflineKey(testRemoteKeyName, pubKey)
+	require.NoError(t, err)
+
+	signBytes := []byte("tx sign bytes")
+
+	// Signing with an offline key fails without the remote signer.
+	_, _, err = localKeyring.Sign(testRemoteKeyName, signBytes, signingtypes.SignMode_SIGN_MODE_DIRECT)
+	require.Error(t, err)
+
+	remoteSigningKeyring := signer.NewRemoteSigningKeyring(localKeyring, remoteSignerClient)
+
+	signature, signPubKey, err := remoteSigningKeyring.Sign(testRemoteKeyName, signBytes, signingtypes.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	require.True(t, pubKey.Equals(signPubKey))
+	require.True(t, pubKey.VerifySignature(signBytes, signature))
+
+	// The keys which are not served remotely are still signed with locally.
+	_, _, err = remoteSigningKeyring.Sign("local_key", signBytes, signingtypes.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+}
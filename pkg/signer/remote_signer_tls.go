@@ -0,0 +1,65 @@
+package signer
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"os"
+)
+
+// NewRemoteSignerClientTLSConfig returns the mTLS config used by the RemoteSignerClient
+// to present its certificate to the remote signing services, and to verify
+// their certificate against the given CA bundle.
+func NewRemoteSignerClientTLSConfig(caFile, certFile, keyFile string) (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, ErrSignerInvalidRemoteConf.Wrapf("unable to load tls key pair: %v", err)
+	}
+
+	rootCAs, err := loadCertPool(caFile)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tls.Config{
+		MinVersion:   tls.VersionTLS12,
+		Certificates: []tls.Certificate{cert},
+		RootCAs:      rootCAs,
+	}, nil
+}
+
+// NewRemoteSignerServerTLSConfig returns the mTLS config of a remote signing
+// service, which requires the clients to present a certificate signed by one
+// of the CAs of the given CA bundle.
+func NewRemoteSignerServerTLSConfig(clientCAFile, certFile, keyFile string) (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, ErrSignerInvalidRemoteConf.Wrapf("unable to load tls key pair: %v", err)
+	}
+
+	clientCAs, err := loadCertPool(clientCAFile)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tls.Config{
+		MinVersion:   tls.VersionTLS12,
+		Certificates: []tls.Certificate{cert},
+		ClientCAs:    clientCAs,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+	}, nil
+}
+
+// loadCertPool returns the pool of the certificates of the given PEM encoded CA bundle.
+func loadCertPool(caFile string) (*x509.CertPool, error) {
+	caPEM, err := os.ReadFile(caFile)
+	if err != nil {
+		return nil, ErrSignerInvalidRemoteConf.Wrapf("unable to read tls ca file: %v", err)
+	}
+
+	certPool := x509.NewCertPool()
+	if !certPool.AppendCertsFromPEM(caPEM) {
+		return nil, ErrSignerInvalidRemoteConf.Wrapf("no valid certificate found in tls ca file %q", caFile)
+	}
+
+	return certPool, nil
+}
@@ -246,7 +246,9 @@ func (s *applicationMinStakeTestSuite) getExpectedApp(claim *prooftypes.Claim) *
 	sharedParams := s.keepers.SharedKeeper.GetParams(s.ctx)
 	sessionEndHeight := sharedtypes.GetSessionEndHeight(&sharedParams, s.getCurrentHeight())
 	relayMiningDifficulty := s.newRelayminingDifficulty()
-	expectedBurnCoin, err := claim.GetClaimeduPOKT(sharedParams, relayMiningDifficulty)
+	service, isServiceFound := s.keepers.ServiceKeeper.GetService(s.ctx, s.serviceId)
+	require.True(s.T(), isServiceFound)
+	expectedBurnCoin, err := claim.GetClaimeduPOKT(sharedParams, service, relayMiningDifficulty)
 	require.NoError(s.T(), err)
 
 	globalInflationPerClaim := s.keepers.Keeper.GetParams(s.ctx).GlobalInflationPerClaim
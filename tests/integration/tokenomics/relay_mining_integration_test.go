@@ -136,7 +136,7 @@ func TestComputeNewDifficultyHash_RewardsReflectWorkCompleted(t *testing.T) {
 		claim := prepareRealClaim(t, numRelays, supplierAddress, session, &service, &relayMiningDifficulty)
 
 		// Get the claim's expected reward.
-		claimedRewards, err := claim.GetClaimeduPOKT(sharedParams, relayMiningDifficulty)
+		claimedRewards, err := claim.GetClaimeduPOKT(sharedParams, service, relayMiningDifficulty)
 		require.NoError(t, err)
 
 		// Get the number of claimed mined relays.
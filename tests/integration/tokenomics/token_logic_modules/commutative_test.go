@@ -3,6 +3,7 @@ package token_logic_modules
 import (
 	"bytes"
 	"fmt"
+	"slices"
 	"strings"
 	"testing"
 
@@ -27,30 +28,39 @@ import (
 var zerouPOKT = types.NewInt64Coin(volatile.DenomuPOKT, 0)
 
 // TestTLMProcessorTestSuite asserts that the network state that results from running
-// each permutation of the default TLM processors is identical (demonstrating
-// commutativity).
+// each permutation of the default TLM pipeline (i.e. the token_logic_modules
+// tokenomics param), extended with the TLM_SERVICE_OWNER_REV_SHARE TLM, is identical
+// (demonstrating commutativity).
 //
 // It does this in the following steps:
-//  1. Construct a TokenomicsModuleKeepers instance for each TLM processor permutation.
+//  1. Construct a TokenomicsModuleKeepers instance and set the token_logic_modules
+//     param for each TLM pipeline permutation.
 //  2. Create valid claims (which require no proofs).
 //  3. Advance the block height to the settlement height and settle the claims.
 //  4. Assert that the settlement states of all TLM order permutations match.
 func (s *tokenLogicModuleTestSuite) TestTLMProcessorsAreCommutative() {
-	// Generate all permutations of TLM processor ordering.
-	tokenLogicModules := tlm.NewDefaultTokenLogicModules()
-	tlmOrderPermutations := permute(s.T(), tokenLogicModules)
+	// Route a share of the settlement amount to the service owner such that the
+	// TLM_SERVICE_OWNER_REV_SHARE TLM, which is disabled by default, has effects.
+	s.service.OwnerRevSharePercentage = 10
+
+	// Generate all permutations of TLM pipeline ordering.
+	tokenLogicModuleConfigs := append(
+		slices.Clone(tokenomicstypes.DefaultTokenLogicModules),
+		getServiceOwnerRevShareTLMConfig(20),
+	)
+	tlmOrderPermutations := permute(s.T(), tokenLogicModuleConfigs)
 
-	numTLMOrderPermutations := factorial(len(tokenLogicModules))
+	numTLMOrderPermutations := factorial(len(tokenLogicModuleConfigs))
 	require.Equal(s.T(), numTLMOrderPermutations, len(tlmOrderPermutations))
 
 	for i, tlmPermutation := range tlmOrderPermutations {
 		var tlmIds []string
-		for _, tokenLogicModule := range tlmPermutation {
-			tlmIds = append(tlmIds, tokenLogicModule.GetId().String())
+		for _, tlmConfig := range tlmPermutation {
+			tlmIds = append(tlmIds, tlmConfig.Id.String())
 		}
 
 		// The test description is a unique identifier for each permutation.
-		// E.g.: "permutaiton_1_of_2:TLMRelayBurnEqualsMint_TLMGlobalMint"
+		// E.g.: "permutaiton_1_of_2:TLM_RELAY_BURN_EQUALS_MINT_TLM_GLOBAL_MINT"
 		testDesc := fmt.Sprintf(
 			"permutaiton_%d_of_%d:%s",
 			i+1, numTLMOrderPermutations,
@@ -58,7 +68,13 @@ func (s *tokenLogicModuleTestSuite) TestTLMProcessorsAreCommutative() {
 		)
 
 		s.T().Run(testDesc, func(t *testing.T) {
-			s.setupKeepers(t, testkeeper.WithTokenLogicModules(tlmPermutation))
+			s.setupKeepers(t)
+
+			// Run the settlement pipeline in the order of the current permutation.
+			tokenomicsParams := s.getTokenomicsParams()
+			tokenomicsParams.TokenLogicModules = tlmPermutation
+			err := s.keepers.Keeper.SetParams(s.ctx, *tokenomicsParams)
+			require.NoError(t, err)
 
 			// Assert that no pre-existing claims are present.
 			numExistingClaims := len(s.keepers.GetAllClaims(s.ctx))
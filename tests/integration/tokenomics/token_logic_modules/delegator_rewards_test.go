@@ -0,0 +1,214 @@
+package token_logic_modules
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/poktroll/app/volatile"
+	"github.com/pokt-network/poktroll/testutil/sample"
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
+	suppliertypes "github.com/pokt-network/poktroll/x/supplier/types"
+	tokenomicstypes "github.com/pokt-network/poktroll/x/tokenomics/types"
+)
+
+// TestDelegatorRewards asserts that the TLM_RELAY_BURN_EQUALS_MINT TLM distributes
+// the supplier rewards of each settled claim to the supplier's delegators, pro-rata
+// to their delegated stake and minus the supplier's delegation commission, and the
+// rest of the rewards to the supplier's shareholders, without any rounding dust
+// being left in the supplier module account.
+func (s *tokenLogicModuleTestSuite) TestDelegatorRewards() {
+	// The settlement amount of the claim created by s.createClaims(), given the
+	// suite's compute units per relay and compute units to tokens multiplier.
+	const claimSettlementAmount = 1000
+
+	// The suite's supplier stake is 1 POKT (i.e. suppliertypes.DefaultMinStake).
+	supplierStakeAmount := suppliertypes.DefaultMinStake.Amount.Int64()
+
+	tests := []struct {
+		desc                           string
+		delegationCommissionPercentage uint64
+		// updatedDelegationCommissionPercentage, if not zero, is a commission set by the
+		// supplier owner after the claimed session started, which must not apply to it.
+		updatedDelegationCommissionPercentage uint64
+		// delegationStakeAmounts are the stake amounts of the delegations active at the
+		// claimed session start.
+		delegationStakeAmounts []int64
+		// isUnbonding, if true, makes the delegations unbond at the claimed session end,
+		// which must not affect their rewards for it.
+		isUnbonding bool
+		// inactiveDelegationStakeAmount is the stake amount of a delegation which is
+		// activated after the claimed session started and earns no rewards for it.
+		inactiveDelegationStakeAmount  int64
+		expectedDelegatorRewardAmounts []int64
+	}{
+		{
+			desc:                           "no delegations",
+			expectedDelegatorRewardAmounts: []int64{},
+		},
+		{
+			desc:                           "delegations without commission",
+			delegationStakeAmounts:         []int64{supplierStakeAmount, 2 * supplierStakeAmount},
+			expectedDelegatorRewardAmounts: []int64{250, 500},
+		},
+		{
+			desc:                           "delegations with commission",
+			delegationCommissionPercentage: 10,
+			delegationStakeAmounts:         []int64{supplierStakeAmount, 2 * supplierStakeAmount},
+			expectedDelegatorRewardAmounts: []int64{225, 450},
+		},
+		{
+			desc:                           "delegations unbonding at the claimed session end",
+			delegationCommissionPercentage: 10,
+			delegationStakeAmounts:         []int64{supplierStakeAmount, 2 * supplierStakeAmount},
+			isUnbonding:                    true,
+			expectedDelegatorRewardAmounts: []int64{225, 450},
+		},
+		{
+			desc:                           "delegations activated after the claimed session started earn no rewards",
+			delegationCommissionPercentage: 10,
+			delegationStakeAmounts:         []int64{supplierStakeAmount, 2 * supplierStakeAmount},
+			inactiveDelegationStakeAmount:  supplierStakeAmount,
+			expectedDelegatorRewardAmounts: []int64{225, 450},
+		},
+		{
+			desc:                                  "commission updated after the claimed session started",
+			delegationCommissionPercentage:        10,
+			updatedDelegationCommissionPercentage: 100,
+			delegationStakeAmounts:                []int64{supplierStakeAmount, 2 * supplierStakeAmount},
+			expectedDelegatorRewardAmounts:        []int64{225, 450},
+		},
+		{
+			desc:                           "delegator rewards remainders go to the shareholders",
+			delegationStakeAmounts:         []int64{supplierStakeAmount, supplierStakeAmount},
+			expectedDelegatorRewardAmounts: []int64{333, 333},
+		},
+		{
+			desc:                           "delegations with 100% commission",
+			delegationCommissionPercentage: 100,
+			delegationStakeAmounts:         []int64{supplierStakeAmount, 2 * supplierStakeAmount, 3 * supplierStakeAmount},
+			expectedDelegatorRewardAmounts: []int64{0, 0, 0},
+		},
+	}
+
+	for _, test := range tests {
+		s.T().Run(test.desc, func(t *testing.T) {
+			s.supplier.DelegationCommissionPercentage = test.delegationCommissionPercentage
+			s.supplier.DelegationCommissionHistory = nil
+			if test.updatedDelegationCommissionPercentage > 0 {
+				// The claims are created for the session starting at height 1.
+				effectiveHeight := sharedtypes.GetNextSessionStartHeight(s.getSharedParams(), 1)
+				s.supplier.SetDelegationCommissionPercentage(test.updatedDelegationCommissionPercentage, effectiveHeight, 0)
+			}
+			s.setupKeepers(t)
+
+			tokenomicsParams := s.getTokenomicsParams()
+			tokenomicsParams.TokenLogicModules = []tokenomicstypes.TokenLogicModuleConfig{
+				{Id: tokenomicstypes.TokenLogicModuleId_TLM_RELAY_BURN_EQUALS_MINT},
+			}
+			err := s.keepers.Keeper.SetParams(s.ctx, *tokenomicsParams)
+			require.NoError(t, err)
+
+			// The claims are created for the session starting at height 1.
+			claimedSessionEndHeight := sharedtypes.GetSessionEndHeight(s.getSharedParams(), 1)
+			var unstakeSessionEndHeight uint64
+			if test.isUnbonding {
+				unstakeSessionEndHeight = uint64(claimedSessionEndHeight)
+			}
+
+			delegatorAddresses := make([]string, len(test.delegationStakeAmounts))
+			for i, delegationStakeAmount := range test.delegationStakeAmounts {
+				delegatorAddresses[i] = s.setDelegation(delegationStakeAmount, 1, unstakeSessionEndHeight)
+			}
+			var inactiveDelegatorAddress string
+			if test.inactiveDelegationStakeAmount > 0 {
+				inactiveDelegatorAddress = s.setDelegation(test.inactiveDelegationStakeAmount, claimedSessionEndHeight+1, 0)
+			}
+
+			supplierOwnerStartBalance := s.getBalance(t, s.supplier.GetOwnerAddress())
+			supplierModuleAddress := authtypes.NewModuleAddress(suppliertypes.ModuleName).String()
+			supplierModuleStartBalance := s.getBalance(t, supplierModuleAddress)
+
+			s.createClaims(&s.keepers, 1)
+			settledResults, _ := s.settleClaims(t)
+			require.Len(t, settledResults, 1)
+
+			// Assert that the settlement result reflects the delegator rewards.
+			delegatorRewardTransfers := make(map[string]types.Coin)
+			for _, transfer := range settledResults[0].GetModToAcctTransfers() {
+				if transfer.GetOpReason() == tokenomicstypes.SettlementOpReason_TLM_RELAY_BURN_EQUALS_MINT_DELEGATOR_REWARD_DISTRIBUTION {
+					delegatorRewardTransfers[transfer.GetRecipientAddress()] = transfer.GetCoin()
+				}
+			}
+
+			totalDelegatorRewardsCoin := types.NewInt64Coin(volatile.DenomuPOKT, 0)
+			for i, delegatorAddress := range delegatorAddresses {
+				expectedRewardCoin := types.NewInt64Coin(volatile.DenomuPOKT, test.expectedDelegatorRewardAmounts[i])
+				totalDelegatorRewardsCoin = totalDelegatorRewardsCoin.Add(expectedRewardCoin)
+
+				// Zero amount transfers are not queued.
+				if expectedRewardCoin.IsZero() {
+					require.NotContains(t, delegatorRewardTransfers, delegatorAddress)
+				} else {
+					require.Equal(t, expectedRewardCoin, delegatorRewardTransfers[delegatorAddress])
+				}
+
+				// Assert that the delegator received its rewards.
+				require.Equal(t, &expectedRewardCoin, s.getBalance(t, delegatorAddress))
+			}
+			require.Len(t, delegatorRewardTransfers, countPositive(test.expectedDelegatorRewardAmounts))
+
+			// Assert that the inactive delegator did not receive any rewards.
+			if inactiveDelegatorAddress != "" {
+				require.NotContains(t, delegatorRewardTransfers, inactiveDelegatorAddress)
+				require.True(t, s.getBalance(t, inactiveDelegatorAddress).IsZero())
+			}
+
+			// Assert that the supplier shareholders received the rest of the rewards.
+			supplierRewardsCoin := types.NewInt64Coin(volatile.DenomuPOKT, claimSettlementAmount).Sub(totalDelegatorRewardsCoin)
+			expectedSupplierOwnerBalance := supplierOwnerStartBalance.Add(supplierRewardsCoin)
+			require.Equal(t, &expectedSupplierOwnerBalance, s.getBalance(t, s.supplier.GetOwnerAddress()))
+
+			// Assert that all the rewards minted to the supplier module account were
+			// distributed, leaving no rounding dust behind.
+			require.Equal(t, supplierModuleStartBalance, s.getBalance(t, supplierModuleAddress))
+
+			s.assertNoPendingClaims(t)
+		})
+	}
+}
+
+// setDelegation stores a delegation of the given stake amount to the suite's supplier
+// and returns the address of its delegator. The delegation earns rewards from the session
+// starting at activationHeight and is unbonding if unstakeSessionEndHeight is not zero.
+func (s *tokenLogicModuleTestSuite) setDelegation(
+	stakeAmount int64,
+	activationHeight int64,
+	unstakeSessionEndHeight uint64,
+) string {
+	s.T().Helper()
+
+	stake := types.NewInt64Coin(volatile.DenomuPOKT, stakeAmount)
+	delegation := suppliertypes.Delegation{
+		DelegatorAddress:        sample.AccAddress(),
+		SupplierOperatorAddress: s.supplier.GetOperatorAddress(),
+		Stake:                   &stake,
+		ActivationHeight:        activationHeight,
+		UnstakeSessionEndHeight: unstakeSessionEndHeight,
+	}
+	s.keepers.SupplierKeeper.SetDelegation(s.ctx, delegation)
+
+	return delegation.GetDelegatorAddress()
+}
+
+// countPositive returns the number of positive amounts in the given slice.
+func countPositive(amounts []int64) (numPositive int) {
+	for _, amount := range amounts {
+		if amount > 0 {
+			numPositive++
+		}
+	}
+	return numPositive
+}
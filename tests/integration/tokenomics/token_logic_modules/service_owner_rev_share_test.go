@@ -0,0 +1,143 @@
+package token_logic_modules
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/poktroll/app/volatile"
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
+	tokenomicstypes "github.com/pokt-network/poktroll/x/tokenomics/types"
+)
+
+// TestServiceOwnerRevShare asserts that the TLM_SERVICE_OWNER_REV_SHARE TLM routes
+// the service's owner rev share percentage, capped by the TLM's max_rev_share_percentage
+// param, of each settled claim from the supplier's rewards to the service owner.
+func (s *tokenLogicModuleTestSuite) TestServiceOwnerRevShare() {
+	// The settlement amount of the claim created by s.createClaims(), given the
+	// suite's compute units per relay and compute units to tokens multiplier.
+	const claimSettlementAmount = 1000
+
+	tests := []struct {
+		desc                           string
+		serviceOwnerRevSharePercentage uint64
+		// updatedServiceOwnerRevSharePercentage, if not zero, is a percentage set by the
+		// service owner after the claimed session started, which must not apply to it.
+		updatedServiceOwnerRevSharePercentage uint64
+		tokenLogicModules                     []tokenomicstypes.TokenLogicModuleConfig
+		expectedRevSharePercentage            int64
+	}{
+		{
+			desc:                           "service owner rev share percentage below the max",
+			serviceOwnerRevSharePercentage: 10,
+			tokenLogicModules:              getServiceOwnerRevShareTLMs(20),
+			expectedRevSharePercentage:     10,
+		},
+		{
+			desc:                           "service owner rev share percentage capped by the max",
+			serviceOwnerRevSharePercentage: 30,
+			tokenLogicModules:              getServiceOwnerRevShareTLMs(20),
+			expectedRevSharePercentage:     20,
+		},
+		{
+			desc:                           "service owner rev share percentage is zero",
+			serviceOwnerRevSharePercentage: 0,
+			tokenLogicModules:              getServiceOwnerRevShareTLMs(20),
+			expectedRevSharePercentage:     0,
+		},
+		{
+			desc:                                  "service owner rev share percentage updated after the claimed session started",
+			serviceOwnerRevSharePercentage:        10,
+			updatedServiceOwnerRevSharePercentage: 20,
+			tokenLogicModules:                     getServiceOwnerRevShareTLMs(20),
+			expectedRevSharePercentage:            10,
+		},
+		{
+			desc:                           "service owner rev share TLM is disabled",
+			serviceOwnerRevSharePercentage: 10,
+			tokenLogicModules: []tokenomicstypes.TokenLogicModuleConfig{
+				{Id: tokenomicstypes.TokenLogicModuleId_TLM_RELAY_BURN_EQUALS_MINT},
+			},
+			expectedRevSharePercentage: 0,
+		},
+	}
+
+	for _, test := range tests {
+		s.T().Run(test.desc, func(t *testing.T) {
+			s.service.OwnerRevSharePercentage = test.serviceOwnerRevSharePercentage
+			s.service.OwnerRevSharePercentageHistory = nil
+			if test.updatedServiceOwnerRevSharePercentage > 0 {
+				// The claims are created for the session starting at height 1.
+				effectiveHeight := sharedtypes.GetNextSessionStartHeight(s.getSharedParams(), 1)
+				s.service.SetOwnerRevSharePercentage(test.updatedServiceOwnerRevSharePercentage, effectiveHeight, 0)
+			}
+			s.setupKeepers(t)
+
+			tokenomicsParams := s.getTokenomicsParams()
+			tokenomicsParams.TokenLogicModules = test.tokenLogicModules
+			err := s.keepers.Keeper.SetParams(s.ctx, *tokenomicsParams)
+			require.NoError(t, err)
+
+			sourceOwnerStartBalance := s.getBalance(t, s.sourceOwnerBech32)
+			supplierOwnerStartBalance := s.getBalance(t, s.supplier.GetOwnerAddress())
+
+			s.createClaims(&s.keepers, 1)
+			settledResults, _ := s.settleClaims(t)
+			require.Len(t, settledResults, 1)
+
+			expectedRevShareAmount := claimSettlementAmount * test.expectedRevSharePercentage / 100
+			expectedRevShareCoin := types.NewInt64Coin(volatile.DenomuPOKT, expectedRevShareAmount)
+
+			// Assert that the settlement result reflects the service owner rev share.
+			var revShareTransfers []tokenomicstypes.ModToAcctTransfer
+			for _, transfer := range settledResults[0].GetModToAcctTransfers() {
+				if transfer.GetOpReason() == tokenomicstypes.SettlementOpReason_TLM_SERVICE_OWNER_REV_SHARE_REWARD_DISTRIBUTION {
+					revShareTransfers = append(revShareTransfers, transfer)
+				}
+			}
+
+			if expectedRevShareAmount == 0 {
+				require.Empty(t, revShareTransfers)
+			} else {
+				require.Len(t, revShareTransfers, 1)
+				require.Equal(t, s.sourceOwnerBech32, revShareTransfers[0].GetRecipientAddress())
+				require.Equal(t, expectedRevShareCoin, revShareTransfers[0].GetCoin())
+			}
+
+			// Assert that the service owner received its rev share, which was
+			// deducted from the supplier's rewards.
+			expectedSourceOwnerBalance := sourceOwnerStartBalance.Add(expectedRevShareCoin)
+			require.Equal(t, &expectedSourceOwnerBalance, s.getBalance(t, s.sourceOwnerBech32))
+
+			supplierRewardsCoin := types.NewInt64Coin(volatile.DenomuPOKT, claimSettlementAmount).Sub(expectedRevShareCoin)
+			expectedSupplierOwnerBalance := supplierOwnerStartBalance.Add(supplierRewardsCoin)
+			require.Equal(t, &expectedSupplierOwnerBalance, s.getBalance(t, s.supplier.GetOwnerAddress()))
+
+			s.assertNoPendingClaims(t)
+		})
+	}
+}
+
+// getServiceOwnerRevShareTLMs returns a TLM pipeline which only runs the
+// TLM_RELAY_BURN_EQUALS_MINT and the TLM_SERVICE_OWNER_REV_SHARE TLMs, the latter
+// with the given max rev share percentage.
+func getServiceOwnerRevShareTLMs(maxRevSharePercentage uint64) []tokenomicstypes.TokenLogicModuleConfig {
+	return []tokenomicstypes.TokenLogicModuleConfig{
+		{Id: tokenomicstypes.TokenLogicModuleId_TLM_RELAY_BURN_EQUALS_MINT},
+		getServiceOwnerRevShareTLMConfig(maxRevSharePercentage),
+	}
+}
+
+// getServiceOwnerRevShareTLMConfig returns the config of the TLM_SERVICE_OWNER_REV_SHARE
+// TLM with the given max rev share percentage.
+func getServiceOwnerRevShareTLMConfig(maxRevSharePercentage uint64) tokenomicstypes.TokenLogicModuleConfig {
+	return tokenomicstypes.TokenLogicModuleConfig{
+		Id: tokenomicstypes.TokenLogicModuleId_TLM_SERVICE_OWNER_REV_SHARE,
+		Params: &tokenomicstypes.TokenLogicModuleConfig_ServiceOwnerRevShare{
+			ServiceOwnerRevShare: &tokenomicstypes.TLMServiceOwnerRevShareParams{
+				MaxRevSharePercentage: maxRevSharePercentage,
+			},
+		},
+	}
+}
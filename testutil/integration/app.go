@@ -391,6 +391,7 @@ func NewCompleteIntegrationApp(t *testing.T, opts ...IntegrationAppOptionFn) *Ap
 		authority.String(),
 
 		bankKeeper,
+		sharedKeeper,
 	)
 	serviceModule := service.NewAppModule(
 		cdc,
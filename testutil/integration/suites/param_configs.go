@@ -28,6 +28,7 @@ const (
 	ParamTypeBytes                     ParamType = "uint8"
 	ParamTypeCoin                      ParamType = "Coin"
 	ParamTypeMintAllocationPercentages ParamType = "MintAllocationPercentages"
+	ParamTypeTokenLogicModuleConfigs   ParamType = "TokenLogicModuleConfig"
 )
 
 // ModuleParamConfig holds type information about a module's parameters update
@@ -131,8 +132,9 @@ var (
 			QueryParamsResponse:     servicetypes.QueryParamsResponse{},
 		},
 		ValidParams: servicetypes.Params{
-			AddServiceFee:   &ValidAddServiceFeeCoin,
-			TargetNumRelays: servicetypes.DefaultTargetNumRelays,
+			AddServiceFee:                     &ValidAddServiceFeeCoin,
+			TargetNumRelays:                   servicetypes.DefaultTargetNumRelays,
+			MaxComputeUnitsToTokensMultiplier: servicetypes.DefaultMaxComputeUnitsToTokensMultiplier,
 		},
 		ParamTypes: map[ParamType]any{
 			ParamTypeCoin:   servicetypes.MsgUpdateParam_AsCoin{},
@@ -192,11 +194,16 @@ var (
 			QueryParamsResponse:     suppliertypes.QueryParamsResponse{},
 		},
 		ValidParams: suppliertypes.Params{
-			MinStake:   &ValidActorMinStake,
-			StakingFee: &ValidStakingFee,
+			MinStake:                              &ValidActorMinStake,
+			StakingFee:                            &ValidStakingFee,
+			ProofFaultWindowSessions:              5,
+			ProofFaultPenaltyEscalationPercentage: 50,
+			ProofFaultJailingThreshold:            2,
+			JailDurationSessions:                  7,
 		},
 		ParamTypes: map[ParamType]any{
-			ParamTypeCoin: suppliertypes.MsgUpdateParam_AsCoin{},
+			ParamTypeCoin:   suppliertypes.MsgUpdateParam_AsCoin{},
+			ParamTypeUint64: suppliertypes.MsgUpdateParam_AsUint64{},
 		},
 		DefaultParams:    suppliertypes.DefaultParams(),
 		NewParamClientFn: suppliertypes.NewQueryClient,
@@ -239,9 +246,11 @@ var (
 			MintAllocationPercentages: tokenomicstypes.DefaultMintAllocationPercentages,
 			DaoRewardAddress:          sample.AccAddress(),
 			GlobalInflationPerClaim:   0.666,
+			TokenLogicModules:         tokenomicstypes.DefaultTokenLogicModules,
 		},
 		ParamTypes: map[ParamType]any{
 			ParamTypeMintAllocationPercentages: tokenomicstypes.MsgUpdateParam_AsMintAllocationPercentages{},
+			ParamTypeTokenLogicModuleConfigs:   tokenomicstypes.MsgUpdateParam_AsTokenLogicModules{},
 			ParamTypeString:                    tokenomicstypes.MsgUpdateParam_AsString{},
 			ParamTypeFloat64:                   tokenomicstypes.MsgUpdateParam_AsFloat{},
 		},
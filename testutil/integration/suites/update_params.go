@@ -272,6 +272,14 @@ func (s *ParamsSuite) RunUpdateParamAsSigner(
 		asMintAllocationPercentagesField.Set(reflect.New(paramReflectValue.Type()))
 		// =~ *msg.AsType.AsMintAllocationPercentages = paramReflectValue.Interface().(MintAllocationPercentages)
 		asMintAllocationPercentagesField.Elem().Set(paramReflectValue)
+	case ParamTypeTokenLogicModuleConfigs:
+		// Params.TokenLogicModules is a slice, but MsgUpdateParam wraps it in a
+		// TokenLogicModuleConfigs message; construct the wrapper to assign the slice to.
+		asTokenLogicModulesField := msgAsTypeValue.Elem().FieldByName("AsTokenLogicModules")
+		// =~ msg.AsType.AsTokenLogicModules = new(TokenLogicModuleConfigs)
+		asTokenLogicModulesField.Set(reflect.New(asTokenLogicModulesField.Type().Elem()))
+		// =~ msg.AsType.AsTokenLogicModules.TokenLogicModules = paramReflectValue.Interface().([]TokenLogicModuleConfig)
+		asTokenLogicModulesField.Elem().FieldByName("TokenLogicModules").Set(paramReflectValue)
 	default:
 		t.Fatalf("ERROR: unknown field type %q", paramType)
 	}
@@ -127,6 +127,8 @@ var (
 // to modify its behavior.
 type keeperConfig struct {
 	sharedParams *sharedtypes.Params
+
+	testSupplierJailHistory []*sharedtypes.SupplierJailPeriod
 }
 
 // KeeperOptionFn is a function type that sets/updates fields on the keeperConfig.
@@ -140,6 +142,14 @@ func WithSharedModuleParams(sharedParams *sharedtypes.Params) KeeperOptionFn {
 	}
 }
 
+// WithTestSupplierJailHistory returns a KeeperOptionFn that sets the jail periods
+// of the TestSupplier returned by the supplier keeper mock.
+func WithTestSupplierJailHistory(jailHistory []*sharedtypes.SupplierJailPeriod) KeeperOptionFn {
+	return func(c *keeperConfig) {
+		c.testSupplierJailHistory = jailHistory
+	}
+}
+
 func SessionKeeper(t testing.TB, opts ...KeeperOptionFn) (keeper.Keeper, context.Context) {
 	t.Helper()
 
@@ -166,7 +176,7 @@ func SessionKeeper(t testing.TB, opts ...KeeperOptionFn) (keeper.Keeper, context
 	mockAccountKeeper.EXPECT().GetAccount(gomock.Any(), gomock.Any()).AnyTimes()
 
 	mockAppKeeper := defaultAppKeeperMock(t)
-	mockSupplierKeeper := defaultSupplierKeeperMock(t)
+	mockSupplierKeeper := defaultSupplierKeeperMock(t, cfg)
 
 	sharedParams := sharedtypes.DefaultParams()
 	if cfg.sharedParams != nil {
@@ -244,7 +254,7 @@ func defaultAppKeeperMock(t testing.TB) types.ApplicationKeeper {
 	return mockAppKeeper
 }
 
-func defaultSupplierKeeperMock(t testing.TB) types.SupplierKeeper {
+func defaultSupplierKeeperMock(t testing.TB, cfg *keeperConfig) types.SupplierKeeper {
 	t.Helper()
 	ctrl := gomock.NewController(t)
 
@@ -279,6 +289,7 @@ func defaultSupplierKeeperMock(t testing.TB) types.SupplierKeeper {
 			testSupplier := TestSupplier
 			testSupplier.Services = nil
 			testSupplier.ServiceConfigHistory = nil
+			testSupplier.JailHistory = cfg.testSupplierJailHistory
 
 			return testSupplier, true
 		}).AnyTimes()
@@ -202,6 +202,24 @@ func TokenomicsKeeperWithActorAddrs(t testing.TB) (
 	mockSupplierKeeper.EXPECT().
 		SetDehydratedSupplier(gomock.Any(), gomock.Any()).
 		AnyTimes()
+	// The test supplier has no delegations.
+	mockSupplierKeeper.EXPECT().
+		GetSupplierDelegations(gomock.Any(), gomock.Any()).
+		Return(nil).
+		AnyTimes()
+	mockSupplierKeeper.EXPECT().
+		SetDelegation(gomock.Any(), gomock.Any()).
+		AnyTimes()
+	// The test supplier has no prior proof faults.
+	mockSupplierKeeper.EXPECT().
+		RecordSupplierProofFault(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, supplierOperatorAddr string, proofFault suppliertypes.ProofFault) (suppliertypes.SupplierProofFaults, error) {
+			return suppliertypes.SupplierProofFaults{
+				OperatorAddress: supplierOperatorAddr,
+				ProofFaults:     []suppliertypes.ProofFault{proofFault},
+			}, nil
+		}).
+		AnyTimes()
 
 	// Get test supplier if the address matches.
 	mockSupplierKeeper.EXPECT().
@@ -467,6 +485,7 @@ func NewTokenomicsModuleKeepers(
 		log.NewNopLogger(),
 		authority.String(),
 		bankKeeper,
+		sharedKeeper,
 	)
 
 	if params, ok := cfg.moduleParams[servicetypes.ModuleName]; ok {
@@ -66,6 +66,8 @@ func NewAnyTimesCommittedBlocksSequenceBlockClient(
 // when that call is made, it returns a new BlocksObservable that is notified of
 // blocks sent on the given blocksPublishCh.
 // blocksPublishCh is the channel the caller can use to publish blocks the observable.
+// The blocks buffered in blocksPublishCh are all replayed to the observers which
+// subscribe after they were published, so none of them is missed.
 func NewOneTimeCommittedBlocksSequenceBlockClient(
 	t *testing.T,
 	blocksPublishCh chan client.Block,
@@ -81,11 +83,12 @@ func NewOneTimeCommittedBlocksSequenceBlockClient(
 	blockClientMock.EXPECT().CommittedBlocksSequence(
 		gomock.AssignableToTypeOf(context.Background()),
 	).DoAndReturn(func(ctx context.Context) client.BlockReplayObservable {
-		// Create a new replay observable with a replay buffer size of 1. Blocks
-		// are published to this observable via the provided blocksPublishCh.
+		// Create a new replay observable with a replay buffer size of the
+		// blocksPublishCh capacity (at least 1). Blocks are published to this
+		// observable via the provided blocksPublishCh.
 		withPublisherOpt := channel.WithPublisher(blocksPublishCh)
 		obs, _ := channel.NewReplayObservable[client.Block](
-			ctx, 1, withPublisherOpt,
+			ctx, max(cap(blocksPublishCh), 1), withPublisherOpt,
 		)
 		return obs
 	})
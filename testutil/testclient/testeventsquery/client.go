@@ -2,14 +2,11 @@ package testeventsquery
 
 import (
 	"context"
-	"fmt"
 	"sync"
 	"testing"
 	"time"
 
-	cosmoskeyring "github.com/cosmos/cosmos-sdk/crypto/keyring"
 	"github.com/regen-network/gocuke"
-	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 
 	"github.com/pokt-network/poktroll/pkg/client"
@@ -59,30 +56,22 @@ func NewOneTimeEventsQuery(
 }
 
 // NewOneTimeTxEventsQueryClient creates a mock of the Events that expects to to
-// a single call to the EventsBytes method where the query is for transaction
-// events for sender address matching that of the given key.
+// a single call to the EventsBytes method where the query is for the committed
+// transactions events (i.e. events.CommittedTxsQuery).
 // The caller can simulate blockchain events by sending on publishCh, the value
 // of which is set to the publish channel of the events bytes observable publish
 // channel.
 func NewOneTimeTxEventsQueryClient(
 	ctx context.Context,
 	t *testing.T,
-	key *cosmoskeyring.Record,
 	publishChMu *sync.Mutex,
 	publishCh *chan<- either.Bytes,
 ) *mockclient.MockEventsQueryClient {
 	t.Helper()
 
-	signingAddr, err := key.GetAddress()
-	require.NoError(t, err)
-
-	expectedEventsQuery := fmt.Sprintf(
-		"tm.event='Tx' AND message.sender='%s'",
-		signingAddr,
-	)
 	return NewOneTimeEventsQuery(
 		ctx, t,
-		expectedEventsQuery,
+		events.CommittedTxsQuery,
 		publishChMu,
 		publishCh,
 	)
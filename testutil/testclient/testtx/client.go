@@ -77,6 +77,7 @@ func NewOneTimeSignAndBroadcastTxClient(
 		gomock.Any(),
 		gomock.Any(),
 	).DoAndReturn(signAndBroadcast).Times(1)
+	txClient.EXPECT().PendingTxs().Return(nil).AnyTimes()
 
 	return txClient
 }
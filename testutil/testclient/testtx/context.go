@@ -6,7 +6,6 @@ import (
 	"testing"
 
 	"cosmossdk.io/depinject"
-	abci "github.com/cometbft/cometbft/abci/types"
 	cometbytes "github.com/cometbft/cometbft/libs/bytes"
 	cometrpctypes "github.com/cometbft/cometbft/rpc/core/types"
 	comettypes "github.com/cometbft/cometbft/types"
@@ -54,28 +53,17 @@ func NewLocalnetContext(t *testing.T) client.TxContext {
 // methods may be called.
 
 // NewOneTimeErrTxTimeoutTxContext creates a mock transaction context designed to
-// simulate a specific timeout error scenario during transaction broadcasting.
-// expectedErrMsg is populated with the same error message which is presented in
-// the result from the QueryTx method so that it can be asserted against.
+// simulate a transaction which is not observed as committed before its timeout
+// height. queryTxFn is called with the hash and bytes of the broadcast transaction
+// to produce the result of the QueryTx method, which is called once it times out.
 func NewOneTimeErrTxTimeoutTxContext(
 	t *testing.T,
 	keyring cosmoskeyring.Keyring,
 	signingKeyName string,
-	expectedErrMsg *string,
+	queryTxFn func(txHash, txBz []byte) (*cometrpctypes.ResultTx, error),
 ) *mockclient.MockTxContext {
 	t.Helper()
 
-	signerKey, err := keyring.Key(signingKeyName)
-	require.NoError(t, err)
-
-	signerAddr, err := signerKey.GetAddress()
-	require.NoError(t, err)
-
-	*expectedErrMsg = fmt.Sprintf(
-		"fee payer address: %s does not exist: unknown address",
-		signerAddr.String(),
-	)
-
 	var expectedTx cometbytes.HexBytes
 	txCtxMock := NewBaseTxContext(
 		t, signingKeyName,
@@ -83,7 +71,10 @@ func NewOneTimeErrTxTimeoutTxContext(
 		&expectedTx,
 	)
 
-	// intercept #BroadcastTx() call to mock response and prevent actual broadcast
+	// intercept #BroadcastTx() call to mock response and prevent actual broadcast.
+	// The pending transaction, first observed at height 1, is rebroadcast once at
+	// height 1+DefaultRebroadcastHeightOffset, before it times out at the height
+	// DefaultCommitTimeoutHeightOffset.
 	txCtxMock.EXPECT().BroadcastTx(gomock.Any()).
 		DoAndReturn(
 			func(txBytes []byte) (*cosmostypes.TxResponse, error) {
@@ -93,9 +84,9 @@ func NewOneTimeErrTxTimeoutTxContext(
 					TxHash: expectedTxHash.String(),
 				}, nil
 			},
-		).Times(1)
+		).Times(2)
 
-	txCtxMock.EXPECT().GetSimulatedTxGas(gomock.Any(), gomock.Any(), gomock.Any()).
+	txCtxMock.EXPECT().GetSimulatedTxGas(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return(uint64(1), nil).
 		Times(1)
 
@@ -109,16 +100,7 @@ func NewOneTimeErrTxTimeoutTxContext(
 			txHash []byte,
 			_ bool,
 		) (*cometrpctypes.ResultTx, error) {
-			return &cometrpctypes.ResultTx{
-				Hash:   txHash,
-				Height: 1,
-				TxResult: abci.ExecTxResult{
-					Code:      1,
-					Log:       *expectedErrMsg,
-					Codespace: "test_codespace",
-				},
-				Tx: expectedTx.Bytes(),
-			}, nil
+			return queryTxFn(txHash, expectedTx.Bytes())
 		},
 	)
 
@@ -206,7 +188,7 @@ func NewOneTimeTxTxContext(
 }
 
 // NewBaseTxContext creates a mock transaction context that's configured to expect
-// calls to NewTxBuilder, SignTx, and EncodeTx methods, any number of times.
+// calls to NewTxBuilder, SignTxWithSequence, and EncodeTx methods, any number of times.
 // EncodeTx is used to intercept the encoded transaction bytes and store them in
 // the expectedTx output parameter. Each of these methods proxies to the corresponding
 // method on a real transaction context.
@@ -222,11 +204,11 @@ func NewBaseTxContext(
 	txCtxMock.EXPECT().NewTxBuilder().
 		DoAndReturn(txCtx.NewTxBuilder).
 		AnyTimes()
-	txCtxMock.EXPECT().SignTx(
+	txCtxMock.EXPECT().SignTxWithSequence(
 		gomock.Eq(signingKeyName),
 		gomock.AssignableToTypeOf(txCtx.NewTxBuilder()),
-		gomock.Eq(false), gomock.Eq(false),
-	).DoAndReturn(txCtx.SignTx).AnyTimes()
+		gomock.Any(), gomock.Any(),
+	).DoAndReturn(txCtx.SignTxWithSequence).AnyTimes()
 	txCtxMock.EXPECT().EncodeTx(gomock.Any()).
 		DoAndReturn(
 			func(txBuilder cosmosclient.TxBuilder) (_ []byte, err error) {
@@ -242,7 +224,7 @@ func NewBaseTxContext(
 
 // NewAnyTimesTxTxContext initializes a mock transaction context that's configured to allow
 // arbitrary calls to certain predefined interactions, primarily concerning the retrieval
-// of account numbers and sequences.
+// of account numbers and sequences, which are always 1.
 func NewAnyTimesTxTxContext(
 	t *testing.T,
 	keyring cosmoskeyring.Keyring,
@@ -274,6 +256,9 @@ func NewAnyTimesTxTxContext(
 	require.NoError(t, err)
 	txCtxMock := mockclient.NewMockTxContext(ctrl)
 	txCtxMock.EXPECT().GetKeyring().Return(keyring).AnyTimes()
+	txCtxMock.EXPECT().GetAccountNumberSequence(gomock.Any()).
+		DoAndReturn(txCtx.GetAccountNumberSequence).
+		AnyTimes()
 
 	return txCtxMock, txCtx
 }
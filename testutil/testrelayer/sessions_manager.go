@@ -31,7 +31,7 @@ func NewMockOneTimeRelayerSessionsManager(
 		Start(gomock.Eq(ctx)).
 		Times(1)
 	relayerSessionsManagerMock.EXPECT().
-		Stop().
+		Stop(gomock.Any()).
 		Times(1)
 	return relayerSessionsManagerMock
 }
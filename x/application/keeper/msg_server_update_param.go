@@ -8,6 +8,7 @@ import (
 	"google.golang.org/grpc/status"
 
 	apptypes "github.com/pokt-network/poktroll/x/application/types"
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
 )
 
 func (k msgServer) UpdateParam(ctx context.Context, msg *apptypes.MsgUpdateParam) (*apptypes.MsgUpdateParamResponse, error) {
@@ -79,6 +80,11 @@ func (k msgServer) UpdateParam(ctx context.Context, msg *apptypes.MsgUpdateParam
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	if err := sharedtypes.EmitParamsUpdatedEvent(ctx, apptypes.ModuleName); err != nil {
+		logger.Error(err.Error())
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
 	updatedParams := k.GetParams(ctx)
 
 	return &apptypes.MsgUpdateParamResponse{
@@ -8,6 +8,7 @@ import (
 	"google.golang.org/grpc/status"
 
 	"github.com/pokt-network/poktroll/x/gateway/types"
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
 )
 
 // UpdateParam updates a single parameter in the proof module and returns
@@ -57,6 +58,11 @@ func (k msgServer) UpdateParam(ctx context.Context, msg *types.MsgUpdateParam) (
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	if err := sharedtypes.EmitParamsUpdatedEvent(ctx, types.ModuleName); err != nil {
+		logger.Error(err.Error())
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
 	updatedParams := k.GetParams(ctx)
 
 	return &types.MsgUpdateParamResponse{
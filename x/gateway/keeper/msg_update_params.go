@@ -9,6 +9,7 @@ import (
 	"google.golang.org/grpc/status"
 
 	"github.com/pokt-network/poktroll/x/gateway/types"
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
 )
 
 func (k msgServer) UpdateParams(
@@ -37,5 +38,10 @@ func (k msgServer) UpdateParams(
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	if err := sharedtypes.EmitParamsUpdatedEvent(ctx, types.ModuleName); err != nil {
+		logger.Error(err.Error())
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
 	return &types.MsgUpdateParamsResponse{}, nil
 }
@@ -178,12 +178,16 @@ func TestMsgServer_ClaimMorseSupplier_SuccessNewSupplier(t *testing.T) {
 	require.Equal(t, *expectedMorseAccount, foundMorseAccount)
 
 	// Assert that an event is emitted for each claim.
+	// The supplier's empty histories are parsed from the event as empty slices.
+	expectedEventSupplier := expectedSupplier
+	expectedEventSupplier.JailHistory = []*sharedtypes.SupplierJailPeriod{}
+	expectedEventSupplier.DelegationCommissionHistory = []*sharedtypes.SupplierDelegationCommissionUpdate{}
 	expectedEvent := &migrationtypes.EventMorseSupplierClaimed{
 		MorseSrcAddress:      msgClaim.GetMorseSrcAddress(),
 		ClaimedBalance:       expectedClaimedUnstakedTokens,
 		ClaimedSupplierStake: supplierStake,
 		SessionEndHeight:     expectedSessionEndHeight,
-		Supplier:             &expectedSupplier,
+		Supplier:             &expectedEventSupplier,
 	}
 	claimEvents := events.FilterEvents[*migrationtypes.EventMorseSupplierClaimed](t, ctx.EventManager().Events())
 	require.Equal(t, 1, len(claimEvents))
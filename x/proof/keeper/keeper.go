@@ -74,6 +74,11 @@ func NewKeeper(
 	// and AccountKeeperQueryClient that are thin wrappers around the Application and
 	// Account keepers respectively to satisfy the RingClient needs.
 	//
+	// The RingClient caches the rings it constructs, but only reuses them when the
+	// ring addresses derived from the current application state are unchanged.
+	// This keeps the proof validation deterministic without invalidating the
+	// cache upon (un)delegations, which are not observable from the proof keeper.
+	//
 	// TODO_MAINNET(@red-0ne): Make ring signature verification a stateless
 	// function and get rid of the RingClient and its dependencies by moving
 	// application ring retrieval to the application keeper, and making it
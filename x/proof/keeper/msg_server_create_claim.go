@@ -79,11 +79,12 @@ func (k msgServer) CreateClaim(
 		return nil, status.Error(codes.Internal, types.ErrProofInvalidClaimRootHash.Wrapf("%v", err).Error())
 	}
 
-	// Get the number of compute units per relay for the service
-	serviceComputeUnitsPerRelay, err := k.getServiceComputeUnitsPerRelay(ctx, claim.SessionHeader.ServiceId)
+	// Get the service to retrieve its number of compute units per relay
+	service, err := k.getService(ctx, claim.SessionHeader.ServiceId)
 	if err != nil {
 		return nil, status.Error(codes.NotFound, types.ErrProofServiceNotFound.Wrapf("%v", err).Error())
 	}
+	serviceComputeUnitsPerRelay := service.ComputeUnitsPerRelay
 
 	// For a specific service, each relay costs the same amount.
 	// TODO_POST_MAINNET: Investigate ways of having request specific compute unit
@@ -115,7 +116,7 @@ func (k msgServer) CreateClaim(
 	serviceId := session.GetHeader().GetServiceId()
 	sharedParams := k.sharedKeeper.GetParams(ctx)
 	relayMiningDifficulty, _ := k.serviceKeeper.GetRelayMiningDifficulty(ctx, serviceId)
-	claimedUPOKT, err := claim.GetClaimeduPOKT(sharedParams, relayMiningDifficulty)
+	claimedUPOKT, err := claim.GetClaimeduPOKT(sharedParams, service, relayMiningDifficulty)
 
 	// Emit the appropriate event based on whether the claim was created or updated.
 	var claimUpsertEvent proto.Message
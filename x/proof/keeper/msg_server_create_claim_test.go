@@ -190,7 +190,7 @@ func TestMsgServer_CreateClaim_Success(t *testing.T) {
 			numEstimatedComputUnits, err := claim.GetNumEstimatedComputeUnits(relayMiningDifficulty)
 			require.NoError(t, err)
 
-			claimedUPOKT, err := claim.GetClaimeduPOKT(sharedParams, relayMiningDifficulty)
+			claimedUPOKT, err := claim.GetClaimeduPOKT(sharedParams, *service, relayMiningDifficulty)
 			require.NoError(t, err)
 
 			require.EqualValues(t, &claim, claimCreatedEvents[0].GetClaim())
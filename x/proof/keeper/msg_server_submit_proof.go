@@ -122,12 +122,16 @@ func (k msgServer) SubmitProof(
 		return nil, status.Error(codes.Internal, types.ErrProofInvalidClaimRootHash.Wrap(err.Error()).Error())
 	}
 
-	// Get the service ID relayMiningDifficulty to calculate the claimed uPOKT.
+	// Get the service and its relayMiningDifficulty to calculate the claimed uPOKT.
 	serviceId := sessionHeader.GetServiceId()
+	service, err := k.getService(ctx, serviceId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
 	sharedParams := k.sharedKeeper.GetParams(ctx)
 	relayMiningDifficulty, _ := k.serviceKeeper.GetRelayMiningDifficulty(ctx, serviceId)
 
-	claimedUPOKT, err := claim.GetClaimeduPOKT(sharedParams, relayMiningDifficulty)
+	claimedUPOKT, err := claim.GetClaimeduPOKT(sharedParams, service, relayMiningDifficulty)
 	numEstimatedComputUnits, err := claim.GetNumEstimatedComputeUnits(relayMiningDifficulty)
 
 	// Check if a prior proof already exists.
@@ -232,12 +236,16 @@ func (k Keeper) ProofRequirementForClaim(ctx context.Context, claim *types.Claim
 	sharedParams := k.sharedKeeper.GetParams(ctx)
 
 	serviceId := claim.GetSessionHeader().GetServiceId()
+	service, err := k.getService(ctx, serviceId)
+	if err != nil {
+		return requirementReason, err
+	}
 	relayMiningDifficulty, _ := k.serviceKeeper.GetRelayMiningDifficulty(ctx, serviceId)
 
 	// Retrieve the number of tokens claimed to compare against the threshold.
 	// Different services have varying compute_unit -> token multipliers, so the
 	// threshold value is done in a common unit denomination.
-	claimeduPOKT, err := claim.GetClaimeduPOKT(sharedParams, relayMiningDifficulty)
+	claimeduPOKT, err := claim.GetClaimeduPOKT(sharedParams, service, relayMiningDifficulty)
 	if err != nil {
 		return requirementReason, err
 	}
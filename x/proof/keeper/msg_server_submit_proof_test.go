@@ -247,7 +247,7 @@ func TestMsgServer_SubmitProof_Success(t *testing.T) {
 			numEstimatedComputUnits, err := claim.GetNumEstimatedComputeUnits(relayMiningDifficulty)
 			require.NoError(t, err)
 
-			claimedUPOKT, err := claim.GetClaimeduPOKT(sharedParams, relayMiningDifficulty)
+			claimedUPOKT, err := claim.GetClaimeduPOKT(sharedParams, *service, relayMiningDifficulty)
 			require.NoError(t, err)
 
 			require.EqualValues(t, claim, proofSubmittedEvent.GetClaim())
@@ -12,12 +12,20 @@ import (
 	tetsproof "github.com/pokt-network/poktroll/testutil/proof"
 	"github.com/pokt-network/poktroll/testutil/sample"
 	"github.com/pokt-network/poktroll/x/proof/types"
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
 )
 
 func TestKeeper_IsProofRequired(t *testing.T) {
 	keepers, ctx := keeper.NewProofModuleKeepers(t)
 	sdkCtx := cosmostypes.UnwrapSDKContext(ctx)
 
+	// Add the service which the test claims are created for.
+	keepers.SetService(ctx, sharedtypes.Service{
+		Id:                   tetsproof.DefaultTestServiceID,
+		ComputeUnitsPerRelay: 1,
+		OwnerAddress:         sample.AccAddress(),
+	})
+
 	proofParams := keepers.Keeper.GetParams(sdkCtx)
 	sharedParams := keepers.SharedKeeper.GetParams(sdkCtx)
 	// Set expected compute units to be below the proof requirement threshold to only
@@ -4,25 +4,25 @@ import (
 	"context"
 
 	"github.com/pokt-network/poktroll/x/proof/types"
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
 )
 
-// getServiceComputeUnitsPerRelay is used to ensure that a service with the ServiceID exists.
-// exists.
-// It returns the compute units per relay for the service with the given id.
-func (k Keeper) getServiceComputeUnitsPerRelay(
+// getService is used to ensure that a service with the ServiceID exists.
+// It returns the service with the given id.
+func (k Keeper) getService(
 	ctx context.Context,
 	serviceId string,
-) (uint64, error) {
-	logger := k.Logger().With("method", "getServiceComputeUnitsPerRelay")
+) (sharedtypes.Service, error) {
+	logger := k.Logger().With("method", "getService")
 
 	service, found := k.serviceKeeper.GetService(ctx, serviceId)
 	if !found {
-		return 0, types.ErrProofServiceNotFound.Wrapf("service %s not found", serviceId)
+		return sharedtypes.Service{}, types.ErrProofServiceNotFound.Wrapf("service %s not found", serviceId)
 	}
 
 	logger.
 		With("service_id", serviceId).
 		Debug("got service for proof")
 
-	return service.ComputeUnitsPerRelay, nil
+	return service, nil
 }
@@ -42,12 +42,22 @@ func (claim *Claim) GetNumEstimatedComputeUnits(
 	return new(big.Int).Div(numerator, denominator).Uint64(), nil
 }
 
-// GetClaimeduPOKT returns the claim's reward based on the relay mining difficulty
-// and global network parameters.
+// GetClaimeduPOKT returns the claim's reward based on the relay mining difficulty,
+// the service compute units to tokens multiplier and global network parameters.
 func (claim *Claim) GetClaimeduPOKT(
 	sharedParams sharedtypes.Params,
+	service sharedtypes.Service,
 	relayMiningDifficulty servicetypes.RelayMiningDifficulty,
 ) (sdk.Coin, error) {
+	// Ensure the claim's service ID matches the given service ID.
+	if claim.GetSessionHeader().GetServiceId() != service.GetId() {
+		return sdk.Coin{}, ErrProofServiceNotFound.Wrapf(
+			"claim service ID (%s) does not match the service ID (%s)",
+			claim.GetSessionHeader().GetServiceId(),
+			service.GetId(),
+		)
+	}
+
 	// Get the estimated number of compute units as a ratio to calculate the reward
 	// to avoid precision loss.
 	numEstimatedComputeUnitsRat, err := claim.getNumEstimatedComputeUnitsRat(relayMiningDifficulty)
@@ -55,9 +65,15 @@ func (claim *Claim) GetClaimeduPOKT(
 		return sdk.Coin{}, err
 	}
 
-	computeUnitsToTokenMultiplierRat := new(big.Rat).SetUint64(sharedParams.GetComputeUnitsToTokensMultiplier())
+	// CUTTM is either service specific or, if unset, a GLOBAL network wide parameter.
+	// It is the one which applies to the claim's session, regardless of the
+	// updates made after the session started.
+	computeUnitsToTokensMultiplier := sharedParams.GetServiceComputeUnitsToTokensMultiplier(
+		&service,
+		claim.GetSessionHeader().GetSessionStartBlockHeight(),
+	)
+	computeUnitsToTokenMultiplierRat := new(big.Rat).SetUint64(computeUnitsToTokensMultiplier)
 
-	// CUTTM is a GLOBAL network wide parameter.
 	upoktAmountRat := new(big.Rat).Mul(numEstimatedComputeUnitsRat, computeUnitsToTokenMultiplierRat)
 
 	// Perform the division as late as possible to minimize precision loss.
@@ -66,7 +82,7 @@ func (claim *Claim) GetClaimeduPOKT(
 		return sdk.Coin{}, ErrProofInvalidClaimedAmount.Wrapf(
 			"num estimated compute units (%s) * CUTTM (%d) resulted in a negative amount: %s",
 			numEstimatedComputeUnitsRat.RatString(),
-			sharedParams.GetComputeUnitsToTokensMultiplier(),
+			computeUnitsToTokensMultiplier,
 			upoktAmountRat,
 		)
 	}
@@ -58,6 +58,18 @@ func (k msgServer) AddService(
 		)
 	}
 
+	// Service owners can only set multipliers up to the governance defined maximum.
+	maxComputeUnitsToTokensMultiplier := k.GetParams(ctx).MaxComputeUnitsToTokensMultiplier
+	if msg.Service.ComputeUnitsToTokensMultiplier > maxComputeUnitsToTokensMultiplier {
+		return nil, status.Error(
+			codes.InvalidArgument,
+			types.ErrServiceInvalidComputeUnitsToTokensMultiplier.Wrapf(
+				"compute units to tokens multiplier %d exceeds the maximum %d",
+				msg.Service.ComputeUnitsToTokensMultiplier, maxComputeUnitsToTokensMultiplier,
+			).Error(),
+		)
+	}
+
 	// Retrieve the address of the actor adding the service; the owner of the service.
 	serviceOwnerAddr, err := sdk.AccAddressFromBech32(msg.OwnerAddress)
 	if err != nil {
@@ -213,3 +213,80 @@ func TestMsgServer_AddService(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgServer_AddService_ComputeUnitsToTokensMultiplier(t *testing.T) {
+	serviceOwnerAddr := sample.AccAddress()
+
+	tests := []struct {
+		desc              string
+		service           sharedtypes.Service
+		expectedErrString string
+	}{
+		{
+			desc: "valid - multiplier at the maximum",
+			service: sharedtypes.Service{
+				Id:                             "svc1",
+				Name:                           "service 1",
+				ComputeUnitsPerRelay:           1,
+				OwnerAddress:                   serviceOwnerAddr,
+				ComputeUnitsToTokensMultiplier: types.DefaultMaxComputeUnitsToTokensMultiplier,
+			},
+		},
+		{
+			desc: "invalid - multiplier exceeds the maximum",
+			service: sharedtypes.Service{
+				Id:                             "svc1",
+				Name:                           "service 1",
+				ComputeUnitsPerRelay:           1,
+				OwnerAddress:                   serviceOwnerAddr,
+				ComputeUnitsToTokensMultiplier: types.DefaultMaxComputeUnitsToTokensMultiplier + 1,
+			},
+			expectedErrString: types.ErrServiceInvalidComputeUnitsToTokensMultiplier.Wrapf(
+				"compute units to tokens multiplier %d exceeds the maximum %d",
+				types.DefaultMaxComputeUnitsToTokensMultiplier+1, types.DefaultMaxComputeUnitsToTokensMultiplier,
+			).Error(),
+		},
+		{
+			desc: "invalid - multiplier history is set",
+			service: sharedtypes.Service{
+				Id:                             "svc1",
+				Name:                           "service 1",
+				ComputeUnitsPerRelay:           1,
+				OwnerAddress:                   serviceOwnerAddr,
+				ComputeUnitsToTokensMultiplier: 7,
+				ComputeUnitsToTokensMultiplierHistory: []*sharedtypes.ServiceComputeUnitsToTokensMultiplierUpdate{
+					{ComputeUnitsToTokensMultiplier: 1, EffectiveBlockHeight: 0},
+					{ComputeUnitsToTokensMultiplier: 7, EffectiveBlockHeight: 1},
+				},
+			},
+			expectedErrString: types.ErrServiceInvalidComputeUnitsToTokensMultiplier.Wrap(
+				"compute units to tokens multiplier history cannot be set when adding a service",
+			).Error(),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			k, ctx := keepertest.ServiceKeeper(t)
+			srv := keeper.NewMsgServerImpl(k)
+			keepertest.AddAccToAccMapCoins(t, serviceOwnerAddr, volatile.DenomuPOKT, oneUPOKTGreaterThanFee)
+
+			_, err := srv.AddService(ctx, &types.MsgAddService{
+				OwnerAddress: serviceOwnerAddr,
+				Service:      test.service,
+			})
+			if test.expectedErrString != "" {
+				require.ErrorContains(t, err, test.expectedErrString)
+
+				_, found := k.GetService(ctx, test.service.Id)
+				require.False(t, found)
+				return
+			}
+			require.NoError(t, err)
+
+			serviceFound, found := k.GetService(ctx, test.service.Id)
+			require.True(t, found)
+			require.Equal(t, test.service, serviceFound)
+		})
+	}
+}
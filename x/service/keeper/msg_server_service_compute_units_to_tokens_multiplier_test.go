@@ -0,0 +1,264 @@
+package keeper_test
+
+import (
+	"testing"
+
+	cosmostypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	testevents "github.com/pokt-network/poktroll/testutil/events"
+	keepertest "github.com/pokt-network/poktroll/testutil/keeper"
+	"github.com/pokt-network/poktroll/testutil/sample"
+	"github.com/pokt-network/poktroll/x/service/keeper"
+	"github.com/pokt-network/poktroll/x/service/types"
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
+)
+
+// The height at which the multipliers are updated, in the middle of the first session.
+const testUpdateHeight = int64(5)
+
+var (
+	sharedParams              = sharedtypes.DefaultParams()
+	currentSessionStartHeight = sharedtypes.GetSessionStartHeight(&sharedParams, testUpdateHeight)
+	nextSessionStartHeight    = sharedtypes.GetNextSessionStartHeight(&sharedParams, testUpdateHeight)
+)
+
+func TestMsgServer_SetServiceComputeUnitsToTokensMultiplier(t *testing.T) {
+	serviceOwnerAddr := sample.AccAddress()
+	service := sharedtypes.Service{
+		Id:                   "svc1",
+		Name:                 "service 1",
+		ComputeUnitsPerRelay: 1,
+		OwnerAddress:         serviceOwnerAddr,
+	}
+
+	tests := []struct {
+		desc                           string
+		signerAddr                     string
+		serviceId                      string
+		computeUnitsToTokensMultiplier uint64
+		expectedErr                    error
+		expectedCode                   codes.Code
+	}{
+		{
+			desc:                           "valid - service owner sets the multiplier",
+			signerAddr:                     serviceOwnerAddr,
+			serviceId:                      service.Id,
+			computeUnitsToTokensMultiplier: 42,
+		},
+		{
+			desc:                           "valid - service owner resets the multiplier to the global shared param",
+			signerAddr:                     serviceOwnerAddr,
+			serviceId:                      service.Id,
+			computeUnitsToTokensMultiplier: 0,
+		},
+		{
+			desc:                           "valid - service owner sets the maximum multiplier",
+			signerAddr:                     serviceOwnerAddr,
+			serviceId:                      service.Id,
+			computeUnitsToTokensMultiplier: types.DefaultMaxComputeUnitsToTokensMultiplier,
+		},
+		{
+			desc:                           "invalid - multiplier exceeds the maximum",
+			signerAddr:                     serviceOwnerAddr,
+			serviceId:                      service.Id,
+			computeUnitsToTokensMultiplier: types.DefaultMaxComputeUnitsToTokensMultiplier + 1,
+			expectedErr:                    types.ErrServiceInvalidComputeUnitsToTokensMultiplier,
+			expectedCode:                   codes.InvalidArgument,
+		},
+		{
+			desc:                           "invalid - signer is not the service owner",
+			signerAddr:                     sample.AccAddress(),
+			serviceId:                      service.Id,
+			computeUnitsToTokensMultiplier: 42,
+			expectedErr:                    types.ErrServiceInvalidOwnerAddress,
+			expectedCode:                   codes.PermissionDenied,
+		},
+		{
+			desc:                           "invalid - service not found",
+			signerAddr:                     serviceOwnerAddr,
+			serviceId:                      "svc2",
+			computeUnitsToTokensMultiplier: 42,
+			expectedErr:                    types.ErrServiceNotFound,
+			expectedCode:                   codes.NotFound,
+		},
+		{
+			desc:                           "invalid - invalid signer address",
+			signerAddr:                     "invalid_address",
+			serviceId:                      service.Id,
+			computeUnitsToTokensMultiplier: 42,
+			expectedErr:                    types.ErrServiceInvalidAddress,
+			expectedCode:                   codes.InvalidArgument,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			k, ctx := keepertest.ServiceKeeper(t)
+			ctx = cosmostypes.UnwrapSDKContext(ctx).WithBlockHeight(testUpdateHeight)
+			srv := keeper.NewMsgServerImpl(k)
+
+			// Start from a service which already has a non-default multiplier.
+			initialService := service
+			initialService.ComputeUnitsToTokensMultiplier = 7
+			k.SetService(ctx, initialService)
+
+			msg := types.NewMsgSetServiceComputeUnitsToTokensMultiplier(
+				test.signerAddr,
+				test.serviceId,
+				test.computeUnitsToTokensMultiplier,
+			)
+			res, err := srv.SetServiceComputeUnitsToTokensMultiplier(ctx, msg)
+			if test.expectedErr != nil {
+				require.ErrorContains(t, err, test.expectedErr.Error())
+				require.Equal(t, test.expectedCode, status.Code(err))
+
+				// Ensure the service was not updated.
+				foundService, found := k.GetService(ctx, initialService.Id)
+				require.True(t, found)
+				require.Equal(t, initialService, foundService)
+				return
+			}
+			require.NoError(t, err)
+
+			expectedService := initialService
+			expectedService.SetComputeUnitsToTokensMultiplier(
+				test.computeUnitsToTokensMultiplier,
+				nextSessionStartHeight,
+				0,
+			)
+			require.Equal(t, &expectedService, res.GetService())
+
+			foundService, found := k.GetService(ctx, initialService.Id)
+			require.True(t, found)
+			require.Equal(t, expectedService, foundService)
+
+			// The update only applies from the next session.
+			require.Equal(t,
+				initialService.ComputeUnitsToTokensMultiplier,
+				foundService.GetComputeUnitsToTokensMultiplierAtHeight(currentSessionStartHeight),
+			)
+			require.Equal(t,
+				test.computeUnitsToTokensMultiplier,
+				foundService.GetComputeUnitsToTokensMultiplierAtHeight(nextSessionStartHeight),
+			)
+
+			events := cosmostypes.UnwrapSDKContext(ctx).EventManager().Events()
+			updatedEvents := testevents.FilterEvents[*types.EventServiceComputeUnitsToTokensMultiplierUpdated](t, events)
+			require.Len(t, updatedEvents, 1)
+			require.Equal(t, &types.EventServiceComputeUnitsToTokensMultiplierUpdated{
+				ServiceId:                          initialService.Id,
+				PrevComputeUnitsToTokensMultiplier: initialService.ComputeUnitsToTokensMultiplier,
+				NewComputeUnitsToTokensMultiplier:  test.computeUnitsToTokensMultiplier,
+				EffectiveBlockHeight:               nextSessionStartHeight,
+			}, updatedEvents[0])
+		})
+	}
+}
+
+func TestMsgServer_UpdateServiceComputeUnitsToTokensMultiplier(t *testing.T) {
+	service := sharedtypes.Service{
+		Id:                   "svc1",
+		Name:                 "service 1",
+		ComputeUnitsPerRelay: 1,
+		OwnerAddress:         sample.AccAddress(),
+	}
+
+	tests := []struct {
+		desc                           string
+		useModuleAuthority             bool
+		serviceId                      string
+		computeUnitsToTokensMultiplier uint64
+		expectedErr                    error
+		expectedCode                   codes.Code
+	}{
+		{
+			desc:                           "valid - authority updates the multiplier",
+			useModuleAuthority:             true,
+			serviceId:                      service.Id,
+			computeUnitsToTokensMultiplier: 42,
+		},
+		{
+			desc:                           "invalid - signer is not the module authority",
+			useModuleAuthority:             false,
+			serviceId:                      service.Id,
+			computeUnitsToTokensMultiplier: 42,
+			expectedErr:                    types.ErrServiceInvalidSigner,
+			expectedCode:                   codes.PermissionDenied,
+		},
+		{
+			desc:                           "invalid - service not found",
+			useModuleAuthority:             true,
+			serviceId:                      "svc2",
+			computeUnitsToTokensMultiplier: 42,
+			expectedErr:                    types.ErrServiceNotFound,
+			expectedCode:                   codes.NotFound,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			k, ctx := keepertest.ServiceKeeper(t)
+			ctx = cosmostypes.UnwrapSDKContext(ctx).WithBlockHeight(testUpdateHeight)
+			srv := keeper.NewMsgServerImpl(k)
+			k.SetService(ctx, service)
+
+			authority := sample.AccAddress()
+			if test.useModuleAuthority {
+				authority = k.GetAuthority()
+			}
+
+			msg := types.NewMsgUpdateServiceComputeUnitsToTokensMultiplier(
+				authority,
+				test.serviceId,
+				test.computeUnitsToTokensMultiplier,
+			)
+			res, err := srv.UpdateServiceComputeUnitsToTokensMultiplier(ctx, msg)
+			if test.expectedErr != nil {
+				require.ErrorContains(t, err, test.expectedErr.Error())
+				require.Equal(t, test.expectedCode, status.Code(err))
+
+				// Ensure the service was not updated.
+				foundService, found := k.GetService(ctx, service.Id)
+				require.True(t, found)
+				require.Equal(t, service, foundService)
+				return
+			}
+			require.NoError(t, err)
+
+			expectedService := service
+			expectedService.SetComputeUnitsToTokensMultiplier(
+				test.computeUnitsToTokensMultiplier,
+				nextSessionStartHeight,
+				0,
+			)
+			require.Equal(t, &expectedService, res.GetService())
+
+			foundService, found := k.GetService(ctx, service.Id)
+			require.True(t, found)
+			require.Equal(t, expectedService, foundService)
+
+			// The update only applies from the next session.
+			require.Equal(t,
+				service.ComputeUnitsToTokensMultiplier,
+				foundService.GetComputeUnitsToTokensMultiplierAtHeight(currentSessionStartHeight),
+			)
+			require.Equal(t,
+				test.computeUnitsToTokensMultiplier,
+				foundService.GetComputeUnitsToTokensMultiplierAtHeight(nextSessionStartHeight),
+			)
+
+			events := cosmostypes.UnwrapSDKContext(ctx).EventManager().Events()
+			updatedEvents := testevents.FilterEvents[*types.EventServiceComputeUnitsToTokensMultiplierUpdated](t, events)
+			require.Len(t, updatedEvents, 1)
+			require.Equal(t, &types.EventServiceComputeUnitsToTokensMultiplierUpdated{
+				ServiceId:                          service.Id,
+				PrevComputeUnitsToTokensMultiplier: 0,
+				NewComputeUnitsToTokensMultiplier:  test.computeUnitsToTokensMultiplier,
+				EffectiveBlockHeight:               nextSessionStartHeight,
+			}, updatedEvents[0])
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/pokt-network/poktroll/telemetry"
+	"github.com/pokt-network/poktroll/x/service/types"
+)
+
+// SetServiceComputeUnitsToTokensMultiplier updates the compute units to tokens
+// multiplier of a service. Only the service owner is allowed to update it, up to
+// the max_compute_units_to_tokens_multiplier service param.
+func (k msgServer) SetServiceComputeUnitsToTokensMultiplier(
+	ctx context.Context,
+	msg *types.MsgSetServiceComputeUnitsToTokensMultiplier,
+) (*types.MsgSetServiceComputeUnitsToTokensMultiplierResponse, error) {
+	isSuccessful := false
+	defer telemetry.EventSuccessCounter(
+		"set_service_compute_units_to_tokens_multiplier",
+		telemetry.DefaultCounterFn,
+		func() bool { return isSuccessful },
+	)
+
+	logger := k.Logger().With("method", "SetServiceComputeUnitsToTokensMultiplier")
+
+	if err := msg.ValidateBasic(); err != nil {
+		logger.Error(fmt.Sprintf("Setting service compute units to tokens multiplier failed basic validation: %v", err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	service, found := k.GetService(ctx, msg.ServiceId)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			types.ErrServiceNotFound.Wrapf("service %q not found", msg.ServiceId).Error(),
+		)
+	}
+
+	if service.OwnerAddress != msg.OwnerAddress {
+		return nil, status.Error(
+			codes.PermissionDenied,
+			types.ErrServiceInvalidOwnerAddress.Wrapf(
+				"signer %q is not the owner %q of service %q",
+				msg.OwnerAddress, service.OwnerAddress, msg.ServiceId,
+			).Error(),
+		)
+	}
+
+	// Service owners can only set multipliers up to the governance defined maximum.
+	maxComputeUnitsToTokensMultiplier := k.GetParams(ctx).MaxComputeUnitsToTokensMultiplier
+	if msg.ComputeUnitsToTokensMultiplier > maxComputeUnitsToTokensMultiplier {
+		return nil, status.Error(
+			codes.InvalidArgument,
+			types.ErrServiceInvalidComputeUnitsToTokensMultiplier.Wrapf(
+				"compute units to tokens multiplier %d exceeds the maximum %d",
+				msg.ComputeUnitsToTokensMultiplier, maxComputeUnitsToTokensMultiplier,
+			).Error(),
+		)
+	}
+
+	updatedService, err := k.setServiceComputeUnitsToTokensMultiplier(ctx, service, msg.ComputeUnitsToTokensMultiplier)
+	if err != nil {
+		logger.Error(fmt.Sprintf("Failed to set service compute units to tokens multiplier: %v", err))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	logger.Info(fmt.Sprintf(
+		"Set the compute units to tokens multiplier of service %q to %d",
+		msg.ServiceId, msg.ComputeUnitsToTokensMultiplier,
+	))
+
+	isSuccessful = true
+	return &types.MsgSetServiceComputeUnitsToTokensMultiplierResponse{
+		Service: updatedService,
+	}, nil
+}
@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/pokt-network/poktroll/telemetry"
+	"github.com/pokt-network/poktroll/x/service/types"
+)
+
+// SetServiceOwnerRevSharePercentage updates the percentage of the settled claims
+// of a service which is routed to its owner. Only the service owner is allowed to
+// update it, and the effective percentage is capped by the governance controlled
+// max_rev_share_percentage of the TLM_SERVICE_OWNER_REV_SHARE token logic module.
+// The update applies to the sessions starting after it.
+func (k msgServer) SetServiceOwnerRevSharePercentage(
+	ctx context.Context,
+	msg *types.MsgSetServiceOwnerRevSharePercentage,
+) (*types.MsgSetServiceOwnerRevSharePercentageResponse, error) {
+	isSuccessful := false
+	defer telemetry.EventSuccessCounter(
+		"set_service_owner_rev_share_percentage",
+		telemetry.DefaultCounterFn,
+		func() bool { return isSuccessful },
+	)
+
+	logger := k.Logger().With("method", "SetServiceOwnerRevSharePercentage")
+
+	if err := msg.ValidateBasic(); err != nil {
+		logger.Error(fmt.Sprintf("Setting service owner rev share percentage failed basic validation: %v", err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	service, found := k.GetService(ctx, msg.ServiceId)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			types.ErrServiceNotFound.Wrapf("service %q not found", msg.ServiceId).Error(),
+		)
+	}
+
+	if service.OwnerAddress != msg.OwnerAddress {
+		return nil, status.Error(
+			codes.PermissionDenied,
+			types.ErrServiceInvalidOwnerAddress.Wrapf(
+				"signer %q is not the owner %q of service %q",
+				msg.OwnerAddress, service.OwnerAddress, msg.ServiceId,
+			).Error(),
+		)
+	}
+
+	updatedService, err := k.setServiceOwnerRevSharePercentage(ctx, service, msg.OwnerRevSharePercentage)
+	if err != nil {
+		logger.Error(fmt.Sprintf("Failed to set service owner rev share percentage: %v", err))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	logger.Info(fmt.Sprintf(
+		"Set the owner rev share percentage of service %q to %d",
+		msg.ServiceId, msg.OwnerRevSharePercentage,
+	))
+
+	isSuccessful = true
+	return &types.MsgSetServiceOwnerRevSharePercentageResponse{
+		Service: updatedService,
+	}, nil
+}
@@ -0,0 +1,144 @@
+package keeper_test
+
+import (
+	"testing"
+
+	cosmostypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	testevents "github.com/pokt-network/poktroll/testutil/events"
+	keepertest "github.com/pokt-network/poktroll/testutil/keeper"
+	"github.com/pokt-network/poktroll/testutil/sample"
+	"github.com/pokt-network/poktroll/x/service/keeper"
+	"github.com/pokt-network/poktroll/x/service/types"
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
+)
+
+func TestMsgServer_SetServiceOwnerRevSharePercentage(t *testing.T) {
+	serviceOwnerAddr := sample.AccAddress()
+	service := sharedtypes.Service{
+		Id:                   "svc1",
+		Name:                 "service 1",
+		ComputeUnitsPerRelay: 1,
+		OwnerAddress:         serviceOwnerAddr,
+	}
+
+	tests := []struct {
+		desc                    string
+		signerAddr              string
+		serviceId               string
+		ownerRevSharePercentage uint64
+		expectedErr             error
+		expectedCode            codes.Code
+	}{
+		{
+			desc:                    "valid - service owner sets the rev share percentage",
+			signerAddr:              serviceOwnerAddr,
+			serviceId:               service.Id,
+			ownerRevSharePercentage: 42,
+		},
+		{
+			desc:                    "valid - service owner disables its rev share",
+			signerAddr:              serviceOwnerAddr,
+			serviceId:               service.Id,
+			ownerRevSharePercentage: 0,
+		},
+		{
+			desc:                    "invalid - signer is not the service owner",
+			signerAddr:              sample.AccAddress(),
+			serviceId:               service.Id,
+			ownerRevSharePercentage: 42,
+			expectedErr:             types.ErrServiceInvalidOwnerAddress,
+			expectedCode:            codes.PermissionDenied,
+		},
+		{
+			desc:                    "invalid - service not found",
+			signerAddr:              serviceOwnerAddr,
+			serviceId:               "svc2",
+			ownerRevSharePercentage: 42,
+			expectedErr:             types.ErrServiceNotFound,
+			expectedCode:            codes.NotFound,
+		},
+		{
+			desc:                    "invalid - owner rev share percentage greater than 100",
+			signerAddr:              serviceOwnerAddr,
+			serviceId:               service.Id,
+			ownerRevSharePercentage: 101,
+			expectedErr:             sharedtypes.ErrSharedInvalidRevShare,
+			expectedCode:            codes.InvalidArgument,
+		},
+		{
+			desc:                    "invalid - invalid signer address",
+			signerAddr:              "invalid_address",
+			serviceId:               service.Id,
+			ownerRevSharePercentage: 42,
+			expectedErr:             types.ErrServiceInvalidAddress,
+			expectedCode:            codes.InvalidArgument,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			k, ctx := keepertest.ServiceKeeper(t)
+			ctx = cosmostypes.UnwrapSDKContext(ctx).WithBlockHeight(testUpdateHeight)
+			srv := keeper.NewMsgServerImpl(k)
+
+			// Start from a service which already has a non-zero owner rev share percentage.
+			initialService := service
+			initialService.OwnerRevSharePercentage = 7
+			k.SetService(ctx, initialService)
+
+			msg := types.NewMsgSetServiceOwnerRevSharePercentage(
+				test.signerAddr,
+				test.serviceId,
+				test.ownerRevSharePercentage,
+			)
+			res, err := srv.SetServiceOwnerRevSharePercentage(ctx, msg)
+			if test.expectedErr != nil {
+				require.ErrorContains(t, err, test.expectedErr.Error())
+				require.Equal(t, test.expectedCode, status.Code(err))
+
+				// Ensure the service was not updated.
+				foundService, found := k.GetService(ctx, initialService.Id)
+				require.True(t, found)
+				require.Equal(t, initialService, foundService)
+				return
+			}
+			require.NoError(t, err)
+
+			expectedService := initialService
+			expectedService.SetOwnerRevSharePercentage(
+				test.ownerRevSharePercentage,
+				nextSessionStartHeight,
+				0,
+			)
+			require.Equal(t, &expectedService, res.GetService())
+
+			foundService, found := k.GetService(ctx, initialService.Id)
+			require.True(t, found)
+			require.Equal(t, expectedService, foundService)
+
+			// The update only applies from the next session.
+			require.Equal(t,
+				initialService.OwnerRevSharePercentage,
+				foundService.GetOwnerRevSharePercentageAtHeight(currentSessionStartHeight),
+			)
+			require.Equal(t,
+				test.ownerRevSharePercentage,
+				foundService.GetOwnerRevSharePercentageAtHeight(nextSessionStartHeight),
+			)
+
+			events := cosmostypes.UnwrapSDKContext(ctx).EventManager().Events()
+			updatedEvents := testevents.FilterEvents[*types.EventServiceOwnerRevSharePercentageUpdated](t, events)
+			require.Len(t, updatedEvents, 1)
+			require.Equal(t, &types.EventServiceOwnerRevSharePercentageUpdated{
+				ServiceId:                   initialService.Id,
+				PrevOwnerRevSharePercentage: initialService.OwnerRevSharePercentage,
+				NewOwnerRevSharePercentage:  test.ownerRevSharePercentage,
+				EffectiveBlockHeight:        nextSessionStartHeight,
+			}, updatedEvents[0])
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"google.golang.org/grpc/status"
 
 	servicetypes "github.com/pokt-network/poktroll/x/service/types"
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
 )
 
 // UpdateParam updates a single parameter in the service module and returns
@@ -43,6 +44,9 @@ func (k msgServer) UpdateParam(
 	case servicetypes.ParamTargetNumRelays:
 		logger = logger.With("param_value", msg.GetAsUint64())
 		params.TargetNumRelays = msg.GetAsUint64()
+	case servicetypes.ParamMaxComputeUnitsToTokensMultiplier:
+		logger = logger.With("param_value", msg.GetAsUint64())
+		params.MaxComputeUnitsToTokensMultiplier = msg.GetAsUint64()
 	default:
 		return nil, status.Error(
 			codes.InvalidArgument,
@@ -61,6 +65,11 @@ func (k msgServer) UpdateParam(
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	if err := sharedtypes.EmitParamsUpdatedEvent(ctx, servicetypes.ModuleName); err != nil {
+		logger.Error(err.Error())
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
 	updatedParams := k.GetParams(ctx)
 
 	return &servicetypes.MsgUpdateParamResponse{
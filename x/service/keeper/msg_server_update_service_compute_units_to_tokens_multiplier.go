@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/pokt-network/poktroll/x/service/types"
+)
+
+// UpdateServiceComputeUnitsToTokensMultiplier updates the compute units to tokens
+// multiplier of any service. Only the module authority (i.e. governance) is allowed
+// to update it.
+func (k msgServer) UpdateServiceComputeUnitsToTokensMultiplier(
+	ctx context.Context,
+	msg *types.MsgUpdateServiceComputeUnitsToTokensMultiplier,
+) (*types.MsgUpdateServiceComputeUnitsToTokensMultiplierResponse, error) {
+	logger := k.Logger().With("method", "UpdateServiceComputeUnitsToTokensMultiplier")
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if k.GetAuthority() != msg.Authority {
+		return nil, status.Error(
+			codes.PermissionDenied,
+			types.ErrServiceInvalidSigner.Wrapf(
+				"invalid authority; expected %s, got %s", k.GetAuthority(), msg.Authority,
+			).Error(),
+		)
+	}
+
+	service, found := k.GetService(ctx, msg.ServiceId)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			types.ErrServiceNotFound.Wrapf("service %q not found", msg.ServiceId).Error(),
+		)
+	}
+
+	updatedService, err := k.setServiceComputeUnitsToTokensMultiplier(ctx, service, msg.ComputeUnitsToTokensMultiplier)
+	if err != nil {
+		logger.Error(fmt.Sprintf("Failed to update service compute units to tokens multiplier: %v", err))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	logger.Info(fmt.Sprintf(
+		"Updated the compute units to tokens multiplier of service %q to %d",
+		msg.ServiceId, msg.ComputeUnitsToTokensMultiplier,
+	))
+
+	return &types.MsgUpdateServiceComputeUnitsToTokensMultiplierResponse{
+		Service: updatedService,
+	}, nil
+}
@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/pokt-network/poktroll/x/service/types"
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
+)
+
+// setServiceComputeUnitsToTokensMultiplier updates the compute units to tokens
+// multiplier of the given service and emits an
+// EventServiceComputeUnitsToTokensMultiplierUpdated event.
+// The update takes effect from the next session so that the relays already served
+// in the current session, and the claims of the pending sessions, are settled at
+// the multiplier of their own session.
+func (k Keeper) setServiceComputeUnitsToTokensMultiplier(
+	ctx context.Context,
+	service sharedtypes.Service,
+	computeUnitsToTokensMultiplier uint64,
+) (*sharedtypes.Service, error) {
+	effectiveHeight, minRetainedHeight := k.getServiceUpdateHeights(ctx)
+
+	prevComputeUnitsToTokensMultiplier := service.ComputeUnitsToTokensMultiplier
+	service.SetComputeUnitsToTokensMultiplier(computeUnitsToTokensMultiplier, effectiveHeight, minRetainedHeight)
+	k.SetService(ctx, service)
+
+	multiplierUpdatedEvent := &types.EventServiceComputeUnitsToTokensMultiplierUpdated{
+		ServiceId:                          service.Id,
+		PrevComputeUnitsToTokensMultiplier: prevComputeUnitsToTokensMultiplier,
+		NewComputeUnitsToTokensMultiplier:  computeUnitsToTokensMultiplier,
+		EffectiveBlockHeight:               effectiveHeight,
+	}
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(multiplierUpdatedEvent); err != nil {
+		return nil, err
+	}
+
+	return &service, nil
+}
+
+// getServiceUpdateHeights returns the start height of the next session, from which
+// a service update made at the current height applies, and the minimum height of
+// the sessions whose updates must be retained because they may still be claimed
+// or settled, with an additional session of margin.
+func (k Keeper) getServiceUpdateHeights(ctx context.Context) (effectiveHeight, minRetainedHeight int64) {
+	currentHeight := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	sharedParams := k.sharedKeeper.GetParams(ctx)
+
+	effectiveHeight = sharedtypes.GetNextSessionStartHeight(&sharedParams, currentHeight)
+
+	numBlocksPerSession := int64(sharedParams.GetNumBlocksPerSession())
+	numRetainedSessions := sharedtypes.GetNumPendingSessions(&sharedParams) + 1
+	minRetainedHeight = sharedtypes.GetSessionStartHeight(&sharedParams, currentHeight) -
+		numRetainedSessions*numBlocksPerSession
+	if minRetainedHeight < 0 {
+		minRetainedHeight = 0
+	}
+
+	return effectiveHeight, minRetainedHeight
+}
@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/pokt-network/poktroll/x/service/types"
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
+)
+
+// setServiceOwnerRevSharePercentage updates the owner rev share percentage of
+// the given service and emits an EventServiceOwnerRevSharePercentageUpdated event.
+// The update takes effect from the next session so that the claims of the current
+// and pending sessions are settled with the percentage of their own session.
+func (k Keeper) setServiceOwnerRevSharePercentage(
+	ctx context.Context,
+	service sharedtypes.Service,
+	ownerRevSharePercentage uint64,
+) (*sharedtypes.Service, error) {
+	effectiveHeight, minRetainedHeight := k.getServiceUpdateHeights(ctx)
+
+	prevOwnerRevSharePercentage := service.OwnerRevSharePercentage
+	service.SetOwnerRevSharePercentage(ownerRevSharePercentage, effectiveHeight, minRetainedHeight)
+	k.SetService(ctx, service)
+
+	revShareUpdatedEvent := &types.EventServiceOwnerRevSharePercentageUpdated{
+		ServiceId:                   service.Id,
+		PrevOwnerRevSharePercentage: prevOwnerRevSharePercentage,
+		NewOwnerRevSharePercentage:  ownerRevSharePercentage,
+		EffectiveBlockHeight:        effectiveHeight,
+	}
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(revShareUpdatedEvent); err != nil {
+		return nil, err
+	}
+
+	return &service, nil
+}
@@ -91,6 +91,38 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						// {ProtoField: "computeUnitsPerRelay"},
 					},
 				},
+				{
+					RpcMethod: "SetServiceComputeUnitsToTokensMultiplier",
+					Use:       "set-service-compute-units-to-tokens-multiplier <service-id> <compute-units-to-tokens-multiplier>",
+					Short:     "Set the compute units to tokens multiplier of an owned service.",
+					Long: `
+- Set the amount of uPOKT a compute unit of the service translates to:
+  - <service-id>: the id of a service owned by the signer
+  - <compute-units-to-tokens-multiplier>: integer value; 0 to use the global compute_units_to_tokens_multiplier shared param`,
+					Example: `pocketd tx service set-service-compute-units-to-tokens-multiplier svc-foo 42 --fees 300upokt --from foo`,
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "service_id"},
+						{ProtoField: "compute_units_to_tokens_multiplier"},
+					},
+				},
+				{
+					RpcMethod: "UpdateServiceComputeUnitsToTokensMultiplier",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "SetServiceOwnerRevSharePercentage",
+					Use:       "set-service-owner-rev-share-percentage <service-id> <owner-rev-share-percentage>",
+					Short:     "Set the percentage of the settled claims of an owned service which is routed to its owner.",
+					Long: `
+- Set the service owner revenue share, capped by the governance controlled max of the TLM_SERVICE_OWNER_REV_SHARE token logic module:
+  - <service-id>: the id of a service owned by the signer
+  - <owner-rev-share-percentage>: integer value between 0 and 100`,
+					Example: `pocketd tx service set-service-owner-rev-share-percentage svc-foo 5 --fees 300upokt --from foo`,
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "service_id"},
+						{ProtoField: "owner_rev_share_percentage"},
+					},
+				},
 				// this line is used by ignite scaffolding # autocli/tx
 			},
 		},
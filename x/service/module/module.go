@@ -177,6 +177,7 @@ type ModuleInputs struct {
 
 	AccountKeeper types.AccountKeeper
 	BankKeeper    types.BankKeeper
+	SharedKeeper  types.SharedKeeper
 }
 
 type ModuleOutputs struct {
@@ -198,6 +199,7 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		in.Logger,
 		authority.String(),
 		in.BankKeeper,
+		in.SharedKeeper,
 	)
 	m := NewAppModule(
 		in.Cdc,
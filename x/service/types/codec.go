@@ -11,6 +11,15 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgAddService{},
 	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSetServiceComputeUnitsToTokensMultiplier{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgUpdateServiceComputeUnitsToTokensMultiplier{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSetServiceOwnerRevSharePercentage{},
+	)
 	// this line is used by starport scaffolding # 3
 
 	registry.RegisterImplementations((*sdk.Msg)(nil),
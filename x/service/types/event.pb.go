@@ -97,35 +97,190 @@ func (m *EventRelayMiningDifficultyUpdated) GetNewNumRelaysEma() uint64 {
 	return 0
 }
 
+// EventServiceComputeUnitsToTokensMultiplierUpdated is an event emitted whenever
+// the compute units to tokens multiplier of a service is updated.
+type EventServiceComputeUnitsToTokensMultiplierUpdated struct {
+	ServiceId                          string `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	PrevComputeUnitsToTokensMultiplier uint64 `protobuf:"varint,2,opt,name=prev_compute_units_to_tokens_multiplier,json=prevComputeUnitsToTokensMultiplier,proto3" json:"prev_compute_units_to_tokens_multiplier,omitempty"`
+	NewComputeUnitsToTokensMultiplier  uint64 `protobuf:"varint,3,opt,name=new_compute_units_to_tokens_multiplier,json=newComputeUnitsToTokensMultiplier,proto3" json:"new_compute_units_to_tokens_multiplier,omitempty"`
+	// The start height of the first session settled with the new multiplier.
+	EffectiveBlockHeight int64 `protobuf:"varint,4,opt,name=effective_block_height,json=effectiveBlockHeight,proto3" json:"effective_block_height,omitempty"`
+}
+
+func (m *EventServiceComputeUnitsToTokensMultiplierUpdated) Reset() {
+	*m = EventServiceComputeUnitsToTokensMultiplierUpdated{}
+}
+func (m *EventServiceComputeUnitsToTokensMultiplierUpdated) String() string {
+	return proto.CompactTextString(m)
+}
+func (*EventServiceComputeUnitsToTokensMultiplierUpdated) ProtoMessage() {}
+func (*EventServiceComputeUnitsToTokensMultiplierUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a38747b533ead694, []int{1}
+}
+func (m *EventServiceComputeUnitsToTokensMultiplierUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventServiceComputeUnitsToTokensMultiplierUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *EventServiceComputeUnitsToTokensMultiplierUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventServiceComputeUnitsToTokensMultiplierUpdated.Merge(m, src)
+}
+func (m *EventServiceComputeUnitsToTokensMultiplierUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventServiceComputeUnitsToTokensMultiplierUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventServiceComputeUnitsToTokensMultiplierUpdated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventServiceComputeUnitsToTokensMultiplierUpdated proto.InternalMessageInfo
+
+func (m *EventServiceComputeUnitsToTokensMultiplierUpdated) GetServiceId() string {
+	if m != nil {
+		return m.ServiceId
+	}
+	return ""
+}
+
+func (m *EventServiceComputeUnitsToTokensMultiplierUpdated) GetPrevComputeUnitsToTokensMultiplier() uint64 {
+	if m != nil {
+		return m.PrevComputeUnitsToTokensMultiplier
+	}
+	return 0
+}
+
+func (m *EventServiceComputeUnitsToTokensMultiplierUpdated) GetNewComputeUnitsToTokensMultiplier() uint64 {
+	if m != nil {
+		return m.NewComputeUnitsToTokensMultiplier
+	}
+	return 0
+}
+
+func (m *EventServiceComputeUnitsToTokensMultiplierUpdated) GetEffectiveBlockHeight() int64 {
+	if m != nil {
+		return m.EffectiveBlockHeight
+	}
+	return 0
+}
+
+// EventServiceOwnerRevSharePercentageUpdated is an event emitted whenever
+// the owner rev share percentage of a service is updated.
+type EventServiceOwnerRevSharePercentageUpdated struct {
+	ServiceId                   string `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	PrevOwnerRevSharePercentage uint64 `protobuf:"varint,2,opt,name=prev_owner_rev_share_percentage,json=prevOwnerRevSharePercentage,proto3" json:"prev_owner_rev_share_percentage,omitempty"`
+	NewOwnerRevSharePercentage  uint64 `protobuf:"varint,3,opt,name=new_owner_rev_share_percentage,json=newOwnerRevSharePercentage,proto3" json:"new_owner_rev_share_percentage,omitempty"`
+	// The start height of the first session settled with the new percentage.
+	EffectiveBlockHeight int64 `protobuf:"varint,4,opt,name=effective_block_height,json=effectiveBlockHeight,proto3" json:"effective_block_height,omitempty"`
+}
+
+func (m *EventServiceOwnerRevSharePercentageUpdated) Reset() {
+	*m = EventServiceOwnerRevSharePercentageUpdated{}
+}
+func (m *EventServiceOwnerRevSharePercentageUpdated) String() string {
+	return proto.CompactTextString(m)
+}
+func (*EventServiceOwnerRevSharePercentageUpdated) ProtoMessage() {}
+func (*EventServiceOwnerRevSharePercentageUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a38747b533ead694, []int{2}
+}
+func (m *EventServiceOwnerRevSharePercentageUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventServiceOwnerRevSharePercentageUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *EventServiceOwnerRevSharePercentageUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventServiceOwnerRevSharePercentageUpdated.Merge(m, src)
+}
+func (m *EventServiceOwnerRevSharePercentageUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventServiceOwnerRevSharePercentageUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventServiceOwnerRevSharePercentageUpdated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventServiceOwnerRevSharePercentageUpdated proto.InternalMessageInfo
+
+func (m *EventServiceOwnerRevSharePercentageUpdated) GetServiceId() string {
+	if m != nil {
+		return m.ServiceId
+	}
+	return ""
+}
+
+func (m *EventServiceOwnerRevSharePercentageUpdated) GetPrevOwnerRevSharePercentage() uint64 {
+	if m != nil {
+		return m.PrevOwnerRevSharePercentage
+	}
+	return 0
+}
+
+func (m *EventServiceOwnerRevSharePercentageUpdated) GetNewOwnerRevSharePercentage() uint64 {
+	if m != nil {
+		return m.NewOwnerRevSharePercentage
+	}
+	return 0
+}
+
+func (m *EventServiceOwnerRevSharePercentageUpdated) GetEffectiveBlockHeight() int64 {
+	if m != nil {
+		return m.EffectiveBlockHeight
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*EventRelayMiningDifficultyUpdated)(nil), "pocket.service.EventRelayMiningDifficultyUpdated")
+	proto.RegisterType((*EventServiceComputeUnitsToTokensMultiplierUpdated)(nil), "pocket.service.EventServiceComputeUnitsToTokensMultiplierUpdated")
+	proto.RegisterType((*EventServiceOwnerRevSharePercentageUpdated)(nil), "pocket.service.EventServiceOwnerRevSharePercentageUpdated")
 }
 
 func init() { proto.RegisterFile("pocket/service/event.proto", fileDescriptor_a38747b533ead694) }
 
 var fileDescriptor_a38747b533ead694 = []byte{
-	// 321 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0xc1, 0x4a, 0xc3, 0x30,
-	0x18, 0xc7, 0x97, 0x39, 0x85, 0xe5, 0xa0, 0x52, 0x05, 0xcb, 0xd4, 0x30, 0x3d, 0x0d, 0x64, 0xab,
-	0xe0, 0x55, 0x3c, 0x88, 0x83, 0x79, 0x70, 0x87, 0xa1, 0x17, 0x2f, 0x21, 0x6b, 0xbf, 0xb5, 0x61,
-	0x6d, 0x52, 0x92, 0x74, 0xdd, 0xde, 0xc2, 0x07, 0xf0, 0x81, 0x3c, 0xee, 0xb8, 0xa3, 0x74, 0x2f,
-	0x22, 0xc9, 0x86, 0x88, 0xe8, 0x2d, 0xc9, 0xff, 0xf7, 0xcb, 0xc7, 0x3f, 0xc1, 0xad, 0x5c, 0x86,
-	0x53, 0x30, 0x81, 0x06, 0x35, 0xe3, 0x21, 0x04, 0x30, 0x03, 0x61, 0x7a, 0xb9, 0x92, 0x46, 0x7a,
-	0xfb, 0x9b, 0xac, 0xb7, 0xcd, 0x5a, 0xc7, 0xb1, 0x8c, 0xa5, 0x8b, 0x02, 0xbb, 0xda, 0x50, 0x97,
-	0xef, 0x75, 0x7c, 0xd1, 0xb7, 0xd6, 0x08, 0x52, 0xb6, 0x78, 0xe2, 0x82, 0x8b, 0xf8, 0x81, 0x4f,
-	0x26, 0x3c, 0x2c, 0x52, 0xb3, 0x78, 0xc9, 0x23, 0x66, 0x20, 0xf2, 0xce, 0x31, 0xde, 0x5e, 0x43,
-	0x79, 0xe4, 0xa3, 0x36, 0xea, 0x34, 0x47, 0xcd, 0xed, 0xc9, 0x63, 0xe4, 0xdd, 0xe1, 0xb3, 0x5c,
-	0xc1, 0x8c, 0x1a, 0xa6, 0x62, 0x30, 0x34, 0x61, 0x3a, 0xa1, 0x09, 0xcc, 0x29, 0x88, 0x50, 0x46,
-	0x10, 0xf9, 0x75, 0x27, 0xf8, 0x96, 0x79, 0x76, 0xc8, 0x80, 0xe9, 0x64, 0x00, 0xf3, 0xfe, 0x26,
-	0xf7, 0x6e, 0xf1, 0xa9, 0x80, 0xf2, 0x5f, 0x7d, 0xc7, 0xe9, 0x27, 0x02, 0xca, 0x3f, 0xed, 0x2e,
-	0x3e, 0x72, 0xd3, 0x45, 0x91, 0x51, 0x65, 0x5b, 0x68, 0x0a, 0x19, 0xf3, 0x1b, 0x6d, 0xd4, 0x69,
-	0x8c, 0x0e, 0x6d, 0x34, 0x2c, 0x32, 0x57, 0x4f, 0xf7, 0x33, 0xe6, 0x5d, 0x61, 0xcf, 0x0e, 0xfb,
-	0x45, 0xef, 0x3a, 0xfa, 0x40, 0x40, 0xf9, 0x13, 0xbe, 0x1f, 0x7e, 0x54, 0x04, 0x2d, 0x2b, 0x82,
-	0x56, 0x15, 0x41, 0x9f, 0x15, 0x41, 0x6f, 0x6b, 0x52, 0x5b, 0xae, 0x49, 0x6d, 0xb5, 0x26, 0xb5,
-	0xd7, 0xeb, 0x98, 0x9b, 0xa4, 0x18, 0xf7, 0x42, 0x99, 0x05, 0xb9, 0x9c, 0x9a, 0xae, 0x00, 0x53,
-	0x4a, 0x35, 0x75, 0x1b, 0x25, 0xd3, 0x34, 0x98, 0x7f, 0x7f, 0x8d, 0x59, 0xe4, 0xa0, 0xc7, 0x7b,
-	0xee, 0xd5, 0x6f, 0xbe, 0x02, 0x00, 0x00, 0xff, 0xff, 0x8f, 0x09, 0xb0, 0x48, 0xb9, 0x01, 0x00,
-	0x00,
+	// 500 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0x41, 0x6e, 0xd3, 0x40,
+	0x14, 0x86, 0xe3, 0x24, 0x20, 0x75, 0x16, 0x80, 0x4c, 0x05, 0x51, 0x0a, 0xa6, 0xcd, 0x02, 0x2a,
+	0x50, 0x63, 0x10, 0x2c, 0x11, 0x8b, 0xd0, 0x48, 0x61, 0xd1, 0x02, 0x4e, 0xba, 0x61, 0x33, 0x72,
+	0xec, 0x17, 0x7b, 0x64, 0x7b, 0xc6, 0x9a, 0x79, 0xb6, 0x93, 0x33, 0xb0, 0xe1, 0x00, 0x1c, 0x82,
+	0x63, 0xb0, 0xec, 0xb2, 0x4b, 0x94, 0x5c, 0x04, 0xcd, 0x38, 0x8a, 0x2a, 0x44, 0x4a, 0xc4, 0x6e,
+	0xec, 0xff, 0xff, 0xbf, 0x37, 0xef, 0x97, 0x86, 0x74, 0x73, 0x11, 0x24, 0x80, 0xae, 0x02, 0x59,
+	0xb2, 0x00, 0x5c, 0x28, 0x81, 0x63, 0x3f, 0x97, 0x02, 0x85, 0x7d, 0xa7, 0xd6, 0xfa, 0x6b, 0xad,
+	0xbb, 0x1f, 0x89, 0x48, 0x18, 0xc9, 0xd5, 0xa7, 0xda, 0xd5, 0xfb, 0xde, 0x24, 0x47, 0x43, 0x9d,
+	0xf2, 0x20, 0xf5, 0x17, 0x67, 0x8c, 0x33, 0x1e, 0x9d, 0xb2, 0xd9, 0x8c, 0x05, 0x45, 0x8a, 0x8b,
+	0x8b, 0x3c, 0xf4, 0x11, 0x42, 0xfb, 0x31, 0x21, 0x6b, 0x0c, 0x65, 0x61, 0xc7, 0x3a, 0xb4, 0x8e,
+	0xf7, 0xbc, 0xbd, 0xf5, 0x9f, 0x0f, 0xa1, 0xfd, 0x8e, 0x3c, 0xca, 0x25, 0x94, 0x14, 0x7d, 0x19,
+	0x01, 0xd2, 0xd8, 0x57, 0x31, 0x8d, 0x61, 0x4e, 0x81, 0x07, 0x22, 0x84, 0xb0, 0xd3, 0x34, 0x81,
+	0x8e, 0xf6, 0x4c, 0x8c, 0x65, 0xe4, 0xab, 0x78, 0x04, 0xf3, 0x61, 0xad, 0xdb, 0x6f, 0xc9, 0x01,
+	0x87, 0x6a, 0x6b, 0xbc, 0x65, 0xe2, 0x0f, 0x39, 0x54, 0x7f, 0x4d, 0x9f, 0x90, 0xfb, 0x66, 0x3a,
+	0x2f, 0x32, 0x2a, 0xf5, 0x16, 0x8a, 0x42, 0xe6, 0x77, 0xda, 0x87, 0xd6, 0x71, 0xdb, 0xbb, 0xa7,
+	0xa5, 0xf3, 0x22, 0x33, 0xeb, 0xa9, 0x61, 0xe6, 0xdb, 0x2f, 0x88, 0xad, 0x87, 0xfd, 0xe1, 0xbe,
+	0x65, 0xdc, 0x77, 0x39, 0x54, 0xd7, 0xcd, 0xbd, 0x1f, 0x4d, 0xf2, 0xca, 0xd4, 0x33, 0xae, 0x97,
+	0x7d, 0x2f, 0xb2, 0xbc, 0x40, 0xb8, 0xe0, 0x0c, 0xd5, 0x44, 0x4c, 0x44, 0x02, 0x5c, 0x9d, 0x15,
+	0x29, 0xb2, 0x3c, 0x65, 0x20, 0x77, 0xac, 0x6b, 0x4c, 0x9e, 0x99, 0x0b, 0x07, 0x35, 0x8c, 0x16,
+	0x9a, 0x46, 0x51, 0x50, 0x34, 0x3c, 0x9a, 0x6d, 0x80, 0xa6, 0xb9, 0xb6, 0xd7, 0xd3, 0xf6, 0x9b,
+	0x47, 0xdb, 0x9f, 0xc9, 0x53, 0xbd, 0xd6, 0x0e, 0xcc, 0x96, 0x61, 0x1e, 0x71, 0xa8, 0xfe, 0x81,
+	0x7c, 0x43, 0x1e, 0xc0, 0x6c, 0x06, 0x01, 0xb2, 0x12, 0xe8, 0x34, 0x15, 0x41, 0x42, 0x63, 0x60,
+	0x51, 0x8c, 0xa6, 0xdb, 0x96, 0xb7, 0xbf, 0x51, 0x07, 0x5a, 0x1c, 0x19, 0xad, 0xf7, 0xb5, 0x49,
+	0x9e, 0x5f, 0xaf, 0xec, 0x63, 0xc5, 0x41, 0x7a, 0x50, 0x8e, 0x63, 0x5f, 0xc2, 0x27, 0x90, 0x01,
+	0x70, 0xf4, 0x23, 0xd8, 0xb1, 0xab, 0x53, 0xf2, 0xc4, 0x74, 0x25, 0x34, 0x85, 0xea, 0x93, 0xd2,
+	0x1c, 0x9a, 0x6f, 0x40, 0xeb, 0x8e, 0x0e, 0xb4, 0x6d, 0xcb, 0x2c, 0x7b, 0x40, 0x1c, 0x5d, 0xce,
+	0x0d, 0x90, 0xba, 0x94, 0x2e, 0x87, 0x6a, 0x1b, 0xe3, 0xbf, 0xda, 0x18, 0x9c, 0xff, 0x5c, 0x3a,
+	0xd6, 0xe5, 0xd2, 0xb1, 0xae, 0x96, 0x8e, 0xf5, 0x6b, 0xe9, 0x58, 0xdf, 0x56, 0x4e, 0xe3, 0x72,
+	0xe5, 0x34, 0xae, 0x56, 0x4e, 0xe3, 0xcb, 0xcb, 0x88, 0x61, 0x5c, 0x4c, 0xfb, 0x81, 0xc8, 0xdc,
+	0x5c, 0x24, 0x78, 0xc2, 0x01, 0x2b, 0x21, 0x13, 0xf3, 0x21, 0x45, 0x9a, 0xba, 0xf3, 0xcd, 0xdb,
+	0xc6, 0x45, 0x0e, 0x6a, 0x7a, 0xdb, 0x3c, 0xdb, 0xd7, 0xbf, 0x07, 0x00, 0xa4, 0xd0, 0x71, 0xa0,
+	0xfa, 0x03, 0x00, 0x00,
 }
 
 func (m *EventRelayMiningDifficultyUpdated) Marshal() (dAtA []byte, err error) {
@@ -182,6 +337,96 @@ func (m *EventRelayMiningDifficultyUpdated) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
+func (m *EventServiceComputeUnitsToTokensMultiplierUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventServiceComputeUnitsToTokensMultiplierUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventServiceComputeUnitsToTokensMultiplierUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EffectiveBlockHeight != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.EffectiveBlockHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.NewComputeUnitsToTokensMultiplier != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.NewComputeUnitsToTokensMultiplier))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PrevComputeUnitsToTokensMultiplier != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.PrevComputeUnitsToTokensMultiplier))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ServiceId) > 0 {
+		i -= len(m.ServiceId)
+		copy(dAtA[i:], m.ServiceId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ServiceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventServiceOwnerRevSharePercentageUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventServiceOwnerRevSharePercentageUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventServiceOwnerRevSharePercentageUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EffectiveBlockHeight != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.EffectiveBlockHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.NewOwnerRevSharePercentage != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.NewOwnerRevSharePercentage))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PrevOwnerRevSharePercentage != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.PrevOwnerRevSharePercentage))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ServiceId) > 0 {
+		i -= len(m.ServiceId)
+		copy(dAtA[i:], m.ServiceId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ServiceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvent(v)
 	base := offset
@@ -220,6 +465,50 @@ func (m *EventRelayMiningDifficultyUpdated) Size() (n int) {
 	return n
 }
 
+func (m *EventServiceComputeUnitsToTokensMultiplierUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ServiceId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.PrevComputeUnitsToTokensMultiplier != 0 {
+		n += 1 + sovEvent(uint64(m.PrevComputeUnitsToTokensMultiplier))
+	}
+	if m.NewComputeUnitsToTokensMultiplier != 0 {
+		n += 1 + sovEvent(uint64(m.NewComputeUnitsToTokensMultiplier))
+	}
+	if m.EffectiveBlockHeight != 0 {
+		n += 1 + sovEvent(uint64(m.EffectiveBlockHeight))
+	}
+	return n
+}
+
+func (m *EventServiceOwnerRevSharePercentageUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ServiceId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.PrevOwnerRevSharePercentage != 0 {
+		n += 1 + sovEvent(uint64(m.PrevOwnerRevSharePercentage))
+	}
+	if m.NewOwnerRevSharePercentage != 0 {
+		n += 1 + sovEvent(uint64(m.NewOwnerRevSharePercentage))
+	}
+	if m.EffectiveBlockHeight != 0 {
+		n += 1 + sovEvent(uint64(m.EffectiveBlockHeight))
+	}
+	return n
+}
+
 func sovEvent(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -410,6 +699,284 @@ func (m *EventRelayMiningDifficultyUpdated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventServiceComputeUnitsToTokensMultiplierUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventServiceComputeUnitsToTokensMultiplierUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventServiceComputeUnitsToTokensMultiplierUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServiceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevComputeUnitsToTokensMultiplier", wireType)
+			}
+			m.PrevComputeUnitsToTokensMultiplier = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PrevComputeUnitsToTokensMultiplier |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewComputeUnitsToTokensMultiplier", wireType)
+			}
+			m.NewComputeUnitsToTokensMultiplier = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NewComputeUnitsToTokensMultiplier |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveBlockHeight", wireType)
+			}
+			m.EffectiveBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EffectiveBlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventServiceOwnerRevSharePercentageUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventServiceOwnerRevSharePercentageUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventServiceOwnerRevSharePercentageUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServiceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevOwnerRevSharePercentage", wireType)
+			}
+			m.PrevOwnerRevSharePercentage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PrevOwnerRevSharePercentage |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOwnerRevSharePercentage", wireType)
+			}
+			m.NewOwnerRevSharePercentage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NewOwnerRevSharePercentage |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveBlockHeight", wireType)
+			}
+			m.EffectiveBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EffectiveBlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvent(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -37,5 +37,12 @@ func (msg *MsgAddService) ValidateBasic() error {
 	if err := msg.Service.ValidateBasic(); err != nil {
 		return err
 	}
+
+	// The multiplier history is only ever written onchain, as the multiplier is updated.
+	if len(msg.Service.ComputeUnitsToTokensMultiplierHistory) > 0 {
+		return ErrServiceInvalidComputeUnitsToTokensMultiplier.Wrap(
+			"compute units to tokens multiplier history cannot be set when adding a service",
+		)
+	}
 	return nil
 }
@@ -130,6 +130,70 @@ func TestMsgAddService_ValidateBasic(t *testing.T) {
 			},
 			expectedErr: nil,
 		},
+		{
+			desc: "owner rev share percentage greater than 100",
+			msg: MsgAddService{
+				OwnerAddress: serviceOwnerAddress,
+				Service: sharedtypes.Service{
+					Id:                      "svc1",
+					Name:                    "service name",
+					ComputeUnitsPerRelay:    1,
+					OwnerAddress:            serviceOwnerAddress,
+					OwnerRevSharePercentage: 101,
+				},
+			},
+			expectedErr: sharedtypes.ErrSharedInvalidService.
+				Wrapf("%s", sharedtypes.ErrSharedInvalidRevShare),
+		},
+		{
+			desc: "valid owner rev share percentage",
+			msg: MsgAddService{
+				OwnerAddress: serviceOwnerAddress,
+				Service: sharedtypes.Service{
+					Id:                      "svc1",
+					Name:                    "service name",
+					ComputeUnitsPerRelay:    1,
+					OwnerAddress:            serviceOwnerAddress,
+					OwnerRevSharePercentage: 100,
+				},
+			},
+			expectedErr: nil,
+		},
+		{
+			desc: "compute units to tokens multiplier history set",
+			msg: MsgAddService{
+				OwnerAddress: serviceOwnerAddress,
+				Service: sharedtypes.Service{
+					Id:                             "svc1",
+					Name:                           "service name",
+					ComputeUnitsPerRelay:           1,
+					OwnerAddress:                   serviceOwnerAddress,
+					ComputeUnitsToTokensMultiplier: 7,
+					ComputeUnitsToTokensMultiplierHistory: []*sharedtypes.ServiceComputeUnitsToTokensMultiplierUpdate{
+						{ComputeUnitsToTokensMultiplier: 7, EffectiveBlockHeight: 0},
+					},
+				},
+			},
+			expectedErr: ErrServiceInvalidComputeUnitsToTokensMultiplier,
+		},
+		{
+			desc: "unordered compute units to tokens multiplier history",
+			msg: MsgAddService{
+				OwnerAddress: serviceOwnerAddress,
+				Service: sharedtypes.Service{
+					Id:                             "svc1",
+					Name:                           "service name",
+					ComputeUnitsPerRelay:           1,
+					OwnerAddress:                   serviceOwnerAddress,
+					ComputeUnitsToTokensMultiplier: 7,
+					ComputeUnitsToTokensMultiplierHistory: []*sharedtypes.ServiceComputeUnitsToTokensMultiplierUpdate{
+						{ComputeUnitsToTokensMultiplier: 5, EffectiveBlockHeight: 11},
+						{ComputeUnitsToTokensMultiplier: 7, EffectiveBlockHeight: 1},
+					},
+				},
+			},
+			expectedErr: sharedtypes.ErrSharedInvalidService,
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.desc, func(t *testing.T) {
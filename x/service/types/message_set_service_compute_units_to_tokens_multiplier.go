@@ -0,0 +1,33 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
+)
+
+var _ sdk.Msg = (*MsgSetServiceComputeUnitsToTokensMultiplier)(nil)
+
+func NewMsgSetServiceComputeUnitsToTokensMultiplier(
+	serviceOwnerAddr, serviceId string,
+	computeUnitsToTokensMultiplier uint64,
+) *MsgSetServiceComputeUnitsToTokensMultiplier {
+	return &MsgSetServiceComputeUnitsToTokensMultiplier{
+		OwnerAddress:                   serviceOwnerAddr,
+		ServiceId:                      serviceId,
+		ComputeUnitsToTokensMultiplier: computeUnitsToTokensMultiplier,
+	}
+}
+
+// ValidateBasic performs basic validation of the message and its fields
+func (msg *MsgSetServiceComputeUnitsToTokensMultiplier) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.OwnerAddress); err != nil {
+		return ErrServiceInvalidAddress.Wrapf("invalid signer address %s; (%v)", msg.OwnerAddress, err)
+	}
+
+	if err := sharedtypes.IsValidServiceId(msg.ServiceId); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,47 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/poktroll/testutil/sample"
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
+)
+
+func TestMsgSetServiceComputeUnitsToTokensMultiplier_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		desc        string
+		msg         *MsgSetServiceComputeUnitsToTokensMultiplier
+		expectedErr error
+	}{
+		{
+			desc:        "invalid service owner address",
+			msg:         NewMsgSetServiceComputeUnitsToTokensMultiplier("invalid_address", "svc1", 42),
+			expectedErr: ErrServiceInvalidAddress,
+		},
+		{
+			desc:        "invalid service ID",
+			msg:         NewMsgSetServiceComputeUnitsToTokensMultiplier(sample.AccAddress(), "svc&1", 42),
+			expectedErr: sharedtypes.ErrSharedInvalidServiceId,
+		},
+		{
+			desc: "valid - zero multiplier (i.e. use the global shared param)",
+			msg:  NewMsgSetServiceComputeUnitsToTokensMultiplier(sample.AccAddress(), "svc1", 0),
+		},
+		{
+			desc: "valid",
+			msg:  NewMsgSetServiceComputeUnitsToTokensMultiplier(sample.AccAddress(), "svc1", 42),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			err := test.msg.ValidateBasic()
+			if test.expectedErr != nil {
+				require.ErrorIs(t, err, test.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
+)
+
+var _ sdk.Msg = (*MsgSetServiceOwnerRevSharePercentage)(nil)
+
+func NewMsgSetServiceOwnerRevSharePercentage(
+	serviceOwnerAddr, serviceId string,
+	ownerRevSharePercentage uint64,
+) *MsgSetServiceOwnerRevSharePercentage {
+	return &MsgSetServiceOwnerRevSharePercentage{
+		OwnerAddress:            serviceOwnerAddr,
+		ServiceId:               serviceId,
+		OwnerRevSharePercentage: ownerRevSharePercentage,
+	}
+}
+
+// ValidateBasic performs basic validation of the message and its fields
+func (msg *MsgSetServiceOwnerRevSharePercentage) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.OwnerAddress); err != nil {
+		return ErrServiceInvalidAddress.Wrapf("invalid signer address %s; (%v)", msg.OwnerAddress, err)
+	}
+
+	if err := sharedtypes.IsValidServiceId(msg.ServiceId); err != nil {
+		return err
+	}
+
+	if err := sharedtypes.ValidateServiceOwnerRevSharePercentage(msg.OwnerRevSharePercentage); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,52 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/poktroll/testutil/sample"
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
+)
+
+func TestMsgSetServiceOwnerRevSharePercentage_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		desc        string
+		msg         *MsgSetServiceOwnerRevSharePercentage
+		expectedErr error
+	}{
+		{
+			desc:        "invalid service owner address",
+			msg:         NewMsgSetServiceOwnerRevSharePercentage("invalid_address", "svc1", 42),
+			expectedErr: ErrServiceInvalidAddress,
+		},
+		{
+			desc:        "invalid service ID",
+			msg:         NewMsgSetServiceOwnerRevSharePercentage(sample.AccAddress(), "svc&1", 42),
+			expectedErr: sharedtypes.ErrSharedInvalidServiceId,
+		},
+		{
+			desc:        "owner rev share percentage greater than 100",
+			msg:         NewMsgSetServiceOwnerRevSharePercentage(sample.AccAddress(), "svc1", 101),
+			expectedErr: sharedtypes.ErrSharedInvalidRevShare,
+		},
+		{
+			desc: "valid - zero owner rev share percentage",
+			msg:  NewMsgSetServiceOwnerRevSharePercentage(sample.AccAddress(), "svc1", 0),
+		},
+		{
+			desc: "valid",
+			msg:  NewMsgSetServiceOwnerRevSharePercentage(sample.AccAddress(), "svc1", 42),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			err := test.msg.ValidateBasic()
+			if test.expectedErr != nil {
+				require.ErrorIs(t, err, test.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
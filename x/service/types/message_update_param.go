@@ -50,6 +50,11 @@ func (msg *MsgUpdateParam) ValidateBasic() error {
 		return ValidateAddServiceFee(msg.GetAsCoin())
 	case ParamTargetNumRelays:
 		return ValidateTargetNumRelays(msg.GetAsUint64())
+	case ParamMaxComputeUnitsToTokensMultiplier:
+		if err := genericParamTypeIs[*MsgUpdateParam_AsUint64](msg); err != nil {
+			return err
+		}
+		return ValidateMaxComputeUnitsToTokensMultiplier(msg.GetAsUint64())
 	default:
 		return ErrServiceParamInvalid.Wrapf("unsupported param %q", msg.Name)
 	}
@@ -0,0 +1,33 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
+)
+
+var _ sdk.Msg = (*MsgUpdateServiceComputeUnitsToTokensMultiplier)(nil)
+
+func NewMsgUpdateServiceComputeUnitsToTokensMultiplier(
+	authority, serviceId string,
+	computeUnitsToTokensMultiplier uint64,
+) *MsgUpdateServiceComputeUnitsToTokensMultiplier {
+	return &MsgUpdateServiceComputeUnitsToTokensMultiplier{
+		Authority:                      authority,
+		ServiceId:                      serviceId,
+		ComputeUnitsToTokensMultiplier: computeUnitsToTokensMultiplier,
+	}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (msg *MsgUpdateServiceComputeUnitsToTokensMultiplier) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return ErrServiceInvalidAddress.Wrapf("invalid authority address %s; (%v)", msg.Authority, err)
+	}
+
+	if err := sharedtypes.IsValidServiceId(msg.ServiceId); err != nil {
+		return err
+	}
+
+	return nil
+}
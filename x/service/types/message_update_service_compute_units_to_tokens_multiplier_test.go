@@ -0,0 +1,43 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/poktroll/testutil/sample"
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
+)
+
+func TestMsgUpdateServiceComputeUnitsToTokensMultiplier_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		desc        string
+		msg         *MsgUpdateServiceComputeUnitsToTokensMultiplier
+		expectedErr error
+	}{
+		{
+			desc:        "invalid authority address",
+			msg:         NewMsgUpdateServiceComputeUnitsToTokensMultiplier("invalid_address", "svc1", 42),
+			expectedErr: ErrServiceInvalidAddress,
+		},
+		{
+			desc:        "invalid service ID",
+			msg:         NewMsgUpdateServiceComputeUnitsToTokensMultiplier(sample.AccAddress(), "svc&1", 42),
+			expectedErr: sharedtypes.ErrSharedInvalidServiceId,
+		},
+		{
+			desc: "valid",
+			msg:  NewMsgUpdateServiceComputeUnitsToTokensMultiplier(sample.AccAddress(), "svc1", 42),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			err := test.msg.ValidateBasic()
+			if test.expectedErr != nil {
+				require.ErrorIs(t, err, test.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -22,6 +22,10 @@ var (
 	KeyTargetNumRelays     = []byte("TargetNumRelays")
 	ParamTargetNumRelays   = "target_num_relays"
 	DefaultTargetNumRelays = uint64(10e4)
+
+	KeyMaxComputeUnitsToTokensMultiplier     = []byte("MaxComputeUnitsToTokensMultiplier")
+	ParamMaxComputeUnitsToTokensMultiplier   = "max_compute_units_to_tokens_multiplier"
+	DefaultMaxComputeUnitsToTokensMultiplier = uint64(1000)
 )
 
 // ParamKeyTable the param key table for launch module
@@ -33,10 +37,12 @@ func ParamKeyTable() paramtypes.KeyTable {
 func NewParams(
 	addServiceFee *cosmostypes.Coin,
 	targetNumRelays uint64,
+	maxComputeUnitsToTokensMultiplier uint64,
 ) Params {
 	return Params{
-		AddServiceFee:   addServiceFee,
-		TargetNumRelays: targetNumRelays,
+		AddServiceFee:                     addServiceFee,
+		TargetNumRelays:                   targetNumRelays,
+		MaxComputeUnitsToTokensMultiplier: maxComputeUnitsToTokensMultiplier,
 	}
 }
 
@@ -45,6 +51,7 @@ func DefaultParams() Params {
 	return NewParams(
 		&MinAddServiceFee,
 		DefaultTargetNumRelays,
+		DefaultMaxComputeUnitsToTokensMultiplier,
 	)
 }
 
@@ -53,6 +60,11 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyAddServiceFee, &p.AddServiceFee, ValidateAddServiceFee),
 		paramtypes.NewParamSetPair(KeyTargetNumRelays, &p.AddServiceFee, ValidateTargetNumRelays),
+		paramtypes.NewParamSetPair(
+			KeyMaxComputeUnitsToTokensMultiplier,
+			&p.MaxComputeUnitsToTokensMultiplier,
+			ValidateMaxComputeUnitsToTokensMultiplier,
+		),
 	}
 }
 
@@ -66,6 +78,10 @@ func (p Params) ValidateBasic() error {
 		return err
 	}
 
+	if err := ValidateMaxComputeUnitsToTokensMultiplier(p.MaxComputeUnitsToTokensMultiplier); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -109,3 +125,20 @@ func ValidateTargetNumRelays(targetNumRelaysAny any) error {
 
 	return nil
 }
+
+// ValidateMaxComputeUnitsToTokensMultiplier validates the MaxComputeUnitsToTokensMultiplier param
+func ValidateMaxComputeUnitsToTokensMultiplier(maxComputeUnitsToTokensMultiplierAny any) error {
+	maxComputeUnitsToTokensMultiplier, ok := maxComputeUnitsToTokensMultiplierAny.(uint64)
+	if !ok {
+		return ErrServiceParamInvalid.Wrapf("invalid parameter type: %T", maxComputeUnitsToTokensMultiplierAny)
+	}
+
+	if maxComputeUnitsToTokensMultiplier < 1 {
+		return ErrServiceParamInvalid.Wrapf(
+			"max_compute_units_to_tokens_multiplier must be greater than 0: got %d",
+			maxComputeUnitsToTokensMultiplier,
+		)
+	}
+
+	return nil
+}
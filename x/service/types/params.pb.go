@@ -34,6 +34,10 @@ type Params struct {
 	// target_num_relays is the target for the EMA of the number of relays per session.
 	// Per service, onchain relay mining difficulty will be adjusted to maintain this target.
 	TargetNumRelays uint64 `protobuf:"varint,2,opt,name=target_num_relays,json=targetNumRelays,proto3" json:"target_num_relays" yaml:"target_num_relays"`
+	// max_compute_units_to_tokens_multiplier is the maximum compute units to tokens
+	// multiplier a service owner can set for their service. It does not bound the
+	// multipliers set through governance.
+	MaxComputeUnitsToTokensMultiplier uint64 `protobuf:"varint,3,opt,name=max_compute_units_to_tokens_multiplier,json=maxComputeUnitsToTokensMultiplier,proto3" json:"max_compute_units_to_tokens_multiplier" yaml:"max_compute_units_to_tokens_multiplier"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -79,6 +83,13 @@ func (m *Params) GetTargetNumRelays() uint64 {
 	return 0
 }
 
+func (m *Params) GetMaxComputeUnitsToTokensMultiplier() uint64 {
+	if m != nil {
+		return m.MaxComputeUnitsToTokensMultiplier
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "pocket.service.Params")
 }
@@ -86,28 +97,33 @@ func init() {
 func init() { proto.RegisterFile("pocket/service/params.proto", fileDescriptor_bb052db7e9dc89f5) }
 
 var fileDescriptor_bb052db7e9dc89f5 = []byte{
-	// 335 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2e, 0xc8, 0x4f, 0xce,
-	0x4e, 0x2d, 0xd1, 0x2f, 0x4e, 0x2d, 0x2a, 0xcb, 0x4c, 0x4e, 0xd5, 0x2f, 0x48, 0x2c, 0x4a, 0xcc,
-	0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x83, 0x48, 0xea, 0x41, 0x25, 0xa5, 0x04,
-	0x13, 0x73, 0x33, 0xf3, 0xf2, 0xf5, 0xc1, 0x24, 0x44, 0x89, 0x94, 0x48, 0x7a, 0x7e, 0x7a, 0x3e,
-	0x98, 0xa9, 0x0f, 0x62, 0x41, 0x45, 0xe5, 0x92, 0xf3, 0x8b, 0x73, 0xf3, 0x8b, 0xf5, 0x93, 0x12,
-	0x8b, 0x53, 0xf5, 0xcb, 0x0c, 0x93, 0x52, 0x4b, 0x12, 0x0d, 0xf5, 0x93, 0xf3, 0x33, 0xf3, 0x20,
-	0xf2, 0x4a, 0xbf, 0x19, 0xb9, 0xd8, 0x02, 0xc0, 0x36, 0x09, 0x15, 0x70, 0xf1, 0x27, 0xa6, 0xa4,
-	0xc4, 0x43, 0xad, 0x88, 0x4f, 0x4b, 0x4d, 0x95, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x36, 0x92, 0xd4,
-	0x83, 0x18, 0xa2, 0x07, 0x32, 0x44, 0x0f, 0x6a, 0x88, 0x9e, 0x73, 0x7e, 0x66, 0x9e, 0x93, 0xee,
-	0xab, 0x7b, 0xf2, 0xe8, 0xba, 0x3e, 0xdd, 0x93, 0x17, 0xab, 0x4c, 0xcc, 0xcd, 0xb1, 0x52, 0x42,
-	0x93, 0x50, 0x0a, 0xe2, 0x4d, 0x4c, 0x49, 0x09, 0x86, 0x08, 0xb8, 0xa5, 0xa6, 0x0a, 0xc5, 0x72,
-	0x09, 0x96, 0x24, 0x16, 0xa5, 0xa7, 0x96, 0xc4, 0xe7, 0x95, 0xe6, 0xc6, 0x17, 0xa5, 0xe6, 0x24,
-	0x56, 0x16, 0x4b, 0x30, 0x29, 0x30, 0x6a, 0xb0, 0x38, 0x19, 0xbe, 0xba, 0x27, 0x8f, 0x29, 0xf9,
-	0xe9, 0x9e, 0xbc, 0x04, 0xc4, 0x68, 0x0c, 0x29, 0xa5, 0x20, 0x7e, 0x88, 0x98, 0x5f, 0x69, 0x6e,
-	0x10, 0x58, 0xc4, 0x4a, 0xe1, 0xc5, 0x02, 0x79, 0xc6, 0xae, 0xe7, 0x1b, 0xb4, 0xc4, 0xa1, 0x41,
-	0x5b, 0x01, 0x0f, 0x5c, 0x88, 0x97, 0x9d, 0xfc, 0x4e, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e,
-	0xf1, 0xc6, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58,
-	0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0x83, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4,
-	0xfc, 0x5c, 0xfd, 0x82, 0xfc, 0xec, 0x12, 0xdd, 0xbc, 0xd4, 0x92, 0xf2, 0xfc, 0xa2, 0x6c, 0x30,
-	0xa7, 0x28, 0x3f, 0x27, 0x07, 0xc9, 0xc0, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0x70, 0xa0,
-	0x1a, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0xc0, 0xc9, 0x3c, 0xd8, 0xcc, 0x01, 0x00, 0x00,
+	// 406 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x41, 0x6b, 0x13, 0x41,
+	0x14, 0xc7, 0x33, 0x56, 0x7a, 0x58, 0xd1, 0xd2, 0x20, 0x1a, 0x2b, 0xcc, 0xc6, 0x3d, 0x48, 0x11,
+	0xb2, 0x63, 0xf4, 0xd6, 0x63, 0x0a, 0xde, 0x2c, 0x12, 0xeb, 0x45, 0x91, 0x61, 0xb2, 0x79, 0xc6,
+	0x61, 0x77, 0xf6, 0x0d, 0x33, 0xb3, 0x35, 0xf9, 0x0a, 0x9e, 0xfc, 0x08, 0x7e, 0x00, 0x11, 0x3f,
+	0x86, 0xc7, 0x1e, 0x7b, 0x5a, 0x64, 0x73, 0x50, 0x72, 0xcc, 0x27, 0x90, 0xcc, 0x2c, 0xa5, 0xb4,
+	0x97, 0x5c, 0x86, 0x37, 0xff, 0xff, 0xef, 0xfd, 0xdf, 0xc0, 0xbc, 0xe8, 0xb1, 0xc6, 0x2c, 0x07,
+	0xc7, 0x2c, 0x98, 0x33, 0x99, 0x01, 0xd3, 0xc2, 0x08, 0x65, 0x53, 0x6d, 0xd0, 0x61, 0xf7, 0x5e,
+	0x30, 0xd3, 0xd6, 0x3c, 0xd8, 0x17, 0x4a, 0x96, 0xc8, 0xfc, 0x19, 0x90, 0x83, 0xfb, 0x33, 0x9c,
+	0xa1, 0x2f, 0xd9, 0xa6, 0x6a, 0x55, 0x9a, 0xa1, 0x55, 0x68, 0xd9, 0x44, 0x58, 0x60, 0x67, 0xc3,
+	0x09, 0x38, 0x31, 0x64, 0x19, 0xca, 0x32, 0xf8, 0xc9, 0x8f, 0x9d, 0x68, 0xf7, 0x8d, 0x9f, 0xd4,
+	0xd5, 0xd1, 0x9e, 0x98, 0x4e, 0x79, 0x3b, 0x82, 0x7f, 0x02, 0xe8, 0x91, 0x3e, 0x39, 0xbc, 0xf3,
+	0xe2, 0x51, 0x1a, 0x42, 0xd2, 0x4d, 0x48, 0xda, 0x86, 0xa4, 0xc7, 0x28, 0xcb, 0xd1, 0x60, 0x55,
+	0xc7, 0xd7, 0xbb, 0xd6, 0x75, 0xfc, 0x60, 0x21, 0x54, 0x71, 0x94, 0x5c, 0x33, 0x92, 0xf1, 0x5d,
+	0x31, 0x9d, 0xbe, 0x0d, 0xc2, 0x2b, 0x80, 0xee, 0xc7, 0x68, 0xdf, 0x09, 0x33, 0x03, 0xc7, 0xcb,
+	0x4a, 0x71, 0x03, 0x85, 0x58, 0xd8, 0xde, 0xad, 0x3e, 0x39, 0xbc, 0x3d, 0x1a, 0xae, 0xea, 0xf8,
+	0xa6, 0xb9, 0xae, 0xe3, 0x5e, 0x88, 0xbe, 0x61, 0x25, 0xe3, 0xbd, 0xa0, 0x9d, 0x54, 0x6a, 0xec,
+	0x95, 0xee, 0x4f, 0x12, 0x3d, 0x55, 0x62, 0xce, 0x33, 0x54, 0xba, 0x72, 0xc0, 0xab, 0x52, 0x3a,
+	0xcb, 0x1d, 0x72, 0x87, 0x39, 0x94, 0x96, 0xab, 0xaa, 0x70, 0x52, 0x17, 0x12, 0x4c, 0x6f, 0xc7,
+	0x0f, 0xfd, 0xb0, 0xaa, 0xe3, 0x2d, 0x3b, 0xd6, 0x75, 0x3c, 0x08, 0x2f, 0xd9, 0x8e, 0x4f, 0xc6,
+	0x4f, 0x94, 0x98, 0x1f, 0x07, 0xee, 0xdd, 0x06, 0x3b, 0xc5, 0x53, 0x0f, 0xbd, 0xbe, 0x64, 0x8e,
+	0xfa, 0xff, 0xbe, 0xc7, 0xe4, 0xeb, 0xdf, 0x5f, 0xcf, 0x1e, 0xb6, 0xbb, 0x30, 0xbf, 0xdc, 0x86,
+	0xf0, 0x47, 0xa3, 0x93, 0xdf, 0x0d, 0x25, 0xe7, 0x0d, 0x25, 0x17, 0x0d, 0x25, 0x7f, 0x1a, 0x4a,
+	0xbe, 0x2d, 0x69, 0xe7, 0x7c, 0x49, 0x3b, 0x17, 0x4b, 0xda, 0x79, 0xff, 0x7c, 0x26, 0xdd, 0xe7,
+	0x6a, 0x92, 0x66, 0xa8, 0x98, 0xc6, 0xdc, 0x0d, 0x4a, 0x70, 0x5f, 0xd0, 0xe4, 0xfe, 0x62, 0xb0,
+	0x28, 0xae, 0x04, 0xba, 0x85, 0x06, 0x3b, 0xd9, 0xf5, 0x5b, 0xf0, 0xf2, 0xff, 0x00, 0x48, 0x7a,
+	0xb5, 0x8b, 0x7d, 0x02, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -135,6 +151,9 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.TargetNumRelays != that1.TargetNumRelays {
 		return false
 	}
+	if this.MaxComputeUnitsToTokensMultiplier != that1.MaxComputeUnitsToTokensMultiplier {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -157,6 +176,11 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxComputeUnitsToTokensMultiplier != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxComputeUnitsToTokensMultiplier))
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.TargetNumRelays != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.TargetNumRelays))
 		i--
@@ -201,6 +225,9 @@ func (m *Params) Size() (n int) {
 	if m.TargetNumRelays != 0 {
 		n += 1 + sovParams(uint64(m.TargetNumRelays))
 	}
+	if m.MaxComputeUnitsToTokensMultiplier != 0 {
+		n += 1 + sovParams(uint64(m.MaxComputeUnitsToTokensMultiplier))
+	}
 	return n
 }
 
@@ -294,6 +321,25 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxComputeUnitsToTokensMultiplier", wireType)
+			}
+			m.MaxComputeUnitsToTokensMultiplier = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxComputeUnitsToTokensMultiplier |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
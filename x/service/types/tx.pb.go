@@ -353,6 +353,327 @@ func (m *MsgAddServiceResponse) GetService() *types1.Service {
 	return nil
 }
 
+// MsgSetServiceComputeUnitsToTokensMultiplier defines a message for the service
+// owner to update the compute units to tokens multiplier of its service.
+type MsgSetServiceComputeUnitsToTokensMultiplier struct {
+	OwnerAddress string `protobuf:"bytes,1,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`
+	ServiceId    string `protobuf:"bytes,2,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	// The amount of uPOKT a compute unit of the service translates to.
+	// Zero resets the service to the global 'compute_units_to_tokens_multiplier' shared param.
+	ComputeUnitsToTokensMultiplier uint64 `protobuf:"varint,3,opt,name=compute_units_to_tokens_multiplier,json=computeUnitsToTokensMultiplier,proto3" json:"compute_units_to_tokens_multiplier,omitempty"`
+}
+
+func (m *MsgSetServiceComputeUnitsToTokensMultiplier) Reset() {
+	*m = MsgSetServiceComputeUnitsToTokensMultiplier{}
+}
+func (m *MsgSetServiceComputeUnitsToTokensMultiplier) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgSetServiceComputeUnitsToTokensMultiplier) ProtoMessage() {}
+func (*MsgSetServiceComputeUnitsToTokensMultiplier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c139846c83c36dca, []int{6}
+}
+func (m *MsgSetServiceComputeUnitsToTokensMultiplier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetServiceComputeUnitsToTokensMultiplier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *MsgSetServiceComputeUnitsToTokensMultiplier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetServiceComputeUnitsToTokensMultiplier.Merge(m, src)
+}
+func (m *MsgSetServiceComputeUnitsToTokensMultiplier) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetServiceComputeUnitsToTokensMultiplier) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetServiceComputeUnitsToTokensMultiplier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetServiceComputeUnitsToTokensMultiplier proto.InternalMessageInfo
+
+func (m *MsgSetServiceComputeUnitsToTokensMultiplier) GetOwnerAddress() string {
+	if m != nil {
+		return m.OwnerAddress
+	}
+	return ""
+}
+
+func (m *MsgSetServiceComputeUnitsToTokensMultiplier) GetServiceId() string {
+	if m != nil {
+		return m.ServiceId
+	}
+	return ""
+}
+
+func (m *MsgSetServiceComputeUnitsToTokensMultiplier) GetComputeUnitsToTokensMultiplier() uint64 {
+	if m != nil {
+		return m.ComputeUnitsToTokensMultiplier
+	}
+	return 0
+}
+
+type MsgSetServiceComputeUnitsToTokensMultiplierResponse struct {
+	Service *types1.Service `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
+}
+
+func (m *MsgSetServiceComputeUnitsToTokensMultiplierResponse) Reset() {
+	*m = MsgSetServiceComputeUnitsToTokensMultiplierResponse{}
+}
+func (m *MsgSetServiceComputeUnitsToTokensMultiplierResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgSetServiceComputeUnitsToTokensMultiplierResponse) ProtoMessage() {}
+func (*MsgSetServiceComputeUnitsToTokensMultiplierResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c139846c83c36dca, []int{7}
+}
+func (m *MsgSetServiceComputeUnitsToTokensMultiplierResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetServiceComputeUnitsToTokensMultiplierResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *MsgSetServiceComputeUnitsToTokensMultiplierResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetServiceComputeUnitsToTokensMultiplierResponse.Merge(m, src)
+}
+func (m *MsgSetServiceComputeUnitsToTokensMultiplierResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetServiceComputeUnitsToTokensMultiplierResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetServiceComputeUnitsToTokensMultiplierResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetServiceComputeUnitsToTokensMultiplierResponse proto.InternalMessageInfo
+
+func (m *MsgSetServiceComputeUnitsToTokensMultiplierResponse) GetService() *types1.Service {
+	if m != nil {
+		return m.Service
+	}
+	return nil
+}
+
+// MsgUpdateServiceComputeUnitsToTokensMultiplier defines a message for governance
+// to update the compute units to tokens multiplier of any service.
+type MsgUpdateServiceComputeUnitsToTokensMultiplier struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	ServiceId string `protobuf:"bytes,2,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	// The amount of uPOKT a compute unit of the service translates to.
+	// Zero resets the service to the global 'compute_units_to_tokens_multiplier' shared param.
+	ComputeUnitsToTokensMultiplier uint64 `protobuf:"varint,3,opt,name=compute_units_to_tokens_multiplier,json=computeUnitsToTokensMultiplier,proto3" json:"compute_units_to_tokens_multiplier,omitempty"`
+}
+
+func (m *MsgUpdateServiceComputeUnitsToTokensMultiplier) Reset() {
+	*m = MsgUpdateServiceComputeUnitsToTokensMultiplier{}
+}
+func (m *MsgUpdateServiceComputeUnitsToTokensMultiplier) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgUpdateServiceComputeUnitsToTokensMultiplier) ProtoMessage() {}
+func (*MsgUpdateServiceComputeUnitsToTokensMultiplier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c139846c83c36dca, []int{8}
+}
+func (m *MsgUpdateServiceComputeUnitsToTokensMultiplier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateServiceComputeUnitsToTokensMultiplier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *MsgUpdateServiceComputeUnitsToTokensMultiplier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateServiceComputeUnitsToTokensMultiplier.Merge(m, src)
+}
+func (m *MsgUpdateServiceComputeUnitsToTokensMultiplier) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateServiceComputeUnitsToTokensMultiplier) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateServiceComputeUnitsToTokensMultiplier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateServiceComputeUnitsToTokensMultiplier proto.InternalMessageInfo
+
+func (m *MsgUpdateServiceComputeUnitsToTokensMultiplier) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateServiceComputeUnitsToTokensMultiplier) GetServiceId() string {
+	if m != nil {
+		return m.ServiceId
+	}
+	return ""
+}
+
+func (m *MsgUpdateServiceComputeUnitsToTokensMultiplier) GetComputeUnitsToTokensMultiplier() uint64 {
+	if m != nil {
+		return m.ComputeUnitsToTokensMultiplier
+	}
+	return 0
+}
+
+type MsgUpdateServiceComputeUnitsToTokensMultiplierResponse struct {
+	Service *types1.Service `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
+}
+
+func (m *MsgUpdateServiceComputeUnitsToTokensMultiplierResponse) Reset() {
+	*m = MsgUpdateServiceComputeUnitsToTokensMultiplierResponse{}
+}
+func (m *MsgUpdateServiceComputeUnitsToTokensMultiplierResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgUpdateServiceComputeUnitsToTokensMultiplierResponse) ProtoMessage() {}
+func (*MsgUpdateServiceComputeUnitsToTokensMultiplierResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c139846c83c36dca, []int{9}
+}
+func (m *MsgUpdateServiceComputeUnitsToTokensMultiplierResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateServiceComputeUnitsToTokensMultiplierResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *MsgUpdateServiceComputeUnitsToTokensMultiplierResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateServiceComputeUnitsToTokensMultiplierResponse.Merge(m, src)
+}
+func (m *MsgUpdateServiceComputeUnitsToTokensMultiplierResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateServiceComputeUnitsToTokensMultiplierResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateServiceComputeUnitsToTokensMultiplierResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateServiceComputeUnitsToTokensMultiplierResponse proto.InternalMessageInfo
+
+func (m *MsgUpdateServiceComputeUnitsToTokensMultiplierResponse) GetService() *types1.Service {
+	if m != nil {
+		return m.Service
+	}
+	return nil
+}
+
+// MsgSetServiceOwnerRevSharePercentage defines a message for the service owner
+// to update the percentage of its service's settled claims which it receives.
+type MsgSetServiceOwnerRevSharePercentage struct {
+	OwnerAddress string `protobuf:"bytes,1,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`
+	ServiceId    string `protobuf:"bytes,2,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	// The percentage of each settled claim's amount for the service which is routed to the service owner.
+	// It is bounded by the max_rev_share_percentage of the TLM_SERVICE_OWNER_REV_SHARE token logic module.
+	OwnerRevSharePercentage uint64 `protobuf:"varint,3,opt,name=owner_rev_share_percentage,json=ownerRevSharePercentage,proto3" json:"owner_rev_share_percentage,omitempty"`
+}
+
+func (m *MsgSetServiceOwnerRevSharePercentage) Reset()         { *m = MsgSetServiceOwnerRevSharePercentage{} }
+func (m *MsgSetServiceOwnerRevSharePercentage) String() string { return proto.CompactTextString(m) }
+func (*MsgSetServiceOwnerRevSharePercentage) ProtoMessage()    {}
+func (*MsgSetServiceOwnerRevSharePercentage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c139846c83c36dca, []int{10}
+}
+func (m *MsgSetServiceOwnerRevSharePercentage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetServiceOwnerRevSharePercentage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *MsgSetServiceOwnerRevSharePercentage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetServiceOwnerRevSharePercentage.Merge(m, src)
+}
+func (m *MsgSetServiceOwnerRevSharePercentage) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetServiceOwnerRevSharePercentage) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetServiceOwnerRevSharePercentage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetServiceOwnerRevSharePercentage proto.InternalMessageInfo
+
+func (m *MsgSetServiceOwnerRevSharePercentage) GetOwnerAddress() string {
+	if m != nil {
+		return m.OwnerAddress
+	}
+	return ""
+}
+
+func (m *MsgSetServiceOwnerRevSharePercentage) GetServiceId() string {
+	if m != nil {
+		return m.ServiceId
+	}
+	return ""
+}
+
+func (m *MsgSetServiceOwnerRevSharePercentage) GetOwnerRevSharePercentage() uint64 {
+	if m != nil {
+		return m.OwnerRevSharePercentage
+	}
+	return 0
+}
+
+type MsgSetServiceOwnerRevSharePercentageResponse struct {
+	Service *types1.Service `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
+}
+
+func (m *MsgSetServiceOwnerRevSharePercentageResponse) Reset() {
+	*m = MsgSetServiceOwnerRevSharePercentageResponse{}
+}
+func (m *MsgSetServiceOwnerRevSharePercentageResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgSetServiceOwnerRevSharePercentageResponse) ProtoMessage() {}
+func (*MsgSetServiceOwnerRevSharePercentageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c139846c83c36dca, []int{11}
+}
+func (m *MsgSetServiceOwnerRevSharePercentageResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetServiceOwnerRevSharePercentageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *MsgSetServiceOwnerRevSharePercentageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetServiceOwnerRevSharePercentageResponse.Merge(m, src)
+}
+func (m *MsgSetServiceOwnerRevSharePercentageResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetServiceOwnerRevSharePercentageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetServiceOwnerRevSharePercentageResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetServiceOwnerRevSharePercentageResponse proto.InternalMessageInfo
+
+func (m *MsgSetServiceOwnerRevSharePercentageResponse) GetService() *types1.Service {
+	if m != nil {
+		return m.Service
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "pocket.service.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "pocket.service.MsgUpdateParamsResponse")
@@ -360,51 +681,72 @@ func init() {
 	proto.RegisterType((*MsgUpdateParamResponse)(nil), "pocket.service.MsgUpdateParamResponse")
 	proto.RegisterType((*MsgAddService)(nil), "pocket.service.MsgAddService")
 	proto.RegisterType((*MsgAddServiceResponse)(nil), "pocket.service.MsgAddServiceResponse")
+	proto.RegisterType((*MsgSetServiceComputeUnitsToTokensMultiplier)(nil), "pocket.service.MsgSetServiceComputeUnitsToTokensMultiplier")
+	proto.RegisterType((*MsgSetServiceComputeUnitsToTokensMultiplierResponse)(nil), "pocket.service.MsgSetServiceComputeUnitsToTokensMultiplierResponse")
+	proto.RegisterType((*MsgUpdateServiceComputeUnitsToTokensMultiplier)(nil), "pocket.service.MsgUpdateServiceComputeUnitsToTokensMultiplier")
+	proto.RegisterType((*MsgUpdateServiceComputeUnitsToTokensMultiplierResponse)(nil), "pocket.service.MsgUpdateServiceComputeUnitsToTokensMultiplierResponse")
+	proto.RegisterType((*MsgSetServiceOwnerRevSharePercentage)(nil), "pocket.service.MsgSetServiceOwnerRevSharePercentage")
+	proto.RegisterType((*MsgSetServiceOwnerRevSharePercentageResponse)(nil), "pocket.service.MsgSetServiceOwnerRevSharePercentageResponse")
 }
 
 func init() { proto.RegisterFile("pocket/service/tx.proto", fileDescriptor_c139846c83c36dca) }
 
 var fileDescriptor_c139846c83c36dca = []byte{
-	// 614 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x3d, 0x6f, 0xd3, 0x40,
-	0x18, 0xf6, 0xb5, 0xa1, 0xc5, 0xd7, 0x0f, 0x84, 0x55, 0xda, 0xd4, 0x08, 0x27, 0xb2, 0x04, 0x54,
-	0x15, 0xb5, 0xfb, 0x81, 0x2a, 0x51, 0x89, 0xa1, 0x66, 0x29, 0x43, 0x11, 0x72, 0x15, 0x09, 0xb1,
-	0x44, 0x97, 0xf8, 0xe4, 0x58, 0xa9, 0x7d, 0x96, 0xef, 0x92, 0xb6, 0x1b, 0x62, 0x64, 0x82, 0x95,
-	0x5f, 0xc0, 0x98, 0x81, 0x85, 0x7f, 0xd0, 0xb1, 0x62, 0xea, 0x14, 0xa1, 0x64, 0x08, 0xea, 0xaf,
-	0x40, 0x3e, 0x9f, 0x9d, 0xd8, 0xa0, 0x54, 0x62, 0x49, 0xee, 0xde, 0xe7, 0x79, 0xbf, 0x9f, 0x33,
-	0x5c, 0x0b, 0x49, 0xb3, 0x8d, 0x99, 0x49, 0x71, 0xd4, 0xf5, 0x9a, 0xd8, 0x64, 0xe7, 0x46, 0x18,
-	0x11, 0x46, 0x94, 0xe5, 0x04, 0x30, 0x04, 0xa0, 0xde, 0x47, 0xbe, 0x17, 0x10, 0x93, 0xff, 0x26,
-	0x14, 0x55, 0x6b, 0x12, 0xea, 0x13, 0x6a, 0x36, 0x10, 0xc5, 0x66, 0x77, 0xa7, 0x81, 0x19, 0xda,
-	0x31, 0x9b, 0xc4, 0x0b, 0x04, 0xbe, 0x26, 0x70, 0x9f, 0xba, 0x66, 0x77, 0x27, 0xfe, 0x13, 0xc0,
-	0x7a, 0x02, 0xd4, 0xf9, 0xcd, 0x4c, 0x2e, 0x02, 0x5a, 0x71, 0x89, 0x4b, 0x12, 0x7b, 0x7c, 0x12,
-	0xd6, 0x87, 0x85, 0x2a, 0x43, 0x14, 0x21, 0x9f, 0x16, 0xc1, 0x16, 0x8a, 0xb0, 0x93, 0x72, 0x12,
-	0x50, 0xff, 0x01, 0xe0, 0xbd, 0x63, 0xea, 0xd6, 0x42, 0x07, 0x31, 0xfc, 0x96, 0xbb, 0x29, 0xfb,
-	0x50, 0x46, 0x1d, 0xd6, 0x22, 0x91, 0xc7, 0x2e, 0xca, 0xa0, 0x0a, 0x36, 0x64, 0xab, 0xfc, 0xf3,
-	0xfb, 0xd6, 0x8a, 0x28, 0xe4, 0xd0, 0x71, 0x22, 0x4c, 0xe9, 0x09, 0x8b, 0xbc, 0xc0, 0xb5, 0xc7,
-	0x54, 0xe5, 0x05, 0x9c, 0x4b, 0x12, 0x97, 0x67, 0xaa, 0x60, 0x63, 0x61, 0x77, 0xd5, 0xc8, 0xcf,
-	0xc8, 0x48, 0xe2, 0x5b, 0xf2, 0x65, 0xbf, 0x22, 0x7d, 0x1b, 0xf5, 0x36, 0x81, 0x2d, 0x1c, 0x0e,
-	0xf6, 0x3e, 0x8e, 0x7a, 0x9b, 0xe3, 0x50, 0x9f, 0x46, 0xbd, 0xcd, 0xaa, 0x28, 0xfb, 0x3c, 0xeb,
-	0xaa, 0x50, 0xa7, 0xbe, 0x0e, 0xd7, 0x0a, 0x26, 0x1b, 0xd3, 0x90, 0x04, 0x14, 0xeb, 0xbf, 0x01,
-	0x5c, 0xce, 0x63, 0xff, 0xdd, 0x95, 0x02, 0x4b, 0x01, 0xf2, 0x31, 0xef, 0x49, 0xb6, 0xf9, 0x59,
-	0x39, 0x84, 0xf3, 0x88, 0xd6, 0xe3, 0x55, 0x96, 0x67, 0x79, 0xab, 0xeb, 0x86, 0x08, 0x13, 0xef,
-	0xda, 0x10, 0xbb, 0x36, 0x5e, 0x11, 0x2f, 0xb0, 0x16, 0x6e, 0xfa, 0x95, 0x94, 0x7d, 0x24, 0xd9,
-	0x73, 0x88, 0xc6, 0x66, 0xe5, 0x19, 0x94, 0x11, 0xad, 0x77, 0xbc, 0x80, 0xed, 0x3f, 0x2f, 0x97,
-	0xaa, 0x60, 0xa3, 0x64, 0x2d, 0xdd, 0xf4, 0x2b, 0x63, 0xe3, 0x91, 0x64, 0xdf, 0x45, 0xb4, 0xc6,
-	0xcf, 0x07, 0xcb, 0xf9, 0xf9, 0x58, 0x32, 0x2f, 0x80, 0x5d, 0x84, 0x58, 0x3f, 0x82, 0xab, 0xf9,
-	0x4e, 0xd3, 0x21, 0x28, 0x46, 0xb6, 0x0f, 0x30, 0x6d, 0x1f, 0xe9, 0x12, 0xf4, 0xaf, 0x00, 0x2e,
-	0x1d, 0x53, 0xf7, 0xd0, 0x71, 0x4e, 0x12, 0x82, 0xf2, 0x12, 0x2e, 0x91, 0xb3, 0x00, 0x47, 0x75,
-	0x94, 0x4c, 0xe7, 0xd6, 0xb9, 0x2d, 0x72, 0xba, 0xb0, 0x29, 0xfb, 0x70, 0x5e, 0xa4, 0xfa, 0x4b,
-	0x11, 0x5c, 0x8b, 0x86, 0xc8, 0x63, 0x95, 0x62, 0x45, 0xd8, 0x29, 0xf9, 0x40, 0x89, 0xbb, 0xcd,
-	0x67, 0xd6, 0x5f, 0xc3, 0x07, 0xb9, 0xda, 0xb2, 0x2e, 0xb7, 0xc7, 0x49, 0xc0, 0xb4, 0x24, 0x59,
-	0xf8, 0xdd, 0x2f, 0x33, 0x70, 0xf6, 0x98, 0xba, 0xca, 0x3b, 0xb8, 0x98, 0xd3, 0x7d, 0xa5, 0x38,
-	0x9f, 0x82, 0xba, 0xd4, 0xa7, 0xb7, 0x10, 0xb2, 0x9a, 0x6a, 0x70, 0x61, 0x52, 0x7a, 0xda, 0x74,
-	0x3f, 0xf5, 0xc9, 0x74, 0x3c, 0x0b, 0x6b, 0x43, 0x38, 0xb1, 0x9c, 0x47, 0xff, 0xf0, 0x1a, 0xc3,
-	0xea, 0xe3, 0xa9, 0x70, 0x1a, 0x53, 0xbd, 0xf3, 0x21, 0x7e, 0x88, 0xd6, 0x9b, 0xcb, 0x81, 0x06,
-	0xae, 0x06, 0x1a, 0xb8, 0x1e, 0x68, 0xe0, 0xd7, 0x40, 0x03, 0x9f, 0x87, 0x9a, 0x74, 0x35, 0xd4,
-	0xa4, 0xeb, 0xa1, 0x26, 0xbd, 0xdf, 0x76, 0x3d, 0xd6, 0xea, 0x34, 0x8c, 0x26, 0xf1, 0xcd, 0x90,
-	0xb4, 0xd9, 0x56, 0x80, 0xd9, 0x19, 0x89, 0xda, 0xfc, 0x12, 0x91, 0xd3, 0xd3, 0x89, 0x57, 0x1a,
-	0x8b, 0x92, 0x36, 0xe6, 0xf8, 0xe7, 0x65, 0xef, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x5f, 0x3d,
-	0xa3, 0x7c, 0x40, 0x05, 0x00, 0x00,
+	// 861 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xcd, 0x6e, 0xe3, 0x54,
+	0x14, 0xce, 0x9d, 0x09, 0x19, 0x72, 0x3a, 0x2d, 0xc2, 0x1a, 0x26, 0xa9, 0xd1, 0x38, 0xc1, 0xe2,
+	0x27, 0xea, 0xcc, 0xd8, 0xd3, 0xe9, 0x28, 0x12, 0x1d, 0x7e, 0xd4, 0x54, 0x42, 0x1d, 0xa4, 0xc0,
+	0xc8, 0x99, 0x48, 0x88, 0x8d, 0x71, 0xec, 0x2b, 0xd7, 0x24, 0xf6, 0xb5, 0x7c, 0x6f, 0xd2, 0x76,
+	0x87, 0x58, 0xb2, 0x42, 0x6c, 0x90, 0x78, 0x01, 0x58, 0xb0, 0xe8, 0x02, 0x16, 0xbc, 0x41, 0x97,
+	0x15, 0x12, 0x52, 0x57, 0x15, 0x4a, 0x17, 0x45, 0xe5, 0x25, 0x90, 0xed, 0x1b, 0xa7, 0x4e, 0xd3,
+	0x34, 0x4d, 0xc4, 0x6c, 0x12, 0xfb, 0x7c, 0xe7, 0xe7, 0x3b, 0xdf, 0x39, 0xbe, 0x36, 0x14, 0x7c,
+	0x62, 0xb6, 0x31, 0x53, 0x29, 0x0e, 0x7a, 0x8e, 0x89, 0x55, 0xb6, 0xab, 0xf8, 0x01, 0x61, 0x44,
+	0x58, 0x8a, 0x01, 0x85, 0x03, 0xe2, 0xeb, 0x86, 0xeb, 0x78, 0x44, 0x8d, 0x7e, 0x63, 0x17, 0x51,
+	0x32, 0x09, 0x75, 0x09, 0x55, 0x5b, 0x06, 0xc5, 0x6a, 0x6f, 0xb5, 0x85, 0x99, 0xb1, 0xaa, 0x9a,
+	0xc4, 0xf1, 0x38, 0x5e, 0xe0, 0xb8, 0x4b, 0x6d, 0xb5, 0xb7, 0x1a, 0xfe, 0x71, 0x60, 0x39, 0x06,
+	0xf4, 0xe8, 0x4e, 0x8d, 0x6f, 0x38, 0x74, 0xc7, 0x26, 0x36, 0x89, 0xed, 0xe1, 0x15, 0xb7, 0xbe,
+	0x39, 0xc2, 0xd2, 0x37, 0x02, 0xc3, 0xa5, 0xa3, 0xe0, 0xb6, 0x11, 0x60, 0x6b, 0xe0, 0x13, 0x83,
+	0xf2, 0x1f, 0x08, 0x5e, 0xab, 0x53, 0xbb, 0xe9, 0x5b, 0x06, 0xc3, 0xcf, 0xa3, 0x30, 0xa1, 0x0a,
+	0x79, 0xa3, 0xcb, 0xb6, 0x49, 0xe0, 0xb0, 0xbd, 0x22, 0x2a, 0xa3, 0x4a, 0xbe, 0x56, 0xfc, 0xf3,
+	0xb7, 0x87, 0x77, 0x38, 0x91, 0x0d, 0xcb, 0x0a, 0x30, 0xa5, 0x0d, 0x16, 0x38, 0x9e, 0xad, 0x0d,
+	0x5d, 0x85, 0xf7, 0x21, 0x17, 0x17, 0x2e, 0xde, 0x28, 0xa3, 0xca, 0xc2, 0xe3, 0xbb, 0x4a, 0x5a,
+	0x23, 0x25, 0xce, 0x5f, 0xcb, 0x1f, 0x1c, 0x97, 0x32, 0xbf, 0x9c, 0xee, 0xaf, 0x20, 0x8d, 0x07,
+	0xac, 0xaf, 0x7d, 0x7b, 0xba, 0xbf, 0x32, 0x4c, 0xf5, 0xdd, 0xe9, 0xfe, 0x4a, 0x99, 0xd3, 0xde,
+	0x4d, 0xba, 0x1a, 0xe1, 0x29, 0x2f, 0x43, 0x61, 0xc4, 0xa4, 0x61, 0xea, 0x13, 0x8f, 0x62, 0xf9,
+	0x1f, 0x04, 0x4b, 0x69, 0x6c, 0xe6, 0xae, 0x04, 0xc8, 0x7a, 0x86, 0x8b, 0xa3, 0x9e, 0xf2, 0x5a,
+	0x74, 0x2d, 0x6c, 0xc0, 0x2d, 0x83, 0xea, 0xe1, 0x28, 0x8b, 0x37, 0xa3, 0x56, 0x97, 0x15, 0x9e,
+	0x26, 0x9c, 0xb5, 0xc2, 0x67, 0xad, 0x6c, 0x12, 0xc7, 0xab, 0x2d, 0x9c, 0x1d, 0x97, 0x06, 0xde,
+	0x5b, 0x19, 0x2d, 0x67, 0xd0, 0xd0, 0x2c, 0x3c, 0x80, 0xbc, 0x41, 0xf5, 0xae, 0xe3, 0xb1, 0xea,
+	0x93, 0x62, 0xb6, 0x8c, 0x2a, 0xd9, 0xda, 0xe2, 0xd9, 0x71, 0x69, 0x68, 0xdc, 0xca, 0x68, 0xaf,
+	0x1a, 0xb4, 0x19, 0x5d, 0xaf, 0x2f, 0xa5, 0xf5, 0xa9, 0xe5, 0x23, 0x02, 0x6c, 0xcf, 0xc7, 0xf2,
+	0x16, 0xdc, 0x4d, 0x77, 0x3a, 0x10, 0x41, 0x50, 0x92, 0x79, 0xa0, 0x49, 0xf3, 0x18, 0x0c, 0x41,
+	0xfe, 0x09, 0xc1, 0x62, 0x9d, 0xda, 0x1b, 0x96, 0xd5, 0x88, 0x1d, 0x84, 0x0f, 0x61, 0x91, 0xec,
+	0x78, 0x38, 0xd0, 0x8d, 0x58, 0x9d, 0x2b, 0x75, 0xbb, 0x1d, 0xb9, 0x73, 0x9b, 0x50, 0x85, 0x5b,
+	0xbc, 0xd4, 0x85, 0x8d, 0x88, 0x76, 0x51, 0xe1, 0x75, 0x6a, 0xd9, 0x70, 0x23, 0xb4, 0x81, 0xf3,
+	0xba, 0x10, 0x76, 0x9b, 0xae, 0x2c, 0x3f, 0x83, 0x37, 0x52, 0xdc, 0x92, 0x2e, 0x1f, 0x0d, 0x8b,
+	0xa0, 0x49, 0x45, 0x92, 0xf4, 0xf2, 0xbf, 0x08, 0xee, 0xd7, 0xa9, 0xdd, 0xc0, 0x8c, 0x43, 0x9b,
+	0xc4, 0xf5, 0xbb, 0x0c, 0x37, 0x3d, 0x87, 0xd1, 0x17, 0xe4, 0x05, 0x69, 0x63, 0x8f, 0xd6, 0xbb,
+	0x1d, 0xe6, 0xf8, 0x1d, 0x07, 0x07, 0xf3, 0xaa, 0x70, 0x0f, 0x80, 0x57, 0xd6, 0x1d, 0x8b, 0xaf,
+	0x51, 0x9e, 0x5b, 0x9e, 0x59, 0xc2, 0xa7, 0x20, 0x9b, 0x71, 0x7d, 0xbd, 0x1b, 0x12, 0xd0, 0x19,
+	0xd1, 0x59, 0x44, 0x41, 0x77, 0x13, 0x0e, 0xd1, 0x9a, 0x65, 0x35, 0xc9, 0x9c, 0xc8, 0x74, 0xac,
+	0x70, 0x36, 0xac, 0x5d, 0xa3, 0xd9, 0x39, 0x64, 0xfd, 0xf9, 0x06, 0x28, 0xc9, 0x26, 0x4e, 0xa7,
+	0xec, 0xac, 0xcf, 0xe4, 0x4b, 0x94, 0x54, 0xbb, 0x78, 0x32, 0x7d, 0x7c, 0xf9, 0xc9, 0x34, 0x55,
+	0xdb, 0xf2, 0xd7, 0x50, 0xbd, 0x5e, 0xc4, 0x1c, 0x53, 0xf9, 0x0b, 0xc1, 0xdb, 0xa9, 0xf9, 0x7f,
+	0x1e, 0x6e, 0x87, 0x86, 0x7b, 0x8d, 0x30, 0xe0, 0x39, 0x0e, 0x4c, 0xec, 0x31, 0xc3, 0xc6, 0xff,
+	0xf3, 0x96, 0x3f, 0x05, 0x31, 0xce, 0x1e, 0xe0, 0x9e, 0x1e, 0x71, 0xd5, 0xfd, 0xa4, 0x36, 0x1f,
+	0x45, 0x81, 0x8c, 0xa7, 0x36, 0x76, 0xad, 0xbf, 0x82, 0x07, 0xd3, 0xb4, 0x35, 0xbb, 0x72, 0x8f,
+	0x7f, 0xc8, 0xc1, 0xcd, 0x3a, 0xb5, 0x85, 0x2f, 0xe0, 0x76, 0xea, 0xf5, 0x58, 0x1a, 0x3d, 0x46,
+	0x47, 0x5e, 0x42, 0xe2, 0x7b, 0x57, 0x38, 0x24, 0x9c, 0x9a, 0xb0, 0x70, 0xce, 0x2e, 0x48, 0x93,
+	0xe3, 0xc4, 0x77, 0x27, 0xe3, 0x49, 0x5a, 0x0d, 0xe0, 0xdc, 0x19, 0x7e, 0x6f, 0x4c, 0xd4, 0x10,
+	0x16, 0xdf, 0x99, 0x08, 0x27, 0x39, 0x7f, 0x45, 0x50, 0x99, 0xfa, 0xc0, 0x7c, 0x3a, 0x26, 0xe7,
+	0xb4, 0xc1, 0xe2, 0xe6, 0x1c, 0xc1, 0x09, 0xdd, 0xdf, 0x11, 0xdc, 0xbf, 0xce, 0x41, 0xf4, 0xd1,
+	0xa5, 0xd2, 0x4e, 0x47, 0xfa, 0x93, 0xf9, 0xe2, 0x13, 0xde, 0x3f, 0x22, 0x78, 0xeb, 0xea, 0x47,
+	0xf5, 0xc9, 0x44, 0x89, 0x2e, 0x89, 0x12, 0x3f, 0x98, 0x25, 0x6a, 0xc0, 0x4c, 0x7c, 0xe5, 0x9b,
+	0xf0, 0x83, 0xad, 0xf6, 0xd9, 0x41, 0x5f, 0x42, 0x87, 0x7d, 0x09, 0x1d, 0xf5, 0x25, 0xf4, 0x77,
+	0x5f, 0x42, 0xdf, 0x9f, 0x48, 0x99, 0xc3, 0x13, 0x29, 0x73, 0x74, 0x22, 0x65, 0xbe, 0x7c, 0x64,
+	0x3b, 0x6c, 0xbb, 0xdb, 0x52, 0x4c, 0xe2, 0xaa, 0x3e, 0x69, 0xb3, 0x87, 0x1e, 0x66, 0x3b, 0x24,
+	0x68, 0x47, 0x37, 0x01, 0xe9, 0x74, 0xce, 0x9d, 0x99, 0xe1, 0xc7, 0x0b, 0x6d, 0xe5, 0xa2, 0xcf,
+	0xd0, 0xb5, 0xff, 0x06, 0x00, 0xac, 0xfc, 0x8c, 0x74, 0x68, 0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -424,6 +766,15 @@ type MsgClient interface {
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 	UpdateParam(ctx context.Context, in *MsgUpdateParam, opts ...grpc.CallOption) (*MsgUpdateParamResponse, error)
 	AddService(ctx context.Context, in *MsgAddService, opts ...grpc.CallOption) (*MsgAddServiceResponse, error)
+	// SetServiceComputeUnitsToTokensMultiplier defines a (service owner) operation
+	// for updating the compute units to tokens multiplier of a service.
+	SetServiceComputeUnitsToTokensMultiplier(ctx context.Context, in *MsgSetServiceComputeUnitsToTokensMultiplier, opts ...grpc.CallOption) (*MsgSetServiceComputeUnitsToTokensMultiplierResponse, error)
+	// UpdateServiceComputeUnitsToTokensMultiplier defines a (governance) operation
+	// for updating the compute units to tokens multiplier of any service.
+	UpdateServiceComputeUnitsToTokensMultiplier(ctx context.Context, in *MsgUpdateServiceComputeUnitsToTokensMultiplier, opts ...grpc.CallOption) (*MsgUpdateServiceComputeUnitsToTokensMultiplierResponse, error)
+	// SetServiceOwnerRevSharePercentage defines a (service owner) operation
+	// for updating the percentage of the settled claims which is routed to the service owner.
+	SetServiceOwnerRevSharePercentage(ctx context.Context, in *MsgSetServiceOwnerRevSharePercentage, opts ...grpc.CallOption) (*MsgSetServiceOwnerRevSharePercentageResponse, error)
 }
 
 type msgClient struct {
@@ -461,6 +812,33 @@ func (c *msgClient) AddService(ctx context.Context, in *MsgAddService, opts ...g
 	return out, nil
 }
 
+func (c *msgClient) SetServiceComputeUnitsToTokensMultiplier(ctx context.Context, in *MsgSetServiceComputeUnitsToTokensMultiplier, opts ...grpc.CallOption) (*MsgSetServiceComputeUnitsToTokensMultiplierResponse, error) {
+	out := new(MsgSetServiceComputeUnitsToTokensMultiplierResponse)
+	err := c.cc.Invoke(ctx, "/pocket.service.Msg/SetServiceComputeUnitsToTokensMultiplier", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateServiceComputeUnitsToTokensMultiplier(ctx context.Context, in *MsgUpdateServiceComputeUnitsToTokensMultiplier, opts ...grpc.CallOption) (*MsgUpdateServiceComputeUnitsToTokensMultiplierResponse, error) {
+	out := new(MsgUpdateServiceComputeUnitsToTokensMultiplierResponse)
+	err := c.cc.Invoke(ctx, "/pocket.service.Msg/UpdateServiceComputeUnitsToTokensMultiplier", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetServiceOwnerRevSharePercentage(ctx context.Context, in *MsgSetServiceOwnerRevSharePercentage, opts ...grpc.CallOption) (*MsgSetServiceOwnerRevSharePercentageResponse, error) {
+	out := new(MsgSetServiceOwnerRevSharePercentageResponse)
+	err := c.cc.Invoke(ctx, "/pocket.service.Msg/SetServiceOwnerRevSharePercentage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// UpdateParams defines a (governance) operation for updating the module
@@ -468,6 +846,15 @@ type MsgServer interface {
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 	UpdateParam(context.Context, *MsgUpdateParam) (*MsgUpdateParamResponse, error)
 	AddService(context.Context, *MsgAddService) (*MsgAddServiceResponse, error)
+	// SetServiceComputeUnitsToTokensMultiplier defines a (service owner) operation
+	// for updating the compute units to tokens multiplier of a service.
+	SetServiceComputeUnitsToTokensMultiplier(context.Context, *MsgSetServiceComputeUnitsToTokensMultiplier) (*MsgSetServiceComputeUnitsToTokensMultiplierResponse, error)
+	// UpdateServiceComputeUnitsToTokensMultiplier defines a (governance) operation
+	// for updating the compute units to tokens multiplier of any service.
+	UpdateServiceComputeUnitsToTokensMultiplier(context.Context, *MsgUpdateServiceComputeUnitsToTokensMultiplier) (*MsgUpdateServiceComputeUnitsToTokensMultiplierResponse, error)
+	// SetServiceOwnerRevSharePercentage defines a (service owner) operation
+	// for updating the percentage of the settled claims which is routed to the service owner.
+	SetServiceOwnerRevSharePercentage(context.Context, *MsgSetServiceOwnerRevSharePercentage) (*MsgSetServiceOwnerRevSharePercentageResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -483,6 +870,15 @@ func (*UnimplementedMsgServer) UpdateParam(ctx context.Context, req *MsgUpdatePa
 func (*UnimplementedMsgServer) AddService(ctx context.Context, req *MsgAddService) (*MsgAddServiceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddService not implemented")
 }
+func (*UnimplementedMsgServer) SetServiceComputeUnitsToTokensMultiplier(ctx context.Context, req *MsgSetServiceComputeUnitsToTokensMultiplier) (*MsgSetServiceComputeUnitsToTokensMultiplierResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetServiceComputeUnitsToTokensMultiplier not implemented")
+}
+func (*UnimplementedMsgServer) UpdateServiceComputeUnitsToTokensMultiplier(ctx context.Context, req *MsgUpdateServiceComputeUnitsToTokensMultiplier) (*MsgUpdateServiceComputeUnitsToTokensMultiplierResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateServiceComputeUnitsToTokensMultiplier not implemented")
+}
+func (*UnimplementedMsgServer) SetServiceOwnerRevSharePercentage(ctx context.Context, req *MsgSetServiceOwnerRevSharePercentage) (*MsgSetServiceOwnerRevSharePercentageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetServiceOwnerRevSharePercentage not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -542,6 +938,60 @@ func _Msg_AddService_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetServiceComputeUnitsToTokensMultiplier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetServiceComputeUnitsToTokensMultiplier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetServiceComputeUnitsToTokensMultiplier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pocket.service.Msg/SetServiceComputeUnitsToTokensMultiplier",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetServiceComputeUnitsToTokensMultiplier(ctx, req.(*MsgSetServiceComputeUnitsToTokensMultiplier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateServiceComputeUnitsToTokensMultiplier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateServiceComputeUnitsToTokensMultiplier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateServiceComputeUnitsToTokensMultiplier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pocket.service.Msg/UpdateServiceComputeUnitsToTokensMultiplier",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateServiceComputeUnitsToTokensMultiplier(ctx, req.(*MsgUpdateServiceComputeUnitsToTokensMultiplier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetServiceOwnerRevSharePercentage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetServiceOwnerRevSharePercentage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetServiceOwnerRevSharePercentage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pocket.service.Msg/SetServiceOwnerRevSharePercentage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetServiceOwnerRevSharePercentage(ctx, req.(*MsgSetServiceOwnerRevSharePercentage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "pocket.service.Msg",
@@ -559,6 +1009,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AddService",
 			Handler:    _Msg_AddService_Handler,
 		},
+		{
+			MethodName: "SetServiceComputeUnitsToTokensMultiplier",
+			Handler:    _Msg_SetServiceComputeUnitsToTokensMultiplier_Handler,
+		},
+		{
+			MethodName: "UpdateServiceComputeUnitsToTokensMultiplier",
+			Handler:    _Msg_UpdateServiceComputeUnitsToTokensMultiplier_Handler,
+		},
+		{
+			MethodName: "SetServiceOwnerRevSharePercentage",
+			Handler:    _Msg_SetServiceOwnerRevSharePercentage_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pocket/service/tx.proto",
@@ -816,7 +1278,238 @@ func (m *MsgAddServiceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+func (m *MsgSetServiceComputeUnitsToTokensMultiplier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetServiceComputeUnitsToTokensMultiplier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetServiceComputeUnitsToTokensMultiplier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ComputeUnitsToTokensMultiplier != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ComputeUnitsToTokensMultiplier))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ServiceId) > 0 {
+		i -= len(m.ServiceId)
+		copy(dAtA[i:], m.ServiceId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ServiceId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OwnerAddress) > 0 {
+		i -= len(m.OwnerAddress)
+		copy(dAtA[i:], m.OwnerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OwnerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetServiceComputeUnitsToTokensMultiplierResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetServiceComputeUnitsToTokensMultiplierResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetServiceComputeUnitsToTokensMultiplierResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Service != nil {
+		{
+			size, err := m.Service.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateServiceComputeUnitsToTokensMultiplier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateServiceComputeUnitsToTokensMultiplier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateServiceComputeUnitsToTokensMultiplier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ComputeUnitsToTokensMultiplier != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ComputeUnitsToTokensMultiplier))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ServiceId) > 0 {
+		i -= len(m.ServiceId)
+		copy(dAtA[i:], m.ServiceId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ServiceId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateServiceComputeUnitsToTokensMultiplierResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateServiceComputeUnitsToTokensMultiplierResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateServiceComputeUnitsToTokensMultiplierResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Service != nil {
+		{
+			size, err := m.Service.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetServiceOwnerRevSharePercentage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetServiceOwnerRevSharePercentage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetServiceOwnerRevSharePercentage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OwnerRevSharePercentage != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OwnerRevSharePercentage))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ServiceId) > 0 {
+		i -= len(m.ServiceId)
+		copy(dAtA[i:], m.ServiceId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ServiceId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OwnerAddress) > 0 {
+		i -= len(m.OwnerAddress)
+		copy(dAtA[i:], m.OwnerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OwnerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetServiceOwnerRevSharePercentageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetServiceOwnerRevSharePercentageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetServiceOwnerRevSharePercentageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Service != nil {
+		{
+			size, err := m.Service.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
 	for v >= 1<<7 {
@@ -930,16 +1623,736 @@ func (m *MsgAddServiceResponse) Size() (n int) {
 		l = m.Service.Size()
 		n += 1 + l + sovTx(uint64(l))
 	}
-	return n
+	return n
+}
+
+func (m *MsgSetServiceComputeUnitsToTokensMultiplier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ServiceId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ComputeUnitsToTokensMultiplier != 0 {
+		n += 1 + sovTx(uint64(m.ComputeUnitsToTokensMultiplier))
+	}
+	return n
+}
+
+func (m *MsgSetServiceComputeUnitsToTokensMultiplierResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Service != nil {
+		l = m.Service.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateServiceComputeUnitsToTokensMultiplier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ServiceId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ComputeUnitsToTokensMultiplier != 0 {
+		n += 1 + sovTx(uint64(m.ComputeUnitsToTokensMultiplier))
+	}
+	return n
+}
+
+func (m *MsgUpdateServiceComputeUnitsToTokensMultiplierResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Service != nil {
+		l = m.Service.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetServiceOwnerRevSharePercentage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ServiceId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.OwnerRevSharePercentage != 0 {
+		n += 1 + sovTx(uint64(m.OwnerRevSharePercentage))
+	}
+	return n
+}
+
+func (m *MsgSetServiceOwnerRevSharePercentageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Service != nil {
+		l = m.Service.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParam) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParam: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParam: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AsCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &types.Coin{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.AsType = &MsgUpdateParam_AsCoin{v}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AsUint64", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AsType = &MsgUpdateParam_AsUint64{v}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Params == nil {
+				m.Params = &Params{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddService) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddService: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddService: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Service.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
+func (m *MsgAddServiceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddServiceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddServiceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Service == nil {
+				m.Service = &types1.Service{}
+			}
+			if err := m.Service.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgSetServiceComputeUnitsToTokensMultiplier) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -962,15 +2375,15 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetServiceComputeUnitsToTokensMultiplier: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetServiceComputeUnitsToTokensMultiplier: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -998,13 +2411,13 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1014,25 +2427,43 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ServiceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ComputeUnitsToTokensMultiplier", wireType)
+			}
+			m.ComputeUnitsToTokensMultiplier = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ComputeUnitsToTokensMultiplier |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1054,7 +2485,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetServiceComputeUnitsToTokensMultiplierResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1077,12 +2508,48 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetServiceComputeUnitsToTokensMultiplierResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetServiceComputeUnitsToTokensMultiplierResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Service == nil {
+				m.Service = &types1.Service{}
+			}
+			if err := m.Service.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1104,7 +2571,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParam) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateServiceComputeUnitsToTokensMultiplier) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1127,10 +2594,10 @@ func (m *MsgUpdateParam) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParam: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateServiceComputeUnitsToTokensMultiplier: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParam: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateServiceComputeUnitsToTokensMultiplier: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1167,7 +2634,7 @@ func (m *MsgUpdateParam) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1195,48 +2662,13 @@ func (m *MsgUpdateParam) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.ServiceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AsCoin", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &types.Coin{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.AsType = &MsgUpdateParam_AsCoin{v}
-			iNdEx = postIndex
-		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AsUint64", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ComputeUnitsToTokensMultiplier", wireType)
 			}
-			var v uint64
+			m.ComputeUnitsToTokensMultiplier = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1246,12 +2678,11 @@ func (m *MsgUpdateParam) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= uint64(b&0x7F) << shift
+				m.ComputeUnitsToTokensMultiplier |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.AsType = &MsgUpdateParam_AsUint64{v}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1273,7 +2704,7 @@ func (m *MsgUpdateParam) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateServiceComputeUnitsToTokensMultiplierResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1296,15 +2727,15 @@ func (m *MsgUpdateParamResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateServiceComputeUnitsToTokensMultiplierResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateServiceComputeUnitsToTokensMultiplierResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1331,10 +2762,10 @@ func (m *MsgUpdateParamResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Params == nil {
-				m.Params = &Params{}
+			if m.Service == nil {
+				m.Service = &types1.Service{}
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Service.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1359,7 +2790,7 @@ func (m *MsgUpdateParamResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddService) Unmarshal(dAtA []byte) error {
+func (m *MsgSetServiceOwnerRevSharePercentage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1382,10 +2813,10 @@ func (m *MsgAddService) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddService: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetServiceOwnerRevSharePercentage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddService: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetServiceOwnerRevSharePercentage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1422,9 +2853,9 @@ func (m *MsgAddService) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1434,25 +2865,43 @@ func (m *MsgAddService) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Service.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ServiceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerRevSharePercentage", wireType)
+			}
+			m.OwnerRevSharePercentage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OwnerRevSharePercentage |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1474,7 +2923,7 @@ func (m *MsgAddService) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddServiceResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetServiceOwnerRevSharePercentageResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1497,10 +2946,10 @@ func (m *MsgAddServiceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddServiceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetServiceOwnerRevSharePercentageResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddServiceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetServiceOwnerRevSharePercentageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
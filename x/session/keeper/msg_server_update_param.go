@@ -7,6 +7,7 @@ import (
 	"google.golang.org/grpc/status"
 
 	sessiontypes "github.com/pokt-network/poktroll/x/session/types"
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
 	suppliertypes "github.com/pokt-network/poktroll/x/supplier/types"
 
 	"google.golang.org/grpc/codes"
@@ -59,6 +60,11 @@ func (k msgServer) UpdateParam(ctx context.Context, msg *sessiontypes.MsgUpdateP
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	if err := sharedtypes.EmitParamsUpdatedEvent(ctx, sessiontypes.ModuleName); err != nil {
+		logger.Error(err.Error())
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
 	updatedParams := k.GetParams(ctx)
 
 	return &sessiontypes.MsgUpdateParamResponse{
@@ -180,11 +180,6 @@ func (k Keeper) hydrateSessionSuppliers(ctx context.Context, sh *sessionHydrator
 	// https://github.com/pokt-network/poktroll/pull/1103#discussion_r1992214953
 	numSuppliersPerSession := int(k.GetParams(ctx).NumSuppliersPerSession)
 
-	// Map supplier operator addresses to random weights for deterministic sorting.
-	// This ensures fair distribution when:
-	// - NumCandidateSuppliers exceeds NumSuppliersPerSession
-	// - We need to randomly but fairly determine which suppliers can serve Applications
-	candidatesToRandomWeight := make(map[string]int)
 	candidateSupplierConfigs := make([]*sharedtypes.ServiceConfigUpdate, 0)
 
 	// Get an iterator of service configurations updates at the query height or earlier.
@@ -207,9 +202,24 @@ func (k Keeper) hydrateSessionSuppliers(ctx context.Context, sh *sessionHydrator
 		// This check is necessary because:
 		// - The iterator filters by: activationHeight <= currentHeight (sh.blockHeight)
 		// - We also need to check if its active: deactivationHeight > currentHeight (sh.blockHeight)
-		if supplierServiceConfigUpdate.IsActive(sh.blockHeight) {
-			candidateSupplierConfigs = append(candidateSupplierConfigs, supplierServiceConfigUpdate)
+		if !supplierServiceConfigUpdate.IsActive(sh.blockHeight) {
+			continue
+		}
+
+		// Exclude the suppliers which are jailed at query block height due to
+		// repeated proof faults.
+		// Jailing and unjailing take effect at session boundaries, so a supplier
+		// is either jailed or not for the whole session.
+		supplier, found := k.supplierKeeper.GetDehydratedSupplier(ctx, supplierServiceConfigUpdate.OperatorAddress)
+		if found && supplier.IsJailed(sh.blockHeight) {
+			logger.Debug(fmt.Sprintf(
+				"excluding jailed supplier %q from the session",
+				supplierServiceConfigUpdate.OperatorAddress,
+			))
+			continue
 		}
+
+		candidateSupplierConfigs = append(candidateSupplierConfigs, supplierServiceConfigUpdate)
 	}
 
 	defer telemetry.SessionSuppliersGauge(len(candidateSupplierConfigs), numSuppliersPerSession, sh.sessionHeader.ServiceId)
@@ -237,12 +247,7 @@ func (k Keeper) hydrateSessionSuppliers(ctx context.Context, sh *sessionHydrator
 		return nil
 	}
 
-	for _, serviceConfigUpdate := range candidateSupplierConfigs {
-		supplierOperatorAddress := serviceConfigUpdate.OperatorAddress
-		candidatesToRandomWeight[supplierOperatorAddress] = generateSupplierRandomWeight(supplierOperatorAddress, sh.sessionIDBz)
-	}
-
-	sortedCandidates := sortCandidateSupplierConfigsBySupplierWeight(candidateSupplierConfigs, candidatesToRandomWeight)
+	sortedCandidates := SortCandidateSupplierConfigs(sh.sessionIDBz, candidateSupplierConfigs)
 	suppliers := k.getServiceConfigsSuppliers(ctx, sortedCandidates[:numSuppliersPerSession])
 	sh.session.Suppliers = suppliers
 
@@ -349,6 +354,27 @@ func getSessionStartBlockHeightBz(sharedParams *sharedtypes.Params, blockHeight
 	return sessionStartBlockHeightBz
 }
 
+// SortCandidateSupplierConfigs sorts the given candidate service config updates
+// of the session with the given ID bytes by the random weights of their suppliers.
+// The first NumSuppliersPerSession sorted candidates are selected for the session.
+// NB: It is publicly exposed to verify the session suppliers selection offchain.
+func SortCandidateSupplierConfigs(
+	sessionIDBz []byte,
+	candidateSupplierConfigs []*sharedtypes.ServiceConfigUpdate,
+) []*sharedtypes.ServiceConfigUpdate {
+	// Map supplier operator addresses to random weights for deterministic sorting.
+	// This ensures fair distribution when:
+	// - NumCandidateSuppliers exceeds NumSuppliersPerSession
+	// - We need to randomly but fairly determine which suppliers can serve Applications
+	candidatesToRandomWeight := make(map[string]int, len(candidateSupplierConfigs))
+	for _, serviceConfigUpdate := range candidateSupplierConfigs {
+		supplierOperatorAddress := serviceConfigUpdate.OperatorAddress
+		candidatesToRandomWeight[supplierOperatorAddress] = generateSupplierRandomWeight(supplierOperatorAddress, sessionIDBz)
+	}
+
+	return sortCandidateSupplierConfigsBySupplierWeight(candidateSupplierConfigs, candidatesToRandomWeight)
+}
+
 // sortCandidateSupplierConfigsBySupplierWeight sorts the given service config
 // list by their corresponding suppliers addresses using the provided random weights map.
 func sortCandidateSupplierConfigsBySupplierWeight(
@@ -360,3 +360,94 @@ func TestSession_HydrateSession_Suppliers(t *testing.T) {
 		require.Len(t, session.Suppliers, test.numExpectedSuppliers)
 	}
 }
+
+func TestSession_HydrateSession_JailedSuppliers(t *testing.T) {
+	// The session of the hydrated block height starts at height 9 given the
+	// test shared params (i.e. 4 blocks per session).
+	blockHeight := int64(10)
+
+	tests := []struct {
+		desc string
+
+		jailHistory []*sharedtypes.SupplierJailPeriod
+
+		numExpectedSuppliers int
+		expectedErr          error
+	}{
+		{
+			desc: "supplier was never jailed",
+
+			numExpectedSuppliers: 1,
+		},
+		{
+			desc: "supplier is jailed starting from the session",
+			jailHistory: []*sharedtypes.SupplierJailPeriod{
+				{JailStartHeight: 9, JailEndHeight: 12},
+			},
+
+			expectedErr: types.ErrSessionSuppliersNotFound,
+		},
+		{
+			desc: "supplier is jailed and unjailed starting from the next session",
+			jailHistory: []*sharedtypes.SupplierJailPeriod{
+				{JailStartHeight: 5, JailEndHeight: 8, UnjailHeight: 13},
+			},
+
+			expectedErr: types.ErrSessionSuppliersNotFound,
+		},
+		{
+			desc: "supplier is jailed starting from the next session",
+			jailHistory: []*sharedtypes.SupplierJailPeriod{
+				{JailStartHeight: 13, JailEndHeight: 16},
+			},
+
+			numExpectedSuppliers: 1,
+		},
+		{
+			desc: "supplier is unjailed starting from the session",
+			jailHistory: []*sharedtypes.SupplierJailPeriod{
+				{JailStartHeight: 5, JailEndHeight: 8, UnjailHeight: 9},
+			},
+
+			numExpectedSuppliers: 1,
+		},
+		{
+			desc: "supplier is jailed, unjailed and jailed again after the session",
+			jailHistory: []*sharedtypes.SupplierJailPeriod{
+				{JailStartHeight: 5, JailEndHeight: 8, UnjailHeight: 13},
+				{JailStartHeight: 17, JailEndHeight: 20},
+			},
+
+			expectedErr: types.ErrSessionSuppliersNotFound,
+		},
+		{
+			desc: "supplier is unjailed before the session and jailed again after it",
+			jailHistory: []*sharedtypes.SupplierJailPeriod{
+				{JailStartHeight: 1, JailEndHeight: 4, UnjailHeight: 9},
+				{JailStartHeight: 13, JailEndHeight: 16},
+			},
+
+			numExpectedSuppliers: 1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			sessionKeeper, ctx := keepertest.SessionKeeper(
+				t,
+				sharedParamsOpt,
+				keepertest.WithTestSupplierJailHistory(test.jailHistory),
+			)
+			ctx = sdk.UnwrapSDKContext(ctx).WithBlockHeight(100) // provide a sufficiently large block height to avoid errors
+
+			sessionHydrator := keeper.NewSessionHydrator(keepertest.TestApp1Address, keepertest.TestServiceId1, blockHeight)
+			session, err := sessionKeeper.HydrateSession(ctx, sessionHydrator)
+			if test.expectedErr != nil {
+				require.ErrorContains(t, err, test.expectedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, session.Suppliers, test.numExpectedSuppliers)
+		})
+	}
+}
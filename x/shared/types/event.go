@@ -0,0 +1,15 @@
+package types
+
+import (
+	"context"
+
+	cosmostypes "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EmitParamsUpdatedEvent emits an EventParamsUpdated event which notifies the
+// offchain consumers (e.g. the RelayMiner params caches) that the params of the
+// module with the given name were updated.
+func EmitParamsUpdatedEvent(ctx context.Context, moduleName string) error {
+	sdkCtx := cosmostypes.UnwrapSDKContext(ctx)
+	return sdkCtx.EventManager().EmitTypedEvent(&EventParamsUpdated{ModuleName: moduleName})
+}
@@ -302,6 +302,18 @@ func ValidateComputeUnitsToTokensMultiplier(computeUnitsToTokensMultiplerAny any
 	return nil
 }
 
+// GetServiceComputeUnitsToTokensMultiplier returns the amount of uPOKT a compute
+// unit of the given service translates to in the session starting at sessionStartHeight.
+// It is the service specific multiplier if set, or the global
+// compute_units_to_tokens_multiplier param otherwise.
+func (params *Params) GetServiceComputeUnitsToTokensMultiplier(service *Service, sessionStartHeight int64) uint64 {
+	if serviceMultiplier := service.GetComputeUnitsToTokensMultiplierAtHeight(sessionStartHeight); serviceMultiplier > 0 {
+		return serviceMultiplier
+	}
+
+	return params.GetComputeUnitsToTokensMultiplier()
+}
+
 // validateIsUint64 returns the casted uin64 value or an error if value is not
 // type assertable to uint64.
 func validateIsUint64(value any) (uint64, error) {
@@ -295,3 +295,69 @@ func TestParams_ValidateGatewayUnbondingPeriodSessions(t *testing.T) {
 		})
 	}
 }
+
+func TestParams_GetServiceComputeUnitsToTokensMultiplier(t *testing.T) {
+	params := DefaultParams()
+	params.ComputeUnitsToTokensMultiplier = 42
+
+	serviceWithHistory := &Service{
+		Id:                             "svc1",
+		ComputeUnitsToTokensMultiplier: 9,
+		ComputeUnitsToTokensMultiplierHistory: []*ServiceComputeUnitsToTokensMultiplierUpdate{
+			{ComputeUnitsToTokensMultiplier: 0, EffectiveBlockHeight: 0},
+			{ComputeUnitsToTokensMultiplier: 7, EffectiveBlockHeight: 11},
+			{ComputeUnitsToTokensMultiplier: 9, EffectiveBlockHeight: 21},
+		},
+	}
+
+	tests := []struct {
+		desc                                   string
+		service                                *Service
+		sessionStartHeight                     int64
+		expectedComputeUnitsToTokensMultiplier uint64
+	}{
+		{
+			desc:                                   "nil service falls back to the global multiplier",
+			service:                                nil,
+			sessionStartHeight:                     1,
+			expectedComputeUnitsToTokensMultiplier: 42,
+		},
+		{
+			desc:                                   "service without a multiplier falls back to the global multiplier",
+			service:                                &Service{Id: "svc1"},
+			sessionStartHeight:                     1,
+			expectedComputeUnitsToTokensMultiplier: 42,
+		},
+		{
+			desc:                                   "service multiplier overrides the global multiplier",
+			service:                                &Service{Id: "svc1", ComputeUnitsToTokensMultiplier: 7},
+			sessionStartHeight:                     1,
+			expectedComputeUnitsToTokensMultiplier: 7,
+		},
+		{
+			desc:                                   "session started before the first update uses the global multiplier",
+			service:                                serviceWithHistory,
+			sessionStartHeight:                     1,
+			expectedComputeUnitsToTokensMultiplier: 42,
+		},
+		{
+			desc:                                   "session started at an update height uses the updated multiplier",
+			service:                                serviceWithHistory,
+			sessionStartHeight:                     11,
+			expectedComputeUnitsToTokensMultiplier: 7,
+		},
+		{
+			desc:                                   "session started after the latest update uses the latest multiplier",
+			service:                                serviceWithHistory,
+			sessionStartHeight:                     31,
+			expectedComputeUnitsToTokensMultiplier: 9,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			multiplier := params.GetServiceComputeUnitsToTokensMultiplier(tt.service, tt.sessionStartHeight)
+			require.Equal(t, tt.expectedComputeUnitsToTokensMultiplier, multiplier)
+		})
+	}
+}
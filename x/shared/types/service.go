@@ -59,6 +59,88 @@ func (s *Service) ValidateBasic() error {
 		return ErrSharedInvalidService.Wrapf("%s", err)
 	}
 
+	if err := ValidateServiceOwnerRevSharePercentage(s.OwnerRevSharePercentage); err != nil {
+		return ErrSharedInvalidService.Wrapf("%s", err)
+	}
+
+	if err := s.validateComputeUnitsToTokensMultiplierHistory(); err != nil {
+		return err
+	}
+
+	if err := s.validateOwnerRevSharePercentageHistory(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateComputeUnitsToTokensMultiplierHistory ensures that the multiplier updates
+// are ordered by effective height and that the latest one is the current multiplier.
+func (s *Service) validateComputeUnitsToTokensMultiplierHistory() error {
+	history := s.GetComputeUnitsToTokensMultiplierHistory()
+	if len(history) == 0 {
+		return nil
+	}
+
+	for i, update := range history {
+		if update == nil {
+			return ErrSharedInvalidService.Wrapf("nil compute units to tokens multiplier update at index %d", i)
+		}
+		if update.GetEffectiveBlockHeight() < 0 {
+			return ErrSharedInvalidService.Wrapf("negative compute units to tokens multiplier effective height %d", update.GetEffectiveBlockHeight())
+		}
+		if i > 0 && update.GetEffectiveBlockHeight() <= history[i-1].GetEffectiveBlockHeight() {
+			return ErrSharedInvalidService.Wrapf(
+				"compute units to tokens multiplier updates are not ordered by effective height: %d after %d",
+				update.GetEffectiveBlockHeight(), history[i-1].GetEffectiveBlockHeight(),
+			)
+		}
+	}
+
+	if latest := history[len(history)-1].GetComputeUnitsToTokensMultiplier(); latest != s.ComputeUnitsToTokensMultiplier {
+		return ErrSharedInvalidService.Wrapf(
+			"latest compute units to tokens multiplier update %d does not match the service multiplier %d",
+			latest, s.ComputeUnitsToTokensMultiplier,
+		)
+	}
+
+	return nil
+}
+
+// validateOwnerRevSharePercentageHistory ensures that the owner rev share percentage
+// updates are valid percentages, ordered by effective height, and that the latest
+// one is the current percentage.
+func (s *Service) validateOwnerRevSharePercentageHistory() error {
+	history := s.GetOwnerRevSharePercentageHistory()
+	if len(history) == 0 {
+		return nil
+	}
+
+	for i, update := range history {
+		if update == nil {
+			return ErrSharedInvalidService.Wrapf("nil owner rev share percentage update at index %d", i)
+		}
+		if err := ValidateServiceOwnerRevSharePercentage(update.GetOwnerRevSharePercentage()); err != nil {
+			return ErrSharedInvalidService.Wrapf("%s", err)
+		}
+		if update.GetEffectiveBlockHeight() < 0 {
+			return ErrSharedInvalidService.Wrapf("negative owner rev share percentage effective height %d", update.GetEffectiveBlockHeight())
+		}
+		if i > 0 && update.GetEffectiveBlockHeight() <= history[i-1].GetEffectiveBlockHeight() {
+			return ErrSharedInvalidService.Wrapf(
+				"owner rev share percentage updates are not ordered by effective height: %d after %d",
+				update.GetEffectiveBlockHeight(), history[i-1].GetEffectiveBlockHeight(),
+			)
+		}
+	}
+
+	if latest := history[len(history)-1].GetOwnerRevSharePercentage(); latest != s.OwnerRevSharePercentage {
+		return ErrSharedInvalidService.Wrapf(
+			"latest owner rev share percentage update %d does not match the service percentage %d",
+			latest, s.OwnerRevSharePercentage,
+		)
+	}
+
 	return nil
 }
 
@@ -129,3 +211,128 @@ func ValidateComputeUnitsPerRelay(computeUnitsPerRelay uint64) error {
 	}
 	return nil
 }
+
+// ValidateServiceOwnerRevSharePercentage makes sure the service owner rev share percentage is a valid percentage.
+func ValidateServiceOwnerRevSharePercentage(ownerRevSharePercentage uint64) error {
+	if ownerRevSharePercentage > 100 {
+		return ErrSharedInvalidRevShare.Wrapf("service owner rev share percentage must be at most 100, got %d", ownerRevSharePercentage)
+	}
+	return nil
+}
+
+// GetComputeUnitsToTokensMultiplierAtHeight returns the compute units to tokens
+// multiplier of the service which applies to the session starting at sessionStartHeight.
+// It returns zero if the global compute_units_to_tokens_multiplier applies instead.
+func (s *Service) GetComputeUnitsToTokensMultiplierAtHeight(sessionStartHeight int64) uint64 {
+	history := s.GetComputeUnitsToTokensMultiplierHistory()
+	if len(history) == 0 {
+		return s.GetComputeUnitsToTokensMultiplier()
+	}
+
+	for i := len(history) - 1; i >= 0; i-- {
+		if history[i].GetEffectiveBlockHeight() <= sessionStartHeight {
+			return history[i].GetComputeUnitsToTokensMultiplier()
+		}
+	}
+
+	// The sessions preceding the oldest retained update are all settled, so this
+	// is only reached for heights which are no longer relevant.
+	return history[0].GetComputeUnitsToTokensMultiplier()
+}
+
+// SetComputeUnitsToTokensMultiplier sets the compute units to tokens multiplier
+// of the service, which applies to the sessions starting at or after effectiveHeight.
+// The updates which no longer apply to any session starting at or after
+// minRetainedHeight are pruned from the history.
+func (s *Service) SetComputeUnitsToTokensMultiplier(
+	computeUnitsToTokensMultiplier uint64,
+	effectiveHeight int64,
+	minRetainedHeight int64,
+) {
+	history := slices.Clone(s.ComputeUnitsToTokensMultiplierHistory)
+
+	// Record the multiplier which applies to the sessions preceding the first update.
+	if len(history) == 0 {
+		history = append(history, &ServiceComputeUnitsToTokensMultiplierUpdate{
+			ComputeUnitsToTokensMultiplier: s.ComputeUnitsToTokensMultiplier,
+			EffectiveBlockHeight:           0,
+		})
+	}
+
+	update := &ServiceComputeUnitsToTokensMultiplierUpdate{
+		ComputeUnitsToTokensMultiplier: computeUnitsToTokensMultiplier,
+		EffectiveBlockHeight:           effectiveHeight,
+	}
+
+	// Only the last update made before a session starts applies to it.
+	if history[len(history)-1].GetEffectiveBlockHeight() == effectiveHeight {
+		history[len(history)-1] = update
+	} else {
+		history = append(history, update)
+	}
+
+	for len(history) > 1 && history[1].GetEffectiveBlockHeight() <= minRetainedHeight {
+		history = history[1:]
+	}
+
+	s.ComputeUnitsToTokensMultiplier = computeUnitsToTokensMultiplier
+	s.ComputeUnitsToTokensMultiplierHistory = history
+}
+
+// GetOwnerRevSharePercentageAtHeight returns the owner rev share percentage of the
+// service which applies to the session starting at sessionStartHeight.
+func (s *Service) GetOwnerRevSharePercentageAtHeight(sessionStartHeight int64) uint64 {
+	history := s.GetOwnerRevSharePercentageHistory()
+	if len(history) == 0 {
+		return s.GetOwnerRevSharePercentage()
+	}
+
+	for i := len(history) - 1; i >= 0; i-- {
+		if history[i].GetEffectiveBlockHeight() <= sessionStartHeight {
+			return history[i].GetOwnerRevSharePercentage()
+		}
+	}
+
+	// The sessions preceding the oldest retained update are all settled, so this
+	// is only reached for heights which are no longer relevant.
+	return history[0].GetOwnerRevSharePercentage()
+}
+
+// SetOwnerRevSharePercentage sets the owner rev share percentage of the service,
+// which applies to the sessions starting at or after effectiveHeight.
+// The updates which no longer apply to any session starting at or after
+// minRetainedHeight are pruned from the history.
+func (s *Service) SetOwnerRevSharePercentage(
+	ownerRevSharePercentage uint64,
+	effectiveHeight int64,
+	minRetainedHeight int64,
+) {
+	history := slices.Clone(s.OwnerRevSharePercentageHistory)
+
+	// Record the percentage which applies to the sessions preceding the first update.
+	if len(history) == 0 {
+		history = append(history, &ServiceOwnerRevSharePercentageUpdate{
+			OwnerRevSharePercentage: s.OwnerRevSharePercentage,
+			EffectiveBlockHeight:    0,
+		})
+	}
+
+	update := &ServiceOwnerRevSharePercentageUpdate{
+		OwnerRevSharePercentage: ownerRevSharePercentage,
+		EffectiveBlockHeight:    effectiveHeight,
+	}
+
+	// Only the last update made before a session starts applies to it.
+	if history[len(history)-1].GetEffectiveBlockHeight() == effectiveHeight {
+		history[len(history)-1] = update
+	} else {
+		history = append(history, update)
+	}
+
+	for len(history) > 1 && history[1].GetEffectiveBlockHeight() <= minRetainedHeight {
+		history = history[1:]
+	}
+
+	s.OwnerRevSharePercentage = ownerRevSharePercentage
+	s.OwnerRevSharePercentageHistory = history
+}
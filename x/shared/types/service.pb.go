@@ -96,14 +96,33 @@ type Service struct {
 	// TODO_POST_MAINNET: Rename service.name to service.description
 	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	// The cost of a single relay for this service in terms of compute units.
-	// Must be used alongside the global 'compute_units_to_tokens_multiplier' to calculate the cost of a relay for this service.
-	// cost_per_relay_for_specific_service = compute_units_per_relay_for_specific_service * compute_units_to_tokens_multiplier_global_value
+	// Must be used alongside the service 'compute_units_to_tokens_multiplier' (or the global one if unset) to calculate the cost of a relay for this service.
+	// cost_per_relay_for_specific_service = compute_units_per_relay_for_specific_service * compute_units_to_tokens_multiplier_for_specific_service
 	ComputeUnitsPerRelay uint64 `protobuf:"varint,3,opt,name=compute_units_per_relay,json=computeUnitsPerRelay,proto3" json:"compute_units_per_relay,omitempty"`
 	// The owner address that created the service.
 	// It is the address that receives rewards based on the Service's onchain usage
 	// It is the only address that can update the service configuration (e.g. compute_units_per_relay),
 	// or make other updates to it.
 	OwnerAddress string `protobuf:"bytes,4,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`
+	// (Optional) The amount of uPOKT a compute unit of this service translates to when settling a session.
+	// If zero, the global 'compute_units_to_tokens_multiplier' shared param is used instead.
+	// It can be updated by the service owner or by governance, and is the latest value set,
+	// which applies from the session following its update (see compute_units_to_tokens_multiplier_history).
+	ComputeUnitsToTokensMultiplier uint64 `protobuf:"varint,5,opt,name=compute_units_to_tokens_multiplier,json=computeUnitsToTokensMultiplier,proto3" json:"compute_units_to_tokens_multiplier,omitempty"`
+	// (Optional) The percentage of each settled claim's amount for this service which is
+	// routed to the service owner by the TLM_SERVICE_OWNER_REV_SHARE token logic module.
+	// It is bounded by the governance controlled max_rev_share_percentage of the TLM.
+	// It can be updated by the service owner, and is the latest value set,
+	// which applies from the session following its update (see owner_rev_share_percentage_history).
+	OwnerRevSharePercentage uint64 `protobuf:"varint,6,opt,name=owner_rev_share_percentage,json=ownerRevSharePercentage,proto3" json:"owner_rev_share_percentage,omitempty"`
+	// The updates of the compute units to tokens multiplier, ordered by effective height,
+	// which the sessions that are not settled yet may still be settled with.
+	// Empty if the compute_units_to_tokens_multiplier was never updated.
+	ComputeUnitsToTokensMultiplierHistory []*ServiceComputeUnitsToTokensMultiplierUpdate `protobuf:"bytes,7,rep,name=compute_units_to_tokens_multiplier_history,json=computeUnitsToTokensMultiplierHistory,proto3" json:"compute_units_to_tokens_multiplier_history,omitempty"`
+	// The updates of the owner rev share percentage, ordered by effective height,
+	// which the sessions that are not settled yet may still be settled with.
+	// Empty if the owner_rev_share_percentage was never updated.
+	OwnerRevSharePercentageHistory []*ServiceOwnerRevSharePercentageUpdate `protobuf:"bytes,8,rep,name=owner_rev_share_percentage_history,json=ownerRevSharePercentageHistory,proto3" json:"owner_rev_share_percentage_history,omitempty"`
 }
 
 func (m *Service) Reset()         { *m = Service{} }
@@ -163,6 +182,143 @@ func (m *Service) GetOwnerAddress() string {
 	return ""
 }
 
+func (m *Service) GetComputeUnitsToTokensMultiplier() uint64 {
+	if m != nil {
+		return m.ComputeUnitsToTokensMultiplier
+	}
+	return 0
+}
+
+func (m *Service) GetOwnerRevSharePercentage() uint64 {
+	if m != nil {
+		return m.OwnerRevSharePercentage
+	}
+	return 0
+}
+
+func (m *Service) GetComputeUnitsToTokensMultiplierHistory() []*ServiceComputeUnitsToTokensMultiplierUpdate {
+	if m != nil {
+		return m.ComputeUnitsToTokensMultiplierHistory
+	}
+	return nil
+}
+
+func (m *Service) GetOwnerRevSharePercentageHistory() []*ServiceOwnerRevSharePercentageUpdate {
+	if m != nil {
+		return m.OwnerRevSharePercentageHistory
+	}
+	return nil
+}
+
+// ServiceComputeUnitsToTokensMultiplierUpdate is a compute units to tokens multiplier
+// of a service along with the height from which it applies.
+type ServiceComputeUnitsToTokensMultiplierUpdate struct {
+	// The amount of uPOKT a compute unit of the service translates to, or zero
+	// to use the global 'compute_units_to_tokens_multiplier' shared param.
+	ComputeUnitsToTokensMultiplier uint64 `protobuf:"varint,1,opt,name=compute_units_to_tokens_multiplier,json=computeUnitsToTokensMultiplier,proto3" json:"compute_units_to_tokens_multiplier,omitempty"`
+	// The start height of the first session settled with this multiplier.
+	EffectiveBlockHeight int64 `protobuf:"varint,2,opt,name=effective_block_height,json=effectiveBlockHeight,proto3" json:"effective_block_height,omitempty"`
+}
+
+func (m *ServiceComputeUnitsToTokensMultiplierUpdate) Reset() {
+	*m = ServiceComputeUnitsToTokensMultiplierUpdate{}
+}
+func (m *ServiceComputeUnitsToTokensMultiplierUpdate) String() string {
+	return proto.CompactTextString(m)
+}
+func (*ServiceComputeUnitsToTokensMultiplierUpdate) ProtoMessage() {}
+func (*ServiceComputeUnitsToTokensMultiplierUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4dfdeb4ae793ca69, []int{1}
+}
+func (m *ServiceComputeUnitsToTokensMultiplierUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ServiceComputeUnitsToTokensMultiplierUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ServiceComputeUnitsToTokensMultiplierUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ServiceComputeUnitsToTokensMultiplierUpdate.Merge(m, src)
+}
+func (m *ServiceComputeUnitsToTokensMultiplierUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *ServiceComputeUnitsToTokensMultiplierUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_ServiceComputeUnitsToTokensMultiplierUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ServiceComputeUnitsToTokensMultiplierUpdate proto.InternalMessageInfo
+
+func (m *ServiceComputeUnitsToTokensMultiplierUpdate) GetComputeUnitsToTokensMultiplier() uint64 {
+	if m != nil {
+		return m.ComputeUnitsToTokensMultiplier
+	}
+	return 0
+}
+
+func (m *ServiceComputeUnitsToTokensMultiplierUpdate) GetEffectiveBlockHeight() int64 {
+	if m != nil {
+		return m.EffectiveBlockHeight
+	}
+	return 0
+}
+
+// ServiceOwnerRevSharePercentageUpdate is an owner rev share percentage of a
+// service along with the height from which it applies.
+type ServiceOwnerRevSharePercentageUpdate struct {
+	// The percentage of each settled claim's amount which is routed to the service owner.
+	OwnerRevSharePercentage uint64 `protobuf:"varint,1,opt,name=owner_rev_share_percentage,json=ownerRevSharePercentage,proto3" json:"owner_rev_share_percentage,omitempty"`
+	// The start height of the first session settled with this percentage.
+	EffectiveBlockHeight int64 `protobuf:"varint,2,opt,name=effective_block_height,json=effectiveBlockHeight,proto3" json:"effective_block_height,omitempty"`
+}
+
+func (m *ServiceOwnerRevSharePercentageUpdate) Reset()         { *m = ServiceOwnerRevSharePercentageUpdate{} }
+func (m *ServiceOwnerRevSharePercentageUpdate) String() string { return proto.CompactTextString(m) }
+func (*ServiceOwnerRevSharePercentageUpdate) ProtoMessage()    {}
+func (*ServiceOwnerRevSharePercentageUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4dfdeb4ae793ca69, []int{2}
+}
+func (m *ServiceOwnerRevSharePercentageUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ServiceOwnerRevSharePercentageUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ServiceOwnerRevSharePercentageUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ServiceOwnerRevSharePercentageUpdate.Merge(m, src)
+}
+func (m *ServiceOwnerRevSharePercentageUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *ServiceOwnerRevSharePercentageUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_ServiceOwnerRevSharePercentageUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ServiceOwnerRevSharePercentageUpdate proto.InternalMessageInfo
+
+func (m *ServiceOwnerRevSharePercentageUpdate) GetOwnerRevSharePercentage() uint64 {
+	if m != nil {
+		return m.OwnerRevSharePercentage
+	}
+	return 0
+}
+
+func (m *ServiceOwnerRevSharePercentageUpdate) GetEffectiveBlockHeight() int64 {
+	if m != nil {
+		return m.EffectiveBlockHeight
+	}
+	return 0
+}
+
 // ApplicationServiceConfig holds the service configuration the application stakes for
 type ApplicationServiceConfig struct {
 	ServiceId string `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
@@ -172,7 +328,7 @@ func (m *ApplicationServiceConfig) Reset()         { *m = ApplicationServiceConf
 func (m *ApplicationServiceConfig) String() string { return proto.CompactTextString(m) }
 func (*ApplicationServiceConfig) ProtoMessage()    {}
 func (*ApplicationServiceConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4dfdeb4ae793ca69, []int{1}
+	return fileDescriptor_4dfdeb4ae793ca69, []int{3}
 }
 func (m *ApplicationServiceConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -215,7 +371,7 @@ func (m *SupplierServiceConfig) Reset()         { *m = SupplierServiceConfig{} }
 func (m *SupplierServiceConfig) String() string { return proto.CompactTextString(m) }
 func (*SupplierServiceConfig) ProtoMessage()    {}
 func (*SupplierServiceConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4dfdeb4ae793ca69, []int{2}
+	return fileDescriptor_4dfdeb4ae793ca69, []int{4}
 }
 func (m *SupplierServiceConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -272,7 +428,7 @@ func (m *SupplierEndpoint) Reset()         { *m = SupplierEndpoint{} }
 func (m *SupplierEndpoint) String() string { return proto.CompactTextString(m) }
 func (*SupplierEndpoint) ProtoMessage()    {}
 func (*SupplierEndpoint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4dfdeb4ae793ca69, []int{3}
+	return fileDescriptor_4dfdeb4ae793ca69, []int{5}
 }
 func (m *SupplierEndpoint) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -328,7 +484,7 @@ func (m *ServiceRevenueShare) Reset()         { *m = ServiceRevenueShare{} }
 func (m *ServiceRevenueShare) String() string { return proto.CompactTextString(m) }
 func (*ServiceRevenueShare) ProtoMessage()    {}
 func (*ServiceRevenueShare) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4dfdeb4ae793ca69, []int{4}
+	return fileDescriptor_4dfdeb4ae793ca69, []int{6}
 }
 func (m *ServiceRevenueShare) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -377,7 +533,7 @@ func (m *ConfigOption) Reset()         { *m = ConfigOption{} }
 func (m *ConfigOption) String() string { return proto.CompactTextString(m) }
 func (*ConfigOption) ProtoMessage()    {}
 func (*ConfigOption) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4dfdeb4ae793ca69, []int{5}
+	return fileDescriptor_4dfdeb4ae793ca69, []int{7}
 }
 func (m *ConfigOption) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -420,6 +576,8 @@ func init() {
 	proto.RegisterEnum("pocket.shared.RPCType", RPCType_name, RPCType_value)
 	proto.RegisterEnum("pocket.shared.ConfigOptions", ConfigOptions_name, ConfigOptions_value)
 	proto.RegisterType((*Service)(nil), "pocket.shared.Service")
+	proto.RegisterType((*ServiceComputeUnitsToTokensMultiplierUpdate)(nil), "pocket.shared.ServiceComputeUnitsToTokensMultiplierUpdate")
+	proto.RegisterType((*ServiceOwnerRevSharePercentageUpdate)(nil), "pocket.shared.ServiceOwnerRevSharePercentageUpdate")
 	proto.RegisterType((*ApplicationServiceConfig)(nil), "pocket.shared.ApplicationServiceConfig")
 	proto.RegisterType((*SupplierServiceConfig)(nil), "pocket.shared.SupplierServiceConfig")
 	proto.RegisterType((*SupplierEndpoint)(nil), "pocket.shared.SupplierEndpoint")
@@ -430,47 +588,58 @@ func init() {
 func init() { proto.RegisterFile("pocket/shared/service.proto", fileDescriptor_4dfdeb4ae793ca69) }
 
 var fileDescriptor_4dfdeb4ae793ca69 = []byte{
-	// 629 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xc1, 0x6e, 0xd3, 0x4a,
-	0x14, 0xcd, 0xc4, 0x79, 0x2f, 0xc9, 0x6d, 0x93, 0x67, 0xcd, 0xcb, 0x7b, 0x98, 0x16, 0x4c, 0xe5,
-	0x55, 0x55, 0xa9, 0x49, 0x09, 0xea, 0x82, 0x45, 0x85, 0xda, 0x28, 0x54, 0x6d, 0xd5, 0x24, 0x1a,
-	0xa7, 0xaa, 0xc4, 0xc6, 0x72, 0xed, 0x21, 0xb5, 0x92, 0x78, 0x46, 0x63, 0x3b, 0x25, 0x4b, 0x7e,
-	0x00, 0xf1, 0x0f, 0xfc, 0x02, 0xe2, 0x1b, 0x58, 0x56, 0xac, 0xba, 0x44, 0xe9, 0x8f, 0xa0, 0xf1,
-	0xd8, 0x85, 0x46, 0x08, 0xc4, 0xee, 0xce, 0x3d, 0xe7, 0xde, 0x7b, 0x72, 0xee, 0x8d, 0x61, 0x9d,
-	0x33, 0x6f, 0x4c, 0xe3, 0x56, 0x74, 0xe9, 0x0a, 0xea, 0xb7, 0x22, 0x2a, 0x66, 0x81, 0x47, 0x9b,
-	0x5c, 0xb0, 0x98, 0xe1, 0x9a, 0x02, 0x9b, 0x0a, 0x5c, 0x7b, 0xe8, 0xb1, 0x68, 0xca, 0x22, 0x27,
-	0x05, 0x5b, 0xea, 0xa1, 0x98, 0x6b, 0x8d, 0x11, 0x1b, 0x31, 0x95, 0x97, 0x91, 0xca, 0x5a, 0x1f,
-	0x10, 0x94, 0x6d, 0xd5, 0x11, 0xd7, 0xa1, 0x18, 0xf8, 0x06, 0xda, 0x40, 0x9b, 0x55, 0x52, 0x0c,
-	0x7c, 0x8c, 0xa1, 0x14, 0xba, 0x53, 0x6a, 0x14, 0xd3, 0x4c, 0x1a, 0xe3, 0x5d, 0x78, 0xe0, 0xb1,
-	0x29, 0x4f, 0x62, 0xea, 0x24, 0x61, 0x10, 0x47, 0x0e, 0xa7, 0xc2, 0x11, 0x74, 0xe2, 0xce, 0x0d,
-	0x6d, 0x03, 0x6d, 0x96, 0x48, 0x23, 0x83, 0xcf, 0x24, 0x3a, 0xa0, 0x82, 0x48, 0x0c, 0xef, 0x41,
-	0x8d, 0x5d, 0x85, 0x54, 0x38, 0xae, 0xef, 0x0b, 0x1a, 0x45, 0x46, 0x49, 0xf6, 0x3c, 0x30, 0xbe,
-	0x7c, 0xdc, 0x6e, 0x64, 0x2a, 0xf7, 0x15, 0x62, 0xc7, 0x22, 0x08, 0x47, 0x64, 0x35, 0xa5, 0x67,
-	0x39, 0xeb, 0x39, 0x18, 0xfb, 0x9c, 0x4f, 0x02, 0xcf, 0x8d, 0x03, 0x16, 0x66, 0x7a, 0x3b, 0x2c,
-	0x7c, 0x1d, 0x8c, 0xf0, 0x63, 0x80, 0xcc, 0x12, 0xe7, 0x4e, 0x7d, 0x35, 0xcb, 0x1c, 0xf9, 0xd6,
-	0x27, 0x04, 0xff, 0xd9, 0x89, 0x2c, 0xa6, 0xe2, 0x4f, 0x0a, 0xf1, 0x1e, 0x54, 0x69, 0xe8, 0x73,
-	0x16, 0x84, 0x71, 0x64, 0x14, 0x37, 0xb4, 0xcd, 0x95, 0xf6, 0x93, 0xe6, 0x3d, 0xb7, 0x9b, 0x79,
-	0xdf, 0x6e, 0xc6, 0x23, 0xdf, 0x2b, 0xf0, 0x0b, 0xa8, 0x0a, 0x3a, 0x73, 0x52, 0xa6, 0xa1, 0xa5,
-	0xe5, 0xd6, 0x72, 0xb9, 0x9a, 0x45, 0xe8, 0x8c, 0x86, 0x09, 0xb5, 0x65, 0x92, 0x54, 0x04, 0x9d,
-	0xa5, 0x91, 0xf5, 0x0e, 0x81, 0xbe, 0x3c, 0x00, 0xeb, 0xa0, 0x25, 0x62, 0x92, 0x89, 0x95, 0x21,
-	0x7e, 0x0a, 0x15, 0xc1, 0x3d, 0x27, 0x9e, 0x73, 0xb5, 0xa8, 0x7a, 0xfb, 0xff, 0xa5, 0x31, 0x64,
-	0xd0, 0x19, 0xce, 0x39, 0x25, 0x65, 0xc1, 0x3d, 0x19, 0xe0, 0x5d, 0x28, 0x7b, 0xa9, 0x05, 0x51,
-	0x26, 0x6c, 0x7d, 0xa9, 0x42, 0x19, 0xd4, 0xe7, 0xd2, 0x6c, 0x92, 0x73, 0xad, 0xb7, 0x08, 0xfe,
-	0xfd, 0x89, 0x64, 0xdc, 0x86, 0x72, 0xbe, 0x55, 0xf4, 0x9b, 0xad, 0xe6, 0x44, 0xbc, 0x03, 0x8d,
-	0x3b, 0x77, 0xe4, 0x09, 0x79, 0x34, 0x8c, 0xdd, 0x11, 0xcd, 0x6e, 0x08, 0xe7, 0x26, 0x0c, 0xee,
-	0x90, 0xe3, 0x52, 0xa5, 0xa8, 0x6b, 0xd6, 0x10, 0x56, 0x7f, 0x14, 0x87, 0x9b, 0xa0, 0x8d, 0xe9,
-	0x3c, 0x9d, 0x5b, 0x6f, 0x3f, 0xfa, 0xc5, 0xcf, 0x88, 0x88, 0x24, 0xe2, 0x06, 0xfc, 0x35, 0x73,
-	0x27, 0x49, 0x7e, 0xd3, 0xea, 0xb1, 0x75, 0x02, 0xe5, 0xcc, 0x24, 0xfc, 0x0f, 0xac, 0x9c, 0xf5,
-	0x4e, 0x7a, 0xfd, 0xf3, 0x9e, 0x43, 0x06, 0x1d, 0xbd, 0x80, 0x2b, 0x50, 0x3a, 0x94, 0x11, 0xc2,
-	0x35, 0xa8, 0x9e, 0x77, 0x0f, 0xec, 0x7e, 0xe7, 0xa4, 0x3b, 0xd4, 0x8b, 0x78, 0x15, 0x2a, 0xc7,
-	0x76, 0x5f, 0xd1, 0x34, 0x49, 0x23, 0x5d, 0x7b, 0xa8, 0x97, 0xb6, 0x76, 0xa0, 0x76, 0x6f, 0x30,
-	0xc6, 0x50, 0xcf, 0x5b, 0x76, 0xfa, 0xbd, 0x97, 0x47, 0x87, 0x7a, 0x01, 0xaf, 0x40, 0x79, 0x78,
-	0x74, 0xda, 0xed, 0x9f, 0x0d, 0x75, 0x74, 0x70, 0xfa, 0x79, 0x61, 0xa2, 0xeb, 0x85, 0x89, 0x6e,
-	0x16, 0x26, 0xfa, 0xba, 0x30, 0xd1, 0xfb, 0x5b, 0xb3, 0x70, 0x7d, 0x6b, 0x16, 0x6e, 0x6e, 0xcd,
-	0xc2, 0xab, 0xd6, 0x28, 0x88, 0x2f, 0x93, 0x8b, 0xa6, 0xc7, 0xa6, 0x2d, 0xce, 0xc6, 0xf1, 0x76,
-	0x48, 0xe3, 0x2b, 0x26, 0xc6, 0xe9, 0x43, 0xb0, 0xc9, 0xa4, 0xf5, 0x26, 0xff, 0x34, 0xc8, 0x23,
-	0x88, 0x2e, 0xfe, 0x4e, 0xff, 0xd9, 0xcf, 0xbe, 0x05, 0x00, 0x00, 0xff, 0xff, 0x48, 0xbf, 0x05,
-	0x54, 0x38, 0x04, 0x00, 0x00,
+	// 810 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0x4f, 0x8f, 0xdb, 0x44,
+	0x14, 0xcf, 0xc4, 0x69, 0x93, 0xbc, 0xfd, 0x83, 0x35, 0x84, 0xd6, 0x6c, 0xc1, 0xac, 0x2c, 0x90,
+	0x56, 0x8b, 0x9a, 0x94, 0x2d, 0x3d, 0x00, 0xaa, 0x50, 0x13, 0x85, 0x76, 0x77, 0xb5, 0x49, 0x34,
+	0x71, 0x54, 0x89, 0x8b, 0xe5, 0xb5, 0x67, 0x13, 0x2b, 0x8e, 0x67, 0x34, 0x1e, 0xa7, 0xe4, 0x84,
+	0xf8, 0x02, 0x88, 0x0b, 0x07, 0x3e, 0x05, 0x17, 0xc4, 0x37, 0x40, 0xe2, 0x58, 0x71, 0xea, 0x11,
+	0x65, 0xbf, 0x08, 0xf2, 0xd8, 0x09, 0xdd, 0x90, 0x66, 0xb7, 0xdc, 0x66, 0xe6, 0xf7, 0x9b, 0xf7,
+	0x7e, 0xef, 0x37, 0xcf, 0xcf, 0x70, 0x8f, 0x33, 0x6f, 0x4c, 0x65, 0x23, 0x1e, 0xb9, 0x82, 0xfa,
+	0x8d, 0x98, 0x8a, 0x69, 0xe0, 0xd1, 0x3a, 0x17, 0x4c, 0x32, 0xbc, 0x93, 0x81, 0xf5, 0x0c, 0xdc,
+	0x7b, 0xdf, 0x63, 0xf1, 0x84, 0xc5, 0x8e, 0x02, 0x1b, 0xd9, 0x26, 0x63, 0xee, 0xd5, 0x86, 0x6c,
+	0xc8, 0xb2, 0xf3, 0x74, 0x95, 0x9d, 0x5a, 0x7f, 0x94, 0xa0, 0xdc, 0xcf, 0x22, 0xe2, 0x5d, 0x28,
+	0x06, 0xbe, 0x81, 0xf6, 0xd1, 0x41, 0x95, 0x14, 0x03, 0x1f, 0x63, 0x28, 0x45, 0xee, 0x84, 0x1a,
+	0x45, 0x75, 0xa2, 0xd6, 0xf8, 0x11, 0xdc, 0xf5, 0xd8, 0x84, 0x27, 0x92, 0x3a, 0x49, 0x14, 0xc8,
+	0xd8, 0xe1, 0x54, 0x38, 0x82, 0x86, 0xee, 0xcc, 0xd0, 0xf6, 0xd1, 0x41, 0x89, 0xd4, 0x72, 0x78,
+	0x90, 0xa2, 0x3d, 0x2a, 0x48, 0x8a, 0xe1, 0xc7, 0xb0, 0xc3, 0x5e, 0x44, 0x54, 0x38, 0xae, 0xef,
+	0x0b, 0x1a, 0xc7, 0x46, 0x29, 0x8d, 0xd9, 0x34, 0xfe, 0xfa, 0xed, 0x7e, 0x2d, 0x57, 0xf9, 0x24,
+	0x43, 0xfa, 0x52, 0x04, 0xd1, 0x90, 0x6c, 0x2b, 0x7a, 0x7e, 0x86, 0x4f, 0xc0, 0xba, 0x9a, 0x55,
+	0x32, 0x47, 0xb2, 0x31, 0x8d, 0x62, 0x67, 0x92, 0x84, 0x32, 0xe0, 0x61, 0x40, 0x85, 0x71, 0x4b,
+	0x09, 0x30, 0x5f, 0x17, 0x60, 0x33, 0x5b, 0xd1, 0xce, 0x96, 0x2c, 0xfc, 0x15, 0xec, 0x65, 0x52,
+	0x04, 0x9d, 0x3a, 0xca, 0xb6, 0xb4, 0x06, 0x8f, 0x46, 0xd2, 0x1d, 0x52, 0xe3, 0xb6, 0x8a, 0x71,
+	0x57, 0x31, 0x08, 0x9d, 0xf6, 0x53, 0xbc, 0xb7, 0x84, 0xf1, 0xcf, 0x08, 0x0e, 0xaf, 0x57, 0xe2,
+	0x8c, 0x82, 0x58, 0x32, 0x31, 0x33, 0xca, 0xfb, 0xda, 0xc1, 0xd6, 0xd1, 0x97, 0xf5, 0x2b, 0x8f,
+	0x54, 0xcf, 0xfd, 0x6e, 0x6d, 0xd4, 0x39, 0xe0, 0xbe, 0x2b, 0x29, 0xf9, 0x64, 0x73, 0x35, 0xcf,
+	0xb2, 0x44, 0xf8, 0x7b, 0xb0, 0xde, 0x5c, 0xd4, 0x52, 0x4e, 0x45, 0xc9, 0x79, 0xb8, 0x5e, 0x4e,
+	0x77, 0x7d, 0xc9, 0xb9, 0x0e, 0xf3, 0x0d, 0x8e, 0xe4, 0x02, 0xac, 0x5f, 0x11, 0x7c, 0xfa, 0x16,
+	0x75, 0xdd, 0xf0, 0x45, 0xd1, 0x8d, 0x5e, 0xf4, 0x73, 0xb8, 0x43, 0x2f, 0x2e, 0xa8, 0x27, 0x83,
+	0x29, 0x75, 0xce, 0x43, 0xe6, 0x8d, 0x9d, 0x11, 0x0d, 0x86, 0x23, 0xa9, 0x3a, 0x57, 0x23, 0xb5,
+	0x25, 0xda, 0x4c, 0xc1, 0x67, 0x0a, 0xb3, 0x7e, 0x41, 0xf0, 0xf1, 0x4d, 0x4a, 0xbf, 0xa6, 0x61,
+	0xd0, 0xe6, 0x86, 0xf9, 0x7f, 0xda, 0xbe, 0x00, 0xe3, 0x09, 0xe7, 0x61, 0xe0, 0xb9, 0x32, 0x60,
+	0xd1, 0xd2, 0xd7, 0xe8, 0x22, 0x18, 0xe2, 0x0f, 0x01, 0xf2, 0x11, 0xe0, 0x2c, 0xbf, 0xd6, 0x6a,
+	0x7e, 0x72, 0xec, 0x5b, 0xbf, 0x23, 0x78, 0xaf, 0x9f, 0x70, 0xe5, 0xcc, 0xdb, 0x5c, 0xc4, 0x8f,
+	0xa1, 0x4a, 0x23, 0x9f, 0xb3, 0x20, 0x92, 0xb1, 0x51, 0x54, 0x9d, 0xf2, 0xd1, 0x6a, 0xa7, 0xe4,
+	0x71, 0xdb, 0x39, 0x8f, 0xfc, 0x7b, 0x03, 0x7f, 0x0d, 0xd5, 0xa5, 0x3f, 0x86, 0xa6, 0xae, 0x5b,
+	0xeb, 0x1b, 0x8d, 0xd0, 0x29, 0x8d, 0x12, 0xaa, 0x9c, 0x22, 0x15, 0x91, 0x7b, 0x66, 0xfd, 0x88,
+	0x40, 0x5f, 0x4d, 0x80, 0x75, 0xd0, 0x12, 0x11, 0xe6, 0x62, 0xd3, 0x25, 0xfe, 0x0c, 0x2a, 0x82,
+	0x7b, 0x8e, 0x9c, 0xf1, 0x6c, 0x30, 0xed, 0x1e, 0xdd, 0x59, 0x49, 0x43, 0x7a, 0x2d, 0x7b, 0xc6,
+	0x29, 0x29, 0x0b, 0xee, 0xa5, 0x0b, 0xfc, 0x08, 0xca, 0x9e, 0xb2, 0x20, 0xce, 0x85, 0xdd, 0x5b,
+	0xb9, 0x91, 0x19, 0xd4, 0xe5, 0xa9, 0xd9, 0x64, 0xc1, 0xb5, 0x7e, 0x40, 0xf0, 0xee, 0x1a, 0xc9,
+	0xf8, 0x08, 0xca, 0x8b, 0x29, 0x86, 0xae, 0x99, 0x62, 0x0b, 0x22, 0x7e, 0x00, 0xb5, 0xb5, 0xdd,
+	0x93, 0xcd, 0x4c, 0x2c, 0xfe, 0xd3, 0x38, 0x27, 0xa5, 0x4a, 0x51, 0xd7, 0x2c, 0x1b, 0xb6, 0x5f,
+	0x17, 0x87, 0xeb, 0xa0, 0x8d, 0xe9, 0x4c, 0xe5, 0xdd, 0x3d, 0xfa, 0x60, 0x43, 0x19, 0x31, 0x49,
+	0x89, 0xb8, 0x06, 0xb7, 0xa6, 0x6e, 0x98, 0x2c, 0x66, 0x78, 0xb6, 0x39, 0x3c, 0x85, 0x72, 0x6e,
+	0x12, 0x7e, 0x07, 0xb6, 0x06, 0x9d, 0xd3, 0x4e, 0xf7, 0x79, 0xc7, 0x21, 0xbd, 0x96, 0x5e, 0xc0,
+	0x15, 0x28, 0x3d, 0x4d, 0x57, 0x08, 0xef, 0x40, 0xf5, 0x79, 0xbb, 0xd9, 0xef, 0xb6, 0x4e, 0xdb,
+	0xb6, 0x5e, 0xc4, 0xdb, 0x50, 0x39, 0xe9, 0x77, 0x33, 0x9a, 0x96, 0xd2, 0x48, 0xbb, 0x6f, 0xeb,
+	0xa5, 0xc3, 0x07, 0xb0, 0x73, 0x25, 0x31, 0xc6, 0xb0, 0xbb, 0x08, 0xd9, 0xea, 0x76, 0xbe, 0x39,
+	0x7e, 0xaa, 0x17, 0xf0, 0x16, 0x94, 0xed, 0xe3, 0xb3, 0x76, 0x77, 0x60, 0xeb, 0xa8, 0x79, 0xf6,
+	0xe7, 0xdc, 0x44, 0x2f, 0xe7, 0x26, 0x7a, 0x35, 0x37, 0xd1, 0xdf, 0x73, 0x13, 0xfd, 0x74, 0x69,
+	0x16, 0x5e, 0x5e, 0x9a, 0x85, 0x57, 0x97, 0x66, 0xe1, 0xdb, 0xc6, 0x30, 0x90, 0xa3, 0xe4, 0xbc,
+	0xee, 0xb1, 0x49, 0x83, 0xb3, 0xb1, 0xbc, 0x1f, 0x51, 0xf9, 0x82, 0x89, 0xb1, 0xda, 0x08, 0x16,
+	0x86, 0x8d, 0xef, 0x16, 0xbf, 0xc2, 0xb4, 0x09, 0xe2, 0xf3, 0xdb, 0xea, 0x4f, 0xf6, 0xf0, 0x9f,
+	0x01, 0x00, 0xb9, 0xcf, 0x0f, 0x26, 0x28, 0x07, 0x00, 0x00,
 }
 
 func (m *Service) Marshal() (dAtA []byte, err error) {
@@ -493,6 +662,44 @@ func (m *Service) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.OwnerRevSharePercentageHistory) > 0 {
+		for iNdEx := len(m.OwnerRevSharePercentageHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OwnerRevSharePercentageHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.ComputeUnitsToTokensMultiplierHistory) > 0 {
+		for iNdEx := len(m.ComputeUnitsToTokensMultiplierHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ComputeUnitsToTokensMultiplierHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.OwnerRevSharePercentage != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.OwnerRevSharePercentage))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ComputeUnitsToTokensMultiplier != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.ComputeUnitsToTokensMultiplier))
+		i--
+		dAtA[i] = 0x28
+	}
 	if len(m.OwnerAddress) > 0 {
 		i -= len(m.OwnerAddress)
 		copy(dAtA[i:], m.OwnerAddress)
@@ -522,6 +729,72 @@ func (m *Service) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ServiceComputeUnitsToTokensMultiplierUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ServiceComputeUnitsToTokensMultiplierUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ServiceComputeUnitsToTokensMultiplierUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EffectiveBlockHeight != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.EffectiveBlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ComputeUnitsToTokensMultiplier != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.ComputeUnitsToTokensMultiplier))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ServiceOwnerRevSharePercentageUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ServiceOwnerRevSharePercentageUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ServiceOwnerRevSharePercentageUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EffectiveBlockHeight != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.EffectiveBlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.OwnerRevSharePercentage != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.OwnerRevSharePercentage))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ApplicationServiceConfig) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -761,6 +1034,54 @@ func (m *Service) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovService(uint64(l))
 	}
+	if m.ComputeUnitsToTokensMultiplier != 0 {
+		n += 1 + sovService(uint64(m.ComputeUnitsToTokensMultiplier))
+	}
+	if m.OwnerRevSharePercentage != 0 {
+		n += 1 + sovService(uint64(m.OwnerRevSharePercentage))
+	}
+	if len(m.ComputeUnitsToTokensMultiplierHistory) > 0 {
+		for _, e := range m.ComputeUnitsToTokensMultiplierHistory {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if len(m.OwnerRevSharePercentageHistory) > 0 {
+		for _, e := range m.OwnerRevSharePercentageHistory {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ServiceComputeUnitsToTokensMultiplierUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ComputeUnitsToTokensMultiplier != 0 {
+		n += 1 + sovService(uint64(m.ComputeUnitsToTokensMultiplier))
+	}
+	if m.EffectiveBlockHeight != 0 {
+		n += 1 + sovService(uint64(m.EffectiveBlockHeight))
+	}
+	return n
+}
+
+func (m *ServiceOwnerRevSharePercentageUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OwnerRevSharePercentage != 0 {
+		n += 1 + sovService(uint64(m.OwnerRevSharePercentage))
+	}
+	if m.EffectiveBlockHeight != 0 {
+		n += 1 + sovService(uint64(m.EffectiveBlockHeight))
+	}
 	return n
 }
 
@@ -1006,6 +1327,288 @@ func (m *Service) Unmarshal(dAtA []byte) error {
 			}
 			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ComputeUnitsToTokensMultiplier", wireType)
+			}
+			m.ComputeUnitsToTokensMultiplier = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ComputeUnitsToTokensMultiplier |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerRevSharePercentage", wireType)
+			}
+			m.OwnerRevSharePercentage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OwnerRevSharePercentage |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ComputeUnitsToTokensMultiplierHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ComputeUnitsToTokensMultiplierHistory = append(m.ComputeUnitsToTokensMultiplierHistory, &ServiceComputeUnitsToTokensMultiplierUpdate{})
+			if err := m.ComputeUnitsToTokensMultiplierHistory[len(m.ComputeUnitsToTokensMultiplierHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerRevSharePercentageHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnerRevSharePercentageHistory = append(m.OwnerRevSharePercentageHistory, &ServiceOwnerRevSharePercentageUpdate{})
+			if err := m.OwnerRevSharePercentageHistory[len(m.OwnerRevSharePercentageHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ServiceComputeUnitsToTokensMultiplierUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ServiceComputeUnitsToTokensMultiplierUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ServiceComputeUnitsToTokensMultiplierUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ComputeUnitsToTokensMultiplier", wireType)
+			}
+			m.ComputeUnitsToTokensMultiplier = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ComputeUnitsToTokensMultiplier |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveBlockHeight", wireType)
+			}
+			m.EffectiveBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EffectiveBlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ServiceOwnerRevSharePercentageUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ServiceOwnerRevSharePercentageUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ServiceOwnerRevSharePercentageUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerRevSharePercentage", wireType)
+			}
+			m.OwnerRevSharePercentage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OwnerRevSharePercentage |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveBlockHeight", wireType)
+			}
+			m.EffectiveBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EffectiveBlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -285,3 +285,66 @@ func TestIsValidEndpointUrl(t *testing.T) {
 		})
 	}
 }
+
+func TestService_SetComputeUnitsToTokensMultiplier(t *testing.T) {
+	service := &Service{Id: "svc1", ComputeUnitsToTokensMultiplier: 5}
+
+	// The first update records the multiplier preceding it.
+	service.SetComputeUnitsToTokensMultiplier(7, 11, 0)
+	require.Equal(t, uint64(7), service.GetComputeUnitsToTokensMultiplier())
+	require.Equal(t, uint64(5), service.GetComputeUnitsToTokensMultiplierAtHeight(1))
+	require.Equal(t, uint64(7), service.GetComputeUnitsToTokensMultiplierAtHeight(11))
+
+	// An update effective at the same height replaces the previous one.
+	service.SetComputeUnitsToTokensMultiplier(8, 11, 0)
+	require.Len(t, service.GetComputeUnitsToTokensMultiplierHistory(), 2)
+	require.Equal(t, uint64(5), service.GetComputeUnitsToTokensMultiplierAtHeight(1))
+	require.Equal(t, uint64(8), service.GetComputeUnitsToTokensMultiplierAtHeight(11))
+
+	// A later update keeps the previous ones for the sessions they apply to.
+	service.SetComputeUnitsToTokensMultiplier(9, 21, 1)
+	require.Len(t, service.GetComputeUnitsToTokensMultiplierHistory(), 3)
+	require.Equal(t, uint64(5), service.GetComputeUnitsToTokensMultiplierAtHeight(1))
+	require.Equal(t, uint64(8), service.GetComputeUnitsToTokensMultiplierAtHeight(11))
+	require.Equal(t, uint64(9), service.GetComputeUnitsToTokensMultiplierAtHeight(21))
+
+	// Updates which no longer apply to any retained session are pruned.
+	service.SetComputeUnitsToTokensMultiplier(10, 41, 21)
+	require.Equal(t,
+		[]*ServiceComputeUnitsToTokensMultiplierUpdate{
+			{ComputeUnitsToTokensMultiplier: 9, EffectiveBlockHeight: 21},
+			{ComputeUnitsToTokensMultiplier: 10, EffectiveBlockHeight: 41},
+		},
+		service.GetComputeUnitsToTokensMultiplierHistory(),
+	)
+	require.Equal(t, uint64(9), service.GetComputeUnitsToTokensMultiplierAtHeight(31))
+	require.Equal(t, uint64(10), service.GetComputeUnitsToTokensMultiplierAtHeight(41))
+}
+
+func TestService_SetOwnerRevSharePercentage(t *testing.T) {
+	service := &Service{Id: "svc1", OwnerRevSharePercentage: 5}
+
+	// The first update records the percentage preceding it.
+	service.SetOwnerRevSharePercentage(7, 11, 0)
+	require.Equal(t, uint64(7), service.GetOwnerRevSharePercentage())
+	require.Equal(t, uint64(5), service.GetOwnerRevSharePercentageAtHeight(1))
+	require.Equal(t, uint64(7), service.GetOwnerRevSharePercentageAtHeight(11))
+
+	// An update effective at the same height replaces the previous one.
+	service.SetOwnerRevSharePercentage(8, 11, 0)
+	require.Len(t, service.GetOwnerRevSharePercentageHistory(), 2)
+	require.Equal(t, uint64(5), service.GetOwnerRevSharePercentageAtHeight(1))
+	require.Equal(t, uint64(8), service.GetOwnerRevSharePercentageAtHeight(11))
+
+	// Updates which no longer apply to any retained session are pruned.
+	service.SetOwnerRevSharePercentage(9, 21, 11)
+	require.Equal(t,
+		[]*ServiceOwnerRevSharePercentageUpdate{
+			{OwnerRevSharePercentage: 8, EffectiveBlockHeight: 11},
+			{OwnerRevSharePercentage: 9, EffectiveBlockHeight: 21},
+		},
+		service.GetOwnerRevSharePercentageHistory(),
+	)
+	require.Equal(t, uint64(8), service.GetOwnerRevSharePercentageAtHeight(11))
+	require.Equal(t, uint64(9), service.GetOwnerRevSharePercentageAtHeight(21))
+}
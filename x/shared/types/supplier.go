@@ -1,9 +1,15 @@
 package types
 
+import "slices"
+
 // SupplierNotUnstaking is the value of `unstake_session_end_height` if the
 // supplier is not actively in the unbonding period.
 const SupplierNotUnstaking uint64 = iota
 
+// SupplierNotUnjailed is the value of a jail period's `unjail_height` if the
+// supplier was not unjailed from it yet.
+const SupplierNotUnjailed int64 = 0
+
 // IsUnbonding returns true if the supplier is actively unbonding.
 // It determines if the supplier has submitted an unstake message, in which case
 // the supplier has its UnstakeSessionEndHeight set.
@@ -11,6 +17,90 @@ func (s *Supplier) IsUnbonding() bool {
 	return s.UnstakeSessionEndHeight != SupplierNotUnstaking
 }
 
+// IsJailed returns true if the supplier is excluded from the sessions at the given
+// block height because one of its jail periods applies to that height.
+func (s *Supplier) IsJailed(queryHeight int64) bool {
+	for _, jailPeriod := range s.JailHistory {
+		if jailPeriod.IsActive(queryHeight) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// HasPendingJail returns true if the supplier was jailed and has not been unjailed yet.
+// It is the case for the whole jail period and until the supplier is unjailed.
+func (s *Supplier) HasPendingJail() bool {
+	return s.GetPendingJailPeriod() != nil
+}
+
+// GetPendingJailPeriod returns the latest jail period of the supplier if it was
+// not unjailed from it yet, or nil otherwise.
+func (s *Supplier) GetPendingJailPeriod() *SupplierJailPeriod {
+	if len(s.JailHistory) == 0 {
+		return nil
+	}
+
+	latestJailPeriod := s.JailHistory[len(s.JailHistory)-1]
+	if latestJailPeriod.GetUnjailHeight() != SupplierNotUnjailed {
+		return nil
+	}
+
+	return latestJailPeriod
+}
+
+// GetLatestJailStartHeight returns the session start height from which the supplier
+// was last jailed, or 0 if it was never jailed.
+func (s *Supplier) GetLatestJailStartHeight() int64 {
+	if len(s.JailHistory) == 0 {
+		return 0
+	}
+
+	return s.JailHistory[len(s.JailHistory)-1].GetJailStartHeight()
+}
+
+// Jail records a new jail period of the supplier, starting from jailStartHeight
+// and lasting at least until the session ending at jailEndHeight.
+// The jail periods which no longer apply to any session starting at or after
+// minRetainedHeight are pruned from the history.
+func (s *Supplier) Jail(jailStartHeight, jailEndHeight, minRetainedHeight int64) {
+	jailHistory := make([]*SupplierJailPeriod, 0, len(s.JailHistory)+1)
+	for _, jailPeriod := range s.JailHistory {
+		if jailPeriod.GetUnjailHeight() != SupplierNotUnjailed &&
+			jailPeriod.GetUnjailHeight() <= minRetainedHeight {
+			continue
+		}
+		jailHistory = append(jailHistory, jailPeriod)
+	}
+
+	s.JailHistory = append(jailHistory, &SupplierJailPeriod{
+		JailStartHeight: jailStartHeight,
+		JailEndHeight:   jailEndHeight,
+		UnjailHeight:    SupplierNotUnjailed,
+	})
+}
+
+// Unjail ends the pending jail period of the supplier, which is included in
+// the sessions starting at or after unjailHeight again.
+// It is a no-op if the supplier has no pending jail period.
+func (s *Supplier) Unjail(unjailHeight int64) {
+	if pendingJailPeriod := s.GetPendingJailPeriod(); pendingJailPeriod != nil {
+		pendingJailPeriod.UnjailHeight = unjailHeight
+	}
+}
+
+// IsActive returns true if the jail period applies to the session at the given block height.
+// Jailing and unjailing take effect at session boundaries, so a supplier is either
+// jailed or not for a whole session.
+func (p *SupplierJailPeriod) IsActive(queryHeight int64) bool {
+	if queryHeight < p.GetJailStartHeight() {
+		return false
+	}
+
+	return p.GetUnjailHeight() == SupplierNotUnjailed || queryHeight < p.GetUnjailHeight()
+}
+
 // IsActive checks if the supplier is authorized to serve requests for a specific service
 // at the given block height.
 //
@@ -63,6 +153,64 @@ func (s *Supplier) HasOperator(address string) bool {
 	return s.OperatorAddress == address
 }
 
+// GetDelegationCommissionPercentageAtHeight returns the delegation commission of the
+// supplier which applies to the session starting at sessionStartHeight.
+func (s *Supplier) GetDelegationCommissionPercentageAtHeight(sessionStartHeight int64) uint64 {
+	history := s.GetDelegationCommissionHistory()
+	if len(history) == 0 {
+		return s.GetDelegationCommissionPercentage()
+	}
+
+	for i := len(history) - 1; i >= 0; i-- {
+		if history[i].GetEffectiveBlockHeight() <= sessionStartHeight {
+			return history[i].GetDelegationCommissionPercentage()
+		}
+	}
+
+	// The sessions preceding the oldest retained update are all settled, so this
+	// is only reached for heights which are no longer relevant.
+	return history[0].GetDelegationCommissionPercentage()
+}
+
+// SetDelegationCommissionPercentage sets the delegation commission of the supplier,
+// which applies to the sessions starting at or after effectiveHeight.
+// The updates which no longer apply to any session starting at or after
+// minRetainedHeight are pruned from the history.
+func (s *Supplier) SetDelegationCommissionPercentage(
+	delegationCommissionPercentage uint64,
+	effectiveHeight int64,
+	minRetainedHeight int64,
+) {
+	history := slices.Clone(s.DelegationCommissionHistory)
+
+	// Record the commission which applies to the sessions preceding the first update.
+	if len(history) == 0 {
+		history = append(history, &SupplierDelegationCommissionUpdate{
+			DelegationCommissionPercentage: s.DelegationCommissionPercentage,
+			EffectiveBlockHeight:           0,
+		})
+	}
+
+	update := &SupplierDelegationCommissionUpdate{
+		DelegationCommissionPercentage: delegationCommissionPercentage,
+		EffectiveBlockHeight:           effectiveHeight,
+	}
+
+	// Only the last update made before a session starts applies to it.
+	if history[len(history)-1].GetEffectiveBlockHeight() == effectiveHeight {
+		history[len(history)-1] = update
+	} else {
+		history = append(history, update)
+	}
+
+	for len(history) > 1 && history[1].GetEffectiveBlockHeight() <= minRetainedHeight {
+		history = history[1:]
+	}
+
+	s.DelegationCommissionPercentage = delegationCommissionPercentage
+	s.DelegationCommissionHistory = history
+}
+
 // GetSupplierUnbondingEndHeight returns the session end height at which the given
 // supplier finishes unbonding.
 //
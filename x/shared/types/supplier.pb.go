@@ -43,6 +43,20 @@ type Supplier struct {
 	// List of historical service configuration updates, tracking the suppliers
 	// services update and corresponding activation heights.
 	ServiceConfigHistory []*ServiceConfigUpdate `protobuf:"bytes,6,rep,name=service_config_history,json=serviceConfigHistory,proto3" json:"service_config_history,omitempty"`
+	// Percentage (0-100) of the delegators' share of the settlement rewards which
+	// is kept by the supplier, and distributed to its shareholders, as a commission.
+	// It is the latest commission set by the supplier owner, which applies from the
+	// session following its update (see delegation_commission_history).
+	DelegationCommissionPercentage uint64 `protobuf:"varint,7,opt,name=delegation_commission_percentage,json=delegationCommissionPercentage,proto3" json:"delegation_commission_percentage,omitempty"`
+	// History of the periods during which the supplier is jailed, and excluded from
+	// sessions, for repeatedly missing or submitting invalid proofs, ordered by jail
+	// start height. Only the latest period may not be unjailed yet, and the periods
+	// which no longer apply to the sessions that may still be claimed or settled are pruned.
+	JailHistory []*SupplierJailPeriod `protobuf:"bytes,8,rep,name=jail_history,json=jailHistory,proto3" json:"jail_history,omitempty"`
+	// History of the delegation commission updates, ordered by effective height.
+	// It is empty until the commission is first updated, and only retains the updates
+	// which apply to the sessions that may still be claimed or settled.
+	DelegationCommissionHistory []*SupplierDelegationCommissionUpdate `protobuf:"bytes,11,rep,name=delegation_commission_history,json=delegationCommissionHistory,proto3" json:"delegation_commission_history,omitempty"`
 }
 
 func (m *Supplier) Reset()         { *m = Supplier{} }
@@ -116,6 +130,141 @@ func (m *Supplier) GetServiceConfigHistory() []*ServiceConfigUpdate {
 	return nil
 }
 
+func (m *Supplier) GetDelegationCommissionPercentage() uint64 {
+	if m != nil {
+		return m.DelegationCommissionPercentage
+	}
+	return 0
+}
+
+func (m *Supplier) GetJailHistory() []*SupplierJailPeriod {
+	if m != nil {
+		return m.JailHistory
+	}
+	return nil
+}
+
+func (m *Supplier) GetDelegationCommissionHistory() []*SupplierDelegationCommissionUpdate {
+	if m != nil {
+		return m.DelegationCommissionHistory
+	}
+	return nil
+}
+
+// SupplierJailPeriod is a period during which a supplier is excluded from sessions.
+type SupplierJailPeriod struct {
+	// Session start height from which the supplier is jailed.
+	JailStartHeight int64 `protobuf:"varint,1,opt,name=jail_start_height,json=jailStartHeight,proto3" json:"jail_start_height,omitempty"`
+	// Session end height of the last session the supplier is jailed for.
+	// The supplier can only be unjailed once this session is reached.
+	JailEndHeight int64 `protobuf:"varint,2,opt,name=jail_end_height,json=jailEndHeight,proto3" json:"jail_end_height,omitempty"`
+	// Session start height from which the unjailed supplier is included in sessions
+	// again (0 if not unjailed yet)
+	UnjailHeight int64 `protobuf:"varint,3,opt,name=unjail_height,json=unjailHeight,proto3" json:"unjail_height,omitempty"`
+}
+
+func (m *SupplierJailPeriod) Reset()         { *m = SupplierJailPeriod{} }
+func (m *SupplierJailPeriod) String() string { return proto.CompactTextString(m) }
+func (*SupplierJailPeriod) ProtoMessage()    {}
+func (*SupplierJailPeriod) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fd9cf6b0d91d1e18, []int{1}
+}
+func (m *SupplierJailPeriod) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SupplierJailPeriod) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SupplierJailPeriod) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SupplierJailPeriod.Merge(m, src)
+}
+func (m *SupplierJailPeriod) XXX_Size() int {
+	return m.Size()
+}
+func (m *SupplierJailPeriod) XXX_DiscardUnknown() {
+	xxx_messageInfo_SupplierJailPeriod.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SupplierJailPeriod proto.InternalMessageInfo
+
+func (m *SupplierJailPeriod) GetJailStartHeight() int64 {
+	if m != nil {
+		return m.JailStartHeight
+	}
+	return 0
+}
+
+func (m *SupplierJailPeriod) GetJailEndHeight() int64 {
+	if m != nil {
+		return m.JailEndHeight
+	}
+	return 0
+}
+
+func (m *SupplierJailPeriod) GetUnjailHeight() int64 {
+	if m != nil {
+		return m.UnjailHeight
+	}
+	return 0
+}
+
+// SupplierDelegationCommissionUpdate is a delegation commission of a supplier
+// along with the height from which it applies.
+type SupplierDelegationCommissionUpdate struct {
+	// Percentage (0-100) of the delegators' share of the settlement rewards kept by the supplier.
+	DelegationCommissionPercentage uint64 `protobuf:"varint,1,opt,name=delegation_commission_percentage,json=delegationCommissionPercentage,proto3" json:"delegation_commission_percentage,omitempty"`
+	// The start height of the first session settled with this commission.
+	EffectiveBlockHeight int64 `protobuf:"varint,2,opt,name=effective_block_height,json=effectiveBlockHeight,proto3" json:"effective_block_height,omitempty"`
+}
+
+func (m *SupplierDelegationCommissionUpdate) Reset()         { *m = SupplierDelegationCommissionUpdate{} }
+func (m *SupplierDelegationCommissionUpdate) String() string { return proto.CompactTextString(m) }
+func (*SupplierDelegationCommissionUpdate) ProtoMessage()    {}
+func (*SupplierDelegationCommissionUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fd9cf6b0d91d1e18, []int{2}
+}
+func (m *SupplierDelegationCommissionUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SupplierDelegationCommissionUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SupplierDelegationCommissionUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SupplierDelegationCommissionUpdate.Merge(m, src)
+}
+func (m *SupplierDelegationCommissionUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *SupplierDelegationCommissionUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_SupplierDelegationCommissionUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SupplierDelegationCommissionUpdate proto.InternalMessageInfo
+
+func (m *SupplierDelegationCommissionUpdate) GetDelegationCommissionPercentage() uint64 {
+	if m != nil {
+		return m.DelegationCommissionPercentage
+	}
+	return 0
+}
+
+func (m *SupplierDelegationCommissionUpdate) GetEffectiveBlockHeight() int64 {
+	if m != nil {
+		return m.EffectiveBlockHeight
+	}
+	return 0
+}
+
 // ServiceConfigUpdate tracks a change in a supplier's service configurations
 // at a specific block height, enabling tracking of configuration changes over time.
 // This record helps maintain a complete history of service configs and their availability periods.
@@ -139,7 +288,7 @@ func (m *ServiceConfigUpdate) Reset()         { *m = ServiceConfigUpdate{} }
 func (m *ServiceConfigUpdate) String() string { return proto.CompactTextString(m) }
 func (*ServiceConfigUpdate) ProtoMessage()    {}
 func (*ServiceConfigUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fd9cf6b0d91d1e18, []int{1}
+	return fileDescriptor_fd9cf6b0d91d1e18, []int{3}
 }
 func (m *ServiceConfigUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -194,43 +343,56 @@ func (m *ServiceConfigUpdate) GetDeactivationHeight() int64 {
 
 func init() {
 	proto.RegisterType((*Supplier)(nil), "pocket.shared.Supplier")
+	proto.RegisterType((*SupplierJailPeriod)(nil), "pocket.shared.SupplierJailPeriod")
+	proto.RegisterType((*SupplierDelegationCommissionUpdate)(nil), "pocket.shared.SupplierDelegationCommissionUpdate")
 	proto.RegisterType((*ServiceConfigUpdate)(nil), "pocket.shared.ServiceConfigUpdate")
 }
 
 func init() { proto.RegisterFile("pocket/shared/supplier.proto", fileDescriptor_fd9cf6b0d91d1e18) }
 
 var fileDescriptor_fd9cf6b0d91d1e18 = []byte{
-	// 473 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0x8e, 0x49, 0x5a, 0xca, 0x96, 0x8a, 0xb2, 0x8d, 0xc0, 0x0d, 0xc8, 0x8a, 0x22, 0x0e, 0x41,
-	0xa8, 0x5e, 0xb5, 0x1c, 0x11, 0x08, 0x1a, 0x21, 0xf5, 0xc2, 0xc5, 0x11, 0x12, 0xe2, 0x62, 0x6d,
-	0xec, 0xc1, 0x5e, 0x25, 0xdd, 0xb1, 0x76, 0x37, 0x29, 0x7d, 0x0b, 0x8e, 0x3c, 0x08, 0x0f, 0xc1,
-	0xb1, 0xe2, 0xd4, 0x13, 0x42, 0xc9, 0x8b, 0xa0, 0xec, 0xae, 0x69, 0x68, 0x23, 0xc1, 0xcd, 0x33,
-	0xdf, 0x8f, 0x67, 0xbe, 0x59, 0xf2, 0xb8, 0xc2, 0x6c, 0x0c, 0x86, 0xe9, 0x92, 0x2b, 0xc8, 0x99,
-	0x9e, 0x56, 0xd5, 0x44, 0x80, 0x8a, 0x2b, 0x85, 0x06, 0xe9, 0x8e, 0x43, 0x63, 0x87, 0x76, 0xf6,
-	0x33, 0xd4, 0xa7, 0xa8, 0x53, 0x0b, 0x32, 0x57, 0x38, 0x66, 0x27, 0x72, 0x15, 0x1b, 0x71, 0x0d,
-	0x6c, 0x76, 0x38, 0x02, 0xc3, 0x0f, 0x59, 0x86, 0x42, 0x7a, 0xfc, 0xd1, 0xb5, 0xff, 0x80, 0x9a,
-	0x89, 0x0c, 0x3c, 0xd8, 0x2e, 0xb0, 0x40, 0x67, 0xba, 0xfc, 0x72, 0xdd, 0xde, 0xd7, 0x26, 0xd9,
-	0x1a, 0xfa, 0x79, 0xe8, 0x4b, 0xb2, 0x83, 0x67, 0x12, 0x54, 0xca, 0xf3, 0x5c, 0x81, 0xd6, 0x61,
-	0xd0, 0x0d, 0xfa, 0x77, 0x8e, 0xc3, 0x1f, 0xdf, 0x0e, 0xda, 0x7e, 0x90, 0x37, 0x0e, 0x19, 0x1a,
-	0x25, 0x64, 0x91, 0xdc, 0xb5, 0x74, 0xdf, 0xa3, 0x03, 0xb2, 0x8b, 0x15, 0x28, 0x6e, 0xf0, 0xca,
-	0xe1, 0xd6, 0x3f, 0x1c, 0xee, 0xd5, 0x8a, 0xda, 0x84, 0x91, 0x0d, 0x6d, 0xf8, 0x18, 0xc2, 0x66,
-	0x37, 0xe8, 0x6f, 0x1f, 0xed, 0xc7, 0x5e, 0xb6, 0xdc, 0x39, 0xf6, 0x3b, 0xc7, 0x03, 0x14, 0x32,
-	0x71, 0x3c, 0xfa, 0x9a, 0x6c, 0xf9, 0x45, 0x75, 0xd8, 0xea, 0x36, 0xfb, 0xdb, 0x47, 0x4f, 0xe2,
-	0xbf, 0x12, 0x8d, 0xeb, 0xfd, 0x86, 0x8e, 0x36, 0x40, 0xf9, 0x49, 0x14, 0xc9, 0x1f, 0x15, 0x7d,
-	0x41, 0x3a, 0x53, 0x69, 0xcd, 0x52, 0x0d, 0x5a, 0x0b, 0x94, 0x29, 0xc8, 0x3c, 0x2d, 0x41, 0x14,
-	0xa5, 0x09, 0x37, 0xba, 0x41, 0xbf, 0x95, 0x3c, 0xf4, 0x8c, 0xa1, 0x23, 0xbc, 0x95, 0xf9, 0x89,
-	0x85, 0xe9, 0x07, 0xf2, 0xc0, 0x1b, 0xa5, 0x99, 0x35, 0x4e, 0x4b, 0xa1, 0x0d, 0xaa, 0xf3, 0x70,
-	0xd3, 0x0e, 0xd3, 0xbb, 0x3e, 0xcc, 0xea, 0x10, 0xef, 0xab, 0x9c, 0x1b, 0x48, 0xda, 0x7a, 0xb5,
-	0x79, 0xe2, 0xf4, 0xbd, 0x9f, 0x01, 0xd9, 0x5b, 0xc3, 0xa6, 0x4f, 0xd7, 0xc4, 0x6c, 0x0f, 0x75,
-	0x33, 0xcc, 0x57, 0xe4, 0xb6, 0xb7, 0xb6, 0x87, 0xf8, 0xdf, 0x68, 0x6a, 0x11, 0x7d, 0x46, 0xee,
-	0xf3, 0xcc, 0x88, 0x19, 0x37, 0xcb, 0x50, 0x7c, 0x20, 0xcb, 0xc3, 0x34, 0x93, 0xdd, 0x2b, 0xc0,
-	0x27, 0xc1, 0xc8, 0x5e, 0x0e, 0x37, 0xe9, 0x2d, 0x4b, 0xa7, 0xab, 0x90, 0x13, 0x1c, 0xbf, 0xfb,
-	0x3e, 0x8f, 0x82, 0x8b, 0x79, 0x14, 0x5c, 0xce, 0xa3, 0xe0, 0xd7, 0x3c, 0x0a, 0xbe, 0x2c, 0xa2,
-	0xc6, 0xc5, 0x22, 0x6a, 0x5c, 0x2e, 0xa2, 0xc6, 0x47, 0x56, 0x08, 0x53, 0x4e, 0x47, 0x71, 0x86,
-	0xa7, 0xac, 0xc2, 0xb1, 0x39, 0x90, 0x60, 0xce, 0x50, 0x8d, 0x6d, 0xa1, 0x70, 0x32, 0x61, 0x9f,
-	0xeb, 0x87, 0x6e, 0xce, 0x2b, 0xd0, 0xa3, 0x4d, 0xfb, 0xa2, 0x9f, 0xff, 0x0e, 0x00, 0x00, 0xff,
-	0xff, 0x23, 0x44, 0xe8, 0x3a, 0x6e, 0x03, 0x00, 0x00,
+	// 651 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xdd, 0x6e, 0xd3, 0x30,
+	0x14, 0x5e, 0xd6, 0xfd, 0xe1, 0xae, 0xda, 0xe6, 0x55, 0x23, 0xdb, 0x20, 0x2a, 0x05, 0xa1, 0x02,
+	0x5a, 0xa2, 0x0d, 0xee, 0x10, 0x08, 0xd6, 0x21, 0x4d, 0x48, 0x48, 0x53, 0x2a, 0x24, 0xc4, 0x4d,
+	0xe4, 0x26, 0x67, 0xa9, 0x69, 0x1a, 0x47, 0xb6, 0xbb, 0xb1, 0x87, 0x40, 0xe2, 0x0d, 0xb8, 0xe1,
+	0x11, 0x78, 0x08, 0x2e, 0x27, 0xae, 0x76, 0x85, 0x50, 0xf7, 0x22, 0x28, 0xb6, 0xd3, 0xee, 0xa7,
+	0xd3, 0x7a, 0x17, 0x9f, 0xef, 0x3b, 0x9f, 0xbf, 0x73, 0x8e, 0x4f, 0xd0, 0xbd, 0x8c, 0x85, 0x5d,
+	0x90, 0x9e, 0xe8, 0x10, 0x0e, 0x91, 0x27, 0xfa, 0x59, 0x96, 0x50, 0xe0, 0x6e, 0xc6, 0x99, 0x64,
+	0xb8, 0xa2, 0x51, 0x57, 0xa3, 0x1b, 0xeb, 0x21, 0x13, 0x3d, 0x26, 0x02, 0x05, 0x7a, 0xfa, 0xa0,
+	0x99, 0x1b, 0x8e, 0x3e, 0x79, 0x6d, 0x22, 0xc0, 0x3b, 0xda, 0x6e, 0x83, 0x24, 0xdb, 0x5e, 0xc8,
+	0x68, 0x6a, 0xf0, 0xcd, 0x2b, 0xf7, 0x00, 0x3f, 0xa2, 0x21, 0x18, 0xb0, 0x1a, 0xb3, 0x98, 0x69,
+	0xd1, 0xfc, 0x4b, 0x47, 0xeb, 0x3f, 0x66, 0xd1, 0x42, 0xcb, 0xf8, 0xc1, 0xaf, 0x50, 0x85, 0x1d,
+	0xa7, 0xc0, 0x03, 0x12, 0x45, 0x1c, 0x84, 0xb0, 0xad, 0x9a, 0xd5, 0xb8, 0xb3, 0x6b, 0xff, 0xf9,
+	0xb5, 0x55, 0x35, 0x46, 0xde, 0x6a, 0xa4, 0x25, 0x39, 0x4d, 0x63, 0x7f, 0x51, 0xd1, 0x4d, 0x0c,
+	0x37, 0xd1, 0x32, 0xcb, 0x80, 0x13, 0xc9, 0x46, 0x0a, 0xd3, 0xb7, 0x28, 0x2c, 0x15, 0x19, 0x85,
+	0x88, 0x87, 0x66, 0x85, 0x24, 0x5d, 0xb0, 0x4b, 0x35, 0xab, 0x51, 0xde, 0x59, 0x77, 0x4d, 0x5a,
+	0x5e, 0xb3, 0x6b, 0x6a, 0x76, 0x9b, 0x8c, 0xa6, 0xbe, 0xe6, 0xe1, 0x37, 0x68, 0xc1, 0x14, 0x2a,
+	0xec, 0x99, 0x5a, 0xa9, 0x51, 0xde, 0x79, 0xe4, 0x5e, 0xea, 0xa8, 0x5b, 0xd4, 0xd7, 0xd2, 0xb4,
+	0x26, 0x4b, 0x0f, 0x69, 0xec, 0x0f, 0xb3, 0xf0, 0x4b, 0xb4, 0xd1, 0x4f, 0x95, 0x58, 0x20, 0x40,
+	0x08, 0xca, 0xd2, 0x00, 0xd2, 0x28, 0xe8, 0x00, 0x8d, 0x3b, 0xd2, 0x9e, 0xad, 0x59, 0x8d, 0x19,
+	0xff, 0xae, 0x61, 0xb4, 0x34, 0xe1, 0x5d, 0x1a, 0xed, 0x2b, 0x18, 0x7f, 0x42, 0x6b, 0x46, 0x28,
+	0x08, 0x95, 0x70, 0xd0, 0xa1, 0x42, 0x32, 0x7e, 0x62, 0xcf, 0x29, 0x33, 0xf5, 0xab, 0x66, 0x2e,
+	0x9a, 0xf8, 0x98, 0x45, 0x44, 0x82, 0x5f, 0x15, 0x17, 0x83, 0xfb, 0x3a, 0x1f, 0xef, 0xa3, 0x5a,
+	0x04, 0x09, 0xc4, 0x44, 0xe6, 0x8e, 0x42, 0xd6, 0xeb, 0x51, 0x6d, 0x2e, 0x03, 0x1e, 0x42, 0x2a,
+	0x49, 0x0c, 0xf6, 0xbc, 0x32, 0xe7, 0x8c, 0x78, 0xcd, 0x21, 0xed, 0x60, 0xc8, 0xc2, 0x7b, 0x68,
+	0xf1, 0x0b, 0xa1, 0xc9, 0xd0, 0xd9, 0x82, 0x72, 0xf6, 0xe0, 0x86, 0x36, 0xbd, 0x27, 0x34, 0x39,
+	0x00, 0x4e, 0x59, 0xe4, 0x97, 0xf3, 0xb4, 0xc2, 0x4f, 0x1f, 0xdd, 0x1f, 0xef, 0xa7, 0x90, 0x2d,
+	0x2b, 0xd9, 0xed, 0x1b, 0x64, 0xf7, 0xc6, 0x78, 0x34, 0xf5, 0x6f, 0x8e, 0xf3, 0x6f, 0xae, 0xad,
+	0x7f, 0xb3, 0x10, 0xbe, 0x6e, 0x0d, 0x3f, 0x45, 0x2b, 0xaa, 0x26, 0x21, 0x09, 0x97, 0xc5, 0xac,
+	0xf2, 0xf7, 0x5a, 0xf2, 0x97, 0x72, 0xa0, 0x95, 0xc7, 0xcd, 0x8c, 0x1e, 0x23, 0x15, 0xba, 0x38,
+	0xd5, 0x69, 0xc5, 0xac, 0xe4, 0xe1, 0xd1, 0x2c, 0x1f, 0xa2, 0x4a, 0x3f, 0xd5, 0x9d, 0xd2, 0xac,
+	0x92, 0x62, 0x2d, 0xea, 0xa0, 0x26, 0xd5, 0x7f, 0x5a, 0xa8, 0x7e, 0x7b, 0x4d, 0x13, 0x4d, 0xcf,
+	0x9a, 0x68, 0x7a, 0x2f, 0xd0, 0x1a, 0x1c, 0x1e, 0x42, 0x28, 0xe9, 0x11, 0x04, 0xed, 0x84, 0x85,
+	0xdd, 0xcb, 0x45, 0x54, 0x87, 0xe8, 0x6e, 0x0e, 0x1a, 0x9b, 0x7f, 0x2d, 0xb4, 0x3a, 0xe6, 0xad,
+	0xe1, 0x27, 0x63, 0x96, 0x54, 0xad, 0xf9, 0xf5, 0x55, 0x7c, 0x8d, 0xe6, 0xcd, 0xc3, 0x54, 0x37,
+	0x4d, 0xba, 0x58, 0x45, 0x12, 0x7e, 0x86, 0x56, 0x48, 0xee, 0x4b, 0xb7, 0xe0, 0x52, 0x4b, 0x97,
+	0x47, 0x80, 0xe9, 0xbd, 0x87, 0x56, 0x23, 0xb8, 0x4e, 0x9f, 0x51, 0x74, 0x1c, 0xc1, 0xd5, 0x84,
+	0xdd, 0x0f, 0xbf, 0x07, 0x8e, 0x75, 0x3a, 0x70, 0xac, 0xb3, 0x81, 0x63, 0xfd, 0x1b, 0x38, 0xd6,
+	0xf7, 0x73, 0x67, 0xea, 0xf4, 0xdc, 0x99, 0x3a, 0x3b, 0x77, 0xa6, 0x3e, 0x7b, 0x31, 0x95, 0x9d,
+	0x7e, 0xdb, 0x0d, 0x59, 0xcf, 0xcb, 0x58, 0x57, 0x6e, 0xa5, 0x20, 0x8f, 0x19, 0xef, 0xaa, 0x03,
+	0x67, 0x49, 0xe2, 0x7d, 0x2d, 0x7e, 0x93, 0xf2, 0x24, 0x03, 0xd1, 0x9e, 0x53, 0xff, 0xc3, 0xe7,
+	0xff, 0x07, 0x00, 0xd8, 0xb8, 0x71, 0x08, 0xac, 0x05, 0x00, 0x00,
 }
 
 func (m *Supplier) Marshal() (dAtA []byte, err error) {
@@ -253,6 +415,39 @@ func (m *Supplier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DelegationCommissionHistory) > 0 {
+		for iNdEx := len(m.DelegationCommissionHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegationCommissionHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSupplier(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.JailHistory) > 0 {
+		for iNdEx := len(m.JailHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.JailHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSupplier(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.DelegationCommissionPercentage != 0 {
+		i = encodeVarintSupplier(dAtA, i, uint64(m.DelegationCommissionPercentage))
+		i--
+		dAtA[i] = 0x38
+	}
 	if len(m.ServiceConfigHistory) > 0 {
 		for iNdEx := len(m.ServiceConfigHistory) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -315,6 +510,77 @@ func (m *Supplier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SupplierJailPeriod) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SupplierJailPeriod) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SupplierJailPeriod) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UnjailHeight != 0 {
+		i = encodeVarintSupplier(dAtA, i, uint64(m.UnjailHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.JailEndHeight != 0 {
+		i = encodeVarintSupplier(dAtA, i, uint64(m.JailEndHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.JailStartHeight != 0 {
+		i = encodeVarintSupplier(dAtA, i, uint64(m.JailStartHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SupplierDelegationCommissionUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SupplierDelegationCommissionUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SupplierDelegationCommissionUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EffectiveBlockHeight != 0 {
+		i = encodeVarintSupplier(dAtA, i, uint64(m.EffectiveBlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.DelegationCommissionPercentage != 0 {
+		i = encodeVarintSupplier(dAtA, i, uint64(m.DelegationCommissionPercentage))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ServiceConfigUpdate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -411,6 +677,54 @@ func (m *Supplier) Size() (n int) {
 			n += 1 + l + sovSupplier(uint64(l))
 		}
 	}
+	if m.DelegationCommissionPercentage != 0 {
+		n += 1 + sovSupplier(uint64(m.DelegationCommissionPercentage))
+	}
+	if len(m.JailHistory) > 0 {
+		for _, e := range m.JailHistory {
+			l = e.Size()
+			n += 1 + l + sovSupplier(uint64(l))
+		}
+	}
+	if len(m.DelegationCommissionHistory) > 0 {
+		for _, e := range m.DelegationCommissionHistory {
+			l = e.Size()
+			n += 1 + l + sovSupplier(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SupplierJailPeriod) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.JailStartHeight != 0 {
+		n += 1 + sovSupplier(uint64(m.JailStartHeight))
+	}
+	if m.JailEndHeight != 0 {
+		n += 1 + sovSupplier(uint64(m.JailEndHeight))
+	}
+	if m.UnjailHeight != 0 {
+		n += 1 + sovSupplier(uint64(m.UnjailHeight))
+	}
+	return n
+}
+
+func (m *SupplierDelegationCommissionUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DelegationCommissionPercentage != 0 {
+		n += 1 + sovSupplier(uint64(m.DelegationCommissionPercentage))
+	}
+	if m.EffectiveBlockHeight != 0 {
+		n += 1 + sovSupplier(uint64(m.EffectiveBlockHeight))
+	}
 	return n
 }
 
@@ -659,6 +973,288 @@ func (m *Supplier) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationCommissionPercentage", wireType)
+			}
+			m.DelegationCommissionPercentage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSupplier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DelegationCommissionPercentage |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSupplier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSupplier
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSupplier
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JailHistory = append(m.JailHistory, &SupplierJailPeriod{})
+			if err := m.JailHistory[len(m.JailHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationCommissionHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSupplier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSupplier
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSupplier
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegationCommissionHistory = append(m.DelegationCommissionHistory, &SupplierDelegationCommissionUpdate{})
+			if err := m.DelegationCommissionHistory[len(m.DelegationCommissionHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSupplier(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSupplier
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SupplierJailPeriod) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSupplier
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SupplierJailPeriod: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SupplierJailPeriod: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailStartHeight", wireType)
+			}
+			m.JailStartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSupplier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JailStartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailEndHeight", wireType)
+			}
+			m.JailEndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSupplier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JailEndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnjailHeight", wireType)
+			}
+			m.UnjailHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSupplier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnjailHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSupplier(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSupplier
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SupplierDelegationCommissionUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSupplier
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SupplierDelegationCommissionUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SupplierDelegationCommissionUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationCommissionPercentage", wireType)
+			}
+			m.DelegationCommissionPercentage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSupplier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DelegationCommissionPercentage |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveBlockHeight", wireType)
+			}
+			m.EffectiveBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSupplier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EffectiveBlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSupplier(dAtA[iNdEx:])
@@ -0,0 +1,78 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSupplier_SetDelegationCommissionPercentage(t *testing.T) {
+	supplier := &Supplier{OperatorAddress: "operator", DelegationCommissionPercentage: 5}
+
+	// The first update records the commission preceding it.
+	supplier.SetDelegationCommissionPercentage(7, 11, 0)
+	require.Equal(t, uint64(7), supplier.GetDelegationCommissionPercentage())
+	require.Equal(t, uint64(5), supplier.GetDelegationCommissionPercentageAtHeight(1))
+	require.Equal(t, uint64(7), supplier.GetDelegationCommissionPercentageAtHeight(11))
+
+	// An update effective at the same height replaces the previous one.
+	supplier.SetDelegationCommissionPercentage(8, 11, 0)
+	require.Len(t, supplier.GetDelegationCommissionHistory(), 2)
+	require.Equal(t, uint64(5), supplier.GetDelegationCommissionPercentageAtHeight(1))
+	require.Equal(t, uint64(8), supplier.GetDelegationCommissionPercentageAtHeight(11))
+
+	// A later update keeps the previous ones for the sessions they apply to.
+	supplier.SetDelegationCommissionPercentage(100, 21, 1)
+	require.Len(t, supplier.GetDelegationCommissionHistory(), 3)
+	require.Equal(t, uint64(5), supplier.GetDelegationCommissionPercentageAtHeight(1))
+	require.Equal(t, uint64(8), supplier.GetDelegationCommissionPercentageAtHeight(11))
+	require.Equal(t, uint64(100), supplier.GetDelegationCommissionPercentageAtHeight(21))
+
+	// Updates which no longer apply to any retained session are pruned.
+	supplier.SetDelegationCommissionPercentage(10, 41, 21)
+	require.Equal(t,
+		[]*SupplierDelegationCommissionUpdate{
+			{DelegationCommissionPercentage: 100, EffectiveBlockHeight: 21},
+			{DelegationCommissionPercentage: 10, EffectiveBlockHeight: 41},
+		},
+		supplier.GetDelegationCommissionHistory(),
+	)
+	require.Equal(t, uint64(100), supplier.GetDelegationCommissionPercentageAtHeight(31))
+	require.Equal(t, uint64(10), supplier.GetDelegationCommissionPercentageAtHeight(41))
+}
+
+func TestSupplier_JailHistory(t *testing.T) {
+	supplier := &Supplier{OperatorAddress: "operator"}
+	require.False(t, supplier.HasPendingJail())
+	require.False(t, supplier.IsJailed(1))
+
+	// The supplier is jailed for the sessions starting at 5 until it is unjailed.
+	supplier.Jail(5, 8, 0)
+	require.True(t, supplier.HasPendingJail())
+	require.False(t, supplier.IsJailed(4))
+	require.True(t, supplier.IsJailed(5))
+	require.True(t, supplier.IsJailed(100))
+
+	supplier.Unjail(13)
+	require.False(t, supplier.HasPendingJail())
+	require.True(t, supplier.IsJailed(12))
+	require.False(t, supplier.IsJailed(13))
+
+	// Jailing the supplier again keeps the previous jail period.
+	supplier.Jail(17, 20, 0)
+	require.True(t, supplier.HasPendingJail())
+	require.True(t, supplier.IsJailed(9))
+	require.False(t, supplier.IsJailed(13))
+	require.True(t, supplier.IsJailed(17))
+
+	// The jail periods which no longer apply to any retained session are pruned.
+	supplier.Unjail(25)
+	supplier.Jail(29, 32, 13)
+	require.Equal(t,
+		[]*SupplierJailPeriod{
+			{JailStartHeight: 17, JailEndHeight: 20, UnjailHeight: 25},
+			{JailStartHeight: 29, JailEndHeight: 32, UnjailHeight: SupplierNotUnjailed},
+		},
+		supplier.GetJailHistory(),
+	)
+}
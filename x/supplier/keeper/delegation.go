@@ -0,0 +1,126 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+
+	"github.com/pokt-network/poktroll/x/supplier/types"
+)
+
+// SetDelegation stores a delegation record and maintains its unstaking index.
+//
+// The unstaking index enables the EndBlocker to efficiently find delegations that
+// are unbonding without iterating over and unmarshaling all delegations in the store.
+func (k Keeper) SetDelegation(ctx context.Context, delegation types.Delegation) {
+	delegationKey := types.DelegationKey(delegation.SupplierOperatorAddress, delegation.DelegatorAddress)
+
+	delegationBz := k.cdc.MustMarshal(&delegation)
+	k.getDelegationStore(ctx).Set(delegationKey, delegationBz)
+
+	delegationUnstakingHeightStore := k.getDelegationUnstakingHeightStore(ctx)
+	if delegation.IsUnbonding() {
+		// Add to unstaking index if the delegation is unbonding
+		delegationUnstakingHeightStore.Set(delegationKey, delegationKey)
+	} else {
+		// Remove from unstaking index if the delegation is not unbonding
+		delegationUnstakingHeightStore.Delete(delegationKey)
+	}
+}
+
+// GetDelegation retrieves the delegation of the given delegator to the given supplier.
+func (k Keeper) GetDelegation(
+	ctx context.Context,
+	supplierOperatorAddr string,
+	delegatorAddr string,
+) (delegation types.Delegation, found bool) {
+	delegationKey := types.DelegationKey(supplierOperatorAddr, delegatorAddr)
+	return k.getDelegationByKey(ctx, delegationKey)
+}
+
+// RemoveDelegation deletes a delegation from the store and removes its unstaking index.
+func (k Keeper) RemoveDelegation(
+	ctx context.Context,
+	supplierOperatorAddr string,
+	delegatorAddr string,
+) {
+	delegationKey := types.DelegationKey(supplierOperatorAddr, delegatorAddr)
+
+	k.getDelegationUnstakingHeightStore(ctx).Delete(delegationKey)
+	k.getDelegationStore(ctx).Delete(delegationKey)
+}
+
+// GetSupplierDelegations returns all the delegations, including the unbonding
+// ones, to the given supplier, ordered by delegator address.
+func (k Keeper) GetSupplierDelegations(
+	ctx context.Context,
+	supplierOperatorAddr string,
+) (delegations []types.Delegation) {
+	supplierDelegationStore := prefix.NewStore(
+		k.getDelegationStore(ctx),
+		types.SupplierOperatorKey(supplierOperatorAddr),
+	)
+	iterator := storetypes.KVStorePrefixIterator(supplierDelegationStore, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var delegation types.Delegation
+		k.cdc.MustUnmarshal(iterator.Value(), &delegation)
+		delegations = append(delegations, delegation)
+	}
+
+	return delegations
+}
+
+// GetAllDelegations returns all the delegations stored in the blockchain state.
+func (k Keeper) GetAllDelegations(ctx context.Context) (delegations []types.Delegation) {
+	iterator := storetypes.KVStorePrefixIterator(k.getDelegationStore(ctx), []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var delegation types.Delegation
+		k.cdc.MustUnmarshal(iterator.Value(), &delegation)
+		delegations = append(delegations, delegation)
+	}
+
+	return delegations
+}
+
+// GetAllUnstakingDelegationsIterator returns an iterator for all delegations that
+// are currently unbonding. The iterator values are the delegations' store keys.
+// It is used to process delegations that have completed their unbonding period.
+func (k Keeper) GetAllUnstakingDelegationsIterator(
+	ctx context.Context,
+) storetypes.Iterator {
+	delegationUnstakingHeightStore := k.getDelegationUnstakingHeightStore(ctx)
+
+	return storetypes.KVStorePrefixIterator(delegationUnstakingHeightStore, []byte{})
+}
+
+// getDelegationByKey retrieves a delegation given its store key.
+func (k Keeper) getDelegationByKey(
+	ctx context.Context,
+	delegationKey []byte,
+) (delegation types.Delegation, found bool) {
+	delegationBz := k.getDelegationStore(ctx).Get(delegationKey)
+	if delegationBz == nil {
+		return delegation, false
+	}
+
+	k.cdc.MustUnmarshal(delegationBz, &delegation)
+	return delegation, true
+}
+
+// getDelegationStore returns a KVStore for the delegation data
+func (k Keeper) getDelegationStore(ctx context.Context) storetypes.KVStore {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(storeAdapter, types.KeyPrefix(types.DelegationKeyPrefix))
+}
+
+// getDelegationUnstakingHeightStore returns a KVStore for the delegation unstaking height index
+func (k Keeper) getDelegationUnstakingHeightStore(ctx context.Context) storetypes.KVStore {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(storeAdapter, types.KeyPrefix(types.DelegationUnstakingHeightKeyPrefix))
+}
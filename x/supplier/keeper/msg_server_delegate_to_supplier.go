@@ -0,0 +1,137 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/pokt-network/poktroll/app/volatile"
+	"github.com/pokt-network/poktroll/telemetry"
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
+	suppliertypes "github.com/pokt-network/poktroll/x/supplier/types"
+)
+
+// DelegateToSupplier handles the MsgDelegateToSupplier message by applying the following logic:
+//   - the msg is validated
+//   - the supplier must exist and must not be unbonding
+//   - the delegated amount is transferred from the delegator's account to the supplier module account
+//   - the amount is added to any existing delegation of the delegator to the supplier
+//   - if the existing delegation is unbonding, the unbonding is canceled
+//   - the delegation is (re-)activated from the next session
+//   - an EventSupplierDelegated event is emitted
+//
+// Delegated stake is slashed along with the supplier right away, but only earns
+// a share of the rewards of the claims for the sessions starting after it is
+// delegated. Adding stake to an existing delegation defers the whole delegation's
+// rewards to the next session, such that no stake is rewarded for a session
+// which started before it was delegated.
+func (k msgServer) DelegateToSupplier(
+	ctx context.Context,
+	msg *suppliertypes.MsgDelegateToSupplier,
+) (*suppliertypes.MsgDelegateToSupplierResponse, error) {
+	isSuccessful := false
+	defer telemetry.EventSuccessCounter(
+		"delegate_to_supplier",
+		telemetry.DefaultCounterFn,
+		func() bool { return isSuccessful },
+	)
+
+	logger := k.Logger().With("method", "DelegateToSupplier")
+	logger.Info(fmt.Sprintf("About to delegate to supplier with msg: %v", msg))
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Ensure the supplier exists and is not unbonding.
+	supplier, isSupplierFound := k.GetDehydratedSupplier(ctx, msg.GetSupplierOperatorAddress())
+	if !isSupplierFound {
+		logger.Info(fmt.Sprintf("Supplier not found. Cannot delegate to address %s", msg.GetSupplierOperatorAddress()))
+		return nil, status.Error(
+			codes.NotFound,
+			suppliertypes.ErrSupplierNotFound.Wrapf(
+				"supplier with operator address %q", msg.GetSupplierOperatorAddress(),
+			).Error(),
+		)
+	}
+
+	if supplier.IsUnbonding() {
+		logger.Info(fmt.Sprintf("Supplier %s is unbonding. Cannot delegate to it", msg.GetSupplierOperatorAddress()))
+		return nil, status.Error(
+			codes.FailedPrecondition,
+			suppliertypes.ErrSupplierIsUnstaking.Wrapf(
+				"supplier with operator address %q", msg.GetSupplierOperatorAddress(),
+			).Error(),
+		)
+	}
+
+	delegatorAddress, err := sdk.AccAddressFromBech32(msg.GetDelegatorAddress())
+	if err != nil {
+		logger.Info(fmt.Sprintf("ERROR: could not parse address %q", msg.GetDelegatorAddress()))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Escrow the delegated amount in the supplier module account, alongside the suppliers' stakes.
+	if err = k.bankKeeper.SendCoinsFromAccountToModule(
+		ctx, delegatorAddress, suppliertypes.ModuleName, sdk.NewCoins(*msg.Amount),
+	); err != nil {
+		logger.Info(fmt.Sprintf("ERROR: delegator %q could not delegate %s: %s", delegatorAddress, msg.Amount, err))
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	delegation, isDelegationFound := k.GetDelegation(ctx, msg.GetSupplierOperatorAddress(), msg.GetDelegatorAddress())
+	if !isDelegationFound {
+		zeroStake := sdk.NewInt64Coin(volatile.DenomuPOKT, 0)
+		delegation = suppliertypes.Delegation{
+			DelegatorAddress:        msg.GetDelegatorAddress(),
+			SupplierOperatorAddress: msg.GetSupplierOperatorAddress(),
+			Stake:                   &zeroStake,
+			UnstakeSessionEndHeight: suppliertypes.DelegationNotUnstaking,
+		}
+	}
+
+	newStake := delegation.Stake.Add(*msg.Amount)
+	delegation.Stake = &newStake
+
+	// If the delegator has initiated an undelegate action, cancel it since they are delegating again.
+	wasDelegationUnbonding := delegation.IsUnbonding()
+	delegation.UnstakeSessionEndHeight = suppliertypes.DelegationNotUnstaking
+
+	// The delegation earns rewards for the sessions starting after the stake is delegated.
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sharedParams := k.sharedKeeper.GetParams(ctx)
+	delegation.ActivationHeight = sharedtypes.GetNextSessionStartHeight(&sharedParams, sdkCtx.BlockHeight())
+
+	k.SetDelegation(ctx, delegation)
+	logger.Info(fmt.Sprintf("Successfully updated delegation: %+v", delegation))
+
+	events := make([]sdk.Msg, 0)
+	sessionEndHeight := sharedtypes.GetSessionEndHeight(&sharedParams, sdkCtx.BlockHeight())
+
+	if wasDelegationUnbonding {
+		events = append(events, &suppliertypes.EventSupplierDelegationUnbondingCanceled{
+			Delegation:       &delegation,
+			Height:           sdkCtx.BlockHeight(),
+			SessionEndHeight: sessionEndHeight,
+		})
+	}
+
+	// Emit an event which signals that the delegator delegated to the supplier.
+	events = append(events, &suppliertypes.EventSupplierDelegated{
+		Delegation:       &delegation,
+		SessionEndHeight: sessionEndHeight,
+	})
+	if err = sdkCtx.EventManager().EmitTypedEvents(events...); err != nil {
+		err = suppliertypes.ErrSupplierEmitEvent.Wrapf("(%+v): %s", events, err)
+		logger.Error(err.Error())
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	isSuccessful = true
+	return &suppliertypes.MsgDelegateToSupplierResponse{
+		Delegation: &delegation,
+	}, nil
+}
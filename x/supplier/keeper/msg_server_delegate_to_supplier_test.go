@@ -0,0 +1,159 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	cosmostypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/pokt-network/poktroll/app/volatile"
+	testevents "github.com/pokt-network/poktroll/testutil/events"
+	keepertest "github.com/pokt-network/poktroll/testutil/keeper"
+	"github.com/pokt-network/poktroll/testutil/sample"
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
+	"github.com/pokt-network/poktroll/x/supplier/keeper"
+	suppliertypes "github.com/pokt-network/poktroll/x/supplier/types"
+)
+
+func TestMsgServer_DelegateToSupplier_Success(t *testing.T) {
+	supplierModuleKeepers, ctx := keepertest.SupplierKeeper(t)
+	srv := keeper.NewMsgServerImpl(*supplierModuleKeepers.Keeper)
+	sharedParams := supplierModuleKeepers.SharedKeeper.GetParams(ctx)
+
+	supplierOperatorAddr := stakeTestSupplier(t, ctx, srv)
+	delegatorAddr := sample.AccAddress()
+
+	// Delegate to the supplier.
+	delegateMsg := suppliertypes.NewMsgDelegateToSupplier(
+		delegatorAddr,
+		supplierOperatorAddr,
+		cosmostypes.NewInt64Coin(volatile.DenomuPOKT, 100),
+	)
+	res, err := srv.DelegateToSupplier(ctx, delegateMsg)
+	require.NoError(t, err)
+
+	// The delegation earns rewards from the next session.
+	currentHeight := cosmostypes.UnwrapSDKContext(ctx).BlockHeight()
+	expectedStake := cosmostypes.NewInt64Coin(volatile.DenomuPOKT, 100)
+	expectedDelegation := suppliertypes.Delegation{
+		DelegatorAddress:        delegatorAddr,
+		SupplierOperatorAddress: supplierOperatorAddr,
+		Stake:                   &expectedStake,
+		ActivationHeight:        sharedtypes.GetNextSessionStartHeight(&sharedParams, currentHeight),
+	}
+	require.Equal(t, &expectedDelegation, res.GetDelegation())
+
+	// Assert that the EventSupplierDelegated event is emitted.
+	sessionEndHeight := sharedtypes.GetSessionEndHeight(&sharedParams, cosmostypes.UnwrapSDKContext(ctx).BlockHeight())
+	events := cosmostypes.UnwrapSDKContext(ctx).EventManager().Events()
+	delegatedEvents := testevents.FilterEvents[*suppliertypes.EventSupplierDelegated](t, events)
+	require.Len(t, delegatedEvents, 1)
+	require.Equal(t, &suppliertypes.EventSupplierDelegated{
+		Delegation:       &expectedDelegation,
+		SessionEndHeight: sessionEndHeight,
+	}, delegatedEvents[0])
+
+	// Delegate more to the same supplier, in a later session.
+	nextSessionStartHeight := sharedtypes.GetNextSessionStartHeight(&sharedParams, currentHeight)
+	ctx = cosmostypes.UnwrapSDKContext(ctx).WithBlockHeight(nextSessionStartHeight)
+	delegateMsg.Amount = &expectedStake
+	_, err = srv.DelegateToSupplier(ctx, delegateMsg)
+	require.NoError(t, err)
+
+	// Assert that the delegated amount is added to the existing delegation, and
+	// that the whole delegation earns rewards from the session following the top up.
+	foundDelegation, isDelegationFound := supplierModuleKeepers.GetDelegation(ctx, supplierOperatorAddr, delegatorAddr)
+	require.True(t, isDelegationFound)
+	require.Equal(t, int64(200), foundDelegation.GetStake().Amount.Int64())
+	require.False(t, foundDelegation.IsUnbonding())
+	require.Equal(t, sharedtypes.GetNextSessionStartHeight(&sharedParams, nextSessionStartHeight), foundDelegation.GetActivationHeight())
+	require.False(t, foundDelegation.IsActive(nextSessionStartHeight))
+
+	// Assert that the delegated amount was escrowed from the delegator's balance.
+	require.Equal(t, int64(-200), supplierModuleKeepers.SupplierBalanceMap[delegatorAddr])
+
+	delegations := supplierModuleKeepers.GetSupplierDelegations(ctx, supplierOperatorAddr)
+	require.Len(t, delegations, 1)
+}
+
+func TestMsgServer_DelegateToSupplier_Errors(t *testing.T) {
+	delegatorAddr := sample.AccAddress()
+	delegationCoin := cosmostypes.NewInt64Coin(volatile.DenomuPOKT, 100)
+
+	tests := []struct {
+		desc                 string
+		isSupplierUnbonding  bool
+		supplierOperatorAddr string
+		amount               cosmostypes.Coin
+		expectedErr          error
+		expectedCode         codes.Code
+	}{
+		{
+			desc:                 "supplier not found",
+			supplierOperatorAddr: sample.AccAddress(),
+			amount:               delegationCoin,
+			expectedErr:          suppliertypes.ErrSupplierNotFound,
+			expectedCode:         codes.NotFound,
+		},
+		{
+			desc:                "supplier is unbonding",
+			isSupplierUnbonding: true,
+			amount:              delegationCoin,
+			expectedErr:         suppliertypes.ErrSupplierIsUnstaking,
+			expectedCode:        codes.FailedPrecondition,
+		},
+		{
+			desc:         "zero amount",
+			amount:       cosmostypes.NewInt64Coin(volatile.DenomuPOKT, 0),
+			expectedErr:  suppliertypes.ErrSupplierInvalidDelegation,
+			expectedCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			supplierModuleKeepers, ctx := keepertest.SupplierKeeper(t)
+			srv := keeper.NewMsgServerImpl(*supplierModuleKeepers.Keeper)
+
+			supplierOperatorAddr := stakeTestSupplier(t, ctx, srv)
+			if test.isSupplierUnbonding {
+				_, err := srv.UnstakeSupplier(ctx, suppliertypes.NewMsgUnstakeSupplier(supplierOperatorAddr, supplierOperatorAddr))
+				require.NoError(t, err)
+			}
+			if test.supplierOperatorAddr != "" {
+				supplierOperatorAddr = test.supplierOperatorAddr
+			}
+
+			msg := suppliertypes.NewMsgDelegateToSupplier(delegatorAddr, supplierOperatorAddr, test.amount)
+			_, err := srv.DelegateToSupplier(ctx, msg)
+			require.ErrorContains(t, err, test.expectedErr.Error())
+			require.Equal(t, test.expectedCode, status.Code(err))
+
+			// Ensure no delegation was created.
+			_, isDelegationFound := supplierModuleKeepers.GetDelegation(ctx, supplierOperatorAddr, delegatorAddr)
+			require.False(t, isDelegationFound)
+			require.Zero(t, supplierModuleKeepers.SupplierBalanceMap[delegatorAddr])
+		})
+	}
+}
+
+// stakeTestSupplier stakes a supplier, operated by its owner, for the test service
+// and returns its operator address.
+func stakeTestSupplier(t *testing.T, ctx context.Context, srv suppliertypes.MsgServer) string {
+	t.Helper()
+
+	supplierOperatorAddr := sample.AccAddress()
+	stakeMsg, _ := newSupplierStakeMsg(
+		supplierOperatorAddr,
+		supplierOperatorAddr,
+		suppliertypes.DefaultMinStake.Amount.Int64(),
+		serviceID,
+	)
+	_, err := srv.StakeSupplier(ctx, stakeMsg)
+	require.NoError(t, err)
+
+	return supplierOperatorAddr
+}
@@ -0,0 +1,106 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/pokt-network/poktroll/telemetry"
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
+	suppliertypes "github.com/pokt-network/poktroll/x/supplier/types"
+)
+
+// SetSupplierDelegationCommission handles the MsgSetSupplierDelegationCommission
+// message, which updates the percentage of the delegators' share of the settlement
+// rewards that the supplier keeps. Only the supplier owner is allowed to update it.
+func (k msgServer) SetSupplierDelegationCommission(
+	ctx context.Context,
+	msg *suppliertypes.MsgSetSupplierDelegationCommission,
+) (*suppliertypes.MsgSetSupplierDelegationCommissionResponse, error) {
+	isSuccessful := false
+	defer telemetry.EventSuccessCounter(
+		"set_supplier_delegation_commission",
+		telemetry.DefaultCounterFn,
+		func() bool { return isSuccessful },
+	)
+
+	logger := k.Logger().With("method", "SetSupplierDelegationCommission")
+	logger.Info(fmt.Sprintf("About to set supplier delegation commission with msg: %v", msg))
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	supplier, isSupplierFound := k.GetDehydratedSupplier(ctx, msg.GetOperatorAddress())
+	if !isSupplierFound {
+		logger.Info(fmt.Sprintf("Supplier not found. Cannot set the delegation commission of address %s", msg.GetOperatorAddress()))
+		return nil, status.Error(
+			codes.NotFound,
+			suppliertypes.ErrSupplierNotFound.Wrapf(
+				"supplier with operator address %q", msg.GetOperatorAddress(),
+			).Error(),
+		)
+	}
+
+	// Only the supplier owner, which receives the supplier rewards, is allowed
+	// to update the delegation commission.
+	if !supplier.HasOwner(msg.GetOwnerAddress()) {
+		logger.Info("only the supplier owner is allowed to set the supplier delegation commission")
+		return nil, status.Error(
+			codes.PermissionDenied,
+			sharedtypes.ErrSharedUnauthorizedSupplierUpdate.Wrapf(
+				"signer %q is not the owner %q of supplier %q",
+				msg.GetOwnerAddress(), supplier.GetOwnerAddress(), supplier.GetOperatorAddress(),
+			).Error(),
+		)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	currentHeight := sdkCtx.BlockHeight()
+	sharedParams := k.sharedKeeper.GetParams(ctx)
+
+	// The new commission only applies from the next session, so that the claims of
+	// the ongoing and pending sessions settle with the commission their delegators
+	// delegated with.
+	effectiveHeight := sharedtypes.GetNextSessionStartHeight(&sharedParams, currentHeight)
+
+	// Retain the updates applying to the sessions which may still be claimed or
+	// settled, with an additional session of margin.
+	numBlocksPerSession := int64(sharedParams.GetNumBlocksPerSession())
+	numRetainedSessions := sharedtypes.GetNumPendingSessions(&sharedParams) + 1
+	minRetainedHeight := sharedtypes.GetSessionStartHeight(&sharedParams, currentHeight) -
+		numRetainedSessions*numBlocksPerSession
+	if minRetainedHeight < 0 {
+		minRetainedHeight = 0
+	}
+
+	prevDelegationCommissionPercentage := supplier.DelegationCommissionPercentage
+	supplier.SetDelegationCommissionPercentage(
+		msg.GetDelegationCommissionPercentage(),
+		effectiveHeight,
+		minRetainedHeight,
+	)
+
+	// Only the dehydrated supplier is updated since the service configs are unchanged.
+	k.SetDehydratedSupplier(ctx, supplier)
+
+	event := &suppliertypes.EventSupplierDelegationCommissionUpdated{
+		OperatorAddress:                    supplier.GetOperatorAddress(),
+		PrevDelegationCommissionPercentage: prevDelegationCommissionPercentage,
+		NewDelegationCommissionPercentage:  supplier.GetDelegationCommissionPercentage(),
+		EffectiveBlockHeight:               effectiveHeight,
+	}
+	if err := sdkCtx.EventManager().EmitTypedEvent(event); err != nil {
+		err = suppliertypes.ErrSupplierEmitEvent.Wrapf("(%+v): %s", event, err)
+		logger.Error(err.Error())
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	isSuccessful = true
+	return &suppliertypes.MsgSetSupplierDelegationCommissionResponse{
+		Supplier: &supplier,
+	}, nil
+}
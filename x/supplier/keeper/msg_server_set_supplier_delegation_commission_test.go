@@ -0,0 +1,131 @@
+package keeper_test
+
+import (
+	"testing"
+
+	cosmostypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	testevents "github.com/pokt-network/poktroll/testutil/events"
+	keepertest "github.com/pokt-network/poktroll/testutil/keeper"
+	"github.com/pokt-network/poktroll/testutil/sample"
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
+	"github.com/pokt-network/poktroll/x/supplier/keeper"
+	suppliertypes "github.com/pokt-network/poktroll/x/supplier/types"
+)
+
+func TestMsgServer_SetSupplierDelegationCommission(t *testing.T) {
+	tests := []struct {
+		desc                           string
+		useSupplierOwner               bool
+		useUnknownSupplier             bool
+		delegationCommissionPercentage uint64
+		expectedErr                    error
+		expectedCode                   codes.Code
+	}{
+		{
+			desc:                           "valid - supplier owner sets the commission",
+			useSupplierOwner:               true,
+			delegationCommissionPercentage: 10,
+		},
+		{
+			desc:                           "valid - supplier owner sets a 100% commission",
+			useSupplierOwner:               true,
+			delegationCommissionPercentage: 100,
+		},
+		{
+			desc:                           "invalid - signer is not the supplier owner",
+			useSupplierOwner:               false,
+			delegationCommissionPercentage: 10,
+			expectedErr:                    sharedtypes.ErrSharedUnauthorizedSupplierUpdate,
+			expectedCode:                   codes.PermissionDenied,
+		},
+		{
+			desc:                           "invalid - supplier not found",
+			useSupplierOwner:               true,
+			useUnknownSupplier:             true,
+			delegationCommissionPercentage: 10,
+			expectedErr:                    suppliertypes.ErrSupplierNotFound,
+			expectedCode:                   codes.NotFound,
+		},
+		{
+			desc:                           "invalid - commission above 100%",
+			useSupplierOwner:               true,
+			delegationCommissionPercentage: 101,
+			expectedErr:                    suppliertypes.ErrSupplierInvalidDelegationCommission,
+			expectedCode:                   codes.InvalidArgument,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			supplierModuleKeepers, ctx := keepertest.SupplierKeeper(t)
+			srv := keeper.NewMsgServerImpl(*supplierModuleKeepers.Keeper)
+
+			supplierOperatorAddr := stakeTestSupplier(t, ctx, srv)
+			initialSupplier, isSupplierFound := supplierModuleKeepers.GetDehydratedSupplier(ctx, supplierOperatorAddr)
+			require.True(t, isSupplierFound)
+
+			signerAddr := sample.AccAddress()
+			if test.useSupplierOwner {
+				signerAddr = initialSupplier.GetOwnerAddress()
+			}
+			operatorAddr := supplierOperatorAddr
+			if test.useUnknownSupplier {
+				operatorAddr = sample.AccAddress()
+			}
+
+			msg := suppliertypes.NewMsgSetSupplierDelegationCommission(
+				signerAddr,
+				operatorAddr,
+				test.delegationCommissionPercentage,
+			)
+			res, err := srv.SetSupplierDelegationCommission(ctx, msg)
+			if test.expectedErr != nil {
+				require.ErrorContains(t, err, test.expectedErr.Error())
+				require.Equal(t, test.expectedCode, status.Code(err))
+
+				// Ensure the supplier was not updated.
+				foundSupplier, isSupplierFound := supplierModuleKeepers.GetDehydratedSupplier(ctx, supplierOperatorAddr)
+				require.True(t, isSupplierFound)
+				require.Equal(t, initialSupplier, foundSupplier)
+				return
+			}
+			require.NoError(t, err)
+
+			// The new commission only applies from the next session.
+			sharedParams := supplierModuleKeepers.SharedKeeper.GetParams(ctx)
+			currentHeight := cosmostypes.UnwrapSDKContext(ctx).BlockHeight()
+			expectedEffectiveHeight := sharedtypes.GetNextSessionStartHeight(&sharedParams, currentHeight)
+
+			expectedSupplier := initialSupplier
+			expectedSupplier.DelegationCommissionPercentage = test.delegationCommissionPercentage
+			expectedSupplier.DelegationCommissionHistory = []*sharedtypes.SupplierDelegationCommissionUpdate{
+				{DelegationCommissionPercentage: 0, EffectiveBlockHeight: 0},
+				{DelegationCommissionPercentage: test.delegationCommissionPercentage, EffectiveBlockHeight: expectedEffectiveHeight},
+			}
+			require.Equal(t, &expectedSupplier, res.GetSupplier())
+
+			foundSupplier, isSupplierFound := supplierModuleKeepers.GetDehydratedSupplier(ctx, supplierOperatorAddr)
+			require.True(t, isSupplierFound)
+			require.Equal(t, expectedSupplier, foundSupplier)
+
+			events := cosmostypes.UnwrapSDKContext(ctx).EventManager().Events()
+			updatedEvents := testevents.FilterEvents[*suppliertypes.EventSupplierDelegationCommissionUpdated](t, events)
+			require.Len(t, updatedEvents, 1)
+			require.Equal(t, &suppliertypes.EventSupplierDelegationCommissionUpdated{
+				OperatorAddress:                    supplierOperatorAddr,
+				PrevDelegationCommissionPercentage: 0,
+				NewDelegationCommissionPercentage:  test.delegationCommissionPercentage,
+				EffectiveBlockHeight:               expectedEffectiveHeight,
+			}, updatedEvents[0])
+
+			// The claims of the ongoing session settle with the previous commission.
+			sessionStartHeight := sharedtypes.GetSessionStartHeight(&sharedParams, currentHeight)
+			require.Equal(t, uint64(0), foundSupplier.GetDelegationCommissionPercentageAtHeight(sessionStartHeight))
+			require.Equal(t, test.delegationCommissionPercentage, foundSupplier.GetDelegationCommissionPercentageAtHeight(expectedEffectiveHeight))
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/pokt-network/poktroll/telemetry"
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
+	suppliertypes "github.com/pokt-network/poktroll/x/supplier/types"
+)
+
+// UndelegateFromSupplier handles the MsgUndelegateFromSupplier message to begin
+// the unbonding process of a delegation. This initiates a process where:
+// - The delegation is marked as unstaking and stops earning rewards after the current session
+// - The delegation can still be slashed along with the supplier until it finishes unbonding
+// - After the supplier unbonding period, the delegated tokens are returned to the delegator's account
+func (k msgServer) UndelegateFromSupplier(
+	ctx context.Context,
+	msg *suppliertypes.MsgUndelegateFromSupplier,
+) (*suppliertypes.MsgUndelegateFromSupplierResponse, error) {
+	isSuccessful := false
+	defer telemetry.EventSuccessCounter(
+		"undelegate_from_supplier",
+		telemetry.DefaultCounterFn,
+		func() bool { return isSuccessful },
+	)
+
+	logger := k.Logger().With("method", "UndelegateFromSupplier")
+	logger.Info(fmt.Sprintf("About to undelegate from supplier with msg: %v", msg))
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	delegation, isDelegationFound := k.GetDelegation(ctx, msg.GetSupplierOperatorAddress(), msg.GetDelegatorAddress())
+	if !isDelegationFound {
+		logger.Info(fmt.Sprintf(
+			"Delegation not found. Cannot undelegate %s from supplier %s",
+			msg.GetDelegatorAddress(), msg.GetSupplierOperatorAddress(),
+		))
+		return nil, status.Error(
+			codes.NotFound,
+			suppliertypes.ErrSupplierDelegationNotFound.Wrapf(
+				"delegation of %q to supplier with operator address %q",
+				msg.GetDelegatorAddress(), msg.GetSupplierOperatorAddress(),
+			).Error(),
+		)
+	}
+
+	// A delegation cannot be undelegated twice while still in the unbonding period.
+	if delegation.IsUnbonding() {
+		logger.Info(fmt.Sprintf(
+			"Delegation of %s to supplier %s still unbonding from previous undelegation",
+			msg.GetDelegatorAddress(), msg.GetSupplierOperatorAddress(),
+		))
+		return nil, status.Error(
+			codes.FailedPrecondition,
+			suppliertypes.ErrSupplierDelegationIsUnstaking.Wrapf(
+				"delegation of %q to supplier with operator address %q",
+				msg.GetDelegatorAddress(), msg.GetSupplierOperatorAddress(),
+			).Error(),
+		)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	currentHeight := sdkCtx.BlockHeight()
+	sharedParams := k.sharedKeeper.GetParams(ctx)
+
+	// Mark the delegation as unstaking by recording the end height of the current session.
+	delegation.UnstakeSessionEndHeight = uint64(sharedtypes.GetSessionEndHeight(&sharedParams, currentHeight))
+
+	// Update the delegation record in state
+	k.SetDelegation(ctx, delegation)
+
+	// Emit an event signaling that the delegation has begun the unbonding process
+	unbondingEndHeight := suppliertypes.GetDelegationUnbondingEndHeight(&sharedParams, &delegation)
+	event := &suppliertypes.EventSupplierDelegationUnbondingBegin{
+		Delegation:         &delegation,
+		SessionEndHeight:   int64(delegation.GetUnstakeSessionEndHeight()),
+		UnbondingEndHeight: unbondingEndHeight,
+	}
+	if err := sdkCtx.EventManager().EmitTypedEvent(event); err != nil {
+		err = suppliertypes.ErrSupplierEmitEvent.Wrapf("(%+v): %s", event, err)
+		logger.Error(err.Error())
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	isSuccessful = true
+	return &suppliertypes.MsgUndelegateFromSupplierResponse{
+		Delegation: &delegation,
+	}, nil
+}
@@ -0,0 +1,231 @@
+package keeper_test
+
+import (
+	"testing"
+
+	cosmostypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/pokt-network/poktroll/app/volatile"
+	testevents "github.com/pokt-network/poktroll/testutil/events"
+	keepertest "github.com/pokt-network/poktroll/testutil/keeper"
+	"github.com/pokt-network/poktroll/testutil/sample"
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
+	"github.com/pokt-network/poktroll/x/supplier/keeper"
+	suppliertypes "github.com/pokt-network/poktroll/x/supplier/types"
+)
+
+func TestMsgServer_UndelegateFromSupplier_Success(t *testing.T) {
+	supplierModuleKeepers, ctx := keepertest.SupplierKeeper(t)
+	srv := keeper.NewMsgServerImpl(*supplierModuleKeepers.Keeper)
+	sharedParams := supplierModuleKeepers.SharedKeeper.GetParams(ctx)
+
+	supplierOperatorAddr := stakeTestSupplier(t, ctx, srv)
+	delegatorAddr := sample.AccAddress()
+	delegationCoin := cosmostypes.NewInt64Coin(volatile.DenomuPOKT, 100)
+
+	_, err := srv.DelegateToSupplier(ctx, suppliertypes.NewMsgDelegateToSupplier(delegatorAddr, supplierOperatorAddr, delegationCoin))
+	require.NoError(t, err)
+	require.Equal(t, int64(-100), supplierModuleKeepers.SupplierBalanceMap[delegatorAddr])
+
+	// Reset the events, as if a new block were created.
+	ctx, _ = testevents.ResetEventManager(ctx)
+
+	// Initiate the undelegation.
+	res, err := srv.UndelegateFromSupplier(ctx, suppliertypes.NewMsgUndelegateFromSupplier(delegatorAddr, supplierOperatorAddr))
+	require.NoError(t, err)
+
+	currentHeight := cosmostypes.UnwrapSDKContext(ctx).BlockHeight()
+	expectedDelegation := suppliertypes.Delegation{
+		DelegatorAddress:        delegatorAddr,
+		SupplierOperatorAddress: supplierOperatorAddr,
+		Stake:                   &delegationCoin,
+		UnstakeSessionEndHeight: uint64(sharedtypes.GetSessionEndHeight(&sharedParams, currentHeight)),
+		ActivationHeight:        sharedtypes.GetNextSessionStartHeight(&sharedParams, currentHeight),
+	}
+	require.Equal(t, &expectedDelegation, res.GetDelegation())
+
+	// Assert that the EventSupplierDelegationUnbondingBegin event is emitted.
+	unbondingEndHeight := suppliertypes.GetDelegationUnbondingEndHeight(&sharedParams, &expectedDelegation)
+	events := cosmostypes.UnwrapSDKContext(ctx).EventManager().Events()
+	unbondingBeginEvents := testevents.FilterEvents[*suppliertypes.EventSupplierDelegationUnbondingBegin](t, events)
+	require.Len(t, unbondingBeginEvents, 1)
+	require.Equal(t, &suppliertypes.EventSupplierDelegationUnbondingBegin{
+		Delegation:         &expectedDelegation,
+		SessionEndHeight:   int64(expectedDelegation.GetUnstakeSessionEndHeight()),
+		UnbondingEndHeight: unbondingEndHeight,
+	}, unbondingBeginEvents[0])
+
+	// Assert that the delegation is not unbonded before the end of the unbonding period.
+	ctx = keepertest.SetBlockHeight(ctx, unbondingEndHeight-1)
+	numUnbondedDelegations, err := supplierModuleKeepers.EndBlockerUnbondDelegations(ctx)
+	require.NoError(t, err)
+	require.Zero(t, numUnbondedDelegations)
+
+	// Reset the events, as if a new block were created.
+	ctx, _ = testevents.ResetEventManager(ctx)
+
+	// Move block height to the end of the unbonding period and unbond the delegation.
+	ctx = keepertest.SetBlockHeight(ctx, unbondingEndHeight)
+	numUnbondedDelegations, err = supplierModuleKeepers.EndBlockerUnbondDelegations(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), numUnbondedDelegations)
+
+	// Assert that the EventSupplierDelegationUnbondingEnd event is emitted.
+	events = cosmostypes.UnwrapSDKContext(ctx).EventManager().Events()
+	unbondingEndEvents := testevents.FilterEvents[*suppliertypes.EventSupplierDelegationUnbondingEnd](t, events)
+	require.Len(t, unbondingEndEvents, 1)
+	require.Equal(t, &suppliertypes.EventSupplierDelegationUnbondingEnd{
+		Delegation:         &expectedDelegation,
+		Reason:             suppliertypes.DelegationUnbondingReason_DELEGATION_UNBONDING_REASON_VOLUNTARY,
+		SessionEndHeight:   sharedtypes.GetSessionEndHeight(&sharedParams, unbondingEndHeight),
+		UnbondingEndHeight: unbondingEndHeight,
+	}, unbondingEndEvents[0])
+
+	// Assert that the delegation is removed and its stake returned to the delegator.
+	_, isDelegationFound := supplierModuleKeepers.GetDelegation(ctx, supplierOperatorAddr, delegatorAddr)
+	require.False(t, isDelegationFound)
+	require.Zero(t, supplierModuleKeepers.SupplierBalanceMap[delegatorAddr])
+}
+
+func TestMsgServer_UndelegateFromSupplier_CancelUnbondingIfDelegatedAgain(t *testing.T) {
+	supplierModuleKeepers, ctx := keepertest.SupplierKeeper(t)
+	srv := keeper.NewMsgServerImpl(*supplierModuleKeepers.Keeper)
+	sharedParams := supplierModuleKeepers.SharedKeeper.GetParams(ctx)
+
+	supplierOperatorAddr := stakeTestSupplier(t, ctx, srv)
+	delegatorAddr := sample.AccAddress()
+	delegateMsg := suppliertypes.NewMsgDelegateToSupplier(
+		delegatorAddr,
+		supplierOperatorAddr,
+		cosmostypes.NewInt64Coin(volatile.DenomuPOKT, 100),
+	)
+
+	_, err := srv.DelegateToSupplier(ctx, delegateMsg)
+	require.NoError(t, err)
+
+	res, err := srv.UndelegateFromSupplier(ctx, suppliertypes.NewMsgUndelegateFromSupplier(delegatorAddr, supplierOperatorAddr))
+	require.NoError(t, err)
+	unbondingEndHeight := suppliertypes.GetDelegationUnbondingEndHeight(&sharedParams, res.GetDelegation())
+
+	// Reset the events, as if a new block were created.
+	ctx, _ = testevents.ResetEventManager(ctx)
+
+	// Delegate again to the supplier to cancel the unbonding.
+	_, err = srv.DelegateToSupplier(ctx, delegateMsg)
+	require.NoError(t, err)
+
+	events := cosmostypes.UnwrapSDKContext(ctx).EventManager().Events()
+	require.Len(t, testevents.FilterEvents[*suppliertypes.EventSupplierDelegationUnbondingCanceled](t, events), 1)
+	require.Len(t, testevents.FilterEvents[*suppliertypes.EventSupplierDelegated](t, events), 1)
+
+	foundDelegation, isDelegationFound := supplierModuleKeepers.GetDelegation(ctx, supplierOperatorAddr, delegatorAddr)
+	require.True(t, isDelegationFound)
+	require.False(t, foundDelegation.IsUnbonding())
+	require.Equal(t, int64(200), foundDelegation.GetStake().Amount.Int64())
+
+	// Assert that the delegation is not unbonded at the end of the canceled unbonding period.
+	ctx = keepertest.SetBlockHeight(ctx, unbondingEndHeight)
+	numUnbondedDelegations, err := supplierModuleKeepers.EndBlockerUnbondDelegations(ctx)
+	require.NoError(t, err)
+	require.Zero(t, numUnbondedDelegations)
+
+	_, isDelegationFound = supplierModuleKeepers.GetDelegation(ctx, supplierOperatorAddr, delegatorAddr)
+	require.True(t, isDelegationFound)
+}
+
+func TestMsgServer_UndelegateFromSupplier_UnbondedWithSupplier(t *testing.T) {
+	supplierModuleKeepers, ctx := keepertest.SupplierKeeper(t)
+	srv := keeper.NewMsgServerImpl(*supplierModuleKeepers.Keeper)
+	sharedParams := supplierModuleKeepers.SharedKeeper.GetParams(ctx)
+
+	supplierOperatorAddr := stakeTestSupplier(t, ctx, srv)
+	delegatorAddr := sample.AccAddress()
+
+	_, err := srv.DelegateToSupplier(ctx, suppliertypes.NewMsgDelegateToSupplier(
+		delegatorAddr,
+		supplierOperatorAddr,
+		cosmostypes.NewInt64Coin(volatile.DenomuPOKT, 100),
+	))
+	require.NoError(t, err)
+
+	// Unstake the supplier while the delegation is still active.
+	unstakeRes, err := srv.UnstakeSupplier(ctx, suppliertypes.NewMsgUnstakeSupplier(supplierOperatorAddr, supplierOperatorAddr))
+	require.NoError(t, err)
+	unbondingEndHeight := sharedtypes.GetSupplierUnbondingEndHeight(&sharedParams, unstakeRes.GetSupplier())
+
+	// Reset the events, as if a new block were created.
+	ctx, _ = testevents.ResetEventManager(ctx)
+
+	// Unbond the supplier.
+	ctx = keepertest.SetBlockHeight(ctx, unbondingEndHeight)
+	numUnbondedSuppliers, err := supplierModuleKeepers.EndBlockerUnbondSuppliers(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), numUnbondedSuppliers)
+
+	// Assert that the delegations to the unbonded supplier are unbonded along with it.
+	events := cosmostypes.UnwrapSDKContext(ctx).EventManager().Events()
+	unbondingEndEvents := testevents.FilterEvents[*suppliertypes.EventSupplierDelegationUnbondingEnd](t, events)
+	require.Len(t, unbondingEndEvents, 1)
+	require.Equal(t,
+		suppliertypes.DelegationUnbondingReason_DELEGATION_UNBONDING_REASON_SUPPLIER_UNBONDED,
+		unbondingEndEvents[0].GetReason(),
+	)
+
+	_, isDelegationFound := supplierModuleKeepers.GetDelegation(ctx, supplierOperatorAddr, delegatorAddr)
+	require.False(t, isDelegationFound)
+	require.Zero(t, supplierModuleKeepers.SupplierBalanceMap[delegatorAddr])
+}
+
+func TestMsgServer_UndelegateFromSupplier_Errors(t *testing.T) {
+	tests := []struct {
+		desc                  string
+		isDelegated           bool
+		isDelegationUnbonding bool
+		expectedErr           error
+		expectedCode          codes.Code
+	}{
+		{
+			desc:         "delegation not found",
+			expectedErr:  suppliertypes.ErrSupplierDelegationNotFound,
+			expectedCode: codes.NotFound,
+		},
+		{
+			desc:                  "delegation is already unbonding",
+			isDelegated:           true,
+			isDelegationUnbonding: true,
+			expectedErr:           suppliertypes.ErrSupplierDelegationIsUnstaking,
+			expectedCode:          codes.FailedPrecondition,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			supplierModuleKeepers, ctx := keepertest.SupplierKeeper(t)
+			srv := keeper.NewMsgServerImpl(*supplierModuleKeepers.Keeper)
+
+			supplierOperatorAddr := stakeTestSupplier(t, ctx, srv)
+			delegatorAddr := sample.AccAddress()
+			undelegateMsg := suppliertypes.NewMsgUndelegateFromSupplier(delegatorAddr, supplierOperatorAddr)
+
+			if test.isDelegated {
+				_, err := srv.DelegateToSupplier(ctx, suppliertypes.NewMsgDelegateToSupplier(
+					delegatorAddr,
+					supplierOperatorAddr,
+					cosmostypes.NewInt64Coin(volatile.DenomuPOKT, 100),
+				))
+				require.NoError(t, err)
+			}
+			if test.isDelegationUnbonding {
+				_, err := srv.UndelegateFromSupplier(ctx, undelegateMsg)
+				require.NoError(t, err)
+			}
+
+			_, err := srv.UndelegateFromSupplier(ctx, undelegateMsg)
+			require.ErrorContains(t, err, test.expectedErr.Error())
+			require.Equal(t, test.expectedCode, status.Code(err))
+		})
+	}
+}
@@ -0,0 +1,113 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/pokt-network/poktroll/telemetry"
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
+	suppliertypes "github.com/pokt-network/poktroll/x/supplier/types"
+)
+
+// UnjailSupplier handles the MsgUnjailSupplier message, which makes a jailed
+// supplier eligible for sessions again once its jail period has elapsed.
+//
+// The supplier is unjailed starting from the next session so that the suppliers
+// of the current session remain unchanged.
+func (k msgServer) UnjailSupplier(
+	ctx context.Context,
+	msg *suppliertypes.MsgUnjailSupplier,
+) (*suppliertypes.MsgUnjailSupplierResponse, error) {
+	isSuccessful := false
+	defer telemetry.EventSuccessCounter(
+		"unjail_supplier",
+		telemetry.DefaultCounterFn,
+		func() bool { return isSuccessful },
+	)
+
+	logger := k.Logger().With("method", "UnjailSupplier")
+	logger.Info(fmt.Sprintf("About to unjail supplier with msg: %v", msg))
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	supplier, isSupplierFound := k.GetDehydratedSupplier(ctx, msg.GetOperatorAddress())
+	if !isSupplierFound {
+		logger.Info(fmt.Sprintf("Supplier not found. Cannot unjail address %s", msg.GetOperatorAddress()))
+		return nil, status.Error(
+			codes.NotFound,
+			suppliertypes.ErrSupplierNotFound.Wrapf(
+				"supplier with operator address %q", msg.GetOperatorAddress(),
+			).Error(),
+		)
+	}
+
+	// Ensure the signer address matches either the owner or the operator address.
+	// Both are authorized to unjail the supplier.
+	if !supplier.HasOperator(msg.GetSigner()) && !supplier.HasOwner(msg.GetSigner()) {
+		logger.Info("only the supplier owner or operator is allowed to unjail the supplier")
+		return nil, status.Error(
+			codes.PermissionDenied,
+			sharedtypes.ErrSharedUnauthorizedSupplierUpdate.Wrapf(
+				"signer %q is not allowed to unjail supplier %q",
+				msg.GetSigner(), supplier.GetOperatorAddress(),
+			).Error(),
+		)
+	}
+
+	if !supplier.HasPendingJail() {
+		logger.Info(fmt.Sprintf("Supplier %s is not jailed", msg.GetOperatorAddress()))
+		return nil, status.Error(
+			codes.FailedPrecondition,
+			suppliertypes.ErrSupplierNotJailed.Wrapf(
+				"supplier with operator address %q", msg.GetOperatorAddress(),
+			).Error(),
+		)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sharedParams := k.sharedKeeper.GetParams(ctx)
+	currentHeight := sdkCtx.BlockHeight()
+	sessionEndHeight := sharedtypes.GetSessionEndHeight(&sharedParams, currentHeight)
+
+	// The supplier can only be unjailed after the last session of its jail period.
+	jailEndHeight := supplier.GetPendingJailPeriod().GetJailEndHeight()
+	if sessionEndHeight < jailEndHeight {
+		logger.Info(fmt.Sprintf(
+			"Supplier %s is jailed until the session ending at height %d",
+			msg.GetOperatorAddress(), jailEndHeight,
+		))
+		return nil, status.Error(
+			codes.FailedPrecondition,
+			suppliertypes.ErrSupplierJailPeriodNotElapsed.Wrapf(
+				"supplier with operator address %q is jailed until height %d; current session end height: %d",
+				msg.GetOperatorAddress(), jailEndHeight, sessionEndHeight,
+			).Error(),
+		)
+	}
+
+	supplier.Unjail(sharedtypes.GetNextSessionStartHeight(&sharedParams, currentHeight))
+
+	// Only the dehydrated supplier is updated since the service configs are unchanged.
+	k.SetDehydratedSupplier(ctx, supplier)
+
+	event := &suppliertypes.EventSupplierUnjailed{
+		Supplier:         &supplier,
+		SessionEndHeight: sessionEndHeight,
+	}
+	if err := sdkCtx.EventManager().EmitTypedEvent(event); err != nil {
+		err = suppliertypes.ErrSupplierEmitEvent.Wrapf("(%+v): %s", event, err)
+		logger.Error(err.Error())
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	isSuccessful = true
+	return &suppliertypes.MsgUnjailSupplierResponse{
+		Supplier: &supplier,
+	}, nil
+}
@@ -0,0 +1,143 @@
+package keeper_test
+
+import (
+	"testing"
+
+	cosmostypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	testevents "github.com/pokt-network/poktroll/testutil/events"
+	keepertest "github.com/pokt-network/poktroll/testutil/keeper"
+	"github.com/pokt-network/poktroll/testutil/sample"
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
+	"github.com/pokt-network/poktroll/x/supplier/keeper"
+	suppliertypes "github.com/pokt-network/poktroll/x/supplier/types"
+)
+
+func TestMsgServer_UnjailSupplier(t *testing.T) {
+	tests := []struct {
+		desc                string
+		useUnknownSigner    bool
+		useUnknownSupplier  bool
+		isJailed            bool
+		isUnjailed          bool
+		isJailPeriodOngoing bool
+		expectedErr         error
+		expectedCode        codes.Code
+	}{
+		{
+			desc:     "valid - supplier unjailed after the jail period",
+			isJailed: true,
+		},
+		{
+			desc:             "invalid - signer is not the supplier owner or operator",
+			useUnknownSigner: true,
+			isJailed:         true,
+			expectedErr:      sharedtypes.ErrSharedUnauthorizedSupplierUpdate,
+			expectedCode:     codes.PermissionDenied,
+		},
+		{
+			desc:               "invalid - supplier not found",
+			useUnknownSupplier: true,
+			expectedErr:        suppliertypes.ErrSupplierNotFound,
+			expectedCode:       codes.NotFound,
+		},
+		{
+			desc:         "invalid - supplier was never jailed",
+			expectedErr:  suppliertypes.ErrSupplierNotJailed,
+			expectedCode: codes.FailedPrecondition,
+		},
+		{
+			desc:         "invalid - supplier is already unjailed",
+			isJailed:     true,
+			isUnjailed:   true,
+			expectedErr:  suppliertypes.ErrSupplierNotJailed,
+			expectedCode: codes.FailedPrecondition,
+		},
+		{
+			desc:                "invalid - jail period has not elapsed",
+			isJailed:            true,
+			isJailPeriodOngoing: true,
+			expectedErr:         suppliertypes.ErrSupplierJailPeriodNotElapsed,
+			expectedCode:        codes.FailedPrecondition,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			supplierModuleKeepers, ctx := keepertest.SupplierKeeper(t)
+			srv := keeper.NewMsgServerImpl(*supplierModuleKeepers.Keeper)
+			sharedParams := supplierModuleKeepers.SharedKeeper.GetParams(ctx)
+			numBlocksPerSession := int64(sharedParams.GetNumBlocksPerSession())
+
+			supplierOperatorAddr := stakeTestSupplier(t, ctx, srv)
+			supplier, isSupplierFound := supplierModuleKeepers.GetDehydratedSupplier(ctx, supplierOperatorAddr)
+			require.True(t, isSupplierFound)
+
+			// Jail the supplier for the session following the current one.
+			currentHeight := cosmostypes.UnwrapSDKContext(ctx).BlockHeight()
+			jailStartHeight := sharedtypes.GetNextSessionStartHeight(&sharedParams, currentHeight)
+			jailEndHeight := jailStartHeight + numBlocksPerSession - 1
+			if test.isJailed {
+				supplier.Jail(jailStartHeight, jailEndHeight, 0)
+			}
+			if test.isUnjailed {
+				supplier.Unjail(jailEndHeight + 1)
+			}
+			supplierModuleKeepers.SetDehydratedSupplier(ctx, supplier)
+
+			// Move to the last session of the jail period, or to the one preceding it.
+			unjailHeight := jailEndHeight
+			if test.isJailPeriodOngoing {
+				unjailHeight = jailStartHeight - 1
+			}
+			ctx = keepertest.SetBlockHeight(ctx, unjailHeight)
+
+			signerAddr := supplierOperatorAddr
+			if test.useUnknownSigner {
+				signerAddr = sample.AccAddress()
+			}
+			operatorAddr := supplierOperatorAddr
+			if test.useUnknownSupplier {
+				operatorAddr = sample.AccAddress()
+			}
+
+			res, err := srv.UnjailSupplier(ctx, suppliertypes.NewMsgUnjailSupplier(signerAddr, operatorAddr))
+			if test.expectedErr != nil {
+				require.ErrorContains(t, err, test.expectedErr.Error())
+				require.Equal(t, test.expectedCode, status.Code(err))
+
+				// Ensure the supplier was not updated.
+				foundSupplier, isSupplierFound := supplierModuleKeepers.GetDehydratedSupplier(ctx, supplierOperatorAddr)
+				require.True(t, isSupplierFound)
+				require.Equal(t, supplier, foundSupplier)
+				return
+			}
+			require.NoError(t, err)
+
+			// The supplier is unjailed starting from the next session.
+			expectedSupplier := supplier
+			expectedSupplier.JailHistory = []*sharedtypes.SupplierJailPeriod{{
+				JailStartHeight: jailStartHeight,
+				JailEndHeight:   jailEndHeight,
+				UnjailHeight:    jailEndHeight + 1,
+			}}
+			require.Equal(t, &expectedSupplier, res.GetSupplier())
+			require.True(t, expectedSupplier.IsJailed(jailEndHeight))
+			require.False(t, expectedSupplier.IsJailed(jailEndHeight+1))
+
+			foundSupplier, isSupplierFound := supplierModuleKeepers.GetDehydratedSupplier(ctx, supplierOperatorAddr)
+			require.True(t, isSupplierFound)
+			require.Equal(t, expectedSupplier, foundSupplier)
+
+			events := cosmostypes.UnwrapSDKContext(ctx).EventManager().Events()
+			unjailedEvents := testevents.FilterEvents[*suppliertypes.EventSupplierUnjailed](t, events)
+			require.Len(t, unjailedEvents, 1)
+			require.Equal(t, supplierOperatorAddr, unjailedEvents[0].GetSupplier().GetOperatorAddress())
+			require.Equal(t, expectedSupplier.GetJailHistory(), unjailedEvents[0].GetSupplier().GetJailHistory())
+			require.Equal(t, jailEndHeight, unjailedEvents[0].GetSessionEndHeight())
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
 	suppliertypes "github.com/pokt-network/poktroll/x/supplier/types"
 )
 
@@ -41,6 +42,18 @@ func (k msgServer) UpdateParam(ctx context.Context, msg *suppliertypes.MsgUpdate
 	case suppliertypes.ParamStakingFee:
 		logger = logger.With("staking_fee", msg.GetAsCoin())
 		params.StakingFee = msg.GetAsCoin()
+	case suppliertypes.ParamProofFaultWindowSessions:
+		logger = logger.With("proof_fault_window_sessions", msg.GetAsUint64())
+		params.ProofFaultWindowSessions = msg.GetAsUint64()
+	case suppliertypes.ParamProofFaultPenaltyEscalationPercentage:
+		logger = logger.With("proof_fault_penalty_escalation_percentage", msg.GetAsUint64())
+		params.ProofFaultPenaltyEscalationPercentage = msg.GetAsUint64()
+	case suppliertypes.ParamProofFaultJailingThreshold:
+		logger = logger.With("proof_fault_jailing_threshold", msg.GetAsUint64())
+		params.ProofFaultJailingThreshold = msg.GetAsUint64()
+	case suppliertypes.ParamJailDurationSessions:
+		logger = logger.With("jail_duration_sessions", msg.GetAsUint64())
+		params.JailDurationSessions = msg.GetAsUint64()
 	default:
 		return nil, status.Error(
 			codes.InvalidArgument,
@@ -60,6 +73,11 @@ func (k msgServer) UpdateParam(ctx context.Context, msg *suppliertypes.MsgUpdate
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	if err := sharedtypes.EmitParamsUpdatedEvent(ctx, suppliertypes.ModuleName); err != nil {
+		logger.Error(err.Error())
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
 	updatedParams := k.GetParams(ctx)
 
 	return &suppliertypes.MsgUpdateParamResponse{
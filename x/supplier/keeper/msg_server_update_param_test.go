@@ -39,3 +39,29 @@ func TestMsgUpdateParam_UpdateMinStakeOnly(t *testing.T) {
 	// Ensure the other parameters are unchanged
 	testkeeper.AssertDefaultParamsEqualExceptFields(t, &defaultParams, res.Params, string(suppliertypes.KeyMinStake))
 }
+
+func TestMsgUpdateParam_UpdateProofFaultJailingThresholdOnly(t *testing.T) {
+	expectedProofFaultJailingThreshold := uint64(7)
+
+	// Set the parameters to their default values
+	k, msgSrv, ctx := setupMsgServer(t)
+	defaultParams := suppliertypes.DefaultParams()
+	require.NoError(t, k.SetParams(ctx, defaultParams))
+
+	// Ensure the default values are different from the new values we want to set
+	require.NotEqual(t, expectedProofFaultJailingThreshold, defaultParams.ProofFaultJailingThreshold)
+
+	// Update the proof fault jailing threshold
+	updateParamMsg := &suppliertypes.MsgUpdateParam{
+		Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		Name:      suppliertypes.ParamProofFaultJailingThreshold,
+		AsType:    &suppliertypes.MsgUpdateParam_AsUint64{AsUint64: expectedProofFaultJailingThreshold},
+	}
+	res, err := msgSrv.UpdateParam(ctx, updateParamMsg)
+	require.NoError(t, err)
+
+	require.Equal(t, expectedProofFaultJailingThreshold, res.Params.ProofFaultJailingThreshold)
+
+	// Ensure the other parameters are unchanged
+	testkeeper.AssertDefaultParamsEqualExceptFields(t, &defaultParams, res.Params, string(suppliertypes.KeyProofFaultJailingThreshold))
+}
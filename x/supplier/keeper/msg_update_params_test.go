@@ -46,8 +46,10 @@ func TestMsgUpdateParams(t *testing.T) {
 			params: &suppliertypes.MsgUpdateParams{
 				Authority: k.GetAuthority(),
 				Params: suppliertypes.Params{
-					MinStake:   &suppliertypes.DefaultMinStake,
-					StakingFee: &zerouPokt,
+					MinStake:                 &suppliertypes.DefaultMinStake,
+					StakingFee:               &zerouPokt,
+					ProofFaultWindowSessions: 1,
+					JailDurationSessions:     1,
 				},
 			},
 			shouldError: false,
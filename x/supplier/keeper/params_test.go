@@ -119,3 +119,73 @@ func TestParams_ValidateStakingFee(t *testing.T) {
 		})
 	}
 }
+
+func TestParams_ValidateProofFaultWindowSessions(t *testing.T) {
+	tests := []struct {
+		desc                     string
+		proofFaultWindowSessions any
+		expectedErr              error
+	}{
+		{
+			desc:                     "invalid type",
+			proofFaultWindowSessions: int64(10),
+			expectedErr:              suppliertypes.ErrSupplierParamInvalid.Wrapf("invalid parameter type: int64"),
+		},
+		{
+			desc:                     "zero ProofFaultWindowSessions",
+			proofFaultWindowSessions: uint64(0),
+			expectedErr:              suppliertypes.ErrSupplierParamInvalid.Wrapf("proof_fault_window_sessions must be at least 1: got 0"),
+		},
+		{
+			desc:                     "valid ProofFaultWindowSessions",
+			proofFaultWindowSessions: suppliertypes.DefaultProofFaultWindowSessions,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			err := suppliertypes.ValidateProofFaultWindowSessions(test.proofFaultWindowSessions)
+			if test.expectedErr != nil {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), test.expectedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestParams_ValidateJailDurationSessions(t *testing.T) {
+	tests := []struct {
+		desc                 string
+		jailDurationSessions any
+		expectedErr          error
+	}{
+		{
+			desc:                 "invalid type",
+			jailDurationSessions: "4",
+			expectedErr:          suppliertypes.ErrSupplierParamInvalid.Wrapf("invalid parameter type: string"),
+		},
+		{
+			desc:                 "zero JailDurationSessions",
+			jailDurationSessions: uint64(0),
+			expectedErr:          suppliertypes.ErrSupplierParamInvalid.Wrapf("jail_duration_sessions must be at least 1: got 0"),
+		},
+		{
+			desc:                 "valid JailDurationSessions",
+			jailDurationSessions: suppliertypes.DefaultJailDurationSessions,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			err := suppliertypes.ValidateJailDurationSessions(test.jailDurationSessions)
+			if test.expectedErr != nil {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), test.expectedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
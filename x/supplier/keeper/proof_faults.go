@@ -0,0 +1,134 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/pokt-network/poktroll/x/supplier/types"
+)
+
+// RecordSupplierProofFault adds the given proof fault to the proof faults of the
+// supplier with the given operator address.
+//
+// Proof faults are counted per session: the expiring claims of a supplier for the
+// different services or applications of the same session are a single proof fault,
+// so only the first one of them is recorded.
+//
+// Proof faults which are no longer within the proof fault window ending at the
+// session of the recorded proof fault are pruned, so the returned SupplierProofFaults
+// only contains the proof faults counting towards the supplier's penalty escalation
+// and jailing.
+func (k Keeper) RecordSupplierProofFault(
+	ctx context.Context,
+	supplierOperatorAddr string,
+	proofFault types.ProofFault,
+) (types.SupplierProofFaults, error) {
+	logger := k.Logger().With("method", "RecordSupplierProofFault")
+
+	sharedParams := k.sharedKeeper.GetParams(ctx)
+	supplierParams := k.GetParams(ctx)
+	windowStartHeight := types.GetProofFaultWindowStartHeight(
+		&sharedParams,
+		&supplierParams,
+		proofFault.GetSessionEndHeight(),
+	)
+
+	supplierProofFaults, _ := k.GetSupplierProofFaults(ctx, supplierOperatorAddr)
+	supplierProofFaults.OperatorAddress = supplierOperatorAddr
+
+	// Only keep the proof faults which are still within the proof fault window.
+	isSessionProofFaultRecorded := false
+	proofFaultsInWindow := make([]types.ProofFault, 0, len(supplierProofFaults.ProofFaults)+1)
+	for _, prevProofFault := range supplierProofFaults.ProofFaults {
+		if prevProofFault.GetSessionEndHeight() >= windowStartHeight {
+			proofFaultsInWindow = append(proofFaultsInWindow, prevProofFault)
+		}
+		if prevProofFault.GetSessionEndHeight() == proofFault.GetSessionEndHeight() {
+			isSessionProofFaultRecorded = true
+		}
+	}
+
+	if isSessionProofFaultRecorded {
+		logger.Info(fmt.Sprintf(
+			"proof fault for the session ending at height %d of supplier %q is already recorded, skipping session %q",
+			proofFault.GetSessionEndHeight(), supplierOperatorAddr, proofFault.GetSessionId(),
+		))
+		supplierProofFaults.ProofFaults = proofFaultsInWindow
+		return supplierProofFaults, nil
+	}
+	supplierProofFaults.ProofFaults = append(proofFaultsInWindow, proofFault)
+
+	k.SetSupplierProofFaults(ctx, supplierProofFaults)
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	event := &types.EventSupplierProofFaultRecorded{
+		OperatorAddress: supplierOperatorAddr,
+		ProofFault:      &proofFault,
+		NumProofFaults:  uint64(len(supplierProofFaults.ProofFaults)),
+	}
+	if err := sdkCtx.EventManager().EmitTypedEvent(event); err != nil {
+		err = types.ErrSupplierEmitEvent.Wrapf("(%+v): %s", event, err)
+		logger.Error(err.Error())
+		return types.SupplierProofFaults{}, err
+	}
+
+	logger.Info(fmt.Sprintf(
+		"recorded proof fault for session %q of supplier %q (%d proof faults in window)",
+		proofFault.GetSessionId(), supplierOperatorAddr, len(supplierProofFaults.ProofFaults),
+	))
+
+	return supplierProofFaults, nil
+}
+
+// SetSupplierProofFaults stores the proof faults of a supplier.
+func (k Keeper) SetSupplierProofFaults(ctx context.Context, supplierProofFaults types.SupplierProofFaults) {
+	supplierProofFaultsBz := k.cdc.MustMarshal(&supplierProofFaults)
+	k.getSupplierProofFaultsStore(ctx).Set(
+		types.SupplierOperatorKey(supplierProofFaults.OperatorAddress),
+		supplierProofFaultsBz,
+	)
+}
+
+// GetSupplierProofFaults retrieves the proof faults of the supplier with the given operator address.
+func (k Keeper) GetSupplierProofFaults(
+	ctx context.Context,
+	supplierOperatorAddr string,
+) (supplierProofFaults types.SupplierProofFaults, found bool) {
+	supplierProofFaultsBz := k.getSupplierProofFaultsStore(ctx).Get(types.SupplierOperatorKey(supplierOperatorAddr))
+	if supplierProofFaultsBz == nil {
+		return supplierProofFaults, false
+	}
+
+	k.cdc.MustUnmarshal(supplierProofFaultsBz, &supplierProofFaults)
+	return supplierProofFaults, true
+}
+
+// RemoveSupplierProofFaults deletes the proof faults of the supplier with the given operator address.
+func (k Keeper) RemoveSupplierProofFaults(ctx context.Context, supplierOperatorAddr string) {
+	k.getSupplierProofFaultsStore(ctx).Delete(types.SupplierOperatorKey(supplierOperatorAddr))
+}
+
+// GetAllSupplierProofFaults returns the proof faults of all the suppliers stored in the blockchain state.
+func (k Keeper) GetAllSupplierProofFaults(ctx context.Context) (allSupplierProofFaults []types.SupplierProofFaults) {
+	iterator := storetypes.KVStorePrefixIterator(k.getSupplierProofFaultsStore(ctx), []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var supplierProofFaults types.SupplierProofFaults
+		k.cdc.MustUnmarshal(iterator.Value(), &supplierProofFaults)
+		allSupplierProofFaults = append(allSupplierProofFaults, supplierProofFaults)
+	}
+
+	return allSupplierProofFaults
+}
+
+// getSupplierProofFaultsStore returns a KVStore for the supplier proof faults data
+func (k Keeper) getSupplierProofFaultsStore(ctx context.Context) storetypes.KVStore {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(storeAdapter, types.KeyPrefix(types.SupplierProofFaultsKeyPrefix))
+}
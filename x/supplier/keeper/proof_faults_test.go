@@ -0,0 +1,118 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	cosmostypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	testevents "github.com/pokt-network/poktroll/testutil/events"
+	keepertest "github.com/pokt-network/poktroll/testutil/keeper"
+	"github.com/pokt-network/poktroll/testutil/sample"
+	suppliertypes "github.com/pokt-network/poktroll/x/supplier/types"
+)
+
+func TestRecordSupplierProofFault_PrunesProofFaultsOutsideWindow(t *testing.T) {
+	supplierModuleKeepers, ctx := keepertest.SupplierKeeper(t)
+	sharedParams := supplierModuleKeepers.SharedKeeper.GetParams(ctx)
+	supplierParams := supplierModuleKeepers.Keeper.GetParams(ctx)
+	numBlocksPerSession := int64(sharedParams.GetNumBlocksPerSession())
+	proofFaultWindowSessions := int64(supplierParams.GetProofFaultWindowSessions())
+
+	supplierOperatorAddr := sample.AccAddress()
+	newProofFault := func(sessionNumber int64) suppliertypes.ProofFault {
+		return suppliertypes.ProofFault{
+			SessionId:        fmt.Sprintf("session_%d", sessionNumber),
+			SessionEndHeight: sessionNumber * numBlocksPerSession,
+		}
+	}
+
+	// Record a proof fault for the first and second sessions.
+	firstProofFault := newProofFault(1)
+	_, err := supplierModuleKeepers.RecordSupplierProofFault(ctx, supplierOperatorAddr, firstProofFault)
+	require.NoError(t, err)
+
+	secondProofFault := newProofFault(2)
+	supplierProofFaults, err := supplierModuleKeepers.RecordSupplierProofFault(ctx, supplierOperatorAddr, secondProofFault)
+	require.NoError(t, err)
+	require.Equal(t, []suppliertypes.ProofFault{firstProofFault, secondProofFault}, supplierProofFaults.GetProofFaults())
+
+	// Record a proof fault for the last session of the window starting at the
+	// first session: all the proof faults are within the window.
+	lastInWindowProofFault := newProofFault(proofFaultWindowSessions)
+	supplierProofFaults, err = supplierModuleKeepers.RecordSupplierProofFault(ctx, supplierOperatorAddr, lastInWindowProofFault)
+	require.NoError(t, err)
+	require.Len(t, supplierProofFaults.GetProofFaults(), 3)
+
+	// Record a proof fault for the following session: the first proof fault
+	// is no longer within the window and is pruned.
+	outOfWindowProofFault := newProofFault(proofFaultWindowSessions + 1)
+	supplierProofFaults, err = supplierModuleKeepers.RecordSupplierProofFault(ctx, supplierOperatorAddr, outOfWindowProofFault)
+	require.NoError(t, err)
+	require.Equal(t,
+		[]suppliertypes.ProofFault{secondProofFault, lastInWindowProofFault, outOfWindowProofFault},
+		supplierProofFaults.GetProofFaults(),
+	)
+
+	// Ensure the stored proof faults match the returned ones.
+	foundSupplierProofFaults, isFound := supplierModuleKeepers.GetSupplierProofFaults(ctx, supplierOperatorAddr)
+	require.True(t, isFound)
+	require.Equal(t, supplierProofFaults, foundSupplierProofFaults)
+
+	// Ensure an event was emitted for each recorded proof fault.
+	events := cosmostypes.UnwrapSDKContext(ctx).EventManager().Events()
+	recordedEvents := testevents.FilterEvents[*suppliertypes.EventSupplierProofFaultRecorded](t, events)
+	require.Len(t, recordedEvents, 4)
+	require.Equal(t, &suppliertypes.EventSupplierProofFaultRecorded{
+		OperatorAddress: supplierOperatorAddr,
+		ProofFault:      &outOfWindowProofFault,
+		NumProofFaults:  3,
+	}, recordedEvents[3])
+
+	// Ensure the proof faults can be removed.
+	supplierModuleKeepers.RemoveSupplierProofFaults(ctx, supplierOperatorAddr)
+	_, isFound = supplierModuleKeepers.GetSupplierProofFaults(ctx, supplierOperatorAddr)
+	require.False(t, isFound)
+	require.Empty(t, supplierModuleKeepers.GetAllSupplierProofFaults(ctx))
+}
+
+func TestRecordSupplierProofFault_CountsSessionProofFaultsOnce(t *testing.T) {
+	supplierModuleKeepers, ctx := keepertest.SupplierKeeper(t)
+	sharedParams := supplierModuleKeepers.SharedKeeper.GetParams(ctx)
+	numBlocksPerSession := int64(sharedParams.GetNumBlocksPerSession())
+
+	supplierOperatorAddr := sample.AccAddress()
+
+	// Record the proof faults of several claims of the same session, e.g. for
+	// different services: only the first one is recorded.
+	firstProofFault := suppliertypes.ProofFault{
+		SessionId:        "session_1_service_1",
+		SessionEndHeight: numBlocksPerSession,
+	}
+	for _, sessionId := range []string{"session_1_service_1", "session_1_service_2", "session_1_service_3"} {
+		supplierProofFaults, err := supplierModuleKeepers.RecordSupplierProofFault(ctx, supplierOperatorAddr, suppliertypes.ProofFault{
+			SessionId:        sessionId,
+			SessionEndHeight: numBlocksPerSession,
+		})
+		require.NoError(t, err)
+		require.Equal(t, []suppliertypes.ProofFault{firstProofFault}, supplierProofFaults.GetProofFaults())
+	}
+
+	// The proof fault of another session is recorded.
+	secondProofFault := suppliertypes.ProofFault{
+		SessionId:        "session_2_service_1",
+		SessionEndHeight: 2 * numBlocksPerSession,
+	}
+	supplierProofFaults, err := supplierModuleKeepers.RecordSupplierProofFault(ctx, supplierOperatorAddr, secondProofFault)
+	require.NoError(t, err)
+	require.Equal(t, []suppliertypes.ProofFault{firstProofFault, secondProofFault}, supplierProofFaults.GetProofFaults())
+	require.Equal(t, uint64(1), supplierProofFaults.GetNumProofFaultsSince(secondProofFault.GetSessionEndHeight()))
+
+	// Ensure an event was only emitted for the recorded proof faults.
+	events := cosmostypes.UnwrapSDKContext(ctx).EventManager().Events()
+	recordedEvents := testevents.FilterEvents[*suppliertypes.EventSupplierProofFaultRecorded](t, events)
+	require.Len(t, recordedEvents, 2)
+	require.Equal(t, uint64(1), recordedEvents[0].GetNumProofFaults())
+	require.Equal(t, uint64(2), recordedEvents[1].GetNumProofFaults())
+}
@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/pokt-network/poktroll/x/supplier/types"
+)
+
+// SupplierDelegations returns a paginated list of all the delegations, including
+// the unbonding ones, to the supplier with the given operator address.
+func (k Keeper) SupplierDelegations(
+	ctx context.Context,
+	req *types.QuerySupplierDelegationsRequest,
+) (*types.QuerySupplierDelegationsResponse, error) {
+	logger := k.Logger().With("method", "SupplierDelegations")
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if err := req.ValidateBasic(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	supplierDelegationStore := prefix.NewStore(
+		k.getDelegationStore(ctx),
+		types.SupplierOperatorKey(req.GetOperatorAddress()),
+	)
+
+	var delegations []types.Delegation
+
+	pageRes, err := query.Paginate(
+		supplierDelegationStore,
+		req.Pagination,
+		func(key []byte, value []byte) error {
+			var delegation types.Delegation
+			if err := k.cdc.Unmarshal(value, &delegation); err != nil {
+				err = fmt.Errorf("unmarshaling delegation with key (hex): %x: %+v", key, err)
+				logger.Error(err.Error())
+				return status.Error(codes.Internal, err.Error())
+			}
+
+			delegations = append(delegations, delegation)
+			return nil
+		},
+	)
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySupplierDelegationsResponse{Delegations: delegations, Pagination: pageRes}, nil
+}
@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/pokt-network/poktroll/x/supplier/types"
+)
+
+// SupplierProofFaults returns the proof faults of the supplier with the given
+// operator address which are still within the proof fault window.
+// An empty SupplierProofFaults is returned if the supplier has no proof faults.
+func (k Keeper) SupplierProofFaults(
+	ctx context.Context,
+	req *types.QueryGetSupplierProofFaultsRequest,
+) (*types.QueryGetSupplierProofFaultsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if err := req.ValidateBasic(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	supplierProofFaults, found := k.GetSupplierProofFaults(ctx, req.GetOperatorAddress())
+	if !found {
+		supplierProofFaults = types.SupplierProofFaults{
+			OperatorAddress: req.GetOperatorAddress(),
+			ProofFaults:     []types.ProofFault{},
+		}
+	}
+
+	return &types.QueryGetSupplierProofFaultsResponse{SupplierProofFaults: supplierProofFaults}, nil
+}
+
+// AllSupplierProofFaults returns a paginated list of the proof faults of all the
+// suppliers which have at least one recorded proof fault.
+func (k Keeper) AllSupplierProofFaults(
+	ctx context.Context,
+	req *types.QueryAllSupplierProofFaultsRequest,
+) (*types.QueryAllSupplierProofFaultsResponse, error) {
+	logger := k.Logger().With("method", "AllSupplierProofFaults")
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var allSupplierProofFaults []types.SupplierProofFaults
+
+	pageRes, err := query.Paginate(
+		k.getSupplierProofFaultsStore(ctx),
+		req.Pagination,
+		func(key []byte, value []byte) error {
+			var supplierProofFaults types.SupplierProofFaults
+			if err := k.cdc.Unmarshal(value, &supplierProofFaults); err != nil {
+				err = fmt.Errorf("unmarshaling supplier proof faults with key (hex): %x: %+v", key, err)
+				logger.Error(err.Error())
+				return status.Error(codes.Internal, err.Error())
+			}
+
+			allSupplierProofFaults = append(allSupplierProofFaults, supplierProofFaults)
+			return nil
+		},
+	)
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllSupplierProofFaultsResponse{
+		SupplierProofFaults: allSupplierProofFaults,
+		Pagination:          pageRes,
+	}, nil
+}
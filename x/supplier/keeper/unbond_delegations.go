@@ -0,0 +1,138 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	cosmostypes "github.com/cosmos/cosmos-sdk/types"
+
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
+	suppliertypes "github.com/pokt-network/poktroll/x/supplier/types"
+)
+
+// EndBlockerUnbondDelegations unbonds delegations whose unbonding period has elapsed.
+func (k Keeper) EndBlockerUnbondDelegations(ctx context.Context) (numUnbondedDelegations uint64, err error) {
+	sdkCtx := cosmostypes.UnwrapSDKContext(ctx)
+	sharedParams := k.sharedKeeper.GetParams(ctx)
+	currentHeight := sdkCtx.BlockHeight()
+
+	// Only process unbonding delegations at the same heights as unbonding suppliers.
+	if sharedtypes.IsSessionEndHeight(&sharedParams, currentHeight) {
+		return numUnbondedDelegations, nil
+	}
+
+	logger := k.Logger().With("method", "UnbondDelegations")
+
+	// Collect the unbonding delegations keys first since unbonding a delegation
+	// removes it from the index being iterated over.
+	var unstakingDelegationKeys [][]byte
+	allUnstakingDelegationsIterator := k.GetAllUnstakingDelegationsIterator(ctx)
+	for ; allUnstakingDelegationsIterator.Valid(); allUnstakingDelegationsIterator.Next() {
+		unstakingDelegationKeys = append(unstakingDelegationKeys, allUnstakingDelegationsIterator.Value())
+	}
+	allUnstakingDelegationsIterator.Close()
+
+	for _, delegationKey := range unstakingDelegationKeys {
+		delegation, found := k.getDelegationByKey(ctx, delegationKey)
+		if !found {
+			// We should be able to find the delegation if it is in the index.
+			err = fmt.Errorf("should never happen: could not find unbonding delegation with key %s", delegationKey)
+			logger.Error(err.Error())
+			return numUnbondedDelegations, err
+		}
+
+		// If the unbonding height is ahead of the current height, the delegation
+		// stays in the unbonding state.
+		unbondingEndHeight := suppliertypes.GetDelegationUnbondingEndHeight(&sharedParams, &delegation)
+		if unbondingEndHeight > currentHeight {
+			continue
+		}
+
+		if err = k.unbondDelegation(
+			ctx,
+			delegation,
+			suppliertypes.DelegationUnbondingReason_DELEGATION_UNBONDING_REASON_VOLUNTARY,
+			unbondingEndHeight,
+		); err != nil {
+			return numUnbondedDelegations, err
+		}
+
+		numUnbondedDelegations += 1
+	}
+
+	return numUnbondedDelegations, nil
+}
+
+// unbondSupplierDelegations unbonds all the delegations to the given supplier
+// right away. It is called when the supplier itself finishes unbonding, since
+// its delegations can no longer earn rewards nor be slashed.
+func (k Keeper) unbondSupplierDelegations(
+	ctx context.Context,
+	supplierOperatorAddress string,
+) error {
+	currentHeight := cosmostypes.UnwrapSDKContext(ctx).BlockHeight()
+
+	for _, delegation := range k.GetSupplierDelegations(ctx, supplierOperatorAddress) {
+		if err := k.unbondDelegation(
+			ctx,
+			delegation,
+			suppliertypes.DelegationUnbondingReason_DELEGATION_UNBONDING_REASON_SUPPLIER_UNBONDED,
+			currentHeight,
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// unbondDelegation returns the stake of the given delegation to the delegator,
+// removes the delegation from the store and emits an EventSupplierDelegationUnbondingEnd.
+func (k Keeper) unbondDelegation(
+	ctx context.Context,
+	delegation suppliertypes.Delegation,
+	unbondingReason suppliertypes.DelegationUnbondingReason,
+	unbondingEndHeight int64,
+) error {
+	logger := k.Logger().With("method", "unbondDelegation")
+
+	delegatorAddress, err := cosmostypes.AccAddressFromBech32(delegation.DelegatorAddress)
+	if err != nil {
+		logger.Error(fmt.Sprintf("could not parse the delegator address %s", delegation.DelegatorAddress))
+		return err
+	}
+
+	// If the delegation stake is 0 due to slashing, then do not move 0 coins
+	// to the delegator account.
+	if delegation.Stake.IsPositive() {
+		// Send the coins from the supplier pool back to the delegator.
+		if err = k.bankKeeper.SendCoinsFromModuleToAccount(
+			ctx, suppliertypes.ModuleName, delegatorAddress, []cosmostypes.Coin{*delegation.Stake},
+		); err != nil {
+			logger.Error(fmt.Sprintf(
+				"could not send %s coins from module %s to account %s due to %s",
+				delegation.Stake.String(), suppliertypes.ModuleName, delegatorAddress, err,
+			))
+			return err
+		}
+	}
+
+	k.RemoveDelegation(ctx, delegation.SupplierOperatorAddress, delegation.DelegatorAddress)
+	logger.Info(fmt.Sprintf("Successfully removed the delegation: %+v", delegation))
+
+	// Emit an event which signals that the delegation has successfully unbonded.
+	sdkCtx := cosmostypes.UnwrapSDKContext(ctx)
+	sessionEndHeight := k.sharedKeeper.GetSessionEndHeight(ctx, sdkCtx.BlockHeight())
+	unbondingEndEvent := &suppliertypes.EventSupplierDelegationUnbondingEnd{
+		Delegation:         &delegation,
+		Reason:             unbondingReason,
+		SessionEndHeight:   sessionEndHeight,
+		UnbondingEndHeight: unbondingEndHeight,
+	}
+	if err = sdkCtx.EventManager().EmitTypedEvent(unbondingEndEvent); err != nil {
+		logger.Error(fmt.Sprintf("failed to emit event: %+v; %s", unbondingEndEvent, err))
+		return err
+	}
+
+	return nil
+}
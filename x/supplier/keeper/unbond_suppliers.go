@@ -93,6 +93,16 @@ func (k Keeper) EndBlockerUnbondSuppliers(ctx context.Context) (numUnbondedSuppl
 		// This can result in a lot of state bloat.
 		// k.hydrateSupplierServiceConfigs(ctx, &supplier)
 
+		// Return the stake of all the delegations to the supplier since they can
+		// no longer earn rewards once the supplier is removed.
+		if err = k.unbondSupplierDelegations(ctx, supplier.OperatorAddress); err != nil {
+			logger.Error(fmt.Sprintf("could not unbond the delegations to supplier %s due to %s", supplier.OperatorAddress, err))
+			return numUnbondedSuppliers, err
+		}
+
+		// The proof faults of the unbonded supplier are no longer relevant.
+		k.RemoveSupplierProofFaults(ctx, supplier.OperatorAddress)
+
 		// Remove the supplier from the store.
 		k.RemoveSupplier(ctx, supplierOperatorAddress.String())
 		logger.Info(fmt.Sprintf("Successfully removed the supplier: %+v", supplier))
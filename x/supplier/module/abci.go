@@ -25,6 +25,14 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) error {
 
 	k.Logger().Info(fmt.Sprintf("unbonded %d suppliers", numUnbondedSuppliers))
 
+	numUnbondedDelegations, err := k.EndBlockerUnbondDelegations(ctx)
+	if err != nil {
+		logger.Error(fmt.Sprintf("could not unbond delegations due to error %v", err))
+		return err
+	}
+
+	k.Logger().Info(fmt.Sprintf("unbonded %d delegations", numUnbondedDelegations))
+
 	numSuppliersWithPrunedHistory, err := k.EndBlockerPruneSupplierServiceConfigHistory(ctx)
 	if err != nil {
 		logger.Error(fmt.Sprintf("could not prune service update history due to error %v", err))
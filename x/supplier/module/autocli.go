@@ -56,13 +56,57 @@ Returns details include things like:
 						},
 					},
 				},
+				{
+					Alias:     []string{"delegations"},
+					RpcMethod: "SupplierDelegations",
+					Use:       "list-supplier-delegations [operator_address]",
+					Short:     "List all the delegations to a specific supplier",
+					Long: `Retrieves a paginated list of all the delegations, including the unbonding ones, to the supplier identified by its operator address.
+
+Returns the delegator addresses, delegated amounts and unbonding status.`,
+
+					Example: `	pocketd query supplier list-supplier-delegations pokt1abc...xyz
+	pocketd query supplier list-supplier-delegations pokt1abc...xyz --page 2 --limit 50`,
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{
+							ProtoField: "operator_address",
+						},
+					},
+				},
+				{
+					Alias:     []string{"proof-faults"},
+					RpcMethod: "SupplierProofFaults",
+					Use:       "show-supplier-proof-faults [operator_address]",
+					Short:     "Shows the recent proof faults of a specific supplier",
+					Long: `Retrieves the proof faults (i.e. claims which expired because their required proof was missing or invalid) of the supplier identified by its operator address.
+
+Only the proof faults within the proof fault window (i.e. the proof_fault_window_sessions supplier param) are returned.
+They determine the escalation of the supplier's proof missing penalty and whether it is jailed.`,
+
+					Example: `	pocketd query supplier show-supplier-proof-faults pokt1abc...xyz
+	pocketd query supplier show-supplier-proof-faults pokt1abc...xyz --output json`,
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{
+							ProtoField: "operator_address",
+						},
+					},
+				},
+				{
+					RpcMethod: "AllSupplierProofFaults",
+					Use:       "list-supplier-proof-faults",
+					Short:     "List the recent proof faults of all suppliers",
+					Long:      `Retrieves a paginated list of the proof faults of all the suppliers which have at least one proof fault within the proof fault window.`,
+
+					Example: `	pocketd query supplier list-supplier-proof-faults
+	pocketd query supplier list-supplier-proof-faults --page 2 --limit 50`,
+				},
 				// this line is used by ignite scaffolding # autocli/query
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
 			Service:              modulev1.Msg_ServiceDesc.ServiceName,
 			EnhanceCustomCommand: true, // only required if you want to use the custom command (for backwards compatibility)
-			RpcCommandOptions:    []*autocliv1.RpcCommandOptions{
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
 				//{
 				//	RpcMethod: "UpdateParams",
 				//	Skip:      true, // skipped because authority gated
@@ -85,6 +129,58 @@ Returns details include things like:
 				//	Short:          "Send a update-param tx",
 				//	PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "name"}, {ProtoField: "asType"}},
 				//},
+				{
+					RpcMethod: "DelegateToSupplier",
+					Use:       "delegate-to-supplier <supplier-operator-address> <amount>",
+					Short:     "Delegate uPOKT to a supplier to earn a share of its rewards",
+					Long: `Delegate uPOKT to the supplier identified by <supplier-operator-address>.
+
+Delegators earn a share of the supplier's settlement rewards, pro-rata to their delegated stake and minus the supplier's delegation commission, and are slashed along with the supplier.
+Delegating to a supplier the signer is undelegating from cancels the undelegation.`,
+					Example: `	pocketd tx supplier delegate-to-supplier pokt1abc...xyz 1000000upokt --from delegator`,
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "supplier_operator_address"},
+						{ProtoField: "amount"},
+					},
+				},
+				{
+					RpcMethod: "UndelegateFromSupplier",
+					Use:       "undelegate-from-supplier <supplier-operator-address>",
+					Short:     "Begin unbonding the whole delegation of the signer to a supplier",
+					Long: `Begin unbonding the whole delegation of the signer to the supplier identified by <supplier-operator-address>.
+
+The delegated uPOKT is returned to the delegator once the supplier unbonding period (i.e. the supplier_unbonding_period_sessions shared param) has elapsed.`,
+					Example: `	pocketd tx supplier undelegate-from-supplier pokt1abc...xyz --from delegator`,
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "supplier_operator_address"},
+					},
+				},
+				{
+					RpcMethod: "SetSupplierDelegationCommission",
+					Use:       "set-supplier-delegation-commission <operator-address> <delegation-commission-percentage>",
+					Short:     "Set the percentage of the delegators' rewards which is kept by an owned supplier",
+					Long: `Set the supplier delegation commission:
+  - <operator-address>: the operator address of a supplier owned by the signer
+  - <delegation-commission-percentage>: integer value between 0 and 100`,
+					Example: `	pocketd tx supplier set-supplier-delegation-commission pokt1abc...xyz 10 --from owner`,
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "operator_address"},
+						{ProtoField: "delegation_commission_percentage"},
+					},
+				},
+				{
+					RpcMethod: "UnjailSupplier",
+					Use:       "unjail-supplier <operator-address>",
+					Short:     "Make a jailed supplier eligible for sessions again",
+					Long: `Unjail the supplier identified by <operator-address>, which can be signed by either the supplier owner or operator.
+
+Suppliers are jailed when their number of proof faults within the proof fault window reaches the proof_fault_jailing_threshold supplier param.
+A jailed supplier can only be unjailed once its jail period (i.e. the jail_duration_sessions supplier param) has elapsed and is included in sessions again starting from the next session.`,
+					Example: `	pocketd tx supplier unjail-supplier pokt1abc...xyz --from operator`,
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "operator_address"},
+					},
+				},
 				// this line is used by ignite scaffolding # autocli/tx
 			},
 		},
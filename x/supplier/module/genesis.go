@@ -28,6 +28,16 @@ func InitGenesis(ctx context.Context, k keeper.Keeper, genState types.GenesisSta
 
 		k.SetAndIndexDehydratedSupplier(ctx, supplier)
 	}
+
+	// Set all the delegations
+	for _, delegation := range genState.DelegationList {
+		k.SetDelegation(ctx, delegation)
+	}
+
+	// Set all the supplier proof faults
+	for _, supplierProofFaults := range genState.SupplierProofFaultsList {
+		k.SetSupplierProofFaults(ctx, supplierProofFaults)
+	}
 	// this line is used by starport scaffolding # genesis/module/init
 	if err := k.SetParams(ctx, genState.Params); err != nil {
 		panic(err)
@@ -40,6 +50,8 @@ func ExportGenesis(ctx context.Context, k keeper.Keeper) *types.GenesisState {
 	genesis.Params = k.GetParams(ctx)
 
 	genesis.SupplierList = k.GetAllSuppliers(ctx)
+	genesis.DelegationList = k.GetAllDelegations(ctx)
+	genesis.SupplierProofFaultsList = k.GetAllSupplierProofFaults(ctx)
 	// this line is used by starport scaffolding # genesis/module/export
 
 	return genesis
@@ -17,6 +17,18 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgUpdateParam{},
 	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgDelegateToSupplier{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgUndelegateFromSupplier{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSetSupplierDelegationCommission{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgUnjailSupplier{},
+	)
 	// this line is used by starport scaffolding # 3
 
 	registry.RegisterImplementations((*sdk.Msg)(nil),
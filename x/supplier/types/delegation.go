@@ -0,0 +1,134 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/pokt-network/poktroll/app/volatile"
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
+)
+
+// DelegationNotUnstaking is the value of `unstake_session_end_height` if the
+// delegation is not actively in the unbonding period.
+const DelegationNotUnstaking uint64 = iota
+
+// IsUnbonding returns true if the delegation is actively unbonding.
+// It determines if the delegator has submitted an undelegate message, in which
+// case the delegation has its UnstakeSessionEndHeight set.
+func (d *Delegation) IsUnbonding() bool {
+	return d.UnstakeSessionEndHeight != DelegationNotUnstaking
+}
+
+// IsActive returns true if the delegation earns rewards for the session starting
+// at the given sessionStartHeight, i.e. if it was delegated before the session started
+// and, if it is unbonding, was undelegated during or after that session.
+func (d *Delegation) IsActive(sessionStartHeight int64) bool {
+	if sessionStartHeight < d.GetActivationHeight() {
+		return false
+	}
+
+	return !d.IsUnbonding() || sessionStartHeight <= int64(d.GetUnstakeSessionEndHeight())
+}
+
+// ValidateBasic performs basic (non-state-dependant) validation on a Delegation.
+func (d *Delegation) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(d.DelegatorAddress); err != nil {
+		return ErrSupplierInvalidAddress.Wrapf("invalid delegator address %s; (%v)", d.DelegatorAddress, err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(d.SupplierOperatorAddress); err != nil {
+		return ErrSupplierInvalidAddress.Wrapf("invalid supplier operator address %s; (%v)", d.SupplierOperatorAddress, err)
+	}
+
+	if d.ActivationHeight < 0 {
+		return ErrSupplierInvalidDelegation.Wrapf("negative delegation activation height %d", d.ActivationHeight)
+	}
+
+	return ValidateDelegationAmount(d.Stake)
+}
+
+// GetDelegationUnbondingEndHeight returns the session end height at which the
+// given delegation finishes unbonding.
+// Delegations unbond over the same period as suppliers, which is determined by
+// the supplier_unbonding_period_sessions shared param.
+func GetDelegationUnbondingEndHeight(
+	sharedParams *sharedtypes.Params,
+	delegation *Delegation,
+) int64 {
+	// Calculate the number of blocks in the unbonding period
+	unbondingPeriodBlocks := sharedParams.GetSupplierUnbondingPeriodSessions() * sharedParams.GetNumBlocksPerSession()
+
+	// Add the unbonding period to the session end height to get the final unbonding height
+	return int64(delegation.GetUnstakeSessionEndHeight() + unbondingPeriodBlocks)
+}
+
+// ValidateDelegationAmount ensures that the given amount is a valid, positive,
+// uPOKT amount which can be delegated to a supplier.
+func ValidateDelegationAmount(amount *sdk.Coin) error {
+	if amount == nil {
+		return ErrSupplierInvalidDelegation.Wrap("nil delegation amount")
+	}
+
+	if err := amount.Validate(); err != nil {
+		return ErrSupplierInvalidDelegation.Wrapf("invalid delegation amount %v; (%v)", amount, err)
+	}
+
+	if amount.Denom != volatile.DenomuPOKT {
+		return ErrSupplierInvalidDelegation.Wrapf("invalid delegation amount denom %v", amount)
+	}
+
+	if !amount.IsPositive() {
+		return ErrSupplierInvalidDelegation.Wrapf("invalid delegation amount: %v <= 0", amount)
+	}
+
+	return nil
+}
+
+// ValidateDelegationCommissionPercentage ensures that the given supplier delegation
+// commission percentage is a valid percentage (i.e. at most 100).
+func ValidateDelegationCommissionPercentage(delegationCommissionPercentage uint64) error {
+	if delegationCommissionPercentage > 100 {
+		return ErrSupplierInvalidDelegationCommission.Wrapf(
+			"delegation commission percentage must be at most 100, got %d",
+			delegationCommissionPercentage,
+		)
+	}
+
+	return nil
+}
+
+// ValidateDelegationCommissionHistory ensures that the delegation commission updates
+// of the given supplier are valid percentages, ordered by effective height, and that
+// the latest one is the current supplier delegation commission.
+func ValidateDelegationCommissionHistory(supplier *sharedtypes.Supplier) error {
+	history := supplier.GetDelegationCommissionHistory()
+	if len(history) == 0 {
+		return nil
+	}
+
+	for i, update := range history {
+		if update == nil {
+			return ErrSupplierInvalidDelegationCommission.Wrapf("nil delegation commission update at index %d", i)
+		}
+		if err := ValidateDelegationCommissionPercentage(update.GetDelegationCommissionPercentage()); err != nil {
+			return err
+		}
+		if update.GetEffectiveBlockHeight() < 0 {
+			return ErrSupplierInvalidDelegationCommission.Wrapf("negative delegation commission effective height %d", update.GetEffectiveBlockHeight())
+		}
+		if i > 0 && update.GetEffectiveBlockHeight() <= history[i-1].GetEffectiveBlockHeight() {
+			return ErrSupplierInvalidDelegationCommission.Wrapf(
+				"delegation commission updates are not ordered by effective height: %d after %d",
+				update.GetEffectiveBlockHeight(), history[i-1].GetEffectiveBlockHeight(),
+			)
+		}
+	}
+
+	if latest := history[len(history)-1].GetDelegationCommissionPercentage(); latest != supplier.GetDelegationCommissionPercentage() {
+		return ErrSupplierInvalidDelegationCommission.Wrapf(
+			"latest delegation commission update %d does not match the supplier delegation commission %d",
+			latest, supplier.GetDelegationCommissionPercentage(),
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,518 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: pocket/supplier/delegation.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Delegation represents the uPOKT delegated by a token holder to a supplier.
+// Delegators earn a pro-rata share of the supplier's settlement rewards, minus
+// the supplier's delegation commission, for the sessions during which the delegation
+// is active, and are slashed along with the supplier.
+type Delegation struct {
+	// Bech32 address of the account which delegated the stake and receives the rewards
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// Operator address of the supplier the stake is delegated to
+	SupplierOperatorAddress string `protobuf:"bytes,2,opt,name=supplier_operator_address,json=supplierOperatorAddress,proto3" json:"supplier_operator_address,omitempty"`
+	// Total amount of delegated uPOKT
+	Stake *types.Coin `protobuf:"bytes,3,opt,name=stake,proto3" json:"stake,omitempty"`
+	// Session end height when the delegator initiated undelegating (0 if not undelegating)
+	// It is the end height of the last session for which the delegation earns rewards.
+	UnstakeSessionEndHeight uint64 `protobuf:"varint,4,opt,name=unstake_session_end_height,json=unstakeSessionEndHeight,proto3" json:"unstake_session_end_height,omitempty"`
+	// Start height of the first session for which the delegation earns rewards.
+	// It is the start of the session following the one in which the stake was last delegated.
+	ActivationHeight int64 `protobuf:"varint,5,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+}
+
+func (m *Delegation) Reset()         { *m = Delegation{} }
+func (m *Delegation) String() string { return proto.CompactTextString(m) }
+func (*Delegation) ProtoMessage()    {}
+func (*Delegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5b2d186e44c2b9cc, []int{0}
+}
+func (m *Delegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Delegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *Delegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Delegation.Merge(m, src)
+}
+func (m *Delegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *Delegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_Delegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Delegation proto.InternalMessageInfo
+
+func (m *Delegation) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
+func (m *Delegation) GetSupplierOperatorAddress() string {
+	if m != nil {
+		return m.SupplierOperatorAddress
+	}
+	return ""
+}
+
+func (m *Delegation) GetStake() *types.Coin {
+	if m != nil {
+		return m.Stake
+	}
+	return nil
+}
+
+func (m *Delegation) GetUnstakeSessionEndHeight() uint64 {
+	if m != nil {
+		return m.UnstakeSessionEndHeight
+	}
+	return 0
+}
+
+func (m *Delegation) GetActivationHeight() int64 {
+	if m != nil {
+		return m.ActivationHeight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Delegation)(nil), "pocket.supplier.Delegation")
+}
+
+func init() { proto.RegisterFile("pocket/supplier/delegation.proto", fileDescriptor_5b2d186e44c2b9cc) }
+
+var fileDescriptor_5b2d186e44c2b9cc = []byte{
+	// 358 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xbf, 0xae, 0xda, 0x30,
+	0x14, 0xc6, 0x31, 0x7f, 0x2a, 0xd5, 0x1d, 0x0a, 0x11, 0x12, 0x21, 0x83, 0x15, 0x75, 0x8a, 0x54,
+	0x11, 0x8b, 0x76, 0xec, 0x54, 0x5a, 0xa4, 0x6e, 0x48, 0xa1, 0x53, 0x97, 0x28, 0x7f, 0xac, 0x60,
+	0x25, 0xf8, 0x44, 0xb6, 0xa1, 0xed, 0x5b, 0xf4, 0x61, 0xfa, 0x08, 0x1d, 0x3a, 0xa2, 0x3b, 0x31,
+	0x5e, 0x85, 0x17, 0xb9, 0x22, 0x76, 0x40, 0x77, 0xba, 0x9b, 0x8f, 0xbf, 0xdf, 0xf7, 0x9d, 0x73,
+	0x12, 0x63, 0xbf, 0x86, 0xac, 0x64, 0x9a, 0xaa, 0x43, 0x5d, 0x57, 0x9c, 0x49, 0x9a, 0xb3, 0x8a,
+	0x15, 0x89, 0xe6, 0x20, 0xc2, 0x5a, 0x82, 0x06, 0xe7, 0xad, 0x21, 0xc2, 0x8e, 0xf0, 0xe6, 0x19,
+	0xa8, 0x3d, 0xa8, 0xb8, 0x95, 0xa9, 0x29, 0x0c, 0xeb, 0x11, 0x53, 0xd1, 0x34, 0x51, 0x8c, 0x1e,
+	0x97, 0x29, 0xd3, 0xc9, 0x92, 0x66, 0xc0, 0x6d, 0x96, 0x37, 0x2d, 0xa0, 0x00, 0xe3, 0xbb, 0x9e,
+	0xcc, 0xed, 0xbb, 0x7f, 0x7d, 0x8c, 0xbf, 0xde, 0xda, 0x3a, 0x6b, 0x3c, 0xb1, 0x43, 0x80, 0x8c,
+	0x93, 0x3c, 0x97, 0x4c, 0x29, 0x17, 0xf9, 0x28, 0x78, 0xbd, 0x72, 0x1f, 0xfe, 0x2e, 0xa6, 0xb6,
+	0xe3, 0x67, 0xa3, 0x6c, 0xb5, 0xe4, 0xa2, 0x88, 0xc6, 0x37, 0x8b, 0xbd, 0x77, 0xbe, 0xe3, 0x79,
+	0x37, 0x72, 0x0c, 0x35, 0x93, 0xcf, 0xe2, 0xfa, 0x2f, 0xc4, 0xcd, 0x3a, 0xeb, 0xc6, 0x3a, 0xbb,
+	0x54, 0x8a, 0x47, 0x4a, 0x27, 0x25, 0x73, 0x07, 0x3e, 0x0a, 0xde, 0x7c, 0x98, 0x87, 0xd6, 0x7e,
+	0xdd, 0x38, 0xb4, 0x1b, 0x87, 0x5f, 0x80, 0x8b, 0xc8, 0x70, 0xce, 0x27, 0xec, 0x1d, 0x44, 0x7b,
+	0x8c, 0x15, 0x53, 0x8a, 0x83, 0x88, 0x99, 0xc8, 0xe3, 0x1d, 0xe3, 0xc5, 0x4e, 0xbb, 0x43, 0x1f,
+	0x05, 0xc3, 0x68, 0x66, 0x89, 0xad, 0x01, 0xd6, 0x22, 0xff, 0xd6, 0xca, 0xce, 0x7b, 0x3c, 0x49,
+	0x32, 0xcd, 0x8f, 0xed, 0x87, 0xe9, 0x3c, 0x23, 0x1f, 0x05, 0x83, 0x68, 0x7c, 0x17, 0x0c, 0xbc,
+	0xda, 0xfc, 0x6f, 0x08, 0x3a, 0x35, 0x04, 0x9d, 0x1b, 0x82, 0x1e, 0x1b, 0x82, 0xfe, 0x5c, 0x48,
+	0xef, 0x74, 0x21, 0xbd, 0xf3, 0x85, 0xf4, 0x7e, 0x2c, 0x0b, 0xae, 0x77, 0x87, 0x34, 0xcc, 0x60,
+	0x4f, 0x6b, 0x28, 0xf5, 0x42, 0x30, 0xfd, 0x13, 0x64, 0xd9, 0x16, 0x12, 0xaa, 0x8a, 0xfe, 0xba,
+	0x3f, 0x02, 0xfd, 0xbb, 0x66, 0x2a, 0x7d, 0xd5, 0xfe, 0x9e, 0x8f, 0x4f, 0x03, 0x00, 0x54, 0x16,
+	0x2f, 0x53, 0x24, 0x02, 0x00, 0x00,
+}
+
+func (m *Delegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Delegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Delegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ActivationHeight != 0 {
+		i = encodeVarintDelegation(dAtA, i, uint64(m.ActivationHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.UnstakeSessionEndHeight != 0 {
+		i = encodeVarintDelegation(dAtA, i, uint64(m.UnstakeSessionEndHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Stake != nil {
+		{
+			size, err := m.Stake.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDelegation(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SupplierOperatorAddress) > 0 {
+		i -= len(m.SupplierOperatorAddress)
+		copy(dAtA[i:], m.SupplierOperatorAddress)
+		i = encodeVarintDelegation(dAtA, i, uint64(len(m.SupplierOperatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintDelegation(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDelegation(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDelegation(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Delegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovDelegation(uint64(l))
+	}
+	l = len(m.SupplierOperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovDelegation(uint64(l))
+	}
+	if m.Stake != nil {
+		l = m.Stake.Size()
+		n += 1 + l + sovDelegation(uint64(l))
+	}
+	if m.UnstakeSessionEndHeight != 0 {
+		n += 1 + sovDelegation(uint64(m.UnstakeSessionEndHeight))
+	}
+	if m.ActivationHeight != 0 {
+		n += 1 + sovDelegation(uint64(m.ActivationHeight))
+	}
+	return n
+}
+
+func sovDelegation(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDelegation(x uint64) (n int) {
+	return sovDelegation(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Delegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDelegation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Delegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Delegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplierOperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SupplierOperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stake", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Stake == nil {
+				m.Stake = &types.Coin{}
+			}
+			if err := m.Stake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnstakeSessionEndHeight", wireType)
+			}
+			m.UnstakeSessionEndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnstakeSessionEndHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationHeight", wireType)
+			}
+			m.ActivationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDelegation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipDelegation(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowDelegation
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthDelegation
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupDelegation
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthDelegation
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthDelegation        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowDelegation          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupDelegation = fmt.Errorf("proto: unexpected end of group")
+)
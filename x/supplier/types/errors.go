@@ -6,14 +6,21 @@ import sdkerrors "cosmossdk.io/errors"
 
 // x/supplier module sentinel errors
 var (
-	ErrSupplierInvalidSigner        = sdkerrors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
-	ErrSupplierInvalidStake         = sdkerrors.Register(ModuleName, 1101, "invalid supplier stake")
-	ErrSupplierInvalidAddress       = sdkerrors.Register(ModuleName, 1102, "invalid address")
-	ErrSupplierNotFound             = sdkerrors.Register(ModuleName, 1103, "supplier not found")
-	ErrSupplierInvalidServiceConfig = sdkerrors.Register(ModuleName, 1104, "invalid service config")
-	ErrSupplierIsUnstaking          = sdkerrors.Register(ModuleName, 1105, "supplier is in unbonding period")
-	ErrSupplierServiceNotFound      = sdkerrors.Register(ModuleName, 1106, "service not found")
-	ErrSupplierParamInvalid         = sdkerrors.Register(ModuleName, 1107, "the provided param is invalid")
-	ErrSupplierEmitEvent            = sdkerrors.Register(ModuleName, 1108, "failed to emit event")
-	ErrSupplierInvalidServiceId     = sdkerrors.Register(ModuleName, 1109, "invalid service ID")
+	ErrSupplierInvalidSigner               = sdkerrors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
+	ErrSupplierInvalidStake                = sdkerrors.Register(ModuleName, 1101, "invalid supplier stake")
+	ErrSupplierInvalidAddress              = sdkerrors.Register(ModuleName, 1102, "invalid address")
+	ErrSupplierNotFound                    = sdkerrors.Register(ModuleName, 1103, "supplier not found")
+	ErrSupplierInvalidServiceConfig        = sdkerrors.Register(ModuleName, 1104, "invalid service config")
+	ErrSupplierIsUnstaking                 = sdkerrors.Register(ModuleName, 1105, "supplier is in unbonding period")
+	ErrSupplierServiceNotFound             = sdkerrors.Register(ModuleName, 1106, "service not found")
+	ErrSupplierParamInvalid                = sdkerrors.Register(ModuleName, 1107, "the provided param is invalid")
+	ErrSupplierEmitEvent                   = sdkerrors.Register(ModuleName, 1108, "failed to emit event")
+	ErrSupplierInvalidServiceId            = sdkerrors.Register(ModuleName, 1109, "invalid service ID")
+	ErrSupplierDelegationNotFound          = sdkerrors.Register(ModuleName, 1110, "delegation not found")
+	ErrSupplierDelegationIsUnstaking       = sdkerrors.Register(ModuleName, 1111, "delegation is in unbonding period")
+	ErrSupplierInvalidDelegation           = sdkerrors.Register(ModuleName, 1112, "invalid delegation")
+	ErrSupplierInvalidDelegationCommission = sdkerrors.Register(ModuleName, 1113, "invalid delegation commission")
+	ErrSupplierNotJailed                   = sdkerrors.Register(ModuleName, 1114, "supplier is not jailed")
+	ErrSupplierJailPeriodNotElapsed        = sdkerrors.Register(ModuleName, 1115, "supplier jail period has not elapsed")
+	ErrSupplierInvalidJailHistory          = sdkerrors.Register(ModuleName, 1116, "invalid supplier jail history")
 )
@@ -54,6 +54,34 @@ func (SupplierUnbondingReason) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_0ff4bce83a0142ab, []int{0}
 }
 
+type DelegationUnbondingReason int32
+
+const (
+	DelegationUnbondingReason_DELEGATION_UNBONDING_REASON_UNSPECIFIED       DelegationUnbondingReason = 0
+	DelegationUnbondingReason_DELEGATION_UNBONDING_REASON_VOLUNTARY         DelegationUnbondingReason = 1
+	DelegationUnbondingReason_DELEGATION_UNBONDING_REASON_SUPPLIER_UNBONDED DelegationUnbondingReason = 2
+)
+
+var DelegationUnbondingReason_name = map[int32]string{
+	0: "DELEGATION_UNBONDING_REASON_UNSPECIFIED",
+	1: "DELEGATION_UNBONDING_REASON_VOLUNTARY",
+	2: "DELEGATION_UNBONDING_REASON_SUPPLIER_UNBONDED",
+}
+
+var DelegationUnbondingReason_value = map[string]int32{
+	"DELEGATION_UNBONDING_REASON_UNSPECIFIED":       0,
+	"DELEGATION_UNBONDING_REASON_VOLUNTARY":         1,
+	"DELEGATION_UNBONDING_REASON_SUPPLIER_UNBONDED": 2,
+}
+
+func (x DelegationUnbondingReason) String() string {
+	return proto.EnumName(DelegationUnbondingReason_name, int32(x))
+}
+
+func (DelegationUnbondingReason) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_0ff4bce83a0142ab, []int{1}
+}
+
 // EventSupplierStaked is emitted when a supplier stake message is committed onchain.
 type EventSupplierStaked struct {
 	Supplier *types.Supplier `protobuf:"bytes,1,opt,name=supplier,proto3" json:"supplier"`
@@ -356,394 +384,2290 @@ func (m *EventSupplierServiceConfigActivated) GetActivationHeight() int64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterEnum("pocket.supplier.SupplierUnbondingReason", SupplierUnbondingReason_name, SupplierUnbondingReason_value)
-	proto.RegisterType((*EventSupplierStaked)(nil), "pocket.supplier.EventSupplierStaked")
-	proto.RegisterType((*EventSupplierUnbondingBegin)(nil), "pocket.supplier.EventSupplierUnbondingBegin")
-	proto.RegisterType((*EventSupplierUnbondingEnd)(nil), "pocket.supplier.EventSupplierUnbondingEnd")
-	proto.RegisterType((*EventSupplierUnbondingCanceled)(nil), "pocket.supplier.EventSupplierUnbondingCanceled")
-	proto.RegisterType((*EventSupplierServiceConfigActivated)(nil), "pocket.supplier.EventSupplierServiceConfigActivated")
+// EventSupplierDelegated is emitted when a delegate to supplier message is committed onchain.
+type EventSupplierDelegated struct {
+	Delegation *Delegation `protobuf:"bytes,1,opt,name=delegation,proto3" json:"delegation"`
+	// The session end height of the session in which the delegation was made.
+	SessionEndHeight int64 `protobuf:"varint,2,opt,name=session_end_height,json=sessionEndHeight,proto3" json:"session_end_height"`
 }
 
-func init() { proto.RegisterFile("pocket/supplier/event.proto", fileDescriptor_0ff4bce83a0142ab) }
-
-var fileDescriptor_0ff4bce83a0142ab = []byte{
-	// 557 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x55, 0x4d, 0x6e, 0xd3, 0x40,
-	0x18, 0xcd, 0xa4, 0x28, 0x42, 0x03, 0x82, 0x60, 0x0a, 0x4d, 0x5b, 0xe4, 0x54, 0xa9, 0x10, 0x29,
-	0x52, 0x6d, 0xa5, 0x9c, 0x20, 0x4e, 0x0c, 0x04, 0x82, 0x1d, 0xd9, 0x35, 0x08, 0x36, 0x96, 0x7f,
-	0x06, 0x67, 0x94, 0x74, 0xc6, 0xb2, 0x27, 0x01, 0x6e, 0xc1, 0x01, 0x10, 0x27, 0xe0, 0x0c, 0x88,
-	0x25, 0x1b, 0xa4, 0x2e, 0xbb, 0xb2, 0x50, 0xb2, 0xf3, 0x29, 0x50, 0xfc, 0x93, 0x10, 0x9a, 0x08,
-	0x21, 0x65, 0xc9, 0x6a, 0x66, 0xde, 0x7b, 0xf3, 0xcd, 0xfb, 0x9e, 0x3d, 0x36, 0xdc, 0xf7, 0xa9,
-	0x33, 0x40, 0x4c, 0x0c, 0x47, 0xbe, 0x3f, 0xc4, 0x28, 0x10, 0xd1, 0x18, 0x11, 0x26, 0xf8, 0x01,
-	0x65, 0x94, 0xbb, 0x99, 0x92, 0x42, 0x4e, 0xee, 0xed, 0x3a, 0x34, 0x3c, 0xa3, 0xa1, 0x99, 0xd0,
-	0x62, 0xba, 0x48, 0xb5, 0x7b, 0xdb, 0x1e, 0xf5, 0x68, 0x8a, 0xcf, 0x66, 0x19, 0xca, 0xa7, 0x1a,
-	0xd1, 0xb6, 0x42, 0x24, 0x8e, 0x1b, 0x36, 0x62, 0x56, 0x43, 0x74, 0x28, 0x26, 0x19, 0x7f, 0x2f,
-	0x3f, 0xbe, 0x6f, 0x05, 0xc8, 0x9d, 0xbb, 0x48, 0xd9, 0xda, 0x67, 0x00, 0x6f, 0xcb, 0x33, 0x3f,
-	0x7a, 0x86, 0xeb, 0xcc, 0x1a, 0x20, 0x97, 0x6b, 0xc2, 0xab, 0xb9, 0xb2, 0x02, 0x0e, 0x40, 0xfd,
-	0xda, 0xc9, 0x8e, 0x90, 0x5b, 0x4d, 0x0a, 0x09, 0xf9, 0x06, 0xe9, 0x7a, 0x1c, 0x55, 0xe7, 0x62,
-	0x6d, 0x3e, 0xe3, 0xda, 0x90, 0x0b, 0x51, 0x18, 0x62, 0x4a, 0x4c, 0x44, 0x5c, 0xb3, 0x8f, 0xb0,
-	0xd7, 0x67, 0x95, 0xe2, 0x01, 0xa8, 0x6f, 0x49, 0x77, 0xe3, 0xa8, 0xba, 0x82, 0xd5, 0xca, 0x19,
-	0x26, 0x13, 0xf7, 0x69, 0x82, 0xd4, 0xbe, 0x15, 0xe1, 0xfe, 0x92, 0x41, 0x83, 0xd8, 0x94, 0xb8,
-	0x98, 0x78, 0x12, 0xf2, 0x30, 0xd9, 0x84, 0xd1, 0x2e, 0x2c, 0x05, 0xc8, 0x0a, 0x29, 0x49, 0xcc,
-	0xdd, 0x38, 0xa9, 0x0b, 0x7f, 0x3c, 0x14, 0xe1, 0xd2, 0xd9, 0x5a, 0xa2, 0x97, 0x60, 0x1c, 0x55,
-	0xb3, 0xbd, 0x5a, 0x36, 0xae, 0x69, 0x7b, 0xeb, 0xdf, 0xda, 0xe6, 0x9e, 0xc1, 0xed, 0x51, 0x7e,
-	0xd8, 0xef, 0x75, 0xae, 0x24, 0x75, 0x2a, 0x71, 0x54, 0x5d, 0xc9, 0x6b, 0xdc, 0x1c, 0x5d, 0x44,
-	0xf8, 0xb5, 0x08, 0x77, 0x57, 0x47, 0x28, 0x13, 0xf7, 0x7f, 0x80, 0x7f, 0x0f, 0xf0, 0x07, 0x80,
-	0xfc, 0xea, 0x00, 0x5b, 0x16, 0x71, 0xd0, 0x70, 0x33, 0xf7, 0xa5, 0x06, 0x4b, 0x4b, 0xbd, 0x26,
-	0xd9, 0x64, 0xae, 0xb2, 0x71, 0x43, 0x77, 0xea, 0x0b, 0x80, 0x87, 0xcb, 0x97, 0x1e, 0x05, 0x63,
-	0xec, 0xa0, 0x16, 0x25, 0x6f, 0xb1, 0xd7, 0x74, 0x18, 0x1e, 0x5b, 0x6c, 0x33, 0x4d, 0x49, 0xf0,
-	0x96, 0x95, 0xd6, 0x9b, 0xb9, 0x5a, 0xf2, 0x7b, 0x27, 0x8e, 0xaa, 0x97, 0x49, 0xad, 0xbc, 0x80,
-	0x52, 0xbb, 0x0f, 0x3f, 0x01, 0xb8, 0xb3, 0xe6, 0x05, 0xe2, 0x8e, 0xe0, 0x7d, 0xdd, 0xe8, 0xf5,
-	0xba, 0x1d, 0x59, 0x33, 0x0d, 0x45, 0x52, 0x95, 0x76, 0x47, 0x79, 0x62, 0x6a, 0x72, 0x53, 0x57,
-	0x15, 0xd3, 0x50, 0xf4, 0x9e, 0xdc, 0xea, 0x3c, 0xee, 0xc8, 0xed, 0x72, 0x81, 0x7b, 0x00, 0x0f,
-	0xd7, 0x4b, 0x5f, 0xaa, 0x5d, 0x43, 0x39, 0x6d, 0x6a, 0xaf, 0xcb, 0x80, 0x3b, 0x86, 0x47, 0xeb,
-	0x85, 0x92, 0xdc, 0x55, 0x5f, 0x99, 0x2f, 0x3a, 0x8a, 0xa9, 0x9f, 0x36, 0x9f, 0xcb, 0xe5, 0xa2,
-	0xa4, 0x7e, 0x9f, 0xf0, 0xe0, 0x7c, 0xc2, 0x83, 0x8b, 0x09, 0x0f, 0x7e, 0x4e, 0x78, 0xf0, 0x71,
-	0xca, 0x17, 0xce, 0xa7, 0x7c, 0xe1, 0x62, 0xca, 0x17, 0xde, 0x34, 0x3c, 0xcc, 0xfa, 0x23, 0x5b,
-	0x70, 0xe8, 0x99, 0xe8, 0xd3, 0x01, 0x3b, 0x26, 0x88, 0xbd, 0xa3, 0xc1, 0x20, 0x59, 0x04, 0x74,
-	0x38, 0x14, 0xdf, 0x2f, 0xfe, 0x0c, 0xec, 0x83, 0x8f, 0x42, 0xbb, 0x94, 0x7c, 0x9a, 0x1f, 0xfd,
-	0x0a, 0x00, 0x00, 0xff, 0xff, 0x86, 0x31, 0x9d, 0xae, 0x39, 0x06, 0x00, 0x00,
+func (m *EventSupplierDelegated) Reset()         { *m = EventSupplierDelegated{} }
+func (m *EventSupplierDelegated) String() string { return proto.CompactTextString(m) }
+func (*EventSupplierDelegated) ProtoMessage()    {}
+func (*EventSupplierDelegated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0ff4bce83a0142ab, []int{5}
 }
-
-func (m *EventSupplierStaked) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventSupplierDelegated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSupplierDelegated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return b[:n], nil
 }
-
-func (m *EventSupplierStaked) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventSupplierDelegated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSupplierDelegated.Merge(m, src)
+}
+func (m *EventSupplierDelegated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSupplierDelegated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSupplierDelegated.DiscardUnknown(m)
 }
 
-func (m *EventSupplierStaked) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.SessionEndHeight != 0 {
-		i = encodeVarintEvent(dAtA, i, uint64(m.SessionEndHeight))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Supplier != nil {
-		{
-			size, err := m.Supplier.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvent(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_EventSupplierDelegated proto.InternalMessageInfo
+
+func (m *EventSupplierDelegated) GetDelegation() *Delegation {
+	if m != nil {
+		return m.Delegation
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *EventSupplierUnbondingBegin) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventSupplierDelegated) GetSessionEndHeight() int64 {
+	if m != nil {
+		return m.SessionEndHeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *EventSupplierUnbondingBegin) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventSupplierDelegationUnbondingBegin is emitted when an undelegate from supplier
+// message is committed onchain, indicating that the delegation will now begin unbonding.
+type EventSupplierDelegationUnbondingBegin struct {
+	Delegation *Delegation `protobuf:"bytes,1,opt,name=delegation,proto3" json:"delegation"`
+	// The session end height of the session in which the delegation unbonding began.
+	SessionEndHeight int64 `protobuf:"varint,2,opt,name=session_end_height,json=sessionEndHeight,proto3" json:"session_end_height"`
+	// The height at which the delegation unbonding will end.
+	UnbondingEndHeight int64 `protobuf:"varint,3,opt,name=unbonding_end_height,json=unbondingEndHeight,proto3" json:"unbonding_end_height"`
 }
 
-func (m *EventSupplierUnbondingBegin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.UnbondingEndHeight != 0 {
-		i = encodeVarintEvent(dAtA, i, uint64(m.UnbondingEndHeight))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.SessionEndHeight != 0 {
-		i = encodeVarintEvent(dAtA, i, uint64(m.SessionEndHeight))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.Reason != 0 {
-		i = encodeVarintEvent(dAtA, i, uint64(m.Reason))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Supplier != nil {
-		{
-			size, err := m.Supplier.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvent(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *EventSupplierDelegationUnbondingBegin) Reset()         { *m = EventSupplierDelegationUnbondingBegin{} }
+func (m *EventSupplierDelegationUnbondingBegin) String() string { return proto.CompactTextString(m) }
+func (*EventSupplierDelegationUnbondingBegin) ProtoMessage()    {}
+func (*EventSupplierDelegationUnbondingBegin) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0ff4bce83a0142ab, []int{6}
 }
-
-func (m *EventSupplierUnbondingEnd) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventSupplierDelegationUnbondingBegin) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSupplierDelegationUnbondingBegin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return b[:n], nil
 }
-
-func (m *EventSupplierUnbondingEnd) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventSupplierDelegationUnbondingBegin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSupplierDelegationUnbondingBegin.Merge(m, src)
+}
+func (m *EventSupplierDelegationUnbondingBegin) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSupplierDelegationUnbondingBegin) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSupplierDelegationUnbondingBegin.DiscardUnknown(m)
 }
 
-func (m *EventSupplierUnbondingEnd) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.UnbondingEndHeight != 0 {
-		i = encodeVarintEvent(dAtA, i, uint64(m.UnbondingEndHeight))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.SessionEndHeight != 0 {
-		i = encodeVarintEvent(dAtA, i, uint64(m.SessionEndHeight))
-		i--
-		dAtA[i] = 0x18
+var xxx_messageInfo_EventSupplierDelegationUnbondingBegin proto.InternalMessageInfo
+
+func (m *EventSupplierDelegationUnbondingBegin) GetDelegation() *Delegation {
+	if m != nil {
+		return m.Delegation
 	}
-	if m.Reason != 0 {
-		i = encodeVarintEvent(dAtA, i, uint64(m.Reason))
-		i--
-		dAtA[i] = 0x10
+	return nil
+}
+
+func (m *EventSupplierDelegationUnbondingBegin) GetSessionEndHeight() int64 {
+	if m != nil {
+		return m.SessionEndHeight
 	}
-	if m.Supplier != nil {
-		{
-			size, err := m.Supplier.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvent(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *EventSupplierDelegationUnbondingBegin) GetUnbondingEndHeight() int64 {
+	if m != nil {
+		return m.UnbondingEndHeight
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *EventSupplierUnbondingCanceled) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+// EventSupplierDelegationUnbondingEnd is emitted when a delegation has completed
+// unbonding, either because the unbonding period, determined by the shared param
+// supplier_unbonding_period_sessions, has elapsed or because the supplier unbonded.
+type EventSupplierDelegationUnbondingEnd struct {
+	Delegation *Delegation               `protobuf:"bytes,1,opt,name=delegation,proto3" json:"delegation"`
+	Reason     DelegationUnbondingReason `protobuf:"varint,2,opt,name=reason,proto3,enum=pocket.supplier.DelegationUnbondingReason" json:"reason"`
+	// The session end height of the session in which the delegation unbonding ended.
+	SessionEndHeight int64 `protobuf:"varint,3,opt,name=session_end_height,json=sessionEndHeight,proto3" json:"session_end_height"`
+	// The height at which the delegation unbonding ended.
+	UnbondingEndHeight int64 `protobuf:"varint,4,opt,name=unbonding_end_height,json=unbondingEndHeight,proto3" json:"unbonding_end_height"`
+}
+
+func (m *EventSupplierDelegationUnbondingEnd) Reset()         { *m = EventSupplierDelegationUnbondingEnd{} }
+func (m *EventSupplierDelegationUnbondingEnd) String() string { return proto.CompactTextString(m) }
+func (*EventSupplierDelegationUnbondingEnd) ProtoMessage()    {}
+func (*EventSupplierDelegationUnbondingEnd) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0ff4bce83a0142ab, []int{7}
+}
+func (m *EventSupplierDelegationUnbondingEnd) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSupplierDelegationUnbondingEnd) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return b[:n], nil
 }
-
-func (m *EventSupplierUnbondingCanceled) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventSupplierDelegationUnbondingEnd) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSupplierDelegationUnbondingEnd.Merge(m, src)
+}
+func (m *EventSupplierDelegationUnbondingEnd) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSupplierDelegationUnbondingEnd) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSupplierDelegationUnbondingEnd.DiscardUnknown(m)
 }
 
-func (m *EventSupplierUnbondingCanceled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Height != 0 {
-		i = encodeVarintEvent(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x18
+var xxx_messageInfo_EventSupplierDelegationUnbondingEnd proto.InternalMessageInfo
+
+func (m *EventSupplierDelegationUnbondingEnd) GetDelegation() *Delegation {
+	if m != nil {
+		return m.Delegation
 	}
-	if m.SessionEndHeight != 0 {
-		i = encodeVarintEvent(dAtA, i, uint64(m.SessionEndHeight))
-		i--
-		dAtA[i] = 0x10
+	return nil
+}
+
+func (m *EventSupplierDelegationUnbondingEnd) GetReason() DelegationUnbondingReason {
+	if m != nil {
+		return m.Reason
 	}
-	if m.Supplier != nil {
-		{
-			size, err := m.Supplier.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvent(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	return DelegationUnbondingReason_DELEGATION_UNBONDING_REASON_UNSPECIFIED
+}
+
+func (m *EventSupplierDelegationUnbondingEnd) GetSessionEndHeight() int64 {
+	if m != nil {
+		return m.SessionEndHeight
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *EventSupplierServiceConfigActivated) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventSupplierDelegationUnbondingEnd) GetUnbondingEndHeight() int64 {
+	if m != nil {
+		return m.UnbondingEndHeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *EventSupplierServiceConfigActivated) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventSupplierDelegationUnbondingCanceled is emitted when a delegator which was
+// undelegating delegates to the same supplier again before the unbonding period
+// has elapsed. An EventSupplierDelegated event will also be emitted immediately after this event.
+type EventSupplierDelegationUnbondingCanceled struct {
+	Delegation *Delegation `protobuf:"bytes,1,opt,name=delegation,proto3" json:"delegation"`
+	// The exact height at which the delegation unbonding was canceled.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height"`
+	// The session end height of the session in which the delegation unbonding was canceled.
+	SessionEndHeight int64 `protobuf:"varint,3,opt,name=session_end_height,json=sessionEndHeight,proto3" json:"session_end_height"`
 }
 
-func (m *EventSupplierServiceConfigActivated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ActivationHeight != 0 {
-		i = encodeVarintEvent(dAtA, i, uint64(m.ActivationHeight))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Supplier != nil {
-		{
-			size, err := m.Supplier.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvent(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (m *EventSupplierDelegationUnbondingCanceled) Reset() {
+	*m = EventSupplierDelegationUnbondingCanceled{}
+}
+func (m *EventSupplierDelegationUnbondingCanceled) String() string { return proto.CompactTextString(m) }
+func (*EventSupplierDelegationUnbondingCanceled) ProtoMessage()    {}
+func (*EventSupplierDelegationUnbondingCanceled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0ff4bce83a0142ab, []int{8}
+}
+func (m *EventSupplierDelegationUnbondingCanceled) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSupplierDelegationUnbondingCanceled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return b[:n], nil
+}
+func (m *EventSupplierDelegationUnbondingCanceled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSupplierDelegationUnbondingCanceled.Merge(m, src)
+}
+func (m *EventSupplierDelegationUnbondingCanceled) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSupplierDelegationUnbondingCanceled) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSupplierDelegationUnbondingCanceled.DiscardUnknown(m)
 }
 
-func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvent(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_EventSupplierDelegationUnbondingCanceled proto.InternalMessageInfo
+
+func (m *EventSupplierDelegationUnbondingCanceled) GetDelegation() *Delegation {
+	if m != nil {
+		return m.Delegation
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *EventSupplierStaked) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Supplier != nil {
-		l = m.Supplier.Size()
-		n += 1 + l + sovEvent(uint64(l))
+
+func (m *EventSupplierDelegationUnbondingCanceled) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	if m.SessionEndHeight != 0 {
-		n += 1 + sovEvent(uint64(m.SessionEndHeight))
+	return 0
+}
+
+func (m *EventSupplierDelegationUnbondingCanceled) GetSessionEndHeight() int64 {
+	if m != nil {
+		return m.SessionEndHeight
 	}
-	return n
+	return 0
 }
 
-func (m *EventSupplierUnbondingBegin) Size() (n int) {
-	if m == nil {
-		return 0
+// EventSupplierDelegationCommissionUpdated is emitted when a supplier owner updates
+// the commission it keeps from the delegators' share of the settlement rewards.
+type EventSupplierDelegationCommissionUpdated struct {
+	OperatorAddress                    string `protobuf:"bytes,1,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address"`
+	PrevDelegationCommissionPercentage uint64 `protobuf:"varint,2,opt,name=prev_delegation_commission_percentage,json=prevDelegationCommissionPercentage,proto3" json:"prev_delegation_commission_percentage"`
+	NewDelegationCommissionPercentage  uint64 `protobuf:"varint,3,opt,name=new_delegation_commission_percentage,json=newDelegationCommissionPercentage,proto3" json:"new_delegation_commission_percentage"`
+	// The start height of the first session settled with the new delegation commission.
+	EffectiveBlockHeight int64 `protobuf:"varint,4,opt,name=effective_block_height,json=effectiveBlockHeight,proto3" json:"effective_block_height"`
+}
+
+func (m *EventSupplierDelegationCommissionUpdated) Reset() {
+	*m = EventSupplierDelegationCommissionUpdated{}
+}
+func (m *EventSupplierDelegationCommissionUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventSupplierDelegationCommissionUpdated) ProtoMessage()    {}
+func (*EventSupplierDelegationCommissionUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0ff4bce83a0142ab, []int{9}
+}
+func (m *EventSupplierDelegationCommissionUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSupplierDelegationCommissionUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Supplier != nil {
-		l = m.Supplier.Size()
-		n += 1 + l + sovEvent(uint64(l))
+	return b[:n], nil
+}
+func (m *EventSupplierDelegationCommissionUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSupplierDelegationCommissionUpdated.Merge(m, src)
+}
+func (m *EventSupplierDelegationCommissionUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSupplierDelegationCommissionUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSupplierDelegationCommissionUpdated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSupplierDelegationCommissionUpdated proto.InternalMessageInfo
+
+func (m *EventSupplierDelegationCommissionUpdated) GetOperatorAddress() string {
+	if m != nil {
+		return m.OperatorAddress
 	}
-	if m.Reason != 0 {
-		n += 1 + sovEvent(uint64(m.Reason))
+	return ""
+}
+
+func (m *EventSupplierDelegationCommissionUpdated) GetPrevDelegationCommissionPercentage() uint64 {
+	if m != nil {
+		return m.PrevDelegationCommissionPercentage
 	}
-	if m.SessionEndHeight != 0 {
-		n += 1 + sovEvent(uint64(m.SessionEndHeight))
+	return 0
+}
+
+func (m *EventSupplierDelegationCommissionUpdated) GetNewDelegationCommissionPercentage() uint64 {
+	if m != nil {
+		return m.NewDelegationCommissionPercentage
 	}
-	if m.UnbondingEndHeight != 0 {
-		n += 1 + sovEvent(uint64(m.UnbondingEndHeight))
+	return 0
+}
+
+func (m *EventSupplierDelegationCommissionUpdated) GetEffectiveBlockHeight() int64 {
+	if m != nil {
+		return m.EffectiveBlockHeight
 	}
-	return n
+	return 0
 }
 
-func (m *EventSupplierUnbondingEnd) Size() (n int) {
-	if m == nil {
-		return 0
+// EventSupplierProofFaultRecorded is emitted when a claim of a supplier expires
+// because its required proof was missing or invalid.
+type EventSupplierProofFaultRecorded struct {
+	OperatorAddress string      `protobuf:"bytes,1,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address"`
+	ProofFault      *ProofFault `protobuf:"bytes,2,opt,name=proof_fault,json=proofFault,proto3" json:"proof_fault"`
+	// The number of proof faults of the supplier within the proof fault window,
+	// including the recorded one.
+	NumProofFaults uint64 `protobuf:"varint,3,opt,name=num_proof_faults,json=numProofFaults,proto3" json:"num_proof_faults"`
+}
+
+func (m *EventSupplierProofFaultRecorded) Reset()         { *m = EventSupplierProofFaultRecorded{} }
+func (m *EventSupplierProofFaultRecorded) String() string { return proto.CompactTextString(m) }
+func (*EventSupplierProofFaultRecorded) ProtoMessage()    {}
+func (*EventSupplierProofFaultRecorded) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0ff4bce83a0142ab, []int{10}
+}
+func (m *EventSupplierProofFaultRecorded) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSupplierProofFaultRecorded) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Supplier != nil {
-		l = m.Supplier.Size()
-		n += 1 + l + sovEvent(uint64(l))
+	return b[:n], nil
+}
+func (m *EventSupplierProofFaultRecorded) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSupplierProofFaultRecorded.Merge(m, src)
+}
+func (m *EventSupplierProofFaultRecorded) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSupplierProofFaultRecorded) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSupplierProofFaultRecorded.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSupplierProofFaultRecorded proto.InternalMessageInfo
+
+func (m *EventSupplierProofFaultRecorded) GetOperatorAddress() string {
+	if m != nil {
+		return m.OperatorAddress
 	}
-	if m.Reason != 0 {
-		n += 1 + sovEvent(uint64(m.Reason))
+	return ""
+}
+
+func (m *EventSupplierProofFaultRecorded) GetProofFault() *ProofFault {
+	if m != nil {
+		return m.ProofFault
 	}
-	if m.SessionEndHeight != 0 {
-		n += 1 + sovEvent(uint64(m.SessionEndHeight))
+	return nil
+}
+
+func (m *EventSupplierProofFaultRecorded) GetNumProofFaults() uint64 {
+	if m != nil {
+		return m.NumProofFaults
 	}
-	if m.UnbondingEndHeight != 0 {
-		n += 1 + sovEvent(uint64(m.UnbondingEndHeight))
+	return 0
+}
+
+// EventSupplierJailed is emitted when a supplier reaches the proof_fault_jailing_threshold
+// number of proof faults within the proof fault window. The supplier is excluded
+// from sessions starting from the next session.
+type EventSupplierJailed struct {
+	Supplier *types.Supplier `protobuf:"bytes,1,opt,name=supplier,proto3" json:"supplier"`
+	// The number of proof faults of the supplier within the proof fault window,
+	// since it was last jailed, which made it reach the jailing threshold.
+	NumProofFaults uint64 `protobuf:"varint,2,opt,name=num_proof_faults,json=numProofFaults,proto3" json:"num_proof_faults"`
+	// The session end height of the session in which the supplier was jailed.
+	SessionEndHeight int64 `protobuf:"varint,3,opt,name=session_end_height,json=sessionEndHeight,proto3" json:"session_end_height"`
+	// The session end height of the last session the supplier is jailed for.
+	JailEndHeight int64 `protobuf:"varint,4,opt,name=jail_end_height,json=jailEndHeight,proto3" json:"jail_end_height"`
+}
+
+func (m *EventSupplierJailed) Reset()         { *m = EventSupplierJailed{} }
+func (m *EventSupplierJailed) String() string { return proto.CompactTextString(m) }
+func (*EventSupplierJailed) ProtoMessage()    {}
+func (*EventSupplierJailed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0ff4bce83a0142ab, []int{11}
+}
+func (m *EventSupplierJailed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSupplierJailed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return b[:n], nil
+}
+func (m *EventSupplierJailed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSupplierJailed.Merge(m, src)
+}
+func (m *EventSupplierJailed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSupplierJailed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSupplierJailed.DiscardUnknown(m)
 }
 
-func (m *EventSupplierUnbondingCanceled) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_EventSupplierJailed proto.InternalMessageInfo
+
+func (m *EventSupplierJailed) GetSupplier() *types.Supplier {
+	if m != nil {
+		return m.Supplier
 	}
-	var l int
-	_ = l
-	if m.Supplier != nil {
-		l = m.Supplier.Size()
-		n += 1 + l + sovEvent(uint64(l))
+	return nil
+}
+
+func (m *EventSupplierJailed) GetNumProofFaults() uint64 {
+	if m != nil {
+		return m.NumProofFaults
 	}
-	if m.SessionEndHeight != 0 {
-		n += 1 + sovEvent(uint64(m.SessionEndHeight))
+	return 0
+}
+
+func (m *EventSupplierJailed) GetSessionEndHeight() int64 {
+	if m != nil {
+		return m.SessionEndHeight
 	}
-	if m.Height != 0 {
-		n += 1 + sovEvent(uint64(m.Height))
+	return 0
+}
+
+func (m *EventSupplierJailed) GetJailEndHeight() int64 {
+	if m != nil {
+		return m.JailEndHeight
 	}
-	return n
+	return 0
 }
 
-func (m *EventSupplierServiceConfigActivated) Size() (n int) {
-	if m == nil {
-		return 0
+// EventSupplierUnjailed is emitted when an unjail supplier message is committed onchain.
+// The supplier is included in sessions again starting from the next session.
+type EventSupplierUnjailed struct {
+	Supplier *types.Supplier `protobuf:"bytes,1,opt,name=supplier,proto3" json:"supplier"`
+	// The session end height of the session in which the supplier was unjailed.
+	SessionEndHeight int64 `protobuf:"varint,2,opt,name=session_end_height,json=sessionEndHeight,proto3" json:"session_end_height"`
+}
+
+func (m *EventSupplierUnjailed) Reset()         { *m = EventSupplierUnjailed{} }
+func (m *EventSupplierUnjailed) String() string { return proto.CompactTextString(m) }
+func (*EventSupplierUnjailed) ProtoMessage()    {}
+func (*EventSupplierUnjailed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0ff4bce83a0142ab, []int{12}
+}
+func (m *EventSupplierUnjailed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSupplierUnjailed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Supplier != nil {
-		l = m.Supplier.Size()
-		n += 1 + l + sovEvent(uint64(l))
+	return b[:n], nil
+}
+func (m *EventSupplierUnjailed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSupplierUnjailed.Merge(m, src)
+}
+func (m *EventSupplierUnjailed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSupplierUnjailed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSupplierUnjailed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSupplierUnjailed proto.InternalMessageInfo
+
+func (m *EventSupplierUnjailed) GetSupplier() *types.Supplier {
+	if m != nil {
+		return m.Supplier
 	}
-	if m.ActivationHeight != 0 {
-		n += 1 + sovEvent(uint64(m.ActivationHeight))
+	return nil
+}
+
+func (m *EventSupplierUnjailed) GetSessionEndHeight() int64 {
+	if m != nil {
+		return m.SessionEndHeight
 	}
-	return n
+	return 0
 }
 
-func sovEvent(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func init() {
+	proto.RegisterEnum("pocket.supplier.SupplierUnbondingReason", SupplierUnbondingReason_name, SupplierUnbondingReason_value)
+	proto.RegisterEnum("pocket.supplier.DelegationUnbondingReason", DelegationUnbondingReason_name, DelegationUnbondingReason_value)
+	proto.RegisterType((*EventSupplierStaked)(nil), "pocket.supplier.EventSupplierStaked")
+	proto.RegisterType((*EventSupplierUnbondingBegin)(nil), "pocket.supplier.EventSupplierUnbondingBegin")
+	proto.RegisterType((*EventSupplierUnbondingEnd)(nil), "pocket.supplier.EventSupplierUnbondingEnd")
+	proto.RegisterType((*EventSupplierUnbondingCanceled)(nil), "pocket.supplier.EventSupplierUnbondingCanceled")
+	proto.RegisterType((*EventSupplierServiceConfigActivated)(nil), "pocket.supplier.EventSupplierServiceConfigActivated")
+	proto.RegisterType((*EventSupplierDelegated)(nil), "pocket.supplier.EventSupplierDelegated")
+	proto.RegisterType((*EventSupplierDelegationUnbondingBegin)(nil), "pocket.supplier.EventSupplierDelegationUnbondingBegin")
+	proto.RegisterType((*EventSupplierDelegationUnbondingEnd)(nil), "pocket.supplier.EventSupplierDelegationUnbondingEnd")
+	proto.RegisterType((*EventSupplierDelegationUnbondingCanceled)(nil), "pocket.supplier.EventSupplierDelegationUnbondingCanceled")
+	proto.RegisterType((*EventSupplierDelegationCommissionUpdated)(nil), "pocket.supplier.EventSupplierDelegationCommissionUpdated")
+	proto.RegisterType((*EventSupplierProofFaultRecorded)(nil), "pocket.supplier.EventSupplierProofFaultRecorded")
+	proto.RegisterType((*EventSupplierJailed)(nil), "pocket.supplier.EventSupplierJailed")
+	proto.RegisterType((*EventSupplierUnjailed)(nil), "pocket.supplier.EventSupplierUnjailed")
 }
-func sozEvent(x uint64) (n int) {
-	return sovEvent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func init() { proto.RegisterFile("pocket/supplier/event.proto", fileDescriptor_0ff4bce83a0142ab) }
+
+var fileDescriptor_0ff4bce83a0142ab = []byte{
+	// 992 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x57, 0xcf, 0x6f, 0xe3, 0x44,
+	0x14, 0xae, 0x9d, 0x55, 0x05, 0x6f, 0xa1, 0x0d, 0xde, 0x6e, 0xb7, 0x3f, 0x50, 0xdc, 0xcd, 0x52,
+	0x6d, 0x5a, 0xd4, 0x44, 0x5d, 0x8e, 0x48, 0xa0, 0xb8, 0xf1, 0x2e, 0xd9, 0xcd, 0x26, 0xd1, 0xa4,
+	0x01, 0xc1, 0xc5, 0x72, 0xec, 0x89, 0xeb, 0x4d, 0x32, 0x63, 0xd9, 0x4e, 0xca, 0x4a, 0x9c, 0x39,
+	0x73, 0x07, 0x21, 0x4e, 0x5c, 0xe0, 0xcc, 0x11, 0x71, 0xe4, 0x82, 0xb4, 0x07, 0x0e, 0x3d, 0x59,
+	0xd0, 0xde, 0x7c, 0xe3, 0x3f, 0x40, 0x76, 0x1c, 0x3b, 0x71, 0xe2, 0xfe, 0x40, 0x3e, 0x80, 0xc4,
+	0x29, 0xce, 0x7b, 0xdf, 0x9b, 0xf7, 0xbd, 0x6f, 0x66, 0x9e, 0x9f, 0x61, 0xdb, 0xa0, 0x4a, 0x0f,
+	0xdb, 0x25, 0x6b, 0x68, 0x18, 0x7d, 0x1d, 0x9b, 0x25, 0x3c, 0xc2, 0xc4, 0x2e, 0x1a, 0x26, 0xb5,
+	0x29, 0xb7, 0x3a, 0x76, 0x16, 0x27, 0xce, 0xad, 0x4d, 0x85, 0x5a, 0x03, 0x6a, 0x49, 0xbe, 0xbb,
+	0x34, 0xfe, 0x33, 0xc6, 0x6e, 0xad, 0x69, 0x54, 0xa3, 0x63, 0xbb, 0xf7, 0x14, 0x58, 0x73, 0x63,
+	0x4c, 0xa9, 0x23, 0x5b, 0xb8, 0x34, 0x3a, 0xec, 0x60, 0x5b, 0x3e, 0x2c, 0x29, 0x54, 0x27, 0x81,
+	0xff, 0xed, 0x49, 0xfa, 0x13, 0xd9, 0xc4, 0x6a, 0xc8, 0x22, 0xf0, 0xee, 0xc4, 0xc9, 0xa9, 0xb8,
+	0x8f, 0x35, 0xd9, 0xd6, 0xe9, 0x24, 0xfe, 0x7e, 0x1c, 0x61, 0x98, 0x94, 0x76, 0xa5, 0xae, 0x3c,
+	0xec, 0x07, 0x45, 0xe4, 0xbf, 0x65, 0xe0, 0x8e, 0xe8, 0x15, 0xd5, 0x0a, 0x30, 0x2d, 0x5b, 0xee,
+	0x61, 0x95, 0x2b, 0xc3, 0x6b, 0x93, 0xa8, 0x0d, 0x66, 0x87, 0x29, 0xdc, 0x7e, 0x74, 0xaf, 0x38,
+	0xa9, 0xd7, 0x67, 0x53, 0x9c, 0x04, 0x08, 0x6f, 0xb8, 0x0e, 0x1f, 0x82, 0x51, 0xf8, 0xc4, 0x55,
+	0x80, 0xb3, 0xb0, 0x65, 0xe9, 0x94, 0x48, 0x98, 0xa8, 0xd2, 0x09, 0xd6, 0xb5, 0x13, 0x7b, 0x83,
+	0xdd, 0x61, 0x0a, 0x19, 0x61, 0xdd, 0x75, 0xf8, 0x05, 0x5e, 0x94, 0x0d, 0x6c, 0x22, 0x51, 0x3f,
+	0xf2, 0x2d, 0xf9, 0x5f, 0x58, 0xd8, 0x9e, 0x21, 0xd8, 0x26, 0x1d, 0x4a, 0x54, 0x9d, 0x68, 0x02,
+	0xd6, 0x74, 0x92, 0x06, 0xd1, 0x1a, 0x2c, 0x9b, 0x58, 0xb6, 0x28, 0xf1, 0xc9, 0xad, 0x3c, 0x2a,
+	0x14, 0x63, 0x3b, 0x5b, 0x9c, 0xcb, 0x8d, 0x7c, 0xbc, 0x00, 0xae, 0xc3, 0x07, 0xb1, 0x28, 0xf8,
+	0x4d, 0x28, 0x3b, 0x73, 0xb3, 0xb2, 0xb9, 0xa7, 0xb0, 0x36, 0x9c, 0x24, 0x9b, 0x5e, 0xe7, 0x96,
+	0xbf, 0xce, 0x86, 0xeb, 0xf0, 0x0b, 0xfd, 0x88, 0x0b, 0xad, 0x91, 0x84, 0x3f, 0xb3, 0xb0, 0xb9,
+	0x58, 0x42, 0x91, 0xa8, 0xff, 0x0b, 0x78, 0xb5, 0x80, 0xbf, 0x31, 0x90, 0x5b, 0x2c, 0xe0, 0x91,
+	0x4c, 0x14, 0xdc, 0x4f, 0xe7, 0xbe, 0xe4, 0x61, 0x79, 0xa6, 0x56, 0x5f, 0x9b, 0x80, 0x55, 0xf0,
+	0x9b, 0xd2, 0x9d, 0xfa, 0x91, 0x81, 0x07, 0xb3, 0x97, 0x1e, 0x9b, 0x23, 0x5d, 0xc1, 0x47, 0x94,
+	0x74, 0x75, 0xad, 0xac, 0xd8, 0xfa, 0x48, 0xb6, 0xd3, 0x29, 0x4a, 0x80, 0xb7, 0xe4, 0xf1, 0x7a,
+	0x1e, 0xab, 0x19, 0xbe, 0x77, 0x5d, 0x87, 0x9f, 0x77, 0xa2, 0x6c, 0x64, 0x0a, 0xe8, 0xfe, 0xc0,
+	0xc0, 0xfa, 0x0c, 0xdd, 0xca, 0xb8, 0xd1, 0x61, 0x95, 0x7b, 0x06, 0x10, 0x75, 0xbd, 0x80, 0xe3,
+	0xf6, 0xdc, 0xe9, 0xab, 0x84, 0x10, 0x61, 0xc5, 0x75, 0xf8, 0xa9, 0x10, 0x34, 0xf5, 0x9c, 0x92,
+	0xb8, 0x5f, 0xb2, 0xb0, 0xbb, 0x88, 0xad, 0x4e, 0x49, 0xac, 0x75, 0xfd, 0xfb, 0xc8, 0x27, 0xde,
+	0x9a, 0xcc, 0x3f, 0xb8, 0x35, 0xbf, 0xb3, 0xb1, 0x53, 0xb6, 0x40, 0x08, 0xaf, 0x01, 0xa5, 0x2a,
+	0x43, 0x3d, 0xd6, 0x8a, 0xf6, 0x2f, 0x59, 0xe8, 0xbf, 0xd9, 0x8c, 0xfe, 0x64, 0xa0, 0x70, 0x95,
+	0xac, 0x61, 0x5b, 0x4a, 0x55, 0xdb, 0xa8, 0x41, 0xb1, 0x37, 0x6c, 0x50, 0x37, 0xd4, 0x2b, 0xff,
+	0x53, 0x26, 0xb1, 0xc6, 0x23, 0x3a, 0x18, 0xe8, 0x3e, 0xbc, 0x6d, 0xa8, 0x7e, 0x0f, 0xf8, 0x10,
+	0xb2, 0xd4, 0xc0, 0xa6, 0x6c, 0x53, 0x53, 0x92, 0x55, 0xd5, 0xc4, 0x96, 0xe5, 0x57, 0xfa, 0xba,
+	0xb0, 0xe6, 0x3a, 0xfc, 0x9c, 0x0f, 0xad, 0x4e, 0x2c, 0xe5, 0xb1, 0x81, 0xfb, 0x02, 0x76, 0x0d,
+	0x13, 0x8f, 0xa4, 0xa8, 0x54, 0x49, 0x09, 0xb3, 0x48, 0x06, 0x36, 0x15, 0x4c, 0x6c, 0x59, 0xc3,
+	0x7e, 0xd9, 0xb7, 0x84, 0x3d, 0xd7, 0xe1, 0xaf, 0x17, 0x80, 0xf2, 0x1e, 0x6c, 0x11, 0xf7, 0x66,
+	0x88, 0xe1, 0x5e, 0xc2, 0x3b, 0x04, 0x9f, 0x5e, 0x9d, 0x3c, 0xe3, 0x27, 0x2f, 0xb8, 0x0e, 0x7f,
+	0x2d, 0x3c, 0xba, 0x4f, 0xf0, 0xe9, 0x15, 0xa9, 0x9b, 0xb0, 0x8e, 0xbb, 0x5d, 0xec, 0xf5, 0x5b,
+	0x2c, 0x75, 0xfa, 0x54, 0xe9, 0xcd, 0x1e, 0xcc, 0x2d, 0xd7, 0xe1, 0x13, 0x10, 0x68, 0x2d, 0xb4,
+	0x0b, 0x9e, 0x39, 0xd8, 0xb8, 0xbf, 0x18, 0xe0, 0x67, 0x36, 0xae, 0xe9, 0x4d, 0x9c, 0x8f, 0xbd,
+	0x81, 0x13, 0x61, 0x85, 0x9a, 0x6a, 0x1a, 0xfb, 0xf5, 0x1c, 0x6e, 0x4f, 0x0d, 0xb2, 0x1b, 0x6c,
+	0xc2, 0xa9, 0x8e, 0x52, 0x0b, 0xab, 0xae, 0xc3, 0x4f, 0xc7, 0x20, 0x30, 0x42, 0x27, 0xf7, 0x01,
+	0x64, 0xc9, 0x70, 0x20, 0x4d, 0xb9, 0xad, 0x40, 0x6c, 0x9f, 0x4f, 0xdc, 0x87, 0x56, 0xc8, 0x70,
+	0x10, 0xad, 0x6d, 0xe5, 0xbf, 0x66, 0x63, 0x23, 0xf4, 0x53, 0x59, 0x4f, 0x69, 0x24, 0x58, 0x44,
+	0x8d, 0xbd, 0x3e, 0xb5, 0x94, 0xba, 0xd7, 0xfb, 0xb0, 0xfa, 0x42, 0xd6, 0xfb, 0xf3, 0x8d, 0xeb,
+	0x8e, 0xeb, 0xf0, 0x71, 0x17, 0x7a, 0xd3, 0x33, 0x44, 0x57, 0xf9, 0x3b, 0x06, 0xee, 0xc6, 0x66,
+	0xa7, 0x17, 0xa9, 0xe9, 0x93, 0xca, 0x4b, 0x6f, 0xff, 0x1b, 0x06, 0xee, 0x25, 0x0c, 0xa8, 0xdc,
+	0x1e, 0xec, 0xb6, 0xda, 0xcd, 0x66, 0xad, 0x2a, 0x22, 0xa9, 0x5d, 0x17, 0x1a, 0xf5, 0x4a, 0xb5,
+	0xfe, 0x44, 0x42, 0x62, 0xb9, 0xd5, 0xa8, 0x4b, 0xed, 0x7a, 0xab, 0x29, 0x1e, 0x55, 0x1f, 0x57,
+	0xc5, 0x4a, 0x76, 0x89, 0x7b, 0x08, 0x0f, 0x92, 0xa1, 0x1f, 0x37, 0x6a, 0xed, 0xfa, 0x71, 0x19,
+	0x7d, 0x9a, 0x65, 0xb8, 0x03, 0xd8, 0x4b, 0x06, 0x0a, 0x62, 0xad, 0xf1, 0x89, 0xf4, 0xbc, 0x5a,
+	0x97, 0x5a, 0xc7, 0xe5, 0x67, 0x62, 0x96, 0xdd, 0xff, 0x9e, 0x81, 0xcd, 0xc4, 0x97, 0x16, 0xf7,
+	0x2e, 0x3c, 0xac, 0x88, 0x35, 0xf1, 0x49, 0xf9, 0xb8, 0xda, 0xa8, 0xcf, 0x2f, 0x37, 0x4b, 0x71,
+	0x0f, 0x76, 0x2f, 0x03, 0x4f, 0x93, 0x3c, 0x84, 0x83, 0xcb, 0xa0, 0xb1, 0x02, 0xc4, 0x4a, 0x96,
+	0x15, 0x1a, 0xbf, 0x9e, 0xe7, 0x98, 0x57, 0xe7, 0x39, 0xe6, 0xec, 0x3c, 0xc7, 0xfc, 0x71, 0x9e,
+	0x63, 0xbe, 0xba, 0xc8, 0x2d, 0xbd, 0xba, 0xc8, 0x2d, 0x9d, 0x5d, 0xe4, 0x96, 0x3e, 0x3b, 0xd4,
+	0x74, 0xfb, 0x64, 0xd8, 0x29, 0x2a, 0x74, 0x50, 0x32, 0x68, 0xcf, 0x3e, 0x20, 0xd8, 0x3e, 0xa5,
+	0x66, 0xcf, 0xff, 0x63, 0xd2, 0x7e, 0xbf, 0xf4, 0x79, 0xf4, 0xa1, 0x6a, 0xbf, 0x34, 0xb0, 0xd5,
+	0x59, 0xf6, 0xbf, 0x51, 0xdf, 0xfb, 0x7b, 0x00, 0x3a, 0x95, 0x23, 0x85, 0x87, 0x0f, 0x00, 0x00,
+}
+
+func (m *EventSupplierStaked) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSupplierStaked) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSupplierStaked) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SessionEndHeight != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.SessionEndHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Supplier != nil {
+		{
+			size, err := m.Supplier.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSupplierUnbondingBegin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSupplierUnbondingBegin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSupplierUnbondingBegin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UnbondingEndHeight != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.UnbondingEndHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SessionEndHeight != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.SessionEndHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Reason != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Reason))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Supplier != nil {
+		{
+			size, err := m.Supplier.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSupplierUnbondingEnd) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSupplierUnbondingEnd) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSupplierUnbondingEnd) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UnbondingEndHeight != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.UnbondingEndHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SessionEndHeight != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.SessionEndHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Reason != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Reason))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Supplier != nil {
+		{
+			size, err := m.Supplier.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSupplierUnbondingCanceled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSupplierUnbondingCanceled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSupplierUnbondingCanceled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SessionEndHeight != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.SessionEndHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Supplier != nil {
+		{
+			size, err := m.Supplier.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSupplierServiceConfigActivated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSupplierServiceConfigActivated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSupplierServiceConfigActivated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ActivationHeight != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.ActivationHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Supplier != nil {
+		{
+			size, err := m.Supplier.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSupplierDelegated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSupplierDelegated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSupplierDelegated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SessionEndHeight != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.SessionEndHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Delegation != nil {
+		{
+			size, err := m.Delegation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSupplierDelegationUnbondingBegin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSupplierDelegationUnbondingBegin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSupplierDelegationUnbondingBegin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UnbondingEndHeight != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.UnbondingEndHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SessionEndHeight != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.SessionEndHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Delegation != nil {
+		{
+			size, err := m.Delegation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSupplierDelegationUnbondingEnd) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSupplierDelegationUnbondingEnd) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSupplierDelegationUnbondingEnd) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UnbondingEndHeight != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.UnbondingEndHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SessionEndHeight != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.SessionEndHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Reason != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Reason))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Delegation != nil {
+		{
+			size, err := m.Delegation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSupplierDelegationUnbondingCanceled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSupplierDelegationUnbondingCanceled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSupplierDelegationUnbondingCanceled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SessionEndHeight != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.SessionEndHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Height != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Delegation != nil {
+		{
+			size, err := m.Delegation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSupplierDelegationCommissionUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSupplierDelegationCommissionUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSupplierDelegationCommissionUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EffectiveBlockHeight != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.EffectiveBlockHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.NewDelegationCommissionPercentage != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.NewDelegationCommissionPercentage))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PrevDelegationCommissionPercentage != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.PrevDelegationCommissionPercentage))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSupplierProofFaultRecorded) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSupplierProofFaultRecorded) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSupplierProofFaultRecorded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NumProofFaults != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.NumProofFaults))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ProofFault != nil {
+		{
+			size, err := m.ProofFault.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSupplierJailed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSupplierJailed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSupplierJailed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.JailEndHeight != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.JailEndHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SessionEndHeight != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.SessionEndHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.NumProofFaults != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.NumProofFaults))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Supplier != nil {
+		{
+			size, err := m.Supplier.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSupplierUnjailed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSupplierUnjailed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSupplierUnjailed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SessionEndHeight != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.SessionEndHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Supplier != nil {
+		{
+			size, err := m.Supplier.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvent(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventSupplierStaked) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Supplier != nil {
+		l = m.Supplier.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.SessionEndHeight != 0 {
+		n += 1 + sovEvent(uint64(m.SessionEndHeight))
+	}
+	return n
+}
+
+func (m *EventSupplierUnbondingBegin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Supplier != nil {
+		l = m.Supplier.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Reason != 0 {
+		n += 1 + sovEvent(uint64(m.Reason))
+	}
+	if m.SessionEndHeight != 0 {
+		n += 1 + sovEvent(uint64(m.SessionEndHeight))
+	}
+	if m.UnbondingEndHeight != 0 {
+		n += 1 + sovEvent(uint64(m.UnbondingEndHeight))
+	}
+	return n
+}
+
+func (m *EventSupplierUnbondingEnd) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Supplier != nil {
+		l = m.Supplier.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Reason != 0 {
+		n += 1 + sovEvent(uint64(m.Reason))
+	}
+	if m.SessionEndHeight != 0 {
+		n += 1 + sovEvent(uint64(m.SessionEndHeight))
+	}
+	if m.UnbondingEndHeight != 0 {
+		n += 1 + sovEvent(uint64(m.UnbondingEndHeight))
+	}
+	return n
+}
+
+func (m *EventSupplierUnbondingCanceled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Supplier != nil {
+		l = m.Supplier.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.SessionEndHeight != 0 {
+		n += 1 + sovEvent(uint64(m.SessionEndHeight))
+	}
+	if m.Height != 0 {
+		n += 1 + sovEvent(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *EventSupplierServiceConfigActivated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Supplier != nil {
+		l = m.Supplier.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.ActivationHeight != 0 {
+		n += 1 + sovEvent(uint64(m.ActivationHeight))
+	}
+	return n
+}
+
+func (m *EventSupplierDelegated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Delegation != nil {
+		l = m.Delegation.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.SessionEndHeight != 0 {
+		n += 1 + sovEvent(uint64(m.SessionEndHeight))
+	}
+	return n
+}
+
+func (m *EventSupplierDelegationUnbondingBegin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Delegation != nil {
+		l = m.Delegation.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.SessionEndHeight != 0 {
+		n += 1 + sovEvent(uint64(m.SessionEndHeight))
+	}
+	if m.UnbondingEndHeight != 0 {
+		n += 1 + sovEvent(uint64(m.UnbondingEndHeight))
+	}
+	return n
+}
+
+func (m *EventSupplierDelegationUnbondingEnd) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Delegation != nil {
+		l = m.Delegation.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Reason != 0 {
+		n += 1 + sovEvent(uint64(m.Reason))
+	}
+	if m.SessionEndHeight != 0 {
+		n += 1 + sovEvent(uint64(m.SessionEndHeight))
+	}
+	if m.UnbondingEndHeight != 0 {
+		n += 1 + sovEvent(uint64(m.UnbondingEndHeight))
+	}
+	return n
+}
+
+func (m *EventSupplierDelegationUnbondingCanceled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Delegation != nil {
+		l = m.Delegation.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovEvent(uint64(m.Height))
+	}
+	if m.SessionEndHeight != 0 {
+		n += 1 + sovEvent(uint64(m.SessionEndHeight))
+	}
+	return n
+}
+
+func (m *EventSupplierDelegationCommissionUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.PrevDelegationCommissionPercentage != 0 {
+		n += 1 + sovEvent(uint64(m.PrevDelegationCommissionPercentage))
+	}
+	if m.NewDelegationCommissionPercentage != 0 {
+		n += 1 + sovEvent(uint64(m.NewDelegationCommissionPercentage))
+	}
+	if m.EffectiveBlockHeight != 0 {
+		n += 1 + sovEvent(uint64(m.EffectiveBlockHeight))
+	}
+	return n
+}
+
+func (m *EventSupplierProofFaultRecorded) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.ProofFault != nil {
+		l = m.ProofFault.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.NumProofFaults != 0 {
+		n += 1 + sovEvent(uint64(m.NumProofFaults))
+	}
+	return n
+}
+
+func (m *EventSupplierJailed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Supplier != nil {
+		l = m.Supplier.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.NumProofFaults != 0 {
+		n += 1 + sovEvent(uint64(m.NumProofFaults))
+	}
+	if m.SessionEndHeight != 0 {
+		n += 1 + sovEvent(uint64(m.SessionEndHeight))
+	}
+	if m.JailEndHeight != 0 {
+		n += 1 + sovEvent(uint64(m.JailEndHeight))
+	}
+	return n
+}
+
+func (m *EventSupplierUnjailed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Supplier != nil {
+		l = m.Supplier.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.SessionEndHeight != 0 {
+		n += 1 + sovEvent(uint64(m.SessionEndHeight))
+	}
+	return n
+}
+
+func sovEvent(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvent(x uint64) (n int) {
+	return sovEvent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventSupplierStaked) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSupplierStaked: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSupplierStaked: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supplier", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Supplier == nil {
+				m.Supplier = &types.Supplier{}
+			}
+			if err := m.Supplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionEndHeight", wireType)
+			}
+			m.SessionEndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SessionEndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSupplierUnbondingBegin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSupplierUnbondingBegin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSupplierUnbondingBegin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supplier", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Supplier == nil {
+				m.Supplier = &types.Supplier{}
+			}
+			if err := m.Supplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			m.Reason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reason |= SupplierUnbondingReason(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionEndHeight", wireType)
+			}
+			m.SessionEndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SessionEndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingEndHeight", wireType)
+			}
+			m.UnbondingEndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingEndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSupplierUnbondingEnd) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSupplierUnbondingEnd: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSupplierUnbondingEnd: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supplier", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Supplier == nil {
+				m.Supplier = &types.Supplier{}
+			}
+			if err := m.Supplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			m.Reason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reason |= SupplierUnbondingReason(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionEndHeight", wireType)
+			}
+			m.SessionEndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SessionEndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingEndHeight", wireType)
+			}
+			m.UnbondingEndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingEndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSupplierUnbondingCanceled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSupplierUnbondingCanceled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSupplierUnbondingCanceled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supplier", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Supplier == nil {
+				m.Supplier = &types.Supplier{}
+			}
+			if err := m.Supplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionEndHeight", wireType)
+			}
+			m.SessionEndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SessionEndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSupplierServiceConfigActivated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSupplierServiceConfigActivated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSupplierServiceConfigActivated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supplier", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Supplier == nil {
+				m.Supplier = &types.Supplier{}
+			}
+			if err := m.Supplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationHeight", wireType)
+			}
+			m.ActivationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSupplierDelegated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSupplierDelegated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSupplierDelegated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Delegation == nil {
+				m.Delegation = &Delegation{}
+			}
+			if err := m.Delegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionEndHeight", wireType)
+			}
+			m.SessionEndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SessionEndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSupplierDelegationUnbondingBegin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSupplierDelegationUnbondingBegin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSupplierDelegationUnbondingBegin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Delegation == nil {
+				m.Delegation = &Delegation{}
+			}
+			if err := m.Delegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionEndHeight", wireType)
+			}
+			m.SessionEndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SessionEndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingEndHeight", wireType)
+			}
+			m.UnbondingEndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingEndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *EventSupplierStaked) Unmarshal(dAtA []byte) error {
+func (m *EventSupplierDelegationUnbondingEnd) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -766,15 +2690,15 @@ func (m *EventSupplierStaked) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventSupplierStaked: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventSupplierDelegationUnbondingEnd: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSupplierStaked: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventSupplierDelegationUnbondingEnd: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Supplier", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegation", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -801,14 +2725,33 @@ func (m *EventSupplierStaked) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Supplier == nil {
-				m.Supplier = &types.Supplier{}
+			if m.Delegation == nil {
+				m.Delegation = &Delegation{}
 			}
-			if err := m.Supplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Delegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			m.Reason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reason |= DelegationUnbondingReason(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field SessionEndHeight", wireType)
 			}
@@ -827,6 +2770,25 @@ func (m *EventSupplierStaked) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingEndHeight", wireType)
+			}
+			m.UnbondingEndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingEndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -848,7 +2810,7 @@ func (m *EventSupplierStaked) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventSupplierUnbondingBegin) Unmarshal(dAtA []byte) error {
+func (m *EventSupplierDelegationUnbondingCanceled) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -871,15 +2833,15 @@ func (m *EventSupplierUnbondingBegin) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventSupplierUnbondingBegin: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventSupplierDelegationUnbondingCanceled: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSupplierUnbondingBegin: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventSupplierDelegationUnbondingCanceled: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Supplier", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegation", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -906,18 +2868,18 @@ func (m *EventSupplierUnbondingBegin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Supplier == nil {
-				m.Supplier = &types.Supplier{}
+			if m.Delegation == nil {
+				m.Delegation = &Delegation{}
 			}
-			if err := m.Supplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Delegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			m.Reason = 0
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -927,7 +2889,7 @@ func (m *EventSupplierUnbondingBegin) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Reason |= SupplierUnbondingReason(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -951,25 +2913,6 @@ func (m *EventSupplierUnbondingBegin) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingEndHeight", wireType)
-			}
-			m.UnbondingEndHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UnbondingEndHeight |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -991,7 +2934,7 @@ func (m *EventSupplierUnbondingBegin) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventSupplierUnbondingEnd) Unmarshal(dAtA []byte) error {
+func (m *EventSupplierDelegationCommissionUpdated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1014,17 +2957,17 @@ func (m *EventSupplierUnbondingEnd) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventSupplierUnbondingEnd: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventSupplierDelegationCommissionUpdated: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSupplierUnbondingEnd: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventSupplierDelegationCommissionUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Supplier", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1034,33 +2977,29 @@ func (m *EventSupplierUnbondingEnd) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Supplier == nil {
-				m.Supplier = &types.Supplier{}
-			}
-			if err := m.Supplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevDelegationCommissionPercentage", wireType)
 			}
-			m.Reason = 0
+			m.PrevDelegationCommissionPercentage = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1070,16 +3009,16 @@ func (m *EventSupplierUnbondingEnd) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Reason |= SupplierUnbondingReason(b&0x7F) << shift
+				m.PrevDelegationCommissionPercentage |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SessionEndHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewDelegationCommissionPercentage", wireType)
 			}
-			m.SessionEndHeight = 0
+			m.NewDelegationCommissionPercentage = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1089,16 +3028,16 @@ func (m *EventSupplierUnbondingEnd) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SessionEndHeight |= int64(b&0x7F) << shift
+				m.NewDelegationCommissionPercentage |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingEndHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveBlockHeight", wireType)
 			}
-			m.UnbondingEndHeight = 0
+			m.EffectiveBlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1108,7 +3047,7 @@ func (m *EventSupplierUnbondingEnd) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UnbondingEndHeight |= int64(b&0x7F) << shift
+				m.EffectiveBlockHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1134,7 +3073,7 @@ func (m *EventSupplierUnbondingEnd) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventSupplierUnbondingCanceled) Unmarshal(dAtA []byte) error {
+func (m *EventSupplierProofFaultRecorded) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1157,10 +3096,147 @@ func (m *EventSupplierUnbondingCanceled) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventSupplierUnbondingCanceled: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventSupplierProofFaultRecorded: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSupplierUnbondingCanceled: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventSupplierProofFaultRecorded: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofFault", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProofFault == nil {
+				m.ProofFault = &ProofFault{}
+			}
+			if err := m.ProofFault.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumProofFaults", wireType)
+			}
+			m.NumProofFaults = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumProofFaults |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSupplierJailed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSupplierJailed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSupplierJailed: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1200,6 +3276,25 @@ func (m *EventSupplierUnbondingCanceled) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumProofFaults", wireType)
+			}
+			m.NumProofFaults = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumProofFaults |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field SessionEndHeight", wireType)
 			}
@@ -1218,11 +3313,11 @@ func (m *EventSupplierUnbondingCanceled) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 3:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JailEndHeight", wireType)
 			}
-			m.Height = 0
+			m.JailEndHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1232,7 +3327,7 @@ func (m *EventSupplierUnbondingCanceled) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= int64(b&0x7F) << shift
+				m.JailEndHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1258,7 +3353,7 @@ func (m *EventSupplierUnbondingCanceled) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventSupplierServiceConfigActivated) Unmarshal(dAtA []byte) error {
+func (m *EventSupplierUnjailed) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1281,10 +3376,10 @@ func (m *EventSupplierServiceConfigActivated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventSupplierServiceConfigActivated: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventSupplierUnjailed: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSupplierServiceConfigActivated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventSupplierUnjailed: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1325,9 +3420,9 @@ func (m *EventSupplierServiceConfigActivated) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActivationHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionEndHeight", wireType)
 			}
-			m.ActivationHeight = 0
+			m.SessionEndHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1337,7 +3432,7 @@ func (m *EventSupplierServiceConfigActivated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ActivationHeight |= int64(b&0x7F) << shift
+				m.SessionEndHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
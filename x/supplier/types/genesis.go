@@ -11,7 +11,9 @@ import (
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		SupplierList: []sharedtypes.Supplier{},
+		SupplierList:            []sharedtypes.Supplier{},
+		DelegationList:          []Delegation{},
+		SupplierProofFaultsList: []SupplierProofFaults{},
 		// this line is used by starport scaffolding # genesis/types/default
 		Params: DefaultParams(),
 	}
@@ -44,7 +46,11 @@ func (gs GenesisState) Validate() error {
 		if err != nil {
 			return ErrSupplierInvalidStake.Wrapf("cannot parse stake amount for supplier %v; (%v)", supplier.Stake, err)
 		}
-		if stake.IsZero() || stake.IsNegative() {
+		if stake.IsNegative() {
+			return ErrSupplierInvalidStake.Wrapf("invalid stake amount for supplier: %v < 0", supplier.Stake)
+		}
+		// A supplier whose stake was fully slashed remains in the store until it unbonds.
+		if stake.IsZero() && !supplier.IsUnbonding() {
 			return ErrSupplierInvalidStake.Wrapf("invalid stake amount for supplier: %v <= 0", supplier.Stake)
 		}
 		if stake.Denom != "upokt" {
@@ -66,6 +72,60 @@ func (gs GenesisState) Validate() error {
 			return ErrSupplierInvalidAddress.Wrapf("invalid supplier operator address %v", err.Error())
 		}
 
+		// Validate the supplier delegation commission
+		if err := ValidateDelegationCommissionPercentage(supplier.DelegationCommissionPercentage); err != nil {
+			return err
+		}
+		if err := ValidateDelegationCommissionHistory(&supplier); err != nil {
+			return err
+		}
+
+		// Validate the supplier jail periods
+		if err := ValidateJailHistory(&supplier); err != nil {
+			return err
+		}
+	}
+
+	// Check that the delegations are valid, unique and delegated to genesis suppliers
+	delegationKeyMap := make(map[string]struct{})
+	for _, delegation := range gs.DelegationList {
+		if err := delegation.ValidateBasic(); err != nil {
+			return err
+		}
+
+		if _, ok := supplierOperatorAddrMap[string(SupplierOperatorKey(delegation.SupplierOperatorAddress))]; !ok {
+			return ErrSupplierNotFound.Wrapf(
+				"delegation from %q to unknown supplier %q",
+				delegation.DelegatorAddress, delegation.SupplierOperatorAddress,
+			)
+		}
+
+		delegationKey := string(DelegationKey(delegation.SupplierOperatorAddress, delegation.DelegatorAddress))
+		if _, ok := delegationKeyMap[delegationKey]; ok {
+			return fmt.Errorf("duplicated index for delegation")
+		}
+		delegationKeyMap[delegationKey] = struct{}{}
+	}
+
+	// Check that the proof faults are valid, unique and belong to genesis suppliers
+	proofFaultsKeyMap := make(map[string]struct{})
+	for _, supplierProofFaults := range gs.SupplierProofFaultsList {
+		if err := supplierProofFaults.ValidateBasic(); err != nil {
+			return err
+		}
+
+		supplierOperatorKey := string(SupplierOperatorKey(supplierProofFaults.OperatorAddress))
+		if _, ok := supplierOperatorAddrMap[supplierOperatorKey]; !ok {
+			return ErrSupplierNotFound.Wrapf(
+				"proof faults of unknown supplier %q",
+				supplierProofFaults.OperatorAddress,
+			)
+		}
+
+		if _, ok := proofFaultsKeyMap[supplierOperatorKey]; ok {
+			return fmt.Errorf("duplicated index for supplier proof faults")
+		}
+		proofFaultsKeyMap[supplierOperatorKey] = struct{}{}
 	}
 	// this line is used by starport scaffolding # genesis/types/validate
 
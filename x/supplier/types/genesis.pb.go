@@ -28,8 +28,10 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // GenesisState defines the supplier module's genesis state.
 type GenesisState struct {
 	// params defines all the parameters of the module.
-	Params       Params           `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	SupplierList []types.Supplier `protobuf:"bytes,2,rep,name=supplierList,proto3" json:"supplierList"`
+	Params                  Params                `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	SupplierList            []types.Supplier      `protobuf:"bytes,2,rep,name=supplierList,proto3" json:"supplierList"`
+	DelegationList          []Delegation          `protobuf:"bytes,3,rep,name=delegationList,proto3" json:"delegationList"`
+	SupplierProofFaultsList []SupplierProofFaults `protobuf:"bytes,4,rep,name=supplierProofFaultsList,proto3" json:"supplierProofFaultsList"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -75,6 +77,20 @@ func (m *GenesisState) GetSupplierList() []types.Supplier {
 	return nil
 }
 
+func (m *GenesisState) GetDelegationList() []Delegation {
+	if m != nil {
+		return m.DelegationList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSupplierProofFaultsList() []SupplierProofFaults {
+	if m != nil {
+		return m.SupplierProofFaultsList
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "pocket.supplier.GenesisState")
 }
@@ -82,24 +98,29 @@ func init() {
 func init() { proto.RegisterFile("pocket/supplier/genesis.proto", fileDescriptor_ecf6bad8548ed19e) }
 
 var fileDescriptor_ecf6bad8548ed19e = []byte{
-	// 262 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2d, 0xc8, 0x4f, 0xce,
-	0x4e, 0x2d, 0xd1, 0x2f, 0x2e, 0x2d, 0x28, 0xc8, 0xc9, 0x4c, 0x2d, 0xd2, 0x4f, 0x4f, 0xcd, 0x4b,
-	0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x87, 0x48, 0xeb, 0xc1, 0xa4,
-	0xa5, 0x04, 0x13, 0x73, 0x33, 0xf3, 0xf2, 0xf5, 0xc1, 0x24, 0x44, 0x8d, 0x94, 0x48, 0x7a, 0x7e,
-	0x7a, 0x3e, 0x98, 0xa9, 0x0f, 0x62, 0x41, 0x45, 0x65, 0xd0, 0x0d, 0x2e, 0x48, 0x2c, 0x4a, 0xcc,
-	0x2d, 0x46, 0x97, 0xcd, 0x48, 0x2c, 0x4a, 0x4d, 0x81, 0x2b, 0x82, 0xc8, 0x2a, 0xf5, 0x32, 0x72,
-	0xf1, 0xb8, 0x43, 0xdc, 0x11, 0x5c, 0x92, 0x58, 0x92, 0x2a, 0x64, 0xc5, 0xc5, 0x06, 0xd1, 0x2e,
-	0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0x6d, 0x24, 0xae, 0x87, 0xe6, 0x2e, 0xbd, 0x00, 0xb0, 0xb4, 0x13,
-	0xe7, 0x89, 0x7b, 0xf2, 0x0c, 0x2b, 0x9e, 0x6f, 0xd0, 0x62, 0x0c, 0x82, 0xea, 0x10, 0x72, 0xe4,
-	0xe2, 0x81, 0xa9, 0xf2, 0xc9, 0x2c, 0x2e, 0x91, 0x60, 0x52, 0x60, 0x46, 0x31, 0x01, 0xec, 0x02,
-	0xbd, 0x60, 0xa8, 0x12, 0x27, 0x16, 0x90, 0x09, 0x41, 0x28, 0x5a, 0x9c, 0xfc, 0x4f, 0x3c, 0x92,
-	0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc6, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c,
-	0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0xc3, 0xf4, 0xcc, 0x92,
-	0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0xfd, 0x82, 0xfc, 0xec, 0x12, 0xdd, 0xbc, 0xd4, 0x92,
-	0xf2, 0xfc, 0xa2, 0x6c, 0x30, 0xa7, 0x28, 0x3f, 0x27, 0x47, 0xbf, 0x02, 0x11, 0x0a, 0x25, 0x95,
-	0x05, 0xa9, 0xc5, 0x49, 0x6c, 0x60, 0x7f, 0x1a, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0x33, 0xb5,
-	0x57, 0x35, 0x7e, 0x01, 0x00, 0x00,
+	// 345 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0x41, 0x4b, 0xf3, 0x30,
+	0x18, 0xc7, 0x9b, 0x6d, 0x0c, 0xde, 0xbe, 0x43, 0xb1, 0x08, 0x1b, 0x53, 0xe3, 0x14, 0x0f, 0x22,
+	0xd8, 0xa2, 0xde, 0xbc, 0x39, 0x44, 0x11, 0x04, 0xc7, 0x76, 0xf3, 0x22, 0xd9, 0x96, 0x75, 0x61,
+	0x5d, 0x9f, 0x90, 0x64, 0xa8, 0xdf, 0xc2, 0x8f, 0xe1, 0xd1, 0x4f, 0xe0, 0x79, 0xc7, 0x1d, 0x77,
+	0x12, 0xe9, 0x0e, 0x7e, 0x0d, 0x69, 0x9a, 0x6e, 0xac, 0xc5, 0x4b, 0x49, 0xf3, 0xff, 0x3d, 0xbf,
+	0x24, 0xcf, 0x63, 0xef, 0x71, 0xe8, 0x8d, 0xa8, 0xf2, 0xe4, 0x84, 0xf3, 0x80, 0x51, 0xe1, 0xf9,
+	0x34, 0xa4, 0x92, 0x49, 0x97, 0x0b, 0x50, 0xe0, 0x6c, 0x26, 0xb1, 0x9b, 0xc6, 0xf5, 0x2d, 0x32,
+	0x66, 0x21, 0x78, 0xfa, 0x9b, 0x30, 0xf5, 0x6d, 0x1f, 0x7c, 0xd0, 0x4b, 0x2f, 0x5e, 0x99, 0xdd,
+	0x46, 0x56, 0xdc, 0xa7, 0x01, 0xf5, 0x89, 0x62, 0x10, 0x1a, 0x62, 0x37, 0x4b, 0x70, 0x22, 0xc8,
+	0xd8, 0x9c, 0x5c, 0x3f, 0xc8, 0xa5, 0x02, 0x60, 0xf0, 0x34, 0x20, 0x93, 0x40, 0x65, 0x05, 0x43,
+	0x22, 0x68, 0x7f, 0x49, 0x26, 0xe9, 0xe1, 0x67, 0xc1, 0xae, 0xdc, 0x26, 0x8f, 0xe9, 0x28, 0xa2,
+	0xa8, 0x73, 0x69, 0x97, 0x93, 0x13, 0x6a, 0xa8, 0x81, 0x8e, 0xff, 0x9f, 0x57, 0xdd, 0xcc, 0xe3,
+	0xdc, 0x96, 0x8e, 0x9b, 0xff, 0xa6, 0x5f, 0xfb, 0xd6, 0xfb, 0xcf, 0xc7, 0x09, 0x6a, 0x9b, 0x0a,
+	0xe7, 0xca, 0xae, 0xa4, 0xd4, 0x3d, 0x93, 0xaa, 0x56, 0x68, 0x14, 0xd7, 0x0c, 0xfa, 0x06, 0x6e,
+	0xc7, 0x20, 0xcd, 0x52, 0x6c, 0x68, 0xaf, 0x95, 0x38, 0x77, 0xf6, 0xc6, 0xaa, 0x05, 0x5a, 0x52,
+	0xd4, 0x92, 0x9d, 0xdc, 0x35, 0xae, 0x97, 0x98, 0x11, 0x65, 0x0a, 0x9d, 0xbe, 0x5d, 0x4d, 0xe1,
+	0x56, 0xdc, 0x95, 0x9b, 0xb8, 0x29, 0x52, 0x3b, 0x4b, 0xda, 0x79, 0x94, 0x73, 0x76, 0xf2, 0xbc,
+	0x91, 0xff, 0xa5, 0x6a, 0x3e, 0x4c, 0x23, 0x8c, 0x66, 0x11, 0x46, 0xf3, 0x08, 0xa3, 0xef, 0x08,
+	0xa3, 0xb7, 0x05, 0xb6, 0x66, 0x0b, 0x6c, 0xcd, 0x17, 0xd8, 0x7a, 0x3c, 0xf3, 0x99, 0x1a, 0x4e,
+	0xba, 0x6e, 0x0f, 0xc6, 0x1e, 0x87, 0x91, 0x3a, 0x0d, 0xa9, 0x7a, 0x06, 0x31, 0xd2, 0x3f, 0x02,
+	0x82, 0xc0, 0x7b, 0x59, 0xcd, 0x4e, 0xbd, 0x72, 0x2a, 0xbb, 0x65, 0x3d, 0x98, 0x8b, 0xdf, 0x01,
+	0x00, 0xc2, 0x76, 0xdc, 0x10, 0x74, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -122,6 +143,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SupplierProofFaultsList) > 0 {
+		for iNdEx := len(m.SupplierProofFaultsList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SupplierProofFaultsList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.DelegationList) > 0 {
+		for iNdEx := len(m.DelegationList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegationList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.SupplierList) > 0 {
 		for iNdEx := len(m.SupplierList) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -174,6 +223,18 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.DelegationList) > 0 {
+		for _, e := range m.DelegationList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SupplierProofFaultsList) > 0 {
+		for _, e := range m.SupplierProofFaultsList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -279,6 +340,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegationList = append(m.DelegationList, Delegation{})
+			if err := m.DelegationList[len(m.DelegationList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplierProofFaultsList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SupplierProofFaultsList = append(m.SupplierProofFaultsList, SupplierProofFaults{})
+			if err := m.SupplierProofFaultsList[len(m.SupplierProofFaultsList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -53,6 +53,12 @@ func TestGenesisState_Validate(t *testing.T) {
 	}
 	serviceList2 := []*sharedtypes.SupplierServiceConfig{serviceConfig2}
 
+	delegatorAddr := sample.AccAddress()
+	delegationStake := sdk.NewCoin("upokt", math.NewInt(50))
+	zeroStake := sdk.NewCoin("upokt", math.NewInt(0))
+
+	proofFault := types.ProofFault{SessionId: "session_id", SessionEndHeight: 10}
+
 	tests := []struct {
 		desc     string
 		genState *types.GenesisState
@@ -106,6 +112,22 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			isValid: false,
 		},
+		{
+			desc: "valid - zero stake of a fully slashed unbonding supplier",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				SupplierList: []sharedtypes.Supplier{
+					{
+						OwnerAddress:            addr1,
+						OperatorAddress:         addr1,
+						Stake:                   &zeroStake,
+						Services:                serviceList1,
+						UnstakeSessionEndHeight: 10,
+					},
+				},
+			},
+			isValid: true,
+		},
 		{
 			desc: "invalid - negative supplier stake",
 			genState: &types.GenesisState{
@@ -350,6 +372,342 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			isValid: false,
 		},
+		{
+			desc: "valid - supplier delegation",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				SupplierList: []sharedtypes.Supplier{
+					{
+						OwnerAddress:    addr1,
+						OperatorAddress: addr1,
+						Stake:           &stake1,
+						Services:        serviceList1,
+					},
+				},
+				DelegationList: []types.Delegation{
+					{
+						DelegatorAddress:        delegatorAddr,
+						SupplierOperatorAddress: addr1,
+						Stake:                   &delegationStake,
+					},
+				},
+			},
+			isValid: true,
+		},
+		{
+			desc: "invalid - supplier delegation commission above 100%",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				SupplierList: []sharedtypes.Supplier{
+					{
+						OwnerAddress:                   addr1,
+						OperatorAddress:                addr1,
+						Stake:                          &stake1,
+						Services:                       serviceList1,
+						DelegationCommissionPercentage: 101,
+					},
+				},
+			},
+			isValid: false,
+		},
+		{
+			desc: "valid - supplier delegation commission history",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				SupplierList: []sharedtypes.Supplier{
+					{
+						OwnerAddress:                   addr1,
+						OperatorAddress:                addr1,
+						Stake:                          &stake1,
+						Services:                       serviceList1,
+						DelegationCommissionPercentage: 20,
+						DelegationCommissionHistory: []*sharedtypes.SupplierDelegationCommissionUpdate{
+							{DelegationCommissionPercentage: 10, EffectiveBlockHeight: 0},
+							{DelegationCommissionPercentage: 20, EffectiveBlockHeight: 11},
+						},
+					},
+				},
+			},
+			isValid: true,
+		},
+		{
+			desc: "valid - supplier jailed again after being unjailed",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				SupplierList: []sharedtypes.Supplier{
+					{
+						OwnerAddress:    addr1,
+						OperatorAddress: addr1,
+						Stake:           &stake1,
+						Services:        serviceList1,
+						JailHistory: []*sharedtypes.SupplierJailPeriod{
+							{JailStartHeight: 5, JailEndHeight: 8, UnjailHeight: 13},
+							{JailStartHeight: 17, JailEndHeight: 20},
+						},
+					},
+				},
+			},
+			isValid: true,
+		},
+		{
+			desc: "invalid - supplier jail period pending before the latest one",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				SupplierList: []sharedtypes.Supplier{
+					{
+						OwnerAddress:    addr1,
+						OperatorAddress: addr1,
+						Stake:           &stake1,
+						Services:        serviceList1,
+						JailHistory: []*sharedtypes.SupplierJailPeriod{
+							{JailStartHeight: 5, JailEndHeight: 8},
+							{JailStartHeight: 17, JailEndHeight: 20},
+						},
+					},
+				},
+			},
+			isValid: false,
+		},
+		{
+			desc: "invalid - supplier jail periods overlap",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				SupplierList: []sharedtypes.Supplier{
+					{
+						OwnerAddress:    addr1,
+						OperatorAddress: addr1,
+						Stake:           &stake1,
+						Services:        serviceList1,
+						JailHistory: []*sharedtypes.SupplierJailPeriod{
+							{JailStartHeight: 5, JailEndHeight: 8, UnjailHeight: 13},
+							{JailStartHeight: 9, JailEndHeight: 12},
+						},
+					},
+				},
+			},
+			isValid: false,
+		},
+		{
+			desc: "invalid - supplier delegation commission history not ordered by effective height",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				SupplierList: []sharedtypes.Supplier{
+					{
+						OwnerAddress:                   addr1,
+						OperatorAddress:                addr1,
+						Stake:                          &stake1,
+						Services:                       serviceList1,
+						DelegationCommissionPercentage: 20,
+						DelegationCommissionHistory: []*sharedtypes.SupplierDelegationCommissionUpdate{
+							{DelegationCommissionPercentage: 10, EffectiveBlockHeight: 11},
+							{DelegationCommissionPercentage: 20, EffectiveBlockHeight: 11},
+						},
+					},
+				},
+			},
+			isValid: false,
+		},
+		{
+			desc: "invalid - supplier delegation commission history does not end with the supplier commission",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				SupplierList: []sharedtypes.Supplier{
+					{
+						OwnerAddress:                   addr1,
+						OperatorAddress:                addr1,
+						Stake:                          &stake1,
+						Services:                       serviceList1,
+						DelegationCommissionPercentage: 30,
+						DelegationCommissionHistory: []*sharedtypes.SupplierDelegationCommissionUpdate{
+							{DelegationCommissionPercentage: 10, EffectiveBlockHeight: 0},
+							{DelegationCommissionPercentage: 20, EffectiveBlockHeight: 11},
+						},
+					},
+				},
+			},
+			isValid: false,
+		},
+		{
+			desc: "invalid - delegation to unknown supplier",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				SupplierList: []sharedtypes.Supplier{
+					{
+						OwnerAddress:    addr1,
+						OperatorAddress: addr1,
+						Stake:           &stake1,
+						Services:        serviceList1,
+					},
+				},
+				DelegationList: []types.Delegation{
+					{
+						DelegatorAddress:        delegatorAddr,
+						SupplierOperatorAddress: addr2,
+						Stake:                   &delegationStake,
+					},
+				},
+			},
+			isValid: false,
+		},
+		{
+			desc: "invalid - duplicated delegation",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				SupplierList: []sharedtypes.Supplier{
+					{
+						OwnerAddress:    addr1,
+						OperatorAddress: addr1,
+						Stake:           &stake1,
+						Services:        serviceList1,
+					},
+				},
+				DelegationList: []types.Delegation{
+					{
+						DelegatorAddress:        delegatorAddr,
+						SupplierOperatorAddress: addr1,
+						Stake:                   &delegationStake,
+					},
+					{
+						DelegatorAddress:        delegatorAddr,
+						SupplierOperatorAddress: addr1,
+						Stake:                   &delegationStake,
+					},
+				},
+			},
+			isValid: false,
+		},
+		{
+			desc: "invalid - zero delegation stake",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				SupplierList: []sharedtypes.Supplier{
+					{
+						OwnerAddress:    addr1,
+						OperatorAddress: addr1,
+						Stake:           &stake1,
+						Services:        serviceList1,
+					},
+				},
+				DelegationList: []types.Delegation{
+					{
+						DelegatorAddress:        delegatorAddr,
+						SupplierOperatorAddress: addr1,
+						Stake:                   &zeroStake,
+					},
+				},
+			},
+			isValid: false,
+		},
+		{
+			desc: "invalid - invalid delegator address",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				SupplierList: []sharedtypes.Supplier{
+					{
+						OwnerAddress:    addr1,
+						OperatorAddress: addr1,
+						Stake:           &stake1,
+						Services:        serviceList1,
+					},
+				},
+				DelegationList: []types.Delegation{
+					{
+						DelegatorAddress:        "invalid_address",
+						SupplierOperatorAddress: addr1,
+						Stake:                   &delegationStake,
+					},
+				},
+			},
+			isValid: false,
+		},
+		{
+			desc: "valid - supplier proof faults",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				SupplierList: []sharedtypes.Supplier{
+					{
+						OwnerAddress:    addr1,
+						OperatorAddress: addr1,
+						Stake:           &stake1,
+						Services:        serviceList1,
+					},
+				},
+				SupplierProofFaultsList: []types.SupplierProofFaults{
+					{
+						OperatorAddress: addr1,
+						ProofFaults:     []types.ProofFault{proofFault},
+					},
+				},
+			},
+			isValid: true,
+		},
+		{
+			desc: "invalid - proof faults of unknown supplier",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				SupplierList: []sharedtypes.Supplier{
+					{
+						OwnerAddress:    addr1,
+						OperatorAddress: addr1,
+						Stake:           &stake1,
+						Services:        serviceList1,
+					},
+				},
+				SupplierProofFaultsList: []types.SupplierProofFaults{
+					{
+						OperatorAddress: addr2,
+						ProofFaults:     []types.ProofFault{proofFault},
+					},
+				},
+			},
+			isValid: false,
+		},
+		{
+			desc: "invalid - duplicated supplier proof faults",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				SupplierList: []sharedtypes.Supplier{
+					{
+						OwnerAddress:    addr1,
+						OperatorAddress: addr1,
+						Stake:           &stake1,
+						Services:        serviceList1,
+					},
+				},
+				SupplierProofFaultsList: []types.SupplierProofFaults{
+					{
+						OperatorAddress: addr1,
+						ProofFaults:     []types.ProofFault{proofFault},
+					},
+					{
+						OperatorAddress: addr1,
+						ProofFaults:     []types.ProofFault{proofFault},
+					},
+				},
+			},
+			isValid: false,
+		},
+		{
+			desc: "invalid - invalid proof faults operator address",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				SupplierList: []sharedtypes.Supplier{
+					{
+						OwnerAddress:    addr1,
+						OperatorAddress: addr1,
+						Stake:           &stake1,
+						Services:        serviceList1,
+					},
+				},
+				SupplierProofFaultsList: []types.SupplierProofFaults{
+					{
+						OperatorAddress: "invalid_address",
+						ProofFaults:     []types.ProofFault{proofFault},
+					},
+				},
+			},
+			isValid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	}
 	for _, test := range tests {
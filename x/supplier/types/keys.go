@@ -31,10 +31,22 @@ package types
 // │                                             <ServiceID>/                           │
 // │                                             <SupplierAddr>/                        │
 // │                                             <ActHeight>/                           │
+// │                                                                                    │
+// │ DelegationKey()                          Delegation/operator_address/              │
+// │                                         └── <SupplierAddr>/                        │
+// │                                             <DelegatorAddr>/                       │
+// │                                                                                    │
+// │ DelegationUnstakingHeightKeyPrefix +     Delegation/unbonding_height/              │
+// │                                         └── <SupplierAddr>/                        │
+// │                                             <DelegatorAddr>/                       │
+// │                                                                                    │
+// │ SupplierProofFaultsKeyPrefix +           SupplierProofFaults/operator_address/     │
+// │                                         └── <SupplierAddr>/                        │
 // └────────────────────────────────────────────────────────────────────────────────────┘
 //
 // Legend
 //   • <SupplierAddr>     : UTF-8 bytes of bech-32/hex operator address.
+//   • <DelegatorAddr>    : UTF-8 bytes of bech-32 delegator address.
 //   • <ServiceID>        : UTF-8 bytes of service identifier.
 //   • <ActHeight>        : 8-byte big-endian encoded activation height.
 //   • <DeactHeight>      : 8-byte big-endian encoded deactivation height.
@@ -81,6 +93,15 @@ const (
 
 	// ServiceConfigUpdateDeactivationHeightKeyPrefix is the prefix for indexing service configs by deactivation height
 	ServiceConfigUpdateDeactivationHeightKeyPrefix = "ServiceConfigUpdate/deactivation_height/"
+
+	// DelegationKeyPrefix is the prefix to retrieve all Delegations, indexed by supplier operator address
+	DelegationKeyPrefix = "Delegation/operator_address/"
+
+	// DelegationUnstakingHeightKeyPrefix is the prefix for indexing the delegations which are unbonding
+	DelegationUnstakingHeightKeyPrefix = "Delegation/unbonding_height/"
+
+	// SupplierProofFaultsKeyPrefix is the prefix to retrieve all SupplierProofFaults, indexed by supplier operator address
+	SupplierProofFaultsKeyPrefix = "SupplierProofFaults/operator_address/"
 )
 
 // SupplierOperatorKey returns the store key to retrieve a Supplier from the index fields
@@ -102,6 +123,21 @@ func SupplierOperatorServiceKey(supplierOperatorAddr string, serviceId string) [
 	return key
 }
 
+// DelegationKey returns the store key to retrieve a Delegation from the index fields
+// The key is composed of supplier operator address and delegator address
+// This ordering allows efficient range queries for all the delegations to a supplier
+func DelegationKey(supplierOperatorAddr string, delegatorAddr string) []byte {
+	var key []byte
+
+	supplierOperatorAddressKey := StringKey(supplierOperatorAddr)
+	key = append(key, supplierOperatorAddressKey...)
+
+	delegatorAddressKey := StringKey(delegatorAddr)
+	key = append(key, delegatorAddressKey...)
+
+	return key
+}
+
 // ServiceConfigUpdateKey returns the store key to retrieve a ServiceConfig from the index fields
 // The key is composed of service ID, activation height, and supplier operator address
 // This ordering allows efficient range queries for configurations by service ID and activation height
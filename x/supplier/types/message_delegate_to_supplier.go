@@ -0,0 +1,31 @@
+package types
+
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+const TypeMsgDelegateToSupplier = "delegate_to_supplier"
+
+var _ sdk.Msg = (*MsgDelegateToSupplier)(nil)
+
+func NewMsgDelegateToSupplier(
+	delegatorAddress string,
+	supplierOperatorAddress string,
+	amount sdk.Coin,
+) *MsgDelegateToSupplier {
+	return &MsgDelegateToSupplier{
+		DelegatorAddress:        delegatorAddress,
+		SupplierOperatorAddress: supplierOperatorAddress,
+		Amount:                  &amount,
+	}
+}
+
+func (msg *MsgDelegateToSupplier) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.DelegatorAddress); err != nil {
+		return ErrSupplierInvalidAddress.Wrapf("invalid delegator address (%s)", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.SupplierOperatorAddress); err != nil {
+		return ErrSupplierInvalidAddress.Wrapf("invalid supplier operator address (%s)", err)
+	}
+
+	return ValidateDelegationAmount(msg.Amount)
+}
@@ -0,0 +1,87 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/poktroll/app/volatile"
+	"github.com/pokt-network/poktroll/testutil/sample"
+)
+
+func TestMsgDelegateToSupplier_ValidateBasic(t *testing.T) {
+	delegatorAddress := sample.AccAddress()
+	operatorAddress := sample.AccAddress()
+	validAmount := sdk.NewInt64Coin(volatile.DenomuPOKT, 100)
+	zeroAmount := sdk.NewInt64Coin(volatile.DenomuPOKT, 0)
+	invalidDenomAmount := sdk.NewInt64Coin("invalid", 100)
+
+	tests := []struct {
+		desc        string
+		msg         MsgDelegateToSupplier
+		expectedErr error
+	}{
+		{
+			desc: "invalid delegator address",
+			msg: MsgDelegateToSupplier{
+				DelegatorAddress:        "invalid_address",
+				SupplierOperatorAddress: operatorAddress,
+				Amount:                  &validAmount,
+			},
+			expectedErr: ErrSupplierInvalidAddress,
+		},
+		{
+			desc: "missing supplier operator address",
+			msg: MsgDelegateToSupplier{
+				DelegatorAddress: delegatorAddress,
+				Amount:           &validAmount,
+			},
+			expectedErr: ErrSupplierInvalidAddress,
+		},
+		{
+			desc: "missing amount",
+			msg: MsgDelegateToSupplier{
+				DelegatorAddress:        delegatorAddress,
+				SupplierOperatorAddress: operatorAddress,
+			},
+			expectedErr: ErrSupplierInvalidDelegation,
+		},
+		{
+			desc: "zero amount",
+			msg: MsgDelegateToSupplier{
+				DelegatorAddress:        delegatorAddress,
+				SupplierOperatorAddress: operatorAddress,
+				Amount:                  &zeroAmount,
+			},
+			expectedErr: ErrSupplierInvalidDelegation,
+		},
+		{
+			desc: "invalid amount denom",
+			msg: MsgDelegateToSupplier{
+				DelegatorAddress:        delegatorAddress,
+				SupplierOperatorAddress: operatorAddress,
+				Amount:                  &invalidDenomAmount,
+			},
+			expectedErr: ErrSupplierInvalidDelegation,
+		},
+		{
+			desc: "valid message",
+			msg: MsgDelegateToSupplier{
+				DelegatorAddress:        delegatorAddress,
+				SupplierOperatorAddress: operatorAddress,
+				Amount:                  &validAmount,
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			err := test.msg.ValidateBasic()
+			if test.expectedErr != nil {
+				require.ErrorIs(t, err, test.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
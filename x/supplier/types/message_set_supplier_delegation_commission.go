@@ -0,0 +1,31 @@
+package types
+
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+const TypeMsgSetSupplierDelegationCommission = "set_supplier_delegation_commission"
+
+var _ sdk.Msg = (*MsgSetSupplierDelegationCommission)(nil)
+
+func NewMsgSetSupplierDelegationCommission(
+	ownerAddress string,
+	operatorAddress string,
+	delegationCommissionPercentage uint64,
+) *MsgSetSupplierDelegationCommission {
+	return &MsgSetSupplierDelegationCommission{
+		OwnerAddress:                   ownerAddress,
+		OperatorAddress:                operatorAddress,
+		DelegationCommissionPercentage: delegationCommissionPercentage,
+	}
+}
+
+func (msg *MsgSetSupplierDelegationCommission) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.OwnerAddress); err != nil {
+		return ErrSupplierInvalidAddress.Wrapf("invalid owner address (%s)", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.OperatorAddress); err != nil {
+		return ErrSupplierInvalidAddress.Wrapf("invalid operator address (%s)", err)
+	}
+
+	return ValidateDelegationCommissionPercentage(msg.DelegationCommissionPercentage)
+}
@@ -0,0 +1,72 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/poktroll/testutil/sample"
+)
+
+func TestMsgSetSupplierDelegationCommission_ValidateBasic(t *testing.T) {
+	ownerAddress := sample.AccAddress()
+	operatorAddress := sample.AccAddress()
+
+	tests := []struct {
+		desc        string
+		msg         MsgSetSupplierDelegationCommission
+		expectedErr error
+	}{
+		{
+			desc: "invalid owner address",
+			msg: MsgSetSupplierDelegationCommission{
+				OwnerAddress:                   "invalid_address",
+				OperatorAddress:                operatorAddress,
+				DelegationCommissionPercentage: 10,
+			},
+			expectedErr: ErrSupplierInvalidAddress,
+		},
+		{
+			desc: "missing operator address",
+			msg: MsgSetSupplierDelegationCommission{
+				OwnerAddress:                   ownerAddress,
+				DelegationCommissionPercentage: 10,
+			},
+			expectedErr: ErrSupplierInvalidAddress,
+		},
+		{
+			desc: "commission above 100%",
+			msg: MsgSetSupplierDelegationCommission{
+				OwnerAddress:                   ownerAddress,
+				OperatorAddress:                operatorAddress,
+				DelegationCommissionPercentage: 101,
+			},
+			expectedErr: ErrSupplierInvalidDelegationCommission,
+		},
+		{
+			desc: "valid message - zero commission",
+			msg: MsgSetSupplierDelegationCommission{
+				OwnerAddress:    ownerAddress,
+				OperatorAddress: operatorAddress,
+			},
+		},
+		{
+			desc: "valid message - 100% commission",
+			msg: MsgSetSupplierDelegationCommission{
+				OwnerAddress:                   ownerAddress,
+				OperatorAddress:                operatorAddress,
+				DelegationCommissionPercentage: 100,
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			err := test.msg.ValidateBasic()
+			if test.expectedErr != nil {
+				require.ErrorIs(t, err, test.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,26 @@
+package types
+
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+const TypeMsgUndelegateFromSupplier = "undelegate_from_supplier"
+
+var _ sdk.Msg = (*MsgUndelegateFromSupplier)(nil)
+
+func NewMsgUndelegateFromSupplier(delegatorAddress, supplierOperatorAddress string) *MsgUndelegateFromSupplier {
+	return &MsgUndelegateFromSupplier{
+		DelegatorAddress:        delegatorAddress,
+		SupplierOperatorAddress: supplierOperatorAddress,
+	}
+}
+
+func (msg *MsgUndelegateFromSupplier) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.DelegatorAddress); err != nil {
+		return ErrSupplierInvalidAddress.Wrapf("invalid delegator address (%s)", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.SupplierOperatorAddress); err != nil {
+		return ErrSupplierInvalidAddress.Wrapf("invalid supplier operator address (%s)", err)
+	}
+
+	return nil
+}
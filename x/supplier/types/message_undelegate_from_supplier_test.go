@@ -0,0 +1,61 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/poktroll/testutil/sample"
+)
+
+func TestMsgUndelegateFromSupplier_ValidateBasic(t *testing.T) {
+	delegatorAddress := sample.AccAddress()
+	operatorAddress := sample.AccAddress()
+
+	tests := []struct {
+		desc        string
+		msg         MsgUndelegateFromSupplier
+		expectedErr error
+	}{
+		{
+			desc: "invalid delegator address",
+			msg: MsgUndelegateFromSupplier{
+				DelegatorAddress:        "invalid_address",
+				SupplierOperatorAddress: operatorAddress,
+			},
+			expectedErr: ErrSupplierInvalidAddress,
+		},
+		{
+			desc: "missing delegator address",
+			msg: MsgUndelegateFromSupplier{
+				SupplierOperatorAddress: operatorAddress,
+			},
+			expectedErr: ErrSupplierInvalidAddress,
+		},
+		{
+			desc: "invalid supplier operator address",
+			msg: MsgUndelegateFromSupplier{
+				DelegatorAddress:        delegatorAddress,
+				SupplierOperatorAddress: "invalid_address",
+			},
+			expectedErr: ErrSupplierInvalidAddress,
+		},
+		{
+			desc: "valid message",
+			msg: MsgUndelegateFromSupplier{
+				DelegatorAddress:        delegatorAddress,
+				SupplierOperatorAddress: operatorAddress,
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			err := test.msg.ValidateBasic()
+			if test.expectedErr != nil {
+				require.ErrorIs(t, err, test.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,26 @@
+package types
+
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+const TypeMsgUnjailSupplier = "unjail_supplier"
+
+var _ sdk.Msg = (*MsgUnjailSupplier)(nil)
+
+func NewMsgUnjailSupplier(signerAddress, operatorAddress string) *MsgUnjailSupplier {
+	return &MsgUnjailSupplier{
+		Signer:          signerAddress,
+		OperatorAddress: operatorAddress,
+	}
+}
+
+func (msg *MsgUnjailSupplier) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return ErrSupplierInvalidAddress.Wrapf("invalid signer address (%s)", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.OperatorAddress); err != nil {
+		return ErrSupplierInvalidAddress.Wrapf("invalid operator address (%s)", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,67 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/poktroll/testutil/sample"
+)
+
+func TestMsgUnjailSupplier_ValidateBasic(t *testing.T) {
+	signerAddress := sample.AccAddress()
+	operatorAddress := sample.AccAddress()
+	tests := []struct {
+		desc        string
+		msg         MsgUnjailSupplier
+		expectedErr error
+	}{
+		{
+			desc: "invalid operator address",
+			msg: MsgUnjailSupplier{
+				Signer:          signerAddress,
+				OperatorAddress: "invalid_address",
+			},
+			expectedErr: ErrSupplierInvalidAddress,
+		},
+		{
+			desc: "missing operator address",
+			msg: MsgUnjailSupplier{
+				Signer: signerAddress,
+			},
+			expectedErr: ErrSupplierInvalidAddress,
+		},
+		{
+			desc: "invalid signer address",
+			msg: MsgUnjailSupplier{
+				Signer:          "invalid_address",
+				OperatorAddress: operatorAddress,
+			},
+			expectedErr: ErrSupplierInvalidAddress,
+		},
+		{
+			desc: "valid message",
+			msg: MsgUnjailSupplier{
+				Signer:          signerAddress,
+				OperatorAddress: operatorAddress,
+			},
+		},
+		{
+			desc: "valid message - same signer and operator addresses",
+			msg: MsgUnjailSupplier{
+				Signer:          operatorAddress,
+				OperatorAddress: operatorAddress,
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			err := test.msg.ValidateBasic()
+			if test.expectedErr != nil {
+				require.ErrorIs(t, err, test.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -16,6 +16,8 @@ func NewMsgUpdateParam(authority string, name string, asType any) *MsgUpdatePara
 	switch t := asType.(type) {
 	case *cosmostypes.Coin:
 		asTypeIface = &MsgUpdateParam_AsCoin{AsCoin: t}
+	case uint64:
+		asTypeIface = &MsgUpdateParam_AsUint64{AsUint64: t}
 	default:
 		panic(fmt.Sprintf("unexpected param value type: %T", asType))
 	}
@@ -54,6 +56,26 @@ func (msg *MsgUpdateParam) ValidateBasic() error {
 			return err
 		}
 		return ValidateStakingFee(msg.GetAsCoin())
+	case ParamProofFaultWindowSessions:
+		if err := genericParamTypeIs[*MsgUpdateParam_AsUint64](msg); err != nil {
+			return err
+		}
+		return ValidateProofFaultWindowSessions(msg.GetAsUint64())
+	case ParamProofFaultPenaltyEscalationPercentage:
+		if err := genericParamTypeIs[*MsgUpdateParam_AsUint64](msg); err != nil {
+			return err
+		}
+		return ValidateProofFaultPenaltyEscalationPercentage(msg.GetAsUint64())
+	case ParamProofFaultJailingThreshold:
+		if err := genericParamTypeIs[*MsgUpdateParam_AsUint64](msg); err != nil {
+			return err
+		}
+		return ValidateProofFaultJailingThreshold(msg.GetAsUint64())
+	case ParamJailDurationSessions:
+		if err := genericParamTypeIs[*MsgUpdateParam_AsUint64](msg); err != nil {
+			return err
+		}
+		return ValidateJailDurationSessions(msg.GetAsUint64())
 	default:
 		return ErrSupplierParamInvalid.Wrapf("unsupported param %q", msg.Name)
 	}
@@ -38,6 +38,21 @@ func TestMsgUpdateParam_ValidateBasic(t *testing.T) {
 				Name:      ParamMinStake,
 				AsType:    &MsgUpdateParam_AsCoin{AsCoin: &DefaultMinStake},
 			},
+		}, {
+			name: "invalid: jail_duration_sessions is zero",
+			msg: MsgUpdateParam{
+				Authority: sample.AccAddress(),
+				Name:      ParamJailDurationSessions,
+				AsType:    &MsgUpdateParam_AsUint64{AsUint64: 0},
+			},
+			err: ErrSupplierParamInvalid,
+		}, {
+			name: "valid: uint64 param",
+			msg: MsgUpdateParam{
+				Authority: sample.AccAddress(),
+				Name:      ParamProofFaultJailingThreshold,
+				AsType:    &MsgUpdateParam_AsUint64{AsUint64: 5},
+			},
 		},
 	}
 	for _, tt := range tests {
@@ -18,6 +18,22 @@ var (
 	ParamStakingFee = "staking_fee"
 	// TODO_MAINNET_MIGRATION(@olshansk): Determine the default value.
 	DefaultStakingFee = cosmostypes.NewInt64Coin("upokt", 1) // 1 uPOKT
+
+	KeyProofFaultWindowSessions     = []byte("ProofFaultWindowSessions")
+	ParamProofFaultWindowSessions   = "proof_fault_window_sessions"
+	DefaultProofFaultWindowSessions = uint64(10)
+
+	KeyProofFaultPenaltyEscalationPercentage     = []byte("ProofFaultPenaltyEscalationPercentage")
+	ParamProofFaultPenaltyEscalationPercentage   = "proof_fault_penalty_escalation_percentage"
+	DefaultProofFaultPenaltyEscalationPercentage = uint64(100)
+
+	KeyProofFaultJailingThreshold     = []byte("ProofFaultJailingThreshold")
+	ParamProofFaultJailingThreshold   = "proof_fault_jailing_threshold"
+	DefaultProofFaultJailingThreshold = uint64(3)
+
+	KeyJailDurationSessions     = []byte("JailDurationSessions")
+	ParamJailDurationSessions   = "jail_duration_sessions"
+	DefaultJailDurationSessions = uint64(4)
 )
 
 // ParamKeyTable the param key table for launch module
@@ -29,10 +45,18 @@ func ParamKeyTable() paramtypes.KeyTable {
 func NewParams(
 	minStake *cosmostypes.Coin,
 	stakingFee *cosmostypes.Coin,
+	proofFaultWindowSessions uint64,
+	proofFaultPenaltyEscalationPercentage uint64,
+	proofFaultJailingThreshold uint64,
+	jailDurationSessions uint64,
 ) Params {
 	return Params{
-		MinStake:   minStake,
-		StakingFee: stakingFee,
+		MinStake:                              minStake,
+		StakingFee:                            stakingFee,
+		ProofFaultWindowSessions:              proofFaultWindowSessions,
+		ProofFaultPenaltyEscalationPercentage: proofFaultPenaltyEscalationPercentage,
+		ProofFaultJailingThreshold:            proofFaultJailingThreshold,
+		JailDurationSessions:                  jailDurationSessions,
 	}
 }
 
@@ -41,6 +65,10 @@ func DefaultParams() Params {
 	return NewParams(
 		&DefaultMinStake,
 		&DefaultStakingFee,
+		DefaultProofFaultWindowSessions,
+		DefaultProofFaultPenaltyEscalationPercentage,
+		DefaultProofFaultJailingThreshold,
+		DefaultJailDurationSessions,
 	)
 }
 
@@ -57,6 +85,26 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 			&p.StakingFee,
 			ValidateStakingFee,
 		),
+		paramtypes.NewParamSetPair(
+			KeyProofFaultWindowSessions,
+			&p.ProofFaultWindowSessions,
+			ValidateProofFaultWindowSessions,
+		),
+		paramtypes.NewParamSetPair(
+			KeyProofFaultPenaltyEscalationPercentage,
+			&p.ProofFaultPenaltyEscalationPercentage,
+			ValidateProofFaultPenaltyEscalationPercentage,
+		),
+		paramtypes.NewParamSetPair(
+			KeyProofFaultJailingThreshold,
+			&p.ProofFaultJailingThreshold,
+			ValidateProofFaultJailingThreshold,
+		),
+		paramtypes.NewParamSetPair(
+			KeyJailDurationSessions,
+			&p.JailDurationSessions,
+			ValidateJailDurationSessions,
+		),
 	}
 }
 
@@ -70,6 +118,22 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := ValidateProofFaultWindowSessions(p.ProofFaultWindowSessions); err != nil {
+		return err
+	}
+
+	if err := ValidateProofFaultPenaltyEscalationPercentage(p.ProofFaultPenaltyEscalationPercentage); err != nil {
+		return err
+	}
+
+	if err := ValidateProofFaultJailingThreshold(p.ProofFaultJailingThreshold); err != nil {
+		return err
+	}
+
+	if err := ValidateJailDurationSessions(p.JailDurationSessions); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -96,6 +160,64 @@ func ValidateStakingFee(stakingFeeAny any) error {
 	return nil
 }
 
+// ValidateProofFaultWindowSessions validates the ProofFaultWindowSessions param.
+func ValidateProofFaultWindowSessions(proofFaultWindowSessionsAny any) error {
+	proofFaultWindowSessions, err := paramAsUint64(proofFaultWindowSessionsAny)
+	if err != nil {
+		return err
+	}
+
+	if proofFaultWindowSessions < 1 {
+		return ErrSupplierParamInvalid.Wrapf("proof_fault_window_sessions must be at least 1: got %d", proofFaultWindowSessions)
+	}
+
+	return nil
+}
+
+// ValidateProofFaultPenaltyEscalationPercentage validates the ProofFaultPenaltyEscalationPercentage param.
+// Any percentage is valid, 0 meaning that the proof missing penalty does not escalate.
+func ValidateProofFaultPenaltyEscalationPercentage(proofFaultPenaltyEscalationPercentageAny any) error {
+	if _, err := paramAsUint64(proofFaultPenaltyEscalationPercentageAny); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ValidateProofFaultJailingThreshold validates the ProofFaultJailingThreshold param.
+// Any threshold is valid, 0 meaning that suppliers are never jailed.
+func ValidateProofFaultJailingThreshold(proofFaultJailingThresholdAny any) error {
+	if _, err := paramAsUint64(proofFaultJailingThresholdAny); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ValidateJailDurationSessions validates the JailDurationSessions param.
+func ValidateJailDurationSessions(jailDurationSessionsAny any) error {
+	jailDurationSessions, err := paramAsUint64(jailDurationSessionsAny)
+	if err != nil {
+		return err
+	}
+
+	if jailDurationSessions < 1 {
+		return ErrSupplierParamInvalid.Wrapf("jail_duration_sessions must be at least 1: got %d", jailDurationSessions)
+	}
+
+	return nil
+}
+
+// paramAsUint64 checks that paramAny is a uint64, returning an error if not.
+func paramAsUint64(paramAny any) (uint64, error) {
+	paramUint64, ok := paramAny.(uint64)
+	if !ok {
+		return 0, ErrSupplierParamInvalid.Wrapf("invalid parameter type: %T", paramAny)
+	}
+
+	return paramUint64, nil
+}
+
 // paramAsPositiveuPOKT checks that paramAny is a *cosmostypes.Coin and that its
 // amount is positive, returning an error if either is not the case.
 func paramAsPositiveuPOKT(paramAny any, paramName string) (*cosmostypes.Coin, error) {
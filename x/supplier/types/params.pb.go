@@ -32,6 +32,19 @@ type Params struct {
 	MinStake *types.Coin `protobuf:"bytes,1,opt,name=min_stake,json=minStake,proto3" json:"min_stake" yaml:"min_stake"`
 	// staking_fee is the fee charged by the protocol for staking a supplier.
 	StakingFee *types.Coin `protobuf:"bytes,2,opt,name=staking_fee,json=stakingFee,proto3" json:"staking_fee" yaml:"staking_fee"`
+	// proof_fault_window_sessions is the number of sessions over which the missing
+	// or invalid proofs (i.e. proof faults) of a supplier are tracked.
+	ProofFaultWindowSessions uint64 `protobuf:"varint,3,opt,name=proof_fault_window_sessions,json=proofFaultWindowSessions,proto3" json:"proof_fault_window_sessions" yaml:"proof_fault_window_sessions"`
+	// proof_fault_penalty_escalation_percentage is the percentage of the proof missing
+	// penalty which is added to the penalty for each prior proof fault of the supplier
+	// within the proof fault window (e.g. 100 doubles the penalty of the second fault).
+	ProofFaultPenaltyEscalationPercentage uint64 `protobuf:"varint,4,opt,name=proof_fault_penalty_escalation_percentage,json=proofFaultPenaltyEscalationPercentage,proto3" json:"proof_fault_penalty_escalation_percentage" yaml:"proof_fault_penalty_escalation_percentage"`
+	// proof_fault_jailing_threshold is the number of proof faults within the proof
+	// fault window at which a supplier is jailed. Jailing is disabled if it is 0.
+	ProofFaultJailingThreshold uint64 `protobuf:"varint,5,opt,name=proof_fault_jailing_threshold,json=proofFaultJailingThreshold,proto3" json:"proof_fault_jailing_threshold" yaml:"proof_fault_jailing_threshold"`
+	// jail_duration_sessions is the number of sessions a jailed supplier is excluded
+	// from before it can be unjailed.
+	JailDurationSessions uint64 `protobuf:"varint,6,opt,name=jail_duration_sessions,json=jailDurationSessions,proto3" json:"jail_duration_sessions" yaml:"jail_duration_sessions"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -77,6 +90,34 @@ func (m *Params) GetStakingFee() *types.Coin {
 	return nil
 }
 
+func (m *Params) GetProofFaultWindowSessions() uint64 {
+	if m != nil {
+		return m.ProofFaultWindowSessions
+	}
+	return 0
+}
+
+func (m *Params) GetProofFaultPenaltyEscalationPercentage() uint64 {
+	if m != nil {
+		return m.ProofFaultPenaltyEscalationPercentage
+	}
+	return 0
+}
+
+func (m *Params) GetProofFaultJailingThreshold() uint64 {
+	if m != nil {
+		return m.ProofFaultJailingThreshold
+	}
+	return 0
+}
+
+func (m *Params) GetJailDurationSessions() uint64 {
+	if m != nil {
+		return m.JailDurationSessions
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "pocket.supplier.Params")
 }
@@ -84,27 +125,40 @@ func init() {
 func init() { proto.RegisterFile("pocket/supplier/params.proto", fileDescriptor_0c4f2ef37b183b5f) }
 
 var fileDescriptor_0c4f2ef37b183b5f = []byte{
-	// 317 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x29, 0xc8, 0x4f, 0xce,
-	0x4e, 0x2d, 0xd1, 0x2f, 0x2e, 0x2d, 0x28, 0xc8, 0xc9, 0x4c, 0x2d, 0xd2, 0x2f, 0x48, 0x2c, 0x4a,
-	0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x87, 0xc8, 0xea, 0xc1, 0x64, 0xa5,
-	0x04, 0x13, 0x73, 0x33, 0xf3, 0xf2, 0xf5, 0xc1, 0x24, 0x44, 0x8d, 0x94, 0x48, 0x7a, 0x7e, 0x7a,
-	0x3e, 0x98, 0xa9, 0x0f, 0x62, 0x41, 0x45, 0xe5, 0x92, 0xf3, 0x8b, 0x73, 0xf3, 0x8b, 0xf5, 0x93,
-	0x12, 0x8b, 0x53, 0xf5, 0xcb, 0x0c, 0x93, 0x52, 0x4b, 0x12, 0x0d, 0xf5, 0x93, 0xf3, 0x33, 0xf3,
-	0x20, 0xf2, 0x4a, 0x2f, 0x19, 0xb9, 0xd8, 0x02, 0xc0, 0x56, 0x09, 0x45, 0x72, 0x71, 0xe6, 0x66,
-	0xe6, 0xc5, 0x17, 0x97, 0x24, 0x66, 0xa7, 0x4a, 0x30, 0x2a, 0x30, 0x6a, 0x70, 0x1b, 0x49, 0xea,
-	0x41, 0xb4, 0xeb, 0x81, 0xb4, 0xeb, 0x41, 0xb5, 0xeb, 0x39, 0xe7, 0x67, 0xe6, 0x39, 0x29, 0xbe,
-	0xba, 0x27, 0x8f, 0x50, 0xff, 0xe9, 0x9e, 0xbc, 0x40, 0x65, 0x62, 0x6e, 0x8e, 0x95, 0x12, 0x5c,
-	0x48, 0x29, 0x88, 0x23, 0x37, 0x33, 0x2f, 0x18, 0xc4, 0x14, 0x4a, 0xe4, 0xe2, 0x06, 0x89, 0x65,
-	0xe6, 0xa5, 0xc7, 0xa7, 0xa5, 0xa6, 0x4a, 0x30, 0x11, 0x32, 0x5c, 0xf5, 0xd5, 0x3d, 0x79, 0x64,
-	0x1d, 0x9f, 0xee, 0xc9, 0x0b, 0x41, 0x8c, 0x47, 0x12, 0x54, 0x0a, 0xe2, 0x82, 0xf2, 0xdc, 0x52,
-	0x53, 0xad, 0x14, 0x5f, 0x2c, 0x90, 0x67, 0xec, 0x7a, 0xbe, 0x41, 0x4b, 0x02, 0x1a, 0x92, 0x15,
-	0x88, 0xb0, 0x84, 0x78, 0xd0, 0xc9, 0xff, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x6f,
-	0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18,
-	0x6e, 0x3c, 0x96, 0x63, 0x88, 0x32, 0x4c, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf,
-	0xd5, 0x2f, 0xc8, 0xcf, 0x2e, 0xd1, 0xcd, 0x4b, 0x2d, 0x29, 0xcf, 0x2f, 0xca, 0x06, 0x73, 0x8a,
-	0xf2, 0x73, 0x72, 0x90, 0x4d, 0x2c, 0xa9, 0x2c, 0x48, 0x2d, 0x4e, 0x62, 0x03, 0x87, 0xa1, 0x31,
-	0x20, 0x00, 0x00, 0xff, 0xff, 0x7a, 0x0f, 0xd9, 0x8d, 0xbd, 0x01, 0x00, 0x00,
+	// 523 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x3f, 0x6f, 0xd3, 0x40,
+	0x18, 0xc6, 0x7b, 0x50, 0x22, 0x70, 0x07, 0xc0, 0xaa, 0x90, 0x09, 0xd4, 0xd7, 0x5a, 0x54, 0x2a,
+	0x48, 0xd8, 0x04, 0xb6, 0xb2, 0x05, 0x5a, 0x09, 0x16, 0x22, 0x17, 0x09, 0xc1, 0x62, 0x5d, 0x9c,
+	0x4b, 0x72, 0xc4, 0xbe, 0xd7, 0xf8, 0x2e, 0x84, 0xec, 0x4c, 0x88, 0x81, 0x8f, 0xc0, 0x47, 0xe8,
+	0xce, 0x17, 0x60, 0xec, 0xd8, 0xe9, 0x84, 0x92, 0x01, 0xe4, 0xd1, 0x9f, 0x00, 0xd9, 0xe7, 0xfc,
+	0x91, 0x02, 0x81, 0x25, 0xba, 0x7b, 0x7f, 0xcf, 0xf3, 0xbe, 0x4f, 0x7c, 0x7a, 0x8d, 0xdb, 0x09,
+	0x84, 0x03, 0x2a, 0x3d, 0x31, 0x4c, 0x92, 0x88, 0xd1, 0xd4, 0x4b, 0x48, 0x4a, 0x62, 0xe1, 0x26,
+	0x29, 0x48, 0x30, 0xaf, 0x6a, 0xea, 0xce, 0x68, 0xfd, 0x3a, 0x89, 0x19, 0x07, 0xaf, 0xfc, 0xd5,
+	0x9a, 0xfa, 0x76, 0x0f, 0x7a, 0x50, 0x1e, 0xbd, 0xe2, 0x54, 0x55, 0xed, 0x10, 0x44, 0x0c, 0xc2,
+	0x6b, 0x13, 0x41, 0xbd, 0xf7, 0x8d, 0x36, 0x95, 0xa4, 0xe1, 0x85, 0xc0, 0xb8, 0xe6, 0xce, 0x69,
+	0xcd, 0xa8, 0xb5, 0xca, 0x51, 0xe6, 0x6b, 0xe3, 0x4a, 0xcc, 0x78, 0x20, 0x24, 0x19, 0x50, 0x0b,
+	0xed, 0xa2, 0x83, 0xad, 0x87, 0x37, 0x5d, 0x6d, 0x77, 0x0b, 0xbb, 0x5b, 0xd9, 0xdd, 0x27, 0xc0,
+	0x78, 0x73, 0x2f, 0x53, 0x78, 0xa1, 0xcf, 0x15, 0xbe, 0x36, 0x26, 0x71, 0x74, 0xe8, 0xcc, 0x4b,
+	0x8e, 0x7f, 0x39, 0x66, 0xfc, 0xa4, 0x38, 0x9a, 0xc4, 0xd8, 0x2a, 0x6a, 0x8c, 0xf7, 0x82, 0x2e,
+	0xa5, 0xd6, 0x85, 0x7f, 0x35, 0xdf, 0xcf, 0x14, 0x5e, 0x76, 0xe4, 0x0a, 0x9b, 0xba, 0xfd, 0x52,
+	0xd1, 0xf1, 0x8d, 0xea, 0x76, 0x4c, 0xa9, 0xf9, 0x11, 0x19, 0xb7, 0x92, 0x14, 0xa0, 0x1b, 0x74,
+	0xc9, 0x30, 0x92, 0xc1, 0x88, 0xf1, 0x0e, 0x8c, 0x02, 0x41, 0x85, 0x60, 0xc0, 0x85, 0x75, 0x71,
+	0x17, 0x1d, 0x6c, 0x36, 0x8f, 0x32, 0x85, 0xd7, 0xc9, 0x72, 0x85, 0x1d, 0x3d, 0x68, 0x8d, 0xc8,
+	0xf1, 0xad, 0x92, 0x1e, 0x17, 0xf0, 0x55, 0xc9, 0x4e, 0x2a, 0x64, 0x7e, 0x43, 0xc6, 0xdd, 0x65,
+	0x6b, 0x42, 0x39, 0x89, 0xe4, 0x38, 0xa0, 0x22, 0x24, 0x11, 0x91, 0x0c, 0x78, 0x90, 0xd0, 0x34,
+	0xa4, 0x5c, 0x92, 0x1e, 0xb5, 0x36, 0xcb, 0x50, 0x24, 0x53, 0xf8, 0xff, 0x4d, 0xb9, 0xc2, 0x0f,
+	0x56, 0x23, 0xae, 0xb5, 0x38, 0xfe, 0xfe, 0x22, 0x70, 0x4b, 0x2b, 0x8f, 0xe6, 0xc2, 0xd6, 0x5c,
+	0x67, 0x7e, 0x46, 0xc6, 0xce, 0x72, 0xd7, 0xb7, 0x84, 0x45, 0xc5, 0xd7, 0x96, 0xfd, 0x94, 0x8a,
+	0x3e, 0x44, 0x1d, 0xeb, 0x52, 0x99, 0xf8, 0x59, 0xa6, 0xf0, 0x7a, 0x61, 0xae, 0xf0, 0x9d, 0xd5,
+	0x94, 0x2b, 0x32, 0xc7, 0xaf, 0x2f, 0x92, 0x3d, 0xd7, 0xf4, 0xe5, 0x0c, 0x9a, 0xef, 0x8c, 0x1b,
+	0x85, 0x23, 0xe8, 0x0c, 0x53, 0xfd, 0x97, 0xe6, 0xaf, 0x59, 0x2b, 0x63, 0x3c, 0xce, 0x14, 0xfe,
+	0x8b, 0x22, 0x57, 0x78, 0x47, 0xcf, 0xff, 0x33, 0x77, 0xfc, 0xed, 0x02, 0x3c, 0xad, 0xea, 0xb3,
+	0xf7, 0x3b, 0xdc, 0xfb, 0xf5, 0x15, 0xa3, 0x4f, 0x3f, 0x4f, 0xef, 0x59, 0xd5, 0x42, 0x7e, 0x58,
+	0xac, 0xa4, 0xde, 0x93, 0xe6, 0x8b, 0xef, 0x13, 0x1b, 0x9d, 0x4d, 0x6c, 0x74, 0x3e, 0xb1, 0xd1,
+	0x8f, 0x89, 0x8d, 0xbe, 0x4c, 0xed, 0x8d, 0xb3, 0xa9, 0xbd, 0x71, 0x3e, 0xb5, 0x37, 0xde, 0x34,
+	0x7a, 0x4c, 0xf6, 0x87, 0x6d, 0x37, 0x84, 0xd8, 0x4b, 0x60, 0x20, 0xef, 0x73, 0x2a, 0x47, 0x90,
+	0x0e, 0xca, 0x4b, 0x0a, 0x51, 0xb4, 0xdc, 0x51, 0x8e, 0x13, 0x2a, 0xda, 0xb5, 0x72, 0x15, 0x1f,
+	0xfd, 0x1e, 0x00, 0x7a, 0xd6, 0x15, 0x24, 0x04, 0x04, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -132,6 +186,18 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.StakingFee.Equal(that1.StakingFee) {
 		return false
 	}
+	if this.ProofFaultWindowSessions != that1.ProofFaultWindowSessions {
+		return false
+	}
+	if this.ProofFaultPenaltyEscalationPercentage != that1.ProofFaultPenaltyEscalationPercentage {
+		return false
+	}
+	if this.ProofFaultJailingThreshold != that1.ProofFaultJailingThreshold {
+		return false
+	}
+	if this.JailDurationSessions != that1.JailDurationSessions {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -154,6 +220,26 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.JailDurationSessions != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.JailDurationSessions))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ProofFaultJailingThreshold != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ProofFaultJailingThreshold))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ProofFaultPenaltyEscalationPercentage != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ProofFaultPenaltyEscalationPercentage))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ProofFaultWindowSessions != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ProofFaultWindowSessions))
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.StakingFee != nil {
 		{
 			size, err := m.StakingFee.MarshalToSizedBuffer(dAtA[:i])
@@ -206,6 +292,18 @@ func (m *Params) Size() (n int) {
 		l = m.StakingFee.Size()
 		n += 1 + l + sovParams(uint64(l))
 	}
+	if m.ProofFaultWindowSessions != 0 {
+		n += 1 + sovParams(uint64(m.ProofFaultWindowSessions))
+	}
+	if m.ProofFaultPenaltyEscalationPercentage != 0 {
+		n += 1 + sovParams(uint64(m.ProofFaultPenaltyEscalationPercentage))
+	}
+	if m.ProofFaultJailingThreshold != 0 {
+		n += 1 + sovParams(uint64(m.ProofFaultJailingThreshold))
+	}
+	if m.JailDurationSessions != 0 {
+		n += 1 + sovParams(uint64(m.JailDurationSessions))
+	}
 	return n
 }
 
@@ -316,6 +414,82 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofFaultWindowSessions", wireType)
+			}
+			m.ProofFaultWindowSessions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProofFaultWindowSessions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofFaultPenaltyEscalationPercentage", wireType)
+			}
+			m.ProofFaultPenaltyEscalationPercentage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProofFaultPenaltyEscalationPercentage |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofFaultJailingThreshold", wireType)
+			}
+			m.ProofFaultJailingThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProofFaultJailingThreshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailDurationSessions", wireType)
+			}
+			m.JailDurationSessions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JailDurationSessions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -0,0 +1,129 @@
+package types
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
+)
+
+// ValidateBasic performs basic (non-state-dependant) validation on a SupplierProofFaults.
+func (spf *SupplierProofFaults) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(spf.OperatorAddress); err != nil {
+		return ErrSupplierInvalidAddress.Wrapf("invalid supplier operator address %s; (%v)", spf.OperatorAddress, err)
+	}
+
+	return nil
+}
+
+// GetProofFaultWindowStartHeight returns the lowest session end height of the
+// proof faults which are within the proof fault window ending at the session
+// ending at sessionEndHeight.
+func GetProofFaultWindowStartHeight(
+	sharedParams *sharedtypes.Params,
+	supplierParams *Params,
+	sessionEndHeight int64,
+) int64 {
+	proofFaultWindowBlocks := supplierParams.GetProofFaultWindowSessions() * sharedParams.GetNumBlocksPerSession()
+
+	// The window includes the session ending at sessionEndHeight, so the proof
+	// faults must have a session end height strictly greater than the one of the
+	// session which precedes the window.
+	return sessionEndHeight - int64(proofFaultWindowBlocks) + 1
+}
+
+// GetEscalatedProofMissingPenalty returns the penalty of a supplier's proof fault
+// given the number of proof faults of the supplier within the proof fault window,
+// including this one.
+//
+// Each prior proof fault within the window adds proof_fault_penalty_escalation_percentage
+// of the proof missing penalty to the penalty:
+// penalty = proof_missing_penalty * (100 + escalation_percentage * (num_proof_faults - 1)) / 100
+func GetEscalatedProofMissingPenalty(
+	supplierParams *Params,
+	proofMissingPenalty sdk.Coin,
+	numProofFaults uint64,
+) sdk.Coin {
+	if numProofFaults <= 1 {
+		return proofMissingPenalty
+	}
+
+	escalationPercentage := math.NewIntFromUint64(supplierParams.GetProofFaultPenaltyEscalationPercentage()).
+		Mul(math.NewIntFromUint64(numProofFaults - 1))
+	escalatedPenaltyAmount := proofMissingPenalty.Amount.
+		Mul(escalationPercentage.AddRaw(100)).
+		QuoRaw(100)
+
+	return sdk.NewCoin(proofMissingPenalty.Denom, escalatedPenaltyAmount)
+}
+
+// GetNumProofFaultsSince returns the number of the proof faults of the sessions
+// ending at or after the given height.
+func (s *SupplierProofFaults) GetNumProofFaultsSince(height int64) uint64 {
+	numProofFaults := uint64(0)
+	for _, proofFault := range s.GetProofFaults() {
+		if proofFault.GetSessionEndHeight() >= height {
+			numProofFaults++
+		}
+	}
+
+	return numProofFaults
+}
+
+// ShouldJailSupplier returns true if the given number of proof faults of a supplier
+// within the proof fault window reached the proof_fault_jailing_threshold param.
+// Suppliers are never jailed if the threshold is 0.
+func ShouldJailSupplier(supplierParams *Params, numProofFaults uint64) bool {
+	jailingThreshold := supplierParams.GetProofFaultJailingThreshold()
+	return jailingThreshold > 0 && numProofFaults >= jailingThreshold
+}
+
+// GetSupplierJailEndHeight returns the session end height of the last session a
+// supplier jailed during the session ending at sessionEndHeight is jailed for.
+//
+// The supplier is jailed starting from the next session, for jail_duration_sessions sessions.
+func GetSupplierJailEndHeight(
+	sharedParams *sharedtypes.Params,
+	supplierParams *Params,
+	sessionEndHeight int64,
+) int64 {
+	jailDurationBlocks := supplierParams.GetJailDurationSessions() * sharedParams.GetNumBlocksPerSession()
+
+	return sessionEndHeight + int64(jailDurationBlocks)
+}
+
+// ValidateJailHistory ensures that the jail periods of the given supplier are
+// ordered and do not overlap, and that only the latest one may not be unjailed yet.
+func ValidateJailHistory(supplier *sharedtypes.Supplier) error {
+	jailHistory := supplier.GetJailHistory()
+	for i, jailPeriod := range jailHistory {
+		if jailPeriod == nil {
+			return ErrSupplierInvalidJailHistory.Wrapf("nil jail period at index %d", i)
+		}
+		if jailPeriod.GetJailStartHeight() <= 0 || jailPeriod.GetJailEndHeight() < jailPeriod.GetJailStartHeight() {
+			return ErrSupplierInvalidJailHistory.Wrapf(
+				"invalid jail period from height %d to %d",
+				jailPeriod.GetJailStartHeight(), jailPeriod.GetJailEndHeight(),
+			)
+		}
+
+		isUnjailed := jailPeriod.GetUnjailHeight() != sharedtypes.SupplierNotUnjailed
+		if isUnjailed && jailPeriod.GetUnjailHeight() <= jailPeriod.GetJailEndHeight() {
+			return ErrSupplierInvalidJailHistory.Wrapf(
+				"unjail height %d is not after the jail end height %d",
+				jailPeriod.GetUnjailHeight(), jailPeriod.GetJailEndHeight(),
+			)
+		}
+		if !isUnjailed && i < len(jailHistory)-1 {
+			return ErrSupplierInvalidJailHistory.Wrapf("jail period at index %d was not unjailed but is not the latest one", i)
+		}
+		if i > 0 && jailPeriod.GetJailStartHeight() < jailHistory[i-1].GetUnjailHeight() {
+			return ErrSupplierInvalidJailHistory.Wrapf(
+				"jail period starting at height %d overlaps the previous one unjailed at height %d",
+				jailPeriod.GetJailStartHeight(), jailHistory[i-1].GetUnjailHeight(),
+			)
+		}
+	}
+
+	return nil
+}
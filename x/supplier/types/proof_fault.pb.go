@@ -0,0 +1,593 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: pocket/supplier/proof_fault.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ProofFault is a claim of a supplier which expired because its required proof
+// was missing or invalid.
+type ProofFault struct {
+	// Session ID of the claim whose proof was missing or invalid
+	SessionId string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// Session end height of the claim whose proof was missing or invalid
+	SessionEndHeight int64 `protobuf:"varint,2,opt,name=session_end_height,json=sessionEndHeight,proto3" json:"session_end_height,omitempty"`
+}
+
+func (m *ProofFault) Reset()         { *m = ProofFault{} }
+func (m *ProofFault) String() string { return proto.CompactTextString(m) }
+func (*ProofFault) ProtoMessage()    {}
+func (*ProofFault) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62f21122f62c8af4, []int{0}
+}
+func (m *ProofFault) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProofFault) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ProofFault) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProofFault.Merge(m, src)
+}
+func (m *ProofFault) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProofFault) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProofFault.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProofFault proto.InternalMessageInfo
+
+func (m *ProofFault) GetSessionId() string {
+	if m != nil {
+		return m.SessionId
+	}
+	return ""
+}
+
+func (m *ProofFault) GetSessionEndHeight() int64 {
+	if m != nil {
+		return m.SessionEndHeight
+	}
+	return 0
+}
+
+// SupplierProofFaults tracks the proof faults of a supplier within the rolling
+// window of sessions defined by the proof_fault_window_sessions supplier param.
+type SupplierProofFaults struct {
+	// Operator address of the supplier which committed the proof faults
+	OperatorAddress string `protobuf:"bytes,1,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty"`
+	// Proof faults within the proof fault window, ordered by session end height
+	ProofFaults []ProofFault `protobuf:"bytes,2,rep,name=proof_faults,json=proofFaults,proto3" json:"proof_faults"`
+}
+
+func (m *SupplierProofFaults) Reset()         { *m = SupplierProofFaults{} }
+func (m *SupplierProofFaults) String() string { return proto.CompactTextString(m) }
+func (*SupplierProofFaults) ProtoMessage()    {}
+func (*SupplierProofFaults) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62f21122f62c8af4, []int{1}
+}
+func (m *SupplierProofFaults) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SupplierProofFaults) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SupplierProofFaults) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SupplierProofFaults.Merge(m, src)
+}
+func (m *SupplierProofFaults) XXX_Size() int {
+	return m.Size()
+}
+func (m *SupplierProofFaults) XXX_DiscardUnknown() {
+	xxx_messageInfo_SupplierProofFaults.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SupplierProofFaults proto.InternalMessageInfo
+
+func (m *SupplierProofFaults) GetOperatorAddress() string {
+	if m != nil {
+		return m.OperatorAddress
+	}
+	return ""
+}
+
+func (m *SupplierProofFaults) GetProofFaults() []ProofFault {
+	if m != nil {
+		return m.ProofFaults
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*ProofFault)(nil), "pocket.supplier.ProofFault")
+	proto.RegisterType((*SupplierProofFaults)(nil), "pocket.supplier.SupplierProofFaults")
+}
+
+func init() { proto.RegisterFile("pocket/supplier/proof_fault.proto", fileDescriptor_62f21122f62c8af4) }
+
+var fileDescriptor_62f21122f62c8af4 = []byte{
+	// 320 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x51, 0x4d, 0x4e, 0x02, 0x31,
+	0x18, 0x9d, 0x82, 0x31, 0xa1, 0x98, 0x40, 0x2a, 0x8b, 0x11, 0x63, 0x45, 0x56, 0x2c, 0x64, 0x26,
+	0xea, 0x09, 0xc4, 0x9f, 0xe8, 0x4a, 0x33, 0xac, 0x74, 0x33, 0x01, 0x5a, 0x86, 0x86, 0x61, 0xbe,
+	0xa6, 0x2d, 0x51, 0x6f, 0xe1, 0x11, 0x3c, 0x84, 0x87, 0x60, 0x49, 0x5c, 0xb1, 0x32, 0x66, 0xb8,
+	0x88, 0x61, 0x3a, 0x64, 0x8c, 0xbb, 0x7e, 0xef, 0xbd, 0x7e, 0xef, 0xe5, 0x7d, 0xf8, 0x44, 0xc2,
+	0x68, 0xca, 0x8d, 0xaf, 0xe7, 0x52, 0xc6, 0x82, 0x2b, 0x5f, 0x2a, 0x80, 0x71, 0x38, 0x1e, 0xcc,
+	0x63, 0xe3, 0x49, 0x05, 0x06, 0x48, 0xcd, 0x4a, 0xbc, 0xad, 0xa4, 0x79, 0x30, 0x02, 0x3d, 0x03,
+	0x1d, 0x66, 0xb4, 0x6f, 0x07, 0xab, 0x6d, 0x36, 0x22, 0x88, 0xc0, 0xe2, 0x9b, 0x97, 0x45, 0xdb,
+	0x4f, 0x18, 0x3f, 0x6e, 0xd6, 0xde, 0x6e, 0xb6, 0x92, 0x23, 0x8c, 0x35, 0xd7, 0x5a, 0x40, 0x12,
+	0x0a, 0xe6, 0xa2, 0x16, 0xea, 0x54, 0x82, 0x4a, 0x8e, 0xdc, 0x33, 0x72, 0x8a, 0xc9, 0x96, 0xe6,
+	0x09, 0x0b, 0x27, 0x5c, 0x44, 0x13, 0xe3, 0x96, 0x5a, 0xa8, 0x53, 0x0e, 0xea, 0x39, 0x73, 0x93,
+	0xb0, 0xbb, 0x0c, 0x6f, 0x7f, 0x20, 0xbc, 0xdf, 0xcf, 0x83, 0x15, 0x1e, 0x9a, 0x5c, 0xe1, 0x3a,
+	0x48, 0xae, 0x06, 0x06, 0x54, 0x38, 0x60, 0x4c, 0x71, 0xad, 0xad, 0x55, 0xcf, 0xfd, 0xfa, 0xec,
+	0x36, 0xf2, 0xd0, 0x97, 0x96, 0xe9, 0x1b, 0x25, 0x92, 0x28, 0xa8, 0x6d, 0x7f, 0xe4, 0x30, 0xb9,
+	0xc6, 0x7b, 0x7f, 0xea, 0xd0, 0x6e, 0xa9, 0x55, 0xee, 0x54, 0xcf, 0x0f, 0xbd, 0x7f, 0x85, 0x78,
+	0x85, 0x71, 0x6f, 0x67, 0xf1, 0x7d, 0xec, 0x04, 0x55, 0x59, 0x44, 0xe9, 0x3d, 0x2c, 0x52, 0x8a,
+	0x96, 0x29, 0x45, 0xab, 0x94, 0xa2, 0x9f, 0x94, 0xa2, 0xf7, 0x35, 0x75, 0x96, 0x6b, 0xea, 0xac,
+	0xd6, 0xd4, 0x79, 0x3e, 0x8b, 0x84, 0x99, 0xcc, 0x87, 0xde, 0x08, 0x66, 0xbe, 0x84, 0xa9, 0xe9,
+	0x26, 0xdc, 0xbc, 0x80, 0x9a, 0x66, 0x83, 0x82, 0x38, 0xf6, 0x5f, 0x8b, 0xe3, 0x98, 0x37, 0xc9,
+	0xf5, 0x70, 0x37, 0x6b, 0xf5, 0xe2, 0x77, 0x00, 0x3b, 0x85, 0x97, 0x15, 0xbc, 0x01, 0x00, 0x00,
+}
+
+func (m *ProofFault) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProofFault) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProofFault) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SessionEndHeight != 0 {
+		i = encodeVarintProofFault(dAtA, i, uint64(m.SessionEndHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.SessionId) > 0 {
+		i -= len(m.SessionId)
+		copy(dAtA[i:], m.SessionId)
+		i = encodeVarintProofFault(dAtA, i, uint64(len(m.SessionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SupplierProofFaults) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SupplierProofFaults) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SupplierProofFaults) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProofFaults) > 0 {
+		for iNdEx := len(m.ProofFaults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProofFaults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProofFault(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintProofFault(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintProofFault(dAtA []byte, offset int, v uint64) int {
+	offset -= sovProofFault(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ProofFault) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SessionId)
+	if l > 0 {
+		n += 1 + l + sovProofFault(uint64(l))
+	}
+	if m.SessionEndHeight != 0 {
+		n += 1 + sovProofFault(uint64(m.SessionEndHeight))
+	}
+	return n
+}
+
+func (m *SupplierProofFaults) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovProofFault(uint64(l))
+	}
+	if len(m.ProofFaults) > 0 {
+		for _, e := range m.ProofFaults {
+			l = e.Size()
+			n += 1 + l + sovProofFault(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovProofFault(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozProofFault(x uint64) (n int) {
+	return sovProofFault(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ProofFault) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProofFault
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProofFault: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProofFault: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProofFault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProofFault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProofFault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionEndHeight", wireType)
+			}
+			m.SessionEndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProofFault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SessionEndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProofFault(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProofFault
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SupplierProofFaults) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProofFault
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SupplierProofFaults: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SupplierProofFaults: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProofFault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProofFault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProofFault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofFaults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProofFault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProofFault
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProofFault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofFaults = append(m.ProofFaults, ProofFault{})
+			if err := m.ProofFaults[len(m.ProofFaults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProofFault(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProofFault
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipProofFault(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowProofFault
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowProofFault
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowProofFault
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthProofFault
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupProofFault
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthProofFault
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthProofFault        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowProofFault          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupProofFault = fmt.Errorf("proto: unexpected end of group")
+)
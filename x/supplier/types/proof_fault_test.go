@@ -0,0 +1,86 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pokt-network/poktroll/app/volatile"
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
+	"github.com/pokt-network/poktroll/x/supplier/types"
+)
+
+func TestGetEscalatedProofMissingPenalty(t *testing.T) {
+	proofMissingPenalty := sdk.NewInt64Coin(volatile.DenomuPOKT, 1000)
+
+	tests := []struct {
+		desc                                  string
+		proofFaultPenaltyEscalationPercentage uint64
+		numProofFaults                        uint64
+		expectedPenaltyAmount                 int64
+	}{
+		{
+			desc:                                  "first proof fault is not escalated",
+			proofFaultPenaltyEscalationPercentage: 100,
+			numProofFaults:                        1,
+			expectedPenaltyAmount:                 1000,
+		},
+		{
+			desc:                                  "second proof fault with 100% escalation",
+			proofFaultPenaltyEscalationPercentage: 100,
+			numProofFaults:                        2,
+			expectedPenaltyAmount:                 2000,
+		},
+		{
+			desc:                                  "fourth proof fault with 50% escalation",
+			proofFaultPenaltyEscalationPercentage: 50,
+			numProofFaults:                        4,
+			expectedPenaltyAmount:                 2500,
+		},
+		{
+			desc:                                  "no escalation",
+			proofFaultPenaltyEscalationPercentage: 0,
+			numProofFaults:                        5,
+			expectedPenaltyAmount:                 1000,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			supplierParams := types.DefaultParams()
+			supplierParams.ProofFaultPenaltyEscalationPercentage = test.proofFaultPenaltyEscalationPercentage
+
+			penalty := types.GetEscalatedProofMissingPenalty(&supplierParams, proofMissingPenalty, test.numProofFaults)
+			require.Equal(t, sdk.NewInt64Coin(volatile.DenomuPOKT, test.expectedPenaltyAmount), penalty)
+		})
+	}
+}
+
+func TestShouldJailSupplier(t *testing.T) {
+	supplierParams := types.DefaultParams()
+	supplierParams.ProofFaultJailingThreshold = 3
+
+	require.False(t, types.ShouldJailSupplier(&supplierParams, 2))
+	require.True(t, types.ShouldJailSupplier(&supplierParams, 3))
+	require.True(t, types.ShouldJailSupplier(&supplierParams, 4))
+
+	// Suppliers are never jailed if the threshold is 0.
+	supplierParams.ProofFaultJailingThreshold = 0
+	require.False(t, types.ShouldJailSupplier(&supplierParams, 10))
+}
+
+func TestProofFaultWindowAndJailHeights(t *testing.T) {
+	sharedParams := sharedtypes.DefaultParams()
+	sharedParams.NumBlocksPerSession = 10
+
+	supplierParams := types.DefaultParams()
+	supplierParams.ProofFaultWindowSessions = 3
+	supplierParams.JailDurationSessions = 2
+
+	// The window ending at the session [41, 50] spans the sessions [21, 50].
+	require.Equal(t, int64(21), types.GetProofFaultWindowStartHeight(&sharedParams, &supplierParams, 50))
+
+	// A supplier jailed during the session [41, 50] is jailed for the sessions [51, 70].
+	require.Equal(t, int64(70), types.GetSupplierJailEndHeight(&sharedParams, &supplierParams, 50))
+}
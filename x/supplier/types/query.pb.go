@@ -313,6 +313,270 @@ func (m *QueryAllSuppliersResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+type QuerySupplierDelegationsRequest struct {
+	OperatorAddress string             `protobuf:"bytes,1,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty"`
+	Pagination      *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySupplierDelegationsRequest) Reset()         { *m = QuerySupplierDelegationsRequest{} }
+func (m *QuerySupplierDelegationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySupplierDelegationsRequest) ProtoMessage()    {}
+func (*QuerySupplierDelegationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ddacf5456729a55, []int{6}
+}
+func (m *QuerySupplierDelegationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySupplierDelegationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *QuerySupplierDelegationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySupplierDelegationsRequest.Merge(m, src)
+}
+func (m *QuerySupplierDelegationsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySupplierDelegationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySupplierDelegationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySupplierDelegationsRequest proto.InternalMessageInfo
+
+func (m *QuerySupplierDelegationsRequest) GetOperatorAddress() string {
+	if m != nil {
+		return m.OperatorAddress
+	}
+	return ""
+}
+
+func (m *QuerySupplierDelegationsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QuerySupplierDelegationsResponse struct {
+	Delegations []Delegation        `protobuf:"bytes,1,rep,name=delegations,proto3" json:"delegations"`
+	Pagination  *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySupplierDelegationsResponse) Reset()         { *m = QuerySupplierDelegationsResponse{} }
+func (m *QuerySupplierDelegationsResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySupplierDelegationsResponse) ProtoMessage()    {}
+func (*QuerySupplierDelegationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ddacf5456729a55, []int{7}
+}
+func (m *QuerySupplierDelegationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySupplierDelegationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *QuerySupplierDelegationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySupplierDelegationsResponse.Merge(m, src)
+}
+func (m *QuerySupplierDelegationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySupplierDelegationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySupplierDelegationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySupplierDelegationsResponse proto.InternalMessageInfo
+
+func (m *QuerySupplierDelegationsResponse) GetDelegations() []Delegation {
+	if m != nil {
+		return m.Delegations
+	}
+	return nil
+}
+
+func (m *QuerySupplierDelegationsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryGetSupplierProofFaultsRequest struct {
+	OperatorAddress string `protobuf:"bytes,1,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty"`
+}
+
+func (m *QueryGetSupplierProofFaultsRequest) Reset()         { *m = QueryGetSupplierProofFaultsRequest{} }
+func (m *QueryGetSupplierProofFaultsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetSupplierProofFaultsRequest) ProtoMessage()    {}
+func (*QueryGetSupplierProofFaultsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ddacf5456729a55, []int{8}
+}
+func (m *QueryGetSupplierProofFaultsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetSupplierProofFaultsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *QueryGetSupplierProofFaultsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetSupplierProofFaultsRequest.Merge(m, src)
+}
+func (m *QueryGetSupplierProofFaultsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetSupplierProofFaultsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetSupplierProofFaultsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetSupplierProofFaultsRequest proto.InternalMessageInfo
+
+func (m *QueryGetSupplierProofFaultsRequest) GetOperatorAddress() string {
+	if m != nil {
+		return m.OperatorAddress
+	}
+	return ""
+}
+
+type QueryGetSupplierProofFaultsResponse struct {
+	SupplierProofFaults SupplierProofFaults `protobuf:"bytes,1,opt,name=supplier_proof_faults,json=supplierProofFaults,proto3" json:"supplier_proof_faults"`
+}
+
+func (m *QueryGetSupplierProofFaultsResponse) Reset()         { *m = QueryGetSupplierProofFaultsResponse{} }
+func (m *QueryGetSupplierProofFaultsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetSupplierProofFaultsResponse) ProtoMessage()    {}
+func (*QueryGetSupplierProofFaultsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ddacf5456729a55, []int{9}
+}
+func (m *QueryGetSupplierProofFaultsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetSupplierProofFaultsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *QueryGetSupplierProofFaultsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetSupplierProofFaultsResponse.Merge(m, src)
+}
+func (m *QueryGetSupplierProofFaultsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetSupplierProofFaultsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetSupplierProofFaultsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetSupplierProofFaultsResponse proto.InternalMessageInfo
+
+func (m *QueryGetSupplierProofFaultsResponse) GetSupplierProofFaults() SupplierProofFaults {
+	if m != nil {
+		return m.SupplierProofFaults
+	}
+	return SupplierProofFaults{}
+}
+
+type QueryAllSupplierProofFaultsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllSupplierProofFaultsRequest) Reset()         { *m = QueryAllSupplierProofFaultsRequest{} }
+func (m *QueryAllSupplierProofFaultsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllSupplierProofFaultsRequest) ProtoMessage()    {}
+func (*QueryAllSupplierProofFaultsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ddacf5456729a55, []int{10}
+}
+func (m *QueryAllSupplierProofFaultsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllSupplierProofFaultsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *QueryAllSupplierProofFaultsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllSupplierProofFaultsRequest.Merge(m, src)
+}
+func (m *QueryAllSupplierProofFaultsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllSupplierProofFaultsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllSupplierProofFaultsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllSupplierProofFaultsRequest proto.InternalMessageInfo
+
+func (m *QueryAllSupplierProofFaultsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryAllSupplierProofFaultsResponse struct {
+	SupplierProofFaults []SupplierProofFaults `protobuf:"bytes,1,rep,name=supplier_proof_faults,json=supplierProofFaults,proto3" json:"supplier_proof_faults"`
+	Pagination          *query.PageResponse   `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllSupplierProofFaultsResponse) Reset()         { *m = QueryAllSupplierProofFaultsResponse{} }
+func (m *QueryAllSupplierProofFaultsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllSupplierProofFaultsResponse) ProtoMessage()    {}
+func (*QueryAllSupplierProofFaultsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ddacf5456729a55, []int{11}
+}
+func (m *QueryAllSupplierProofFaultsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllSupplierProofFaultsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *QueryAllSupplierProofFaultsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllSupplierProofFaultsResponse.Merge(m, src)
+}
+func (m *QueryAllSupplierProofFaultsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllSupplierProofFaultsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllSupplierProofFaultsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllSupplierProofFaultsResponse proto.InternalMessageInfo
+
+func (m *QueryAllSupplierProofFaultsResponse) GetSupplierProofFaults() []SupplierProofFaults {
+	if m != nil {
+		return m.SupplierProofFaults
+	}
+	return nil
+}
+
+func (m *QueryAllSupplierProofFaultsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "pocket.supplier.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "pocket.supplier.QueryParamsResponse")
@@ -320,50 +584,71 @@ func init() {
 	proto.RegisterType((*QueryGetSupplierResponse)(nil), "pocket.supplier.QueryGetSupplierResponse")
 	proto.RegisterType((*QueryAllSuppliersRequest)(nil), "pocket.supplier.QueryAllSuppliersRequest")
 	proto.RegisterType((*QueryAllSuppliersResponse)(nil), "pocket.supplier.QueryAllSuppliersResponse")
+	proto.RegisterType((*QuerySupplierDelegationsRequest)(nil), "pocket.supplier.QuerySupplierDelegationsRequest")
+	proto.RegisterType((*QuerySupplierDelegationsResponse)(nil), "pocket.supplier.QuerySupplierDelegationsResponse")
+	proto.RegisterType((*QueryGetSupplierProofFaultsRequest)(nil), "pocket.supplier.QueryGetSupplierProofFaultsRequest")
+	proto.RegisterType((*QueryGetSupplierProofFaultsResponse)(nil), "pocket.supplier.QueryGetSupplierProofFaultsResponse")
+	proto.RegisterType((*QueryAllSupplierProofFaultsRequest)(nil), "pocket.supplier.QueryAllSupplierProofFaultsRequest")
+	proto.RegisterType((*QueryAllSupplierProofFaultsResponse)(nil), "pocket.supplier.QueryAllSupplierProofFaultsResponse")
 }
 
 func init() { proto.RegisterFile("pocket/supplier/query.proto", fileDescriptor_9ddacf5456729a55) }
 
 var fileDescriptor_9ddacf5456729a55 = []byte{
-	// 606 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x31, 0x6f, 0x13, 0x31,
-	0x18, 0x8d, 0x53, 0x88, 0x12, 0x83, 0x54, 0x30, 0x91, 0x9a, 0x86, 0xea, 0x8a, 0x0e, 0x54, 0x42,
-	0xa4, 0xde, 0x91, 0x32, 0x01, 0x62, 0x68, 0x2a, 0x51, 0x98, 0x68, 0xaf, 0x62, 0x61, 0x20, 0x72,
-	0x12, 0x73, 0xb5, 0x72, 0x39, 0xbb, 0xb6, 0x53, 0xa8, 0x10, 0x0b, 0xcc, 0x48, 0x48, 0x0c, 0x8c,
-	0x2c, 0x0c, 0x8c, 0x0c, 0xfc, 0x88, 0x4e, 0xa8, 0x82, 0xa5, 0x13, 0x42, 0x09, 0x12, 0x7f, 0x03,
-	0xc5, 0xf6, 0xa5, 0x69, 0x92, 0xd2, 0xb0, 0x44, 0xb6, 0xbf, 0xf7, 0xde, 0xf7, 0x9e, 0xfd, 0xe5,
-	0xe0, 0x65, 0xce, 0x1a, 0x2d, 0xa2, 0x7c, 0xd9, 0xe1, 0x3c, 0xa2, 0x44, 0xf8, 0x3b, 0x1d, 0x22,
-	0xf6, 0x3c, 0x2e, 0x98, 0x62, 0x68, 0xd6, 0x14, 0xbd, 0xa4, 0x58, 0xbc, 0x88, 0xdb, 0x34, 0x66,
-	0xbe, 0xfe, 0x35, 0x98, 0x62, 0x3e, 0x64, 0x21, 0xd3, 0x4b, 0xbf, 0xbf, 0xb2, 0xa7, 0x0b, 0x21,
-	0x63, 0x61, 0x44, 0x7c, 0xcc, 0xa9, 0x8f, 0xe3, 0x98, 0x29, 0xac, 0x28, 0x8b, 0xa5, 0xad, 0xce,
-	0x37, 0x98, 0x6c, 0x33, 0x59, 0x33, 0x34, 0xb3, 0xb1, 0xa5, 0xb2, 0xd9, 0xf9, 0x75, 0x2c, 0x89,
-	0xf1, 0xe2, 0xef, 0x56, 0xea, 0x44, 0xe1, 0x8a, 0xcf, 0x71, 0x48, 0x63, 0xad, 0x63, 0xb1, 0xce,
-	0x30, 0x36, 0x41, 0x35, 0x18, 0x4d, 0xea, 0x0b, 0xa3, 0xd9, 0x38, 0x16, 0xb8, 0x2d, 0x47, 0xab,
-	0xdb, 0x58, 0x90, 0xe6, 0x00, 0x64, 0xaa, 0x6e, 0x1e, 0xa2, 0xcd, 0x7e, 0xf7, 0x0d, 0x4d, 0x09,
-	0xc8, 0x4e, 0x87, 0x48, 0xe5, 0x6e, 0xc2, 0x4b, 0xc7, 0x4e, 0x25, 0x67, 0xb1, 0x24, 0xe8, 0x0e,
-	0xcc, 0x18, 0xe9, 0x02, 0xb8, 0x02, 0x4a, 0xe7, 0x56, 0xe6, 0xbc, 0x91, 0x8b, 0xf3, 0x0c, 0xa1,
-	0x9a, 0xdb, 0xff, 0xb9, 0x98, 0xfa, 0xfc, 0xe7, 0x4b, 0x19, 0x04, 0x96, 0xe1, 0x3e, 0x85, 0x73,
-	0x5a, 0x72, 0x9d, 0xa8, 0x2d, 0x8b, 0xb6, 0xdd, 0xd0, 0x1a, 0xbc, 0xc0, 0x38, 0x11, 0x58, 0x31,
-	0x51, 0xc3, 0xcd, 0xa6, 0x20, 0xd2, 0x34, 0xc8, 0x55, 0x0b, 0xdf, 0xbf, 0x2e, 0xe7, 0xed, 0xbd,
-	0xad, 0x9a, 0xca, 0x96, 0x12, 0x34, 0x0e, 0x83, 0xd9, 0x84, 0x61, 0x8f, 0xdd, 0xc7, 0xb0, 0x30,
-	0xae, 0x6f, 0x7d, 0xdf, 0x86, 0xd9, 0xc4, 0xe1, 0x98, 0x73, 0x7d, 0x2b, 0x5e, 0x42, 0xa9, 0x9e,
-	0xe9, 0x3b, 0x0f, 0x06, 0x70, 0xf7, 0x2d, 0xb0, 0xba, 0xab, 0x51, 0x94, 0x80, 0x92, 0x6b, 0x42,
-	0xf7, 0x21, 0x3c, 0x7a, 0x2c, 0xab, 0xbc, 0xe4, 0x59, 0xbf, 0xfd, 0xd7, 0xf2, 0xcc, 0x94, 0xd9,
-	0x37, 0xf3, 0x36, 0x70, 0x48, 0x2c, 0x37, 0x18, 0x62, 0xa2, 0x45, 0x08, 0x25, 0x11, 0xbb, 0xb4,
-	0x41, 0x6a, 0xb4, 0x59, 0x48, 0xf7, 0xa3, 0x3f, 0x48, 0x05, 0x39, 0x7b, 0xf6, 0xb0, 0x59, 0xcd,
-	0xc2, 0xcc, 0x33, 0x1a, 0x29, 0x22, 0xdc, 0x8f, 0x00, 0xce, 0x4f, 0xf0, 0x33, 0x31, 0xe8, 0xcc,
-	0x7f, 0x04, 0x45, 0xeb, 0xc7, 0xb2, 0xa4, 0x75, 0x96, 0xeb, 0xa7, 0x66, 0x31, 0x7d, 0x87, 0xc3,
-	0xac, 0x7c, 0x9b, 0x81, 0x67, 0xb5, 0x43, 0xf4, 0x06, 0xc0, 0x8c, 0x19, 0x08, 0x74, 0x75, 0x6c,
-	0x52, 0xc6, 0xa7, 0xae, 0x78, 0xed, 0xdf, 0x20, 0xd3, 0xcb, 0xf5, 0x5e, 0xff, 0xf8, 0xfd, 0x3e,
-	0x5d, 0x42, 0x4b, 0x3e, 0x67, 0x2d, 0xb5, 0x1c, 0x13, 0xf5, 0x9c, 0x89, 0x96, 0xde, 0x08, 0x16,
-	0x45, 0xa3, 0xff, 0x02, 0xf4, 0x09, 0xc0, 0x6c, 0x92, 0x1a, 0x95, 0x26, 0xb7, 0x18, 0x1f, 0xca,
-	0xe2, 0x8d, 0x29, 0x90, 0xd6, 0xd1, 0x9a, 0x76, 0x74, 0x0f, 0xdd, 0x3d, 0xcd, 0xd1, 0x60, 0xf1,
-	0x72, 0x74, 0xde, 0x5f, 0xa1, 0x0f, 0x00, 0x9e, 0x1f, 0x7e, 0x53, 0x74, 0x82, 0x81, 0x09, 0x73,
-	0x58, 0x2c, 0x4f, 0x03, 0xb5, 0x66, 0x6f, 0x6a, 0xb3, 0x65, 0x54, 0x9a, 0xd6, 0x6c, 0xf5, 0xd1,
-	0x7e, 0xd7, 0x01, 0x07, 0x5d, 0x07, 0x1c, 0x76, 0x1d, 0xf0, 0xab, 0xeb, 0x80, 0x77, 0x3d, 0x27,
-	0x75, 0xd0, 0x73, 0x52, 0x87, 0x3d, 0x27, 0xf5, 0xa4, 0x12, 0x52, 0xb5, 0xdd, 0xa9, 0x7b, 0x0d,
-	0xd6, 0x3e, 0x41, 0xf1, 0xc5, 0x91, 0xa6, 0xda, 0xe3, 0x44, 0xd6, 0x33, 0xfa, 0xd3, 0x73, 0xeb,
-	0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x3d, 0x55, 0xe9, 0x09, 0x94, 0x05, 0x00, 0x00,
+	// 845 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcd, 0x4f, 0x13, 0x4f,
+	0x18, 0xee, 0xc0, 0xef, 0xd7, 0x94, 0xc1, 0x04, 0x1d, 0x50, 0xca, 0x42, 0x16, 0x5c, 0x08, 0xd6,
+	0x46, 0x76, 0x29, 0x70, 0x51, 0xe3, 0x07, 0xc5, 0x14, 0x3d, 0x09, 0x25, 0x5e, 0x3c, 0xd0, 0x6c,
+	0xdb, 0x61, 0xd9, 0xb0, 0xdd, 0x59, 0x76, 0xb7, 0x28, 0x31, 0x5e, 0x34, 0x1e, 0x4d, 0x4c, 0x3c,
+	0x78, 0xf0, 0xe0, 0xc5, 0x83, 0x27, 0xc3, 0xc1, 0x8b, 0x57, 0x4f, 0x78, 0x31, 0x44, 0x2f, 0x9c,
+	0x8c, 0x29, 0x26, 0xfe, 0x1b, 0xa6, 0x33, 0xb3, 0xed, 0xb2, 0xbb, 0xa5, 0xd4, 0xf4, 0xd2, 0xec,
+	0xee, 0xfb, 0xf5, 0x3c, 0xcf, 0xbc, 0xef, 0x3b, 0x85, 0xa3, 0x16, 0x29, 0x6d, 0x61, 0x57, 0x71,
+	0xaa, 0x96, 0x65, 0xe8, 0xd8, 0x56, 0xb6, 0xab, 0xd8, 0xde, 0x95, 0x2d, 0x9b, 0xb8, 0x04, 0x0d,
+	0x30, 0xa3, 0xec, 0x19, 0x85, 0x73, 0x6a, 0x45, 0x37, 0x89, 0x42, 0x7f, 0x99, 0x8f, 0x30, 0xa4,
+	0x11, 0x8d, 0xd0, 0x47, 0xa5, 0xfe, 0xc4, 0xbf, 0x8e, 0x69, 0x84, 0x68, 0x06, 0x56, 0x54, 0x4b,
+	0x57, 0x54, 0xd3, 0x24, 0xae, 0xea, 0xea, 0xc4, 0x74, 0xb8, 0x75, 0xa4, 0x44, 0x9c, 0x0a, 0x71,
+	0x0a, 0x2c, 0x8c, 0xbd, 0x70, 0x53, 0x9a, 0xbd, 0x29, 0x45, 0xd5, 0xc1, 0x0c, 0x8b, 0xb2, 0x93,
+	0x29, 0x62, 0x57, 0xcd, 0x28, 0x96, 0xaa, 0xe9, 0x26, 0xcd, 0xc3, 0x7d, 0x45, 0xbf, 0xaf, 0xe7,
+	0x55, 0x22, 0xba, 0x67, 0x9f, 0x08, 0x72, 0x2b, 0x63, 0x03, 0x6b, 0xfe, 0x0c, 0x63, 0x41, 0x0f,
+	0x4b, 0xb5, 0xd5, 0x8a, 0x87, 0xe5, 0x62, 0xc8, 0x6a, 0x13, 0xb2, 0x51, 0xd8, 0x50, 0xab, 0x86,
+	0x1b, 0x4c, 0xb0, 0xa9, 0xda, 0xb8, 0xdc, 0xf0, 0x64, 0x56, 0x69, 0x08, 0xa2, 0xd5, 0x3a, 0x85,
+	0x15, 0x9a, 0x35, 0x8f, 0xb7, 0xab, 0xd8, 0x71, 0xa5, 0x55, 0x38, 0x78, 0xec, 0xab, 0x63, 0x11,
+	0xd3, 0xc1, 0xe8, 0x1a, 0x8c, 0xb3, 0xea, 0x49, 0x30, 0x01, 0x52, 0xfd, 0x73, 0xc3, 0x72, 0x40,
+	0x7d, 0x99, 0x05, 0x64, 0xfb, 0xf6, 0x7f, 0x8e, 0xc7, 0x3e, 0xfc, 0xd9, 0x4b, 0x83, 0x3c, 0x8f,
+	0x90, 0xd6, 0xe1, 0x30, 0x4d, 0xb9, 0x8c, 0xdd, 0x35, 0xee, 0xcd, 0xab, 0xa1, 0x25, 0x78, 0x96,
+	0x58, 0xd8, 0x56, 0x5d, 0x62, 0x17, 0xd4, 0x72, 0xd9, 0xc6, 0x0e, 0x2b, 0xd0, 0x97, 0x4d, 0x7e,
+	0xff, 0x34, 0x33, 0xc4, 0xc5, 0x5f, 0x64, 0x96, 0x35, 0xd7, 0xd6, 0x4d, 0x2d, 0x3f, 0xe0, 0x45,
+	0xf0, 0xcf, 0xd2, 0x03, 0x98, 0x0c, 0xe7, 0xe7, 0xb8, 0xaf, 0xc2, 0x84, 0x87, 0x30, 0x84, 0x9c,
+	0xaa, 0x22, 0x7b, 0x21, 0xd9, 0xff, 0xea, 0xc8, 0xf3, 0x0d, 0x77, 0xe9, 0x25, 0xe0, 0x79, 0x17,
+	0x0d, 0xc3, 0x73, 0xf2, 0x64, 0x42, 0x39, 0x08, 0x9b, 0x27, 0xce, 0x33, 0x4f, 0xcb, 0x1c, 0x6f,
+	0xfd, 0xc8, 0x65, 0xd6, 0xaa, 0xfc, 0xe0, 0xe5, 0x15, 0x55, 0xc3, 0x3c, 0x36, 0xef, 0x8b, 0x44,
+	0xe3, 0x10, 0x3a, 0xd8, 0xde, 0xd1, 0x4b, 0xb8, 0xa0, 0x97, 0x93, 0x3d, 0x75, 0xea, 0x77, 0x63,
+	0xf9, 0x3e, 0xfe, 0xed, 0x5e, 0x39, 0x9b, 0x80, 0xf1, 0x0d, 0xdd, 0x70, 0xb1, 0x2d, 0xbd, 0x03,
+	0x70, 0x24, 0x02, 0x4f, 0x24, 0xd1, 0xde, 0x0e, 0x88, 0xa2, 0xe5, 0x63, 0x5c, 0x7a, 0x28, 0x97,
+	0x4b, 0x6d, 0xb9, 0xb0, 0xba, 0x7e, 0x32, 0xd2, 0x47, 0x00, 0xc7, 0x29, 0x42, 0xaf, 0xd4, 0x9d,
+	0x46, 0x4b, 0x3b, 0xdd, 0x3c, 0x71, 0x94, 0x8b, 0x40, 0xfc, 0x0f, 0xea, 0x4b, 0x7b, 0x00, 0x4e,
+	0xb4, 0x06, 0xcc, 0x95, 0x5d, 0x82, 0xfd, 0xcd, 0xd1, 0x74, 0xb8, 0xb8, 0xa3, 0xa1, 0xfe, 0x6f,
+	0x86, 0x72, 0x81, 0xfd, 0x51, 0xdd, 0xd3, 0x58, 0x87, 0x52, 0xb0, 0xd9, 0x57, 0xea, 0x83, 0x9f,
+	0xab, 0xcf, 0x7d, 0x57, 0x55, 0x96, 0x5e, 0x00, 0x38, 0x79, 0x62, 0x2d, 0x2e, 0xd0, 0x3a, 0x3c,
+	0xef, 0xa9, 0x50, 0xf0, 0x2d, 0x21, 0x6f, 0x55, 0x4c, 0x85, 0xa4, 0x8a, 0x48, 0xc6, 0x35, 0x1b,
+	0x74, 0xc2, 0x26, 0xc9, 0x80, 0x52, 0xb0, 0xef, 0x23, 0x28, 0x77, 0x69, 0x22, 0xa5, 0x6f, 0x1e,
+	0xeb, 0x56, 0xe5, 0xda, 0xb3, 0xee, 0xed, 0x02, 0xeb, 0xae, 0x75, 0xcc, 0xdc, 0xdb, 0x04, 0xfc,
+	0x9f, 0x12, 0x42, 0xcf, 0x01, 0x8c, 0xb3, 0x35, 0x8d, 0x26, 0x43, 0xf0, 0xc2, 0x77, 0x81, 0x30,
+	0x75, 0xb2, 0x13, 0xab, 0x25, 0xc9, 0xcf, 0x7e, 0xfc, 0x7e, 0xdd, 0x93, 0x42, 0xd3, 0x8a, 0x45,
+	0xb6, 0xdc, 0x19, 0x13, 0xbb, 0x8f, 0x88, 0xbd, 0x45, 0x5f, 0x6c, 0x62, 0x18, 0xc1, 0xeb, 0x0b,
+	0xbd, 0x07, 0x30, 0xe1, 0x69, 0x81, 0x52, 0xd1, 0x25, 0xc2, 0x57, 0x85, 0x70, 0xf9, 0x14, 0x9e,
+	0x1c, 0xd1, 0x12, 0x45, 0x74, 0x03, 0x5d, 0x6f, 0x87, 0xa8, 0xf1, 0xf0, 0x24, 0x38, 0x2d, 0x4f,
+	0xd1, 0x1b, 0x00, 0xcf, 0xf8, 0x37, 0x2d, 0x6a, 0x01, 0x20, 0xe2, 0x76, 0x10, 0xd2, 0xa7, 0x71,
+	0xe5, 0x60, 0x67, 0x29, 0xd8, 0x34, 0x4a, 0x9d, 0x16, 0x2c, 0xfa, 0x02, 0xe0, 0x60, 0xc4, 0xc2,
+	0x42, 0xb3, 0xd1, 0x55, 0x5b, 0x2f, 0x63, 0x21, 0xd3, 0x41, 0x04, 0x87, 0x9b, 0xa3, 0x70, 0x6f,
+	0xa3, 0x9b, 0xed, 0xe0, 0xfa, 0xb6, 0x5f, 0x94, 0xbc, 0x5f, 0x7d, 0x24, 0xfc, 0x6d, 0x3f, 0xdf,
+	0xf6, 0x98, 0xc3, 0xb3, 0x2f, 0x2c, 0x74, 0x16, 0xc4, 0xa9, 0x2c, 0x53, 0x2a, 0x8b, 0xe8, 0x56,
+	0xdb, 0xc6, 0xf5, 0x8d, 0x77, 0x14, 0x97, 0xcf, 0x00, 0x5e, 0x88, 0xde, 0x16, 0xad, 0xe8, 0x9c,
+	0xb8, 0xca, 0x84, 0x85, 0xce, 0x82, 0x38, 0x9d, 0x05, 0x4a, 0x47, 0x46, 0x57, 0x3a, 0xa1, 0x93,
+	0xbd, 0xbf, 0x5f, 0x13, 0xc1, 0x41, 0x4d, 0x04, 0x87, 0x35, 0x11, 0xfc, 0xaa, 0x89, 0xe0, 0xd5,
+	0x91, 0x18, 0x3b, 0x38, 0x12, 0x63, 0x87, 0x47, 0x62, 0xec, 0x61, 0x46, 0xd3, 0xdd, 0xcd, 0x6a,
+	0x51, 0x2e, 0x91, 0x4a, 0x8b, 0xac, 0x8f, 0x9b, 0x79, 0xdd, 0x5d, 0x0b, 0x3b, 0xc5, 0x38, 0xfd,
+	0x77, 0x39, 0xff, 0x77, 0x00, 0xf2, 0x27, 0x06, 0x85, 0xbc, 0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -383,6 +668,11 @@ type QueryClient interface {
 	// Queries a list of Supplier items.
 	Supplier(ctx context.Context, in *QueryGetSupplierRequest, opts ...grpc.CallOption) (*QueryGetSupplierResponse, error)
 	AllSuppliers(ctx context.Context, in *QueryAllSuppliersRequest, opts ...grpc.CallOption) (*QueryAllSuppliersResponse, error)
+	// Queries a list of the delegations to a supplier.
+	SupplierDelegations(ctx context.Context, in *QuerySupplierDelegationsRequest, opts ...grpc.CallOption) (*QuerySupplierDelegationsResponse, error)
+	// Queries the proof faults of a supplier within the proof fault window.
+	SupplierProofFaults(ctx context.Context, in *QueryGetSupplierProofFaultsRequest, opts ...grpc.CallOption) (*QueryGetSupplierProofFaultsResponse, error)
+	AllSupplierProofFaults(ctx context.Context, in *QueryAllSupplierProofFaultsRequest, opts ...grpc.CallOption) (*QueryAllSupplierProofFaultsResponse, error)
 }
 
 type queryClient struct {
@@ -420,6 +710,33 @@ func (c *queryClient) AllSuppliers(ctx context.Context, in *QueryAllSuppliersReq
 	return out, nil
 }
 
+func (c *queryClient) SupplierDelegations(ctx context.Context, in *QuerySupplierDelegationsRequest, opts ...grpc.CallOption) (*QuerySupplierDelegationsResponse, error) {
+	out := new(QuerySupplierDelegationsResponse)
+	err := c.cc.Invoke(ctx, "/pocket.supplier.Query/SupplierDelegations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SupplierProofFaults(ctx context.Context, in *QueryGetSupplierProofFaultsRequest, opts ...grpc.CallOption) (*QueryGetSupplierProofFaultsResponse, error) {
+	out := new(QueryGetSupplierProofFaultsResponse)
+	err := c.cc.Invoke(ctx, "/pocket.supplier.Query/SupplierProofFaults", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllSupplierProofFaults(ctx context.Context, in *QueryAllSupplierProofFaultsRequest, opts ...grpc.CallOption) (*QueryAllSupplierProofFaultsResponse, error) {
+	out := new(QueryAllSupplierProofFaultsResponse)
+	err := c.cc.Invoke(ctx, "/pocket.supplier.Query/AllSupplierProofFaults", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
@@ -427,6 +744,11 @@ type QueryServer interface {
 	// Queries a list of Supplier items.
 	Supplier(context.Context, *QueryGetSupplierRequest) (*QueryGetSupplierResponse, error)
 	AllSuppliers(context.Context, *QueryAllSuppliersRequest) (*QueryAllSuppliersResponse, error)
+	// Queries a list of the delegations to a supplier.
+	SupplierDelegations(context.Context, *QuerySupplierDelegationsRequest) (*QuerySupplierDelegationsResponse, error)
+	// Queries the proof faults of a supplier within the proof fault window.
+	SupplierProofFaults(context.Context, *QueryGetSupplierProofFaultsRequest) (*QueryGetSupplierProofFaultsResponse, error)
+	AllSupplierProofFaults(context.Context, *QueryAllSupplierProofFaultsRequest) (*QueryAllSupplierProofFaultsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -442,6 +764,15 @@ func (*UnimplementedQueryServer) Supplier(ctx context.Context, req *QueryGetSupp
 func (*UnimplementedQueryServer) AllSuppliers(ctx context.Context, req *QueryAllSuppliersRequest) (*QueryAllSuppliersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AllSuppliers not implemented")
 }
+func (*UnimplementedQueryServer) SupplierDelegations(ctx context.Context, req *QuerySupplierDelegationsRequest) (*QuerySupplierDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SupplierDelegations not implemented")
+}
+func (*UnimplementedQueryServer) SupplierProofFaults(ctx context.Context, req *QueryGetSupplierProofFaultsRequest) (*QueryGetSupplierProofFaultsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SupplierProofFaults not implemented")
+}
+func (*UnimplementedQueryServer) AllSupplierProofFaults(ctx context.Context, req *QueryAllSupplierProofFaultsRequest) (*QueryAllSupplierProofFaultsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllSupplierProofFaults not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -501,6 +832,60 @@ func _Query_AllSuppliers_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SupplierDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySupplierDelegationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SupplierDelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pocket.supplier.Query/SupplierDelegations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SupplierDelegations(ctx, req.(*QuerySupplierDelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SupplierProofFaults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetSupplierProofFaultsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SupplierProofFaults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pocket.supplier.Query/SupplierProofFaults",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SupplierProofFaults(ctx, req.(*QueryGetSupplierProofFaultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllSupplierProofFaults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllSupplierProofFaultsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllSupplierProofFaults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pocket.supplier.Query/AllSupplierProofFaults",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllSupplierProofFaults(ctx, req.(*QueryAllSupplierProofFaultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "pocket.supplier.Query",
@@ -518,6 +903,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AllSuppliers",
 			Handler:    _Query_AllSuppliers_Handler,
 		},
+		{
+			MethodName: "SupplierDelegations",
+			Handler:    _Query_SupplierDelegations_Handler,
+		},
+		{
+			MethodName: "SupplierProofFaults",
+			Handler:    _Query_SupplierProofFaults_Handler,
+		},
+		{
+			MethodName: "AllSupplierProofFaults",
+			Handler:    _Query_AllSupplierProofFaults_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pocket/supplier/query.proto",
@@ -749,31 +1146,269 @@ func (m *QueryAllSuppliersResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QuerySupplierDelegationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QuerySupplierDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
+func (m *QuerySupplierDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySupplierDelegationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySupplierDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySupplierDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Delegations) > 0 {
+		for iNdEx := len(m.Delegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Delegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetSupplierProofFaultsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetSupplierProofFaultsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetSupplierProofFaultsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetSupplierProofFaultsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetSupplierProofFaultsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetSupplierProofFaultsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.SupplierProofFaults.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllSupplierProofFaultsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllSupplierProofFaultsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllSupplierProofFaultsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllSupplierProofFaultsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllSupplierProofFaultsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllSupplierProofFaultsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SupplierProofFaults) > 0 {
+		for iNdEx := len(m.SupplierProofFaults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SupplierProofFaults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovQuery(uint64(l))
@@ -842,20 +1477,648 @@ func (m *QueryAllSuppliersResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySupplierDelegationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySupplierDelegationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Delegations) > 0 {
+		for _, e := range m.Delegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetSupplierProofFaultsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetSupplierProofFaultsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SupplierProofFaults.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllSupplierProofFaultsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllSupplierProofFaultsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SupplierProofFaults) > 0 {
+		for _, e := range m.SupplierProofFaults {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetSupplierRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetSupplierRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetSupplierRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetSupplierResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetSupplierResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetSupplierResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supplier", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Supplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllSuppliersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllSuppliersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllSuppliersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Filter = &QueryAllSuppliersRequest_ServiceId{string(dAtA[iNdEx:postIndex])}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllSuppliersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllSuppliersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllSuppliersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supplier", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Supplier = append(m.Supplier, types.Supplier{})
+			if err := m.Supplier[len(m.Supplier)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySupplierDelegationsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -878,12 +2141,80 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySupplierDelegationsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySupplierDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -905,7 +2236,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySupplierDelegationsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -928,15 +2259,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySupplierDelegationsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySupplierDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -963,7 +2294,44 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Delegations = append(m.Delegations, Delegation{})
+			if err := m.Delegations[len(m.Delegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -988,7 +2356,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetSupplierRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetSupplierProofFaultsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1011,10 +2379,10 @@ func (m *QueryGetSupplierRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetSupplierRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetSupplierProofFaultsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetSupplierRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetSupplierProofFaultsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1070,7 +2438,7 @@ func (m *QueryGetSupplierRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetSupplierResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetSupplierProofFaultsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1093,15 +2461,15 @@ func (m *QueryGetSupplierResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetSupplierResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetSupplierProofFaultsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetSupplierResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetSupplierProofFaultsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Supplier", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplierProofFaults", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1128,7 +2496,7 @@ func (m *QueryGetSupplierResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Supplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SupplierProofFaults.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1153,7 +2521,7 @@ func (m *QueryGetSupplierResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllSuppliersRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllSupplierProofFaultsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1176,10 +2544,10 @@ func (m *QueryAllSuppliersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllSuppliersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllSupplierProofFaultsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllSuppliersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllSupplierProofFaultsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1218,38 +2586,6 @@ func (m *QueryAllSuppliersRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Filter = &QueryAllSuppliersRequest_ServiceId{string(dAtA[iNdEx:postIndex])}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1271,7 +2607,7 @@ func (m *QueryAllSuppliersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllSuppliersResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllSupplierProofFaultsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1294,15 +2630,15 @@ func (m *QueryAllSuppliersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllSuppliersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllSupplierProofFaultsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllSuppliersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllSupplierProofFaultsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Supplier", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplierProofFaults", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1329,8 +2665,8 @@ func (m *QueryAllSuppliersResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Supplier = append(m.Supplier, types.Supplier{})
-			if err := m.Supplier[len(m.Supplier)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SupplierProofFaults = append(m.SupplierProofFaults, SupplierProofFaults{})
+			if err := m.SupplierProofFaults[len(m.SupplierProofFaults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
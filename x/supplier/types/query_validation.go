@@ -43,3 +43,23 @@ func (query *QueryAllSuppliersRequest) ValidateBasic() error {
 
 	return nil
 }
+
+// ValidateBasic performs basic (non-state-dependant) validation on a QuerySupplierDelegationsRequest.
+func (query *QuerySupplierDelegationsRequest) ValidateBasic() error {
+	// Validate the supplier operator address
+	if _, err := sdk.AccAddressFromBech32(query.OperatorAddress); err != nil {
+		return ErrSupplierInvalidAddress.Wrapf("invalid supplier operator address %s; (%v)", query.OperatorAddress, err)
+	}
+
+	return nil
+}
+
+// ValidateBasic performs basic (non-state-dependant) validation on a QueryGetSupplierProofFaultsRequest.
+func (query *QueryGetSupplierProofFaultsRequest) ValidateBasic() error {
+	// Validate the supplier operator address
+	if _, err := sdk.AccAddressFromBech32(query.OperatorAddress); err != nil {
+		return ErrSupplierInvalidAddress.Wrapf("invalid supplier operator address %s; (%v)", query.OperatorAddress, err)
+	}
+
+	return nil
+}
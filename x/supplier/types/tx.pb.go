@@ -325,8 +325,8 @@ type MsgUpdateParam struct {
 	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
 	Name      string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	// Types that are valid to be assigned to AsType:
-	//
 	//	*MsgUpdateParam_AsCoin
+	//	*MsgUpdateParam_AsUint64
 	AsType isMsgUpdateParam_AsType `protobuf_oneof:"asType"`
 }
 
@@ -368,8 +368,12 @@ type isMsgUpdateParam_AsType interface {
 type MsgUpdateParam_AsCoin struct {
 	AsCoin *types.Coin `protobuf:"bytes,3,opt,name=as_coin,json=asCoin,proto3,oneof" json:"as_coin,omitempty"`
 }
+type MsgUpdateParam_AsUint64 struct {
+	AsUint64 uint64 `protobuf:"varint,4,opt,name=as_uint64,json=asUint64,proto3,oneof" json:"as_uint64,omitempty"`
+}
 
-func (*MsgUpdateParam_AsCoin) isMsgUpdateParam_AsType() {}
+func (*MsgUpdateParam_AsCoin) isMsgUpdateParam_AsType()   {}
+func (*MsgUpdateParam_AsUint64) isMsgUpdateParam_AsType() {}
 
 func (m *MsgUpdateParam) GetAsType() isMsgUpdateParam_AsType {
 	if m != nil {
@@ -399,10 +403,18 @@ func (m *MsgUpdateParam) GetAsCoin() *types.Coin {
 	return nil
 }
 
+func (m *MsgUpdateParam) GetAsUint64() uint64 {
+	if x, ok := m.GetAsType().(*MsgUpdateParam_AsUint64); ok {
+		return x.AsUint64
+	}
+	return 0
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*MsgUpdateParam) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*MsgUpdateParam_AsCoin)(nil),
+		(*MsgUpdateParam_AsUint64)(nil),
 	}
 }
 
@@ -446,6 +458,389 @@ func (m *MsgUpdateParamResponse) GetParams() *Params {
 	return nil
 }
 
+// MsgDelegateToSupplier defines a message for a token holder to delegate uPOKT
+// to a supplier. Delegating to a supplier the signer is undelegating from cancels
+// the undelegation.
+type MsgDelegateToSupplier struct {
+	DelegatorAddress        string      `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	SupplierOperatorAddress string      `protobuf:"bytes,2,opt,name=supplier_operator_address,json=supplierOperatorAddress,proto3" json:"supplier_operator_address,omitempty"`
+	Amount                  *types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (m *MsgDelegateToSupplier) Reset()         { *m = MsgDelegateToSupplier{} }
+func (m *MsgDelegateToSupplier) String() string { return proto.CompactTextString(m) }
+func (*MsgDelegateToSupplier) ProtoMessage()    {}
+func (*MsgDelegateToSupplier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fde5318adc7c16a9, []int{8}
+}
+func (m *MsgDelegateToSupplier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDelegateToSupplier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *MsgDelegateToSupplier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDelegateToSupplier.Merge(m, src)
+}
+func (m *MsgDelegateToSupplier) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDelegateToSupplier) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDelegateToSupplier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDelegateToSupplier proto.InternalMessageInfo
+
+func (m *MsgDelegateToSupplier) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
+func (m *MsgDelegateToSupplier) GetSupplierOperatorAddress() string {
+	if m != nil {
+		return m.SupplierOperatorAddress
+	}
+	return ""
+}
+
+func (m *MsgDelegateToSupplier) GetAmount() *types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+type MsgDelegateToSupplierResponse struct {
+	Delegation *Delegation `protobuf:"bytes,1,opt,name=delegation,proto3" json:"delegation,omitempty"`
+}
+
+func (m *MsgDelegateToSupplierResponse) Reset()         { *m = MsgDelegateToSupplierResponse{} }
+func (m *MsgDelegateToSupplierResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDelegateToSupplierResponse) ProtoMessage()    {}
+func (*MsgDelegateToSupplierResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fde5318adc7c16a9, []int{9}
+}
+func (m *MsgDelegateToSupplierResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDelegateToSupplierResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *MsgDelegateToSupplierResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDelegateToSupplierResponse.Merge(m, src)
+}
+func (m *MsgDelegateToSupplierResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDelegateToSupplierResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDelegateToSupplierResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDelegateToSupplierResponse proto.InternalMessageInfo
+
+func (m *MsgDelegateToSupplierResponse) GetDelegation() *Delegation {
+	if m != nil {
+		return m.Delegation
+	}
+	return nil
+}
+
+// MsgUndelegateFromSupplier defines a message for a delegator to begin unbonding
+// its whole delegation to a supplier. The delegated stake is returned to the
+// delegator once the supplier unbonding period has elapsed.
+type MsgUndelegateFromSupplier struct {
+	DelegatorAddress        string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	SupplierOperatorAddress string `protobuf:"bytes,2,opt,name=supplier_operator_address,json=supplierOperatorAddress,proto3" json:"supplier_operator_address,omitempty"`
+}
+
+func (m *MsgUndelegateFromSupplier) Reset()         { *m = MsgUndelegateFromSupplier{} }
+func (m *MsgUndelegateFromSupplier) String() string { return proto.CompactTextString(m) }
+func (*MsgUndelegateFromSupplier) ProtoMessage()    {}
+func (*MsgUndelegateFromSupplier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fde5318adc7c16a9, []int{10}
+}
+func (m *MsgUndelegateFromSupplier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUndelegateFromSupplier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *MsgUndelegateFromSupplier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUndelegateFromSupplier.Merge(m, src)
+}
+func (m *MsgUndelegateFromSupplier) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUndelegateFromSupplier) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUndelegateFromSupplier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUndelegateFromSupplier proto.InternalMessageInfo
+
+func (m *MsgUndelegateFromSupplier) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
+func (m *MsgUndelegateFromSupplier) GetSupplierOperatorAddress() string {
+	if m != nil {
+		return m.SupplierOperatorAddress
+	}
+	return ""
+}
+
+type MsgUndelegateFromSupplierResponse struct {
+	Delegation *Delegation `protobuf:"bytes,1,opt,name=delegation,proto3" json:"delegation,omitempty"`
+}
+
+func (m *MsgUndelegateFromSupplierResponse) Reset()         { *m = MsgUndelegateFromSupplierResponse{} }
+func (m *MsgUndelegateFromSupplierResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUndelegateFromSupplierResponse) ProtoMessage()    {}
+func (*MsgUndelegateFromSupplierResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fde5318adc7c16a9, []int{11}
+}
+func (m *MsgUndelegateFromSupplierResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUndelegateFromSupplierResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *MsgUndelegateFromSupplierResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUndelegateFromSupplierResponse.Merge(m, src)
+}
+func (m *MsgUndelegateFromSupplierResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUndelegateFromSupplierResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUndelegateFromSupplierResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUndelegateFromSupplierResponse proto.InternalMessageInfo
+
+func (m *MsgUndelegateFromSupplierResponse) GetDelegation() *Delegation {
+	if m != nil {
+		return m.Delegation
+	}
+	return nil
+}
+
+// MsgSetSupplierDelegationCommission defines a message for the supplier owner to
+// update the commission it keeps from the delegators' share of the settlement rewards.
+type MsgSetSupplierDelegationCommission struct {
+	OwnerAddress                   string `protobuf:"bytes,1,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`
+	OperatorAddress                string `protobuf:"bytes,2,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty"`
+	DelegationCommissionPercentage uint64 `protobuf:"varint,3,opt,name=delegation_commission_percentage,json=delegationCommissionPercentage,proto3" json:"delegation_commission_percentage,omitempty"`
+}
+
+func (m *MsgSetSupplierDelegationCommission) Reset()         { *m = MsgSetSupplierDelegationCommission{} }
+func (m *MsgSetSupplierDelegationCommission) String() string { return proto.CompactTextString(m) }
+func (*MsgSetSupplierDelegationCommission) ProtoMessage()    {}
+func (*MsgSetSupplierDelegationCommission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fde5318adc7c16a9, []int{12}
+}
+func (m *MsgSetSupplierDelegationCommission) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetSupplierDelegationCommission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *MsgSetSupplierDelegationCommission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetSupplierDelegationCommission.Merge(m, src)
+}
+func (m *MsgSetSupplierDelegationCommission) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetSupplierDelegationCommission) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetSupplierDelegationCommission.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetSupplierDelegationCommission proto.InternalMessageInfo
+
+func (m *MsgSetSupplierDelegationCommission) GetOwnerAddress() string {
+	if m != nil {
+		return m.OwnerAddress
+	}
+	return ""
+}
+
+func (m *MsgSetSupplierDelegationCommission) GetOperatorAddress() string {
+	if m != nil {
+		return m.OperatorAddress
+	}
+	return ""
+}
+
+func (m *MsgSetSupplierDelegationCommission) GetDelegationCommissionPercentage() uint64 {
+	if m != nil {
+		return m.DelegationCommissionPercentage
+	}
+	return 0
+}
+
+type MsgSetSupplierDelegationCommissionResponse struct {
+	Supplier *types1.Supplier `protobuf:"bytes,1,opt,name=supplier,proto3" json:"supplier,omitempty"`
+}
+
+func (m *MsgSetSupplierDelegationCommissionResponse) Reset() {
+	*m = MsgSetSupplierDelegationCommissionResponse{}
+}
+func (m *MsgSetSupplierDelegationCommissionResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgSetSupplierDelegationCommissionResponse) ProtoMessage() {}
+func (*MsgSetSupplierDelegationCommissionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fde5318adc7c16a9, []int{13}
+}
+func (m *MsgSetSupplierDelegationCommissionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetSupplierDelegationCommissionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *MsgSetSupplierDelegationCommissionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetSupplierDelegationCommissionResponse.Merge(m, src)
+}
+func (m *MsgSetSupplierDelegationCommissionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetSupplierDelegationCommissionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetSupplierDelegationCommissionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetSupplierDelegationCommissionResponse proto.InternalMessageInfo
+
+func (m *MsgSetSupplierDelegationCommissionResponse) GetSupplier() *types1.Supplier {
+	if m != nil {
+		return m.Supplier
+	}
+	return nil
+}
+
+// MsgUnjailSupplier defines a message for the supplier owner or operator to
+// unjail a supplier once its jail period has elapsed, so it is included in
+// sessions again starting from the next session.
+type MsgUnjailSupplier struct {
+	Signer          string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	OperatorAddress string `protobuf:"bytes,2,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty"`
+}
+
+func (m *MsgUnjailSupplier) Reset()         { *m = MsgUnjailSupplier{} }
+func (m *MsgUnjailSupplier) String() string { return proto.CompactTextString(m) }
+func (*MsgUnjailSupplier) ProtoMessage()    {}
+func (*MsgUnjailSupplier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fde5318adc7c16a9, []int{14}
+}
+func (m *MsgUnjailSupplier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnjailSupplier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *MsgUnjailSupplier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnjailSupplier.Merge(m, src)
+}
+func (m *MsgUnjailSupplier) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnjailSupplier) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnjailSupplier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnjailSupplier proto.InternalMessageInfo
+
+func (m *MsgUnjailSupplier) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *MsgUnjailSupplier) GetOperatorAddress() string {
+	if m != nil {
+		return m.OperatorAddress
+	}
+	return ""
+}
+
+type MsgUnjailSupplierResponse struct {
+	Supplier *types1.Supplier `protobuf:"bytes,1,opt,name=supplier,proto3" json:"supplier,omitempty"`
+}
+
+func (m *MsgUnjailSupplierResponse) Reset()         { *m = MsgUnjailSupplierResponse{} }
+func (m *MsgUnjailSupplierResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnjailSupplierResponse) ProtoMessage()    {}
+func (*MsgUnjailSupplierResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fde5318adc7c16a9, []int{15}
+}
+func (m *MsgUnjailSupplierResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnjailSupplierResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *MsgUnjailSupplierResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnjailSupplierResponse.Merge(m, src)
+}
+func (m *MsgUnjailSupplierResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnjailSupplierResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnjailSupplierResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnjailSupplierResponse proto.InternalMessageInfo
+
+func (m *MsgUnjailSupplierResponse) GetSupplier() *types1.Supplier {
+	if m != nil {
+		return m.Supplier
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "pocket.supplier.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "pocket.supplier.MsgUpdateParamsResponse")
@@ -455,57 +850,84 @@ func init() {
 	proto.RegisterType((*MsgUnstakeSupplierResponse)(nil), "pocket.supplier.MsgUnstakeSupplierResponse")
 	proto.RegisterType((*MsgUpdateParam)(nil), "pocket.supplier.MsgUpdateParam")
 	proto.RegisterType((*MsgUpdateParamResponse)(nil), "pocket.supplier.MsgUpdateParamResponse")
+	proto.RegisterType((*MsgDelegateToSupplier)(nil), "pocket.supplier.MsgDelegateToSupplier")
+	proto.RegisterType((*MsgDelegateToSupplierResponse)(nil), "pocket.supplier.MsgDelegateToSupplierResponse")
+	proto.RegisterType((*MsgUndelegateFromSupplier)(nil), "pocket.supplier.MsgUndelegateFromSupplier")
+	proto.RegisterType((*MsgUndelegateFromSupplierResponse)(nil), "pocket.supplier.MsgUndelegateFromSupplierResponse")
+	proto.RegisterType((*MsgSetSupplierDelegationCommission)(nil), "pocket.supplier.MsgSetSupplierDelegationCommission")
+	proto.RegisterType((*MsgSetSupplierDelegationCommissionResponse)(nil), "pocket.supplier.MsgSetSupplierDelegationCommissionResponse")
+	proto.RegisterType((*MsgUnjailSupplier)(nil), "pocket.supplier.MsgUnjailSupplier")
+	proto.RegisterType((*MsgUnjailSupplierResponse)(nil), "pocket.supplier.MsgUnjailSupplierResponse")
 }
 
 func init() { proto.RegisterFile("pocket/supplier/tx.proto", fileDescriptor_fde5318adc7c16a9) }
 
 var fileDescriptor_fde5318adc7c16a9 = []byte{
-	// 706 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0x4f, 0x4f, 0x13, 0x4f,
-	0x18, 0xee, 0x52, 0xe8, 0x0f, 0xde, 0x02, 0xe5, 0xb7, 0x21, 0xb2, 0xac, 0x66, 0x29, 0xd5, 0xc4,
-	0x8a, 0x61, 0xd7, 0x02, 0xf1, 0xd0, 0xc4, 0x44, 0xcb, 0x45, 0x0f, 0x04, 0xdd, 0x6a, 0x4c, 0x48,
-	0x0c, 0x99, 0xb6, 0xe3, 0xb2, 0x29, 0xdd, 0xd9, 0xcc, 0x0c, 0xff, 0x6e, 0xc6, 0xc4, 0x8b, 0x27,
-	0x8f, 0x7e, 0x04, 0x0f, 0x1e, 0x38, 0x78, 0xf3, 0x0b, 0x70, 0x24, 0x9e, 0x38, 0x19, 0x53, 0x0e,
-	0x7c, 0x0d, 0xb3, 0x3b, 0xb3, 0x5b, 0x76, 0x21, 0xac, 0xca, 0xa5, 0x9d, 0x99, 0xf7, 0x79, 0x9f,
-	0x79, 0x9f, 0xf7, 0xcf, 0x2c, 0x68, 0x3e, 0x69, 0x77, 0x31, 0xb7, 0xd8, 0x8e, 0xef, 0x6f, 0xbb,
-	0x98, 0x5a, 0x7c, 0xdf, 0xf4, 0x29, 0xe1, 0x44, 0x2d, 0x09, 0x8b, 0x19, 0x59, 0xf4, 0xff, 0x51,
-	0xcf, 0xf5, 0x88, 0x15, 0xfe, 0x0a, 0x8c, 0x3e, 0xd3, 0x26, 0xac, 0x47, 0x98, 0xd5, 0x63, 0x8e,
-	0xb5, 0x5b, 0x0b, 0xfe, 0xa4, 0x61, 0x56, 0x18, 0x36, 0xc3, 0x9d, 0x25, 0x36, 0xd2, 0x34, 0xed,
-	0x10, 0x87, 0x88, 0xf3, 0x60, 0x25, 0x4f, 0x0d, 0xc9, 0xd4, 0x42, 0x0c, 0x5b, 0xbb, 0xb5, 0x16,
-	0xe6, 0xa8, 0x66, 0xb5, 0x89, 0xeb, 0x49, 0xfb, 0xad, 0x74, 0x9c, 0x3e, 0xa2, 0xa8, 0x17, 0x71,
-	0xde, 0x8c, 0xac, 0x5b, 0x88, 0xe2, 0x8e, 0xc5, 0x30, 0xdd, 0x75, 0xdb, 0x38, 0xed, 0x2a, 0x8d,
-	0x92, 0x41, 0x58, 0x2b, 0xdf, 0x15, 0x28, 0xad, 0x31, 0xe7, 0x95, 0xdf, 0x41, 0x1c, 0x3f, 0x0f,
-	0x49, 0xd5, 0x87, 0x30, 0x86, 0x76, 0xf8, 0x16, 0xa1, 0x2e, 0x3f, 0xd0, 0x94, 0xb2, 0x52, 0x1d,
-	0x6b, 0x68, 0x3f, 0xbe, 0x2d, 0x4e, 0x4b, 0x1d, 0x4f, 0x3a, 0x1d, 0x8a, 0x19, 0x6b, 0x72, 0xea,
-	0x7a, 0x8e, 0x3d, 0x80, 0xaa, 0x75, 0x28, 0x88, 0xb0, 0xb4, 0xa1, 0xb2, 0x52, 0x2d, 0x2e, 0xcd,
-	0x98, 0xa9, 0x1c, 0x9a, 0xe2, 0x82, 0xc6, 0xd8, 0xd1, 0xcf, 0xb9, 0xdc, 0x97, 0xb3, 0xc3, 0x05,
-	0xc5, 0x96, 0x1e, 0xf5, 0x95, 0xf7, 0x67, 0x87, 0x0b, 0x03, 0xae, 0x8f, 0x67, 0x87, 0x0b, 0xf3,
-	0x32, 0xf0, 0xfd, 0x81, 0xea, 0x54, 0xa4, 0x95, 0x59, 0x98, 0x49, 0x1d, 0xd9, 0x98, 0xf9, 0xc4,
-	0x63, 0xb8, 0x72, 0x34, 0x04, 0x53, 0x6b, 0xcc, 0x69, 0x72, 0xd4, 0xc5, 0x4d, 0xe9, 0xaf, 0x3e,
-	0x80, 0x02, 0x73, 0x1d, 0x0f, 0xd3, 0x4c, 0x59, 0x12, 0xa7, 0x3e, 0x82, 0x09, 0xb2, 0xe7, 0x61,
-	0xba, 0x89, 0x84, 0x39, 0x94, 0x76, 0x95, 0xe3, 0x78, 0x08, 0x97, 0x67, 0xea, 0x2a, 0x4c, 0x11,
-	0x1f, 0x53, 0xc4, 0xc9, 0x80, 0x21, 0x9f, 0xc1, 0x50, 0x8a, 0x3c, 0x22, 0x12, 0x0b, 0x46, 0x58,
-	0x20, 0x43, 0x1b, 0x0e, 0xd3, 0x3a, 0x6b, 0x4a, 0xb7, 0xa0, 0x59, 0x4c, 0xd9, 0x2c, 0xe6, 0x2a,
-	0x71, 0x3d, 0x5b, 0xe0, 0xd4, 0xc7, 0x30, 0x2a, 0x7b, 0x80, 0x69, 0x23, 0xe5, 0x7c, 0xb5, 0xb8,
-	0x74, 0x27, 0x2e, 0x45, 0xd8, 0x05, 0x66, 0x94, 0x91, 0xa6, 0x80, 0xad, 0x12, 0xef, 0xad, 0xeb,
-	0xd8, 0xb1, 0x57, 0xbd, 0x18, 0x94, 0x43, 0xe6, 0xa0, 0xb2, 0x0e, 0x5a, 0x3a, 0x93, 0x51, 0x9a,
-	0xd5, 0x65, 0x18, 0x8d, 0xaa, 0x13, 0xe6, 0xf4, 0x7c, 0xd5, 0x93, 0x57, 0xd9, 0x31, 0xb0, 0xf2,
-	0x59, 0x01, 0x35, 0xa8, 0x9b, 0xc7, 0xae, 0x59, 0x9d, 0xcb, 0xd2, 0x3b, 0xf4, 0x97, 0xe9, 0x4d,
-	0x6a, 0x7d, 0x01, 0xfa, 0xc5, 0xc8, 0xae, 0xa7, 0xf6, 0xab, 0x02, 0x93, 0xc9, 0x2e, 0xfd, 0xe7,
-	0x09, 0x53, 0x61, 0xd8, 0x43, 0x3d, 0x2c, 0x34, 0xda, 0xe1, 0x5a, 0x5d, 0x81, 0xff, 0x10, 0xdb,
-	0x0c, 0xde, 0x8a, 0xb0, 0xb3, 0xae, 0xea, 0x8f, 0xa7, 0x39, 0xbb, 0x80, 0x58, 0xb0, 0xaa, 0x4f,
-	0x26, 0xe7, 0xad, 0x31, 0x0a, 0x05, 0xc4, 0x5e, 0x1e, 0xf8, 0xb8, 0xf2, 0x0c, 0x6e, 0x24, 0xa3,
-	0x8d, 0xd5, 0x5b, 0xf1, 0x7c, 0x2b, 0x57, 0xce, 0x77, 0x34, 0xd4, 0x4b, 0x1f, 0xf2, 0x90, 0x5f,
-	0x63, 0x8e, 0xba, 0x01, 0xe3, 0x89, 0x07, 0xa6, 0x7c, 0xc1, 0x31, 0x35, 0xc5, 0x7a, 0x35, 0x0b,
-	0x11, 0x07, 0xf5, 0x06, 0x26, 0x92, 0x33, 0x3e, 0x7f, 0x99, 0x6b, 0x02, 0xa2, 0xdf, 0xcb, 0x84,
-	0xc4, 0xf4, 0x6d, 0x28, 0xa5, 0xdb, 0xf4, 0xf6, 0xa5, 0xb1, 0x25, 0x41, 0xfa, 0xfd, 0x3f, 0x00,
-	0xc5, 0x97, 0xbc, 0x86, 0xe2, 0xf9, 0xee, 0x98, 0xcb, 0x10, 0xaf, 0xdf, 0xcd, 0x00, 0x44, 0xc4,
-	0xfa, 0xc8, 0xbb, 0xe0, 0x91, 0x6d, 0xac, 0x1f, 0xf5, 0x0d, 0xe5, 0xb8, 0x6f, 0x28, 0x27, 0x7d,
-	0x43, 0xf9, 0xd5, 0x37, 0x94, 0x4f, 0xa7, 0x46, 0xee, 0xf8, 0xd4, 0xc8, 0x9d, 0x9c, 0x1a, 0xb9,
-	0x8d, 0x9a, 0xe3, 0xf2, 0xad, 0x9d, 0x96, 0xd9, 0x26, 0x3d, 0xcb, 0x27, 0x5d, 0xbe, 0xe8, 0x61,
-	0xbe, 0x47, 0x68, 0x37, 0xdc, 0x50, 0xb2, 0xbd, 0x7d, 0xfe, 0x05, 0xe6, 0x07, 0x3e, 0x66, 0xad,
-	0x42, 0xf8, 0xf1, 0x58, 0xfe, 0x1d, 0x00, 0x00, 0xff, 0xff, 0xb6, 0x86, 0x8f, 0x5f, 0x3f, 0x07,
-	0x00, 0x00,
+	// 1014 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x57, 0xcf, 0x4f, 0xe4, 0x54,
+	0x1c, 0x9f, 0x0e, 0x30, 0xc2, 0x97, 0x5d, 0x7e, 0x34, 0x2b, 0x74, 0xba, 0xee, 0x30, 0x54, 0xa3,
+	0x38, 0x66, 0x5b, 0x07, 0xc8, 0x1e, 0x66, 0x63, 0xa2, 0xb0, 0x1a, 0x3c, 0x10, 0xb0, 0xb0, 0x31,
+	0xd9, 0x68, 0x66, 0x1f, 0x33, 0xcf, 0x52, 0x99, 0xf6, 0x35, 0x7d, 0x6f, 0x58, 0xb8, 0x19, 0x0f,
+	0x1e, 0x3c, 0x99, 0x78, 0xd0, 0x3f, 0xc1, 0x23, 0x07, 0x6f, 0xfe, 0x03, 0x24, 0x5e, 0x88, 0xa7,
+	0x3d, 0x19, 0x03, 0x31, 0xfc, 0x19, 0x9a, 0xb6, 0xaf, 0x6f, 0xa6, 0x9d, 0xb2, 0x85, 0x1d, 0x0f,
+	0xee, 0x05, 0xa6, 0xef, 0xfb, 0xf9, 0xfe, 0xfa, 0x7c, 0x7f, 0xbc, 0x16, 0x14, 0x8f, 0xb4, 0x0e,
+	0x30, 0x33, 0x68, 0xd7, 0xf3, 0x3a, 0x36, 0xf6, 0x0d, 0x76, 0xa4, 0x7b, 0x3e, 0x61, 0x44, 0x9e,
+	0x8e, 0x24, 0x7a, 0x2c, 0x51, 0x67, 0x91, 0x63, 0xbb, 0xc4, 0x08, 0xff, 0x46, 0x18, 0x75, 0xbe,
+	0x45, 0xa8, 0x43, 0xa8, 0xe1, 0x50, 0xcb, 0x38, 0xac, 0x07, 0xff, 0xb8, 0xa0, 0x1c, 0x09, 0x9a,
+	0xe1, 0x93, 0x11, 0x3d, 0x70, 0xd1, 0x1d, 0x8b, 0x58, 0x24, 0x3a, 0x0f, 0x7e, 0xf1, 0xd3, 0x0a,
+	0xb7, 0xb4, 0x87, 0x28, 0x36, 0x0e, 0xeb, 0x7b, 0x98, 0xa1, 0xba, 0xd1, 0x22, 0xb6, 0xcb, 0xe5,
+	0xd5, 0x74, 0x9c, 0x6d, 0xdc, 0xc1, 0x16, 0x62, 0x36, 0x89, 0x11, 0x6f, 0xa4, 0x11, 0x1e, 0xf2,
+	0x91, 0x13, 0x7b, 0xbd, 0x1b, 0x4b, 0xf7, 0x91, 0x8f, 0xdb, 0x06, 0xc5, 0xfe, 0xa1, 0xdd, 0xc2,
+	0x69, 0x55, 0x2e, 0xe4, 0x16, 0x22, 0xa9, 0xf6, 0x9b, 0x04, 0xd3, 0x9b, 0xd4, 0x7a, 0xec, 0xb5,
+	0x11, 0xc3, 0xdb, 0xa1, 0x51, 0xf9, 0x01, 0x4c, 0xa0, 0x2e, 0xdb, 0x27, 0xbe, 0xcd, 0x8e, 0x15,
+	0xa9, 0x2a, 0x2d, 0x4d, 0xac, 0x29, 0x7f, 0xfc, 0x7a, 0xff, 0x0e, 0xcf, 0xf4, 0xa3, 0x76, 0xdb,
+	0xc7, 0x94, 0xee, 0x30, 0xdf, 0x76, 0x2d, 0xb3, 0x07, 0x95, 0x1b, 0x50, 0x8a, 0xc2, 0x52, 0x8a,
+	0x55, 0x69, 0x69, 0x72, 0x79, 0x5e, 0x4f, 0xb1, 0xac, 0x47, 0x0e, 0xd6, 0x26, 0x4e, 0xff, 0x5c,
+	0x28, 0xfc, 0x72, 0x79, 0x52, 0x93, 0x4c, 0xae, 0xd1, 0x58, 0xfd, 0xf6, 0xf2, 0xa4, 0xd6, 0xb3,
+	0xf5, 0xfd, 0xe5, 0x49, 0x6d, 0x91, 0x07, 0x7e, 0xd4, 0xcb, 0x3a, 0x15, 0xa9, 0x56, 0x86, 0xf9,
+	0xd4, 0x91, 0x89, 0xa9, 0x47, 0x5c, 0x8a, 0xb5, 0xd3, 0x22, 0xcc, 0x6c, 0x52, 0x6b, 0x87, 0xa1,
+	0x03, 0xbc, 0xc3, 0xf5, 0xe5, 0xf7, 0xa1, 0x44, 0x6d, 0xcb, 0xc5, 0x7e, 0x6e, 0x5a, 0x1c, 0x27,
+	0x7f, 0x00, 0xb7, 0xc9, 0x33, 0x17, 0xfb, 0x4d, 0x14, 0x89, 0x95, 0x62, 0x8e, 0xe2, 0xad, 0x10,
+	0xce, 0xcf, 0xe4, 0x75, 0x98, 0x21, 0x1e, 0xf6, 0x11, 0x23, 0x3d, 0x0b, 0x23, 0x39, 0x16, 0xa6,
+	0x63, 0x8d, 0xd8, 0x88, 0x01, 0x63, 0x34, 0x48, 0x43, 0x19, 0x0d, 0x69, 0x2d, 0xeb, 0x5c, 0x2d,
+	0x68, 0x27, 0x9d, 0xb7, 0x93, 0xbe, 0x4e, 0x6c, 0xd7, 0x8c, 0x70, 0xf2, 0x87, 0x30, 0xce, 0x7b,
+	0x80, 0x2a, 0x63, 0xd5, 0x91, 0xa5, 0xc9, 0xe5, 0xb7, 0x44, 0x29, 0xc2, 0x2e, 0xd0, 0x63, 0x46,
+	0x76, 0x22, 0xd8, 0x3a, 0x71, 0xbf, 0xb2, 0x2d, 0x53, 0x68, 0x35, 0x26, 0x83, 0x72, 0x70, 0x0e,
+	0xb4, 0x2d, 0x50, 0xd2, 0x4c, 0xc6, 0x34, 0xcb, 0x2b, 0x30, 0x1e, 0x57, 0x47, 0x91, 0x52, 0x55,
+	0x4f, 0xba, 0x32, 0x05, 0x50, 0xfb, 0x59, 0x02, 0x39, 0xa8, 0x9b, 0x4b, 0x87, 0xac, 0x4e, 0x16,
+	0xbd, 0xc5, 0x1b, 0xd2, 0x9b, 0xcc, 0xf5, 0x33, 0x50, 0x07, 0x23, 0x1b, 0x2e, 0xdb, 0xdf, 0x25,
+	0x98, 0x4a, 0x76, 0xe9, 0x4b, 0x4f, 0x98, 0x0c, 0xa3, 0x2e, 0x72, 0x70, 0x94, 0xa3, 0x19, 0xfe,
+	0x96, 0x57, 0xe1, 0x35, 0x44, 0x9b, 0xc1, 0x36, 0x51, 0x46, 0x72, 0xfa, 0x63, 0xa3, 0x60, 0x96,
+	0x10, 0x0d, 0x7e, 0xc9, 0xf7, 0x60, 0x02, 0xd1, 0x66, 0xd7, 0x76, 0xd9, 0x83, 0xd5, 0xb0, 0xaf,
+	0x46, 0x37, 0x0a, 0xe6, 0x38, 0xa2, 0x8f, 0xc3, 0x93, 0xc6, 0x54, 0x72, 0x1c, 0xd7, 0xc6, 0xa1,
+	0x84, 0xe8, 0xee, 0xb1, 0x87, 0xb5, 0x4f, 0x61, 0x2e, 0x99, 0x8c, 0x20, 0xc7, 0x10, 0xe3, 0x2f,
+	0xbd, 0x70, 0xfc, 0xe3, 0x99, 0xd7, 0xfe, 0x91, 0xe0, 0xf5, 0x4d, 0x6a, 0x3d, 0x8a, 0x96, 0x1d,
+	0xde, 0x25, 0xa2, 0x13, 0x3e, 0x86, 0x59, 0xbe, 0x02, 0xfb, 0x0a, 0x9b, 0xc7, 0xd3, 0x8c, 0x50,
+	0x89, 0x07, 0x67, 0x17, 0xca, 0xb1, 0xef, 0xe6, 0x8d, 0xfb, 0x64, 0x3e, 0x56, 0xdd, 0x4a, 0x8d,
+	0x63, 0x1d, 0x4a, 0xc8, 0x21, 0x5d, 0x97, 0xe5, 0xf2, 0x6d, 0x72, 0x60, 0x63, 0x2e, 0xa0, 0x73,
+	0x30, 0x25, 0xed, 0x0b, 0xb8, 0x97, 0x49, 0x80, 0xe0, 0xf4, 0x21, 0x40, 0xef, 0x2e, 0xe0, 0xbc,
+	0xde, 0x1d, 0xe0, 0xf5, 0x91, 0x80, 0x98, 0x7d, 0x70, 0xed, 0x4c, 0x82, 0x72, 0xd8, 0xcc, 0xfc,
+	0x0c, 0x7f, 0xe2, 0x13, 0xe7, 0x95, 0xe0, 0xf8, 0x4a, 0xc2, 0x9e, 0xc2, 0xe2, 0x95, 0x19, 0xfd,
+	0x37, 0xa4, 0x7d, 0x57, 0x04, 0x2d, 0xd8, 0x76, 0x98, 0xc5, 0x76, 0x7b, 0xc0, 0x75, 0xe2, 0x38,
+	0x36, 0xa5, 0x36, 0x71, 0x07, 0xef, 0x05, 0x69, 0xe8, 0x7b, 0xe1, 0xa6, 0x8b, 0x4b, 0xde, 0x80,
+	0x6a, 0x2f, 0xf0, 0x66, 0x4b, 0x04, 0xd7, 0xf4, 0xb0, 0xdf, 0xc2, 0x2e, 0x43, 0x16, 0x0e, 0x5b,
+	0x74, 0xd4, 0xac, 0xb4, 0x33, 0x72, 0xd8, 0x16, 0xa8, 0x86, 0x1c, 0xd0, 0x9d, 0x4c, 0x48, 0x43,
+	0x50, 0xcb, 0xe7, 0x61, 0xb8, 0xcd, 0xf8, 0x93, 0x04, 0xb3, 0x61, 0x39, 0xbf, 0x46, 0x76, 0xe7,
+	0x7f, 0x75, 0x0d, 0x6c, 0x43, 0x79, 0x20, 0xb0, 0xa1, 0x72, 0x5d, 0xfe, 0xbb, 0x04, 0x23, 0x9b,
+	0xd4, 0x92, 0x9f, 0xc0, 0xad, 0xc4, 0xcb, 0x56, 0x75, 0xa0, 0x31, 0x53, 0x6f, 0x34, 0xea, 0x52,
+	0x1e, 0x42, 0x04, 0xf6, 0x25, 0xdc, 0x4e, 0xbe, 0xef, 0x2c, 0x66, 0xa9, 0x26, 0x20, 0xea, 0xbb,
+	0xb9, 0x10, 0x61, 0xbe, 0x05, 0xd3, 0xe9, 0x2b, 0xfb, 0xcd, 0xcc, 0xd8, 0x92, 0x20, 0xf5, 0xbd,
+	0x6b, 0x80, 0x84, 0x93, 0xcf, 0x61, 0xb2, 0xff, 0xa6, 0x5c, 0xc8, 0x49, 0x5e, 0x7d, 0x27, 0x07,
+	0x20, 0x0c, 0x77, 0x40, 0xce, 0xb8, 0x69, 0xde, 0xce, 0x52, 0x1f, 0xc4, 0xa9, 0xfa, 0xf5, 0x70,
+	0xc2, 0xdb, 0x11, 0xcc, 0x5d, 0xb1, 0x77, 0x6b, 0xd9, 0x6c, 0x64, 0x61, 0xd5, 0xe5, 0xeb, 0x63,
+	0x85, 0xe7, 0x1f, 0x25, 0x58, 0xc8, 0xdb, 0x5e, 0x2b, 0x99, 0x45, 0x7f, 0xb1, 0x92, 0xfa, 0xf0,
+	0x25, 0x94, 0x44, 0x54, 0x4f, 0x61, 0x2a, 0x35, 0xe6, 0x5a, 0x76, 0x6e, 0xfd, 0x18, 0xb5, 0x96,
+	0x8f, 0x89, 0x3d, 0xa8, 0x63, 0xdf, 0x04, 0x1f, 0x14, 0x6b, 0x5b, 0xa7, 0xe7, 0x15, 0xe9, 0xec,
+	0xbc, 0x22, 0x3d, 0x3f, 0xaf, 0x48, 0x7f, 0x9d, 0x57, 0xa4, 0x1f, 0x2e, 0x2a, 0x85, 0xb3, 0x8b,
+	0x4a, 0xe1, 0xf9, 0x45, 0xa5, 0xf0, 0xa4, 0x6e, 0xd9, 0x6c, 0xbf, 0xbb, 0xa7, 0xb7, 0x88, 0x63,
+	0x78, 0xe4, 0x80, 0xdd, 0x77, 0x31, 0x7b, 0x46, 0xfc, 0x83, 0xf0, 0xc1, 0x27, 0x9d, 0x4e, 0xff,
+	0xd7, 0x06, 0x3b, 0xf6, 0x30, 0xdd, 0x2b, 0x85, 0x1f, 0x4a, 0x2b, 0xff, 0x0e, 0x00, 0xa4, 0xcf,
+	0x03, 0xcc, 0x4d, 0x0e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -526,6 +948,10 @@ type MsgClient interface {
 	StakeSupplier(ctx context.Context, in *MsgStakeSupplier, opts ...grpc.CallOption) (*MsgStakeSupplierResponse, error)
 	UnstakeSupplier(ctx context.Context, in *MsgUnstakeSupplier, opts ...grpc.CallOption) (*MsgUnstakeSupplierResponse, error)
 	UpdateParam(ctx context.Context, in *MsgUpdateParam, opts ...grpc.CallOption) (*MsgUpdateParamResponse, error)
+	DelegateToSupplier(ctx context.Context, in *MsgDelegateToSupplier, opts ...grpc.CallOption) (*MsgDelegateToSupplierResponse, error)
+	UndelegateFromSupplier(ctx context.Context, in *MsgUndelegateFromSupplier, opts ...grpc.CallOption) (*MsgUndelegateFromSupplierResponse, error)
+	SetSupplierDelegationCommission(ctx context.Context, in *MsgSetSupplierDelegationCommission, opts ...grpc.CallOption) (*MsgSetSupplierDelegationCommissionResponse, error)
+	UnjailSupplier(ctx context.Context, in *MsgUnjailSupplier, opts ...grpc.CallOption) (*MsgUnjailSupplierResponse, error)
 }
 
 type msgClient struct {
@@ -572,6 +998,42 @@ func (c *msgClient) UpdateParam(ctx context.Context, in *MsgUpdateParam, opts ..
 	return out, nil
 }
 
+func (c *msgClient) DelegateToSupplier(ctx context.Context, in *MsgDelegateToSupplier, opts ...grpc.CallOption) (*MsgDelegateToSupplierResponse, error) {
+	out := new(MsgDelegateToSupplierResponse)
+	err := c.cc.Invoke(ctx, "/pocket.supplier.Msg/DelegateToSupplier", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UndelegateFromSupplier(ctx context.Context, in *MsgUndelegateFromSupplier, opts ...grpc.CallOption) (*MsgUndelegateFromSupplierResponse, error) {
+	out := new(MsgUndelegateFromSupplierResponse)
+	err := c.cc.Invoke(ctx, "/pocket.supplier.Msg/UndelegateFromSupplier", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetSupplierDelegationCommission(ctx context.Context, in *MsgSetSupplierDelegationCommission, opts ...grpc.CallOption) (*MsgSetSupplierDelegationCommissionResponse, error) {
+	out := new(MsgSetSupplierDelegationCommissionResponse)
+	err := c.cc.Invoke(ctx, "/pocket.supplier.Msg/SetSupplierDelegationCommission", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnjailSupplier(ctx context.Context, in *MsgUnjailSupplier, opts ...grpc.CallOption) (*MsgUnjailSupplierResponse, error) {
+	out := new(MsgUnjailSupplierResponse)
+	err := c.cc.Invoke(ctx, "/pocket.supplier.Msg/UnjailSupplier", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// UpdateParams defines a (governance) operation for updating the module
@@ -580,6 +1042,10 @@ type MsgServer interface {
 	StakeSupplier(context.Context, *MsgStakeSupplier) (*MsgStakeSupplierResponse, error)
 	UnstakeSupplier(context.Context, *MsgUnstakeSupplier) (*MsgUnstakeSupplierResponse, error)
 	UpdateParam(context.Context, *MsgUpdateParam) (*MsgUpdateParamResponse, error)
+	DelegateToSupplier(context.Context, *MsgDelegateToSupplier) (*MsgDelegateToSupplierResponse, error)
+	UndelegateFromSupplier(context.Context, *MsgUndelegateFromSupplier) (*MsgUndelegateFromSupplierResponse, error)
+	SetSupplierDelegationCommission(context.Context, *MsgSetSupplierDelegationCommission) (*MsgSetSupplierDelegationCommissionResponse, error)
+	UnjailSupplier(context.Context, *MsgUnjailSupplier) (*MsgUnjailSupplierResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -598,9 +1064,21 @@ func (*UnimplementedMsgServer) UnstakeSupplier(ctx context.Context, req *MsgUnst
 func (*UnimplementedMsgServer) UpdateParam(ctx context.Context, req *MsgUpdateParam) (*MsgUpdateParamResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParam not implemented")
 }
-
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+func (*UnimplementedMsgServer) DelegateToSupplier(ctx context.Context, req *MsgDelegateToSupplier) (*MsgDelegateToSupplierResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegateToSupplier not implemented")
+}
+func (*UnimplementedMsgServer) UndelegateFromSupplier(ctx context.Context, req *MsgUndelegateFromSupplier) (*MsgUndelegateFromSupplierResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UndelegateFromSupplier not implemented")
+}
+func (*UnimplementedMsgServer) SetSupplierDelegationCommission(ctx context.Context, req *MsgSetSupplierDelegationCommission) (*MsgSetSupplierDelegationCommissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSupplierDelegationCommission not implemented")
+}
+func (*UnimplementedMsgServer) UnjailSupplier(ctx context.Context, req *MsgUnjailSupplier) (*MsgUnjailSupplierResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnjailSupplier not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
 }
 
 func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
@@ -675,6 +1153,78 @@ func _Msg_UpdateParam_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_DelegateToSupplier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDelegateToSupplier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DelegateToSupplier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pocket.supplier.Msg/DelegateToSupplier",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DelegateToSupplier(ctx, req.(*MsgDelegateToSupplier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UndelegateFromSupplier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUndelegateFromSupplier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UndelegateFromSupplier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pocket.supplier.Msg/UndelegateFromSupplier",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UndelegateFromSupplier(ctx, req.(*MsgUndelegateFromSupplier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetSupplierDelegationCommission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetSupplierDelegationCommission)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetSupplierDelegationCommission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pocket.supplier.Msg/SetSupplierDelegationCommission",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetSupplierDelegationCommission(ctx, req.(*MsgSetSupplierDelegationCommission))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnjailSupplier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnjailSupplier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnjailSupplier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pocket.supplier.Msg/UnjailSupplier",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnjailSupplier(ctx, req.(*MsgUnjailSupplier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "pocket.supplier.Msg",
@@ -696,6 +1246,22 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParam",
 			Handler:    _Msg_UpdateParam_Handler,
 		},
+		{
+			MethodName: "DelegateToSupplier",
+			Handler:    _Msg_DelegateToSupplier_Handler,
+		},
+		{
+			MethodName: "UndelegateFromSupplier",
+			Handler:    _Msg_UndelegateFromSupplier_Handler,
+		},
+		{
+			MethodName: "SetSupplierDelegationCommission",
+			Handler:    _Msg_SetSupplierDelegationCommission_Handler,
+		},
+		{
+			MethodName: "UnjailSupplier",
+			Handler:    _Msg_UnjailSupplier_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pocket/supplier/tx.proto",
@@ -1008,6 +1574,18 @@ func (m *MsgUpdateParam_AsCoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	return len(dAtA) - i, nil
 }
+func (m *MsgUpdateParam_AsUint64) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParam_AsUint64) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = encodeVarintTx(dAtA, i, uint64(m.AsUint64))
+	i--
+	dAtA[i] = 0x20
+	return len(dAtA) - i, nil
+}
 func (m *MsgUpdateParamResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1043,167 +1621,1530 @@ func (m *MsgUpdateParamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgDelegateToSupplier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgDelegateToSupplier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgStakeSupplier) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgDelegateToSupplier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.OwnerAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.OperatorAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Amount != nil {
+		{
+			size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.Stake != nil {
-		l = m.Stake.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.SupplierOperatorAddress) > 0 {
+		i -= len(m.SupplierOperatorAddress)
+		copy(dAtA[i:], m.SupplierOperatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SupplierOperatorAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.Services) > 0 {
-		for _, e := range m.Services {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgStakeSupplierResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Supplier != nil {
-		l = m.Supplier.Size()
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgDelegateToSupplierResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgUnstakeSupplier) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.OperatorAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *MsgDelegateToSupplierResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUnstakeSupplierResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgDelegateToSupplierResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Supplier != nil {
-		l = m.Supplier.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if m.Delegation != nil {
+		{
+			size, err := m.Delegation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParam) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUndelegateFromSupplier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUndelegateFromSupplier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUndelegateFromSupplier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.SupplierOperatorAddress) > 0 {
+		i -= len(m.SupplierOperatorAddress)
+		copy(dAtA[i:], m.SupplierOperatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SupplierOperatorAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.AsType != nil {
-		n += m.AsType.Size()
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParam_AsCoin) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUndelegateFromSupplierResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUndelegateFromSupplierResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUndelegateFromSupplierResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.AsCoin != nil {
-		l = m.AsCoin.Size()
+	if m.Delegation != nil {
+		{
+			size, err := m.Delegation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetSupplierDelegationCommission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetSupplierDelegationCommission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetSupplierDelegationCommission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DelegationCommissionPercentage != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DelegationCommissionPercentage))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OwnerAddress) > 0 {
+		i -= len(m.OwnerAddress)
+		copy(dAtA[i:], m.OwnerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OwnerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetSupplierDelegationCommissionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetSupplierDelegationCommissionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetSupplierDelegationCommissionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Supplier != nil {
+		{
+			size, err := m.Supplier.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnjailSupplier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnjailSupplier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnjailSupplier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnjailSupplierResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnjailSupplierResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnjailSupplierResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Supplier != nil {
+		{
+			size, err := m.Supplier.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
 	return n
 }
-func (m *MsgUpdateParamResponse) Size() (n int) {
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgStakeSupplier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Stake != nil {
+		l = m.Stake.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Services) > 0 {
+		for _, e := range m.Services {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgStakeSupplierResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Supplier != nil {
+		l = m.Supplier.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnstakeSupplier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnstakeSupplierResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Supplier != nil {
+		l = m.Supplier.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateParam) Size() (n int) {
 	if m == nil {
 		return 0
 	}
-	var l int
-	_ = l
-	if m.Params != nil {
-		l = m.Params.Size()
-		n += 1 + l + sovTx(uint64(l))
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AsType != nil {
+		n += m.AsType.Size()
+	}
+	return n
+}
+
+func (m *MsgUpdateParam_AsCoin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AsCoin != nil {
+		l = m.AsCoin.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+func (m *MsgUpdateParam_AsUint64) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovTx(uint64(m.AsUint64))
+	return n
+}
+func (m *MsgUpdateParamResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDelegateToSupplier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SupplierOperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Amount != nil {
+		l = m.Amount.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDelegateToSupplierResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Delegation != nil {
+		l = m.Delegation.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUndelegateFromSupplier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SupplierOperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUndelegateFromSupplierResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Delegation != nil {
+		l = m.Delegation.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetSupplierDelegationCommission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DelegationCommissionPercentage != 0 {
+		n += 1 + sovTx(uint64(m.DelegationCommissionPercentage))
+	}
+	return n
+}
+
+func (m *MsgSetSupplierDelegationCommissionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Supplier != nil {
+		l = m.Supplier.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnjailSupplier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnjailSupplierResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Supplier != nil {
+		l = m.Supplier.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStakeSupplier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStakeSupplier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStakeSupplier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stake", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Stake == nil {
+				m.Stake = &types.Coin{}
+			}
+			if err := m.Stake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Services", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Services = append(m.Services, &types1.SupplierServiceConfig{})
+			if err := m.Services[len(m.Services)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStakeSupplierResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStakeSupplierResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStakeSupplierResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supplier", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Supplier == nil {
+				m.Supplier = &types1.Supplier{}
+			}
+			if err := m.Supplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnstakeSupplier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnstakeSupplier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnstakeSupplier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnstakeSupplierResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnstakeSupplierResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnstakeSupplierResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supplier", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Supplier == nil {
+				m.Supplier = &types1.Supplier{}
+			}
+			if err := m.Supplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParam) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParam: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParam: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AsCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &types.Coin{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.AsType = &MsgUpdateParam_AsCoin{v}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AsUint64", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AsType = &MsgUpdateParam_AsUint64{v}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
+func (m *MsgUpdateParamResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Params == nil {
+				m.Params = &Params{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgDelegateToSupplier) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1222,19 +3163,51 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDelegateToSupplier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDelegateToSupplier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplierOperatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1262,11 +3235,11 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.SupplierOperatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1293,7 +3266,10 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Amount == nil {
+				m.Amount = &types.Coin{}
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1318,7 +3294,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDelegateToSupplierResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1341,12 +3317,48 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDelegateToSupplierResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDelegateToSupplierResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Delegation == nil {
+				m.Delegation = &Delegation{}
+			}
+			if err := m.Delegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1368,7 +3380,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgStakeSupplier) Unmarshal(dAtA []byte) error {
+func (m *MsgUndelegateFromSupplier) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1391,15 +3403,15 @@ func (m *MsgStakeSupplier) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgStakeSupplier: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUndelegateFromSupplier: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgStakeSupplier: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUndelegateFromSupplier: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1427,43 +3439,11 @@ func (m *MsgStakeSupplier) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplierOperatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1491,77 +3471,7 @@ func (m *MsgStakeSupplier) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stake", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Stake == nil {
-				m.Stake = &types.Coin{}
-			}
-			if err := m.Stake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Services", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Services = append(m.Services, &types1.SupplierServiceConfig{})
-			if err := m.Services[len(m.Services)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SupplierOperatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1584,7 +3494,7 @@ func (m *MsgStakeSupplier) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgStakeSupplierResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUndelegateFromSupplierResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1607,15 +3517,15 @@ func (m *MsgStakeSupplierResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgStakeSupplierResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUndelegateFromSupplierResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgStakeSupplierResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUndelegateFromSupplierResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Supplier", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegation", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1642,10 +3552,10 @@ func (m *MsgStakeSupplierResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Supplier == nil {
-				m.Supplier = &types1.Supplier{}
+			if m.Delegation == nil {
+				m.Delegation = &Delegation{}
 			}
-			if err := m.Supplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Delegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1670,7 +3580,7 @@ func (m *MsgStakeSupplierResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUnstakeSupplier) Unmarshal(dAtA []byte) error {
+func (m *MsgSetSupplierDelegationCommission) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1693,15 +3603,15 @@ func (m *MsgUnstakeSupplier) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUnstakeSupplier: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetSupplierDelegationCommission: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUnstakeSupplier: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetSupplierDelegationCommission: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1729,7 +3639,7 @@ func (m *MsgUnstakeSupplier) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
+			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -1763,6 +3673,25 @@ func (m *MsgUnstakeSupplier) Unmarshal(dAtA []byte) error {
 			}
 			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationCommissionPercentage", wireType)
+			}
+			m.DelegationCommissionPercentage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DelegationCommissionPercentage |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1784,7 +3713,7 @@ func (m *MsgUnstakeSupplier) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUnstakeSupplierResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetSupplierDelegationCommissionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1807,10 +3736,10 @@ func (m *MsgUnstakeSupplierResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUnstakeSupplierResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetSupplierDelegationCommissionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUnstakeSupplierResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetSupplierDelegationCommissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1870,7 +3799,7 @@ func (m *MsgUnstakeSupplierResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParam) Unmarshal(dAtA []byte) error {
+func (m *MsgUnjailSupplier) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1893,15 +3822,15 @@ func (m *MsgUpdateParam) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParam: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnjailSupplier: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParam: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnjailSupplier: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1929,11 +3858,11 @@ func (m *MsgUpdateParam) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1961,42 +3890,7 @@ func (m *MsgUpdateParam) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AsCoin", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &types.Coin{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.AsType = &MsgUpdateParam_AsCoin{v}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2019,7 +3913,7 @@ func (m *MsgUpdateParam) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUnjailSupplierResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2042,15 +3936,15 @@ func (m *MsgUpdateParamResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnjailSupplierResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnjailSupplierResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Supplier", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2077,10 +3971,10 @@ func (m *MsgUpdateParamResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Params == nil {
-				m.Params = &Params{}
+			if m.Supplier == nil {
+				m.Supplier = &types1.Supplier{}
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Supplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
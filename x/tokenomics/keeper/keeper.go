@@ -35,6 +35,9 @@ type Keeper struct {
 
 	sharedQuerier client.SharedQueryClient
 
+	// tokenLogicModules are the available TLM implementations. The ones which are
+	// run to settle claims, and their order, are configured by the
+	// token_logic_modules param.
 	tokenLogicModules []tlm.TokenLogicModule
 }
 
@@ -60,7 +63,7 @@ func NewKeeper(
 	}
 
 	sharedQuerier := prooftypes.NewSharedKeeperQueryClient(sharedKeeper, sessionKeeper)
-	if err := tlm.ValidateTLMConfig(tokenLogicModules); err != nil {
+	if err := tlm.ValidateTokenLogicModules(tokenLogicModules); err != nil {
 		panic(err)
 	}
 
@@ -8,6 +8,8 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
+	tlm "github.com/pokt-network/poktroll/x/tokenomics/token_logic_module"
 	tokenomicstypes "github.com/pokt-network/poktroll/x/tokenomics/types"
 )
 
@@ -48,6 +50,9 @@ func (k msgServer) UpdateParam(
 	case tokenomicstypes.ParamGlobalInflationPerClaim:
 		logger = logger.With("param_value", msg.GetAsFloat())
 		params.GlobalInflationPerClaim = msg.GetAsFloat()
+	case tokenomicstypes.ParamTokenLogicModules:
+		logger = logger.With("param_value", msg.GetAsTokenLogicModules())
+		params.TokenLogicModules = msg.GetAsTokenLogicModules().GetTokenLogicModules()
 	default:
 		return nil, status.Error(
 			codes.InvalidArgument,
@@ -59,12 +64,21 @@ func (k msgServer) UpdateParam(
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	if err := tlm.ValidateTLMConfig(k.tokenLogicModules, params.TokenLogicModules); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	if err := k.SetParams(ctx, params); err != nil {
 		err = fmt.Errorf("unable to set params: %w", err)
 		logger.Error(err.Error())
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	if err := sharedtypes.EmitParamsUpdatedEvent(ctx, tokenomicstypes.ModuleName); err != nil {
+		logger.Error(err.Error())
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
 	updatedParams := k.GetParams(ctx)
 	return &tokenomicstypes.MsgUpdateParamResponse{
 		Params: &updatedParams,
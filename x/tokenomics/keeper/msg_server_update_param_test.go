@@ -113,3 +113,41 @@ func TestMsgUpdateParam_UpdateGlobalInflationPerClaimOnly(t *testing.T) {
 	// Ensure the other parameters are unchanged
 	testkeeper.AssertDefaultParamsEqualExceptFields(t, &defaultParams, res.Params, string(tokenomicstypes.KeyGlobalInflationPerClaim))
 }
+
+func TestMsgUpdateParam_UpdateTokenLogicModulesOnly(t *testing.T) {
+	expectedTokenLogicModules := []tokenomicstypes.TokenLogicModuleConfig{
+		{Id: tokenomicstypes.TokenLogicModuleId_TLM_RELAY_BURN_EQUALS_MINT},
+	}
+
+	// Set the parameters to their default values
+	k, msgSrv, ctx := setupMsgServer(t)
+	defaultParams := tokenomicstypes.DefaultParams()
+	require.NoError(t, k.SetParams(ctx, defaultParams))
+
+	// Ensure the default values are different from the new values we want to set
+	require.NotEqual(t, expectedTokenLogicModules, defaultParams.TokenLogicModules)
+
+	// Update the token logic modules.
+	updateParamMsg := &tokenomicstypes.MsgUpdateParam{
+		Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		Name:      tokenomicstypes.ParamTokenLogicModules,
+		AsType: &tokenomicstypes.MsgUpdateParam_AsTokenLogicModules{
+			AsTokenLogicModules: &tokenomicstypes.TokenLogicModuleConfigs{
+				TokenLogicModules: expectedTokenLogicModules,
+			},
+		},
+	}
+	res, err := msgSrv.UpdateParam(ctx, updateParamMsg)
+	require.NoError(t, err)
+
+	// Assert that the response contains the expected token logic modules.
+	require.NotEqual(t, defaultParams.TokenLogicModules, res.Params.TokenLogicModules)
+	require.Equal(t, expectedTokenLogicModules, res.Params.TokenLogicModules)
+
+	// Assert that the onchain token logic modules are updated.
+	params := k.GetParams(ctx)
+	require.Equal(t, expectedTokenLogicModules, params.TokenLogicModules)
+
+	// Ensure the other parameters are unchanged
+	testkeeper.AssertDefaultParamsEqualExceptFields(t, &defaultParams, res.Params, string(tokenomicstypes.KeyTokenLogicModules))
+}
@@ -110,12 +110,34 @@ func TestMsgUpdateParams(t *testing.T) {
 						SourceOwner: 0.1,
 						Application: 0.6,
 					},
-					DaoRewardAddress: sample.AccAddress(),
+					DaoRewardAddress:  sample.AccAddress(),
+					TokenLogicModules: tokenomicstypes.DefaultTokenLogicModules,
 				},
 			},
 
 			shouldError: false,
 		},
+		{
+			desc: "invalid: global mint TLM enabled without its reimbursement request TLM",
+
+			req: &tokenomicstypes.MsgUpdateParams{
+				Authority: tokenomicsKeeper.GetAuthority(),
+				Params: tokenomicstypes.Params{
+					MintAllocationPercentages: tokenomicstypes.DefaultMintAllocationPercentages,
+					DaoRewardAddress:          sample.AccAddress(),
+
+					// TLM_GLOBAL_MINT and TLM_GLOBAL_MINT_REIMBURSEMENT_REQUEST
+					// MUST be enabled together.
+					TokenLogicModules: []tokenomicstypes.TokenLogicModuleConfig{
+						{Id: tokenomicstypes.TokenLogicModuleId_TLM_RELAY_BURN_EQUALS_MINT},
+						{Id: tokenomicstypes.TokenLogicModuleId_TLM_GLOBAL_MINT},
+					},
+				},
+			},
+
+			shouldError:    true,
+			expectedErrMsg: "must be (de-)activated together",
+		},
 	}
 
 	for _, test := range tests {
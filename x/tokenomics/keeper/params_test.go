@@ -247,3 +247,117 @@ func TestParams_ValidateGlobalInflationPerClaim(t *testing.T) {
 		})
 	}
 }
+
+func TestParams_ValidateTokenLogicModules(t *testing.T) {
+	tests := []struct {
+		desc              string
+		tokenLogicModules any
+		expectedErr       error
+	}{
+		{
+			desc:              "invalid type",
+			tokenLogicModules: []tokenomicstypes.TokenLogicModuleId{tokenomicstypes.TokenLogicModuleId_TLM_RELAY_BURN_EQUALS_MINT},
+			expectedErr:       tokenomicstypes.ErrTokenomicsParamInvalid.Wrap("invalid parameter type: []types.TokenLogicModuleId"),
+		},
+		{
+			desc: "unspecified TLM id",
+			tokenLogicModules: []tokenomicstypes.TokenLogicModuleConfig{
+				{Id: tokenomicstypes.TokenLogicModuleId_TLM_UNSPECIFIED},
+			},
+			expectedErr: tokenomicstypes.ErrTokenomicsParamInvalid.Wrap("invalid token logic module id: 0"),
+		},
+		{
+			desc: "TLM params mismatching the TLM id",
+			tokenLogicModules: []tokenomicstypes.TokenLogicModuleConfig{
+				{
+					Id: tokenomicstypes.TokenLogicModuleId_TLM_RELAY_BURN_EQUALS_MINT,
+					Params: &tokenomicstypes.TokenLogicModuleConfig_GlobalMint{
+						GlobalMint: &tokenomicstypes.TLMGlobalMintParams{},
+					},
+				},
+			},
+			expectedErr: tokenomicstypes.ErrTokenomicsParamInvalid.Wrap(
+				"token logic module TLM_RELAY_BURN_EQUALS_MINT has params of token logic module TLM_GLOBAL_MINT",
+			),
+		},
+		{
+			desc: "duplicate TLM",
+			tokenLogicModules: []tokenomicstypes.TokenLogicModuleConfig{
+				{Id: tokenomicstypes.TokenLogicModuleId_TLM_RELAY_BURN_EQUALS_MINT},
+				{Id: tokenomicstypes.TokenLogicModuleId_TLM_RELAY_BURN_EQUALS_MINT},
+			},
+			expectedErr: tokenomicstypes.ErrTokenomicsParamInvalid.Wrap(
+				"token logic module TLM_RELAY_BURN_EQUALS_MINT is enabled more than once",
+			),
+		},
+		{
+			desc:              "empty TLMs",
+			tokenLogicModules: []tokenomicstypes.TokenLogicModuleConfig{},
+			expectedErr: tokenomicstypes.ErrTokenomicsConstraint.Wrap(
+				"token logic module TLM_RELAY_BURN_EQUALS_MINT must be enabled",
+			),
+		},
+		{
+			desc: "global mint TLM without its reimbursement request TLM",
+			tokenLogicModules: []tokenomicstypes.TokenLogicModuleConfig{
+				{Id: tokenomicstypes.TokenLogicModuleId_TLM_GLOBAL_MINT},
+				{Id: tokenomicstypes.TokenLogicModuleId_TLM_RELAY_BURN_EQUALS_MINT},
+			},
+			expectedErr: tokenomicstypes.ErrTokenomicsConstraint.Wrap(
+				"token logic modules TLM_GLOBAL_MINT and TLM_GLOBAL_MINT_REIMBURSEMENT_REQUEST must be (de-)activated together",
+			),
+		},
+		{
+			desc: "service owner rev share TLM max rev share percentage greater than 100",
+			tokenLogicModules: []tokenomicstypes.TokenLogicModuleConfig{
+				{Id: tokenomicstypes.TokenLogicModuleId_TLM_RELAY_BURN_EQUALS_MINT},
+				{
+					Id: tokenomicstypes.TokenLogicModuleId_TLM_SERVICE_OWNER_REV_SHARE,
+					Params: &tokenomicstypes.TokenLogicModuleConfig_ServiceOwnerRevShare{
+						ServiceOwnerRevShare: &tokenomicstypes.TLMServiceOwnerRevShareParams{
+							MaxRevSharePercentage: 101,
+						},
+					},
+				},
+			},
+			expectedErr: tokenomicstypes.ErrTokenomicsParamInvalid.Wrap(
+				"token logic module TLM_SERVICE_OWNER_REV_SHARE max rev share percentage must be at most 100, got 101",
+			),
+		},
+		{
+			desc: "valid service owner rev share TLM",
+			tokenLogicModules: []tokenomicstypes.TokenLogicModuleConfig{
+				{Id: tokenomicstypes.TokenLogicModuleId_TLM_RELAY_BURN_EQUALS_MINT},
+				{
+					Id: tokenomicstypes.TokenLogicModuleId_TLM_SERVICE_OWNER_REV_SHARE,
+					Params: &tokenomicstypes.TokenLogicModuleConfig_ServiceOwnerRevShare{
+						ServiceOwnerRevShare: &tokenomicstypes.TLMServiceOwnerRevShareParams{
+							MaxRevSharePercentage: 10,
+						},
+					},
+				},
+			},
+		},
+		{
+			desc: "valid relay burn equals mint TLM only",
+			tokenLogicModules: []tokenomicstypes.TokenLogicModuleConfig{
+				{Id: tokenomicstypes.TokenLogicModuleId_TLM_RELAY_BURN_EQUALS_MINT},
+			},
+		},
+		{
+			desc:              "valid default TokenLogicModules",
+			tokenLogicModules: tokenomicstypes.DefaultTokenLogicModules,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			err := tokenomicstypes.ValidateTokenLogicModules(test.tokenLogicModules)
+			if test.expectedErr != nil {
+				require.ErrorContains(t, err, test.expectedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -96,11 +96,18 @@ func (k Keeper) SettlePendingClaims(ctx cosmostypes.Context) (
 			return settledResults, expiredResults, err
 		}
 
+		var service *sharedtypes.Service
+		service, err = settlementContext.GetService(serviceId)
+		if err != nil {
+			return settledResults, expiredResults, err
+		}
+
 		sharedParams := settlementContext.GetSharedParams()
 		// claimeduPOKT is the amount of uPOKT that the supplier would receive if the
 		// claim is settled. It is derived from the claimed number of relays, the current
-		// service mining difficulty and the global network parameters.
-		claimeduPOKT, err = claim.GetClaimeduPOKT(sharedParams, relayMiningDifficulty)
+		// service mining difficulty, the service compute units to tokens multiplier
+		// and the global network parameters.
+		claimeduPOKT, err = claim.GetClaimeduPOKT(sharedParams, *service, relayMiningDifficulty)
 		if err != nil {
 			return settledResults, expiredResults, err
 		}
@@ -508,8 +515,18 @@ func (k Keeper) GetExpiringClaimsIterator(ctx cosmostypes.Context) (expiringClai
 	return k.proofKeeper.GetSessionEndHeightClaimsIterator(ctx, expiringSessionEndHeight)
 }
 
-// slashSupplierStake slashes the stake of a supplier and transfers the total
-// slashing amount from the supplier bank module to the tokenomics module account.
+// slashSupplierStake slashes the stake of a supplier, and of the delegations to it,
+// and transfers the total slashing amount from the supplier bank module to the
+// tokenomics module account.
+//
+// The proof missing penalty escalates with the number of the supplier's proof
+// faults within the proof fault window, which includes the one being slashed.
+// Suppliers reaching the proof fault jailing threshold are jailed, i.e. excluded
+// from sessions, until they are unjailed once the jail period has elapsed.
+//
+// The escalated penalty is split between the supplier and its delegations
+// (including the unbonding ones), proportionally to their stake. The supplier
+// absorbs any remainder resulting from the integer division.
 func (k Keeper) slashSupplierStake(
 	ctx cosmostypes.Context,
 	settlementContext *settlementContext,
@@ -517,16 +534,68 @@ func (k Keeper) slashSupplierStake(
 ) error {
 	logger := k.logger.With("method", "slashSupplierStake")
 
-	supplierOperatorAddress := ClaimSettlementResult.GetClaim().SupplierOperatorAddress
+	claim := ClaimSettlementResult.GetClaim()
+	supplierOperatorAddress := claim.SupplierOperatorAddress
 	proofParams := k.proofKeeper.GetParams(ctx)
-	slashingCoin := *proofParams.GetProofMissingPenalty()
+	supplierParams := k.supplierKeeper.GetParams(ctx)
 
 	supplierToSlash, err := settlementContext.GetSupplier(supplierOperatorAddress)
 	if err != nil {
 		return err
 	}
 
+	// Record the proof fault to determine the number of the supplier's proof
+	// faults within the proof fault window, including this one.
+	// The expiring claims of the same session are a single proof fault, so they
+	// are all penalized with the same escalated penalty.
+	supplierProofFaults, err := k.supplierKeeper.RecordSupplierProofFault(
+		ctx,
+		supplierOperatorAddress,
+		suppliertypes.ProofFault{
+			SessionId:        claim.GetSessionHeader().GetSessionId(),
+			SessionEndHeight: claim.GetSessionHeader().GetSessionEndBlockHeight(),
+		},
+	)
+	if err != nil {
+		return err
+	}
+	numProofFaults := uint64(len(supplierProofFaults.GetProofFaults()))
+
+	proofMissingPenaltyCoin := suppliertypes.GetEscalatedProofMissingPenalty(
+		&supplierParams,
+		*proofParams.GetProofMissingPenalty(),
+		numProofFaults,
+	)
+
 	slashedSupplierInitialStakeCoin := supplierToSlash.GetStake()
+	delegationsToSlash := settlementContext.GetSupplierDelegations(supplierOperatorAddress)
+
+	// The total stake backing the supplier is its own stake and the stake of all
+	// the delegations to it.
+	totalStakeAmount := slashedSupplierInitialStakeCoin.Amount
+	for _, delegation := range delegationsToSlash {
+		totalStakeAmount = totalStakeAmount.Add(delegation.GetStake().Amount)
+	}
+
+	// Slash the delegations proportionally to their stake.
+	delegationsSlashingAmountMap := make(map[string]math.Int, len(delegationsToSlash))
+	totalDelegationsSlashingAmount := math.ZeroInt()
+	for _, delegation := range delegationsToSlash {
+		var delegationSlashingAmount math.Int
+		if proofMissingPenaltyCoin.Amount.GTE(totalStakeAmount) {
+			// Slash the whole delegation if the penalty is greater than the total stake.
+			delegationSlashingAmount = delegation.GetStake().Amount
+		} else {
+			delegationSlashingAmount = proofMissingPenaltyCoin.Amount.
+				Mul(delegation.GetStake().Amount).
+				Quo(totalStakeAmount)
+		}
+		delegationsSlashingAmountMap[delegation.GetDelegatorAddress()] = delegationSlashingAmount
+		totalDelegationsSlashingAmount = totalDelegationsSlashingAmount.Add(delegationSlashingAmount)
+	}
+
+	// The supplier is slashed for the rest of the penalty.
+	slashingCoin := proofMissingPenaltyCoin.SubAmount(math.MinInt(totalDelegationsSlashingAmount, proofMissingPenaltyCoin.Amount))
 
 	var remainingStakeCoin cosmostypes.Coin
 	if slashedSupplierInitialStakeCoin.IsGTE(slashingCoin) {
@@ -534,7 +603,7 @@ func (k Keeper) slashSupplierStake(
 	} else {
 		// TODO_MAINNET_MIGRATION: Consider emitting an event for this case.
 		logger.Warn(fmt.Sprintf(
-			"total slashing amount (%s) is greater than supplier %q stake (%s)",
+			"supplier slashing amount (%s) is greater than supplier %q stake (%s)",
 			slashingCoin,
 			supplierOperatorAddress,
 			supplierToSlash.GetStake(),
@@ -542,17 +611,20 @@ func (k Keeper) slashSupplierStake(
 
 		// Set the remaining stake to 0 if the slashing amount is greater than the stake.
 		remainingStakeCoin = cosmostypes.NewCoin(volatile.DenomuPOKT, math.NewInt(0))
-		// Total slashing amount is the whole supplier's stake.
+		// Supplier slashing amount is the whole supplier's stake.
 		slashingCoin = cosmostypes.NewCoin(volatile.DenomuPOKT, slashedSupplierInitialStakeCoin.Amount)
 	}
 
+	// The total slashing amount includes the supplier and the delegations slashing amounts.
+	totalSlashingCoin := slashingCoin.AddAmount(totalDelegationsSlashingAmount)
+
 	// Since staking mints tokens to the supplier module account, to have a correct
 	// accounting, the slashing amount needs to be sent from the supplier module
 	// account to the tokenomics module account.
 	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx,
 		suppliertypes.ModuleName,
 		tokenomicstypes.ModuleName,
-		cosmostypes.NewCoins(slashingCoin),
+		cosmostypes.NewCoins(totalSlashingCoin),
 	); err != nil {
 		return tokenomicstypes.ErrTokenomicsSettlementInternal.Wrapf(
 			"failed to send coins from module %q to module %q (reason %q): %s",
@@ -565,7 +637,7 @@ func (k Keeper) slashSupplierStake(
 
 	if err := k.bankKeeper.BurnCoins(ctx,
 		tokenomicstypes.ModuleName,
-		cosmostypes.NewCoins(slashingCoin),
+		cosmostypes.NewCoins(totalSlashingCoin),
 	); err != nil {
 		return tokenomicstypes.ErrTokenomicsSettlementInternal.Wrapf(
 			"failed to burn coins from module %q (reason %q): %s",
@@ -576,8 +648,8 @@ func (k Keeper) slashSupplierStake(
 	}
 
 	// Update telemetry information
-	if slashingCoin.Amount.IsInt64() {
-		defer telemetry.SlashedTokensFromModule(suppliertypes.ModuleName, float32(slashingCoin.Amount.Int64()))
+	if totalSlashingCoin.Amount.IsInt64() {
+		defer telemetry.SlashedTokensFromModule(suppliertypes.ModuleName, float32(totalSlashingCoin.Amount.Int64()))
 	}
 
 	supplierToSlash.Stake = &remainingStakeCoin
@@ -592,8 +664,11 @@ func (k Keeper) slashSupplierStake(
 
 	events := make([]cosmostypes.Msg, 0)
 
+	sharedParams := settlementContext.GetSharedParams()
+	currentHeight := ctx.BlockHeight()
+
 	// Check if the supplier's stake is below the minimum and unstake it if necessary.
-	minSupplierStakeCoin := k.supplierKeeper.GetParams(ctx).MinStake
+	minSupplierStakeCoin := supplierParams.MinStake
 	// TODO_MAINNET_MIGRATION(@red-0ne): SettlePendingClaims is called at the end of every block,
 	// but not every block corresponds to the end of a session. This may lead to a situation
 	// where a force unstaked supplier may still be able to interact with a Gateway or Application.
@@ -602,9 +677,6 @@ func (k Keeper) slashSupplierStake(
 	// at all and fixed only if it does.
 	// Ensure that a slashed supplier going below min stake is unbonded only once.
 	if supplierToSlash.GetStake().IsLT(*minSupplierStakeCoin) && !supplierToSlash.IsUnbonding() {
-		sharedParams := settlementContext.GetSharedParams()
-		sdkCtx := cosmostypes.UnwrapSDKContext(ctx)
-		currentHeight := sdkCtx.BlockHeight()
 		unstakeSessionEndHeight := sharedtypes.GetSessionEndHeight(&sharedParams, currentHeight)
 
 		logger.Warn(fmt.Sprintf(
@@ -639,17 +711,101 @@ func (k Keeper) slashSupplierStake(
 		})
 	}
 
+	// Jail the supplier if it reached the proof fault jailing threshold.
+	// Only the proof faults of the sessions following the supplier's latest jailing
+	// count towards jailing it again, which also excludes the claims of the session
+	// it was jailed for which expire after it was jailed.
+	// Unbonding suppliers are already excluded from the next sessions and suppliers
+	// which are already jailed are not jailed again until they are unjailed.
+	numJailingProofFaults := numProofFaults
+	if len(supplierToSlash.GetJailHistory()) > 0 {
+		numJailingProofFaults = supplierProofFaults.GetNumProofFaultsSince(supplierToSlash.GetLatestJailStartHeight())
+	}
+	if suppliertypes.ShouldJailSupplier(&supplierParams, numJailingProofFaults) &&
+		!supplierToSlash.HasPendingJail() &&
+		!supplierToSlash.IsUnbonding() {
+		// The supplier remains in the current session to preserve the active
+		// suppliers set, and is jailed starting from the next session.
+		jailSessionEndHeight := sharedtypes.GetSessionEndHeight(&sharedParams, currentHeight)
+		jailEndHeight := suppliertypes.GetSupplierJailEndHeight(&sharedParams, &supplierParams, jailSessionEndHeight)
+
+		logger.Warn(fmt.Sprintf(
+			"jailing supplier %q owned by %q until height %d due to %d proof faults",
+			supplierToSlash.GetOperatorAddress(),
+			supplierToSlash.GetOwnerAddress(),
+			jailEndHeight,
+			numJailingProofFaults,
+		))
+
+		// Retain the jail periods applying to the sessions which may still be
+		// claimed or settled, with an additional session of margin.
+		numBlocksPerSession := int64(sharedParams.GetNumBlocksPerSession())
+		numRetainedSessions := sharedtypes.GetNumPendingSessions(&sharedParams) + 1
+		minRetainedHeight := sharedtypes.GetSessionStartHeight(&sharedParams, currentHeight) -
+			numRetainedSessions*numBlocksPerSession
+		if minRetainedHeight < 0 {
+			minRetainedHeight = 0
+		}
+
+		supplierToSlash.Jail(
+			sharedtypes.GetNextSessionStartHeight(&sharedParams, currentHeight),
+			jailEndHeight,
+			minRetainedHeight,
+		)
+
+		events = append(events, &suppliertypes.EventSupplierJailed{
+			Supplier:         supplierToSlash,
+			NumProofFaults:   numJailingProofFaults,
+			SessionEndHeight: jailSessionEndHeight,
+			JailEndHeight:    jailEndHeight,
+		})
+	}
+
 	// Only update the dehydrated supplier, since the service config will remain unchanged.
 	k.supplierKeeper.SetDehydratedSupplier(ctx, *supplierToSlash)
 
-	claim := ClaimSettlementResult.GetClaim()
-
 	// Emit an event that a supplier has been slashed.
 	events = append(events, &tokenomicstypes.EventSupplierSlashed{
 		Claim:               &claim,
 		ProofMissingPenalty: &slashingCoin,
+		NumProofFaults:      numProofFaults,
 	})
 
+	// Update the slashed delegations and emit an event for each of them.
+	for _, delegation := range delegationsToSlash {
+		delegationSlashingCoin := cosmostypes.NewCoin(
+			volatile.DenomuPOKT,
+			delegationsSlashingAmountMap[delegation.GetDelegatorAddress()],
+		)
+		if delegationSlashingCoin.IsZero() {
+			continue
+		}
+
+		remainingDelegationStakeCoin := delegation.GetStake().Sub(delegationSlashingCoin)
+		delegation.Stake = &remainingDelegationStakeCoin
+		if remainingDelegationStakeCoin.IsPositive() {
+			k.supplierKeeper.SetDelegation(ctx, *delegation)
+		} else {
+			// A fully slashed delegation has no stake left to unbond or to be rewarded for.
+			k.supplierKeeper.RemoveDelegation(ctx, delegation.GetSupplierOperatorAddress(), delegation.GetDelegatorAddress())
+		}
+
+		logger.Info(fmt.Sprintf(
+			"slashed delegation of %q to supplier %q by %s, remaining stake: %s",
+			delegation.GetDelegatorAddress(),
+			supplierToSlash.GetOperatorAddress(),
+			delegationSlashingCoin,
+			delegation.GetStake(),
+		))
+
+		events = append(events, &tokenomicstypes.EventSupplierDelegationSlashed{
+			Claim:                   &claim,
+			DelegatorAddress:        delegation.GetDelegatorAddress(),
+			SupplierOperatorAddress: supplierToSlash.GetOperatorAddress(),
+			SlashedAmount:           &delegationSlashingCoin,
+		})
+	}
+
 	if err := ctx.EventManager().EmitTypedEvents(events...); err != nil {
 		return err
 	}
@@ -13,6 +13,7 @@ import (
 	servicekeeper "github.com/pokt-network/poktroll/x/service/keeper"
 	servicetypes "github.com/pokt-network/poktroll/x/service/types"
 	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
+	suppliertypes "github.com/pokt-network/poktroll/x/supplier/types"
 	tokenomicstypes "github.com/pokt-network/poktroll/x/tokenomics/types"
 )
 
@@ -30,6 +31,11 @@ type settlementContext struct {
 	supplierMap      map[string]int
 	settledSuppliers []*sharedtypes.Supplier
 
+	// Cache of the delegations to the settled suppliers, keyed by supplier operator address.
+	// The delegations are fetched once, along with their supplier, and are used to
+	// distribute the supplier rewards and slash the delegated stake.
+	supplierDelegationsMap map[string][]*suppliertypes.Delegation
+
 	// Maintain both a map and a slice for applications to provide:
 	// 1. Fast O(1) lookup by address via the map during claim processing
 	// 2. Consistent ordering via the slice to ensure deterministic settlement results
@@ -62,8 +68,9 @@ func NewSettlementContext(
 		keeper: tokenomicsKeeper,
 		logger: logger.With("module", "settlement_context"),
 
-		supplierMap:      make(map[string]int),
-		settledSuppliers: make([]*sharedtypes.Supplier, 0),
+		supplierMap:            make(map[string]int),
+		settledSuppliers:       make([]*sharedtypes.Supplier, 0),
+		supplierDelegationsMap: make(map[string][]*suppliertypes.Delegation),
 
 		applicationMap:             make(map[string]int),
 		settledApplications:        make([]*apptypes.Application, 0),
@@ -197,6 +204,12 @@ func (sctx *settlementContext) GetSupplier(supplierOperatorAddress string) (*sha
 	)
 }
 
+// GetSupplierDelegations retrieves the cached delegations, including the unbonding
+// ones, to the supplier with the given operator address.
+func (sctx *settlementContext) GetSupplierDelegations(supplierOperatorAddress string) []*suppliertypes.Delegation {
+	return sctx.supplierDelegationsMap[supplierOperatorAddress]
+}
+
 // GetSettledApplications returns all applications that have been modified during the settlement process.
 //
 // These applications will returned in the order they were added to the settlement context.
@@ -256,6 +269,13 @@ func (sctx *settlementContext) cacheSupplier(
 	sctx.supplierMap[supplierOperatorAddress] = idx
 	sctx.settledSuppliers = append(sctx.settledSuppliers, &supplier)
 
+	// Cache the delegations to the supplier for the rewards distribution and slashing.
+	supplierDelegations := sctx.keeper.supplierKeeper.GetSupplierDelegations(ctx, supplierOperatorAddress)
+	sctx.supplierDelegationsMap[supplierOperatorAddress] = make([]*suppliertypes.Delegation, len(supplierDelegations))
+	for i := range supplierDelegations {
+		sctx.supplierDelegationsMap[supplierOperatorAddress][i] = &supplierDelegations[i]
+	}
+
 	return nil
 }
 
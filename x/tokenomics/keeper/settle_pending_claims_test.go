@@ -36,6 +36,8 @@ import (
 	servicetypes "github.com/pokt-network/poktroll/x/service/types"
 	sessiontypes "github.com/pokt-network/poktroll/x/session/types"
 	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
+	supplierkeeper "github.com/pokt-network/poktroll/x/supplier/keeper"
+	suppliermodule "github.com/pokt-network/poktroll/x/supplier/module"
 	suppliertypes "github.com/pokt-network/poktroll/x/supplier/types"
 	tokenomicstypes "github.com/pokt-network/poktroll/x/tokenomics/types"
 )
@@ -178,7 +180,7 @@ func (s *TestSuite) TestSettlePendingClaims_ClaimExpired_ProofRequiredAndNotProv
 	claim := s.claims[0]
 	relayMiningDifficulty := s.relayMiningDifficulties[0]
 
-	proofRequirementThreshold, err := claim.GetClaimeduPOKT(sharedParams, relayMiningDifficulty)
+	proofRequirementThreshold, err := claim.GetClaimeduPOKT(sharedParams, s.getClaimService(&claim), relayMiningDifficulty)
 	require.NoError(t, err)
 
 	// -1 to push threshold below s.claim's compute units
@@ -243,7 +245,7 @@ func (s *TestSuite) TestSettlePendingClaims_ClaimExpired_ProofRequiredAndNotProv
 	require.Equal(t, int64(0), tokenomicsModuleBalRes.Balance.Amount.Int64())
 
 	events := sdkCtx.EventManager().Events()
-	require.Equal(t, 12, len(events)) // asserting on the length of events so the developer must consciously update it upon changes
+	require.Equal(t, 13, len(events)) // asserting on the length of events so the developer must consciously update it upon changes
 
 	// Confirm an expiration event was emitted
 	expectedClaimExpiredEvents := testutilevents.FilterEvents[*tokenomicstypes.EventClaimExpired](t, events)
@@ -268,6 +270,339 @@ func (s *TestSuite) TestSettlePendingClaims_ClaimExpired_ProofRequiredAndNotProv
 	require.Equal(t, &belowStakeAmountProofMissingPenalty, expectedSlashingEvent.GetProofMissingPenalty())
 }
 
+func (s *TestSuite) TestSettlePendingClaims_ClaimExpired_ProofRequiredAndNotProvided_SlashesDelegations() {
+	// Retrieve default values
+	t := s.T()
+	ctx := s.ctx
+	sharedParams := s.keepers.SharedKeeper.GetParams(ctx)
+	// Use a single claim for this test
+	claim := s.claims[0]
+	relayMiningDifficulty := s.relayMiningDifficulties[0]
+
+	proofRequirementThreshold, err := claim.GetClaimeduPOKT(sharedParams, s.getClaimService(&claim), relayMiningDifficulty)
+	require.NoError(t, err)
+
+	// -1 to push threshold below s.claim's compute units
+	proofRequirementThreshold = proofRequirementThreshold.Sub(uPOKTCoin(1))
+
+	// Set the proof missing penalty to half the supplier's stake. Since the supplier
+	// has a delegation of the same amount as its stake, both the supplier and the
+	// delegation get slashed by a quarter of the supplier's stake.
+	proofMissingPenalty := cosmostypes.NewCoin(volatile.DenomuPOKT, math.NewInt(supplierStakeAmt/2))
+	expectedSlashingCoin := cosmostypes.NewCoin(volatile.DenomuPOKT, math.NewInt(supplierStakeAmt/4))
+
+	err = s.keepers.ProofKeeper.SetParams(ctx, prooftypes.Params{
+		ProofRequestProbability:   0,
+		ProofRequirementThreshold: &proofRequirementThreshold,
+		ProofMissingPenalty:       &proofMissingPenalty,
+	})
+	require.NoError(t, err)
+
+	// Delegate to the supplier the same amount as its stake.
+	delegationStake := cosmostypes.NewCoin(volatile.DenomuPOKT, math.NewInt(supplierStakeAmt))
+	delegation := suppliertypes.Delegation{
+		DelegatorAddress:        sample.AccAddress(),
+		SupplierOperatorAddress: claim.SupplierOperatorAddress,
+		Stake:                   &delegationStake,
+	}
+	s.keepers.SetDelegation(ctx, delegation)
+
+	supplierModuleStartBalRes, err := s.keepers.Balance(s.ctx, &banktypes.QueryBalanceRequest{
+		Address: authtypes.NewModuleAddress(suppliertypes.ModuleName).String(),
+		Denom:   volatile.DenomuPOKT,
+	})
+	require.NoError(t, err)
+
+	// Upsert the claim ONLY
+	s.keepers.UpsertClaim(ctx, claim)
+
+	// Settle pending claims after proof window closes
+	// Expectation: All (1) claims should be expired.
+	sessionEndHeight := claim.SessionHeader.SessionEndBlockHeight
+	blockHeight := sharedtypes.GetProofWindowCloseHeight(&sharedParams, sessionEndHeight)
+	sdkCtx := cosmostypes.UnwrapSDKContext(ctx).WithBlockHeight(blockHeight)
+	settledResults, expiredResults, err := s.keepers.SettlePendingClaims(sdkCtx)
+	require.NoError(t, err)
+
+	// Validate claim settlement results
+	require.Equal(t, uint64(0), settledResults.GetNumClaims()) // 0 claims settled
+	require.Equal(t, uint64(1), expiredResults.GetNumClaims()) // 1 claim expired
+
+	// The supplier and its delegation should have been slashed proportionally to their stake.
+	slashedSupplier, supplierFound := s.keepers.GetSupplier(sdkCtx, claim.SupplierOperatorAddress)
+	require.True(t, supplierFound)
+	require.Equal(t, supplierStakeAmt-expectedSlashingCoin.Amount.Int64(), slashedSupplier.Stake.Amount.Int64())
+
+	delegations := s.keepers.GetSupplierDelegations(sdkCtx, claim.SupplierOperatorAddress)
+	require.Len(t, delegations, 1)
+	require.Equal(t, delegationStake.Sub(expectedSlashingCoin), *delegations[0].GetStake())
+
+	// The whole proof missing penalty should have been burned from the supplier module.
+	supplierModuleBalRes, err := s.keepers.Balance(s.ctx, &banktypes.QueryBalanceRequest{
+		Address: authtypes.NewModuleAddress(suppliertypes.ModuleName).String(),
+		Denom:   volatile.DenomuPOKT,
+	})
+	require.NoError(t, err)
+	require.Equal(t, supplierModuleStartBalRes.Balance.Sub(proofMissingPenalty), *supplierModuleBalRes.Balance)
+
+	events := sdkCtx.EventManager().Events()
+
+	// Confirm that the supplier slashing event only accounts for the supplier's share of the penalty.
+	expectedSlashingEvents := testutilevents.FilterEvents[*tokenomicstypes.EventSupplierSlashed](t, events)
+	require.Equal(t, 1, len(expectedSlashingEvents))
+	require.Equal(t, &expectedSlashingCoin, expectedSlashingEvents[0].GetProofMissingPenalty())
+
+	// Confirm that a delegation slashing event was emitted
+	delegationSlashingEvents := testutilevents.FilterEvents[*tokenomicstypes.EventSupplierDelegationSlashed](t, events)
+	require.Equal(t, 1, len(delegationSlashingEvents))
+	require.Equal(t, delegation.GetDelegatorAddress(), delegationSlashingEvents[0].GetDelegatorAddress())
+	require.Equal(t, claim.SupplierOperatorAddress, delegationSlashingEvents[0].GetSupplierOperatorAddress())
+	require.Equal(t, &expectedSlashingCoin, delegationSlashingEvents[0].GetSlashedAmount())
+}
+
+func (s *TestSuite) TestSettlePendingClaims_ClaimExpired_ProofRequiredAndNotProvided_RemovesFullySlashedDelegations() {
+	// Retrieve default values
+	t := s.T()
+	ctx := s.ctx
+	sharedParams := s.keepers.SharedKeeper.GetParams(ctx)
+	// Use a single claim for this test
+	claim := s.claims[0]
+	relayMiningDifficulty := s.relayMiningDifficulties[0]
+
+	proofRequirementThreshold, err := claim.GetClaimeduPOKT(sharedParams, s.getClaimService(&claim), relayMiningDifficulty)
+	require.NoError(t, err)
+
+	// -1 to push threshold below s.claim's compute units
+	proofRequirementThreshold = proofRequirementThreshold.Sub(uPOKTCoin(1))
+
+	// Set the proof missing penalty above the total stake backing the supplier
+	// so that both the supplier and its delegation are fully slashed.
+	delegationStake := cosmostypes.NewCoin(volatile.DenomuPOKT, math.NewInt(supplierStakeAmt))
+	proofMissingPenalty := cosmostypes.NewCoin(volatile.DenomuPOKT, math.NewInt(3*supplierStakeAmt))
+
+	err = s.keepers.ProofKeeper.SetParams(ctx, prooftypes.Params{
+		ProofRequestProbability:   0,
+		ProofRequirementThreshold: &proofRequirementThreshold,
+		ProofMissingPenalty:       &proofMissingPenalty,
+	})
+	require.NoError(t, err)
+
+	delegation := suppliertypes.Delegation{
+		DelegatorAddress:        sample.AccAddress(),
+		SupplierOperatorAddress: claim.SupplierOperatorAddress,
+		Stake:                   &delegationStake,
+	}
+	s.keepers.SetDelegation(ctx, delegation)
+	// Delegated stakes are held by the supplier module account.
+	err = s.keepers.MintCoins(ctx, suppliertypes.ModuleName, cosmostypes.NewCoins(delegationStake))
+	require.NoError(t, err)
+
+	// Upsert the claim ONLY
+	s.keepers.UpsertClaim(ctx, claim)
+
+	// Settle pending claims after proof window closes
+	// Expectation: All (1) claims should be expired.
+	sessionEndHeight := claim.SessionHeader.SessionEndBlockHeight
+	blockHeight := sharedtypes.GetProofWindowCloseHeight(&sharedParams, sessionEndHeight)
+	sdkCtx := cosmostypes.UnwrapSDKContext(ctx).WithBlockHeight(blockHeight)
+	_, expiredResults, err := s.keepers.SettlePendingClaims(sdkCtx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), expiredResults.GetNumClaims())
+
+	// The fully slashed delegation should have been removed.
+	require.Empty(t, s.keepers.GetSupplierDelegations(sdkCtx, claim.SupplierOperatorAddress))
+
+	delegationSlashingEvents := testutilevents.FilterEvents[*tokenomicstypes.EventSupplierDelegationSlashed](t, sdkCtx.EventManager().Events())
+	require.Equal(t, 1, len(delegationSlashingEvents))
+	require.Equal(t, &delegationStake, delegationSlashingEvents[0].GetSlashedAmount())
+
+	// The exported supplier module genesis should remain valid.
+	supplierKeeper, ok := s.keepers.SupplierKeeper.(*supplierkeeper.Keeper)
+	require.True(t, ok)
+	exportedGenesis := suppliermodule.ExportGenesis(sdkCtx, *supplierKeeper)
+	require.NoError(t, exportedGenesis.Validate())
+}
+
+func (s *TestSuite) TestSettlePendingClaims_ClaimExpired_ProofRequiredAndNotProvided_EscalatesPenaltyAndJails() {
+	// Retrieve default values
+	t := s.T()
+	ctx := s.ctx
+	sharedParams := s.keepers.SharedKeeper.GetParams(ctx)
+	supplierParams := s.keepers.SupplierKeeper.GetParams(ctx)
+	// Use a single claim for this test
+	claim := s.claims[0]
+	relayMiningDifficulty := s.relayMiningDifficulties[0]
+
+	proofRequirementThreshold, err := claim.GetClaimeduPOKT(sharedParams, s.getClaimService(&claim), relayMiningDifficulty)
+	require.NoError(t, err)
+
+	// -1 to push threshold below s.claim's compute units
+	proofRequirementThreshold = proofRequirementThreshold.Sub(uPOKTCoin(1))
+
+	// Set the proof missing penalty to a tenth of the supplier's stake so it is
+	// not unstaked when being slashed by the escalated penalty.
+	proofMissingPenalty := cosmostypes.NewCoin(volatile.DenomuPOKT, math.NewInt(supplierStakeAmt/10))
+
+	err = s.keepers.ProofKeeper.SetParams(ctx, prooftypes.Params{
+		ProofRequestProbability:   0,
+		ProofRequirementThreshold: &proofRequirementThreshold,
+		ProofMissingPenalty:       &proofMissingPenalty,
+	})
+	require.NoError(t, err)
+
+	// Record prior proof faults within the proof fault window such that the
+	// expiring claim reaches the proof fault jailing threshold.
+	sessionEndHeight := claim.SessionHeader.SessionEndBlockHeight
+	numPriorProofFaults := int64(supplierParams.GetProofFaultJailingThreshold() - 1)
+	for i := int64(1); i <= numPriorProofFaults; i++ {
+		_, err = s.keepers.RecordSupplierProofFault(ctx, claim.SupplierOperatorAddress, suppliertypes.ProofFault{
+			SessionId:        fmt.Sprintf("prior_session_%d", i),
+			SessionEndHeight: sessionEndHeight - i*int64(sharedParams.GetNumBlocksPerSession()),
+		})
+		require.NoError(t, err)
+	}
+
+	// Upsert the claim ONLY
+	s.keepers.UpsertClaim(ctx, claim)
+
+	// Settle pending claims after proof window closes
+	// Expectation: All (1) claims should be expired.
+	blockHeight := sharedtypes.GetProofWindowCloseHeight(&sharedParams, sessionEndHeight)
+	sdkCtx := cosmostypes.UnwrapSDKContext(ctx).WithBlockHeight(blockHeight)
+	settledResults, expiredResults, err := s.keepers.SettlePendingClaims(sdkCtx)
+	require.NoError(t, err)
+
+	// Validate claim settlement results
+	require.Equal(t, uint64(0), settledResults.GetNumClaims()) // 0 claims settled
+	require.Equal(t, uint64(1), expiredResults.GetNumClaims()) // 1 claim expired
+
+	// The penalty should have been escalated by the prior proof faults.
+	numProofFaults := supplierParams.GetProofFaultJailingThreshold()
+	// Given the default 100% escalation, the penalty of the third proof fault is
+	// three times the proof missing penalty.
+	expectedSlashingCoin := suppliertypes.GetEscalatedProofMissingPenalty(&supplierParams, proofMissingPenalty, numProofFaults)
+	require.Equal(t, proofMissingPenalty.Amount.MulRaw(3), expectedSlashingCoin.Amount)
+
+	// The supplier should have been slashed and jailed starting from the next
+	// session, without being unstaked.
+	currentSessionEndHeight := sharedtypes.GetSessionEndHeight(&sharedParams, blockHeight)
+	expectedJailEndHeight := suppliertypes.GetSupplierJailEndHeight(&sharedParams, &supplierParams, currentSessionEndHeight)
+	slashedSupplier, supplierFound := s.keepers.GetSupplier(sdkCtx, claim.SupplierOperatorAddress)
+	require.True(t, supplierFound)
+	require.Equal(t, supplierStakeAmt-expectedSlashingCoin.Amount.Int64(), slashedSupplier.Stake.Amount.Int64())
+	require.Equal(t, uint64(0), slashedSupplier.UnstakeSessionEndHeight)
+	require.Equal(t, []*sharedtypes.SupplierJailPeriod{{
+		JailStartHeight: currentSessionEndHeight + 1,
+		JailEndHeight:   expectedJailEndHeight,
+		UnjailHeight:    sharedtypes.SupplierNotUnjailed,
+	}}, slashedSupplier.GetJailHistory())
+	require.False(t, slashedSupplier.IsJailed(currentSessionEndHeight))
+	require.True(t, slashedSupplier.IsJailed(currentSessionEndHeight+1))
+
+	events := sdkCtx.EventManager().Events()
+
+	// Confirm that the slashing event accounts for the escalated penalty.
+	expectedSlashingEvents := testutilevents.FilterEvents[*tokenomicstypes.EventSupplierSlashed](t, events)
+	require.Equal(t, 1, len(expectedSlashingEvents))
+	require.Equal(t, &expectedSlashingCoin, expectedSlashingEvents[0].GetProofMissingPenalty())
+	require.Equal(t, numProofFaults, expectedSlashingEvents[0].GetNumProofFaults())
+
+	// Confirm that a jailing event was emitted
+	jailedEvents := testutilevents.FilterEvents[*suppliertypes.EventSupplierJailed](t, events)
+	require.Equal(t, 1, len(jailedEvents))
+	require.Equal(t, claim.SupplierOperatorAddress, jailedEvents[0].GetSupplier().GetOperatorAddress())
+	require.Equal(t, numProofFaults, jailedEvents[0].GetNumProofFaults())
+	require.Equal(t, currentSessionEndHeight, jailedEvents[0].GetSessionEndHeight())
+	require.Equal(t, expectedJailEndHeight, jailedEvents[0].GetJailEndHeight())
+}
+
+func (s *TestSuite) TestSettlePendingClaims_ClaimsExpired_SameSession_CountAsSingleProofFault() {
+	// Retrieve default values
+	t := s.T()
+	ctx := s.ctx
+	sharedParams := s.keepers.SharedKeeper.GetParams(ctx)
+	supplierParams := s.keepers.SupplierKeeper.GetParams(ctx)
+
+	// All claims are for the same supplier and session, use the first one
+	claim := s.claims[0]
+	proofRequirementThreshold, err := claim.GetClaimeduPOKT(sharedParams, s.getClaimService(&claim), s.relayMiningDifficulties[0])
+	require.NoError(t, err)
+
+	// -1 to push threshold below s.claim's compute units
+	proofRequirementThreshold = proofRequirementThreshold.Sub(uPOKTCoin(1))
+
+	// Set the proof missing penalty low enough for the supplier not to be unstaked
+	// when being slashed by the escalated penalty of all the claims.
+	proofMissingPenalty := cosmostypes.NewCoin(volatile.DenomuPOKT, math.NewInt(supplierStakeAmt/20))
+
+	err = s.keepers.ProofKeeper.SetParams(ctx, prooftypes.Params{
+		ProofRequestProbability:   0,
+		ProofRequirementThreshold: &proofRequirementThreshold,
+		ProofMissingPenalty:       &proofMissingPenalty,
+	})
+	require.NoError(t, err)
+
+	// Record prior proof faults within the proof fault window such that the proof
+	// fault of the expiring claims' session reaches the proof fault jailing threshold.
+	sessionEndHeight := claim.SessionHeader.SessionEndBlockHeight
+	numPriorProofFaults := int64(supplierParams.GetProofFaultJailingThreshold() - 1)
+	for i := int64(1); i <= numPriorProofFaults; i++ {
+		_, err = s.keepers.RecordSupplierProofFault(ctx, claim.SupplierOperatorAddress, suppliertypes.ProofFault{
+			SessionId:        fmt.Sprintf("prior_session_%d", i),
+			SessionEndHeight: sessionEndHeight - i*int64(sharedParams.GetNumBlocksPerSession()),
+		})
+		require.NoError(t, err)
+	}
+
+	// Upsert the claims of all the services, without any proof.
+	for _, claim := range s.claims {
+		s.keepers.UpsertClaim(ctx, claim)
+	}
+
+	// Settle pending claims after proof window closes
+	// Expectation: All claims should be expired.
+	blockHeight := sharedtypes.GetProofWindowCloseHeight(&sharedParams, sessionEndHeight)
+	// Use a fresh event manager to only collect the events emitted during settlement.
+	sdkCtx := cosmostypes.UnwrapSDKContext(ctx).
+		WithBlockHeight(blockHeight).
+		WithEventManager(cosmostypes.NewEventManager())
+	settledResults, expiredResults, err := s.keepers.SettlePendingClaims(sdkCtx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), settledResults.GetNumClaims())
+	require.Equal(t, uint64(len(s.claims)), expiredResults.GetNumClaims())
+
+	// The expired claims of the session are a single proof fault, so they are
+	// all slashed with the same escalated penalty.
+	numProofFaults := supplierParams.GetProofFaultJailingThreshold()
+	expectedSlashingCoin := suppliertypes.GetEscalatedProofMissingPenalty(&supplierParams, proofMissingPenalty, numProofFaults)
+
+	events := sdkCtx.EventManager().Events()
+	slashingEvents := testutilevents.FilterEvents[*tokenomicstypes.EventSupplierSlashed](t, events)
+	require.Len(t, slashingEvents, len(s.claims))
+	for _, slashingEvent := range slashingEvents {
+		require.Equal(t, &expectedSlashingCoin, slashingEvent.GetProofMissingPenalty())
+		require.Equal(t, numProofFaults, slashingEvent.GetNumProofFaults())
+	}
+
+	// The session's proof fault is recorded once.
+	recordedEvents := testutilevents.FilterEvents[*suppliertypes.EventSupplierProofFaultRecorded](t, events)
+	require.Len(t, recordedEvents, 1)
+	require.Equal(t, numProofFaults, recordedEvents[0].GetNumProofFaults())
+
+	// The supplier is jailed once, and the claims expiring after it was jailed
+	// do not count towards jailing it again.
+	jailedEvents := testutilevents.FilterEvents[*suppliertypes.EventSupplierJailed](t, events)
+	require.Len(t, jailedEvents, 1)
+	require.Equal(t, numProofFaults, jailedEvents[0].GetNumProofFaults())
+
+	slashedSupplier, isFound := s.keepers.GetSupplier(sdkCtx, claim.SupplierOperatorAddress)
+	require.True(t, isFound)
+	require.Len(t, slashedSupplier.GetJailHistory(), 1)
+
+	expectedStakeAmt := supplierStakeAmt - int64(len(s.claims))*expectedSlashingCoin.Amount.Int64()
+	require.Equal(t, expectedStakeAmt, slashedSupplier.GetStake().Amount.Int64())
+}
+
 func (s *TestSuite) TestSettlePendingClaims_ClaimSettled_ProofRequiredAndProvided_ViaThreshold() {
 	// Retrieve default values
 	t := s.T()
@@ -278,7 +613,7 @@ func (s *TestSuite) TestSettlePendingClaims_ClaimSettled_ProofRequiredAndProvide
 	proof := s.proofs[0]
 	relayMiningDifficulty := s.relayMiningDifficulties[0]
 
-	proofRequirementThreshold, err := claim.GetClaimeduPOKT(sharedParams, relayMiningDifficulty)
+	proofRequirementThreshold, err := claim.GetClaimeduPOKT(sharedParams, s.getClaimService(&claim), relayMiningDifficulty)
 	require.NoError(t, err)
 
 	// -1 to push threshold below s.claim's compute units
@@ -391,7 +726,7 @@ func (s *TestSuite) TestSettlePendingClaims_ClaimExpired_ProofRequired_InvalidOn
 
 	// Confirm an expiration event was emitted
 	events := sdkCtx.EventManager().Events()
-	require.Equal(t, 13, len(events)) // minting, burning, settling, etc..
+	require.Equal(t, 14, len(events)) // minting, burning, settling, etc..
 
 	expectedClaimExpiredEvents := testutilevents.FilterEvents[*tokenomicstypes.EventClaimExpired](t, events)
 	require.Equal(t, 1, len(expectedClaimExpiredEvents))
@@ -431,7 +766,7 @@ func (s *TestSuite) TestClaimSettlement_ClaimSettled_ProofRequiredAndProvided_Vi
 	proof := s.proofs[0]
 	relayMiningDifficulty := s.relayMiningDifficulties[0]
 
-	proofRequirementThreshold, err := claim.GetClaimeduPOKT(sharedParams, relayMiningDifficulty)
+	proofRequirementThreshold, err := claim.GetClaimeduPOKT(sharedParams, s.getClaimService(&claim), relayMiningDifficulty)
 	require.NoError(t, err)
 
 	// +1 so it's not required via probability
@@ -493,7 +828,7 @@ func (s *TestSuite) TestSettlePendingClaims_Settles_WhenAProofIsNotRequired() {
 	claim := s.claims[0]
 	relayMiningDifficulty := s.relayMiningDifficulties[0]
 
-	proofRequirementThreshold, err := claim.GetClaimeduPOKT(sharedParams, relayMiningDifficulty)
+	proofRequirementThreshold, err := claim.GetClaimeduPOKT(sharedParams, s.getClaimService(&claim), relayMiningDifficulty)
 	require.NoError(t, err)
 
 	// +1 to push threshold above s.claim's compute units
@@ -571,7 +906,7 @@ func (s *TestSuite) TestSettlePendingClaims_ClaimPendingAfterSettlement() {
 	claim := s.claims[0]
 	relayMiningDifficulty := s.relayMiningDifficulties[0]
 
-	proofRequirementThreshold, err := claim.GetClaimeduPOKT(sharedParams, relayMiningDifficulty)
+	proofRequirementThreshold, err := claim.GetClaimeduPOKT(sharedParams, s.getClaimService(&claim), relayMiningDifficulty)
 	require.NoError(t, err)
 
 	// +1 to push threshold above s.claim's compute units
@@ -665,7 +1000,7 @@ func (s *TestSuite) TestSettlePendingClaims_ClaimExpired_SupplierUnstaked() {
 	serviceId := claim.GetSessionHeader().GetServiceId()
 	relayMiningDifficulty := s.relayMiningDifficulties[0]
 
-	proofRequirementThreshold, err := claim.GetClaimeduPOKT(sharedParams, relayMiningDifficulty)
+	proofRequirementThreshold, err := claim.GetClaimeduPOKT(sharedParams, s.getClaimService(&claim), relayMiningDifficulty)
 	require.NoError(t, err)
 
 	// -1 to push threshold below s.claim's compute units
@@ -683,6 +1018,15 @@ func (s *TestSuite) TestSettlePendingClaims_ClaimExpired_SupplierUnstaked() {
 	err = s.keepers.ProofKeeper.SetParams(ctx, proofParams)
 	require.NoError(t, err)
 
+	// Jail the supplier on its first proof fault, the expired claims of the same
+	// session being a single proof fault.
+	supplierKeeper, ok := s.keepers.SupplierKeeper.(*supplierkeeper.Keeper)
+	require.True(t, ok)
+	supplierParams := supplierKeeper.GetParams(ctx)
+	supplierParams.ProofFaultJailingThreshold = 1
+	err = supplierKeeper.SetParams(ctx, supplierParams)
+	require.NoError(t, err)
+
 	// Creating multiple claims without proofs to test the claim expiration process:
 	// - We're creating exactly numExpiredClaims applications to create numExpiredClaims claims
 	// - All claims are for the same settlement period
@@ -754,10 +1098,17 @@ func (s *TestSuite) TestSettlePendingClaims_ClaimExpired_SupplierUnstaked() {
 		expectedSlashingEvent := &tokenomicstypes.EventSupplierSlashed{
 			Claim:               expiredClaimsMap[sessionId],
 			ProofMissingPenalty: &proofMissingPenalty,
+			// The expired claims of the same session are a single proof fault.
+			NumProofFaults: 1,
 		}
 		require.EqualValues(t, expectedSlashingEvent, slashingEvents[i])
 	}
 
+	// Assert that the unbonding supplier was not jailed, despite reaching the
+	// proof fault jailing threshold.
+	require.False(t, slashedSupplier.HasPendingJail())
+	require.Empty(t, testutilevents.FilterEvents[*suppliertypes.EventSupplierJailed](t, events))
+
 	// Assert that an EventSupplierUnbondingBegin event was emitted.
 	unbondingBeginEvents := testutilevents.FilterEvents[*suppliertypes.EventSupplierUnbondingBegin](t, events)
 	require.Equal(t, 1, len(unbondingBeginEvents))
@@ -775,6 +1126,11 @@ func (s *TestSuite) TestSettlePendingClaims_ClaimExpired_SupplierUnstaked() {
 		if slashedSupplier.ServiceConfigHistory[i].Service.Endpoints == nil {
 			slashedSupplier.ServiceConfigHistory[i].Service.Endpoints = []*sharedtypes.SupplierEndpoint{}
 		}
+		for _, endpoint := range slashedSupplier.ServiceConfigHistory[i].Service.Endpoints {
+			if endpoint.Configs == nil {
+				endpoint.Configs = []*sharedtypes.ConfigOption{}
+			}
+		}
 	}
 	// Get the active service configs at the time of the claimed session end height.
 	slashedSupplier.Services = slashedSupplier.GetActiveServiceConfigs(sessionEndHeight)
@@ -782,6 +1138,12 @@ func (s *TestSuite) TestSettlePendingClaims_ClaimExpired_SupplierUnstaked() {
 	// DEV_NOTE: The slashing flow skips populating all the supplier's history for performance reasons.
 	// The slashed supplier Services property already has the relevant active service configs at the time of the claimed session end height.
 	slashedSupplier.ServiceConfigHistory = []*sharedtypes.ServiceConfigUpdate{}
+	if slashedSupplier.DelegationCommissionHistory == nil {
+		slashedSupplier.DelegationCommissionHistory = []*sharedtypes.SupplierDelegationCommissionUpdate{}
+	}
+	if slashedSupplier.JailHistory == nil {
+		slashedSupplier.JailHistory = []*sharedtypes.SupplierJailPeriod{}
+	}
 	expectedUnbondingBeginEvent := &suppliertypes.EventSupplierUnbondingBegin{
 		Supplier:           &slashedSupplier,
 		Reason:             suppliertypes.SupplierUnbondingReason_SUPPLIER_UNBONDING_REASON_BELOW_MIN_STAKE,
@@ -826,7 +1188,7 @@ func (s *TestSuite) TestSettlePendingClaims_MultipleClaimsFromDifferentServices(
 	sdkCtx := cosmostypes.UnwrapSDKContext(ctx).WithBlockHeight(blockHeight)
 
 	// All claims have the same proof requirement threshold, pick the first one
-	proofRequirementThreshold, err := s.claims[0].GetClaimeduPOKT(sharedParams, s.relayMiningDifficulties[0])
+	proofRequirementThreshold, err := s.claims[0].GetClaimeduPOKT(sharedParams, s.getClaimService(&s.claims[0]), s.relayMiningDifficulties[0])
 	require.NoError(t, err)
 
 	// -1 to push threshold below s.claim's compute units
@@ -965,6 +1327,10 @@ func (s *TestSuite) createTestActors(
 			supplierServiceConfigs,
 			&sharedtypes.SupplierServiceConfig{
 				ServiceId: serviceId,
+				Endpoints: []*sharedtypes.SupplierEndpoint{{
+					Url:     "http://localhost:8080",
+					RpcType: sharedtypes.RPCType_JSON_RPC,
+				}},
 				RevShare: []*sharedtypes.ServiceRevenueShare{{
 					Address:            supplierOwnerAddr,
 					RevSharePercentage: 100,
@@ -1099,3 +1465,10 @@ func (s *TestSuite) createTestClaimsAndProofs(
 
 	return claims, proofs
 }
+
+// getClaimService returns the service which the given claim is for.
+func (s *TestSuite) getClaimService(claim *prooftypes.Claim) sharedtypes.Service {
+	service, isServiceFound := s.keepers.GetService(s.ctx, claim.GetSessionHeader().GetServiceId())
+	require.True(s.T(), isServiceFound)
+	return service
+}
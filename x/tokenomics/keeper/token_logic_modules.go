@@ -147,7 +147,7 @@ func (k Keeper) ProcessTokenLogicModules(
 	// Determine the total number of tokens being claimed (i.e. for the work completed)
 	// by the supplier for the amount of work they did to service the application
 	// in the session.
-	claimSettlementCoin, err = pendingResult.Claim.GetClaimeduPOKT(sharedParams, relayMiningDifficulty)
+	claimSettlementCoin, err = pendingResult.Claim.GetClaimeduPOKT(sharedParams, *service, relayMiningDifficulty)
 	if err != nil {
 		return err
 	}
@@ -190,14 +190,22 @@ func (k Keeper) ProcessTokenLogicModules(
 		Service:               service,
 		Application:           application,
 		Supplier:              supplier,
+		SupplierDelegations:   settlementContext.GetSupplierDelegations(supplier.OperatorAddress),
 		RelayMiningDifficulty: &relayMiningDifficulty,
 	}
 
-	// Execute all the token logic modules processors
-	for _, tokenLogicModule := range k.tokenLogicModules {
-		tlmName := tokenLogicModule.GetId().String()
+	// Execute the token logic modules processors configured by the token_logic_modules param, in order.
+	for _, tlmConfig := range tokenomicsParams.TokenLogicModules {
+		tlmName := tlmConfig.Id.String()
+
+		tokenLogicModule, err := tlm.GetTokenLogicModule(k.tokenLogicModules, tlmConfig.Id)
+		if err != nil {
+			return tokenomicstypes.ErrTokenomicsProcessingTLM.Wrapf("TLM %q: %s", tlmName, err)
+		}
+
 		logger.Info(fmt.Sprintf("Starting processing TLM: %q", tlmName))
 
+		tlmCtx.Config = tlmConfig
 		if err = tokenLogicModule.Process(ctx, logger, tlmCtx); err != nil {
 			return tokenomicstypes.ErrTokenomicsProcessingTLM.Wrapf("TLM %q: %s", tlmName, err)
 		}
@@ -244,6 +252,9 @@ func (k Keeper) ProcessTokenLogicModules(
 // can claim in a session is AppStake/NumSuppliersPerSession.
 // If this is not the case, then the supplier essentially did "free work" and the
 // actual claim amount is less than what was claimed.
+// The claim amount MUST be computed with the claim's service compute units to
+// tokens multiplier (see GetClaimeduPOKT), which is the same one used by the
+// RelayMiner when metering relays against the application stake.
 // Ref: https://arxiv.org/pdf/2305.10672
 func (k Keeper) ensureClaimAmountLimits(
 	ctx context.Context,
@@ -267,7 +278,11 @@ func (k Keeper) ensureClaimAmountLimits(
 
 	// The application should have enough stake to cover for the global mint reimbursement.
 	// This amount is deducted from the maximum claimable amount.
+	// The application is not overcharged if the global mint reimbursement request TLM is disabled.
 	globalInflationPerClaim := tokenomicsParams.GlobalInflationPerClaim
+	if !tokenomicsParams.IsTokenLogicModuleEnabled(tlm.TLMGlobalMintReimbursementRequest) {
+		globalInflationPerClaim = 0
+	}
 	globalInflationPerClaimRat, err := encoding.Float64ToRat(globalInflationPerClaim)
 	if err != nil {
 		logger.Error(fmt.Sprintf("error calculating claim amount limits due to: %v", err))
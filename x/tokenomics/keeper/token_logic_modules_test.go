@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"math/big"
+	"strings"
 	"testing"
 
 	cosmoslog "cosmossdk.io/log"
@@ -197,6 +198,122 @@ func TestProcessTokenLogicModules_TLMBurnEqualsMint_Valid(t *testing.T) {
 	}
 }
 
+// DEV_NOTE: Most of the setup here is a copy-paste of TLMBurnEqualsMintValid
+// except that the service overrides the global compute units to tokens multiplier
+// and has an update scheduled after the claim's session.
+func TestProcessTokenLogicModules_TLMBurnEqualsMint_Valid_ServiceComputeUnitsToTokensMultiplier(t *testing.T) {
+	// Test Parameters
+	appInitialStake := apptypes.DefaultMinStake.Amount.Mul(cosmosmath.NewInt(2))
+	supplierInitialStake := cosmosmath.NewInt(1000000)
+	globalComputeUnitsToTokensMultiplier := uint64(1)
+	serviceComputeUnitsToTokensMultiplier := uint64(3)
+	serviceComputeUnitsPerRelay := uint64(1)
+	service := prepareTestService(serviceComputeUnitsPerRelay)
+	service.ComputeUnitsToTokensMultiplier = serviceComputeUnitsToTokensMultiplier
+	// Schedule a multiplier update for the session following the claim's one,
+	// which MUST NOT apply to the claim.
+	nextSessionStartHeight := testsession.GetSessionEndHeightWithDefaultParams(1) + 1
+	service.SetComputeUnitsToTokensMultiplier(serviceComputeUnitsToTokensMultiplier*10, nextSessionStartHeight, 0)
+	numRelays := uint64(1000) // By supplier for application in this session
+
+	// Prepare the keepers
+	keepers, ctx := testkeeper.NewTokenomicsModuleKeepers(t,
+		cosmoslog.NewNopLogger(),
+		testkeeper.WithService(*service),
+		testkeeper.WithDefaultModuleBalances(),
+	)
+	ctx = sdk.UnwrapSDKContext(ctx).WithBlockHeight(1)
+	keepers.SetService(ctx, *service)
+
+	// Ensure the claim is within relay mining bounds
+	numSuppliersPerSession := int64(keepers.SessionKeeper.GetParams(ctx).NumSuppliersPerSession)
+	numTokensClaimed := int64(numRelays * serviceComputeUnitsPerRelay * serviceComputeUnitsToTokensMultiplier)
+	maxClaimableAmountPerSupplier := appInitialStake.Quo(cosmosmath.NewInt(numSuppliersPerSession))
+	require.GreaterOrEqual(t, maxClaimableAmountPerSupplier.Int64(), numTokensClaimed)
+
+	// Set the global compute_units_to_tokens_multiplier to a value which MUST be
+	// overridden by the service one.
+	sharedParams := keepers.SharedKeeper.GetParams(ctx)
+	sharedParams.ComputeUnitsToTokensMultiplier = globalComputeUnitsToTokensMultiplier
+	err := keepers.SharedKeeper.SetParams(ctx, sharedParams)
+	require.NoError(t, err)
+	// Setting inflation to zero so we are testing the BurnEqualsMint logic exclusively.
+	tokenomicsParams := keepers.Keeper.GetParams(ctx)
+	tokenomicsParams.GlobalInflationPerClaim = 0
+	err = keepers.Keeper.SetParams(ctx, tokenomicsParams)
+	require.NoError(t, err)
+
+	// Add a new application with non-zero app stake end balance to assert against.
+	appStake := cosmostypes.NewCoin(volatile.DenomuPOKT, appInitialStake)
+	app := apptypes.Application{
+		Address:        sample.AccAddress(),
+		Stake:          &appStake,
+		ServiceConfigs: []*sharedtypes.ApplicationServiceConfig{{ServiceId: service.Id}},
+	}
+	keepers.SetApplication(ctx, app)
+
+	// Add a new supplier which is the sole shareholder of its rewards.
+	supplierRevShares := []*sharedtypes.ServiceRevenueShare{{
+		Address:            sample.AccAddress(),
+		RevSharePercentage: 100,
+	}}
+	services := []*sharedtypes.SupplierServiceConfig{{
+		ServiceId: service.Id,
+		RevShare:  supplierRevShares,
+	}}
+	supplierStake := cosmostypes.NewCoin(volatile.DenomuPOKT, supplierInitialStake)
+	serviceConfigHistory := sharedtest.CreateServiceConfigUpdateHistoryFromServiceConfigs(
+		supplierRevShares[0].Address,
+		services, 1, 0,
+	)
+	supplier := sharedtypes.Supplier{
+		OwnerAddress:         supplierRevShares[0].Address,
+		OperatorAddress:      supplierRevShares[0].Address,
+		Stake:                &supplierStake,
+		Services:             services,
+		ServiceConfigHistory: serviceConfigHistory,
+	}
+	keepers.SetAndIndexDehydratedSupplier(ctx, supplier)
+
+	supplierStartBalance := getBalance(t, ctx, keepers, supplier.GetOperatorAddress())
+
+	// Prepare the claim for which the supplier did work for the application
+	claim := prepareTestClaim(numRelays, service, &app, &supplier)
+	pendingResult := tlm.NewClaimSettlementResult(claim)
+
+	settlementContext := tokenomicskeeper.NewSettlementContext(
+		ctx,
+		keepers.Keeper,
+		keepers.Keeper.Logger(),
+	)
+
+	err = settlementContext.ClaimCacheWarmUp(ctx, &claim)
+	require.NoError(t, err)
+
+	// Process the token logic modules
+	err = keepers.ProcessTokenLogicModules(ctx, settlementContext, pendingResult)
+	require.NoError(t, err)
+
+	// Execute the pending results
+	pendingResults := make(tlm.ClaimSettlementResults, 0)
+	pendingResults.Append(pendingResult)
+	err = keepers.ExecutePendingSettledResults(cosmostypes.UnwrapSDKContext(ctx), pendingResults)
+	require.NoError(t, err)
+
+	// Persist the actors state
+	settlementContext.FlushAllActorsToStore(ctx)
+
+	// Assert that the application stake was burned using the service multiplier.
+	expectedClaimCoin := cosmostypes.NewInt64Coin(volatile.DenomuPOKT, numTokensClaimed)
+	app, appIsFound := keepers.GetApplication(ctx, app.GetAddress())
+	require.True(t, appIsFound)
+	require.Equal(t, appInitialStake.Sub(expectedClaimCoin.Amount), app.GetStake().Amount)
+
+	// Assert that the supplier was rewarded using the service multiplier.
+	supplierEndBalance := getBalance(t, ctx, keepers, supplier.GetOperatorAddress())
+	require.Equal(t, supplierStartBalance.Add(expectedClaimCoin), *supplierEndBalance)
+}
+
 // DEV_NOTE: Most of the setup here is a copy-paste of TLMBurnEqualsMintValid
 // except that the application stake is calculated to explicitly be too low to
 // handle all the relays completed.
@@ -375,6 +492,118 @@ func TestProcessTokenLogicModules_TLMBurnEqualsMint_Valid_SupplierExceedsMaxClai
 	require.Less(t, appBurn.Int64(), numTokensClaimed)
 }
 
+// TestProcessTokenLogicModules_OnlyEnabledTLMsAreProcessed asserts that only the
+// TLMs enabled by the token_logic_modules param are run, even when the global
+// inflation per claim is non-zero.
+func TestProcessTokenLogicModules_OnlyEnabledTLMsAreProcessed(t *testing.T) {
+	// Test Parameters
+	appInitialStake := apptypes.DefaultMinStake.Amount.Mul(cosmosmath.NewInt(2))
+	supplierInitialStake := cosmosmath.NewInt(1000000)
+	globalComputeUnitsToTokensMultiplier := uint64(1)
+	serviceComputeUnitsPerRelay := uint64(1)
+	service := prepareTestService(serviceComputeUnitsPerRelay)
+	numRelays := uint64(1000) // By supplier for application in this session
+	numTokensClaimed := int64(numRelays * serviceComputeUnitsPerRelay * globalComputeUnitsToTokensMultiplier)
+
+	// Prepare the keepers
+	keepers, ctx := testkeeper.NewTokenomicsModuleKeepers(t,
+		cosmoslog.NewNopLogger(),
+		testkeeper.WithService(*service),
+		testkeeper.WithDefaultModuleBalances(),
+	)
+	ctx = sdk.UnwrapSDKContext(ctx).WithBlockHeight(1)
+	keepers.SetService(ctx, *service)
+
+	// Set compute_units_to_tokens_multiplier to simplify expectation calculations.
+	sharedParams := keepers.SharedKeeper.GetParams(ctx)
+	sharedParams.ComputeUnitsToTokensMultiplier = globalComputeUnitsToTokensMultiplier
+	err := keepers.SharedKeeper.SetParams(ctx, sharedParams)
+	require.NoError(t, err)
+
+	// Disable the global mint TLMs while keeping a non-zero global inflation.
+	tokenomicsParams := keepers.Keeper.GetParams(ctx)
+	tokenomicsParams.GlobalInflationPerClaim = tokenomicstypes.DefaultGlobalInflationPerClaim
+	tokenomicsParams.TokenLogicModules = []tokenomicstypes.TokenLogicModuleConfig{
+		{Id: tokenomicstypes.TokenLogicModuleId_TLM_RELAY_BURN_EQUALS_MINT},
+	}
+	err = keepers.Keeper.SetParams(ctx, tokenomicsParams)
+	require.NoError(t, err)
+
+	// Add a new application.
+	appStake := cosmostypes.NewCoin(volatile.DenomuPOKT, appInitialStake)
+	app := apptypes.Application{
+		Address:        sample.AccAddress(),
+		Stake:          &appStake,
+		ServiceConfigs: []*sharedtypes.ApplicationServiceConfig{{ServiceId: service.Id}},
+	}
+	keepers.SetApplication(ctx, app)
+
+	// Add a new supplier which is the sole shareholder of its rewards.
+	supplierOwnerAddress := sample.AccAddress()
+	services := []*sharedtypes.SupplierServiceConfig{{
+		ServiceId: service.Id,
+		RevShare: []*sharedtypes.ServiceRevenueShare{{
+			Address:            supplierOwnerAddress,
+			RevSharePercentage: 100,
+		}},
+	}}
+	supplierStake := cosmostypes.NewCoin(volatile.DenomuPOKT, supplierInitialStake)
+	supplier := sharedtypes.Supplier{
+		OwnerAddress:    supplierOwnerAddress,
+		OperatorAddress: supplierOwnerAddress,
+		Stake:           &supplierStake,
+		Services:        services,
+		ServiceConfigHistory: sharedtest.CreateServiceConfigUpdateHistoryFromServiceConfigs(
+			supplierOwnerAddress,
+			services, 1, 0,
+		),
+	}
+	keepers.SetAndIndexDehydratedSupplier(ctx, supplier)
+
+	// Prepare the claim for which the supplier did work for the application
+	claim := prepareTestClaim(numRelays, service, &app, &supplier)
+	pendingResult := tlm.NewClaimSettlementResult(claim)
+
+	settlementContext := tokenomicskeeper.NewSettlementContext(
+		ctx,
+		keepers.Keeper,
+		keepers.Keeper.Logger(),
+	)
+
+	err = settlementContext.ClaimCacheWarmUp(ctx, &claim)
+	require.NoError(t, err)
+
+	// Process the token logic modules
+	err = keepers.ProcessTokenLogicModules(ctx, settlementContext, pendingResult)
+	require.NoError(t, err)
+
+	// Assert that only relay burn equals mint TLM settlement operations were produced.
+	var opReasons []tokenomicstypes.SettlementOpReason
+	for _, mint := range pendingResult.GetMints() {
+		opReasons = append(opReasons, mint.OpReason)
+	}
+	for _, burn := range pendingResult.GetBurns() {
+		opReasons = append(opReasons, burn.OpReason)
+	}
+	for _, transfer := range pendingResult.GetModToModTransfers() {
+		opReasons = append(opReasons, transfer.OpReason)
+	}
+	for _, transfer := range pendingResult.GetModToAcctTransfers() {
+		opReasons = append(opReasons, transfer.OpReason)
+	}
+	require.NotEmpty(t, opReasons)
+	for _, opReason := range opReasons {
+		require.Truef(t,
+			strings.HasPrefix(opReason.String(), tokenomicstypes.TokenLogicModuleId_TLM_RELAY_BURN_EQUALS_MINT.String()),
+			"unexpected settlement op reason: %s", opReason,
+		)
+	}
+
+	// Assert that exactly the claimed amount is minted (i.e. no global inflation).
+	require.Len(t, pendingResult.GetMints(), 1)
+	require.Equal(t, cosmosmath.NewInt(numTokensClaimed), pendingResult.GetMints()[0].Coin.Amount)
+}
+
 func TestProcessTokenLogicModules_TLMGlobalMint_Valid_MintDistributionCorrect(t *testing.T) {
 	// Test Parameters
 	appInitialStake := apptypes.DefaultMinStake.Amount.Mul(cosmosmath.NewInt(2))
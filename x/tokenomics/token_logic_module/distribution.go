@@ -14,6 +14,117 @@ import (
 	tokenomicstypes "github.com/pokt-network/poktroll/x/tokenomics/types"
 )
 
+// distributeSupplierRewards distributes the supplier rewards to:
+//   - the supplier's delegators, pro-rata to their delegated stake and minus the
+//     supplier's delegation commission (see GetDelegatorRewardAmountMap).
+//   - the supplier's shareholders, which get the rest of the rewards (i.e. the supplier's
+//     own share and the delegation commission) based on the supplier service config rev share.
+func distributeSupplierRewards(
+	logger cosmoslog.Logger,
+	result *tokenomicstypes.ClaimSettlementResult,
+	delegatorsOpReason tokenomicstypes.SettlementOpReason,
+	shareHoldersOpReason tokenomicstypes.SettlementOpReason,
+	supplier *sharedtypes.Supplier,
+	delegations []*suppliertypes.Delegation,
+	serviceId string,
+	amountToDistribute math.Int,
+) error {
+	logger = logger.With(
+		"method", "distributeSupplierRewards",
+		"session_id", result.GetSessionId(),
+	)
+
+	// The delegation commission is the one which applies to the claim's session,
+	// regardless of the updates made after the session started.
+	sessionStartHeight := result.Claim.GetSessionHeader().GetSessionStartBlockHeight()
+	delegatorRewardAmountMap := GetDelegatorRewardAmountMap(supplier, delegations, sessionStartHeight, amountToDistribute)
+
+	// NOTE: Use the delegations slice to iterate through the delegatorRewardAmountMap deterministically.
+	totalDelegatorsRewardAmount := math.ZeroInt()
+	for _, delegation := range delegations {
+		rewardAmount, ok := delegatorRewardAmountMap[delegation.GetDelegatorAddress()]
+		// Don't queue zero amount transfer operations.
+		if !ok || rewardAmount.IsZero() {
+			continue
+		}
+
+		rewardCoin := cosmostypes.NewCoin(volatile.DenomuPOKT, rewardAmount)
+
+		// Queue the sending of the delegator rewards from the supplier module account
+		// to the supplier's delegator.
+		result.AppendModToAcctTransfer(tokenomicstypes.ModToAcctTransfer{
+			OpReason:         delegatorsOpReason,
+			SenderModule:     suppliertypes.ModuleName,
+			RecipientAddress: delegation.GetDelegatorAddress(),
+			Coin:             rewardCoin,
+		})
+		totalDelegatorsRewardAmount = totalDelegatorsRewardAmount.Add(rewardAmount)
+
+		logger.Info(fmt.Sprintf("operation queued: send %s from the supplier module to the delegator with address %q", rewardCoin, delegation.GetDelegatorAddress()))
+	}
+
+	// The supplier shareholders get the rest of the rewards.
+	return distributeSupplierRewardsToShareHolders(
+		logger,
+		result,
+		shareHoldersOpReason,
+		supplier,
+		serviceId,
+		amountToDistribute.Sub(totalDelegatorsRewardAmount),
+	)
+}
+
+// GetDelegatorRewardAmountMap calculates the amount of uPOKT to distribute to each
+// of the supplier's delegators. It returns a map of the delegator address to the
+// amount of uPOKT to distribute.
+//
+// Each delegation which is active for the session starting at sessionStartHeight
+// (i.e. delegated before the session started and not undelegated before it) earns
+// the share of the amount to distribute which is proportional to its stake relative
+// to the total stake backing the supplier (i.e. the supplier's own stake and the
+// stake of the active delegations), minus the supplier's delegation commission which
+// applies to the session. Amounts are rounded down, and the remainders are left to
+// the supplier shareholders.
+// NB: It is publicly exposed to be used in the tests.
+func GetDelegatorRewardAmountMap(
+	supplier *sharedtypes.Supplier,
+	delegations []*suppliertypes.Delegation,
+	sessionStartHeight int64,
+	amountToDistribute math.Int,
+) (delegatorRewardAmountMap map[string]math.Int) {
+	delegatorRewardAmountMap = make(map[string]math.Int, len(delegations))
+
+	// Only the delegations which were active when the session started earn rewards,
+	// regardless of the delegations made or undelegated after it started.
+	totalStakeAmount := supplier.GetStake().Amount
+	for _, delegation := range delegations {
+		if delegation.IsActive(sessionStartHeight) {
+			totalStakeAmount = totalStakeAmount.Add(delegation.GetStake().Amount)
+		}
+	}
+
+	if totalStakeAmount.IsZero() {
+		return delegatorRewardAmountMap
+	}
+
+	delegationCommissionPercentage := supplier.GetDelegationCommissionPercentageAtHeight(sessionStartHeight)
+	delegatorsSharePercentage := int64(100 - delegationCommissionPercentage)
+	for _, delegation := range delegations {
+		if !delegation.IsActive(sessionStartHeight) {
+			continue
+		}
+
+		// rewardShareRat = (delegation stake * (100 - commission)) / (total stake * 100)
+		rewardShareRat := new(big.Rat).SetFrac(
+			new(big.Int).Mul(delegation.GetStake().Amount.BigInt(), big.NewInt(delegatorsSharePercentage)),
+			new(big.Int).Mul(totalStakeAmount.BigInt(), big.NewInt(100)),
+		)
+		delegatorRewardAmountMap[delegation.GetDelegatorAddress()] = calculateAllocationAmount(amountToDistribute, rewardShareRat)
+	}
+
+	return delegatorRewardAmountMap
+}
+
 // distributeSupplierRewardsToShareHolders distributes the supplier rewards to its
 // shareholders based on the rev share percentage of the supplier service config.
 func distributeSupplierRewardsToShareHolders(
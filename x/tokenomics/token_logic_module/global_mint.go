@@ -103,11 +103,13 @@ func (tlm tlmGlobalMint) Process(
 		Coin:            supplierCoin,
 	})
 	// Distribute the rewards from within the supplier's module account.
-	if err = distributeSupplierRewardsToShareHolders(
+	if err = distributeSupplierRewards(
 		logger,
 		tlmCtx.Result,
+		tokenomicstypes.SettlementOpReason_TLM_GLOBAL_MINT_DELEGATOR_REWARD_DISTRIBUTION,
 		tokenomicstypes.SettlementOpReason_TLM_GLOBAL_MINT_SUPPLIER_SHAREHOLDER_REWARD_DISTRIBUTION,
 		tlmCtx.Supplier,
+		tlmCtx.SupplierDelegations,
 		tlmCtx.Service.Id,
 		supplierCoinsToShareAmt,
 	); err != nil {
@@ -57,14 +57,27 @@ func (tlm tlmRelayBurnEqualsMint) Process(
 		defer telemetry.MintedTokensFromModule(suppliertypes.ModuleName, float32(tlmCtx.SettlementCoin.Amount.Int64()))
 	}
 
-	// Distribute the rewards to the supplier's shareholders based on the rev share percentage.
-	if err := distributeSupplierRewardsToShareHolders(
+	// Deduct the service owner rev share, which is sent from the supplier module
+	// account by the TLMServiceOwnerRevShare TLM, if it is enabled.
+	serviceOwnerRevShareCoin := GetServiceOwnerRevShareCoin(
+		&tlmCtx.TokenomicsParams,
+		tlmCtx.Service,
+		tlmCtx.Result.Claim.GetSessionHeader().GetSessionStartBlockHeight(),
+		tlmCtx.SettlementCoin,
+	)
+	supplierRewardsCoin := tlmCtx.SettlementCoin.Sub(serviceOwnerRevShareCoin)
+
+	// Distribute the rewards to the supplier's delegators, pro-rata to their stake,
+	// and to the supplier's shareholders based on the rev share percentage.
+	if err := distributeSupplierRewards(
 		logger,
 		tlmCtx.Result,
+		tokenomicstypes.SettlementOpReason_TLM_RELAY_BURN_EQUALS_MINT_DELEGATOR_REWARD_DISTRIBUTION,
 		tokenomicstypes.SettlementOpReason_TLM_RELAY_BURN_EQUALS_MINT_SUPPLIER_SHAREHOLDER_REWARD_DISTRIBUTION,
 		tlmCtx.Supplier,
+		tlmCtx.SupplierDelegations,
 		tlmCtx.Service.Id,
-		tlmCtx.SettlementCoin.Amount,
+		supplierRewardsCoin.Amount,
 	); err != nil {
 		return tokenomicstypes.ErrTokenomicsTLMInternal.Wrapf(
 			"queueing operation: distributing rewards to supplier with operator address %s shareholders: %v",
@@ -72,7 +85,7 @@ func (tlm tlmRelayBurnEqualsMint) Process(
 			err,
 		)
 	}
-	logger.Info(fmt.Sprintf("operation queued: send (%v) from the supplier module to the supplier account with address %q", tlmCtx.SettlementCoin, tlmCtx.Supplier.OperatorAddress))
+	logger.Info(fmt.Sprintf("operation queued: send (%v) from the supplier module to the supplier account with address %q", supplierRewardsCoin, tlmCtx.Supplier.OperatorAddress))
 
 	// Burn uPOKT from the application module account which was held in escrow
 	// on behalf of the application account.
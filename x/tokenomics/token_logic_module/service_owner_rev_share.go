@@ -0,0 +1,104 @@
+package token_logic_module
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	cosmoslog "cosmossdk.io/log"
+	cosmostypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/pokt-network/poktroll/app/volatile"
+	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
+	suppliertypes "github.com/pokt-network/poktroll/x/supplier/types"
+	tokenomicstypes "github.com/pokt-network/poktroll/x/tokenomics/types"
+)
+
+var _ TokenLogicModule = (*tlmServiceOwnerRevShare)(nil)
+
+type tlmServiceOwnerRevShare struct{}
+
+// NewServiceOwnerRevShareTLM returns a new ServiceOwnerRevShare TLM.
+func NewServiceOwnerRevShareTLM() TokenLogicModule {
+	return &tlmServiceOwnerRevShare{}
+}
+
+func (tlm tlmServiceOwnerRevShare) GetId() TokenLogicModuleId {
+	return TLMServiceOwnerRevShare
+}
+
+// Process processes the business logic for the ServiceOwnerRevShare TLM.
+//
+// DEV_NOTE: The service owner rev share is sent from the supplier module account,
+// out of the uPOKT minted by TLMRelayBurnEqualsMint, which deducts the same amount
+// from what it distributes to the supplier's shareholders. Both TLMs compute the
+// amount with GetServiceOwnerRevShareCoin so that their order does not matter.
+func (tlm tlmServiceOwnerRevShare) Process(
+	_ context.Context,
+	logger cosmoslog.Logger,
+	tlmCtx TLMContext,
+) error {
+	logger = logger.With(
+		"method", "tlmServiceOwnerRevShare#Process",
+		"session_id", tlmCtx.Result.GetSessionId(),
+	)
+
+	serviceOwnerRevShareCoin := GetServiceOwnerRevShareCoin(
+		&tlmCtx.TokenomicsParams,
+		tlmCtx.Service,
+		tlmCtx.Result.Claim.GetSessionHeader().GetSessionStartBlockHeight(),
+		tlmCtx.SettlementCoin,
+	)
+	if serviceOwnerRevShareCoin.IsZero() {
+		logger.Info(fmt.Sprintf("operation skipped: no rev share for the owner of service %q", tlmCtx.Service.GetId()))
+		return nil
+	}
+
+	// Queue the sending of the service owner rev share from the supplier module
+	// account, which holds the uPOKT minted for the claim, to the service owner.
+	tlmCtx.Result.AppendModToAcctTransfer(tokenomicstypes.ModToAcctTransfer{
+		OpReason:         tokenomicstypes.SettlementOpReason_TLM_SERVICE_OWNER_REV_SHARE_REWARD_DISTRIBUTION,
+		SenderModule:     suppliertypes.ModuleName,
+		RecipientAddress: tlmCtx.Service.GetOwnerAddress(),
+		Coin:             serviceOwnerRevShareCoin,
+	})
+
+	logger.Info(fmt.Sprintf(
+		"operation queued: send (%v) from the supplier module to the owner of service %q with address %q",
+		serviceOwnerRevShareCoin, tlmCtx.Service.GetId(), tlmCtx.Service.GetOwnerAddress(),
+	))
+
+	return nil
+}
+
+// GetServiceOwnerRevShareCoin returns the amount of the given settlement coin which
+// is routed to the owner of the given service. It is the service's owner rev share
+// percentage applying to the session starting at sessionStartHeight, capped by the max_rev_share_percentage param of the TLMServiceOwnerRevShare
+// TLM, of the settlement amount (rounded down).
+// It is zero if the TLMServiceOwnerRevShare TLM is not enabled.
+func GetServiceOwnerRevShareCoin(
+	tokenomicsParams *tokenomicstypes.Params,
+	service *sharedtypes.Service,
+	sessionStartHeight int64,
+	settlementCoin cosmostypes.Coin,
+) cosmostypes.Coin {
+	zeroCoin := cosmostypes.NewInt64Coin(volatile.DenomuPOKT, 0)
+
+	tlmConfig, isEnabled := tokenomicsParams.GetTokenLogicModuleConfig(TLMServiceOwnerRevShare)
+	if !isEnabled {
+		return zeroCoin
+	}
+
+	revSharePercentage := min(
+		service.GetOwnerRevSharePercentageAtHeight(sessionStartHeight),
+		tlmConfig.GetServiceOwnerRevShare().GetMaxRevSharePercentage(),
+	)
+	if revSharePercentage == 0 {
+		return zeroCoin
+	}
+
+	revSharePercentageRat := new(big.Rat).SetFrac64(int64(revSharePercentage), 100)
+	revShareAmount := calculateAllocationAmount(settlementCoin.Amount, revSharePercentageRat)
+
+	return cosmostypes.NewCoin(volatile.DenomuPOKT, revShareAmount)
+}
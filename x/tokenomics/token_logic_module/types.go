@@ -10,28 +10,32 @@ import (
 	servicetypes "github.com/pokt-network/poktroll/x/service/types"
 	sessiontypes "github.com/pokt-network/poktroll/x/session/types"
 	sharedtypes "github.com/pokt-network/poktroll/x/shared/types"
+	suppliertypes "github.com/pokt-network/poktroll/x/supplier/types"
 	tokenomicstypes "github.com/pokt-network/poktroll/x/tokenomics/types"
 )
 
-type TokenLogicModuleId int
+// TokenLogicModuleId identifies a token logic module implementation.
+// The token logic modules run to settle claims, and their order, are configured
+// via the tokenomics module's token_logic_modules governance parameter.
+type TokenLogicModuleId = tokenomicstypes.TokenLogicModuleId
 
 const (
 	// UnspecifiedTLM
 	// - Default value for TokenLogicModuleId
 	// - Used as a field type for objects to distinguish if a TLM has handled it or not
-	UnspecifiedTLM TokenLogicModuleId = iota
+	UnspecifiedTLM = tokenomicstypes.TokenLogicModuleId_TLM_UNSPECIFIED
 
 	// TLMRelayBurnEqualsMint
 	// - Burns the application's stake balance based on the amount of work done by the supplier
 	// - Mints the same amount of tokens and adds them to the supplier's account balance
 	// - When the network matures, this is theoretically the only TLM needed
-	TLMRelayBurnEqualsMint
+	TLMRelayBurnEqualsMint = tokenomicstypes.TokenLogicModuleId_TLM_RELAY_BURN_EQUALS_MINT
 
 	// TLMGlobalMint
 	// - Mints new tokens based on global governance parameters
 	// - Rewards participants providing services
 	// - Keeps inflation in check
-	TLMGlobalMint
+	TLMGlobalMint = tokenomicstypes.TokenLogicModuleId_TLM_GLOBAL_MINT
 
 	// TLMGlobalMintReimbursementRequest
 	// - Complements TLMGlobalMint to enable permissionless demand
@@ -40,23 +44,14 @@ const (
 	//   - Overcharged funds are sent to the DAO/PNF
 	//   - Event emitted to track and send reimbursements (managed offchain by PNF)
 	// - TODO_POST_MAINNET: Introduce proper tokenomics based on research by @rawthil and @shane
-	TLMGlobalMintReimbursementRequest
-)
-
-var tokenLogicModuleStrings = [...]string{
-	"UnspecifiedTLM",
-	"TLMRelayBurnEqualsMint",
-	"TLMGlobalMint",
-	"TLMGlobalMintReimbursementRequest",
-}
+	TLMGlobalMintReimbursementRequest = tokenomicstypes.TokenLogicModuleId_TLM_GLOBAL_MINT_REIMBURSEMENT_REQUEST
 
-func (tlm TokenLogicModuleId) String() string {
-	return tokenLogicModuleStrings[tlm]
-}
-
-func (tlm TokenLogicModuleId) EnumIndex() int {
-	return int(tlm)
-}
+	// TLMServiceOwnerRevShare
+	// - Routes a percentage of the settlement amount from the supplier's rewards to the service owner
+	// - The percentage is configured per service and capped by the TLM's max_rev_share_percentage param
+	// - Does not mint or burn anything; the burn still equals the mint
+	TLMServiceOwnerRevShare = tokenomicstypes.TokenLogicModuleId_TLM_SERVICE_OWNER_REV_SHARE
+)
 
 // TokenLogicModule interface
 // - All token logic modules must implement this interface
@@ -82,6 +77,8 @@ type TokenLogicModule interface {
 // - Allows TLMs to remain isolated from the tokenomics keeper and each other
 // - Permits shared memory access (prior to an atomic state transition)
 type TLMContext struct {
+	// Config is the configuration of the TLM being processed, which holds its typed params.
+	Config                tokenomicstypes.TokenLogicModuleConfig
 	TokenomicsParams      tokenomicstypes.Params
 	SettlementCoin        cosmostypes.Coin // This is the "actualSettlementCoin" rather than just the "claimCoin" because of how settlement functions; see ensureClaimAmountLimits for details.
 	SessionHeader         *sessiontypes.SessionHeader
@@ -89,18 +86,18 @@ type TLMContext struct {
 	Service               *sharedtypes.Service
 	Application           *apptypes.Application
 	Supplier              *sharedtypes.Supplier
+	SupplierDelegations   []*suppliertypes.Delegation // The delegations to the supplier, including the unbonding ones which do not earn rewards.
 	RelayMiningDifficulty *servicetypes.RelayMiningDifficulty
 }
 
-// NewDefaultTokenLogicModules
-// - Returns the default token logic module processors:
-//   - TLMRelayBurnEqualsMint
-//   - TLMGlobalMint
-//   - TLMGlobalMintReimbursementRequest
+// NewDefaultTokenLogicModules returns all the available token logic module processors.
+// The processors which are actually run, and their order, are selected by the
+// token_logic_modules tokenomics param.
 func NewDefaultTokenLogicModules() []TokenLogicModule {
 	return []TokenLogicModule{
 		NewRelayBurnEqualsMintTLM(),
 		NewGlobalMintTLM(),
 		NewGlobalMintReimbursementRequestTLM(),
+		NewServiceOwnerRevShareTLM(),
 	}
 }
@@ -2,23 +2,57 @@ package token_logic_module
 
 import tokenomicstypes "github.com/pokt-network/poktroll/x/tokenomics/types"
 
-// ValidateTLMConfig ensures that the global mint and global mint reimbursement request TLMs are activated or deactivated together.
-func ValidateTLMConfig(tokenLogicModules []TokenLogicModule) error {
-	var hasGlobalMintTLM, hasGlobalMintReimbursementRequestTLM bool
+// ValidateTLMConfig ensures that the given TLM pipeline configuration is valid:
+//   - The combination of the configured TLMs is compatible (e.g. TLMGlobalMint and
+//     TLMGlobalMintReimbursementRequest are activated or deactivated together).
+//   - Each configured TLM is implemented by one of the given token logic modules.
+func ValidateTLMConfig(
+	tokenLogicModules []TokenLogicModule,
+	tlmConfigs []tokenomicstypes.TokenLogicModuleConfig,
+) error {
+	if err := tokenomicstypes.ValidateTokenLogicModules(tlmConfigs); err != nil {
+		return err
+	}
+
+	for _, tlmConfig := range tlmConfigs {
+		if _, err := GetTokenLogicModule(tokenLogicModules, tlmConfig.Id); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ValidateTokenLogicModules ensures that each of the given token logic modules
+// has a distinct and specified TLM id.
+func ValidateTokenLogicModules(tokenLogicModules []TokenLogicModule) error {
+	tlmIds := make(map[TokenLogicModuleId]struct{}, len(tokenLogicModules))
 	for _, tlm := range tokenLogicModules {
-		if _, ok := tlm.(tlmGlobalMint); ok {
-			hasGlobalMintTLM = true
-			continue
+		tlmId := tlm.GetId()
+		if tlmId == UnspecifiedTLM {
+			return tokenomicstypes.ErrTokenomicsConstraint.Wrapf("token logic module %T has an unspecified id", tlm)
 		}
-		if _, ok := tlm.(tlmGlobalMintReimbursementRequest); ok {
-			hasGlobalMintReimbursementRequestTLM = true
-			continue
+
+		if _, ok := tlmIds[tlmId]; ok {
+			return tokenomicstypes.ErrTokenomicsConstraint.Wrapf("token logic module %s is implemented more than once", tlmId)
 		}
+		tlmIds[tlmId] = struct{}{}
 	}
 
-	if hasGlobalMintTLM != hasGlobalMintReimbursementRequestTLM {
-		return tokenomicstypes.ErrTokenomicsConstraint.Wrap("TLMGlobalMint and TLMGlobalMintReimbursementRequest must be (de-)activated together")
+	return nil
+}
+
+// GetTokenLogicModule returns the token logic module, among the given ones,
+// which implements the TLM with the given id.
+func GetTokenLogicModule(
+	tokenLogicModules []TokenLogicModule,
+	tlmId TokenLogicModuleId,
+) (TokenLogicModule, error) {
+	for _, tlm := range tokenLogicModules {
+		if tlm.GetId() == tlmId {
+			return tlm, nil
+		}
 	}
 
-	return nil
+	return nil, tokenomicstypes.ErrTokenomicsConstraint.Wrapf("token logic module %s is not implemented", tlmId)
 }
@@ -324,6 +324,9 @@ type EventSupplierSlashed struct {
 	// Amount slashed from the supplier's stake due to the expired claims.
 	// This is a function of the number of expired claims and proof missing penalty.
 	ProofMissingPenalty *types1.Coin `protobuf:"bytes,2,opt,name=proof_missing_penalty,json=proofMissingPenalty,proto3" json:"proof_missing_penalty,omitempty"`
+	// The number of proof faults of the supplier within the proof fault window,
+	// including this one, which the proof missing penalty was escalated for.
+	NumProofFaults uint64 `protobuf:"varint,3,opt,name=num_proof_faults,json=numProofFaults,proto3" json:"num_proof_faults,omitempty"`
 }
 
 func (m *EventSupplierSlashed) Reset()         { *m = EventSupplierSlashed{} }
@@ -369,6 +372,81 @@ func (m *EventSupplierSlashed) GetProofMissingPenalty() *types1.Coin {
 	return nil
 }
 
+func (m *EventSupplierSlashed) GetNumProofFaults() uint64 {
+	if m != nil {
+		return m.NumProofFaults
+	}
+	return 0
+}
+
+// EventSupplierDelegationSlashed is emitted when a delegation to a supplier is
+// slashed, proportionally to its stake, along with the supplier.
+type EventSupplierDelegationSlashed struct {
+	Claim                   *types.Claim `protobuf:"bytes,1,opt,name=claim,proto3" json:"claim,omitempty"`
+	DelegatorAddress        string       `protobuf:"bytes,2,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	SupplierOperatorAddress string       `protobuf:"bytes,3,opt,name=supplier_operator_address,json=supplierOperatorAddress,proto3" json:"supplier_operator_address,omitempty"`
+	// Amount slashed from the delegation's stake; its share of the supplier's
+	// proof missing penalty.
+	SlashedAmount *types1.Coin `protobuf:"bytes,4,opt,name=slashed_amount,json=slashedAmount,proto3" json:"slashed_amount,omitempty"`
+}
+
+func (m *EventSupplierDelegationSlashed) Reset()         { *m = EventSupplierDelegationSlashed{} }
+func (m *EventSupplierDelegationSlashed) String() string { return proto.CompactTextString(m) }
+func (*EventSupplierDelegationSlashed) ProtoMessage()    {}
+func (*EventSupplierDelegationSlashed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_146818b9f891ddf6, []int{4}
+}
+func (m *EventSupplierDelegationSlashed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSupplierDelegationSlashed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *EventSupplierDelegationSlashed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSupplierDelegationSlashed.Merge(m, src)
+}
+func (m *EventSupplierDelegationSlashed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSupplierDelegationSlashed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSupplierDelegationSlashed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSupplierDelegationSlashed proto.InternalMessageInfo
+
+func (m *EventSupplierDelegationSlashed) GetClaim() *types.Claim {
+	if m != nil {
+		return m.Claim
+	}
+	return nil
+}
+
+func (m *EventSupplierDelegationSlashed) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
+func (m *EventSupplierDelegationSlashed) GetSupplierOperatorAddress() string {
+	if m != nil {
+		return m.SupplierOperatorAddress
+	}
+	return ""
+}
+
+func (m *EventSupplierDelegationSlashed) GetSlashedAmount() *types1.Coin {
+	if m != nil {
+		return m.SlashedAmount
+	}
+	return nil
+}
+
 // EventApplicationReimbursementRequest is emitted when an application requests
 // a reimbursement.
 type EventApplicationReimbursementRequest struct {
@@ -384,7 +462,7 @@ func (m *EventApplicationReimbursementRequest) Reset()         { *m = EventAppli
 func (m *EventApplicationReimbursementRequest) String() string { return proto.CompactTextString(m) }
 func (*EventApplicationReimbursementRequest) ProtoMessage()    {}
 func (*EventApplicationReimbursementRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_146818b9f891ddf6, []int{4}
+	return fileDescriptor_146818b9f891ddf6, []int{5}
 }
 func (m *EventApplicationReimbursementRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -457,66 +535,73 @@ func init() {
 	proto.RegisterType((*EventClaimSettled)(nil), "pocket.tokenomics.EventClaimSettled")
 	proto.RegisterType((*EventApplicationOverserviced)(nil), "pocket.tokenomics.EventApplicationOverserviced")
 	proto.RegisterType((*EventSupplierSlashed)(nil), "pocket.tokenomics.EventSupplierSlashed")
+	proto.RegisterType((*EventSupplierDelegationSlashed)(nil), "pocket.tokenomics.EventSupplierDelegationSlashed")
 	proto.RegisterType((*EventApplicationReimbursementRequest)(nil), "pocket.tokenomics.EventApplicationReimbursementRequest")
 }
 
 func init() { proto.RegisterFile("pocket/tokenomics/event.proto", fileDescriptor_146818b9f891ddf6) }
 
 var fileDescriptor_146818b9f891ddf6 = []byte{
-	// 845 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x55, 0x4f, 0x4f, 0x1b, 0x47,
-	0x14, 0xf7, 0x1a, 0x42, 0xe5, 0x69, 0xa0, 0xf6, 0x02, 0x91, 0x49, 0x8b, 0x4d, 0xad, 0x1c, 0x68,
-	0xa5, 0xec, 0x8a, 0x24, 0xe7, 0xaa, 0xb6, 0xe3, 0x54, 0x2b, 0x15, 0xdb, 0x19, 0x97, 0x2a, 0xea,
-	0xa1, 0xdb, 0xf5, 0xee, 0x03, 0x46, 0xec, 0xce, 0x6c, 0xe6, 0x8f, 0x03, 0xc7, 0x7e, 0x83, 0x7c,
-	0x8c, 0x7e, 0x94, 0x1c, 0x73, 0xcc, 0xc9, 0x6a, 0xe1, 0xe6, 0x6b, 0xbf, 0x40, 0x35, 0xb3, 0x6b,
-	0x6c, 0x70, 0x02, 0x12, 0x52, 0x6f, 0xb9, 0xc0, 0xce, 0xfb, 0xfd, 0xf1, 0xdb, 0xf9, 0xbd, 0xa7,
-	0x45, 0xdb, 0x29, 0x0b, 0x4f, 0x40, 0xba, 0x92, 0x9d, 0x00, 0x65, 0x09, 0x09, 0x85, 0x0b, 0x23,
-	0xa0, 0xd2, 0x49, 0x39, 0x93, 0xcc, 0xae, 0x64, 0xb0, 0x33, 0x83, 0x1f, 0xd6, 0x42, 0x26, 0x12,
-	0x26, 0xdc, 0x61, 0x20, 0xc0, 0x1d, 0xed, 0x0d, 0x41, 0x06, 0x7b, 0x6e, 0xc8, 0x08, 0xcd, 0x24,
-	0x0f, 0x37, 0x8e, 0xd8, 0x11, 0x33, 0x8f, 0xae, 0x7e, 0xca, 0xab, 0xd5, 0xfc, 0x77, 0x52, 0xce,
-	0xd8, 0xa1, 0x2b, 0xcf, 0x52, 0x10, 0x39, 0xf2, 0x91, 0x0e, 0xe6, 0xe0, 0xc6, 0xbf, 0x4b, 0xa8,
-	0xd2, 0xd1, 0x1d, 0xb5, 0xe3, 0x80, 0x24, 0x9d, 0xd3, 0x94, 0x70, 0x88, 0xec, 0x67, 0xe8, 0x5e,
-	0xa8, 0xcf, 0x55, 0x6b, 0xc7, 0xda, 0xfd, 0xf2, 0xc9, 0xba, 0x93, 0xf7, 0x69, 0xec, 0x1d, 0x43,
-	0x6d, 0x95, 0x26, 0xe3, 0x7a, 0xc6, 0xc2, 0xd9, 0x3f, 0x3b, 0x46, 0x15, 0xd0, 0x06, 0x81, 0x24,
-	0x8c, 0xfa, 0x1c, 0x02, 0xc1, 0x68, 0xb5, 0xb8, 0x63, 0xed, 0xae, 0x3d, 0xd9, 0x75, 0x16, 0xde,
-	0xd4, 0x99, 0xfd, 0xa2, 0x11, 0x60, 0xc3, 0x6f, 0x6d, 0x4e, 0xc6, 0xf5, 0x45, 0x1b, 0x5c, 0x86,
-	0x6b, 0x44, 0xfb, 0x31, 0x42, 0x54, 0x25, 0x3e, 0x87, 0x38, 0x38, 0x13, 0xd5, 0xa5, 0x1d, 0x6b,
-	0x77, 0xb9, 0xb5, 0x36, 0x19, 0xd7, 0xe7, 0xaa, 0xb8, 0x44, 0x55, 0x82, 0xcd, 0xa3, 0xfd, 0x0a,
-	0x6d, 0x69, 0xc0, 0x74, 0x0a, 0x91, 0x1f, 0xb2, 0x24, 0x55, 0x12, 0x7c, 0x45, 0x89, 0x14, 0xd5,
-	0x65, 0xa3, 0xde, 0x9e, 0x8c, 0xeb, 0x9f, 0x26, 0xe1, 0x07, 0x54, 0x25, 0xed, 0x0c, 0x69, 0x67,
-	0xc0, 0x81, 0xae, 0xdb, 0xbf, 0xa3, 0xaf, 0xb5, 0x08, 0x84, 0x24, 0x49, 0x20, 0x17, 0xbc, 0xef,
-	0x19, 0xef, 0xfa, 0x64, 0x5c, 0xbf, 0x89, 0x86, 0xab, 0x54, 0x25, 0x9d, 0x29, 0x76, 0xc5, 0xff,
-	0x25, 0x5a, 0x9d, 0x36, 0xa4, 0x52, 0x76, 0x22, 0xab, 0x2b, 0x26, 0x94, 0x2d, 0x27, 0x9b, 0x14,
-	0x47, 0x4f, 0x8a, 0x93, 0x4f, 0x8a, 0xd3, 0x66, 0x84, 0xb6, 0x2a, 0x93, 0x71, 0xfd, 0xaa, 0x06,
-	0xdf, 0xcf, 0x8f, 0x07, 0xfa, 0xd4, 0xf8, 0x67, 0x79, 0x3e, 0xf5, 0x01, 0x48, 0x19, 0xdf, 0x39,
-	0xf5, 0x63, 0x54, 0x31, 0x04, 0x9f, 0xc3, 0x6b, 0x45, 0x38, 0x24, 0x40, 0x65, 0x9e, 0xfa, 0xa3,
-	0xab, 0x0e, 0x7d, 0xfd, 0x17, 0xcf, 0x58, 0xf3, 0x89, 0x2f, 0x58, 0xe0, 0x72, 0x7a, 0x8d, 0xfe,
-	0x39, 0xf1, 0xbb, 0x27, 0x6e, 0xa7, 0xa8, 0x22, 0x4c, 0xcc, 0xfa, 0x26, 0x7d, 0x0e, 0x42, 0xc5,
-	0xb2, 0xfa, 0x85, 0xb1, 0xfd, 0xe4, 0x6e, 0x0e, 0x2e, 0x05, 0xd8, 0xf0, 0x5b, 0x5b, 0xef, 0xc6,
-	0xf5, 0x82, 0x4e, 0x6b, 0xc1, 0x0a, 0x97, 0xc5, 0x35, 0x72, 0xe3, 0xcf, 0x22, 0xfa, 0xc6, 0xcc,
-	0x58, 0x33, 0x4d, 0x63, 0x12, 0x9a, 0xd5, 0xed, 0x8d, 0x80, 0x0b, 0xe0, 0x23, 0x12, 0x42, 0x64,
-	0x7f, 0x87, 0xca, 0xc1, 0x0c, 0xf2, 0x83, 0x28, 0xe2, 0x66, 0xf2, 0x4a, 0xf8, 0xab, 0xb9, 0x7a,
-	0x33, 0x8a, 0xb8, 0xfd, 0x0c, 0x3d, 0x10, 0x4a, 0xd7, 0x80, 0xfb, 0x2c, 0x05, 0x1e, 0x48, 0xc6,
-	0x33, 0x41, 0xd1, 0x08, 0x36, 0xa6, 0x68, 0x2f, 0x07, 0x8d, 0xea, 0x07, 0xb4, 0x0a, 0xa7, 0x29,
-	0x84, 0xfa, 0xea, 0x87, 0x8a, 0x53, 0x33, 0x32, 0x37, 0x5d, 0x23, 0xbe, 0x3f, 0xe5, 0xb7, 0x14,
-	0xa7, 0xf6, 0x8f, 0x68, 0x0d, 0x0e, 0x0f, 0x21, 0x94, 0x64, 0x04, 0x99, 0xc1, 0xf2, 0x6d, 0x06,
-	0xab, 0x97, 0x02, 0xed, 0xd0, 0x78, 0x6b, 0xa1, 0x0d, 0x73, 0x07, 0x83, 0xbc, 0xbf, 0x41, 0x1c,
-	0x88, 0x63, 0xf3, 0xee, 0xb7, 0xae, 0xda, 0x74, 0xbf, 0xf6, 0xd1, 0x66, 0xb6, 0x1c, 0x09, 0x11,
-	0x82, 0xd0, 0x23, 0x3f, 0x05, 0x1a, 0xc4, 0xf2, 0xcc, 0xbc, 0xfa, 0x8d, 0xcd, 0xac, 0x1b, 0xdd,
-	0x7e, 0x26, 0xeb, 0x67, 0xaa, 0xc6, 0x5f, 0x45, 0xf4, 0xe8, 0x7a, 0x2c, 0x18, 0x48, 0x32, 0x54,
-	0x5c, 0xe4, 0xf1, 0xbd, 0x56, 0x20, 0xe4, 0xff, 0x1f, 0x8f, 0x83, 0xd6, 0x67, 0xaa, 0x37, 0x14,
-	0x72, 0xc9, 0x92, 0x91, 0x54, 0x2e, 0x25, 0x1a, 0x31, 0xfc, 0x6d, 0x84, 0xf2, 0xd9, 0xf1, 0x49,
-	0x64, 0xa2, 0x28, 0xe1, 0x52, 0x5e, 0xf1, 0xa2, 0x0c, 0x16, 0x42, 0xf7, 0x4a, 0x22, 0xb3, 0x83,
-	0x06, 0x36, 0x15, 0x2f, 0xb2, 0xf7, 0xd0, 0x4a, 0x90, 0x30, 0x45, 0x6f, 0x5f, 0x26, 0x9c, 0x13,
-	0xbf, 0xff, 0x03, 0x6d, 0x7e, 0xf4, 0x1b, 0x65, 0x7f, 0x8b, 0xb6, 0x3b, 0xaf, 0xfa, 0x1e, 0x6e,
-	0xfe, 0xe2, 0xf5, 0xba, 0x3e, 0xee, 0x34, 0x07, 0xbd, 0xae, 0x7f, 0xd0, 0x1d, 0xf4, 0x3b, 0x6d,
-	0xef, 0x85, 0xd7, 0x79, 0x5e, 0x2e, 0xd8, 0x15, 0xb4, 0xda, 0xc7, 0xbd, 0xde, 0x0b, 0x7f, 0xdf,
-	0x1b, 0x0c, 0xbc, 0xee, 0x4f, 0x65, 0x6b, 0x56, 0xf2, 0xba, 0xbf, 0x36, 0x7f, 0xf6, 0x9e, 0x97,
-	0x8b, 0xad, 0x97, 0xef, 0xce, 0x6b, 0xd6, 0xfb, 0xf3, 0x9a, 0xf5, 0xe1, 0xbc, 0x66, 0xfd, 0x7d,
-	0x5e, 0xb3, 0xde, 0x5e, 0xd4, 0x0a, 0xef, 0x2f, 0x6a, 0x85, 0x0f, 0x17, 0xb5, 0xc2, 0x6f, 0x4f,
-	0x8f, 0x88, 0x3c, 0x56, 0x43, 0x27, 0x64, 0x89, 0xab, 0x97, 0xf8, 0x31, 0x05, 0xf9, 0x86, 0xf1,
-	0x13, 0x73, 0xe0, 0x2c, 0x8e, 0xdd, 0xd3, 0x85, 0xcf, 0xfa, 0x70, 0xc5, 0x7c, 0xd7, 0x9f, 0xfe,
-	0x17, 0x00, 0x00, 0xff, 0xff, 0xeb, 0x54, 0x35, 0x7b, 0x7a, 0x08, 0x00, 0x00,
+	// 933 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0xcf, 0x6e, 0x1b, 0x45,
+	0x18, 0xcf, 0xba, 0x49, 0x90, 0x87, 0x26, 0xd8, 0x9b, 0xa4, 0x6c, 0x0a, 0xb1, 0x83, 0xd5, 0x83,
+	0x01, 0x75, 0xad, 0xb4, 0x3d, 0x71, 0x40, 0xb5, 0x1d, 0x07, 0xad, 0x44, 0x6c, 0x77, 0x4c, 0x50,
+	0xc5, 0x81, 0x65, 0xbd, 0xfb, 0xc5, 0x59, 0x65, 0x77, 0x66, 0x3b, 0x33, 0xeb, 0x26, 0x47, 0xde,
+	0x80, 0xc7, 0xe0, 0x19, 0x78, 0x82, 0x1e, 0x7b, 0xec, 0xc9, 0x82, 0xe4, 0xe6, 0x2b, 0x12, 0x67,
+	0x34, 0xb3, 0xe3, 0xd8, 0x89, 0x53, 0x8c, 0x22, 0x71, 0xe3, 0x92, 0xec, 0x7c, 0xbf, 0x3f, 0xfe,
+	0x66, 0x7e, 0xdf, 0xd8, 0x8b, 0x76, 0x12, 0xea, 0x9f, 0x82, 0xa8, 0x09, 0x7a, 0x0a, 0x84, 0xc6,
+	0xa1, 0xcf, 0x6b, 0x30, 0x04, 0x22, 0xec, 0x84, 0x51, 0x41, 0xcd, 0x62, 0x06, 0xdb, 0x53, 0xf8,
+	0x61, 0xc9, 0xa7, 0x3c, 0xa6, 0xbc, 0xd6, 0xf7, 0x38, 0xd4, 0x86, 0x7b, 0x7d, 0x10, 0xde, 0x5e,
+	0xcd, 0xa7, 0x21, 0xc9, 0x24, 0x0f, 0x37, 0x07, 0x74, 0x40, 0xd5, 0x63, 0x4d, 0x3e, 0xe9, 0xaa,
+	0xa5, 0x3f, 0x27, 0x61, 0x94, 0x1e, 0xd7, 0xc4, 0x79, 0x02, 0x5c, 0x23, 0xb7, 0x74, 0x30, 0x03,
+	0x57, 0xfe, 0xbc, 0x87, 0x8a, 0x2d, 0xd9, 0x51, 0x33, 0xf2, 0xc2, 0xb8, 0x75, 0x96, 0x84, 0x0c,
+	0x02, 0xf3, 0x19, 0x5a, 0xf1, 0xe5, 0xda, 0x32, 0x76, 0x8d, 0xea, 0x87, 0x4f, 0x36, 0x6c, 0xdd,
+	0xa7, 0xb2, 0xb7, 0x15, 0xb5, 0x91, 0x1f, 0x8f, 0xca, 0x19, 0x0b, 0x67, 0xff, 0xcc, 0x08, 0x15,
+	0x41, 0x1a, 0x78, 0x22, 0xa4, 0xc4, 0x65, 0xe0, 0x71, 0x4a, 0xac, 0xdc, 0xae, 0x51, 0x5d, 0x7f,
+	0x52, 0xb5, 0xe7, 0x76, 0x6a, 0x4f, 0x3f, 0x51, 0x09, 0xb0, 0xe2, 0x37, 0xb6, 0xc6, 0xa3, 0xf2,
+	0xbc, 0x0d, 0x2e, 0xc0, 0x0d, 0xa2, 0xf9, 0x18, 0x21, 0x92, 0xc6, 0x2e, 0x83, 0xc8, 0x3b, 0xe7,
+	0xd6, 0xbd, 0x5d, 0xa3, 0xba, 0xdc, 0x58, 0x1f, 0x8f, 0xca, 0x33, 0x55, 0x9c, 0x27, 0x69, 0x8c,
+	0xd5, 0xa3, 0xf9, 0x12, 0x6d, 0x4b, 0x40, 0x75, 0x0a, 0x81, 0xeb, 0xd3, 0x38, 0x49, 0x05, 0xb8,
+	0x29, 0x09, 0x05, 0xb7, 0x96, 0x95, 0x7a, 0x67, 0x3c, 0x2a, 0xbf, 0x9f, 0x84, 0x1f, 0x90, 0x34,
+	0x6e, 0x66, 0x48, 0x33, 0x03, 0x8e, 0x64, 0xdd, 0xfc, 0x11, 0x7d, 0x22, 0x45, 0xc0, 0x45, 0x18,
+	0x7b, 0x62, 0xce, 0x7b, 0x45, 0x79, 0x97, 0xc7, 0xa3, 0xf2, 0x3f, 0xd1, 0xb0, 0x45, 0xd2, 0xb8,
+	0x35, 0xc1, 0xae, 0xf9, 0xbf, 0x40, 0x6b, 0x93, 0x86, 0xd2, 0x84, 0x9e, 0x0a, 0x6b, 0x55, 0x85,
+	0xb2, 0x6d, 0x67, 0x93, 0x62, 0xcb, 0x49, 0xb1, 0xf5, 0xa4, 0xd8, 0x4d, 0x1a, 0x92, 0x46, 0x71,
+	0x3c, 0x2a, 0x5f, 0xd7, 0xe0, 0xfb, 0x7a, 0x79, 0x24, 0x57, 0x95, 0x3f, 0x96, 0x67, 0x53, 0xef,
+	0x81, 0x10, 0xd1, 0x9d, 0x53, 0x3f, 0x41, 0x45, 0x45, 0x70, 0x19, 0xbc, 0x4a, 0x43, 0x06, 0x31,
+	0x10, 0xa1, 0x53, 0x7f, 0x74, 0xdd, 0xa1, 0x2b, 0xff, 0xe2, 0x29, 0x6b, 0x36, 0xf1, 0x39, 0x0b,
+	0x5c, 0x48, 0x6e, 0xd0, 0xff, 0x4f, 0xfc, 0xee, 0x89, 0x9b, 0x09, 0x2a, 0x72, 0x15, 0xb3, 0x3c,
+	0x49, 0x97, 0x01, 0x4f, 0x23, 0x61, 0x7d, 0xa0, 0x6c, 0xdf, 0x7b, 0x37, 0x7b, 0x57, 0x02, 0xac,
+	0xf8, 0x8d, 0xed, 0x37, 0xa3, 0xf2, 0x92, 0x4c, 0x6b, 0xce, 0x0a, 0x17, 0xf8, 0x0d, 0x72, 0xe5,
+	0xe7, 0x1c, 0xfa, 0x54, 0xcd, 0x58, 0x3d, 0x49, 0xa2, 0xd0, 0x57, 0x57, 0xb7, 0x33, 0x04, 0xc6,
+	0x81, 0x0d, 0x43, 0x1f, 0x02, 0xf3, 0x73, 0x54, 0xf0, 0xa6, 0x90, 0xeb, 0x05, 0x01, 0x53, 0x93,
+	0x97, 0xc7, 0x1f, 0xcd, 0xd4, 0xeb, 0x41, 0xc0, 0xcc, 0x67, 0xe8, 0x01, 0x4f, 0x65, 0x0d, 0x98,
+	0x4b, 0x13, 0x60, 0x9e, 0xa0, 0x2c, 0x13, 0xe4, 0x94, 0x60, 0x73, 0x82, 0x76, 0x34, 0xa8, 0x54,
+	0x5f, 0xa3, 0x35, 0x38, 0x4b, 0xc0, 0x97, 0x47, 0xdf, 0x4f, 0x19, 0xb1, 0xee, 0x2d, 0x38, 0x46,
+	0x7c, 0x7f, 0xc2, 0x6f, 0xa4, 0x8c, 0x98, 0xcf, 0xd1, 0x3a, 0x1c, 0x1f, 0x83, 0x2f, 0xc2, 0x21,
+	0x64, 0x06, 0xcb, 0x8b, 0x0c, 0xd6, 0xae, 0x04, 0xd2, 0xa1, 0xf2, 0x9b, 0x81, 0x36, 0xd5, 0x19,
+	0xf4, 0x74, 0x7f, 0xbd, 0xc8, 0xe3, 0x27, 0x6a, 0xef, 0x0b, 0xaf, 0xda, 0xe4, 0x7e, 0x1d, 0xa2,
+	0x2d, 0x55, 0x75, 0xe3, 0x90, 0xf3, 0x90, 0x0c, 0xdc, 0x04, 0x88, 0x17, 0x89, 0x73, 0x2b, 0xb7,
+	0xa8, 0x99, 0x0d, 0xa5, 0x3b, 0xcc, 0x64, 0xdd, 0x4c, 0x65, 0x56, 0x51, 0x41, 0x0e, 0x65, 0x66,
+	0x79, 0xec, 0xa5, 0x91, 0xd0, 0x57, 0x09, 0xaf, 0x93, 0x34, 0x56, 0x57, 0xf4, 0x40, 0x55, 0x2b,
+	0x7f, 0x19, 0xa8, 0x74, 0xad, 0xf9, 0x7d, 0x88, 0x60, 0xa0, 0x42, 0xb9, 0xc3, 0x36, 0xbe, 0x44,
+	0xc5, 0x20, 0xd3, 0xeb, 0xe8, 0x80, 0x73, 0x9d, 0x5e, 0xe1, 0x0a, 0xa8, 0x67, 0x75, 0xf3, 0x2b,
+	0xb4, 0x7d, 0x7b, 0xde, 0xc0, 0xb3, 0x6e, 0xf3, 0xf8, 0xe3, 0xdb, 0x22, 0x97, 0xda, 0xe7, 0x68,
+	0x9d, 0x67, 0xed, 0xb9, 0x5e, 0x4c, 0x53, 0x22, 0xfe, 0x45, 0x6a, 0x5a, 0x50, 0x57, 0xfc, 0xca,
+	0xaf, 0x39, 0xf4, 0xe8, 0xe6, 0xe4, 0x62, 0x08, 0xe3, 0x7e, 0xca, 0xb8, 0x9e, 0xf0, 0x57, 0x29,
+	0x70, 0xf1, 0xdf, 0x4f, 0xb0, 0x8d, 0x36, 0xa6, 0xaa, 0xd7, 0x04, 0xb4, 0x24, 0x3b, 0x81, 0xe2,
+	0x95, 0x44, 0x22, 0x8a, 0xbf, 0x83, 0x90, 0xbe, 0x5e, 0x6e, 0x18, 0xa8, 0x7d, 0xe7, 0x71, 0x5e,
+	0x57, 0x9c, 0x20, 0x83, 0x39, 0x97, 0xbd, 0x86, 0x81, 0xb5, 0x32, 0x81, 0x55, 0xc5, 0x09, 0xcc,
+	0x3d, 0xb4, 0xaa, 0x4f, 0x6c, 0xd1, 0xf7, 0x0d, 0xd6, 0xc4, 0x2f, 0x7e, 0x42, 0x5b, 0xb7, 0xfe,
+	0x8c, 0x9b, 0x9f, 0xa1, 0x9d, 0xd6, 0xcb, 0xae, 0x83, 0xeb, 0xdf, 0x39, 0x9d, 0xb6, 0x8b, 0x5b,
+	0xf5, 0x5e, 0xa7, 0xed, 0x1e, 0xb5, 0x7b, 0xdd, 0x56, 0xd3, 0x39, 0x70, 0x5a, 0xfb, 0x85, 0x25,
+	0xb3, 0x88, 0xd6, 0xba, 0xb8, 0xd3, 0x39, 0x70, 0x0f, 0x9d, 0x5e, 0xcf, 0x69, 0x7f, 0x53, 0x30,
+	0xa6, 0x25, 0xa7, 0xfd, 0x7d, 0xfd, 0x5b, 0x67, 0xbf, 0x90, 0x6b, 0xbc, 0x78, 0x73, 0x51, 0x32,
+	0xde, 0x5e, 0x94, 0x8c, 0x77, 0x17, 0x25, 0xe3, 0xf7, 0x8b, 0x92, 0xf1, 0xcb, 0x65, 0x69, 0xe9,
+	0xed, 0x65, 0x69, 0xe9, 0xdd, 0x65, 0x69, 0xe9, 0x87, 0xa7, 0x83, 0x50, 0x9c, 0xa4, 0x7d, 0xdb,
+	0xa7, 0x71, 0x4d, 0x7e, 0xcf, 0x3d, 0x26, 0x20, 0x5e, 0x53, 0x76, 0xaa, 0x16, 0x8c, 0x46, 0x51,
+	0xed, 0x6c, 0xee, 0xcd, 0xa7, 0xbf, 0xaa, 0x5e, 0x7d, 0x9e, 0xfe, 0x3d, 0x00, 0x5a, 0x9e, 0x6c,
+	0xbc, 0x9d, 0x09, 0x00, 0x00,
 }
 
 func (m *EventClaimExpired) Marshal() (dAtA []byte, err error) {
@@ -744,6 +829,11 @@ func (m *EventSupplierSlashed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.NumProofFaults != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.NumProofFaults))
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.ProofMissingPenalty != nil {
 		{
 			size, err := m.ProofMissingPenalty.MarshalToSizedBuffer(dAtA[:i])
@@ -771,6 +861,67 @@ func (m *EventSupplierSlashed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventSupplierDelegationSlashed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSupplierDelegationSlashed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSupplierDelegationSlashed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SlashedAmount != nil {
+		{
+			size, err := m.SlashedAmount.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.SupplierOperatorAddress) > 0 {
+		i -= len(m.SupplierOperatorAddress)
+		copy(dAtA[i:], m.SupplierOperatorAddress)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.SupplierOperatorAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Claim != nil {
+		{
+			size, err := m.Claim.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EventApplicationReimbursementRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -951,6 +1102,34 @@ func (m *EventSupplierSlashed) Size() (n int) {
 		l = m.ProofMissingPenalty.Size()
 		n += 1 + l + sovEvent(uint64(l))
 	}
+	if m.NumProofFaults != 0 {
+		n += 1 + sovEvent(uint64(m.NumProofFaults))
+	}
+	return n
+}
+
+func (m *EventSupplierDelegationSlashed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Claim != nil {
+		l = m.Claim.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.SupplierOperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.SlashedAmount != nil {
+		l = m.SlashedAmount.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
 	return n
 }
 
@@ -1709,6 +1888,211 @@ func (m *EventSupplierSlashed) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumProofFaults", wireType)
+			}
+			m.NumProofFaults = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumProofFaults |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSupplierDelegationSlashed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSupplierDelegationSlashed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSupplierDelegationSlashed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claim", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Claim == nil {
+				m.Claim = &types.Claim{}
+			}
+			if err := m.Claim.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplierOperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SupplierOperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashedAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SlashedAmount == nil {
+				m.SlashedAmount = &types1.Coin{}
+			}
+			if err := m.SlashedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
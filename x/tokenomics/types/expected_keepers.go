@@ -98,10 +98,14 @@ type SupplierKeeper interface {
 	GetSupplier(ctx context.Context, supplierOperatorAddr string) (supplier sharedtypes.Supplier, found bool)
 	GetDehydratedSupplier(ctx context.Context, supplierOperatorAddr string) (supplier sharedtypes.Supplier, found bool)
 	GetSupplierActiveServiceConfig(ctx context.Context, supplier *sharedtypes.Supplier, serviceId string) (activeServiceConfigs []*sharedtypes.SupplierServiceConfig)
+	GetSupplierDelegations(ctx context.Context, supplierOperatorAddr string) (delegations []suppliertypes.Delegation)
 
 	// Setters
 	SetAndIndexDehydratedSupplier(ctx context.Context, supplier sharedtypes.Supplier)
 	SetDehydratedSupplier(ctx context.Context, supplier sharedtypes.Supplier)
+	SetDelegation(ctx context.Context, delegation suppliertypes.Delegation)
+	RemoveDelegation(ctx context.Context, supplierOperatorAddr string, delegatorAddr string)
+	RecordSupplierProofFault(ctx context.Context, supplierOperatorAddr string, proofFault suppliertypes.ProofFault) (suppliertypes.SupplierProofFaults, error)
 }
 
 type ServiceKeeper interface {
@@ -18,6 +18,10 @@ func NewMsgUpdateParam(authority string, name string, asTypeAny any) (*MsgUpdate
 		asTypeIface = &MsgUpdateParam_AsString{AsString: asType}
 	case float64:
 		asTypeIface = &MsgUpdateParam_AsFloat{AsFloat: asType}
+	case []TokenLogicModuleConfig:
+		asTypeIface = &MsgUpdateParam_AsTokenLogicModules{
+			AsTokenLogicModules: &TokenLogicModuleConfigs{TokenLogicModules: asType},
+		}
 	default:
 		return nil, fmt.Errorf("unexpected param value type: %T", asTypeAny)
 	}
@@ -60,6 +64,11 @@ func (msg *MsgUpdateParam) ValidateBasic() error {
 			return err
 		}
 		return ValidateGlobalInflationPerClaim(msg.GetAsFloat())
+	case ParamTokenLogicModules:
+		if err := genericParamTypeIs[*MsgUpdateParam_AsTokenLogicModules](msg); err != nil {
+			return err
+		}
+		return ValidateTokenLogicModules(msg.GetAsTokenLogicModules().GetTokenLogicModules())
 	default:
 		return ErrTokenomicsParamNameInvalid.Wrapf("unsupported param %q", msg.Name)
 	}
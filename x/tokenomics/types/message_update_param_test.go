@@ -74,6 +74,34 @@ func TestMsgUpdateParam_ValidateBasic(t *testing.T) {
 			},
 			expectedErr: ErrTokenomicsParamInvalid,
 		},
+		{
+			name: "invalid: token logic modules without relay burn equals mint TLM",
+			msg: MsgUpdateParam{
+				Authority: sample.AccAddress(),
+				Name:      ParamTokenLogicModules,
+				AsType: &MsgUpdateParam_AsTokenLogicModules{
+					AsTokenLogicModules: &TokenLogicModuleConfigs{
+						TokenLogicModules: []TokenLogicModuleConfig{
+							{Id: TokenLogicModuleId_TLM_GLOBAL_MINT},
+							{Id: TokenLogicModuleId_TLM_GLOBAL_MINT_REIMBURSEMENT_REQUEST},
+						},
+					},
+				},
+			},
+			expectedErr: ErrTokenomicsConstraint,
+		},
+		{
+			name: "valid: token logic modules",
+			msg: MsgUpdateParam{
+				Authority: sample.AccAddress(),
+				Name:      ParamTokenLogicModules,
+				AsType: &MsgUpdateParam_AsTokenLogicModules{
+					AsTokenLogicModules: &TokenLogicModuleConfigs{
+						TokenLogicModules: DefaultTokenLogicModules,
+					},
+				},
+			},
+		},
 		{
 			name: "valid: correct address, param name, and type",
 			msg: MsgUpdateParam{
@@ -2,6 +2,7 @@ package types
 
 import (
 	"math"
+	"slices"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
@@ -25,6 +26,8 @@ var (
 	KeyGlobalInflationPerClaim     = []byte("GlobalInflationPerClaim")
 	ParamGlobalInflationPerClaim   = "global_inflation_per_claim"
 	DefaultGlobalInflationPerClaim = float64(0.1)
+	KeyTokenLogicModules           = []byte("TokenLogicModules")
+	ParamTokenLogicModules         = "token_logic_modules"
 
 	_ paramtypes.ParamSet = (*Params)(nil)
 )
@@ -39,11 +42,13 @@ func NewParams(
 	mintAllocationPercentages MintAllocationPercentages,
 	daoRewardAddress string,
 	globalInflationPerClaim float64,
+	tokenLogicModules []TokenLogicModuleConfig,
 ) Params {
 	return Params{
 		MintAllocationPercentages: mintAllocationPercentages,
 		DaoRewardAddress:          daoRewardAddress,
 		GlobalInflationPerClaim:   globalInflationPerClaim,
+		TokenLogicModules:         tokenLogicModules,
 	}
 }
 
@@ -53,6 +58,7 @@ func DefaultParams() Params {
 		DefaultMintAllocationPercentages,
 		DefaultDaoRewardAddress,
 		DefaultGlobalInflationPerClaim,
+		slices.Clone(DefaultTokenLogicModules),
 	)
 }
 
@@ -69,6 +75,11 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 			&p.MintAllocationPercentages,
 			ValidateMintAllocationPercentages,
 		),
+		paramtypes.NewParamSetPair(
+			KeyTokenLogicModules,
+			&p.TokenLogicModules,
+			ValidateTokenLogicModules,
+		),
 	}
 }
 
@@ -86,6 +97,10 @@ func (params *Params) ValidateBasic() error {
 		return err
 	}
 
+	if err := ValidateTokenLogicModules(params.TokenLogicModules); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -197,3 +212,45 @@ func ValidateGlobalInflationPerClaim(GlobalInflationPerClaimAny any) error {
 
 	return nil
 }
+
+// ValidateTokenLogicModules validates the TokenLogicModules param. It ensures that:
+// - Each TLM config is valid and each TLM is enabled at most once.
+// - TLM_RELAY_BURN_EQUALS_MINT is enabled, as it is the TLM which pays the suppliers.
+// - TLM_GLOBAL_MINT and TLM_GLOBAL_MINT_REIMBURSEMENT_REQUEST are enabled or disabled together.
+func ValidateTokenLogicModules(tokenLogicModulesAny any) error {
+	tokenLogicModules, ok := tokenLogicModulesAny.([]TokenLogicModuleConfig)
+	if !ok {
+		return ErrTokenomicsParamInvalid.Wrapf("invalid parameter type: %T", tokenLogicModulesAny)
+	}
+
+	enabledTLMs := make(map[TokenLogicModuleId]struct{}, len(tokenLogicModules))
+	for _, tlmConfig := range tokenLogicModules {
+		if err := tlmConfig.ValidateBasic(); err != nil {
+			return err
+		}
+
+		if _, ok := enabledTLMs[tlmConfig.Id]; ok {
+			return ErrTokenomicsParamInvalid.Wrapf("token logic module %s is enabled more than once", tlmConfig.Id)
+		}
+		enabledTLMs[tlmConfig.Id] = struct{}{}
+	}
+
+	if _, ok := enabledTLMs[TokenLogicModuleId_TLM_RELAY_BURN_EQUALS_MINT]; !ok {
+		return ErrTokenomicsConstraint.Wrapf(
+			"token logic module %s must be enabled",
+			TokenLogicModuleId_TLM_RELAY_BURN_EQUALS_MINT,
+		)
+	}
+
+	_, hasGlobalMintTLM := enabledTLMs[TokenLogicModuleId_TLM_GLOBAL_MINT]
+	_, hasGlobalMintReimbursementRequestTLM := enabledTLMs[TokenLogicModuleId_TLM_GLOBAL_MINT_REIMBURSEMENT_REQUEST]
+	if hasGlobalMintTLM != hasGlobalMintReimbursementRequestTLM {
+		return ErrTokenomicsConstraint.Wrapf(
+			"token logic modules %s and %s must be (de-)activated together",
+			TokenLogicModuleId_TLM_GLOBAL_MINT,
+			TokenLogicModuleId_TLM_GLOBAL_MINT_REIMBURSEMENT_REQUEST,
+		)
+	}
+
+	return nil
+}
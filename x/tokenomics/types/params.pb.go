@@ -26,6 +26,52 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// TokenLogicModuleId identifies a token logic module (TLM) implementation.
+type TokenLogicModuleId int32
+
+const (
+	// TLM_UNSPECIFIED is the default value for TokenLogicModuleId, it is not a valid TLM.
+	TokenLogicModuleId_TLM_UNSPECIFIED TokenLogicModuleId = 0
+	// TLM_RELAY_BURN_EQUALS_MINT burns the application's stake based on the amount of
+	// work done by the supplier, and mints the same amount to the supplier's shareholders.
+	TokenLogicModuleId_TLM_RELAY_BURN_EQUALS_MINT TokenLogicModuleId = 1
+	// TLM_GLOBAL_MINT mints new tokens, in proportion to the work done, which are
+	// distributed according to the mint_allocation_percentages.
+	TokenLogicModuleId_TLM_GLOBAL_MINT TokenLogicModuleId = 2
+	// TLM_GLOBAL_MINT_REIMBURSEMENT_REQUEST complements TLM_GLOBAL_MINT by overcharging
+	// the application by the global inflation amount, which is sent to the DAO to be
+	// reimbursed offchain, in order to prevent self-dealing attacks.
+	TokenLogicModuleId_TLM_GLOBAL_MINT_REIMBURSEMENT_REQUEST TokenLogicModuleId = 3
+	// TLM_SERVICE_OWNER_REV_SHARE routes a percentage of each settled claim's amount,
+	// configured per service and bounded by governance, from the supplier's rewards
+	// to the service owner.
+	TokenLogicModuleId_TLM_SERVICE_OWNER_REV_SHARE TokenLogicModuleId = 4
+)
+
+var TokenLogicModuleId_name = map[int32]string{
+	0: "TLM_UNSPECIFIED",
+	1: "TLM_RELAY_BURN_EQUALS_MINT",
+	2: "TLM_GLOBAL_MINT",
+	3: "TLM_GLOBAL_MINT_REIMBURSEMENT_REQUEST",
+	4: "TLM_SERVICE_OWNER_REV_SHARE",
+}
+
+var TokenLogicModuleId_value = map[string]int32{
+	"TLM_UNSPECIFIED":                       0,
+	"TLM_RELAY_BURN_EQUALS_MINT":            1,
+	"TLM_GLOBAL_MINT":                       2,
+	"TLM_GLOBAL_MINT_REIMBURSEMENT_REQUEST": 3,
+	"TLM_SERVICE_OWNER_REV_SHARE":           4,
+}
+
+func (x TokenLogicModuleId) String() string {
+	return proto.EnumName(TokenLogicModuleId_name, int32(x))
+}
+
+func (TokenLogicModuleId) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_577bb6b98de8f6d1, []int{0}
+}
+
 // Params defines the parameters for the tokenomics module.
 type Params struct {
 	// mint_allocation_percentages represents the distribution of newly minted tokens,
@@ -36,6 +82,10 @@ type Params struct {
 	DaoRewardAddress string `protobuf:"bytes,6,opt,name=dao_reward_address,json=daoRewardAddress,proto3" json:"dao_reward_address" yaml:"dao_reward_address"`
 	// global_inflation_per_claim is the percentage of a claim's claimable uPOKT amount which will be minted on settlement.
 	GlobalInflationPerClaim float64 `protobuf:"fixed64,7,opt,name=global_inflation_per_claim,json=globalInflationPerClaim,proto3" json:"global_inflation_per_claim" yaml:"global_inflation_per_claim"`
+	// token_logic_modules is the ordered list of the token logic modules (TLMs), along
+	// with their parameters, which are run to settle each claim. The TLMs are run in
+	// the given order, and a TLM which is not listed is not run.
+	TokenLogicModules []TokenLogicModuleConfig `protobuf:"bytes,8,rep,name=token_logic_modules,json=tokenLogicModules,proto3" json:"token_logic_modules" yaml:"token_logic_modules"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -88,6 +138,13 @@ func (m *Params) GetGlobalInflationPerClaim() float64 {
 	return 0
 }
 
+func (m *Params) GetTokenLogicModules() []TokenLogicModuleConfig {
+	if m != nil {
+		return m.TokenLogicModules
+	}
+	return nil
+}
+
 // MintAllocationPercentages represents the distribution of newly minted tokens,
 // at the end of claim settlement, as a result of the Global Mint TLM.
 type MintAllocationPercentages struct {
@@ -172,50 +229,403 @@ func (m *MintAllocationPercentages) GetApplication() float64 {
 	return 0
 }
 
+// TokenLogicModuleConfig enables a token logic module (TLM) in the settlement
+// pipeline, along with its parameters.
+type TokenLogicModuleConfig struct {
+	// id is the identifier of the enabled TLM.
+	Id TokenLogicModuleId `protobuf:"varint,1,opt,name=id,proto3,enum=pocket.tokenomics.TokenLogicModuleId" json:"id" yaml:"id"`
+	// params are the parameters of the TLM. They MUST be of the type matching the TLM id.
+	// They may be omitted for the TLMs which have no parameters.
+	//
+	// Types that are valid to be assigned to Params:
+	//	*TokenLogicModuleConfig_RelayBurnEqualsMint
+	//	*TokenLogicModuleConfig_GlobalMint
+	//	*TokenLogicModuleConfig_GlobalMintReimbursementRequest
+	//	*TokenLogicModuleConfig_ServiceOwnerRevShare
+	Params isTokenLogicModuleConfig_Params `protobuf_oneof:"params"`
+}
+
+func (m *TokenLogicModuleConfig) Reset()         { *m = TokenLogicModuleConfig{} }
+func (m *TokenLogicModuleConfig) String() string { return proto.CompactTextString(m) }
+func (*TokenLogicModuleConfig) ProtoMessage()    {}
+func (*TokenLogicModuleConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_577bb6b98de8f6d1, []int{2}
+}
+func (m *TokenLogicModuleConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenLogicModuleConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *TokenLogicModuleConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenLogicModuleConfig.Merge(m, src)
+}
+func (m *TokenLogicModuleConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenLogicModuleConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenLogicModuleConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenLogicModuleConfig proto.InternalMessageInfo
+
+type isTokenLogicModuleConfig_Params interface {
+	isTokenLogicModuleConfig_Params()
+	Equal(interface{}) bool
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type TokenLogicModuleConfig_RelayBurnEqualsMint struct {
+	RelayBurnEqualsMint *TLMRelayBurnEqualsMintParams `protobuf:"bytes,2,opt,name=relay_burn_equals_mint,json=relayBurnEqualsMint,proto3,oneof" json:"relay_burn_equals_mint" yaml:"relay_burn_equals_mint"`
+}
+type TokenLogicModuleConfig_GlobalMint struct {
+	GlobalMint *TLMGlobalMintParams `protobuf:"bytes,3,opt,name=global_mint,json=globalMint,proto3,oneof" json:"global_mint" yaml:"global_mint"`
+}
+type TokenLogicModuleConfig_GlobalMintReimbursementRequest struct {
+	GlobalMintReimbursementRequest *TLMGlobalMintReimbursementRequestParams `protobuf:"bytes,4,opt,name=global_mint_reimbursement_request,json=globalMintReimbursementRequest,proto3,oneof" json:"global_mint_reimbursement_request" yaml:"global_mint_reimbursement_request"`
+}
+type TokenLogicModuleConfig_ServiceOwnerRevShare struct {
+	ServiceOwnerRevShare *TLMServiceOwnerRevShareParams `protobuf:"bytes,5,opt,name=service_owner_rev_share,json=serviceOwnerRevShare,proto3,oneof" json:"service_owner_rev_share" yaml:"service_owner_rev_share"`
+}
+
+func (*TokenLogicModuleConfig_RelayBurnEqualsMint) isTokenLogicModuleConfig_Params()            {}
+func (*TokenLogicModuleConfig_GlobalMint) isTokenLogicModuleConfig_Params()                     {}
+func (*TokenLogicModuleConfig_GlobalMintReimbursementRequest) isTokenLogicModuleConfig_Params() {}
+func (*TokenLogicModuleConfig_ServiceOwnerRevShare) isTokenLogicModuleConfig_Params()           {}
+
+func (m *TokenLogicModuleConfig) GetParams() isTokenLogicModuleConfig_Params {
+	if m != nil {
+		return m.Params
+	}
+	return nil
+}
+
+func (m *TokenLogicModuleConfig) GetId() TokenLogicModuleId {
+	if m != nil {
+		return m.Id
+	}
+	return TokenLogicModuleId_TLM_UNSPECIFIED
+}
+
+func (m *TokenLogicModuleConfig) GetRelayBurnEqualsMint() *TLMRelayBurnEqualsMintParams {
+	if x, ok := m.GetParams().(*TokenLogicModuleConfig_RelayBurnEqualsMint); ok {
+		return x.RelayBurnEqualsMint
+	}
+	return nil
+}
+
+func (m *TokenLogicModuleConfig) GetGlobalMint() *TLMGlobalMintParams {
+	if x, ok := m.GetParams().(*TokenLogicModuleConfig_GlobalMint); ok {
+		return x.GlobalMint
+	}
+	return nil
+}
+
+func (m *TokenLogicModuleConfig) GetGlobalMintReimbursementRequest() *TLMGlobalMintReimbursementRequestParams {
+	if x, ok := m.GetParams().(*TokenLogicModuleConfig_GlobalMintReimbursementRequest); ok {
+		return x.GlobalMintReimbursementRequest
+	}
+	return nil
+}
+
+func (m *TokenLogicModuleConfig) GetServiceOwnerRevShare() *TLMServiceOwnerRevShareParams {
+	if x, ok := m.GetParams().(*TokenLogicModuleConfig_ServiceOwnerRevShare); ok {
+		return x.ServiceOwnerRevShare
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*TokenLogicModuleConfig) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*TokenLogicModuleConfig_RelayBurnEqualsMint)(nil),
+		(*TokenLogicModuleConfig_GlobalMint)(nil),
+		(*TokenLogicModuleConfig_GlobalMintReimbursementRequest)(nil),
+		(*TokenLogicModuleConfig_ServiceOwnerRevShare)(nil),
+	}
+}
+
+// TLMRelayBurnEqualsMintParams are the parameters of the TLM_RELAY_BURN_EQUALS_MINT TLM.
+// It has no parameters yet.
+type TLMRelayBurnEqualsMintParams struct {
+}
+
+func (m *TLMRelayBurnEqualsMintParams) Reset()         { *m = TLMRelayBurnEqualsMintParams{} }
+func (m *TLMRelayBurnEqualsMintParams) String() string { return proto.CompactTextString(m) }
+func (*TLMRelayBurnEqualsMintParams) ProtoMessage()    {}
+func (*TLMRelayBurnEqualsMintParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_577bb6b98de8f6d1, []int{3}
+}
+func (m *TLMRelayBurnEqualsMintParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLMRelayBurnEqualsMintParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *TLMRelayBurnEqualsMintParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLMRelayBurnEqualsMintParams.Merge(m, src)
+}
+func (m *TLMRelayBurnEqualsMintParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLMRelayBurnEqualsMintParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLMRelayBurnEqualsMintParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLMRelayBurnEqualsMintParams proto.InternalMessageInfo
+
+// TLMGlobalMintParams are the parameters of the TLM_GLOBAL_MINT TLM.
+// It has no parameters yet, the global inflation and its allocation are
+// configured by the global_inflation_per_claim and mint_allocation_percentages params.
+type TLMGlobalMintParams struct {
+}
+
+func (m *TLMGlobalMintParams) Reset()         { *m = TLMGlobalMintParams{} }
+func (m *TLMGlobalMintParams) String() string { return proto.CompactTextString(m) }
+func (*TLMGlobalMintParams) ProtoMessage()    {}
+func (*TLMGlobalMintParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_577bb6b98de8f6d1, []int{4}
+}
+func (m *TLMGlobalMintParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLMGlobalMintParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *TLMGlobalMintParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLMGlobalMintParams.Merge(m, src)
+}
+func (m *TLMGlobalMintParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLMGlobalMintParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLMGlobalMintParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLMGlobalMintParams proto.InternalMessageInfo
+
+// TLMGlobalMintReimbursementRequestParams are the parameters of the
+// TLM_GLOBAL_MINT_REIMBURSEMENT_REQUEST TLM. It has no parameters yet.
+type TLMGlobalMintReimbursementRequestParams struct {
+}
+
+func (m *TLMGlobalMintReimbursementRequestParams) Reset() {
+	*m = TLMGlobalMintReimbursementRequestParams{}
+}
+func (m *TLMGlobalMintReimbursementRequestParams) String() string { return proto.CompactTextString(m) }
+func (*TLMGlobalMintReimbursementRequestParams) ProtoMessage()    {}
+func (*TLMGlobalMintReimbursementRequestParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_577bb6b98de8f6d1, []int{5}
+}
+func (m *TLMGlobalMintReimbursementRequestParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLMGlobalMintReimbursementRequestParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *TLMGlobalMintReimbursementRequestParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLMGlobalMintReimbursementRequestParams.Merge(m, src)
+}
+func (m *TLMGlobalMintReimbursementRequestParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLMGlobalMintReimbursementRequestParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLMGlobalMintReimbursementRequestParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLMGlobalMintReimbursementRequestParams proto.InternalMessageInfo
+
+// TLMServiceOwnerRevShareParams are the parameters of the TLM_SERVICE_OWNER_REV_SHARE TLM.
+type TLMServiceOwnerRevShareParams struct {
+	// max_rev_share_percentage is the upper bound of the percentage of each settled
+	// claim's amount which is routed to the service owner. The service owner configured
+	// percentage (i.e. Service.owner_rev_share_percentage) is capped to this value.
+	MaxRevSharePercentage uint64 `protobuf:"varint,1,opt,name=max_rev_share_percentage,json=maxRevSharePercentage,proto3" json:"max_rev_share_percentage" yaml:"max_rev_share_percentage"`
+}
+
+func (m *TLMServiceOwnerRevShareParams) Reset()         { *m = TLMServiceOwnerRevShareParams{} }
+func (m *TLMServiceOwnerRevShareParams) String() string { return proto.CompactTextString(m) }
+func (*TLMServiceOwnerRevShareParams) ProtoMessage()    {}
+func (*TLMServiceOwnerRevShareParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_577bb6b98de8f6d1, []int{6}
+}
+func (m *TLMServiceOwnerRevShareParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLMServiceOwnerRevShareParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *TLMServiceOwnerRevShareParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLMServiceOwnerRevShareParams.Merge(m, src)
+}
+func (m *TLMServiceOwnerRevShareParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLMServiceOwnerRevShareParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLMServiceOwnerRevShareParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLMServiceOwnerRevShareParams proto.InternalMessageInfo
+
+func (m *TLMServiceOwnerRevShareParams) GetMaxRevSharePercentage() uint64 {
+	if m != nil {
+		return m.MaxRevSharePercentage
+	}
+	return 0
+}
+
+// TokenLogicModuleConfigs is the ordered list of the enabled token logic modules.
+// It is used to update the token_logic_modules param via MsgUpdateParam.
+type TokenLogicModuleConfigs struct {
+	TokenLogicModules []TokenLogicModuleConfig `protobuf:"bytes,1,rep,name=token_logic_modules,json=tokenLogicModules,proto3" json:"token_logic_modules" yaml:"token_logic_modules"`
+}
+
+func (m *TokenLogicModuleConfigs) Reset()         { *m = TokenLogicModuleConfigs{} }
+func (m *TokenLogicModuleConfigs) String() string { return proto.CompactTextString(m) }
+func (*TokenLogicModuleConfigs) ProtoMessage()    {}
+func (*TokenLogicModuleConfigs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_577bb6b98de8f6d1, []int{7}
+}
+func (m *TokenLogicModuleConfigs) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenLogicModuleConfigs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *TokenLogicModuleConfigs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenLogicModuleConfigs.Merge(m, src)
+}
+func (m *TokenLogicModuleConfigs) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenLogicModuleConfigs) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenLogicModuleConfigs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenLogicModuleConfigs proto.InternalMessageInfo
+
+func (m *TokenLogicModuleConfigs) GetTokenLogicModules() []TokenLogicModuleConfig {
+	if m != nil {
+		return m.TokenLogicModules
+	}
+	return nil
+}
+
 func init() {
+	proto.RegisterEnum("pocket.tokenomics.TokenLogicModuleId", TokenLogicModuleId_name, TokenLogicModuleId_value)
 	proto.RegisterType((*Params)(nil), "pocket.tokenomics.Params")
 	proto.RegisterType((*MintAllocationPercentages)(nil), "pocket.tokenomics.MintAllocationPercentages")
+	proto.RegisterType((*TokenLogicModuleConfig)(nil), "pocket.tokenomics.TokenLogicModuleConfig")
+	proto.RegisterType((*TLMRelayBurnEqualsMintParams)(nil), "pocket.tokenomics.TLMRelayBurnEqualsMintParams")
+	proto.RegisterType((*TLMGlobalMintParams)(nil), "pocket.tokenomics.TLMGlobalMintParams")
+	proto.RegisterType((*TLMGlobalMintReimbursementRequestParams)(nil), "pocket.tokenomics.TLMGlobalMintReimbursementRequestParams")
+	proto.RegisterType((*TLMServiceOwnerRevShareParams)(nil), "pocket.tokenomics.TLMServiceOwnerRevShareParams")
+	proto.RegisterType((*TokenLogicModuleConfigs)(nil), "pocket.tokenomics.TokenLogicModuleConfigs")
 }
 
 func init() { proto.RegisterFile("pocket/tokenomics/params.proto", fileDescriptor_577bb6b98de8f6d1) }
 
 var fileDescriptor_577bb6b98de8f6d1 = []byte{
-	// 557 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x53, 0xbf, 0x6f, 0xd3, 0x40,
-	0x14, 0xce, 0xb5, 0x50, 0x8a, 0x83, 0x04, 0x35, 0x45, 0x38, 0x41, 0xf2, 0x85, 0x43, 0xa8, 0x11,
-	0xa2, 0x89, 0x44, 0xb7, 0x32, 0x35, 0x95, 0x40, 0x80, 0x10, 0x91, 0x11, 0x0b, 0x8b, 0x75, 0xb1,
-	0x0f, 0x63, 0xc5, 0xf6, 0xb3, 0xce, 0x17, 0x85, 0x6c, 0xcc, 0x4c, 0xfc, 0x09, 0x8c, 0x8c, 0x0c,
-	0xfd, 0x23, 0x3a, 0x56, 0xb0, 0x74, 0x3a, 0x21, 0x67, 0x00, 0x79, 0xf4, 0x5f, 0x80, 0xec, 0xcb,
-	0x0f, 0x57, 0x21, 0x5d, 0xac, 0x7b, 0xdf, 0xf7, 0xbe, 0xef, 0x9d, 0xde, 0x77, 0xd6, 0xcc, 0x18,
-	0x9c, 0x21, 0x13, 0x5d, 0x01, 0x43, 0x16, 0x41, 0xe8, 0x3b, 0x49, 0x37, 0xa6, 0x9c, 0x86, 0x49,
-	0x27, 0xe6, 0x20, 0x40, 0xdf, 0x51, 0x7c, 0x67, 0xc9, 0x37, 0x77, 0x68, 0xe8, 0x47, 0xd0, 0x2d,
-	0xbf, 0xaa, 0xab, 0xb9, 0xeb, 0x81, 0x07, 0xe5, 0xb1, 0x5b, 0x9c, 0x66, 0x68, 0xc3, 0x81, 0x24,
-	0x84, 0xc4, 0x56, 0x84, 0x2a, 0x14, 0x45, 0xd2, 0x4d, 0x6d, 0xab, 0x5f, 0xce, 0xd1, 0x4f, 0x90,
-	0x76, 0x2f, 0xf4, 0x23, 0x61, 0xd3, 0x20, 0x00, 0x87, 0x0a, 0x1f, 0x22, 0x3b, 0x66, 0xdc, 0x61,
-	0x91, 0xa0, 0x1e, 0x4b, 0x0c, 0xd4, 0x42, 0xed, 0xfa, 0x93, 0xc7, 0x9d, 0x95, 0x8b, 0x74, 0x5e,
-	0xfb, 0x91, 0x38, 0x5a, 0x88, 0xfa, 0x4b, 0x4d, 0xef, 0xd9, 0xa9, 0xc4, 0xb5, 0x4c, 0x62, 0x63,
-	0xc5, 0x98, 0x43, 0x0c, 0x09, 0xe3, 0xb9, 0xc4, 0x64, 0x42, 0xc3, 0xe0, 0x90, 0x5c, 0x32, 0x9a,
-	0x58, 0x8d, 0x70, 0xdd, 0x08, 0x7d, 0xa2, 0xe9, 0x2e, 0x05, 0x9b, 0xb3, 0x31, 0xe5, 0xae, 0x4d,
-	0x5d, 0x97, 0xb3, 0x24, 0x31, 0xb6, 0x5a, 0xa8, 0x7d, 0xbd, 0xf7, 0x2a, 0x93, 0xf8, 0x3f, 0x6c,
-	0x2e, 0x71, 0x43, 0x0d, 0x5d, 0xe5, 0xc8, 0xcf, 0x93, 0xfd, 0xdd, 0xd9, 0x8a, 0x8e, 0x14, 0xf4,
-	0x56, 0x70, 0x3f, 0xf2, 0xac, 0x5b, 0x2e, 0x05, 0xab, 0xec, 0x9d, 0xe1, 0xfa, 0x67, 0xa4, 0x35,
-	0xbd, 0x00, 0x06, 0x34, 0xb0, 0xfd, 0xe8, 0x43, 0xb0, 0xb8, 0xb7, 0xed, 0x04, 0xd4, 0x0f, 0x8d,
-	0x6b, 0x2d, 0xd4, 0x46, 0xbd, 0xe3, 0x4c, 0xe2, 0x4b, 0xba, 0x72, 0x89, 0xef, 0xab, 0xbb, 0xac,
-	0xef, 0x21, 0xd6, 0x5d, 0x45, 0xbe, 0x98, 0x73, 0x7d, 0xc6, 0x8f, 0x0b, 0xe6, 0xf0, 0xc1, 0xdf,
-	0x6f, 0x18, 0x7d, 0xf9, 0xf3, 0xe3, 0x51, 0x73, 0xf6, 0x7e, 0x3e, 0x55, 0x5f, 0x90, 0x4a, 0x96,
-	0xfc, 0xda, 0xd0, 0x1a, 0x6b, 0x33, 0xd2, 0xf7, 0xb4, 0x4d, 0x97, 0x42, 0x19, 0x2f, 0xea, 0xdd,
-	0xc9, 0x24, 0x2e, 0xca, 0x5c, 0x62, 0x6d, 0xb1, 0x22, 0x62, 0x15, 0x90, 0xfe, 0x54, 0xdb, 0x9e,
-	0xc7, 0x66, 0x6c, 0x94, 0xdd, 0x38, 0x93, 0x78, 0xbb, 0x12, 0xe5, 0x4d, 0x25, 0x99, 0x23, 0xc4,
-	0x5a, 0x90, 0x85, 0x38, 0x19, 0xc5, 0x71, 0xe0, 0x33, 0x6e, 0x6c, 0x2e, 0xc5, 0x73, 0x6c, 0x29,
-	0x9e, 0x23, 0xc4, 0x5a, 0x90, 0xfa, 0x4b, 0xed, 0x46, 0x02, 0x23, 0xee, 0x30, 0x1b, 0xc6, 0x11,
-	0xe3, 0xc6, 0x95, 0xd2, 0x60, 0x2f, 0x93, 0xf8, 0x02, 0x9e, 0x4b, 0x7c, 0x7b, 0x66, 0x52, 0x41,
-	0x89, 0x55, 0x57, 0xe5, 0x9b, 0xa2, 0xd2, 0x9f, 0x6b, 0x75, 0x5a, 0xd8, 0xaa, 0x45, 0x18, 0x57,
-	0x4b, 0xab, 0x87, 0x99, 0xc4, 0x55, 0x38, 0x97, 0x58, 0x57, 0x4e, 0x15, 0x90, 0x58, 0xd5, 0x96,
-	0xde, 0xbb, 0xef, 0xa9, 0x89, 0x4e, 0x53, 0x13, 0x9d, 0xa5, 0x26, 0x3a, 0x4f, 0x4d, 0xf4, 0x3b,
-	0x35, 0xd1, 0xd7, 0xa9, 0x59, 0x3b, 0x9b, 0x9a, 0xb5, 0xf3, 0xa9, 0x59, 0x7b, 0x7f, 0xe0, 0xf9,
-	0xe2, 0xe3, 0x68, 0xd0, 0x71, 0x20, 0xec, 0xc6, 0x30, 0x14, 0xfb, 0x11, 0x13, 0x63, 0xe0, 0xc3,
-	0xb2, 0xe0, 0x10, 0x04, 0x17, 0xd3, 0x12, 0x93, 0x98, 0x25, 0x83, 0xad, 0xf2, 0xc7, 0x3c, 0xf8,
-	0x17, 0x00, 0x00, 0xff, 0xff, 0xdd, 0x54, 0x41, 0xe5, 0x11, 0x04, 0x00, 0x00,
+	// 1078 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xbd, 0x6f, 0xdb, 0xc6,
+	0x1b, 0xd6, 0xc9, 0xfe, 0xf9, 0xe7, 0x9c, 0x8a, 0xc6, 0xa1, 0xe2, 0x58, 0x56, 0x1a, 0x9e, 0xc3,
+	0xc2, 0xb5, 0x1d, 0x34, 0x56, 0xa1, 0x4c, 0x75, 0x50, 0x14, 0xa2, 0xcb, 0x38, 0x4a, 0x25, 0x7f,
+	0x9c, 0xac, 0x14, 0xed, 0x72, 0xa0, 0xc5, 0x8b, 0x42, 0x98, 0xe4, 0x29, 0x47, 0xca, 0x1f, 0x5b,
+	0xe7, 0x0e, 0x45, 0x87, 0x2e, 0xdd, 0x0a, 0x74, 0x09, 0x3a, 0x65, 0xc8, 0x1f, 0x91, 0x31, 0x68,
+	0x97, 0xa0, 0xc3, 0xa1, 0xb0, 0x87, 0x16, 0x1a, 0xb9, 0x75, 0x2b, 0x78, 0xd4, 0x07, 0x13, 0x51,
+	0x4e, 0xb6, 0x2e, 0x06, 0xef, 0x79, 0x9f, 0xe7, 0x7d, 0xdf, 0xbb, 0xf7, 0x39, 0x9d, 0xa1, 0xda,
+	0x61, 0xad, 0x43, 0x1a, 0x94, 0x02, 0x76, 0x48, 0x3d, 0xe6, 0xda, 0x2d, 0xbf, 0xd4, 0x31, 0xb9,
+	0xe9, 0xfa, 0xeb, 0x1d, 0xce, 0x02, 0xa6, 0x5c, 0x89, 0xe3, 0xeb, 0xa3, 0x78, 0xf1, 0x8a, 0xe9,
+	0xda, 0x1e, 0x2b, 0xc9, 0xbf, 0x31, 0xab, 0x78, 0xb5, 0xcd, 0xda, 0x4c, 0x7e, 0x96, 0xa2, 0xaf,
+	0x3e, 0xba, 0xd8, 0x62, 0xbe, 0xcb, 0x7c, 0x12, 0x07, 0xe2, 0x45, 0x1c, 0xd2, 0xfe, 0x99, 0x86,
+	0x33, 0xbb, 0xb2, 0x8e, 0xf2, 0x1c, 0xc0, 0xeb, 0xae, 0xed, 0x05, 0xc4, 0x74, 0x1c, 0xd6, 0x32,
+	0x03, 0x9b, 0x79, 0xa4, 0x43, 0x79, 0x8b, 0x7a, 0x81, 0xd9, 0xa6, 0x7e, 0x01, 0x2c, 0x81, 0xd5,
+	0x5c, 0xf9, 0xe3, 0xf5, 0xb1, 0x46, 0xd6, 0xeb, 0xb6, 0x17, 0x54, 0x86, 0xa2, 0xdd, 0x91, 0x46,
+	0xbf, 0xf7, 0x42, 0xa0, 0x4c, 0x4f, 0xa0, 0xc2, 0x58, 0x62, 0xce, 0x3a, 0xcc, 0xa7, 0x3c, 0x14,
+	0x48, 0x3b, 0x35, 0x5d, 0x67, 0x43, 0xbb, 0xa0, 0xb4, 0x86, 0x17, 0xdd, 0x49, 0x25, 0x94, 0x53,
+	0xa8, 0x58, 0x26, 0x23, 0x9c, 0x1e, 0x9b, 0xdc, 0x22, 0xa6, 0x65, 0x71, 0xea, 0xfb, 0x85, 0x99,
+	0x25, 0xb0, 0x7a, 0x49, 0xff, 0xb2, 0x27, 0x50, 0x4a, 0x34, 0x14, 0x68, 0x31, 0x2e, 0x3a, 0x1e,
+	0xd3, 0x7e, 0x7b, 0x7e, 0xfb, 0x6a, 0xff, 0x88, 0x2a, 0x31, 0xd4, 0x08, 0xb8, 0xed, 0xb5, 0xf1,
+	0x9c, 0x65, 0x32, 0x2c, 0xb9, 0x7d, 0x5c, 0xf9, 0x16, 0xc0, 0x62, 0xdb, 0x61, 0x07, 0xa6, 0x43,
+	0x6c, 0xef, 0x91, 0x33, 0xec, 0x9b, 0xb4, 0x1c, 0xd3, 0x76, 0x0b, 0xff, 0x5f, 0x02, 0xab, 0x40,
+	0xdf, 0xec, 0x09, 0x74, 0x01, 0x2b, 0x14, 0xe8, 0x66, 0xdc, 0xcb, 0x64, 0x8e, 0x86, 0x17, 0xe2,
+	0x60, 0x75, 0x10, 0xdb, 0xa5, 0x7c, 0x33, 0x8a, 0x28, 0x3f, 0x02, 0x98, 0x97, 0x93, 0x20, 0x0e,
+	0x6b, 0xdb, 0x2d, 0xe2, 0x32, 0xab, 0xeb, 0x50, 0xbf, 0x30, 0xbb, 0x34, 0xb5, 0x9a, 0x2b, 0xaf,
+	0xa5, 0x0c, 0x6b, 0x3f, 0xfa, 0xac, 0x45, 0xe4, 0xba, 0xe4, 0x6e, 0x32, 0xef, 0x91, 0xdd, 0xd6,
+	0x3f, 0xed, 0x4f, 0x2a, 0x2d, 0x5b, 0x28, 0x50, 0x31, 0xee, 0x31, 0x25, 0xa8, 0xe1, 0x2b, 0xc1,
+	0x1b, 0x29, 0xfd, 0x8d, 0x0f, 0xff, 0xfe, 0x19, 0x81, 0xef, 0xfe, 0x7a, 0x76, 0xab, 0xd8, 0xb7,
+	0xf5, 0x49, 0xd2, 0xd8, 0xb1, 0xe1, 0xb4, 0xdf, 0xb3, 0x70, 0x71, 0xa2, 0x75, 0x94, 0x15, 0x38,
+	0x65, 0x99, 0x4c, 0xba, 0x0e, 0xe8, 0xf3, 0x3d, 0x81, 0xa2, 0x65, 0x28, 0x10, 0x1c, 0x4e, 0x4e,
+	0xc3, 0x11, 0xa4, 0xdc, 0x85, 0xb3, 0x03, 0x37, 0x15, 0xb2, 0x92, 0x8d, 0x7a, 0x02, 0xcd, 0x26,
+	0x1c, 0x76, 0x39, 0x96, 0x0c, 0x10, 0x0d, 0x0f, 0x83, 0x91, 0xd8, 0xef, 0x76, 0x3a, 0x8e, 0x4d,
+	0x79, 0x61, 0x6a, 0x24, 0x1e, 0x60, 0x23, 0xf1, 0x00, 0xd1, 0xf0, 0x30, 0xa8, 0x3c, 0x80, 0xef,
+	0xf9, 0xac, 0xcb, 0x5b, 0x94, 0xb0, 0x63, 0x8f, 0xf2, 0xc2, 0xb4, 0x4c, 0xb0, 0xd2, 0x13, 0xe8,
+	0x35, 0x3c, 0x14, 0x28, 0xdf, 0x4f, 0x92, 0x40, 0x35, 0x9c, 0x8b, 0x97, 0x3b, 0xd1, 0x4a, 0xd9,
+	0x82, 0x39, 0x33, 0x4a, 0x1b, 0x1f, 0x44, 0xe1, 0x7f, 0x32, 0xd5, 0x72, 0x4f, 0xa0, 0x24, 0x1c,
+	0x0a, 0xa4, 0xc4, 0x99, 0x12, 0xa0, 0x86, 0x93, 0x14, 0xed, 0xfb, 0x19, 0x78, 0x2d, 0x7d, 0xc6,
+	0xca, 0x03, 0x98, 0xb5, 0x2d, 0x79, 0xa2, 0xef, 0x97, 0x97, 0xdf, 0xc1, 0x1a, 0x55, 0x4b, 0xcf,
+	0xf7, 0x04, 0xca, 0xda, 0x56, 0x28, 0xd0, 0xa5, 0xb8, 0xb0, 0x6d, 0x69, 0x38, 0x6b, 0x5b, 0xca,
+	0x2f, 0x00, 0x5e, 0xe3, 0xd4, 0x31, 0x4f, 0xc9, 0x41, 0x97, 0x7b, 0x84, 0x3e, 0xe9, 0x9a, 0x8e,
+	0x4f, 0xa2, 0x6b, 0x2a, 0x87, 0x90, 0x2b, 0x97, 0xd2, 0x0a, 0xd4, 0xea, 0x38, 0xd2, 0xe8, 0x5d,
+	0xee, 0x19, 0x52, 0x11, 0xcd, 0x3f, 0xb6, 0x83, 0x7e, 0xb7, 0x27, 0xd0, 0x84, 0x94, 0xa1, 0x40,
+	0x37, 0xe2, 0xf2, 0xe9, 0x71, 0xed, 0x7e, 0x06, 0xe7, 0xf9, 0x78, 0x66, 0xa5, 0x03, 0x73, 0xfd,
+	0x6b, 0x25, 0x3b, 0x9b, 0x92, 0x9d, 0x7d, 0x94, 0xde, 0xd9, 0x96, 0x24, 0x26, 0x1a, 0x92, 0xa7,
+	0x9f, 0x90, 0x8f, 0x4e, 0x3f, 0x01, 0x46, 0xa5, 0x61, 0x7b, 0x28, 0x55, 0xfe, 0x00, 0xf0, 0x66,
+	0x22, 0x4c, 0x38, 0xb5, 0xdd, 0x83, 0x2e, 0xf7, 0xa9, 0x4b, 0xe5, 0xea, 0x49, 0x97, 0xfa, 0x81,
+	0x74, 0x4a, 0xae, 0xbc, 0xf1, 0xb6, 0x46, 0x70, 0x52, 0x8c, 0x63, 0x6d, 0xbf, 0xb9, 0xbd, 0x9e,
+	0x40, 0x6f, 0x2f, 0x14, 0x0a, 0xb4, 0x3a, 0xd6, 0x72, 0x3a, 0x35, 0xda, 0x88, 0xda, 0xbe, 0xb0,
+	0xb4, 0xf2, 0x14, 0xc0, 0x05, 0x9f, 0xf2, 0x23, 0x7b, 0x60, 0x62, 0xc2, 0xe9, 0x11, 0xf1, 0x1f,
+	0x9b, 0x9c, 0x4a, 0xc7, 0xe6, 0xca, 0x9f, 0xa4, 0x6f, 0xa9, 0x11, 0x8b, 0xa4, 0xd5, 0x31, 0x3d,
+	0x6a, 0x44, 0x8a, 0xfe, 0x46, 0x3e, 0xeb, 0x09, 0x34, 0x29, 0x69, 0x28, 0x90, 0xda, 0xbf, 0x39,
+	0xe9, 0x84, 0xa8, 0xe9, 0xab, 0x7e, 0x4a, 0x72, 0x7d, 0x16, 0xce, 0xc4, 0xef, 0xa7, 0xa6, 0xc2,
+	0x0f, 0x2e, 0xf2, 0x9d, 0x36, 0x0f, 0xf3, 0x29, 0xd3, 0xd7, 0xd6, 0xe0, 0xca, 0x3b, 0xce, 0x42,
+	0xfb, 0x09, 0xc0, 0x1b, 0x17, 0x6e, 0x52, 0x39, 0x81, 0x05, 0xd7, 0x3c, 0x19, 0xf5, 0x9d, 0x78,
+	0xdd, 0xe4, 0x7d, 0x9c, 0xd6, 0x3f, 0x97, 0xaf, 0xe4, 0x04, 0x4e, 0x28, 0x10, 0xea, 0xbf, 0x92,
+	0x13, 0x18, 0x1a, 0x9e, 0x77, 0xcd, 0x93, 0x61, 0xd5, 0x11, 0xfe, 0x0c, 0xc0, 0x85, 0xf4, 0x9f,
+	0x03, 0x7f, 0xe2, 0xe3, 0x01, 0xfe, 0xd3, 0xc7, 0xe3, 0xd6, 0xaf, 0x00, 0x2a, 0xe3, 0x3f, 0x45,
+	0x4a, 0x1e, 0x5e, 0xde, 0xaf, 0xd5, 0x49, 0x73, 0xbb, 0xb1, 0x6b, 0x6c, 0x56, 0xef, 0x55, 0x8d,
+	0x2f, 0xe6, 0x32, 0x8a, 0x0a, 0x8b, 0x11, 0x88, 0x8d, 0x5a, 0xe5, 0x6b, 0xa2, 0x37, 0xf1, 0x36,
+	0x31, 0xf6, 0x9a, 0x95, 0x5a, 0x83, 0xd4, 0xab, 0xdb, 0xfb, 0x73, 0x60, 0x20, 0xda, 0xaa, 0xed,
+	0xe8, 0x95, 0x5a, 0x0c, 0x66, 0x95, 0x35, 0xb8, 0xfc, 0x06, 0x48, 0xb0, 0x51, 0xad, 0xeb, 0x4d,
+	0xdc, 0x30, 0xea, 0x86, 0x5c, 0xed, 0x35, 0x8d, 0xc6, 0xfe, 0xdc, 0x94, 0x82, 0xe0, 0xf5, 0x88,
+	0xda, 0x30, 0xf0, 0xc3, 0xea, 0xa6, 0x41, 0x76, 0xbe, 0xda, 0x36, 0x30, 0xc1, 0xc6, 0x43, 0xd2,
+	0xb8, 0x5f, 0xc1, 0xc6, 0xdc, 0xb4, 0xde, 0x7c, 0x7a, 0xa6, 0x82, 0x17, 0x67, 0x2a, 0x78, 0x79,
+	0xa6, 0x82, 0x57, 0x67, 0x2a, 0xf8, 0xf3, 0x4c, 0x05, 0x3f, 0x9c, 0xab, 0x99, 0x97, 0xe7, 0x6a,
+	0xe6, 0xd5, 0xb9, 0x9a, 0xf9, 0xe6, 0x4e, 0xdb, 0x0e, 0x1e, 0x77, 0x0f, 0xd6, 0x5b, 0xcc, 0x2d,
+	0x75, 0xd8, 0x61, 0x70, 0xdb, 0xa3, 0xc1, 0x31, 0xe3, 0x87, 0x72, 0xc1, 0x99, 0xe3, 0xbc, 0xfe,
+	0x38, 0x06, 0xa7, 0x1d, 0xea, 0x1f, 0xcc, 0xc8, 0x7f, 0xcf, 0xee, 0xfc, 0x3b, 0x00, 0x07, 0x01,
+	0xec, 0xa1, 0x17, 0x0a, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -246,6 +656,14 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.GlobalInflationPerClaim != that1.GlobalInflationPerClaim {
 		return false
 	}
+	if len(this.TokenLogicModules) != len(that1.TokenLogicModules) {
+		return false
+	}
+	for i := range this.TokenLogicModules {
+		if !this.TokenLogicModules[i].Equal(&that1.TokenLogicModules[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *MintAllocationPercentages) Equal(that interface{}) bool {
@@ -284,74 +702,333 @@ func (this *MintAllocationPercentages) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *TokenLogicModuleConfig) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.GlobalInflationPerClaim != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.GlobalInflationPerClaim))))
-		i--
-		dAtA[i] = 0x39
+	that1, ok := that.(*TokenLogicModuleConfig)
+	if !ok {
+		that2, ok := that.(TokenLogicModuleConfig)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if len(m.DaoRewardAddress) > 0 {
-		i -= len(m.DaoRewardAddress)
-		copy(dAtA[i:], m.DaoRewardAddress)
-		i = encodeVarintParams(dAtA, i, uint64(len(m.DaoRewardAddress)))
-		i--
-		dAtA[i] = 0x32
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	{
-		size, err := m.MintAllocationPercentages.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if this.Id != that1.Id {
+		return false
+	}
+	if that1.Params == nil {
+		if this.Params != nil {
+			return false
 		}
-		i -= size
-		i = encodeVarintParams(dAtA, i, uint64(size))
+	} else if this.Params == nil {
+		return false
+	} else if !this.Params.Equal(that1.Params) {
+		return false
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return true
 }
-
-func (m *MintAllocationPercentages) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *TokenLogicModuleConfig_RelayBurnEqualsMint) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MintAllocationPercentages) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *MintAllocationPercentages) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Application != 0 {
-		i -= 8
+	that1, ok := that.(*TokenLogicModuleConfig_RelayBurnEqualsMint)
+	if !ok {
+		that2, ok := that.(TokenLogicModuleConfig_RelayBurnEqualsMint)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.RelayBurnEqualsMint.Equal(that1.RelayBurnEqualsMint) {
+		return false
+	}
+	return true
+}
+func (this *TokenLogicModuleConfig_GlobalMint) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TokenLogicModuleConfig_GlobalMint)
+	if !ok {
+		that2, ok := that.(TokenLogicModuleConfig_GlobalMint)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.GlobalMint.Equal(that1.GlobalMint) {
+		return false
+	}
+	return true
+}
+func (this *TokenLogicModuleConfig_GlobalMintReimbursementRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TokenLogicModuleConfig_GlobalMintReimbursementRequest)
+	if !ok {
+		that2, ok := that.(TokenLogicModuleConfig_GlobalMintReimbursementRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.GlobalMintReimbursementRequest.Equal(that1.GlobalMintReimbursementRequest) {
+		return false
+	}
+	return true
+}
+func (this *TokenLogicModuleConfig_ServiceOwnerRevShare) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TokenLogicModuleConfig_ServiceOwnerRevShare)
+	if !ok {
+		that2, ok := that.(TokenLogicModuleConfig_ServiceOwnerRevShare)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ServiceOwnerRevShare.Equal(that1.ServiceOwnerRevShare) {
+		return false
+	}
+	return true
+}
+func (this *TLMRelayBurnEqualsMintParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TLMRelayBurnEqualsMintParams)
+	if !ok {
+		that2, ok := that.(TLMRelayBurnEqualsMintParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *TLMGlobalMintParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TLMGlobalMintParams)
+	if !ok {
+		that2, ok := that.(TLMGlobalMintParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *TLMGlobalMintReimbursementRequestParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TLMGlobalMintReimbursementRequestParams)
+	if !ok {
+		that2, ok := that.(TLMGlobalMintReimbursementRequestParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *TLMServiceOwnerRevShareParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TLMServiceOwnerRevShareParams)
+	if !ok {
+		that2, ok := that.(TLMServiceOwnerRevShareParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MaxRevSharePercentage != that1.MaxRevSharePercentage {
+		return false
+	}
+	return true
+}
+func (this *TokenLogicModuleConfigs) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TokenLogicModuleConfigs)
+	if !ok {
+		that2, ok := that.(TokenLogicModuleConfigs)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.TokenLogicModules) != len(that1.TokenLogicModules) {
+		return false
+	}
+	for i := range this.TokenLogicModules {
+		if !this.TokenLogicModules[i].Equal(&that1.TokenLogicModules[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokenLogicModules) > 0 {
+		for iNdEx := len(m.TokenLogicModules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokenLogicModules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.GlobalInflationPerClaim != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.GlobalInflationPerClaim))))
+		i--
+		dAtA[i] = 0x39
+	}
+	if len(m.DaoRewardAddress) > 0 {
+		i -= len(m.DaoRewardAddress)
+		copy(dAtA[i:], m.DaoRewardAddress)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.DaoRewardAddress)))
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size, err := m.MintAllocationPercentages.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MintAllocationPercentages) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MintAllocationPercentages) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MintAllocationPercentages) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Application != 0 {
+		i -= 8
 		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Application))))
 		i--
 		dAtA[i] = 0x29
@@ -383,17 +1060,272 @@ func (m *MintAllocationPercentages) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
-	offset -= sovParams(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
+func (m *TokenLogicModuleConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenLogicModuleConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenLogicModuleConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Params != nil {
+		{
+			size := m.Params.Size()
+			i -= size
+			if _, err := m.Params.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if m.Id != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TokenLogicModuleConfig_RelayBurnEqualsMint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenLogicModuleConfig_RelayBurnEqualsMint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.RelayBurnEqualsMint != nil {
+		{
+			size, err := m.RelayBurnEqualsMint.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintParams(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *TokenLogicModuleConfig_GlobalMint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenLogicModuleConfig_GlobalMint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.GlobalMint != nil {
+		{
+			size, err := m.GlobalMint.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintParams(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *TokenLogicModuleConfig_GlobalMintReimbursementRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenLogicModuleConfig_GlobalMintReimbursementRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.GlobalMintReimbursementRequest != nil {
+		{
+			size, err := m.GlobalMintReimbursementRequest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintParams(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	return len(dAtA) - i, nil
+}
+func (m *TokenLogicModuleConfig_ServiceOwnerRevShare) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenLogicModuleConfig_ServiceOwnerRevShare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ServiceOwnerRevShare != nil {
+		{
+			size, err := m.ServiceOwnerRevShare.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintParams(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *TLMRelayBurnEqualsMintParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLMRelayBurnEqualsMintParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLMRelayBurnEqualsMintParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *TLMGlobalMintParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLMGlobalMintParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLMGlobalMintParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *TLMGlobalMintReimbursementRequestParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLMGlobalMintReimbursementRequestParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLMGlobalMintReimbursementRequestParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *TLMServiceOwnerRevShareParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLMServiceOwnerRevShareParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLMServiceOwnerRevShareParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxRevSharePercentage != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxRevSharePercentage))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TokenLogicModuleConfigs) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenLogicModuleConfigs) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenLogicModuleConfigs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokenLogicModules) > 0 {
+		for iNdEx := len(m.TokenLogicModules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokenLogicModules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
 func (m *Params) Size() (n int) {
 	if m == nil {
 		return 0
@@ -409,40 +1341,737 @@ func (m *Params) Size() (n int) {
 	if m.GlobalInflationPerClaim != 0 {
 		n += 9
 	}
-	return n
-}
+	if len(m.TokenLogicModules) > 0 {
+		for _, e := range m.TokenLogicModules {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MintAllocationPercentages) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Dao != 0 {
+		n += 9
+	}
+	if m.Proposer != 0 {
+		n += 9
+	}
+	if m.Supplier != 0 {
+		n += 9
+	}
+	if m.SourceOwner != 0 {
+		n += 9
+	}
+	if m.Application != 0 {
+		n += 9
+	}
+	return n
+}
+
+func (m *TokenLogicModuleConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovParams(uint64(m.Id))
+	}
+	if m.Params != nil {
+		n += m.Params.Size()
+	}
+	return n
+}
+
+func (m *TokenLogicModuleConfig_RelayBurnEqualsMint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RelayBurnEqualsMint != nil {
+		l = m.RelayBurnEqualsMint.Size()
+		n += 1 + l + sovParams(uint64(l))
+	}
+	return n
+}
+func (m *TokenLogicModuleConfig_GlobalMint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GlobalMint != nil {
+		l = m.GlobalMint.Size()
+		n += 1 + l + sovParams(uint64(l))
+	}
+	return n
+}
+func (m *TokenLogicModuleConfig_GlobalMintReimbursementRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GlobalMintReimbursementRequest != nil {
+		l = m.GlobalMintReimbursementRequest.Size()
+		n += 1 + l + sovParams(uint64(l))
+	}
+	return n
+}
+func (m *TokenLogicModuleConfig_ServiceOwnerRevShare) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ServiceOwnerRevShare != nil {
+		l = m.ServiceOwnerRevShare.Size()
+		n += 1 + l + sovParams(uint64(l))
+	}
+	return n
+}
+func (m *TLMRelayBurnEqualsMintParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *TLMGlobalMintParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *TLMGlobalMintReimbursementRequestParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *TLMServiceOwnerRevShareParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxRevSharePercentage != 0 {
+		n += 1 + sovParams(uint64(m.MaxRevSharePercentage))
+	}
+	return n
+}
+
+func (m *TokenLogicModuleConfigs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TokenLogicModules) > 0 {
+		for _, e := range m.TokenLogicModules {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintAllocationPercentages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MintAllocationPercentages.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DaoRewardAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DaoRewardAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalInflationPerClaim", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.GlobalInflationPerClaim = float64(math.Float64frombits(v))
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenLogicModules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenLogicModules = append(m.TokenLogicModules, TokenLogicModuleConfig{})
+			if err := m.TokenLogicModules[len(m.TokenLogicModules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MintAllocationPercentages) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MintAllocationPercentages: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MintAllocationPercentages: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dao", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Dao = float64(math.Float64frombits(v))
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Proposer = float64(math.Float64frombits(v))
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supplier", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Supplier = float64(math.Float64frombits(v))
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceOwner", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.SourceOwner = float64(math.Float64frombits(v))
+		case 5:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Application", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Application = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TokenLogicModuleConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenLogicModuleConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenLogicModuleConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= TokenLogicModuleId(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayBurnEqualsMint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &TLMRelayBurnEqualsMintParams{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Params = &TokenLogicModuleConfig_RelayBurnEqualsMint{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalMint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &TLMGlobalMintParams{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Params = &TokenLogicModuleConfig_GlobalMint{v}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalMintReimbursementRequest", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &TLMGlobalMintReimbursementRequestParams{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Params = &TokenLogicModuleConfig_GlobalMintReimbursementRequest{v}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceOwnerRevShare", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &TLMServiceOwnerRevShareParams{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Params = &TokenLogicModuleConfig_ServiceOwnerRevShare{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLMRelayBurnEqualsMintParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TLMRelayBurnEqualsMintParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TLMRelayBurnEqualsMintParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MintAllocationPercentages) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Dao != 0 {
-		n += 9
-	}
-	if m.Proposer != 0 {
-		n += 9
-	}
-	if m.Supplier != 0 {
-		n += 9
-	}
-	if m.SourceOwner != 0 {
-		n += 9
-	}
-	if m.Application != 0 {
-		n += 9
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
+func (m *TLMGlobalMintParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TLMGlobalMintParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TLMGlobalMintParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovParams(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozParams(x uint64) (n int) {
-	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *TLMGlobalMintReimbursementRequestParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -465,50 +2094,67 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: TLMGlobalMintReimbursementRequestParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TLMGlobalMintReimbursementRequestParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MintAllocationPercentages", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowParams
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthParams
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthParams
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLMServiceOwnerRevShareParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MintAllocationPercentages.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DaoRewardAddress", wireType)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TLMServiceOwnerRevShareParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TLMServiceOwnerRevShareParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRevSharePercentage", wireType)
 			}
-			var stringLen uint64
+			m.MaxRevSharePercentage = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -518,35 +2164,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MaxRevSharePercentage |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthParams
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthParams
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DaoRewardAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GlobalInflationPerClaim", wireType)
-			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.GlobalInflationPerClaim = float64(math.Float64frombits(v))
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -568,7 +2190,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MintAllocationPercentages) Unmarshal(dAtA []byte) error {
+func (m *TokenLogicModuleConfigs) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -591,67 +2213,46 @@ func (m *MintAllocationPercentages) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MintAllocationPercentages: wiretype end group for non-group")
+			return fmt.Errorf("proto: TokenLogicModuleConfigs: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MintAllocationPercentages: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TokenLogicModuleConfigs: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Dao", wireType)
-			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.Dao = float64(math.Float64frombits(v))
-		case 2:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
-			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenLogicModules", wireType)
 			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.Proposer = float64(math.Float64frombits(v))
-		case 3:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Supplier", wireType)
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthParams
 			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.Supplier = float64(math.Float64frombits(v))
-		case 4:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceOwner", wireType)
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
 			}
-			var v uint64
-			if (iNdEx + 8) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.SourceOwner = float64(math.Float64frombits(v))
-		case 5:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Application", wireType)
-			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
+			m.TokenLogicModules = append(m.TokenLogicModules, TokenLogicModuleConfig{})
+			if err := m.TokenLogicModules[len(m.TokenLogicModules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.Application = float64(math.Float64frombits(v))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
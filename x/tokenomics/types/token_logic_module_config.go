@@ -0,0 +1,72 @@
+package types
+
+// DefaultTokenLogicModules is the default ordered list of the token logic modules
+// which are run to settle each claim.
+var DefaultTokenLogicModules = []TokenLogicModuleConfig{
+	{Id: TokenLogicModuleId_TLM_RELAY_BURN_EQUALS_MINT},
+	{Id: TokenLogicModuleId_TLM_GLOBAL_MINT},
+	{Id: TokenLogicModuleId_TLM_GLOBAL_MINT_REIMBURSEMENT_REQUEST},
+}
+
+// ValidateBasic ensures that the TLM id is known and that the TLM params, if
+// any, are of the type matching the TLM id.
+func (config *TokenLogicModuleConfig) ValidateBasic() error {
+	if _, ok := TokenLogicModuleId_name[int32(config.Id)]; !ok || config.Id == TokenLogicModuleId_TLM_UNSPECIFIED {
+		return ErrTokenomicsParamInvalid.Wrapf("invalid token logic module id: %d", config.Id)
+	}
+
+	if config.Params == nil {
+		return nil
+	}
+
+	var paramsId TokenLogicModuleId
+	switch config.Params.(type) {
+	case *TokenLogicModuleConfig_RelayBurnEqualsMint:
+		paramsId = TokenLogicModuleId_TLM_RELAY_BURN_EQUALS_MINT
+	case *TokenLogicModuleConfig_GlobalMint:
+		paramsId = TokenLogicModuleId_TLM_GLOBAL_MINT
+	case *TokenLogicModuleConfig_GlobalMintReimbursementRequest:
+		paramsId = TokenLogicModuleId_TLM_GLOBAL_MINT_REIMBURSEMENT_REQUEST
+	case *TokenLogicModuleConfig_ServiceOwnerRevShare:
+		paramsId = TokenLogicModuleId_TLM_SERVICE_OWNER_REV_SHARE
+	default:
+		return ErrTokenomicsParamInvalid.Wrapf("unknown token logic module params type: %T", config.Params)
+	}
+
+	if paramsId != config.Id {
+		return ErrTokenomicsParamInvalid.Wrapf(
+			"token logic module %s has params of token logic module %s",
+			config.Id, paramsId,
+		)
+	}
+
+	if serviceOwnerRevShareParams := config.GetServiceOwnerRevShare(); serviceOwnerRevShareParams != nil {
+		if serviceOwnerRevShareParams.MaxRevSharePercentage > 100 {
+			return ErrTokenomicsParamInvalid.Wrapf(
+				"token logic module %s max rev share percentage must be at most 100, got %d",
+				config.Id, serviceOwnerRevShareParams.MaxRevSharePercentage,
+			)
+		}
+	}
+
+	return nil
+}
+
+// GetTokenLogicModuleConfig returns the config of the token logic module with
+// the given id, if it is part of the settlement pipeline.
+func (params *Params) GetTokenLogicModuleConfig(tlmId TokenLogicModuleId) (*TokenLogicModuleConfig, bool) {
+	for i, tlmConfig := range params.TokenLogicModules {
+		if tlmConfig.Id == tlmId {
+			return &params.TokenLogicModules[i], true
+		}
+	}
+
+	return nil, false
+}
+
+// IsTokenLogicModuleEnabled returns true if the token logic module with the
+// given id is part of the settlement pipeline.
+func (params *Params) IsTokenLogicModuleEnabled(tlmId TokenLogicModuleId) bool {
+	_, isEnabled := params.GetTokenLogicModuleConfig(tlmId)
+	return isEnabled
+}
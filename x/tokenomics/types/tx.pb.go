@@ -137,6 +137,7 @@ type MsgUpdateParam struct {
 	//	*MsgUpdateParam_AsMintAllocationPercentages
 	//	*MsgUpdateParam_AsString
 	//	*MsgUpdateParam_AsFloat
+	//	*MsgUpdateParam_AsTokenLogicModules
 	AsType isMsgUpdateParam_AsType `protobuf_oneof:"as_type"`
 }
 
@@ -184,10 +185,14 @@ type MsgUpdateParam_AsString struct {
 type MsgUpdateParam_AsFloat struct {
 	AsFloat float64 `protobuf:"fixed64,5,opt,name=as_float,json=asFloat,proto3,oneof" json:"as_float"`
 }
+type MsgUpdateParam_AsTokenLogicModules struct {
+	AsTokenLogicModules *TokenLogicModuleConfigs `protobuf:"bytes,6,opt,name=as_token_logic_modules,json=asTokenLogicModules,proto3,oneof" json:"as_token_logic_modules" yaml:"as_token_logic_modules"`
+}
 
 func (*MsgUpdateParam_AsMintAllocationPercentages) isMsgUpdateParam_AsType() {}
 func (*MsgUpdateParam_AsString) isMsgUpdateParam_AsType()                    {}
 func (*MsgUpdateParam_AsFloat) isMsgUpdateParam_AsType()                     {}
+func (*MsgUpdateParam_AsTokenLogicModules) isMsgUpdateParam_AsType()         {}
 
 func (m *MsgUpdateParam) GetAsType() isMsgUpdateParam_AsType {
 	if m != nil {
@@ -231,12 +236,20 @@ func (m *MsgUpdateParam) GetAsFloat() float64 {
 	return 0
 }
 
+func (m *MsgUpdateParam) GetAsTokenLogicModules() *TokenLogicModuleConfigs {
+	if x, ok := m.GetAsType().(*MsgUpdateParam_AsTokenLogicModules); ok {
+		return x.AsTokenLogicModules
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*MsgUpdateParam) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*MsgUpdateParam_AsMintAllocationPercentages)(nil),
 		(*MsgUpdateParam_AsString)(nil),
 		(*MsgUpdateParam_AsFloat)(nil),
+		(*MsgUpdateParam_AsTokenLogicModules)(nil),
 	}
 }
 
@@ -292,43 +305,46 @@ func init() {
 func init() { proto.RegisterFile("pocket/tokenomics/tx.proto", fileDescriptor_df88dc3fd9e72965) }
 
 var fileDescriptor_df88dc3fd9e72965 = []byte{
-	// 564 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xbf, 0x6f, 0xd3, 0x40,
-	0x14, 0xf6, 0xd1, 0x9f, 0xbe, 0x96, 0xa2, 0x5a, 0x15, 0x75, 0x8d, 0xe4, 0x04, 0x23, 0xa4, 0x34,
-	0x6a, 0x63, 0xb5, 0x95, 0x3a, 0x44, 0x2c, 0xf5, 0x80, 0x22, 0x95, 0x48, 0xc5, 0x88, 0x05, 0x24,
-	0xac, 0xab, 0x73, 0xb8, 0x56, 0xec, 0x3b, 0xcb, 0x77, 0x85, 0x66, 0x43, 0x8c, 0x4c, 0x48, 0xfc,
-	0x13, 0x8c, 0x19, 0xd8, 0x61, 0x2c, 0x5b, 0xc5, 0xd4, 0x29, 0x42, 0xc9, 0x10, 0x29, 0x23, 0x7f,
-	0x01, 0xca, 0xd9, 0x8d, 0xeb, 0xb6, 0x60, 0x09, 0x96, 0xe4, 0xbd, 0xf7, 0xbd, 0x1f, 0xdf, 0x7d,
-	0xf7, 0xce, 0x50, 0x8b, 0xa8, 0xdb, 0xc6, 0xdc, 0xe4, 0xb4, 0x8d, 0x09, 0x0d, 0x7d, 0x97, 0x99,
-	0xfc, 0xa4, 0x16, 0xc5, 0x94, 0x53, 0x65, 0x39, 0xc1, 0x6a, 0x19, 0xa6, 0x2d, 0xa3, 0xd0, 0x27,
-	0xd4, 0x14, 0xbf, 0x49, 0x96, 0xb6, 0xea, 0x52, 0x16, 0x52, 0x66, 0x86, 0xcc, 0x33, 0xdf, 0x6c,
-	0x8d, 0xff, 0x52, 0x60, 0x2d, 0x01, 0x1c, 0xe1, 0x99, 0x89, 0x93, 0x42, 0x2b, 0x1e, 0xf5, 0x68,
-	0x12, 0x1f, 0x5b, 0x69, 0x54, 0xbf, 0xce, 0x25, 0x42, 0x31, 0x0a, 0xd3, 0x2a, 0xe3, 0x2b, 0x80,
-	0x77, 0x9a, 0xcc, 0x7b, 0x1e, 0xb5, 0x10, 0xc7, 0x07, 0x02, 0x51, 0x76, 0xa1, 0x8c, 0x8e, 0xf9,
-	0x11, 0x8d, 0x7d, 0xde, 0x51, 0x41, 0x19, 0x54, 0x64, 0x4b, 0xfd, 0xf1, 0x65, 0x73, 0x25, 0x1d,
-	0xb7, 0xd7, 0x6a, 0xc5, 0x98, 0xb1, 0x67, 0x3c, 0xf6, 0x89, 0x67, 0x67, 0xa9, 0xca, 0x23, 0x38,
-	0x9b, 0xf4, 0x56, 0x6f, 0x95, 0x41, 0x65, 0x61, 0x7b, 0xad, 0x76, 0xed, 0xb0, 0xb5, 0x64, 0x84,
-	0x25, 0x9f, 0xf6, 0x4a, 0xd2, 0xe7, 0x61, 0xb7, 0x0a, 0xec, 0xb4, 0xa6, 0xbe, 0xfb, 0x7e, 0xd8,
-	0xad, 0x66, 0xdd, 0x3e, 0x0c, 0xbb, 0xd5, 0x07, 0x29, 0xf9, 0x93, 0xcb, 0xf4, 0xaf, 0xb0, 0x35,
-	0x9e, 0xc0, 0xd5, 0x2b, 0x21, 0x1b, 0xb3, 0x88, 0x12, 0x86, 0x95, 0xad, 0x09, 0x21, 0x50, 0x40,
-	0xe8, 0x82, 0x85, 0xf1, 0x69, 0x0a, 0x2e, 0xe5, 0xdb, 0xfd, 0xb3, 0x1c, 0x0a, 0x9c, 0x26, 0x28,
-	0xc4, 0x42, 0x0c, 0xd9, 0x16, 0xb6, 0xf2, 0x0d, 0x40, 0x1d, 0x31, 0x27, 0xf4, 0x09, 0x77, 0x50,
-	0x10, 0x50, 0x17, 0x71, 0x9f, 0x12, 0x27, 0xc2, 0xb1, 0x8b, 0x09, 0x47, 0x1e, 0x66, 0xea, 0x94,
-	0xa0, 0xba, 0x71, 0x03, 0xd5, 0xa6, 0x4f, 0xf8, 0xde, 0xa4, 0xe8, 0x20, 0xab, 0xb1, 0xf6, 0x47,
-	0xbd, 0x52, 0x41, 0xdf, 0x5f, 0xbd, 0xd2, 0xc3, 0x0e, 0x0a, 0x83, 0xba, 0xf1, 0xf7, 0x3c, 0xa3,
-	0x21, 0xd9, 0xf7, 0x10, 0xfb, 0xe3, 0x2c, 0x65, 0x03, 0xca, 0x88, 0x39, 0x4c, 0x1c, 0x57, 0x9d,
-	0x16, 0x72, 0xdc, 0x1e, 0xf5, 0x4a, 0x59, 0xb0, 0x21, 0xd9, 0xf3, 0x28, 0xd5, 0x43, 0x59, 0x87,
-	0xf3, 0x88, 0x39, 0xaf, 0x03, 0x8a, 0xb8, 0x3a, 0x53, 0x06, 0x15, 0x60, 0x2d, 0x8e, 0x7a, 0xa5,
-	0x49, 0xac, 0x21, 0xd9, 0x73, 0x88, 0x3d, 0x1e, 0x9b, 0xf5, 0xa5, 0xfc, 0x02, 0x58, 0x32, 0x9c,
-	0x43, 0xcc, 0xe1, 0x9d, 0x08, 0x1b, 0xfb, 0xf0, 0x6e, 0xfe, 0x52, 0xfe, 0xe3, 0x8a, 0xb7, 0xbf,
-	0x03, 0x38, 0xd5, 0x64, 0x9e, 0xf2, 0x0a, 0x2e, 0xe6, 0xd6, 0xde, 0xb8, 0x49, 0xf2, 0xfc, 0x66,
-	0x69, 0xd5, 0xe2, 0x9c, 0x09, 0xb5, 0x97, 0x70, 0xe1, 0xf2, 0x1a, 0xdd, 0x2f, 0x2c, 0xd5, 0xd6,
-	0x0b, 0x53, 0x2e, 0x9a, 0x6b, 0x33, 0xef, 0xc6, 0x8f, 0xc7, 0x7a, 0x7a, 0xda, 0xd7, 0xc1, 0x59,
-	0x5f, 0x07, 0xe7, 0x7d, 0x1d, 0xfc, 0xec, 0xeb, 0xe0, 0xe3, 0x40, 0x97, 0xce, 0x06, 0xba, 0x74,
-	0x3e, 0xd0, 0xa5, 0x17, 0x3b, 0x9e, 0xcf, 0x8f, 0x8e, 0x0f, 0x6b, 0x2e, 0x0d, 0xcd, 0x88, 0xb6,
-	0xf9, 0x26, 0xc1, 0xfc, 0x2d, 0x8d, 0xdb, 0xc2, 0x89, 0x69, 0x10, 0xe4, 0x5f, 0xd6, 0x58, 0x6a,
-	0x76, 0x38, 0x2b, 0x3e, 0x0c, 0x3b, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0x5f, 0xe2, 0xdc, 0xbe,
-	0xc6, 0x04, 0x00, 0x00,
+	// 623 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xcf, 0x4f, 0xd4, 0x4e,
+	0x1c, 0xed, 0x7c, 0xf9, 0xd9, 0x81, 0x2f, 0x86, 0x4a, 0xa0, 0xd4, 0xd8, 0x62, 0x8d, 0x09, 0x6c,
+	0x60, 0x1b, 0x20, 0xe1, 0x80, 0x5e, 0xa8, 0x89, 0x21, 0x81, 0x4d, 0xb0, 0xea, 0x45, 0x13, 0x9b,
+	0xa1, 0x3b, 0x94, 0x66, 0xdb, 0x4e, 0xd3, 0x19, 0x14, 0x6e, 0xc6, 0xa3, 0x27, 0xff, 0x02, 0xcf,
+	0x1e, 0xf7, 0xc0, 0x5d, 0x8f, 0x78, 0x23, 0x9e, 0x38, 0x35, 0x66, 0xf7, 0xb0, 0xc9, 0x1e, 0xf9,
+	0x0b, 0x4c, 0xa7, 0x65, 0x97, 0x2e, 0x8b, 0x9b, 0xe8, 0x65, 0x77, 0xe6, 0xf3, 0xde, 0xe7, 0xcd,
+	0x9b, 0x97, 0xcf, 0x14, 0x2a, 0x11, 0x71, 0x6a, 0x98, 0x19, 0x8c, 0xd4, 0x70, 0x48, 0x02, 0xcf,
+	0xa1, 0x06, 0x3b, 0x2e, 0x47, 0x31, 0x61, 0x44, 0x9a, 0xce, 0xb0, 0x72, 0x17, 0x53, 0xa6, 0x51,
+	0xe0, 0x85, 0xc4, 0xe0, 0xbf, 0x19, 0x4b, 0x99, 0x73, 0x08, 0x0d, 0x08, 0x35, 0x02, 0xea, 0x1a,
+	0xef, 0x56, 0xd3, 0xbf, 0x1c, 0x98, 0xcf, 0x00, 0x9b, 0xef, 0x8c, 0x6c, 0x93, 0x43, 0x33, 0x2e,
+	0x71, 0x49, 0x56, 0x4f, 0x57, 0x79, 0x55, 0xbd, 0xe9, 0x25, 0x42, 0x31, 0x0a, 0xf2, 0x2e, 0xfd,
+	0x1b, 0x80, 0x77, 0x2a, 0xd4, 0x7d, 0x15, 0x55, 0x11, 0xc3, 0x7b, 0x1c, 0x91, 0x36, 0xa0, 0x88,
+	0x8e, 0xd8, 0x21, 0x89, 0x3d, 0x76, 0x22, 0x83, 0x05, 0xb0, 0x28, 0x9a, 0xf2, 0xcf, 0xd3, 0x95,
+	0x99, 0xfc, 0xb8, 0xad, 0x6a, 0x35, 0xc6, 0x94, 0xbe, 0x60, 0xb1, 0x17, 0xba, 0x56, 0x97, 0x2a,
+	0x3d, 0x81, 0xa3, 0x99, 0xb6, 0xfc, 0xdf, 0x02, 0x58, 0x9c, 0x58, 0x9b, 0x2f, 0xdf, 0xb8, 0x6c,
+	0x39, 0x3b, 0xc2, 0x14, 0xcf, 0x12, 0x4d, 0xf8, 0xda, 0xaa, 0x97, 0x80, 0x95, 0xf7, 0x6c, 0x6e,
+	0x7c, 0x6c, 0xd5, 0x4b, 0x5d, 0xb5, 0x4f, 0xad, 0x7a, 0xe9, 0x61, 0x6e, 0xfe, 0xf8, 0xba, 0xfd,
+	0x1e, 0xb7, 0xfa, 0x2e, 0x9c, 0xeb, 0x29, 0x59, 0x98, 0x46, 0x24, 0xa4, 0x58, 0x5a, 0xed, 0x18,
+	0x02, 0x03, 0x0c, 0x5d, 0xb9, 0xd0, 0x4f, 0x87, 0xe1, 0x54, 0x51, 0xee, 0xaf, 0xe3, 0x90, 0xe0,
+	0x70, 0x88, 0x02, 0xcc, 0xc3, 0x10, 0x2d, 0xbe, 0x96, 0xbe, 0x03, 0xa8, 0x22, 0x6a, 0x07, 0x5e,
+	0xc8, 0x6c, 0xe4, 0xfb, 0xc4, 0x41, 0xcc, 0x23, 0xa1, 0x1d, 0xe1, 0xd8, 0xc1, 0x21, 0x43, 0x2e,
+	0xa6, 0xf2, 0x10, 0xb7, 0xba, 0xdc, 0xc7, 0x6a, 0xc5, 0x0b, 0xd9, 0x56, 0xa7, 0x69, 0xaf, 0xdb,
+	0x63, 0xee, 0xb4, 0x13, 0x6d, 0x80, 0xee, 0x65, 0xa2, 0x3d, 0x3a, 0x41, 0x81, 0xbf, 0xa9, 0xff,
+	0x99, 0xa7, 0x6f, 0x0b, 0xd6, 0x3d, 0x44, 0x6f, 0x3d, 0x4b, 0x5a, 0x86, 0x22, 0xa2, 0x36, 0xe5,
+	0xd7, 0x95, 0x87, 0x79, 0x1c, 0xff, 0xb7, 0x13, 0xad, 0x5b, 0xdc, 0x16, 0xac, 0x71, 0x94, 0xe7,
+	0x21, 0x2d, 0xc1, 0x71, 0x44, 0xed, 0x03, 0x9f, 0x20, 0x26, 0x8f, 0x2c, 0x80, 0x45, 0x60, 0x4e,
+	0xb6, 0x13, 0xad, 0x53, 0xdb, 0x16, 0xac, 0x31, 0x44, 0x9f, 0xa5, 0x4b, 0xe9, 0x0b, 0x80, 0xb3,
+	0x88, 0xda, 0xfc, 0xc2, 0xb6, 0x4f, 0x5c, 0xcf, 0xb1, 0x03, 0x52, 0x3d, 0xf2, 0x31, 0x95, 0x47,
+	0x79, 0x26, 0xa5, 0x3e, 0x99, 0xbc, 0x4c, 0x97, 0xbb, 0x29, 0xb9, 0xc2, 0xb9, 0x4f, 0x49, 0x78,
+	0xe0, 0xb9, 0xd4, 0x7c, 0xdc, 0x4e, 0xb4, 0x5b, 0xd4, 0x2e, 0x13, 0xed, 0x7e, 0x27, 0x89, 0x3e,
+	0x78, 0x9a, 0xc0, 0x5d, 0x44, 0x7b, 0x95, 0xe9, 0xe6, 0x54, 0x71, 0x42, 0x4d, 0x11, 0x8e, 0xa5,
+	0x0a, 0x27, 0x11, 0xd6, 0x77, 0xe0, 0x6c, 0x71, 0x6a, 0xfe, 0x61, 0x06, 0xd7, 0x7e, 0x00, 0x38,
+	0x54, 0xa1, 0xae, 0xf4, 0x16, 0x4e, 0x16, 0xde, 0xa5, 0xde, 0x6f, 0x26, 0x8a, 0xa3, 0xaf, 0x94,
+	0x06, 0x73, 0x3a, 0xd6, 0xde, 0xc0, 0x89, 0xeb, 0x73, 0xfe, 0x60, 0x60, 0xab, 0xb2, 0x34, 0x90,
+	0x72, 0x25, 0xae, 0x8c, 0x7c, 0x48, 0x5f, 0xb7, 0xf9, 0xfc, 0xac, 0xa1, 0x82, 0xf3, 0x86, 0x0a,
+	0x2e, 0x1a, 0x2a, 0xf8, 0xd5, 0x50, 0xc1, 0xe7, 0xa6, 0x2a, 0x9c, 0x37, 0x55, 0xe1, 0xa2, 0xa9,
+	0x0a, 0xaf, 0xd7, 0x5d, 0x8f, 0x1d, 0x1e, 0xed, 0x97, 0x1d, 0x12, 0x18, 0x11, 0xa9, 0xb1, 0x95,
+	0x10, 0xb3, 0xf7, 0x24, 0xae, 0xf1, 0x4d, 0x4c, 0x7c, 0xbf, 0xf8, 0xf4, 0xd3, 0xa8, 0xe9, 0xfe,
+	0x28, 0xff, 0x72, 0xad, 0xff, 0x1e, 0x00, 0xfe, 0x50, 0x79, 0xc3, 0x67, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -621,6 +637,27 @@ func (m *MsgUpdateParam_AsFloat) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	dAtA[i] = 0x29
 	return len(dAtA) - i, nil
 }
+func (m *MsgUpdateParam_AsTokenLogicModules) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParam_AsTokenLogicModules) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.AsTokenLogicModules != nil {
+		{
+			size, err := m.AsTokenLogicModules.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	return len(dAtA) - i, nil
+}
 func (m *MsgUpdateParamResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -746,6 +783,18 @@ func (m *MsgUpdateParam_AsFloat) Size() (n int) {
 	n += 9
 	return n
 }
+func (m *MsgUpdateParam_AsTokenLogicModules) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AsTokenLogicModules != nil {
+		l = m.AsTokenLogicModules.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
 func (m *MsgUpdateParamResponse) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1137,6 +1186,41 @@ func (m *MsgUpdateParam) Unmarshal(dAtA []byte) error {
 			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
 			iNdEx += 8
 			m.AsType = &MsgUpdateParam_AsFloat{float64(math.Float64frombits(v))}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AsTokenLogicModules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &TokenLogicModuleConfigs{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.AsType = &MsgUpdateParam_AsTokenLogicModules{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
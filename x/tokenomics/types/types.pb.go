@@ -53,6 +53,11 @@ const (
 	// Module accounting
 	SettlementOpReason_TLM_GLOBAL_MINT_SUPPLIER_SHAREHOLDER_REWARD_MODULE_TRANSFER  SettlementOpReason = 13
 	SettlementOpReason_TLM_GLOBAL_MINT_REIMBURSEMENT_REQUEST_ESCROW_MODULE_TRANSFER SettlementOpReason = 14
+	// Service owner revenue share
+	SettlementOpReason_TLM_SERVICE_OWNER_REV_SHARE_REWARD_DISTRIBUTION SettlementOpReason = 15
+	// Supplier delegators reward distribution
+	SettlementOpReason_TLM_RELAY_BURN_EQUALS_MINT_DELEGATOR_REWARD_DISTRIBUTION SettlementOpReason = 16
+	SettlementOpReason_TLM_GLOBAL_MINT_DELEGATOR_REWARD_DISTRIBUTION            SettlementOpReason = 17
 )
 
 var SettlementOpReason_name = map[int32]string{
@@ -71,6 +76,9 @@ var SettlementOpReason_name = map[int32]string{
 	12: "UNSPECIFIED_TLM_SUPPLIER_SLASH_STAKE_BURN",
 	13: "TLM_GLOBAL_MINT_SUPPLIER_SHAREHOLDER_REWARD_MODULE_TRANSFER",
 	14: "TLM_GLOBAL_MINT_REIMBURSEMENT_REQUEST_ESCROW_MODULE_TRANSFER",
+	15: "TLM_SERVICE_OWNER_REV_SHARE_REWARD_DISTRIBUTION",
+	16: "TLM_RELAY_BURN_EQUALS_MINT_DELEGATOR_REWARD_DISTRIBUTION",
+	17: "TLM_GLOBAL_MINT_DELEGATOR_REWARD_DISTRIBUTION",
 }
 
 var SettlementOpReason_value = map[string]int32{
@@ -89,6 +97,9 @@ var SettlementOpReason_value = map[string]int32{
 	"UNSPECIFIED_TLM_SUPPLIER_SLASH_STAKE_BURN":                           12,
 	"TLM_GLOBAL_MINT_SUPPLIER_SHAREHOLDER_REWARD_MODULE_TRANSFER":         13,
 	"TLM_GLOBAL_MINT_REIMBURSEMENT_REQUEST_ESCROW_MODULE_TRANSFER":        14,
+	"TLM_SERVICE_OWNER_REV_SHARE_REWARD_DISTRIBUTION":                     15,
+	"TLM_RELAY_BURN_EQUALS_MINT_DELEGATOR_REWARD_DISTRIBUTION":            16,
+	"TLM_GLOBAL_MINT_DELEGATOR_REWARD_DISTRIBUTION":                       17,
 }
 
 func (x SettlementOpReason) String() string {
@@ -370,64 +381,66 @@ func init() {
 func init() { proto.RegisterFile("pocket/tokenomics/types.proto", fileDescriptor_b6778ad94f878cfb) }
 
 var fileDescriptor_b6778ad94f878cfb = []byte{
-	// 907 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0xdd, 0x6e, 0xdb, 0x36,
-	0x18, 0x8d, 0xec, 0x24, 0x6b, 0x98, 0xa4, 0xb5, 0xb9, 0x74, 0x70, 0x8a, 0xc5, 0x0e, 0xb2, 0x0d,
-	0xcb, 0x7e, 0x22, 0x35, 0xc9, 0x0a, 0x74, 0x68, 0xf7, 0x23, 0xd9, 0x4a, 0x23, 0x4c, 0xb6, 0x1c,
-	0xca, 0x42, 0xd0, 0xdd, 0x08, 0xb6, 0xcc, 0x66, 0x42, 0x2c, 0x51, 0x10, 0xe9, 0x6e, 0x7b, 0x8b,
-	0xdd, 0xee, 0x01, 0xf6, 0x06, 0xdb, 0x23, 0x0c, 0xe8, 0x65, 0xb1, 0xab, 0x5e, 0x19, 0x83, 0x73,
-	0xe7, 0xa7, 0x18, 0x44, 0xb1, 0x91, 0x2b, 0x3b, 0x5b, 0xb3, 0x61, 0x40, 0x6f, 0x44, 0x91, 0xdf,
-	0x39, 0xe7, 0xe3, 0x77, 0x74, 0x2e, 0x04, 0xb6, 0x22, 0xe2, 0x9d, 0x63, 0xa6, 0x30, 0x72, 0x8e,
-	0x43, 0x12, 0xf8, 0x1e, 0x55, 0xd8, 0x8f, 0x11, 0xa6, 0x72, 0x14, 0x13, 0x46, 0x60, 0x39, 0x2d,
-	0xcb, 0x59, 0xf9, 0x4e, 0xd5, 0x23, 0x34, 0x20, 0x54, 0xe9, 0x75, 0x29, 0x56, 0x9e, 0xee, 0xf7,
-	0x30, 0xeb, 0xee, 0x2b, 0x1e, 0xf1, 0xc3, 0x94, 0x72, 0x67, 0x33, 0xad, 0xbb, 0x7c, 0xa7, 0xa4,
-	0x1b, 0x51, 0xda, 0x38, 0x23, 0x67, 0x24, 0x3d, 0x4f, 0xde, 0xc4, 0x69, 0x45, 0x5c, 0x21, 0x8a,
-	0x09, 0x79, 0x32, 0xdd, 0x7d, 0xe7, 0xf7, 0x22, 0xb8, 0x5d, 0x1f, 0x74, 0xfd, 0xc0, 0xc6, 0x8c,
-	0x0d, 0x70, 0x80, 0x43, 0x86, 0x30, 0x1d, 0x0e, 0x18, 0xbc, 0x0f, 0x96, 0xbc, 0xa4, 0x50, 0x91,
-	0xb6, 0xa5, 0xdd, 0xd5, 0x83, 0xb7, 0x65, 0x71, 0x4f, 0xae, 0x21, 0x73, 0x8e, 0xb6, 0xfe, 0x6c,
-	0x54, 0x5b, 0x98, 0x8c, 0x6a, 0x29, 0x12, 0xa5, 0x0b, 0xd4, 0xc0, 0x52, 0xe0, 0x87, 0x8c, 0x56,
-	0x0a, 0xdb, 0xc5, 0xdd, 0xd5, 0x83, 0x2d, 0x79, 0x66, 0x42, 0xb9, 0xe9, 0x87, 0x4c, 0x1b, 0xc6,
-	0xa1, 0x15, 0x65, 0x1a, 0x9c, 0x83, 0xd2, 0x25, 0xd1, 0xe8, 0x0d, 0xe3, 0x90, 0x56, 0x8a, 0xd7,
-	0xd2, 0xe0, 0x1c, 0x94, 0x2e, 0x30, 0x06, 0x1b, 0x01, 0xe9, 0xbb, 0x8c, 0xb8, 0x7c, 0x89, 0xbb,
-	0x21, 0x7d, 0x82, 0x63, 0x5a, 0x59, 0xe4, 0x92, 0xef, 0xcd, 0x93, 0x24, 0xfd, 0x0e, 0x49, 0x1e,
-	0x02, 0xab, 0xbd, 0x2b, 0x84, 0xe7, 0x0a, 0xa1, 0x72, 0x90, 0xc3, 0x53, 0xf8, 0x14, 0xdc, 0x16,
-	0xd0, 0xae, 0xe7, 0xb1, 0xa9, 0xa6, 0x4b, 0xbc, 0xe9, 0xfb, 0x57, 0x35, 0x55, 0x3d, 0x8f, 0x5d,
-	0x76, 0xdd, 0x12, 0x5d, 0xe7, 0x4b, 0x21, 0x18, 0xe4, 0x19, 0x74, 0x67, 0x2c, 0x01, 0x90, 0x19,
-	0x02, 0x4f, 0xc0, 0x0a, 0x89, 0xdc, 0x18, 0x77, 0x29, 0x09, 0xf9, 0x07, 0xbc, 0x79, 0xf0, 0xc1,
-	0x9c, 0xd6, 0xd9, 0x47, 0xb7, 0x22, 0xc4, 0xc1, 0xda, 0xfa, 0x64, 0x54, 0xcb, 0xb8, 0xe8, 0x06,
-	0x11, 0x05, 0xd8, 0x00, 0xe5, 0x06, 0xa6, 0xcc, 0x0f, 0xbb, 0xcc, 0x27, 0x61, 0x93, 0xf4, 0x87,
-	0x03, 0x5c, 0x29, 0x6c, 0x4b, 0xbb, 0x2b, 0xda, 0x3b, 0x93, 0x51, 0x0d, 0xf6, 0xb3, 0x62, 0x62,
-	0xd3, 0x70, 0x80, 0xd1, 0x2c, 0x01, 0x3e, 0x00, 0x8b, 0x49, 0x90, 0x2b, 0x45, 0x1e, 0xaa, 0x4d,
-	0x59, 0x84, 0x37, 0x49, 0xba, 0x2c, 0x92, 0x2e, 0xd7, 0x89, 0x1f, 0x6a, 0x6b, 0xc2, 0x03, 0x0e,
-	0x47, 0xfc, 0xb9, 0xf3, 0x5b, 0x01, 0x94, 0x67, 0xdc, 0xfa, 0x3f, 0x66, 0xbd, 0x07, 0xd6, 0x6c,
-	0x1c, 0xf6, 0x71, 0xfc, 0xca, 0x98, 0xe5, 0xc9, 0xa8, 0xb6, 0x4e, 0xf9, 0xf9, 0xcb, 0x09, 0x5f,
-	0x81, 0xc1, 0xc7, 0xa0, 0x84, 0xb0, 0xe7, 0x47, 0x3e, 0x0e, 0x99, 0xda, 0xef, 0xc7, 0x98, 0x52,
-	0x3e, 0xe8, 0x8a, 0xb6, 0x37, 0x19, 0xd5, 0xca, 0xf1, 0xcb, 0x9a, 0xdb, 0x4d, 0x8b, 0x7f, 0xfc,
-	0xba, 0xb7, 0x21, 0x0c, 0x10, 0x70, 0x9b, 0xc5, 0x7e, 0x78, 0x86, 0x66, 0x64, 0x2e, 0x7d, 0x5b,
-	0xfc, 0x37, 0xbe, 0xfd, 0x5c, 0x00, 0xa5, 0x7c, 0xb4, 0xdf, 0x20, 0xdb, 0xbe, 0x04, 0xb7, 0x2e,
-	0xe7, 0x15, 0xcc, 0xd4, 0xb5, 0x8d, 0xc9, 0xa8, 0x56, 0xca, 0x5c, 0x13, 0xe4, 0x3c, 0xf8, 0x3f,
-	0x79, 0xf3, 0xf1, 0x2f, 0xcb, 0x00, 0xce, 0xce, 0x08, 0x6f, 0x81, 0x55, 0xa7, 0x65, 0xb7, 0xf5,
-	0xba, 0x71, 0x64, 0xe8, 0x8d, 0xd2, 0x02, 0x3c, 0x00, 0x72, 0xc7, 0x6c, 0xba, 0x48, 0x37, 0xd5,
-	0xc7, 0xae, 0xe6, 0xa0, 0x96, 0xab, 0x9f, 0x38, 0xaa, 0x69, 0xbb, 0x4d, 0xa3, 0xd5, 0x71, 0x6d,
-	0xa7, 0xdd, 0x36, 0x0d, 0x1d, 0xb9, 0x76, 0x47, 0xfd, 0x46, 0xe7, 0x67, 0x25, 0x09, 0xde, 0x03,
-	0xfb, 0x7f, 0xc3, 0x51, 0x13, 0x4a, 0x5d, 0xed, 0x18, 0x56, 0x4b, 0xd0, 0x12, 0x48, 0xa9, 0x00,
-	0xb7, 0xc0, 0x66, 0x42, 0x7b, 0x64, 0x5a, 0x9a, 0x6a, 0xa6, 0x58, 0xa3, 0x75, 0x64, 0x72, 0x64,
-	0xa9, 0x08, 0x1f, 0x81, 0xfa, 0x6b, 0xdd, 0xe4, 0x58, 0x45, 0xfa, 0xb1, 0x65, 0x36, 0x74, 0xe4,
-	0x22, 0xfd, 0x54, 0x45, 0x0d, 0xb7, 0x61, 0xd8, 0x1d, 0x64, 0x68, 0x0e, 0x17, 0x5a, 0x84, 0x9f,
-	0x80, 0x0f, 0xf3, 0x7d, 0x1a, 0xaa, 0x35, 0x17, 0xbc, 0x04, 0xef, 0x82, 0x4f, 0xf3, 0xe0, 0x36,
-	0xb2, 0xda, 0x96, 0x7d, 0x85, 0xfc, 0x32, 0x7c, 0x08, 0xee, 0xe7, 0x19, 0xaf, 0x7d, 0xb9, 0xb7,
-	0xe0, 0x67, 0xe0, 0xee, 0x0c, 0xdb, 0x72, 0x50, 0x5d, 0x77, 0xad, 0xd3, 0xd6, 0x15, 0xac, 0x1b,
-	0xf0, 0x10, 0x28, 0x79, 0xd6, 0xb4, 0xcd, 0xf3, 0x48, 0x2b, 0xf0, 0x0b, 0xf0, 0x79, 0x9e, 0x84,
-	0x74, 0xa3, 0xa9, 0x39, 0xc8, 0xd6, 0x9b, 0x3a, 0xdf, 0x9d, 0x38, 0xba, 0xdd, 0x71, 0x75, 0xbb,
-	0x8e, 0xac, 0x53, 0x6e, 0x52, 0x07, 0xa9, 0x2d, 0xfb, 0x48, 0x47, 0x25, 0x90, 0x24, 0x63, 0x2a,
-	0x2a, 0x6e, 0x22, 0x95, 0xcd, 0x69, 0xaa, 0xf6, 0xb1, 0xdb, 0xb4, 0x1a, 0x8e, 0xa9, 0x67, 0x9c,
-	0x55, 0xb8, 0x07, 0x3e, 0xfa, 0x07, 0xce, 0x54, 0x22, 0xd6, 0xe0, 0x57, 0xe0, 0xc1, 0x75, 0xac,
-	0xcc, 0xf7, 0x5b, 0x87, 0x5f, 0x83, 0x87, 0xd7, 0x1a, 0x31, 0xaf, 0x70, 0x53, 0x3b, 0x79, 0x36,
-	0xae, 0x4a, 0xcf, 0xc7, 0x55, 0xe9, 0xc5, 0xb8, 0x2a, 0xfd, 0x39, 0xae, 0x4a, 0x3f, 0x5d, 0x54,
-	0x17, 0x9e, 0x5f, 0x54, 0x17, 0x5e, 0x5c, 0x54, 0x17, 0xbe, 0x3d, 0x3c, 0xf3, 0xd9, 0x77, 0xc3,
-	0x9e, 0xec, 0x91, 0x40, 0x89, 0xc8, 0x39, 0xdb, 0x0b, 0x31, 0xfb, 0x9e, 0xc4, 0xe7, 0x7c, 0x13,
-	0x93, 0xc1, 0x40, 0xf9, 0x61, 0xe6, 0xff, 0xa7, 0xb7, 0xcc, 0x7f, 0x41, 0x0e, 0xff, 0x0a, 0x00,
-	0x00, 0xff, 0xff, 0xf1, 0x32, 0x70, 0xc3, 0x21, 0x09, 0x00, 0x00,
+	// 942 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0xcb, 0x6e, 0xdb, 0x46,
+	0x14, 0xd5, 0xc3, 0x4e, 0xe2, 0xb1, 0x1d, 0x4b, 0x53, 0xa7, 0x90, 0x83, 0x5a, 0x32, 0xdc, 0x16,
+	0x75, 0x1f, 0xa2, 0x62, 0xbb, 0x01, 0x52, 0x24, 0x7d, 0x90, 0xe2, 0xd8, 0x26, 0x4a, 0x89, 0xf2,
+	0x90, 0x8c, 0x91, 0x6e, 0x08, 0x89, 0x9a, 0xb8, 0x84, 0x45, 0x0e, 0x41, 0x8e, 0xd2, 0xf6, 0x2f,
+	0xba, 0xed, 0x7f, 0xb4, 0x9f, 0x50, 0x20, 0x40, 0x37, 0x41, 0x57, 0x59, 0x09, 0x85, 0xbc, 0xd3,
+	0x57, 0x14, 0x1c, 0xd2, 0xa6, 0x42, 0xc9, 0x69, 0xdc, 0xa2, 0x40, 0x36, 0x1a, 0xcd, 0xdc, 0x73,
+	0xce, 0x9d, 0x7b, 0xe6, 0x2c, 0x08, 0x36, 0x7d, 0x6a, 0x9f, 0x11, 0xd6, 0x60, 0xf4, 0x8c, 0x78,
+	0xd4, 0x75, 0xec, 0xb0, 0xc1, 0x7e, 0xf2, 0x49, 0x28, 0xf8, 0x01, 0x65, 0x14, 0x96, 0xe3, 0xb2,
+	0x90, 0x96, 0xef, 0x56, 0x6d, 0x1a, 0xba, 0x34, 0x6c, 0xf4, 0xba, 0x21, 0x69, 0x3c, 0xdb, 0xed,
+	0x11, 0xd6, 0xdd, 0x6d, 0xd8, 0xd4, 0xf1, 0x62, 0xca, 0xdd, 0x8d, 0xb8, 0x6e, 0xf1, 0x5d, 0x23,
+	0xde, 0x24, 0xa5, 0xf5, 0x53, 0x7a, 0x4a, 0xe3, 0xf3, 0xe8, 0x5f, 0x72, 0x5a, 0x49, 0xae, 0xe0,
+	0x07, 0x94, 0x3e, 0x9d, 0xee, 0xbe, 0xfd, 0x7b, 0x11, 0xdc, 0x69, 0x0e, 0xba, 0x8e, 0xab, 0x13,
+	0xc6, 0x06, 0xc4, 0x25, 0x1e, 0xc3, 0x24, 0x1c, 0x0e, 0x18, 0x7c, 0x00, 0x16, 0xed, 0xa8, 0x50,
+	0xc9, 0x6f, 0xe5, 0x77, 0x96, 0xf7, 0xde, 0x11, 0x92, 0x7b, 0x72, 0x0d, 0x81, 0x73, 0xa4, 0xd5,
+	0xe7, 0xa3, 0x5a, 0x6e, 0x32, 0xaa, 0xc5, 0x48, 0x1c, 0x2f, 0x50, 0x02, 0x8b, 0xae, 0xe3, 0xb1,
+	0xb0, 0x52, 0xd8, 0x2a, 0xee, 0x2c, 0xef, 0x6d, 0x0a, 0x33, 0x13, 0x0a, 0x2d, 0xc7, 0x63, 0xd2,
+	0x30, 0xf0, 0x34, 0x3f, 0xd5, 0xe0, 0x1c, 0x1c, 0x2f, 0x91, 0x46, 0x6f, 0x18, 0x78, 0x61, 0xa5,
+	0x78, 0x2d, 0x0d, 0xce, 0xc1, 0xf1, 0x02, 0x03, 0xb0, 0xee, 0xd2, 0xbe, 0xc5, 0xa8, 0xc5, 0x97,
+	0xa0, 0xeb, 0x85, 0x4f, 0x49, 0x10, 0x56, 0x16, 0xb8, 0xe4, 0xfb, 0xf3, 0x24, 0x69, 0xdf, 0xa0,
+	0xd1, 0x4f, 0x82, 0x95, 0xde, 0x4b, 0x84, 0xe7, 0x0a, 0xe1, 0xb2, 0x9b, 0xc1, 0x87, 0xf0, 0x19,
+	0xb8, 0x93, 0x40, 0xbb, 0xb6, 0xcd, 0xa6, 0x9a, 0x2e, 0xf2, 0xa6, 0x1f, 0x5c, 0xd5, 0x54, 0xb4,
+	0x6d, 0x76, 0xd9, 0x75, 0x33, 0xe9, 0x3a, 0x5f, 0x0a, 0x43, 0x37, 0xcb, 0x08, 0xb7, 0xc7, 0x79,
+	0x00, 0x52, 0x43, 0xe0, 0x31, 0x58, 0xa2, 0xbe, 0x15, 0x90, 0x6e, 0x48, 0x3d, 0xfe, 0x80, 0xb7,
+	0xf7, 0x3e, 0x9c, 0xd3, 0x3a, 0x7d, 0x74, 0xcd, 0xc7, 0x1c, 0x2c, 0xad, 0x4e, 0x46, 0xb5, 0x94,
+	0x8b, 0x6f, 0xd1, 0xa4, 0x00, 0x65, 0x50, 0x96, 0x49, 0xc8, 0x1c, 0xaf, 0xcb, 0x1c, 0xea, 0xb5,
+	0x68, 0x7f, 0x38, 0x20, 0x95, 0xc2, 0x56, 0x7e, 0x67, 0x49, 0x7a, 0x77, 0x32, 0xaa, 0xc1, 0x7e,
+	0x5a, 0x8c, 0x6c, 0x1a, 0x0e, 0x08, 0x9e, 0x25, 0xc0, 0x87, 0x60, 0x21, 0x0a, 0x72, 0xa5, 0xc8,
+	0x43, 0xb5, 0x21, 0x24, 0xe1, 0x8d, 0x92, 0x2e, 0x24, 0x49, 0x17, 0x9a, 0xd4, 0xf1, 0xa4, 0x95,
+	0xc4, 0x03, 0x0e, 0xc7, 0xfc, 0x77, 0xfb, 0xb7, 0x02, 0x28, 0xcf, 0xb8, 0xf5, 0x7f, 0xcc, 0x7a,
+	0x1f, 0xac, 0xe8, 0xc4, 0xeb, 0x93, 0xe0, 0x95, 0x31, 0xcb, 0x93, 0x51, 0x6d, 0x35, 0xe4, 0xe7,
+	0x17, 0x13, 0xbe, 0x02, 0x83, 0x4f, 0x40, 0x09, 0x13, 0xdb, 0xf1, 0x1d, 0xe2, 0x31, 0xb1, 0xdf,
+	0x0f, 0x48, 0x18, 0xf2, 0x41, 0x97, 0xa4, 0xfa, 0x64, 0x54, 0x2b, 0x07, 0x17, 0x35, 0xab, 0x1b,
+	0x17, 0xff, 0xfc, 0xb5, 0xbe, 0x9e, 0x18, 0x90, 0xc0, 0x75, 0x16, 0x38, 0xde, 0x29, 0x9e, 0x91,
+	0xb9, 0xf4, 0x6d, 0xe1, 0xdf, 0xf8, 0xf6, 0x4b, 0x01, 0x94, 0xb2, 0xd1, 0x7e, 0x8b, 0x6c, 0xfb,
+	0x0a, 0xac, 0x5d, 0xce, 0x9b, 0x30, 0x63, 0xd7, 0xd6, 0x27, 0xa3, 0x5a, 0x29, 0x75, 0x2d, 0x21,
+	0x67, 0xc1, 0xff, 0xc9, 0x9b, 0x4f, 0xfe, 0xb8, 0x09, 0xe0, 0xec, 0x8c, 0x70, 0x0d, 0x2c, 0x9b,
+	0x6d, 0xbd, 0x83, 0x9a, 0xca, 0x81, 0x82, 0xe4, 0x52, 0x0e, 0xee, 0x01, 0xc1, 0x50, 0x5b, 0x16,
+	0x46, 0xaa, 0xf8, 0xc4, 0x92, 0x4c, 0xdc, 0xb6, 0xd0, 0xb1, 0x29, 0xaa, 0xba, 0xd5, 0x52, 0xda,
+	0x86, 0xa5, 0x9b, 0x9d, 0x8e, 0xaa, 0x20, 0x6c, 0xe9, 0x86, 0xf8, 0x2d, 0xe2, 0x67, 0xa5, 0x3c,
+	0xbc, 0x0f, 0x76, 0x5f, 0xc3, 0x11, 0x23, 0x4a, 0x53, 0x34, 0x14, 0xad, 0x9d, 0xd0, 0x22, 0x48,
+	0xa9, 0x00, 0x37, 0xc1, 0x46, 0x44, 0x3b, 0x54, 0x35, 0x49, 0x54, 0x63, 0xac, 0xd2, 0x3e, 0x50,
+	0x39, 0xb2, 0x54, 0x84, 0x87, 0xa0, 0xf9, 0x46, 0x37, 0x39, 0x12, 0x31, 0x3a, 0xd2, 0x54, 0x19,
+	0x61, 0x0b, 0xa3, 0x13, 0x11, 0xcb, 0x96, 0xac, 0xe8, 0x06, 0x56, 0x24, 0x93, 0x0b, 0x2d, 0xc0,
+	0x4f, 0xc1, 0x47, 0xd9, 0x3e, 0xb2, 0xa8, 0xcd, 0x05, 0x2f, 0xc2, 0x7b, 0xe0, 0xb3, 0x2c, 0xb8,
+	0x83, 0xb5, 0x8e, 0xa6, 0x5f, 0x21, 0x7f, 0x03, 0x3e, 0x02, 0x0f, 0xb2, 0x8c, 0x37, 0xbe, 0xdc,
+	0x4d, 0xf8, 0x39, 0xb8, 0x37, 0xc3, 0xd6, 0x4c, 0xdc, 0x44, 0x96, 0x76, 0xd2, 0xbe, 0x82, 0x75,
+	0x0b, 0xee, 0x83, 0x46, 0x96, 0x35, 0x6d, 0xf3, 0x3c, 0xd2, 0x12, 0xfc, 0x12, 0x7c, 0x91, 0x25,
+	0x61, 0xa4, 0xb4, 0x24, 0x13, 0xeb, 0xa8, 0x85, 0xf8, 0xee, 0xd8, 0x44, 0xba, 0x61, 0x21, 0xbd,
+	0x89, 0xb5, 0x13, 0x6e, 0x92, 0x81, 0xc5, 0xb6, 0x7e, 0x80, 0x70, 0x09, 0x44, 0xc9, 0x98, 0x8a,
+	0x8a, 0x15, 0x49, 0xa5, 0x73, 0xaa, 0xa2, 0x7e, 0x64, 0xb5, 0x34, 0xd9, 0x54, 0x51, 0xca, 0x59,
+	0x86, 0x75, 0xf0, 0xf1, 0x3f, 0x70, 0xa6, 0x12, 0xb1, 0x02, 0xbf, 0x06, 0x0f, 0xaf, 0x63, 0x65,
+	0xb6, 0xdf, 0x2a, 0xfc, 0x06, 0x3c, 0xba, 0xd6, 0x88, 0x59, 0x85, 0xdb, 0x17, 0xce, 0xea, 0x08,
+	0x3f, 0x56, 0xa6, 0x1e, 0xe1, 0x71, 0xdc, 0x7f, 0xae, 0xb3, 0x6b, 0x17, 0x11, 0xb8, 0x22, 0xaa,
+	0x32, 0x52, 0xd1, 0xa1, 0x68, 0x68, 0xf3, 0x1f, 0xb3, 0x04, 0x77, 0x41, 0x7d, 0x26, 0x9f, 0xaf,
+	0xa5, 0x94, 0xa5, 0xe3, 0xe7, 0xe3, 0x6a, 0xfe, 0xc5, 0xb8, 0x9a, 0x7f, 0x39, 0xae, 0xe6, 0xff,
+	0x1a, 0x57, 0xf3, 0x3f, 0x9f, 0x57, 0x73, 0x2f, 0xce, 0xab, 0xb9, 0x97, 0xe7, 0xd5, 0xdc, 0x77,
+	0xfb, 0xa7, 0x0e, 0xfb, 0x7e, 0xd8, 0x13, 0x6c, 0xea, 0x36, 0x7c, 0x7a, 0xc6, 0xea, 0x1e, 0x61,
+	0x3f, 0xd0, 0xe0, 0x8c, 0x6f, 0x02, 0x3a, 0x18, 0x34, 0x7e, 0x9c, 0xf9, 0x4a, 0xeb, 0xdd, 0xe0,
+	0x1f, 0x4a, 0xfb, 0x7f, 0x0f, 0x00, 0x9e, 0x6d, 0xfe, 0xd5, 0xc7, 0x09, 0x00, 0x00,
 }
 
 func (m *ClaimSettlementResult) Marshal() (dAtA []byte, err error) {